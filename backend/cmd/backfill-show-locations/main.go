@@ -0,0 +1,135 @@
+// Command backfill-show-locations aligns each show's stored city/state with
+// its primary venue (the lowest venue ID). Submissions historically copied
+// city/state from free-text form fields, so some shows are blank or disagree
+// with the venue they are listed at. CreateShow now derives a blank location
+// from the primary venue, so this is a one-shot cleanup and is idempotent: a
+// second run reports zero changes. Shows with no venue are left alone.
+//
+// Usage:
+//
+//	go run ./cmd/backfill-show-locations                  # dry-run (default)
+//	go run ./cmd/backfill-show-locations --confirm        # apply changes
+//	go run ./cmd/backfill-show-locations --env .env.stage # target a specific env
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/services/catalog"
+)
+
+var (
+	confirm bool
+	envFile string
+)
+
+func main() {
+	flag.BoolVar(&confirm, "confirm", false, "Apply changes (default: dry-run only)")
+	flag.StringVar(&envFile, "env", "", "Path to .env file (defaults to .env.development / .env)")
+	flag.Parse()
+
+	loadEnv()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+	if err := db.Connect(cfg); err != nil {
+		log.Fatalf("connect db: %v", err)
+	}
+	database := db.GetDB()
+
+	mode := "DRY RUN"
+	if confirm {
+		mode = "LIVE"
+	}
+	fmt.Printf("=== Show Location Backfill (%s) ===\n", mode)
+	// Surface the resolved target so a mistargeted --confirm is caught before
+	// any write. Credentials are redacted.
+	fmt.Printf("Target: ENVIRONMENT=%q  db=%s\n\n",
+		os.Getenv(config.EnvEnvironment), redactDBHost(cfg.Database.URL))
+
+	report, err := catalog.BackfillShowLocations(database, catalog.ShowLocationBackfillOptions{DryRun: !confirm})
+	if err != nil {
+		log.Fatalf("backfill: %v", err)
+	}
+
+	printReport(report)
+}
+
+func loadEnv() {
+	if envFile != "" {
+		// Overload (not Load) so an explicit --env is authoritative over keys
+		// already exported in the process environment.
+		if err := godotenv.Overload(envFile); err != nil {
+			log.Fatalf("load env file %s: %v", envFile, err)
+		}
+		log.Printf("loaded env from %s (authoritative)", envFile)
+		return
+	}
+	for _, ef := range []string{".env.development", ".env"} {
+		if err := godotenv.Load(ef); err == nil {
+			log.Printf("loaded env from %s", ef)
+			return
+		}
+	}
+	log.Println("no .env loaded; using process environment")
+}
+
+// redactDBHost extracts host[:port]/dbname from a database URL, dropping any
+// embedded credentials, so the target can be logged without leaking secrets.
+func redactDBHost(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "<unparseable>"
+	}
+	return u.Host + u.Path
+}
+
+func printReport(r *catalog.ShowLocationBackfillReport) {
+	fmt.Println("--- Location changes ---")
+	if len(r.Changes) == 0 {
+		fmt.Println("  (none — all show locations match their primary venue)")
+	}
+	for _, c := range r.Changes {
+		status := "would-update"
+		if c.Applied {
+			status = "updated"
+		}
+		fmt.Printf("  [%s] show %d at venue %d %q: %q, %q -> %q, %q\n",
+			status, c.ShowID, c.VenueID, c.VenueName, c.OldCity, c.OldState, c.NewCity, c.NewState)
+	}
+
+	if len(r.Errors) > 0 {
+		fmt.Println("\n--- Errors ---")
+		for _, e := range r.Errors {
+			fmt.Printf("  [ERROR] %s\n", e)
+		}
+	}
+
+	fmt.Println("\n=== Summary ===")
+	fmt.Printf("Shows scanned:   %d\n", r.Scanned)
+	fmt.Printf("  changed:       %d\n", r.Changed)
+	fmt.Printf("  unchanged:     %d\n", r.Unchanged)
+	fmt.Printf("  errors:        %d\n", len(r.Errors))
+	fmt.Println()
+
+	if !confirm {
+		fmt.Println("DRY RUN — no DB writes. Re-run with --confirm to apply.")
+	} else {
+		fmt.Println("LIVE — changes committed.")
+	}
+
+	// Exit non-zero if a live run hit errors so CI/cron wrappers can alert.
+	if confirm && len(r.Errors) > 0 {
+		os.Exit(1)
+	}
+}
@@ -11,23 +11,32 @@
 //	go run ./cmd/backfill-venue-slugs                 # dry-run (default)
 //	go run ./cmd/backfill-venue-slugs --confirm       # apply changes
 //	go run ./cmd/backfill-venue-slugs --env .env.stage # target a specific env
+//	go run ./cmd/backfill-venue-slugs --confirm --batch-size 200 --rate 100 --checkpoint venues.ckpt
 //
-// Dry-run prints exactly what a live run would change and writes nothing. There
-// is no slug-redirect mechanism in the system and the corrupted slugs have no
-// legitimate external references, so old slugs simply 404 after the rewrite;
-// internal links regenerate from venue.slug.
+// Venues are processed in batches, each in its own transaction. Progress goes
+// to stderr. A run stopped by an error or Ctrl-C prints the --resume-from
+// position to continue from; with --checkpoint the next run picks it up on
+// its own.
+//
+// Dry-run prints exactly what a live run would change and writes nothing. A live
+// run keeps each non-empty old slug in slug_redirects so links to it still
+// resolve; internal links regenerate from venue.slug.
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net/url"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/joho/godotenv"
 
 	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/backfill"
 	"psychic-homily-backend/internal/config"
 	"psychic-homily-backend/internal/services/catalog"
 )
@@ -35,12 +44,15 @@ import (
 var (
 	confirm bool
 	envFile string
+	batch   backfill.Options
 )
 
 func main() {
 	flag.BoolVar(&confirm, "confirm", false, "Apply changes (default: dry-run only)")
 	flag.StringVar(&envFile, "env", "", "Path to .env file (defaults to .env.development / .env)")
+	batch.RegisterFlags(flag.CommandLine)
 	flag.Parse()
+	batch.Progress = os.Stderr
 
 	loadEnv()
 
@@ -51,7 +63,11 @@ func main() {
 	if err := db.Connect(cfg); err != nil {
 		log.Fatalf("connect db: %v", err)
 	}
-	database := db.GetDB()
+	// Ctrl-C stops the run after the batch in flight; the summary then says
+	// where to resume.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	database := db.GetDB().WithContext(ctx)
 
 	mode := "DRY RUN"
 	if confirm {
@@ -63,7 +79,7 @@ func main() {
 	fmt.Printf("Target: ENVIRONMENT=%q  db=%s\n\n",
 		os.Getenv(config.EnvEnvironment), redactDBHost(cfg.Database.URL))
 
-	report, err := catalog.BackfillVenueSlugs(database, catalog.VenueSlugBackfillOptions{DryRun: !confirm})
+	report, err := catalog.BackfillVenueSlugs(database, catalog.VenueSlugBackfillOptions{DryRun: !confirm, Batch: batch})
 	if err != nil {
 		log.Fatalf("backfill: %v", err)
 	}
@@ -135,6 +151,7 @@ func printReport(r *catalog.VenueSlugBackfillReport) {
 	fmt.Printf("  unchanged:     %d\n", r.Unchanged)
 	fmt.Printf("  errors:        %d\n", len(r.Errors))
 	fmt.Println()
+	printResumeHint(r.Errors, r.Cursor)
 
 	if !confirm {
 		fmt.Println("DRY RUN — no DB writes. Re-run with --confirm to apply.")
@@ -147,3 +164,11 @@ func printReport(r *catalog.VenueSlugBackfillReport) {
 		os.Exit(1)
 	}
 }
+
+// printResumeHint tells the operator how to pick up a run that stopped early.
+func printResumeHint(errs []string, cursor backfill.Cursor) {
+	if len(errs) == 0 || cursor.IsZero() {
+		return
+	}
+	fmt.Printf("Stopped early. Batches up to %s are committed; resume with --resume-from %s\n\n", cursor, cursor)
+}
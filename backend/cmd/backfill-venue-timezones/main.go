@@ -9,6 +9,12 @@
 //	go run ./cmd/backfill-venue-timezones --confirm          # apply changes
 //	go run ./cmd/backfill-venue-timezones --verbose          # per-row detail
 //	go run ./cmd/backfill-venue-timezones --env .env.stage   # target a specific env
+//	go run ./cmd/backfill-venue-timezones --confirm --batch-size 200 --rate 100 --checkpoint tz.ckpt
+//
+// Venues, then shows, are processed in batches, each in its own transaction.
+// Progress goes to stderr. A run stopped by an error or Ctrl-C prints the
+// --resume-from position (e.g. "shows:1200") to continue from; with --checkpoint the next run picks it up on
+// its own.
 //
 // Dry-run prints exactly what a live run would change and writes nothing. The
 // re-anchor pass is conservative — it only rewrites shows it can confidently
@@ -20,15 +26,19 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net/url"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/joho/godotenv"
 
 	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/backfill"
 	"psychic-homily-backend/internal/config"
 	"psychic-homily-backend/internal/services/catalog"
 	"psychic-homily-backend/internal/services/geo"
@@ -38,13 +48,16 @@ var (
 	confirm bool
 	verbose bool
 	envFile string
+	batch   backfill.Options
 )
 
 func main() {
 	flag.BoolVar(&confirm, "confirm", false, "Apply changes (default: dry-run only)")
 	flag.BoolVar(&verbose, "verbose", false, "Print per-venue / per-show detail")
 	flag.StringVar(&envFile, "env", "", "Path to .env file (defaults to .env.development / .env)")
+	batch.RegisterFlags(flag.CommandLine)
 	flag.Parse()
+	batch.Progress = os.Stderr
 
 	loadEnv()
 
@@ -55,7 +68,11 @@ func main() {
 	if err := db.Connect(cfg); err != nil {
 		log.Fatalf("connect db: %v", err)
 	}
-	database := db.GetDB()
+	// Ctrl-C stops the run after the batch in flight; the summary then says
+	// where to resume.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	database := db.GetDB().WithContext(ctx)
 
 	mode := "DRY RUN"
 	if confirm {
@@ -70,6 +87,7 @@ func main() {
 	report, err := catalog.BackfillVenueTimezones(database, geo.Default(), catalog.BackfillOptions{
 		DryRun:  !confirm,
 		Verbose: verbose,
+		Batch:   batch,
 	})
 	if err != nil {
 		log.Fatalf("backfill: %v", err)
@@ -121,6 +139,9 @@ func printReport(r *catalog.BackfillReport) {
 		case "unchanged":
 			fmt.Printf("  [unchanged] venue %d %q (%s, %s): %s\n",
 				c.VenueID, c.Name, c.City, c.State, tzStr(c.NewTz))
+		case "manual":
+			fmt.Printf("  [manual] venue %d %q (%s, %s): admin-set timezone kept (%s)\n",
+				c.VenueID, c.Name, c.City, c.State, tzStr(c.OldTz))
 		}
 	}
 
@@ -163,6 +184,7 @@ func printReport(r *catalog.BackfillReport) {
 	fmt.Printf("  no venue tz:     %d\n", r.ShowsNoVenueTz)
 	fmt.Printf("Errors:            %d\n", len(r.Errors))
 	fmt.Println()
+	printResumeHint(r.Errors, r.Cursor)
 
 	if !confirm {
 		fmt.Println("DRY RUN — no DB writes. Re-run with --confirm to apply.")
@@ -182,3 +204,11 @@ func tzStr(s *string) string {
 	}
 	return *s
 }
+
+// printResumeHint tells the operator how to pick up a run that stopped early.
+func printResumeHint(errs []string, cursor backfill.Cursor) {
+	if len(errs) == 0 || cursor.IsZero() {
+		return
+	}
+	fmt.Printf("Stopped early. Batches up to %s are committed; resume with --resume-from %s\n\n", cursor, cursor)
+}
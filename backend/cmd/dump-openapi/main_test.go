@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRender_JSONCoversAdminRoutes(t *testing.T) {
+	out, err := render(buildSpec(), "json", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var spec struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	if err := json.Unmarshal(out, &spec); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+	if spec.OpenAPI != "3.1.0" {
+		t.Errorf("expected OpenAPI 3.1.0, got %q", spec.OpenAPI)
+	}
+	if _, ok := spec.Paths["/admin/activity"]; !ok {
+		t.Error("expected admin routes in the spec")
+	}
+}
+
+func TestRender_Downgrade(t *testing.T) {
+	out, err := render(buildSpec(), "yaml", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(out), "openapi: 3.0.3") {
+		t.Errorf("expected a 3.0.3 YAML document, got %.80q", out)
+	}
+}
+
+func TestRender_UnknownFormat(t *testing.T) {
+	if _, err := render(buildSpec(), "xml", false); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
@@ -66,8 +66,9 @@ func main() {
 
 	// Optionally make admin
 	if *makeAdmin && !user.IsAdmin {
-		db.Model(&user).Update("is_admin", true)
-		fmt.Fprintf(os.Stderr, "Made user %d (%s) an admin\n", user.ID, *user.Email)
+		db.Model(&user).Updates(map[string]any{"is_admin": true, "role": authm.RoleSuperadmin})
+		user.IsAdmin = true
+		fmt.Fprintf(os.Stderr, "Made user %d (%s) a superadmin\n", user.ID, *user.Email)
 	}
 
 	if !user.IsAdmin {
@@ -0,0 +1,312 @@
+// Command loadgen drives the show list and search endpoints of a running
+// server with concurrent clients and reports latency percentiles, so a slow
+// query (e.g. a cursor pagination regression) shows up before deploy.
+//
+// Seed the target first (`go run ./cmd/seed -synthetic -shows 5000`), then:
+//
+//	go run ./cmd/loadgen -url http://localhost:8080 -scenario mixed -c 16 -d 30s
+//	go run ./cmd/loadgen -scenario upcoming -pages 5 -max-p95 250ms   # exit 1 if slower
+//
+// Scenarios:
+//
+//	upcoming  GET /shows/upcoming, following next_cursor up to -pages pages
+//	search    GET /shows/search, /artists/search and /venues/search with -queries
+//	mixed     both, alternating
+//
+// Anonymous reads are rate limited per IP when the server runs with
+// ENABLE_PUBLIC_READ_RATE_LIMITS; 429s are counted separately in the report.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// options is the parsed command line.
+type options struct {
+	baseURL      string
+	scenario     string
+	concurrency  int
+	duration     time.Duration
+	requests     int
+	limit        int
+	pages        int
+	timezone     string
+	cities       string
+	queries      []string
+	apiKey       string
+	timeout      time.Duration
+	maxP95       time.Duration
+	maxErrorRate float64
+}
+
+var scenarios = map[string]bool{"upcoming": true, "search": true, "mixed": true}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run parses args, drives the load and returns the process exit code: 0 on
+// success, 1 when a threshold is exceeded or the target is unreachable, 2 on
+// bad flags.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	opts, err := parseFlags(args, stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "loadgen: %v\n", err)
+		return 2
+	}
+
+	client := &http.Client{Timeout: opts.timeout}
+	if err := checkReachable(ctx, client, opts.baseURL); err != nil {
+		fmt.Fprintf(stderr, "loadgen: %v\n", err)
+		return 1
+	}
+
+	fmt.Fprintf(stdout, "Driving %s: scenario=%s concurrency=%d %s\n\n",
+		opts.baseURL, opts.scenario, opts.concurrency, budgetString(opts))
+	rec := newRecorder()
+	elapsed := drive(ctx, client, opts, rec)
+	rep := rec.report(elapsed)
+	rep.write(stdout)
+
+	if failures := rep.check(opts.maxP95, opts.maxErrorRate); len(failures) > 0 {
+		fmt.Fprintln(stdout)
+		for _, f := range failures {
+			fmt.Fprintf(stdout, "FAIL: %s\n", f)
+		}
+		return 1
+	}
+	return 0
+}
+
+func parseFlags(args []string, stderr io.Writer) (*options, error) {
+	fs := flag.NewFlagSet("loadgen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts := &options{}
+	var queries string
+	fs.StringVar(&opts.baseURL, "url", "http://localhost:8080", "Base URL of the API server")
+	fs.StringVar(&opts.scenario, "scenario", "mixed", "Traffic to send: upcoming, search or mixed")
+	fs.IntVar(&opts.concurrency, "c", 8, "Concurrent clients")
+	fs.DurationVar(&opts.duration, "d", 30*time.Second, "How long to run (ignored when -n is set)")
+	fs.IntVar(&opts.requests, "n", 0, "Stop after this many requests instead of after -d")
+	fs.IntVar(&opts.limit, "limit", 50, "Page size for /shows/upcoming")
+	fs.IntVar(&opts.pages, "pages", 3, "Pages of /shows/upcoming to follow per visit")
+	fs.StringVar(&opts.timezone, "timezone", "America/Phoenix", "timezone parameter for /shows/upcoming")
+	fs.StringVar(&opts.cities, "cities", "", "cities parameter for /shows/upcoming, e.g. 'Phoenix,AZ|Tempe,AZ'")
+	fs.StringVar(&queries, "queries", "velvet,copper,the,hall,coyotes,lantern", "Comma-separated search terms")
+	fs.StringVar(&opts.apiKey, "api-key", "", "Send this public API key as X-API-Key")
+	fs.DurationVar(&opts.timeout, "timeout", 10*time.Second, "Per-request timeout")
+	fs.DurationVar(&opts.maxP95, "max-p95", 0, "Fail if any endpoint's p95 exceeds this (0 = no limit)")
+	fs.Float64Var(&opts.maxErrorRate, "max-error-rate", 0.01, "Fail if more than this fraction of requests error (negative = no limit)")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments %v", fs.Args())
+	}
+
+	if !scenarios[opts.scenario] {
+		return nil, fmt.Errorf("unknown -scenario %q (want upcoming, search or mixed)", opts.scenario)
+	}
+	if opts.concurrency < 1 {
+		return nil, fmt.Errorf("-c must be at least 1")
+	}
+	if opts.requests < 0 || (opts.requests == 0 && opts.duration <= 0) {
+		return nil, fmt.Errorf("set a positive -d or -n")
+	}
+	if opts.limit < 1 || opts.limit > 200 {
+		return nil, fmt.Errorf("-limit must be between 1 and 200")
+	}
+	if opts.pages < 1 {
+		return nil, fmt.Errorf("-pages must be at least 1")
+	}
+	for _, q := range strings.Split(queries, ",") {
+		if q = strings.TrimSpace(q); q != "" {
+			opts.queries = append(opts.queries, q)
+		}
+	}
+	if opts.scenario != "upcoming" && len(opts.queries) == 0 {
+		return nil, fmt.Errorf("-queries is empty")
+	}
+	u, err := url.Parse(opts.baseURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid -url %q", opts.baseURL)
+	}
+	opts.baseURL = strings.TrimRight(opts.baseURL, "/")
+	return opts, nil
+}
+
+func budgetString(opts *options) string {
+	if opts.requests > 0 {
+		return fmt.Sprintf("requests=%d", opts.requests)
+	}
+	return fmt.Sprintf("duration=%s", opts.duration)
+}
+
+// checkReachable fails fast on a wrong -url instead of reporting a run of
+// connection errors.
+func checkReachable(ctx context.Context, client *http.Client, baseURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/health", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("server not reachable at %s: %w", baseURL, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// drive runs the clients until the request or time budget is spent, or ctx
+// is cancelled, and returns the wall time taken.
+func drive(ctx context.Context, client *http.Client, opts *options, rec *recorder) time.Duration {
+	if opts.requests == 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.duration)
+		defer cancel()
+	}
+
+	var issued atomic.Int64
+	// take reserves one request from the budget.
+	take := func() bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		return opts.requests == 0 || issued.Add(1) <= int64(opts.requests)
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for w := 0; w < opts.concurrency; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			c := &clientState{http: client, opts: opts, rec: rec, take: take}
+			for visit := worker; ; visit += opts.concurrency {
+				if !c.visit(ctx, visit) {
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	return time.Since(start)
+}
+
+// clientState is one simulated client.
+type clientState struct {
+	http *http.Client
+	opts *options
+	rec  *recorder
+	take func() bool
+}
+
+// visit performs the n'th visit of the scenario and reports whether the
+// budget allows another.
+func (c *clientState) visit(ctx context.Context, n int) bool {
+	switch {
+	case c.opts.scenario == "upcoming", c.opts.scenario == "mixed" && n%2 == 0:
+		return c.browseUpcoming(ctx)
+	default:
+		return c.search(ctx, n)
+	}
+}
+
+// browseUpcoming loads the first page of upcoming shows and follows the
+// cursor, as infinite scroll does. Cursor pages are reported separately:
+// they are where a pagination regression shows.
+func (c *clientState) browseUpcoming(ctx context.Context) bool {
+	cursor := ""
+	for page := 0; page < c.opts.pages; page++ {
+		if !c.take() {
+			return false
+		}
+		q := url.Values{}
+		q.Set("limit", strconv.Itoa(c.opts.limit))
+		q.Set("timezone", c.opts.timezone)
+		if c.opts.cities != "" {
+			q.Set("cities", c.opts.cities)
+		}
+		endpoint := "GET /shows/upcoming"
+		if cursor != "" {
+			q.Set("cursor", cursor)
+			endpoint = "GET /shows/upcoming (cursor)"
+		}
+
+		var body struct {
+			Pagination struct {
+				NextCursor *string `json:"next_cursor"`
+			} `json:"pagination"`
+		}
+		if !c.get(ctx, endpoint, "/shows/upcoming?"+q.Encode(), &body) || body.Pagination.NextCursor == nil {
+			return true
+		}
+		cursor = *body.Pagination.NextCursor
+	}
+	return true
+}
+
+var searchPaths = []string{"/shows/search", "/artists/search", "/venues/search"}
+
+func (c *clientState) search(ctx context.Context, n int) bool {
+	if !c.take() {
+		return false
+	}
+	path := searchPaths[(n/2)%len(searchPaths)]
+	query := c.opts.queries[n%len(c.opts.queries)]
+	c.get(ctx, "GET "+path, path+"?q="+url.QueryEscape(query), nil)
+	return true
+}
+
+// get issues one request and records it. It decodes a 200 response into out
+// when out is non-nil and reports whether that succeeded.
+func (c *clientState) get(ctx context.Context, endpoint, pathAndQuery string, out any) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.opts.baseURL+pathAndQuery, nil)
+	if err != nil {
+		c.rec.record(endpoint, 0, 0, err)
+		return false
+	}
+	if c.opts.apiKey != "" {
+		req.Header.Set("X-API-Key", c.opts.apiKey)
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		// A request cut off by the end of the run isn't a server error.
+		if ctx.Err() == nil {
+			c.rec.record(endpoint, time.Since(start), 0, err)
+		}
+		return false
+	}
+	defer resp.Body.Close()
+
+	var decodeErr error
+	if resp.StatusCode == http.StatusOK && out != nil {
+		decodeErr = json.NewDecoder(resp.Body).Decode(out)
+	} else {
+		_, _ = io.Copy(io.Discard, resp.Body)
+	}
+	// Latency includes reading the body, as a browser would.
+	c.rec.record(endpoint, time.Since(start), resp.StatusCode, decodeErr)
+	return resp.StatusCode == http.StatusOK && decodeErr == nil
+}
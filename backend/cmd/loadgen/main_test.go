@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeAPI serves /health, a three-page /shows/upcoming and the search routes.
+func fakeAPI(t *testing.T, searchStatus int) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var cursorPages atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/shows/upcoming", func(w http.ResponseWriter, r *http.Request) {
+		next := map[string]string{"": `"p2"`, "p2": `"p3"`, "p3": "null"}[r.URL.Query().Get("cursor")]
+		if r.URL.Query().Get("cursor") != "" {
+			cursorPages.Add(1)
+		}
+		fmt.Fprintf(w, `{"shows":[],"pagination":{"next_cursor":%s,"has_more":%t}}`, next, next != "null")
+	})
+	for _, path := range searchPaths {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("q") == "" {
+				t.Errorf("%s called without q", r.URL.Path)
+			}
+			w.WriteHeader(searchStatus)
+		})
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &cursorPages
+}
+
+func TestRun_UpcomingFollowsCursor(t *testing.T) {
+	srv, cursorPages := fakeAPI(t, http.StatusOK)
+	var out, errOut bytes.Buffer
+	code := run(context.Background(), []string{
+		"-url", srv.URL, "-scenario", "upcoming", "-c", "1", "-n", "6", "-pages", "5",
+	}, &out, &errOut)
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr: %s", code, errOut.String())
+	}
+	// Two visits of three pages each: the cursor ends the walk before -pages.
+	if got := cursorPages.Load(); got != 4 {
+		t.Errorf("cursor pages = %d, want 4", got)
+	}
+	for _, want := range []string{"GET /shows/upcoming (cursor)", "p95", "total"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("report missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestRun_MixedHitsSearchEndpoints(t *testing.T) {
+	srv, _ := fakeAPI(t, http.StatusOK)
+	var out, errOut bytes.Buffer
+	code := run(context.Background(), []string{
+		"-url", srv.URL, "-scenario", "mixed", "-c", "4", "-n", "40",
+	}, &out, &errOut)
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr: %s", code, errOut.String())
+	}
+	for _, path := range searchPaths {
+		if !strings.Contains(out.String(), "GET "+path) {
+			t.Errorf("report missing %s:\n%s", path, out.String())
+		}
+	}
+}
+
+func TestRun_FailsOnErrorRate(t *testing.T) {
+	srv, _ := fakeAPI(t, http.StatusInternalServerError)
+	var out, errOut bytes.Buffer
+	code := run(context.Background(), []string{
+		"-url", srv.URL, "-scenario", "search", "-c", "2", "-n", "10",
+	}, &out, &errOut)
+	if code != 1 {
+		t.Fatalf("exit code = %d, want 1", code)
+	}
+	if !strings.Contains(out.String(), "FAIL: error rate 100.00%") {
+		t.Errorf("missing error-rate failure:\n%s", out.String())
+	}
+}
+
+func TestRun_RateLimitedIsNotAnError(t *testing.T) {
+	srv, _ := fakeAPI(t, http.StatusTooManyRequests)
+	var out, errOut bytes.Buffer
+	code := run(context.Background(), []string{
+		"-url", srv.URL, "-scenario", "search", "-c", "1", "-n", "3",
+	}, &out, &errOut)
+	if code != 0 {
+		t.Fatalf("exit code = %d, want 0:\n%s", code, out.String())
+	}
+}
+
+func TestRun_RejectsBadFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"-scenario", "browse"},
+		{"-c", "0"},
+		{"-limit", "500"},
+		{"-d", "0"},
+		{"-url", "localhost"},
+		{"-scenario", "search", "-queries", " , "},
+		{"extra"},
+	} {
+		var out bytes.Buffer
+		if code := run(context.Background(), args, &out, &out); code != 2 {
+			t.Errorf("%v: exit code = %d, want 2", args, code)
+		}
+	}
+}
+
+func TestRun_UnreachableServer(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+	var out bytes.Buffer
+	if code := run(context.Background(), []string{"-url", url, "-n", "1"}, &out, &out); code != 1 {
+		t.Errorf("exit code = %d, want 1", code)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	for p, want := range map[int]time.Duration{50: 50 * time.Millisecond, 95: 95 * time.Millisecond, 99: 99 * time.Millisecond, 100: 100 * time.Millisecond} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("p%d = %s, want %s", p, got, want)
+		}
+	}
+	if got := percentile(sorted[:1], 99); got != time.Millisecond {
+		t.Errorf("single sample p99 = %s", got)
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("empty p50 = %s", got)
+	}
+}
+
+func TestReportCheck_P95Threshold(t *testing.T) {
+	rec := newRecorder()
+	for i := 0; i < 20; i++ {
+		rec.record("GET /shows/upcoming", 300*time.Millisecond, http.StatusOK, nil)
+		rec.record("GET /shows/search", 10*time.Millisecond, http.StatusOK, nil)
+	}
+	failures := rec.report(time.Second).check(100*time.Millisecond, 0.01)
+	if len(failures) != 1 || !strings.Contains(failures[0], "GET /shows/upcoming p95") {
+		t.Errorf("failures = %v", failures)
+	}
+}
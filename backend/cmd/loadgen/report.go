@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// recorder collects every request's outcome, grouped by endpoint.
+type recorder struct {
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+type series struct {
+	latencies   []time.Duration
+	errors      int // transport errors, 5xx and undecodable 200s
+	rateLimited int // 429s, counted apart from errors
+	statuses    map[int]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{series: map[string]*series{}}
+}
+
+func (r *recorder) record(endpoint string, latency time.Duration, status int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.series[endpoint]
+	if !ok {
+		s = &series{statuses: map[int]int{}}
+		r.series[endpoint] = s
+	}
+	s.latencies = append(s.latencies, latency)
+	if status != 0 {
+		s.statuses[status]++
+	}
+	switch {
+	case status == http.StatusTooManyRequests:
+		s.rateLimited++
+	case err != nil, status == 0, status >= 500:
+		s.errors++
+	}
+}
+
+// stats summarizes one endpoint (or the total).
+type stats struct {
+	Endpoint                string
+	Count                   int
+	Errors, RateLimited     int
+	OtherStatuses           map[int]int // non-200, non-429 statuses
+	P50, P90, P95, P99, Max time.Duration
+	RPS                     float64
+}
+
+// report is a finished run.
+type report struct {
+	Elapsed   time.Duration
+	Endpoints []stats
+	Total     stats
+}
+
+func (r *recorder) report(elapsed time.Duration) *report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rep := &report{Elapsed: elapsed}
+	total := &series{statuses: map[int]int{}}
+	names := make([]string, 0, len(r.series))
+	for name := range r.series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s := r.series[name]
+		rep.Endpoints = append(rep.Endpoints, summarize(name, s, elapsed))
+		total.latencies = append(total.latencies, s.latencies...)
+		total.errors += s.errors
+		total.rateLimited += s.rateLimited
+		for code, n := range s.statuses {
+			total.statuses[code] += n
+		}
+	}
+	rep.Total = summarize("total", total, elapsed)
+	return rep
+}
+
+func summarize(name string, s *series, elapsed time.Duration) stats {
+	sorted := append([]time.Duration(nil), s.latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	st := stats{
+		Endpoint:      name,
+		Count:         len(sorted),
+		Errors:        s.errors,
+		RateLimited:   s.rateLimited,
+		OtherStatuses: map[int]int{},
+		P50:           percentile(sorted, 50),
+		P90:           percentile(sorted, 90),
+		P95:           percentile(sorted, 95),
+		P99:           percentile(sorted, 99),
+	}
+	if len(sorted) > 0 {
+		st.Max = sorted[len(sorted)-1]
+	}
+	if elapsed > 0 {
+		st.RPS = float64(len(sorted)) / elapsed.Seconds()
+	}
+	for code, n := range s.statuses {
+		if code != http.StatusOK && code != http.StatusTooManyRequests {
+			st.OtherStatuses[code] = n
+		}
+	}
+	return st
+}
+
+// percentile is the nearest-rank percentile of sorted latencies.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100 // ceil(p/100 * n)
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func (rep *report) write(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "endpoint\trequests\trps\terrors\t429s\tp50\tp90\tp95\tp99\tmax\t")
+	rows := append(append([]stats(nil), rep.Endpoints...), rep.Total)
+	for _, st := range rows {
+		fmt.Fprintf(tw, "%s\t%d\t%.1f\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t\n",
+			st.Endpoint, st.Count, st.RPS, st.Errors, st.RateLimited,
+			ms(st.P50), ms(st.P90), ms(st.P95), ms(st.P99), ms(st.Max))
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\nElapsed %s\n", rep.Elapsed.Round(time.Millisecond))
+	for _, st := range rep.Endpoints {
+		if len(st.OtherStatuses) == 0 {
+			continue
+		}
+		codes := make([]int, 0, len(st.OtherStatuses))
+		for code := range st.OtherStatuses {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		fmt.Fprintf(w, "%s statuses:", st.Endpoint)
+		for _, code := range codes {
+			fmt.Fprintf(w, " %d×%d", code, st.OtherStatuses[code])
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func ms(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d.Microseconds())/1000)
+}
+
+// check returns a line per threshold the run exceeded.
+func (rep *report) check(maxP95 time.Duration, maxErrorRate float64) []string {
+	var failures []string
+	if rep.Total.Count == 0 {
+		return []string{"no requests completed"}
+	}
+	if maxP95 > 0 {
+		for _, st := range rep.Endpoints {
+			if st.P95 > maxP95 {
+				failures = append(failures, fmt.Sprintf("%s p95 %s exceeds %s", st.Endpoint, ms(st.P95), maxP95))
+			}
+		}
+	}
+	if maxErrorRate >= 0 {
+		rate := float64(rep.Total.Errors) / float64(rep.Total.Count)
+		if rate > maxErrorRate {
+			failures = append(failures, fmt.Sprintf("error rate %.2f%% exceeds %.2f%%", rate*100, maxErrorRate*100))
+		}
+	}
+	return failures
+}
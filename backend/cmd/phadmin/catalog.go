@@ -0,0 +1,375 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/internal/backfill"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/seed"
+	"psychic-homily-backend/internal/services/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/geo"
+	"psychic-homily-backend/internal/services/pipeline"
+)
+
+func init() {
+	register(command{
+		path:  []string{"seed"},
+		short: "Load the dev dataset or a synthetic one (same as cmd/seed)",
+		setup: func(fs *flag.FlagSet) func(*runtime) error {
+			synthetic := fs.Bool("synthetic", false, "Generate a synthetic catalog instead of loading the Hugo dataset")
+			update := fs.Bool("update", false, "Update venues, artists and shows that changed in the Hugo content instead of skipping them")
+			export := fs.Bool("export", false, "Write the database's approved shows, venues and artists back to the Hugo content")
+			out := fs.String("out", "..", "With --export, the directory holding content/shows and data")
+			opts := seed.SyntheticOptions{}
+			fs.IntVar(&opts.Shows, "shows", 500, "Synthetic shows to generate")
+			fs.IntVar(&opts.Venues, "venues", 0, "Synthetic venues (default shows/10, at least 10)")
+			fs.IntVar(&opts.Artists, "artists", 0, "Synthetic artists (default shows/2, at least 20)")
+			fs.Uint64Var(&opts.Seed, "seed", 1, "Pseudo-random seed; the same seed gives the same data")
+			fs.IntVar(&opts.Days, "days", 180, "Spread upcoming shows over this many days (plus a third as many past)")
+			anchor := fs.String("anchor", "", "Date (YYYY-MM-DD) shows are spread around (default today)")
+			return func(rt *runtime) error {
+				modes := 0
+				for _, on := range []bool{*synthetic, *update, *export} {
+					if on {
+						modes++
+					}
+				}
+				if modes > 1 {
+					return usageErrorf("--synthetic, --update and --export can't be combined")
+				}
+				if *export {
+					// Export only reads the database; --dry-run skips the file writes.
+					_, gdb, err := rt.open()
+					if err != nil {
+						return err
+					}
+					_, err = seed.Export(gdb, seed.ExportOptions{Dir: *out, DryRun: rt.dryRun})
+					return err
+				}
+				if *update {
+					return rt.write(func(tx *gorm.DB) error {
+						_, err := seed.Sync(tx)
+						return err
+					})
+				}
+				if !*synthetic {
+					return rt.write(func(tx *gorm.DB) error {
+						seed.Run(tx)
+						return nil
+					})
+				}
+				if *anchor != "" {
+					t, err := time.Parse("2006-01-02", *anchor)
+					if err != nil {
+						return usageErrorf("invalid --anchor %q", *anchor)
+					}
+					opts.Anchor = t
+				}
+				return rt.write(func(tx *gorm.DB) error {
+					_, err := seed.RunSynthetic(tx, opts)
+					return err
+				})
+			}
+		},
+	})
+
+	register(command{
+		path:  []string{"venues", "backfill-slugs"},
+		short: "Repair corrupted venue slugs (same as cmd/backfill-venue-slugs)",
+		setup: func(fs *flag.FlagSet) func(*runtime) error {
+			var batch backfill.Options
+			batch.RegisterFlags(fs)
+			return func(rt *runtime) error {
+				return runVenueSlugBackfill(rt, batch)
+			}
+		},
+	})
+
+	register(command{
+		path:  []string{"venues", "backfill-timezones"},
+		short: "Geocode venues and re-anchor mis-zoned shows (same as cmd/backfill-venue-timezones)",
+		setup: func(fs *flag.FlagSet) func(*runtime) error {
+			var batch backfill.Options
+			batch.RegisterFlags(fs)
+			verbose := fs.Bool("verbose", false, "Print per-venue / per-show detail")
+			return func(rt *runtime) error {
+				return runVenueTimezoneBackfill(rt, batch, *verbose)
+			}
+		},
+	})
+
+	register(command{
+		path:  []string{"artists", "backfill-genres"},
+		short: "Infer artist genres from the genre tags on their releases",
+		setup: func(fs *flag.FlagSet) func(*runtime) error {
+			var batch backfill.Options
+			batch.RegisterFlags(fs)
+			return func(rt *runtime) error {
+				return runArtistGenreBackfill(rt, batch)
+			}
+		},
+	})
+
+	register(command{
+		path:  []string{"shows", "reindex-slugs"},
+		short: "Recompute show slugs, keeping old slugs as redirects",
+		setup: func(fs *flag.FlagSet) func(*runtime) error {
+			showID := fs.Uint("show-id", 0, "Only reindex this show (default: all shows)")
+			return func(rt *runtime) error {
+				return runShowSlugReindex(rt, *showID)
+			}
+		},
+	})
+
+	register(command{
+		path:  []string{"discovery", "import"},
+		short: "Import discovered events from JSON (same as cmd/discovery-import)",
+		setup: func(fs *flag.FlagSet) func(*runtime) error {
+			input := fs.String("input", "", "Input JSON file(s); supports glob patterns")
+			verbose := fs.Bool("verbose", false, "Show detailed output for each event")
+			return func(rt *runtime) error {
+				return runDiscoveryImport(rt, *input, *verbose)
+			}
+		},
+	})
+}
+
+// openBackfill opens the database for a batched backfill (see package
+// backfill). Backfills roll back their own batches in a dry run rather than
+// using rt.write, and stop after the batch in flight on Ctrl-C. Progress goes
+// to stderr, keeping rt.out for the report.
+func openBackfill(rt *runtime, batch *backfill.Options) (*gorm.DB, func(), error) {
+	_, gdb, err := rt.open()
+	if err != nil {
+		return nil, nil, err
+	}
+	batch.Progress = os.Stderr
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	return gdb.WithContext(ctx), stop, nil
+}
+
+// backfillResult prints where a run that stopped early can resume, and
+// fails a live run that hit errors.
+func backfillResult(rt *runtime, errs []string, cursor backfill.Cursor, noun string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	if !cursor.IsZero() {
+		fmt.Fprintf(rt.out, "Stopped early; resume with --resume-from %s\n", cursor)
+	}
+	if rt.dryRun {
+		return nil
+	}
+	return fmt.Errorf("%d %s failed", len(errs), noun)
+}
+
+func runVenueSlugBackfill(rt *runtime, batch backfill.Options) error {
+	gdb, stop, err := openBackfill(rt, &batch)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	report, err := catalog.BackfillVenueSlugs(gdb, catalog.VenueSlugBackfillOptions{DryRun: rt.dryRun, Batch: batch})
+	if err != nil {
+		return fmt.Errorf("backfill: %w", err)
+	}
+
+	for _, c := range report.Changes {
+		status := "would-update"
+		if c.Applied {
+			status = "updated"
+		}
+		old := c.OldSlug
+		if old == "" {
+			old = "<empty>"
+		}
+		fmt.Fprintf(rt.out, "  [%s] venue %d %q (%s, %s): %s -> %s\n",
+			status, c.VenueID, c.Name, c.City, c.State, old, c.NewSlug)
+	}
+	for _, e := range report.Errors {
+		fmt.Fprintf(rt.out, "  [ERROR] %s\n", e)
+	}
+	fmt.Fprintf(rt.out, "Venues scanned: %d, changed: %d, unchanged: %d, errors: %d\n",
+		report.Scanned, report.Changed, report.Unchanged, len(report.Errors))
+	return backfillResult(rt, report.Errors, report.Cursor, "venue(s)")
+}
+
+func runVenueTimezoneBackfill(rt *runtime, batch backfill.Options, verbose bool) error {
+	gdb, stop, err := openBackfill(rt, &batch)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	report, err := catalog.BackfillVenueTimezones(gdb, geo.Default(), catalog.BackfillOptions{
+		DryRun:  rt.dryRun,
+		Verbose: verbose,
+		Batch:   batch,
+	})
+	if err != nil {
+		return fmt.Errorf("backfill: %w", err)
+	}
+
+	for _, c := range report.VenueChanges {
+		fmt.Fprintf(rt.out, "  [%s] venue %d %q (%s, %s): %s -> %s\n",
+			c.Action, c.VenueID, c.Name, c.City, c.State, tzString(c.OldTz), tzString(c.NewTz))
+	}
+	for _, c := range report.ShowChanges {
+		fmt.Fprintf(rt.out, "  [%s] show %d %q (venue %d): %s -> %s\n",
+			c.Action, c.ShowID, c.Title, c.VenueID,
+			c.OldInstant.Format(time.RFC3339), c.NewInstant.Format(time.RFC3339))
+	}
+	for _, e := range report.Errors {
+		fmt.Fprintf(rt.out, "  [ERROR] %s\n", e)
+	}
+	fmt.Fprintf(rt.out, "Venues scanned: %d (set %d, updated %d, coords only %d, unchanged %d, missed %d)\n",
+		report.VenuesScanned, report.VenuesSet, report.VenuesUpdated, report.VenuesCoordsOnly,
+		report.VenuesUnchanged, report.VenuesMissed)
+	fmt.Fprintf(rt.out, "Shows scanned: %d (re-anchored %d, already correct %d, ambiguous %d, no venue tz %d), errors: %d\n",
+		report.ShowsScanned, report.ShowsReanchored, report.ShowsAlreadyOK, report.ShowsAmbiguous,
+		report.ShowsNoVenueTz, len(report.Errors))
+	return backfillResult(rt, report.Errors, report.Cursor, "row(s)")
+}
+
+func tzString(tz *string) string {
+	if tz == nil || *tz == "" {
+		return "<none>"
+	}
+	return *tz
+}
+
+func runArtistGenreBackfill(rt *runtime, batch backfill.Options) error {
+	gdb, stop, err := openBackfill(rt, &batch)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	report, err := catalog.BackfillArtistGenres(gdb, catalog.ArtistGenreBackfillOptions{DryRun: rt.dryRun, Batch: batch})
+	if err != nil {
+		return fmt.Errorf("backfill: %w", err)
+	}
+	for _, e := range report.Errors {
+		fmt.Fprintf(rt.out, "  [ERROR] %s\n", e)
+	}
+	fmt.Fprintf(rt.out, "Artists scanned: %d, gained genres: %d, genres added: %d, errors: %d\n",
+		report.Scanned, report.ArtistsChanged, report.GenresAdded, len(report.Errors))
+	return backfillResult(rt, report.Errors, report.Cursor, "batch(es)")
+}
+
+func runShowSlugReindex(rt *runtime, showID uint) error {
+	return rt.write(func(tx *gorm.DB) error {
+		var ids []uint
+		query := tx.Model(&catalogm.Show{}).Order("id")
+		if showID != 0 {
+			query = query.Where("id = ?", showID)
+		}
+		if err := query.Pluck("id", &ids).Error; err != nil {
+			return fmt.Errorf("list shows: %w", err)
+		}
+		if showID != 0 && len(ids) == 0 {
+			return fmt.Errorf("show %d not found", showID)
+		}
+
+		changed, failed := 0, 0
+		for _, id := range ids {
+			// One transaction per show (a savepoint in a dry run), so one bad
+			// show doesn't undo the rest.
+			err := tx.Transaction(func(showTx *gorm.DB) error {
+				var before catalogm.Show
+				if err := showTx.Select("id", "slug").First(&before, id).Error; err != nil {
+					return err
+				}
+				rewritten, err := catalog.RecanonicaliseShowSlug(showTx, id)
+				if err != nil || !rewritten {
+					return err
+				}
+				var after catalogm.Show
+				if err := showTx.Select("id", "slug").First(&after, id).Error; err != nil {
+					return err
+				}
+				changed++
+				fmt.Fprintf(rt.out, "  show %d: %s -> %s\n", id, slugOrEmpty(before.Slug), slugOrEmpty(after.Slug))
+				return nil
+			})
+			if err != nil {
+				failed++
+				fmt.Fprintf(rt.out, "  [ERROR] show %d: %v\n", id, err)
+			}
+		}
+
+		fmt.Fprintf(rt.out, "Shows scanned: %d, reindexed: %d, errors: %d\n", len(ids), changed, failed)
+		if failed > 0 && !rt.dryRun {
+			return fmt.Errorf("%d show(s) failed", failed)
+		}
+		return nil
+	})
+}
+
+func slugOrEmpty(slug *string) string {
+	if slug == nil || *slug == "" {
+		return "<empty>"
+	}
+	return *slug
+}
+
+// runDiscoveryImport passes --dry-run through to the importer, which already
+// simulates an import without writing.
+func runDiscoveryImport(rt *runtime, input string, verbose bool) error {
+	if input == "" {
+		return usageErrorf("--input is required")
+	}
+	files, err := filepath.Glob(input)
+	if err != nil {
+		return usageErrorf("invalid glob pattern: %v", err)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no files found matching %s", input)
+	}
+
+	_, gdb, err := rt.open()
+	if err != nil {
+		return err
+	}
+	discoveryService := pipeline.NewDiscoveryService(gdb, nil)
+
+	total := &contracts.ImportResult{}
+	for _, file := range files {
+		result, err := discoveryService.ImportFromJSON(file, rt.dryRun)
+		if err != nil {
+			fmt.Fprintf(rt.out, "  [ERROR] %s: %v\n", file, err)
+			total.Errors++
+			continue
+		}
+		total.Total += result.Total
+		total.Imported += result.Imported
+		total.Duplicates += result.Duplicates
+		total.Rejected += result.Rejected
+		total.Errors += result.Errors
+		if verbose {
+			for _, msg := range result.Messages {
+				fmt.Fprintf(rt.out, "    %s\n", msg)
+			}
+		}
+		fmt.Fprintf(rt.out, "  %s: %d total, %d imported, %d duplicates, %d rejected, %d errors\n",
+			file, result.Total, result.Imported, result.Duplicates, result.Rejected, result.Errors)
+	}
+
+	fmt.Fprintf(rt.out, "Files: %d, events: %d, imported: %d, duplicates: %d, rejected: %d, errors: %d\n",
+		len(files), total.Total, total.Imported, total.Duplicates, total.Rejected, total.Errors)
+	if rt.dryRun {
+		fmt.Fprintln(rt.out, "DRY RUN — no changes written.")
+	}
+	if total.Errors > 0 {
+		return fmt.Errorf("%d import error(s)", total.Errors)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Command phadmin is the admin CLI: one binary for seeding, backfills and
+// maintenance jobs that share config/DB bootstrapping and a --dry-run flag.
+//
+// Usage:
+//
+//	go run ./cmd/phadmin <command> [subcommand] [flags]
+//	go run ./cmd/phadmin help
+//
+// Every command takes:
+//
+//	--env <file>   load this .env file (authoritative; default .env.development / .env)
+//	--dry-run      report what would change and write nothing
+//
+// Dry runs of database writes execute inside a transaction that is rolled
+// back, so the report comes from the same code path as a live run.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+)
+
+// command is one phadmin subcommand, addressed by its path
+// (e.g. ["users", "promote"]).
+type command struct {
+	path  []string
+	short string
+	// setup registers the command's own flags on fs and returns the function
+	// that runs it once they are parsed.
+	setup func(fs *flag.FlagSet) func(rt *runtime) error
+}
+
+func (c command) name() string { return strings.Join(c.path, " ") }
+
+// commands is the registry, kept sorted by name for help output.
+var commands []command
+
+func register(c command) {
+	commands = append(commands, c)
+	sort.Slice(commands, func(i, j int) bool { return commands[i].name() < commands[j].name() })
+}
+
+// errUsage marks a bad invocation: the command's flag help is printed and
+// phadmin exits 2.
+var errUsage = errors.New("usage")
+
+// usageErrorf returns an errUsage-wrapped error with a message.
+func usageErrorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errUsage, fmt.Sprintf(format, args...))
+}
+
+// errDryRunRollback rolls back a dry-run transaction.
+var errDryRunRollback = errors.New("dry run: rolled back")
+
+// connectFunc loads config and opens the database for an --env value.
+type connectFunc func(envFile string) (*config.Config, *gorm.DB, error)
+
+// runtime is what a command runs with. The database is opened on first use,
+// so flag validation happens before any connection is made.
+type runtime struct {
+	dryRun  bool
+	envFile string
+	out     io.Writer
+	connect connectFunc
+
+	cfg *config.Config
+	gdb *gorm.DB
+}
+
+// open connects on first call and returns the config and database.
+func (rt *runtime) open() (*config.Config, *gorm.DB, error) {
+	if rt.gdb != nil {
+		return rt.cfg, rt.gdb, nil
+	}
+	cfg, gdb, err := rt.connect(rt.envFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	rt.cfg, rt.gdb = cfg, gdb
+	mode := "LIVE"
+	if rt.dryRun {
+		mode = "DRY RUN"
+	}
+	// Surface the resolved target so a mistargeted live run (e.g. prod via the
+	// wrong --env) is caught before any write. Credentials are redacted.
+	fmt.Fprintf(rt.out, "Mode: %s  ENVIRONMENT=%q  db=%s\n\n",
+		mode, os.Getenv(config.EnvEnvironment), redactDBHost(cfg.Database.URL))
+	return cfg, gdb, nil
+}
+
+// write runs fn against the database. In a dry run fn gets a transaction
+// that is rolled back afterwards; live, it gets the database itself, so
+// commands keep their own transaction boundaries.
+func (rt *runtime) write(fn func(tx *gorm.DB) error) error {
+	_, gdb, err := rt.open()
+	if err != nil {
+		return err
+	}
+	if !rt.dryRun {
+		return fn(gdb)
+	}
+	err = gdb.Transaction(func(tx *gorm.DB) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		return errDryRunRollback
+	})
+	if errors.Is(err, errDryRunRollback) {
+		fmt.Fprintln(rt.out, "\nDRY RUN — rolled back, no changes written.")
+		return nil
+	}
+	return err
+}
+
+func main() {
+	log.SetFlags(0)
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, connectDB))
+}
+
+// run dispatches args to a command and returns the process exit code.
+func run(args []string, stdout, stderr io.Writer, connect connectFunc) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printHelp(stdout)
+		return 0
+	}
+
+	cmd, rest, ok := lookup(args)
+	if !ok {
+		fmt.Fprintf(stderr, "phadmin: unknown command %q\n\n", strings.Join(leadingWords(args), " "))
+		printHelp(stderr)
+		return 2
+	}
+
+	fs := flag.NewFlagSet("phadmin "+cmd.name(), flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	rt := &runtime{out: stdout, connect: connect}
+	fs.StringVar(&rt.envFile, "env", "", "Path to .env file (defaults to .env.development / .env)")
+	fs.BoolVar(&rt.dryRun, "dry-run", false, "Report what would change without writing")
+	runCmd := cmd.setup(fs)
+	if err := fs.Parse(rest); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "phadmin %s: unexpected arguments %v\n", cmd.name(), fs.Args())
+		fs.Usage()
+		return 2
+	}
+
+	if err := runCmd(rt); err != nil {
+		fmt.Fprintf(stderr, "phadmin %s: %v\n", cmd.name(), err)
+		if errors.Is(err, errUsage) {
+			fs.Usage()
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// lookup finds the command with the longest path matching the leading
+// words of args and returns the remaining args.
+func lookup(args []string) (command, []string, bool) {
+	var best command
+	found := false
+	for _, c := range commands {
+		if len(c.path) > len(args) || (found && len(c.path) <= len(best.path)) {
+			continue
+		}
+		match := true
+		for i, word := range c.path {
+			if args[i] != word {
+				match = false
+				break
+			}
+		}
+		if match {
+			best, found = c, true
+		}
+	}
+	if !found {
+		return command{}, nil, false
+	}
+	return best, args[len(best.path):], true
+}
+
+// leadingWords returns the args before the first flag.
+func leadingWords(args []string) []string {
+	for i, a := range args {
+		if strings.HasPrefix(a, "-") {
+			return args[:i]
+		}
+	}
+	return args
+}
+
+func printHelp(w io.Writer) {
+	fmt.Fprintln(w, "phadmin — Psychic Homily admin CLI")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Usage: phadmin <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-24s %s\n", c.name(), c.short)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Every command accepts --env <file> and --dry-run.")
+	fmt.Fprintln(w, "Run 'phadmin <command> -h' for a command's flags.")
+}
+
+// connectDB loads the env file and config and connects, the same way the
+// standalone backfill commands do.
+func connectDB(envFile string) (*config.Config, *gorm.DB, error) {
+	loadEnv(envFile)
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("load config: %w", err)
+	}
+	if err := db.Connect(cfg); err != nil {
+		return nil, nil, fmt.Errorf("connect db: %w", err)
+	}
+	return cfg, db.GetDB(), nil
+}
+
+func loadEnv(envFile string) {
+	if envFile != "" {
+		// Overload (not Load) so an explicit --env is authoritative over
+		// anything already exported in the shell, e.g. a stray DATABASE_URL.
+		if err := godotenv.Overload(envFile); err != nil {
+			log.Fatalf("load env file %s: %v", envFile, err)
+		}
+		log.Printf("loaded env from %s (authoritative)", envFile)
+		return
+	}
+	for _, ef := range []string{".env.development", ".env"} {
+		if err := godotenv.Load(ef); err == nil {
+			log.Printf("loaded env from %s", ef)
+			return
+		}
+	}
+	log.Println("no .env loaded; using process environment")
+}
+
+// redactDBHost extracts host[:port]/dbname from a database URL, dropping any
+// embedded credentials, so the target can be logged without leaking secrets.
+func redactDBHost(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "<unparseable>"
+	}
+	return u.Host + u.Path
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	adminm "psychic-homily-backend/internal/models/admin"
+	authm "psychic-homily-backend/internal/models/auth"
+	usersvc "psychic-homily-backend/internal/services/user"
+)
+
+func init() {
+	register(command{
+		path:  []string{"users", "promote"},
+		short: "Grant a staff role to a user by email",
+		setup: func(fs *flag.FlagSet) func(*runtime) error {
+			email := fs.String("email", "", "Email of the user to promote (required)")
+			role := fs.String("role", string(authm.RoleAdmin), "Role to grant: user, moderator, admin or superadmin")
+			return func(rt *runtime) error {
+				return runUserPromote(rt, *email, authm.Role(*role))
+			}
+		},
+	})
+
+	register(command{
+		path:  []string{"accounts", "purge-expired"},
+		short: "Permanently delete accounts past the deletion grace period",
+		setup: func(*flag.FlagSet) func(*runtime) error {
+			return runPurgeExpiredAccounts
+		},
+	})
+}
+
+func runUserPromote(rt *runtime, email string, role authm.Role) error {
+	if email == "" {
+		return usageErrorf("--email is required")
+	}
+	if !role.Valid() {
+		return usageErrorf("unknown role %q", role)
+	}
+
+	return rt.write(func(tx *gorm.DB) error {
+		users := usersvc.NewUserService(tx)
+		user, err := users.GetUserByEmail(email)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("no user with email %s", email)
+		}
+
+		// Actor 0 is "not a user", so SetUserRole's self-change guard never
+		// trips; the last-superadmin guard still applies.
+		previous, err := users.SetUserRole(0, user.ID, role)
+		if err != nil {
+			return err
+		}
+		if err := writeAuditLog(tx, "set_user_role", "user", user.ID, map[string]interface{}{
+			"role":          role,
+			"previous_role": previous,
+			"source":        "phadmin",
+		}); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(rt.out, "User %d: %s -> %s\n", user.ID, previous, role)
+		return nil
+	})
+}
+
+// runPurgeExpiredAccounts is the account half of the cleanup job's cycle,
+// run on demand. Receipts are signed with the same key the server uses.
+func runPurgeExpiredAccounts(rt *runtime) error {
+	cfg, _, err := rt.open()
+	if err != nil {
+		return err
+	}
+
+	return rt.write(func(tx *gorm.DB) error {
+		users := usersvc.NewUserService(tx)
+		users.SetDeletionReceiptKey(cfg.OAuth.SecretKey)
+
+		expired, err := users.GetExpiredDeletedAccounts()
+		if err != nil {
+			return err
+		}
+
+		purged := 0
+		for _, account := range expired {
+			deletedAt := ""
+			if account.DeletedAt != nil {
+				deletedAt = account.DeletedAt.Format(time.RFC3339)
+			}
+			if err := users.PermanentlyDeleteUser(account.ID); err != nil {
+				fmt.Fprintf(rt.out, "  [ERROR] user %d: %v\n", account.ID, err)
+				continue
+			}
+			purged++
+			fmt.Fprintf(rt.out, "  purged user %d (deleted %s)\n", account.ID, deletedAt)
+		}
+
+		fmt.Fprintf(rt.out, "Expired accounts: %d, purged: %d, failed: %d\n",
+			len(expired), purged, len(expired)-purged)
+		if purged < len(expired) && !rt.dryRun {
+			return fmt.Errorf("%d account(s) failed", len(expired)-purged)
+		}
+		return nil
+	})
+}
+
+// writeAuditLog records a CLI action with no actor, like the cleanup job's
+// system entries.
+func writeAuditLog(tx *gorm.DB, action, entityType string, entityID uint, metadata map[string]interface{}) error {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshal audit metadata: %w", err)
+	}
+	raw := json.RawMessage(metadataJSON)
+	entry := adminm.AuditLog{
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Metadata:   &raw,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := tx.Create(&entry).Error; err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	return nil
+}
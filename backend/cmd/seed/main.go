@@ -1,916 +1,87 @@
+// Command seed loads the dev dataset into the database named by
+// .env.$NODE_ENV (see internal/seed). `phadmin seed` runs the same seed with
+// --dry-run support.
+//
+// With -update it syncs the Hugo content instead of only adding to it:
+// venues, artists and shows already in the database are updated where the
+// content changed (see seed.Sync), and it reports created, updated and
+// unchanged counts.
+//
+// With -export it goes the other way, writing approved shows, venues and
+// artists from the database back to ../content/shows and ../data (or under
+// -out), in the format seed reads (see seed.Export).
+//
+// With -synthetic it instead generates a deterministic fake catalog, sized by
+// -shows (venues and artists scale with it unless set), for exercising
+// pagination and performance locally:
+//
+//	go run ./cmd/seed -synthetic -shows 500 -seed 1
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
-	"os"
-	"path/filepath"
-	"strconv"
-	"strings"
 	"time"
 
-	"psychic-homily-backend/db"
-	"psychic-homily-backend/internal/config"
-	"psychic-homily-backend/internal/seeddata"
-	"psychic-homily-backend/internal/services/catalog"
-	"psychic-homily-backend/internal/utils"
-
-	authm "psychic-homily-backend/internal/models/auth"
-	catalogm "psychic-homily-backend/internal/models/catalog"
-	engagementm "psychic-homily-backend/internal/models/engagement"
-
-	"github.com/goccy/go-yaml"
 	"github.com/joho/godotenv"
-	"golang.org/x/crypto/bcrypt"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
-)
-
-type VenueData struct {
-	Name    string `yaml:"name"`
-	Address string `yaml:"address"`
-	City    string `yaml:"city"`
-	State   string `yaml:"state"`
-	Zip     string `yaml:"zip"`
-	Social  struct {
-		Instagram string `yaml:"instagram"`
-		Website   string `yaml:"website"`
-	} `yaml:"social"`
-}
 
-type ArtistData struct {
-	Name        string `yaml:"name"`
-	ArizonaBand bool   `yaml:"arizona-band"`
-	Social      struct {
-		Instagram string `yaml:"instagram"`
-		Website   string `yaml:"website"`
-	} `yaml:"social"`
-	URL string `yaml:"url"`
-}
-
-type ShowData struct {
-	Title          string   `yaml:"title"`
-	Date           string   `yaml:"date"`       // Hugo creation date
-	EventDate      string   `yaml:"event_date"` // Actual show date
-	Draft          bool     `yaml:"draft"`
-	Venues         []string `yaml:"venues"` // Array of venue slugs
-	City           string   `yaml:"city"`
-	State          string   `yaml:"state"`
-	Price          string   `yaml:"price"` // String, can be empty
-	AgeRequirement string   `yaml:"age_requirement"`
-	Bands          []string `yaml:"bands"` // Array of band slugs (order matters!)
-}
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/seed"
+)
 
 func main() {
-	fmt.Println("Seeding database...")
-	db := connectToDatabase()
-
-	// Seed venues
-	fmt.Println("Seeding venues...")
-	venues := getVenueData()
-	venueModels := make([]*catalogm.Venue, 0, len(venues))
-
-	for _, venue := range venues {
-		slug := utils.GenerateVenueSlug(venue.Name, venue.City, venue.State)
-		venueModel := &catalogm.Venue{
-			Name:    venue.Name,
-			Slug:    &slug,
-			Address: &venue.Address,
-			City:    venue.City,
-			State:   venue.State,
-			Zipcode: &venue.Zip,
-			Social: catalogm.Social{
-				Instagram: &venue.Social.Instagram,
-				Website:   &venue.Social.Website,
-			},
-		}
-		venueModels = append(venueModels, venueModel)
-	}
-
-	// Insert venues one by one, skipping duplicates
-	// Venue uniqueness is on LOWER(name), LOWER(city) per migration 000004
-	var venuesCreated int64
-	for _, venueModel := range venueModels {
-		// Check if venue already exists (case-insensitive)
-		var existing catalogm.Venue
-		result := db.Where("LOWER(name) = LOWER(?) AND LOWER(city) = LOWER(?)", venueModel.Name, venueModel.City).First(&existing)
-		if result.Error == nil {
-			// Venue exists, skip
-			continue
-		}
-		// Create new venue
-		if err := db.Create(venueModel).Error; err != nil {
-			log.Printf("Warning: Failed to create venue %s: %v", venueModel.Name, err)
-			continue
-		}
-		venuesCreated++
-	}
-
-	fmt.Printf("✅ Successfully processed %d venues (%d created)\n", len(venues), venuesCreated)
-
-	// Seed artists
-	fmt.Println("Seeding artists...")
-	artists := getArtistData()
-	artistModels := make([]*catalogm.Artist, 0, len(artists))
-
-	for _, artist := range artists {
-		// Set state to "AZ" only if it's an Arizona band
-		var state *string
-		if artist.ArizonaBand {
-			az := "AZ"
-			state = &az
-		}
-
-		slug := utils.GenerateArtistSlug(artist.Name)
-		artistModel := &catalogm.Artist{
-			Name:  artist.Name,
-			Slug:  &slug,
-			State: state,
-			Social: catalogm.Social{
-				Instagram: &artist.Social.Instagram,
-				Website:   &artist.Social.Website,
-			},
-		}
-		artistModels = append(artistModels, artistModel)
-	}
-
-	// Skip duplicates gracefully. Target-less ON CONFLICT DO NOTHING (no Columns) so
-	// it catches the case-INSENSITIVE artists_lower_name_uniq index (PSY-1256) — a
-	// named `(name)` arbiter would (a) no longer exist after that migration dropped
-	// artists_name_key and (b) miss case-variant dups.
-	artistResult := db.Clauses(clause.OnConflict{
-		DoNothing: true,
-	}).Create(artistModels)
-
-	if artistResult.Error != nil {
-		log.Fatalf("Failed to create artists: %v", artistResult.Error)
-	}
-
-	fmt.Printf("✅ Successfully processed %d artists (%d created)\n", len(artists), artistResult.RowsAffected)
-
-	// Seed labels and releases with proper linking (completes the discovery loop)
-	labelsCreated, releasesCreated := seedLabelsAndReleases(db)
-
-	// Seed shows
-	fmt.Println("Seeding shows...")
-	shows := getShowData()
-	showCount := 0
-	successCount := 0
-
-	for _, show := range shows {
-		// Skip draft shows
-		if show.Draft {
-			fmt.Printf("⏭️  Skipping draft show: %s\n", show.Title)
-			continue
-		}
-
-		showCount++
-		if err := createShowWithAssociations(db, show); err != nil {
-			log.Printf("❌ Failed to create show '%s': %v", show.Title, err)
-		} else {
-			successCount++
-			fmt.Printf("✅ Created show: %s\n", show.Title)
-		}
-	}
-
-	// Seed radio stations and shows
-	stationsCreated, radioShowsCreated := seedRadioStationsAndShows(db)
-
-	// Seed test users
-	fmt.Println("Seeding test users...")
-	usersCreated := seedTestUsers(db)
-
-	// Seed rich exemplars (PSY-665) — one entity per type with every optional
-	// field populated, plus the PSY-657 social:{} canary. Runs LAST because it
-	// depends on the admin user existing (tag/collection FKs are NOT NULL).
-	seedRichExemplars(db)
-
-	fmt.Printf("Database seeding completed!\n")
-	fmt.Printf("Summary: %d venues, %d artists, %d labels, %d releases, %d/%d shows, %d radio stations, %d radio shows, %d users\n",
-		len(venues), len(artists), labelsCreated, releasesCreated, successCount, showCount, stationsCreated, radioShowsCreated, usersCreated)
-}
-
-func getVenueData() map[string]VenueData {
-	data, err := os.ReadFile("../data/venues.yaml")
-	if err != nil {
-		log.Fatalf("Failed to read venues.yaml: %v", err)
-	}
-
-	var venues map[string]VenueData
-	err = yaml.Unmarshal(data, &venues)
-	if err != nil {
-		log.Fatalf("Failed to unmarshal venues.yaml: %v", err)
-	}
-	return venues
-}
-
-func getArtistData() map[string]ArtistData {
-	data, err := os.ReadFile("../data/bands.yaml")
-	if err != nil {
-		log.Fatalf("Failed to read bands.yaml: %v", err)
-	}
-
-	var artists map[string]ArtistData
-	err = yaml.Unmarshal(data, &artists)
-	if err != nil {
-		log.Fatalf("Failed to unmarshal bands.yaml: %v", err)
-	}
-	return artists
-}
-
-func getShowData() []ShowData {
-	// Read all show files from content/shows/
-	showDir := "../content/shows"
-	files, err := os.ReadDir(showDir)
-	if err != nil {
-		log.Fatalf("Failed to read shows directory: %v", err)
-	}
-
-	var shows []ShowData
-	for _, file := range files {
-		if file.IsDir() || !strings.HasSuffix(file.Name(), ".md") || file.Name() == "_index.md" {
-			continue
-		}
-
-		// Read the show file
-		data, err := os.ReadFile(filepath.Join(showDir, file.Name()))
-		if err != nil {
-			log.Printf("Warning: Failed to read %s: %v", file.Name(), err)
-			continue
-		}
-
-		// Parse frontmatter
-		show, err := parseShowFrontmatter(data)
-		if err != nil {
-			log.Printf("Warning: Failed to parse %s: %v", file.Name(), err)
-			continue
-		}
-
-		shows = append(shows, show)
-	}
-
-	return shows
-}
-
-func parseShowFrontmatter(data []byte) (ShowData, error) {
-	// Split frontmatter from content
-	parts := strings.Split(string(data), "---")
-	if len(parts) < 3 {
-		return ShowData{}, fmt.Errorf("invalid frontmatter format")
-	}
-
-	// Parse YAML frontmatter
-	var show ShowData
-	err := yaml.Unmarshal([]byte(parts[1]), &show)
-	if err != nil {
-		return ShowData{}, fmt.Errorf("failed to parse YAML: %w", err)
-	}
-
-	return show, nil
-}
-
-func createShowWithAssociations(db *gorm.DB, showData ShowData) error {
-	// Parse event date and convert to UTC
-	eventDate, err := time.Parse("2006-01-02T15:04:05-07:00", showData.EventDate)
-	if err != nil {
-		return fmt.Errorf("failed to parse event date: %w", err)
-	}
-
-	// Convert to UTC for database storage
-	eventDateUTC := eventDate.UTC()
-
-	// Parse price
-	var price *float64
-	if showData.Price != "" {
-		if p, err := strconv.ParseFloat(showData.Price, 64); err == nil {
-			price = &p
-		}
-	}
-
-	// Generate normalized title: "Band1, Band2, Band3 at Venue Name"
-	normalizedTitle := generateNormalizedTitle(showData)
-
-	// Generate slug from headliner, venue, and date
-	headlinerName := ""
-	if len(showData.Bands) > 0 {
-		headlinerName = normalizeArtistName(showData.Bands[0])
-	}
-	venueName := ""
-	if len(showData.Venues) > 0 {
-		venueName = normalizeVenueName(showData.Venues[0])
-	}
-	showSlug := utils.GenerateShowSlug(eventDateUTC, headlinerName, venueName, showData.State)
-
-	// Create the show
-	show := &catalogm.Show{
-		Title:          normalizedTitle,
-		Slug:           &showSlug,
-		EventDate:      eventDateUTC,
-		City:           &showData.City,
-		State:          &showData.State,
-		Price:          price,
-		AgeRequirement: &showData.AgeRequirement,
-	}
-
-	// Use transaction for data consistency
-	return db.Transaction(func(tx *gorm.DB) error {
-		// Create the show
-		if err := tx.Create(show).Error; err != nil {
-			return fmt.Errorf("failed to create show: %w", err)
-		}
-
-		// Associate venues. Track the lowest venue.ID for the
-		// denormalized show_artists.venue_id below — matches the
-		// 20260512023704 backfill migration's LATERAL tiebreaker (PSY-576).
-		var primaryVenueID *uint
-		for _, venueSlug := range showData.Venues {
-			var venue catalogm.Venue
-			// Try to find venue by name (normalized)
-			venueName := normalizeVenueName(venueSlug)
-
-			// Try exact match first
-			result := tx.Where("LOWER(name) = LOWER(?)", venueName).First(&venue)
-			if result.Error != nil {
-				// Try partial match for cases like venue name variations
-				result = tx.Where("LOWER(name) LIKE LOWER(?)", "%"+venueName+"%").First(&venue)
-				if result.Error != nil {
-					log.Printf("Warning: Venue not found: %s (slug: %s)", venueName, venueSlug)
-					continue
-				}
-			}
-
-			// Create show-venue association
-			showVenue := catalogm.ShowVenue{
-				ShowID:  show.ID,
-				VenueID: venue.ID,
-			}
-			if err := tx.Create(&showVenue).Error; err != nil {
-				return fmt.Errorf("failed to create show-venue association: %w", err)
-			}
-
-			if primaryVenueID == nil || venue.ID < *primaryVenueID {
-				vid := venue.ID
-				primaryVenueID = &vid
-			}
-		}
-
-		// Associate artists in order
-		showEventDate := show.EventDate
-		for position, artistSlug := range showData.Bands {
-			var artist catalogm.Artist
-			// Try to find artist by name (normalized)
-			artistName := normalizeArtistName(artistSlug)
-
-			// Try exact match first
-			result := tx.Where("LOWER(name) = LOWER(?)", artistName).First(&artist)
-			if result.Error != nil {
-				// Try partial match for cases like "Fashion Club (LA)" vs "Fashion Club"
-				result = tx.Where("LOWER(name) LIKE LOWER(?)", "%"+artistName+"%").First(&artist)
-				if result.Error != nil {
-					log.Printf("Warning: Artist not found: %s (slug: %s)", artistName, artistSlug)
-					continue
-				}
-			}
-
-			// Determine set type based on position
-			setType := "opener"
-			if position == 0 {
-				setType = "headliner"
-			}
-
-			// Create show-artist association with position. EventDate +
-			// VenueID denormalize the show dedup key so the partial unique
-			// index `shows_artist_venue_eventdate_uniq` covers seeded rows
-			// (PSY-576).
-			showArtist := catalogm.ShowArtist{
-				ShowID:    show.ID,
-				ArtistID:  artist.ID,
-				Position:  position,
-				SetType:   setType,
-				EventDate: &showEventDate,
-				VenueID:   primaryVenueID,
-			}
-			if err := tx.Create(&showArtist).Error; err != nil {
-				return fmt.Errorf("failed to create show-artist association: %w", err)
-			}
-		}
-
-		return nil
-	})
-}
-
-// Helper functions for name normalization
-func normalizeVenueName(slug string) string {
-	// Convert slug to display name
-	// e.g., "club-congress" -> "Club Congress"
-	words := strings.Split(slug, "-")
-	for i, word := range words {
-		if len(word) > 0 {
-			words[i] = cases.Title(language.English).String(word)
-		}
-	}
-	return strings.Join(words, " ")
-}
-
-func normalizeArtistName(slug string) string {
-	// Convert slug to display name
-	// e.g., "where's-lucy?" -> "Where's Lucy?"
-	words := strings.Split(slug, "-")
-	for i, word := range words {
-		if len(word) > 0 {
-			words[i] = cases.Title(language.English).String(word)
+	synthetic := flag.Bool("synthetic", false, "Generate a synthetic catalog instead of loading the Hugo dataset")
+	update := flag.Bool("update", false, "Update venues, artists and shows that changed in the Hugo content instead of skipping them")
+	export := flag.Bool("export", false, "Write the database's approved shows, venues and artists back to the Hugo content")
+	out := flag.String("out", "..", "With -export, the directory holding content/shows and data")
+	opts := seed.SyntheticOptions{}
+	flag.IntVar(&opts.Shows, "shows", 500, "Synthetic shows to generate")
+	flag.IntVar(&opts.Venues, "venues", 0, "Synthetic venues (default shows/10, at least 10)")
+	flag.IntVar(&opts.Artists, "artists", 0, "Synthetic artists (default shows/2, at least 20)")
+	flag.Uint64Var(&opts.Seed, "seed", 1, "Pseudo-random seed; the same seed gives the same data")
+	flag.IntVar(&opts.Days, "days", 180, "Spread upcoming shows over this many days (plus a third as many past)")
+	anchor := flag.String("anchor", "", "Date (YYYY-MM-DD) shows are spread around (default today)")
+	flag.Parse()
+
+	modes := 0
+	for _, on := range []bool{*synthetic, *update, *export} {
+		if on {
+			modes++
+		}
+	}
+	if modes > 1 {
+		log.Fatal("-synthetic, -update and -export can't be combined")
+	}
+	if *export {
+		if _, err := seed.Export(connectToDatabase(), seed.ExportOptions{Dir: *out}); err != nil {
+			log.Fatalf("Export failed: %v", err)
 		}
-	}
-	return strings.Join(words, " ")
-}
-
-func generateNormalizedTitle(showData ShowData) string {
-	// Build band list in order
-	var bandNames []string
-	for _, bandSlug := range showData.Bands {
-		bandName := normalizeArtistName(bandSlug)
-		bandNames = append(bandNames, bandName)
-	}
-
-	// Join bands with commas
-	bandList := strings.Join(bandNames, ", ")
-
-	// Get venue name (use first venue if multiple)
-	var venueName string
-	if len(showData.Venues) > 0 {
-		venueName = normalizeVenueName(showData.Venues[0])
-	}
-
-	// Format: "Band1, Band2, Band3 at Venue Name"
-	if venueName != "" {
-		return fmt.Sprintf("%s at %s", bandList, venueName)
-	}
-
-	return bandList
-}
-
-type seedUser struct {
-	Email         string
-	Username      string
-	Password      string
-	FirstName     string
-	LastName      string
-	IsAdmin       bool
-	EmailVerified bool
-	UserTier      string
-}
-
-func seedTestUsers(db *gorm.DB) int {
-	users := []seedUser{
-		{
-			Email:         "admin@test.local",
-			Username:      "admin",
-			Password:      "admin123",
-			FirstName:     "Admin",
-			LastName:      "User",
-			IsAdmin:       true,
-			EmailVerified: true,
-			UserTier:      "trusted_contributor",
-		},
-		{
-			Email:         "testuser@test.local",
-			Username:      "testuser",
-			Password:      "testuser123",
-			FirstName:     "Test",
-			LastName:      "User",
-			IsAdmin:       false,
-			EmailVerified: true,
-			UserTier:      "new_user",
-		},
-	}
-
-	created := 0
-	for _, u := range users {
-		// Check if user already exists
-		var existing authm.User
-		if err := db.Where("email = ?", u.Email).First(&existing).Error; err == nil {
-			fmt.Printf("  User %s already exists, skipping\n", u.Email)
-			continue
-		}
-
-		// Hash password
-		hashedBytes, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
-		if err != nil {
-			log.Printf("Warning: Failed to hash password for %s: %v", u.Email, err)
-			continue
-		}
-		hashedPassword := string(hashedBytes)
-
-		user := &authm.User{
-			Email:         &u.Email,
-			Username:      &u.Username,
-			PasswordHash:  &hashedPassword,
-			FirstName:     &u.FirstName,
-			LastName:      &u.LastName,
-			IsAdmin:       u.IsAdmin,
-			EmailVerified: u.EmailVerified,
-			IsActive:      true,
-			UserTier:      u.UserTier,
-		}
-
-		if err := db.Create(user).Error; err != nil {
-			log.Printf("Warning: Failed to create user %s: %v", u.Email, err)
-			continue
-		}
-
-		// Create user preferences
-		prefs := &authm.UserPreferences{
-			UserID:            user.ID,
-			NotificationEmail: true,
-			Theme:             "system",
-			Timezone:          "America/Phoenix",
-			Language:          "en",
-		}
-		if err := db.Create(prefs).Error; err != nil {
-			log.Printf("Warning: Failed to create preferences for %s: %v", u.Email, err)
-		}
-
-		created++
-		fmt.Printf("  Created user: %s (%s)\n", u.Email, u.Username)
-	}
-
-	// Seed engagement data for testuser
-	seedTestUserEngagement(db)
-
-	return created
-}
-
-func seedTestUserEngagement(db *gorm.DB) {
-	// Find the test user
-	var testUser authm.User
-	if err := db.Where("email = ?", "testuser@test.local").First(&testUser).Error; err != nil {
-		log.Printf("Warning: Could not find testuser for engagement seeding: %v", err)
 		return
 	}
-
-	// Follow a couple of artists (via user_bookmarks with entity_type=artist, action=follow)
-	var artists []catalogm.Artist
-	if err := db.Limit(2).Find(&artists).Error; err != nil || len(artists) == 0 {
-		log.Printf("Warning: No artists found for engagement seeding")
-		return
-	}
-
-	for _, artist := range artists {
-		bookmark := engagementm.UserBookmark{
-			UserID:     testUser.ID,
-			EntityType: engagementm.BookmarkEntityArtist,
-			EntityID:   artist.ID,
-			Action:     engagementm.BookmarkActionFollow,
+	if *update {
+		if _, err := seed.Sync(connectToDatabase()); err != nil {
+			log.Fatalf("Sync failed: %v", err)
 		}
-		result := db.Where(
-			"user_id = ? AND entity_type = ? AND entity_id = ? AND action = ?",
-			testUser.ID, engagementm.BookmarkEntityArtist, artist.ID, engagementm.BookmarkActionFollow,
-		).FirstOrCreate(&bookmark)
-		if result.Error != nil {
-			log.Printf("Warning: Failed to create artist follow for %s: %v", artist.Name, result.Error)
-		}
-	}
-
-	// Save a couple of shows (via user_bookmarks with entity_type=show, action=save)
-	var shows []catalogm.Show
-	if err := db.Where("status = ?", "approved").Limit(2).Find(&shows).Error; err != nil || len(shows) == 0 {
-		log.Printf("Warning: No approved shows found for engagement seeding")
 		return
 	}
-
-	for _, show := range shows {
-		bookmark := engagementm.UserBookmark{
-			UserID:     testUser.ID,
-			EntityType: engagementm.BookmarkEntityShow,
-			EntityID:   show.ID,
-			Action:     engagementm.BookmarkActionSave,
-		}
-		result := db.Where(
-			"user_id = ? AND entity_type = ? AND entity_id = ? AND action = ?",
-			testUser.ID, engagementm.BookmarkEntityShow, show.ID, engagementm.BookmarkActionSave,
-		).FirstOrCreate(&bookmark)
-		if result.Error != nil {
-			log.Printf("Warning: Failed to create show save for %s: %v", show.Title, result.Error)
-		}
-	}
-
-	fmt.Println("  Seeded engagement data for testuser (follows + saved shows)")
-}
-
-// seedReleaseData describes a release to seed
-type seedReleaseData struct {
-	Title       string
-	ReleaseType catalogm.ReleaseType
-	ReleaseYear int
-	ArtistName  string // matched by LOWER(name)
-	LabelName   string // matched by LOWER(name); must be seeded first
-}
-
-// findOrCreateArtist looks up an artist by name (case-insensitive). If not found,
-// it creates a minimal artist record so the seed is self-contained.
-func findOrCreateArtist(database *gorm.DB, name string) (*catalogm.Artist, error) {
-	// Single artist write path (PSY-1254): keeps the seed self-contained while
-	// routing through the same dedup/slug funnel as the real create paths.
-	artist, created, err := catalog.FindOrCreateArtistTx(database, name, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create fallback artist %s: %w", name, err)
-	}
-	if created {
-		log.Printf("  Created fallback artist: %s", name)
-	}
-	return artist, nil
-}
-
-// seedLabelsAndReleases creates labels, releases, and the junction table entries
-// that complete the discovery loop: Show -> Artist -> Release -> Label -> label mates.
-func seedLabelsAndReleases(database *gorm.DB) (int, int) {
-	fmt.Println("Seeding labels...")
-
-	// Labels to seed — chosen to pair realistically with artists in data/bands.yaml
-	labelNames := []struct {
-		Name        string
-		City        string
-		State       string
-		Country     string
-		FoundedYear int
-	}{
-		{"Loma Vista Recordings", "Los Angeles", "CA", "US", 2012},
-		{"4AD", "London", "", "GB", 1980},
-		{"Sub Pop Records", "Seattle", "WA", "US", 1988},
-		{"Drag City", "Chicago", "IL", "US", 1990},
-		{"Rock Action Records", "Glasgow", "", "GB", 1996},
-		{"Sacred Bones Records", "Brooklyn", "NY", "US", 2007},
-		{"DFA Records", "New York", "NY", "US", 2001},
-	}
-
-	var labelsCreated int
-	for _, l := range labelNames {
-		// Check if label already exists
-		var existing catalogm.Label
-		if database.Where("LOWER(name) = LOWER(?)", l.Name).First(&existing).Error == nil {
-			continue
-		}
-
-		slug := utils.GenerateArtistSlug(l.Name) // reuse artist slug generator for labels
-		city := l.City
-		state := l.State
-		country := l.Country
-		label := &catalogm.Label{
-			Name:        l.Name,
-			Slug:        &slug,
-			City:        &city,
-			State:       &state,
-			Country:     &country,
-			FoundedYear: &l.FoundedYear,
-			Status:      catalogm.LabelStatusActive,
-		}
-		if err := database.Create(label).Error; err != nil {
-			log.Printf("Warning: Failed to create label %s: %v", l.Name, err)
-			continue
-		}
-		labelsCreated++
-	}
-	fmt.Printf("✅ Processed %d labels (%d created)\n", len(labelNames), labelsCreated)
-
-	fmt.Println("Seeding releases with label links...")
-
-	// Releases to seed — all artists exist in data/bands.yaml.
-	// Each label has at least 2 releases so the Catalog tab has content.
-	releases := []seedReleaseData{
-		// Loma Vista Recordings — HEALTH, Carpenter Brut
-		{"DEATH MAGIC", catalogm.ReleaseTypeLP, 2015, "HEALTH", "Loma Vista Recordings"},
-		{"VOL. 4 :: SLAVES OF FEAR", catalogm.ReleaseTypeLP, 2019, "HEALTH", "Loma Vista Recordings"},
-		{"Leather Teeth", catalogm.ReleaseTypeLP, 2018, "Carpenter Brut", "Loma Vista Recordings"},
-
-		// 4AD — Pixies, Alvvays
-		{"Doolittle", catalogm.ReleaseTypeLP, 1989, "Pixies", "4AD"},
-		{"Surfer Rosa", catalogm.ReleaseTypeLP, 1988, "Pixies", "4AD"},
-		{"Blue Rev", catalogm.ReleaseTypeLP, 2022, "Alvvays", "4AD"},
-		{"Antisocialites", catalogm.ReleaseTypeLP, 2017, "Alvvays", "4AD"},
-
-		// Sub Pop Records — Cat Power, Soccer Mommy
-		{"Covers", catalogm.ReleaseTypeLP, 2022, "Cat Power", "Sub Pop Records"},
-		{"color theory", catalogm.ReleaseTypeLP, 2020, "Soccer Mommy", "Sub Pop Records"},
-
-		// Drag City — Jeff Tweedy, Bill Orcutt
-		{"Warm", catalogm.ReleaseTypeLP, 2018, "Jeff Tweedy", "Drag City"},
-		{"Music for Four Guitars", catalogm.ReleaseTypeLP, 2022, "Bill Orcutt", "Drag City"},
-
-		// Rock Action Records — Mogwai
-		{"As the Love Continues", catalogm.ReleaseTypeLP, 2021, "Mogwai", "Rock Action Records"},
-		{"Every Country's Sun", catalogm.ReleaseTypeLP, 2017, "Mogwai", "Rock Action Records"},
-
-		// Sacred Bones Records — Marissa Nadler, Chat Pile
-		{"The Path of the Clouds", catalogm.ReleaseTypeLP, 2021, "Marissa Nadler", "Sacred Bones Records"},
-		{"God's Country", catalogm.ReleaseTypeLP, 2022, "Chat Pile", "Sacred Bones Records"},
-
-		// DFA Records — LCD Soundsystem
-		{"Sound of Silver", catalogm.ReleaseTypeLP, 2007, "LCD Soundsystem", "DFA Records"},
-		{"American Dream", catalogm.ReleaseTypeLP, 2017, "LCD Soundsystem", "DFA Records"},
+	if !*synthetic {
+		seed.Run(connectToDatabase())
+		return
 	}
 
-	var releasesCreated int
-	for _, r := range releases {
-		// Check if release already exists
-		var existing catalogm.Release
-		if database.Where("LOWER(title) = LOWER(?)", r.Title).First(&existing).Error == nil {
-			continue
-		}
-
-		// Find or create the artist (fallback ensures seed is self-contained)
-		artist, err := findOrCreateArtist(database, r.ArtistName)
+	if *anchor != "" {
+		t, err := time.Parse("2006-01-02", *anchor)
 		if err != nil {
-			log.Printf("Warning: %v", err)
-			continue
+			log.Fatalf("Invalid -anchor %q: %v", *anchor, err)
 		}
-
-		// Find the label
-		var label catalogm.Label
-		if database.Where("LOWER(name) = LOWER(?)", r.LabelName).First(&label).Error != nil {
-			log.Printf("Warning: Label not found for release %s: %s", r.Title, r.LabelName)
-			continue
-		}
-
-		slug := utils.GenerateArtistSlug(r.Title)
-		// Ensure unique slug
-		slug = utils.GenerateUniqueSlug(slug, func(candidate string) bool {
-			var count int64
-			database.Model(&catalogm.Release{}).Where("slug = ?", candidate).Count(&count)
-			return count > 0
-		})
-		year := r.ReleaseYear
-
-		release := &catalogm.Release{
-			Title:       r.Title,
-			Slug:        &slug,
-			ReleaseType: r.ReleaseType,
-			ReleaseYear: &year,
-		}
-
-		err = database.Transaction(func(tx *gorm.DB) error {
-			if err := tx.Create(release).Error; err != nil {
-				return fmt.Errorf("failed to create release: %w", err)
-			}
-
-			// Artist-release link
-			ar := catalogm.ArtistRelease{
-				ArtistID:  artist.ID,
-				ReleaseID: release.ID,
-				Role:      catalogm.ArtistReleaseRoleMain,
-				Position:  0,
-			}
-			if err := tx.Create(&ar).Error; err != nil {
-				return fmt.Errorf("failed to create artist-release link: %w", err)
-			}
-
-			// Release-label link (the key discovery loop connection)
-			rl := catalogm.ReleaseLabel{
-				ReleaseID: release.ID,
-				LabelID:   label.ID,
-			}
-			if err := tx.Create(&rl).Error; err != nil {
-				return fmt.Errorf("failed to create release-label link: %w", err)
-			}
-
-			// Artist-label link (so the label page shows this artist)
-			al := catalogm.ArtistLabel{
-				ArtistID: artist.ID,
-				LabelID:  label.ID,
-			}
-			if err := tx.Where("artist_id = ? AND label_id = ?", artist.ID, label.ID).
-				FirstOrCreate(&al).Error; err != nil {
-				return fmt.Errorf("failed to create artist-label link: %w", err)
-			}
-
-			return nil
-		})
-		if err != nil {
-			log.Printf("Warning: Failed to create release %s: %v", r.Title, err)
-			continue
-		}
-		releasesCreated++
-		fmt.Printf("  ✅ %s by %s on %s\n", r.Title, r.ArtistName, r.LabelName)
+		opts.Anchor = t
 	}
-	fmt.Printf("✅ Processed %d releases (%d created)\n", len(releases), releasesCreated)
-
-	return labelsCreated, releasesCreated
-}
-
-// seedRadioStationsAndShows creates radio networks, stations, and shows.
-// The data source is backend/internal/seeddata/radio.go — the same package
-// that backs cmd/gen-e2e-seed, so prod/stage/dev/E2E cannot drift.
-//
-// Networks are seeded first so stations can resolve NetworkSlug to a
-// radio_networks.id before insert.
-func seedRadioStationsAndShows(database *gorm.DB) (int, int) {
-	fmt.Println("Seeding radio networks...")
-
-	// networkIDsBySlug lets us assign network_id by slug without a
-	// per-station subquery — looked up once after networks are created.
-	networkIDsBySlug := make(map[string]uint, len(seeddata.RadioNetworks))
-	for _, n := range seeddata.RadioNetworks {
-		var existing catalogm.RadioNetwork
-		if err := database.Where("slug = ?", n.Slug).First(&existing).Error; err == nil {
-			networkIDsBySlug[n.Slug] = existing.ID
-			continue
-		}
-		network := &catalogm.RadioNetwork{Slug: n.Slug, Name: n.Name}
-		if err := database.Create(network).Error; err != nil {
-			log.Printf("Warning: Failed to create radio network %s: %v", n.Slug, err)
-			continue
-		}
-		networkIDsBySlug[n.Slug] = network.ID
-	}
-
-	fmt.Println("Seeding radio stations...")
-
-	var stationsCreated int
-	for _, s := range seeddata.RadioStations {
-		var existing catalogm.RadioStation
-		if database.Where("slug = ?", s.Slug).First(&existing).Error == nil {
-			continue
-		}
-
-		station := &catalogm.RadioStation{
-			Name:           s.Name,
-			Slug:           s.Slug,
-			Description:    &s.Description,
-			City:           &s.City,
-			Country:        &s.Country,
-			Timezone:       &s.Timezone,
-			StreamURL:      &s.StreamURL,
-			Website:        &s.Website,
-			DonationURL:    &s.DonationURL,
-			BroadcastType:  s.BroadcastType,
-			PlaylistSource: &s.PlaylistSource,
-			IsActive:       true,
-			IsFlagship:     s.IsFlagship,
-		}
-		if s.State != "" {
-			station.State = &s.State
-		}
-		if s.FrequencyMHz > 0 {
-			station.FrequencyMHz = &s.FrequencyMHz
-		}
-		if s.NetworkSlug != "" {
-			if id, ok := networkIDsBySlug[s.NetworkSlug]; ok {
-				station.NetworkID = &id
-			} else {
-				log.Printf("Warning: Station %s references unknown network slug %q (skipping NetworkID)", s.Slug, s.NetworkSlug)
-			}
-		}
-
-		if err := database.Create(station).Error; err != nil {
-			log.Printf("Warning: Failed to create radio station %s: %v", s.Name, err)
-			continue
-		}
-		stationsCreated++
-	}
-	fmt.Printf("✅ Processed %d radio stations (%d created)\n", len(seeddata.RadioStations), stationsCreated)
-
-	fmt.Println("Seeding radio shows with archive URLs...")
-
-	var radioShowsCreated int
-	for _, s := range seeddata.RadioShows {
-		// Find the parent station
-		var station catalogm.RadioStation
-		if err := database.Where("slug = ?", s.StationSlug).First(&station).Error; err != nil {
-			log.Printf("Warning: Station %s not found for show %s: %v", s.StationSlug, s.Name, err)
-			continue
-		}
-
-		// Check if show already exists
-		var existing catalogm.RadioShow
-		if database.Where("slug = ?", s.Slug).First(&existing).Error == nil {
-			continue
-		}
-
-		show := &catalogm.RadioShow{
-			StationID:       station.ID,
-			Name:            s.Name,
-			Slug:            s.Slug,
-			Description:     &s.Description,
-			ScheduleDisplay: &s.ScheduleDisplay,
-			ArchiveURL:      &s.ArchiveURL,
-			ExternalID:      &s.ExternalID,
-			IsActive:        true,
-		}
-		if s.HostName != "" {
-			show.HostName = &s.HostName
-		}
-
-		if err := database.Create(show).Error; err != nil {
-			log.Printf("Warning: Failed to create radio show %s: %v", s.Name, err)
-			continue
-		}
-		radioShowsCreated++
-		fmt.Printf("  ✅ %s on %s (archive: %s)\n", s.Name, s.StationSlug, s.ArchiveURL)
+	if _, err := seed.RunSynthetic(connectToDatabase(), opts); err != nil {
+		log.Fatalf("Synthetic seed failed: %v", err)
 	}
-	fmt.Printf("✅ Processed %d radio shows (%d created)\n", len(seeddata.RadioShows), radioShowsCreated)
-
-	return stationsCreated, radioShowsCreated
 }
 
 func connectToDatabase() *gorm.DB {
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"strings"
@@ -23,6 +25,7 @@ import (
 	"psychic-homily-backend/internal/api/routes"
 	"psychic-homily-backend/internal/auth"
 	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/httpclient"
 	"psychic-homily-backend/internal/logger"
 	"psychic-homily-backend/internal/observability"
 	"psychic-homily-backend/internal/services"
@@ -30,6 +33,15 @@ import (
 )
 
 func main() {
+	var migrate migrateFlags
+	flag.StringVar(&migrate.Command, "migrate", "", "Run a migration command and exit: up, down, status or force")
+	flag.IntVar(&migrate.Steps, "migrate-steps", 1, "Number of migrations -migrate down rolls back")
+	flag.Int64Var(&migrate.Version, "migrate-version", -2, "Version -migrate force records (-1 for none)")
+	flag.Parse()
+	if err := migrate.validate(); err != nil {
+		log.Fatal(err)
+	}
+
 	// Load environment-specific .env file
 	environment := getEnv("ENVIRONMENT", config.EnvDevelopment)
 	envFile := fmt.Sprintf(".env.%s", environment)
@@ -73,6 +85,21 @@ func main() {
 		log.Fatalf("PSY-914 oauth-test-provider misconfiguration: %v", err)
 	}
 
+	// Outbound egress policy: optional forward proxy + host allowlist, enforced
+	// by internal/httpclient for every integration (and http.DefaultClient, for
+	// libraries like goth/resend). Refuse to boot when the allowlist omits a
+	// host an enabled integration needs, rather than failing on first use.
+	proxyURL, _ := cfg.Egress.ParsedProxyURL() // already validated by config.Load
+	httpclient.Configure(httpclient.Policy{ProxyURL: proxyURL, AllowedHosts: cfg.Egress.AllowedHosts})
+	requiredHosts := cfg.RequiredEgressHosts()
+	if dsn, err := url.Parse(os.Getenv("SENTRY_DSN")); err == nil && dsn.Hostname() != "" {
+		requiredHosts = append(requiredHosts, dsn.Hostname())
+	}
+	if missing := httpclient.MissingHosts(requiredHosts); len(missing) > 0 {
+		log.Fatalf("egress misconfiguration: %s is missing hosts required by enabled integrations: %s",
+			config.EnvEgressAllowedHosts, strings.Join(missing, ", "))
+	}
+
 	// Initialize structured logger
 	// Use JSON format in production, text format with debug in development
 	isProduction := environment == config.EnvProduction
@@ -96,6 +123,9 @@ func main() {
 			// secrets (e.g. the Discord webhook token) must still be redacted at
 			// the call site (utils.RedactErrorURL). See ScrubSentryEvent's doc.
 			BeforeSend: observability.ScrubSentryEvent,
+			// Route event delivery through the egress policy like every other
+			// outbound call.
+			HTTPTransport: httpclient.Transport(),
 		}); err != nil {
 			log.Printf("Sentry initialization failed: %v", err)
 		} else {
@@ -121,12 +151,52 @@ func main() {
 		})
 	})
 
-	// Connect to database
-	if err := db.Connect(cfg); err != nil {
+	// Connect to database, retrying while it comes up (DB_CONNECT_TIMEOUT_SECONDS)
+	if err := db.ConnectWithRetry(cfg); err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	database := db.GetDB()
 
+	if migrate.Command != "" {
+		if err := runMigrate(context.Background(), database, migrate, os.Stdout); err != nil {
+			log.Fatalf("migrate %s: %v", migrate.Command, err)
+		}
+		return
+	}
+
+	// Watch the connection: while Postgres is down, queries fail fast and the
+	// API answers 503 SERVICE_UNAVAILABLE; once a ping succeeds again it
+	// recovers on its own, without a redeploy.
+	dbWatchdogCtx, dbWatchdogCancel := context.WithCancel(context.Background())
+	go db.StartWatchdog(dbWatchdogCtx, database)
+
+	// With a secret store configured, poll it for rotated values. JWT keys
+	// are reloaded into the shared key ring; the pool and OAuth secrets keep
+	// what they read at startup, so those rotations are logged for the deploy
+	// to restart on rather than swapped in mid-flight.
+	secretsWatchCtx, secretsWatchCancel := context.WithCancel(context.Background())
+	if cfg.Secrets.Provider != nil {
+		log.Printf("Secrets backend: %s (cache TTL %s)", cfg.Secrets.Backend, cfg.Secrets.CacheTTL)
+		go cfg.Secrets.Provider.WatchRotation(secretsWatchCtx, cfg.Secrets.CacheTTL, config.ManagedSecretKeys, func(key string) {
+			if (key == config.EnvJWTSecretKey || key == config.EnvJWTPreviousKeys) && cfg.JWT.Keys != nil {
+				if _, err := cfg.JWT.Keys.Reload(secretsWatchCtx); err != nil {
+					logger.Default().Error("jwt_keys_reload_failed", "key", key, "error", err.Error())
+					return
+				}
+				logger.Default().Info("jwt_keys_reloaded",
+					"key", key,
+					"current_kid", cfg.JWT.Keys.Current().ID,
+				)
+				return
+			}
+			logger.Default().Warn("secret_rotated",
+				"key", key,
+				"backend", cfg.Secrets.Backend,
+				"action", "restart to apply",
+			)
+		})
+	}
+
 	// PSY-1384: refuse to boot if critical columns are missing — catches
 	// schema_migrations/DDL drift before engagement writes 422 at runtime.
 	if err := db.AssertRequiredSchema(database); err != nil {
@@ -168,22 +238,40 @@ func main() {
 	})
 
 	// Setup CORS middleware
-	log.Printf("CORS Configuration: Origins=%v, Methods=%v, Headers=%v, Credentials=%v",
-		cfg.CORS.AllowedOrigins, cfg.CORS.AllowedMethods, cfg.CORS.AllowedHeaders, cfg.CORS.AllowCredentials)
+	log.Printf("CORS Configuration: Origins=%v, Patterns=%v, Methods=%v, Headers=%v, Credentials=%v",
+		cfg.CORS.AllowedOrigins, cfg.CORS.AllowedOriginPatterns, cfg.CORS.AllowedMethods, cfg.CORS.AllowedHeaders, cfg.CORS.AllowCredentials)
 
 	// CORS middleware with dynamic origin validation. Construction is
 	// extracted to newCORSMiddleware so the preflight contract — notably
 	// that non-prod echoes the Lighthouse x-vercel-protection-bypass header
-	// (PSY-929) — is unit-testable.
-	router.Use(newCORSMiddleware(cfg.CORS, isProduction).Handler)
+	// (PSY-929) — is unit-testable. The embed endpoints (venue widget feed,
+	// oEmbed) are fetched from venues' own sites, so WidgetCORS opens those
+	// to every origin and leaves the allowlist in charge everywhere else.
+	router.Use(routes.WidgetCORS(newCORSMiddleware(cfg.CORS, isProduction).Handler))
 
 	// Add security headers middleware
 	// Adds headers like X-Content-Type-Options, X-Frame-Options, CSP, HSTS (in production)
 	router.Use(middleware.SecurityHeaders)
 
+	// Answer 503 SERVICE_UNAVAILABLE while the database watchdog has Postgres
+	// marked down. Mounted ahead of the idempotency store, which needs the
+	// database itself.
+	router.Use(middleware.DatabaseAvailability(db.Available))
+
 	// Create service container (all services instantiated once)
 	sc := services.NewServiceContainer(database, cfg)
 
+	// Answer 503 MAINTENANCE_MODE on non-admin routes while MAINTENANCE_MODE
+	// is set or the maintenance_mode feature flag is on. Mounted ahead of the
+	// rate limiters so a maintenance 503 never spends anyone's budget.
+	if cfg.Server.MaintenanceMode {
+		log.Printf("⚠️  %s is set: non-admin routes will answer 503", config.EnvMaintenanceMode)
+		sc.AdminNotifier.NotifyMaintenanceMode(true, config.EnvMaintenanceMode+" env var", "")
+	}
+	router.Use(middleware.MaintenanceMode(sc.JWT, func() bool {
+		return middleware.MaintenanceModeEnabled(cfg.Server.MaintenanceMode, sc.FeatureFlags)
+	}))
+
 	// PSY-1362/1373: rate-limit public-READ traffic (GET/HEAD) by auth state —
 	// anonymous per-IP (100/min), authenticated per-USER (300/min, so shared-IP
 	// logged-in users don't collide). Mounted here — after sc (needs sc.JWT),
@@ -202,6 +290,15 @@ func main() {
 	// (stage, observe 429 rates, then prod).
 	router.Use(routes.EngagementMutationRateLimiter(sc.JWT, os.Getenv))
 
+	// Replay stored responses for retried mutations carrying an
+	// Idempotency-Key (show submission, reports, save/follow). Mounted after
+	// the rate limiters so a 429 is never stored, and before SetupRoutes.
+	router.Use(routes.IdempotencyMiddleware(sc.JWT, sc.Idempotency))
+
+	// Compress the differential sync feeds (/sync/*). Full syncs are large,
+	// repetitive JSON pulled by mobile clients; other endpoints are unchanged.
+	router.Use(routes.SyncCompression())
+
 	// Setup routes
 	_ = routes.SetupRoutes(router, sc, cfg)
 
@@ -215,6 +312,7 @@ func main() {
 		cleanupCancel                context.CancelFunc
 		reminderCancel               context.CancelFunc
 		enrichmentCancel             context.CancelFunc
+		discoveryMonitorCancel       context.CancelFunc
 		autoPromotionCancel          context.CancelFunc
 		radioFetchCancel             context.CancelFunc
 		relDerivationCancel          context.CancelFunc
@@ -226,6 +324,7 @@ func main() {
 		artistDiscographySweepCancel context.CancelFunc
 		artistLinksSweepCancel       context.CancelFunc
 		releaseLinksSweepCancel      context.CancelFunc
+		ticketAvailabilityCancel     context.CancelFunc
 	)
 
 	// Start account cleanup service (background job for permanent deletion)
@@ -255,6 +354,16 @@ func main() {
 		log.Printf("DISABLE_ENRICHMENT_WORKER=1: skipping enrichment worker startup")
 	}
 
+	// Start discovery source monitor (background job alerting Discord when a
+	// scraped venue source stops producing events)
+	if os.Getenv("DISABLE_DISCOVERY_SOURCE_MONITOR") != "1" {
+		var discoveryMonitorCtx context.Context
+		discoveryMonitorCtx, discoveryMonitorCancel = context.WithCancel(context.Background())
+		sc.DiscoverySourceMonitor.Start(discoveryMonitorCtx)
+	} else {
+		log.Printf("DISABLE_DISCOVERY_SOURCE_MONITOR=1: skipping discovery source monitor startup")
+	}
+
 	// Start auto-promotion scheduler (background job for daily user tier evaluation)
 	if os.Getenv("DISABLE_AUTO_PROMOTION") != "1" {
 		var autoPromotionCtx context.Context
@@ -379,6 +488,21 @@ func main() {
 		log.Printf("release links sweep disabled (set ENABLE_RELEASE_LINKS_SWEEP=1 to enable)")
 	}
 
+	// Start ticket availability checks (background job polling the ticket pages
+	// of upcoming shows on known providers and keeping is_sold_out in step).
+	// OPT-IN, default OFF (inverted polarity vs the DISABLE_* services above):
+	// it reads third-party ticket pages, whose hosts must also be on the egress
+	// allowlist, so it runs only where explicitly enabled
+	// (ENABLE_TICKET_AVAILABILITY_CHECK=1). Providers are tuned or disabled
+	// individually via TICKET_CHECK_<PROVIDER>_* (see catalog/ticket_availability.go).
+	if os.Getenv("ENABLE_TICKET_AVAILABILITY_CHECK") == "1" {
+		var ticketAvailabilityCtx context.Context
+		ticketAvailabilityCtx, ticketAvailabilityCancel = context.WithCancel(context.Background())
+		sc.TicketAvailability.Start(ticketAvailabilityCtx)
+	} else {
+		log.Printf("ticket availability checks disabled (set ENABLE_TICKET_AVAILABILITY_CHECK=1 to enable)")
+	}
+
 	// Create HTTP server
 	srv := &http.Server{
 		Addr:    cfg.Server.Addr,
@@ -416,6 +540,10 @@ func main() {
 		enrichmentCancel()
 		sc.EnrichmentWorker.Stop()
 	}
+	if discoveryMonitorCancel != nil {
+		discoveryMonitorCancel()
+		sc.DiscoverySourceMonitor.Stop()
+	}
 	if autoPromotionCancel != nil {
 		autoPromotionCancel()
 		sc.AutoPromotion.Stop()
@@ -460,6 +588,13 @@ func main() {
 		releaseLinksSweepCancel()
 		sc.ReleaseLinksSweep.Stop()
 	}
+	if ticketAvailabilityCancel != nil {
+		ticketAvailabilityCancel()
+		sc.TicketAvailability.Stop()
+	}
+
+	dbWatchdogCancel()
+	secretsWatchCancel()
 
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -468,6 +603,8 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("error during shutdown: %s\n", err)
 	}
+	// Post Discord/Slack notifications still waiting for their batch window.
+	sc.AdminNotifier.Flush()
 
 	log.Println("Server gracefully stopped.")
 }
@@ -479,23 +616,21 @@ func main() {
 // version bump that stopped echoing the Lighthouse bypass header would slip
 // past a helper-only test and silently re-break the /explore gate (PSY-929).
 func newCORSMiddleware(corsCfg config.CORSConfig, isProduction bool) *cors.Cors {
-	// Map for fast origin lookup against the explicit allowlist.
-	allowedOriginsMap := make(map[string]bool)
-	for _, origin := range corsCfg.AllowedOrigins {
-		allowedOriginsMap[origin] = true
-	}
-
 	return cors.New(cors.Options{
 		AllowOriginFunc: func(r *http.Request, origin string) bool {
-			// Check explicit allowed origins (from CORS_ALLOWED_ORIGINS or env defaults)
-			if allowedOriginsMap[origin] {
-				return true
-			}
-			// Allow Vercel preview deployments only in non-production environments.
-			// For production, add specific preview URLs to CORS_ALLOWED_ORIGINS instead.
-			if !isProduction && strings.HasSuffix(origin, ".vercel.app") {
+			// Exact origins from CORS_ALLOWED_ORIGINS (or the env defaults);
+			// preview-deploy patterns only outside production.
+			if corsCfg.AllowsOrigin(origin, isProduction) {
 				return true
 			}
+			// Logged regardless of Debug so a misconfigured frontend origin
+			// shows up without turning on go-chi/cors tracing.
+			logger.FromContext(r.Context()).Warn("cors_origin_rejected",
+				"origin", origin,
+				"method", r.Method,
+				"path", r.URL.Path,
+				"request_id", logger.GetRequestID(r.Context()),
+			)
 			return false
 		},
 		AllowedMethods: corsCfg.AllowedMethods,
@@ -504,8 +639,8 @@ func newCORSMiddleware(corsCfg config.CORSConfig, isProduction bool) *cors.Cors
 		// (PSY-929). Prod stays tight — see config.CORSAllowedHeaders.
 		AllowedHeaders:   config.CORSAllowedHeaders(corsCfg.AllowedHeaders, isProduction),
 		AllowCredentials: corsCfg.AllowCredentials,
-		MaxAge:           300,           // Cache preflight for 5 minutes
-		Debug:            !isProduction, // Only enable debug logging in development
+		MaxAge:           300, // Cache preflight for 5 minutes
+		Debug:            corsCfg.Debug,
 	})
 }
 
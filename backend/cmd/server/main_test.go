@@ -42,10 +42,11 @@ func allowHeadersContains(resp *http.Response, header string) bool {
 // how the /explore gate silently broke before.
 func TestNewCORSMiddlewarePreflight(t *testing.T) {
 	corsCfg := config.CORSConfig{
-		AllowedOrigins:   []string{"https://app.example.com"},
-		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
-		AllowCredentials: true,
+		AllowedOrigins:        []string{"https://app.example.com"},
+		AllowedOriginPatterns: []string{"https://*.vercel.app"},
+		AllowedMethods:        []string{"GET", "POST", "OPTIONS"},
+		AllowedHeaders:        []string{"Accept", "Authorization", "Content-Type"},
+		AllowCredentials:      true,
 	}
 	const previewOrigin = "https://psychic-homily-abc123-matts-projects.vercel.app"
 
@@ -96,3 +97,18 @@ func TestNewCORSMiddlewarePreflight(t *testing.T) {
 		}
 	})
 }
+
+func TestNewCORSMiddleware_RejectsUnlistedOrigin(t *testing.T) {
+	corsCfg := config.CORSConfig{
+		AllowedOrigins:        []string{"https://app.example.com"},
+		AllowedOriginPatterns: []string{"https://*.vercel.app"},
+		AllowedMethods:        []string{"GET"},
+		AllowedHeaders:        []string{"Content-Type"},
+	}
+	for _, isProduction := range []bool{false, true} {
+		resp := firePreflight(t, newCORSMiddleware(corsCfg, isProduction), "https://evil.example.net", "Content-Type")
+		if got := resp.Header.Get("Access-Control-Allow-Origin"); got != "" {
+			t.Errorf("isProduction=%v: unlisted origin must be rejected; Access-Control-Allow-Origin = %q", isProduction, got)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db/migrations"
+	"psychic-homily-backend/db/migrator"
+)
+
+// migrateFlags holds the -migrate command line. When Command is set the
+// server runs it against the configured database and exits instead of
+// serving.
+type migrateFlags struct {
+	Command string
+	Steps   int
+	Version int64
+}
+
+// validate checks the flags before any connection is made.
+func (f migrateFlags) validate() error {
+	switch f.Command {
+	case "", "up", "status":
+		return nil
+	case "down":
+		if f.Steps < 1 {
+			return fmt.Errorf("-migrate down needs -migrate-steps of at least 1")
+		}
+		return nil
+	case "force":
+		if f.Version < migrator.NilVersion {
+			return fmt.Errorf("-migrate force needs -migrate-version (a migration version, or -1 for none)")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown -migrate command %q (want up, down, status or force)", f.Command)
+	}
+}
+
+// runMigrate runs the -migrate command with the embedded migrations.
+func runMigrate(ctx context.Context, database *gorm.DB, f migrateFlags, out io.Writer) error {
+	sqlDB, err := database.DB()
+	if err != nil {
+		return fmt.Errorf("get sql.DB: %w", err)
+	}
+	m, err := migrator.New(sqlDB, migrations.FS, migrator.Options{Logf: log.Printf})
+	if err != nil {
+		return err
+	}
+
+	switch f.Command {
+	case "up":
+		applied, err := m.Up(ctx)
+		fmt.Fprintf(out, "Applied %d migration(s)\n", applied)
+		return explainDirty(err)
+	case "down":
+		rolledBack, err := m.Down(ctx, f.Steps)
+		fmt.Fprintf(out, "Rolled back %d migration(s)\n", rolledBack)
+		return explainDirty(err)
+	case "force":
+		return m.Force(ctx, f.Version)
+	default:
+		status, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printMigrationStatus(out, status)
+		return nil
+	}
+}
+
+func printMigrationStatus(out io.Writer, status *migrator.Status) {
+	version := "none"
+	if status.Version != migrator.NilVersion {
+		version = fmt.Sprint(status.Version)
+	}
+	state := "clean"
+	if status.Dirty {
+		state = "DIRTY"
+	}
+	fmt.Fprintf(out, "Version: %s (%s)\n", version, state)
+	fmt.Fprintf(out, "Latest:  %d\n", status.Latest)
+	fmt.Fprintf(out, "Pending: %d\n", len(status.Pending))
+	for _, mig := range status.Pending {
+		fmt.Fprintf(out, "  %d_%s\n", mig.Version, mig.Name)
+	}
+}
+
+// explainDirty adds the recovery command to a dirty-database error.
+func explainDirty(err error) error {
+	var dirty *migrator.DirtyError
+	if errors.As(err, &dirty) {
+		return fmt.Errorf("%w (e.g. -migrate force -migrate-version %d once its changes are in place)", err, dirty.Version)
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"psychic-homily-backend/db/migrator"
+)
+
+func TestMigrateFlagsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   migrateFlags
+		wantErr string
+	}{
+		{name: "no command", flags: migrateFlags{Steps: 1, Version: -2}},
+		{name: "up", flags: migrateFlags{Command: "up", Steps: 1, Version: -2}},
+		{name: "status", flags: migrateFlags{Command: "status", Steps: 1, Version: -2}},
+		{name: "down", flags: migrateFlags{Command: "down", Steps: 3, Version: -2}},
+		{name: "down with zero steps", flags: migrateFlags{Command: "down", Steps: 0, Version: -2}, wantErr: "-migrate-steps"},
+		{name: "force", flags: migrateFlags{Command: "force", Steps: 1, Version: 20260824000000}},
+		{name: "force to none", flags: migrateFlags{Command: "force", Steps: 1, Version: migrator.NilVersion}},
+		{name: "force without version", flags: migrateFlags{Command: "force", Steps: 1, Version: -2}, wantErr: "-migrate-version"},
+		{name: "unknown command", flags: migrateFlags{Command: "goto", Steps: 1, Version: -2}, wantErr: "unknown -migrate command"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.flags.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExplainDirty(t *testing.T) {
+	err := explainDirty(&migrator.DirtyError{Version: 42})
+	var dirty *migrator.DirtyError
+	if !errors.As(err, &dirty) {
+		t.Fatalf("wrapped error lost DirtyError: %v", err)
+	}
+	if !strings.Contains(err.Error(), "-migrate force -migrate-version 42") {
+		t.Errorf("error = %q, want the force hint", err)
+	}
+
+	plain := errors.New("boom")
+	if got := explainDirty(plain); got != plain {
+		t.Errorf("non-dirty error changed: %v", got)
+	}
+}
@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/internal/config"
+)
+
+// ErrUnavailable is returned by every query issued while the database is
+// marked unavailable. Handlers surface it as 503 SERVICE_UNAVAILABLE instead
+// of a generic 500.
+var ErrUnavailable = errors.New("database unavailable")
+
+const (
+	// watchdogInterval is how often the watchdog pings a healthy database.
+	watchdogInterval = 15 * time.Second
+	// watchdogPingTimeout bounds a single watchdog ping.
+	watchdogPingTimeout = 3 * time.Second
+	// reconnectBackoffMin and reconnectBackoffMax bound the delay between
+	// reconnection attempts while the database is down.
+	reconnectBackoffMin = time.Second
+	reconnectBackoffMax = 30 * time.Second
+)
+
+var (
+	// available is the circuit breaker: when false, queries fail fast with
+	// ErrUnavailable rather than queueing on a dead connection pool.
+	available atomic.Bool
+	// suspect wakes the watchdog early when a query fails with a connection
+	// error, so an outage is confirmed (or ruled out) without waiting for
+	// the next scheduled ping.
+	suspect = make(chan struct{}, 1)
+)
+
+func init() {
+	available.Store(true)
+}
+
+// Available reports whether the database is currently reachable.
+func Available() bool {
+	return available.Load()
+}
+
+// setAvailable records a change in database reachability, logging only
+// transitions.
+func setAvailable(ok bool, cause error) {
+	if available.Swap(ok) == ok {
+		return
+	}
+	if ok {
+		log.Println("✅ Database connection restored")
+	} else {
+		log.Printf("⚠️  Database unavailable, failing queries fast until it recovers: %v", cause)
+	}
+}
+
+// IsUnavailableError reports whether err means the database could not be
+// reached — a refused or dropped connection, a server shutting down or in
+// recovery — as opposed to a problem with the query itself. A nil error
+// returns false.
+func IsUnavailableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrUnavailable) || errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08: connection exception. 57P01-57P03: admin/crash shutdown,
+		// cannot connect now (server starting up or in recovery).
+		return strings.HasPrefix(pgErr.Code, "08") || pgErr.Code == "57P01" ||
+			pgErr.Code == "57P02" || pgErr.Code == "57P03"
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// reconnectBackoff returns the delay before reconnection attempt n (0-based):
+// doubling from reconnectBackoffMin, capped at reconnectBackoffMax.
+func reconnectBackoff(attempt int) time.Duration {
+	d := reconnectBackoffMin
+	for i := 0; i < attempt && d < reconnectBackoffMax; i++ {
+		d *= 2
+	}
+	if d > reconnectBackoffMax {
+		d = reconnectBackoffMax
+	}
+	return d
+}
+
+// availabilityPlugin wires the circuit breaker into every GORM operation:
+// a before-callback fails the statement with ErrUnavailable while the
+// database is down, and an after-callback wakes the watchdog when a statement
+// fails with a connection error.
+type availabilityPlugin struct{}
+
+func (availabilityPlugin) Name() string { return "db_availability" }
+
+func (availabilityPlugin) Initialize(gdb *gorm.DB) error {
+	cb := gdb.Callback()
+	const before, after = "db_availability:before", "db_availability:after"
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register(before, failFastWhenUnavailable),
+		cb.Create().After("gorm:create").Register(after, reportConnectionErrors),
+		cb.Query().Before("gorm:query").Register(before, failFastWhenUnavailable),
+		cb.Query().After("gorm:query").Register(after, reportConnectionErrors),
+		cb.Update().Before("gorm:update").Register(before, failFastWhenUnavailable),
+		cb.Update().After("gorm:update").Register(after, reportConnectionErrors),
+		cb.Delete().Before("gorm:delete").Register(before, failFastWhenUnavailable),
+		cb.Delete().After("gorm:delete").Register(after, reportConnectionErrors),
+		cb.Row().Before("gorm:row").Register(before, failFastWhenUnavailable),
+		cb.Row().After("gorm:row").Register(after, reportConnectionErrors),
+		cb.Raw().Before("gorm:raw").Register(before, failFastWhenUnavailable),
+		cb.Raw().After("gorm:raw").Register(after, reportConnectionErrors),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func failFastWhenUnavailable(tx *gorm.DB) {
+	if !Available() {
+		_ = tx.AddError(ErrUnavailable)
+	}
+}
+
+func reportConnectionErrors(tx *gorm.DB) {
+	if tx.Error == nil || errors.Is(tx.Error, ErrUnavailable) || !IsUnavailableError(tx.Error) {
+		return
+	}
+	select {
+	case suspect <- struct{}{}:
+	default:
+	}
+}
+
+// pingDB checks reachability with a bounded ping.
+func pingDB(ctx context.Context, gdb *gorm.DB) error {
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, watchdogPingTimeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+// StartWatchdog pings the database until ctx is cancelled. A failed ping
+// marks the database unavailable — so queries fail fast with ErrUnavailable
+// — and the watchdog then retries with exponential backoff, marking it
+// available again as soon as a ping succeeds. database/sql re-dials pooled
+// connections on its own, so a Postgres restart recovers without a
+// redeploy. Blocks; run it in a goroutine.
+func StartWatchdog(ctx context.Context, gdb *gorm.DB) {
+	failures := 0
+	wait := watchdogInterval
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-suspect:
+			timer.Stop()
+		}
+
+		if err := pingDB(ctx, gdb); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			setAvailable(false, err)
+			wait = reconnectBackoff(failures)
+			failures++
+		} else {
+			setAvailable(true, nil)
+			failures = 0
+			wait = watchdogInterval
+		}
+		timer.Reset(wait)
+	}
+}
+
+// ConnectWithRetry calls Connect, retrying with exponential backoff until it
+// succeeds or cfg.Database.ConnectTimeout has elapsed, so the server can
+// start alongside a database that is still coming up.
+func ConnectWithRetry(cfg *config.Config) error {
+	deadline := time.Now().Add(cfg.Database.ConnectTimeout)
+	for attempt := 0; ; attempt++ {
+		err := Connect(cfg)
+		if err == nil {
+			return nil
+		}
+		delay := reconnectBackoff(attempt)
+		if time.Now().Add(delay).After(deadline) {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt+1, err)
+		}
+		log.Printf("⚠️  Database connection attempt %d failed, retrying in %s: %v", attempt+1, delay, err)
+		time.Sleep(delay)
+	}
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestIsUnavailableError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "breaker open", err: fmt.Errorf("list shows: %w", ErrUnavailable), want: true},
+		{name: "bad conn", err: driver.ErrBadConn, want: true},
+		{name: "connect error", err: &pgconn.ConnectError{}, want: true},
+		{name: "connection failure", err: &pgconn.PgError{Code: "08006"}, want: true},
+		{name: "admin shutdown", err: &pgconn.PgError{Code: "57P01"}, want: true},
+		{name: "cannot connect now", err: &pgconn.PgError{Code: "57P03"}, want: true},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}, want: false},
+		{name: "query canceled", err: &pgconn.PgError{Code: "57014"}, want: false},
+		{name: "record not found", err: gorm.ErrRecordNotFound, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, IsUnavailableError(tt.err))
+		})
+	}
+}
+
+func TestReconnectBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Second, reconnectBackoff(0))
+	assert.Equal(t, 2*time.Second, reconnectBackoff(1))
+	assert.Equal(t, 16*time.Second, reconnectBackoff(4))
+	assert.Equal(t, reconnectBackoffMax, reconnectBackoff(5))
+	assert.Equal(t, reconnectBackoffMax, reconnectBackoff(100))
+}
+
+// openUnreachableDB returns a GORM handle with the availability plugin whose
+// DSN points at a closed port. The pool dials lazily, so opening succeeds.
+func openUnreachableDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	gdb, err := gorm.Open(postgres.New(postgres.Config{
+		DSN: "host=127.0.0.1 port=1 user=x dbname=x sslmode=disable connect_timeout=2",
+	}), &gorm.Config{DisableAutomaticPing: true, Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, gdb.Use(availabilityPlugin{}))
+	return gdb
+}
+
+func TestAvailabilityPlugin(t *testing.T) {
+	gdb := openUnreachableDB(t)
+	t.Cleanup(func() { setAvailable(true, nil) })
+
+	t.Run("fails fast while unavailable", func(t *testing.T) {
+		setAvailable(false, errors.New("test"))
+		var n int
+		err := gdb.Raw("SELECT 1").Scan(&n).Error
+		assert.ErrorIs(t, err, ErrUnavailable)
+		err = gdb.Exec("SELECT 1").Error
+		assert.ErrorIs(t, err, ErrUnavailable)
+	})
+
+	t.Run("connection errors wake the watchdog", func(t *testing.T) {
+		setAvailable(true, nil)
+		select {
+		case <-suspect:
+		default:
+		}
+
+		var n int
+		err := gdb.Raw("SELECT 1").Scan(&n).Error
+		require.Error(t, err)
+		assert.True(t, IsUnavailableError(err), "got %v", err)
+		assert.NotErrorIs(t, err, ErrUnavailable)
+
+		select {
+		case <-suspect:
+		default:
+			t.Fatal("expected a suspect signal for the watchdog")
+		}
+	})
+}
+
+func TestStartWatchdog_MarksUnavailableOnFailedPing(t *testing.T) {
+	gdb := openUnreachableDB(t)
+	t.Cleanup(func() { setAvailable(true, nil) })
+	setAvailable(true, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		StartWatchdog(ctx, gdb)
+		close(done)
+	}()
+
+	suspect <- struct{}{}
+	assert.Eventually(t, func() bool { return !Available() }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watchdog did not stop after cancel")
+	}
+}
@@ -9,10 +9,16 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
 
 	"psychic-homily-backend/internal/config"
 )
 
+// ReadReplicaResolver names the dbresolver resolver Connect registers when
+// DATABASE_REPLICA_URL is set. Its reads go to the replica; its writes stay
+// on the primary.
+const ReadReplicaResolver = "read_replica"
+
 var (
 	DB *gorm.DB
 )
@@ -22,10 +28,13 @@ func Connect(cfg *config.Config) error {
 
 	// ErrRecordNotFound is application-level branching (lookup-or-create, radio
 	// matching against unmatched plays), not an error worth surfacing.
+	// ParameterizedQueries keeps bound values (emails, tokens, search terms)
+	// out of slow-query and error logs; only the placeholders are printed.
 	gormLogger := logger.New(log.New(os.Stdout, "", log.LstdFlags), logger.Config{
-		SlowThreshold:             200 * time.Millisecond,
+		SlowThreshold:             cfg.Database.SlowQueryThreshold,
 		LogLevel:                  logger.Warn,
 		IgnoreRecordNotFoundError: true,
+		ParameterizedQueries:      true,
 		Colorful:                  cfg.Server.LogLevel == "debug",
 	})
 	if cfg.Server.LogLevel == "debug" {
@@ -51,6 +60,41 @@ func Connect(cfg *config.Config) error {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database handle: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+
+	if err := DB.Use(queryMetricsPlugin{}); err != nil {
+		return fmt.Errorf("failed to register query metrics plugin: %w", err)
+	}
+
+	// Fail queries fast with ErrUnavailable while the watchdog (see
+	// availability.go) has the database marked down.
+	if err := DB.Use(availabilityPlugin{}); err != nil {
+		return fmt.Errorf("failed to register database availability plugin: %w", err)
+	}
+	setAvailable(true, nil)
+
+	// Heavy list, search and export queries opt in to the read replica via
+	// shared.ReadReplica; everything else, and every write, stays on the
+	// primary.
+	if cfg.Database.ReplicaURL != "" {
+		resolver := dbresolver.Register(dbresolver.Config{
+			Replicas: []gorm.Dialector{postgres.Open(cfg.Database.ReplicaURL)},
+		}, ReadReplicaResolver)
+		if err := DB.Use(resolver); err != nil {
+			return fmt.Errorf("failed to connect to read replica: %w", err)
+		}
+		resolver.SetMaxOpenConns(cfg.Database.MaxOpenConns).
+			SetMaxIdleConns(cfg.Database.MaxIdleConns).
+			SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+		log.Println("✅ Read replica configured")
+	}
+
 	log.Println("✅ Database connected successfully")
 	return nil
 }
@@ -0,0 +1,8 @@
+-- Reverse show_series. Generated occurrences survive as ordinary shows: the
+-- shows columns are dropped, not the rows, so a down never deletes events.
+DROP INDEX IF EXISTS idx_shows_series_id_event_date;
+ALTER TABLE shows
+    DROP COLUMN IF EXISTS series_detached,
+    DROP COLUMN IF EXISTS series_id;
+DROP TABLE IF EXISTS show_series_artists;
+DROP TABLE IF EXISTS show_series;
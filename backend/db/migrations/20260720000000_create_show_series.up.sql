@@ -0,0 +1,52 @@
+-- show_series — recurring-event templates (weekly residencies, open mics,
+-- standing DJ nights). A series row holds the template (weekday, local start
+-- time, venue, billing, date range); individual shows rows are generated from
+-- it and point back via shows.series_id. An occurrence an admin edits by hand
+-- is marked series_detached so template edits and regeneration leave it alone.
+--
+-- ADDITIVE: two brand-new tables + two nullable/defaulted columns on shows.
+-- Multi-statement => golang-migrate wraps in a transaction => no CREATE INDEX
+-- CONCURRENTLY.
+
+CREATE TABLE show_series (
+    id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(500) NOT NULL,
+    venue_id BIGINT NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    -- 0 = Sunday ... 6 = Saturday (Go's time.Weekday numbering).
+    day_of_week SMALLINT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6),
+    -- Venue-local wall-clock start time, "HH:MM". Stored local (not UTC) so a
+    -- weekly 8pm residency stays at 8pm across DST transitions.
+    start_time VARCHAR(5) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    price DECIMAL(10, 2),
+    age_requirement VARCHAR(255),
+    description TEXT,
+    ticket_url VARCHAR(500),
+    -- Soft reference: deleting the creating admin must not delete the series.
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT show_series_date_range CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_show_series_venue_id ON show_series (venue_id);
+
+-- Template billing, copied onto every generated occurrence's show_artists.
+CREATE TABLE show_series_artists (
+    series_id BIGINT NOT NULL REFERENCES show_series(id) ON DELETE CASCADE,
+    artist_id BIGINT NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    position INT NOT NULL DEFAULT 0,
+    set_type VARCHAR(50) NOT NULL DEFAULT 'performer',
+    PRIMARY KEY (series_id, artist_id)
+);
+
+-- Deleting a series orphans its past occurrences rather than deleting them:
+-- they are real shows with attendance/bookmark history.
+ALTER TABLE shows
+    ADD COLUMN series_id BIGINT REFERENCES show_series(id) ON DELETE SET NULL,
+    ADD COLUMN series_detached BOOLEAN NOT NULL DEFAULT false;
+
+-- Regeneration scans a series' occurrences by (series_id, event_date).
+CREATE INDEX idx_shows_series_id_event_date
+    ON shows (series_id, event_date) WHERE series_id IS NOT NULL;
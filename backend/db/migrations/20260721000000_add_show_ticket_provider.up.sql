@@ -0,0 +1,7 @@
+-- Ticket vendor behind shows.ticket_url. Nullable: most shows have no ticket
+-- link, and "not set" is distinct from "other". The CHECK mirrors
+-- models/catalog TicketProviders so a bad value can't slip in via a raw write.
+ALTER TABLE shows
+    ADD COLUMN ticket_provider VARCHAR(32)
+        CONSTRAINT shows_ticket_provider_check
+        CHECK (ticket_provider IN ('eventbrite', 'dice', 'seetickets', 'box_office', 'other'));
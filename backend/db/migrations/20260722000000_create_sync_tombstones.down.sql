@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_artists_updated_at_id;
+DROP INDEX IF EXISTS idx_venues_updated_at_id;
+DROP INDEX IF EXISTS idx_shows_updated_at_id;
+DROP TRIGGER IF EXISTS artists_sync_tombstone ON artists;
+DROP TRIGGER IF EXISTS venues_sync_tombstone ON venues;
+DROP TRIGGER IF EXISTS shows_sync_tombstone ON shows;
+DROP FUNCTION IF EXISTS record_sync_tombstone();
+DROP TABLE IF EXISTS sync_tombstones;
@@ -0,0 +1,48 @@
+-- Differential sync for offline clients (GET /v1/sync/{shows,venues,artists}).
+--
+-- Inserts and edits are found by keyset over (updated_at, id); hard deletes
+-- leave nothing to scan, so a row-level trigger records a tombstone for every
+-- deleted show, venue and artist. A trigger (not application code) is the
+-- only place that sees every delete path: admin deletes, artist merges,
+-- cleanup jobs and ON DELETE CASCADE alike.
+--
+-- city/state are copied from the deleted row so market-scoped syncs can skip
+-- tombstones for other markets. Tombstones are keyed by their BIGSERIAL id,
+-- which the sync cursor carries.
+CREATE TABLE sync_tombstones (
+    id BIGSERIAL PRIMARY KEY,
+    entity_type VARCHAR(16) NOT NULL CHECK (entity_type IN ('show', 'venue', 'artist')),
+    entity_id INTEGER NOT NULL,
+    city VARCHAR(255),
+    state VARCHAR(255),
+    deleted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sync_tombstones_entity_type_id ON sync_tombstones (entity_type, id);
+
+CREATE OR REPLACE FUNCTION record_sync_tombstone()
+  RETURNS trigger
+  AS $$
+BEGIN
+    INSERT INTO sync_tombstones (entity_type, entity_id, city, state)
+    VALUES (TG_ARGV[0], OLD.id, OLD.city, OLD.state);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER shows_sync_tombstone
+    AFTER DELETE ON shows
+    FOR EACH ROW EXECUTE FUNCTION record_sync_tombstone('show');
+
+CREATE TRIGGER venues_sync_tombstone
+    AFTER DELETE ON venues
+    FOR EACH ROW EXECUTE FUNCTION record_sync_tombstone('venue');
+
+CREATE TRIGGER artists_sync_tombstone
+    AFTER DELETE ON artists
+    FOR EACH ROW EXECUTE FUNCTION record_sync_tombstone('artist');
+
+-- Keyset indexes for the change scans.
+CREATE INDEX idx_shows_updated_at_id ON shows (updated_at, id);
+CREATE INDEX idx_venues_updated_at_id ON venues (updated_at, id);
+CREATE INDEX idx_artists_updated_at_id ON artists (updated_at, id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_venues_lat_lng;
+
+ALTER TABLE venues
+    DROP COLUMN IF EXISTS geocode_precision;
@@ -0,0 +1,14 @@
+-- Record how precise a venue's coordinates are. 'city' is the offline
+-- GeoNames centroid set at create/update; 'address' is a street-level point
+-- from the configured address geocoder (GEOCODING_PROVIDER). NULL when the
+-- venue has no coordinates. Existing coordinates are all centroids.
+ALTER TABLE venues
+    ADD COLUMN geocode_precision VARCHAR(16)
+        CONSTRAINT venues_geocode_precision_check
+        CHECK (geocode_precision IN ('city', 'address'));
+
+UPDATE venues SET geocode_precision = 'city' WHERE latitude IS NOT NULL;
+
+-- Bounding-box prefilter for the shows near=lat,lng&radius_km= search.
+CREATE INDEX idx_venues_lat_lng ON venues (latitude, longitude)
+    WHERE latitude IS NOT NULL;
@@ -0,0 +1,16 @@
+-- Old slugs that should keep resolving after the entity they named went away.
+--
+-- Written when an admin merges a duplicate artist or venue into a canonical
+-- record: the duplicate's slug maps to the canonical entity so bookmarked
+-- and shared links keep working. Redirects that pointed at the duplicate are
+-- re-pointed in the same transaction, so chains never form.
+CREATE TABLE slug_redirects (
+    id BIGSERIAL PRIMARY KEY,
+    entity_type VARCHAR(16) NOT NULL CHECK (entity_type IN ('show', 'venue', 'artist')),
+    old_slug VARCHAR(255) NOT NULL,
+    entity_id INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (entity_type, old_slug)
+);
+
+CREATE INDEX idx_slug_redirects_entity ON slug_redirects (entity_type, entity_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_shows_deleted_at;
+ALTER TABLE shows DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,8 @@
+-- Soft delete for shows.
+--
+-- Deleting a show sets deleted_at instead of removing the row, so an admin
+-- can restore it. Public queries exclude soft-deleted shows; the cleanup job
+-- hard-deletes them once the retention period has passed.
+ALTER TABLE shows ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+
+CREATE INDEX idx_shows_deleted_at ON shows(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE revisions DROP COLUMN IF EXISTS snapshot;
@@ -0,0 +1,7 @@
+-- Full JSON snapshot of the entity row taken when a revision is recorded.
+--
+-- field_changes only holds the fields an edit touched; the snapshot lets the
+-- admin history view show the whole record at each revision and diff
+-- consecutive revisions, including a show's venue and artist IDs. Only show
+-- and venue revisions carry one; older rows and other entity types stay NULL.
+ALTER TABLE revisions ADD COLUMN snapshot JSONB NULL;
@@ -0,0 +1,23 @@
+-- Scoped public API keys that any verified user can create.
+--
+-- Separate from api_tokens: those are admin/CLI credentials that
+-- authenticate as the full user. A public key only reaches endpoints tagged
+-- with one of its scopes, and is throttled per key using the fixed
+-- one-minute window in window_started_at/window_count.
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,            -- First characters of the key, for display
+    key_hash VARCHAR(64) NOT NULL UNIQUE,       -- SHA-256 hash of the key
+    scopes TEXT NOT NULL,                       -- Space-separated, e.g. 'read:shows read:venues'
+    rate_limit_per_minute INTEGER NOT NULL DEFAULT 60,
+    request_count BIGINT NOT NULL DEFAULT 0,
+    window_started_at TIMESTAMP WITH TIME ZONE,
+    window_count INTEGER NOT NULL DEFAULT 0,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_api_keys_user_id ON api_keys(user_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS discovery_sources;
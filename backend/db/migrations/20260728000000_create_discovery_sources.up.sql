@@ -0,0 +1,21 @@
+-- Per-source health for the venue discovery importer.
+--
+-- One row per scraped source (keyed by the scraper's venue slug), updated on
+-- every non-dry-run import. A source that stops producing events simply stops
+-- appearing in payloads, so staleness is measured from last_success_at (or
+-- created_at when it never succeeded). stale_alerted_at records the last
+-- Discord alert so each stale episode is announced once.
+CREATE TABLE discovery_sources (
+    id SERIAL PRIMARY KEY,
+    source_key VARCHAR(255) NOT NULL UNIQUE,    -- Scraper venue slug, e.g. 'valley-bar'
+    venue_name VARCHAR(255) NOT NULL DEFAULT '',
+    last_import_at TIMESTAMP WITH TIME ZONE,
+    last_success_at TIMESTAMP WITH TIME ZONE,   -- Last import where at least one event didn't error
+    last_event_count INTEGER NOT NULL DEFAULT 0,
+    total_events BIGINT NOT NULL DEFAULT 0,
+    error_streak INTEGER NOT NULL DEFAULT 0,    -- Consecutive imports where every event errored
+    last_error TEXT,
+    stale_alerted_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
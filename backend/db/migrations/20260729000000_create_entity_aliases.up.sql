@@ -0,0 +1,18 @@
+-- Admin-curated name aliases for import matching.
+--
+-- Maps a scraper- or seed-provided name (e.g. 'The Crescent') to a canonical
+-- venue or artist, so imports link to the existing row instead of guessing
+-- with fuzzy matching or creating a near-duplicate. entity_id is polymorphic,
+-- so there is no foreign key: merges re-point aliases, and lookups ignore an
+-- alias whose entity no longer exists.
+CREATE TABLE entity_aliases (
+    id SERIAL PRIMARY KEY,
+    entity_type VARCHAR(16) NOT NULL CHECK (entity_type IN ('venue', 'artist')),
+    entity_id INTEGER NOT NULL,
+    alias VARCHAR(255) NOT NULL,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_entity_aliases_type_alias_lower ON entity_aliases(entity_type, LOWER(alias));
+CREATE INDEX idx_entity_aliases_entity ON entity_aliases(entity_type, entity_id);
@@ -0,0 +1,4 @@
+ALTER TABLE shows
+    DROP COLUMN IF EXISTS flyer_thumbnail_url,
+    DROP COLUMN IF EXISTS flyer_url,
+    DROP COLUMN IF EXISTS flyer_key;
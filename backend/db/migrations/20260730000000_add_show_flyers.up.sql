@@ -0,0 +1,7 @@
+-- Uploaded show flyers. flyer_key is the storage prefix the resized copies
+-- live under ("flyers/shows/<id>/<token>-"), kept so a replaced or purged
+-- show can delete its objects. image_url stays the externally-linked flyer.
+ALTER TABLE shows
+    ADD COLUMN flyer_key VARCHAR(255),
+    ADD COLUMN flyer_url TEXT,
+    ADD COLUMN flyer_thumbnail_url TEXT;
@@ -0,0 +1,17 @@
+-- Validated batch imports awaiting commit.
+--
+-- POST /admin/data/import/validate stores the checked payload here and hands
+-- the admin a one-time token (only its SHA-256 hash is kept); batches with
+-- row errors are never stored. The commit step imports exactly the stored
+-- payload, so what was validated is what lands.
+CREATE TABLE data_import_validations (
+    id SERIAL PRIMARY KEY,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    admin_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    payload JSONB NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    committed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_data_import_validations_expires_at ON data_import_validations(expires_at);
@@ -0,0 +1,13 @@
+-- In-progress show submissions, saved so users don't lose work when they
+-- navigate away from the submit form. payload is the CreateShowRequest body
+-- as the form last had it, which may be incomplete. The per-user cap is
+-- enforced by the service.
+CREATE TABLE show_drafts (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_show_drafts_user_updated ON show_drafts(user_id, updated_at DESC);
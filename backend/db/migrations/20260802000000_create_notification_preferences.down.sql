@@ -0,0 +1,16 @@
+ALTER TABLE user_preferences ADD COLUMN notification_email BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE user_preferences ADD COLUMN notification_push BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE user_preferences up SET notification_email = FALSE
+WHERE EXISTS (
+    SELECT 1 FROM notification_preferences np
+    WHERE np.user_id = up.user_id AND np.channel = 'email' AND np.enabled = FALSE
+);
+
+UPDATE user_preferences up SET notification_push = TRUE
+WHERE EXISTS (
+    SELECT 1 FROM notification_preferences np
+    WHERE np.user_id = up.user_id AND np.channel = 'push' AND np.enabled = TRUE
+);
+
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,36 @@
+-- Per-user notification preference matrix: one row per (event type, channel)
+-- the user has set. Cells without a row use the service's defaults, so new
+-- event types and channels need no backfill.
+CREATE TABLE notification_preferences (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_type VARCHAR(40) NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, event_type, channel)
+);
+
+-- Carry over existing choices. show_reminders stays as the reminder job's
+-- column and is kept in sync with the saved_show_reminder/email cell.
+INSERT INTO notification_preferences (user_id, event_type, channel, enabled)
+SELECT user_id, 'saved_show_reminder', 'email', TRUE
+FROM user_preferences
+WHERE show_reminders = TRUE;
+
+-- The old global email switch becomes an opt-out of the email cells that
+-- default on.
+INSERT INTO notification_preferences (user_id, event_type, channel, enabled)
+SELECT up.user_id, e.event_type, 'email', FALSE
+FROM user_preferences up
+CROSS JOIN (VALUES ('favorite_venue_announcement'), ('submission_status')) AS e(event_type)
+WHERE up.notification_email = FALSE;
+
+-- The old global push switch opts in to every push cell.
+INSERT INTO notification_preferences (user_id, event_type, channel, enabled)
+SELECT up.user_id, e.event_type, 'push', TRUE
+FROM user_preferences up
+CROSS JOIN (VALUES ('saved_show_reminder'), ('favorite_venue_announcement'), ('submission_status'), ('digest')) AS e(event_type)
+WHERE up.notification_push = TRUE;
+
+ALTER TABLE user_preferences DROP COLUMN notification_email;
+ALTER TABLE user_preferences DROP COLUMN notification_push;
@@ -0,0 +1,16 @@
+ALTER TABLE user_bookmarks ADD COLUMN reminder_sent_at TIMESTAMPTZ;
+CREATE INDEX idx_user_bookmarks_reminder ON user_bookmarks(entity_type, action, reminder_sent_at)
+    WHERE reminder_sent_at IS NULL;
+
+UPDATE user_bookmarks ub
+SET reminder_sent_at = sn.sent_at
+FROM sent_notifications sn
+WHERE sn.notification_type = 'show_reminder_day_before'
+    AND sn.entity_type = 'show'
+    AND sn.channel = 'email'
+    AND sn.user_id = ub.user_id
+    AND sn.entity_id = ub.entity_id
+    AND ub.entity_type = 'show'
+    AND ub.action = 'save';
+
+DROP TABLE IF EXISTS sent_notifications;
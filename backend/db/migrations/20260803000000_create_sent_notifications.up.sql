@@ -0,0 +1,25 @@
+-- One row per notification actually delivered, keyed so a scheduled sender
+-- can check (and record) "already sent" for a user, entity and channel.
+CREATE TABLE sent_notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    notification_type VARCHAR(64) NOT NULL,
+    entity_type VARCHAR(32) NOT NULL,
+    entity_id INTEGER NOT NULL,
+    channel VARCHAR(16) NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_sent_notifications_dedup
+    ON sent_notifications (user_id, notification_type, entity_type, entity_id, channel);
+CREATE INDEX idx_sent_notifications_entity
+    ON sent_notifications (entity_type, entity_id);
+
+-- The day-before reminder used to be deduplicated on the bookmark row.
+INSERT INTO sent_notifications (user_id, notification_type, entity_type, entity_id, channel, sent_at)
+SELECT user_id, 'show_reminder_day_before', 'show', entity_id, 'email', reminder_sent_at
+FROM user_bookmarks
+WHERE entity_type = 'show' AND action = 'save' AND reminder_sent_at IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE user_bookmarks DROP COLUMN reminder_sent_at;
@@ -0,0 +1,16 @@
+-- Browser Web Push subscriptions. An endpoint identifies one browser profile
+-- with the push service, so it is unique across users: subscribing again
+-- from a shared browser moves the row to the new user.
+CREATE TABLE push_subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    endpoint TEXT NOT NULL,
+    p256dh VARCHAR(128) NOT NULL,
+    auth VARCHAR(64) NOT NULL,
+    user_agent VARCHAR(512),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_success_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_push_subscriptions_endpoint ON push_subscriptions (endpoint);
+CREATE INDEX idx_push_subscriptions_user ON push_subscriptions (user_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_users_role;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_role,
+    DROP COLUMN IF EXISTS role;
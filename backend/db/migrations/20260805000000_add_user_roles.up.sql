@@ -0,0 +1,11 @@
+-- Staff roles replace the single is_admin flag for permission checks.
+-- is_admin stays as a compatibility flag, kept true for admin and superadmin
+-- by the role endpoint, because owner-or-admin checks still read it.
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'user',
+    ADD CONSTRAINT chk_users_role CHECK (role IN ('user', 'moderator', 'admin', 'superadmin'));
+
+-- Existing admins keep full access.
+UPDATE users SET role = 'superadmin' WHERE is_admin = TRUE;
+
+CREATE INDEX idx_users_role ON users (role) WHERE role <> 'user';
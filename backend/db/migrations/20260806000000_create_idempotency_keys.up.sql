@@ -0,0 +1,21 @@
+-- Idempotency-Key replay store. The first request carrying a key claims the
+-- row (status_code 0 while in flight) and then records its response; retries
+-- with the same key inside the 24h window get that response back instead of
+-- running the mutation again. Keys are namespaced per caller by scope
+-- ("user:<id>" for sessions, a credential hash for API tokens/keys).
+CREATE TABLE idempotency_keys (
+    id BIGSERIAL PRIMARY KEY,
+    scope VARCHAR(100) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    path TEXT NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    content_type VARCHAR(255),
+    response_body BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_idempotency_keys_scope_key ON idempotency_keys (scope, idempotency_key);
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_shows_submitted_by_created_at;
+DROP INDEX IF EXISTS idx_users_quarantined_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS quarantine_reason,
+    DROP COLUMN IF EXISTS quarantined_at;
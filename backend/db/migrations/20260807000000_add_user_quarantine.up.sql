@@ -0,0 +1,11 @@
+-- Submission quarantine: while quarantined_at is set, a user's new show
+-- submissions are held as pending for moderator review instead of being
+-- published. Set automatically by burst detection or by a moderator.
+ALTER TABLE users
+    ADD COLUMN quarantined_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN quarantine_reason VARCHAR(500);
+
+CREATE INDEX idx_users_quarantined_at ON users (quarantined_at) WHERE quarantined_at IS NOT NULL;
+
+-- Submission throttle counts a user's shows over the last day.
+CREATE INDEX idx_shows_submitted_by_created_at ON shows (submitted_by, created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS submissions_approved_count,
+    DROP COLUMN IF EXISTS submissions_rejected_count;
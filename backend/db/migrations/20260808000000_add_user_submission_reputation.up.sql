@@ -0,0 +1,29 @@
+-- Submission reputation: how many of a user's show submissions moderators
+-- have approved or rejected. Drives the derived submission trust tier; see
+-- User.SubmissionTrust.
+ALTER TABLE users
+    ADD COLUMN submissions_approved_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN submissions_rejected_count INTEGER NOT NULL DEFAULT 0;
+
+-- Backfill from history. Rejections are the shows still rejected;
+-- approvals are shows a moderator approved per the audit log (shows created
+-- approved never passed review and don't count).
+UPDATE users u SET submissions_rejected_count = r.cnt
+FROM (
+    SELECT submitted_by, COUNT(*) AS cnt
+    FROM shows
+    WHERE status = 'rejected' AND submitted_by IS NOT NULL
+    GROUP BY submitted_by
+) r
+WHERE u.id = r.submitted_by;
+
+UPDATE users u SET submissions_approved_count = a.cnt
+FROM (
+    SELECT s.submitted_by, COUNT(DISTINCT s.id) AS cnt
+    FROM audit_logs al
+    JOIN shows s ON s.id = al.entity_id
+    WHERE al.action = 'approve_show' AND al.entity_type = 'show'
+      AND s.status = 'approved' AND s.submitted_by IS NOT NULL
+    GROUP BY s.submitted_by
+) a
+WHERE u.id = a.submitted_by;
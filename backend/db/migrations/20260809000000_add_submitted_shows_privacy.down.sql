@@ -0,0 +1,6 @@
+ALTER TABLE users ALTER COLUMN privacy_settings SET DEFAULT '{"contributions":"visible","saved_shows":"hidden","following":"visible","collections":"visible","last_active":"visible","profile_sections":"visible"}';
+
+UPDATE users
+SET privacy_settings = privacy_settings - 'submitted_shows'
+WHERE privacy_settings IS NOT NULL
+  AND jsonb_exists(privacy_settings, 'submitted_shows');
@@ -0,0 +1,9 @@
+-- Public profiles list a user's approved submitted shows, gated by a new
+-- submitted_shows privacy field (visible | count_only | hidden). Existing
+-- rows get the default explicitly so the stored JSON stays complete.
+UPDATE users
+SET privacy_settings = privacy_settings || '{"submitted_shows":"visible"}'::jsonb
+WHERE privacy_settings IS NOT NULL
+  AND NOT jsonb_exists(privacy_settings, 'submitted_shows');
+
+ALTER TABLE users ALTER COLUMN privacy_settings SET DEFAULT '{"contributions":"visible","saved_shows":"hidden","following":"visible","collections":"visible","last_active":"visible","profile_sections":"visible","submitted_shows":"visible"}';
@@ -0,0 +1 @@
+DELETE FROM notification_preferences WHERE event_type = 'followed_artist_show';
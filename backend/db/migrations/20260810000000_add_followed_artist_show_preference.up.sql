@@ -0,0 +1,8 @@
+-- followed_artist_show/email defaults on. Users who turned off email for
+-- favorite-venue announcements, the closest existing event, keep that
+-- choice for followed artists too.
+INSERT INTO notification_preferences (user_id, event_type, channel, enabled)
+SELECT user_id, 'followed_artist_show', 'email', FALSE
+FROM notification_preferences
+WHERE event_type = 'favorite_venue_announcement' AND channel = 'email' AND enabled = FALSE
+ON CONFLICT (user_id, event_type, channel) DO NOTHING;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_venues_all_ages;
+
+ALTER TABLE venues
+    DROP COLUMN IF EXISTS door_time,
+    DROP COLUMN IF EXISTS all_ages,
+    DROP COLUMN IF EXISTS accessibility_notes;
@@ -0,0 +1,12 @@
+-- Venue operating info: accessibility notes, an all-ages flag and the typical
+-- door time. All nullable with no DEFAULT, so existing rows read as unknown and
+-- Postgres adds the columns without a table rewrite. door_time is local 24h
+-- "HH:MM".
+ALTER TABLE venues
+    ADD COLUMN accessibility_notes TEXT,
+    ADD COLUMN all_ages BOOLEAN,
+    ADD COLUMN door_time VARCHAR(5)
+        CONSTRAINT venues_door_time_format CHECK (door_time ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$');
+
+-- Supports the all_ages show-list filter.
+CREATE INDEX idx_venues_all_ages ON venues (all_ages) WHERE all_ages IS NOT NULL;
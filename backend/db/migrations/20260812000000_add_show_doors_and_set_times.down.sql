@@ -0,0 +1,5 @@
+ALTER TABLE show_artists
+    DROP COLUMN IF EXISTS set_time;
+
+ALTER TABLE shows
+    DROP COLUMN IF EXISTS doors_time;
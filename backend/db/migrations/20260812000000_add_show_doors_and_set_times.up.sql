@@ -0,0 +1,9 @@
+-- Show time fields: when doors open, and each artist's set time. Both are
+-- absolute instants (TIMESTAMPTZ, like shows.event_date), nullable with no
+-- DEFAULT so Postgres adds them without a table rewrite. A lineup with set
+-- times is ordered by them; rows without one fall back to position.
+ALTER TABLE shows
+    ADD COLUMN doors_time TIMESTAMPTZ;
+
+ALTER TABLE show_artists
+    ADD COLUMN set_time TIMESTAMPTZ;
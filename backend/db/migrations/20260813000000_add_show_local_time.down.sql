@@ -0,0 +1,6 @@
+ALTER TABLE shows
+    DROP COLUMN IF EXISTS event_timezone,
+    DROP COLUMN IF EXISTS event_local_time;
+
+ALTER TABLE venues
+    DROP COLUMN IF EXISTS timezone_manual;
@@ -0,0 +1,31 @@
+-- Venue-local event times.
+--
+-- venues.timezone_manual marks a timezone an admin set by hand, so
+-- re-geocoding on a relocation (or the timezone backfill) leaves it alone.
+-- NOT NULL DEFAULT FALSE is a metadata-only change, no table rewrite.
+--
+-- shows.event_local_time / event_timezone record the wall-clock time a show
+-- was listed at and the IANA zone that wall-clock was read in, so the time
+-- the submitter meant survives a later change to the venue's timezone.
+-- event_local_time is deliberately TIMESTAMP (no zone): it is a wall-clock
+-- reading, not an instant. Both nullable.
+ALTER TABLE venues
+    ADD COLUMN timezone_manual BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE shows
+    ADD COLUMN event_local_time TIMESTAMP,
+    ADD COLUMN event_timezone VARCHAR(64);
+
+-- Backfill from each show's primary (lowest-id) venue, the same venue the
+-- API renders local times in. Shows whose venue has no geocoded timezone stay
+-- NULL until they are next saved.
+UPDATE shows s
+SET event_timezone = pv.timezone,
+    event_local_time = s.event_date AT TIME ZONE pv.timezone
+FROM (
+    SELECT DISTINCT ON (sv.show_id) sv.show_id, v.timezone
+    FROM show_venues sv
+    JOIN venues v ON v.id = sv.venue_id
+    ORDER BY sv.show_id, sv.venue_id
+) pv
+WHERE pv.show_id = s.id AND pv.timezone IS NOT NULL;
@@ -0,0 +1,27 @@
+-- Structured show pricing.
+--
+-- shows.price (one nullable number) becomes a range with a currency and a
+-- free/donation flag:
+--   price_min / price_max  -- a single price sets only price_min
+--   price_currency         -- ISO 4217, USD for every existing row
+--   is_free                -- free or donation entry; price_min/price_max,
+--                             if set, are then the suggested donation
+--
+-- The rename keeps existing prices in place as price_min. A stored 0 was the
+-- only way to say "free", so those rows become is_free with no price.
+ALTER TABLE shows
+    RENAME COLUMN price TO price_min;
+
+ALTER TABLE shows
+    ADD COLUMN price_max DECIMAL(10, 2),
+    ADD COLUMN price_currency CHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN is_free BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE shows
+SET is_free = TRUE,
+    price_min = NULL
+WHERE price_min = 0;
+
+ALTER TABLE shows
+    ADD CONSTRAINT shows_price_range_check
+        CHECK (price_max IS NULL OR (price_min IS NOT NULL AND price_max >= price_min));
@@ -0,0 +1,16 @@
+-- artist_genres: genres inferred for an artist from import metadata.
+--
+-- The genre taxonomy is the existing tags table (category = 'genre', nested
+-- through parent_id). Community-applied genre tags stay in entity_tags, which
+-- requires an adding user; genres read off a discovery import have no user, so
+-- they land here instead, with the source that supplied them. Readers treat an
+-- artist's genres as the union of both.
+CREATE TABLE artist_genres (
+    artist_id BIGINT NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    tag_id BIGINT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    source VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (artist_id, tag_id)
+);
+
+CREATE INDEX idx_artist_genres_tag ON artist_genres(tag_id);
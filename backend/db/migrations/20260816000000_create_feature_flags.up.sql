@@ -0,0 +1,17 @@
+-- Runtime feature flags, toggled by admins without a redeploy.
+--
+-- A flag is on for everyone when enabled, and for admins alone when only
+-- enabled_for_admins is set (to try a behavior in production first).
+-- environments is a space-separated list (e.g. 'stage development'); when
+-- non-empty the flag is off everywhere else. Unknown keys read as off.
+CREATE TABLE feature_flags (
+    id SERIAL PRIMARY KEY,
+    key VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    enabled_for_admins BOOLEAN NOT NULL DEFAULT FALSE,
+    environments TEXT NOT NULL DEFAULT '',
+    updated_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
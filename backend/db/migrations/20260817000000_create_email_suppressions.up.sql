@@ -0,0 +1,12 @@
+-- Addresses no email is sent to: hard bounces and spam complaints reported
+-- by the email provider's webhook, plus any added by hand. email is stored
+-- lowercased. Sending skips suppressed recipients so repeated bounces don't
+-- damage the sending domain's reputation.
+CREATE TABLE email_suppressions (
+    id SERIAL PRIMARY KEY,
+    email VARCHAR(320) NOT NULL UNIQUE,
+    reason VARCHAR(20) NOT NULL,
+    provider VARCHAR(20) NOT NULL DEFAULT '',
+    detail TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
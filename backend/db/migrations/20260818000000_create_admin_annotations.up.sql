@@ -0,0 +1,23 @@
+-- Internal admin annotations on catalog entities: free-form notes and colored
+-- tags (e.g. "needs flyer", "unconfirmed date"). Never exposed outside admin
+-- endpoints.
+--
+-- Polymorphic like revisions/audit_logs: (entity_type, entity_id) points at a
+-- show, venue, artist, release, label or festival. kind is 'note' (body is the
+-- note text) or 'tag' (body is the label, color its #rrggbb color). A tag
+-- label is unique per entity, case-insensitively.
+CREATE TABLE admin_annotations (
+    id SERIAL PRIMARY KEY,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id INTEGER NOT NULL,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('note', 'tag')),
+    body TEXT NOT NULL,
+    color VARCHAR(7),
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_admin_annotations_entity ON admin_annotations(entity_type, entity_id);
+CREATE UNIQUE INDEX idx_admin_annotations_entity_tag ON admin_annotations(entity_type, entity_id, LOWER(body)) WHERE kind = 'tag';
+CREATE INDEX idx_admin_annotations_tag ON admin_annotations(LOWER(body), entity_type) WHERE kind = 'tag';
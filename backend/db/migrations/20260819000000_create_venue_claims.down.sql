@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS venue_managers;
+DROP TABLE IF EXISTS venue_claims;
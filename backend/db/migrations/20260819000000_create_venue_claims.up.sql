@@ -0,0 +1,34 @@
+-- Venue claims: venue staff ask to manage their venue's page. Evidence is a
+-- free-form note for the reviewer (role, work email, link to staff page). A
+-- user may have one pending claim per venue; approved and denied claims stay
+-- as history.
+CREATE TABLE venue_claims (
+    id SERIAL PRIMARY KEY,
+    venue_id INTEGER NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    evidence TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied')),
+    reviewed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_venue_claims_status ON venue_claims(status, created_at);
+CREATE INDEX idx_venue_claims_user ON venue_claims(user_id);
+CREATE UNIQUE INDEX idx_venue_claims_pending ON venue_claims(venue_id, user_id) WHERE status = 'pending';
+
+-- Venue managers may edit their venue's details and flag its shows sold out
+-- or cancelled without admin rights. Rows are created by approving a claim
+-- (claim_id) and removed by an admin.
+CREATE TABLE venue_managers (
+    venue_id INTEGER NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    claim_id INTEGER REFERENCES venue_claims(id) ON DELETE SET NULL,
+    granted_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (venue_id, user_id)
+);
+
+CREATE INDEX idx_venue_managers_user ON venue_managers(user_id);
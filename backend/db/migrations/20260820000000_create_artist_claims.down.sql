@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS artist_managers;
+DROP TABLE IF EXISTS artist_claims;
+ALTER TABLE artists DROP COLUMN IF EXISTS verified;
@@ -0,0 +1,33 @@
+-- Artist claims: an artist (or their team) asks to manage the artist's page.
+-- Mirrors venue_claims. Approving a claim creates an artist_managers row and
+-- marks the artist verified; managers may edit the artist's bio and social
+-- links without admin rights.
+ALTER TABLE artists ADD COLUMN verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE artist_claims (
+    id SERIAL PRIMARY KEY,
+    artist_id INTEGER NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    evidence TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied')),
+    reviewed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_artist_claims_status ON artist_claims(status, created_at);
+CREATE INDEX idx_artist_claims_user ON artist_claims(user_id);
+CREATE UNIQUE INDEX idx_artist_claims_pending ON artist_claims(artist_id, user_id) WHERE status = 'pending';
+
+CREATE TABLE artist_managers (
+    artist_id INTEGER NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    claim_id INTEGER REFERENCES artist_claims(id) ON DELETE SET NULL,
+    granted_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (artist_id, user_id)
+);
+
+CREATE INDEX idx_artist_managers_user ON artist_managers(user_id);
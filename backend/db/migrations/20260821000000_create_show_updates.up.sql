@@ -0,0 +1,15 @@
+-- Show updates: short notes posted on a show page by its submitter, a manager
+-- of one of its venues, or an admin ("X dropped off the bill", "moved to
+-- 8pm"). Listed oldest first alongside the show; reportable through
+-- entity_reports (entity_type 'show_update') and deletable by the author or
+-- a moderator.
+CREATE TABLE show_updates (
+    id SERIAL PRIMARY KEY,
+    show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_show_updates_show ON show_updates(show_id, created_at);
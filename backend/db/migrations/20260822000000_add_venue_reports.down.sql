@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS venue_reports;
+DROP TYPE IF EXISTS venue_report_type;
@@ -0,0 +1,25 @@
+-- Create venue report type enum
+CREATE TYPE venue_report_type AS ENUM ('inaccurate', 'closed', 'removal_request');
+
+-- Create venue_reports table (reuses existing show_report_status enum)
+CREATE TABLE venue_reports (
+    id SERIAL PRIMARY KEY,
+    venue_id INTEGER NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    reported_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    report_type venue_report_type NOT NULL,
+    details TEXT,
+    status show_report_status NOT NULL DEFAULT 'pending',
+    admin_notes TEXT,
+    reviewed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- One report per user per venue
+    UNIQUE(venue_id, reported_by)
+);
+
+-- Indexes for common queries
+CREATE INDEX idx_venue_reports_status ON venue_reports(status);
+CREATE INDEX idx_venue_reports_venue_id ON venue_reports(venue_id);
+CREATE INDEX idx_venue_reports_created_at ON venue_reports(created_at DESC);
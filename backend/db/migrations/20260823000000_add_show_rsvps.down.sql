@@ -0,0 +1,10 @@
+COMMENT ON COLUMN user_bookmarks.action IS 'Action type: save, follow, bookmark';
+
+DELETE FROM user_bookmarks WHERE entity_type = 'show' AND action = 'going';
+
+ALTER TABLE users ALTER COLUMN privacy_settings SET DEFAULT '{"contributions":"visible","saved_shows":"hidden","following":"visible","collections":"visible","last_active":"visible","profile_sections":"visible","submitted_shows":"visible"}';
+
+UPDATE users
+SET privacy_settings = privacy_settings - 'rsvps'
+WHERE privacy_settings IS NOT NULL
+  AND jsonb_exists(privacy_settings, 'rsvps');
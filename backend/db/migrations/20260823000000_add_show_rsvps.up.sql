@@ -0,0 +1,12 @@
+-- Show RSVPs ("I'm going") are user_bookmarks rows with action 'going',
+-- separate from saves. Whether a user's RSVPs are listed by name on the show
+-- is gated by a new rsvps privacy field (visible | hidden), hidden by default.
+-- Existing rows get the default explicitly so the stored JSON stays complete.
+UPDATE users
+SET privacy_settings = privacy_settings || '{"rsvps":"hidden"}'::jsonb
+WHERE privacy_settings IS NOT NULL
+  AND NOT jsonb_exists(privacy_settings, 'rsvps');
+
+ALTER TABLE users ALTER COLUMN privacy_settings SET DEFAULT '{"contributions":"visible","saved_shows":"hidden","following":"visible","collections":"visible","last_active":"visible","profile_sections":"visible","submitted_shows":"visible","rsvps":"hidden"}';
+
+COMMENT ON COLUMN user_bookmarks.action IS 'Action type: save, follow, bookmark, going';
@@ -0,0 +1,19 @@
+-- Signed receipts for permanently deleted accounts, kept for compliance
+-- requests. user_id deliberately has no foreign key (the user row is deleted
+-- in the same transaction) and the email is stored only as a SHA-256 hash.
+-- payload is the exact JSON that was signed (json, not jsonb, so Postgres
+-- keeps the bytes as written); signature is its HMAC-SHA256 under the key
+-- identified by key_id (both empty when no signing key was configured).
+CREATE TABLE deletion_receipts (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    email_hash VARCHAR(64),
+    payload JSON NOT NULL,
+    signature TEXT NOT NULL,
+    key_id VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_deletion_receipts_user ON deletion_receipts(user_id);
+CREATE INDEX idx_deletion_receipts_email_hash ON deletion_receipts(email_hash);
+CREATE INDEX idx_deletion_receipts_created_at ON deletion_receipts(created_at DESC);
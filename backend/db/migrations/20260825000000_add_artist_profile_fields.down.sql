@@ -0,0 +1,4 @@
+ALTER TABLE artists
+    DROP COLUMN IF EXISTS pronouns,
+    DROP COLUMN IF EXISTS hometown,
+    DROP COLUMN IF EXISTS bio;
@@ -0,0 +1,13 @@
+-- Artist profile fields.
+--
+-- bio is the artist's own markdown profile text, written by admins and the
+-- artist's managers; the API cleans it on write and renders it to sanitized
+-- HTML on read. description stays the short catalog blurb.
+-- hometown is free text ("Grew up in Flagstaff, AZ"), distinct from the
+-- structured city/state/country the scene rollups key on.
+-- pronouns is free text too ("she/her", "they/them").
+-- All nullable, so adding them is a metadata-only change.
+ALTER TABLE artists
+    ADD COLUMN bio TEXT,
+    ADD COLUMN hometown VARCHAR(255),
+    ADD COLUMN pronouns VARCHAR(64);
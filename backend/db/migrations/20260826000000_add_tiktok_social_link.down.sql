@@ -0,0 +1,3 @@
+ALTER TABLE labels DROP COLUMN IF EXISTS tiktok;
+ALTER TABLE venues DROP COLUMN IF EXISTS tiktok;
+ALTER TABLE artists DROP COLUMN IF EXISTS tiktok;
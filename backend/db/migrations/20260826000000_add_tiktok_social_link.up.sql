@@ -0,0 +1,6 @@
+-- TikTok joins the social links embedded in artists, venues and labels
+-- (catalogm.Social). Stored as a full profile URL, like the other links.
+-- Nullable, so adding it is a metadata-only change.
+ALTER TABLE artists ADD COLUMN tiktok VARCHAR(255);
+ALTER TABLE venues ADD COLUMN tiktok VARCHAR(255);
+ALTER TABLE labels ADD COLUMN tiktok VARCHAR(255);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_shows_rescheduled_from_show_id;
+ALTER TABLE shows DROP COLUMN IF EXISTS rescheduled_from_show_id;
+ALTER TABLE shows DROP COLUMN IF EXISTS rescheduled_show_id;
+ALTER TABLE shows DROP COLUMN IF EXISTS original_date;
+ALTER TABLE shows DROP COLUMN IF EXISTS status_reason;
+ALTER TABLE shows DROP COLUMN IF EXISTS is_postponed;
@@ -0,0 +1,17 @@
+-- Cancellation and postponement. status_reason explains the current
+-- is_cancelled / is_postponed flag. Postponing to a new date creates a new
+-- show for that date: the postponed show points forward to it via
+-- rescheduled_show_id, and the new show points back via
+-- rescheduled_from_show_id and keeps the date it was first billed for in
+-- original_date. Deleting either side of the link just unlinks the other.
+--
+-- ADDITIVE: nullable/defaulted columns only.
+
+ALTER TABLE shows ADD COLUMN is_postponed BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE shows ADD COLUMN status_reason TEXT;
+ALTER TABLE shows ADD COLUMN original_date TIMESTAMPTZ;
+ALTER TABLE shows ADD COLUMN rescheduled_show_id INTEGER REFERENCES shows(id) ON DELETE SET NULL;
+ALTER TABLE shows ADD COLUMN rescheduled_from_show_id INTEGER REFERENCES shows(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_shows_rescheduled_from_show_id ON shows (rescheduled_from_show_id)
+    WHERE rescheduled_from_show_id IS NOT NULL;
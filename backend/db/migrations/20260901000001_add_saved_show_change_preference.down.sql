@@ -0,0 +1 @@
+DELETE FROM notification_preferences WHERE event_type = 'saved_show_change';
@@ -0,0 +1,8 @@
+-- saved_show_change (a saved or RSVP'd show was cancelled or postponed)
+-- defaults to email on, push off. Users who opted in to saved-show reminder
+-- pushes, the closest existing event, get these pushes too.
+INSERT INTO notification_preferences (user_id, event_type, channel, enabled)
+SELECT user_id, 'saved_show_change', 'push', TRUE
+FROM notification_preferences
+WHERE event_type = 'saved_show_reminder' AND channel = 'push' AND enabled = TRUE
+ON CONFLICT (user_id, event_type, channel) DO NOTHING;
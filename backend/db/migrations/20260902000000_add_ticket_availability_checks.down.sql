@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS show_ticket_status_changes;
+ALTER TABLE shows DROP COLUMN IF EXISTS ticket_last_checked_at;
@@ -0,0 +1,21 @@
+-- Ticket availability checks. The ticket availability job polls the ticket
+-- pages of upcoming shows sold through a known provider and keeps
+-- is_sold_out in step with them; ticket_last_checked_at is when it last
+-- looked. show_ticket_status_changes records every change to is_sold_out,
+-- whether made by the job ('ticket_check', with the provider it read) or by
+-- hand ('manual').
+--
+-- ADDITIVE: one nullable column and a new table.
+
+ALTER TABLE shows ADD COLUMN ticket_last_checked_at TIMESTAMPTZ;
+
+CREATE TABLE show_ticket_status_changes (
+    id SERIAL PRIMARY KEY,
+    show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+    is_sold_out BOOLEAN NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    provider VARCHAR(32),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_show_ticket_status_changes_show ON show_ticket_status_changes(show_id, created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS show_short_link_referrers;
+DROP INDEX IF EXISTS idx_shows_short_code;
+ALTER TABLE shows DROP COLUMN IF EXISTS short_code;
@@ -0,0 +1,36 @@
+-- Show short links. Every approved show gets a short_code, served as
+-- /s/{code} and redirected to the show page. Codes are a bijective scramble
+-- of the show ID (see catalog.ShowShortCode), so they are unique by
+-- construction; the backfill below computes the same codes for shows that
+-- were approved before this migration. show_short_link_referrers counts
+-- click-throughs per show and referring site.
+--
+-- ADDITIVE: one nullable column, a backfill and a new table.
+
+ALTER TABLE shows ADD COLUMN short_code VARCHAR(12);
+
+CREATE UNIQUE INDEX idx_shows_short_code ON shows(short_code) WHERE short_code IS NOT NULL;
+
+-- Base-36 digits of (id * 1580030173) mod 36^7, most significant first.
+UPDATE shows SET short_code = codes.code
+FROM (
+    SELECT s.id,
+           string_agg(
+               substr('0123456789abcdefghijklmnopqrstuvwxyz',
+                      (((s.id::bigint * 1580030173) % 78364164096) / power(36, d.place)::bigint % 36)::int + 1,
+                      1),
+               '' ORDER BY d.place DESC) AS code
+    FROM shows s
+    CROSS JOIN generate_series(0, 6) AS d(place)
+    WHERE s.status = 'approved'
+    GROUP BY s.id
+) codes
+WHERE shows.id = codes.id;
+
+CREATE TABLE show_short_link_referrers (
+    show_id INTEGER NOT NULL REFERENCES shows(id) ON DELETE CASCADE,
+    referrer VARCHAR(255) NOT NULL,
+    clicks BIGINT NOT NULL DEFAULT 0,
+    last_clicked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (show_id, referrer)
+);
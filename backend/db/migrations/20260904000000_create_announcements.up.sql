@@ -0,0 +1,32 @@
+-- Site-wide announcement banners, managed by admins.
+--
+-- A banner shows from starts_at until ends_at (NULL: until an admin expires
+-- it). severity is 'info', 'warning' or 'critical'. When dismissible, a
+-- signed-in user who closes it gets a row in announcement_dismissals and is
+-- not shown it again; non-dismissible banners ignore dismissals.
+--
+-- ADDITIVE: two brand-new tables.
+CREATE TABLE announcements (
+    id SERIAL PRIMARY KEY,
+    message TEXT NOT NULL,
+    severity VARCHAR(20) NOT NULL DEFAULT 'info' CHECK (severity IN ('info', 'warning', 'critical')),
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ends_at TIMESTAMP WITH TIME ZONE,
+    dismissible BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    updated_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (ends_at IS NULL OR ends_at >= starts_at)
+);
+
+CREATE INDEX idx_announcements_window ON announcements(starts_at, ends_at);
+
+CREATE TABLE announcement_dismissals (
+    announcement_id INTEGER NOT NULL REFERENCES announcements(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    dismissed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (announcement_id, user_id)
+);
+
+CREATE INDEX idx_announcement_dismissals_user ON announcement_dismissals(user_id);
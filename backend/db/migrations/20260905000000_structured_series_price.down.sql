@@ -0,0 +1,14 @@
+-- Collapse the range back to one price: the lowest, with free series as 0.
+-- price_max and price_currency are lost.
+UPDATE show_series
+SET price_min = 0
+WHERE is_free AND price_min IS NULL;
+
+ALTER TABLE show_series
+    DROP CONSTRAINT IF EXISTS show_series_price_range_check,
+    DROP COLUMN IF EXISTS is_free,
+    DROP COLUMN IF EXISTS price_currency,
+    DROP COLUMN IF EXISTS price_max;
+
+ALTER TABLE show_series
+    RENAME COLUMN price_min TO price;
@@ -0,0 +1,23 @@
+-- Structured show series pricing, matching shows (see
+-- 20260814000000_structured_show_price): show_series.price becomes
+-- price_min / price_max / price_currency / is_free, and generated occurrences
+-- copy all four.
+--
+-- A stored 0 was the only way to say "free", so those rows become is_free
+-- with no price.
+ALTER TABLE show_series
+    RENAME COLUMN price TO price_min;
+
+ALTER TABLE show_series
+    ADD COLUMN price_max DECIMAL(10, 2),
+    ADD COLUMN price_currency CHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN is_free BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE show_series
+SET is_free = TRUE,
+    price_min = NULL
+WHERE price_min = 0;
+
+ALTER TABLE show_series
+    ADD CONSTRAINT show_series_price_range_check
+        CHECK (price_max IS NULL OR (price_min IS NOT NULL AND price_max >= price_min));
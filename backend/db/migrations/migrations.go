@@ -0,0 +1,12 @@
+// Package migrations embeds the SQL migration files so the server binary and
+// the test suites can apply them without a checkout of this directory. The
+// files stay in golang-migrate's {version}_{name}.{up|down}.sql layout, which
+// the migrate container reads from the same directory.
+package migrations
+
+import "embed"
+
+// FS holds every *.sql file in this directory.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,350 @@
+// Package migrator applies the SQL migrations in db/migrations.
+//
+// It keeps golang-migrate's bookkeeping, a one-row schema_migrations
+// (version, dirty) table, so the server's -migrate flag and the migrate
+// container can be used on the same database. Like golang-migrate, each file
+// is sent as a single multi-statement query (Postgres runs it as one implicit
+// transaction), and the version is marked dirty while a file runs. A file
+// that fails leaves the database dirty at that version until someone fixes
+// the schema by hand and calls Force.
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NilVersion is the version of a database with no migrations applied.
+const NilVersion int64 = -1
+
+// lockKey is the pg_advisory_lock key held while migrating, so two servers
+// started with -migrate up don't race. It is not golang-migrate's key; don't
+// run the migrate container and -migrate against one database at once.
+const lockKey int64 = 7_386_010_946
+
+var fileRe = regexp.MustCompile(`^([0-9]+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is one version's pair of files.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// DirtyError reports a database left part-way through a migration.
+type DirtyError struct {
+	Version int64
+}
+
+func (e *DirtyError) Error() string {
+	return fmt.Sprintf("database is dirty at version %d: that migration failed part-way; "+
+		"fix the schema by hand, then force the version it is now at", e.Version)
+}
+
+// Options configures a Migrator.
+type Options struct {
+	// StripConcurrently drops CONCURRENTLY from CREATE INDEX statements. The
+	// test suites use it; production keeps concurrent index builds.
+	StripConcurrently bool
+	// Logf, if set, is called once per migration applied or rolled back.
+	Logf func(format string, args ...any)
+}
+
+// Migrator applies migrations to one database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	opts       Options
+}
+
+// Status describes where a database is relative to the migration files.
+type Status struct {
+	Version int64 // NilVersion when nothing has been applied
+	Dirty   bool
+	Latest  int64 // highest version among the files
+	Pending []Migration
+}
+
+// Load reads the migration files at the root of fsys, sorted by version.
+// Every version needs an up file; down files are optional.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		match := fileRe.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: bad version: %w", entry.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %q and %q", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// New loads the migrations in fsys for db.
+func New(db *sql.DB, fsys fs.FS, opts Options) (*Migrator, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database connection is nil")
+	}
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) == 0 {
+		return nil, fmt.Errorf("no migrations found")
+	}
+	return &Migrator{db: db, migrations: migrations, opts: opts}, nil
+}
+
+// Migrations returns the loaded migrations, oldest first.
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Status reports the current version and the migrations not yet applied.
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	var status *Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		version, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		status = &Status{
+			Version: version,
+			Dirty:   dirty,
+			Latest:  m.migrations[len(m.migrations)-1].Version,
+		}
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				status.Pending = append(status.Pending, mig)
+			}
+		}
+		return nil
+	})
+	return status, err
+}
+
+// Up applies every pending migration and returns how many ran.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		version, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return &DirtyError{Version: version}
+		}
+		if version != NilVersion && m.indexOf(version) < 0 {
+			return fmt.Errorf("database is at version %d, which has no migration file", version)
+		}
+
+		for _, mig := range m.migrations {
+			if mig.Version <= version {
+				continue
+			}
+			if err := m.run(ctx, conn, mig.Version, mig.Up); err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", mig.Version, mig.Name, err)
+			}
+			applied++
+			m.logf("applied %d_%s", mig.Version, mig.Name)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the last steps migrations and returns how many ran.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps < 1 {
+		return 0, fmt.Errorf("steps must be at least 1")
+	}
+
+	rolledBack := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		version, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return &DirtyError{Version: version}
+		}
+		if version == NilVersion {
+			return nil
+		}
+		idx := m.indexOf(version)
+		if idx < 0 {
+			return fmt.Errorf("database is at version %d, which has no migration file", version)
+		}
+
+		for ; idx >= 0 && rolledBack < steps; idx-- {
+			mig := m.migrations[idx]
+			if mig.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down file", mig.Version, mig.Name)
+			}
+			target := NilVersion
+			if idx > 0 {
+				target = m.migrations[idx-1].Version
+			}
+			if err := m.run(ctx, conn, target, mig.Down); err != nil {
+				return fmt.Errorf("migration %d_%s down: %w", mig.Version, mig.Name, err)
+			}
+			rolledBack++
+			m.logf("rolled back %d_%s", mig.Version, mig.Name)
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Force records version as applied and clears the dirty flag without running
+// anything. It is the recovery step after fixing a failed migration by hand:
+// force the version whose changes are now fully in place (or the one before
+// it, to have Up retry the file). NilVersion empties the table.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version != NilVersion && m.indexOf(version) < 0 {
+		return fmt.Errorf("no migration file has version %d", version)
+	}
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := setVersion(ctx, conn, version, false); err != nil {
+			return err
+		}
+		m.logf("forced version %d", version)
+		return nil
+	})
+}
+
+// run marks target dirty, executes body, then marks target clean. This is
+// the order golang-migrate uses, so a failure leaves the same state.
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, target int64, body string) error {
+	if err := setVersion(ctx, conn, target, true); err != nil {
+		return err
+	}
+	if m.opts.StripConcurrently {
+		body = strings.ReplaceAll(body, "CONCURRENTLY ", "")
+	}
+	if strings.TrimSpace(body) != "" {
+		if _, err := conn.ExecContext(ctx, body); err != nil {
+			return err
+		}
+	}
+	return setVersion(ctx, conn, target, false)
+}
+
+func (m *Migrator) indexOf(version int64) int {
+	i := sort.Search(len(m.migrations), func(i int) bool { return m.migrations[i].Version >= version })
+	if i < len(m.migrations) && m.migrations[i].Version == version {
+		return i
+	}
+	return -1
+}
+
+func (m *Migrator) logf(format string, args ...any) {
+	if m.opts.Logf != nil {
+		m.opts.Logf(format, args...)
+	}
+}
+
+// withLock runs fn on one connection holding the migration advisory lock,
+// after making sure schema_migrations exists.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Unlock with a fresh context so a cancelled ctx doesn't leave the
+		// session holding the lock on a pooled connection.
+		if _, unlockErr := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey); unlockErr != nil && err == nil {
+			err = fmt.Errorf("release migration lock: %w", unlockErr)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx,
+		`CREATE TABLE IF NOT EXISTS schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)`); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+func readVersion(ctx context.Context, conn *sql.Conn) (int64, bool, error) {
+	var version int64
+	var dirty bool
+	err := conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NilVersion, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	return version, dirty, nil
+}
+
+// setVersion replaces the schema_migrations row. An empty table means
+// NilVersion; a dirty NilVersion is stored as -1, as golang-migrate does.
+func setVersion(ctx context.Context, conn *sql.Conn, version int64, dirty bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin version update: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after Commit
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations"); err != nil {
+		return fmt.Errorf("clear schema_migrations: %w", err)
+	}
+	if version != NilVersion || dirty {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)", version, dirty); err != nil {
+			return fmt.Errorf("write schema_migrations: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit version update: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package migrator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"psychic-homily-backend/db/migrations"
+	"psychic-homily-backend/db/migrator"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestMigrator_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestPostgres(t)
+	defer testDB.Cleanup()
+	sqlDB, err := testDB.DB.DB()
+	if err != nil {
+		t.Fatalf("get sql.DB: %v", err)
+	}
+	m, err := migrator.New(sqlDB, migrations.FS, migrator.Options{StripConcurrently: true})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	ctx := context.Background()
+	all := m.Migrations()
+	latest := all[len(all)-1].Version
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if status.Version != latest || status.Dirty || len(status.Pending) != 0 {
+		t.Fatalf("fresh database status = %+v, want version %d clean with nothing pending", status, latest)
+	}
+
+	t.Run("down and up again", func(t *testing.T) {
+		if n, err := m.Down(ctx, 1); err != nil || n != 1 {
+			t.Fatalf("Down(1) = %d, %v", n, err)
+		}
+		status, err := m.Status(ctx)
+		if err != nil {
+			t.Fatalf("Status: %v", err)
+		}
+		if status.Version != all[len(all)-2].Version || len(status.Pending) != 1 {
+			t.Fatalf("after Down(1) status = %+v", status)
+		}
+		if n, err := m.Up(ctx); err != nil || n != 1 {
+			t.Fatalf("Up = %d, %v", n, err)
+		}
+	})
+
+	t.Run("dirty database is refused until forced", func(t *testing.T) {
+		if _, err := sqlDB.Exec("UPDATE schema_migrations SET dirty = true"); err != nil {
+			t.Fatalf("mark dirty: %v", err)
+		}
+		_, err := m.Up(ctx)
+		var dirty *migrator.DirtyError
+		if !errors.As(err, &dirty) || dirty.Version != latest {
+			t.Fatalf("Up on dirty database = %v, want DirtyError at %d", err, latest)
+		}
+
+		if err := m.Force(ctx, latest); err != nil {
+			t.Fatalf("Force: %v", err)
+		}
+		status, err := m.Status(ctx)
+		if err != nil {
+			t.Fatalf("Status: %v", err)
+		}
+		if status.Dirty || status.Version != latest {
+			t.Fatalf("after Force status = %+v", status)
+		}
+	})
+
+	t.Run("force rejects unknown version", func(t *testing.T) {
+		if err := m.Force(ctx, latest+1); err == nil {
+			t.Fatal("expected error for version with no file")
+		}
+	})
+}
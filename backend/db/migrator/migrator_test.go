@@ -0,0 +1,70 @@
+package migrator
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad_SortsByVersionAndPairsFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"20260102000000_add_venues.up.sql":   {Data: []byte("CREATE TABLE venues ();")},
+		"20260102000000_add_venues.down.sql": {Data: []byte("DROP TABLE venues;")},
+		"20260101000000_add_shows.up.sql":    {Data: []byte("CREATE TABLE shows ();")},
+		"README.md":                          {Data: []byte("not a migration")},
+		"migrations.go":                      {Data: []byte("package migrations")},
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(migrations) != 2 {
+		t.Fatalf("got %d migrations, want 2", len(migrations))
+	}
+	if migrations[0].Version != 20260101000000 || migrations[0].Name != "add_shows" {
+		t.Errorf("first migration = %d_%s", migrations[0].Version, migrations[0].Name)
+	}
+	if migrations[0].Down != "" {
+		t.Errorf("add_shows has no down file, got %q", migrations[0].Down)
+	}
+	if migrations[1].Up != "CREATE TABLE venues ();" || migrations[1].Down != "DROP TABLE venues;" {
+		t.Errorf("add_venues files not paired: %+v", migrations[1])
+	}
+}
+
+func TestLoad_RejectsDuplicateVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"1_add_shows.up.sql":  {Data: []byte("SELECT 1;")},
+		"1_add_venues.up.sql": {Data: []byte("SELECT 1;")},
+	}
+	_, err := Load(fsys)
+	if err == nil || !strings.Contains(err.Error(), "used by both") {
+		t.Fatalf("expected duplicate version error, got %v", err)
+	}
+}
+
+func TestLoad_RejectsMissingUpFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"1_add_shows.down.sql": {Data: []byte("SELECT 1;")},
+	}
+	_, err := Load(fsys)
+	if err == nil || !strings.Contains(err.Error(), "no up file") {
+		t.Fatalf("expected missing up file error, got %v", err)
+	}
+}
+
+func TestNew_RequiresMigrations(t *testing.T) {
+	if _, err := New(nil, fstest.MapFS{}, Options{}); err == nil {
+		t.Fatal("expected error for nil db")
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	m := &Migrator{migrations: []Migration{{Version: 1}, {Version: 5}, {Version: 9}}}
+	for version, want := range map[int64]int{1: 0, 5: 1, 9: 2, 4: -1, 10: -1, NilVersion: -1} {
+		if got := m.indexOf(version); got != want {
+			t.Errorf("indexOf(%d) = %d, want %d", version, got, want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
+)
+
+// QueryDuration records how long each GORM statement takes, keyed by
+// operation (create, query, update, delete, row, raw). It is registered with
+// the default Prometheus registry and served on /metrics.
+var QueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "db_query_duration_seconds",
+	Help:    "Duration of database statements, by GORM operation.",
+	Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+}, []string{"operation"})
+
+func init() {
+	prometheus.MustRegister(QueryDuration)
+}
+
+// queryStartKey is the statement instance key holding the start time.
+const queryStartKey = "db_metrics:start"
+
+// queryMetricsPlugin times every GORM statement into QueryDuration.
+type queryMetricsPlugin struct{}
+
+func (queryMetricsPlugin) Name() string { return "db_query_metrics" }
+
+func (queryMetricsPlugin) Initialize(gdb *gorm.DB) error {
+	cb := gdb.Callback()
+	const before, after = "db_metrics:before", "db_metrics:after"
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register(before, startQueryTimer),
+		cb.Create().After("gorm:create").Register(after, observeQuery("create")),
+		cb.Query().Before("gorm:query").Register(before, startQueryTimer),
+		cb.Query().After("gorm:query").Register(after, observeQuery("query")),
+		cb.Update().Before("gorm:update").Register(before, startQueryTimer),
+		cb.Update().After("gorm:update").Register(after, observeQuery("update")),
+		cb.Delete().Before("gorm:delete").Register(before, startQueryTimer),
+		cb.Delete().After("gorm:delete").Register(after, observeQuery("delete")),
+		cb.Row().Before("gorm:row").Register(before, startQueryTimer),
+		cb.Row().After("gorm:row").Register(after, observeQuery("row")),
+		cb.Raw().Before("gorm:raw").Register(before, startQueryTimer),
+		cb.Raw().After("gorm:raw").Register(after, observeQuery("raw")),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startQueryTimer(tx *gorm.DB) {
+	tx.InstanceSet(queryStartKey, time.Now())
+}
+
+func observeQuery(operation string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		v, ok := tx.InstanceGet(queryStartKey)
+		if !ok || errors.Is(tx.Error, ErrUnavailable) {
+			// Failed fast by the availability breaker; nothing reached Postgres.
+			return
+		}
+		if start, ok := v.(time.Time); ok {
+			QueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// histogramCount returns how many statements QueryDuration has observed for
+// operation.
+func histogramCount(t *testing.T, operation string) uint64 {
+	t.Helper()
+	var m dto.Metric
+	require.NoError(t, QueryDuration.WithLabelValues(operation).(interface{ Write(*dto.Metric) error }).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestQueryMetricsPlugin(t *testing.T) {
+	gdb := openUnreachableDB(t)
+	require.NoError(t, gdb.Use(queryMetricsPlugin{}))
+	t.Cleanup(func() { setAvailable(true, nil) })
+
+	setAvailable(true, nil)
+	before := histogramCount(t, "raw")
+	_ = gdb.Exec("SELECT 1").Error
+	assert.Equal(t, before+1, histogramCount(t, "raw"), "statements that reach the driver are timed, even when they fail")
+
+	setAvailable(false, nil)
+	assert.ErrorIs(t, gdb.Exec("SELECT 1").Error, ErrUnavailable)
+	assert.Equal(t, before+1, histogramCount(t, "raw"), "statements failed fast by the breaker are not timed")
+}
@@ -0,0 +1,285 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// AdminAnnotationHandler handles internal admin notes and tags on entities
+type AdminAnnotationHandler struct {
+	annotationService contracts.AdminAnnotationServiceInterface
+	auditLogService   contracts.AuditLogServiceInterface
+}
+
+// NewAdminAnnotationHandler creates a new admin annotation handler
+func NewAdminAnnotationHandler(
+	annotationService contracts.AdminAnnotationServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *AdminAnnotationHandler {
+	return &AdminAnnotationHandler{
+		annotationService: annotationService,
+		auditLogService:   auditLogService,
+	}
+}
+
+// annotationError maps a service error to an HTTP error, logging the ones
+// that aren't the caller's fault.
+func annotationError(ctx context.Context, event, action string, err error, attrs ...any) error {
+	if mapped := shared.MapAdminAnnotationError(err); mapped != nil {
+		return mapped
+	}
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Error(event,
+		append(attrs, "error", err.Error(), "request_id", requestID)...,
+	)
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to %s (request_id: %s)", action, requestID),
+	)
+}
+
+// logAnnotationChange records an annotation change in the audit log (fire
+// and forget).
+func (h *AdminAnnotationHandler) logAnnotationChange(userID uint, action, entityType string, entityID uint, metadata map[string]interface{}) {
+	if h.auditLogService != nil {
+		h.auditLogService.LogAction(userID, action, entityType, entityID, metadata)
+	}
+}
+
+// annotationSummaries returns the admin tags and note counts for an admin
+// list page, keyed by entity ID. A failed lookup is logged and yields no
+// summaries rather than failing the list.
+func annotationSummaries(ctx context.Context, svc contracts.AdminAnnotationServiceInterface, entityType string, ids []uint) map[uint]*contracts.AdminAnnotationSummary {
+	if svc == nil || len(ids) == 0 {
+		return map[uint]*contracts.AdminAnnotationSummary{}
+	}
+	summaries, err := svc.GetSummaries(entityType, ids)
+	if err != nil {
+		logger.FromContext(ctx).Warn("admin_annotation_summaries_failed",
+			"entity_type", entityType,
+			"error", err.Error(),
+			"request_id", logger.GetRequestID(ctx),
+		)
+		return map[uint]*contracts.AdminAnnotationSummary{}
+	}
+	return summaries
+}
+
+// GetAnnotationsRequest represents the request for an entity's annotations
+type GetAnnotationsRequest struct {
+	EntityType string `path:"entity_type" doc:"Entity type (show, venue, artist, release, label, festival)" example:"show"`
+	EntityID   string `path:"entity_id" doc:"Entity ID" example:"42"`
+}
+
+// GetAnnotationsResponse represents an entity's notes and tags
+type GetAnnotationsResponse struct {
+	Body *contracts.AdminAnnotationsResponse
+}
+
+// GetAnnotationsHandler handles GET /admin/annotations/{entity_type}/{entity_id}
+func (h *AdminAnnotationHandler) GetAnnotationsHandler(ctx context.Context, req *GetAnnotationsRequest) (*GetAnnotationsResponse, error) {
+	entityID, err := strconv.ParseUint(req.EntityID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid entity ID")
+	}
+
+	annotations, err := h.annotationService.GetAnnotations(req.EntityType, uint(entityID))
+	if err != nil {
+		return nil, annotationError(ctx, "get_admin_annotations_failed", "get annotations", err,
+			"entity_type", req.EntityType, "entity_id", entityID)
+	}
+	return &GetAnnotationsResponse{Body: annotations}, nil
+}
+
+// AddAdminNoteRequest represents the request for adding a note
+type AddAdminNoteRequest struct {
+	EntityType string `path:"entity_type" doc:"Entity type (show, venue, artist, release, label, festival)" example:"show"`
+	EntityID   string `path:"entity_id" doc:"Entity ID" example:"42"`
+	Body       struct {
+		Body string `json:"body" doc:"Note text (at most 5000 characters)" example:"Promoter says the date may move to Saturday"`
+	}
+}
+
+// AdminNoteResponse represents a single note response
+type AdminNoteResponse struct {
+	Body *contracts.AdminNoteResponse
+}
+
+// AddAdminNoteHandler handles POST /admin/annotations/{entity_type}/{entity_id}/notes
+func (h *AdminAnnotationHandler) AddAdminNoteHandler(ctx context.Context, req *AddAdminNoteRequest) (*AdminNoteResponse, error) {
+	entityID, err := strconv.ParseUint(req.EntityID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid entity ID")
+	}
+	user := middleware.GetUserFromContext(ctx)
+
+	note, err := h.annotationService.AddNote(req.EntityType, uint(entityID), req.Body.Body, user.ID)
+	if err != nil {
+		return nil, annotationError(ctx, "add_admin_note_failed", "add note", err,
+			"entity_type", req.EntityType, "entity_id", entityID)
+	}
+
+	h.logAnnotationChange(user.ID, "add_admin_note", note.EntityType, note.EntityID, map[string]interface{}{"note_id": note.ID})
+	return &AdminNoteResponse{Body: note}, nil
+}
+
+// UpdateAdminNoteRequest represents the request for editing a note
+type UpdateAdminNoteRequest struct {
+	NoteID string `path:"note_id" doc:"Note ID" example:"7"`
+	Body   struct {
+		Body string `json:"body" doc:"New note text (at most 5000 characters)"`
+	}
+}
+
+// UpdateAdminNoteHandler handles PATCH /admin/annotation-notes/{note_id}
+func (h *AdminAnnotationHandler) UpdateAdminNoteHandler(ctx context.Context, req *UpdateAdminNoteRequest) (*AdminNoteResponse, error) {
+	noteID, err := strconv.ParseUint(req.NoteID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid note ID")
+	}
+	user := middleware.GetUserFromContext(ctx)
+
+	note, err := h.annotationService.UpdateNote(uint(noteID), req.Body.Body)
+	if err != nil {
+		return nil, annotationError(ctx, "update_admin_note_failed", "update note", err, "note_id", noteID)
+	}
+
+	h.logAnnotationChange(user.ID, "update_admin_note", note.EntityType, note.EntityID, map[string]interface{}{"note_id": note.ID})
+	return &AdminNoteResponse{Body: note}, nil
+}
+
+// DeleteAdminNoteRequest represents the request for deleting a note
+type DeleteAdminNoteRequest struct {
+	NoteID string `path:"note_id" doc:"Note ID" example:"7"`
+}
+
+// DeleteAdminNoteHandler handles DELETE /admin/annotation-notes/{note_id}
+func (h *AdminAnnotationHandler) DeleteAdminNoteHandler(ctx context.Context, req *DeleteAdminNoteRequest) (*struct{}, error) {
+	noteID, err := strconv.ParseUint(req.NoteID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid note ID")
+	}
+	user := middleware.GetUserFromContext(ctx)
+
+	if err := h.annotationService.DeleteNote(uint(noteID)); err != nil {
+		return nil, annotationError(ctx, "delete_admin_note_failed", "delete note", err, "note_id", noteID)
+	}
+
+	h.logAnnotationChange(user.ID, "delete_admin_note", "admin_note", uint(noteID), nil)
+	return nil, nil
+}
+
+// AddAdminTagRequest represents the request for tagging an entity
+type AddAdminTagRequest struct {
+	EntityType string `path:"entity_type" doc:"Entity type (show, venue, artist, release, label, festival)" example:"show"`
+	EntityID   string `path:"entity_id" doc:"Entity ID" example:"42"`
+	Body       struct {
+		Label string `json:"label" doc:"Tag label (at most 50 characters); re-adding an existing label recolors it" example:"needs flyer"`
+		Color string `json:"color,omitempty" required:"false" doc:"Hex color (default #6B7280)" example:"#F59E0B"`
+	}
+}
+
+// AdminTagResponse represents a single tag response
+type AdminTagResponse struct {
+	Body *contracts.AdminTagResponse
+}
+
+// AddAdminTagHandler handles POST /admin/annotations/{entity_type}/{entity_id}/tags
+func (h *AdminAnnotationHandler) AddAdminTagHandler(ctx context.Context, req *AddAdminTagRequest) (*AdminTagResponse, error) {
+	entityID, err := strconv.ParseUint(req.EntityID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid entity ID")
+	}
+	user := middleware.GetUserFromContext(ctx)
+
+	tag, err := h.annotationService.AddTag(req.EntityType, uint(entityID), req.Body.Label, req.Body.Color, user.ID)
+	if err != nil {
+		return nil, annotationError(ctx, "add_admin_tag_failed", "add tag", err,
+			"entity_type", req.EntityType, "entity_id", entityID)
+	}
+
+	h.logAnnotationChange(user.ID, "add_admin_tag", req.EntityType, uint(entityID), map[string]interface{}{"label": tag.Label})
+	return &AdminTagResponse{Body: tag}, nil
+}
+
+// RemoveAdminTagRequest represents the request for untagging an entity
+type RemoveAdminTagRequest struct {
+	EntityType string `path:"entity_type" doc:"Entity type (show, venue, artist, release, label, festival)" example:"show"`
+	EntityID   string `path:"entity_id" doc:"Entity ID" example:"42"`
+	Label      string `path:"label" doc:"Tag label (case-insensitive)" example:"needs flyer"`
+}
+
+// RemoveAdminTagHandler handles DELETE /admin/annotations/{entity_type}/{entity_id}/tags/{label}
+func (h *AdminAnnotationHandler) RemoveAdminTagHandler(ctx context.Context, req *RemoveAdminTagRequest) (*struct{}, error) {
+	entityID, err := strconv.ParseUint(req.EntityID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid entity ID")
+	}
+	user := middleware.GetUserFromContext(ctx)
+
+	if err := h.annotationService.RemoveTag(req.EntityType, uint(entityID), req.Label); err != nil {
+		return nil, annotationError(ctx, "remove_admin_tag_failed", "remove tag", err,
+			"entity_type", req.EntityType, "entity_id", entityID)
+	}
+
+	h.logAnnotationChange(user.ID, "remove_admin_tag", req.EntityType, uint(entityID), map[string]interface{}{"label": req.Label})
+	return nil, nil
+}
+
+// ListAdminTagsRequest represents the request for listing tags in use
+type ListAdminTagsRequest struct{}
+
+// ListAdminTagsResponse represents the tags in use
+type ListAdminTagsResponse struct {
+	Body struct {
+		Tags []*contracts.AdminTagCount `json:"tags" doc:"Tags in use, most used first"`
+	}
+}
+
+// ListAdminTagsHandler handles GET /admin/annotation-tags
+func (h *AdminAnnotationHandler) ListAdminTagsHandler(ctx context.Context, _ *ListAdminTagsRequest) (*ListAdminTagsResponse, error) {
+	tags, err := h.annotationService.ListTags()
+	if err != nil {
+		return nil, annotationError(ctx, "list_admin_tags_failed", "list tags", err)
+	}
+
+	resp := &ListAdminTagsResponse{}
+	resp.Body.Tags = tags
+	return resp, nil
+}
+
+// ListTaggedEntitiesRequest represents the request for entities with a tag
+type ListTaggedEntitiesRequest struct {
+	Label      string `path:"label" doc:"Tag label (case-insensitive)" example:"needs flyer"`
+	EntityType string `query:"entity_type" required:"false" doc:"Only this entity type"`
+	Limit      int    `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of entities to return (max 100)"`
+	Offset     int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+// ListTaggedEntitiesResponse represents the entities carrying a tag
+type ListTaggedEntitiesResponse struct {
+	Body struct {
+		Entities []*contracts.TaggedEntityResponse `json:"entities"`
+		Total    int64                             `json:"total"`
+	}
+}
+
+// ListTaggedEntitiesHandler handles GET /admin/annotation-tags/{label}
+func (h *AdminAnnotationHandler) ListTaggedEntitiesHandler(ctx context.Context, req *ListTaggedEntitiesRequest) (*ListTaggedEntitiesResponse, error) {
+	entities, total, err := h.annotationService.ListTagged(req.Label, req.EntityType, req.Limit, req.Offset)
+	if err != nil {
+		return nil, annotationError(ctx, "list_tagged_entities_failed", "list tagged entities", err, "label", req.Label)
+	}
+
+	resp := &ListTaggedEntitiesResponse{}
+	resp.Body.Entities = entities
+	resp.Body.Total = total
+	return resp, nil
+}
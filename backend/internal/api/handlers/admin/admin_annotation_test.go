@@ -0,0 +1,188 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestGetAnnotationsHandler_Success(t *testing.T) {
+	h := NewAdminAnnotationHandler(&testhelpers.MockAdminAnnotationService{
+		GetAnnotationsFn: func(entityType string, entityID uint) (*contracts.AdminAnnotationsResponse, error) {
+			if entityType != "show" || entityID != 42 {
+				t.Errorf("unexpected entity %s %d", entityType, entityID)
+			}
+			return &contracts.AdminAnnotationsResponse{
+				EntityType: entityType,
+				EntityID:   entityID,
+				Notes:      []*contracts.AdminNoteResponse{{ID: 1, Body: "Call the promoter"}},
+				Tags:       []*contracts.AdminTagResponse{{ID: 2, Label: "needs flyer", Color: "#F59E0B"}},
+			}, nil
+		},
+	}, nil)
+
+	resp, err := h.GetAnnotationsHandler(dataQualityAdminCtx(), &GetAnnotationsRequest{EntityType: "show", EntityID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Notes) != 1 || len(resp.Body.Tags) != 1 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestGetAnnotationsHandler_InvalidID(t *testing.T) {
+	h := NewAdminAnnotationHandler(&testhelpers.MockAdminAnnotationService{}, nil)
+	_, err := h.GetAnnotationsHandler(dataQualityAdminCtx(), &GetAnnotationsRequest{EntityType: "show", EntityID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestAddAdminNoteHandler_AuditsNote(t *testing.T) {
+	var audited string
+	h := NewAdminAnnotationHandler(&testhelpers.MockAdminAnnotationService{
+		AddNoteFn: func(entityType string, entityID uint, body string, userID uint) (*contracts.AdminNoteResponse, error) {
+			return &contracts.AdminNoteResponse{ID: 5, EntityType: entityType, EntityID: entityID, Body: body, CreatedBy: &userID}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) { audited = action },
+	})
+
+	req := &AddAdminNoteRequest{EntityType: "venue", EntityID: "3"}
+	req.Body.Body = "Door code changed"
+	resp, err := h.AddAdminNoteHandler(dataQualityAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 5 || resp.Body.EntityType != "venue" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+	if audited != "add_admin_note" {
+		t.Errorf("expected add_admin_note audit entry, got %q", audited)
+	}
+}
+
+func TestAddAdminNoteHandler_ErrorMapping(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"invalid", apperrors.ErrAdminAnnotationInvalid("note is required"), 422},
+		{"entity not found", apperrors.ErrAdminAnnotationEntityNotFound("show", 9), 404},
+		{"internal", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewAdminAnnotationHandler(&testhelpers.MockAdminAnnotationService{
+				AddNoteFn: func(string, uint, string, uint) (*contracts.AdminNoteResponse, error) { return nil, tc.err },
+			}, nil)
+			_, err := h.AddAdminNoteHandler(dataQualityAdminCtx(), &AddAdminNoteRequest{EntityType: "show", EntityID: "9"})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+func TestDeleteAdminNoteHandler_NotFound(t *testing.T) {
+	h := NewAdminAnnotationHandler(&testhelpers.MockAdminAnnotationService{
+		DeleteNoteFn: func(noteID uint) error { return apperrors.ErrAdminAnnotationNotFound(fmt.Sprintf("note %d", noteID)) },
+	}, nil)
+	_, err := h.DeleteAdminNoteHandler(dataQualityAdminCtx(), &DeleteAdminNoteRequest{NoteID: "7"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestAddAdminTagHandler_Success(t *testing.T) {
+	h := NewAdminAnnotationHandler(&testhelpers.MockAdminAnnotationService{
+		AddTagFn: func(entityType string, entityID uint, label, color string, _ uint) (*contracts.AdminTagResponse, error) {
+			if label != "unconfirmed date" || color != "#FF0000" {
+				t.Errorf("unexpected tag %q %q", label, color)
+			}
+			return &contracts.AdminTagResponse{ID: 1, Label: label, Color: color}, nil
+		},
+	}, nil)
+
+	req := &AddAdminTagRequest{EntityType: "show", EntityID: "1"}
+	req.Body.Label = "unconfirmed date"
+	req.Body.Color = "#FF0000"
+	resp, err := h.AddAdminTagHandler(dataQualityAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Label != "unconfirmed date" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestListTaggedEntitiesHandler_Success(t *testing.T) {
+	h := NewAdminAnnotationHandler(&testhelpers.MockAdminAnnotationService{
+		ListTaggedFn: func(label, entityType string, limit, offset int) ([]*contracts.TaggedEntityResponse, int64, error) {
+			if label != "needs flyer" || entityType != "show" || limit != 50 {
+				t.Errorf("unexpected args %q %q %d", label, entityType, limit)
+			}
+			return []*contracts.TaggedEntityResponse{{EntityType: "show", EntityID: 1, EntityName: "Rock Night"}}, 1, nil
+		},
+	}, nil)
+
+	resp, err := h.ListTaggedEntitiesHandler(dataQualityAdminCtx(), &ListTaggedEntitiesRequest{Label: "needs flyer", EntityType: "show", Limit: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 1 || resp.Body.Entities[0].EntityName != "Rock Night" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestGetAdminShowsHandler_IncludesAnnotations(t *testing.T) {
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			GetAdminShowsFn: func(_, _ int, filters contracts.AdminShowFilters) ([]*contracts.ShowResponse, int64, error) {
+				if filters.Tag != "needs flyer" {
+					t.Errorf("expected tag filter to be passed through, got %q", filters.Tag)
+				}
+				return []*contracts.ShowResponse{{ID: 1}, {ID: 2}}, 2, nil
+			},
+		}
+		ah.annotationService = &testhelpers.MockAdminAnnotationService{
+			GetSummariesFn: func(entityType string, ids []uint) (map[uint]*contracts.AdminAnnotationSummary, error) {
+				if entityType != "show" || len(ids) != 2 {
+					t.Errorf("unexpected summary lookup %s %v", entityType, ids)
+				}
+				return map[uint]*contracts.AdminAnnotationSummary{
+					1: {Tags: []*contracts.AdminTagResponse{{Label: "needs flyer"}}, NoteCount: 2},
+				}, nil
+			},
+		}
+	})
+
+	resp, err := h.GetAdminShowsHandler(adminCtx(), &GetAdminShowsRequest{Limit: 50, Tag: "needs flyer"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := resp.Body.Annotations[1]; got == nil || got.NoteCount != 2 {
+		t.Errorf("expected show 1 summary, got %+v", resp.Body.Annotations)
+	}
+	if _, ok := resp.Body.Annotations[2]; ok {
+		t.Error("shows without annotations should be omitted")
+	}
+}
+
+func TestGetUnverifiedVenuesHandler_AnnotationLookupFailureDoesNotFailList(t *testing.T) {
+	h := NewAdminVenueHandler(&testhelpers.MockVenueService{
+		GetUnverifiedVenuesFn: func(_, _ int) ([]*contracts.UnverifiedVenueResponse, int64, error) {
+			return []*contracts.UnverifiedVenueResponse{{ID: 3}}, 1, nil
+		},
+	}, nil, &testhelpers.MockAdminAnnotationService{
+		GetSummariesFn: func(string, []uint) (map[uint]*contracts.AdminAnnotationSummary, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	})
+
+	resp, err := h.GetUnverifiedVenuesHandler(adminCtx(), &GetUnverifiedVenuesRequest{Limit: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Venues) != 1 || len(resp.Body.Annotations) != 0 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
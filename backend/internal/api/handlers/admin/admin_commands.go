@@ -0,0 +1,218 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Admin command types accepted by POST /admin/commands.
+const (
+	AdminCommandApproveShow   = "approve_show"
+	AdminCommandVerifyVenue   = "verify_venue"
+	AdminCommandDismissReport = "dismiss_report"
+)
+
+// AdminCommandHandler executes batches of typed admin commands for the admin
+// UI's command palette. Every command delegates to the same service method
+// as its single-item admin endpoint and is audit-logged the same way.
+type AdminCommandHandler struct {
+	showService               contracts.ShowServiceInterface
+	showAdminService          contracts.ShowAdminServiceInterface
+	venueService              contracts.VenueServiceInterface
+	entityReportService       contracts.EntityReportServiceInterface
+	discordService            contracts.DiscordServiceInterface
+	auditLogService           contracts.AuditLogServiceInterface
+	notificationFilterService contracts.NotificationFilterServiceInterface
+}
+
+// NewAdminCommandHandler creates a new admin command handler
+func NewAdminCommandHandler(
+	showService contracts.ShowServiceInterface,
+	showAdminService contracts.ShowAdminServiceInterface,
+	venueService contracts.VenueServiceInterface,
+	entityReportService contracts.EntityReportServiceInterface,
+	discordService contracts.DiscordServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+	notificationFilterService contracts.NotificationFilterServiceInterface,
+) *AdminCommandHandler {
+	return &AdminCommandHandler{
+		showService:               showService,
+		showAdminService:          showAdminService,
+		venueService:              venueService,
+		entityReportService:       entityReportService,
+		discordService:            discordService,
+		auditLogService:           auditLogService,
+		notificationFilterService: notificationFilterService,
+	}
+}
+
+// AdminCommand is a single command in a batch.
+type AdminCommand struct {
+	Type         string `json:"type" enum:"approve_show,verify_venue,dismiss_report" doc:"Command to run"`
+	ID           uint   `json:"id" minimum:"1" doc:"Target show, venue or entity report ID"`
+	VerifyVenues bool   `json:"verify_venues,omitempty" required:"false" doc:"approve_show: also verify the show's venues"`
+	Notes        string `json:"notes,omitempty" required:"false" maxLength:"1000" doc:"dismiss_report: admin notes"`
+}
+
+// AdminCommandResult is the outcome of one command, in request order.
+type AdminCommandResult struct {
+	Index   int    `json:"index"`
+	Type    string `json:"type"`
+	ID      uint   `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// RunAdminCommandsRequest represents the HTTP request for a command batch
+type RunAdminCommandsRequest struct {
+	Body struct {
+		Commands []AdminCommand `json:"commands" minItems:"1" maxItems:"100" doc:"Commands to execute, in order"`
+		DryRun   bool           `json:"dry_run,omitempty" required:"false" doc:"Check every command's preconditions without applying any"`
+	}
+}
+
+// RunAdminCommandsResponse represents the HTTP response for a command batch
+type RunAdminCommandsResponse struct {
+	Body struct {
+		DryRun    bool                 `json:"dry_run"`
+		Succeeded int                  `json:"succeeded"`
+		Failed    int                  `json:"failed"`
+		Results   []AdminCommandResult `json:"results"`
+	}
+}
+
+// RunAdminCommandsHandler handles POST /admin/commands.
+// Commands run sequentially and independently: a failed command is reported
+// in its result row and never stops the batch. In dry-run mode each
+// command's target is loaded and its preconditions checked, but nothing is
+// written, audited or announced.
+func (h *AdminCommandHandler) RunAdminCommandsHandler(ctx context.Context, req *RunAdminCommandsRequest) (*RunAdminCommandsResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	resp := &RunAdminCommandsResponse{}
+	resp.Body.DryRun = req.Body.DryRun
+	resp.Body.Results = make([]AdminCommandResult, 0, len(req.Body.Commands))
+
+	var approved []uint
+	for i, cmd := range req.Body.Commands {
+		result := AdminCommandResult{Index: i, Type: cmd.Type, ID: cmd.ID}
+
+		var err error
+		if req.Body.DryRun {
+			err = h.checkCommand(cmd)
+		} else {
+			err = h.runCommand(user.ID, cmd)
+			if err == nil && cmd.Type == AdminCommandApproveShow {
+				approved = append(approved, cmd.ID)
+			}
+		}
+
+		if err != nil {
+			result.Error = err.Error()
+			resp.Body.Failed++
+		} else {
+			result.Success = true
+			resp.Body.Succeeded++
+		}
+		resp.Body.Results = append(resp.Body.Results, result)
+	}
+
+	matchNotificationFiltersAsync(ctx, h.showService, h.notificationFilterService, approved)
+
+	logger.FromContext(ctx).Info("admin_commands_run",
+		"dry_run", req.Body.DryRun,
+		"commands", len(req.Body.Commands),
+		"succeeded", resp.Body.Succeeded,
+		"failed", resp.Body.Failed,
+		"admin_id", user.ID,
+	)
+
+	return resp, nil
+}
+
+// checkCommand validates a command against current state without writing.
+// The preconditions mirror the service methods runCommand calls.
+func (h *AdminCommandHandler) checkCommand(cmd AdminCommand) error {
+	switch cmd.Type {
+	case AdminCommandApproveShow:
+		show, err := h.showService.GetShow(cmd.ID)
+		if err != nil {
+			return err
+		}
+		status := catalogm.ShowStatus(show.Status)
+		if status != catalogm.ShowStatusPending && status != catalogm.ShowStatusRejected {
+			return fmt.Errorf("show cannot be approved (current status: %s)", show.Status)
+		}
+		return nil
+	case AdminCommandVerifyVenue:
+		_, err := h.venueService.GetVenue(cmd.ID)
+		return err
+	case AdminCommandDismissReport:
+		report, err := h.entityReportService.GetEntityReport(cmd.ID)
+		if err != nil {
+			return err
+		}
+		if report.Status != string(communitym.EntityReportStatusPending) {
+			return fmt.Errorf("report has already been reviewed (status: %s)", report.Status)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown command type: %s", cmd.Type)
+}
+
+// runCommand applies a command and records it in the audit log.
+func (h *AdminCommandHandler) runCommand(adminID uint, cmd AdminCommand) error {
+	switch cmd.Type {
+	case AdminCommandApproveShow:
+		show, err := h.showAdminService.ApproveShow(cmd.ID, cmd.VerifyVenues)
+		if err != nil {
+			return err
+		}
+		if h.discordService != nil {
+			h.discordService.NotifyShowApproved(show)
+		}
+		h.logAction(adminID, "approve_show", "show", cmd.ID, map[string]interface{}{
+			"verify_venues": cmd.VerifyVenues,
+		})
+		return nil
+	case AdminCommandVerifyVenue:
+		if _, err := h.venueService.VerifyVenue(cmd.ID); err != nil {
+			return err
+		}
+		h.logAction(adminID, "verify_venue", "venue", cmd.ID, nil)
+		return nil
+	case AdminCommandDismissReport:
+		notes := strings.TrimSpace(cmd.Notes)
+		report, err := h.entityReportService.DismissEntityReport(cmd.ID, adminID, notes)
+		if err != nil {
+			return err
+		}
+		h.logAction(adminID, "dismiss_entity_report", report.EntityType, report.EntityID, map[string]interface{}{
+			"report_id":   report.ID,
+			"report_type": report.ReportType,
+			"notes":       notes,
+		})
+		return nil
+	}
+	return fmt.Errorf("unknown command type: %s", cmd.Type)
+}
+
+// logAction records a command in the audit log, tagged so command-palette
+// actions can be told apart from the single-item endpoints.
+func (h *AdminCommandHandler) logAction(adminID uint, action, entityType string, entityID uint, metadata map[string]interface{}) {
+	if h.auditLogService == nil {
+		return
+	}
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	metadata["command"] = true
+	h.auditLogService.LogAction(adminID, action, entityType, entityID, metadata)
+}
@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func adminCommandHandler(opts ...func(*AdminCommandHandler)) *AdminCommandHandler {
+	h := &AdminCommandHandler{
+		showService:         &testhelpers.MockShowService{},
+		showAdminService:    &testhelpers.MockShowAdminService{},
+		venueService:        &testhelpers.MockVenueService{},
+		entityReportService: &testhelpers.MockEntityReportService{},
+		discordService:      &testhelpers.MockDiscordService{},
+		auditLogService:     &testhelpers.MockAuditLogService{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func commandsRequest(dryRun bool, cmds ...AdminCommand) *RunAdminCommandsRequest {
+	req := &RunAdminCommandsRequest{}
+	req.Body.Commands = cmds
+	req.Body.DryRun = dryRun
+	return req
+}
+
+func TestRunAdminCommandsHandler_RunsSequentiallyAndAudits(t *testing.T) {
+	var order []string
+	var audited []string
+	h := adminCommandHandler(func(h *AdminCommandHandler) {
+		h.showAdminService = &testhelpers.MockShowAdminService{
+			ApproveShowFn: func(showID uint, verifyVenues bool) (*contracts.ShowResponse, error) {
+				order = append(order, "approve_show")
+				if !verifyVenues {
+					t.Error("expected verify_venues to be passed through")
+				}
+				return &contracts.ShowResponse{ID: showID}, nil
+			},
+		}
+		h.venueService = &testhelpers.MockVenueService{
+			VerifyVenueFn: func(venueID uint) (*contracts.VenueDetailResponse, error) {
+				order = append(order, "verify_venue")
+				return nil, apperrors.ErrVenueNotFound(venueID)
+			},
+		}
+		h.entityReportService = &testhelpers.MockEntityReportService{
+			DismissEntityReportFn: func(reportID, reviewerID uint, notes string) (*contracts.EntityReportResponse, error) {
+				order = append(order, "dismiss_report")
+				if reviewerID != 1 || notes != "spam" {
+					t.Errorf("unexpected args: reviewer=%d notes=%q", reviewerID, notes)
+				}
+				return &contracts.EntityReportResponse{ID: reportID, EntityType: "artist", EntityID: 7}, nil
+			},
+		}
+		h.auditLogService = &testhelpers.MockAuditLogService{
+			LogActionFn: func(_ uint, action string, _ string, _ uint, metadata map[string]interface{}) {
+				audited = append(audited, action)
+				if metadata["command"] != true {
+					t.Errorf("expected command tag in audit metadata for %s", action)
+				}
+			},
+		}
+	})
+
+	resp, err := h.RunAdminCommandsHandler(adminCtx(), commandsRequest(false,
+		AdminCommand{Type: AdminCommandApproveShow, ID: 1, VerifyVenues: true},
+		AdminCommand{Type: AdminCommandVerifyVenue, ID: 2},
+		AdminCommand{Type: AdminCommandDismissReport, ID: 3, Notes: " spam "},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Succeeded != 2 || resp.Body.Failed != 1 {
+		t.Errorf("expected 2 succeeded / 1 failed, got %d / %d", resp.Body.Succeeded, resp.Body.Failed)
+	}
+	if len(order) != 3 || order[0] != "approve_show" || order[2] != "dismiss_report" {
+		t.Errorf("expected commands to run in order, got %v", order)
+	}
+	if r := resp.Body.Results[1]; r.Success || r.Error == "" || r.Index != 1 {
+		t.Errorf("expected verify_venue to fail with an error, got %+v", r)
+	}
+	if len(audited) != 2 || audited[0] != "approve_show" || audited[1] != "dismiss_entity_report" {
+		t.Errorf("expected audit entries for successful commands only, got %v", audited)
+	}
+}
+
+func TestRunAdminCommandsHandler_DryRunWritesNothing(t *testing.T) {
+	h := adminCommandHandler(func(h *AdminCommandHandler) {
+		h.showService = &testhelpers.MockShowService{
+			GetShowFn: func(showID uint) (*contracts.ShowResponse, error) {
+				status := "pending"
+				if showID == 2 {
+					status = "approved"
+				}
+				return &contracts.ShowResponse{ID: showID, Status: status}, nil
+			},
+		}
+		h.entityReportService = &testhelpers.MockEntityReportService{
+			GetEntityReportFn: func(reportID uint) (*contracts.EntityReportResponse, error) {
+				return &contracts.EntityReportResponse{ID: reportID, Status: "pending"}, nil
+			},
+		}
+		h.showAdminService = &testhelpers.MockShowAdminService{
+			ApproveShowFn: func(uint, bool) (*contracts.ShowResponse, error) {
+				t.Error("dry run must not approve")
+				return nil, nil
+			},
+		}
+		h.auditLogService = &testhelpers.MockAuditLogService{
+			LogActionFn: func(uint, string, string, uint, map[string]interface{}) {
+				t.Error("dry run must not audit")
+			},
+		}
+	})
+
+	resp, err := h.RunAdminCommandsHandler(adminCtx(), commandsRequest(true,
+		AdminCommand{Type: AdminCommandApproveShow, ID: 1},
+		AdminCommand{Type: AdminCommandApproveShow, ID: 2},
+		AdminCommand{Type: AdminCommandDismissReport, ID: 3},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.DryRun || resp.Body.Succeeded != 2 || resp.Body.Failed != 1 {
+		t.Errorf("unexpected dry-run summary: %+v", resp.Body)
+	}
+	if resp.Body.Results[1].Success {
+		t.Error("expected an already-approved show to fail the dry-run check")
+	}
+}
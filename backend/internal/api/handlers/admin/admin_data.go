@@ -232,13 +232,8 @@ func (h *AdminDataHandler) DataImportHandler(ctx context.Context, req *DataImpor
 
 	user := middleware.GetUserFromContext(ctx)
 
-	// Validate limits
-	totalItems := len(req.Body.Shows) + len(req.Body.Artists) + len(req.Body.Venues)
-	if totalItems == 0 {
-		return nil, huma.Error422UnprocessableEntity("At least one show, artist, or venue is required")
-	}
-	if totalItems > 500 {
-		return nil, huma.Error422UnprocessableEntity("Maximum 500 total items can be imported at once")
+	if err := checkImportSize(req.Body); err != nil {
+		return nil, err
 	}
 
 	logger.FromContext(ctx).Debug("admin_data_import_attempt",
@@ -276,3 +271,16 @@ func (h *AdminDataHandler) DataImportHandler(ctx context.Context, req *DataImpor
 
 	return &DataImportResponse{Body: *result}, nil
 }
+
+// checkImportSize enforces the per-request item limits shared by the import
+// endpoints.
+func checkImportSize(body contracts.DataImportRequest) error {
+	totalItems := len(body.Shows) + len(body.Artists) + len(body.Venues)
+	if totalItems == 0 {
+		return huma.Error422UnprocessableEntity("At least one show, artist, or venue is required")
+	}
+	if totalItems > 500 {
+		return huma.Error422UnprocessableEntity("Maximum 500 total items can be imported at once")
+	}
+	return nil
+}
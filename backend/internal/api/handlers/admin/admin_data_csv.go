@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// ExportCSVRequest represents the HTTP request for a CSV export
+type ExportCSVRequest struct {
+	Columns  string `query:"columns" doc:"Comma-separated columns to include, in order (default: all)" example:"id,title,event_date"`
+	FromDate string `query:"from_date" doc:"Include rows on or after this date (YYYY-MM-DD). Shows filter on event date; venues and users on creation date"`
+	ToDate   string `query:"to_date" doc:"Include rows on or before this date (YYYY-MM-DD)"`
+}
+
+// ExportShowsCSVHandler handles GET /admin/export/shows.csv
+func (h *AdminDataHandler) ExportShowsCSVHandler(ctx context.Context, req *ExportCSVRequest) (*huma.StreamResponse, error) {
+	return h.exportCSV(ctx, contracts.CSVExportShows, req)
+}
+
+// ExportVenuesCSVHandler handles GET /admin/export/venues.csv
+func (h *AdminDataHandler) ExportVenuesCSVHandler(ctx context.Context, req *ExportCSVRequest) (*huma.StreamResponse, error) {
+	return h.exportCSV(ctx, contracts.CSVExportVenues, req)
+}
+
+// ExportUsersCSVHandler handles GET /admin/export/users.csv
+func (h *AdminDataHandler) ExportUsersCSVHandler(ctx context.Context, req *ExportCSVRequest) (*huma.StreamResponse, error) {
+	return h.exportCSV(ctx, contracts.CSVExportUsers, req)
+}
+
+// exportCSV validates the request up front — once streaming starts the 200
+// status is committed — then streams the rows straight to the response.
+func (h *AdminDataHandler) exportCSV(ctx context.Context, entity string, req *ExportCSVRequest) (*huma.StreamResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+	user := middleware.GetUserFromContext(ctx)
+
+	var params contracts.CSVExportParams
+	if req.Columns != "" {
+		params.Columns = strings.Split(req.Columns, ",")
+	}
+	if req.FromDate != "" {
+		fromDate, err := shared.ParseDate(req.FromDate)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid from_date format, expected YYYY-MM-DD")
+		}
+		params.From = &fromDate
+	}
+	if req.ToDate != "" {
+		toDate, err := shared.ParseDate(req.ToDate)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid to_date format, expected YYYY-MM-DD")
+		}
+		// to_date is inclusive; the service range end is exclusive.
+		end := toDate.AddDate(0, 0, 1)
+		params.To = &end
+	}
+
+	if err := h.dataSyncService.ValidateCSVExport(entity, params); err != nil {
+		if mapped := shared.MapDataExportError(err); mapped != nil {
+			return nil, mapped
+		}
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to export %s (request_id: %s)", entity, requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("admin_export_csv",
+		"entity", entity,
+		"columns", req.Columns,
+		"from_date", req.FromDate,
+		"to_date", req.ToDate,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	filename := fmt.Sprintf("%s-%s.csv", entity, time.Now().UTC().Format("20060102"))
+	return &huma.StreamResponse{
+		Body: func(hctx huma.Context) {
+			hctx.SetHeader("Content-Type", "text/csv; charset=utf-8")
+			hctx.SetHeader("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+			hctx.SetHeader("Cache-Control", "no-store")
+			// Headers are already sent by the first write, so a failure here
+			// can only truncate the file; log it for the request ID.
+			if err := h.dataSyncService.WriteCSV(hctx.Context(), entity, params, hctx.BodyWriter()); err != nil {
+				logger.FromContext(ctx).Error("admin_export_csv_failed",
+					"entity", entity,
+					"error", err.Error(),
+					"request_id", requestID,
+				)
+			}
+		},
+	}, nil
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestExportShowsCSVHandler_ParsesParams(t *testing.T) {
+	var gotEntity string
+	var gotParams contracts.CSVExportParams
+	h := NewAdminDataHandler(&testhelpers.MockDataSyncService{
+		ValidateCSVExportFn: func(entity string, params contracts.CSVExportParams) error {
+			gotEntity, gotParams = entity, params
+			return nil
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	resp, err := h.ExportShowsCSVHandler(ctx, &ExportCSVRequest{
+		Columns:  "id,title",
+		FromDate: "2026-03-01",
+		ToDate:   "2026-03-31",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp == nil || resp.Body == nil {
+		t.Fatal("expected a streaming response")
+	}
+	if gotEntity != contracts.CSVExportShows {
+		t.Errorf("expected shows export, got %q", gotEntity)
+	}
+	if len(gotParams.Columns) != 2 || gotParams.Columns[1] != "title" {
+		t.Errorf("unexpected columns %v", gotParams.Columns)
+	}
+	// to_date is inclusive, so the exclusive end is the next day.
+	if gotParams.To == nil || !gotParams.To.Equal(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected range end %v", gotParams.To)
+	}
+}
+
+func TestExportCSVHandler_RejectsBadRequests(t *testing.T) {
+	h := NewAdminDataHandler(&testhelpers.MockDataSyncService{
+		ValidateCSVExportFn: func(string, contracts.CSVExportParams) error {
+			return apperrors.ErrDataExportInvalidColumn("password_hash", []string{"id"})
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.ExportUsersCSVHandler(ctx, &ExportCSVRequest{Columns: "password_hash"})
+	testhelpers.AssertHumaError(t, err, 422)
+
+	_, err = h.ExportVenuesCSVHandler(ctx, &ExportCSVRequest{FromDate: "03/01/2026"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
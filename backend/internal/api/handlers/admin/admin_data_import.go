@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// ValidateDataImportRequest represents the HTTP request for validating a
+// batch import. dryRun is ignored: validation never writes catalog data.
+type ValidateDataImportRequest struct {
+	Body contracts.DataImportRequest `json:"body"`
+}
+
+// ValidateDataImportResponse represents the validation report
+type ValidateDataImportResponse struct {
+	Body *contracts.DataImportValidationReport
+}
+
+// ValidateDataImportHandler handles POST /admin/data/import/validate
+func (h *AdminDataHandler) ValidateDataImportHandler(ctx context.Context, req *ValidateDataImportRequest) (*ValidateDataImportResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+	user := middleware.GetUserFromContext(ctx)
+
+	if err := checkImportSize(req.Body); err != nil {
+		return nil, err
+	}
+
+	report, err := h.dataSyncService.ValidateImport(req.Body, user.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_data_import_validate_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to validate import (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("admin_data_import_validated",
+		"valid", report.Valid,
+		"errors", len(report.Errors),
+		"shows", report.Shows,
+		"artists", report.Artists,
+		"venues", report.Venues,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	return &ValidateDataImportResponse{Body: report}, nil
+}
+
+// CommitDataImportRequest represents the HTTP request for committing a
+// validated batch import
+type CommitDataImportRequest struct {
+	Body struct {
+		Token string `json:"token" minLength:"1" doc:"Token from a clean validation report"`
+	}
+}
+
+// CommitDataImportHandler handles POST /admin/data/import/commit
+func (h *AdminDataHandler) CommitDataImportHandler(ctx context.Context, req *CommitDataImportRequest) (*DataImportResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+	user := middleware.GetUserFromContext(ctx)
+
+	result, err := h.dataSyncService.CommitImport(req.Body.Token, user.ID)
+	if err != nil {
+		if mapped := shared.MapDataImportError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_data_import_commit_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to import data (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("admin_data_import_committed",
+		"shows_imported", result.Shows.Imported,
+		"artists_imported", result.Artists.Imported,
+		"venues_imported", result.Venues.Imported,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	return &DataImportResponse{Body: *result}, nil
+}
@@ -0,0 +1,89 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestValidateDataImportHandler(t *testing.T) {
+	var gotAdmin uint
+	h := NewAdminDataHandler(&testhelpers.MockDataSyncService{
+		ValidateImportFn: func(req contracts.DataImportRequest, adminID uint) (*contracts.DataImportValidationReport, error) {
+			gotAdmin = adminID
+			return &contracts.DataImportValidationReport{
+				Shows: len(req.Shows),
+				Errors: []contracts.DataImportRowError{
+					{Entity: "show", Row: 0, Field: "eventDate", Code: contracts.DataImportRowInvalidDate},
+				},
+			}, nil
+		},
+	})
+
+	_, err := h.ValidateDataImportHandler(adminCtx(), &ValidateDataImportRequest{})
+	testhelpers.AssertHumaError(t, err, 422)
+
+	req := &ValidateDataImportRequest{}
+	req.Body.Shows = []contracts.ExportedShow{{}}
+	resp, err := h.ValidateDataImportHandler(adminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Valid || len(resp.Body.Errors) != 1 || resp.Body.Shows != 1 {
+		t.Errorf("unexpected report %+v", resp.Body)
+	}
+	if gotAdmin == 0 {
+		t.Error("expected the admin's ID to be passed to the service")
+	}
+}
+
+func TestCommitDataImportHandler_MapsErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", apperrors.ErrDataImportTokenNotFound(), 404},
+		{"expired", apperrors.ErrDataImportTokenExpired(), 410},
+		{"used", apperrors.ErrDataImportTokenUsed(), 409},
+		{"unexpected", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewAdminDataHandler(&testhelpers.MockDataSyncService{
+				CommitImportFn: func(string, uint) (*contracts.DataImportResult, error) {
+					return nil, tc.err
+				},
+			})
+			req := &CommitDataImportRequest{}
+			req.Body.Token = "abc"
+			_, err := h.CommitDataImportHandler(adminCtx(), req)
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+func TestCommitDataImportHandler_Success(t *testing.T) {
+	h := NewAdminDataHandler(&testhelpers.MockDataSyncService{
+		CommitImportFn: func(token string, _ uint) (*contracts.DataImportResult, error) {
+			if token != "abc" {
+				t.Errorf("expected token abc, got %q", token)
+			}
+			result := &contracts.DataImportResult{}
+			result.Shows.Imported = 3
+			return result, nil
+		},
+	})
+	req := &CommitDataImportRequest{}
+	req.Body.Token = "abc"
+	resp, err := h.CommitDataImportHandler(adminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Shows.Imported != 3 {
+		t.Errorf("expected 3 shows imported, got %d", resp.Body.Shows.Imported)
+	}
+}
@@ -0,0 +1,102 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// AdminDeletionReceiptHandler serves the deletion receipt log for compliance requests
+type AdminDeletionReceiptHandler struct {
+	receiptService contracts.DeletionReceiptServiceInterface
+}
+
+// NewAdminDeletionReceiptHandler creates a new deletion receipt handler
+func NewAdminDeletionReceiptHandler(receiptService contracts.DeletionReceiptServiceInterface) *AdminDeletionReceiptHandler {
+	return &AdminDeletionReceiptHandler{
+		receiptService: receiptService,
+	}
+}
+
+// ListDeletionReceiptsRequest represents the request for listing deletion receipts
+type ListDeletionReceiptsRequest struct {
+	Limit  int    `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of receipts to return (max 100)"`
+	Offset int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+	Email  string `query:"email" doc:"Only receipts for this email (matched by hash)"`
+}
+
+// ListDeletionReceiptsResponse represents the response for listing deletion receipts
+type ListDeletionReceiptsResponse struct {
+	Body struct {
+		Receipts []*contracts.DeletionReceiptRecord `json:"receipts"`
+		Total    int64                              `json:"total"`
+	}
+}
+
+// ListDeletionReceiptsHandler handles GET /admin/deletion-receipts
+func (h *AdminDeletionReceiptHandler) ListDeletionReceiptsHandler(ctx context.Context, req *ListDeletionReceiptsRequest) (*ListDeletionReceiptsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	receipts, total, err := h.receiptService.ListDeletionReceipts(limit, req.Offset, req.Email)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_deletion_receipts_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get deletion receipts (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &ListDeletionReceiptsResponse{}
+	resp.Body.Receipts = receipts
+	if resp.Body.Receipts == nil {
+		resp.Body.Receipts = []*contracts.DeletionReceiptRecord{}
+	}
+	resp.Body.Total = total
+	return resp, nil
+}
+
+// GetDeletionReceiptRequest represents the request for a single deletion receipt
+type GetDeletionReceiptRequest struct {
+	ReceiptID uint `path:"receipt_id" doc:"Deletion receipt ID"`
+}
+
+// GetDeletionReceiptResponse represents the response for a single deletion receipt
+type GetDeletionReceiptResponse struct {
+	Body *contracts.DeletionReceiptRecord
+}
+
+// GetDeletionReceiptHandler handles GET /admin/deletion-receipts/{receipt_id}
+func (h *AdminDeletionReceiptHandler) GetDeletionReceiptHandler(ctx context.Context, req *GetDeletionReceiptRequest) (*GetDeletionReceiptResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	receipt, err := h.receiptService.GetDeletionReceipt(req.ReceiptID)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_deletion_receipt_failed",
+			"receipt_id", req.ReceiptID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get deletion receipt (request_id: %s)", requestID),
+		)
+	}
+	if receipt == nil {
+		return nil, huma.Error404NotFound("Deletion receipt not found")
+	}
+
+	return &GetDeletionReceiptResponse{Body: receipt}, nil
+}
@@ -0,0 +1,96 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestListDeletionReceiptsHandler_Success(t *testing.T) {
+	var gotEmail string
+	var gotLimit int
+	h := NewAdminDeletionReceiptHandler(&testhelpers.MockDeletionReceiptService{
+		ListDeletionReceiptsFn: func(limit, _ int, email string) ([]*contracts.DeletionReceiptRecord, int64, error) {
+			gotLimit, gotEmail = limit, email
+			return []*contracts.DeletionReceiptRecord{{ID: 7, UserID: 42, Verified: true}}, 1, nil
+		},
+	})
+
+	resp, err := h.ListDeletionReceiptsHandler(dataQualityAdminCtx(), &ListDeletionReceiptsRequest{Limit: 500, Email: "gone@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotLimit != 100 || gotEmail != "gone@example.com" {
+		t.Errorf("service called with limit=%d email=%q", gotLimit, gotEmail)
+	}
+	if resp.Body.Total != 1 || len(resp.Body.Receipts) != 1 || resp.Body.Receipts[0].ID != 7 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestListDeletionReceiptsHandler_EmptyIsNotNull(t *testing.T) {
+	h := NewAdminDeletionReceiptHandler(&testhelpers.MockDeletionReceiptService{
+		ListDeletionReceiptsFn: func(int, int, string) ([]*contracts.DeletionReceiptRecord, int64, error) {
+			return nil, 0, nil
+		},
+	})
+
+	resp, err := h.ListDeletionReceiptsHandler(dataQualityAdminCtx(), &ListDeletionReceiptsRequest{Limit: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Receipts == nil {
+		t.Error("receipts should be an empty list, not null")
+	}
+}
+
+func TestListDeletionReceiptsHandler_ServiceError(t *testing.T) {
+	h := NewAdminDeletionReceiptHandler(&testhelpers.MockDeletionReceiptService{
+		ListDeletionReceiptsFn: func(int, int, string) ([]*contracts.DeletionReceiptRecord, int64, error) {
+			return nil, 0, fmt.Errorf("db down")
+		},
+	})
+
+	_, err := h.ListDeletionReceiptsHandler(dataQualityAdminCtx(), &ListDeletionReceiptsRequest{Limit: 50})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestGetDeletionReceiptHandler_Success(t *testing.T) {
+	h := NewAdminDeletionReceiptHandler(&testhelpers.MockDeletionReceiptService{
+		GetDeletionReceiptFn: func(id uint) (*contracts.DeletionReceiptRecord, error) {
+			return &contracts.DeletionReceiptRecord{ID: id, Signature: "abc"}, nil
+		},
+	})
+
+	resp, err := h.GetDeletionReceiptHandler(dataQualityAdminCtx(), &GetDeletionReceiptRequest{ReceiptID: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 3 || resp.Body.Signature != "abc" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestGetDeletionReceiptHandler_NotFound(t *testing.T) {
+	h := NewAdminDeletionReceiptHandler(&testhelpers.MockDeletionReceiptService{
+		GetDeletionReceiptFn: func(uint) (*contracts.DeletionReceiptRecord, error) {
+			return nil, nil
+		},
+	})
+
+	_, err := h.GetDeletionReceiptHandler(dataQualityAdminCtx(), &GetDeletionReceiptRequest{ReceiptID: 3})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestGetDeletionReceiptHandler_ServiceError(t *testing.T) {
+	h := NewAdminDeletionReceiptHandler(&testhelpers.MockDeletionReceiptService{
+		GetDeletionReceiptFn: func(uint) (*contracts.DeletionReceiptRecord, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	})
+
+	_, err := h.GetDeletionReceiptHandler(dataQualityAdminCtx(), &GetDeletionReceiptRequest{ReceiptID: 3})
+	testhelpers.AssertHumaError(t, err, 500)
+}
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// adminEventsKeepalive is how often an idle stream gets a comment line, so
+// proxies don't close it and clients notice a dead connection.
+const adminEventsKeepalive = 25 * time.Second
+
+// AdminEventsHandler streams admin queue events over server-sent events
+type AdminEventsHandler struct {
+	eventBus  contracts.AdminEventBusInterface
+	keepalive time.Duration
+}
+
+// NewAdminEventsHandler creates a new admin events handler
+func NewAdminEventsHandler(eventBus contracts.AdminEventBusInterface) *AdminEventsHandler {
+	return &AdminEventsHandler{
+		eventBus:  eventBus,
+		keepalive: adminEventsKeepalive,
+	}
+}
+
+// StreamAdminEventsRequest represents the HTTP request for the admin event stream
+type StreamAdminEventsRequest struct{}
+
+// StreamAdminEventsHandler handles GET /admin/events. Each event is written
+// as an SSE message whose event name is the AdminEvent type (show_submitted,
+// report_created, venue_edit_pending) and whose data is the AdminEvent JSON.
+// The stream runs until the client disconnects.
+func (h *AdminEventsHandler) StreamAdminEventsHandler(ctx context.Context, req *StreamAdminEventsRequest) (*huma.StreamResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	return &huma.StreamResponse{
+		Body: func(hctx huma.Context) {
+			events, unsubscribe := h.eventBus.Subscribe()
+			defer unsubscribe()
+
+			hctx.SetHeader("Content-Type", "text/event-stream")
+			hctx.SetHeader("Cache-Control", "no-cache")
+			// Stop nginx-style proxies from buffering the stream.
+			hctx.SetHeader("X-Accel-Buffering", "no")
+			hctx.SetStatus(http.StatusOK)
+
+			w := hctx.BodyWriter()
+			flusher, _ := w.(http.Flusher)
+			write := func(s string) bool {
+				if _, err := w.Write([]byte(s)); err != nil {
+					return false
+				}
+				if flusher != nil {
+					flusher.Flush()
+				}
+				return true
+			}
+
+			if !write(": connected\n\n") {
+				return
+			}
+
+			ticker := time.NewTicker(h.keepalive)
+			defer ticker.Stop()
+
+			done := hctx.Context().Done()
+			for {
+				select {
+				case <-done:
+					return
+				case <-ticker.C:
+					if !write(": ping\n\n") {
+						return
+					}
+				case event, ok := <-events:
+					if !ok {
+						return
+					}
+					data, err := json.Marshal(event)
+					if err != nil {
+						logger.FromContext(ctx).Error("admin_event_encode_failed",
+							"event_type", event.Type,
+							"error", err.Error(),
+							"request_id", requestID,
+						)
+						continue
+					}
+					if !write(fmt.Sprintf("event: %s\ndata: %s\n\n", event.Type, data)) {
+						return
+					}
+				}
+			}
+		},
+	}, nil
+}
@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// fakeAdminEventBus hands the handler a channel the test controls and
+// signals when the stream has subscribed.
+type fakeAdminEventBus struct {
+	ch           chan contracts.AdminEvent
+	subscribed   chan struct{}
+	unsubscribed bool
+}
+
+func newFakeAdminEventBus() *fakeAdminEventBus {
+	return &fakeAdminEventBus{
+		ch:         make(chan contracts.AdminEvent, 4),
+		subscribed: make(chan struct{}),
+	}
+}
+
+func (f *fakeAdminEventBus) Publish(event contracts.AdminEvent) { f.ch <- event }
+
+func (f *fakeAdminEventBus) Subscribe() (<-chan contracts.AdminEvent, func()) {
+	close(f.subscribed)
+	return f.ch, func() { f.unsubscribed = true }
+}
+
+// runAdminEventStream starts the stream body in the background and returns
+// the recorder plus a stop function that disconnects the client and waits
+// for the body to return.
+func runAdminEventStream(t *testing.T, h *AdminEventsHandler) (*httptest.ResponseRecorder, func()) {
+	t.Helper()
+	resp, err := h.StreamAdminEventsHandler(adminCtx(), &StreamAdminEventsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/admin/events", nil).WithContext(reqCtx)
+	rr := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		resp.Body(humatest.NewContext(nil, req, rr))
+	}()
+
+	return rr, func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+			t.Fatal("stream did not stop after client disconnect")
+		}
+	}
+}
+
+func TestStreamAdminEventsHandler_WritesEvents(t *testing.T) {
+	bus := newFakeAdminEventBus()
+	h := NewAdminEventsHandler(bus)
+	rr, stop := runAdminEventStream(t, h)
+
+	<-bus.subscribed
+	bus.Publish(contracts.AdminEvent{Type: contracts.AdminEventShowSubmitted, ID: 12, EntityType: "show", EntityID: 12, Summary: "Night Beats"})
+	bus.Publish(contracts.AdminEvent{Type: contracts.AdminEventVenueEditPending, ID: 3, EntityType: "venue", EntityID: 8})
+	// Give the stream a moment to drain the channel before disconnecting.
+	deadline := time.Now().Add(2 * time.Second)
+	for len(bus.ch) > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	stop()
+
+	if got := rr.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", got)
+	}
+	body := rr.Body.String()
+	if !strings.Contains(body, "event: show_submitted\ndata: {") || !strings.Contains(body, `"summary":"Night Beats"`) {
+		t.Errorf("missing show_submitted event in stream:\n%s", body)
+	}
+	if !strings.Contains(body, "event: venue_edit_pending\n") {
+		t.Errorf("missing venue_edit_pending event in stream:\n%s", body)
+	}
+	if !bus.unsubscribed {
+		t.Error("expected stream to unsubscribe on disconnect")
+	}
+}
+
+func TestStreamAdminEventsHandler_SendsKeepalive(t *testing.T) {
+	bus := newFakeAdminEventBus()
+	h := NewAdminEventsHandler(bus)
+	h.keepalive = 10 * time.Millisecond
+	rr, stop := runAdminEventStream(t, h)
+
+	<-bus.subscribed
+	time.Sleep(50 * time.Millisecond)
+	stop()
+
+	if !strings.Contains(rr.Body.String(), ": ping\n\n") {
+		t.Errorf("expected keepalive comment in stream, got:\n%s", rr.Body.String())
+	}
+}
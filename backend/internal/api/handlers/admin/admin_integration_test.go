@@ -32,10 +32,12 @@ func (s *AdminHandlerIntegrationSuite) SetupSuite() {
 		s.deps.DiscordService,
 		s.deps.AuditLogService,
 		nil, // notificationFilterService
+		nil, // annotationService
 	)
 	s.venueHandler = NewAdminVenueHandler(
 		s.deps.VenueService,
 		s.deps.AuditLogService,
+		nil, // annotationService
 	)
 	s.tokenHandler = NewAdminTokenHandler(
 		s.deps.APITokenService,
@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// AdminJWTKeyHandler handles admin inspection and rotation of the JWT key ring
+type AdminJWTKeyHandler struct {
+	keyRotator      contracts.JWTKeyRotatorInterface
+	auditLogService contracts.AuditLogServiceInterface
+}
+
+// NewAdminJWTKeyHandler creates a new JWT key handler
+func NewAdminJWTKeyHandler(
+	keyRotator contracts.JWTKeyRotatorInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *AdminJWTKeyHandler {
+	return &AdminJWTKeyHandler{
+		keyRotator:      keyRotator,
+		auditLogService: auditLogService,
+	}
+}
+
+// GetJWTKeysRequest represents the request for the key ring status
+type GetJWTKeysRequest struct{}
+
+// JWTKeysResponse reports the key ring's key ids (never the secrets)
+type JWTKeysResponse struct {
+	Body *contracts.JWTKeyStatus
+}
+
+// GetJWTKeysHandler handles GET /admin/auth/jwt-keys
+func (h *AdminJWTKeyHandler) GetJWTKeysHandler(_ context.Context, _ *GetJWTKeysRequest) (*JWTKeysResponse, error) {
+	return &JWTKeysResponse{Body: h.keyRotator.JWTKeyStatus()}, nil
+}
+
+// RotateJWTKeysRequest represents the request for reloading the key ring
+type RotateJWTKeysRequest struct{}
+
+// RotateJWTKeysHandler handles POST /admin/auth/jwt-keys/rotate. It reloads
+// JWT_SECRET_KEY and JWT_PREVIOUS_KEYS from the secrets backend; the secrets
+// themselves are changed in the backend first.
+func (h *AdminJWTKeyHandler) RotateJWTKeysHandler(ctx context.Context, _ *RotateJWTKeysRequest) (*JWTKeysResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+	user := middleware.GetUserFromContext(ctx)
+
+	status, err := h.keyRotator.RotateJWTKeys(ctx)
+	if err != nil {
+		if errors.Is(err, config.ErrJWTKeyRingStatic) {
+			return nil, huma.Error409Conflict("JWT keys are not reloadable in this process")
+		}
+		logger.FromContext(ctx).Error("rotate_jwt_keys_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to reload JWT keys (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("jwt_keys_rotated",
+		"current_kid", status.CurrentKeyID,
+		"previous_kids", status.PreviousKeyIDs,
+		"changed", status.Changed,
+		"request_id", requestID,
+	)
+	if h.auditLogService != nil {
+		h.auditLogService.LogAction(user.ID, "rotate_jwt_keys", "jwt_key", 0, map[string]interface{}{
+			"current_kid":   status.CurrentKeyID,
+			"previous_kids": status.PreviousKeyIDs,
+			"changed":       status.Changed,
+		})
+	}
+
+	return &JWTKeysResponse{Body: status}, nil
+}
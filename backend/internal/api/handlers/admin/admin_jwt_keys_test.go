@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestGetJWTKeysHandler_Success(t *testing.T) {
+	h := NewAdminJWTKeyHandler(&testhelpers.MockJWTKeyRotator{
+		JWTKeyStatusFn: func() *contracts.JWTKeyStatus {
+			return &contracts.JWTKeyStatus{CurrentKeyID: "abc", PreviousKeyIDs: []string{"def"}}
+		},
+	}, nil)
+
+	resp, err := h.GetJWTKeysHandler(dataQualityAdminCtx(), &GetJWTKeysRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.CurrentKeyID != "abc" || len(resp.Body.PreviousKeyIDs) != 1 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestRotateJWTKeysHandler_Success(t *testing.T) {
+	var audited string
+	h := NewAdminJWTKeyHandler(&testhelpers.MockJWTKeyRotator{
+		RotateJWTKeysFn: func(context.Context) (*contracts.JWTKeyStatus, error) {
+			return &contracts.JWTKeyStatus{CurrentKeyID: "new", PreviousKeyIDs: []string{"old"}, Changed: true}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(actorID uint, action, entityType string, _ uint, metadata map[string]interface{}) {
+			audited = fmt.Sprintf("%d %s %s %v", actorID, action, entityType, metadata["current_kid"])
+		},
+	})
+
+	resp, err := h.RotateJWTKeysHandler(dataQualityAdminCtx(), &RotateJWTKeysRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Changed || resp.Body.CurrentKeyID != "new" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+	if audited != "1 rotate_jwt_keys jwt_key new" {
+		t.Errorf("unexpected audit entry %q", audited)
+	}
+}
+
+func TestRotateJWTKeysHandler_StaticRing(t *testing.T) {
+	h := NewAdminJWTKeyHandler(&testhelpers.MockJWTKeyRotator{
+		RotateJWTKeysFn: func(context.Context) (*contracts.JWTKeyStatus, error) {
+			return nil, config.ErrJWTKeyRingStatic
+		},
+	}, nil)
+
+	_, err := h.RotateJWTKeysHandler(dataQualityAdminCtx(), &RotateJWTKeysRequest{})
+	testhelpers.AssertHumaError(t, err, 409)
+}
+
+func TestRotateJWTKeysHandler_ReloadError(t *testing.T) {
+	h := NewAdminJWTKeyHandler(&testhelpers.MockJWTKeyRotator{
+		RotateJWTKeysFn: func(context.Context) (*contracts.JWTKeyStatus, error) {
+			return nil, fmt.Errorf("vault down")
+		},
+	}, nil)
+
+	_, err := h.RotateJWTKeysHandler(dataQualityAdminCtx(), &RotateJWTKeysRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
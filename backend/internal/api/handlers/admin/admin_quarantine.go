@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// AdminQuarantineHandler handles submission quarantine review
+type AdminQuarantineHandler struct {
+	throttleService contracts.SubmissionThrottleServiceInterface
+	auditLogService contracts.AuditLogServiceInterface
+}
+
+// NewAdminQuarantineHandler creates a new admin quarantine handler
+func NewAdminQuarantineHandler(
+	throttleService contracts.SubmissionThrottleServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *AdminQuarantineHandler {
+	return &AdminQuarantineHandler{
+		throttleService: throttleService,
+		auditLogService: auditLogService,
+	}
+}
+
+// ListQuarantinedUsersRequest represents the HTTP request for listing quarantined users
+type ListQuarantinedUsersRequest struct{}
+
+// ListQuarantinedUsersResponse represents the HTTP response for listing quarantined users
+type ListQuarantinedUsersResponse struct {
+	Body struct {
+		Users []contracts.QuarantinedUser `json:"users"`
+		Total int                         `json:"total"`
+	}
+}
+
+// QuarantineUserRequest represents the HTTP request for quarantining a user
+type QuarantineUserRequest struct {
+	UserID string `path:"user_id" doc:"User ID"`
+	Body   struct {
+		Reason string `json:"reason,omitempty" maxLength:"500" doc:"Why the user is quarantined"`
+	}
+}
+
+// QuarantineUserResponse represents the HTTP response for quarantining a user
+type QuarantineUserResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+// ReleaseUserQuarantineRequest represents the HTTP request for releasing a user
+type ReleaseUserQuarantineRequest struct {
+	UserID string `path:"user_id" doc:"User ID"`
+}
+
+// ReleaseUserQuarantineResponse represents the HTTP response for releasing a user
+type ReleaseUserQuarantineResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+// ListQuarantinedUsersHandler handles GET /admin/quarantined-users
+func (h *AdminQuarantineHandler) ListQuarantinedUsersHandler(ctx context.Context, _ *ListQuarantinedUsersRequest) (*ListQuarantinedUsersResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	users, err := h.throttleService.ListQuarantinedUsers()
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_list_quarantined_users_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to list quarantined users (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &ListQuarantinedUsersResponse{}
+	resp.Body.Users = users
+	if resp.Body.Users == nil {
+		resp.Body.Users = []contracts.QuarantinedUser{}
+	}
+	resp.Body.Total = len(resp.Body.Users)
+	return resp, nil
+}
+
+// QuarantineUserHandler handles PUT /admin/users/{user_id}/quarantine
+func (h *AdminQuarantineHandler) QuarantineUserHandler(ctx context.Context, req *QuarantineUserRequest) (*QuarantineUserResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+	actor := middleware.GetUserFromContext(ctx)
+	if actor == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	userID, err := strconv.ParseUint(req.UserID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID")
+	}
+
+	if err := h.throttleService.QuarantineUser(uint(userID), req.Body.Reason); err != nil {
+		if mapped := shared.MapSubmissionError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_quarantine_user_failed",
+			"user_id", userID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to quarantine user (request_id: %s)", requestID),
+		)
+	}
+
+	h.auditLogService.LogAction(actor.ID, "quarantine_user", "user", uint(userID), map[string]interface{}{
+		"reason": req.Body.Reason,
+	})
+
+	logger.FromContext(ctx).Info("admin_quarantine_user_success",
+		"admin_id", actor.ID,
+		"user_id", userID,
+	)
+
+	resp := &QuarantineUserResponse{}
+	resp.Body.Success = true
+	return resp, nil
+}
+
+// ReleaseUserQuarantineHandler handles DELETE /admin/users/{user_id}/quarantine
+func (h *AdminQuarantineHandler) ReleaseUserQuarantineHandler(ctx context.Context, req *ReleaseUserQuarantineRequest) (*ReleaseUserQuarantineResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+	actor := middleware.GetUserFromContext(ctx)
+	if actor == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	userID, err := strconv.ParseUint(req.UserID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID")
+	}
+
+	if err := h.throttleService.ReleaseUser(uint(userID)); err != nil {
+		if mapped := shared.MapSubmissionError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_release_user_quarantine_failed",
+			"user_id", userID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to release user (request_id: %s)", requestID),
+		)
+	}
+
+	h.auditLogService.LogAction(actor.ID, "release_user_quarantine", "user", uint(userID), nil)
+
+	logger.FromContext(ctx).Info("admin_release_user_quarantine_success",
+		"admin_id", actor.ID,
+		"user_id", userID,
+	)
+
+	resp := &ReleaseUserQuarantineResponse{}
+	resp.Body.Success = true
+	return resp, nil
+}
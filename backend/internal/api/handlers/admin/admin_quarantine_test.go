@@ -0,0 +1,155 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// --- ListQuarantinedUsersHandler ---
+
+func TestListQuarantinedUsersHandler_Success(t *testing.T) {
+	h := NewAdminQuarantineHandler(&testhelpers.MockSubmissionThrottleService{
+		ListQuarantinedUsersFn: func() ([]contracts.QuarantinedUser, error) {
+			return []contracts.QuarantinedUser{{UserID: 5, PendingShows: 3}}, nil
+		},
+	}, nil)
+
+	resp, err := h.ListQuarantinedUsersHandler(adminCtx(), &ListQuarantinedUsersRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 1 || resp.Body.Users[0].UserID != 5 {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestListQuarantinedUsersHandler_EmptyIsNotNull(t *testing.T) {
+	h := NewAdminQuarantineHandler(&testhelpers.MockSubmissionThrottleService{}, nil)
+
+	resp, err := h.ListQuarantinedUsersHandler(adminCtx(), &ListQuarantinedUsersRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Users == nil {
+		t.Error("expected an empty slice, got nil")
+	}
+}
+
+func TestListQuarantinedUsersHandler_ServiceError(t *testing.T) {
+	h := NewAdminQuarantineHandler(&testhelpers.MockSubmissionThrottleService{
+		ListQuarantinedUsersFn: func() ([]contracts.QuarantinedUser, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}, nil)
+
+	_, err := h.ListQuarantinedUsersHandler(adminCtx(), &ListQuarantinedUsersRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+// --- QuarantineUserHandler ---
+
+func TestQuarantineUserHandler_NoAuth(t *testing.T) {
+	h := NewAdminQuarantineHandler(nil, nil)
+	_, err := h.QuarantineUserHandler(context.Background(), &QuarantineUserRequest{UserID: "5"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestQuarantineUserHandler_InvalidID(t *testing.T) {
+	h := NewAdminQuarantineHandler(nil, nil)
+	_, err := h.QuarantineUserHandler(adminCtx(), &QuarantineUserRequest{UserID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestQuarantineUserHandler_Success(t *testing.T) {
+	var gotUser uint
+	var gotReason, auditAction string
+	h := NewAdminQuarantineHandler(
+		&testhelpers.MockSubmissionThrottleService{
+			QuarantineUserFn: func(userID uint, reason string) error {
+				gotUser, gotReason = userID, reason
+				return nil
+			},
+		},
+		&testhelpers.MockAuditLogService{
+			LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) {
+				auditAction = action
+			},
+		},
+	)
+
+	req := &QuarantineUserRequest{UserID: "5"}
+	req.Body.Reason = "spam"
+	resp, err := h.QuarantineUserHandler(adminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success || gotUser != 5 || gotReason != "spam" {
+		t.Errorf("unexpected call: user=%d reason=%q", gotUser, gotReason)
+	}
+	if auditAction != "quarantine_user" {
+		t.Errorf("expected quarantine_user audit entry, got %q", auditAction)
+	}
+}
+
+func TestQuarantineUserHandler_MappedErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", apperrors.ErrSubmissionUserNotFound(), 404},
+		{"staff", apperrors.ErrSubmissionStaffQuarantine(), 403},
+		{"other", fmt.Errorf("db error"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewAdminQuarantineHandler(&testhelpers.MockSubmissionThrottleService{
+				QuarantineUserFn: func(uint, string) error { return tt.err },
+			}, &testhelpers.MockAuditLogService{})
+			_, err := h.QuarantineUserHandler(adminCtx(), &QuarantineUserRequest{UserID: "5"})
+			testhelpers.AssertHumaError(t, err, tt.status)
+		})
+	}
+}
+
+// --- ReleaseUserQuarantineHandler ---
+
+func TestReleaseUserQuarantineHandler_Success(t *testing.T) {
+	var released uint
+	var auditAction string
+	h := NewAdminQuarantineHandler(
+		&testhelpers.MockSubmissionThrottleService{
+			ReleaseUserFn: func(userID uint) error {
+				released = userID
+				return nil
+			},
+		},
+		&testhelpers.MockAuditLogService{
+			LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) {
+				auditAction = action
+			},
+		},
+	)
+
+	resp, err := h.ReleaseUserQuarantineHandler(adminCtx(), &ReleaseUserQuarantineRequest{UserID: "5"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success || released != 5 || auditAction != "release_user_quarantine" {
+		t.Errorf("unexpected result: released=%d audit=%q", released, auditAction)
+	}
+}
+
+func TestReleaseUserQuarantineHandler_NotFound(t *testing.T) {
+	h := NewAdminQuarantineHandler(&testhelpers.MockSubmissionThrottleService{
+		ReleaseUserFn: func(uint) error { return apperrors.ErrSubmissionUserNotFound() },
+	}, &testhelpers.MockAuditLogService{})
+
+	_, err := h.ReleaseUserQuarantineHandler(adminCtx(), &ReleaseUserQuarantineRequest{UserID: "5"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
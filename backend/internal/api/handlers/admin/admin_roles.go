@@ -0,0 +1,146 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// AdminRoleHandler handles staff role listing and assignment
+type AdminRoleHandler struct {
+	userService     contracts.UserServiceInterface
+	auditLogService contracts.AuditLogServiceInterface
+}
+
+// NewAdminRoleHandler creates a new admin role handler
+func NewAdminRoleHandler(
+	userService contracts.UserServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *AdminRoleHandler {
+	return &AdminRoleHandler{
+		userService:     userService,
+		auditLogService: auditLogService,
+	}
+}
+
+// RoleInfo describes one role and the permissions it grants
+type RoleInfo struct {
+	Role        authm.Role         `json:"role"`
+	Permissions []authm.Permission `json:"permissions"`
+}
+
+// ListRolesRequest represents the HTTP request for listing roles
+type ListRolesRequest struct{}
+
+// ListRolesResponse represents the HTTP response for listing roles
+type ListRolesResponse struct {
+	Body struct {
+		Roles []RoleInfo `json:"roles"`
+		// Caller's own role and permissions, for the admin UI to decide
+		// which sections to show.
+		CurrentRole        authm.Role         `json:"current_role"`
+		CurrentPermissions []authm.Permission `json:"current_permissions"`
+	}
+}
+
+// SetUserRoleRequest represents the HTTP request for changing a user's role
+type SetUserRoleRequest struct {
+	UserID string `path:"user_id" doc:"User ID"`
+	Body   struct {
+		Role authm.Role `json:"role" enum:"user,moderator,admin,superadmin" doc:"Role to grant"`
+	}
+}
+
+// SetUserRoleResponse represents the HTTP response for changing a user's role
+type SetUserRoleResponse struct {
+	Body struct {
+		UserID       uint               `json:"user_id"`
+		Role         authm.Role         `json:"role"`
+		PreviousRole authm.Role         `json:"previous_role"`
+		Permissions  []authm.Permission `json:"permissions"`
+	}
+}
+
+// ListRolesHandler handles GET /admin/roles
+func (h *AdminRoleHandler) ListRolesHandler(ctx context.Context, _ *ListRolesRequest) (*ListRolesResponse, error) {
+	resp := &ListRolesResponse{}
+	for _, role := range authm.Roles {
+		resp.Body.Roles = append(resp.Body.Roles, RoleInfo{
+			Role:        role,
+			Permissions: nonNilPermissions(role),
+		})
+	}
+	if user := middleware.GetUserFromContext(ctx); user != nil {
+		role := user.EffectiveRole()
+		resp.Body.CurrentRole = role
+		resp.Body.CurrentPermissions = nonNilPermissions(role)
+	}
+	return resp, nil
+}
+
+// SetUserRoleHandler handles PUT /admin/users/{user_id}/role
+func (h *AdminRoleHandler) SetUserRoleHandler(ctx context.Context, req *SetUserRoleRequest) (*SetUserRoleResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+	actor := middleware.GetUserFromContext(ctx)
+	if actor == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	userID, err := strconv.ParseUint(req.UserID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID")
+	}
+
+	previous, err := h.userService.SetUserRole(actor.ID, uint(userID), req.Body.Role)
+	if err != nil {
+		if mapped := shared.MapRoleError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_set_user_role_failed",
+			"user_id", userID,
+			"role", req.Body.Role,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to update role (request_id: %s)", requestID),
+		)
+	}
+
+	h.auditLogService.LogAction(actor.ID, "set_user_role", "user", uint(userID), map[string]interface{}{
+		"role":          req.Body.Role,
+		"previous_role": previous,
+	})
+
+	logger.FromContext(ctx).Info("admin_set_user_role_success",
+		"admin_id", actor.ID,
+		"user_id", userID,
+		"role", req.Body.Role,
+		"previous_role", previous,
+	)
+
+	resp := &SetUserRoleResponse{}
+	resp.Body.UserID = uint(userID)
+	resp.Body.Role = req.Body.Role
+	resp.Body.PreviousRole = previous
+	resp.Body.Permissions = nonNilPermissions(req.Body.Role)
+	return resp, nil
+}
+
+// nonNilPermissions returns role's permissions as a non-nil slice so the
+// user role serializes as [] rather than null.
+func nonNilPermissions(role authm.Role) []authm.Permission {
+	perms := role.Permissions()
+	if perms == nil {
+		return []authm.Permission{}
+	}
+	return perms
+}
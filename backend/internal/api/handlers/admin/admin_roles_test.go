@@ -0,0 +1,107 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+)
+
+// --- ListRolesHandler ---
+
+func TestListRolesHandler(t *testing.T) {
+	h := NewAdminRoleHandler(nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 2, Role: authm.RoleModerator})
+
+	resp, err := h.ListRolesHandler(ctx, &ListRolesRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Roles) != len(authm.Roles) {
+		t.Fatalf("expected %d roles, got %d", len(authm.Roles), len(resp.Body.Roles))
+	}
+	if resp.Body.Roles[0].Role != authm.RoleUser || resp.Body.Roles[0].Permissions == nil {
+		t.Errorf("expected user role with empty permissions, got %+v", resp.Body.Roles[0])
+	}
+	if resp.Body.CurrentRole != authm.RoleModerator {
+		t.Errorf("expected current role moderator, got %q", resp.Body.CurrentRole)
+	}
+	if len(resp.Body.CurrentPermissions) != 1 || resp.Body.CurrentPermissions[0] != authm.PermissionModerateContent {
+		t.Errorf("unexpected current permissions: %v", resp.Body.CurrentPermissions)
+	}
+}
+
+// --- SetUserRoleHandler ---
+
+func TestSetUserRoleHandler_NoAuth(t *testing.T) {
+	h := NewAdminRoleHandler(nil, nil)
+	_, err := h.SetUserRoleHandler(context.Background(), &SetUserRoleRequest{UserID: "5"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestSetUserRoleHandler_InvalidID(t *testing.T) {
+	h := NewAdminRoleHandler(nil, nil)
+	_, err := h.SetUserRoleHandler(adminCtx(), &SetUserRoleRequest{UserID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestSetUserRoleHandler_Success(t *testing.T) {
+	var audited map[string]interface{}
+	userSvc := &testhelpers.MockUserService{
+		SetUserRoleFn: func(actorID, userID uint, role authm.Role) (authm.Role, error) {
+			if actorID != 1 || userID != 5 || role != authm.RoleModerator {
+				t.Errorf("unexpected args %d %d %q", actorID, userID, role)
+			}
+			return authm.RoleUser, nil
+		},
+	}
+	auditSvc := &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, metadata map[string]interface{}) {
+			if action != "set_user_role" || entityType != "user" || entityID != 5 {
+				t.Errorf("unexpected audit entry %q %q %d", action, entityType, entityID)
+			}
+			audited = metadata
+		},
+	}
+	h := NewAdminRoleHandler(userSvc, auditSvc)
+
+	req := &SetUserRoleRequest{UserID: "5"}
+	req.Body.Role = authm.RoleModerator
+	resp, err := h.SetUserRoleHandler(adminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.UserID != 5 || resp.Body.Role != authm.RoleModerator || resp.Body.PreviousRole != authm.RoleUser {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+	if len(resp.Body.Permissions) != 1 {
+		t.Errorf("expected 1 permission, got %v", resp.Body.Permissions)
+	}
+	if audited["previous_role"] != authm.RoleUser {
+		t.Errorf("expected previous_role in audit metadata, got %v", audited)
+	}
+}
+
+func TestSetUserRoleHandler_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		status int
+	}{
+		{apperrors.ErrRoleInvalid("owner"), 422},
+		{apperrors.ErrRoleUserNotFound(), 404},
+		{apperrors.ErrRoleSelfChange(), 403},
+		{apperrors.ErrRoleLastSuperadmin(), 409},
+		{fmt.Errorf("db error"), 500},
+	} {
+		userSvc := &testhelpers.MockUserService{
+			SetUserRoleFn: func(_, _ uint, _ authm.Role) (authm.Role, error) { return "", tc.err },
+		}
+		h := NewAdminRoleHandler(userSvc, &testhelpers.MockAuditLogService{})
+
+		_, err := h.SetUserRoleHandler(adminCtx(), &SetUserRoleRequest{UserID: "5"})
+		testhelpers.AssertHumaError(t, err, tc.status)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
 
@@ -24,6 +25,10 @@ type AdminShowHandler struct {
 	discordService            contracts.DiscordServiceInterface
 	auditLogService           contracts.AuditLogServiceInterface
 	notificationFilterService contracts.NotificationFilterServiceInterface
+	annotationService         contracts.AdminAnnotationServiceInterface
+	// showChangeNotifier tells savers and RSVPs about bulk-cancelled shows.
+	// Optional; see SetShowChangeNotifier.
+	showChangeNotifier contracts.ShowChangeNotifierInterface
 }
 
 // NewAdminShowHandler creates a new admin show handler
@@ -34,6 +39,7 @@ func NewAdminShowHandler(
 	discordService contracts.DiscordServiceInterface,
 	auditLogService contracts.AuditLogServiceInterface,
 	notificationFilterService contracts.NotificationFilterServiceInterface,
+	annotationService contracts.AdminAnnotationServiceInterface,
 ) *AdminShowHandler {
 	return &AdminShowHandler{
 		showService:               showService,
@@ -42,9 +48,16 @@ func NewAdminShowHandler(
 		discordService:            discordService,
 		auditLogService:           auditLogService,
 		notificationFilterService: notificationFilterService,
+		annotationService:         annotationService,
 	}
 }
 
+// SetShowChangeNotifier wires notifications to a show's savers and RSVPs
+// when a bulk action cancels it. Nil-safe: when unset, nobody is told.
+func (h *AdminShowHandler) SetShowChangeNotifier(showChangeNotifier contracts.ShowChangeNotifierInterface) {
+	h.showChangeNotifier = showChangeNotifier
+}
+
 // GetPendingShowsRequest represents the HTTP request for listing pending shows
 type GetPendingShowsRequest struct {
 	Limit   int    `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of shows to return (max 100)"`
@@ -102,6 +115,61 @@ type RejectShowResponse struct {
 	Body contracts.ShowResponse `json:"body"`
 }
 
+// RestoreShowRequest represents the HTTP request for restoring a deleted show
+type RestoreShowRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+}
+
+// RestoreShowResponse represents the HTTP response for restoring a deleted show
+type RestoreShowResponse struct {
+	Body contracts.ShowResponse `json:"body"`
+}
+
+// GetShowDuplicatesRequest represents the HTTP request for a show's duplicate candidates
+type GetShowDuplicatesRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+}
+
+// GetShowDuplicatesResponse represents the HTTP response for a show's duplicate candidates
+type GetShowDuplicatesResponse struct {
+	Body struct {
+		Candidates []contracts.DuplicateCandidate `json:"candidates"`
+	}
+}
+
+// GetShowTicketStatusRequest represents the HTTP request for a show's ticket status
+type GetShowTicketStatusRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+}
+
+// GetShowTicketStatusResponse represents the HTTP response for a show's ticket status
+type GetShowTicketStatusResponse struct {
+	Body contracts.ShowTicketStatusResponse `json:"body"`
+}
+
+// GetShowShortLinkStatsRequest represents the HTTP request for a show's short link stats
+type GetShowShortLinkStatsRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+}
+
+// GetShowShortLinkStatsResponse represents the HTTP response for a show's short link stats
+type GetShowShortLinkStatsResponse struct {
+	Body contracts.ShowShortLinkStatsResponse `json:"body"`
+}
+
+// SetShowDuplicateOfRequest represents the HTTP request for linking a pending show to the show it duplicates
+type SetShowDuplicateOfRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+	Body   struct {
+		DuplicateOfShowID *uint `json:"duplicate_of_show_id" required:"false" doc:"ID of the existing show; omit or null to clear the link"`
+	}
+}
+
+// SetShowDuplicateOfResponse represents the HTTP response for linking a duplicate show
+type SetShowDuplicateOfResponse struct {
+	Body contracts.ShowResponse `json:"body"`
+}
+
 // BatchApproveShowsRequest represents the HTTP request for batch approving shows
 type BatchApproveShowsRequest struct {
 	Body struct {
@@ -304,7 +372,8 @@ func (h *AdminShowHandler) ApproveShowHandler(ctx context.Context, req *ApproveS
 	// Fire-and-forget: match notification filters for this newly approved show
 	if h.notificationFilterService != nil {
 		servicesshared.GoSafe(ctx, "notification_filter_match", func() {
-			showModel := &catalogm.Show{ID: uint(showID), Title: show.Title, EventDate: show.EventDate, Price: show.Price, Slug: shared.PtrString(show.Slug)}
+			showModel := &catalogm.Show{ID: uint(showID), Title: show.Title, EventDate: show.EventDate, Slug: shared.PtrString(show.Slug),
+				PriceMin: show.PriceMin, PriceMax: show.PriceMax, PriceCurrency: show.PriceCurrency, IsFree: show.IsFree}
 			if show.City != nil {
 				showModel.City = show.City
 			}
@@ -380,6 +449,171 @@ func (h *AdminShowHandler) RejectShowHandler(ctx context.Context, req *RejectSho
 	return &RejectShowResponse{Body: *show}, nil
 }
 
+// RestoreShowHandler handles POST /admin/shows/{show_id}/restore.
+// Only soft-deleted shows that have not yet been purged by the retention
+// sweep can be restored.
+func (h *AdminShowHandler) RestoreShowHandler(ctx context.Context, req *RestoreShowRequest) (*RestoreShowResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	show, err := h.showAdminService.RestoreShow(uint(showID))
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_restore_show_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to restore show (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("admin_restore_show_success",
+		"show_id", showID,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	h.auditLogService.LogAction(user.ID, "restore_show", "show", uint(showID), nil)
+
+	return &RestoreShowResponse{Body: *show}, nil
+}
+
+// GetShowDuplicatesHandler handles GET /admin/shows/{show_id}/duplicates
+func (h *AdminShowHandler) GetShowDuplicatesHandler(ctx context.Context, req *GetShowDuplicatesRequest) (*GetShowDuplicatesResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	candidates, err := h.showAdminService.FindDuplicateCandidates(uint(showID))
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_show_duplicates_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to find duplicate shows (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &GetShowDuplicatesResponse{}
+	resp.Body.Candidates = candidates
+	return resp, nil
+}
+
+// GetShowTicketStatusHandler handles GET /admin/shows/{show_id}/ticket-status
+// Returns the sold-out flag, when the ticket page was last checked and the
+// flag's history.
+func (h *AdminShowHandler) GetShowTicketStatusHandler(ctx context.Context, req *GetShowTicketStatusRequest) (*GetShowTicketStatusResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	status, err := h.showAdminService.GetShowTicketStatus(uint(showID))
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_show_ticket_status_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get ticket status (request_id: %s)", requestID),
+		)
+	}
+
+	return &GetShowTicketStatusResponse{Body: *status}, nil
+}
+
+// GetShowShortLinkStatsHandler handles GET /admin/shows/{show_id}/short-link-stats
+// Returns the show's short code and its click-throughs by referrer.
+func (h *AdminShowHandler) GetShowShortLinkStatsHandler(ctx context.Context, req *GetShowShortLinkStatsRequest) (*GetShowShortLinkStatsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	stats, err := h.showAdminService.GetShowShortLinkStats(uint(showID))
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_show_short_link_stats_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get short link stats (request_id: %s)", requestID),
+		)
+	}
+
+	return &GetShowShortLinkStatsResponse{Body: *stats}, nil
+}
+
+// SetShowDuplicateOfHandler handles PUT /admin/shows/{show_id}/duplicate-of
+func (h *AdminShowHandler) SetShowDuplicateOfHandler(ctx context.Context, req *SetShowDuplicateOfRequest) (*SetShowDuplicateOfResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	show, err := h.showAdminService.SetShowDuplicateOf(uint(showID), req.Body.DuplicateOfShowID)
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("admin_set_show_duplicate_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to link duplicate show (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("admin_set_show_duplicate_success",
+		"show_id", showID,
+		"duplicate_of_show_id", req.Body.DuplicateOfShowID,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	h.auditLogService.LogAction(user.ID, "link_duplicate_show", "show", uint(showID), map[string]interface{}{
+		"duplicate_of_show_id": req.Body.DuplicateOfShowID,
+	})
+
+	return &SetShowDuplicateOfResponse{Body: *show}, nil
+}
+
 // BatchApproveShowsHandler handles POST /admin/shows/batch-approve
 func (h *AdminShowHandler) BatchApproveShowsHandler(ctx context.Context, req *BatchApproveShowsRequest) (*BatchApproveShowsResponse, error) {
 	user := middleware.GetUserFromContext(ctx)
@@ -397,29 +631,7 @@ func (h *AdminShowHandler) BatchApproveShowsHandler(ctx context.Context, req *Ba
 	}
 
 	// Fire-and-forget: match notification filters for batch-approved shows
-	if h.notificationFilterService != nil && len(result.Succeeded) > 0 {
-		servicesshared.GoSafe(ctx, "notification_filter_match", func() {
-			for _, showID := range result.Succeeded {
-				show, err := h.showService.GetShow(showID)
-				if err != nil || show == nil {
-					continue
-				}
-				showModel := &catalogm.Show{ID: showID, Title: show.Title, EventDate: show.EventDate, Price: show.Price, Slug: shared.PtrString(show.Slug)}
-				if show.City != nil {
-					showModel.City = show.City
-				}
-				if show.State != nil {
-					showModel.State = show.State
-				}
-				if err := h.notificationFilterService.MatchAndNotify(showModel); err != nil {
-					logger.Default().Error("notification_filter_batch_match_failed",
-						"show_id", showID,
-						"error", err.Error(),
-					)
-				}
-			}
-		})
-	}
+	h.matchNotificationFiltersAsync(ctx, result.Succeeded)
 
 	logger.FromContext(ctx).Info("admin_batch_approve_shows",
 		"approved", len(result.Succeeded),
@@ -478,6 +690,154 @@ func (h *AdminShowHandler) BatchRejectShowsHandler(ctx context.Context, req *Bat
 	}, nil
 }
 
+// matchNotificationFiltersAsync runs notification-filter matching for newly
+// approved shows in the background (fire-and-forget).
+func (h *AdminShowHandler) matchNotificationFiltersAsync(ctx context.Context, showIDs []uint) {
+	matchNotificationFiltersAsync(ctx, h.showService, h.notificationFilterService, showIDs)
+}
+
+// notifyShowChangesAsync tells the savers and RSVPs of each bulk-cancelled
+// show, the same as a single cancel does (fire-and-forget).
+func (h *AdminShowHandler) notifyShowChangesAsync(ctx context.Context, showIDs []uint, actorID uint) {
+	if h.showChangeNotifier == nil || len(showIDs) == 0 {
+		return
+	}
+	servicesshared.GoSafe(ctx, "notify_show_change", func() {
+		for _, showID := range showIDs {
+			if err := h.showChangeNotifier.NotifyShowChange(showID, actorID); err != nil {
+				logger.Default().Error("notify_show_change_failed",
+					"show_id", showID,
+					"error", err.Error(),
+				)
+			}
+		}
+	})
+}
+
+// matchNotificationFiltersAsync is the handler-independent form, shared with
+// the admin command batch endpoint.
+func matchNotificationFiltersAsync(ctx context.Context, showService contracts.ShowServiceInterface, notificationFilterService contracts.NotificationFilterServiceInterface, showIDs []uint) {
+	if notificationFilterService == nil || len(showIDs) == 0 {
+		return
+	}
+	servicesshared.GoSafe(ctx, "notification_filter_match", func() {
+		for _, showID := range showIDs {
+			show, err := showService.GetShow(showID)
+			if err != nil || show == nil {
+				continue
+			}
+			showModel := &catalogm.Show{ID: showID, Title: show.Title, EventDate: show.EventDate, Slug: shared.PtrString(show.Slug),
+				PriceMin: show.PriceMin, PriceMax: show.PriceMax, PriceCurrency: show.PriceCurrency, IsFree: show.IsFree}
+			if show.City != nil {
+				showModel.City = show.City
+			}
+			if show.State != nil {
+				showModel.State = show.State
+			}
+			if err := notificationFilterService.MatchAndNotify(showModel); err != nil {
+				logger.Default().Error("notification_filter_batch_match_failed",
+					"show_id", showID,
+					"error", err.Error(),
+				)
+			}
+		}
+	})
+}
+
+// BulkShowActionRequest represents the HTTP request for a bulk show action
+type BulkShowActionRequest struct {
+	Body struct {
+		ShowIDs  []uint `json:"show_ids" minItems:"1" maxItems:"100" doc:"List of show IDs to act on"`
+		Action   string `json:"action" enum:"approve,reject,cancel,delete" doc:"Action to apply to every show"`
+		Reason   string `json:"reason,omitempty" maxLength:"1000" doc:"Rejection reason (required for reject); optional cancellation reason for cancel"`
+		Category string `json:"category,omitempty" enum:"non_music,duplicate,bad_data,past_event,other" doc:"Rejection category (reject only)"`
+	}
+}
+
+// BulkShowActionResponse represents the HTTP response for a bulk show action
+type BulkShowActionResponse struct {
+	Body contracts.BulkShowActionResult
+}
+
+// bulkShowAuditActions maps a bulk action to the audit log action recorded
+// for each show it succeeded on.
+var bulkShowAuditActions = map[string]string{
+	contracts.BulkShowActionApprove: "approve_show",
+	contracts.BulkShowActionReject:  "reject_show",
+	contracts.BulkShowActionCancel:  "cancel_show",
+	contracts.BulkShowActionDelete:  "delete_show",
+}
+
+// BulkShowActionHandler handles POST /admin/shows/bulk.
+// Each show is processed in its own transaction; the response reports the
+// outcome per show. Successful items are audit-logged individually and the
+// whole batch is announced in a single Discord message.
+func (h *AdminShowHandler) BulkShowActionHandler(ctx context.Context, req *BulkShowActionRequest) (*BulkShowActionResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	if req.Body.Action == contracts.BulkShowActionReject && strings.TrimSpace(req.Body.Reason) == "" {
+		return nil, huma.Error422UnprocessableEntity("Rejection reason is required")
+	}
+
+	result, err := h.showAdminService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs:  req.Body.ShowIDs,
+		Action:   req.Body.Action,
+		Reason:   req.Body.Reason,
+		Category: req.Body.Category,
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_bulk_show_action_failed",
+			"action", req.Body.Action,
+			"admin_id", user.ID,
+			"error", err.Error(),
+		)
+		return nil, huma.Error500InternalServerError("Failed to apply bulk show action")
+	}
+
+	auditAction := bulkShowAuditActions[result.Action]
+	var succeeded []uint
+	for _, item := range result.Results {
+		if !item.Success {
+			continue
+		}
+		succeeded = append(succeeded, item.ShowID)
+		metadata := map[string]interface{}{
+			"bulk": true,
+		}
+		if result.Action == contracts.BulkShowActionReject {
+			metadata["reason"] = req.Body.Reason
+			metadata["category"] = req.Body.Category
+		}
+		if result.Action == contracts.BulkShowActionCancel && req.Body.Reason != "" {
+			metadata["reason"] = req.Body.Reason
+		}
+		if result.Action == contracts.BulkShowActionDelete {
+			metadata["title"] = item.Title
+		}
+		h.auditLogService.LogAction(user.ID, auditAction, "show", item.ShowID, metadata)
+	}
+
+	switch result.Action {
+	case contracts.BulkShowActionApprove:
+		h.matchNotificationFiltersAsync(ctx, succeeded)
+	case contracts.BulkShowActionCancel:
+		h.notifyShowChangesAsync(ctx, succeeded, user.ID)
+	}
+
+	if h.discordService != nil {
+		h.discordService.NotifyBulkShowAction(result, shared.Deref(user.Email))
+	}
+
+	logger.FromContext(ctx).Info("admin_bulk_show_action",
+		"action", result.Action,
+		"succeeded", result.Succeeded,
+		"failed", result.Failed,
+		"admin_id", user.ID,
+	)
+
+	return &BulkShowActionResponse{Body: *result}, nil
+}
+
 // ============================================================================
 // Show Import Admin Handlers
 // ============================================================================
@@ -609,13 +969,15 @@ type GetAdminShowsRequest struct {
 	FromDate string `query:"from_date" doc:"Filter shows from this date (RFC3339 format)"`
 	ToDate   string `query:"to_date" doc:"Filter shows until this date (RFC3339 format)"`
 	City     string `query:"city" doc:"Filter by city"`
+	Tag      string `query:"tag" doc:"Filter by internal admin tag label (case-insensitive)"`
 }
 
 // GetAdminShowsResponse represents the HTTP response for listing all shows (admin)
 type GetAdminShowsResponse struct {
 	Body struct {
-		Shows []*contracts.ShowResponse `json:"shows"`
-		Total int64                     `json:"total"`
+		Shows       []*contracts.ShowResponse                  `json:"shows"`
+		Total       int64                                      `json:"total"`
+		Annotations map[uint]*contracts.AdminAnnotationSummary `json:"annotations" doc:"Admin tags and note counts by show ID; shows without any are omitted"`
 	}
 }
 
@@ -645,6 +1007,7 @@ func (h *AdminShowHandler) GetAdminShowsHandler(ctx context.Context, req *GetAdm
 		"from_date", req.FromDate,
 		"to_date", req.ToDate,
 		"city", req.City,
+		"tag", req.Tag,
 	)
 
 	// Build filters
@@ -653,6 +1016,7 @@ func (h *AdminShowHandler) GetAdminShowsHandler(ctx context.Context, req *GetAdm
 		FromDate: req.FromDate,
 		ToDate:   req.ToDate,
 		City:     req.City,
+		Tag:      req.Tag,
 	}
 
 	// Get shows
@@ -672,15 +1036,16 @@ func (h *AdminShowHandler) GetAdminShowsHandler(ctx context.Context, req *GetAdm
 		"total", total,
 	)
 
-	return &GetAdminShowsResponse{
-		Body: struct {
-			Shows []*contracts.ShowResponse `json:"shows"`
-			Total int64                     `json:"total"`
-		}{
-			Shows: shows,
-			Total: total,
-		},
-	}, nil
+	showIDs := make([]uint, len(shows))
+	for i, show := range shows {
+		showIDs[i] = show.ID
+	}
+
+	resp := &GetAdminShowsResponse{}
+	resp.Body.Shows = shows
+	resp.Body.Total = total
+	resp.Body.Annotations = annotationSummaries(ctx, h.annotationService, "show", showIDs)
+	return resp, nil
 }
 
 // BulkExportShowsRequest represents the HTTP request for bulk exporting shows
@@ -3,9 +3,12 @@ package admin
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 
+	"psychic-homily-backend/internal/api/handlers/shared"
 	"psychic-homily-backend/internal/api/middleware"
 	"psychic-homily-backend/internal/logger"
 	"psychic-homily-backend/internal/services/contracts"
@@ -97,3 +100,183 @@ func (h *AdminStatsHandler) GetActivityFeedHandler(ctx context.Context, req *Get
 
 	return &GetActivityFeedResponse{Body: *feed}, nil
 }
+
+// reviewQueueDefaultDays is the window used when no from date is given.
+const reviewQueueDefaultDays = 30
+
+// GetReviewQueueStatsRequest represents the HTTP request for review-queue analytics
+type GetReviewQueueStatsRequest struct {
+	FromDate string `query:"from_date" doc:"Start of the range (YYYY-MM-DD). Defaults to 30 days before to_date."`
+	ToDate   string `query:"to_date" doc:"End of the range, inclusive (YYYY-MM-DD). Defaults to today."`
+}
+
+// GetReviewQueueStatsResponse represents the HTTP response for review-queue analytics
+type GetReviewQueueStatsResponse struct {
+	Body contracts.ReviewQueueStats
+}
+
+// GetReviewQueueStatsHandler handles GET /admin/stats/review-queue
+func (h *AdminStatsHandler) GetReviewQueueStatsHandler(ctx context.Context, req *GetReviewQueueStatsRequest) (*GetReviewQueueStatsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	// The range is [from, to+1 day) so to_date covers the whole day.
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.ToDate != "" {
+		parsed, err := shared.ParseDate(req.ToDate)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid to_date format, expected YYYY-MM-DD")
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -reviewQueueDefaultDays)
+	if req.FromDate != "" {
+		parsed, err := shared.ParseDate(req.FromDate)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid from_date format, expected YYYY-MM-DD")
+		}
+		from = parsed
+	}
+	if from.After(to) {
+		return nil, huma.Error400BadRequest("from_date must not be after to_date")
+	}
+	to = to.AddDate(0, 0, 1)
+
+	logger.FromContext(ctx).Debug("admin_review_queue_stats_attempt",
+		"admin_id", user.ID,
+		"from", from,
+		"to", to,
+	)
+
+	stats, err := h.adminStatsService.GetReviewQueueStats(from, to)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_review_queue_stats_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get review queue stats (request_id: %s)", requestID),
+		)
+	}
+
+	return &GetReviewQueueStatsResponse{Body: *stats}, nil
+}
+
+// maxStatsTimeSeriesPoints caps the buckets one time-series request asks for.
+const maxStatsTimeSeriesPoints = 1000
+
+// statsIntervalDays is each interval's length in days, for the point cap.
+var statsIntervalDays = map[string]int{
+	contracts.StatsIntervalDay:   1,
+	contracts.StatsIntervalWeek:  7,
+	contracts.StatsIntervalMonth: 30,
+}
+
+// GetStatsTimeSeriesRequest represents the HTTP request for a dashboard time series
+type GetStatsTimeSeriesRequest struct {
+	Metric   string `query:"metric" required:"true" enum:"shows_submitted,shows_approved,signups,reports" doc:"What to count"`
+	Interval string `query:"interval" default:"day" enum:"day,week,month" doc:"Bucket size (UTC; weeks start on Monday)"`
+	Range    string `query:"range" default:"90d" pattern:"^[1-9][0-9]{0,2}[dwmy]$" doc:"How far back to go: a count of days, weeks, months or years, e.g. 90d, 12w, 6m, 1y"`
+}
+
+// GetStatsTimeSeriesResponse represents the HTTP response for a dashboard time series
+type GetStatsTimeSeriesResponse struct {
+	Body contracts.StatsTimeSeries
+}
+
+// parseStatsRange returns the time range ago from now, for a range already
+// matched by GetStatsTimeSeriesRequest's pattern.
+func parseStatsRange(rng string, now time.Time) (time.Time, error) {
+	n, err := strconv.Atoi(rng[:len(rng)-1])
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch rng[len(rng)-1] {
+	case 'd':
+		return now.AddDate(0, 0, -n), nil
+	case 'w':
+		return now.AddDate(0, 0, -7*n), nil
+	case 'm':
+		return now.AddDate(0, -n, 0), nil
+	case 'y':
+		return now.AddDate(-n, 0, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("unknown range unit in %q", rng)
+}
+
+// GetStatsTimeSeriesHandler handles GET /admin/stats/timeseries
+func (h *AdminStatsHandler) GetStatsTimeSeriesHandler(ctx context.Context, req *GetStatsTimeSeriesRequest) (*GetStatsTimeSeriesResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	interval := req.Interval
+	if interval == "" {
+		interval = contracts.StatsIntervalDay
+	}
+	rng := req.Range
+	if rng == "" {
+		rng = "90d"
+	}
+	now := time.Now().UTC()
+	from, err := parseStatsRange(rng, now)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid range, expected e.g. 90d, 12w, 6m or 1y")
+	}
+	if days := int(now.Sub(from).Hours() / 24); days/statsIntervalDays[interval] > maxStatsTimeSeriesPoints {
+		return nil, huma.Error400BadRequest(
+			fmt.Sprintf("Range is too long for interval %q (at most %d points); use a wider interval", interval, maxStatsTimeSeriesPoints),
+		)
+	}
+
+	series, err := h.adminStatsService.GetStatsTimeSeries(req.Metric, interval, from)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_stats_timeseries_failed",
+			"metric", req.Metric,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get stats time series (request_id: %s)", requestID),
+		)
+	}
+
+	return &GetStatsTimeSeriesResponse{Body: *series}, nil
+}
+
+// GetModerationQueueRequest represents the HTTP request for the unified moderation queue
+type GetModerationQueueRequest struct {
+	Type   string `query:"type" enum:"show,venue_edit,show_report,artist_report,venue_report" doc:"Only return items of this type"`
+	Limit  int    `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of items to return (max 100)"`
+	Offset int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetModerationQueueResponse represents the HTTP response for the unified moderation queue
+type GetModerationQueueResponse struct {
+	Body contracts.ModerationQueue
+}
+
+// GetModerationQueueHandler handles GET /admin/moderation-queue
+func (h *AdminStatsHandler) GetModerationQueueHandler(ctx context.Context, req *GetModerationQueueRequest) (*GetModerationQueueResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	queue, err := h.adminStatsService.GetModerationQueue(req.Type, limit, req.Offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_moderation_queue_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get moderation queue (request_id: %s)", requestID),
+		)
+	}
+
+	return &GetModerationQueueResponse{Body: *queue}, nil
+}
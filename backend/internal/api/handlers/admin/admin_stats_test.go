@@ -3,6 +3,7 @@ package admin
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
 	authm "psychic-homily-backend/internal/models/auth"
@@ -47,3 +48,230 @@ func TestGetActivityFeedHandler_ServiceError(t *testing.T) {
 	_, err := h.GetActivityFeedHandler(ctx, &GetActivityFeedRequest{})
 	testhelpers.AssertHumaError(t, err, 500)
 }
+
+// =============================================================================
+// GetReviewQueueStatsHandler
+// =============================================================================
+
+func TestGetReviewQueueStatsHandler_DateRange(t *testing.T) {
+	var gotFrom, gotTo time.Time
+	mock := &testhelpers.MockAdminStatsService{
+		GetReviewQueueStatsFn: func(from, to time.Time) (*contracts.ReviewQueueStats, error) {
+			gotFrom, gotTo = from, to
+			return &contracts.ReviewQueueStats{From: from, To: to, Approvals: 4}, nil
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	resp, err := h.GetReviewQueueStatsHandler(ctx, &GetReviewQueueStatsRequest{FromDate: "2026-03-01", ToDate: "2026-03-31"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Approvals != 4 {
+		t.Errorf("expected 4 approvals, got %d", resp.Body.Approvals)
+	}
+	if want := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC); !gotFrom.Equal(want) {
+		t.Errorf("from = %v, want %v", gotFrom, want)
+	}
+	// to_date is inclusive, so the service sees the start of the next day.
+	if want := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC); !gotTo.Equal(want) {
+		t.Errorf("to = %v, want %v", gotTo, want)
+	}
+}
+
+func TestGetReviewQueueStatsHandler_DefaultRange(t *testing.T) {
+	var gotFrom, gotTo time.Time
+	mock := &testhelpers.MockAdminStatsService{
+		GetReviewQueueStatsFn: func(from, to time.Time) (*contracts.ReviewQueueStats, error) {
+			gotFrom, gotTo = from, to
+			return &contracts.ReviewQueueStats{}, nil
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	if _, err := h.GetReviewQueueStatsHandler(ctx, &GetReviewQueueStatsRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if days := gotTo.Sub(gotFrom).Hours() / 24; days != reviewQueueDefaultDays+1 {
+		t.Errorf("expected a %d-day window, got %v days", reviewQueueDefaultDays+1, days)
+	}
+	if !gotTo.After(time.Now()) {
+		t.Errorf("expected default range to include today, to = %v", gotTo)
+	}
+}
+
+func TestGetReviewQueueStatsHandler_InvalidParams(t *testing.T) {
+	h := NewAdminStatsHandler(&testhelpers.MockAdminStatsService{})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	tests := []struct {
+		name string
+		req  GetReviewQueueStatsRequest
+	}{
+		{"bad from_date", GetReviewQueueStatsRequest{FromDate: "03/01/2026"}},
+		{"bad to_date", GetReviewQueueStatsRequest{ToDate: "tomorrow"}},
+		{"from after to", GetReviewQueueStatsRequest{FromDate: "2026-04-02", ToDate: "2026-04-01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.GetReviewQueueStatsHandler(ctx, &tt.req)
+			testhelpers.AssertHumaError(t, err, 400)
+		})
+	}
+}
+
+func TestGetReviewQueueStatsHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockAdminStatsService{
+		GetReviewQueueStatsFn: func(from, to time.Time) (*contracts.ReviewQueueStats, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.GetReviewQueueStatsHandler(ctx, &GetReviewQueueStatsRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+// =============================================================================
+// GetStatsTimeSeriesHandler
+// =============================================================================
+
+func TestGetStatsTimeSeriesHandler_PassesMetricIntervalAndRange(t *testing.T) {
+	var gotMetric, gotInterval string
+	var gotFrom time.Time
+	mock := &testhelpers.MockAdminStatsService{
+		GetStatsTimeSeriesFn: func(metric, interval string, from time.Time) (*contracts.StatsTimeSeries, error) {
+			gotMetric, gotInterval, gotFrom = metric, interval, from
+			return &contracts.StatsTimeSeries{Metric: metric, Interval: interval, Total: 3}, nil
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	resp, err := h.GetStatsTimeSeriesHandler(ctx, &GetStatsTimeSeriesRequest{Metric: "signups", Interval: "week", Range: "12w"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 3 || gotMetric != "signups" || gotInterval != "week" {
+		t.Errorf("unexpected call: metric=%q interval=%q total=%d", gotMetric, gotInterval, resp.Body.Total)
+	}
+	if days := time.Since(gotFrom).Hours() / 24; days < 83.9 || days > 84.1 {
+		t.Errorf("expected from 84 days ago, got %v days", days)
+	}
+}
+
+func TestGetStatsTimeSeriesHandler_Defaults(t *testing.T) {
+	var gotInterval string
+	var gotFrom time.Time
+	mock := &testhelpers.MockAdminStatsService{
+		GetStatsTimeSeriesFn: func(metric, interval string, from time.Time) (*contracts.StatsTimeSeries, error) {
+			gotInterval, gotFrom = interval, from
+			return &contracts.StatsTimeSeries{}, nil
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	if _, err := h.GetStatsTimeSeriesHandler(ctx, &GetStatsTimeSeriesRequest{Metric: "reports"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotInterval != "day" {
+		t.Errorf("interval = %q, want day", gotInterval)
+	}
+	if days := time.Since(gotFrom).Hours() / 24; days < 89.9 || days > 90.1 {
+		t.Errorf("expected a 90-day range, got %v days", days)
+	}
+}
+
+func TestGetStatsTimeSeriesHandler_RejectsTooManyPoints(t *testing.T) {
+	h := NewAdminStatsHandler(&testhelpers.MockAdminStatsService{})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.GetStatsTimeSeriesHandler(ctx, &GetStatsTimeSeriesRequest{Metric: "signups", Interval: "day", Range: "5y"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetStatsTimeSeriesHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockAdminStatsService{
+		GetStatsTimeSeriesFn: func(string, string, time.Time) (*contracts.StatsTimeSeries, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.GetStatsTimeSeriesHandler(ctx, &GetStatsTimeSeriesRequest{Metric: "shows_submitted"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+// =============================================================================
+// GetModerationQueueHandler
+// =============================================================================
+
+func TestGetModerationQueueHandler_Success(t *testing.T) {
+	var gotType string
+	var gotLimit, gotOffset int
+	mock := &testhelpers.MockAdminStatsService{
+		GetModerationQueueFn: func(itemType string, limit, offset int) (*contracts.ModerationQueue, error) {
+			gotType, gotLimit, gotOffset = itemType, limit, offset
+			return &contracts.ModerationQueue{
+				Items: []contracts.ModerationQueueItem{
+					{Type: contracts.ModerationItemVenueReport, ID: 3, EntityType: "venue", EntityID: 9, Title: "The Rebel Lounge"},
+				},
+				Total:  1,
+				Counts: map[string]int64{contracts.ModerationItemVenueReport: 1, contracts.ModerationItemShow: 4},
+			}, nil
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	resp, err := h.GetModerationQueueHandler(ctx, &GetModerationQueueRequest{Type: "venue_report", Limit: 20, Offset: 40})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotType != "venue_report" || gotLimit != 20 || gotOffset != 40 {
+		t.Errorf("service called with (%q, %d, %d)", gotType, gotLimit, gotOffset)
+	}
+	if len(resp.Body.Items) != 1 || resp.Body.Items[0].Title != "The Rebel Lounge" {
+		t.Errorf("unexpected items: %+v", resp.Body.Items)
+	}
+	if resp.Body.Counts[contracts.ModerationItemShow] != 4 {
+		t.Errorf("expected 4 pending shows in counts, got %d", resp.Body.Counts[contracts.ModerationItemShow])
+	}
+}
+
+func TestGetModerationQueueHandler_ClampsLimit(t *testing.T) {
+	var gotLimit int
+	mock := &testhelpers.MockAdminStatsService{
+		GetModerationQueueFn: func(itemType string, limit, offset int) (*contracts.ModerationQueue, error) {
+			gotLimit = limit
+			return &contracts.ModerationQueue{}, nil
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	if _, err := h.GetModerationQueueHandler(ctx, &GetModerationQueueRequest{Limit: 500}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotLimit != 100 {
+		t.Errorf("expected limit clamped to 100, got %d", gotLimit)
+	}
+}
+
+func TestGetModerationQueueHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockAdminStatsService{
+		GetModerationQueueFn: func(itemType string, limit, offset int) (*contracts.ModerationQueue, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewAdminStatsHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.GetModerationQueueHandler(ctx, &GetModerationQueueRequest{Limit: 50})
+	testhelpers.AssertHumaError(t, err, 500)
+}
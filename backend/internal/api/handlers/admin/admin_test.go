@@ -9,6 +9,7 @@ import (
 
 	"psychic-homily-backend/internal/api/handlers/shared"
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
 	authm "psychic-homily-backend/internal/models/auth"
 	"psychic-homily-backend/internal/services/contracts"
 )
@@ -18,11 +19,11 @@ import (
 // ============================================================================
 
 func testAdminShowHandler() *AdminShowHandler {
-	return NewAdminShowHandler(nil, nil, nil, nil, nil, nil)
+	return NewAdminShowHandler(nil, nil, nil, nil, nil, nil, nil)
 }
 
 func testAdminVenueHandler() *AdminVenueHandler {
-	return NewAdminVenueHandler(nil, nil)
+	return NewAdminVenueHandler(nil, nil, nil)
 }
 
 func testAdminTokenHandler() *AdminTokenHandler {
@@ -725,6 +726,61 @@ func TestRejectShowHandler_ServiceError(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 422)
 }
 
+func TestRestoreShowHandler_Success(t *testing.T) {
+	var auditAction string
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			RestoreShowFn: func(showID uint) (*contracts.ShowResponse, error) {
+				return &contracts.ShowResponse{ID: showID, Status: "approved"}, nil
+			},
+		}
+		ah.auditLogService = &testhelpers.MockAuditLogService{
+			LogActionFn: func(_ uint, action string, _ string, _ uint, _ map[string]interface{}) {
+				auditAction = action
+			},
+		}
+	})
+	resp, err := h.RestoreShowHandler(adminCtx(), &RestoreShowRequest{ShowID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 42 {
+		t.Errorf("expected ID=42, got %d", resp.Body.ID)
+	}
+	if auditAction != "restore_show" {
+		t.Errorf("expected action='restore_show', got %q", auditAction)
+	}
+}
+
+func TestRestoreShowHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"not found", apperrors.ErrShowNotFound(42), 404},
+		{"not deleted", apperrors.ErrShowValidationFailed("show is not deleted"), 422},
+		{"database", fmt.Errorf("connection reset"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := adminShowHandler(func(ah *AdminShowHandler) {
+				ah.showAdminService = &testhelpers.MockShowAdminService{
+					RestoreShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+						return nil, tt.err
+					},
+				}
+			})
+			_, err := h.RestoreShowHandler(adminCtx(), &RestoreShowRequest{ShowID: "42"})
+			testhelpers.AssertHumaError(t, err, tt.code)
+		})
+	}
+
+	h := adminShowHandler()
+	_, err := h.RestoreShowHandler(adminCtx(), &RestoreShowRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
 func TestVerifyVenueHandler_Success(t *testing.T) {
 	var auditCalled bool
 	h := adminVenueHandler(func(ah *AdminVenueHandler) {
@@ -1159,6 +1215,181 @@ func TestBatchRejectShowsHandler_RequiresReason(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 422)
 }
 
+// ============================================================================
+// Bulk show actions
+// ============================================================================
+
+func TestBulkShowActionHandler_AuditsSuccessesAndNotifiesOnce(t *testing.T) {
+	var audited []uint
+	var auditActions []string
+	notifications := 0
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			BulkShowActionFn: func(req *contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error) {
+				if req.Action != contracts.BulkShowActionCancel {
+					t.Errorf("expected action=cancel, got %q", req.Action)
+				}
+				return &contracts.BulkShowActionResult{
+					Action:    req.Action,
+					Succeeded: 2,
+					Failed:    1,
+					Results: []contracts.BulkShowItemResult{
+						{ShowID: 1, Title: "A", Success: true},
+						{ShowID: 2, Error: "SHOW_NOT_FOUND: show not found"},
+						{ShowID: 3, Title: "C", Success: true},
+					},
+				}, nil
+			},
+		}
+		ah.auditLogService = &testhelpers.MockAuditLogService{
+			LogActionFn: func(_ uint, action string, _ string, entityID uint, _ map[string]interface{}) {
+				audited = append(audited, entityID)
+				auditActions = append(auditActions, action)
+			},
+		}
+		ah.discordService = &testhelpers.MockDiscordService{
+			NotifyBulkShowActionFn: func(result *contracts.BulkShowActionResult, _ string) {
+				notifications++
+				if len(result.Results) != 3 {
+					t.Errorf("expected 3 results in notification, got %d", len(result.Results))
+				}
+			},
+		}
+	})
+
+	req := &BulkShowActionRequest{}
+	req.Body.ShowIDs = []uint{1, 2, 3}
+	req.Body.Action = contracts.BulkShowActionCancel
+	resp, err := h.BulkShowActionHandler(adminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Succeeded != 2 || resp.Body.Failed != 1 {
+		t.Errorf("expected 2 succeeded / 1 failed, got %d / %d", resp.Body.Succeeded, resp.Body.Failed)
+	}
+	if len(audited) != 2 || audited[0] != 1 || audited[1] != 3 {
+		t.Errorf("expected audit entries for shows [1 3], got %v", audited)
+	}
+	for _, a := range auditActions {
+		if a != "cancel_show" {
+			t.Errorf("expected audit action cancel_show, got %q", a)
+		}
+	}
+	if notifications != 1 {
+		t.Errorf("expected exactly 1 Discord notification, got %d", notifications)
+	}
+}
+
+func TestBulkShowActionHandler_CancelNotifiesSavers(t *testing.T) {
+	notified := make(chan [2]uint, 2)
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			BulkShowActionFn: func(req *contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error) {
+				if req.Reason != "Venue closed" {
+					t.Errorf("expected cancellation reason to be passed through, got %q", req.Reason)
+				}
+				return &contracts.BulkShowActionResult{
+					Action:    req.Action,
+					Succeeded: 1,
+					Failed:    1,
+					Results: []contracts.BulkShowItemResult{
+						{ShowID: 1, Title: "A", Success: true},
+						{ShowID: 2, Error: "show is already cancelled"},
+					},
+				}, nil
+			},
+		}
+	})
+	h.SetShowChangeNotifier(&testhelpers.MockShowChangeNotifier{
+		NotifyShowChangeFn: func(showID, actorID uint) error {
+			notified <- [2]uint{showID, actorID}
+			return nil
+		},
+	})
+
+	req := &BulkShowActionRequest{}
+	req.Body.ShowIDs = []uint{1, 2}
+	req.Body.Action = contracts.BulkShowActionCancel
+	req.Body.Reason = "Venue closed"
+	if _, err := h.BulkShowActionHandler(adminCtx(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only the show that was actually cancelled is announced.
+	select {
+	case got := <-notified:
+		if got[0] != 1 {
+			t.Errorf("expected notification for show 1, got %v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected savers of the cancelled show to be notified")
+	}
+	select {
+	case got := <-notified:
+		t.Errorf("unexpected notification for show %d", got[0])
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBulkShowActionHandler_RejectRequiresReason(t *testing.T) {
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			BulkShowActionFn: func(*contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error) {
+				t.Fatal("service should not be called without a reason")
+				return nil, nil
+			},
+		}
+	})
+
+	req := &BulkShowActionRequest{}
+	req.Body.ShowIDs = []uint{1}
+	req.Body.Action = contracts.BulkShowActionReject
+	req.Body.Reason = "   "
+
+	_, err := h.BulkShowActionHandler(adminCtx(), req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestBulkShowActionHandler_PassesRejectFields(t *testing.T) {
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			BulkShowActionFn: func(req *contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error) {
+				if req.Reason != "Not a music event" || req.Category != "non_music" {
+					t.Errorf("unexpected reason/category %q/%q", req.Reason, req.Category)
+				}
+				return &contracts.BulkShowActionResult{Action: req.Action, Results: []contracts.BulkShowItemResult{}}, nil
+			},
+		}
+	})
+
+	req := &BulkShowActionRequest{}
+	req.Body.ShowIDs = []uint{1}
+	req.Body.Action = contracts.BulkShowActionReject
+	req.Body.Reason = "Not a music event"
+	req.Body.Category = "non_music"
+
+	if _, err := h.BulkShowActionHandler(adminCtx(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBulkShowActionHandler_ServiceError(t *testing.T) {
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			BulkShowActionFn: func(*contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error) {
+				return nil, fmt.Errorf("database error")
+			},
+		}
+	})
+
+	req := &BulkShowActionRequest{}
+	req.Body.ShowIDs = []uint{1}
+	req.Body.Action = contracts.BulkShowActionDelete
+
+	_, err := h.BulkShowActionHandler(adminCtx(), req)
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
 // ============================================================================
 // Pending shows with filters (admin_shows.go — filter path coverage)
 // ============================================================================
@@ -1806,3 +2037,169 @@ func TestDataImportHandler_DryRun(t *testing.T) {
 		t.Error("expected dry_run=true to be passed to service")
 	}
 }
+
+func TestGetShowDuplicatesHandler(t *testing.T) {
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			FindDuplicateCandidatesFn: func(showID uint) ([]contracts.DuplicateCandidate, error) {
+				if showID == 404 {
+					return nil, apperrors.ErrShowNotFound(showID)
+				}
+				return []contracts.DuplicateCandidate{{ShowID: 7, Score: 0.9}}, nil
+			},
+		}
+	})
+	resp, err := h.GetShowDuplicatesHandler(adminCtx(), &GetShowDuplicatesRequest{ShowID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Candidates) != 1 || resp.Body.Candidates[0].ShowID != 7 {
+		t.Errorf("unexpected candidates: %+v", resp.Body.Candidates)
+	}
+
+	_, err = h.GetShowDuplicatesHandler(adminCtx(), &GetShowDuplicatesRequest{ShowID: "404"})
+	testhelpers.AssertHumaError(t, err, 404)
+
+	_, err = h.GetShowDuplicatesHandler(adminCtx(), &GetShowDuplicatesRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestSetShowDuplicateOfHandler_Success(t *testing.T) {
+	var auditAction string
+	var gotTarget *uint
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			SetShowDuplicateOfFn: func(showID uint, duplicateOfShowID *uint) (*contracts.ShowResponse, error) {
+				gotTarget = duplicateOfShowID
+				return &contracts.ShowResponse{ID: showID, DuplicateOfShowID: duplicateOfShowID}, nil
+			},
+		}
+		ah.auditLogService = &testhelpers.MockAuditLogService{
+			LogActionFn: func(_ uint, action string, _ string, _ uint, _ map[string]interface{}) {
+				auditAction = action
+			},
+		}
+	})
+	req := &SetShowDuplicateOfRequest{ShowID: "42"}
+	target := uint(7)
+	req.Body.DuplicateOfShowID = &target
+
+	resp, err := h.SetShowDuplicateOfHandler(adminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotTarget == nil || *gotTarget != 7 {
+		t.Errorf("expected target 7, got %v", gotTarget)
+	}
+	if resp.Body.DuplicateOfShowID == nil || *resp.Body.DuplicateOfShowID != 7 {
+		t.Errorf("expected duplicate_of_show_id=7, got %v", resp.Body.DuplicateOfShowID)
+	}
+	if auditAction != "link_duplicate_show" {
+		t.Errorf("expected action='link_duplicate_show', got %q", auditAction)
+	}
+}
+
+func TestSetShowDuplicateOfHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"not found", apperrors.ErrShowNotFound(42), 404},
+		{"not pending", apperrors.ErrShowValidationFailed("only pending shows can be linked as duplicates"), 422},
+		{"database", fmt.Errorf("connection reset"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := adminShowHandler(func(ah *AdminShowHandler) {
+				ah.showAdminService = &testhelpers.MockShowAdminService{
+					SetShowDuplicateOfFn: func(_ uint, _ *uint) (*contracts.ShowResponse, error) {
+						return nil, tt.err
+					},
+				}
+			})
+			_, err := h.SetShowDuplicateOfHandler(adminCtx(), &SetShowDuplicateOfRequest{ShowID: "42"})
+			testhelpers.AssertHumaError(t, err, tt.code)
+		})
+	}
+
+	h := adminShowHandler()
+	_, err := h.SetShowDuplicateOfHandler(adminCtx(), &SetShowDuplicateOfRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetShowTicketStatusHandler(t *testing.T) {
+	checkedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			GetShowTicketStatusFn: func(showID uint) (*contracts.ShowTicketStatusResponse, error) {
+				switch showID {
+				case 404:
+					return nil, apperrors.ErrShowNotFound(showID)
+				case 500:
+					return nil, fmt.Errorf("db down")
+				}
+				return &contracts.ShowTicketStatusResponse{
+					ShowID:        showID,
+					IsSoldOut:     true,
+					LastCheckedAt: &checkedAt,
+					Changes:       []contracts.ShowTicketStatusChangeResponse{{IsSoldOut: true, Source: "ticket_check"}},
+				}, nil
+			},
+		}
+	})
+	resp, err := h.GetShowTicketStatusHandler(adminCtx(), &GetShowTicketStatusRequest{ShowID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ShowID != 42 || !resp.Body.IsSoldOut || len(resp.Body.Changes) != 1 {
+		t.Errorf("unexpected ticket status: %+v", resp.Body)
+	}
+
+	_, err = h.GetShowTicketStatusHandler(adminCtx(), &GetShowTicketStatusRequest{ShowID: "404"})
+	testhelpers.AssertHumaError(t, err, 404)
+
+	_, err = h.GetShowTicketStatusHandler(adminCtx(), &GetShowTicketStatusRequest{ShowID: "500"})
+	testhelpers.AssertHumaError(t, err, 500)
+
+	_, err = h.GetShowTicketStatusHandler(adminCtx(), &GetShowTicketStatusRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetShowShortLinkStatsHandler(t *testing.T) {
+	code := "0k3x9ab"
+	h := adminShowHandler(func(ah *AdminShowHandler) {
+		ah.showAdminService = &testhelpers.MockShowAdminService{
+			GetShowShortLinkStatsFn: func(showID uint) (*contracts.ShowShortLinkStatsResponse, error) {
+				switch showID {
+				case 404:
+					return nil, apperrors.ErrShowNotFound(showID)
+				case 500:
+					return nil, fmt.Errorf("db down")
+				}
+				return &contracts.ShowShortLinkStatsResponse{
+					ShowID:      showID,
+					ShortCode:   &code,
+					TotalClicks: 3,
+					Referrers:   []contracts.ShowShortLinkReferrerStats{{Referrer: "instagram.com", Clicks: 2}, {Referrer: "direct", Clicks: 1}},
+				}, nil
+			},
+		}
+	})
+	resp, err := h.GetShowShortLinkStatsHandler(adminCtx(), &GetShowShortLinkStatsRequest{ShowID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ShowID != 42 || resp.Body.TotalClicks != 3 || len(resp.Body.Referrers) != 2 {
+		t.Errorf("unexpected short link stats: %+v", resp.Body)
+	}
+
+	_, err = h.GetShowShortLinkStatsHandler(adminCtx(), &GetShowShortLinkStatsRequest{ShowID: "404"})
+	testhelpers.AssertHumaError(t, err, 404)
+
+	_, err = h.GetShowShortLinkStatsHandler(adminCtx(), &GetShowShortLinkStatsRequest{ShowID: "500"})
+	testhelpers.AssertHumaError(t, err, 500)
+
+	_, err = h.GetShowShortLinkStatsHandler(adminCtx(), &GetShowShortLinkStatsRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 
 	"psychic-homily-backend/internal/logger"
+	authm "psychic-homily-backend/internal/models/auth"
 	"psychic-homily-backend/internal/services/contracts"
 )
 
@@ -29,6 +30,7 @@ type GetAdminUsersRequest struct {
 	Limit  int    `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of users to return (max 100)"`
 	Offset int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
 	Search string `query:"search" maxLength:"200" doc:"Search by email or username"`
+	Role   string `query:"role" enum:"user,moderator,admin,superadmin" doc:"Only users with this role"`
 }
 
 // GetAdminUsersResponse represents the HTTP response for listing users
@@ -66,6 +68,7 @@ func (h *AdminUserHandler) GetAdminUsersHandler(ctx context.Context, req *GetAdm
 	// Build filters
 	filters := contracts.AdminUserFilters{
 		Search: req.Search,
+		Role:   authm.Role(req.Role),
 	}
 
 	// Get users
@@ -14,18 +14,21 @@ import (
 
 // AdminVenueHandler handles admin venue management
 type AdminVenueHandler struct {
-	venueService    contracts.VenueServiceInterface
-	auditLogService contracts.AuditLogServiceInterface
+	venueService      contracts.VenueServiceInterface
+	auditLogService   contracts.AuditLogServiceInterface
+	annotationService contracts.AdminAnnotationServiceInterface
 }
 
 // NewAdminVenueHandler creates a new admin venue handler
 func NewAdminVenueHandler(
 	venueService contracts.VenueServiceInterface,
 	auditLogService contracts.AuditLogServiceInterface,
+	annotationService contracts.AdminAnnotationServiceInterface,
 ) *AdminVenueHandler {
 	return &AdminVenueHandler{
-		venueService:    venueService,
-		auditLogService: auditLogService,
+		venueService:      venueService,
+		auditLogService:   auditLogService,
+		annotationService: annotationService,
 	}
 }
 
@@ -48,8 +51,9 @@ type GetUnverifiedVenuesRequest struct {
 // GetUnverifiedVenuesResponse represents the HTTP response for listing unverified venues
 type GetUnverifiedVenuesResponse struct {
 	Body struct {
-		Venues []*contracts.UnverifiedVenueResponse `json:"venues"`
-		Total  int64                                `json:"total"`
+		Venues      []*contracts.UnverifiedVenueResponse       `json:"venues"`
+		Total       int64                                      `json:"total"`
+		Annotations map[uint]*contracts.AdminAnnotationSummary `json:"annotations" doc:"Admin tags and note counts by venue ID; venues without any are omitted"`
 	}
 }
 
@@ -136,8 +140,14 @@ func (h *AdminVenueHandler) GetUnverifiedVenuesHandler(ctx context.Context, req
 		"total", total,
 	)
 
+	venueIDs := make([]uint, len(venues))
+	for i, venue := range venues {
+		venueIDs[i] = venue.ID
+	}
+
 	resp := &GetUnverifiedVenuesResponse{}
 	resp.Body.Venues = venues
 	resp.Body.Total = total
+	resp.Body.Annotations = annotationSummaries(ctx, h.annotationService, "venue", venueIDs)
 	return resp, nil
 }
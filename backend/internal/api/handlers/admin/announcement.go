@@ -0,0 +1,265 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// AnnouncementHandler handles site-wide announcement banners: admin
+// management plus the public read and per-user dismissal the site uses
+type AnnouncementHandler struct {
+	announcementService contracts.AnnouncementServiceInterface
+	auditLogService     contracts.AuditLogServiceInterface
+}
+
+// NewAnnouncementHandler creates a new announcement handler
+func NewAnnouncementHandler(
+	announcementService contracts.AnnouncementServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *AnnouncementHandler {
+	return &AnnouncementHandler{
+		announcementService: announcementService,
+		auditLogService:     auditLogService,
+	}
+}
+
+// ListAnnouncementsRequest represents the request for listing announcements
+type ListAnnouncementsRequest struct{}
+
+// ListAnnouncementsResponse represents the response for listing announcements
+type ListAnnouncementsResponse struct {
+	Body struct {
+		Announcements []*contracts.AnnouncementResponse `json:"announcements" doc:"Announcements, latest start first"`
+		Count         int                               `json:"count" doc:"Number of announcements"`
+	}
+}
+
+// ListAnnouncementsHandler handles GET /admin/announcements
+func (h *AnnouncementHandler) ListAnnouncementsHandler(ctx context.Context, _ *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	announcements, err := h.announcementService.ListAnnouncements()
+	if err != nil {
+		logger.FromContext(ctx).Error("list_announcements_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to list announcements (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &ListAnnouncementsResponse{}
+	resp.Body.Announcements = announcements
+	resp.Body.Count = len(announcements)
+	return resp, nil
+}
+
+// CreateAnnouncementRequest represents the request for creating an announcement
+type CreateAnnouncementRequest struct {
+	Body struct {
+		Message     string     `json:"message" doc:"Banner text" example:"The site will be down for maintenance Sunday 2-4am MST"`
+		Severity    string     `json:"severity,omitempty" required:"false" enum:"info,warning,critical" doc:"Banner style (default info)"`
+		StartsAt    *time.Time `json:"starts_at,omitempty" required:"false" doc:"When the banner starts showing (default now)"`
+		EndsAt      *time.Time `json:"ends_at,omitempty" required:"false" doc:"When the banner stops showing (default: when expired)"`
+		Dismissible bool       `json:"dismissible,omitempty" required:"false" doc:"Signed-in users can close the banner for good"`
+	}
+}
+
+// AnnouncementResponse represents a single announcement response
+type AnnouncementResponse struct {
+	Body *contracts.AnnouncementResponse
+}
+
+// CreateAnnouncementHandler handles POST /admin/announcements
+func (h *AnnouncementHandler) CreateAnnouncementHandler(ctx context.Context, req *CreateAnnouncementRequest) (*AnnouncementResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	announcement, err := h.announcementService.CreateAnnouncement(&contracts.CreateAnnouncementRequest{
+		Message:     req.Body.Message,
+		Severity:    req.Body.Severity,
+		StartsAt:    req.Body.StartsAt,
+		EndsAt:      req.Body.EndsAt,
+		Dismissible: req.Body.Dismissible,
+	}, user.ID)
+	if err != nil {
+		if mapped := shared.MapAnnouncementError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("create_announcement_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to create announcement (request_id: %s)", requestID),
+		)
+	}
+
+	h.logAnnouncementChange(user.ID, "create_announcement", announcement)
+	return &AnnouncementResponse{Body: announcement}, nil
+}
+
+// UpdateAnnouncementRequest represents the request for updating an
+// announcement. Omitted fields are left as they are.
+type UpdateAnnouncementRequest struct {
+	AnnouncementID uint `path:"announcement_id" doc:"Announcement ID" example:"1"`
+	Body           struct {
+		Message     *string    `json:"message,omitempty" required:"false" doc:"Banner text"`
+		Severity    *string    `json:"severity,omitempty" required:"false" enum:"info,warning,critical" doc:"Banner style"`
+		StartsAt    *time.Time `json:"starts_at,omitempty" required:"false" doc:"When the banner starts showing"`
+		EndsAt      *time.Time `json:"ends_at,omitempty" required:"false" doc:"When the banner stops showing"`
+		Dismissible *bool      `json:"dismissible,omitempty" required:"false" doc:"Signed-in users can close the banner for good"`
+	}
+}
+
+// UpdateAnnouncementHandler handles PATCH /admin/announcements/{announcement_id}
+func (h *AnnouncementHandler) UpdateAnnouncementHandler(ctx context.Context, req *UpdateAnnouncementRequest) (*AnnouncementResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	announcement, err := h.announcementService.UpdateAnnouncement(req.AnnouncementID, &contracts.UpdateAnnouncementRequest{
+		Message:     req.Body.Message,
+		Severity:    req.Body.Severity,
+		StartsAt:    req.Body.StartsAt,
+		EndsAt:      req.Body.EndsAt,
+		Dismissible: req.Body.Dismissible,
+	}, user.ID)
+	if err != nil {
+		if mapped := shared.MapAnnouncementError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("update_announcement_failed",
+			"announcement_id", req.AnnouncementID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to update announcement (request_id: %s)", requestID),
+		)
+	}
+
+	h.logAnnouncementChange(user.ID, "update_announcement", announcement)
+	return &AnnouncementResponse{Body: announcement}, nil
+}
+
+// ExpireAnnouncementRequest represents the request for expiring an announcement
+type ExpireAnnouncementRequest struct {
+	AnnouncementID uint `path:"announcement_id" doc:"Announcement ID" example:"1"`
+}
+
+// ExpireAnnouncementHandler handles POST /admin/announcements/{announcement_id}/expire
+func (h *AnnouncementHandler) ExpireAnnouncementHandler(ctx context.Context, req *ExpireAnnouncementRequest) (*AnnouncementResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	announcement, err := h.announcementService.ExpireAnnouncement(req.AnnouncementID, user.ID)
+	if err != nil {
+		if mapped := shared.MapAnnouncementError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("expire_announcement_failed",
+			"announcement_id", req.AnnouncementID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to expire announcement (request_id: %s)", requestID),
+		)
+	}
+
+	h.logAnnouncementChange(user.ID, "expire_announcement", announcement)
+	return &AnnouncementResponse{Body: announcement}, nil
+}
+
+// GetActiveAnnouncementsRequest represents the request for the active banners
+type GetActiveAnnouncementsRequest struct{}
+
+// GetActiveAnnouncementsResponse represents the response for the active banners
+type GetActiveAnnouncementsResponse struct {
+	Body struct {
+		Announcements []*contracts.ActiveAnnouncementResponse `json:"announcements" doc:"Banners to show, most severe first"`
+	}
+}
+
+// GetActiveAnnouncementsHandler handles GET /announcements/active. Signed-in
+// callers don't get the dismissible banners they have dismissed.
+func (h *AnnouncementHandler) GetActiveAnnouncementsHandler(ctx context.Context, _ *GetActiveAnnouncementsRequest) (*GetActiveAnnouncementsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	var userID uint
+	if user := middleware.GetUserFromContext(ctx); user != nil {
+		userID = user.ID
+	}
+
+	announcements, err := h.announcementService.GetActiveAnnouncements(userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("get_active_announcements_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get announcements (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &GetActiveAnnouncementsResponse{}
+	resp.Body.Announcements = announcements
+	return resp, nil
+}
+
+// DismissAnnouncementRequest represents the request for dismissing a banner
+type DismissAnnouncementRequest struct {
+	AnnouncementID uint `path:"announcement_id" doc:"Announcement ID" example:"1"`
+}
+
+// DismissAnnouncementHandler handles POST /announcements/{announcement_id}/dismiss
+func (h *AnnouncementHandler) DismissAnnouncementHandler(ctx context.Context, req *DismissAnnouncementRequest) (*struct{}, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	if err := h.announcementService.DismissAnnouncement(req.AnnouncementID, user.ID); err != nil {
+		if mapped := shared.MapAnnouncementError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("dismiss_announcement_failed",
+			"announcement_id", req.AnnouncementID,
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to dismiss announcement (request_id: %s)", requestID),
+		)
+	}
+	return nil, nil
+}
+
+// logAnnouncementChange records an announcement's new state in the audit log
+// (fire and forget).
+func (h *AnnouncementHandler) logAnnouncementChange(actorID uint, action string, announcement *contracts.AnnouncementResponse) {
+	if h.auditLogService == nil {
+		return
+	}
+	h.auditLogService.LogAction(actorID, action, "announcement", announcement.ID, map[string]interface{}{
+		"severity":    announcement.Severity,
+		"starts_at":   announcement.StartsAt,
+		"ends_at":     announcement.EndsAt,
+		"dismissible": announcement.Dismissible,
+	})
+}
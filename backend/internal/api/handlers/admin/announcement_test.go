@@ -0,0 +1,190 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestListAnnouncementsHandler_Success(t *testing.T) {
+	h := NewAnnouncementHandler(&testhelpers.MockAnnouncementService{
+		ListAnnouncementsFn: func() ([]*contracts.AnnouncementResponse, error) {
+			return []*contracts.AnnouncementResponse{{ID: 1, Message: "Down Sunday", Status: "scheduled"}}, nil
+		},
+	}, nil)
+
+	resp, err := h.ListAnnouncementsHandler(dataQualityAdminCtx(), &ListAnnouncementsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Count != 1 || resp.Body.Announcements[0].Message != "Down Sunday" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestCreateAnnouncementHandler_Success(t *testing.T) {
+	var audited string
+	h := NewAnnouncementHandler(&testhelpers.MockAnnouncementService{
+		CreateAnnouncementFn: func(req *contracts.CreateAnnouncementRequest, userID uint) (*contracts.AnnouncementResponse, error) {
+			if userID != 1 {
+				t.Errorf("expected userID=1, got %d", userID)
+			}
+			return &contracts.AnnouncementResponse{ID: 5, Message: req.Message, Severity: req.Severity, Dismissible: req.Dismissible}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			audited = fmt.Sprintf("%s %s %d", action, entityType, entityID)
+		},
+	})
+
+	req := &CreateAnnouncementRequest{}
+	req.Body.Message = "Down Sunday"
+	req.Body.Severity = "warning"
+	req.Body.Dismissible = true
+
+	resp, err := h.CreateAnnouncementHandler(dataQualityAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 5 || resp.Body.Severity != "warning" || !resp.Body.Dismissible {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+	if audited != "create_announcement announcement 5" {
+		t.Errorf("unexpected audit log: %q", audited)
+	}
+}
+
+func TestUpdateAnnouncementHandler_PassesOnlyProvidedFields(t *testing.T) {
+	h := NewAnnouncementHandler(&testhelpers.MockAnnouncementService{
+		UpdateAnnouncementFn: func(id uint, req *contracts.UpdateAnnouncementRequest, _ uint) (*contracts.AnnouncementResponse, error) {
+			if id != 5 {
+				t.Errorf("expected id 5, got %d", id)
+			}
+			if req.Severity == nil || *req.Severity != "critical" {
+				t.Error("expected severity=critical to be passed through")
+			}
+			if req.Message != nil || req.StartsAt != nil || req.EndsAt != nil || req.Dismissible != nil {
+				t.Errorf("expected omitted fields to stay nil, got %+v", req)
+			}
+			return &contracts.AnnouncementResponse{ID: id, Severity: "critical"}, nil
+		},
+	}, nil)
+
+	severity := "critical"
+	req := &UpdateAnnouncementRequest{AnnouncementID: 5}
+	req.Body.Severity = &severity
+
+	resp, err := h.UpdateAnnouncementHandler(dataQualityAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Severity != "critical" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestAnnouncementHandlers_MapServiceErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", apperrors.ErrAnnouncementNotFound(5), 404},
+		{"invalid", apperrors.ErrAnnouncementInvalid("message is required"), 422},
+		{"other", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewAnnouncementHandler(&testhelpers.MockAnnouncementService{
+				CreateAnnouncementFn: func(*contracts.CreateAnnouncementRequest, uint) (*contracts.AnnouncementResponse, error) {
+					return nil, tc.err
+				},
+				UpdateAnnouncementFn: func(uint, *contracts.UpdateAnnouncementRequest, uint) (*contracts.AnnouncementResponse, error) {
+					return nil, tc.err
+				},
+				ExpireAnnouncementFn: func(uint, uint) (*contracts.AnnouncementResponse, error) {
+					return nil, tc.err
+				},
+				DismissAnnouncementFn: func(uint, uint) error { return tc.err },
+			}, nil)
+
+			_, err := h.CreateAnnouncementHandler(dataQualityAdminCtx(), &CreateAnnouncementRequest{})
+			testhelpers.AssertHumaError(t, err, tc.status)
+			_, err = h.UpdateAnnouncementHandler(dataQualityAdminCtx(), &UpdateAnnouncementRequest{AnnouncementID: 5})
+			testhelpers.AssertHumaError(t, err, tc.status)
+			_, err = h.ExpireAnnouncementHandler(dataQualityAdminCtx(), &ExpireAnnouncementRequest{AnnouncementID: 5})
+			testhelpers.AssertHumaError(t, err, tc.status)
+			_, err = h.DismissAnnouncementHandler(dataQualityAdminCtx(), &DismissAnnouncementRequest{AnnouncementID: 5})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+func TestGetActiveAnnouncementsHandler_PassesCaller(t *testing.T) {
+	var gotUserID uint
+	h := NewAnnouncementHandler(&testhelpers.MockAnnouncementService{
+		GetActiveAnnouncementsFn: func(userID uint) ([]*contracts.ActiveAnnouncementResponse, error) {
+			gotUserID = userID
+			return []*contracts.ActiveAnnouncementResponse{{ID: 5, Message: "Down Sunday"}}, nil
+		},
+	}, nil)
+
+	resp, err := h.GetActiveAnnouncementsHandler(context.Background(), &GetActiveAnnouncementsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotUserID != 0 || len(resp.Body.Announcements) != 1 {
+		t.Errorf("anonymous: userID = %d, body = %+v", gotUserID, resp.Body)
+	}
+
+	_, err = h.GetActiveAnnouncementsHandler(testhelpers.CtxWithUser(&authm.User{ID: 42}), &GetActiveAnnouncementsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotUserID != 42 {
+		t.Errorf("signed in: userID = %d, want 42", gotUserID)
+	}
+}
+
+func TestGetActiveAnnouncementsHandler_ServiceError(t *testing.T) {
+	h := NewAnnouncementHandler(&testhelpers.MockAnnouncementService{
+		GetActiveAnnouncementsFn: func(uint) ([]*contracts.ActiveAnnouncementResponse, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	}, nil)
+
+	_, err := h.GetActiveAnnouncementsHandler(context.Background(), &GetActiveAnnouncementsRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestDismissAnnouncementHandler(t *testing.T) {
+	var dismissed string
+	h := NewAnnouncementHandler(&testhelpers.MockAnnouncementService{
+		DismissAnnouncementFn: func(id, userID uint) error {
+			if id == 6 {
+				return apperrors.ErrAnnouncementNotDismissible(id)
+			}
+			dismissed = fmt.Sprintf("%d by %d", id, userID)
+			return nil
+		},
+	}, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 42})
+
+	if _, err := h.DismissAnnouncementHandler(ctx, &DismissAnnouncementRequest{AnnouncementID: 5}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dismissed != "5 by 42" {
+		t.Errorf("dismissed = %q", dismissed)
+	}
+
+	_, err := h.DismissAnnouncementHandler(ctx, &DismissAnnouncementRequest{AnnouncementID: 6})
+	testhelpers.AssertHumaError(t, err, 409)
+
+	_, err = h.DismissAnnouncementHandler(context.Background(), &DismissAnnouncementRequest{AnnouncementID: 5})
+	testhelpers.AssertHumaError(t, err, 401)
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// EmailPreviewHandler renders email templates with sample data so admins can
+// check copy and layout without sending anything
+type EmailPreviewHandler struct {
+	emailService contracts.EmailPreviewServiceInterface
+}
+
+// NewEmailPreviewHandler creates a new email preview handler
+func NewEmailPreviewHandler(emailService contracts.EmailPreviewServiceInterface) *EmailPreviewHandler {
+	return &EmailPreviewHandler{emailService: emailService}
+}
+
+// GetEmailPreviewRequest represents the request for previewing a template
+type GetEmailPreviewRequest struct {
+	Template string `path:"template" doc:"Template name" example:"magic_link"`
+}
+
+// GetEmailPreviewResponse represents the rendered template
+type GetEmailPreviewResponse struct {
+	Body *contracts.EmailPreview
+}
+
+// GetEmailPreviewHandler handles GET /admin/email-previews/{template}
+func (h *EmailPreviewHandler) GetEmailPreviewHandler(ctx context.Context, req *GetEmailPreviewRequest) (*GetEmailPreviewResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	names := h.emailService.EmailTemplateNames()
+	if !slices.Contains(names, req.Template) {
+		return nil, huma.Error404NotFound(
+			fmt.Sprintf("Unknown email template %q (available: %s)", req.Template, strings.Join(names, ", ")),
+		)
+	}
+
+	preview, err := h.emailService.PreviewEmail(req.Template)
+	if err != nil {
+		logger.FromContext(ctx).Error("email_preview_failed",
+			"template", req.Template,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to render email template (request_id: %s)", requestID),
+		)
+	}
+
+	return &GetEmailPreviewResponse{Body: preview}, nil
+}
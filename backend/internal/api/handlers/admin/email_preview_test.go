@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func emailPreviewMock(previewFn func(string) (*contracts.EmailPreview, error)) *testhelpers.MockEmailPreviewService {
+	return &testhelpers.MockEmailPreviewService{
+		EmailTemplateNamesFn: func() []string { return []string{"verification", "magic_link"} },
+		PreviewEmailFn:       previewFn,
+	}
+}
+
+func TestGetEmailPreviewHandler_Success(t *testing.T) {
+	h := NewEmailPreviewHandler(emailPreviewMock(func(name string) (*contracts.EmailPreview, error) {
+		return &contracts.EmailPreview{Template: name, Subject: "Sign in", HTML: "<p>Sign in</p>", Text: "Sign in"}, nil
+	}))
+
+	resp, err := h.GetEmailPreviewHandler(dataQualityAdminCtx(), &GetEmailPreviewRequest{Template: "magic_link"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Template != "magic_link" || resp.Body.HTML != "<p>Sign in</p>" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestGetEmailPreviewHandler_UnknownTemplate(t *testing.T) {
+	h := NewEmailPreviewHandler(emailPreviewMock(func(string) (*contracts.EmailPreview, error) {
+		t.Fatal("PreviewEmail should not be called for an unknown template")
+		return nil, nil
+	}))
+
+	_, err := h.GetEmailPreviewHandler(dataQualityAdminCtx(), &GetEmailPreviewRequest{Template: "nope"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestGetEmailPreviewHandler_RenderError(t *testing.T) {
+	h := NewEmailPreviewHandler(emailPreviewMock(func(string) (*contracts.EmailPreview, error) {
+		return nil, fmt.Errorf("template: bad")
+	}))
+
+	_, err := h.GetEmailPreviewHandler(dataQualityAdminCtx(), &GetEmailPreviewRequest{Template: "verification"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
@@ -0,0 +1,158 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// EntityAliasHandler handles the admin import alias dictionary, which maps
+// scraper- and seed-provided venue/artist names to canonical entities.
+type EntityAliasHandler struct {
+	entityAliasService contracts.EntityAliasServiceInterface
+	auditLogService    contracts.AuditLogServiceInterface
+}
+
+// NewEntityAliasHandler creates a new entity alias handler
+func NewEntityAliasHandler(
+	entityAliasService contracts.EntityAliasServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *EntityAliasHandler {
+	return &EntityAliasHandler{
+		entityAliasService: entityAliasService,
+		auditLogService:    auditLogService,
+	}
+}
+
+// ListEntityAliasesRequest represents the request for listing aliases
+type ListEntityAliasesRequest struct {
+	EntityType string `query:"entity_type" required:"false" enum:"venue,artist" doc:"Only return aliases of this entity type"`
+}
+
+// ListEntityAliasesResponse represents the response for listing aliases
+type ListEntityAliasesResponse struct {
+	Body struct {
+		Aliases []*contracts.EntityAliasResponse `json:"aliases" doc:"Aliases, ordered by entity type then alias"`
+		Count   int                              `json:"count" doc:"Number of aliases"`
+	}
+}
+
+// ListEntityAliasesHandler handles GET /admin/entity-aliases
+func (h *EntityAliasHandler) ListEntityAliasesHandler(ctx context.Context, req *ListEntityAliasesRequest) (*ListEntityAliasesResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	aliases, err := h.entityAliasService.ListAliases(req.EntityType)
+	if err != nil {
+		if mapped := shared.MapEntityAliasError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("list_entity_aliases_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to list aliases (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &ListEntityAliasesResponse{}
+	resp.Body.Aliases = aliases
+	resp.Body.Count = len(aliases)
+	return resp, nil
+}
+
+// CreateEntityAliasRequest represents the request for creating an alias
+type CreateEntityAliasRequest struct {
+	Body struct {
+		EntityType string `json:"entity_type" enum:"venue,artist" doc:"Entity type the alias resolves to"`
+		EntityID   uint   `json:"entity_id" doc:"ID of the canonical venue or artist" example:"42"`
+		Alias      string `json:"alias" doc:"Name as it appears in scraped or seed data" example:"The Crescent"`
+	}
+}
+
+// CreateEntityAliasResponse represents the response for creating an alias
+type CreateEntityAliasResponse struct {
+	Body *contracts.EntityAliasResponse
+}
+
+// CreateEntityAliasHandler handles POST /admin/entity-aliases
+func (h *EntityAliasHandler) CreateEntityAliasHandler(ctx context.Context, req *CreateEntityAliasRequest) (*CreateEntityAliasResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	alias, err := h.entityAliasService.CreateAlias(req.Body.EntityType, req.Body.EntityID, req.Body.Alias, user.ID)
+	if err != nil {
+		if mapped := shared.MapEntityAliasError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("create_entity_alias_failed",
+			"entity_type", req.Body.EntityType,
+			"entity_id", req.Body.EntityID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to create alias (request_id: %s)", requestID),
+		)
+	}
+
+	// Audit log (fire and forget)
+	if h.auditLogService != nil {
+		h.auditLogService.LogAction(user.ID, "create_entity_alias", alias.EntityType, alias.EntityID, map[string]interface{}{
+			"alias_id": alias.ID,
+			"alias":    alias.Alias,
+		})
+	}
+
+	return &CreateEntityAliasResponse{Body: alias}, nil
+}
+
+// DeleteEntityAliasRequest represents the request for deleting an alias
+type DeleteEntityAliasRequest struct {
+	AliasID string `path:"alias_id" doc:"Alias ID" example:"1"`
+}
+
+// DeleteEntityAliasHandler handles DELETE /admin/entity-aliases/{alias_id}
+func (h *EntityAliasHandler) DeleteEntityAliasHandler(ctx context.Context, req *DeleteEntityAliasRequest) (*struct{}, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	aliasID, err := strconv.ParseUint(req.AliasID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid alias ID")
+	}
+
+	deleted, err := h.entityAliasService.DeleteAlias(uint(aliasID))
+	if err != nil {
+		if mapped := shared.MapEntityAliasError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("delete_entity_alias_failed",
+			"alias_id", aliasID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to delete alias (request_id: %s)", requestID),
+		)
+	}
+
+	// Audit log (fire and forget)
+	if h.auditLogService != nil {
+		h.auditLogService.LogAction(user.ID, "delete_entity_alias", deleted.EntityType, deleted.EntityID, map[string]interface{}{
+			"alias_id": deleted.ID,
+			"alias":    deleted.Alias,
+		})
+	}
+
+	return nil, nil
+}
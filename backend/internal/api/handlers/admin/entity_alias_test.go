@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestListEntityAliasesHandler_Success(t *testing.T) {
+	var gotType string
+	h := NewEntityAliasHandler(&testhelpers.MockEntityAliasService{
+		ListAliasesFn: func(entityType string) ([]*contracts.EntityAliasResponse, error) {
+			gotType = entityType
+			return []*contracts.EntityAliasResponse{{ID: 1, EntityType: "venue", EntityID: 5, Alias: "The Crescent"}}, nil
+		},
+	}, nil)
+
+	resp, err := h.ListEntityAliasesHandler(dataQualityAdminCtx(), &ListEntityAliasesRequest{EntityType: "venue"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotType != "venue" {
+		t.Errorf("expected entity_type filter 'venue', got %q", gotType)
+	}
+	if resp.Body.Count != 1 || resp.Body.Aliases[0].Alias != "The Crescent" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestListEntityAliasesHandler_ServiceError(t *testing.T) {
+	h := NewEntityAliasHandler(&testhelpers.MockEntityAliasService{
+		ListAliasesFn: func(string) ([]*contracts.EntityAliasResponse, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	}, nil)
+
+	_, err := h.ListEntityAliasesHandler(dataQualityAdminCtx(), &ListEntityAliasesRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestCreateEntityAliasHandler_Success(t *testing.T) {
+	var audited string
+	h := NewEntityAliasHandler(&testhelpers.MockEntityAliasService{
+		CreateAliasFn: func(entityType string, entityID uint, alias string, createdBy uint) (*contracts.EntityAliasResponse, error) {
+			if createdBy != 1 {
+				t.Errorf("expected createdBy=1, got %d", createdBy)
+			}
+			return &contracts.EntityAliasResponse{ID: 3, EntityType: entityType, EntityID: entityID, Alias: alias}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			audited = fmt.Sprintf("%s %s %d", action, entityType, entityID)
+		},
+	})
+
+	req := &CreateEntityAliasRequest{}
+	req.Body.EntityType = "venue"
+	req.Body.EntityID = 5
+	req.Body.Alias = "The Crescent"
+
+	resp, err := h.CreateEntityAliasHandler(dataQualityAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 3 {
+		t.Errorf("expected alias ID 3, got %d", resp.Body.ID)
+	}
+	if audited != "create_entity_alias venue 5" {
+		t.Errorf("unexpected audit log: %q", audited)
+	}
+}
+
+func TestCreateEntityAliasHandler_MapsServiceErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"exists", apperrors.ErrEntityAliasExists("venue", "The Crescent"), 409},
+		{"target not found", apperrors.ErrEntityAliasTargetNotFound("venue", 5), 404},
+		{"invalid", apperrors.ErrEntityAliasInvalid("alias is required"), 422},
+		{"unexpected", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewEntityAliasHandler(&testhelpers.MockEntityAliasService{
+				CreateAliasFn: func(string, uint, string, uint) (*contracts.EntityAliasResponse, error) {
+					return nil, tc.err
+				},
+			}, nil)
+			_, err := h.CreateEntityAliasHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), &CreateEntityAliasRequest{})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+func TestDeleteEntityAliasHandler(t *testing.T) {
+	var audited bool
+	h := NewEntityAliasHandler(&testhelpers.MockEntityAliasService{
+		DeleteAliasFn: func(aliasID uint) (*contracts.EntityAliasResponse, error) {
+			if aliasID == 404 {
+				return nil, apperrors.ErrEntityAliasNotFound(aliasID)
+			}
+			return &contracts.EntityAliasResponse{ID: aliasID, EntityType: "artist", EntityID: 8, Alias: "KB"}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) {
+			audited = action == "delete_entity_alias"
+		},
+	})
+
+	if _, err := h.DeleteEntityAliasHandler(dataQualityAdminCtx(), &DeleteEntityAliasRequest{AliasID: "7"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !audited {
+		t.Error("expected delete to be audit-logged")
+	}
+
+	_, err := h.DeleteEntityAliasHandler(dataQualityAdminCtx(), &DeleteEntityAliasRequest{AliasID: "404"})
+	testhelpers.AssertHumaError(t, err, 404)
+
+	_, err = h.DeleteEntityAliasHandler(dataQualityAdminCtx(), &DeleteEntityAliasRequest{AliasID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
@@ -0,0 +1,231 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	adminm "psychic-homily-backend/internal/models/admin"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// FeatureFlagHandler handles admin management of runtime feature flags
+type FeatureFlagHandler struct {
+	featureFlagService contracts.FeatureFlagServiceInterface
+	auditLogService    contracts.AuditLogServiceInterface
+	discordService     contracts.DiscordServiceInterface
+}
+
+// NewFeatureFlagHandler creates a new feature flag handler
+func NewFeatureFlagHandler(
+	featureFlagService contracts.FeatureFlagServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+	discordService contracts.DiscordServiceInterface,
+) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		featureFlagService: featureFlagService,
+		auditLogService:    auditLogService,
+		discordService:     discordService,
+	}
+}
+
+// ListFeatureFlagsRequest represents the request for listing flags
+type ListFeatureFlagsRequest struct{}
+
+// ListFeatureFlagsResponse represents the response for listing flags
+type ListFeatureFlagsResponse struct {
+	Body struct {
+		Flags []*contracts.FeatureFlagResponse `json:"flags" doc:"Flags, ordered by key"`
+		Count int                              `json:"count" doc:"Number of flags"`
+	}
+}
+
+// ListFeatureFlagsHandler handles GET /admin/feature-flags
+func (h *FeatureFlagHandler) ListFeatureFlagsHandler(ctx context.Context, _ *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	flags, err := h.featureFlagService.ListFlags()
+	if err != nil {
+		logger.FromContext(ctx).Error("list_feature_flags_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to list feature flags (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &ListFeatureFlagsResponse{}
+	resp.Body.Flags = flags
+	resp.Body.Count = len(flags)
+	return resp, nil
+}
+
+// CreateFeatureFlagRequest represents the request for creating a flag
+type CreateFeatureFlagRequest struct {
+	Body struct {
+		Key              string   `json:"key" doc:"Lowercase snake_case key handlers check" example:"graphql_endpoint"`
+		Description      *string  `json:"description,omitempty" required:"false" doc:"What the flag gates"`
+		Enabled          bool     `json:"enabled,omitempty" required:"false" doc:"On for everyone"`
+		EnabledForAdmins bool     `json:"enabled_for_admins,omitempty" required:"false" doc:"On for admins even when not enabled for everyone"`
+		Environments     []string `json:"environments,omitempty" required:"false" doc:"Only apply in these environments (empty: all)" example:"[\"stage\"]"`
+	}
+}
+
+// FeatureFlagResponse represents a single flag response
+type FeatureFlagResponse struct {
+	Body *contracts.FeatureFlagResponse
+}
+
+// CreateFeatureFlagHandler handles POST /admin/feature-flags
+func (h *FeatureFlagHandler) CreateFeatureFlagHandler(ctx context.Context, req *CreateFeatureFlagRequest) (*FeatureFlagResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	maintenanceBefore := h.maintenanceModeOn(req.Body.Key)
+
+	flag, err := h.featureFlagService.CreateFlag(&contracts.CreateFeatureFlagRequest{
+		Key:              req.Body.Key,
+		Description:      req.Body.Description,
+		Enabled:          req.Body.Enabled,
+		EnabledForAdmins: req.Body.EnabledForAdmins,
+		Environments:     req.Body.Environments,
+	}, user.ID)
+	if err != nil {
+		if mapped := shared.MapFeatureFlagError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("create_feature_flag_failed",
+			"key", req.Body.Key,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to create feature flag (request_id: %s)", requestID),
+		)
+	}
+
+	h.logFlagChange(user.ID, "create_feature_flag", flag)
+	h.notifyMaintenanceToggle(req.Body.Key, maintenanceBefore, user)
+	return &FeatureFlagResponse{Body: flag}, nil
+}
+
+// UpdateFeatureFlagRequest represents the request for updating a flag.
+// Omitted fields are left as they are.
+type UpdateFeatureFlagRequest struct {
+	Key  string `path:"key" doc:"Flag key" example:"graphql_endpoint"`
+	Body struct {
+		Description      *string   `json:"description,omitempty" required:"false" doc:"What the flag gates"`
+		Enabled          *bool     `json:"enabled,omitempty" required:"false" doc:"On for everyone"`
+		EnabledForAdmins *bool     `json:"enabled_for_admins,omitempty" required:"false" doc:"On for admins even when not enabled for everyone"`
+		Environments     *[]string `json:"environments,omitempty" required:"false" doc:"Only apply in these environments (empty: all)"`
+	}
+}
+
+// UpdateFeatureFlagHandler handles PATCH /admin/feature-flags/{key}
+func (h *FeatureFlagHandler) UpdateFeatureFlagHandler(ctx context.Context, req *UpdateFeatureFlagRequest) (*FeatureFlagResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	maintenanceBefore := h.maintenanceModeOn(req.Key)
+
+	flag, err := h.featureFlagService.UpdateFlag(req.Key, &contracts.UpdateFeatureFlagRequest{
+		Description:      req.Body.Description,
+		Enabled:          req.Body.Enabled,
+		EnabledForAdmins: req.Body.EnabledForAdmins,
+		Environments:     req.Body.Environments,
+	}, user.ID)
+	if err != nil {
+		if mapped := shared.MapFeatureFlagError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("update_feature_flag_failed",
+			"key", req.Key,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to update feature flag (request_id: %s)", requestID),
+		)
+	}
+
+	h.logFlagChange(user.ID, "update_feature_flag", flag)
+	h.notifyMaintenanceToggle(req.Key, maintenanceBefore, user)
+	return &FeatureFlagResponse{Body: flag}, nil
+}
+
+// DeleteFeatureFlagRequest represents the request for deleting a flag
+type DeleteFeatureFlagRequest struct {
+	Key string `path:"key" doc:"Flag key" example:"graphql_endpoint"`
+}
+
+// DeleteFeatureFlagHandler handles DELETE /admin/feature-flags/{key}
+func (h *FeatureFlagHandler) DeleteFeatureFlagHandler(ctx context.Context, req *DeleteFeatureFlagRequest) (*struct{}, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	maintenanceBefore := h.maintenanceModeOn(req.Key)
+
+	if err := h.featureFlagService.DeleteFlag(req.Key); err != nil {
+		if mapped := shared.MapFeatureFlagError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("delete_feature_flag_failed",
+			"key", req.Key,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to delete feature flag (request_id: %s)", requestID),
+		)
+	}
+
+	// Audit log (fire and forget)
+	if h.auditLogService != nil {
+		h.auditLogService.LogAction(user.ID, "delete_feature_flag", "feature_flag", 0, map[string]interface{}{
+			"key": req.Key,
+		})
+	}
+	h.notifyMaintenanceToggle(req.Key, maintenanceBefore, user)
+
+	return nil, nil
+}
+
+// logFlagChange records a flag's new state in the audit log (fire and
+// forget).
+func (h *FeatureFlagHandler) logFlagChange(actorID uint, action string, flag *contracts.FeatureFlagResponse) {
+	if h.auditLogService == nil {
+		return
+	}
+	h.auditLogService.LogAction(actorID, action, "feature_flag", flag.ID, map[string]interface{}{
+		"key":                flag.Key,
+		"enabled":            flag.Enabled,
+		"enabled_for_admins": flag.EnabledForAdmins,
+		"environments":       flag.Environments,
+	})
+}
+
+// maintenanceModeOn reports whether key is the maintenance mode flag and it
+// is currently on. Called before a write so notifyMaintenanceToggle can tell
+// whether the write flipped it.
+func (h *FeatureFlagHandler) maintenanceModeOn(key string) bool {
+	return key == adminm.FeatureFlagMaintenanceMode && h.featureFlagService.IsEnabled(key, false)
+}
+
+// notifyMaintenanceToggle posts to Discord when a write to key turned
+// maintenance mode on or off.
+func (h *FeatureFlagHandler) notifyMaintenanceToggle(key string, before bool, actor *authm.User) {
+	if key != adminm.FeatureFlagMaintenanceMode || h.discordService == nil {
+		return
+	}
+	after := h.featureFlagService.IsEnabled(key, false)
+	if after == before {
+		return
+	}
+	h.discordService.NotifyMaintenanceMode(after, "feature flag", shared.Deref(actor.Email))
+}
@@ -0,0 +1,175 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestListFeatureFlagsHandler_Success(t *testing.T) {
+	h := NewFeatureFlagHandler(&testhelpers.MockFeatureFlagService{
+		ListFlagsFn: func() ([]*contracts.FeatureFlagResponse, error) {
+			return []*contracts.FeatureFlagResponse{{ID: 1, Key: "graphql_endpoint"}}, nil
+		},
+	}, nil, nil)
+
+	resp, err := h.ListFeatureFlagsHandler(dataQualityAdminCtx(), &ListFeatureFlagsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Count != 1 || resp.Body.Flags[0].Key != "graphql_endpoint" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestListFeatureFlagsHandler_ServiceError(t *testing.T) {
+	h := NewFeatureFlagHandler(&testhelpers.MockFeatureFlagService{
+		ListFlagsFn: func() ([]*contracts.FeatureFlagResponse, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	}, nil, nil)
+
+	_, err := h.ListFeatureFlagsHandler(dataQualityAdminCtx(), &ListFeatureFlagsRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestCreateFeatureFlagHandler_Success(t *testing.T) {
+	var audited string
+	h := NewFeatureFlagHandler(&testhelpers.MockFeatureFlagService{
+		CreateFlagFn: func(req *contracts.CreateFeatureFlagRequest, userID uint) (*contracts.FeatureFlagResponse, error) {
+			if userID != 1 {
+				t.Errorf("expected userID=1, got %d", userID)
+			}
+			return &contracts.FeatureFlagResponse{ID: 4, Key: req.Key, EnabledForAdmins: req.EnabledForAdmins}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			audited = fmt.Sprintf("%s %s %d", action, entityType, entityID)
+		},
+	}, nil)
+
+	req := &CreateFeatureFlagRequest{}
+	req.Body.Key = "graphql_endpoint"
+	req.Body.EnabledForAdmins = true
+
+	resp, err := h.CreateFeatureFlagHandler(dataQualityAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 4 || !resp.Body.EnabledForAdmins {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+	if audited != "create_feature_flag feature_flag 4" {
+		t.Errorf("unexpected audit log: %q", audited)
+	}
+}
+
+func TestFeatureFlagHandlers_MapServiceErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", apperrors.ErrFeatureFlagNotFound("graphql_endpoint"), 404},
+		{"exists", apperrors.ErrFeatureFlagExists("graphql_endpoint"), 409},
+		{"invalid", apperrors.ErrFeatureFlagInvalid("bad key"), 422},
+		{"other", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewFeatureFlagHandler(&testhelpers.MockFeatureFlagService{
+				CreateFlagFn: func(*contracts.CreateFeatureFlagRequest, uint) (*contracts.FeatureFlagResponse, error) {
+					return nil, tc.err
+				},
+				UpdateFlagFn: func(string, *contracts.UpdateFeatureFlagRequest, uint) (*contracts.FeatureFlagResponse, error) {
+					return nil, tc.err
+				},
+				DeleteFlagFn: func(string) error { return tc.err },
+			}, nil, nil)
+
+			_, err := h.CreateFeatureFlagHandler(dataQualityAdminCtx(), &CreateFeatureFlagRequest{})
+			testhelpers.AssertHumaError(t, err, tc.status)
+			_, err = h.UpdateFeatureFlagHandler(dataQualityAdminCtx(), &UpdateFeatureFlagRequest{Key: "graphql_endpoint"})
+			testhelpers.AssertHumaError(t, err, tc.status)
+			_, err = h.DeleteFeatureFlagHandler(dataQualityAdminCtx(), &DeleteFeatureFlagRequest{Key: "graphql_endpoint"})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+func TestUpdateFeatureFlagHandler_PassesOnlyProvidedFields(t *testing.T) {
+	h := NewFeatureFlagHandler(&testhelpers.MockFeatureFlagService{
+		UpdateFlagFn: func(key string, req *contracts.UpdateFeatureFlagRequest, _ uint) (*contracts.FeatureFlagResponse, error) {
+			if key != "graphql_endpoint" {
+				t.Errorf("expected key graphql_endpoint, got %q", key)
+			}
+			if req.Enabled == nil || !*req.Enabled {
+				t.Error("expected enabled=true to be passed through")
+			}
+			if req.EnabledForAdmins != nil || req.Environments != nil || req.Description != nil {
+				t.Errorf("expected omitted fields to stay nil, got %+v", req)
+			}
+			return &contracts.FeatureFlagResponse{ID: 4, Key: key, Enabled: true}, nil
+		},
+	}, nil, nil)
+
+	enabled := true
+	req := &UpdateFeatureFlagRequest{Key: "graphql_endpoint"}
+	req.Body.Enabled = &enabled
+
+	resp, err := h.UpdateFeatureFlagHandler(dataQualityAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Enabled {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestUpdateFeatureFlagHandler_NotifiesMaintenanceToggle(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		key        string
+		before     bool
+		after      bool
+		wantNotify bool
+	}{
+		{"maintenance turned on", "maintenance_mode", false, true, true},
+		{"maintenance turned off", "maintenance_mode", true, false, true},
+		{"maintenance unchanged", "maintenance_mode", true, true, false},
+		{"other flag", "graphql_endpoint", false, true, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state := tc.before
+			var notified []bool
+			h := NewFeatureFlagHandler(&testhelpers.MockFeatureFlagService{
+				UpdateFlagFn: func(key string, _ *contracts.UpdateFeatureFlagRequest, _ uint) (*contracts.FeatureFlagResponse, error) {
+					state = tc.after
+					return &contracts.FeatureFlagResponse{ID: 9, Key: key, Enabled: tc.after}, nil
+				},
+				IsEnabledFn: func(string, bool) bool { return state },
+			}, nil, &testhelpers.MockDiscordService{
+				NotifyMaintenanceModeFn: func(enabled bool, source, _ string) {
+					notified = append(notified, enabled)
+				},
+			})
+
+			_, err := h.UpdateFeatureFlagHandler(dataQualityAdminCtx(), &UpdateFeatureFlagRequest{Key: tc.key})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.wantNotify {
+				if len(notified) != 0 {
+					t.Errorf("expected no notification, got %v", notified)
+				}
+				return
+			}
+			if len(notified) != 1 || notified[0] != tc.after {
+				t.Errorf("expected one notification with enabled=%v, got %v", tc.after, notified)
+			}
+		})
+	}
+}
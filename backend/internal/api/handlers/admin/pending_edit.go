@@ -142,13 +142,20 @@ func (h *PendingEditHandler) suggestEdit(ctx context.Context, entityType string,
 	// allowlist controls *which* fields can be edited but not *what values*
 	// they take — and ApprovePendingEdit applies values blindly.
 	allowed := allowedEditFields(entityType)
-	for _, change := range req.Body.Changes {
+	for i, change := range req.Body.Changes {
 		if !allowed[change.Field] {
 			return nil, huma.Error422UnprocessableEntity(fmt.Sprintf("Field '%s' is not editable on %s entities", change.Field, entityType))
 		}
 		if err := shared.ValidateFieldChangeValue(change.Field, change.NewValue); err != nil {
 			return nil, err
 		}
+		// Typed columns (venue capacity, all_ages, ...) arrive as strings
+		// from the edit drawer; store them as the type approval will write.
+		normalized, err := shared.NormalizeFieldChangeValue(change.Field, change.NewValue)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Changes[i].NewValue = normalized
 	}
 
 	// Create the pending edit
@@ -187,6 +187,47 @@ func TestSuggestEdit_Contributor_CreatesPending(t *testing.T) {
 	}
 }
 
+func TestSuggestEdit_VenueOperatingInfoNormalized(t *testing.T) {
+	var got []adminm.FieldChange
+	h := NewPendingEditHandler(
+		&testhelpers.MockPendingEditService{
+			CreatePendingEditFn: func(req *contracts.CreatePendingEditRequest) (*contracts.PendingEditResponse, error) {
+				got = req.Changes
+				return makePendingEditResponse(1), nil
+			},
+		},
+		nil,
+	)
+
+	req := &SuggestEntityEditRequest{EntityID: "10"}
+	req.Body.Changes = []adminm.FieldChange{
+		{Field: "capacity", OldValue: nil, NewValue: "250"},
+		{Field: "all_ages", OldValue: nil, NewValue: "true"},
+		{Field: "door_time", OldValue: nil, NewValue: "19:30"},
+		{Field: "accessibility_notes", OldValue: "Stairs only", NewValue: nil},
+	}
+	req.Body.Summary = "Add venue info"
+
+	if _, err := h.SuggestVenueEditHandler(pendingEditContributorCtx(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []any{250, true, "19:30", nil}
+	for i, w := range want {
+		if got[i].NewValue != w {
+			t.Errorf("%s: got %#v, want %#v", got[i].Field, got[i].NewValue, w)
+		}
+	}
+}
+
+func TestSuggestEdit_VenueInvalidDoorTime(t *testing.T) {
+	h := testPendingEditHandler()
+	req := &SuggestEntityEditRequest{EntityID: "1"}
+	req.Body.Changes = []adminm.FieldChange{{Field: "door_time", OldValue: nil, NewValue: "7pm"}}
+	req.Body.Summary = "Add door time"
+	_, err := h.SuggestVenueEditHandler(pendingEditContributorCtx(), req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
 // ============================================================================
 // Tests: SuggestEdit — Trusted User (auto-applies)
 // ============================================================================
@@ -703,7 +744,7 @@ func TestAllowedEditFields(t *testing.T) {
 
 	// Venue allowed (image_url added in PSY-521)
 	venue := allowedEditFields("venue")
-	for _, f := range []string{"name", "address", "city", "zipcode", "website", "image_url"} {
+	for _, f := range []string{"name", "address", "city", "zipcode", "website", "image_url", "capacity", "all_ages", "door_time", "accessibility_notes"} {
 		if !venue[f] {
 			t.Errorf("expected %s to be allowed for venue", f)
 		}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -329,3 +331,221 @@ func (h *RevisionHandler) RollbackRevisionHandler(ctx context.Context, req *Roll
 	resp.Body.Success = true
 	return resp, nil
 }
+
+// --- Snapshot / Restore ---
+
+// RevisionSnapshotRequest is the Huma request for the revision snapshot and
+// restore endpoints.
+type RevisionSnapshotRequest struct {
+	RevisionID string `path:"revision_id" doc:"Revision ID"`
+}
+
+// GetRevisionSnapshotResponse is the Huma response for GET /admin/revisions/{revision_id}/snapshot
+type GetRevisionSnapshotResponse struct {
+	Body contracts.RevisionSnapshot
+}
+
+// GetRevisionSnapshotHandler handles GET /admin/revisions/{revision_id}/snapshot.
+// Shows what the entity's tracked fields looked like right after the revision
+// and which of them have changed since.
+func (h *RevisionHandler) GetRevisionSnapshotHandler(ctx context.Context, req *RevisionSnapshotRequest) (*GetRevisionSnapshotResponse, error) {
+	revisionID, err := strconv.ParseUint(req.RevisionID, 10, 64)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid revision ID")
+	}
+
+	snapshot, err := h.revisionService.GetRevisionSnapshot(uint(revisionID))
+	if err != nil {
+		logger.FromContext(ctx).Error("revision_snapshot_failed",
+			"revision_id", revisionID,
+			"error", err.Error(),
+		)
+		return nil, huma.Error500InternalServerError("Failed to get revision snapshot")
+	}
+	if snapshot == nil {
+		return nil, huma.Error404NotFound("Revision not found")
+	}
+
+	return &GetRevisionSnapshotResponse{Body: *snapshot}, nil
+}
+
+// RestoreRevisionResponse is the Huma response for POST /admin/revisions/{revision_id}/restore
+type RestoreRevisionResponse struct {
+	Body struct {
+		Success        bool     `json:"success"`
+		RestoredFields []string `json:"restored_fields"`
+	}
+}
+
+// RestoreRevisionHandler handles POST /admin/revisions/{revision_id}/restore.
+// Unlike rollback, which undoes one revision, restore returns the entity to
+// its state as of the revision, undoing every later edit.
+func (h *RevisionHandler) RestoreRevisionHandler(ctx context.Context, req *RevisionSnapshotRequest) (*RestoreRevisionResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	revisionID, err := strconv.ParseUint(req.RevisionID, 10, 64)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid revision ID")
+	}
+
+	snapshot, err := h.revisionService.RestoreRevision(uint(revisionID), user.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("revision_restore_failed",
+			"revision_id", revisionID,
+			"admin_id", user.ID,
+			"error", err.Error(),
+		)
+		return nil, huma.Error422UnprocessableEntity(err.Error())
+	}
+
+	restored := make([]string, 0, len(snapshot.Fields))
+	for _, f := range snapshot.Fields {
+		if f.Changed {
+			restored = append(restored, f.Field)
+		}
+	}
+
+	logger.FromContext(ctx).Info("revision_restored",
+		"revision_id", revisionID,
+		"entity_type", snapshot.EntityType,
+		"entity_id", snapshot.EntityID,
+		"restored_fields", len(restored),
+		"admin_id", user.ID,
+	)
+
+	if h.auditLogService != nil && len(restored) > 0 {
+		servicesshared.GoSafe(ctx, "audit_log", func() {
+			h.auditLogService.LogAction(user.ID, "revision_restore", snapshot.EntityType, snapshot.EntityID, map[string]interface{}{
+				"revision_id":     revisionID,
+				"restored_fields": restored,
+			})
+		})
+	}
+
+	resp := &RestoreRevisionResponse{}
+	resp.Body.Success = true
+	resp.Body.RestoredFields = restored
+	return resp, nil
+}
+
+// --- Admin show/venue history ---
+
+// AdminHistoryItem is one revision in the admin history view: the edited
+// fields, the full entity snapshot taken with the revision, and every
+// top-level snapshot key that differs from the previous revision's snapshot.
+// SnapshotDiff is empty when either snapshot is missing (revisions recorded
+// before snapshots existed, or the oldest revision).
+type AdminHistoryItem struct {
+	RevisionResponseItem
+	Snapshot     json.RawMessage      `json:"snapshot,omitempty"`
+	SnapshotDiff []adminm.FieldChange `json:"snapshot_diff"`
+}
+
+// AdminHistoryResponse is the Huma response for the admin history endpoints
+type AdminHistoryResponse struct {
+	Body struct {
+		Revisions []AdminHistoryItem `json:"revisions"`
+		Total     int64              `json:"total"`
+	}
+}
+
+// GetShowHistoryRequest is the Huma request for GET /admin/shows/{show_id}/history
+type GetShowHistoryRequest struct {
+	ShowID string `path:"show_id" doc:"Show ID"`
+	Limit  int    `query:"limit" required:"false" minimum:"1" maximum:"100" doc:"Max results (default 20, max 100)"`
+	Offset int    `query:"offset" required:"false" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetShowHistoryHandler handles GET /admin/shows/{show_id}/history.
+// Reverting to a listed revision goes through POST /admin/revisions/{revision_id}/restore.
+func (h *RevisionHandler) GetShowHistoryHandler(ctx context.Context, req *GetShowHistoryRequest) (*AdminHistoryResponse, error) {
+	return h.adminHistory(ctx, "show", req.ShowID, req.Limit, req.Offset)
+}
+
+// GetVenueHistoryRequest is the Huma request for GET /admin/venues/{venue_id}/history
+type GetVenueHistoryRequest struct {
+	VenueID string `path:"venue_id" doc:"Venue ID"`
+	Limit   int    `query:"limit" required:"false" minimum:"1" maximum:"100" doc:"Max results (default 20, max 100)"`
+	Offset  int    `query:"offset" required:"false" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetVenueHistoryHandler handles GET /admin/venues/{venue_id}/history.
+func (h *RevisionHandler) GetVenueHistoryHandler(ctx context.Context, req *GetVenueHistoryRequest) (*AdminHistoryResponse, error) {
+	return h.adminHistory(ctx, "venue", req.VenueID, req.Limit, req.Offset)
+}
+
+// adminHistory pages an entity's revisions newest first. One extra revision
+// is fetched so the last item on the page can still be diffed against its
+// predecessor.
+func (h *RevisionHandler) adminHistory(ctx context.Context, entityType, idStr string, limit, offset int) (*AdminHistoryResponse, error) {
+	entityID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return nil, huma.Error400BadRequest(fmt.Sprintf("Invalid %s ID", entityType))
+	}
+
+	if limit <= 0 {
+		limit = 20
+	}
+
+	revisions, total, err := h.revisionService.GetEntityHistory(entityType, uint(entityID), limit+1, offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("revision_admin_history_failed",
+			"entity_type", entityType,
+			"entity_id", entityID,
+			"error", err.Error(),
+		)
+		return nil, huma.Error500InternalServerError("Failed to get revision history")
+	}
+
+	items := make([]AdminHistoryItem, 0, limit)
+	for i := 0; i < len(revisions) && i < limit; i++ {
+		item := AdminHistoryItem{
+			RevisionResponseItem: mapRevisionToResponse(revisions[i]),
+			SnapshotDiff:         []adminm.FieldChange{},
+		}
+		if revisions[i].Snapshot != nil {
+			item.Snapshot = *revisions[i].Snapshot
+			if i+1 < len(revisions) && revisions[i+1].Snapshot != nil {
+				item.SnapshotDiff = diffSnapshots(*revisions[i+1].Snapshot, item.Snapshot)
+			}
+		}
+		items = append(items, item)
+	}
+
+	resp := &AdminHistoryResponse{}
+	resp.Body.Revisions = items
+	resp.Body.Total = total
+	return resp, nil
+}
+
+// diffSnapshots lists the top-level keys whose values differ between two
+// snapshots, sorted by key. updated_at is skipped since every edit bumps it.
+func diffSnapshots(prev, cur json.RawMessage) []adminm.FieldChange {
+	var before, after map[string]interface{}
+	if json.Unmarshal(prev, &before) != nil || json.Unmarshal(cur, &after) != nil {
+		return []adminm.FieldChange{}
+	}
+
+	keys := make(map[string]bool, len(after))
+	for k := range before {
+		keys[k] = true
+	}
+	for k := range after {
+		keys[k] = true
+	}
+	delete(keys, "updated_at")
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	diff := []adminm.FieldChange{}
+	for _, k := range sorted {
+		if !reflect.DeepEqual(before[k], after[k]) {
+			diff = append(diff, adminm.FieldChange{Field: k, OldValue: before[k], NewValue: after[k]})
+		}
+	}
+	return diff
+}
@@ -10,6 +10,7 @@ import (
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
 )
 
 // ============================================================================
@@ -589,3 +590,204 @@ func TestMapRevisionToResponse_EmptyUsernameTreatedAsUnset(t *testing.T) {
 		t.Errorf("expected user_username=nil when username is empty string, got %v", *item.UserUsername)
 	}
 }
+
+// ============================================================================
+// Tests: GetRevisionSnapshotHandler / RestoreRevisionHandler
+// ============================================================================
+
+func makeTestSnapshot() *contracts.RevisionSnapshot {
+	return &contracts.RevisionSnapshot{
+		RevisionID: 42,
+		EntityType: "show",
+		EntityID:   7,
+		Fields: []contracts.RevisionSnapshotField{
+			{Field: "title", Value: "Old Title", CurrentValue: "New Title", Changed: true},
+			{Field: "price", Value: 10.0, CurrentValue: 10.0},
+		},
+	}
+}
+
+func TestRevisionHandler_GetSnapshot_Success(t *testing.T) {
+	h := NewRevisionHandler(
+		&testhelpers.MockRevisionService{
+			GetRevisionSnapshotFn: func(revisionID uint) (*contracts.RevisionSnapshot, error) {
+				if revisionID != 42 {
+					t.Errorf("expected revisionID=42, got %d", revisionID)
+				}
+				return makeTestSnapshot(), nil
+			},
+		},
+		nil,
+	)
+
+	resp, err := h.GetRevisionSnapshotHandler(revisionAdminCtx(), &RevisionSnapshotRequest{RevisionID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Fields) != 2 || resp.Body.EntityID != 7 {
+		t.Errorf("unexpected snapshot: %+v", resp.Body)
+	}
+}
+
+func TestRevisionHandler_GetSnapshot_NotFound(t *testing.T) {
+	h := NewRevisionHandler(
+		&testhelpers.MockRevisionService{
+			GetRevisionSnapshotFn: func(revisionID uint) (*contracts.RevisionSnapshot, error) {
+				return nil, nil
+			},
+		},
+		nil,
+	)
+
+	_, err := h.GetRevisionSnapshotHandler(revisionAdminCtx(), &RevisionSnapshotRequest{RevisionID: "42"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestRevisionHandler_GetSnapshot_InvalidID(t *testing.T) {
+	h := NewRevisionHandler(&testhelpers.MockRevisionService{}, nil)
+
+	_, err := h.GetRevisionSnapshotHandler(revisionAdminCtx(), &RevisionSnapshotRequest{RevisionID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestRevisionHandler_Restore_Success(t *testing.T) {
+	var receivedAdminID uint
+	h := NewRevisionHandler(
+		&testhelpers.MockRevisionService{
+			RestoreRevisionFn: func(revisionID uint, adminUserID uint) (*contracts.RevisionSnapshot, error) {
+				receivedAdminID = adminUserID
+				return makeTestSnapshot(), nil
+			},
+		},
+		&testhelpers.MockAuditLogService{},
+	)
+
+	resp, err := h.RestoreRevisionHandler(revisionAdminCtx(), &RevisionSnapshotRequest{RevisionID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success {
+		t.Error("expected success=true")
+	}
+	if len(resp.Body.RestoredFields) != 1 || resp.Body.RestoredFields[0] != "title" {
+		t.Errorf("expected restored_fields=[title], got %v", resp.Body.RestoredFields)
+	}
+	if receivedAdminID != 1 {
+		t.Errorf("expected adminID=1, got %d", receivedAdminID)
+	}
+}
+
+func TestRevisionHandler_Restore_ServiceError(t *testing.T) {
+	h := NewRevisionHandler(
+		&testhelpers.MockRevisionService{
+			RestoreRevisionFn: func(revisionID uint, adminUserID uint) (*contracts.RevisionSnapshot, error) {
+				return nil, fmt.Errorf("revision not found")
+			},
+		},
+		nil,
+	)
+
+	_, err := h.RestoreRevisionHandler(revisionAdminCtx(), &RevisionSnapshotRequest{RevisionID: "42"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+// ============================================================================
+// Tests: GetShowHistoryHandler / GetVenueHistoryHandler
+// ============================================================================
+
+func withSnapshot(r adminm.Revision, snapshot string) adminm.Revision {
+	raw := json.RawMessage(snapshot)
+	r.Snapshot = &raw
+	return r
+}
+
+func TestRevisionHandler_GetShowHistory_DiffsAgainstPreviousSnapshot(t *testing.T) {
+	var receivedLimit int
+	h := NewRevisionHandler(
+		&testhelpers.MockRevisionService{
+			GetEntityHistoryFn: func(entityType string, entityID uint, limit, offset int) ([]adminm.Revision, int64, error) {
+				if entityType != "show" || entityID != 7 {
+					t.Errorf("unexpected params: type=%s, id=%d", entityType, entityID)
+				}
+				receivedLimit = limit
+				// Newest first; the third row is the extra one fetched for diffing.
+				return []adminm.Revision{
+					withSnapshot(makeTestRevision(3), `{"title":"C","venue_ids":[2],"updated_at":"t3"}`),
+					withSnapshot(makeTestRevision(2), `{"title":"B","venue_ids":[1],"updated_at":"t2"}`),
+					withSnapshot(makeTestRevision(1), `{"title":"A","venue_ids":[1],"updated_at":"t1"}`),
+				}, 3, nil
+			},
+		},
+		nil,
+	)
+
+	resp, err := h.GetShowHistoryHandler(revisionAdminCtx(), &GetShowHistoryRequest{ShowID: "7", Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedLimit != 3 {
+		t.Errorf("expected limit+1=3 passed to service, got %d", receivedLimit)
+	}
+	if len(resp.Body.Revisions) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(resp.Body.Revisions))
+	}
+
+	newest := resp.Body.Revisions[0].SnapshotDiff
+	if len(newest) != 2 || newest[0].Field != "title" || newest[1].Field != "venue_ids" {
+		t.Errorf("expected diff on title and venue_ids, got %+v", newest)
+	}
+	older := resp.Body.Revisions[1].SnapshotDiff
+	if len(older) != 1 || older[0].Field != "title" || older[0].OldValue != "A" || older[0].NewValue != "B" {
+		t.Errorf("expected title A -> B, got %+v", older)
+	}
+	if string(resp.Body.Revisions[1].Snapshot) == "" {
+		t.Error("expected snapshot to be returned")
+	}
+}
+
+func TestRevisionHandler_GetVenueHistory_MissingSnapshots(t *testing.T) {
+	h := NewRevisionHandler(
+		&testhelpers.MockRevisionService{
+			GetEntityHistoryFn: func(entityType string, entityID uint, limit, offset int) ([]adminm.Revision, int64, error) {
+				if entityType != "venue" {
+					t.Errorf("expected entity type venue, got %s", entityType)
+				}
+				return []adminm.Revision{
+					withSnapshot(makeTestRevision(2), `{"name":"New"}`),
+					makeTestRevision(1),
+				}, 2, nil
+			},
+		},
+		nil,
+	)
+
+	resp, err := h.GetVenueHistoryHandler(revisionAdminCtx(), &GetVenueHistoryRequest{VenueID: "4"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Revisions) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(resp.Body.Revisions))
+	}
+	for _, r := range resp.Body.Revisions {
+		if r.SnapshotDiff == nil || len(r.SnapshotDiff) != 0 {
+			t.Errorf("revision %d: expected empty snapshot diff, got %+v", r.ID, r.SnapshotDiff)
+		}
+	}
+}
+
+func TestRevisionHandler_GetShowHistory_Errors(t *testing.T) {
+	h := NewRevisionHandler(
+		&testhelpers.MockRevisionService{
+			GetEntityHistoryFn: func(entityType string, entityID uint, limit, offset int) ([]adminm.Revision, int64, error) {
+				return nil, 0, fmt.Errorf("db error")
+			},
+		},
+		nil,
+	)
+
+	_, err := h.GetShowHistoryHandler(revisionAdminCtx(), &GetShowHistoryRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+
+	_, err = h.GetShowHistoryHandler(revisionAdminCtx(), &GetShowHistoryRequest{ShowID: "7"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
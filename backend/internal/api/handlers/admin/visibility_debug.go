@@ -0,0 +1,59 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// VisibilityDebugHandler handles the admin show-visibility debugger.
+type VisibilityDebugHandler struct {
+	visibilityService contracts.VisibilityDebugServiceInterface
+}
+
+// NewVisibilityDebugHandler creates a new visibility debug handler.
+func NewVisibilityDebugHandler(
+	visibilityService contracts.VisibilityDebugServiceInterface,
+) *VisibilityDebugHandler {
+	return &VisibilityDebugHandler{
+		visibilityService: visibilityService,
+	}
+}
+
+// ExplainShowVisibilityRequest is the Huma request for GET /admin/debug/visibility
+type ExplainShowVisibilityRequest struct {
+	UserID uint `query:"user_id" required:"false" doc:"Viewer user ID (omit or 0 for the logged-out view)"`
+	ShowID uint `query:"show_id" minimum:"1" doc:"Show ID to evaluate"`
+}
+
+// ExplainShowVisibilityResponse is the Huma response for GET /admin/debug/visibility
+type ExplainShowVisibilityResponse struct {
+	Body contracts.VisibilityExplanation
+}
+
+// ExplainShowVisibilityHandler handles GET /admin/debug/visibility
+func (h *VisibilityDebugHandler) ExplainShowVisibilityHandler(ctx context.Context, req *ExplainShowVisibilityRequest) (*ExplainShowVisibilityResponse, error) {
+	var userID *uint
+	if req.UserID != 0 {
+		userID = &req.UserID
+	}
+
+	explanation, err := h.visibilityService.ExplainShowVisibility(userID, req.ShowID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("visibility_debug_failed",
+			"show_id", req.ShowID,
+			"target_user_id", req.UserID,
+			"error", err.Error(),
+		)
+		if mapped := shared.MapVisibilityError(err); mapped != nil {
+			return nil, mapped
+		}
+		return nil, huma.Error500InternalServerError("Failed to evaluate show visibility")
+	}
+
+	return &ExplainShowVisibilityResponse{Body: *explanation}, nil
+}
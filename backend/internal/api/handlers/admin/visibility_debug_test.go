@@ -0,0 +1,107 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func visibilityAdminCtx() context.Context {
+	return testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+}
+
+func TestExplainShowVisibilityHandler_Success(t *testing.T) {
+	var gotUserID *uint
+	var gotShowID uint
+	h := NewVisibilityDebugHandler(&testhelpers.MockVisibilityDebugService{
+		ExplainShowVisibilityFn: func(userID *uint, showID uint) (*contracts.VisibilityExplanation, error) {
+			gotUserID, gotShowID = userID, showID
+			rule := contracts.VisibilityRuleStatus
+			return &contracts.VisibilityExplanation{
+				ShowID:     showID,
+				ShowStatus: "pending",
+				UserID:     userID,
+				ViewerRole: "user",
+				HiddenBy:   &rule,
+				Steps: []contracts.VisibilityStep{
+					{Rule: rule, Outcome: contracts.VisibilityOutcomeHide, Surface: contracts.VisibilitySurfaceDetail},
+				},
+			}, nil
+		},
+	})
+
+	resp, err := h.ExplainShowVisibilityHandler(visibilityAdminCtx(), &ExplainShowVisibilityRequest{UserID: 9, ShowID: 42})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotShowID != 42 {
+		t.Errorf("expected show_id=42, got %d", gotShowID)
+	}
+	if gotUserID == nil || *gotUserID != 9 {
+		t.Errorf("expected user_id=9, got %v", gotUserID)
+	}
+	if resp.Body.HiddenBy == nil || *resp.Body.HiddenBy != contracts.VisibilityRuleStatus {
+		t.Errorf("expected hidden_by=status, got %v", resp.Body.HiddenBy)
+	}
+	if len(resp.Body.Steps) != 1 {
+		t.Errorf("expected 1 step, got %d", len(resp.Body.Steps))
+	}
+}
+
+func TestExplainShowVisibilityHandler_ZeroUserIsAnonymous(t *testing.T) {
+	called := false
+	h := NewVisibilityDebugHandler(&testhelpers.MockVisibilityDebugService{
+		ExplainShowVisibilityFn: func(userID *uint, showID uint) (*contracts.VisibilityExplanation, error) {
+			called = true
+			if userID != nil {
+				t.Errorf("expected nil user_id for anonymous view, got %d", *userID)
+			}
+			return &contracts.VisibilityExplanation{ShowID: showID, ViewerRole: "anonymous"}, nil
+		},
+	})
+
+	if _, err := h.ExplainShowVisibilityHandler(visibilityAdminCtx(), &ExplainShowVisibilityRequest{ShowID: 42}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("service was not called")
+	}
+}
+
+func TestExplainShowVisibilityHandler_ShowNotFound(t *testing.T) {
+	h := NewVisibilityDebugHandler(&testhelpers.MockVisibilityDebugService{
+		ExplainShowVisibilityFn: func(_ *uint, showID uint) (*contracts.VisibilityExplanation, error) {
+			return nil, apperrors.ErrVisibilityShowNotFound(showID)
+		},
+	})
+
+	_, err := h.ExplainShowVisibilityHandler(visibilityAdminCtx(), &ExplainShowVisibilityRequest{ShowID: 42})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestExplainShowVisibilityHandler_UserNotFound(t *testing.T) {
+	h := NewVisibilityDebugHandler(&testhelpers.MockVisibilityDebugService{
+		ExplainShowVisibilityFn: func(userID *uint, _ uint) (*contracts.VisibilityExplanation, error) {
+			return nil, apperrors.ErrVisibilityUserNotFound(*userID)
+		},
+	})
+
+	_, err := h.ExplainShowVisibilityHandler(visibilityAdminCtx(), &ExplainShowVisibilityRequest{UserID: 9, ShowID: 42})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestExplainShowVisibilityHandler_ServiceError(t *testing.T) {
+	h := NewVisibilityDebugHandler(&testhelpers.MockVisibilityDebugService{
+		ExplainShowVisibilityFn: func(_ *uint, _ uint) (*contracts.VisibilityExplanation, error) {
+			return nil, fmt.Errorf("database error")
+		},
+	})
+
+	_, err := h.ExplainShowVisibilityHandler(visibilityAdminCtx(), &ExplainShowVisibilityRequest{ShowID: 42})
+	testhelpers.AssertHumaError(t, err, 500)
+}
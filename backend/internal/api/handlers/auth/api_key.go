@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// APIKeyHandler handles public API key management for the signed-in user.
+// Keys themselves can't reach these endpoints (they carry no scope), so a
+// leaked key can't mint or revoke other keys.
+type APIKeyHandler struct {
+	apiKeyService contracts.APIKeyServiceInterface
+}
+
+// NewAPIKeyHandler creates a new API key handler
+func NewAPIKeyHandler(apiKeyService contracts.APIKeyServiceInterface) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// ListAPIKeysRequest is empty — user is derived from JWT context
+type ListAPIKeysRequest struct{}
+
+// ListAPIKeysResponse lists the user's active keys with usage counters
+type ListAPIKeysResponse struct {
+	Body struct {
+		Keys []contracts.APIKeyResponse `json:"keys"`
+	}
+}
+
+// ListAPIKeysHandler handles GET /auth/api-keys
+func (h *APIKeyHandler) ListAPIKeysHandler(ctx context.Context, req *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	keys, err := h.apiKeyService.ListKeys(user.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("list_api_keys_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(fmt.Sprintf("Failed to list API keys (request_id: %s)", requestID))
+	}
+
+	resp := &ListAPIKeysResponse{}
+	resp.Body.Keys = keys
+	return resp, nil
+}
+
+// CreateAPIKeyRequest names the key and picks its scopes
+type CreateAPIKeyRequest struct {
+	Body struct {
+		Name   string   `json:"name" doc:"Label to recognize the key by" example:"Tour date widget"`
+		Scopes []string `json:"scopes" doc:"Scopes to grant: read:shows, read:venues, write:submissions" example:"[\"read:shows\"]"`
+	}
+}
+
+// CreateAPIKeyResponse returns the new key, including the plaintext key
+type CreateAPIKeyResponse struct {
+	Body contracts.APIKeyCreateResponse
+}
+
+// CreateAPIKeyHandler handles POST /auth/api-keys. The plaintext key is only
+// returned in this response.
+func (h *APIKeyHandler) CreateAPIKeyHandler(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	created, err := h.apiKeyService.CreateKey(user.ID, req.Body.Name, req.Body.Scopes)
+	if err != nil {
+		if mapped := shared.MapAPIKeyError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("create_api_key_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(fmt.Sprintf("Failed to create API key (request_id: %s)", requestID))
+	}
+
+	logger.FromContext(ctx).Info("api_key_created",
+		"user_id", user.ID,
+		"api_key_id", created.ID,
+		"scopes", created.Scopes,
+		"request_id", requestID,
+	)
+
+	return &CreateAPIKeyResponse{Body: *created}, nil
+}
+
+// GetAPIKeyRequest identifies one of the user's keys
+type GetAPIKeyRequest struct {
+	KeyID string `path:"key_id" doc:"API key ID" example:"1"`
+}
+
+// GetAPIKeyResponse returns a key with its usage counters
+type GetAPIKeyResponse struct {
+	Body contracts.APIKeyResponse
+}
+
+// GetAPIKeyHandler handles GET /auth/api-keys/{key_id}
+func (h *APIKeyHandler) GetAPIKeyHandler(ctx context.Context, req *GetAPIKeyRequest) (*GetAPIKeyResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	keyID, err := strconv.ParseUint(req.KeyID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid API key ID")
+	}
+
+	key, err := h.apiKeyService.GetKey(user.ID, uint(keyID))
+	if err != nil {
+		if mapped := shared.MapAPIKeyError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("get_api_key_failed",
+			"user_id", user.ID,
+			"api_key_id", keyID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(fmt.Sprintf("Failed to get API key (request_id: %s)", requestID))
+	}
+
+	return &GetAPIKeyResponse{Body: *key}, nil
+}
+
+// RevokeAPIKeyRequest identifies the key to revoke
+type RevokeAPIKeyRequest struct {
+	KeyID string `path:"key_id" doc:"API key ID" example:"1"`
+}
+
+// RevokeAPIKeyResponse confirms the revocation
+type RevokeAPIKeyResponse struct {
+	Body struct {
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+	}
+}
+
+// RevokeAPIKeyHandler handles DELETE /auth/api-keys/{key_id}
+func (h *APIKeyHandler) RevokeAPIKeyHandler(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	keyID, err := strconv.ParseUint(req.KeyID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid API key ID")
+	}
+
+	if err := h.apiKeyService.RevokeKey(user.ID, uint(keyID)); err != nil {
+		if mapped := shared.MapAPIKeyError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("revoke_api_key_failed",
+			"user_id", user.ID,
+			"api_key_id", keyID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(fmt.Sprintf("Failed to revoke API key (request_id: %s)", requestID))
+	}
+
+	logger.FromContext(ctx).Info("api_key_revoked",
+		"user_id", user.ID,
+		"api_key_id", keyID,
+		"request_id", requestID,
+	)
+
+	resp := &RevokeAPIKeyResponse{}
+	resp.Body.Success = true
+	resp.Body.Message = "API key revoked"
+	return resp, nil
+}
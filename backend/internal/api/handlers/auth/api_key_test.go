@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func apiKeyUserCtx() context.Context {
+	return testhelpers.CtxWithUser(&authm.User{ID: 5, EmailVerified: true})
+}
+
+// --- ListAPIKeysHandler ---
+
+func TestListAPIKeysHandler_NoAuth(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{})
+	_, err := h.ListAPIKeysHandler(context.Background(), &ListAPIKeysRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestListAPIKeysHandler_Success(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+		ListKeysFn: func(userID uint) ([]contracts.APIKeyResponse, error) {
+			if userID != 5 {
+				t.Errorf("userID = %d, want 5", userID)
+			}
+			return []contracts.APIKeyResponse{{ID: 1, Name: "widget", RequestCount: 12}}, nil
+		},
+	})
+
+	resp, err := h.ListAPIKeysHandler(apiKeyUserCtx(), &ListAPIKeysRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Keys) != 1 || resp.Body.Keys[0].RequestCount != 12 {
+		t.Errorf("unexpected keys: %+v", resp.Body.Keys)
+	}
+}
+
+func TestListAPIKeysHandler_ServiceError(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+		ListKeysFn: func(uint) ([]contracts.APIKeyResponse, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	})
+	_, err := h.ListAPIKeysHandler(apiKeyUserCtx(), &ListAPIKeysRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+// --- CreateAPIKeyHandler ---
+
+func TestCreateAPIKeyHandler_NoAuth(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{})
+	_, err := h.CreateAPIKeyHandler(context.Background(), &CreateAPIKeyRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestCreateAPIKeyHandler_Success(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+		CreateKeyFn: func(userID uint, name string, scopes []string) (*contracts.APIKeyCreateResponse, error) {
+			if name != "widget" || len(scopes) != 1 || scopes[0] != authm.APIKeyScopeReadShows {
+				t.Errorf("unexpected args: name=%q scopes=%v", name, scopes)
+			}
+			return &contracts.APIKeyCreateResponse{
+				APIKeyResponse: contracts.APIKeyResponse{ID: 4, Name: name, Scopes: scopes},
+				Key:            "phak_secret",
+			}, nil
+		},
+	})
+
+	req := &CreateAPIKeyRequest{}
+	req.Body.Name = "widget"
+	req.Body.Scopes = []string{authm.APIKeyScopeReadShows}
+	resp, err := h.CreateAPIKeyHandler(apiKeyUserCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 4 || resp.Body.Key != "phak_secret" {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestCreateAPIKeyHandler_MapsServiceErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not verified", apperrors.ErrAPIKeyEmailNotVerified(), 403},
+		{"invalid scope", apperrors.ErrAPIKeyInvalid("unknown scope"), 422},
+		{"limit reached", apperrors.ErrAPIKeyLimitReached(10), 409},
+		{"internal", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+				CreateKeyFn: func(uint, string, []string) (*contracts.APIKeyCreateResponse, error) {
+					return nil, tc.err
+				},
+			})
+			_, err := h.CreateAPIKeyHandler(apiKeyUserCtx(), &CreateAPIKeyRequest{})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+// --- GetAPIKeyHandler ---
+
+func TestGetAPIKeyHandler_InvalidID(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{})
+	_, err := h.GetAPIKeyHandler(apiKeyUserCtx(), &GetAPIKeyRequest{KeyID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetAPIKeyHandler_NotFound(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+		GetKeyFn: func(userID, keyID uint) (*contracts.APIKeyResponse, error) {
+			return nil, apperrors.ErrAPIKeyNotFound(keyID)
+		},
+	})
+	_, err := h.GetAPIKeyHandler(apiKeyUserCtx(), &GetAPIKeyRequest{KeyID: "9"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestGetAPIKeyHandler_Success(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+		GetKeyFn: func(userID, keyID uint) (*contracts.APIKeyResponse, error) {
+			return &contracts.APIKeyResponse{ID: keyID, RequestCount: 3}, nil
+		},
+	})
+	resp, err := h.GetAPIKeyHandler(apiKeyUserCtx(), &GetAPIKeyRequest{KeyID: "9"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 9 || resp.Body.RequestCount != 3 {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+// --- RevokeAPIKeyHandler ---
+
+func TestRevokeAPIKeyHandler_NoAuth(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{})
+	_, err := h.RevokeAPIKeyHandler(context.Background(), &RevokeAPIKeyRequest{KeyID: "1"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestRevokeAPIKeyHandler_Success(t *testing.T) {
+	var revoked uint
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+		RevokeKeyFn: func(userID, keyID uint) error {
+			revoked = keyID
+			return nil
+		},
+	})
+	resp, err := h.RevokeAPIKeyHandler(apiKeyUserCtx(), &RevokeAPIKeyRequest{KeyID: "7"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success || revoked != 7 {
+		t.Errorf("expected key 7 revoked, got success=%v revoked=%d", resp.Body.Success, revoked)
+	}
+}
+
+func TestRevokeAPIKeyHandler_NotFound(t *testing.T) {
+	h := NewAPIKeyHandler(&testhelpers.MockAPIKeyService{
+		RevokeKeyFn: func(userID, keyID uint) error {
+			return apperrors.ErrAPIKeyNotFound(keyID)
+		},
+	})
+	_, err := h.RevokeAPIKeyHandler(apiKeyUserCtx(), &RevokeAPIKeyRequest{KeyID: "7"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
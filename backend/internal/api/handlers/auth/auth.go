@@ -11,6 +11,7 @@ import (
 
 	"github.com/danielgtaylor/huma/v2"
 
+	"psychic-homily-backend/internal/api/handlers/shared"
 	"psychic-homily-backend/internal/api/middleware"
 	"psychic-homily-backend/internal/config"
 	autherrors "psychic-homily-backend/internal/errors"
@@ -43,6 +44,49 @@ func validateSignupAgeConfirmation(ageConfirmed bool, minAgeAttested int) (code,
 	return "", "", true
 }
 
+// validateCredentialsPresent records a required error for a blank email or
+// password.
+func validateCredentialsPresent(v *shared.Validator, email, password string) {
+	v.Check(email != "", "email", shared.ValidationCodeRequired, "Email is required")
+	v.Check(password != "", "password", shared.ValidationCodeRequired, "Password is required")
+}
+
+// validateRegisterRequest runs the signup presence/consent checks and
+// returns every failure, plus the error code and message of the first.
+func validateRegisterRequest(input *RegisterRequest) (code, message string, errs []*shared.FieldError) {
+	var v shared.Validator
+	validateCredentialsPresent(&v, input.Body.Email, input.Body.Password)
+	if !v.Valid() {
+		code, message = autherrors.CodeValidationFailed, "Email and password are required"
+	}
+	if !input.Body.TermsAccepted {
+		msg := "You must accept the Terms of Service and Privacy Policy"
+		v.Add(shared.NewFieldError("terms_accepted", shared.ValidationCodeRequired, msg))
+		if code == "" {
+			code, message = autherrors.CodeValidationFailed, msg
+		}
+	}
+	if input.Body.TermsVersion == "" {
+		msg := "Terms version is required"
+		v.Add(shared.NewFieldError("terms_version", shared.ValidationCodeRequired, msg))
+		if code == "" {
+			code, message = autherrors.CodeValidationFailed, msg
+		}
+	}
+	if ageCode, ageMsg, ok := validateSignupAgeConfirmation(input.Body.AgeConfirmed, input.Body.MinAgeAttested); !ok {
+		if !input.Body.AgeConfirmed {
+			v.Add(shared.NewFieldError("age_confirmed", shared.ValidationCodeRequired, ageMsg))
+		} else {
+			v.Add(shared.NewFieldError("min_age_attested", shared.ValidationCodeOutOfRange, ageMsg).
+				WithValue(input.Body.MinAgeAttested))
+		}
+		if code == "" {
+			code, message = ageCode, ageMsg
+		}
+	}
+	return code, message, v.FieldErrors()
+}
+
 // AuthHandler handles authentication requests
 type AuthHandler struct {
 	authService       contracts.AuthServiceInterface
@@ -87,12 +131,13 @@ type LoginRequest struct {
 type LoginResponse struct {
 	SetCookie http.Cookie `header:"Set-Cookie" doc:"Authentication cookie"`
 	Body      struct {
-		Success   bool        `json:"success" example:"true" doc:"Success status"`
-		Message   string      `json:"message" example:"Login successful" doc:"Response message"`
-		Token     string      `json:"token,omitempty" example:"eyJhbGciOiJIUzI1NiIs..." doc:"JWT token for non-cookie clients (e.g. mobile apps)"`
-		ErrorCode string      `json:"error_code,omitempty" example:"INVALID_CREDENTIALS" doc:"Error code for programmatic handling"`
-		RequestID string      `json:"request_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000" doc:"Request ID for debugging"`
-		User      *authm.User `json:"user,omitempty" doc:"User information"`
+		Success   bool                 `json:"success" example:"true" doc:"Success status"`
+		Message   string               `json:"message" example:"Login successful" doc:"Response message"`
+		Token     string               `json:"token,omitempty" example:"eyJhbGciOiJIUzI1NiIs..." doc:"JWT token for non-cookie clients (e.g. mobile apps)"`
+		ErrorCode string               `json:"error_code,omitempty" example:"INVALID_CREDENTIALS" doc:"Error code for programmatic handling"`
+		Errors    []*shared.FieldError `json:"errors,omitempty" doc:"Field-level details when error_code is VALIDATION_FAILED"`
+		RequestID string               `json:"request_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000" doc:"Request ID for debugging"`
+		User      *authm.User          `json:"user,omitempty" doc:"User information"`
 	}
 }
 
@@ -107,7 +152,9 @@ func (h *AuthHandler) LoginHandler(ctx context.Context, input *LoginRequest) (*L
 	)
 
 	// Validate email and password
-	if input.Body.Email == "" || input.Body.Password == "" {
+	var v shared.Validator
+	validateCredentialsPresent(&v, input.Body.Email, input.Body.Password)
+	if !v.Valid() {
 		authErr := autherrors.ErrValidationFailed("Email and password are required")
 		logger.AuthWarn(ctx, "login_validation_failed",
 			"error", authErr.Message,
@@ -115,6 +162,7 @@ func (h *AuthHandler) LoginHandler(ctx context.Context, input *LoginRequest) (*L
 		resp.Body.Success = false
 		resp.Body.Message = authErr.Message
 		resp.Body.ErrorCode = autherrors.CodeValidationFailed
+		resp.Body.Errors = v.FieldErrors()
 		return resp, nil
 	}
 
@@ -539,12 +587,13 @@ type RegisterRequest struct {
 type RegisterResponse struct {
 	SetCookie http.Cookie `header:"Set-Cookie" doc:"Authentication cookie"`
 	Body      struct {
-		Success   bool        `json:"success" example:"true" doc:"Success status"`
-		Message   string      `json:"message" example:"Registration successful" doc:"Response message"`
-		Token     string      `json:"token,omitempty" example:"eyJhbGciOiJIUzI1NiIs..." doc:"JWT token for non-cookie clients (e.g. mobile apps)"`
-		ErrorCode string      `json:"error_code,omitempty" example:"USER_EXISTS" doc:"Error code for programmatic handling"`
-		RequestID string      `json:"request_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000" doc:"Request ID for debugging"`
-		User      *authm.User `json:"user,omitempty" doc:"User information"`
+		Success   bool                 `json:"success" example:"true" doc:"Success status"`
+		Message   string               `json:"message" example:"Registration successful" doc:"Response message"`
+		Token     string               `json:"token,omitempty" example:"eyJhbGciOiJIUzI1NiIs..." doc:"JWT token for non-cookie clients (e.g. mobile apps)"`
+		ErrorCode string               `json:"error_code,omitempty" example:"USER_EXISTS" doc:"Error code for programmatic handling"`
+		Errors    []*shared.FieldError `json:"errors,omitempty" doc:"Field-level details when error_code is VALIDATION_FAILED"`
+		RequestID string               `json:"request_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000" doc:"Request ID for debugging"`
+		User      *authm.User          `json:"user,omitempty" doc:"User information"`
 	}
 }
 
@@ -558,44 +607,17 @@ func (h *AuthHandler) RegisterHandler(ctx context.Context, input *RegisterReques
 		"email_hash", logger.HashEmail(input.Body.Email),
 	)
 
-	// Validate email and password presence
-	if input.Body.Email == "" || input.Body.Password == "" {
-		authErr := autherrors.ErrValidationFailed("Email and password are required")
-		logger.AuthWarn(ctx, "register_validation_failed",
-			"error", authErr.Message,
-		)
-		resp.Body.Success = false
-		resp.Body.Message = authErr.Message
-		resp.Body.ErrorCode = autherrors.CodeValidationFailed
-		return resp, nil
-	}
-	if !input.Body.TermsAccepted {
-		authErr := autherrors.ErrValidationFailed("You must accept the Terms of Service and Privacy Policy")
-		logger.AuthWarn(ctx, "register_validation_failed",
-			"error", authErr.Message,
-		)
-		resp.Body.Success = false
-		resp.Body.Message = authErr.Message
-		resp.Body.ErrorCode = autherrors.CodeValidationFailed
-		return resp, nil
-	}
-	if input.Body.TermsVersion == "" {
-		authErr := autherrors.ErrValidationFailed("Terms version is required")
-		logger.AuthWarn(ctx, "register_validation_failed",
-			"error", authErr.Message,
-		)
-		resp.Body.Success = false
-		resp.Body.Message = authErr.Message
-		resp.Body.ErrorCode = autherrors.CodeValidationFailed
-		return resp, nil
-	}
-	if errCode, errMsg, ok := validateSignupAgeConfirmation(input.Body.AgeConfirmed, input.Body.MinAgeAttested); !ok {
+	// Validate every signup field up front so the form can flag them all.
+	// Message and ErrorCode stay those of the first failure, in the order
+	// the checks used to return.
+	if errCode, errMsg, fieldErrs := validateRegisterRequest(input); len(fieldErrs) > 0 {
 		logger.AuthWarn(ctx, "register_validation_failed",
 			"error", errMsg,
 		)
 		resp.Body.Success = false
 		resp.Body.Message = errMsg
 		resp.Body.ErrorCode = errCode
+		resp.Body.Errors = fieldErrs
 		return resp, nil
 	}
 
@@ -621,6 +643,10 @@ func (h *AuthHandler) RegisterHandler(ctx context.Context, input *RegisterReques
 			resp.Body.Success = false
 			resp.Body.Message = authErr.Message
 			resp.Body.ErrorCode = autherrors.CodeValidationFailed
+			for _, msg := range validationResult.Errors {
+				resp.Body.Errors = append(resp.Body.Errors,
+					shared.NewFieldError("password", shared.ValidationCodeInvalidValue, msg))
+			}
 			return resp, nil
 		}
 	}
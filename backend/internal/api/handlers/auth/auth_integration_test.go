@@ -79,7 +79,7 @@ func (s *AuthHandlerIntegrationSuite) newAuthHandler(emailConfigured bool) *Auth
 
 	authSvc := auth.NewAuthService(s.deps.DB, emailCfg, s.deps.UserService)
 	jwtSvc := auth.NewJWTService(s.deps.DB, emailCfg, s.deps.UserService)
-	emailSvc := notification.NewEmailService(emailCfg)
+	emailSvc := notification.NewEmailService(emailCfg, nil)
 	discordSvc := notification.NewDiscordService(emailCfg)
 	pv := auth.NewPasswordValidator()
 
@@ -85,6 +85,23 @@ func TestLoginHandler_MissingPassword(t *testing.T) {
 	}
 }
 
+func TestLoginHandler_MissingPassword_FieldErrors(t *testing.T) {
+	h := testAuthHandler()
+	input := &LoginRequest{}
+	input.Body.Email = "user@example.com"
+
+	resp, err := h.LoginHandler(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Errors) != 1 {
+		t.Fatalf("expected 1 field error, got %d", len(resp.Body.Errors))
+	}
+	if fe := resp.Body.Errors[0]; fe.Field != "password" || fe.Code != "required" {
+		t.Errorf("expected password/required, got %s/%s", fe.Field, fe.Code)
+	}
+}
+
 // --- OAuthLoginHandler ---
 
 func TestOAuthLoginHandler_InvalidProvider(t *testing.T) {
@@ -332,6 +349,33 @@ func TestRegisterHandler_MissingTermsAcceptance(t *testing.T) {
 	}
 }
 
+// TestRegisterHandler_ReportsAllFieldErrors: every failing signup field is
+// listed, while message/error_code stay those of the first check.
+func TestRegisterHandler_ReportsAllFieldErrors(t *testing.T) {
+	h := testAuthHandler()
+	input := &RegisterRequest{}
+	input.Body.Email = "user@example.com"
+
+	resp, err := h.RegisterHandler(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ErrorCode != autherrors.CodeValidationFailed {
+		t.Errorf("expected error_code=%s, got %s", autherrors.CodeValidationFailed, resp.Body.ErrorCode)
+	}
+	if resp.Body.Message != "Email and password are required" {
+		t.Errorf("unexpected message %q", resp.Body.Message)
+	}
+	var fields []string
+	for _, fe := range resp.Body.Errors {
+		fields = append(fields, fe.Field)
+	}
+	want := "password,terms_accepted,terms_version,age_confirmed"
+	if got := strings.Join(fields, ","); got != want {
+		t.Errorf("expected fields %s, got %s", want, got)
+	}
+}
+
 // TestRegisterHandler_MissingAgeConfirmation mirrors the terms-rejection test
 // (PSY-1023): a signup with terms accepted but no age confirmation must be
 // rejected with the AGE_CONFIRMATION_REQUIRED code.
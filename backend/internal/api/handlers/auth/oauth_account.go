@@ -2,11 +2,13 @@ package auth
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 
 	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/config"
 	"psychic-homily-backend/internal/logger"
 	"psychic-homily-backend/internal/services/contracts"
 )
@@ -14,12 +16,14 @@ import (
 // OAuthAccountHandler handles OAuth account management HTTP requests
 type OAuthAccountHandler struct {
 	userService contracts.UserServiceInterface
+	config      *config.Config
 }
 
 // NewOAuthAccountHandler creates a new OAuth account handler
-func NewOAuthAccountHandler(userService contracts.UserServiceInterface) *OAuthAccountHandler {
+func NewOAuthAccountHandler(userService contracts.UserServiceInterface, cfg *config.Config) *OAuthAccountHandler {
 	return &OAuthAccountHandler{
 		userService: userService,
+		config:      cfg,
 	}
 }
 
@@ -188,3 +192,71 @@ func (h *OAuthAccountHandler) UnlinkOAuthAccountHandler(ctx context.Context, req
 		},
 	}, nil
 }
+
+// LinkOAuthAccountRequest represents the request for linking an OAuth account
+type LinkOAuthAccountRequest struct {
+	Provider string `path:"provider" doc:"OAuth provider to link (e.g., google)" example:"google"`
+}
+
+// LinkOAuthAccountResponse represents the response for starting a link flow
+type LinkOAuthAccountResponse struct {
+	SetCookie http.Cookie `header:"Set-Cookie" doc:"Short-lived link intent cookie"`
+	Body      struct {
+		Success      bool   `json:"success"`
+		AuthorizeURL string `json:"authorize_url" example:"/auth/login/google" doc:"Path (relative to the API base) to navigate the browser to"`
+	}
+}
+
+// LinkOAuthAccountHandler handles POST /auth/link/{provider}. It binds the
+// next OAuth round-trip to the signed-in user: the browser then navigates to
+// AuthorizeURL, and the provider callback attaches the account to this user
+// instead of logging in, redirecting back to /settings with ?linked= or
+// ?link_error=.
+func (h *OAuthAccountHandler) LinkOAuthAccountHandler(ctx context.Context, req *LinkOAuthAccountRequest) (*LinkOAuthAccountResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	if req.Provider != "google" && req.Provider != "github" {
+		return nil, huma.Error422UnprocessableEntity("Invalid provider")
+	}
+
+	value, err := encodeOAuthLinkIntent(oauthLinkIntent{
+		UserID:    user.ID,
+		Provider:  req.Provider,
+		ExpiresAt: time.Now().Add(oauthLinkIntentTTL).Unix(),
+	}, h.config.OAuth.SecretKey)
+	if err != nil {
+		logger.FromContext(ctx).Error("link_oauth_intent_failed",
+			"user_id", user.ID,
+			"provider", req.Provider,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError("Failed to start account linking")
+	}
+
+	logger.FromContext(ctx).Info("link_oauth_started",
+		"user_id", user.ID,
+		"provider", req.Provider,
+		"request_id", requestID,
+	)
+
+	resp := &LinkOAuthAccountResponse{
+		SetCookie: http.Cookie{
+			Name:     oauthLinkIntentCookieName,
+			Value:    value,
+			Path:     "/",
+			MaxAge:   int(oauthLinkIntentTTL / time.Second),
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+			Secure:   h.config.Session.Secure,
+		},
+	}
+	resp.Body.Success = true
+	resp.Body.AuthorizeURL = "/auth/login/" + req.Provider
+	return resp, nil
+}
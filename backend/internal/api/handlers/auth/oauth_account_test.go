@@ -10,7 +10,7 @@ import (
 )
 
 func testOAuthAccountHandler() *OAuthAccountHandler {
-	return NewOAuthAccountHandler(nil)
+	return NewOAuthAccountHandler(nil, testConfig())
 }
 
 // --- GetOAuthAccountsHandler ---
@@ -52,7 +52,7 @@ func TestGetOAuthAccountsHandler_ConnectedAtIsUTC(t *testing.T) {
 		},
 	}
 
-	h := NewOAuthAccountHandler(mockUserService)
+	h := NewOAuthAccountHandler(mockUserService, testConfig())
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	resp, err := h.GetOAuthAccountsHandler(ctx, &GetOAuthAccountsRequest{})
@@ -88,3 +88,42 @@ func TestUnlinkOAuthAccountHandler_InvalidProvider(t *testing.T) {
 	_, err := h.UnlinkOAuthAccountHandler(ctx, req)
 	testhelpers.AssertHumaError(t, err, 422)
 }
+
+// --- LinkOAuthAccountHandler ---
+
+func TestLinkOAuthAccountHandler_NoAuth(t *testing.T) {
+	h := testOAuthAccountHandler()
+	_, err := h.LinkOAuthAccountHandler(context.Background(), &LinkOAuthAccountRequest{Provider: "google"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestLinkOAuthAccountHandler_InvalidProvider(t *testing.T) {
+	h := testOAuthAccountHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+	_, err := h.LinkOAuthAccountHandler(ctx, &LinkOAuthAccountRequest{Provider: "myspace"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestLinkOAuthAccountHandler_SetsSignedIntent(t *testing.T) {
+	h := testOAuthAccountHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 9})
+
+	resp, err := h.LinkOAuthAccountHandler(ctx, &LinkOAuthAccountRequest{Provider: "github"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.AuthorizeURL != "/auth/login/github" {
+		t.Errorf("expected /auth/login/github, got %q", resp.Body.AuthorizeURL)
+	}
+	if resp.SetCookie.Name != oauthLinkIntentCookieName || !resp.SetCookie.HttpOnly {
+		t.Errorf("expected an HttpOnly %s cookie, got %+v", oauthLinkIntentCookieName, resp.SetCookie)
+	}
+
+	intent, err := decodeOAuthLinkIntent(resp.SetCookie.Value, testConfig().OAuth.SecretKey, time.Now())
+	if err != nil {
+		t.Fatalf("cookie did not decode: %v", err)
+	}
+	if intent.UserID != 9 || intent.Provider != "github" {
+		t.Errorf("unexpected intent: %+v", intent)
+	}
+}
@@ -1,7 +1,9 @@
 package auth
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -10,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +26,14 @@ import (
 
 const oauthSignupConsentCookieName = "oauth_signup_consent"
 
+// oauthLinkIntentCookieName marks an OAuth round-trip started by
+// POST /auth/link/{provider}: the callback attaches the provider to the
+// signed-in user instead of logging in.
+const oauthLinkIntentCookieName = "oauth_link_intent"
+
+// oauthLinkIntentTTL covers the full OAuth round-trip, like the consent cookie.
+const oauthLinkIntentTTL = 10 * time.Minute
+
 func generateRandomID() string {
 	bytes := make([]byte, 16)
 	rand.Read(bytes)
@@ -269,6 +280,28 @@ func (h *OAuthHTTPHandler) OAuthCallbackHTTPHandler(w http.ResponseWriter, r *ht
 		})
 	}
 
+	// Read the account-linking intent captured by POST /auth/link/{provider}.
+	var linkIntent *oauthLinkIntent
+	if cookie, err := r.Cookie(oauthLinkIntentCookieName); err == nil {
+		intent, decodeErr := decodeOAuthLinkIntent(cookie.Value, h.config.OAuth.SecretKey, time.Now())
+		if decodeErr != nil {
+			log.Printf("WARN: rejected OAuth link intent cookie: %v", decodeErr)
+		} else if intent.Provider == provider {
+			linkIntent = intent
+		}
+
+		// Always clear the one-time link cookie.
+		http.SetCookie(w, &http.Cookie{
+			Name:     oauthLinkIntentCookieName,
+			Value:    "",
+			Path:     "/",
+			MaxAge:   -1,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+			Secure:   secureCookie,
+		})
+	}
+
 	// Add provider to query parameters for Goth (following best practices)
 	q := r.URL.Query()
 	q.Add("provider", provider)
@@ -280,6 +313,11 @@ func (h *OAuthHTTPHandler) OAuthCallbackHTTPHandler(w http.ResponseWriter, r *ht
 		frontendURL = "http://localhost:3000"
 	}
 
+	if linkIntent != nil {
+		h.completeOAuthLink(w, r, provider, linkIntent.UserID, frontendURL)
+		return
+	}
+
 	// Use AuthService to handle the complete OAuth flow. New users require consent.
 	user, token, err := h.authService.OAuthCallbackWithConsent(w, r, provider, signupConsent)
 	if err != nil {
@@ -322,6 +360,72 @@ func (h *OAuthHTTPHandler) OAuthCallbackHTTPHandler(w http.ResponseWriter, r *ht
 	http.Redirect(w, r, frontendURL, http.StatusTemporaryRedirect)
 }
 
+// completeOAuthLink finishes an account-linking round-trip and sends the
+// browser back to settings. The session cookie is left untouched.
+func (h *OAuthHTTPHandler) completeOAuthLink(w http.ResponseWriter, r *http.Request, provider string, userID uint, frontendURL string) {
+	_, err := h.authService.OAuthLinkCallback(w, r, provider, userID)
+	if err != nil {
+		log.Printf("OAuth link failed for user ID %d (%s): %v", userID, provider, err)
+		errorMessage := "Failed to connect account"
+		var authErr *autherrors.AuthError
+		if errors.As(err, &authErr) {
+			switch authErr.Code {
+			case autherrors.CodeOAuthAccountInUse, autherrors.CodeOAuthProviderLinked:
+				errorMessage = authErr.UserMessage()
+			}
+		}
+		redirectURL := frontendURL + "/settings?link_error=" + url.QueryEscape(errorMessage)
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+
+	log.Printf("OAuth link successful for user ID %d: %s", userID, provider)
+	http.Redirect(w, r, frontendURL+"/settings?linked="+url.QueryEscape(provider), http.StatusTemporaryRedirect)
+}
+
+// oauthLinkIntent is the payload of the link-intent cookie.
+type oauthLinkIntent struct {
+	UserID    uint   `json:"uid"`
+	Provider  string `json:"provider"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// encodeOAuthLinkIntent signs an intent with the OAuth secret so the
+// callback can trust the user id without a session lookup.
+func encodeOAuthLinkIntent(intent oauthLinkIntent, secret string) (string, error) {
+	data, err := json.Marshal(intent)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + signOAuthLinkIntent(payload, secret), nil
+}
+
+func decodeOAuthLinkIntent(value, secret string, now time.Time) (*oauthLinkIntent, error) {
+	payload, sig, ok := strings.Cut(value, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(signOAuthLinkIntent(payload, secret))) {
+		return nil, errors.New("invalid link intent signature")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, err
+	}
+	var intent oauthLinkIntent
+	if err := json.Unmarshal(data, &intent); err != nil {
+		return nil, err
+	}
+	if intent.UserID == 0 || now.Unix() > intent.ExpiresAt {
+		return nil, errors.New("link intent expired")
+	}
+	return &intent, nil
+}
+
+func signOAuthLinkIntent(payload, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
 func encodeOAuthSignupConsent(consent contracts.OAuthSignupConsent) (string, error) {
 	data, err := json.Marshal(consent)
 	if err != nil {
@@ -2,12 +2,19 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	autherrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
 )
 
 // --- generateRandomID ---
@@ -325,3 +332,112 @@ func TestOAuthLoginHTTPHandler_SignupIntent_RecordsAgeConsent(t *testing.T) {
 		t.Errorf("expected MinAgeAttested=%d in consent cookie, got %d", MinSignupAge, consent.MinAgeAttested)
 	}
 }
+
+// --- OAuth link intent ---
+
+func TestOAuthLinkIntent_RoundTrip(t *testing.T) {
+	now := time.Now()
+	value, err := encodeOAuthLinkIntent(oauthLinkIntent{UserID: 5, Provider: "google", ExpiresAt: now.Add(time.Minute).Unix()}, "secret")
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	intent, err := decodeOAuthLinkIntent(value, "secret", now)
+	if err != nil || intent.UserID != 5 || intent.Provider != "google" {
+		t.Fatalf("decode = %+v, %v", intent, err)
+	}
+
+	if _, err := decodeOAuthLinkIntent(value, "other-secret", now); err == nil {
+		t.Error("expected a signature error for the wrong secret")
+	}
+	tampered := strings.Replace(value, value[:4], "AAAA", 1)
+	if _, err := decodeOAuthLinkIntent(tampered, "secret", now); err == nil {
+		t.Error("expected a signature error for a tampered payload")
+	}
+	if _, err := decodeOAuthLinkIntent(value, "secret", now.Add(2*time.Minute)); err == nil {
+		t.Error("expected an expiry error")
+	}
+}
+
+func linkCallbackRequest(t *testing.T, userID uint, provider string) (*httptest.ResponseRecorder, *http.Request) {
+	t.Helper()
+	value, err := encodeOAuthLinkIntent(oauthLinkIntent{UserID: userID, Provider: provider, ExpiresAt: time.Now().Add(time.Minute).Unix()}, testConfig().OAuth.SecretKey)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	req := httptest.NewRequest("GET", "/auth/callback/"+provider, nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("provider", provider)
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	req.AddCookie(&http.Cookie{Name: oauthLinkIntentCookieName, Value: value})
+	return httptest.NewRecorder(), req
+}
+
+func TestOAuthCallbackHTTPHandler_LinkIntent_Success(t *testing.T) {
+	var linkedUser uint
+	h := NewOAuthHTTPHandler(&testhelpers.MockAuthService{
+		OAuthLinkCallbackFn: func(_ http.ResponseWriter, _ *http.Request, provider string, userID uint) (*authm.User, error) {
+			linkedUser = userID
+			return &authm.User{ID: userID}, nil
+		},
+		OAuthCallbackWithConsentFn: func(http.ResponseWriter, *http.Request, string, *contracts.OAuthSignupConsent) (*authm.User, string, error) {
+			t.Error("a link round-trip must not log in")
+			return nil, "", nil
+		},
+	}, testConfig())
+
+	w, req := linkCallbackRequest(t, 12, "google")
+	h.OAuthCallbackHTTPHandler(w, req)
+
+	if linkedUser != 12 {
+		t.Errorf("expected link for user 12, got %d", linkedUser)
+	}
+	if got := w.Header().Get("Location"); !strings.HasSuffix(got, "/settings?linked=google") {
+		t.Errorf("unexpected redirect %q", got)
+	}
+	for _, c := range w.Result().Cookies() {
+		if c.Name == "auth_token" {
+			t.Error("linking must not replace the session cookie")
+		}
+	}
+}
+
+func TestOAuthCallbackHTTPHandler_LinkIntent_AccountInUse(t *testing.T) {
+	h := NewOAuthHTTPHandler(&testhelpers.MockAuthService{
+		OAuthLinkCallbackFn: func(http.ResponseWriter, *http.Request, string, uint) (*authm.User, error) {
+			return nil, fmt.Errorf("failed to link OAuth account: %w", autherrors.ErrOAuthAccountInUse("google"))
+		},
+	}, testConfig())
+
+	w, req := linkCallbackRequest(t, 12, "google")
+	h.OAuthCallbackHTTPHandler(w, req)
+
+	location := w.Header().Get("Location")
+	if !strings.Contains(location, "/settings?link_error=") || !strings.Contains(location, "different+Psychic+Homily+account") {
+		t.Errorf("unexpected redirect %q", location)
+	}
+}
+
+func TestOAuthCallbackHTTPHandler_LinkIntent_ProviderMismatchFallsBackToLogin(t *testing.T) {
+	loggedIn := false
+	h := NewOAuthHTTPHandler(&testhelpers.MockAuthService{
+		OAuthLinkCallbackFn: func(http.ResponseWriter, *http.Request, string, uint) (*authm.User, error) {
+			t.Error("an intent for another provider must be ignored")
+			return nil, nil
+		},
+		OAuthCallbackWithConsentFn: func(http.ResponseWriter, *http.Request, string, *contracts.OAuthSignupConsent) (*authm.User, string, error) {
+			loggedIn = true
+			return &authm.User{ID: 1}, "token", nil
+		},
+	}, testConfig())
+
+	w, req := linkCallbackRequest(t, 12, "github")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("provider", "google")
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	h.OAuthCallbackHTTPHandler(w, req)
+
+	if !loggedIn {
+		t.Error("expected the regular login callback")
+	}
+}
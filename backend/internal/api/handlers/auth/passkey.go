@@ -24,15 +24,17 @@ type PasskeyHandler struct {
 	webauthnService contracts.WebAuthnServiceInterface
 	jwtService      contracts.JWTServiceInterface
 	userService     contracts.UserServiceInterface
+	auditLogService contracts.AuditLogServiceInterface
 	config          *config.Config
 }
 
 // NewPasskeyHandler creates a new passkey handler
-func NewPasskeyHandler(webauthnService contracts.WebAuthnServiceInterface, jwtService contracts.JWTServiceInterface, userService contracts.UserServiceInterface, cfg *config.Config) *PasskeyHandler {
+func NewPasskeyHandler(webauthnService contracts.WebAuthnServiceInterface, jwtService contracts.JWTServiceInterface, userService contracts.UserServiceInterface, auditLogService contracts.AuditLogServiceInterface, cfg *config.Config) *PasskeyHandler {
 	return &PasskeyHandler{
 		webauthnService: webauthnService,
 		jwtService:      jwtService,
 		userService:     userService,
+		auditLogService: auditLogService,
 		config:          cfg,
 	}
 }
@@ -226,6 +228,9 @@ func (h *PasskeyHandler) FinishRegisterHandler(ctx context.Context, input *Finis
 		"user_id", contextUser.ID,
 		"credential_id", credential.ID,
 	)
+	h.logPasskeyAudit(contextUser.ID, "passkey_registered", credential.ID, map[string]interface{}{
+		"display_name": displayName,
+	})
 
 	resp.Body.Success = true
 	resp.Body.Message = "Passkey registered successfully"
@@ -393,6 +398,7 @@ func (h *PasskeyHandler) FinishLoginHandler(ctx context.Context, input *FinishLo
 	}
 
 	var user *authm.User
+	var credential *authm.WebAuthnCredential
 
 	if userID != 0 {
 		// User-specific login
@@ -404,16 +410,31 @@ func (h *PasskeyHandler) FinishLoginHandler(ctx context.Context, input *FinishLo
 			return resp, nil
 		}
 
-		_, err = h.webauthnService.FinishLogin(user, session, parsedResponse)
+		// Same lockout as password login: a locked account is refused before
+		// the assertion is checked.
+		if h.userService.IsAccountLocked(user) {
+			return h.lockedLoginResponse(ctx, resp, user), nil
+		}
+
+		credential, err = h.webauthnService.FinishLogin(user, session, parsedResponse)
 	} else {
-		// Discoverable login
-		user, _, err = h.webauthnService.FinishDiscoverableLogin(session, parsedResponse)
+		// Discoverable login. The owner is only known once the credential is
+		// looked up, so the lockout check runs after validation.
+		user, credential, err = h.webauthnService.FinishDiscoverableLogin(session, parsedResponse)
 	}
 
 	if err != nil {
 		logger.AuthWarn(ctx, "passkey_login_finish_failed",
 			"error", err.Error(),
 		)
+		if user != nil {
+			if authErr := h.recordFailedPasskeyLogin(ctx, user); authErr != nil {
+				resp.Body.Success = false
+				resp.Body.Message = autherrors.ToExternalMessage(autherrors.CodeServiceUnavailable)
+				resp.Body.ErrorCode = autherrors.CodeServiceUnavailable
+				return resp, authErr
+			}
+		}
 		resp.Body.Success = false
 		resp.Body.Message = "Invalid passkey"
 		resp.Body.ErrorCode = autherrors.CodeInvalidCredentials
@@ -423,6 +444,42 @@ func (h *PasskeyHandler) FinishLoginHandler(ctx context.Context, input *FinishLo
 	// Delete used challenge
 	_ = h.webauthnService.DeleteChallenge(input.Body.ChallengeID)
 
+	if h.userService.IsAccountLocked(user) {
+		return h.lockedLoginResponse(ctx, resp, user), nil
+	}
+	if !user.IsActive {
+		logger.AuthWarn(ctx, "passkey_login_account_inactive",
+			"user_id", user.ID,
+		)
+		authErr := autherrors.ErrAccountInactive()
+		resp.Body.Success = false
+		resp.Body.Message = authErr.UserMessage()
+		resp.Body.ErrorCode = autherrors.CodeAccountInactive
+		return resp, nil
+	}
+
+	// Log + continue on failure, as password login does: the user is
+	// authenticated either way.
+	if resetErr := h.userService.ResetFailedAttempts(user.ID); resetErr != nil {
+		logger.AuthError(ctx, "passkey_reset_failed_attempts_failed", resetErr,
+			"user_id", user.ID,
+		)
+	}
+
+	if credential != nil && credential.CloneWarning {
+		// The authenticator's sign counter went backwards, which can mean a
+		// cloned key. The login is still allowed (synced passkeys can trip
+		// this); the event is recorded for review.
+		logger.AuthWarn(ctx, "passkey_clone_warning",
+			"user_id", user.ID,
+			"credential_id", credential.ID,
+			"sign_count", credential.SignCount,
+		)
+		h.logPasskeyAudit(user.ID, "passkey_clone_warning", credential.ID, map[string]interface{}{
+			"sign_count": credential.SignCount,
+		})
+	}
+
 	// Generate JWT token
 	token, err := h.jwtService.CreateToken(user)
 	if err != nil {
@@ -441,6 +498,11 @@ func (h *PasskeyHandler) FinishLoginHandler(ctx context.Context, input *FinishLo
 	logger.AuthInfo(ctx, "passkey_login_success",
 		"user_id", user.ID,
 	)
+	var credentialID uint
+	if credential != nil {
+		credentialID = credential.ID
+	}
+	h.logPasskeyAudit(user.ID, "passkey_login", credentialID, nil)
 
 	resp.Body.Success = true
 	resp.Body.Message = "Login successful"
@@ -448,6 +510,47 @@ func (h *PasskeyHandler) FinishLoginHandler(ctx context.Context, input *FinishLo
 	return resp, nil
 }
 
+// lockedLoginResponse fills resp for an account locked by failed logins.
+func (h *PasskeyHandler) lockedLoginResponse(ctx context.Context, resp *FinishLoginResponse, user *authm.User) *FinishLoginResponse {
+	minutes := int(h.userService.GetLockTimeRemaining(user).Minutes()) + 1 // Round up
+	authErr := autherrors.ErrAccountLockedWithMinutes(minutes)
+	logger.AuthWarn(ctx, "passkey_login_account_locked",
+		"user_id", user.ID,
+		"minutes_remaining", minutes,
+	)
+	resp.Body.Success = false
+	resp.Body.Message = authErr.UserMessage()
+	resp.Body.ErrorCode = autherrors.CodeAccountLocked
+	return resp
+}
+
+// recordFailedPasskeyLogin counts a failed assertion toward the account
+// lockout shared with password login. Like password login it fails closed:
+// if the counter cannot be written the caller returns a 5xx instead of a
+// free retry.
+func (h *PasskeyHandler) recordFailedPasskeyLogin(ctx context.Context, user *authm.User) *autherrors.AuthError {
+	if h.userService.IsAccountLocked(user) {
+		return nil
+	}
+	if err := h.userService.IncrementFailedAttempts(user.ID); err != nil {
+		authErr := autherrors.ErrServiceUnavailable("passkey_increment_failed_attempts", err)
+		logger.AuthError(ctx, "passkey_increment_failed_attempts_failed", err,
+			"user_id", user.ID,
+		)
+		return authErr
+	}
+	h.logPasskeyAudit(user.ID, "passkey_login_failed", 0, nil)
+	return nil
+}
+
+// logPasskeyAudit records a passkey event against the account's owner.
+func (h *PasskeyHandler) logPasskeyAudit(userID uint, action string, credentialID uint, metadata map[string]interface{}) {
+	if h.auditLogService == nil {
+		return
+	}
+	h.auditLogService.LogAction(userID, action, "webauthn_credential", credentialID, metadata)
+}
+
 // --- Credential Management Endpoints ---
 
 // ListCredentialsResponse represents the response with user's passkeys
@@ -540,6 +643,7 @@ func (h *PasskeyHandler) DeleteCredentialHandler(ctx context.Context, input *Del
 		"user_id", contextUser.ID,
 		"credential_id", input.CredentialID,
 	)
+	h.logPasskeyAudit(contextUser.ID, "passkey_deleted", input.CredentialID, nil)
 
 	resp.Body.Success = true
 	resp.Body.Message = "Passkey deleted successfully"
@@ -849,6 +953,7 @@ func (h *PasskeyHandler) FinishSignupHandler(ctx context.Context, input *FinishS
 		"user_id", user.ID,
 		"email", email,
 	)
+	h.logPasskeyAudit(user.ID, "passkey_signup", 0, nil)
 
 	resp.Body.Success = true
 	resp.Body.Message = "Account created successfully"
@@ -2,8 +2,10 @@ package auth
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
@@ -18,11 +20,11 @@ import (
 // ============================================================================
 
 func testPasskeyHandler() *PasskeyHandler {
-	return NewPasskeyHandler(nil, nil, nil, testConfig())
+	return NewPasskeyHandler(nil, nil, nil, nil, testConfig())
 }
 
 func testPasskeyHandlerWithMocks(wa *testhelpers.MockWebAuthnService, jwt *testhelpers.MockJWTService, us *testhelpers.MockUserService) *PasskeyHandler {
-	return NewPasskeyHandler(wa, jwt, us, testConfig())
+	return NewPasskeyHandler(wa, jwt, us, nil, testConfig())
 }
 
 // ============================================================================
@@ -566,6 +568,206 @@ func TestFinishLoginHandler_UserSpecificLogin_UserNotFound(t *testing.T) {
 	}
 }
 
+// parseableFinishLoginRequest returns an assertion that survives protocol
+// parsing, so tests can reach the validation and lockout paths. The
+// signature is junk; the mocked service decides whether it verifies.
+func parseableFinishLoginRequest() *FinishLoginRequest {
+	authData := make([]byte, 37) // rpIdHash + flags + sign count
+	authData[32] = 0x01          // user present
+	clientData := `{"type":"webauthn.get","challenge":"Y2hhbGxlbmdl","origin":"http://localhost:3000"}`
+
+	input := &FinishLoginRequest{}
+	input.Body.ChallengeID = "valid-challenge"
+	input.Body.Response = CredentialAssertionResponse{
+		ID:    "Y3JlZA",
+		RawID: "Y3JlZA",
+		Type:  "public-key",
+		Response: CredentialAssertionAuthenticatorResponse{
+			AuthenticatorData: base64.RawURLEncoding.EncodeToString(authData),
+			ClientDataJSON:    base64.RawURLEncoding.EncodeToString([]byte(clientData)),
+			Signature:         base64.RawURLEncoding.EncodeToString([]byte("sig")),
+		},
+	}
+	return input
+}
+
+func passkeyChallenge(userID uint) func(string, string) (*webauthn.SessionData, uint, error) {
+	return func(string, string) (*webauthn.SessionData, uint, error) {
+		return &webauthn.SessionData{}, userID, nil
+	}
+}
+
+func TestFinishLoginHandler_AccountLocked(t *testing.T) {
+	finishCalled := false
+	mockWA := &testhelpers.MockWebAuthnService{
+		GetChallengeFn: passkeyChallenge(42),
+		FinishLoginFn: func(*authm.User, *webauthn.SessionData, *protocol.ParsedCredentialAssertionData) (*authm.WebAuthnCredential, error) {
+			finishCalled = true
+			return &authm.WebAuthnCredential{}, nil
+		},
+	}
+	mockUS := &testhelpers.MockUserService{
+		GetUserByIDFn:          func(uint) (*authm.User, error) { return &authm.User{ID: 42, IsActive: true}, nil },
+		IsAccountLockedFn:      func(*authm.User) bool { return true },
+		GetLockTimeRemainingFn: func(*authm.User) time.Duration { return 10 * time.Minute },
+	}
+	h := testPasskeyHandlerWithMocks(mockWA, &testhelpers.MockJWTService{}, mockUS)
+
+	resp, err := h.FinishLoginHandler(context.Background(), parseableFinishLoginRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Success || resp.Body.ErrorCode != autherrors.CodeAccountLocked {
+		t.Errorf("expected ACCOUNT_LOCKED, got success=%v code=%s", resp.Body.Success, resp.Body.ErrorCode)
+	}
+	if finishCalled {
+		t.Error("a locked account must be refused before the assertion is checked")
+	}
+}
+
+func TestFinishLoginHandler_FailedAssertionCountsTowardLockout(t *testing.T) {
+	var incremented uint
+	var audited []string
+	mockWA := &testhelpers.MockWebAuthnService{
+		GetChallengeFn: passkeyChallenge(42),
+		FinishLoginFn: func(*authm.User, *webauthn.SessionData, *protocol.ParsedCredentialAssertionData) (*authm.WebAuthnCredential, error) {
+			return nil, fmt.Errorf("signature mismatch")
+		},
+	}
+	mockUS := &testhelpers.MockUserService{
+		GetUserByIDFn: func(uint) (*authm.User, error) { return &authm.User{ID: 42, IsActive: true}, nil },
+		IncrementFailedAttemptsFn: func(userID uint) error {
+			incremented = userID
+			return nil
+		},
+	}
+	h := NewPasskeyHandler(mockWA, &testhelpers.MockJWTService{}, mockUS, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) {
+			audited = append(audited, action)
+		},
+	}, testConfig())
+
+	resp, err := h.FinishLoginHandler(context.Background(), parseableFinishLoginRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ErrorCode != autherrors.CodeInvalidCredentials {
+		t.Errorf("expected INVALID_CREDENTIALS, got %s", resp.Body.ErrorCode)
+	}
+	if incremented != 42 {
+		t.Errorf("expected failed attempt recorded for user 42, got %d", incremented)
+	}
+	if len(audited) != 1 || audited[0] != "passkey_login_failed" {
+		t.Errorf("expected passkey_login_failed audit entry, got %v", audited)
+	}
+}
+
+func TestFinishLoginHandler_IncrementFailureFailsClosed(t *testing.T) {
+	mockWA := &testhelpers.MockWebAuthnService{
+		GetChallengeFn: passkeyChallenge(0),
+		FinishDiscoverableLoginFn: func(*webauthn.SessionData, *protocol.ParsedCredentialAssertionData) (*authm.User, *authm.WebAuthnCredential, error) {
+			return &authm.User{ID: 42}, nil, fmt.Errorf("signature mismatch")
+		},
+	}
+	mockUS := &testhelpers.MockUserService{
+		IncrementFailedAttemptsFn: func(uint) error { return fmt.Errorf("db down") },
+	}
+	h := testPasskeyHandlerWithMocks(mockWA, &testhelpers.MockJWTService{}, mockUS)
+
+	resp, err := h.FinishLoginHandler(context.Background(), parseableFinishLoginRequest())
+	if err == nil {
+		t.Fatal("expected an error so the request fails with a 5xx")
+	}
+	if resp.Body.ErrorCode != autherrors.CodeServiceUnavailable {
+		t.Errorf("expected SERVICE_UNAVAILABLE, got %s", resp.Body.ErrorCode)
+	}
+}
+
+func TestFinishLoginHandler_DiscoverableLockedAfterValidation(t *testing.T) {
+	mockWA := &testhelpers.MockWebAuthnService{
+		GetChallengeFn: passkeyChallenge(0),
+		FinishDiscoverableLoginFn: func(*webauthn.SessionData, *protocol.ParsedCredentialAssertionData) (*authm.User, *authm.WebAuthnCredential, error) {
+			return &authm.User{ID: 42, IsActive: true}, &authm.WebAuthnCredential{ID: 7}, nil
+		},
+	}
+	mockUS := &testhelpers.MockUserService{
+		IsAccountLockedFn: func(*authm.User) bool { return true },
+	}
+	mockJWT := &testhelpers.MockJWTService{
+		CreateTokenFn: func(*authm.User) (string, error) {
+			t.Error("no token may be minted for a locked account")
+			return "", nil
+		},
+	}
+	h := testPasskeyHandlerWithMocks(mockWA, mockJWT, mockUS)
+
+	resp, err := h.FinishLoginHandler(context.Background(), parseableFinishLoginRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ErrorCode != autherrors.CodeAccountLocked {
+		t.Errorf("expected ACCOUNT_LOCKED, got %s", resp.Body.ErrorCode)
+	}
+}
+
+func TestFinishLoginHandler_InactiveAccount(t *testing.T) {
+	mockWA := &testhelpers.MockWebAuthnService{
+		GetChallengeFn: passkeyChallenge(0),
+		FinishDiscoverableLoginFn: func(*webauthn.SessionData, *protocol.ParsedCredentialAssertionData) (*authm.User, *authm.WebAuthnCredential, error) {
+			return &authm.User{ID: 42, IsActive: false}, &authm.WebAuthnCredential{ID: 7}, nil
+		},
+	}
+	h := testPasskeyHandlerWithMocks(mockWA, &testhelpers.MockJWTService{}, &testhelpers.MockUserService{})
+
+	resp, err := h.FinishLoginHandler(context.Background(), parseableFinishLoginRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ErrorCode != autherrors.CodeAccountInactive {
+		t.Errorf("expected ACCOUNT_INACTIVE, got %s", resp.Body.ErrorCode)
+	}
+}
+
+func TestFinishLoginHandler_SuccessResetsLockoutAndAudits(t *testing.T) {
+	var reset uint
+	var audited []string
+	mockWA := &testhelpers.MockWebAuthnService{
+		GetChallengeFn: passkeyChallenge(42),
+		FinishLoginFn: func(*authm.User, *webauthn.SessionData, *protocol.ParsedCredentialAssertionData) (*authm.WebAuthnCredential, error) {
+			return &authm.WebAuthnCredential{ID: 7, SignCount: 3, CloneWarning: true}, nil
+		},
+	}
+	mockUS := &testhelpers.MockUserService{
+		GetUserByIDFn: func(uint) (*authm.User, error) { return &authm.User{ID: 42, IsActive: true}, nil },
+		ResetFailedAttemptsFn: func(userID uint) error {
+			reset = userID
+			return nil
+		},
+	}
+	mockJWT := &testhelpers.MockJWTService{
+		CreateTokenFn: func(*authm.User) (string, error) { return "token", nil },
+	}
+	h := NewPasskeyHandler(mockWA, mockJWT, mockUS, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) {
+			audited = append(audited, action)
+		},
+	}, testConfig())
+
+	resp, err := h.FinishLoginHandler(context.Background(), parseableFinishLoginRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success {
+		t.Fatalf("expected success, got %s: %s", resp.Body.ErrorCode, resp.Body.Message)
+	}
+	if reset != 42 {
+		t.Errorf("expected failed attempts reset for user 42, got %d", reset)
+	}
+	if len(audited) != 2 || audited[0] != "passkey_clone_warning" || audited[1] != "passkey_login" {
+		t.Errorf("expected clone warning then login audit entries, got %v", audited)
+	}
+}
+
 // ============================================================================
 // ListCredentialsHandler
 // ============================================================================
@@ -45,7 +45,7 @@ func (h *UserPreferencesHandler) UnsubscribeCollectionDigestPageHandler(w http.R
 	}
 	uid := uint(uid64)
 
-	if !engagement.VerifyScopedUnsubscribeSignature(uid, engagement.UnsubscribeScopeCollectionDigest, sig, h.jwtSecret) {
+	if !h.verifyScopedSignature(uid, engagement.UnsubscribeScopeCollectionDigest, sig) {
 		writeUnsubscribeError(w, r, http.StatusForbidden, "This unsubscribe link is invalid or has been tampered with.")
 		return
 	}
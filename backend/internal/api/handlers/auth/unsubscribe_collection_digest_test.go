@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/config"
 	"psychic-homily-backend/internal/services/engagement"
 )
 
@@ -17,7 +18,7 @@ import (
 // the right Content-Type, status, and side effect.
 
 func TestUnsubscribeCollectionDigestPage_MissingParams(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 
 	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/collection-digest", nil)
 	w := httptest.NewRecorder()
@@ -32,7 +33,7 @@ func TestUnsubscribeCollectionDigestPage_MissingParams(t *testing.T) {
 }
 
 func TestUnsubscribeCollectionDigestPage_InvalidSignature(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 
 	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/collection-digest?uid=42&sig=bogus", nil)
 	w := httptest.NewRecorder()
@@ -57,7 +58,7 @@ func TestUnsubscribeCollectionDigestPage_GET_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	req := httptest.NewRequest(http.MethodGet,
 		"/unsubscribe/collection-digest?uid=99&sig="+sig, nil)
@@ -100,7 +101,7 @@ func TestUnsubscribeCollectionDigestPage_POST_OneClick_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	body := strings.NewReader("List-Unsubscribe=One-Click")
 	req := httptest.NewRequest(http.MethodPost,
@@ -124,7 +125,7 @@ func TestUnsubscribeCollectionDigestPage_POST_OneClick_Success(t *testing.T) {
 }
 
 func TestUnsubscribeCollectionDigestPage_POST_InvalidSig_JSON(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 
 	req := httptest.NewRequest(http.MethodPost,
 		"/unsubscribe/collection-digest?uid=42&sig=bogus", strings.NewReader("List-Unsubscribe=One-Click"))
@@ -151,7 +152,7 @@ func TestUnsubscribeCollectionDigestPage_ServiceError_GET(t *testing.T) {
 			return errors.New("db unavailable")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	req := httptest.NewRequest(http.MethodGet,
 		"/unsubscribe/collection-digest?uid=50&sig="+sig, nil)
@@ -176,7 +177,7 @@ func TestUnsubscribeCollectionDigestPage_ServiceError_POST(t *testing.T) {
 			return errors.New("db unavailable")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	req := httptest.NewRequest(http.MethodPost,
 		"/unsubscribe/collection-digest?uid=50&sig="+sig, strings.NewReader("List-Unsubscribe=One-Click"))
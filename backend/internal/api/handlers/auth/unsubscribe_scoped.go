@@ -1,10 +1,12 @@
 package auth
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/services/engagement"
 )
 
@@ -60,6 +62,25 @@ func (h *UserPreferencesHandler) UnsubscribeSceneDigestPageHandler(w http.Respon
 	})
 }
 
+// UnsubscribeSavedShowChangesPageHandler serves /unsubscribe/saved-show-changes,
+// turning off the saved_show_change email cell.
+func (h *UserPreferencesHandler) UnsubscribeSavedShowChangesPageHandler(w http.ResponseWriter, r *http.Request) {
+	h.handleScopedUnsubscribe(w, r, scopedUnsubscribeConfig{
+		scope: engagement.UnsubscribeScopeSavedShowChanges,
+		setPref: func(uid uint) error {
+			if h.notificationPreferences == nil {
+				return fmt.Errorf("notification preferences not configured")
+			}
+			_, err := h.notificationPreferences.UpdatePreferences(uid, contracts.NotificationPreferenceMatrix{
+				contracts.NotificationEventSavedShowChange: {contracts.NotificationChannelEmail: false},
+			})
+			return err
+		},
+		noun:      "emails about changes to shows you saved",
+		logSuffix: "saved_show_changes",
+	})
+}
+
 // handleScopedUnsubscribe is the shared GET/POST body for the scoped
 // unsubscribe handlers. Mirrors UnsubscribeCollectionDigestPageHandler but
 // parameterized by scope + preference setter.
@@ -74,7 +95,7 @@ func (h *UserPreferencesHandler) handleScopedUnsubscribe(w http.ResponseWriter,
 	}
 	uid := uint(uid64)
 
-	if !engagement.VerifyScopedUnsubscribeSignature(uid, cfg.scope, sig, h.jwtSecret) {
+	if !h.verifyScopedSignature(uid, cfg.scope, sig) {
 		writeUnsubscribeError(w, r, http.StatusForbidden, "This unsubscribe link is invalid or has been tampered with.")
 		return
 	}
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/services/engagement"
 )
 
@@ -29,7 +31,7 @@ func TestUnsubscribeTierNotifications_GET_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/tier-notifications?uid=99&sig="+sig, nil)
 	w := httptest.NewRecorder()
@@ -68,7 +70,7 @@ func TestUnsubscribeEditNotifications_POST_OneClick_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	req := httptest.NewRequest(http.MethodPost,
 		"/unsubscribe/edit-notifications?uid=7&sig="+sig, strings.NewReader("List-Unsubscribe=One-Click"))
@@ -91,7 +93,7 @@ func TestUnsubscribeEditNotifications_POST_OneClick_Success(t *testing.T) {
 }
 
 func TestUnsubscribeScoped_InvalidSignature(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 
 	// Tier handler, GET, bad sig -> 403 HTML.
 	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/tier-notifications?uid=42&sig=bogus", nil)
@@ -127,7 +129,7 @@ func TestUnsubscribeScoped_CrossScopeSignatureRejected(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/edit-notifications?uid=11&sig="+tierSig, nil)
 	w := httptest.NewRecorder()
@@ -138,7 +140,7 @@ func TestUnsubscribeScoped_CrossScopeSignatureRejected(t *testing.T) {
 }
 
 func TestUnsubscribeScoped_MissingParams(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 
 	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/tier-notifications", nil)
 	w := httptest.NewRecorder()
@@ -161,7 +163,7 @@ func TestUnsubscribeScoped_ServiceError(t *testing.T) {
 			return errors.New("db unavailable")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 
 	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/tier-notifications?uid=50&sig="+sig, nil)
 	w := httptest.NewRecorder()
@@ -170,3 +172,78 @@ func TestUnsubscribeScoped_ServiceError(t *testing.T) {
 		t.Fatalf("expected 500, got %d", w.Code)
 	}
 }
+
+func TestUnsubscribeSavedShowChanges_GET_Success(t *testing.T) {
+	secret := "test-secret"
+	sig := engagement.ComputeScopedUnsubscribeSignature(12, engagement.UnsubscribeScopeSavedShowChanges, secret)
+
+	var gotUID uint
+	var gotUpdates contracts.NotificationPreferenceMatrix
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing(secret, nil))
+	h.SetNotificationPreferenceService(&testhelpers.MockNotificationPreferenceService{
+		UpdatePreferencesFn: func(userID uint, updates contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error) {
+			gotUID, gotUpdates = userID, updates
+			return updates, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/saved-show-changes?uid=12&sig="+sig, nil)
+	w := httptest.NewRecorder()
+	h.UnsubscribeSavedShowChangesPageHandler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "changes to shows you saved") {
+		t.Errorf("confirmation page must name the category, body was: %s", w.Body.String())
+	}
+	enabled, ok := gotUpdates[contracts.NotificationEventSavedShowChange][contracts.NotificationChannelEmail]
+	if gotUID != 12 || !ok || enabled || len(gotUpdates) != 1 {
+		t.Errorf("expected saved_show_change/email off for uid 12, got uid=%d updates=%v", gotUID, gotUpdates)
+	}
+}
+
+func TestUnsubscribeSavedShowChanges_NotConfigured(t *testing.T) {
+	secret := "test-secret"
+	sig := engagement.ComputeScopedUnsubscribeSignature(12, engagement.UnsubscribeScopeSavedShowChanges, secret)
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing(secret, nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/saved-show-changes?uid=12&sig="+sig, nil)
+	w := httptest.NewRecorder()
+	h.UnsubscribeSavedShowChangesPageHandler(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", w.Code)
+	}
+}
+
+func TestUnsubscribeTierNotifications_AcceptsLinkSignedBeforeRotation(t *testing.T) {
+	// A link minted under the old key keeps working while that key is still
+	// listed as a previous key.
+	sig := engagement.ComputeScopedUnsubscribeSignature(5, engagement.UnsubscribeScopeTierNotifications, "old-secret")
+
+	var called bool
+	mock := &testhelpers.MockUserService{
+		SetNotifyOnTierNotificationsFn: func(uint, bool) error {
+			called = true
+			return nil
+		},
+	}
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("new-secret", []string{"old-secret"}))
+
+	req := httptest.NewRequest(http.MethodGet, "/unsubscribe/tier-notifications?uid=5&sig="+sig, nil)
+	w := httptest.NewRecorder()
+	h.UnsubscribeTierNotificationsPageHandler(w, req)
+
+	if w.Code != http.StatusOK || !called {
+		t.Fatalf("expected 200 and the preference flipped, got %d (called=%v)", w.Code, called)
+	}
+
+	// Once the old key is dropped from the ring, its links stop working.
+	h = NewUserPreferencesHandler(mock, config.NewJWTKeyRing("new-secret", nil))
+	w = httptest.NewRecorder()
+	h.UnsubscribeTierNotificationsPageHandler(w, httptest.NewRequest(http.MethodGet, "/unsubscribe/tier-notifications?uid=5&sig="+sig, nil))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for a retired key, got %d", w.Code)
+	}
+}
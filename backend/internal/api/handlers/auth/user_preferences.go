@@ -10,6 +10,7 @@ import (
 
 	"psychic-homily-backend/internal/api/handlers/shared"
 	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/config"
 	autherrors "psychic-homily-backend/internal/errors"
 	"psychic-homily-backend/internal/logger"
 	authm "psychic-homily-backend/internal/models/auth"
@@ -21,17 +22,36 @@ import (
 // UserPreferencesHandler handles user preferences endpoints
 type UserPreferencesHandler struct {
 	userService contracts.UserServiceInterface
-	jwtSecret   string
+	// keys verify the HMAC unsubscribe links, which may predate a rotation.
+	keys *config.JWTKeyRing
+	// notificationPreferences backs unsubscribe links for events that live
+	// only in the preference matrix. Optional; see
+	// SetNotificationPreferenceService.
+	notificationPreferences contracts.NotificationPreferenceServiceInterface
 }
 
 // NewUserPreferencesHandler creates a new user preferences handler
-func NewUserPreferencesHandler(userService contracts.UserServiceInterface, jwtSecret string) *UserPreferencesHandler {
+func NewUserPreferencesHandler(userService contracts.UserServiceInterface, keys *config.JWTKeyRing) *UserPreferencesHandler {
 	return &UserPreferencesHandler{
 		userService: userService,
-		jwtSecret:   jwtSecret,
+		keys:        keys,
 	}
 }
 
+// verifyScopedSignature reports whether sig is the (uid, scope) unsubscribe
+// signature under any key in the ring.
+func (h *UserPreferencesHandler) verifyScopedSignature(uid uint, scope, sig string) bool {
+	return h.keys.Verify(func(secret string) bool {
+		return engagement.VerifyScopedUnsubscribeSignature(uid, scope, sig, secret)
+	})
+}
+
+// SetNotificationPreferenceService wires the preference matrix for
+// matrix-only unsubscribe links (saved-show changes).
+func (h *UserPreferencesHandler) SetNotificationPreferenceService(notificationPreferences contracts.NotificationPreferenceServiceInterface) {
+	h.notificationPreferences = notificationPreferences
+}
+
 // SetFavoriteCitiesRequest represents the request to update favorite cities
 type SetFavoriteCitiesRequest struct {
 	Body struct {
@@ -217,7 +237,7 @@ type UnsubscribeShowRemindersResponse struct {
 
 // UnsubscribeShowRemindersHandler handles POST /auth/unsubscribe/show-reminders (public, no auth)
 func (h *UserPreferencesHandler) UnsubscribeShowRemindersHandler(ctx context.Context, req *UnsubscribeShowRemindersRequest) (*UnsubscribeShowRemindersResponse, error) {
-	if !engagement.VerifyScopedUnsubscribeSignature(req.Body.UID, engagement.UnsubscribeScopeShowReminders, req.Body.Sig, h.jwtSecret) {
+	if !h.verifyScopedSignature(req.Body.UID, engagement.UnsubscribeScopeShowReminders, req.Body.Sig) {
 		return nil, huma.Error403Forbidden("Invalid unsubscribe link")
 	}
 
@@ -425,9 +445,11 @@ type UnsubscribeCommentSubscriptionResponse struct {
 // entity_id) so a link leaked from one email can't be mutated to target
 // other users or resources.
 func (h *UserPreferencesHandler) UnsubscribeCommentSubscriptionHandler(ctx context.Context, req *UnsubscribeCommentSubscriptionRequest) (*UnsubscribeCommentSubscriptionResponse, error) {
-	if !engagement.VerifyCommentSubscriptionUnsubscribeSignature(
-		req.Body.UID, req.Body.EntityType, req.Body.EntityID, req.Body.Sig, h.jwtSecret,
-	) {
+	if !h.keys.Verify(func(secret string) bool {
+		return engagement.VerifyCommentSubscriptionUnsubscribeSignature(
+			req.Body.UID, req.Body.EntityType, req.Body.EntityID, req.Body.Sig, secret,
+		)
+	}) {
 		return nil, huma.Error403Forbidden("Invalid unsubscribe link")
 	}
 
@@ -474,7 +496,7 @@ type UnsubscribeMentionResponse struct {
 // UnsubscribeMentionHandler handles POST /unsubscribe/mention. Flips the
 // user's notify_on_mention preference to false. Public — HMAC-signed.
 func (h *UserPreferencesHandler) UnsubscribeMentionHandler(ctx context.Context, req *UnsubscribeMentionRequest) (*UnsubscribeMentionResponse, error) {
-	if !engagement.VerifyScopedUnsubscribeSignature(req.Body.UID, engagement.UnsubscribeScopeMention, req.Body.Sig, h.jwtSecret) {
+	if !h.verifyScopedSignature(req.Body.UID, engagement.UnsubscribeScopeMention, req.Body.Sig) {
 		return nil, huma.Error403Forbidden("Invalid unsubscribe link")
 	}
 
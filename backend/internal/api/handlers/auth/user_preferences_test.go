@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/config"
 	autherrors "psychic-homily-backend/internal/errors"
 	authm "psychic-homily-backend/internal/models/auth"
 	"psychic-homily-backend/internal/services/engagement"
@@ -14,7 +15,7 @@ import (
 // --- SetFavoriteCitiesHandler ---
 
 func TestSetFavoriteCitiesHandler_NoAuth(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &SetFavoriteCitiesRequest{}
 
 	_, err := h.SetFavoriteCitiesHandler(context.Background(), req)
@@ -29,7 +30,7 @@ func TestSetFavoriteCitiesHandler_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	user := &authm.User{ID: 1, IsActive: true}
 	ctx := testhelpers.CtxWithUser(user)
 
@@ -57,7 +58,7 @@ func TestSetFavoriteCitiesHandler_NilCities(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &SetFavoriteCitiesRequest{}
@@ -78,7 +79,7 @@ func TestSetFavoriteCitiesHandler_ServiceError(t *testing.T) {
 			return errors.New("db error")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetFavoriteCitiesRequest{}
 
@@ -89,7 +90,7 @@ func TestSetFavoriteCitiesHandler_ServiceError(t *testing.T) {
 // --- SetChartDefaultsHandler ---
 
 func TestSetChartDefaultsHandler_NoAuth(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &SetChartDefaultsRequest{}
 
 	_, err := h.SetChartDefaultsHandler(context.Background(), req)
@@ -105,7 +106,7 @@ func TestSetChartDefaultsHandler_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &SetChartDefaultsRequest{}
@@ -133,7 +134,7 @@ func TestSetChartDefaultsHandler_Clear(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &SetChartDefaultsRequest{}
@@ -154,7 +155,7 @@ func TestSetChartDefaultsHandler_ServiceError(t *testing.T) {
 			return errors.New("db error")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetChartDefaultsRequest{}
 	req.Body.Defaults = &authm.ChartDefaults{Window: "quarter"}
@@ -166,7 +167,7 @@ func TestSetChartDefaultsHandler_ServiceError(t *testing.T) {
 // --- SetShowRemindersHandler ---
 
 func TestSetShowRemindersHandler_NoAuth(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &SetShowRemindersRequest{}
 
 	_, err := h.SetShowRemindersHandler(context.Background(), req)
@@ -181,7 +182,7 @@ func TestSetShowRemindersHandler_Success_Enable(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetShowRemindersRequest{}
 	req.Body.Enabled = true
@@ -204,7 +205,7 @@ func TestSetShowRemindersHandler_Success_Disable(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetShowRemindersRequest{}
 	req.Body.Enabled = false
@@ -224,7 +225,7 @@ func TestSetShowRemindersHandler_ServiceError(t *testing.T) {
 			return errors.New("db error")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetShowRemindersRequest{}
 	req.Body.Enabled = true
@@ -236,7 +237,7 @@ func TestSetShowRemindersHandler_ServiceError(t *testing.T) {
 // --- UnsubscribeShowRemindersHandler ---
 
 func TestUnsubscribeShowRemindersHandler_InvalidSignature(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &UnsubscribeShowRemindersRequest{}
 	req.Body.UID = 1
 	req.Body.Sig = "invalid-sig"
@@ -256,7 +257,7 @@ func TestUnsubscribeShowRemindersHandler_ServiceError(t *testing.T) {
 			return errors.New("db error")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 	req := &UnsubscribeShowRemindersRequest{}
 	req.Body.UID = uid
 	req.Body.Sig = sig
@@ -275,7 +276,7 @@ func computeTestUnsubscribeSig(uid uint, secret string) string {
 // ──────────────────────────────────────────────
 
 func TestSetCommentNotificationsHandler_NoAuth(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &SetCommentNotificationsRequest{}
 	enabled := false
 	req.Body.NotifyOnMention = &enabled
@@ -284,7 +285,7 @@ func TestSetCommentNotificationsHandler_NoAuth(t *testing.T) {
 }
 
 func TestSetCommentNotificationsHandler_NoFieldsRejected(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetCommentNotificationsRequest{}
 	_, err := h.SetCommentNotificationsHandler(ctx, req)
@@ -315,7 +316,7 @@ func TestSetCommentNotificationsHandler_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	no := false
@@ -347,7 +348,7 @@ func TestSetCommentNotificationsHandler_Success(t *testing.T) {
 }
 
 func TestUnsubscribeCommentSubscriptionHandler_InvalidSignature(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &UnsubscribeCommentSubscriptionRequest{}
 	req.Body.UID = 1
 	req.Body.EntityType = "artist"
@@ -374,7 +375,7 @@ func TestUnsubscribeCommentSubscriptionHandler_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 	req := &UnsubscribeCommentSubscriptionRequest{}
 	req.Body.UID = uid
 	req.Body.EntityType = entityType
@@ -394,7 +395,7 @@ func TestUnsubscribeCommentSubscriptionHandler_Success(t *testing.T) {
 }
 
 func TestUnsubscribeMentionHandler_InvalidSignature(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &UnsubscribeMentionRequest{}
 	req.Body.UID = 1
 	req.Body.Sig = "bad"
@@ -417,7 +418,7 @@ func TestUnsubscribeMentionHandler_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, secret)
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing(secret, nil))
 	req := &UnsubscribeMentionRequest{}
 	req.Body.UID = uid
 	req.Body.Sig = sig
@@ -452,7 +453,7 @@ func TestSetDefaultReplyPermission_EmptyPermission(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetDefaultReplyPermissionRequest{}
 	req.Body.Permission = "   "
@@ -472,7 +473,7 @@ func TestSetDefaultReplyPermission_InvalidEnum(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetDefaultReplyPermissionRequest{}
 	req.Body.Permission = "garbage"
@@ -497,7 +498,7 @@ func TestSetDefaultReplyPermission_AcceptsAllValidEnumValues(t *testing.T) {
 					return nil
 				},
 			}
-			h := NewUserPreferencesHandler(mock, "secret")
+			h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 			ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 			req := &SetDefaultReplyPermissionRequest{}
 			req.Body.Permission = perm
@@ -529,7 +530,7 @@ func TestSetDefaultReplyPermission_ServiceTypedInvalid(t *testing.T) {
 			return autherrors.ErrInvalidReplyPermission(permission)
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetDefaultReplyPermissionRequest{}
 	req.Body.Permission = "anyone"
@@ -546,7 +547,7 @@ func TestSetDefaultReplyPermission_ServiceError(t *testing.T) {
 			return errors.New("db down")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetDefaultReplyPermissionRequest{}
 	req.Body.Permission = "anyone"
@@ -557,7 +558,7 @@ func TestSetDefaultReplyPermission_ServiceError(t *testing.T) {
 // --- SetCollectionDigestHandler ---
 
 func TestSetCollectionDigestHandler_NoAuth(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	_, err := h.SetCollectionDigestHandler(context.Background(), &SetCollectionDigestRequest{})
 	testhelpers.AssertHumaError(t, err, 401)
 }
@@ -573,7 +574,7 @@ func TestSetCollectionDigestHandler_Success_Enable(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetCollectionDigestRequest{}
 	req.Body.Enabled = true
@@ -594,7 +595,7 @@ func TestSetCollectionDigestHandler_Success_Disable(t *testing.T) {
 	mock := &testhelpers.MockUserService{
 		SetNotifyOnCollectionDigestFn: func(_ uint, _ bool) error { return nil },
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetCollectionDigestRequest{}
 	req.Body.Enabled = false
@@ -614,7 +615,7 @@ func TestSetCollectionDigestHandler_ServiceError(t *testing.T) {
 			return errors.New("db error")
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetCollectionDigestRequest{}
 	req.Body.Enabled = true
@@ -628,7 +629,7 @@ func TestSetCollectionDigestHandler_ServiceError(t *testing.T) {
 // ──────────────────────────────────────────────
 
 func TestSetTierEditNotificationsHandler_NoAuth(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	req := &SetTierEditNotificationsRequest{}
 	enabled := false
 	req.Body.NotifyOnTierNotifications = &enabled
@@ -637,7 +638,7 @@ func TestSetTierEditNotificationsHandler_NoAuth(t *testing.T) {
 }
 
 func TestSetTierEditNotificationsHandler_NoFieldsRejected(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetTierEditNotificationsRequest{}
 	_, err := h.SetTierEditNotificationsHandler(ctx, req)
@@ -668,7 +669,7 @@ func TestSetTierEditNotificationsHandler_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	no := false
@@ -702,7 +703,7 @@ func TestSetTierEditNotificationsHandler_Success(t *testing.T) {
 // --- SetSceneDigestHandler (PSY-1342) ---
 
 func TestSetSceneDigestHandler_NoAuth(t *testing.T) {
-	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, "secret")
+	h := NewUserPreferencesHandler(&testhelpers.MockUserService{}, config.NewJWTKeyRing("secret", nil))
 	_, err := h.SetSceneDigestHandler(context.Background(), &SetSceneDigestRequest{})
 	testhelpers.AssertHumaError(t, err, 401)
 }
@@ -718,7 +719,7 @@ func TestSetSceneDigestHandler_Success_Enable(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetSceneDigestRequest{}
 	req.Body.Enabled = true
@@ -739,7 +740,7 @@ func TestSetSceneDigestHandler_ServiceError(t *testing.T) {
 	mock := &testhelpers.MockUserService{
 		SetNotifyOnSceneDigestFn: func(_ uint, _ bool) error { return errors.New("db error") },
 	}
-	h := NewUserPreferencesHandler(mock, "secret")
+	h := NewUserPreferencesHandler(mock, config.NewJWTKeyRing("secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 	req := &SetSceneDigestRequest{}
 	req.Body.Enabled = true
@@ -31,6 +31,9 @@ type ArtistHandler struct {
 	// startup. The artist Bandcamp/Spotify mutation endpoints accept it as an
 	// admin bypass for the discovery backfill bot.
 	internalSecret string
+	// artistClaimService lets artist managers edit their artist's profile.
+	// Optional; see SetArtistClaimService.
+	artistClaimService contracts.ArtistClaimServiceInterface
 }
 
 func NewArtistHandler(artistService contracts.ArtistServiceInterface, auditLogService contracts.AuditLogServiceInterface, revisionService contracts.RevisionServiceInterface, cfg *config.Config) *ArtistHandler {
@@ -46,6 +49,12 @@ func NewArtistHandler(artistService contracts.ArtistServiceInterface, auditLogSe
 	}
 }
 
+// SetArtistClaimService wires artist-manager permissions on profile updates.
+// Nil-safe: when unset, only admins may update an artist's profile.
+func (h *ArtistHandler) SetArtistClaimService(artistClaimService contracts.ArtistClaimServiceInterface) {
+	h.artistClaimService = artistClaimService
+}
+
 // matchesInternalSecret reports whether the request-supplied secret matches the
 // configured internal API secret. The comparison is constant-time to avoid a
 // timing oracle: these endpoints are mounted on rc.Protected (not rc.Admin)
@@ -92,6 +101,7 @@ type ListArtistsRequest struct {
 	Cities   string `query:"cities" doc:"Pipe-delimited multi-city filter (max 10): Phoenix,AZ|Mesa,AZ" example:"Phoenix,AZ|Mesa,AZ"`
 	Tags     string `query:"tags" doc:"Comma-separated tag slugs. Multi-tag filter (PSY-309): AND by default (entity must have every tag); set tag_match=any for OR." example:"post-punk,phoenix"`
 	TagMatch string `query:"tag_match" doc:"Tag matching mode: 'all' (default, AND) or 'any' (OR)" example:"all" enum:"all,any"`
+	Genre    string `query:"genre" doc:"Comma-separated genre slugs. Matches artists with any of them, including subgenres." example:"post-punk,shoegaze"`
 }
 
 // ListArtistsResponse represents the response for listing artists
@@ -143,6 +153,11 @@ func (h *ArtistHandler) ListArtistsHandler(ctx context.Context, req *ListArtists
 		// count matches the list result count.
 		filters["skip_active_filter"] = true
 	}
+	if genres := parseGenreFilter(req.Genre); len(genres) > 0 {
+		// Genre pages are evergreen too, like tag pages (PSY-495).
+		filters["genre_slugs"] = genres
+		filters["skip_active_filter"] = true
+	}
 
 	artists, err := h.artistService.GetArtistsWithShowCounts(filters)
 	if err != nil {
@@ -218,7 +233,9 @@ type GetArtistShowsRequest struct {
 	ArtistID   string `path:"artist_id" doc:"Artist ID or slug" example:"the-national"`
 	Timezone   string `query:"timezone" doc:"Timezone for date filtering" example:"America/Phoenix"`
 	Limit      int    `query:"limit" default:"20" minimum:"1" maximum:"200" doc:"Maximum number of shows to return (max 200)"`
+	Offset     int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
 	TimeFilter string `query:"time_filter" doc:"Filter shows by time: upcoming, past, or all" example:"upcoming" enum:"upcoming,past,all"`
+	Year       int    `query:"year" minimum:"0" doc:"Only shows in this calendar year (in the request timezone). 0 or omitted: every year." example:"2024"`
 }
 
 // GetArtistShowsResponse represents the response for the artist shows endpoint
@@ -227,6 +244,9 @@ type GetArtistShowsResponse struct {
 		Shows    []*contracts.ArtistShowResponse `json:"shows" doc:"List of shows"`
 		ArtistID uint                            `json:"artist_id" doc:"Artist ID (resolved from slug if provided)"`
 		Total    int64                           `json:"total" doc:"Total number of shows matching filter"`
+		Limit    int                             `json:"limit"`
+		Offset   int                             `json:"offset"`
+		Years    []*contracts.ShowYearCount      `json:"years,omitempty" doc:"Past shows per year, most recent first (time_filter=past only)"`
 	}
 }
 
@@ -264,7 +284,12 @@ func (h *ArtistHandler) GetArtistShowsHandler(ctx context.Context, req *GetArtis
 		artistID = artist.ID
 	}
 
-	shows, total, err := h.artistService.GetShowsForArtist(artistID, timezone, limit, timeFilter)
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	shows, total, err := h.artistService.GetShowsForArtistPage(artistID, timezone, limit, offset, timeFilter, req.Year)
 	if err != nil {
 		var artistErr *apperrors.ArtistError
 		if errors.As(err, &artistErr) && artistErr.Code == apperrors.CodeArtistNotFound {
@@ -277,6 +302,18 @@ func (h *ArtistHandler) GetArtistShowsHandler(ctx context.Context, req *GetArtis
 	resp.Body.Shows = shows
 	resp.Body.ArtistID = artistID
 	resp.Body.Total = total
+	resp.Body.Limit = limit
+	resp.Body.Offset = offset
+
+	// The past-shows archive groups by year; the per-year counts always cover
+	// the whole archive so the year picker survives a year filter.
+	if timeFilter == "past" {
+		years, err := h.artistService.GetShowYearsForArtist(artistID, timezone, timeFilter)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to fetch show years", err)
+		}
+		resp.Body.Years = years
+	}
 
 	return resp, nil
 }
@@ -351,8 +388,13 @@ type AdminCreateArtistRequest struct {
 		Spotify     *string `json:"spotify" required:"false" doc:"Spotify URL" maxLength:"500"`
 		SoundCloud  *string `json:"soundcloud" required:"false" doc:"SoundCloud URL" maxLength:"500"`
 		Bandcamp    *string `json:"bandcamp" required:"false" doc:"Bandcamp URL" maxLength:"500"`
+		TikTok      *string `json:"tiktok" required:"false" doc:"TikTok handle or URL" maxLength:"255"`
 		Website     *string `json:"website" required:"false" doc:"Website URL" maxLength:"500"`
 		Description *string `json:"description" required:"false" doc:"Markdown description (max 5000 chars)" maxLength:"5000"`
+		Bio         *string `json:"bio" required:"false" doc:"Markdown bio (max 5000 chars); raw HTML is removed" maxLength:"5000"`
+		Hometown    *string `json:"hometown" required:"false" doc:"Hometown, as shown on the profile" maxLength:"255"`
+		Pronouns    *string `json:"pronouns" required:"false" doc:"Pronouns, e.g. they/them" maxLength:"64"`
+		ImageURL    *string `json:"image_url" required:"false" doc:"Artist photo URL" maxLength:"2048"`
 	}
 }
 
@@ -378,10 +420,14 @@ func (h *ArtistHandler) AdminCreateArtistHandler(ctx context.Context, req *Admin
 	if req.Body.Description != nil && len(*req.Body.Description) > 5000 {
 		return nil, huma.Error422UnprocessableEntity("Description must be 5000 characters or fewer")
 	}
+	if err := validateArtistProfileFields(req.Body.Bio, req.Body.ImageURL); err != nil {
+		return nil, err
+	}
 
-	// PSY-525: URL scheme validation (http/https only) for social URL fields.
-	if err := shared.ValidateSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
-		req.Body.YouTube, req.Body.Spotify, req.Body.SoundCloud, req.Body.Bandcamp, req.Body.Website); err != nil {
+	// Social links are canonicalized (handles become profile URLs) and
+	// validated: http/https, the platform's hosts, no link shorteners.
+	if err := shared.NormalizeSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
+		req.Body.YouTube, req.Body.Spotify, req.Body.SoundCloud, req.Body.Bandcamp, req.Body.TikTok, req.Body.Website); err != nil {
 		return nil, err
 	}
 
@@ -398,8 +444,13 @@ func (h *ArtistHandler) AdminCreateArtistHandler(ctx context.Context, req *Admin
 		Spotify:     req.Body.Spotify,
 		SoundCloud:  req.Body.SoundCloud,
 		Bandcamp:    req.Body.Bandcamp,
+		TikTok:      req.Body.TikTok,
 		Website:     req.Body.Website,
 		Description: req.Body.Description,
+		Bio:         req.Body.Bio,
+		Hometown:    req.Body.Hometown,
+		Pronouns:    req.Body.Pronouns,
+		ImageURL:    req.Body.ImageURL,
 	}
 
 	artist, err := h.artistService.CreateArtist(createReq)
@@ -781,6 +832,10 @@ func hasArtistUpdateFields(req *contracts.UpdateArtistRequest) bool {
 		req.City != nil ||
 		req.Country != nil ||
 		req.Description != nil ||
+		req.Bio != nil ||
+		req.Hometown != nil ||
+		req.Pronouns != nil ||
+		req.ImageURL != nil ||
 		req.BandcampEmbedURL != nil ||
 		req.Instagram != nil ||
 		req.Facebook != nil ||
@@ -789,9 +844,20 @@ func hasArtistUpdateFields(req *contracts.UpdateArtistRequest) bool {
 		req.Spotify != nil ||
 		req.SoundCloud != nil ||
 		req.Bandcamp != nil ||
+		req.TikTok != nil ||
 		req.Website != nil
 }
 
+// validateArtistProfileFields checks the profile fields the admin and manager
+// endpoints share: the bio's length (also capped by the create request's
+// maxLength tag, but not by the PATCH bodies) and the photo URL's scheme.
+func validateArtistProfileFields(bio, imageURL *string) error {
+	if bio != nil && len(*bio) > 5000 {
+		return huma.Error422UnprocessableEntity("Bio must be 5000 characters or fewer")
+	}
+	return shared.ValidateImageURL(imageURL)
+}
+
 // AdminUpdateArtistRequest represents the request for updating an artist (admin only)
 type AdminUpdateArtistRequest struct {
 	ArtistID string `path:"artist_id" validate:"required" doc:"Artist ID"`
@@ -807,8 +873,13 @@ type AdminUpdateArtistRequest struct {
 		Spotify     *string `json:"spotify,omitempty" required:"false" doc:"Spotify URL"`
 		Soundcloud  *string `json:"soundcloud,omitempty" required:"false" doc:"SoundCloud URL"`
 		Bandcamp    *string `json:"bandcamp,omitempty" required:"false" doc:"Bandcamp URL"`
+		TikTok      *string `json:"tiktok,omitempty" required:"false" doc:"TikTok handle or URL" maxLength:"255"`
 		Website     *string `json:"website,omitempty" required:"false" doc:"Website URL"`
 		Description *string `json:"description,omitempty" required:"false" doc:"Markdown description (max 5000 chars)"`
+		Bio         *string `json:"bio,omitempty" required:"false" doc:"Markdown bio (max 5000 chars); raw HTML is removed"`
+		Hometown    *string `json:"hometown,omitempty" required:"false" doc:"Hometown, as shown on the profile" maxLength:"255"`
+		Pronouns    *string `json:"pronouns,omitempty" required:"false" doc:"Pronouns, e.g. they/them" maxLength:"64"`
+		ImageURL    *string `json:"image_url,omitempty" required:"false" doc:"Artist photo URL; replaces the current photo and its attribution" maxLength:"2048"`
 		Summary     *string `json:"summary,omitempty" required:"false" doc:"Revision summary describing the change"`
 	}
 }
@@ -840,11 +911,14 @@ func (h *ArtistHandler) AdminUpdateArtistHandler(ctx context.Context, req *Admin
 	if req.Body.Description != nil && len(*req.Body.Description) > 5000 {
 		return nil, huma.Error422UnprocessableEntity("Description must be 5000 characters or fewer")
 	}
+	if err := validateArtistProfileFields(req.Body.Bio, req.Body.ImageURL); err != nil {
+		return nil, err
+	}
 
-	// PSY-525: URL scheme validation (http/https only) for social URL fields.
+	// Social links are canonicalized and validated as on create.
 	// (artist.go AdminUpdate uses lowercase Youtube/Soundcloud field names.)
-	if err := shared.ValidateSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
-		req.Body.Youtube, req.Body.Spotify, req.Body.Soundcloud, req.Body.Bandcamp, req.Body.Website); err != nil {
+	if err := shared.NormalizeSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
+		req.Body.Youtube, req.Body.Spotify, req.Body.Soundcloud, req.Body.Bandcamp, req.Body.TikTok, req.Body.Website); err != nil {
 		return nil, err
 	}
 
@@ -868,6 +942,10 @@ func (h *ArtistHandler) AdminUpdateArtistHandler(ctx context.Context, req *Admin
 		State:       req.Body.State,
 		Country:     req.Body.Country,
 		Description: req.Body.Description,
+		Bio:         req.Body.Bio,
+		Hometown:    req.Body.Hometown,
+		Pronouns:    req.Body.Pronouns,
+		ImageURL:    req.Body.ImageURL,
 		Instagram:   req.Body.Instagram,
 		Facebook:    req.Body.Facebook,
 		Twitter:     req.Body.Twitter,
@@ -875,6 +953,7 @@ func (h *ArtistHandler) AdminUpdateArtistHandler(ctx context.Context, req *Admin
 		Spotify:     req.Body.Spotify,
 		SoundCloud:  req.Body.Soundcloud,
 		Bandcamp:    req.Body.Bandcamp,
+		TikTok:      req.Body.TikTok,
 		Website:     req.Body.Website,
 	}
 
@@ -903,24 +982,7 @@ func (h *ArtistHandler) AdminUpdateArtistHandler(ctx context.Context, req *Admin
 		h.auditLogService.LogEntityEdit(user.ID, "artist", uint(artistID), nil)
 	}
 
-	// Record revision (fire and forget)
-	if h.revisionService != nil && oldArtist != nil {
-		servicesshared.GoSafe(ctx, "record_revision", func() {
-			changes := revisiondiff.Compare(oldArtist, artist, revisiondiff.ArtistFields)
-			if len(changes) > 0 {
-				summary := ""
-				if req.Body.Summary != nil {
-					summary = *req.Body.Summary
-				}
-				if err := h.revisionService.RecordRevision("artist", uint(artistID), user.ID, changes, summary); err != nil {
-					logger.Default().Error("record_artist_revision_failed",
-						"artist_id", artistID,
-						"error", err.Error(),
-					)
-				}
-			}
-		})
-	}
+	h.recordArtistRevision(ctx, uint(artistID), user.ID, oldArtist, artist, req.Body.Summary)
 
 	logger.FromContext(ctx).Info("admin_update_artist_success",
 		"artist_id", artistID,
@@ -931,6 +993,159 @@ func (h *ArtistHandler) AdminUpdateArtistHandler(ctx context.Context, req *Admin
 	return &AdminUpdateArtistResponse{Body: artist}, nil
 }
 
+// recordArtistRevision records the field-level diff of an artist edit as a
+// revision (fire and forget). oldArtist is nil when the pre-edit lookup failed
+// or revisions are disabled, in which case nothing is recorded.
+func (h *ArtistHandler) recordArtistRevision(ctx context.Context, artistID, userID uint, oldArtist, artist *contracts.ArtistDetailResponse, summary *string) {
+	if h.revisionService == nil || oldArtist == nil {
+		return
+	}
+	servicesshared.GoSafe(ctx, "record_revision", func() {
+		changes := revisiondiff.Compare(oldArtist, artist, revisiondiff.ArtistFields)
+		if len(changes) == 0 {
+			return
+		}
+		text := ""
+		if summary != nil {
+			text = *summary
+		}
+		if err := h.revisionService.RecordRevision("artist", artistID, userID, changes, text); err != nil {
+			logger.Default().Error("record_artist_revision_failed",
+				"artist_id", artistID,
+				"error", err.Error(),
+			)
+		}
+	})
+}
+
+// ============================================================================
+// Artist Profile Update (Managers)
+// ============================================================================
+
+// UpdateArtistProfileRequest represents the request for a manager's profile
+// edit. Only the profile text (description, bio, hometown, pronouns), the
+// photo and social links are editable here; name and location changes still
+// go through an admin or a suggested edit.
+type UpdateArtistProfileRequest struct {
+	ArtistID uint `path:"artist_id" minimum:"1" doc:"Artist ID" example:"1"`
+	Body     struct {
+		Instagram   *string `json:"instagram,omitempty" required:"false" doc:"Instagram URL"`
+		Facebook    *string `json:"facebook,omitempty" required:"false" doc:"Facebook URL"`
+		Twitter     *string `json:"twitter,omitempty" required:"false" doc:"Twitter/X URL"`
+		Youtube     *string `json:"youtube,omitempty" required:"false" doc:"YouTube URL"`
+		Spotify     *string `json:"spotify,omitempty" required:"false" doc:"Spotify URL"`
+		Soundcloud  *string `json:"soundcloud,omitempty" required:"false" doc:"SoundCloud URL"`
+		Bandcamp    *string `json:"bandcamp,omitempty" required:"false" doc:"Bandcamp URL"`
+		TikTok      *string `json:"tiktok,omitempty" required:"false" doc:"TikTok handle or URL" maxLength:"255"`
+		Website     *string `json:"website,omitempty" required:"false" doc:"Website URL"`
+		Description *string `json:"description,omitempty" required:"false" doc:"Markdown description (max 5000 chars)"`
+		Bio         *string `json:"bio,omitempty" required:"false" doc:"Markdown bio (max 5000 chars); raw HTML is removed"`
+		Hometown    *string `json:"hometown,omitempty" required:"false" doc:"Hometown, as shown on the profile" maxLength:"255"`
+		Pronouns    *string `json:"pronouns,omitempty" required:"false" doc:"Pronouns, e.g. they/them" maxLength:"64"`
+		ImageURL    *string `json:"image_url,omitempty" required:"false" doc:"Artist photo URL; replaces the current photo and its attribution" maxLength:"2048"`
+		Summary     *string `json:"summary,omitempty" required:"false" doc:"Revision summary describing the change"`
+	}
+}
+
+// UpdateArtistProfileHandler handles PATCH /artists/{artist_id}/profile.
+// Open to the artist's managers and to admins.
+func (h *ArtistHandler) UpdateArtistProfileHandler(ctx context.Context, req *UpdateArtistProfileRequest) (*AdminUpdateArtistResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	if !user.IsAdmin {
+		managed := false
+		if h.artistClaimService != nil {
+			var err error
+			managed, err = h.artistClaimService.IsArtistManager(user.ID, req.ArtistID)
+			if err != nil {
+				logger.FromContext(ctx).Error("artist_manager_lookup_failed",
+					"artist_id", req.ArtistID,
+					"user_id", user.ID,
+					"error", err.Error(),
+					"request_id", requestID,
+				)
+				return nil, huma.Error500InternalServerError(
+					fmt.Sprintf("Failed to update artist (request_id: %s)", requestID),
+				)
+			}
+		}
+		if !managed {
+			return nil, huma.Error403Forbidden("Only an artist manager or an admin can update this artist; suggest an edit instead")
+		}
+	}
+
+	if req.Body.Description != nil && len(*req.Body.Description) > 5000 {
+		return nil, huma.Error422UnprocessableEntity("Description must be 5000 characters or fewer")
+	}
+	if err := validateArtistProfileFields(req.Body.Bio, req.Body.ImageURL); err != nil {
+		return nil, err
+	}
+	if err := shared.NormalizeSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
+		req.Body.Youtube, req.Body.Spotify, req.Body.Soundcloud, req.Body.Bandcamp, req.Body.TikTok, req.Body.Website); err != nil {
+		return nil, err
+	}
+
+	serviceReq := &contracts.UpdateArtistRequest{
+		Description: req.Body.Description,
+		Bio:         req.Body.Bio,
+		Hometown:    req.Body.Hometown,
+		Pronouns:    req.Body.Pronouns,
+		ImageURL:    req.Body.ImageURL,
+		Instagram:   req.Body.Instagram,
+		Facebook:    req.Body.Facebook,
+		Twitter:     req.Body.Twitter,
+		YouTube:     req.Body.Youtube,
+		Spotify:     req.Body.Spotify,
+		SoundCloud:  req.Body.Soundcloud,
+		Bandcamp:    req.Body.Bandcamp,
+		TikTok:      req.Body.TikTok,
+		Website:     req.Body.Website,
+	}
+	if !hasArtistUpdateFields(serviceReq) {
+		return nil, huma.Error422UnprocessableEntity("No fields to update")
+	}
+
+	var oldArtist *contracts.ArtistDetailResponse
+	if h.revisionService != nil {
+		oldArtist, _ = h.artistService.GetArtist(req.ArtistID)
+	}
+
+	artist, err := h.artistService.UpdateArtist(req.ArtistID, serviceReq)
+	if err != nil {
+		var artistErr *apperrors.ArtistError
+		if errors.As(err, &artistErr) && artistErr.Code == apperrors.CodeArtistNotFound {
+			return nil, huma.Error404NotFound("Artist not found")
+		}
+		logger.FromContext(ctx).Error("update_artist_profile_failed",
+			"artist_id", req.ArtistID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to update artist (request_id: %s)", requestID),
+		)
+	}
+
+	if h.auditLogService != nil {
+		h.auditLogService.LogEntityEdit(user.ID, "artist", req.ArtistID, nil)
+	}
+	h.recordArtistRevision(ctx, req.ArtistID, user.ID, oldArtist, artist, req.Body.Summary)
+
+	logger.FromContext(ctx).Info("artist_profile_updated",
+		"artist_id", req.ArtistID,
+		"user_id", user.ID,
+		"is_admin", user.IsAdmin,
+		"request_id", requestID,
+	)
+
+	return &AdminUpdateArtistResponse{Body: artist}, nil
+}
+
 // ============================================================================
 // Artist Aliases
 // ============================================================================
@@ -1086,22 +1301,58 @@ type MergeArtistsResponse struct {
 
 // MergeArtistsHandler handles POST /admin/artists/merge
 func (h *ArtistHandler) MergeArtistsHandler(ctx context.Context, req *MergeArtistsRequest) (*MergeArtistsResponse, error) {
-	requestID := logger.GetRequestID(ctx)
-
-	user := middleware.GetUserFromContext(ctx)
-
 	if req.Body.CanonicalArtistID == 0 || req.Body.MergeFromArtistID == 0 {
 		return nil, huma.Error422UnprocessableEntity("Both canonical_artist_id and merge_from_artist_id are required")
 	}
 
-	result, err := h.artistService.MergeArtists(req.Body.CanonicalArtistID, req.Body.MergeFromArtistID)
+	result, err := h.mergeArtists(ctx, req.Body.CanonicalArtistID, req.Body.MergeFromArtistID)
+	if err != nil {
+		return nil, err
+	}
+	return &MergeArtistsResponse{Body: result}, nil
+}
+
+// MergeArtistIntoRequest represents the request for merging a duplicate
+// artist into a canonical one
+type MergeArtistIntoRequest struct {
+	ArtistID string `path:"artist_id" doc:"ID of the duplicate artist to merge and delete" example:"2"`
+	Body     struct {
+		CanonicalArtistID uint `json:"canonical_artist_id" doc:"ID of the artist to keep"`
+	}
+}
+
+// MergeArtistIntoHandler handles POST /admin/artists/{artist_id}/merge
+func (h *ArtistHandler) MergeArtistIntoHandler(ctx context.Context, req *MergeArtistIntoRequest) (*MergeArtistsResponse, error) {
+	mergeFromID, err := strconv.ParseUint(req.ArtistID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid artist ID")
+	}
+	if req.Body.CanonicalArtistID == 0 {
+		return nil, huma.Error422UnprocessableEntity("canonical_artist_id is required")
+	}
+
+	result, err := h.mergeArtists(ctx, req.Body.CanonicalArtistID, uint(mergeFromID))
+	if err != nil {
+		return nil, err
+	}
+	return &MergeArtistsResponse{Body: result}, nil
+}
+
+// mergeArtists runs the merge, writes the audit entry and maps errors for
+// both merge endpoints.
+func (h *ArtistHandler) mergeArtists(ctx context.Context, canonicalID, mergeFromID uint) (*contracts.MergeArtistResult, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	result, err := h.artistService.MergeArtists(canonicalID, mergeFromID)
 	if err != nil {
 		if mapped := shared.MapArtistError(err); mapped != nil {
 			return nil, mapped
 		}
 		logger.FromContext(ctx).Error("merge_artists_failed",
-			"canonical_id", req.Body.CanonicalArtistID,
-			"merge_from_id", req.Body.MergeFromArtistID,
+			"canonical_id", canonicalID,
+			"merge_from_id", mergeFromID,
 			"error", err.Error(),
 			"request_id", requestID,
 		)
@@ -1112,20 +1363,23 @@ func (h *ArtistHandler) MergeArtistsHandler(ctx context.Context, req *MergeArtis
 
 	// Audit log (fire and forget)
 	if h.auditLogService != nil {
-		h.auditLogService.LogAction(user.ID, "merge_artists", "artist", req.Body.CanonicalArtistID, map[string]interface{}{
-			"merged_artist_id":   req.Body.MergeFromArtistID,
+		h.auditLogService.LogAction(user.ID, "merge_artists", "artist", canonicalID, map[string]interface{}{
+			"merged_artist_id":   mergeFromID,
 			"merged_artist_name": result.MergedArtistName,
 			"shows_moved":        result.ShowsMoved,
+			"reports_moved":      result.ReportsMoved,
+			"links_copied":       result.LinksCopied,
+			"slug_redirected":    result.SlugRedirected,
 		})
 	}
 
 	logger.FromContext(ctx).Info("artists_merged",
-		"canonical_id", req.Body.CanonicalArtistID,
-		"merged_id", req.Body.MergeFromArtistID,
+		"canonical_id", canonicalID,
+		"merged_id", mergeFromID,
 		"merged_name", result.MergedArtistName,
 		"admin_id", user.ID,
 		"request_id", requestID,
 	)
 
-	return &MergeArtistsResponse{Body: result}, nil
+	return result, nil
 }
@@ -0,0 +1,277 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+	servicesshared "psychic-homily-backend/internal/services/shared"
+)
+
+// ArtistClaimHandler handles artist claim submission, the admin review queue
+// and artist manager grants.
+type ArtistClaimHandler struct {
+	claimService    contracts.ArtistClaimServiceInterface
+	auditLogService contracts.AuditLogServiceInterface
+}
+
+// NewArtistClaimHandler creates a new artist claim handler.
+func NewArtistClaimHandler(claimService contracts.ArtistClaimServiceInterface, auditLogService contracts.AuditLogServiceInterface) *ArtistClaimHandler {
+	return &ArtistClaimHandler{
+		claimService:    claimService,
+		auditLogService: auditLogService,
+	}
+}
+
+// artistClaimError logs an artist claim service failure and maps it to an HTTP error.
+func artistClaimError(ctx context.Context, op string, err error) error {
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Warn(op+"_failed",
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	if mapped := shared.MapArtistClaimError(err); mapped != nil {
+		return mapped
+	}
+	var artistErr *apperrors.ArtistError
+	if errors.As(err, &artistErr) && artistErr.Code == apperrors.CodeArtistNotFound {
+		return huma.Error404NotFound("Artist not found")
+	}
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to process artist claim (request_id: %s)", requestID),
+	)
+}
+
+// logAction writes a fire-and-forget audit entry for a claim review or
+// manager grant change.
+func (h *ArtistClaimHandler) logAction(ctx context.Context, userID uint, action string, artistID uint, metadata map[string]interface{}) {
+	if h.auditLogService == nil {
+		return
+	}
+	servicesshared.GoSafe(ctx, "audit_log", func() {
+		h.auditLogService.LogAction(userID, action, "artist", artistID, metadata)
+	})
+}
+
+// ============================================================================
+// Claim Submission (Protected)
+// ============================================================================
+
+// SubmitArtistClaimRequest represents the request for claiming an artist
+type SubmitArtistClaimRequest struct {
+	ArtistID uint `path:"artist_id" minimum:"1" doc:"Artist ID" example:"1"`
+	Body     struct {
+		Evidence string `json:"evidence" minLength:"1" maxLength:"2000" doc:"How you are connected to the artist, e.g. band member, manager or label, with a link that proves it"`
+	}
+}
+
+// ArtistClaimResponse represents a single artist claim
+type ArtistClaimResponse struct {
+	Body *contracts.ArtistClaimResponse
+}
+
+// SubmitArtistClaimHandler handles POST /artists/{artist_id}/claim
+func (h *ArtistClaimHandler) SubmitArtistClaimHandler(ctx context.Context, req *SubmitArtistClaimRequest) (*ArtistClaimResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	claim, err := h.claimService.SubmitClaim(req.ArtistID, user.ID, req.Body.Evidence)
+	if err != nil {
+		return nil, artistClaimError(ctx, "submit_artist_claim", err)
+	}
+
+	logger.FromContext(ctx).Info("artist_claim_submitted",
+		"claim_id", claim.ID,
+		"artist_id", req.ArtistID,
+		"user_id", user.ID,
+	)
+
+	return &ArtistClaimResponse{Body: claim}, nil
+}
+
+// GetMyArtistClaimsRequest represents the request for the user's own claims
+type GetMyArtistClaimsRequest struct{}
+
+// ArtistClaimListResponse represents a list of artist claims
+type ArtistClaimListResponse struct {
+	Body struct {
+		Claims []*contracts.ArtistClaimResponse `json:"claims" doc:"Artist claims"`
+		Total  int64                            `json:"total" doc:"Total number of matching claims"`
+	}
+}
+
+// GetMyArtistClaimsHandler handles GET /my/artist-claims
+func (h *ArtistClaimHandler) GetMyArtistClaimsHandler(ctx context.Context, _ *GetMyArtistClaimsRequest) (*ArtistClaimListResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	claims, err := h.claimService.GetUserClaims(user.ID)
+	if err != nil {
+		return nil, artistClaimError(ctx, "get_my_artist_claims", err)
+	}
+
+	resp := &ArtistClaimListResponse{}
+	resp.Body.Claims = claims
+	resp.Body.Total = int64(len(claims))
+	return resp, nil
+}
+
+// GetMyManagedArtistsRequest represents the request for the artists the user manages
+type GetMyManagedArtistsRequest struct{}
+
+// ArtistManagerListResponse represents a list of artist manager grants
+type ArtistManagerListResponse struct {
+	Body struct {
+		Managers []*contracts.ArtistManagerResponse `json:"managers" doc:"Artist manager grants"`
+	}
+}
+
+// GetMyManagedArtistsHandler handles GET /my/managed-artists
+func (h *ArtistClaimHandler) GetMyManagedArtistsHandler(ctx context.Context, _ *GetMyManagedArtistsRequest) (*ArtistManagerListResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	managers, err := h.claimService.GetManagedArtists(user.ID)
+	if err != nil {
+		return nil, artistClaimError(ctx, "get_managed_artists", err)
+	}
+
+	resp := &ArtistManagerListResponse{}
+	resp.Body.Managers = managers
+	return resp, nil
+}
+
+// ============================================================================
+// Admin Review Queue
+// ============================================================================
+
+// ListArtistClaimsRequest represents the request for the claim review queue
+type ListArtistClaimsRequest struct {
+	Status string `query:"status" default:"pending" enum:"pending,approved,denied,all" doc:"Claim status to list"`
+	Limit  int    `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Max results"`
+	Offset int    `query:"offset" default:"0" minimum:"0" doc:"Results to skip"`
+}
+
+// ListArtistClaimsHandler handles GET /admin/artist-claims
+func (h *ArtistClaimHandler) ListArtistClaimsHandler(ctx context.Context, req *ListArtistClaimsRequest) (*ArtistClaimListResponse, error) {
+	status := req.Status
+	if status == "all" {
+		status = ""
+	}
+
+	claims, total, err := h.claimService.ListClaims(status, req.Limit, req.Offset)
+	if err != nil {
+		return nil, artistClaimError(ctx, "list_artist_claims", err)
+	}
+
+	resp := &ArtistClaimListResponse{}
+	resp.Body.Claims = claims
+	resp.Body.Total = total
+	return resp, nil
+}
+
+// ReviewArtistClaimRequest represents the request for approving or denying a claim
+type ReviewArtistClaimRequest struct {
+	ClaimID uint `path:"claim_id" minimum:"1" doc:"Artist claim ID" example:"1"`
+	Body    struct {
+		Note string `json:"note,omitempty" maxLength:"2000" doc:"Note shown to the claimant; required when denying"`
+	}
+}
+
+// ApproveArtistClaimHandler handles POST /admin/artist-claims/{claim_id}/approve.
+// The claimant becomes a manager of the artist and the artist is verified.
+func (h *ArtistClaimHandler) ApproveArtistClaimHandler(ctx context.Context, req *ReviewArtistClaimRequest) (*ArtistClaimResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	claim, err := h.claimService.ApproveClaim(req.ClaimID, user.ID, req.Body.Note)
+	if err != nil {
+		return nil, artistClaimError(ctx, "approve_artist_claim", err)
+	}
+
+	h.logAction(ctx, user.ID, "approve_artist_claim", claim.ArtistID, map[string]interface{}{
+		"claim_id": claim.ID,
+		"user_id":  claim.UserID,
+	})
+
+	logger.FromContext(ctx).Info("artist_claim_approved",
+		"claim_id", claim.ID,
+		"artist_id", claim.ArtistID,
+		"user_id", claim.UserID,
+		"admin_id", user.ID,
+	)
+
+	return &ArtistClaimResponse{Body: claim}, nil
+}
+
+// DenyArtistClaimHandler handles POST /admin/artist-claims/{claim_id}/deny
+func (h *ArtistClaimHandler) DenyArtistClaimHandler(ctx context.Context, req *ReviewArtistClaimRequest) (*ArtistClaimResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	claim, err := h.claimService.DenyClaim(req.ClaimID, user.ID, req.Body.Note)
+	if err != nil {
+		return nil, artistClaimError(ctx, "deny_artist_claim", err)
+	}
+
+	h.logAction(ctx, user.ID, "deny_artist_claim", claim.ArtistID, map[string]interface{}{
+		"claim_id": claim.ID,
+		"user_id":  claim.UserID,
+	})
+
+	return &ArtistClaimResponse{Body: claim}, nil
+}
+
+// ============================================================================
+// Admin Manager Grants
+// ============================================================================
+
+// ListArtistManagersRequest represents the request for an artist's managers
+type ListArtistManagersRequest struct {
+	ArtistID uint `path:"artist_id" minimum:"1" doc:"Artist ID" example:"1"`
+}
+
+// ListArtistManagersHandler handles GET /admin/artists/{artist_id}/managers
+func (h *ArtistClaimHandler) ListArtistManagersHandler(ctx context.Context, req *ListArtistManagersRequest) (*ArtistManagerListResponse, error) {
+	managers, err := h.claimService.ListArtistManagers(req.ArtistID)
+	if err != nil {
+		return nil, artistClaimError(ctx, "list_artist_managers", err)
+	}
+
+	resp := &ArtistManagerListResponse{}
+	resp.Body.Managers = managers
+	return resp, nil
+}
+
+// RemoveArtistManagerRequest represents the request for revoking a manager grant
+type RemoveArtistManagerRequest struct {
+	ArtistID uint `path:"artist_id" minimum:"1" doc:"Artist ID" example:"1"`
+	UserID   uint `path:"user_id" minimum:"1" doc:"Manager user ID" example:"1"`
+}
+
+// RemoveArtistManagerHandler handles DELETE /admin/artists/{artist_id}/managers/{user_id}.
+// The artist loses its verified badge when its last manager is removed.
+func (h *ArtistClaimHandler) RemoveArtistManagerHandler(ctx context.Context, req *RemoveArtistManagerRequest) (*struct{}, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	if err := h.claimService.RemoveArtistManager(req.ArtistID, req.UserID); err != nil {
+		return nil, artistClaimError(ctx, "remove_artist_manager", err)
+	}
+
+	h.logAction(ctx, user.ID, "remove_artist_manager", req.ArtistID, map[string]interface{}{
+		"user_id": req.UserID,
+	})
+
+	return nil, nil
+}
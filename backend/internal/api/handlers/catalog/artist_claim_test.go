@@ -0,0 +1,128 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestSubmitArtistClaimHandler_NoAuth(t *testing.T) {
+	h := NewArtistClaimHandler(&testhelpers.MockArtistClaimService{}, nil)
+	_, err := h.SubmitArtistClaimHandler(context.Background(), &SubmitArtistClaimRequest{ArtistID: 1})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestSubmitArtistClaimHandler_Success(t *testing.T) {
+	h := NewArtistClaimHandler(&testhelpers.MockArtistClaimService{
+		SubmitClaimFn: func(artistID, userID uint, evidence string) (*contracts.ArtistClaimResponse, error) {
+			if artistID != 3 || userID != 5 || evidence != "I play bass in the band" {
+				t.Errorf("unexpected claim %d %d %q", artistID, userID, evidence)
+			}
+			return &contracts.ArtistClaimResponse{ID: 1, ArtistID: artistID, UserID: userID, Status: "pending"}, nil
+		},
+	}, nil)
+
+	req := &SubmitArtistClaimRequest{ArtistID: 3}
+	req.Body.Evidence = "I play bass in the band"
+	resp, err := h.SubmitArtistClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Status != "pending" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestSubmitArtistClaimHandler_ErrorMapping(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"artist not found", apperrors.ErrArtistNotFound(3), 404},
+		{"duplicate", apperrors.ErrArtistClaimConflict("already pending"), 409},
+		{"invalid", apperrors.ErrArtistClaimInvalid("evidence is required"), 422},
+		{"internal", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewArtistClaimHandler(&testhelpers.MockArtistClaimService{
+				SubmitClaimFn: func(uint, uint, string) (*contracts.ArtistClaimResponse, error) { return nil, tc.err },
+			}, nil)
+			_, err := h.SubmitArtistClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &SubmitArtistClaimRequest{ArtistID: 3})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+func TestListArtistClaimsHandler_AllStatuses(t *testing.T) {
+	h := NewArtistClaimHandler(&testhelpers.MockArtistClaimService{
+		ListClaimsFn: func(status string, limit, offset int) ([]*contracts.ArtistClaimResponse, int64, error) {
+			if status != "" || limit != 20 {
+				t.Errorf("unexpected args %q %d %d", status, limit, offset)
+			}
+			return []*contracts.ArtistClaimResponse{{ID: 1}}, 1, nil
+		},
+	}, nil)
+
+	resp, err := h.ListArtistClaimsHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), &ListArtistClaimsRequest{Status: "all", Limit: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 1 || len(resp.Body.Claims) != 1 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestApproveArtistClaimHandler_AuditsApproval(t *testing.T) {
+	done := make(chan string, 1)
+	h := NewArtistClaimHandler(&testhelpers.MockArtistClaimService{
+		ApproveClaimFn: func(claimID, reviewerID uint, _ string) (*contracts.ArtistClaimResponse, error) {
+			return &contracts.ArtistClaimResponse{ID: claimID, ArtistID: 3, UserID: 5, Status: "approved", ReviewedBy: &reviewerID}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			done <- fmt.Sprintf("%s:%s:%d", action, entityType, entityID)
+		},
+	})
+
+	resp, err := h.ApproveArtistClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), &ReviewArtistClaimRequest{ClaimID: 9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Status != "approved" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+	if got := <-done; got != "approve_artist_claim:artist:3" {
+		t.Errorf("unexpected audit entry %q", got)
+	}
+}
+
+func TestDenyArtistClaimHandler_AlreadyReviewed(t *testing.T) {
+	h := NewArtistClaimHandler(&testhelpers.MockArtistClaimService{
+		DenyClaimFn: func(uint, uint, string) (*contracts.ArtistClaimResponse, error) {
+			return nil, apperrors.ErrArtistClaimNotPending("approved")
+		},
+	}, nil)
+
+	req := &ReviewArtistClaimRequest{ClaimID: 9}
+	req.Body.Note = "Could not verify"
+	_, err := h.DenyArtistClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), req)
+	testhelpers.AssertHumaError(t, err, 409)
+}
+
+func TestRemoveArtistManagerHandler_NotFound(t *testing.T) {
+	h := NewArtistClaimHandler(&testhelpers.MockArtistClaimService{
+		RemoveArtistManagerFn: func(artistID, userID uint) error {
+			return apperrors.ErrArtistManagerNotFound(artistID, userID)
+		},
+	}, nil)
+
+	_, err := h.RemoveArtistManagerHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), &RemoveArtistManagerRequest{ArtistID: 3, UserID: 5})
+	testhelpers.AssertHumaError(t, err, 404)
+}
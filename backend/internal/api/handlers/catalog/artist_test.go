@@ -3,6 +3,7 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
@@ -463,7 +464,7 @@ func TestGetArtist_ServiceError(t *testing.T) {
 
 func TestGetArtistShows_ByID(t *testing.T) {
 	mock := &testhelpers.MockArtistService{
-		GetShowsForArtistFn: func(artistID uint, timezone string, limit int, timeFilter string) ([]*contracts.ArtistShowResponse, int64, error) {
+		GetShowsForArtistPageFn: func(artistID uint, timezone string, limit, offset int, timeFilter string, year int) ([]*contracts.ArtistShowResponse, int64, error) {
 			if artistID != 5 {
 				t.Errorf("expected artistID=5, got %d", artistID)
 			}
@@ -489,7 +490,7 @@ func TestGetArtistShows_BySlug(t *testing.T) {
 		GetArtistBySlugFn: func(slug string) (*contracts.ArtistDetailResponse, error) {
 			return &contracts.ArtistDetailResponse{ID: 10}, nil
 		},
-		GetShowsForArtistFn: func(artistID uint, _ string, _ int, _ string) ([]*contracts.ArtistShowResponse, int64, error) {
+		GetShowsForArtistPageFn: func(artistID uint, _ string, _, _ int, _ string, _ int) ([]*contracts.ArtistShowResponse, int64, error) {
 			if artistID != 10 {
 				t.Errorf("expected resolved artistID=10, got %d", artistID)
 			}
@@ -509,7 +510,7 @@ func TestGetArtistShows_BySlug(t *testing.T) {
 
 func TestGetArtistShows_ArtistNotFound(t *testing.T) {
 	mock := &testhelpers.MockArtistService{
-		GetShowsForArtistFn: func(_ uint, _ string, _ int, _ string) ([]*contracts.ArtistShowResponse, int64, error) {
+		GetShowsForArtistPageFn: func(_ uint, _ string, _, _ int, _ string, _ int) ([]*contracts.ArtistShowResponse, int64, error) {
 			return nil, 0, apperrors.ErrArtistNotFound(99)
 		},
 	}
@@ -521,7 +522,7 @@ func TestGetArtistShows_ArtistNotFound(t *testing.T) {
 
 func TestGetArtistShows_ServiceError(t *testing.T) {
 	mock := &testhelpers.MockArtistService{
-		GetShowsForArtistFn: func(_ uint, _ string, _ int, _ string) ([]*contracts.ArtistShowResponse, int64, error) {
+		GetShowsForArtistPageFn: func(_ uint, _ string, _, _ int, _ string, _ int) ([]*contracts.ArtistShowResponse, int64, error) {
 			return nil, 0, fmt.Errorf("db error")
 		},
 	}
@@ -531,6 +532,55 @@ func TestGetArtistShows_ServiceError(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 500)
 }
 
+func TestGetArtistShows_PastIncludesYears(t *testing.T) {
+	mock := &testhelpers.MockArtistService{
+		GetShowsForArtistPageFn: func(_ uint, _ string, limit, offset int, timeFilter string, year int) ([]*contracts.ArtistShowResponse, int64, error) {
+			if limit != 10 || offset != 20 || timeFilter != "past" || year != 2024 {
+				t.Errorf("unexpected args: limit=%d offset=%d timeFilter=%q year=%d", limit, offset, timeFilter, year)
+			}
+			return []*contracts.ArtistShowResponse{{ID: 100}}, 31, nil
+		},
+		GetShowYearsForArtistFn: func(_ uint, _ string, timeFilter string) ([]*contracts.ShowYearCount, error) {
+			return []*contracts.ShowYearCount{{Year: 2024, Count: 25}, {Year: 2023, Count: 6}}, nil
+		},
+	}
+	h := NewArtistHandler(mock, nil, nil, nil)
+
+	resp, err := h.GetArtistShowsHandler(context.Background(), &GetArtistShowsRequest{
+		ArtistID: "5", Limit: 10, Offset: 20, TimeFilter: "past", Year: 2024,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Offset != 20 || resp.Body.Limit != 10 {
+		t.Errorf("expected limit/offset echoed, got %d/%d", resp.Body.Limit, resp.Body.Offset)
+	}
+	if len(resp.Body.Years) != 2 || resp.Body.Years[0].Year != 2024 {
+		t.Errorf("unexpected years: %+v", resp.Body.Years)
+	}
+}
+
+func TestGetArtistShows_UpcomingOmitsYears(t *testing.T) {
+	mock := &testhelpers.MockArtistService{
+		GetShowsForArtistPageFn: func(_ uint, _ string, _, _ int, _ string, _ int) ([]*contracts.ArtistShowResponse, int64, error) {
+			return []*contracts.ArtistShowResponse{}, 0, nil
+		},
+		GetShowYearsForArtistFn: func(_ uint, _ string, _ string) ([]*contracts.ShowYearCount, error) {
+			t.Fatal("year counts are only for the past archive")
+			return nil, nil
+		},
+	}
+	h := NewArtistHandler(mock, nil, nil, nil)
+
+	resp, err := h.GetArtistShowsHandler(context.Background(), &GetArtistShowsRequest{ArtistID: "5", Limit: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Years != nil {
+		t.Errorf("expected no years, got %+v", resp.Body.Years)
+	}
+}
+
 // ============================================================================
 // Mock-based tests: DeleteArtistHandler
 // ============================================================================
@@ -1118,6 +1168,52 @@ func TestMergeArtists_NotFound(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 404)
 }
 
+func TestMergeArtistInto_InvalidID(t *testing.T) {
+	h := testArtistHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &MergeArtistIntoRequest{ArtistID: "abc"}
+	req.Body.CanonicalArtistID = 1
+
+	_, err := h.MergeArtistIntoHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestMergeArtistInto_MissingCanonical(t *testing.T) {
+	h := testArtistHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.MergeArtistIntoHandler(ctx, &MergeArtistIntoRequest{ArtistID: "2"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestMergeArtistInto_Success(t *testing.T) {
+	mock := &testhelpers.MockArtistService{
+		MergeArtistsFn: func(canonicalID, mergeFromID uint) (*contracts.MergeArtistResult, error) {
+			if canonicalID != 1 || mergeFromID != 2 {
+				t.Errorf("expected canonical=1 mergeFrom=2, got %d, %d", canonicalID, mergeFromID)
+			}
+			return &contracts.MergeArtistResult{
+				CanonicalArtistID: 1,
+				MergedArtistID:    2,
+				LinksCopied:       []string{"spotify"},
+				SlugRedirected:    true,
+			}, nil
+		},
+	}
+	h := NewArtistHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &MergeArtistIntoRequest{ArtistID: "2"}
+	req.Body.CanonicalArtistID = 1
+
+	resp, err := h.MergeArtistIntoHandler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.SlugRedirected || len(resp.Body.LinksCopied) != 1 {
+		t.Errorf("unexpected result: %+v", resp.Body)
+	}
+}
+
 func TestAdminCreateArtist_EmptyName(t *testing.T) {
 	h := testArtistHandler()
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
@@ -1428,3 +1524,192 @@ func TestGetArtistLabels_ServiceError(t *testing.T) {
 	_, err := h.GetArtistLabelsHandler(context.Background(), &GetArtistLabelsRequest{ArtistID: "5"})
 	testhelpers.AssertHumaError(t, err, 500)
 }
+
+// ============================================================================
+// Mock-based tests: UpdateArtistProfileHandler
+// ============================================================================
+
+func TestUpdateArtistProfile_NoAuth(t *testing.T) {
+	h := NewArtistHandler(&testhelpers.MockArtistService{}, nil, nil, nil)
+	_, err := h.UpdateArtistProfileHandler(context.Background(), &UpdateArtistProfileRequest{ArtistID: 42})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestUpdateArtistProfile_NonManagerForbidden(t *testing.T) {
+	h := NewArtistHandler(&testhelpers.MockArtistService{}, nil, nil, nil)
+	h.SetArtistClaimService(&testhelpers.MockArtistClaimService{
+		IsArtistManagerFn: func(uint, uint) (bool, error) { return false, nil },
+	})
+	desc := "New bio"
+	req := &UpdateArtistProfileRequest{ArtistID: 42}
+	req.Body.Description = &desc
+
+	_, err := h.UpdateArtistProfileHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
+func TestUpdateArtistProfile_ManagerLookupFails(t *testing.T) {
+	h := NewArtistHandler(&testhelpers.MockArtistService{}, nil, nil, nil)
+	h.SetArtistClaimService(&testhelpers.MockArtistClaimService{
+		IsArtistManagerFn: func(uint, uint) (bool, error) { return false, fmt.Errorf("db down") },
+	})
+	desc := "New bio"
+	req := &UpdateArtistProfileRequest{ArtistID: 42}
+	req.Body.Description = &desc
+
+	_, err := h.UpdateArtistProfileHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestUpdateArtistProfile_ManagerUpdatesBioAndLinks(t *testing.T) {
+	mock := &testhelpers.MockArtistService{
+		UpdateArtistFn: func(artistID uint, req *contracts.UpdateArtistRequest) (*contracts.ArtistDetailResponse, error) {
+			if artistID != 42 {
+				t.Errorf("expected artistID=42, got %d", artistID)
+			}
+			if req.Name != nil || req.City != nil || req.State != nil || req.Country != nil {
+				t.Errorf("profile update must not touch name or location: %+v", req)
+			}
+			if req.Description == nil || *req.Description != "New bio" || req.Bandcamp == nil {
+				t.Errorf("unexpected update request: %+v", req)
+			}
+			return &contracts.ArtistDetailResponse{ID: 42, Verified: true}, nil
+		},
+	}
+	h := NewArtistHandler(mock, nil, nil, nil)
+	h.SetArtistClaimService(&testhelpers.MockArtistClaimService{
+		IsArtistManagerFn: func(userID, artistID uint) (bool, error) { return userID == 5 && artistID == 42, nil },
+	})
+	desc := "New bio"
+	bandcamp := "https://snakeclan.bandcamp.com"
+	req := &UpdateArtistProfileRequest{ArtistID: 42}
+	req.Body.Description = &desc
+	req.Body.Bandcamp = &bandcamp
+
+	resp, err := h.UpdateArtistProfileHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Verified {
+		t.Errorf("expected verified artist, got %+v", resp.Body)
+	}
+}
+
+func TestUpdateArtistProfile_AdminBypassesManagerCheck(t *testing.T) {
+	mock := &testhelpers.MockArtistService{
+		UpdateArtistFn: func(_ uint, _ *contracts.UpdateArtistRequest) (*contracts.ArtistDetailResponse, error) {
+			return &contracts.ArtistDetailResponse{ID: 42}, nil
+		},
+	}
+	h := NewArtistHandler(mock, nil, nil, nil)
+	website := "https://snakeclan.com"
+	req := &UpdateArtistProfileRequest{ArtistID: 42}
+	req.Body.Website = &website
+
+	if _, err := h.UpdateArtistProfileHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUpdateArtistProfile_Validation(t *testing.T) {
+	h := NewArtistHandler(&testhelpers.MockArtistService{}, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.UpdateArtistProfileHandler(ctx, &UpdateArtistProfileRequest{ArtistID: 42})
+	testhelpers.AssertHumaError(t, err, 422)
+
+	bad := "javascript:alert(1)"
+	req := &UpdateArtistProfileRequest{ArtistID: 42}
+	req.Body.Instagram = &bad
+	_, err = h.UpdateArtistProfileHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestAdminCreateArtist_NormalizesSocialLinks(t *testing.T) {
+	mock := &testhelpers.MockArtistService{
+		CreateArtistFn: func(req *contracts.CreateArtistRequest) (*contracts.ArtistDetailResponse, error) {
+			if req.Instagram == nil || *req.Instagram != "https://instagram.com/snakeclan" {
+				t.Errorf("expected instagram handle to become a URL, got %v", req.Instagram)
+			}
+			if req.TikTok == nil || *req.TikTok != "https://www.tiktok.com/@snakeclan" {
+				t.Errorf("expected tiktok handle to become a URL, got %v", req.TikTok)
+			}
+			if req.Website == nil || *req.Website != "https://snakeclan.com" {
+				t.Errorf("expected schemeless website to get https, got %v", req.Website)
+			}
+			return &contracts.ArtistDetailResponse{ID: 43}, nil
+		},
+	}
+	h := NewArtistHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	instagram, tiktok, website := "@snakeclan", "snakeclan", "snakeclan.com/"
+	req := &AdminCreateArtistRequest{}
+	req.Body.Name = "Snake Clan"
+	req.Body.Instagram = &instagram
+	req.Body.TikTok = &tiktok
+	req.Body.Website = &website
+
+	if _, err := h.AdminCreateArtistHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	shortened := "https://bit.ly/snakeclan"
+	update := &AdminUpdateArtistRequest{ArtistID: "43"}
+	update.Body.Website = &shortened
+	_, err := h.AdminUpdateArtistHandler(ctx, update)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestUpdateArtistProfile_ManagerUpdatesProfileFields(t *testing.T) {
+	mock := &testhelpers.MockArtistService{
+		UpdateArtistFn: func(_ uint, req *contracts.UpdateArtistRequest) (*contracts.ArtistDetailResponse, error) {
+			if req.Bio == nil || *req.Bio != "**Loud** since 2009" {
+				t.Errorf("expected bio to pass through, got %v", req.Bio)
+			}
+			if req.Hometown == nil || *req.Hometown != "Flagstaff, AZ" || req.Pronouns == nil || *req.Pronouns != "they/them" {
+				t.Errorf("unexpected hometown/pronouns: %+v", req)
+			}
+			if req.ImageURL == nil || *req.ImageURL != "https://example.com/band.jpg" {
+				t.Errorf("expected image_url to pass through, got %v", req.ImageURL)
+			}
+			return &contracts.ArtistDetailResponse{ID: 42}, nil
+		},
+	}
+	h := NewArtistHandler(mock, nil, nil, nil)
+	h.SetArtistClaimService(&testhelpers.MockArtistClaimService{
+		IsArtistManagerFn: func(userID, artistID uint) (bool, error) { return true, nil },
+	})
+	bio, hometown, pronouns, photo := "**Loud** since 2009", "Flagstaff, AZ", "they/them", "https://example.com/band.jpg"
+	req := &UpdateArtistProfileRequest{ArtistID: 42}
+	req.Body.Bio = &bio
+	req.Body.Hometown = &hometown
+	req.Body.Pronouns = &pronouns
+	req.Body.ImageURL = &photo
+
+	if _, err := h.UpdateArtistProfileHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestArtistProfileFields_Validation(t *testing.T) {
+	h := NewArtistHandler(&testhelpers.MockArtistService{}, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	long := strings.Repeat("a", 5001)
+	badPhoto := "javascript:alert(1)"
+
+	profile := &UpdateArtistProfileRequest{ArtistID: 42}
+	profile.Body.Bio = &long
+	_, err := h.UpdateArtistProfileHandler(ctx, profile)
+	testhelpers.AssertHumaError(t, err, 422)
+
+	update := &AdminUpdateArtistRequest{ArtistID: "42"}
+	update.Body.ImageURL = &badPhoto
+	_, err = h.AdminUpdateArtistHandler(ctx, update)
+	testhelpers.AssertHumaError(t, err, 422)
+
+	create := &AdminCreateArtistRequest{}
+	create.Body.Name = "Photo Band"
+	create.Body.ImageURL = &badPhoto
+	_, err = h.AdminCreateArtistHandler(ctx, create)
+	testhelpers.AssertHumaError(t, err, 422)
+}
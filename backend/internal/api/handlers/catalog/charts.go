@@ -830,6 +830,41 @@ func (h *ChartsHandler) GetCommunityPulseHandler(ctx context.Context, _ *GetComm
 	return resp, nil
 }
 
+// --- GetPublicStats ---
+
+// GetPublicStatsRequest is the Huma request for GET /stats/public.
+// No query params — global, viewer-independent counts.
+type GetPublicStatsRequest struct{}
+
+// GetPublicStatsResponse is the Huma response for GET /stats/public — the
+// homepage hero's live numbers.
+type GetPublicStatsResponse struct {
+	// CacheControl: same masthead budget as /community/pulse.
+	CacheControl string `header:"Cache-Control"`
+	Body         struct {
+		UpcomingShows       int                       `json:"upcoming_shows"`
+		Venues              int                       `json:"venues"`
+		Artists             int                       `json:"artists"`
+		ShowsThisWeekByCity []contracts.CityShowCount `json:"shows_this_week_by_city"`
+	}
+}
+
+// GetPublicStatsHandler handles GET /stats/public.
+func (h *ChartsHandler) GetPublicStatsHandler(ctx context.Context, _ *GetPublicStatsRequest) (*GetPublicStatsResponse, error) {
+	data, err := h.chartsService.GetPublicStats()
+	if err != nil {
+		logger.FromContext(ctx).Error("public_stats_failed", "error", err.Error())
+		return nil, huma.Error500InternalServerError("Failed to get public stats")
+	}
+
+	resp := &GetPublicStatsResponse{CacheControl: chartsMastheadCacheControl}
+	resp.Body.UpcomingShows = data.UpcomingShows
+	resp.Body.Venues = data.Venues
+	resp.Body.Artists = data.Artists
+	resp.Body.ShowsThisWeekByCity = data.ShowsThisWeekByCity
+	return resp, nil
+}
+
 // --- GetFreshlyAdded ---
 
 // GetFreshlyAddedRequest is the Huma request for GET /charts/freshly-added
@@ -1303,6 +1338,62 @@ func (h *ChartsHandler) GetFeaturedCollectionHistoryHandler(ctx context.Context,
 	return resp, nil
 }
 
+// --- GetYearInReview ---
+
+// GetYearInReviewRequest is the Huma request for the year-in-review recaps.
+// Year 0 (absent) means the current UTC year.
+type GetYearInReviewRequest struct {
+	Year int `query:"year" required:"false" minimum:"0" maximum:"9999" doc:"Calendar year (UTC); defaults to the current year"`
+}
+
+// GetYearInReviewResponse is the Huma response for GET /stats/year-in-review.
+type GetYearInReviewResponse struct {
+	// CacheControl mirrors the module tier for the public recap; the personal
+	// variant sends no-store for the same reason as /charts/me.
+	CacheControl string `header:"Cache-Control"`
+	Body         *contracts.YearInReview
+}
+
+// GetYearInReviewHandler handles GET /stats/year-in-review — the community
+// end-of-year recap.
+func (h *ChartsHandler) GetYearInReviewHandler(ctx context.Context, req *GetYearInReviewRequest) (*GetYearInReviewResponse, error) {
+	year, yearErr := normalizeYearInReviewYear(req.Year)
+	if yearErr != nil {
+		return nil, yearErr
+	}
+
+	data, err := h.chartsService.GetYearInReview(year)
+	if err != nil {
+		logger.FromContext(ctx).Error("charts_year_in_review_failed", "year", year, "error", err.Error())
+		return nil, huma.Error500InternalServerError("Failed to get year in review")
+	}
+	return &GetYearInReviewResponse{CacheControl: chartsModuleCacheControl, Body: data}, nil
+}
+
+// GetPersonalYearInReviewHandler handles GET /stats/year-in-review/me — the
+// recap over the requesting user's saved shows. Registered on rc.Protected.
+func (h *ChartsHandler) GetPersonalYearInReviewHandler(ctx context.Context, req *GetYearInReviewRequest) (*GetYearInReviewResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+	year, yearErr := normalizeYearInReviewYear(req.Year)
+	if yearErr != nil {
+		return nil, yearErr
+	}
+
+	data, err := h.chartsService.GetPersonalYearInReview(user.ID, year)
+	if err != nil {
+		logger.FromContext(ctx).Error("charts_personal_year_in_review_failed",
+			"user_id", user.ID,
+			"year", year,
+			"error", err.Error(),
+		)
+		return nil, huma.Error500InternalServerError("Failed to get personal year in review")
+	}
+	return &GetYearInReviewResponse{CacheControl: "no-store", Body: data}, nil
+}
+
 // --- Helpers ---
 
 // normalizeChartWindow maps the optional window query param to a ChartWindow.
@@ -1316,6 +1407,20 @@ func normalizeChartWindow(window string) (contracts.ChartWindow, error) {
 	return normalized, nil
 }
 
+// normalizeYearInReviewYear defaults an absent year to the current UTC year
+// and rejects future years (nothing to recap yet). Unlike chart windows,
+// pre-launch years are allowed: the show archive predates the charts.
+func normalizeYearInReviewYear(year int) (int, error) {
+	current := time.Now().UTC().Year()
+	if year == 0 {
+		return current, nil
+	}
+	if year > current {
+		return 0, huma.Error422UnprocessableEntity("year is in the future")
+	}
+	return year, nil
+}
+
 // normalizeChartsLimit clamps the limit param to a valid range [1, 50],
 // defaulting to 20. LEGACY endpoints only (trending/popular/active/hot +
 // summary/ticker/overview) — the paginated module endpoints rely on their
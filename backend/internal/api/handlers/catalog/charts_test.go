@@ -1016,6 +1016,46 @@ func TestChartsHandler_CommunityPulse_ServiceError(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 500)
 }
 
+func TestChartsHandler_PublicStats_Success(t *testing.T) {
+	h := NewChartsHandler(&testhelpers.MockChartsService{
+		GetPublicStatsFn: func() (*contracts.PublicStats, error) {
+			return &contracts.PublicStats{
+				UpcomingShows: 312,
+				Venues:        58,
+				Artists:       2140,
+				ShowsThisWeekByCity: []contracts.CityShowCount{
+					{City: "Phoenix", State: "AZ", Shows: 41},
+					{City: "Tucson", State: "AZ", Shows: 9},
+				},
+			}, nil
+		},
+	})
+
+	resp, err := h.GetPublicStatsHandler(context.Background(), &GetPublicStatsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.CacheControl != chartsMastheadCacheControl {
+		t.Errorf("expected masthead Cache-Control, got %q", resp.CacheControl)
+	}
+	if resp.Body.UpcomingShows != 312 || resp.Body.Venues != 58 || resp.Body.Artists != 2140 {
+		t.Errorf("unexpected mapping: %+v", resp.Body)
+	}
+	if len(resp.Body.ShowsThisWeekByCity) != 2 || resp.Body.ShowsThisWeekByCity[0].City != "Phoenix" {
+		t.Errorf("unexpected cities: %+v", resp.Body.ShowsThisWeekByCity)
+	}
+}
+
+func TestChartsHandler_PublicStats_ServiceError(t *testing.T) {
+	h := NewChartsHandler(&testhelpers.MockChartsService{
+		GetPublicStatsFn: func() (*contracts.PublicStats, error) {
+			return nil, fmt.Errorf("db exploded")
+		},
+	})
+	_, err := h.GetPublicStatsHandler(context.Background(), &GetPublicStatsRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
 // TestChartsHandler_Summary_InvalidWindow422 exercises the huma enum-tag
 // validation chain (dead-validate-tag gotcha: assert the 422).
 func TestChartsHandler_Summary_InvalidWindow422(t *testing.T) {
@@ -1603,3 +1643,108 @@ func TestChartsHandler_ChartEntityRank_InvalidParams422(t *testing.T) {
 		t.Errorf("expected 422 for window=bogus, got %d", resp.Code)
 	}
 }
+
+// ============================================================================
+// Tests: GetYearInReviewHandler / GetPersonalYearInReviewHandler
+// ============================================================================
+
+func TestChartsHandler_YearInReview_DefaultsToCurrentYear(t *testing.T) {
+	var receivedYear int
+	h := NewChartsHandler(&testhelpers.MockChartsService{
+		GetYearInReviewFn: func(year int) (*contracts.YearInReview, error) {
+			receivedYear = year
+			return &contracts.YearInReview{
+				Year:         year,
+				TotalShows:   12,
+				BusiestMonth: &contracts.YearInReviewMonth{Month: 3, ShowCount: 5},
+				Cities:       []contracts.YearInReviewCity{{City: "Phoenix", State: "AZ", ShowCount: 12}},
+			}, nil
+		},
+	})
+
+	resp, err := h.GetYearInReviewHandler(context.Background(), &GetYearInReviewRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Now().UTC().Year(); receivedYear != want {
+		t.Errorf("expected default year %d, got %d", want, receivedYear)
+	}
+	if resp.CacheControl != chartsModuleCacheControl {
+		t.Errorf("expected module Cache-Control, got %q", resp.CacheControl)
+	}
+	if resp.Body.TotalShows != 12 || resp.Body.BusiestMonth.Month != 3 || len(resp.Body.Cities) != 1 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestChartsHandler_YearInReview_PastYearForwarded(t *testing.T) {
+	var receivedYear int
+	h := NewChartsHandler(&testhelpers.MockChartsService{
+		GetYearInReviewFn: func(year int) (*contracts.YearInReview, error) {
+			receivedYear = year
+			return &contracts.YearInReview{Year: year, Cities: []contracts.YearInReviewCity{}}, nil
+		},
+	})
+
+	if _, err := h.GetYearInReviewHandler(context.Background(), &GetYearInReviewRequest{Year: 2019}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedYear != 2019 {
+		t.Errorf("expected pre-launch year 2019 forwarded, got %d", receivedYear)
+	}
+}
+
+func TestChartsHandler_YearInReview_FutureYear(t *testing.T) {
+	h := NewChartsHandler(&testhelpers.MockChartsService{})
+
+	_, err := h.GetYearInReviewHandler(context.Background(), &GetYearInReviewRequest{Year: time.Now().UTC().Year() + 1})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestChartsHandler_YearInReview_ServiceError(t *testing.T) {
+	h := NewChartsHandler(&testhelpers.MockChartsService{
+		GetYearInReviewFn: func(int) (*contracts.YearInReview, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	})
+
+	_, err := h.GetYearInReviewHandler(context.Background(), &GetYearInReviewRequest{Year: 2025})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestChartsHandler_PersonalYearInReview_NoAuth(t *testing.T) {
+	h := NewChartsHandler(&testhelpers.MockChartsService{})
+
+	_, err := h.GetPersonalYearInReviewHandler(context.Background(), &GetYearInReviewRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestChartsHandler_PersonalYearInReview_Success(t *testing.T) {
+	var receivedUserID uint
+	var receivedYear int
+	h := NewChartsHandler(&testhelpers.MockChartsService{
+		GetPersonalYearInReviewFn: func(userID uint, year int) (*contracts.YearInReview, error) {
+			receivedUserID, receivedYear = userID, year
+			return &contracts.YearInReview{
+				Year:            year,
+				TotalShows:      3,
+				MostActiveVenue: &contracts.YearInReviewVenue{ID: 3, Name: "Valley Bar", Slug: "valley-bar", ShowCount: 2},
+				Cities:          []contracts.YearInReviewCity{},
+			}, nil
+		},
+	})
+
+	resp, err := h.GetPersonalYearInReviewHandler(personalStatsCtx(42), &GetYearInReviewRequest{Year: 2025})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedUserID != 42 || receivedYear != 2025 {
+		t.Errorf("unexpected args: user=%d year=%d", receivedUserID, receivedYear)
+	}
+	if resp.CacheControl != "no-store" {
+		t.Errorf("expected no-store for the per-user recap, got %q", resp.CacheControl)
+	}
+	if resp.Body.MostActiveVenue == nil || resp.Body.MostActiveVenue.Name != "Valley Bar" {
+		t.Errorf("unexpected venue: %+v", resp.Body.MostActiveVenue)
+	}
+}
@@ -0,0 +1,83 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// maxResolveRefs caps one batched resolve call (IDs + slugs combined).
+const maxResolveRefs = 100
+
+type entityResolveService interface {
+	Resolve(entityType string, ids []uint, slugs []string) ([]contracts.EntityResolution, error)
+}
+
+// EntityResolveHandler maps legacy numeric-ID links to canonical slugs (and
+// back) so old links and external embeds keep working.
+type EntityResolveHandler struct {
+	entityResolveService entityResolveService
+}
+
+func NewEntityResolveHandler(entityResolveService entityResolveService) *EntityResolveHandler {
+	return &EntityResolveHandler{entityResolveService: entityResolveService}
+}
+
+type ResolveEntitiesRequest struct {
+	Type string `query:"type" enum:"show,venue,artist,release,label,festival,tag" doc:"Entity type"`
+	ID   string `query:"id" required:"false" doc:"Comma-separated numeric IDs to resolve" example:"123,456"`
+	Slug string `query:"slug" required:"false" doc:"Comma-separated slugs to resolve" example:"the-band-live-2026-03-09"`
+}
+
+type ResolveEntitiesResponse struct {
+	Body struct {
+		Type    string                       `json:"type"`
+		Results []contracts.EntityResolution `json:"results"`
+	}
+}
+
+// ResolveEntitiesHandler handles GET /resolve
+func (h *EntityResolveHandler) ResolveEntitiesHandler(ctx context.Context, req *ResolveEntitiesRequest) (*ResolveEntitiesResponse, error) {
+	var ids []uint
+	for _, raw := range splitResolveList(req.ID) {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil || id == 0 {
+			return nil, huma.Error400BadRequest(fmt.Sprintf("Invalid id %q", raw))
+		}
+		ids = append(ids, uint(id))
+	}
+	slugs := splitResolveList(req.Slug)
+
+	if len(ids)+len(slugs) == 0 {
+		return nil, huma.Error422UnprocessableEntity("At least one id or slug is required")
+	}
+	if len(ids)+len(slugs) > maxResolveRefs {
+		return nil, huma.Error422UnprocessableEntity(fmt.Sprintf("At most %d ids and slugs may be resolved per request", maxResolveRefs))
+	}
+
+	results, err := h.entityResolveService.Resolve(req.Type, ids, slugs)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("Failed to resolve entities", err)
+	}
+
+	resp := &ResolveEntitiesResponse{}
+	resp.Body.Type = req.Type
+	resp.Body.Results = results
+	return resp, nil
+}
+
+// splitResolveList splits a comma-separated query value, dropping blanks.
+func splitResolveList(raw string) []string {
+	var out []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+type mockEntityResolveService struct {
+	gotType  string
+	gotIDs   []uint
+	gotSlugs []string
+	err      error
+}
+
+func (m *mockEntityResolveService) Resolve(entityType string, ids []uint, slugs []string) ([]contracts.EntityResolution, error) {
+	m.gotType, m.gotIDs, m.gotSlugs = entityType, ids, slugs
+	if m.err != nil {
+		return nil, m.err
+	}
+	results := make([]contracts.EntityResolution, 0, len(ids)+len(slugs))
+	for _, id := range ids {
+		results = append(results, contracts.EntityResolution{Query: fmt.Sprint(id), Found: true, ID: id})
+	}
+	for _, slug := range slugs {
+		results = append(results, contracts.EntityResolution{Query: slug})
+	}
+	return results, nil
+}
+
+func TestResolveEntitiesHandler_ParsesBatch(t *testing.T) {
+	svc := &mockEntityResolveService{}
+	h := NewEntityResolveHandler(svc)
+
+	resp, err := h.ResolveEntitiesHandler(context.Background(), &ResolveEntitiesRequest{
+		Type: "show",
+		ID:   "123, 456",
+		Slug: "some-show,",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if svc.gotType != "show" || len(svc.gotIDs) != 2 || svc.gotIDs[1] != 456 || len(svc.gotSlugs) != 1 {
+		t.Errorf("unexpected service call: type=%q ids=%v slugs=%v", svc.gotType, svc.gotIDs, svc.gotSlugs)
+	}
+	if resp.Body.Type != "show" || len(resp.Body.Results) != 3 {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestResolveEntitiesHandler_InvalidID(t *testing.T) {
+	h := NewEntityResolveHandler(&mockEntityResolveService{})
+
+	_, err := h.ResolveEntitiesHandler(context.Background(), &ResolveEntitiesRequest{Type: "artist", ID: "12,abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestResolveEntitiesHandler_RequiresReference(t *testing.T) {
+	h := NewEntityResolveHandler(&mockEntityResolveService{})
+
+	_, err := h.ResolveEntitiesHandler(context.Background(), &ResolveEntitiesRequest{Type: "venue"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestResolveEntitiesHandler_TooManyReferences(t *testing.T) {
+	h := NewEntityResolveHandler(&mockEntityResolveService{})
+	slugs := make([]string, maxResolveRefs+1)
+	for i := range slugs {
+		slugs[i] = fmt.Sprintf("slug-%d", i)
+	}
+
+	_, err := h.ResolveEntitiesHandler(context.Background(), &ResolveEntitiesRequest{Type: "label", Slug: strings.Join(slugs, ",")})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestResolveEntitiesHandler_ServiceError(t *testing.T) {
+	h := NewEntityResolveHandler(&mockEntityResolveService{err: fmt.Errorf("database down")})
+
+	_, err := h.ResolveEntitiesHandler(context.Background(), &ResolveEntitiesRequest{Type: "festival", ID: "1"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
@@ -0,0 +1,80 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// NearbyShowsHandler serves "shows near me" for anonymous visitors.
+type NearbyShowsHandler struct {
+	nearbyService contracts.NearbyShowsServiceInterface
+}
+
+// NewNearbyShowsHandler creates a new nearby shows handler.
+func NewNearbyShowsHandler(nearbyService contracts.NearbyShowsServiceInterface) *NearbyShowsHandler {
+	return &NearbyShowsHandler{nearbyService: nearbyService}
+}
+
+// GetNearbyShowsRequest represents the request for nearby shows.
+type GetNearbyShowsRequest struct {
+	Timezone string `query:"timezone" default:"UTC" doc:"IANA timezone (e.g., 'America/Phoenix'). Defaults to UTC."`
+	Limit    int    `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Number of shows (max 100). Defaults to 20."`
+
+	// clientIP is captured from the connection in Resolve. Like the rate
+	// limiters, it trusts r.RemoteAddr, so a proxy in front of the backend
+	// must preserve the client address there.
+	clientIP string
+}
+
+// Resolve captures the client IP for the lookup.
+func (r *GetNearbyShowsRequest) Resolve(ctx huma.Context) []error {
+	r.clientIP = hostFromRemoteAddr(ctx.RemoteAddr())
+	return nil
+}
+
+// hostFromRemoteAddr strips the port from a "host:port" RemoteAddr.
+func hostFromRemoteAddr(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// GetNearbyShowsResponse represents the response for nearby shows.
+type GetNearbyShowsResponse struct {
+	Body *contracts.NearbyShowsResponse
+}
+
+// GetNearbyShowsHandler handles GET /shows/nearby
+func (h *NearbyShowsHandler) GetNearbyShowsHandler(ctx context.Context, req *GetNearbyShowsRequest) (*GetNearbyShowsResponse, error) {
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	limit := req.Limit
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	resp, err := h.nearbyService.GetNearbyShows(req.clientIP, timezone, limit)
+	if err != nil {
+		requestID := logger.GetRequestID(ctx)
+		logger.FromContext(ctx).Error("shows_nearby_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to fetch nearby shows (request_id: %s)", requestID),
+		)
+	}
+	return &GetNearbyShowsResponse{Body: resp}, nil
+}
@@ -0,0 +1,77 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestGetNearbyShowsHandler_ForwardsIPTimezoneAndLimit(t *testing.T) {
+	var gotIP, gotTZ string
+	var gotLimit int
+	h := NewNearbyShowsHandler(&testhelpers.MockNearbyShowsService{
+		GetNearbyShowsFn: func(clientIP, timezone string, limit int) (*contracts.NearbyShowsResponse, error) {
+			gotIP, gotTZ, gotLimit = clientIP, timezone, limit
+			return &contracts.NearbyShowsResponse{Source: contracts.NearbySourceIP, Area: "Phoenix-Mesa-Chandler, AZ"}, nil
+		},
+	})
+
+	req := &GetNearbyShowsRequest{Timezone: "America/Phoenix", Limit: 500}
+	req.clientIP = hostFromRemoteAddr("203.0.113.9:51234")
+	resp, err := h.GetNearbyShowsHandler(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotIP != "203.0.113.9" || gotTZ != "America/Phoenix" || gotLimit != 100 {
+		t.Errorf("unexpected args: ip=%q tz=%q limit=%d", gotIP, gotTZ, gotLimit)
+	}
+	if resp.Body.Source != contracts.NearbySourceIP {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestGetNearbyShowsHandler_Defaults(t *testing.T) {
+	var gotTZ string
+	var gotLimit int
+	h := NewNearbyShowsHandler(&testhelpers.MockNearbyShowsService{
+		GetNearbyShowsFn: func(_ string, timezone string, limit int) (*contracts.NearbyShowsResponse, error) {
+			gotTZ, gotLimit = timezone, limit
+			return &contracts.NearbyShowsResponse{Source: contracts.NearbySourceDefault}, nil
+		},
+	})
+
+	if _, err := h.GetNearbyShowsHandler(context.Background(), &GetNearbyShowsRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotTZ != "UTC" || gotLimit != 20 {
+		t.Errorf("expected UTC/20 defaults, got %q/%d", gotTZ, gotLimit)
+	}
+}
+
+func TestGetNearbyShowsHandler_ServiceError(t *testing.T) {
+	h := NewNearbyShowsHandler(&testhelpers.MockNearbyShowsService{
+		GetNearbyShowsFn: func(string, string, int) (*contracts.NearbyShowsResponse, error) {
+			return nil, errors.New("db down")
+		},
+	})
+
+	_, err := h.GetNearbyShowsHandler(context.Background(), &GetNearbyShowsRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestHostFromRemoteAddr(t *testing.T) {
+	tests := map[string]string{
+		"203.0.113.9:443":   "203.0.113.9",
+		"[2001:db8::1]:443": "2001:db8::1",
+		"203.0.113.9":       "203.0.113.9",
+		"":                  "",
+	}
+	for in, want := range tests {
+		if got := hostFromRemoteAddr(in); got != want {
+			t.Errorf("hostFromRemoteAddr(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm"
 
 	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/config"
 	"psychic-homily-backend/internal/logger"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
@@ -31,7 +32,7 @@ type RadioPlayMatchSuggestionHandler struct {
 	emailService contracts.EmailServiceInterface
 	frontendURL  string
 	backendURL   string
-	jwtSecret    string
+	keys         *config.JWTKeyRing
 }
 
 // NewRadioPlayMatchSuggestionHandler wires the suggestion service + audit log.
@@ -50,13 +51,14 @@ func NewRadioPlayMatchSuggestionHandler(
 func (h *RadioPlayMatchSuggestionHandler) SetApprovalEmailDeps(
 	db *gorm.DB,
 	emailService contracts.EmailServiceInterface,
-	frontendURL, backendURL, jwtSecret string,
+	frontendURL, backendURL string,
+	keys *config.JWTKeyRing,
 ) {
 	h.db = db
 	h.emailService = emailService
 	h.frontendURL = frontendURL
 	h.backendURL = backendURL
-	h.jwtSecret = jwtSecret
+	h.keys = keys
 }
 
 // ──────────────────────────────────────────────
@@ -339,7 +341,7 @@ func (h *RadioPlayMatchSuggestionHandler) sendApprovalEmail(result *contracts.Ra
 
 	username := servicesshared.ResolveUserName(&user)
 	unsubURL := engagement.GenerateScopedUnsubscribeURL(
-		h.backendURL, user.ID, engagement.UnsubscribeScopeEditNotifications, h.jwtSecret,
+		h.backendURL, user.ID, engagement.UnsubscribeScopeEditNotifications, h.keys.Current().Secret,
 	)
 	if err := h.emailService.SendEditApprovedEmail(
 		*user.Email, username, "artist", entityName, entityURL, unsubURL,
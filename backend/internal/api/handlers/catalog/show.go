@@ -15,6 +15,9 @@ import (
 	"psychic-homily-backend/internal/api/middleware"
 	apperrors "psychic-homily-backend/internal/errors"
 	"psychic-homily-backend/internal/logger"
+	adminm "psychic-homily-backend/internal/models/admin"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
 	"psychic-homily-backend/internal/respond"
 	"psychic-homily-backend/internal/services/contracts"
 	servicesshared "psychic-homily-backend/internal/services/shared"
@@ -34,6 +37,24 @@ type ShowHandler struct {
 	// path (PSY-563). May be nil in tests; production wiring lives in
 	// routes/shows.go and admin/shows.go.
 	revisionService contracts.RevisionServiceInterface
+	// showDraftService deletes the draft a submission was made from. Optional;
+	// see SetShowDraftService.
+	showDraftService contracts.ShowDraftServiceInterface
+	// submissionThrottle enforces submission caps and holds quarantined
+	// users' shows for review. Optional; see SetSubmissionThrottle.
+	submissionThrottle contracts.SubmissionThrottleServiceInterface
+	// auditLogService flags reputation-based auto-approvals. Optional; see
+	// SetAuditLogService.
+	auditLogService contracts.AuditLogServiceInterface
+	// venueClaimService lets managers of a show's venue flip its sold-out
+	// and cancelled flags. Optional; see SetVenueClaimService.
+	venueClaimService contracts.VenueClaimServiceInterface
+	// showChangeNotifier tells savers and RSVPs when a show is cancelled or
+	// postponed. Optional; see SetShowChangeNotifier.
+	showChangeNotifier contracts.ShowChangeNotifierInterface
+	// showRSVPService fills going_count on show reads. Optional; see
+	// SetShowRSVPService.
+	showRSVPService contracts.ShowRSVPServiceInterface
 }
 
 // NewShowHandler creates a new show handler
@@ -57,12 +78,68 @@ func NewShowHandler(
 	}
 }
 
+// SetVenueClaimService wires venue-manager permissions on the show status
+// flags. Nil-safe: when unset, only admins and the submitter may flip them.
+func (h *ShowHandler) SetVenueClaimService(venueClaimService contracts.VenueClaimServiceInterface) {
+	h.venueClaimService = venueClaimService
+}
+
+// SetShowDraftService wires draft promotion on submit. Nil-safe: when unset,
+// a submitted draft_id is ignored.
+func (h *ShowHandler) SetShowDraftService(showDraftService contracts.ShowDraftServiceInterface) {
+	h.showDraftService = showDraftService
+}
+
+// SetSubmissionThrottle wires per-user submission caps and quarantine holds.
+// Nil-safe: when unset, submissions are not throttled.
+func (h *ShowHandler) SetSubmissionThrottle(submissionThrottle contracts.SubmissionThrottleServiceInterface) {
+	h.submissionThrottle = submissionThrottle
+}
+
+// SetShowRSVPService wires going counts on show reads. Nil-safe: when unset,
+// going_count is omitted.
+func (h *ShowHandler) SetShowRSVPService(showRSVPService contracts.ShowRSVPServiceInterface) {
+	h.showRSVPService = showRSVPService
+}
+
+// withGoingCounts sets GoingCount on shows with one batch lookup. Like
+// LastEditedAt, a failed lookup leaves the counts unset rather than failing
+// the read.
+func (h *ShowHandler) withGoingCounts(ctx context.Context, shows ...*contracts.ShowResponse) {
+	if h.showRSVPService == nil || len(shows) == 0 {
+		return
+	}
+	ids := make([]uint, len(shows))
+	for i, show := range shows {
+		ids[i] = show.ID
+	}
+	counts, err := h.showRSVPService.GetBatchGoingCounts(ids)
+	if err != nil {
+		logger.FromContext(ctx).Warn("show_going_counts_failed",
+			"count", len(ids),
+			"error", err.Error(),
+		)
+		return
+	}
+	for _, show := range shows {
+		count := counts[show.ID]
+		show.GoingCount = &count
+	}
+}
+
+// SetAuditLogService wires the audit log. Nil-safe: when unset, auto-approvals
+// are only logged.
+func (h *ShowHandler) SetAuditLogService(auditLogService contracts.AuditLogServiceInterface) {
+	h.auditLogService = auditLogService
+}
+
 // Artist represents an artist in a show request
 type Artist struct {
-	ID              *uint   `json:"id,omitempty"`
-	Name            *string `json:"name,omitempty"`
-	IsHeadliner     *bool   `json:"is_headliner,omitempty"`
-	InstagramHandle *string `json:"instagram_handle,omitempty"`
+	ID              *uint      `json:"id,omitempty"`
+	Name            *string    `json:"name,omitempty"`
+	IsHeadliner     *bool      `json:"is_headliner,omitempty"`
+	InstagramHandle *string    `json:"instagram_handle,omitempty"`
+	SetTime         *time.Time `json:"set_time,omitempty" doc:"When the artist goes on"`
 }
 
 // Venue represents a venue in a show request
@@ -89,14 +166,16 @@ func initializeArtist(a *Artist) {
 
 // CreateShowRequestBody represents the request body with preprocessing
 type CreateShowRequestBody struct {
-	Title          *string   `json:"title,omitempty" doc:"Show title (optional)"`
-	EventDate      time.Time `json:"event_date" validate:"required" doc:"Event date and time"`
-	City           string    `json:"city" doc:"City where the show takes place"`
-	State          string    `json:"state" doc:"State where the show takes place"`
-	Price          *float64  `json:"price,omitempty" doc:"Ticket price"`
-	AgeRequirement *string   `json:"age_requirement,omitempty" doc:"Age requirement (e.g., '21+', 'All Ages')"`
-	Description    *string   `json:"description,omitempty" doc:"Show description" required:"false"`
-	TicketURL      *string   `json:"ticket_url,omitempty" doc:"Ticket purchase URL" required:"false"`
+	Title          *string    `json:"title,omitempty" doc:"Show title (optional)"`
+	EventDate      time.Time  `json:"event_date" validate:"required" doc:"Event date and time"`
+	DoorsTime      *time.Time `json:"doors_time,omitempty" doc:"When doors open; must not be after event_date" required:"false"`
+	City           string     `json:"city,omitempty" doc:"City where the show takes place (defaults to the primary venue's city)" required:"false"`
+	State          string     `json:"state,omitempty" doc:"State where the show takes place (defaults to the primary venue's state)" required:"false"`
+	Price          *float64   `json:"price,omitempty" doc:"Single ticket price; 0 means free. Superseded by price_min, ignored when any structured price field is set" required:"false"`
+	AgeRequirement *string    `json:"age_requirement,omitempty" doc:"Age requirement (e.g., '21+', 'All Ages')"`
+	Description    *string    `json:"description,omitempty" doc:"Show description" required:"false"`
+	TicketURL      *string    `json:"ticket_url,omitempty" doc:"Ticket purchase URL" required:"false"`
+	TicketProvider *string    `json:"ticket_provider,omitempty" doc:"Ticket vendor: eventbrite, dice, seetickets, box_office, or other" required:"false"`
 	// NOTE: `validate:"..."` tags are NOT enforced here — huma reads its own schema
 	// tags (minItems/maxItems/...), not go-playground `validate`, and this repo wires
 	// no validator. The real per-field validation is the Resolve method below, where
@@ -104,6 +183,13 @@ type CreateShowRequestBody struct {
 	Venues    []Venue  `json:"venues" validate:"required,min=1" doc:"List of venues for the show"`
 	Artists   []Artist `json:"artists" validate:"required,min=1" doc:"List of artists in the show"`
 	IsPrivate *bool    `json:"is_private,omitempty" doc:"If true, show is private and only visible to submitter"`
+	DraftID   *uint    `json:"draft_id,omitempty" doc:"ID of the submitter's draft this show was completed from; the draft is removed once the show is created" required:"false"`
+
+	// Structured price
+	PriceMin      *float64 `json:"price_min,omitempty" doc:"Lowest ticket price; a single price sets only this" required:"false"`
+	PriceMax      *float64 `json:"price_max,omitempty" doc:"Highest ticket price, for a range (requires price_min)" required:"false"`
+	PriceCurrency *string  `json:"price_currency,omitempty" doc:"ISO 4217 currency code (default USD)" required:"false"`
+	IsFree        *bool    `json:"is_free,omitempty" doc:"Free or donation entry; price_min/price_max are then the suggested donation" required:"false"`
 }
 
 // Artist/venue count caps for a single show (PSY-1267). These bound
@@ -117,88 +203,85 @@ const (
 	maxShowVenues  = 10
 )
 
+// ticketProviderError rejects a ticket_provider outside the known vendors.
+// Empty is accepted (it clears the field on update).
+func ticketProviderError(provider string) error {
+	if catalogm.IsValidTicketProvider(provider) {
+		return nil
+	}
+	return fmt.Errorf("Ticket provider must be one of: %s", strings.Join(catalogm.TicketProviders, ", "))
+}
+
+// hasStructuredPrice reports whether a show request sets any structured
+// price field. When none is set the legacy single price applies.
+func hasStructuredPrice(min, max *float64, currency *string, isFree *bool) bool {
+	return min != nil || max != nil || currency != nil || isFree != nil
+}
+
+// normalizePriceCurrency upper-cases a requested currency code; nil stays nil.
+func normalizePriceCurrency(currency *string) *string {
+	if currency == nil {
+		return nil
+	}
+	code := strings.ToUpper(strings.TrimSpace(*currency))
+	return &code
+}
+
 // Resolve implements preprocessing and validation for the request body
 func (r *CreateShowRequestBody) Resolve(ctx huma.Context) []error {
-	var errors []error
+	var v shared.Validator
 
 	// PSY-1267: cap the array sizes before the per-element loops below (so an
 	// oversized payload is rejected up front, not looped over).
 	if len(r.Artists) > maxShowArtists {
-		errors = append(errors, &huma.ErrorDetail{
-			Location: "body.artists",
-			Message:  fmt.Sprintf("A show may have at most %d artists", maxShowArtists),
-			Value:    len(r.Artists),
-		})
+		v.Add(shared.NewFieldError("artists", shared.ValidationCodeTooMany,
+			fmt.Sprintf("A show may have at most %d artists", maxShowArtists)).WithValue(len(r.Artists)))
 	}
 	if len(r.Venues) > maxShowVenues {
-		errors = append(errors, &huma.ErrorDetail{
-			Location: "body.venues",
-			Message:  fmt.Sprintf("A show may have at most %d venues", maxShowVenues),
-			Value:    len(r.Venues),
-		})
+		v.Add(shared.NewFieldError("venues", shared.ValidationCodeTooMany,
+			fmt.Sprintf("A show may have at most %d venues", maxShowVenues)).WithValue(len(r.Venues)))
 	}
 
 	// Validate text field lengths
-	if r.Title != nil && len(*r.Title) > 255 {
-		errors = append(errors, &huma.ErrorDetail{
-			Location: "body.title",
-			Message:  "Title must be 255 characters or fewer",
-			Value:    len(*r.Title),
-		})
-	}
-	if r.Description != nil && len(*r.Description) > 5000 {
-		errors = append(errors, &huma.ErrorDetail{
-			Location: "body.description",
-			Message:  "Description must be 5000 characters or fewer",
-			Value:    len(*r.Description),
-		})
-	}
-	if r.AgeRequirement != nil && len(*r.AgeRequirement) > 50 {
-		errors = append(errors, &huma.ErrorDetail{
-			Location: "body.age_requirement",
-			Message:  "Age requirement must be 50 characters or fewer",
-			Value:    len(*r.AgeRequirement),
-		})
-	}
+	v.MaxLength("title", r.Title, 255, "Title must be 255 characters or fewer")
+	v.MaxLength("description", r.Description, 5000, "Description must be 5000 characters or fewer")
+	v.MaxLength("age_requirement", r.AgeRequirement, 50, "Age requirement must be 50 characters or fewer")
 
 	// PSY-747: ticket URL is length-capped AND scheme-validated (http/https
 	// only) — previously it accepted javascript:/data: on a public show.
 	if r.TicketURL != nil {
 		if err := shared.URLSchemeError("ticket_url", *r.TicketURL); err != nil {
-			errors = append(errors, &huma.ErrorDetail{
-				Location: "body.ticket_url",
-				Message:  err.Error(),
-				Value:    *r.TicketURL,
-			})
+			v.Add(shared.NewFieldError("ticket_url", shared.ValidationCodeInvalidFormat, err.Error()).WithValue(*r.TicketURL))
+		}
+	}
+	if r.TicketProvider != nil {
+		if err := ticketProviderError(*r.TicketProvider); err != nil {
+			v.Add(shared.NewFieldError("ticket_provider", shared.ValidationCodeInvalidValue, err.Error()).WithValue(*r.TicketProvider))
 		}
 	}
 
+	if r.DoorsTime != nil && r.DoorsTime.After(r.EventDate) {
+		v.Add(shared.NewFieldError("doors_time", shared.ValidationCodeOutOfRange,
+			"Doors time must not be after the event date").WithValue(*r.DoorsTime))
+	}
+
 	// Validate price range
 	if r.Price != nil && (*r.Price < 0 || *r.Price > 10000) {
-		errors = append(errors, &huma.ErrorDetail{
-			Location: "body.price",
-			Message:  "Price must be between 0 and 10000",
-			Value:    *r.Price,
-		})
+		v.Add(shared.NewFieldError("price", shared.ValidationCodeOutOfRange,
+			"Price must be between 0 and 10000").WithValue(*r.Price))
 	}
+	r.PriceCurrency = normalizePriceCurrency(r.PriceCurrency)
+	v.CheckErr("price_min", shared.ValidationCodeInvalidValue,
+		utils.ValidatePrice(r.PriceMin, r.PriceMax, shared.Deref(r.PriceCurrency)))
 
 	// Validate venues
 	for i := range r.Venues {
 		venue := &r.Venues[i]
 		if (venue.ID == nil || *venue.ID == 0) && (venue.Name == nil || *venue.Name == "") {
-			errors = append(errors, &huma.ErrorDetail{
-				Location: fmt.Sprintf("body.venues[%d]", i),
-				Message:  "Either 'id' or 'name' must be provided",
-				Value:    venue,
-			})
-		}
-		if venue.Name != nil && len(*venue.Name) > 255 {
-			errors = append(errors, &huma.ErrorDetail{
-				Location: fmt.Sprintf("body.venues[%d].name", i),
-				Message:  "Venue name must be 255 characters or fewer",
-				Value:    len(*venue.Name),
-			})
+			v.Add(shared.NewFieldError(fmt.Sprintf("venues[%d]", i), shared.ValidationCodeRequired,
+				"Either 'id' or 'name' must be provided").WithValue(venue))
 		}
+		v.MaxLength(fmt.Sprintf("venues[%d].name", i), venue.Name, 255, "Venue name must be 255 characters or fewer")
 	}
 
 	// Preprocess and validate artists
@@ -207,19 +290,10 @@ func (r *CreateShowRequestBody) Resolve(ctx huma.Context) []error {
 
 		artist := &r.Artists[i]
 		if (artist.ID == nil || *artist.ID == 0) && (artist.Name == nil || *artist.Name == "") {
-			errors = append(errors, &huma.ErrorDetail{
-				Location: fmt.Sprintf("body.artists[%d]", i),
-				Message:  "Either 'id' or 'name' must be provided",
-				Value:    artist,
-			})
-		}
-		if artist.Name != nil && len(*artist.Name) > 255 {
-			errors = append(errors, &huma.ErrorDetail{
-				Location: fmt.Sprintf("body.artists[%d].name", i),
-				Message:  "Artist name must be 255 characters or fewer",
-				Value:    len(*artist.Name),
-			})
+			v.Add(shared.NewFieldError(fmt.Sprintf("artists[%d]", i), shared.ValidationCodeRequired,
+				"Either 'id' or 'name' must be provided").WithValue(artist))
 		}
+		v.MaxLength(fmt.Sprintf("artists[%d].name", i), artist.Name, 255, "Artist name must be 255 characters or fewer")
 		// PSY-1118: instagram_handle must be a bare handle, not a URL — a
 		// URL-shaped value bypassed the PSY-1113 social-host anchor and rendered
 		// as an off-platform SocialLinks href. The service re-validates +
@@ -228,16 +302,13 @@ func (r *CreateShowRequestBody) Resolve(ctx huma.Context) []error {
 		// field-located 422 instead of the generic show-create error.
 		if artist.InstagramHandle != nil && *artist.InstagramHandle != "" {
 			if _, err := utils.NormalizeInstagramHandle(*artist.InstagramHandle); err != nil {
-				errors = append(errors, &huma.ErrorDetail{
-					Location: fmt.Sprintf("body.artists[%d].instagram_handle", i),
-					Message:  err.Error(),
-					Value:    *artist.InstagramHandle,
-				})
+				v.Add(shared.NewFieldError(fmt.Sprintf("artists[%d].instagram_handle", i),
+					shared.ValidationCodeInvalidFormat, err.Error()).WithValue(*artist.InstagramHandle))
 			}
 		}
 	}
 
-	return errors
+	return v.Errors()
 }
 
 // CreateShowRequest represents the HTTP request for creating a show
@@ -268,6 +339,9 @@ type GetShowsRequest struct {
 	ToDate   time.Time `query:"to_date" doc:"Filter shows until this date"`
 	Tags     string    `query:"tags" doc:"Comma-separated tag slugs. Multi-tag filter (PSY-309): AND by default; set tag_match=any for OR." example:"post-punk,phoenix"`
 	TagMatch string    `query:"tag_match" doc:"Tag matching mode: 'all' (default, AND) or 'any' (OR)" example:"all" enum:"all,any"`
+	Genre    string    `query:"genre" doc:"Comma-separated genre slugs. Matches any of them, including subgenres; shows match through their lineup." example:"post-punk,shoegaze"`
+	AllAges  string    `query:"all_ages" doc:"Only shows at a venue flagged all-ages (true) or not all-ages (false). Venues with no flag set never match." enum:"true,false"`
+	Metro    string    `query:"metro" required:"false" pattern:"^[0-9]{1,10}$" doc:"Only shows at a venue in this CBSA metro (the metro code from /shows/cities), e.g. 38060 for Phoenix, Tempe, Mesa and Scottsdale" example:"38060"`
 }
 
 // SearchShowsRequest represents the autocomplete search request for shows.
@@ -294,14 +368,71 @@ type GetShowsResponse struct {
 
 // GetUpcomingShowsRequest represents the HTTP request for listing upcoming shows
 type GetUpcomingShowsRequest struct {
-	Timezone string `query:"timezone" default:"UTC" doc:"IANA timezone (e.g., 'America/Phoenix', 'America/New_York'). Defaults to UTC."`
-	Cursor   string `query:"cursor" doc:"Pagination cursor from previous response. Omit for first page."`
-	Limit    int    `query:"limit" default:"50" minimum:"1" maximum:"200" doc:"Number of shows per page (max 200). Defaults to 50."`
-	City     string `query:"city" doc:"Filter by city name (exact match). Legacy — prefer 'cities' param."`
-	State    string `query:"state" doc:"Filter by state code (exact match, e.g., 'AZ'). Legacy — prefer 'cities' param."`
-	Cities   string `query:"cities" doc:"Filter by multiple cities. Pipe-delimited pairs: 'Phoenix,AZ|Mesa,AZ|Tucson,AZ'. Max 10 cities."`
-	Tags     string `query:"tags" doc:"Comma-separated tag slugs. Multi-tag filter (PSY-309): AND by default; set tag_match=any for OR." example:"post-punk,phoenix"`
-	TagMatch string `query:"tag_match" doc:"Tag matching mode: 'all' (default, AND) or 'any' (OR)" example:"all" enum:"all,any"`
+	Timezone string  `query:"timezone" default:"UTC" doc:"IANA timezone (e.g., 'America/Phoenix', 'America/New_York'). Defaults to UTC."`
+	Cursor   string  `query:"cursor" doc:"Pagination cursor from previous response. Omit for first page."`
+	Limit    int     `query:"limit" default:"50" minimum:"1" maximum:"200" doc:"Number of shows per page (max 200). Defaults to 50."`
+	City     string  `query:"city" doc:"Filter by city name (exact match). Legacy — prefer 'cities' param."`
+	State    string  `query:"state" doc:"Filter by state code (exact match, e.g., 'AZ'). Legacy — prefer 'cities' param."`
+	Cities   string  `query:"cities" doc:"Filter by multiple cities. Pipe-delimited pairs: 'Phoenix,AZ|Mesa,AZ|Tucson,AZ'. Max 10 cities."`
+	Tags     string  `query:"tags" doc:"Comma-separated tag slugs. Multi-tag filter (PSY-309): AND by default; set tag_match=any for OR." example:"post-punk,phoenix"`
+	TagMatch string  `query:"tag_match" doc:"Tag matching mode: 'all' (default, AND) or 'any' (OR)" example:"all" enum:"all,any"`
+	Genre    string  `query:"genre" doc:"Comma-separated genre slugs. Matches any of them, including subgenres; shows match through their lineup." example:"post-punk,shoegaze"`
+	Near     string  `query:"near" doc:"Only shows at a venue within radius_km of this point, as 'lat,lng'. Venues without coordinates are excluded." example:"33.4484,-112.0740"`
+	RadiusKm float64 `query:"radius_km" minimum:"0" maximum:"500" doc:"Search radius in km for 'near' (default 40, max 500)"`
+	AllAges  string  `query:"all_ages" doc:"Only shows at a venue flagged all-ages (true) or not all-ages (false). Venues with no flag set never match." enum:"true,false"`
+	MaxPrice string  `query:"max_price" doc:"Only free shows and shows whose lowest price is at most this amount (0 = free only). Shows with no price never match." example:"20"`
+	Metro    string  `query:"metro" required:"false" pattern:"^[0-9]{1,10}$" doc:"Only shows at a venue in this CBSA metro (the metro code from /shows/cities), e.g. 38060 for Phoenix, Tempe, Mesa and Scottsdale" example:"38060"`
+}
+
+// defaultNearRadiusKm is the radius used when 'near' is given without
+// radius_km — roughly a metro area.
+const defaultNearRadiusKm = 40
+
+// parseNearFilter parses the 'near=lat,lng' and 'radius_km' query params.
+// Empty near returns nil (no distance filter).
+func parseNearFilter(near string, radiusKm float64) (*contracts.NearFilter, error) {
+	if near == "" {
+		if radiusKm != 0 {
+			return nil, fmt.Errorf("radius_km requires near")
+		}
+		return nil, nil
+	}
+	parts := strings.Split(near, ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("near must be 'lat,lng'")
+	}
+	lat, latErr := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lng, lngErr := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if latErr != nil || lngErr != nil || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return nil, fmt.Errorf("near must be 'lat,lng' with latitude in [-90, 90] and longitude in [-180, 180]")
+	}
+	if radiusKm == 0 {
+		radiusKm = defaultNearRadiusKm
+	}
+	return &contracts.NearFilter{Latitude: lat, Longitude: lng, RadiusKm: radiusKm}, nil
+}
+
+// parseAllAgesFilter parses the 'all_ages' query param. Empty returns nil
+// (no all-ages filter); huma's enum tag has already rejected anything else.
+func parseAllAgesFilter(allAges string) *bool {
+	if allAges == "" {
+		return nil
+	}
+	v := allAges == "true"
+	return &v
+}
+
+// parseMaxPriceFilter parses the 'max_price' query param. Empty returns nil
+// (no price filter).
+func parseMaxPriceFilter(maxPrice string) (*float64, error) {
+	if maxPrice == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(maxPrice), 64)
+	if err != nil || v < 0 || v > utils.MaxPrice {
+		return nil, fmt.Errorf("max_price must be a number between 0 and %d", utils.MaxPrice)
+	}
+	return &v, nil
 }
 
 // GetShowCitiesRequest represents the HTTP request for listing show cities
@@ -339,12 +470,14 @@ type UpdateShowRequest struct {
 	Body   struct {
 		Title          *string    `json:"title,omitempty" doc:"Show title"`
 		EventDate      *time.Time `json:"event_date,omitempty" doc:"Event date and time"`
+		DoorsTime      *time.Time `json:"doors_time,omitempty" doc:"When doors open; must not be after event_date" required:"false"`
 		City           *string    `json:"city,omitempty" doc:"City where the show takes place"`
 		State          *string    `json:"state,omitempty" doc:"State where the show takes place"`
-		Price          *float64   `json:"price,omitempty" doc:"Ticket price"`
+		Price          *float64   `json:"price,omitempty" doc:"Single ticket price; 0 means free. Superseded by price_min, ignored when any structured price field is set" required:"false"`
 		AgeRequirement *string    `json:"age_requirement,omitempty" doc:"Age requirement"`
 		Description    *string    `json:"description,omitempty" doc:"Show description" required:"false"`
 		TicketURL      *string    `json:"ticket_url,omitempty" doc:"Ticket purchase URL" required:"false"`
+		TicketProvider *string    `json:"ticket_provider,omitempty" doc:"Ticket vendor: eventbrite, dice, seetickets, box_office, or other (empty clears)" required:"false"`
 		ImageURL       *string    `json:"image_url,omitempty" doc:"Show flyer image URL" required:"false"`
 		Venues         []Venue    `json:"venues,omitempty" doc:"List of venues for the show"`
 		Artists        []Artist   `json:"artists,omitempty" doc:"List of artists for the show"`
@@ -353,6 +486,13 @@ type UpdateShowRequest struct {
 		// AdminUpdateArtistRequest.Body.Summary (PSY-563). Empty string =
 		// no reason supplied (revision still recorded if fields changed).
 		Summary *string `json:"summary,omitempty" doc:"Revision summary describing the change" required:"false"`
+
+		// Structured price. price_min and price_max are written together.
+		PriceMin      *float64 `json:"price_min,omitempty" doc:"Lowest ticket price; sent without price_max it makes a single price" required:"false"`
+		PriceMax      *float64 `json:"price_max,omitempty" doc:"Highest ticket price, for a range (requires price_min)" required:"false"`
+		PriceCurrency *string  `json:"price_currency,omitempty" doc:"ISO 4217 currency code" required:"false"`
+		IsFree        *bool    `json:"is_free,omitempty" doc:"Free or donation entry; price_min/price_max are then the suggested donation" required:"false"`
+		ClearPrice    bool     `json:"clear_price,omitempty" doc:"Clear price_min and price_max" required:"false"`
 	}
 }
 
@@ -404,6 +544,48 @@ func (h *ShowHandler) CreateShowHandler(ctx context.Context, req *CreateShowRequ
 		return nil, huma.Error403Forbidden("Email verification required to submit shows. Please verify your email address in Settings.")
 	}
 
+	// Enforce the daily cap; burst or quarantined submitters are held for review
+	holdForReview := false
+	if user != nil && h.submissionThrottle != nil {
+		decision, err := h.submissionThrottle.CheckSubmission(user)
+		if err != nil {
+			if mapped := shared.MapSubmissionError(err); mapped != nil {
+				logger.FromContext(ctx).Warn("show_create_throttled",
+					"user_id", user.ID,
+					"error", err.Error(),
+					"request_id", requestID,
+				)
+				return nil, mapped
+			}
+			// Fail open: a throttle lookup failure shouldn't block submissions
+			logger.FromContext(ctx).Error("show_create_throttle_check_failed",
+				"user_id", user.ID,
+				"error", err.Error(),
+				"request_id", requestID,
+			)
+		} else if decision.Hold {
+			holdForReview = true
+			logger.FromContext(ctx).Info("show_create_held_for_review",
+				"user_id", user.ID,
+				"reason", decision.Reason,
+				"request_id", requestID,
+			)
+		}
+	}
+
+	// Submission reputation: high-trust submitters are auto-approved, low-trust
+	// submitters are held for review. A moderator's quarantine always holds.
+	isPrivate := shared.Deref(req.Body.IsPrivate)
+	autoApproved := false
+	if user != nil && !isPrivate && !user.Can(authm.PermissionModerateContent) {
+		switch user.SubmissionTrust() {
+		case authm.SubmissionTrustHigh:
+			autoApproved = !holdForReview
+		case authm.SubmissionTrustLow:
+			holdForReview = true
+		}
+	}
+
 	logger.FromContext(ctx).Debug("show_create_attempt",
 		"venue_count", len(req.Body.Venues),
 		"artist_count", len(req.Body.Artists),
@@ -436,6 +618,7 @@ func (h *ShowHandler) CreateShowHandler(ctx context.Context, req *CreateShowRequ
 			Name:            shared.Deref(artist.Name),
 			IsHeadliner:     artist.IsHeadliner,
 			InstagramHandle: artist.InstagramHandle,
+			SetTime:         artist.SetTime,
 		}
 	}
 
@@ -443,23 +626,35 @@ func (h *ShowHandler) CreateShowHandler(ctx context.Context, req *CreateShowRequ
 	description := shared.Deref(req.Body.Description)
 	ageRequirement := shared.Deref(req.Body.AgeRequirement)
 	ticketURL := shared.Deref(req.Body.TicketURL)
-	isPrivate := shared.Deref(req.Body.IsPrivate)
+	ticketProvider := shared.Deref(req.Body.TicketProvider)
 
 	// Convert request to service request with user context
 	serviceReq := &contracts.CreateShowRequest{
 		Title:             title,
 		EventDate:         req.Body.EventDate,
+		DoorsTime:         req.Body.DoorsTime,
 		City:              req.Body.City,
 		State:             req.Body.State,
-		Price:             req.Body.Price,
 		AgeRequirement:    ageRequirement,
 		Description:       description,
 		TicketURL:         ticketURL,
+		TicketProvider:    ticketProvider,
 		Venues:            serviceVenues,
 		Artists:           serviceArtists,
 		SubmittedByUserID: submittedByUserID,
 		SubmitterIsAdmin:  submitterIsAdmin,
 		IsPrivate:         isPrivate,
+		HoldForReview:     holdForReview,
+	}
+	if hasStructuredPrice(req.Body.PriceMin, req.Body.PriceMax, req.Body.PriceCurrency, req.Body.IsFree) {
+		serviceReq.PriceMin = req.Body.PriceMin
+		serviceReq.PriceMax = req.Body.PriceMax
+		serviceReq.PriceCurrency = shared.Deref(req.Body.PriceCurrency)
+		serviceReq.IsFree = shared.Deref(req.Body.IsFree)
+	} else if req.Body.Price != nil {
+		legacy := utils.LegacyPrice(*req.Body.Price)
+		serviceReq.PriceMin = legacy.Min
+		serviceReq.IsFree = legacy.IsFree
 	}
 
 	// Create show using service
@@ -480,9 +675,25 @@ func (h *ShowHandler) CreateShowHandler(ctx context.Context, req *CreateShowRequ
 		"show_id", show.ID,
 		"title", show.Title,
 		"status", show.Status,
+		"warnings", show.Warnings,
 		"request_id", requestID,
 	)
 
+	if autoApproved && show.Status == string(catalogm.ShowStatusApproved) {
+		logger.FromContext(ctx).Info("show_auto_approved",
+			"show_id", show.ID,
+			"user_id", user.ID,
+			"request_id", requestID,
+		)
+		if h.auditLogService != nil {
+			h.auditLogService.LogAction(user.ID, "auto_approve_show", "show", show.ID, map[string]interface{}{
+				"trust_tier":           authm.SubmissionTrustHigh,
+				"submissions_approved": user.SubmissionsApproved,
+				"submissions_rejected": user.SubmissionsRejected,
+			})
+		}
+	}
+
 	// Send Discord notification for new show submission
 	submitterEmail := ""
 	if user != nil && user.Email != nil {
@@ -497,6 +708,20 @@ func (h *ShowHandler) CreateShowHandler(ctx context.Context, req *CreateShowRequ
 		}
 	}
 
+	// The draft this submission came from has served its purpose
+	if req.Body.DraftID != nil && user != nil && h.showDraftService != nil {
+		if err := h.showDraftService.DeleteDraft(user.ID, *req.Body.DraftID); err != nil {
+			// Log but don't fail the request - show was created successfully
+			logger.FromContext(ctx).Warn("show_draft_promote_failed",
+				"show_id", show.ID,
+				"draft_id", *req.Body.DraftID,
+				"user_id", user.ID,
+				"error", err.Error(),
+				"request_id", requestID,
+			)
+		}
+	}
+
 	// Auto-save the show to the submitter's personal list
 	if submittedByUserID != nil {
 		if err := h.savedShowService.SaveShow(*submittedByUserID, show.ID); err != nil {
@@ -569,6 +794,8 @@ func (h *ShowHandler) GetShowHandler(ctx context.Context, req *GetShowRequest) (
 		}
 	}
 
+	h.withGoingCounts(ctx, show)
+
 	logger.FromContext(ctx).Debug("show_get_success",
 		"show_id", show.ID,
 		"slug", show.Slug,
@@ -615,6 +842,14 @@ func (h *ShowHandler) GetShowsHandler(ctx context.Context, req *GetShowsRequest)
 	if req.State != "" {
 		filters["state"] = req.State
 	}
+	if req.Metro != "" {
+		filters["metro"] = req.Metro
+	}
+	if req.City == "" && req.State == "" && req.Metro == "" {
+		if cities := middleware.CityScopeFromContext(ctx); len(cities) > 0 {
+			filters["cities"] = cities
+		}
+	}
 	if !req.FromDate.IsZero() {
 		filters["from_date"] = req.FromDate
 	}
@@ -624,6 +859,12 @@ func (h *ShowHandler) GetShowsHandler(ctx context.Context, req *GetShowsRequest)
 	if tf := parseTagFilter(req.Tags, req.TagMatch); tf.HasTags() {
 		filters["tag_filter"] = tf
 	}
+	if genres := parseGenreFilter(req.Genre); len(genres) > 0 {
+		filters["genre_slugs"] = genres
+	}
+	if allAges := parseAllAgesFilter(req.AllAges); allAges != nil {
+		filters["all_ages"] = *allAges
+	}
 
 	logger.FromContext(ctx).Debug("shows_list_attempt",
 		"filter_count", len(filters),
@@ -641,6 +882,8 @@ func (h *ShowHandler) GetShowsHandler(ctx context.Context, req *GetShowsRequest)
 		)
 	}
 
+	h.withGoingCounts(ctx, shows...)
+
 	logger.FromContext(ctx).Debug("shows_list_success",
 		"count", len(shows),
 	)
@@ -739,6 +982,18 @@ func (h *ShowHandler) GetUpcomingShowsHandler(ctx context.Context, req *GetUpcom
 			State: req.State,
 		}
 	}
+	if req.Metro != "" {
+		if filters == nil {
+			filters = &contracts.UpcomingShowsFilter{}
+		}
+		filters.Metro = req.Metro
+	}
+	if req.Cities == "" && req.City == "" && req.State == "" && req.Near == "" && req.Metro == "" {
+		// No location asked for: a city subdomain's cities are the default.
+		if cities := middleware.CityScopeFromContext(ctx); len(cities) > 0 {
+			filters = &contracts.UpcomingShowsFilter{Cities: cities}
+		}
+	}
 	if tf := parseTagFilter(req.Tags, req.TagMatch); tf.HasTags() {
 		if filters == nil {
 			filters = &contracts.UpcomingShowsFilter{}
@@ -746,6 +1001,38 @@ func (h *ShowHandler) GetUpcomingShowsHandler(ctx context.Context, req *GetUpcom
 		filters.TagSlugs = tf.TagSlugs
 		filters.TagMatchAny = tf.MatchAny
 	}
+	if genres := parseGenreFilter(req.Genre); len(genres) > 0 {
+		if filters == nil {
+			filters = &contracts.UpcomingShowsFilter{}
+		}
+		filters.GenreSlugs = genres
+	}
+	near, err := parseNearFilter(req.Near, req.RadiusKm)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	if near != nil {
+		if filters == nil {
+			filters = &contracts.UpcomingShowsFilter{}
+		}
+		filters.Near = near
+	}
+	if allAges := parseAllAgesFilter(req.AllAges); allAges != nil {
+		if filters == nil {
+			filters = &contracts.UpcomingShowsFilter{}
+		}
+		filters.AllAges = allAges
+	}
+	maxPrice, err := parseMaxPriceFilter(req.MaxPrice)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	if maxPrice != nil {
+		if filters == nil {
+			filters = &contracts.UpcomingShowsFilter{}
+		}
+		filters.MaxPrice = maxPrice
+	}
 
 	logger.FromContext(ctx).Debug("shows_upcoming_attempt",
 		"timezone", timezone,
@@ -755,6 +1042,10 @@ func (h *ShowHandler) GetUpcomingShowsHandler(ctx context.Context, req *GetUpcom
 		"city", req.City,
 		"state", req.State,
 		"cities", req.Cities,
+		"near", req.Near,
+		"metro", req.Metro,
+		"all_ages", req.AllAges,
+		"max_price", req.MaxPrice,
 	)
 
 	// Get upcoming shows using service (admins see all, others see only approved)
@@ -770,6 +1061,8 @@ func (h *ShowHandler) GetUpcomingShowsHandler(ctx context.Context, req *GetUpcom
 		)
 	}
 
+	h.withGoingCounts(ctx, shows...)
+
 	logger.FromContext(ctx).Debug("shows_upcoming_success",
 		"count", len(shows),
 		"has_more", nextCursor != nil,
@@ -807,13 +1100,13 @@ func (h *ShowHandler) UpdateShowHandler(ctx context.Context, req *UpdateShowRequ
 	// Resolve (it validates at the service chokepoint), and an update can create new
 	// artists too — so it amplifies outbound enrichment the same way. Reject an
 	// oversized payload up front.
-	if len(req.Body.Artists) > maxShowArtists {
-		return nil, huma.Error422UnprocessableEntity(
-			fmt.Sprintf("A show may have at most %d artists", maxShowArtists))
-	}
-	if len(req.Body.Venues) > maxShowVenues {
-		return nil, huma.Error422UnprocessableEntity(
-			fmt.Sprintf("A show may have at most %d venues", maxShowVenues))
+	var capCheck shared.Validator
+	capCheck.Check(len(req.Body.Artists) <= maxShowArtists, "artists", shared.ValidationCodeTooMany,
+		fmt.Sprintf("A show may have at most %d artists", maxShowArtists))
+	capCheck.Check(len(req.Body.Venues) <= maxShowVenues, "venues", shared.ValidationCodeTooMany,
+		fmt.Sprintf("A show may have at most %d venues", maxShowVenues))
+	if err := capCheck.Err(); err != nil {
+		return nil, err
 	}
 
 	// Early debug log to confirm handler is called
@@ -872,36 +1165,44 @@ func (h *ShowHandler) UpdateShowHandler(ctx context.Context, req *UpdateShowRequ
 		)
 	}
 
-	// Validate text field lengths
-	if req.Body.Title != nil && len(*req.Body.Title) > 255 {
-		return nil, huma.Error422UnprocessableEntity("Title must be 255 characters or fewer")
-	}
-	if req.Body.Description != nil && len(*req.Body.Description) > 5000 {
-		return nil, huma.Error422UnprocessableEntity("Description must be 5000 characters or fewer")
-	}
-	if req.Body.AgeRequirement != nil && len(*req.Body.AgeRequirement) > 50 {
-		return nil, huma.Error422UnprocessableEntity("Age requirement must be 50 characters or fewer")
+	// Validate every field before failing so the client sees all of them.
+	var v shared.Validator
+	v.MaxLength("title", req.Body.Title, 255, "Title must be 255 characters or fewer")
+	v.MaxLength("description", req.Body.Description, 5000, "Description must be 5000 characters or fewer")
+	v.MaxLength("age_requirement", req.Body.AgeRequirement, 50, "Age requirement must be 50 characters or fewer")
+	if req.Body.Price != nil {
+		v.Check(*req.Body.Price >= 0 && *req.Body.Price <= 10000, "price", shared.ValidationCodeOutOfRange,
+			"Price must be between 0 and 10000")
 	}
-	if req.Body.Price != nil && (*req.Body.Price < 0 || *req.Body.Price > 10000) {
-		return nil, huma.Error422UnprocessableEntity("Price must be between 0 and 10000")
+	req.Body.PriceCurrency = normalizePriceCurrency(req.Body.PriceCurrency)
+	v.CheckErr("price_min", shared.ValidationCodeInvalidValue,
+		utils.ValidatePrice(req.Body.PriceMin, req.Body.PriceMax, shared.Deref(req.Body.PriceCurrency)))
+	if req.Body.PriceCurrency != nil {
+		v.Required("price_currency", *req.Body.PriceCurrency, "price_currency must not be empty")
 	}
 	// PSY-747: ticket URL is length-capped AND scheme-validated (http/https
 	// only) — previously it accepted javascript:/data: on a public show.
-	if err := shared.ValidateURLField("ticket_url", req.Body.TicketURL); err != nil {
-		return nil, err
+	v.CheckErr("ticket_url", shared.ValidationCodeInvalidFormat, shared.ValidateURLField("ticket_url", req.Body.TicketURL))
+	if req.Body.TicketProvider != nil {
+		v.CheckErr("ticket_provider", shared.ValidationCodeInvalidValue, ticketProviderError(*req.Body.TicketProvider))
 	}
+	// PSY-525: URL scheme validation (http/https only) for image_url, after
+	// the cheaper length check.
 	if req.Body.ImageURL != nil && len(*req.Body.ImageURL) > 2048 {
-		return nil, huma.Error422UnprocessableEntity("Image URL must be 2048 characters or fewer")
+		v.MaxLength("image_url", req.Body.ImageURL, 2048, "Image URL must be 2048 characters or fewer")
+	} else {
+		v.CheckErr("image_url", shared.ValidationCodeInvalidFormat, shared.ValidateImageURL(req.Body.ImageURL))
 	}
-	// PSY-525: URL scheme validation (http/https only) for image_url.
-	if err := shared.ValidateImageURL(req.Body.ImageURL); err != nil {
-		return nil, err
+	if req.Body.DoorsTime != nil && req.Body.EventDate != nil {
+		v.Check(!req.Body.DoorsTime.After(*req.Body.EventDate), "doors_time", shared.ValidationCodeOutOfRange,
+			"Doors time must not be after the event date")
 	}
 	// PSY-563: Summary length cap mirrors the artist analog (no schema cap;
 	// keep parity with field UX). Empty summaries are allowed — the History
 	// row simply renders without a reason line.
-	if req.Body.Summary != nil && len(*req.Body.Summary) > 5000 {
-		return nil, huma.Error422UnprocessableEntity("Summary must be 5000 characters or fewer")
+	v.MaxLength("summary", req.Body.Summary, 5000, "Summary must be 5000 characters or fewer")
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
 	// Build typed update request for basic show fields. The service writes
@@ -910,14 +1211,27 @@ func (h *ShowHandler) UpdateShowHandler(ctx context.Context, req *UpdateShowRequ
 	serviceUpdates := &contracts.UpdateShowRequest{
 		Title:          req.Body.Title,
 		EventDate:      req.Body.EventDate,
+		DoorsTime:      req.Body.DoorsTime,
 		City:           req.Body.City,
 		State:          req.Body.State,
-		Price:          req.Body.Price,
 		AgeRequirement: req.Body.AgeRequirement,
 		Description:    req.Body.Description,
 		TicketURL:      req.Body.TicketURL,
+		TicketProvider: req.Body.TicketProvider,
 		ImageURL:       req.Body.ImageURL,
 	}
+	if hasStructuredPrice(req.Body.PriceMin, req.Body.PriceMax, req.Body.PriceCurrency, req.Body.IsFree) || req.Body.ClearPrice {
+		serviceUpdates.PriceMin = req.Body.PriceMin
+		serviceUpdates.PriceMax = req.Body.PriceMax
+		serviceUpdates.PriceCurrency = req.Body.PriceCurrency
+		serviceUpdates.IsFree = req.Body.IsFree
+		serviceUpdates.ClearPrice = req.Body.ClearPrice
+	} else if req.Body.Price != nil {
+		legacy := utils.LegacyPrice(*req.Body.Price)
+		serviceUpdates.PriceMin = legacy.Min
+		serviceUpdates.IsFree = &legacy.IsFree
+		serviceUpdates.ClearPrice = legacy.IsFree
+	}
 
 	// Convert venues to service format (nil if not provided)
 	var serviceVenues []contracts.CreateShowVenue
@@ -944,6 +1258,7 @@ func (h *ShowHandler) UpdateShowHandler(ctx context.Context, req *UpdateShowRequ
 				Name:            shared.Deref(artist.Name),
 				IsHeadliner:     artist.IsHeadliner,
 				InstagramHandle: artist.InstagramHandle,
+				SetTime:         artist.SetTime,
 			}
 		}
 	}
@@ -1472,7 +1787,7 @@ type GetMySubmissionsResponse struct {
 }
 
 // ============================================================================
-// Show Status Flag Handlers (Admin or Submitter)
+// Show Status Flag Handlers (Admin, Submitter or Venue Manager)
 // ============================================================================
 
 // SetShowSoldOutRequest represents the HTTP request for setting sold out status
@@ -1489,7 +1804,7 @@ type SetShowSoldOutResponse struct {
 }
 
 // SetShowSoldOutHandler handles POST /shows/{show_id}/sold-out
-// Allows admin or the show submitter to set the sold out flag
+// Allows admin, the show submitter or a venue manager to set the sold out flag
 func (h *ShowHandler) SetShowSoldOutHandler(ctx context.Context, req *SetShowSoldOutRequest) (*SetShowSoldOutResponse, error) {
 	requestID := logger.GetRequestID(ctx)
 
@@ -1517,15 +1832,14 @@ func (h *ShowHandler) SetShowSoldOutHandler(ctx context.Context, req *SetShowSol
 		)
 	}
 
-	// Check authorization: must be admin or submitter
-	isOwner := show.SubmittedBy != nil && *show.SubmittedBy == user.ID
-	if !user.IsAdmin && !isOwner {
+	// Check authorization: must be admin, submitter or venue manager
+	if !h.canUpdateShowFlags(ctx, show, user.ID, user.IsAdmin) {
 		logger.FromContext(ctx).Warn("set_show_sold_out_unauthorized",
 			"show_id", showID,
 			"user_id", user.ID,
 			"request_id", requestID,
 		)
-		return nil, huma.Error403Forbidden("Only the show submitter or an admin can update this show")
+		return nil, huma.Error403Forbidden("Only the show submitter, a venue manager or an admin can update this show")
 	}
 
 	logger.FromContext(ctx).Debug("set_show_sold_out_attempt",
@@ -1555,9 +1869,59 @@ func (h *ShowHandler) SetShowSoldOutHandler(ctx context.Context, req *SetShowSol
 		"request_id", requestID,
 	)
 
+	h.recordFlagRevision(ctx, uint(showID), user.ID, "is_sold_out", show.IsSoldOut, req.Body.Value)
+
 	return &SetShowSoldOutResponse{Body: *updatedShow}, nil
 }
 
+// canUpdateShowFlags reports whether a user may flip a show's sold-out or
+// cancelled flag. See canManageShow.
+func (h *ShowHandler) canUpdateShowFlags(ctx context.Context, show *contracts.ShowResponse, userID uint, isAdmin bool) bool {
+	return canManageShow(ctx, h.venueClaimService, show, userID, isAdmin)
+}
+
+// canManageShow reports whether a user may act for a show (flip its flags,
+// post updates): admins, the submitter, and managers of any of the show's
+// venues. A nil venueClaimService skips the manager check; a failed manager
+// lookup denies.
+func canManageShow(ctx context.Context, venueClaimService contracts.VenueClaimServiceInterface, show *contracts.ShowResponse, userID uint, isAdmin bool) bool {
+	if isAdmin || (show.SubmittedBy != nil && *show.SubmittedBy == userID) {
+		return true
+	}
+	if venueClaimService == nil {
+		return false
+	}
+	managed, err := venueClaimService.IsShowVenueManager(userID, show.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("venue_manager_lookup_failed",
+			"show_id", show.ID,
+			"user_id", userID,
+			"error", err.Error(),
+			"request_id", logger.GetRequestID(ctx),
+		)
+		return false
+	}
+	return managed
+}
+
+// recordFlagRevision records a sold-out/cancelled flip in revision history so
+// status changes on a live show are as visible as field edits. Fire-and-forget,
+// like the UpdateShowHandler revision; unchanged flags record nothing.
+func (h *ShowHandler) recordFlagRevision(ctx context.Context, showID, userID uint, field string, oldValue, newValue bool) {
+	if h.revisionService == nil || oldValue == newValue {
+		return
+	}
+	changes := []adminm.FieldChange{{Field: field, OldValue: oldValue, NewValue: newValue}}
+	servicesshared.GoSafe(ctx, "record_revision", func() {
+		if err := h.revisionService.RecordRevision("show", showID, userID, changes, ""); err != nil {
+			logger.Default().Error("record_show_revision_failed",
+				"show_id", showID,
+				"error", err.Error(),
+			)
+		}
+	})
+}
+
 // SetShowCancelledRequest represents the HTTP request for setting cancelled status
 type SetShowCancelledRequest struct {
 	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
@@ -1572,7 +1936,7 @@ type SetShowCancelledResponse struct {
 }
 
 // SetShowCancelledHandler handles POST /shows/{show_id}/cancelled
-// Allows admin or the show submitter to set the cancelled flag
+// Allows admin, the show submitter or a venue manager to set the cancelled flag
 func (h *ShowHandler) SetShowCancelledHandler(ctx context.Context, req *SetShowCancelledRequest) (*SetShowCancelledResponse, error) {
 	requestID := logger.GetRequestID(ctx)
 
@@ -1600,15 +1964,14 @@ func (h *ShowHandler) SetShowCancelledHandler(ctx context.Context, req *SetShowC
 		)
 	}
 
-	// Check authorization: must be admin or submitter
-	isOwner := show.SubmittedBy != nil && *show.SubmittedBy == user.ID
-	if !user.IsAdmin && !isOwner {
+	// Check authorization: must be admin, submitter or venue manager
+	if !h.canUpdateShowFlags(ctx, show, user.ID, user.IsAdmin) {
 		logger.FromContext(ctx).Warn("set_show_cancelled_unauthorized",
 			"show_id", showID,
 			"user_id", user.ID,
 			"request_id", requestID,
 		)
-		return nil, huma.Error403Forbidden("Only the show submitter or an admin can update this show")
+		return nil, huma.Error403Forbidden("Only the show submitter, a venue manager or an admin can update this show")
 	}
 
 	logger.FromContext(ctx).Debug("set_show_cancelled_attempt",
@@ -1621,6 +1984,14 @@ func (h *ShowHandler) SetShowCancelledHandler(ctx context.Context, req *SetShowC
 	// Set cancelled status
 	updatedShow, err := h.showStateService.SetShowCancelled(uint(showID), req.Body.Value)
 	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			logger.FromContext(ctx).Warn("set_show_cancelled_rejected",
+				"show_id", showID,
+				"error", err.Error(),
+				"request_id", requestID,
+			)
+			return nil, mapped
+		}
 		logger.FromContext(ctx).Error("set_show_cancelled_failed",
 			"show_id", showID,
 			"error", err.Error(),
@@ -1638,6 +2009,11 @@ func (h *ShowHandler) SetShowCancelledHandler(ctx context.Context, req *SetShowC
 		"request_id", requestID,
 	)
 
+	h.recordFlagRevision(ctx, uint(showID), user.ID, "is_cancelled", show.IsCancelled, req.Body.Value)
+	if req.Body.Value && !show.IsCancelled {
+		h.notifyShowChange(ctx, uint(showID), user.ID)
+	}
+
 	return &SetShowCancelledResponse{Body: *updatedShow}, nil
 }
 
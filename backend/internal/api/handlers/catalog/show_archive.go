@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// GetShowArchiveRequest represents the request for the past-show archive
+type GetShowArchiveRequest struct {
+	Year     int    `query:"year" minimum:"0" doc:"Calendar year (in timezone). 0 or omitted: every year." example:"2024"`
+	Month    int    `query:"month" minimum:"0" maximum:"12" doc:"Month 1-12 within year; requires year. 0 or omitted: every month." example:"6"`
+	Timezone string `query:"timezone" default:"UTC" doc:"IANA timezone for the past/upcoming boundary and year/month grouping. Defaults to UTC."`
+	Limit    int    `query:"limit" default:"50" minimum:"1" maximum:"200" doc:"Number of shows per page (max 200)"`
+	Offset   int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetShowArchiveResponse represents the response for the past-show archive
+type GetShowArchiveResponse struct {
+	Body struct {
+		Shows  []*contracts.ShowResponse   `json:"shows" doc:"Past shows, most recent first"`
+		Total  int64                       `json:"total" doc:"Total number of shows matching year/month"`
+		Limit  int                         `json:"limit"`
+		Offset int                         `json:"offset"`
+		Years  []*contracts.ShowYearCount  `json:"years" doc:"Past shows per year across the whole archive, most recent first"`
+		Months []*contracts.ShowMonthCount `json:"months,omitempty" doc:"Past shows per month of the selected year, most recent first"`
+	}
+}
+
+// GetShowArchiveHandler handles GET /shows/archive
+func (h *ShowHandler) GetShowArchiveHandler(ctx context.Context, req *GetShowArchiveRequest) (*GetShowArchiveResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	if req.Month > 0 && req.Year == 0 {
+		return nil, huma.Error400BadRequest("month requires year")
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	limit := req.Limit
+	if limit < 1 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	archive, err := h.showService.GetShowArchive(req.Year, req.Month, timezone, limit, offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("show_archive_failed",
+			"error", err.Error(),
+			"year", req.Year,
+			"month", req.Month,
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get show archive (request_id: %s)", requestID),
+		)
+	}
+
+	h.withGoingCounts(ctx, archive.Shows...)
+
+	resp := &GetShowArchiveResponse{}
+	resp.Body.Shows = archive.Shows
+	resp.Body.Total = archive.Total
+	resp.Body.Limit = limit
+	resp.Body.Offset = offset
+	resp.Body.Years = archive.Years
+	resp.Body.Months = archive.Months
+	return resp, nil
+}
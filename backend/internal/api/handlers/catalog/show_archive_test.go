@@ -0,0 +1,59 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestGetShowArchive_MonthRequiresYear(t *testing.T) {
+	h := testShowHandler()
+
+	_, err := h.GetShowArchiveHandler(context.Background(), &GetShowArchiveRequest{Month: 6})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetShowArchive_Success(t *testing.T) {
+	mock := &testhelpers.MockShowService{
+		GetShowArchiveFn: func(year, month int, timezone string, limit, offset int) (*contracts.ShowArchive, error) {
+			if year != 2024 || month != 6 || timezone != "America/Phoenix" || limit != 25 || offset != 50 {
+				t.Errorf("unexpected args: year=%d month=%d tz=%q limit=%d offset=%d", year, month, timezone, limit, offset)
+			}
+			return &contracts.ShowArchive{
+				Shows:  []*contracts.ShowResponse{{ID: 9}},
+				Total:  51,
+				Years:  []*contracts.ShowYearCount{{Year: 2024, Count: 120}},
+				Months: []*contracts.ShowMonthCount{{Month: 6, Count: 51}},
+			}, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	resp, err := h.GetShowArchiveHandler(context.Background(), &GetShowArchiveRequest{
+		Year: 2024, Month: 6, Timezone: "America/Phoenix", Limit: 25, Offset: 50,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 51 || len(resp.Body.Shows) != 1 || resp.Body.Offset != 50 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+	if len(resp.Body.Years) != 1 || len(resp.Body.Months) != 1 {
+		t.Errorf("expected year and month counts, got %+v / %+v", resp.Body.Years, resp.Body.Months)
+	}
+}
+
+func TestGetShowArchive_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockShowService{
+		GetShowArchiveFn: func(_, _ int, _ string, _, _ int) (*contracts.ShowArchive, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.GetShowArchiveHandler(context.Background(), &GetShowArchiveRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
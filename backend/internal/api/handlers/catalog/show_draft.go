@@ -0,0 +1,147 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// ShowDraftHandler handles the current user's show submission drafts.
+type ShowDraftHandler struct {
+	showDraftService contracts.ShowDraftServiceInterface
+}
+
+// NewShowDraftHandler creates a new show draft handler.
+func NewShowDraftHandler(showDraftService contracts.ShowDraftServiceInterface) *ShowDraftHandler {
+	return &ShowDraftHandler{
+		showDraftService: showDraftService,
+	}
+}
+
+// ShowDraftBody is the body for saving a draft
+type ShowDraftBody struct {
+	Payload json.RawMessage `json:"payload" doc:"The submit form's show request body as it stands; may be incomplete"`
+}
+
+// CreateShowDraftRequest represents the request for saving a new draft
+type CreateShowDraftRequest struct {
+	Body ShowDraftBody
+}
+
+// UpdateShowDraftRequest represents the request for replacing a draft
+type UpdateShowDraftRequest struct {
+	DraftID uint `path:"draft_id" doc:"Draft ID"`
+	Body    ShowDraftBody
+}
+
+// ShowDraftIDRequest represents a request addressing a single draft
+type ShowDraftIDRequest struct {
+	DraftID uint `path:"draft_id" doc:"Draft ID"`
+}
+
+// ShowDraftResponse represents a single draft
+type ShowDraftResponse struct {
+	Body *contracts.ShowDraftResponse
+}
+
+// ListShowDraftsResponse represents the user's drafts
+type ListShowDraftsResponse struct {
+	Body struct {
+		Drafts []contracts.ShowDraftResponse `json:"drafts" doc:"Drafts, most recently edited first"`
+	}
+}
+
+// CreateShowDraftHandler handles POST /shows/drafts
+func (h *ShowDraftHandler) CreateShowDraftHandler(ctx context.Context, req *CreateShowDraftRequest) (*ShowDraftResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	draft, err := h.showDraftService.CreateDraft(user.ID, req.Body.Payload)
+	if err != nil {
+		return nil, showDraftError(ctx, "show_draft_create", user.ID, err)
+	}
+	return &ShowDraftResponse{Body: draft}, nil
+}
+
+// UpdateShowDraftHandler handles PUT /shows/drafts/{draft_id}
+func (h *ShowDraftHandler) UpdateShowDraftHandler(ctx context.Context, req *UpdateShowDraftRequest) (*ShowDraftResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	draft, err := h.showDraftService.UpdateDraft(user.ID, req.DraftID, req.Body.Payload)
+	if err != nil {
+		return nil, showDraftError(ctx, "show_draft_update", user.ID, err)
+	}
+	return &ShowDraftResponse{Body: draft}, nil
+}
+
+// GetShowDraftHandler handles GET /shows/drafts/{draft_id}
+func (h *ShowDraftHandler) GetShowDraftHandler(ctx context.Context, req *ShowDraftIDRequest) (*ShowDraftResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	draft, err := h.showDraftService.GetDraft(user.ID, req.DraftID)
+	if err != nil {
+		return nil, showDraftError(ctx, "show_draft_get", user.ID, err)
+	}
+	return &ShowDraftResponse{Body: draft}, nil
+}
+
+// ListShowDraftsHandler handles GET /shows/drafts
+func (h *ShowDraftHandler) ListShowDraftsHandler(ctx context.Context, _ *struct{}) (*ListShowDraftsResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	drafts, err := h.showDraftService.ListDrafts(user.ID)
+	if err != nil {
+		return nil, showDraftError(ctx, "show_draft_list", user.ID, err)
+	}
+	resp := &ListShowDraftsResponse{}
+	resp.Body.Drafts = drafts
+	return resp, nil
+}
+
+// DeleteShowDraftHandler handles DELETE /shows/drafts/{draft_id}
+func (h *ShowDraftHandler) DeleteShowDraftHandler(ctx context.Context, req *ShowDraftIDRequest) (*struct{}, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	if err := h.showDraftService.DeleteDraft(user.ID, req.DraftID); err != nil {
+		return nil, showDraftError(ctx, "show_draft_delete", user.ID, err)
+	}
+	return nil, nil
+}
+
+// showDraftError maps a draft service failure to an HTTP error, logging
+// anything unexpected.
+func showDraftError(ctx context.Context, op string, userID uint, err error) error {
+	if mapped := shared.MapShowDraftError(err); mapped != nil {
+		return mapped
+	}
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Error(op+"_failed",
+		"user_id", userID,
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to process show draft (request_id: %s)", requestID),
+	)
+}
@@ -0,0 +1,76 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestShowDraftHandlers_RequireAuth(t *testing.T) {
+	h := NewShowDraftHandler(&testhelpers.MockShowDraftService{})
+	ctx := context.Background()
+
+	_, err := h.ListShowDraftsHandler(ctx, nil)
+	testhelpers.AssertHumaError(t, err, 401)
+	_, err = h.CreateShowDraftHandler(ctx, &CreateShowDraftRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+	_, err = h.GetShowDraftHandler(ctx, &ShowDraftIDRequest{DraftID: 1})
+	testhelpers.AssertHumaError(t, err, 401)
+	_, err = h.UpdateShowDraftHandler(ctx, &UpdateShowDraftRequest{DraftID: 1})
+	testhelpers.AssertHumaError(t, err, 401)
+	_, err = h.DeleteShowDraftHandler(ctx, &ShowDraftIDRequest{DraftID: 1})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestCreateShowDraftHandler_Success(t *testing.T) {
+	var gotUser uint
+	h := NewShowDraftHandler(&testhelpers.MockShowDraftService{
+		CreateDraftFn: func(userID uint, payload json.RawMessage) (*contracts.ShowDraftResponse, error) {
+			gotUser = userID
+			return &contracts.ShowDraftResponse{ID: 3, Payload: payload}, nil
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 9})
+
+	resp, err := h.CreateShowDraftHandler(ctx, &CreateShowDraftRequest{
+		Body: ShowDraftBody{Payload: json.RawMessage(`{"title":"x"}`)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotUser != 9 || resp.Body.ID != 3 {
+		t.Errorf("unexpected result: user=%d draft=%+v", gotUser, resp.Body)
+	}
+}
+
+func TestShowDraftHandlers_MapServiceErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", apperrors.ErrShowDraftNotFound(1), 404},
+		{"invalid", apperrors.ErrShowDraftInvalid("payload must be a JSON object"), 422},
+		{"limit reached", apperrors.ErrShowDraftLimitReached(20), 409},
+		{"unexpected", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewShowDraftHandler(&testhelpers.MockShowDraftService{
+				UpdateDraftFn: func(uint, uint, json.RawMessage) (*contracts.ShowDraftResponse, error) {
+					return nil, tc.err
+				},
+			})
+			ctx := testhelpers.CtxWithUser(&authm.User{ID: 9})
+
+			_, err := h.UpdateShowDraftHandler(ctx, &UpdateShowDraftRequest{DraftID: 1})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+	servicesshared "psychic-homily-backend/internal/services/shared"
+)
+
+// ShowFlyerHandler handles uploaded show flyer endpoints.
+type ShowFlyerHandler struct {
+	showService     contracts.ShowServiceInterface
+	flyerService    contracts.FlyerServiceInterface
+	auditLogService contracts.AuditLogServiceInterface
+}
+
+// NewShowFlyerHandler creates a new show flyer handler.
+func NewShowFlyerHandler(
+	showService contracts.ShowServiceInterface,
+	flyerService contracts.FlyerServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *ShowFlyerHandler {
+	return &ShowFlyerHandler{
+		showService:     showService,
+		flyerService:    flyerService,
+		auditLogService: auditLogService,
+	}
+}
+
+// ShowFlyerForm is the multipart body of a flyer upload.
+type ShowFlyerForm struct {
+	File huma.FormFile `form:"file" contentType:"image/jpeg,image/png,image/gif" required:"true" doc:"Flyer image (JPEG, PNG or GIF)"`
+}
+
+// UploadShowFlyerRequest represents the request for uploading a show flyer
+type UploadShowFlyerRequest struct {
+	ShowID  string `path:"show_id" doc:"Show ID" example:"1"`
+	RawBody huma.MultipartFormFiles[ShowFlyerForm]
+}
+
+// ShowFlyerResponse represents the stored flyer's URLs
+type ShowFlyerResponse struct {
+	Body *contracts.ShowFlyerResponse
+}
+
+// UploadShowFlyerHandler handles POST /shows/{show_id}/flyer
+func (h *ShowFlyerHandler) UploadShowFlyerHandler(ctx context.Context, req *UploadShowFlyerRequest) (*ShowFlyerResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	showID, err := h.authorizeFlyerEdit(ctx, req.ShowID)
+	if err != nil {
+		return nil, err
+	}
+
+	file := req.RawBody.Data().File
+	defer file.Close()
+
+	flyer, err := h.flyerService.UploadShowFlyer(ctx, showID, file)
+	if err != nil {
+		return nil, flyerError(ctx, "show_flyer_upload", showID, err)
+	}
+
+	h.logAction(ctx, user.ID, "upload_show_flyer", showID, map[string]interface{}{
+		"filename": file.Filename,
+		"size":     file.Size,
+	})
+
+	logger.FromContext(ctx).Info("show_flyer_uploaded",
+		"show_id", showID,
+		"user_id", user.ID,
+		"size", file.Size,
+	)
+
+	return &ShowFlyerResponse{Body: flyer}, nil
+}
+
+// DeleteShowFlyerRequest represents the request for removing a show flyer
+type DeleteShowFlyerRequest struct {
+	ShowID string `path:"show_id" doc:"Show ID" example:"1"`
+}
+
+// DeleteShowFlyerHandler handles DELETE /shows/{show_id}/flyer
+func (h *ShowFlyerHandler) DeleteShowFlyerHandler(ctx context.Context, req *DeleteShowFlyerRequest) (*struct{}, error) {
+	user := middleware.GetUserFromContext(ctx)
+	showID, err := h.authorizeFlyerEdit(ctx, req.ShowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.flyerService.DeleteShowFlyer(ctx, showID); err != nil {
+		return nil, flyerError(ctx, "show_flyer_delete", showID, err)
+	}
+
+	h.logAction(ctx, user.ID, "delete_show_flyer", showID, nil)
+
+	logger.FromContext(ctx).Info("show_flyer_deleted",
+		"show_id", showID,
+		"user_id", user.ID,
+	)
+
+	return nil, nil
+}
+
+// authorizeFlyerEdit parses the show ID and checks the caller may change
+// the show's flyer: admins, or the user who submitted the show.
+func (h *ShowFlyerHandler) authorizeFlyerEdit(ctx context.Context, rawID string) (uint, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return 0, huma.Error401Unauthorized("Authentication required")
+	}
+
+	id, err := strconv.ParseUint(rawID, 10, 32)
+	if err != nil {
+		showErr := apperrors.ErrShowInvalidID(rawID)
+		return 0, huma.Error400BadRequest(fmt.Sprintf("%s [%s]", showErr.Message, showErr.Code))
+	}
+	showID := uint(id)
+
+	show, err := h.showService.GetShow(showID)
+	if err != nil {
+		showErr := apperrors.ErrShowNotFound(showID)
+		return 0, huma.Error404NotFound(fmt.Sprintf("%s [%s]", showErr.Message, showErr.Code))
+	}
+
+	isOwner := show.SubmittedBy != nil && *show.SubmittedBy == user.ID
+	if !user.IsAdmin && !isOwner {
+		logger.FromContext(ctx).Warn("show_flyer_unauthorized",
+			"show_id", showID,
+			"user_id", user.ID,
+			"submitted_by", show.SubmittedBy,
+		)
+		return 0, huma.Error403Forbidden("Only the show's submitter or an admin can change its flyer")
+	}
+	return showID, nil
+}
+
+// flyerError logs a flyer service failure and maps it to an HTTP error.
+func flyerError(ctx context.Context, op string, showID uint, err error) error {
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Warn(op+"_failed",
+		"show_id", showID,
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	if mapped := shared.MapMediaError(err); mapped != nil {
+		return mapped
+	}
+	if mapped := shared.MapShowError(err); mapped != nil {
+		return mapped
+	}
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to process show flyer (request_id: %s)", requestID),
+	)
+}
+
+func (h *ShowFlyerHandler) logAction(ctx context.Context, userID uint, action string, showID uint, metadata map[string]interface{}) {
+	if h.auditLogService == nil {
+		return
+	}
+	servicesshared.GoSafe(ctx, "audit_log", func() {
+		h.auditLogService.LogAction(userID, action, "show", showID, metadata)
+	})
+}
@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func flyerShowService(submittedBy uint) *testhelpers.MockShowService {
+	return &testhelpers.MockShowService{
+		GetShowFn: func(showID uint) (*contracts.ShowResponse, error) {
+			if showID == 404 {
+				return nil, fmt.Errorf("not found")
+			}
+			return &contracts.ShowResponse{ID: showID, SubmittedBy: &submittedBy}, nil
+		},
+	}
+}
+
+func TestDeleteShowFlyerHandler_Authorization(t *testing.T) {
+	var deleted uint
+	flyers := &testhelpers.MockFlyerService{
+		DeleteShowFlyerFn: func(_ context.Context, showID uint) error {
+			deleted = showID
+			return nil
+		},
+	}
+	h := NewShowFlyerHandler(flyerShowService(7), flyers, nil)
+
+	_, err := h.DeleteShowFlyerHandler(context.Background(), &DeleteShowFlyerRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 401)
+
+	stranger := testhelpers.CtxWithUser(&authm.User{ID: 8})
+	_, err = h.DeleteShowFlyerHandler(stranger, &DeleteShowFlyerRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 403)
+
+	_, err = h.DeleteShowFlyerHandler(stranger, &DeleteShowFlyerRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+
+	_, err = h.DeleteShowFlyerHandler(stranger, &DeleteShowFlyerRequest{ShowID: "404"})
+	testhelpers.AssertHumaError(t, err, 404)
+
+	if deleted != 0 {
+		t.Fatalf("expected no delete for rejected requests, got show %d", deleted)
+	}
+
+	owner := testhelpers.CtxWithUser(&authm.User{ID: 7})
+	if _, err := h.DeleteShowFlyerHandler(owner, &DeleteShowFlyerRequest{ShowID: "1"}); err != nil {
+		t.Fatalf("owner delete: unexpected error: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("expected show 1 flyer deleted, got %d", deleted)
+	}
+
+	admin := testhelpers.CtxWithUser(&authm.User{ID: 2, IsAdmin: true})
+	if _, err := h.DeleteShowFlyerHandler(admin, &DeleteShowFlyerRequest{ShowID: "3"}); err != nil {
+		t.Fatalf("admin delete: unexpected error: %v", err)
+	}
+}
+
+func TestDeleteShowFlyerHandler_MapsServiceErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not configured", apperrors.ErrMediaNotConfigured(), 503},
+		{"too large", apperrors.ErrMediaTooLarge(10 << 20), 413},
+		{"unsupported type", apperrors.ErrMediaUnsupportedType("application/pdf"), 415},
+		{"invalid image", apperrors.ErrMediaInvalidImage(fmt.Errorf("bad header")), 422},
+		{"no flyer", apperrors.ErrMediaNotFound(1), 404},
+		{"show gone", apperrors.ErrShowNotFound(1), 404},
+		{"unexpected", fmt.Errorf("storage down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewShowFlyerHandler(flyerShowService(7), &testhelpers.MockFlyerService{
+				DeleteShowFlyerFn: func(context.Context, uint) error {
+					return tc.err
+				},
+			}, nil)
+			ctx := testhelpers.CtxWithUser(&authm.User{ID: 7})
+
+			_, err := h.DeleteShowFlyerHandler(ctx, &DeleteShowFlyerRequest{ShowID: "1"})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// ShowLineupArtist is one slot in a lineup reorder request.
+type ShowLineupArtist struct {
+	ArtistID uint       `json:"artist_id" minimum:"1" doc:"ID of an artist already on the show"`
+	SetType  string     `json:"set_type" enum:"headliner,support,opener,dj,special_guest,performer" doc:"Slot on the bill: headliner, support (direct support), opener, dj (DJ set), special_guest or performer"`
+	SetTime  *time.Time `json:"set_time,omitempty" required:"false" doc:"When the artist goes on (RFC3339). Omit to keep the current set time."`
+}
+
+// SetShowLineupRequest represents the HTTP request for reordering a show's lineup
+type SetShowLineupRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+	Body   struct {
+		Artists []ShowLineupArtist `json:"artists" minItems:"1" doc:"Every artist on the show, in billing order (headliner usually first). Exactly one must be the headliner."`
+	}
+}
+
+// SetShowLineupResponse represents the HTTP response for reordering a show's lineup
+type SetShowLineupResponse struct {
+	Body contracts.ShowResponse `json:"body"`
+}
+
+// SetShowLineupHandler handles PUT /shows/{show_id}/lineup
+// Reorders the show's existing artists and sets their set types. Allows admin,
+// the show submitter or a venue manager, like the status flags; adding or
+// removing artists goes through PUT /shows/{show_id}.
+func (h *ShowHandler) SetShowLineupHandler(ctx context.Context, req *SetShowLineupRequest) (*SetShowLineupResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	// Require authentication
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	var v shared.Validator
+	v.Check(len(req.Body.Artists) <= maxShowArtists, "artists", shared.ValidationCodeTooMany,
+		fmt.Sprintf("A show may have at most %d artists", maxShowArtists))
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// Parse show ID
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	// Get the show to check ownership
+	show, err := h.showService.GetShow(uint(showID))
+	if err != nil {
+		var showErr *apperrors.ShowError
+		if errors.As(err, &showErr) && showErr.Code == apperrors.CodeShowNotFound {
+			return nil, huma.Error404NotFound("Show not found")
+		}
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get show (request_id: %s)", requestID),
+		)
+	}
+
+	if !canManageShow(ctx, h.venueClaimService, show, user.ID, user.IsAdmin) {
+		logger.FromContext(ctx).Warn("set_show_lineup_unauthorized",
+			"show_id", showID,
+			"user_id", user.ID,
+			"request_id", requestID,
+		)
+		return nil, huma.Error403Forbidden("Only the show submitter, a venue manager or an admin can update this show")
+	}
+
+	lineup := make([]contracts.ShowLineupEntry, len(req.Body.Artists))
+	for i, artist := range req.Body.Artists {
+		lineup[i] = contracts.ShowLineupEntry{
+			ArtistID: artist.ArtistID,
+			SetType:  artist.SetType,
+			SetTime:  artist.SetTime,
+		}
+	}
+
+	updatedShow, err := h.showService.SetShowLineup(uint(showID), lineup)
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			logger.FromContext(ctx).Warn("set_show_lineup_rejected",
+				"show_id", showID,
+				"error", err.Error(),
+				"request_id", requestID,
+			)
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("set_show_lineup_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to update lineup (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("set_show_lineup_success",
+		"show_id", showID,
+		"artist_count", len(lineup),
+		"user_id", user.ID,
+		"request_id", requestID,
+	)
+
+	return &SetShowLineupResponse{Body: *updatedShow}, nil
+}
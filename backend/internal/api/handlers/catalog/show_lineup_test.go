@@ -0,0 +1,130 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func lineupRequest(artists ...ShowLineupArtist) *SetShowLineupRequest {
+	req := &SetShowLineupRequest{ShowID: "1"}
+	req.Body.Artists = artists
+	return req
+}
+
+func ownedShowMock(submitter uint) *testhelpers.MockShowService {
+	return &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &submitter}, nil
+		},
+	}
+}
+
+func TestSetShowLineupHandler_NoAuth(t *testing.T) {
+	h := testShowHandler()
+
+	_, err := h.SetShowLineupHandler(context.Background(), lineupRequest())
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestSetShowLineupHandler_InvalidID(t *testing.T) {
+	h := testShowHandler()
+	req := lineupRequest(ShowLineupArtist{ArtistID: 1, SetType: "headliner"})
+	req.ShowID = "abc"
+
+	_, err := h.SetShowLineupHandler(testhelpers.CtxWithUser(&authm.User{ID: 1}), req)
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestSetShowLineupHandler_TooManyArtists(t *testing.T) {
+	h := testShowHandler()
+	artists := make([]ShowLineupArtist, maxShowArtists+1)
+	for i := range artists {
+		artists[i] = ShowLineupArtist{ArtistID: uint(i + 1), SetType: "opener"}
+	}
+
+	_, err := h.SetShowLineupHandler(testhelpers.CtxWithUser(&authm.User{ID: 1}), lineupRequest(artists...))
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestSetShowLineupHandler_NotFound(t *testing.T) {
+	mock := &testhelpers.MockShowService{
+		GetShowFn: func(id uint) (*contracts.ShowResponse, error) {
+			return nil, apperrors.ErrShowNotFound(id)
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.SetShowLineupHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}),
+		lineupRequest(ShowLineupArtist{ArtistID: 1, SetType: "headliner"}))
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestSetShowLineupHandler_NotOwner(t *testing.T) {
+	h := NewShowHandler(ownedShowMock(99), nil, nil, nil, nil, nil, nil)
+
+	_, err := h.SetShowLineupHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}),
+		lineupRequest(ShowLineupArtist{ArtistID: 1, SetType: "headliner"}))
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
+func TestSetShowLineupHandler_Success(t *testing.T) {
+	mock := ownedShowMock(5)
+	var got []contracts.ShowLineupEntry
+	mock.SetShowLineupFn = func(showID uint, lineup []contracts.ShowLineupEntry) (*contracts.ShowResponse, error) {
+		got = lineup
+		return &contracts.ShowResponse{ID: showID}, nil
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	resp, err := h.SetShowLineupHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), lineupRequest(
+		ShowLineupArtist{ArtistID: 3, SetType: "headliner"},
+		ShowLineupArtist{ArtistID: 7, SetType: "support"},
+		ShowLineupArtist{ArtistID: 2, SetType: "dj"},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 1 {
+		t.Errorf("expected show 1, got %d", resp.Body.ID)
+	}
+	want := []contracts.ShowLineupEntry{
+		{ArtistID: 3, SetType: "headliner"},
+		{ArtistID: 7, SetType: "support"},
+		{ArtistID: 2, SetType: "dj"},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("lineup = %+v, want %+v", got, want)
+	}
+}
+
+func TestSetShowLineupHandler_ValidationFailure(t *testing.T) {
+	mock := ownedShowMock(5)
+	mock.SetShowLineupFn = func(uint, []contracts.ShowLineupEntry) (*contracts.ShowResponse, error) {
+		return nil, apperrors.ErrShowValidationFailed("lineup must have exactly one headliner (got 2)")
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.SetShowLineupHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), lineupRequest(
+		ShowLineupArtist{ArtistID: 3, SetType: "headliner"},
+		ShowLineupArtist{ArtistID: 7, SetType: "headliner"},
+	))
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestSetShowLineupHandler_ServiceError(t *testing.T) {
+	mock := ownedShowMock(5)
+	mock.SetShowLineupFn = func(uint, []contracts.ShowLineupEntry) (*contracts.ShowResponse, error) {
+		return nil, fmt.Errorf("db down")
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.SetShowLineupHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}),
+		lineupRequest(ShowLineupArtist{ArtistID: 3, SetType: "headliner"}))
+	testhelpers.AssertHumaError(t, err, 500)
+}
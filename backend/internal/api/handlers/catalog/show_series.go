@@ -0,0 +1,241 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+	servicesshared "psychic-homily-backend/internal/services/shared"
+)
+
+// ShowSeriesHandler handles recurring show series endpoints.
+type ShowSeriesHandler struct {
+	seriesService   contracts.ShowSeriesServiceInterface
+	auditLogService contracts.AuditLogServiceInterface
+}
+
+// NewShowSeriesHandler creates a new show series handler.
+func NewShowSeriesHandler(seriesService contracts.ShowSeriesServiceInterface, auditLogService contracts.AuditLogServiceInterface) *ShowSeriesHandler {
+	return &ShowSeriesHandler{
+		seriesService:   seriesService,
+		auditLogService: auditLogService,
+	}
+}
+
+// seriesError logs a series service failure and maps it to an HTTP error.
+func seriesError(ctx context.Context, op string, seriesID uint, err error) error {
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Warn(op+"_failed",
+		"series_id", seriesID,
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	if mapped := shared.MapShowSeriesError(err); mapped != nil {
+		return mapped
+	}
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to process show series (request_id: %s)", requestID),
+	)
+}
+
+// ============================================================================
+// Get Show Series
+// ============================================================================
+
+// GetShowSeriesRequest represents the request for getting a series
+type GetShowSeriesRequest struct {
+	SeriesID uint `path:"series_id" minimum:"1" doc:"Show series ID" example:"1"`
+}
+
+// ShowSeriesResponse represents a series template with its occurrences
+type ShowSeriesResponse struct {
+	Body *contracts.ShowSeriesResponse
+}
+
+// GetShowSeriesHandler handles GET /show-series/{series_id}
+func (h *ShowSeriesHandler) GetShowSeriesHandler(ctx context.Context, req *GetShowSeriesRequest) (*ShowSeriesResponse, error) {
+	series, err := h.seriesService.GetSeries(req.SeriesID)
+	if err != nil {
+		return nil, seriesError(ctx, "get_show_series", req.SeriesID, err)
+	}
+	return &ShowSeriesResponse{Body: series}, nil
+}
+
+// ============================================================================
+// Create Show Series
+// ============================================================================
+
+// CreateShowSeriesRequest represents the request for creating a series
+type CreateShowSeriesRequest struct {
+	Body struct {
+		Title          string                            `json:"title" minLength:"1" maxLength:"500" doc:"Title given to every occurrence"`
+		VenueID        uint                              `json:"venue_id" minimum:"1" doc:"Existing venue ID"`
+		DayOfWeek      int                               `json:"day_of_week" minimum:"0" maximum:"6" doc:"Weekday, 0 = Sunday"`
+		StartTime      string                            `json:"start_time" doc:"Venue-local start time (HH:MM)" example:"20:00"`
+		StartDate      string                            `json:"start_date" doc:"First date of the series (YYYY-MM-DD)" example:"2026-11-01"`
+		EndDate        string                            `json:"end_date" doc:"Last date of the series (YYYY-MM-DD)" example:"2027-04-30"`
+		PriceMin       *float64                          `json:"price_min,omitempty" doc:"Lowest ticket price; a single price sets only this"`
+		PriceMax       *float64                          `json:"price_max,omitempty" doc:"Highest ticket price, for a range (requires price_min)"`
+		PriceCurrency  string                            `json:"price_currency,omitempty" doc:"ISO 4217 currency code (default USD)"`
+		IsFree         bool                              `json:"is_free,omitempty" doc:"Free or donation entry; price_min/price_max are then the suggested donation"`
+		AgeRequirement string                            `json:"age_requirement,omitempty" doc:"Age requirement"`
+		Description    string                            `json:"description,omitempty" doc:"Description"`
+		TicketURL      string                            `json:"ticket_url,omitempty" maxLength:"500" doc:"Ticket URL"`
+		Artists        []contracts.ShowSeriesArtistInput `json:"artists" minItems:"1" doc:"Template billing, in order"`
+	}
+}
+
+// CreateShowSeriesHandler handles POST /admin/show-series
+func (h *ShowSeriesHandler) CreateShowSeriesHandler(ctx context.Context, req *CreateShowSeriesRequest) (*ShowSeriesResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	series, err := h.seriesService.CreateSeries(&contracts.CreateShowSeriesRequest{
+		Title:          req.Body.Title,
+		VenueID:        req.Body.VenueID,
+		DayOfWeek:      req.Body.DayOfWeek,
+		StartTime:      req.Body.StartTime,
+		StartDate:      req.Body.StartDate,
+		EndDate:        req.Body.EndDate,
+		PriceMin:       req.Body.PriceMin,
+		PriceMax:       req.Body.PriceMax,
+		PriceCurrency:  req.Body.PriceCurrency,
+		IsFree:         req.Body.IsFree,
+		AgeRequirement: req.Body.AgeRequirement,
+		Description:    req.Body.Description,
+		TicketURL:      req.Body.TicketURL,
+		Artists:        req.Body.Artists,
+		CreatedBy:      &user.ID,
+	})
+	if err != nil {
+		return nil, seriesError(ctx, "create_show_series", 0, err)
+	}
+
+	h.logAction(ctx, user.ID, "create_show_series", series.ID, map[string]interface{}{
+		"occurrences": len(series.Occurrences),
+	})
+
+	logger.FromContext(ctx).Info("show_series_created",
+		"series_id", series.ID,
+		"occurrences", len(series.Occurrences),
+		"admin_id", user.ID,
+	)
+
+	return &ShowSeriesResponse{Body: series}, nil
+}
+
+// ============================================================================
+// Update Show Series
+// ============================================================================
+
+// UpdateShowSeriesRequest represents the request for editing a series
+type UpdateShowSeriesRequest struct {
+	SeriesID uint `path:"series_id" minimum:"1" doc:"Show series ID" example:"1"`
+	Body     contracts.UpdateShowSeriesRequest
+}
+
+// UpdateShowSeriesHandler handles PATCH /admin/show-series/{series_id}.
+// Future non-detached occurrences are regenerated from the edited template.
+func (h *ShowSeriesHandler) UpdateShowSeriesHandler(ctx context.Context, req *UpdateShowSeriesRequest) (*ShowSeriesResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	series, err := h.seriesService.UpdateSeries(req.SeriesID, &req.Body)
+	if err != nil {
+		return nil, seriesError(ctx, "update_show_series", req.SeriesID, err)
+	}
+
+	h.logAction(ctx, user.ID, "update_show_series", series.ID, nil)
+
+	return &ShowSeriesResponse{Body: series}, nil
+}
+
+// ============================================================================
+// Regenerate Occurrences
+// ============================================================================
+
+// RegenerateShowSeriesRequest represents the request for regenerating a series
+type RegenerateShowSeriesRequest struct {
+	SeriesID uint `path:"series_id" minimum:"1" doc:"Show series ID" example:"1"`
+}
+
+// RegenerateShowSeriesHandler handles POST /admin/show-series/{series_id}/regenerate
+func (h *ShowSeriesHandler) RegenerateShowSeriesHandler(ctx context.Context, req *RegenerateShowSeriesRequest) (*ShowSeriesResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	series, err := h.seriesService.RegenerateFutureOccurrences(req.SeriesID)
+	if err != nil {
+		return nil, seriesError(ctx, "regenerate_show_series", req.SeriesID, err)
+	}
+
+	h.logAction(ctx, user.ID, "regenerate_show_series", series.ID, nil)
+
+	return &ShowSeriesResponse{Body: series}, nil
+}
+
+// ============================================================================
+// Detach Occurrence
+// ============================================================================
+
+// DetachShowSeriesOccurrenceRequest represents the request for detaching one occurrence
+type DetachShowSeriesOccurrenceRequest struct {
+	SeriesID uint `path:"series_id" minimum:"1" doc:"Show series ID" example:"1"`
+	ShowID   uint `path:"show_id" minimum:"1" doc:"Occurrence show ID" example:"42"`
+}
+
+// DetachShowSeriesOccurrenceResponse represents the detached occurrence
+type DetachShowSeriesOccurrenceResponse struct {
+	Body *contracts.ShowSeriesOccurrence
+}
+
+// DetachShowSeriesOccurrenceHandler handles POST /admin/show-series/{series_id}/occurrences/{show_id}/detach
+func (h *ShowSeriesHandler) DetachShowSeriesOccurrenceHandler(ctx context.Context, req *DetachShowSeriesOccurrenceRequest) (*DetachShowSeriesOccurrenceResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	occurrence, err := h.seriesService.DetachOccurrence(req.SeriesID, req.ShowID)
+	if err != nil {
+		return nil, seriesError(ctx, "detach_show_series_occurrence", req.SeriesID, err)
+	}
+
+	h.logAction(ctx, user.ID, "detach_show_series_occurrence", req.SeriesID, map[string]interface{}{
+		"show_id": req.ShowID,
+	})
+
+	return &DetachShowSeriesOccurrenceResponse{Body: occurrence}, nil
+}
+
+// ============================================================================
+// Delete Show Series
+// ============================================================================
+
+// DeleteShowSeriesRequest represents the request for deleting a series
+type DeleteShowSeriesRequest struct {
+	SeriesID uint `path:"series_id" minimum:"1" doc:"Show series ID" example:"1"`
+}
+
+// DeleteShowSeriesHandler handles DELETE /admin/show-series/{series_id}.
+// Past and detached occurrences are kept as ordinary shows.
+func (h *ShowSeriesHandler) DeleteShowSeriesHandler(ctx context.Context, req *DeleteShowSeriesRequest) (*struct{}, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	if err := h.seriesService.DeleteSeries(req.SeriesID); err != nil {
+		return nil, seriesError(ctx, "delete_show_series", req.SeriesID, err)
+	}
+
+	h.logAction(ctx, user.ID, "delete_show_series", req.SeriesID, nil)
+
+	return nil, nil
+}
+
+// logAction writes a fire-and-forget audit entry for a series write.
+func (h *ShowSeriesHandler) logAction(ctx context.Context, userID uint, action string, seriesID uint, metadata map[string]interface{}) {
+	if h.auditLogService == nil {
+		return
+	}
+	servicesshared.GoSafe(ctx, "audit_log", func() {
+		h.auditLogService.LogAction(userID, action, "show_series", seriesID, metadata)
+	})
+}
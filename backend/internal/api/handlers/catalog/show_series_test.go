@@ -0,0 +1,162 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func showSeriesAdminCtx() context.Context {
+	return testhelpers.CtxWithUser(&authm.User{ID: 7, IsAdmin: true})
+}
+
+func TestCreateShowSeriesHandler_Success(t *testing.T) {
+	var got *contracts.CreateShowSeriesRequest
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		CreateSeriesFn: func(req *contracts.CreateShowSeriesRequest) (*contracts.ShowSeriesResponse, error) {
+			got = req
+			return &contracts.ShowSeriesResponse{
+				ID:          3,
+				Title:       req.Title,
+				Occurrences: []contracts.ShowSeriesOccurrence{{ShowID: 10}, {ShowID: 11}},
+			}, nil
+		},
+	}, nil)
+
+	req := &CreateShowSeriesRequest{}
+	req.Body.Title = "Tuesday Jazz"
+	req.Body.VenueID = 2
+	req.Body.DayOfWeek = 2
+	req.Body.StartTime = "20:00"
+	req.Body.StartDate = "2026-11-01"
+	req.Body.EndDate = "2026-11-30"
+	req.Body.Artists = []contracts.ShowSeriesArtistInput{{ArtistID: 5, IsHeadliner: true}}
+
+	resp, err := h.CreateShowSeriesHandler(showSeriesAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.CreatedBy == nil || *got.CreatedBy != 7 {
+		t.Errorf("expected created_by=7, got %+v", got)
+	}
+	if got.VenueID != 2 || got.DayOfWeek != 2 || len(got.Artists) != 1 {
+		t.Errorf("request not forwarded: %+v", got)
+	}
+	if resp.Body.ID != 3 || len(resp.Body.Occurrences) != 2 {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestCreateShowSeriesHandler_InvalidTemplate(t *testing.T) {
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		CreateSeriesFn: func(*contracts.CreateShowSeriesRequest) (*contracts.ShowSeriesResponse, error) {
+			return nil, apperrors.ErrShowSeriesInvalid("start_time must be HH:MM")
+		},
+	}, nil)
+
+	_, err := h.CreateShowSeriesHandler(showSeriesAdminCtx(), &CreateShowSeriesRequest{})
+	testhelpers.AssertHumaErrorWithDetail(t, err, 422, "start_time must be HH:MM")
+}
+
+func TestGetShowSeriesHandler_NotFound(t *testing.T) {
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		GetSeriesFn: func(seriesID uint) (*contracts.ShowSeriesResponse, error) {
+			return nil, apperrors.ErrShowSeriesNotFound(seriesID)
+		},
+	}, nil)
+
+	_, err := h.GetShowSeriesHandler(context.Background(), &GetShowSeriesRequest{SeriesID: 99})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestUpdateShowSeriesHandler_ForwardsPatch(t *testing.T) {
+	var gotID uint
+	var gotTitle *string
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		UpdateSeriesFn: func(seriesID uint, req *contracts.UpdateShowSeriesRequest) (*contracts.ShowSeriesResponse, error) {
+			gotID, gotTitle = seriesID, req.Title
+			return &contracts.ShowSeriesResponse{ID: seriesID, Title: *req.Title}, nil
+		},
+	}, nil)
+
+	title := "Renamed Night"
+	req := &UpdateShowSeriesRequest{SeriesID: 4}
+	req.Body.Title = &title
+
+	resp, err := h.UpdateShowSeriesHandler(showSeriesAdminCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotID != 4 || gotTitle == nil || *gotTitle != title {
+		t.Errorf("expected series 4 with title %q, got %d %v", title, gotID, gotTitle)
+	}
+	if resp.Body.Title != title {
+		t.Errorf("expected title %q, got %q", title, resp.Body.Title)
+	}
+}
+
+func TestRegenerateShowSeriesHandler_ServiceError(t *testing.T) {
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		RegenerateFutureOccurrencesFn: func(uint) (*contracts.ShowSeriesResponse, error) {
+			return nil, fmt.Errorf("database down")
+		},
+	}, nil)
+
+	_, err := h.RegenerateShowSeriesHandler(showSeriesAdminCtx(), &RegenerateShowSeriesRequest{SeriesID: 1})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestDetachShowSeriesOccurrenceHandler_Success(t *testing.T) {
+	var gotSeries, gotShow uint
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		DetachOccurrenceFn: func(seriesID, showID uint) (*contracts.ShowSeriesOccurrence, error) {
+			gotSeries, gotShow = seriesID, showID
+			return &contracts.ShowSeriesOccurrence{ShowID: showID, Detached: true}, nil
+		},
+	}, nil)
+
+	resp, err := h.DetachShowSeriesOccurrenceHandler(showSeriesAdminCtx(), &DetachShowSeriesOccurrenceRequest{SeriesID: 2, ShowID: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotSeries != 2 || gotShow != 30 {
+		t.Errorf("expected (2, 30), got (%d, %d)", gotSeries, gotShow)
+	}
+	if !resp.Body.Detached {
+		t.Error("expected detached occurrence")
+	}
+}
+
+func TestDetachShowSeriesOccurrenceHandler_NotAnOccurrence(t *testing.T) {
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		DetachOccurrenceFn: func(seriesID, showID uint) (*contracts.ShowSeriesOccurrence, error) {
+			return nil, apperrors.ErrShowSeriesOccurrenceNotFound(seriesID, showID)
+		},
+	}, nil)
+
+	_, err := h.DetachShowSeriesOccurrenceHandler(showSeriesAdminCtx(), &DetachShowSeriesOccurrenceRequest{SeriesID: 2, ShowID: 30})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestDeleteShowSeriesHandler_LogsAudit(t *testing.T) {
+	done := make(chan string, 1)
+	h := NewShowSeriesHandler(&testhelpers.MockShowSeriesService{
+		DeleteSeriesFn: func(uint) error { return nil },
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			done <- fmt.Sprintf("%s:%s:%d", action, entityType, entityID)
+		},
+	})
+
+	if _, err := h.DeleteShowSeriesHandler(showSeriesAdminCtx(), &DeleteShowSeriesRequest{SeriesID: 8}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := <-done; got != "delete_show_series:show_series:8" {
+		t.Errorf("unexpected audit entry %q", got)
+	}
+}
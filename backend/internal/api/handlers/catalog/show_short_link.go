@@ -0,0 +1,91 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// showShortLinkRefRe is what a short link's ?ref= tag may look like. Links
+// shared where browsers send no Referer (app bios, QR codes, emails) can be
+// tagged instead, e.g. /s/0k3x9ab?ref=instagram-bio.
+var showShortLinkRefRe = regexp.MustCompile(`^[a-z0-9._-]{1,64}$`)
+
+// ShowShortLinkHandler serves show short links.
+type ShowShortLinkHandler struct {
+	shortLinkService contracts.ShowShortLinkServiceInterface
+	frontendURL      string
+}
+
+// NewShowShortLinkHandler creates a new show short link handler. frontendURL
+// is the site short links redirect to.
+func NewShowShortLinkHandler(shortLinkService contracts.ShowShortLinkServiceInterface, frontendURL string) *ShowShortLinkHandler {
+	return &ShowShortLinkHandler{
+		shortLinkService: shortLinkService,
+		frontendURL:      strings.TrimRight(frontendURL, "/"),
+	}
+}
+
+// RedirectHandler serves /s/{code}: a 302 to the show page, counting the
+// click-through by referrer. 302 rather than 301 so browsers don't cache the
+// redirect and skip the count next time.
+func (h *ShowShortLinkHandler) RedirectHandler(w http.ResponseWriter, r *http.Request) {
+	code := chi.URLParam(r, "code")
+	target, err := h.shortLinkService.ResolveShowShortCode(code)
+	if err != nil {
+		var showErr *apperrors.ShowError
+		if errors.As(err, &showErr) && showErr.Code == apperrors.CodeShowNotFound {
+			http.NotFound(w, r)
+			return
+		}
+		logger.FromContext(r.Context()).Error("show_short_link_resolve_failed",
+			"code", code,
+			"error", err.Error(),
+			"request_id", logger.GetRequestID(r.Context()),
+		)
+		http.Error(w, "failed to resolve short link", http.StatusInternalServerError)
+		return
+	}
+
+	// HEAD is link unfurlers and checkers, not people.
+	if r.Method != http.MethodHead {
+		referrer := shortLinkReferrer(r)
+		if err := h.shortLinkService.RecordShowShortLinkClick(target.ShowID, referrer); err != nil {
+			// Losing a count never costs the visitor their redirect.
+			logger.FromContext(r.Context()).Warn("show_short_link_click_failed",
+				"show_id", target.ShowID,
+				"referrer", referrer,
+				"error", err.Error(),
+			)
+		}
+	}
+
+	slug := target.Slug
+	if slug == "" {
+		slug = fmt.Sprint(target.ShowID)
+	}
+	http.Redirect(w, r, h.frontendURL+"/shows/"+url.PathEscape(slug), http.StatusFound)
+}
+
+// shortLinkReferrer names where a click came from: the link's ref tag when
+// it has a valid one, else the referring host (without "www."), else ""
+// (recorded as direct).
+func shortLinkReferrer(r *http.Request) string {
+	if ref := strings.ToLower(r.URL.Query().Get("ref")); showShortLinkRefRe.MatchString(ref) {
+		return ref
+	}
+	u, err := url.Parse(r.Referer())
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
@@ -0,0 +1,154 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+type shortLinkClick struct {
+	ShowID   uint
+	Referrer string
+}
+
+func shortLinkRequest(method, target, code string) *http.Request {
+	r := httptest.NewRequest(method, target, nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("code", code)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
+func testShortLinkHandler(clicks *[]shortLinkClick) *ShowShortLinkHandler {
+	return NewShowShortLinkHandler(&testhelpers.MockShowShortLinkService{
+		ResolveShowShortCodeFn: func(code string) (*contracts.ShowShortLinkTarget, error) {
+			switch code {
+			case "0k3x9ab":
+				return &contracts.ShowShortLinkTarget{ShowID: 42, Slug: "cursive-valley-bar"}, nil
+			case "noslug1":
+				return &contracts.ShowShortLinkTarget{ShowID: 43}, nil
+			case "broken1":
+				return nil, fmt.Errorf("db down")
+			}
+			return nil, apperrors.ErrShowNotFound(0)
+		},
+		RecordShowShortLinkClickFn: func(showID uint, referrer string) error {
+			*clicks = append(*clicks, shortLinkClick{showID, referrer})
+			return nil
+		},
+	}, "https://psychichomily.com/")
+}
+
+func TestShowShortLinkRedirect(t *testing.T) {
+	var clicks []shortLinkClick
+	h := testShortLinkHandler(&clicks)
+
+	r := shortLinkRequest(http.MethodGet, "/s/0k3x9ab", "0k3x9ab")
+	r.Header.Set("Referer", "https://www.Instagram.com/valleybarphx/")
+	w := httptest.NewRecorder()
+	h.RedirectHandler(w, r)
+
+	if w.Code != http.StatusFound {
+		t.Fatalf("expected 302, got %d", w.Code)
+	}
+	if loc := w.Header().Get("Location"); loc != "https://psychichomily.com/shows/cursive-valley-bar" {
+		t.Errorf("unexpected Location %q", loc)
+	}
+	if len(clicks) != 1 || clicks[0] != (shortLinkClick{42, "instagram.com"}) {
+		t.Errorf("unexpected clicks %+v", clicks)
+	}
+}
+
+func TestShowShortLinkRedirect_NoSlugUsesID(t *testing.T) {
+	var clicks []shortLinkClick
+	h := testShortLinkHandler(&clicks)
+
+	w := httptest.NewRecorder()
+	h.RedirectHandler(w, shortLinkRequest(http.MethodGet, "/s/noslug1", "noslug1"))
+
+	if loc := w.Header().Get("Location"); loc != "https://psychichomily.com/shows/43" {
+		t.Errorf("unexpected Location %q", loc)
+	}
+	if len(clicks) != 1 || clicks[0].Referrer != "" {
+		t.Errorf("a click without a referrer should be recorded as direct: %+v", clicks)
+	}
+}
+
+func TestShowShortLinkRedirect_RefTag(t *testing.T) {
+	cases := map[string]string{
+		"/s/0k3x9ab?ref=Instagram-Bio":    "instagram-bio",
+		"/s/0k3x9ab?ref=bad%20tag":        "example.org",
+		"/s/0k3x9ab?ref=":                 "example.org",
+		"/s/0k3x9ab?ref=flyer.qr_code-v2": "flyer.qr_code-v2",
+	}
+	for target, want := range cases {
+		var clicks []shortLinkClick
+		h := testShortLinkHandler(&clicks)
+		r := shortLinkRequest(http.MethodGet, target, "0k3x9ab")
+		r.Header.Set("Referer", "https://example.org/post")
+		h.RedirectHandler(httptest.NewRecorder(), r)
+		if len(clicks) != 1 || clicks[0].Referrer != want {
+			t.Errorf("%s: clicks = %+v, want referrer %q", target, clicks, want)
+		}
+	}
+}
+
+func TestShowShortLinkRedirect_HeadNotCounted(t *testing.T) {
+	var clicks []shortLinkClick
+	h := testShortLinkHandler(&clicks)
+
+	w := httptest.NewRecorder()
+	h.RedirectHandler(w, shortLinkRequest(http.MethodHead, "/s/0k3x9ab", "0k3x9ab"))
+
+	if w.Code != http.StatusFound {
+		t.Errorf("expected 302, got %d", w.Code)
+	}
+	if len(clicks) != 0 {
+		t.Errorf("HEAD should not count a click: %+v", clicks)
+	}
+}
+
+func TestShowShortLinkRedirect_ClickFailureStillRedirects(t *testing.T) {
+	h := NewShowShortLinkHandler(&testhelpers.MockShowShortLinkService{
+		ResolveShowShortCodeFn: func(string) (*contracts.ShowShortLinkTarget, error) {
+			return &contracts.ShowShortLinkTarget{ShowID: 42, Slug: "cursive-valley-bar"}, nil
+		},
+		RecordShowShortLinkClickFn: func(uint, string) error {
+			return fmt.Errorf("db down")
+		},
+	}, "https://psychichomily.com")
+
+	w := httptest.NewRecorder()
+	h.RedirectHandler(w, shortLinkRequest(http.MethodGet, "/s/0k3x9ab", "0k3x9ab"))
+
+	if w.Code != http.StatusFound {
+		t.Errorf("expected 302, got %d", w.Code)
+	}
+}
+
+func TestShowShortLinkRedirect_Errors(t *testing.T) {
+	var clicks []shortLinkClick
+	h := testShortLinkHandler(&clicks)
+
+	w := httptest.NewRecorder()
+	h.RedirectHandler(w, shortLinkRequest(http.MethodGet, "/s/zzzzzzz", "zzzzzzz"))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unknown code: expected 404, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.RedirectHandler(w, shortLinkRequest(http.MethodGet, "/s/broken1", "broken1"))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("service error: expected 500, got %d", w.Code)
+	}
+	if len(clicks) != 0 {
+		t.Errorf("failed lookups should not count clicks: %+v", clicks)
+	}
+}
@@ -0,0 +1,230 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+	servicesshared "psychic-homily-backend/internal/services/shared"
+)
+
+// maxStatusReasonLength caps a cancellation or postponement reason.
+const maxStatusReasonLength = 500
+
+// SetShowChangeNotifier wires notifications to a show's savers and RSVPs
+// when it is cancelled or postponed. Nil-safe: when unset, nobody is told.
+func (h *ShowHandler) SetShowChangeNotifier(showChangeNotifier contracts.ShowChangeNotifierInterface) {
+	h.showChangeNotifier = showChangeNotifier
+}
+
+// notifyShowChange tells the show's savers and RSVPs about its new status.
+// Fire-and-forget, like recordFlagRevision.
+func (h *ShowHandler) notifyShowChange(ctx context.Context, showID, actorID uint) {
+	if h.showChangeNotifier == nil {
+		return
+	}
+	servicesshared.GoSafe(ctx, "notify_show_change", func() {
+		if err := h.showChangeNotifier.NotifyShowChange(showID, actorID); err != nil {
+			logger.Default().Error("notify_show_change_failed",
+				"show_id", showID,
+				"error", err.Error(),
+			)
+		}
+	})
+}
+
+// CancelShowRequest represents the HTTP request for cancelling a show
+type CancelShowRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+	Body   struct {
+		Reason string `json:"reason,omitempty" required:"false" doc:"Why the show was cancelled, shown on the show page and in the email to people who saved it"`
+	}
+}
+
+// CancelShowResponse represents the HTTP response for cancelling a show
+type CancelShowResponse struct {
+	Body contracts.ShowResponse `json:"body"`
+}
+
+// CancelShowHandler handles POST /shows/{show_id}/cancel
+// Cancels the show with an optional reason and notifies the users who saved
+// or RSVP'd to it. Allows admin, the show submitter or a venue manager, like
+// the status flags.
+func (h *ShowHandler) CancelShowHandler(ctx context.Context, req *CancelShowRequest) (*CancelShowResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	// Require authentication
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	var v shared.Validator
+	v.MaxLength("reason", &req.Body.Reason, maxStatusReasonLength,
+		fmt.Sprintf("Reason must be %d characters or fewer", maxStatusReasonLength))
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// Parse show ID
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	// Get the show to check ownership
+	show, err := h.showService.GetShow(uint(showID))
+	if err != nil {
+		var showErr *apperrors.ShowError
+		if errors.As(err, &showErr) && showErr.Code == apperrors.CodeShowNotFound {
+			return nil, huma.Error404NotFound("Show not found")
+		}
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get show (request_id: %s)", requestID),
+		)
+	}
+
+	if !h.canUpdateShowFlags(ctx, show, user.ID, user.IsAdmin) {
+		logger.FromContext(ctx).Warn("cancel_show_unauthorized",
+			"show_id", showID,
+			"user_id", user.ID,
+			"request_id", requestID,
+		)
+		return nil, huma.Error403Forbidden("Only the show submitter, a venue manager or an admin can update this show")
+	}
+
+	updatedShow, err := h.showStateService.CancelShow(uint(showID), req.Body.Reason)
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			logger.FromContext(ctx).Warn("cancel_show_rejected",
+				"show_id", showID,
+				"error", err.Error(),
+				"request_id", requestID,
+			)
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("cancel_show_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to cancel show (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("cancel_show_success",
+		"show_id", showID,
+		"user_id", user.ID,
+		"request_id", requestID,
+	)
+
+	h.recordFlagRevision(ctx, uint(showID), user.ID, "is_cancelled", show.IsCancelled, true)
+	h.notifyShowChange(ctx, uint(showID), user.ID)
+
+	return &CancelShowResponse{Body: *updatedShow}, nil
+}
+
+// PostponeShowRequest represents the HTTP request for postponing a show
+type PostponeShowRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+	Body   struct {
+		Reason       string     `json:"reason,omitempty" required:"false" doc:"Why the show was postponed. Omit when adding the new date to keep the reason given earlier."`
+		NewEventDate *time.Time `json:"new_event_date,omitempty" required:"false" doc:"The new date (RFC3339). Creates the rescheduled show, linked to this one. Omit if the new date isn't known yet; postpone again once it is."`
+	}
+}
+
+// PostponeShowResponse represents the HTTP response for postponing a show.
+// Body is the postponed show; its rescheduled_show_id points to the new one.
+type PostponeShowResponse struct {
+	Body contracts.ShowResponse `json:"body"`
+}
+
+// PostponeShowHandler handles POST /shows/{show_id}/postpone
+// Postpones the show with an optional reason and new date, and notifies the
+// users who saved or RSVP'd to it. Allows admin, the show submitter or a
+// venue manager, like the status flags.
+func (h *ShowHandler) PostponeShowHandler(ctx context.Context, req *PostponeShowRequest) (*PostponeShowResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	// Require authentication
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	var v shared.Validator
+	v.MaxLength("reason", &req.Body.Reason, maxStatusReasonLength,
+		fmt.Sprintf("Reason must be %d characters or fewer", maxStatusReasonLength))
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// Parse show ID
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	// Get the show to check ownership
+	show, err := h.showService.GetShow(uint(showID))
+	if err != nil {
+		var showErr *apperrors.ShowError
+		if errors.As(err, &showErr) && showErr.Code == apperrors.CodeShowNotFound {
+			return nil, huma.Error404NotFound("Show not found")
+		}
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get show (request_id: %s)", requestID),
+		)
+	}
+
+	if !h.canUpdateShowFlags(ctx, show, user.ID, user.IsAdmin) {
+		logger.FromContext(ctx).Warn("postpone_show_unauthorized",
+			"show_id", showID,
+			"user_id", user.ID,
+			"request_id", requestID,
+		)
+		return nil, huma.Error403Forbidden("Only the show submitter, a venue manager or an admin can update this show")
+	}
+
+	updatedShow, err := h.showStateService.PostponeShow(uint(showID), req.Body.Reason, req.Body.NewEventDate)
+	if err != nil {
+		if mapped := shared.MapShowError(err); mapped != nil {
+			logger.FromContext(ctx).Warn("postpone_show_rejected",
+				"show_id", showID,
+				"error", err.Error(),
+				"request_id", requestID,
+			)
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("postpone_show_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to postpone show (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("postpone_show_success",
+		"show_id", showID,
+		"rescheduled_show_id", updatedShow.RescheduledShowID,
+		"user_id", user.ID,
+		"request_id", requestID,
+	)
+
+	h.recordFlagRevision(ctx, uint(showID), user.ID, "is_postponed", show.IsPostponed, true)
+	h.notifyShowChange(ctx, uint(showID), user.ID)
+
+	return &PostponeShowResponse{Body: *updatedShow}, nil
+}
@@ -0,0 +1,173 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestCancelShowHandler_NoAuth(t *testing.T) {
+	h := testShowHandler()
+
+	_, err := h.CancelShowHandler(context.Background(), &CancelShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestCancelShowHandler_ReasonTooLong(t *testing.T) {
+	h := testShowHandler()
+	req := &CancelShowRequest{ShowID: "1"}
+	req.Body.Reason = strings.Repeat("a", maxStatusReasonLength+1)
+
+	_, err := h.CancelShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 1}), req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestCancelShowHandler_InvalidID(t *testing.T) {
+	h := testShowHandler()
+
+	_, err := h.CancelShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 1}), &CancelShowRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestCancelShowHandler_NotFound(t *testing.T) {
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(showID uint) (*contracts.ShowResponse, error) {
+			return nil, apperrors.ErrShowNotFound(showID)
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.CancelShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 1}), &CancelShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestCancelShowHandler_NotOwner(t *testing.T) {
+	h := NewShowHandler(ownedShowMock(99), nil, nil, nil, nil, nil, nil)
+
+	_, err := h.CancelShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &CancelShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
+func TestCancelShowHandler_Success(t *testing.T) {
+	var gotReason string
+	stateMock := &testhelpers.MockShowStateService{
+		CancelShowFn: func(showID uint, reason string) (*contracts.ShowResponse, error) {
+			gotReason = reason
+			return &contracts.ShowResponse{ID: showID, IsCancelled: true, StatusReason: &reason}, nil
+		},
+	}
+	notified := make(chan [2]uint, 1)
+	h := NewShowHandler(ownedShowMock(5), stateMock, nil, nil, nil, nil, nil)
+	h.SetShowChangeNotifier(&testhelpers.MockShowChangeNotifier{
+		NotifyShowChangeFn: func(showID, actorID uint) error {
+			notified <- [2]uint{showID, actorID}
+			return nil
+		},
+	})
+	req := &CancelShowRequest{ShowID: "1"}
+	req.Body.Reason = "Venue flooded"
+
+	resp, err := h.CancelShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.IsCancelled {
+		t.Error("expected is_cancelled=true")
+	}
+	if gotReason != "Venue flooded" {
+		t.Errorf("expected reason to be passed through, got %q", gotReason)
+	}
+	select {
+	case got := <-notified:
+		if got != [2]uint{1, 5} {
+			t.Errorf("expected notification for show 1 by user 5, got %v", got)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected savers to be notified")
+	}
+}
+
+func TestCancelShowHandler_Rejected(t *testing.T) {
+	stateMock := &testhelpers.MockShowStateService{
+		CancelShowFn: func(_ uint, _ string) (*contracts.ShowResponse, error) {
+			return nil, apperrors.ErrShowValidationFailed("show was rescheduled")
+		},
+	}
+	h := NewShowHandler(ownedShowMock(5), stateMock, nil, nil, nil, nil, nil)
+
+	_, err := h.CancelShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &CancelShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestCancelShowHandler_ServiceError(t *testing.T) {
+	stateMock := &testhelpers.MockShowStateService{
+		CancelShowFn: func(_ uint, _ string) (*contracts.ShowResponse, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	}
+	h := NewShowHandler(ownedShowMock(5), stateMock, nil, nil, nil, nil, nil)
+
+	_, err := h.CancelShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &CancelShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestPostponeShowHandler_NoAuth(t *testing.T) {
+	h := testShowHandler()
+
+	_, err := h.PostponeShowHandler(context.Background(), &PostponeShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestPostponeShowHandler_NotOwner(t *testing.T) {
+	h := NewShowHandler(ownedShowMock(99), nil, nil, nil, nil, nil, nil)
+
+	_, err := h.PostponeShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &PostponeShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
+func TestPostponeShowHandler_Success(t *testing.T) {
+	newDate := time.Date(2030, 9, 13, 3, 0, 0, 0, time.UTC)
+	rescheduledID := uint(2)
+	var gotReason string
+	var gotDate *time.Time
+	stateMock := &testhelpers.MockShowStateService{
+		PostponeShowFn: func(showID uint, reason string, newEventDate *time.Time) (*contracts.ShowResponse, error) {
+			gotReason, gotDate = reason, newEventDate
+			return &contracts.ShowResponse{ID: showID, IsPostponed: true, RescheduledShowID: &rescheduledID}, nil
+		},
+	}
+	h := NewShowHandler(ownedShowMock(5), stateMock, nil, nil, nil, nil, nil)
+	req := &PostponeShowRequest{ShowID: "1"}
+	req.Body.Reason = "Illness"
+	req.Body.NewEventDate = &newDate
+
+	resp, err := h.PostponeShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.IsPostponed || resp.Body.RescheduledShowID == nil || *resp.Body.RescheduledShowID != 2 {
+		t.Errorf("expected postponed show linked to show 2, got %+v", resp.Body)
+	}
+	if gotReason != "Illness" || gotDate == nil || !gotDate.Equal(newDate) {
+		t.Errorf("expected reason and date to be passed through, got %q %v", gotReason, gotDate)
+	}
+}
+
+func TestPostponeShowHandler_Rejected(t *testing.T) {
+	stateMock := &testhelpers.MockShowStateService{
+		PostponeShowFn: func(_ uint, _ string, _ *time.Time) (*contracts.ShowResponse, error) {
+			return nil, apperrors.ErrShowValidationFailed("new event date must be in the future")
+		},
+	}
+	h := NewShowHandler(ownedShowMock(5), stateMock, nil, nil, nil, nil, nil)
+
+	_, err := h.PostponeShowHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &PostponeShowRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
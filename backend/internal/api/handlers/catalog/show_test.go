@@ -11,6 +11,7 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/api/middleware"
 	apperrors "psychic-homily-backend/internal/errors"
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
@@ -303,6 +304,51 @@ func TestResolve_InstagramHandleValid(t *testing.T) {
 	}
 }
 
+func TestResolve_TicketProvider(t *testing.T) {
+	name := "Test Artist"
+	venueName := "Test Venue"
+	newBody := func(provider string) *CreateShowRequestBody {
+		return &CreateShowRequestBody{
+			EventDate:      time.Now().UTC().AddDate(0, 0, 7),
+			City:           "Phoenix",
+			State:          "AZ",
+			TicketProvider: &provider,
+			Venues:         []Venue{{Name: &venueName}},
+			Artists:        []Artist{{Name: &name}},
+		}
+	}
+
+	if !hasErrorAt(newBody("ticketmaster").Resolve(nil), "body.ticket_provider") {
+		t.Error("expected a body.ticket_provider error for an unknown provider")
+	}
+	if errs := newBody("dice").Resolve(nil); hasErrorAt(errs, "body.ticket_provider") {
+		t.Errorf("dice must be accepted, got: %v", errs)
+	}
+}
+
+func TestResolve_DoorsTime(t *testing.T) {
+	name := "Test Artist"
+	venueName := "Test Venue"
+	eventDate := time.Now().UTC().AddDate(0, 0, 7)
+	newBody := func(doors time.Time) *CreateShowRequestBody {
+		return &CreateShowRequestBody{
+			EventDate: eventDate,
+			DoorsTime: &doors,
+			City:      "Phoenix",
+			State:     "AZ",
+			Venues:    []Venue{{Name: &venueName}},
+			Artists:   []Artist{{Name: &name}},
+		}
+	}
+
+	if !hasErrorAt(newBody(eventDate.Add(time.Hour)).Resolve(nil), "body.doors_time") {
+		t.Error("expected a body.doors_time error for doors after the event")
+	}
+	if errs := newBody(eventDate.Add(-time.Hour)).Resolve(nil); hasErrorAt(errs, "body.doors_time") {
+		t.Errorf("doors before the event must be accepted, got: %v", errs)
+	}
+}
+
 // --- ExportShowHandler ---
 
 func TestExportShowHandler_NonDevEnvironment(t *testing.T) {
@@ -436,6 +482,63 @@ func TestGetShowsHandler_Success(t *testing.T) {
 	}
 }
 
+func TestGetShowsHandler_FillsGoingCounts(t *testing.T) {
+	mock := &testhelpers.MockShowService{
+		GetShowsFn: func(filters map[string]interface{}) ([]*contracts.ShowResponse, error) {
+			return []*contracts.ShowResponse{{ID: 1}, {ID: 2}}, nil
+		},
+	}
+	calls := 0
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+	h.SetShowRSVPService(&testhelpers.MockShowRSVPService{
+		GetBatchGoingCountsFn: func(ids []uint) (map[uint]int, error) {
+			calls++
+			if len(ids) != 2 {
+				t.Errorf("expected both shows in one lookup, got %v", ids)
+			}
+			return map[uint]int{1: 4, 2: 0}, nil
+		},
+	})
+
+	resp, err := h.GetShowsHandler(context.Background(), &GetShowsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single batch lookup, got %d", calls)
+	}
+	for _, show := range resp.Body {
+		if show.GoingCount == nil {
+			t.Fatalf("expected going_count on show %d", show.ID)
+		}
+	}
+	if *resp.Body[0].GoingCount != 4 || *resp.Body[1].GoingCount != 0 {
+		t.Errorf("expected going counts 4 and 0, got %d and %d", *resp.Body[0].GoingCount, *resp.Body[1].GoingCount)
+	}
+}
+
+func TestGetShowsHandler_GoingCountFailureKeepsList(t *testing.T) {
+	mock := &testhelpers.MockShowService{
+		GetShowsFn: func(filters map[string]interface{}) ([]*contracts.ShowResponse, error) {
+			return []*contracts.ShowResponse{{ID: 1}}, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+	h.SetShowRSVPService(&testhelpers.MockShowRSVPService{
+		GetBatchGoingCountsFn: func([]uint) (map[uint]int, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	})
+
+	resp, err := h.GetShowsHandler(context.Background(), &GetShowsRequest{})
+	if err != nil {
+		t.Fatalf("a failed count lookup must not fail the read: %v", err)
+	}
+	if resp.Body[0].GoingCount != nil {
+		t.Error("expected going_count to be omitted when the lookup fails")
+	}
+}
+
 func TestGetShowsHandler_ServiceError(t *testing.T) {
 	mock := &testhelpers.MockShowService{
 		GetShowsFn: func(_ map[string]interface{}) ([]*contracts.ShowResponse, error) {
@@ -448,6 +551,39 @@ func TestGetShowsHandler_ServiceError(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 500)
 }
 
+func TestGetShowsHandler_CityScope(t *testing.T) {
+	var got map[string]interface{}
+	mock := &testhelpers.MockShowService{
+		GetShowsFn: func(filters map[string]interface{}) ([]*contracts.ShowResponse, error) {
+			got = filters
+			return nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+	ctx := context.WithValue(context.Background(), middleware.CityScopeContextKey, []contracts.CityStateFilter{{City: "Tucson", State: "AZ"}})
+
+	if _, err := h.GetShowsHandler(ctx, &GetShowsRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cities, _ := got["cities"].([]contracts.CityStateFilter); len(cities) != 1 || cities[0].City != "Tucson" {
+		t.Errorf("expected the host's cities by default, got %+v", got)
+	}
+
+	if _, err := h.GetShowsHandler(ctx, &GetShowsRequest{City: "Phoenix"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, scoped := got["cities"]; scoped || got["city"] != "Phoenix" {
+		t.Errorf("expected an explicit city to override the host's, got %+v", got)
+	}
+
+	if _, err := h.GetShowsHandler(ctx, &GetShowsRequest{Metro: "38060"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, scoped := got["cities"]; scoped || got["metro"] != "38060" {
+		t.Errorf("expected a metro to override the host's cities, got %+v", got)
+	}
+}
+
 // ============================================================================
 // Mock-based tests: GetShowCitiesHandler
 // ============================================================================
@@ -530,6 +666,207 @@ func TestGetUpcomingShowsHandler_ServiceError(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 500)
 }
 
+func TestGetUpcomingShowsHandler_NearFilter(t *testing.T) {
+	var got *contracts.UpcomingShowsFilter
+	mock := &testhelpers.MockShowService{
+		GetUpcomingShowsFn: func(_, _ string, _ int, _ bool, filters *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+			got = filters
+			return nil, nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, Near: "33.4484,-112.0740", RadiusKm: 25})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.Near == nil {
+		t.Fatalf("expected near filter, got %+v", got)
+	}
+	if got.Near.Latitude != 33.4484 || got.Near.Longitude != -112.0740 || got.Near.RadiusKm != 25 {
+		t.Errorf("unexpected near filter: %+v", got.Near)
+	}
+}
+
+func TestGetUpcomingShowsHandler_CityScope(t *testing.T) {
+	var got *contracts.UpcomingShowsFilter
+	mock := &testhelpers.MockShowService{
+		GetUpcomingShowsFn: func(_, _ string, _ int, _ bool, filters *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+			got = filters
+			return nil, nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+	ctx := context.WithValue(context.Background(), middleware.CityScopeContextKey, []contracts.CityStateFilter{{City: "Tucson", State: "AZ"}})
+
+	if _, err := h.GetUpcomingShowsHandler(ctx, &GetUpcomingShowsRequest{Limit: 50, Genre: "shoegaze"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || len(got.Cities) != 1 || got.Cities[0].City != "Tucson" || len(got.GenreSlugs) != 1 {
+		t.Fatalf("expected the host's cities alongside other filters, got %+v", got)
+	}
+
+	for _, req := range []*GetUpcomingShowsRequest{
+		{Limit: 50, Cities: "Phoenix,AZ"},
+		{Limit: 50, State: "NM"},
+		{Limit: 50, Near: "33.4484,-112.0740"},
+		{Limit: 50, Metro: "38060"},
+	} {
+		if _, err := h.GetUpcomingShowsHandler(ctx, req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, cs := range got.Cities {
+			if cs.City == "Tucson" {
+				t.Errorf("%+v: expected explicit location params to override the host's cities", req)
+			}
+		}
+	}
+}
+
+func TestGetUpcomingShowsHandler_MetroFilter(t *testing.T) {
+	var got *contracts.UpcomingShowsFilter
+	mock := &testhelpers.MockShowService{
+		GetUpcomingShowsFn: func(_, _ string, _ int, _ bool, filters *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+			got = filters
+			return nil, nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, Metro: "38060", Tags: "diy"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.Metro != "38060" || len(got.TagSlugs) != 1 {
+		t.Fatalf("expected metro=38060 alongside the tag filter, got %+v", got)
+	}
+}
+
+func TestGetUpcomingShowsHandler_AllAgesFilter(t *testing.T) {
+	var got *contracts.UpcomingShowsFilter
+	mock := &testhelpers.MockShowService{
+		GetUpcomingShowsFn: func(_, _ string, _ int, _ bool, filters *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+			got = filters
+			return nil, nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, AllAges: "false"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.AllAges == nil || *got.AllAges {
+		t.Fatalf("expected all_ages=false filter, got %+v", got)
+	}
+
+	got = nil
+	if _, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected no filters without all_ages, got %+v", got)
+	}
+}
+
+func TestGetUpcomingShowsHandler_MaxPriceFilter(t *testing.T) {
+	var got *contracts.UpcomingShowsFilter
+	mock := &testhelpers.MockShowService{
+		GetUpcomingShowsFn: func(_, _ string, _ int, _ bool, filters *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+			got = filters
+			return nil, nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	if _, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, MaxPrice: "20"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.MaxPrice == nil || *got.MaxPrice != 20 {
+		t.Fatalf("expected max_price=20 filter, got %+v", got)
+	}
+
+	_, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, MaxPrice: "cheap"})
+	testhelpers.AssertHumaError(t, err, 400)
+	_, err = h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, MaxPrice: "-5"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetUpcomingShowsHandler_GenreFilter(t *testing.T) {
+	var got *contracts.UpcomingShowsFilter
+	mock := &testhelpers.MockShowService{
+		GetUpcomingShowsFn: func(_, _ string, _ int, _ bool, filters *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+			got = filters
+			return nil, nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	if _, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, Genre: " Post-Punk, shoegaze,post-punk "}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || len(got.GenreSlugs) != 2 || got.GenreSlugs[0] != "post-punk" || got.GenreSlugs[1] != "shoegaze" {
+		t.Fatalf("expected genre slugs [post-punk shoegaze], got %+v", got)
+	}
+
+	got = nil
+	if _, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, Genre: " , "}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected no filter for a blank genre, got %+v", got)
+	}
+}
+
+func TestGetUpcomingShowsHandler_InvalidNear(t *testing.T) {
+	mock := &testhelpers.MockShowService{
+		GetUpcomingShowsFn: func(_, _ string, _ int, _ bool, _ *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+			t.Error("service should not be called")
+			return nil, nil, nil
+		},
+	}
+	h := NewShowHandler(mock, nil, nil, nil, nil, nil, nil)
+
+	_, err := h.GetUpcomingShowsHandler(context.Background(), &GetUpcomingShowsRequest{Limit: 50, Near: "phoenix"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestParseNearFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		near     string
+		radiusKm float64
+		want     *contracts.NearFilter
+		wantErr  bool
+	}{
+		{name: "no filter", near: ""},
+		{name: "default radius", near: "33.4484,-112.074", want: &contracts.NearFilter{Latitude: 33.4484, Longitude: -112.074, RadiusKm: defaultNearRadiusKm}},
+		{name: "explicit radius, spaces", near: " 40.7 , -74.0 ", radiusKm: 5, want: &contracts.NearFilter{Latitude: 40.7, Longitude: -74.0, RadiusKm: 5}},
+		{name: "radius without near", radiusKm: 10, wantErr: true},
+		{name: "one coordinate", near: "33.4", wantErr: true},
+		{name: "not numbers", near: "a,b", wantErr: true},
+		{name: "latitude out of range", near: "91,0", wantErr: true},
+		{name: "longitude out of range", near: "0,181", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseNearFilter(tt.near, tt.radiusKm)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("got %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || *got != *tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // Mock-based tests: CreateShowHandler
 // ============================================================================
@@ -561,6 +898,66 @@ func TestCreateShowHandler_Success(t *testing.T) {
 	}
 }
 
+func TestCreateShowHandler_Price(t *testing.T) {
+	var got *contracts.CreateShowRequest
+	showMock := &testhelpers.MockShowService{
+		CreateShowFn: func(req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+			got = req
+			return &contracts.ShowResponse{ID: 100, Status: "pending"}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, &testhelpers.MockSavedShowService{}, &testhelpers.MockDiscordService{}, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, EmailVerified: true})
+
+	venueID := uint(1)
+	artistName := "Test Band"
+	newReq := func() *CreateShowRequest {
+		req := &CreateShowRequest{}
+		req.Body.EventDate = time.Now().Add(24 * time.Hour)
+		req.Body.Venues = []Venue{{ID: &venueID}}
+		req.Body.Artists = []Artist{{Name: &artistName}}
+		return req
+	}
+
+	// Structured fields win over the legacy single price.
+	req := newReq()
+	legacy, minPrice, maxPrice, currency := 99.0, 12.0, 15.0, "eur"
+	req.Body.Price = &legacy
+	req.Body.PriceMin, req.Body.PriceMax, req.Body.PriceCurrency = &minPrice, &maxPrice, &currency
+	if errs := req.Body.Resolve(nil); len(errs) > 0 {
+		t.Fatalf("unexpected validation errors: %v", errs)
+	}
+	if _, err := h.CreateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.PriceMin == nil || *got.PriceMin != 12 || got.PriceMax == nil || *got.PriceMax != 15 || got.PriceCurrency != "EUR" || got.IsFree {
+		t.Errorf("unexpected structured price: %+v", got)
+	}
+
+	// A legacy price of 0 means free.
+	req = newReq()
+	zero := 0.0
+	req.Body.Price = &zero
+	if _, err := h.CreateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.IsFree || got.PriceMin != nil {
+		t.Errorf("expected legacy price 0 to map to free, got %+v", got)
+	}
+
+	// A range needs a floor, and the top can't be below it.
+	req = newReq()
+	req.Body.PriceMax = &maxPrice
+	if errs := req.Body.Resolve(nil); len(errs) == 0 {
+		t.Error("expected price_max without price_min to be rejected")
+	}
+	req = newReq()
+	req.Body.PriceMin, req.Body.PriceMax = &maxPrice, &minPrice
+	if errs := req.Body.Resolve(nil); len(errs) == 0 {
+		t.Error("expected price_max below price_min to be rejected")
+	}
+}
+
 func TestCreateShowHandler_AutoSave(t *testing.T) {
 	var savedUserID, savedShowID uint
 	showMock := &testhelpers.MockShowService{
@@ -599,6 +996,39 @@ func TestCreateShowHandler_AutoSave(t *testing.T) {
 	}
 }
 
+func TestCreateShowHandler_PromotesDraft(t *testing.T) {
+	var deletedUser, deletedDraft uint
+	showMock := &testhelpers.MockShowService{
+		CreateShowFn: func(_ *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 51, Status: "pending"}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, &testhelpers.MockSavedShowService{}, &testhelpers.MockDiscordService{}, nil, nil)
+	h.SetShowDraftService(&testhelpers.MockShowDraftService{
+		DeleteDraftFn: func(userID, draftID uint) error {
+			deletedUser, deletedDraft = userID, draftID
+			return nil
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 7, EmailVerified: true})
+
+	venueID := uint(1)
+	artistName := "Band"
+	draftID := uint(12)
+	req := &CreateShowRequest{}
+	req.Body.EventDate = time.Now().Add(24 * time.Hour)
+	req.Body.Venues = []Venue{{ID: &venueID}}
+	req.Body.Artists = []Artist{{Name: &artistName}}
+	req.Body.DraftID = &draftID
+
+	if _, err := h.CreateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deletedUser != 7 || deletedDraft != 12 {
+		t.Errorf("expected draft 12 of user 7 removed, got draft %d of user %d", deletedDraft, deletedUser)
+	}
+}
+
 func TestCreateShowHandler_ServiceError(t *testing.T) {
 	showMock := &testhelpers.MockShowService{
 		CreateShowFn: func(_ *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
@@ -621,6 +1051,164 @@ func TestCreateShowHandler_ServiceError(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 422)
 }
 
+func TestCreateShowHandler_ThrottleHoldsForReview(t *testing.T) {
+	var gotHold bool
+	showMock := &testhelpers.MockShowService{
+		CreateShowFn: func(req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+			gotHold = req.HoldForReview
+			return &contracts.ShowResponse{ID: 52, Status: "pending"}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, &testhelpers.MockSavedShowService{}, &testhelpers.MockDiscordService{}, nil, nil)
+	h.SetSubmissionThrottle(&testhelpers.MockSubmissionThrottleService{
+		CheckSubmissionFn: func(_ *authm.User) (*contracts.SubmissionDecision, error) {
+			return &contracts.SubmissionDecision{Hold: true, Reason: "burst"}, nil
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 7, EmailVerified: true})
+
+	venueID := uint(1)
+	artistName := "Band"
+	req := &CreateShowRequest{}
+	req.Body.EventDate = time.Now().Add(24 * time.Hour)
+	req.Body.Venues = []Venue{{ID: &venueID}}
+	req.Body.Artists = []Artist{{Name: &artistName}}
+
+	if _, err := h.CreateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gotHold {
+		t.Error("expected the submission to be held for review")
+	}
+}
+
+func TestCreateShowHandler_ThrottleDailyCap(t *testing.T) {
+	showMock := &testhelpers.MockShowService{
+		CreateShowFn: func(_ *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+			t.Fatal("CreateShow should not be called over the cap")
+			return nil, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, nil, &testhelpers.MockDiscordService{}, nil, nil)
+	h.SetSubmissionThrottle(&testhelpers.MockSubmissionThrottleService{
+		CheckSubmissionFn: func(_ *authm.User) (*contracts.SubmissionDecision, error) {
+			return nil, apperrors.ErrSubmissionDailyCap(20, 3600)
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 7, EmailVerified: true})
+
+	venueID := uint(1)
+	artistName := "Band"
+	req := &CreateShowRequest{}
+	req.Body.EventDate = time.Now().Add(24 * time.Hour)
+	req.Body.Venues = []Venue{{ID: &venueID}}
+	req.Body.Artists = []Artist{{Name: &artistName}}
+
+	_, err := h.CreateShowHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 429)
+}
+
+func TestCreateShowHandler_ThrottleFailureFailsOpen(t *testing.T) {
+	created := false
+	showMock := &testhelpers.MockShowService{
+		CreateShowFn: func(req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+			created = true
+			if req.HoldForReview {
+				t.Error("submission should not be held when the throttle check fails")
+			}
+			return &contracts.ShowResponse{ID: 53, Status: "approved"}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, &testhelpers.MockSavedShowService{}, &testhelpers.MockDiscordService{}, nil, nil)
+	h.SetSubmissionThrottle(&testhelpers.MockSubmissionThrottleService{
+		CheckSubmissionFn: func(_ *authm.User) (*contracts.SubmissionDecision, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 7, EmailVerified: true})
+
+	venueID := uint(1)
+	artistName := "Band"
+	req := &CreateShowRequest{}
+	req.Body.EventDate = time.Now().Add(24 * time.Hour)
+	req.Body.Venues = []Venue{{ID: &venueID}}
+	req.Body.Artists = []Artist{{Name: &artistName}}
+
+	if _, err := h.CreateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !created {
+		t.Error("expected the show to be created")
+	}
+}
+
+func TestCreateShowHandler_HighTrustAutoApproved(t *testing.T) {
+	var auditAction string
+	showMock := &testhelpers.MockShowService{
+		CreateShowFn: func(req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+			if req.HoldForReview {
+				t.Error("high-trust submission should not be held")
+			}
+			return &contracts.ShowResponse{ID: 54, Status: "approved"}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, &testhelpers.MockSavedShowService{}, &testhelpers.MockDiscordService{}, nil, nil)
+	h.SetAuditLogService(&testhelpers.MockAuditLogService{
+		LogActionFn: func(actorID uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			if actorID != 7 || entityType != "show" || entityID != 54 {
+				t.Errorf("unexpected audit entry %d %q %d", actorID, entityType, entityID)
+			}
+			auditAction = action
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 7, EmailVerified: true, SubmissionsApproved: 12})
+
+	venueID := uint(1)
+	artistName := "Band"
+	req := &CreateShowRequest{}
+	req.Body.EventDate = time.Now().Add(24 * time.Hour)
+	req.Body.Venues = []Venue{{ID: &venueID}}
+	req.Body.Artists = []Artist{{Name: &artistName}}
+
+	if _, err := h.CreateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auditAction != "auto_approve_show" {
+		t.Errorf("expected auto_approve_show audit entry, got %q", auditAction)
+	}
+}
+
+func TestCreateShowHandler_LowTrustHeld(t *testing.T) {
+	var gotHold bool
+	showMock := &testhelpers.MockShowService{
+		CreateShowFn: func(req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+			gotHold = req.HoldForReview
+			return &contracts.ShowResponse{ID: 55, Status: "pending"}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, &testhelpers.MockSavedShowService{}, &testhelpers.MockDiscordService{}, nil, nil)
+	h.SetAuditLogService(&testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) {
+			t.Errorf("unexpected audit entry %q", action)
+		},
+	})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 7, EmailVerified: true, SubmissionsApproved: 1, SubmissionsRejected: 4})
+
+	venueID := uint(1)
+	artistName := "Band"
+	req := &CreateShowRequest{}
+	req.Body.EventDate = time.Now().Add(24 * time.Hour)
+	req.Body.Venues = []Venue{{ID: &venueID}}
+	req.Body.Artists = []Artist{{Name: &artistName}}
+
+	if _, err := h.CreateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gotHold {
+		t.Error("expected the low-trust submission to be held for review")
+	}
+}
+
 // ============================================================================
 // Mock-based tests: UpdateShowHandler
 // ============================================================================
@@ -650,6 +1238,49 @@ func TestUpdateShowHandler_OwnerSuccess(t *testing.T) {
 	}
 }
 
+func TestUpdateShowHandler_LegacyFreePrice(t *testing.T) {
+	userID := uint(5)
+	var got *contracts.UpdateShowRequest
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &userID, Status: "pending"}, nil
+		},
+		UpdateShowWithRelationsFn: func(showID uint, updates *contracts.UpdateShowRequest, _ []contracts.CreateShowVenue, _ []contracts.CreateShowArtist, _ bool) (*contracts.ShowResponse, []contracts.OrphanedArtist, error) {
+			got = updates
+			return &contracts.ShowResponse{ID: showID}, nil, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, nil, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 5})
+	zero := 0.0
+	req := &UpdateShowRequest{ShowID: "1"}
+	req.Body.Price = &zero
+
+	if _, err := h.UpdateShowHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.IsFree == nil || !*got.IsFree || !got.ClearPrice || got.PriceMin != nil {
+		t.Errorf("expected legacy price 0 to clear the price and mark the show free, got %+v", got)
+	}
+}
+
+func TestUpdateShowHandler_InvalidCurrency(t *testing.T) {
+	userID := uint(5)
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &userID, Status: "pending"}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, nil, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 5})
+	currency := "dollars"
+	req := &UpdateShowRequest{ShowID: "1"}
+	req.Body.PriceCurrency = &currency
+
+	_, err := h.UpdateShowHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
 func TestUpdateShowHandler_AdminSuccess(t *testing.T) {
 	otherUser := uint(99)
 	showMock := &testhelpers.MockShowService{
@@ -744,6 +1375,24 @@ func TestUpdateShowHandler_SummaryTooLong(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 422)
 }
 
+func TestUpdateShowHandler_InvalidTicketProvider(t *testing.T) {
+	userID := uint(1)
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &userID}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, nil, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	provider := "ticketmaster"
+	req := &UpdateShowRequest{ShowID: "1"}
+	req.Body.TicketProvider = &provider
+
+	_, err := h.UpdateShowHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
 // PSY-563: when fields change and revisionService is wired, the handler
 // records a revision row with the diff and the user-supplied Summary.
 func TestUpdateShowHandler_RecordsRevisionOnChange(t *testing.T) {
@@ -1158,6 +1807,83 @@ func TestSetShowSoldOutHandler_Success(t *testing.T) {
 	}
 }
 
+func TestSetShowSoldOutHandler_RecordsRevision(t *testing.T) {
+	userID := uint(5)
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &userID}, nil
+		},
+	}
+	stateMock := &testhelpers.MockShowStateService{
+		SetShowSoldOutFn: func(showID uint, value bool) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: showID, IsSoldOut: value}, nil
+		},
+	}
+
+	var (
+		mu              sync.Mutex
+		recordedChanges []adminm.FieldChange
+	)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	revisionMock := &testhelpers.MockRevisionService{
+		RecordRevisionFn: func(_ string, _ uint, _ uint, changes []adminm.FieldChange, _ string) error {
+			mu.Lock()
+			recordedChanges = changes
+			mu.Unlock()
+			wg.Done()
+			return nil
+		},
+	}
+
+	h := NewShowHandler(showMock, stateMock, nil, nil, nil, nil, revisionMock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 5})
+	req := &SetShowSoldOutRequest{ShowID: "1"}
+	req.Body.Value = true
+
+	if _, err := h.SetShowSoldOutHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := adminm.FieldChange{Field: "is_sold_out", OldValue: false, NewValue: true}
+	if len(recordedChanges) != 1 || recordedChanges[0] != want {
+		t.Errorf("expected %+v, got %+v", want, recordedChanges)
+	}
+}
+
+func TestSetShowCancelledHandler_UnchangedSkipsRevision(t *testing.T) {
+	userID := uint(5)
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &userID, IsCancelled: true}, nil
+		},
+	}
+	stateMock := &testhelpers.MockShowStateService{
+		SetShowCancelledFn: func(showID uint, value bool) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: showID, IsCancelled: value}, nil
+		},
+	}
+	revisionMock := &testhelpers.MockRevisionService{
+		RecordRevisionFn: func(_ string, _ uint, _ uint, _ []adminm.FieldChange, _ string) error {
+			t.Error("RecordRevision should not be called when the flag is unchanged")
+			return nil
+		},
+	}
+
+	h := NewShowHandler(showMock, stateMock, nil, nil, nil, nil, revisionMock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 5})
+	req := &SetShowCancelledRequest{ShowID: "1"}
+	req.Body.Value = true
+
+	if _, err := h.SetShowCancelledHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestSetShowSoldOutHandler_NotOwner(t *testing.T) {
 	otherUser := uint(99)
 	showMock := &testhelpers.MockShowService{
@@ -1174,6 +1900,56 @@ func TestSetShowSoldOutHandler_NotOwner(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 403)
 }
 
+func TestSetShowSoldOutHandler_VenueManager(t *testing.T) {
+	otherUser := uint(99)
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &otherUser}, nil
+		},
+	}
+	stateMock := &testhelpers.MockShowStateService{
+		SetShowSoldOutFn: func(showID uint, value bool) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: showID, IsSoldOut: value}, nil
+		},
+	}
+	h := NewShowHandler(showMock, stateMock, nil, nil, nil, nil, nil)
+	h.SetVenueClaimService(&testhelpers.MockVenueClaimService{
+		IsShowVenueManagerFn: func(userID, showID uint) (bool, error) {
+			return userID == 5 && showID == 1, nil
+		},
+	})
+	req := &SetShowSoldOutRequest{ShowID: "1"}
+	req.Body.Value = true
+
+	resp, err := h.SetShowSoldOutHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.IsSoldOut {
+		t.Error("expected is_sold_out=true")
+	}
+
+	_, err = h.SetShowSoldOutHandler(testhelpers.CtxWithUser(&authm.User{ID: 6}), req)
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
+func TestSetShowSoldOutHandler_VenueManagerLookupFailureDenies(t *testing.T) {
+	otherUser := uint(99)
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &otherUser}, nil
+		},
+	}
+	h := NewShowHandler(showMock, nil, nil, nil, nil, nil, nil)
+	h.SetVenueClaimService(&testhelpers.MockVenueClaimService{
+		IsShowVenueManagerFn: func(uint, uint) (bool, error) { return false, fmt.Errorf("db down") },
+	})
+	req := &SetShowSoldOutRequest{ShowID: "1"}
+
+	_, err := h.SetShowSoldOutHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
 // ============================================================================
 // Mock-based tests: SetShowCancelledHandler
 // ============================================================================
@@ -1204,6 +1980,26 @@ func TestSetShowCancelledHandler_Success(t *testing.T) {
 	}
 }
 
+func TestSetShowCancelledHandler_RescheduledRejected(t *testing.T) {
+	userID := uint(5)
+	showMock := &testhelpers.MockShowService{
+		GetShowFn: func(_ uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: 1, SubmittedBy: &userID}, nil
+		},
+	}
+	stateMock := &testhelpers.MockShowStateService{
+		SetShowCancelledFn: func(uint, bool) (*contracts.ShowResponse, error) {
+			return nil, apperrors.ErrShowValidationFailed("show was rescheduled to show 2; cancel that show instead")
+		},
+	}
+	h := NewShowHandler(showMock, stateMock, nil, nil, nil, nil, nil)
+	req := &SetShowCancelledRequest{ShowID: "1"}
+	req.Body.Value = true
+
+	_, err := h.SetShowCancelledHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
 func TestSetShowCancelledHandler_NotOwner(t *testing.T) {
 	otherUser := uint(99)
 	showMock := &testhelpers.MockShowService{
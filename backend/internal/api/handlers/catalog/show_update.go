@@ -0,0 +1,151 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+	servicesshared "psychic-homily-backend/internal/services/shared"
+)
+
+// ShowUpdateHandler handles posting and deleting entries in a show's updates
+// feed. The feed itself is returned with the show detail response.
+type ShowUpdateHandler struct {
+	showService       contracts.ShowServiceInterface
+	updateService     contracts.ShowUpdateServiceInterface
+	venueClaimService contracts.VenueClaimServiceInterface
+	auditLogService   contracts.AuditLogServiceInterface
+}
+
+// NewShowUpdateHandler creates a new show update handler. venueClaimService
+// may be nil, in which case only admins and the show's submitter may post.
+func NewShowUpdateHandler(showService contracts.ShowServiceInterface, updateService contracts.ShowUpdateServiceInterface, venueClaimService contracts.VenueClaimServiceInterface, auditLogService contracts.AuditLogServiceInterface) *ShowUpdateHandler {
+	return &ShowUpdateHandler{
+		showService:       showService,
+		updateService:     updateService,
+		venueClaimService: venueClaimService,
+		auditLogService:   auditLogService,
+	}
+}
+
+// showUpdateError logs a show update failure and maps it to an HTTP error.
+func showUpdateError(ctx context.Context, op string, err error) error {
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Warn(op+"_failed",
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	if mapped := shared.MapShowUpdateError(err); mapped != nil {
+		return mapped
+	}
+	var showErr *apperrors.ShowError
+	if errors.As(err, &showErr) && showErr.Code == apperrors.CodeShowNotFound {
+		return huma.Error404NotFound("Show not found")
+	}
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to process show update (request_id: %s)", requestID),
+	)
+}
+
+// logAction writes a fire-and-forget audit entry against the show.
+func (h *ShowUpdateHandler) logAction(ctx context.Context, userID uint, action string, showID uint, metadata map[string]interface{}) {
+	if h.auditLogService == nil {
+		return
+	}
+	servicesshared.GoSafe(ctx, "audit_log", func() {
+		h.auditLogService.LogAction(userID, action, "show", showID, metadata)
+	})
+}
+
+// PostShowUpdateRequest represents the request for posting a show update
+type PostShowUpdateRequest struct {
+	ShowID uint `path:"show_id" minimum:"1" doc:"Show ID" example:"1"`
+	Body   struct {
+		Body string `json:"body" minLength:"1" maxLength:"1000" doc:"The update, e.g. a lineup change or a new set time"`
+	}
+}
+
+// ShowUpdateResponse represents a single show update
+type ShowUpdateResponse struct {
+	Body *contracts.ShowUpdateResponse
+}
+
+// PostShowUpdateHandler handles POST /shows/{show_id}/updates. Open to
+// admins, the show's submitter and managers of its venues.
+func (h *ShowUpdateHandler) PostShowUpdateHandler(ctx context.Context, req *PostShowUpdateRequest) (*ShowUpdateResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	show, err := h.showService.GetShow(req.ShowID)
+	if err != nil {
+		return nil, showUpdateError(ctx, "post_show_update", err)
+	}
+	if !canManageShow(ctx, h.venueClaimService, show, user.ID, user.IsAdmin) {
+		return nil, huma.Error403Forbidden("Only the show submitter, a venue manager or an admin can post updates on this show")
+	}
+
+	update, err := h.updateService.CreateUpdate(req.ShowID, user.ID, req.Body.Body)
+	if err != nil {
+		return nil, showUpdateError(ctx, "post_show_update", err)
+	}
+
+	h.logAction(ctx, user.ID, "post_show_update", req.ShowID, map[string]interface{}{
+		"update_id": update.ID,
+	})
+
+	logger.FromContext(ctx).Info("show_update_posted",
+		"update_id", update.ID,
+		"show_id", req.ShowID,
+		"user_id", user.ID,
+	)
+
+	return &ShowUpdateResponse{Body: update}, nil
+}
+
+// DeleteShowUpdateRequest represents the request for deleting a show update
+type DeleteShowUpdateRequest struct {
+	ShowID   uint `path:"show_id" minimum:"1" doc:"Show ID" example:"1"`
+	UpdateID uint `path:"update_id" minimum:"1" doc:"Show update ID" example:"1"`
+}
+
+// DeleteShowUpdateHandler handles DELETE /shows/{show_id}/updates/{update_id}.
+// The author may delete their own update; moderators may delete any, e.g.
+// after a report.
+func (h *ShowUpdateHandler) DeleteShowUpdateHandler(ctx context.Context, req *DeleteShowUpdateRequest) (*struct{}, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	update, err := h.updateService.GetUpdate(req.UpdateID)
+	if err != nil {
+		return nil, showUpdateError(ctx, "delete_show_update", err)
+	}
+	if update.ShowID != req.ShowID {
+		return nil, huma.Error404NotFound("Show update not found")
+	}
+	if update.UserID != user.ID && !user.Can(authm.PermissionModerateContent) {
+		return nil, huma.Error403Forbidden("Only the author or a moderator can delete this update")
+	}
+
+	if err := h.updateService.DeleteUpdate(req.UpdateID); err != nil {
+		return nil, showUpdateError(ctx, "delete_show_update", err)
+	}
+
+	h.logAction(ctx, user.ID, "delete_show_update", req.ShowID, map[string]interface{}{
+		"update_id": update.ID,
+		"author_id": update.UserID,
+	})
+
+	return nil, nil
+}
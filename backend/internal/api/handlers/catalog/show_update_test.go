@@ -0,0 +1,159 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func showUpdateTestShowService(submittedBy uint) *testhelpers.MockShowService {
+	return &testhelpers.MockShowService{
+		GetShowFn: func(showID uint) (*contracts.ShowResponse, error) {
+			return &contracts.ShowResponse{ID: showID, SubmittedBy: &submittedBy}, nil
+		},
+	}
+}
+
+func TestPostShowUpdateHandler_NoAuth(t *testing.T) {
+	h := NewShowUpdateHandler(showUpdateTestShowService(5), &testhelpers.MockShowUpdateService{}, nil, nil)
+	_, err := h.PostShowUpdateHandler(context.Background(), &PostShowUpdateRequest{ShowID: 3})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestPostShowUpdateHandler_ShowNotFound(t *testing.T) {
+	h := NewShowUpdateHandler(&testhelpers.MockShowService{
+		GetShowFn: func(showID uint) (*contracts.ShowResponse, error) { return nil, apperrors.ErrShowNotFound(showID) },
+	}, &testhelpers.MockShowUpdateService{}, nil, nil)
+	_, err := h.PostShowUpdateHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &PostShowUpdateRequest{ShowID: 3})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestPostShowUpdateHandler_Permissions(t *testing.T) {
+	cases := []struct {
+		name    string
+		user    *authm.User
+		manager bool
+		status  int
+	}{
+		{"submitter", &authm.User{ID: 5}, false, 0},
+		{"admin", &authm.User{ID: 1, IsAdmin: true}, false, 0},
+		{"venue manager", &authm.User{ID: 7}, true, 0},
+		{"anyone else", &authm.User{ID: 7}, false, 403},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewShowUpdateHandler(showUpdateTestShowService(5), &testhelpers.MockShowUpdateService{
+				CreateUpdateFn: func(showID, userID uint, body string) (*contracts.ShowUpdateResponse, error) {
+					return &contracts.ShowUpdateResponse{ID: 1, ShowID: showID, UserID: userID, Body: body}, nil
+				},
+			}, &testhelpers.MockVenueClaimService{
+				IsShowVenueManagerFn: func(uint, uint) (bool, error) { return tc.manager, nil },
+			}, nil)
+
+			req := &PostShowUpdateRequest{ShowID: 3}
+			req.Body.Body = "Moved to 8pm"
+			resp, err := h.PostShowUpdateHandler(testhelpers.CtxWithUser(tc.user), req)
+			if tc.status != 0 {
+				testhelpers.AssertHumaError(t, err, tc.status)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Body.Body != "Moved to 8pm" || resp.Body.UserID != tc.user.ID {
+				t.Errorf("unexpected body: %+v", resp.Body)
+			}
+		})
+	}
+}
+
+func TestPostShowUpdateHandler_AuditsPost(t *testing.T) {
+	done := make(chan string, 1)
+	h := NewShowUpdateHandler(showUpdateTestShowService(5), &testhelpers.MockShowUpdateService{
+		CreateUpdateFn: func(showID, userID uint, body string) (*contracts.ShowUpdateResponse, error) {
+			return &contracts.ShowUpdateResponse{ID: 9, ShowID: showID, UserID: userID, Body: body}, nil
+		},
+	}, nil, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			done <- fmt.Sprintf("%s:%s:%d", action, entityType, entityID)
+		},
+	})
+
+	req := &PostShowUpdateRequest{ShowID: 3}
+	req.Body.Body = "Moved to 8pm"
+	if _, err := h.PostShowUpdateHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := <-done; got != "post_show_update:show:3" {
+		t.Errorf("unexpected audit entry %q", got)
+	}
+}
+
+func TestPostShowUpdateHandler_InvalidBody(t *testing.T) {
+	h := NewShowUpdateHandler(showUpdateTestShowService(5), &testhelpers.MockShowUpdateService{
+		CreateUpdateFn: func(uint, uint, string) (*contracts.ShowUpdateResponse, error) {
+			return nil, apperrors.ErrShowUpdateInvalid("body is required")
+		},
+	}, nil, nil)
+	_, err := h.PostShowUpdateHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &PostShowUpdateRequest{ShowID: 3})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestDeleteShowUpdateHandler(t *testing.T) {
+	cases := []struct {
+		name   string
+		user   *authm.User
+		showID uint
+		status int
+	}{
+		{"author", &authm.User{ID: 5}, 3, 0},
+		{"moderator", &authm.User{ID: 8, Role: authm.RoleModerator}, 3, 0},
+		{"admin", &authm.User{ID: 1, IsAdmin: true}, 3, 0},
+		{"other user", &authm.User{ID: 7}, 3, 403},
+		{"wrong show", &authm.User{ID: 5}, 4, 404},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleted := false
+			h := NewShowUpdateHandler(nil, &testhelpers.MockShowUpdateService{
+				GetUpdateFn: func(updateID uint) (*contracts.ShowUpdateResponse, error) {
+					return &contracts.ShowUpdateResponse{ID: updateID, ShowID: 3, UserID: 5}, nil
+				},
+				DeleteUpdateFn: func(uint) error {
+					deleted = true
+					return nil
+				},
+			}, nil, nil)
+
+			_, err := h.DeleteShowUpdateHandler(testhelpers.CtxWithUser(tc.user), &DeleteShowUpdateRequest{ShowID: tc.showID, UpdateID: 9})
+			if tc.status != 0 {
+				testhelpers.AssertHumaError(t, err, tc.status)
+				if deleted {
+					t.Error("update should not have been deleted")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !deleted {
+				t.Error("expected the update to be deleted")
+			}
+		})
+	}
+}
+
+func TestDeleteShowUpdateHandler_NotFound(t *testing.T) {
+	h := NewShowUpdateHandler(nil, &testhelpers.MockShowUpdateService{
+		GetUpdateFn: func(updateID uint) (*contracts.ShowUpdateResponse, error) {
+			return nil, apperrors.ErrShowUpdateNotFound(updateID)
+		},
+	}, nil, nil)
+	_, err := h.DeleteShowUpdateHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &DeleteShowUpdateRequest{ShowID: 3, UpdateID: 9})
+	testhelpers.AssertHumaError(t, err, 404)
+}
@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/respond"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// SitemapHandler serves the sitemap XML documents crawlers read.
+type SitemapHandler struct {
+	sitemapService contracts.SitemapServiceInterface
+}
+
+// NewSitemapHandler creates a new sitemap handler
+func NewSitemapHandler(sitemapService contracts.SitemapServiceInterface) *SitemapHandler {
+	return &SitemapHandler{sitemapService: sitemapService}
+}
+
+// GetSitemapHandler serves /sitemap.xml: the full URL set, or a sitemap
+// index once the catalog outgrows a single file.
+func (h *SitemapHandler) GetSitemapHandler(w http.ResponseWriter, r *http.Request) {
+	h.serveSitemap(w, r, 0)
+}
+
+// GetSitemapPageHandler serves /sitemap-{page}.xml, the pages a sitemap
+// index points at.
+func (h *SitemapHandler) GetSitemapPageHandler(w http.ResponseWriter, r *http.Request) {
+	page, err := strconv.Atoi(chi.URLParam(r, "page"))
+	if err != nil || page < 1 {
+		http.NotFound(w, r)
+		return
+	}
+	h.serveSitemap(w, r, page)
+}
+
+func (h *SitemapHandler) serveSitemap(w http.ResponseWriter, r *http.Request, page int) {
+	doc, found, err := h.sitemapService.GetSitemap(page)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("sitemap_generation_failed",
+			"page", page,
+			"error", err.Error(),
+		)
+		http.Error(w, "failed to generate sitemap", http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	// Matches the server-side regeneration interval.
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	w.WriteHeader(http.StatusOK)
+	respond.SafeWrite(r.Context(), w, doc)
+}
@@ -0,0 +1,68 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+)
+
+func sitemapRequest(page string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/sitemap-"+page+".xml", nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("page", page)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestGetSitemapHandler(t *testing.T) {
+	h := NewSitemapHandler(&testhelpers.MockSitemapService{
+		GetSitemapFn: func(page int) ([]byte, bool, error) {
+			return []byte(fmt.Sprintf("<urlset page=\"%d\"/>", page)), page <= 2, nil
+		},
+	})
+
+	w := httptest.NewRecorder()
+	h.GetSitemapHandler(w, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/xml; charset=utf-8" {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+	if w.Body.String() != `<urlset page="0"/>` {
+		t.Errorf("unexpected body %q", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	h.GetSitemapPageHandler(w, sitemapRequest("2"))
+	if w.Code != http.StatusOK || w.Body.String() != `<urlset page="2"/>` {
+		t.Errorf("page 2: got %d %q", w.Code, w.Body.String())
+	}
+
+	for _, page := range []string{"3", "0"} {
+		w = httptest.NewRecorder()
+		h.GetSitemapPageHandler(w, sitemapRequest(page))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("page %s: expected 404, got %d", page, w.Code)
+		}
+	}
+}
+
+func TestGetSitemapHandler_ServiceError(t *testing.T) {
+	h := NewSitemapHandler(&testhelpers.MockSitemapService{
+		GetSitemapFn: func(int) ([]byte, bool, error) {
+			return nil, false, fmt.Errorf("db down")
+		},
+	})
+
+	w := httptest.NewRecorder()
+	h.GetSitemapHandler(w, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", w.Code)
+	}
+}
@@ -0,0 +1,86 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// SyncHandler serves the differential sync feeds used by the offline client.
+type SyncHandler struct {
+	syncService contracts.SyncServiceInterface
+}
+
+// NewSyncHandler creates a new sync handler.
+func NewSyncHandler(syncService contracts.SyncServiceInterface) *SyncHandler {
+	return &SyncHandler{syncService: syncService}
+}
+
+// SyncRequest is shared by the show, venue and artist feeds.
+type SyncRequest struct {
+	Since  string `query:"since" required:"false" doc:"Opaque cursor from a previous response's next_cursor. Omit for a full sync."`
+	Limit  int    `query:"limit" required:"false" minimum:"0" maximum:"1000" doc:"Maximum records per page (default 500, max 1000)"`
+	Cities string `query:"cities" required:"false" doc:"Limit the feed to these markets. Pipe-delimited pairs: 'Phoenix,AZ|Mesa,AZ'. Max 10 cities."`
+}
+
+// syncError logs a sync service failure and maps it to an HTTP error.
+func syncError(ctx context.Context, op string, err error) error {
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Warn(op+"_failed",
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	if mapped := shared.MapSyncError(err); mapped != nil {
+		return mapped
+	}
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to load changes (request_id: %s)", requestID),
+	)
+}
+
+// SyncShowsResponse wraps a page of show changes.
+type SyncShowsResponse struct {
+	Body *contracts.SyncShowDelta
+}
+
+// SyncShowsHandler handles GET /sync/shows
+func (h *SyncHandler) SyncShowsHandler(ctx context.Context, req *SyncRequest) (*SyncShowsResponse, error) {
+	delta, err := h.syncService.GetShowChanges(req.Since, req.Limit, parseCityStateFilters(req.Cities))
+	if err != nil {
+		return nil, syncError(ctx, "sync_shows", err)
+	}
+	return &SyncShowsResponse{Body: delta}, nil
+}
+
+// SyncVenuesResponse wraps a page of venue changes.
+type SyncVenuesResponse struct {
+	Body *contracts.SyncVenueDelta
+}
+
+// SyncVenuesHandler handles GET /sync/venues
+func (h *SyncHandler) SyncVenuesHandler(ctx context.Context, req *SyncRequest) (*SyncVenuesResponse, error) {
+	delta, err := h.syncService.GetVenueChanges(req.Since, req.Limit, parseCityStateFilters(req.Cities))
+	if err != nil {
+		return nil, syncError(ctx, "sync_venues", err)
+	}
+	return &SyncVenuesResponse{Body: delta}, nil
+}
+
+// SyncArtistsResponse wraps a page of artist changes.
+type SyncArtistsResponse struct {
+	Body *contracts.SyncArtistDelta
+}
+
+// SyncArtistsHandler handles GET /sync/artists
+func (h *SyncHandler) SyncArtistsHandler(ctx context.Context, req *SyncRequest) (*SyncArtistsResponse, error) {
+	delta, err := h.syncService.GetArtistChanges(req.Since, req.Limit, parseCityStateFilters(req.Cities))
+	if err != nil {
+		return nil, syncError(ctx, "sync_artists", err)
+	}
+	return &SyncArtistsResponse{Body: delta}, nil
+}
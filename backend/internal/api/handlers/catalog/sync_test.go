@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestSyncShowsHandler_ForwardsCursorLimitAndMarket(t *testing.T) {
+	var gotCursor string
+	var gotLimit int
+	var gotMarket []contracts.CityStateFilter
+	h := NewSyncHandler(&testhelpers.MockSyncService{
+		GetShowChangesFn: func(cursor string, limit int, market []contracts.CityStateFilter) (*contracts.SyncShowDelta, error) {
+			gotCursor, gotLimit, gotMarket = cursor, limit, market
+			return &contracts.SyncShowDelta{
+				Created:    []contracts.SyncShow{{ID: 1}},
+				Deleted:    []uint{9},
+				NextCursor: "next",
+			}, nil
+		},
+	})
+
+	resp, err := h.SyncShowsHandler(context.Background(), &SyncRequest{
+		Since:  "abc",
+		Limit:  50,
+		Cities: "Phoenix,AZ|Mesa,AZ",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotCursor != "abc" || gotLimit != 50 {
+		t.Errorf("cursor/limit not forwarded: %q %d", gotCursor, gotLimit)
+	}
+	if len(gotMarket) != 2 || gotMarket[1].City != "Mesa" {
+		t.Errorf("market not parsed: %+v", gotMarket)
+	}
+	if resp.Body.NextCursor != "next" || len(resp.Body.Created) != 1 || resp.Body.Deleted[0] != 9 {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestSyncShowsHandler_InvalidCursor(t *testing.T) {
+	h := NewSyncHandler(&testhelpers.MockSyncService{
+		GetShowChangesFn: func(string, int, []contracts.CityStateFilter) (*contracts.SyncShowDelta, error) {
+			return nil, apperrors.ErrSyncInvalidCursor(errors.New("bad"))
+		},
+	})
+
+	_, err := h.SyncShowsHandler(context.Background(), &SyncRequest{Since: "bad"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestSyncVenuesHandler_InternalError(t *testing.T) {
+	h := NewSyncHandler(&testhelpers.MockSyncService{
+		GetVenueChangesFn: func(string, int, []contracts.CityStateFilter) (*contracts.SyncVenueDelta, error) {
+			return nil, errors.New("db down")
+		},
+	})
+
+	_, err := h.SyncVenuesHandler(context.Background(), &SyncRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestSyncArtistsHandler_NoMarket(t *testing.T) {
+	called := false
+	h := NewSyncHandler(&testhelpers.MockSyncService{
+		GetArtistChangesFn: func(_ string, _ int, market []contracts.CityStateFilter) (*contracts.SyncArtistDelta, error) {
+			called = true
+			if market != nil {
+				t.Errorf("expected nil market, got %+v", market)
+			}
+			return &contracts.SyncArtistDelta{}, nil
+		},
+	})
+
+	if _, err := h.SyncArtistsHandler(context.Background(), &SyncRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("service not called")
+	}
+}
@@ -1,8 +1,6 @@
 package catalog
 
 import (
-	"strings"
-
 	"psychic-homily-backend/internal/services/catalog"
 	"psychic-homily-backend/internal/services/contracts"
 )
@@ -20,30 +18,17 @@ func parseTagFilter(tags, match string) catalog.TagFilter {
 	return catalog.ParseTagFilter(tags, match)
 }
 
+// parseGenreFilter normalizes the `genre=` query param into lowercased,
+// de-duplicated genre slugs. Empty input ⇒ nil (no genre filter).
+func parseGenreFilter(raw string) []string {
+	return catalog.ParseGenreFilter(raw)
+}
+
 // parseCityStateFilters turns the pipe-delimited "City,ST|City,ST" query
 // param into typed filters, using the same wire format as the /shows handler
 // (PSY-982 reuses it for the city-scoped tag facet). Malformed pairs (not
 // exactly city,state, or blank after trimming) are skipped. The list is
 // capped at maxCityFilters. Empty input ⇒ nil (no filter).
 func parseCityStateFilters(raw string) []contracts.CityStateFilter {
-	if raw == "" {
-		return nil
-	}
-	var filters []contracts.CityStateFilter
-	for _, pair := range strings.Split(raw, "|") {
-		parts := strings.Split(pair, ",")
-		if len(parts) != 2 {
-			continue
-		}
-		city := strings.TrimSpace(parts[0])
-		state := strings.TrimSpace(parts[1])
-		if city == "" || state == "" {
-			continue
-		}
-		filters = append(filters, contracts.CityStateFilter{City: city, State: state})
-		if len(filters) >= maxCityFilters {
-			break
-		}
-	}
-	return filters
+	return catalog.ParseCityStateFilters(raw, maxCityFilters)
 }
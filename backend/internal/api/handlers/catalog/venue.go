@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"psychic-homily-backend/internal/api/handlers/shared"
 	"psychic-homily-backend/internal/api/middleware"
@@ -23,6 +24,9 @@ type VenueHandler struct {
 	discordService  contracts.DiscordServiceInterface
 	auditLogService contracts.AuditLogServiceInterface
 	revisionService contracts.RevisionServiceInterface
+	// venueClaimService lets venue managers update their venue directly.
+	// Optional; see SetVenueClaimService.
+	venueClaimService contracts.VenueClaimServiceInterface
 }
 
 func NewVenueHandler(venueService contracts.VenueServiceInterface, discordService contracts.DiscordServiceInterface, auditLogService contracts.AuditLogServiceInterface, revisionService contracts.RevisionServiceInterface) *VenueHandler {
@@ -34,6 +38,12 @@ func NewVenueHandler(venueService contracts.VenueServiceInterface, discordServic
 	}
 }
 
+// SetVenueClaimService wires venue-manager permissions on venue updates.
+// Nil-safe: when unset, only admins may update a venue directly.
+func (h *VenueHandler) SetVenueClaimService(venueClaimService contracts.VenueClaimServiceInterface) {
+	h.venueClaimService = venueClaimService
+}
+
 type SearchVenuesRequest struct {
 	Query string `query:"q" maxLength:"200" doc:"Search query for venue autocomplete" example:"empty bottle"`
 }
@@ -63,6 +73,7 @@ type ListVenuesRequest struct {
 	State    string `query:"state" doc:"Filter by state" example:"AZ"`
 	City     string `query:"city" doc:"Filter by city" example:"Phoenix"`
 	Cities   string `query:"cities" doc:"Pipe-delimited multi-city filter (max 10): Phoenix,AZ|Tucson,AZ" example:"Phoenix,AZ|Tucson,AZ"`
+	Metro    string `query:"metro" required:"false" pattern:"^[0-9]{1,10}$" doc:"Only venues in this CBSA metro (the metro code from /shows/cities)" example:"38060"`
 	Limit    int    `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Maximum number of venues to return"`
 	Offset   int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
 	Tags     string `query:"tags" doc:"Comma-separated tag slugs. Multi-tag filter (PSY-309): AND by default; set tag_match=any for OR." example:"diy,phoenix"`
@@ -101,10 +112,14 @@ func (h *VenueHandler) ListVenuesHandler(ctx context.Context, req *ListVenuesReq
 			cityFilters = cityFilters[:10]
 		}
 		filters.Cities = cityFilters
-	} else {
+	} else if req.State != "" || req.City != "" {
 		filters.State = req.State
 		filters.City = req.City
+	} else if req.Metro == "" {
+		// No location asked for: a city subdomain's cities are the default.
+		filters.Cities = middleware.CityScopeFromContext(ctx)
 	}
+	filters.Metro = req.Metro
 	if tf := parseTagFilter(req.Tags, req.TagMatch); tf.HasTags() {
 		filters.TagSlugs = tf.TagSlugs
 		filters.TagMatchAny = tf.MatchAny
@@ -168,7 +183,9 @@ type GetVenueShowsRequest struct {
 	VenueID    string `path:"venue_id" doc:"Venue ID or slug" example:"valley-bar-phoenix-az"`
 	Timezone   string `query:"timezone" doc:"Timezone for date filtering" example:"America/Phoenix"`
 	Limit      int    `query:"limit" default:"20" minimum:"1" maximum:"200" doc:"Maximum number of shows to return (max 200)"`
+	Offset     int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
 	TimeFilter string `query:"time_filter" doc:"Filter shows by time: upcoming, past, or all" example:"upcoming" enum:"upcoming,past,all"`
+	Year       int    `query:"year" minimum:"0" doc:"Only shows in this calendar year (in the request timezone). 0 or omitted: every year." example:"2024"`
 }
 
 // GetVenueShowsResponse represents the response for the venue shows endpoint
@@ -176,7 +193,10 @@ type GetVenueShowsResponse struct {
 	Body struct {
 		Shows   []*contracts.VenueShowResponse `json:"shows" doc:"List of upcoming shows"`
 		VenueID uint                           `json:"venue_id" doc:"Venue ID"`
-		Total   int64                          `json:"total" doc:"Total number of upcoming shows"`
+		Total   int64                          `json:"total" doc:"Total number of shows matching filter"`
+		Limit   int                            `json:"limit"`
+		Offset  int                            `json:"offset"`
+		Years   []*contracts.ShowYearCount     `json:"years,omitempty" doc:"Past shows per year, most recent first (time_filter=past only)"`
 	}
 }
 
@@ -214,7 +234,12 @@ func (h *VenueHandler) GetVenueShowsHandler(ctx context.Context, req *GetVenueSh
 		venueID = venue.ID
 	}
 
-	shows, total, err := h.venueService.GetShowsForVenue(venueID, timezone, limit, timeFilter)
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	shows, total, err := h.venueService.GetShowsForVenuePage(venueID, timezone, limit, offset, timeFilter, req.Year)
 	if err != nil {
 		var venueErr *apperrors.VenueError
 		if errors.As(err, &venueErr) && venueErr.Code == apperrors.CodeVenueNotFound {
@@ -227,6 +252,18 @@ func (h *VenueHandler) GetVenueShowsHandler(ctx context.Context, req *GetVenueSh
 	resp.Body.Shows = shows
 	resp.Body.VenueID = venueID
 	resp.Body.Total = total
+	resp.Body.Limit = limit
+	resp.Body.Offset = offset
+
+	// The past-shows archive groups by year; the per-year counts always cover
+	// the whole archive so the year picker survives a year filter.
+	if timeFilter == "past" {
+		years, err := h.venueService.GetShowYearsForVenue(venueID, timezone, timeFilter)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to fetch show years", err)
+		}
+		resp.Body.Years = years
+	}
 
 	return resp, nil
 }
@@ -268,17 +305,21 @@ type AdminCreateVenueRequest struct {
 		Zipcode *string `json:"zipcode" required:"false" doc:"ZIP code" maxLength:"20"`
 		// PSY-1179: capacity + description were silently dropped on create — the
 		// service contract + CLI sent them but this HTTP body omitted them.
-		Capacity    *int    `json:"capacity" required:"false" minimum:"0" doc:"Venue capacity"`
-		Description *string `json:"description" required:"false" doc:"Markdown description (max 5000 chars)" maxLength:"5000"`
-		Instagram   *string `json:"instagram" required:"false" doc:"Instagram URL" maxLength:"255"`
-		Facebook    *string `json:"facebook" required:"false" doc:"Facebook URL" maxLength:"500"`
-		Twitter     *string `json:"twitter" required:"false" doc:"Twitter URL" maxLength:"255"`
-		YouTube     *string `json:"youtube" required:"false" doc:"YouTube URL" maxLength:"500"`
-		Spotify     *string `json:"spotify" required:"false" doc:"Spotify URL" maxLength:"500"`
-		SoundCloud  *string `json:"soundcloud" required:"false" doc:"SoundCloud URL" maxLength:"500"`
-		Bandcamp    *string `json:"bandcamp" required:"false" doc:"Bandcamp URL" maxLength:"500"`
-		Website     *string `json:"website" required:"false" doc:"Website URL" maxLength:"500"`
-		Country     *string `json:"country,omitempty" required:"false" doc:"Venue country" maxLength:"100"`
+		Capacity           *int    `json:"capacity" required:"false" minimum:"0" doc:"Venue capacity"`
+		AccessibilityNotes *string `json:"accessibility_notes,omitempty" required:"false" doc:"Accessibility notes (step-free entry, restrooms, seating)" maxLength:"1000"`
+		AllAges            *bool   `json:"all_ages,omitempty" required:"false" doc:"Whether the venue is all-ages; omit if unknown"`
+		DoorTime           *string `json:"door_time,omitempty" required:"false" doc:"Typical door time, local 24h HH:MM" pattern:"^(([01][0-9]|2[0-3]):[0-5][0-9])?$"`
+		Description        *string `json:"description" required:"false" doc:"Markdown description (max 5000 chars)" maxLength:"5000"`
+		Instagram          *string `json:"instagram" required:"false" doc:"Instagram URL" maxLength:"255"`
+		Facebook           *string `json:"facebook" required:"false" doc:"Facebook URL" maxLength:"500"`
+		Twitter            *string `json:"twitter" required:"false" doc:"Twitter URL" maxLength:"255"`
+		YouTube            *string `json:"youtube" required:"false" doc:"YouTube URL" maxLength:"500"`
+		Spotify            *string `json:"spotify" required:"false" doc:"Spotify URL" maxLength:"500"`
+		SoundCloud         *string `json:"soundcloud" required:"false" doc:"SoundCloud URL" maxLength:"500"`
+		Bandcamp           *string `json:"bandcamp" required:"false" doc:"Bandcamp URL" maxLength:"500"`
+		TikTok             *string `json:"tiktok" required:"false" doc:"TikTok handle or URL" maxLength:"255"`
+		Website            *string `json:"website" required:"false" doc:"Website URL" maxLength:"500"`
+		Country            *string `json:"country,omitempty" required:"false" doc:"Venue country" maxLength:"100"`
 	}
 }
 
@@ -293,31 +334,39 @@ func (h *VenueHandler) AdminCreateVenueHandler(ctx context.Context, req *AdminCr
 
 	user := middleware.GetUserFromContext(ctx)
 
-	// PSY-525: URL scheme validation (http/https only) for social URL fields.
-	if err := shared.ValidateSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
-		req.Body.YouTube, req.Body.Spotify, req.Body.SoundCloud, req.Body.Bandcamp, req.Body.Website); err != nil {
+	// Social links are canonicalized (handles become profile URLs) and
+	// validated: http/https, the platform's hosts, no link shorteners.
+	// Lengths and required fields are enforced by the struct tags.
+	var v shared.Validator
+	v.NormalizeSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
+		req.Body.YouTube, req.Body.Spotify, req.Body.SoundCloud, req.Body.Bandcamp, req.Body.TikTok, req.Body.Website)
+	if err := v.Err(); err != nil {
 		return nil, err
 	}
 
 	// Build service request
 	serviceReq := &contracts.CreateVenueRequest{
-		Name:        req.Body.Name,
-		City:        req.Body.City,
-		State:       req.Body.State,
-		Country:     req.Body.Country,
-		Address:     req.Body.Address,
-		Zipcode:     req.Body.Zipcode,
-		Capacity:    req.Body.Capacity,
-		Description: req.Body.Description,
-		Instagram:   req.Body.Instagram,
-		Facebook:    req.Body.Facebook,
-		Twitter:     req.Body.Twitter,
-		YouTube:     req.Body.YouTube,
-		Spotify:     req.Body.Spotify,
-		SoundCloud:  req.Body.SoundCloud,
-		Bandcamp:    req.Body.Bandcamp,
-		Website:     req.Body.Website,
-		SubmittedBy: &user.ID,
+		Name:               req.Body.Name,
+		City:               req.Body.City,
+		State:              req.Body.State,
+		Country:            req.Body.Country,
+		Address:            req.Body.Address,
+		Zipcode:            req.Body.Zipcode,
+		Capacity:           req.Body.Capacity,
+		AccessibilityNotes: req.Body.AccessibilityNotes,
+		AllAges:            req.Body.AllAges,
+		DoorTime:           req.Body.DoorTime,
+		Description:        req.Body.Description,
+		Instagram:          req.Body.Instagram,
+		Facebook:           req.Body.Facebook,
+		Twitter:            req.Body.Twitter,
+		YouTube:            req.Body.YouTube,
+		Spotify:            req.Body.Spotify,
+		SoundCloud:         req.Body.SoundCloud,
+		Bandcamp:           req.Body.Bandcamp,
+		TikTok:             req.Body.TikTok,
+		Website:            req.Body.Website,
+		SubmittedBy:        &user.ID,
 	}
 
 	venue, err := h.venueService.CreateVenue(serviceReq, true)
@@ -362,24 +411,29 @@ func (h *VenueHandler) AdminCreateVenueHandler(ctx context.Context, req *AdminCr
 type UpdateVenueRequest struct {
 	VenueID string `path:"venue_id" validate:"required" doc:"Venue ID"`
 	Body    struct {
-		Name        *string `json:"name,omitempty" required:"false" doc:"Venue name"`
-		Address     *string `json:"address,omitempty" required:"false" doc:"Venue address"`
-		City        *string `json:"city,omitempty" required:"false" doc:"Venue city"`
-		State       *string `json:"state,omitempty" required:"false" doc:"Venue state"`
-		Country     *string `json:"country,omitempty" required:"false" doc:"Venue country"`
-		Zipcode     *string `json:"zipcode,omitempty" required:"false" doc:"Venue zipcode"`
-		Capacity    *int    `json:"capacity,omitempty" required:"false" minimum:"0" doc:"Venue capacity"` // PSY-1179
-		Instagram   *string `json:"instagram,omitempty" required:"false" doc:"Instagram URL"`
-		Facebook    *string `json:"facebook,omitempty" required:"false" doc:"Facebook URL"`
-		Twitter     *string `json:"twitter,omitempty" required:"false" doc:"Twitter URL"`
-		YouTube     *string `json:"youtube,omitempty" required:"false" doc:"YouTube URL"`
-		Spotify     *string `json:"spotify,omitempty" required:"false" doc:"Spotify URL"`
-		SoundCloud  *string `json:"soundcloud,omitempty" required:"false" doc:"SoundCloud URL"`
-		Bandcamp    *string `json:"bandcamp,omitempty" required:"false" doc:"Bandcamp URL"`
-		Website     *string `json:"website,omitempty" required:"false" doc:"Website URL"`
-		Description *string `json:"description,omitempty" required:"false" doc:"Markdown description (max 5000 chars)"`
-		ImageURL    *string `json:"image_url,omitempty" required:"false" doc:"Venue photo URL (max 2048 chars)"`
-		Summary     *string `json:"summary,omitempty" required:"false" doc:"Revision summary describing the change"`
+		Name               *string `json:"name,omitempty" required:"false" doc:"Venue name"`
+		Address            *string `json:"address,omitempty" required:"false" doc:"Venue address"`
+		City               *string `json:"city,omitempty" required:"false" doc:"Venue city"`
+		State              *string `json:"state,omitempty" required:"false" doc:"Venue state"`
+		Country            *string `json:"country,omitempty" required:"false" doc:"Venue country"`
+		Zipcode            *string `json:"zipcode,omitempty" required:"false" doc:"Venue zipcode"`
+		Capacity           *int    `json:"capacity,omitempty" required:"false" minimum:"0" doc:"Venue capacity"` // PSY-1179
+		AccessibilityNotes *string `json:"accessibility_notes,omitempty" required:"false" doc:"Accessibility notes (empty clears)" maxLength:"1000"`
+		AllAges            *bool   `json:"all_ages,omitempty" required:"false" doc:"Whether the venue is all-ages"`
+		DoorTime           *string `json:"door_time,omitempty" required:"false" doc:"Typical door time, local 24h HH:MM (empty clears)" pattern:"^(([01][0-9]|2[0-3]):[0-5][0-9])?$"`
+		Timezone           *string `json:"timezone,omitempty" required:"false" doc:"IANA timezone override, e.g. America/Phoenix (empty clears the override and re-infers it from the location)" maxLength:"64"`
+		Instagram          *string `json:"instagram,omitempty" required:"false" doc:"Instagram URL"`
+		Facebook           *string `json:"facebook,omitempty" required:"false" doc:"Facebook URL"`
+		Twitter            *string `json:"twitter,omitempty" required:"false" doc:"Twitter URL"`
+		YouTube            *string `json:"youtube,omitempty" required:"false" doc:"YouTube URL"`
+		Spotify            *string `json:"spotify,omitempty" required:"false" doc:"Spotify URL"`
+		SoundCloud         *string `json:"soundcloud,omitempty" required:"false" doc:"SoundCloud URL"`
+		Bandcamp           *string `json:"bandcamp,omitempty" required:"false" doc:"Bandcamp URL"`
+		TikTok             *string `json:"tiktok,omitempty" required:"false" doc:"TikTok handle or URL" maxLength:"255"`
+		Website            *string `json:"website,omitempty" required:"false" doc:"Website URL"`
+		Description        *string `json:"description,omitempty" required:"false" doc:"Markdown description (max 5000 chars)"`
+		ImageURL           *string `json:"image_url,omitempty" required:"false" doc:"Venue photo URL (max 2048 chars)"`
+		Summary            *string `json:"summary,omitempty" required:"false" doc:"Revision summary describing the change"`
 	}
 }
 
@@ -388,13 +442,17 @@ type UpdateVenueResponse struct {
 	Body *contracts.VenueDetailResponse
 }
 
-// UpdateVenueHandler handles PUT /venues/{venue_id} — admin-only direct update.
-// Non-admin users (including venue submitters) go through PUT /venues/{id}/suggest-edit,
+// UpdateVenueHandler handles PUT /venues/{venue_id} — direct update by an
+// admin or a manager of the venue (approved venue claim). Other users
+// (including venue submitters) go through PUT /venues/{id}/suggest-edit,
 // which routes through the unified pending_entity_edits queue.
 func (h *VenueHandler) UpdateVenueHandler(ctx context.Context, req *UpdateVenueRequest) (*UpdateVenueResponse, error) {
 	requestID := logger.GetRequestID(ctx)
 
 	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
 
 	// Parse venue ID
 	venueID, err := strconv.ParseUint(req.VenueID, 10, 32)
@@ -402,36 +460,60 @@ func (h *VenueHandler) UpdateVenueHandler(ctx context.Context, req *UpdateVenueR
 		return nil, huma.Error400BadRequest("Invalid venue ID")
 	}
 
-	// Validate required fields aren't being set to empty strings
-	if req.Body.Name != nil && *req.Body.Name == "" {
-		return nil, huma.Error422UnprocessableEntity("Venue name cannot be empty")
-	}
-	if req.Body.City != nil && *req.Body.City == "" {
-		return nil, huma.Error422UnprocessableEntity("City cannot be empty")
-	}
-	if req.Body.State != nil && *req.Body.State == "" {
-		return nil, huma.Error422UnprocessableEntity("State cannot be empty")
+	if !user.IsAdmin {
+		managed := false
+		if h.venueClaimService != nil {
+			managed, err = h.venueClaimService.IsVenueManager(user.ID, uint(venueID))
+			if err != nil {
+				logger.FromContext(ctx).Error("venue_manager_lookup_failed",
+					"venue_id", venueID,
+					"user_id", user.ID,
+					"error", err.Error(),
+					"request_id", requestID,
+				)
+				return nil, huma.Error500InternalServerError(
+					fmt.Sprintf("Failed to update venue (request_id: %s)", requestID),
+				)
+			}
+		}
+		if !managed {
+			return nil, huma.Error403Forbidden("Only a venue manager or an admin can update this venue; suggest an edit instead")
+		}
 	}
-	if req.Body.Description != nil && len(*req.Body.Description) > 5000 {
-		return nil, huma.Error422UnprocessableEntity("Description must be 5000 characters or fewer")
+
+	// Required fields can't be set to empty strings; every invalid field is
+	// reported at once.
+	var v shared.Validator
+	v.NotEmpty("name", req.Body.Name, "Venue name cannot be empty")
+	v.NotEmpty("city", req.Body.City, "City cannot be empty")
+	v.NotEmpty("state", req.Body.State, "State cannot be empty")
+	v.MaxLength("description", req.Body.Description, 5000, "Description must be 5000 characters or fewer")
+	// Venue zones are resolved with time.LoadLocation, so validate with it too.
+	// "Local" would mean the server's zone.
+	if tz := req.Body.Timezone; tz != nil && *tz != "" {
+		_, err := time.LoadLocation(*tz)
+		v.Check(err == nil && *tz != "Local", "timezone", shared.ValidationCodeInvalidValue,
+			"Timezone must be an IANA zone name, e.g. America/Phoenix")
 	}
 
-	// PSY-525: URL scheme validation (http/https only) for image_url and social URL fields.
+	// PSY-525: URL scheme validation (http/https only) for image_url.
 	// Length check first (cheaper, reports bytes); URL scheme check second.
+	// Social links are canonicalized and validated as on create.
 	if req.Body.ImageURL != nil && len(*req.Body.ImageURL) > 2048 {
-		return nil, huma.Error422UnprocessableEntity("Image URL must be 2048 characters or fewer")
-	}
-	if err := shared.ValidateImageURL(req.Body.ImageURL); err != nil {
-		return nil, err
+		v.MaxLength("image_url", req.Body.ImageURL, 2048, "Image URL must be 2048 characters or fewer")
+	} else {
+		v.CheckErr("image_url", shared.ValidationCodeInvalidFormat, shared.ValidateImageURL(req.Body.ImageURL))
 	}
-	if err := shared.ValidateSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
-		req.Body.YouTube, req.Body.Spotify, req.Body.SoundCloud, req.Body.Bandcamp, req.Body.Website); err != nil {
+	v.NormalizeSocialURLs(req.Body.Instagram, req.Body.Facebook, req.Body.Twitter,
+		req.Body.YouTube, req.Body.Spotify, req.Body.SoundCloud, req.Body.Bandcamp, req.Body.TikTok, req.Body.Website)
+	if err := v.Err(); err != nil {
 		return nil, err
 	}
 
 	logger.FromContext(ctx).Info("admin_venue_update",
 		"venue_id", venueID,
-		"admin_id", user.ID,
+		"user_id", user.ID,
+		"is_admin", user.IsAdmin,
 		"request_id", requestID,
 	)
 
@@ -444,23 +526,28 @@ func (h *VenueHandler) UpdateVenueHandler(ctx context.Context, req *UpdateVenueR
 	// Image URL length + scheme already validated above; the service
 	// normalizes empty Description/ImageURL to SQL NULL.
 	serviceReq := &contracts.UpdateVenueRequest{
-		Name:        req.Body.Name,
-		Address:     req.Body.Address,
-		City:        req.Body.City,
-		State:       req.Body.State,
-		Country:     req.Body.Country,
-		Zipcode:     req.Body.Zipcode,
-		Capacity:    req.Body.Capacity,
-		Description: req.Body.Description,
-		ImageURL:    req.Body.ImageURL,
-		Instagram:   req.Body.Instagram,
-		Facebook:    req.Body.Facebook,
-		Twitter:     req.Body.Twitter,
-		YouTube:     req.Body.YouTube,
-		Spotify:     req.Body.Spotify,
-		SoundCloud:  req.Body.SoundCloud,
-		Bandcamp:    req.Body.Bandcamp,
-		Website:     req.Body.Website,
+		Name:               req.Body.Name,
+		Address:            req.Body.Address,
+		City:               req.Body.City,
+		State:              req.Body.State,
+		Country:            req.Body.Country,
+		Zipcode:            req.Body.Zipcode,
+		Capacity:           req.Body.Capacity,
+		AccessibilityNotes: req.Body.AccessibilityNotes,
+		AllAges:            req.Body.AllAges,
+		DoorTime:           req.Body.DoorTime,
+		Timezone:           req.Body.Timezone,
+		Description:        req.Body.Description,
+		ImageURL:           req.Body.ImageURL,
+		Instagram:          req.Body.Instagram,
+		Facebook:           req.Body.Facebook,
+		Twitter:            req.Body.Twitter,
+		YouTube:            req.Body.YouTube,
+		Spotify:            req.Body.Spotify,
+		SoundCloud:         req.Body.SoundCloud,
+		Bandcamp:           req.Body.Bandcamp,
+		TikTok:             req.Body.TikTok,
+		Website:            req.Body.Website,
 	}
 
 	updatedVenue, err := h.venueService.UpdateVenue(uint(venueID), serviceReq)
@@ -713,3 +800,70 @@ func (h *VenueHandler) GetVenueBillNetworkHandler(ctx context.Context, req *GetV
 
 	return &GetVenueBillNetworkResponse{Body: graph}, nil
 }
+
+// MergeVenueRequest represents the request for merging a duplicate venue
+// into a canonical one
+type MergeVenueRequest struct {
+	VenueID string `path:"venue_id" doc:"ID of the duplicate venue to merge and delete" example:"2"`
+	Body    struct {
+		CanonicalVenueID uint `json:"canonical_venue_id" doc:"ID of the venue to keep"`
+	}
+}
+
+// MergeVenueResponse represents the response for merging two venues
+type MergeVenueResponse struct {
+	Body *contracts.MergeVenueResult
+}
+
+// MergeVenueHandler handles POST /admin/venues/{venue_id}/merge
+func (h *VenueHandler) MergeVenueHandler(ctx context.Context, req *MergeVenueRequest) (*MergeVenueResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	mergeFromID, err := strconv.ParseUint(req.VenueID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid venue ID")
+	}
+	if req.Body.CanonicalVenueID == 0 {
+		return nil, huma.Error422UnprocessableEntity("canonical_venue_id is required")
+	}
+
+	result, err := h.venueService.MergeVenues(req.Body.CanonicalVenueID, uint(mergeFromID))
+	if err != nil {
+		if mapped := shared.MapVenueError(err); mapped != nil {
+			return nil, mapped
+		}
+		logger.FromContext(ctx).Error("merge_venues_failed",
+			"canonical_id", req.Body.CanonicalVenueID,
+			"merge_from_id", mergeFromID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to merge venues (request_id: %s)", requestID),
+		)
+	}
+
+	// Audit log (fire and forget)
+	if h.auditLogService != nil {
+		h.auditLogService.LogAction(user.ID, "merge_venues", "venue", req.Body.CanonicalVenueID, map[string]interface{}{
+			"merged_venue_id":   mergeFromID,
+			"merged_venue_name": result.MergedVenueName,
+			"shows_moved":       result.ShowsMoved,
+			"reports_moved":     result.ReportsMoved,
+			"links_copied":      result.LinksCopied,
+			"slug_redirected":   result.SlugRedirected,
+		})
+	}
+
+	logger.FromContext(ctx).Info("venues_merged",
+		"canonical_id", req.Body.CanonicalVenueID,
+		"merged_id", mergeFromID,
+		"merged_name", result.MergedVenueName,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	return &MergeVenueResponse{Body: result}, nil
+}
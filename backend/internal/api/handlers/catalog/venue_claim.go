@@ -0,0 +1,276 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+	servicesshared "psychic-homily-backend/internal/services/shared"
+)
+
+// VenueClaimHandler handles venue claim submission, the admin review queue
+// and venue manager grants.
+type VenueClaimHandler struct {
+	claimService    contracts.VenueClaimServiceInterface
+	auditLogService contracts.AuditLogServiceInterface
+}
+
+// NewVenueClaimHandler creates a new venue claim handler.
+func NewVenueClaimHandler(claimService contracts.VenueClaimServiceInterface, auditLogService contracts.AuditLogServiceInterface) *VenueClaimHandler {
+	return &VenueClaimHandler{
+		claimService:    claimService,
+		auditLogService: auditLogService,
+	}
+}
+
+// claimError logs a venue claim service failure and maps it to an HTTP error.
+func claimError(ctx context.Context, op string, err error) error {
+	requestID := logger.GetRequestID(ctx)
+	logger.FromContext(ctx).Warn(op+"_failed",
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	if mapped := shared.MapVenueClaimError(err); mapped != nil {
+		return mapped
+	}
+	var venueErr *apperrors.VenueError
+	if errors.As(err, &venueErr) && venueErr.Code == apperrors.CodeVenueNotFound {
+		return huma.Error404NotFound("Venue not found")
+	}
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to process venue claim (request_id: %s)", requestID),
+	)
+}
+
+// logAction writes a fire-and-forget audit entry for a claim review or
+// manager grant change.
+func (h *VenueClaimHandler) logAction(ctx context.Context, userID uint, action string, venueID uint, metadata map[string]interface{}) {
+	if h.auditLogService == nil {
+		return
+	}
+	servicesshared.GoSafe(ctx, "audit_log", func() {
+		h.auditLogService.LogAction(userID, action, "venue", venueID, metadata)
+	})
+}
+
+// ============================================================================
+// Claim Submission (Protected)
+// ============================================================================
+
+// SubmitVenueClaimRequest represents the request for claiming a venue
+type SubmitVenueClaimRequest struct {
+	VenueID uint `path:"venue_id" minimum:"1" doc:"Venue ID" example:"1"`
+	Body    struct {
+		Evidence string `json:"evidence" minLength:"1" maxLength:"2000" doc:"How you are connected to the venue, e.g. role, work email or a link to a staff page"`
+	}
+}
+
+// VenueClaimResponse represents a single venue claim
+type VenueClaimResponse struct {
+	Body *contracts.VenueClaimResponse
+}
+
+// SubmitVenueClaimHandler handles POST /venues/{venue_id}/claim
+func (h *VenueClaimHandler) SubmitVenueClaimHandler(ctx context.Context, req *SubmitVenueClaimRequest) (*VenueClaimResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	claim, err := h.claimService.SubmitClaim(req.VenueID, user.ID, req.Body.Evidence)
+	if err != nil {
+		return nil, claimError(ctx, "submit_venue_claim", err)
+	}
+
+	logger.FromContext(ctx).Info("venue_claim_submitted",
+		"claim_id", claim.ID,
+		"venue_id", req.VenueID,
+		"user_id", user.ID,
+	)
+
+	return &VenueClaimResponse{Body: claim}, nil
+}
+
+// GetMyVenueClaimsRequest represents the request for the user's own claims
+type GetMyVenueClaimsRequest struct{}
+
+// VenueClaimListResponse represents a list of venue claims
+type VenueClaimListResponse struct {
+	Body struct {
+		Claims []*contracts.VenueClaimResponse `json:"claims" doc:"Venue claims"`
+		Total  int64                           `json:"total" doc:"Total number of matching claims"`
+	}
+}
+
+// GetMyVenueClaimsHandler handles GET /my/venue-claims
+func (h *VenueClaimHandler) GetMyVenueClaimsHandler(ctx context.Context, _ *GetMyVenueClaimsRequest) (*VenueClaimListResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	claims, err := h.claimService.GetUserClaims(user.ID)
+	if err != nil {
+		return nil, claimError(ctx, "get_my_venue_claims", err)
+	}
+
+	resp := &VenueClaimListResponse{}
+	resp.Body.Claims = claims
+	resp.Body.Total = int64(len(claims))
+	return resp, nil
+}
+
+// GetMyManagedVenuesRequest represents the request for the venues the user manages
+type GetMyManagedVenuesRequest struct{}
+
+// VenueManagerListResponse represents a list of venue manager grants
+type VenueManagerListResponse struct {
+	Body struct {
+		Managers []*contracts.VenueManagerResponse `json:"managers" doc:"Venue manager grants"`
+	}
+}
+
+// GetMyManagedVenuesHandler handles GET /my/managed-venues
+func (h *VenueClaimHandler) GetMyManagedVenuesHandler(ctx context.Context, _ *GetMyManagedVenuesRequest) (*VenueManagerListResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	managers, err := h.claimService.GetManagedVenues(user.ID)
+	if err != nil {
+		return nil, claimError(ctx, "get_managed_venues", err)
+	}
+
+	resp := &VenueManagerListResponse{}
+	resp.Body.Managers = managers
+	return resp, nil
+}
+
+// ============================================================================
+// Admin Review Queue
+// ============================================================================
+
+// ListVenueClaimsRequest represents the request for the claim review queue
+type ListVenueClaimsRequest struct {
+	Status string `query:"status" default:"pending" enum:"pending,approved,denied,all" doc:"Claim status to list"`
+	Limit  int    `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Max results"`
+	Offset int    `query:"offset" default:"0" minimum:"0" doc:"Results to skip"`
+}
+
+// ListVenueClaimsHandler handles GET /admin/venue-claims
+func (h *VenueClaimHandler) ListVenueClaimsHandler(ctx context.Context, req *ListVenueClaimsRequest) (*VenueClaimListResponse, error) {
+	status := req.Status
+	if status == "all" {
+		status = ""
+	}
+
+	claims, total, err := h.claimService.ListClaims(status, req.Limit, req.Offset)
+	if err != nil {
+		return nil, claimError(ctx, "list_venue_claims", err)
+	}
+
+	resp := &VenueClaimListResponse{}
+	resp.Body.Claims = claims
+	resp.Body.Total = total
+	return resp, nil
+}
+
+// ReviewVenueClaimRequest represents the request for approving or denying a claim
+type ReviewVenueClaimRequest struct {
+	ClaimID uint `path:"claim_id" minimum:"1" doc:"Venue claim ID" example:"1"`
+	Body    struct {
+		Note string `json:"note,omitempty" maxLength:"2000" doc:"Note shown to the claimant; required when denying"`
+	}
+}
+
+// ApproveVenueClaimHandler handles POST /admin/venue-claims/{claim_id}/approve.
+// The claimant becomes a manager of the venue.
+func (h *VenueClaimHandler) ApproveVenueClaimHandler(ctx context.Context, req *ReviewVenueClaimRequest) (*VenueClaimResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	claim, err := h.claimService.ApproveClaim(req.ClaimID, user.ID, req.Body.Note)
+	if err != nil {
+		return nil, claimError(ctx, "approve_venue_claim", err)
+	}
+
+	h.logAction(ctx, user.ID, "approve_venue_claim", claim.VenueID, map[string]interface{}{
+		"claim_id": claim.ID,
+		"user_id":  claim.UserID,
+	})
+
+	logger.FromContext(ctx).Info("venue_claim_approved",
+		"claim_id", claim.ID,
+		"venue_id", claim.VenueID,
+		"user_id", claim.UserID,
+		"admin_id", user.ID,
+	)
+
+	return &VenueClaimResponse{Body: claim}, nil
+}
+
+// DenyVenueClaimHandler handles POST /admin/venue-claims/{claim_id}/deny
+func (h *VenueClaimHandler) DenyVenueClaimHandler(ctx context.Context, req *ReviewVenueClaimRequest) (*VenueClaimResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	claim, err := h.claimService.DenyClaim(req.ClaimID, user.ID, req.Body.Note)
+	if err != nil {
+		return nil, claimError(ctx, "deny_venue_claim", err)
+	}
+
+	h.logAction(ctx, user.ID, "deny_venue_claim", claim.VenueID, map[string]interface{}{
+		"claim_id": claim.ID,
+		"user_id":  claim.UserID,
+	})
+
+	return &VenueClaimResponse{Body: claim}, nil
+}
+
+// ============================================================================
+// Admin Manager Grants
+// ============================================================================
+
+// ListVenueManagersRequest represents the request for a venue's managers
+type ListVenueManagersRequest struct {
+	VenueID uint `path:"venue_id" minimum:"1" doc:"Venue ID" example:"1"`
+}
+
+// ListVenueManagersHandler handles GET /admin/venues/{venue_id}/managers
+func (h *VenueClaimHandler) ListVenueManagersHandler(ctx context.Context, req *ListVenueManagersRequest) (*VenueManagerListResponse, error) {
+	managers, err := h.claimService.ListVenueManagers(req.VenueID)
+	if err != nil {
+		return nil, claimError(ctx, "list_venue_managers", err)
+	}
+
+	resp := &VenueManagerListResponse{}
+	resp.Body.Managers = managers
+	return resp, nil
+}
+
+// RemoveVenueManagerRequest represents the request for revoking a manager grant
+type RemoveVenueManagerRequest struct {
+	VenueID uint `path:"venue_id" minimum:"1" doc:"Venue ID" example:"1"`
+	UserID  uint `path:"user_id" minimum:"1" doc:"Manager user ID" example:"1"`
+}
+
+// RemoveVenueManagerHandler handles DELETE /admin/venues/{venue_id}/managers/{user_id}
+func (h *VenueClaimHandler) RemoveVenueManagerHandler(ctx context.Context, req *RemoveVenueManagerRequest) (*struct{}, error) {
+	user := middleware.GetUserFromContext(ctx)
+
+	if err := h.claimService.RemoveVenueManager(req.VenueID, req.UserID); err != nil {
+		return nil, claimError(ctx, "remove_venue_manager", err)
+	}
+
+	h.logAction(ctx, user.ID, "remove_venue_manager", req.VenueID, map[string]interface{}{
+		"user_id": req.UserID,
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,128 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestSubmitVenueClaimHandler_NoAuth(t *testing.T) {
+	h := NewVenueClaimHandler(&testhelpers.MockVenueClaimService{}, nil)
+	_, err := h.SubmitVenueClaimHandler(context.Background(), &SubmitVenueClaimRequest{VenueID: 1})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestSubmitVenueClaimHandler_Success(t *testing.T) {
+	h := NewVenueClaimHandler(&testhelpers.MockVenueClaimService{
+		SubmitClaimFn: func(venueID, userID uint, evidence string) (*contracts.VenueClaimResponse, error) {
+			if venueID != 3 || userID != 5 || evidence != "I book the room" {
+				t.Errorf("unexpected claim %d %d %q", venueID, userID, evidence)
+			}
+			return &contracts.VenueClaimResponse{ID: 1, VenueID: venueID, UserID: userID, Status: "pending"}, nil
+		},
+	}, nil)
+
+	req := &SubmitVenueClaimRequest{VenueID: 3}
+	req.Body.Evidence = "I book the room"
+	resp, err := h.SubmitVenueClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Status != "pending" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestSubmitVenueClaimHandler_ErrorMapping(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"venue not found", apperrors.ErrVenueNotFound(3), 404},
+		{"duplicate", apperrors.ErrVenueClaimConflict("already pending"), 409},
+		{"invalid", apperrors.ErrVenueClaimInvalid("evidence is required"), 422},
+		{"internal", fmt.Errorf("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewVenueClaimHandler(&testhelpers.MockVenueClaimService{
+				SubmitClaimFn: func(uint, uint, string) (*contracts.VenueClaimResponse, error) { return nil, tc.err },
+			}, nil)
+			_, err := h.SubmitVenueClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 5}), &SubmitVenueClaimRequest{VenueID: 3})
+			testhelpers.AssertHumaError(t, err, tc.status)
+		})
+	}
+}
+
+func TestListVenueClaimsHandler_AllStatuses(t *testing.T) {
+	h := NewVenueClaimHandler(&testhelpers.MockVenueClaimService{
+		ListClaimsFn: func(status string, limit, offset int) ([]*contracts.VenueClaimResponse, int64, error) {
+			if status != "" || limit != 20 {
+				t.Errorf("unexpected args %q %d %d", status, limit, offset)
+			}
+			return []*contracts.VenueClaimResponse{{ID: 1}}, 1, nil
+		},
+	}, nil)
+
+	resp, err := h.ListVenueClaimsHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), &ListVenueClaimsRequest{Status: "all", Limit: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 1 || len(resp.Body.Claims) != 1 {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+}
+
+func TestApproveVenueClaimHandler_AuditsApproval(t *testing.T) {
+	done := make(chan string, 1)
+	h := NewVenueClaimHandler(&testhelpers.MockVenueClaimService{
+		ApproveClaimFn: func(claimID, reviewerID uint, _ string) (*contracts.VenueClaimResponse, error) {
+			return &contracts.VenueClaimResponse{ID: claimID, VenueID: 3, UserID: 5, Status: "approved", ReviewedBy: &reviewerID}, nil
+		},
+	}, &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, entityType string, entityID uint, _ map[string]interface{}) {
+			done <- fmt.Sprintf("%s:%s:%d", action, entityType, entityID)
+		},
+	})
+
+	resp, err := h.ApproveVenueClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), &ReviewVenueClaimRequest{ClaimID: 9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Status != "approved" {
+		t.Errorf("unexpected body: %+v", resp.Body)
+	}
+	if got := <-done; got != "approve_venue_claim:venue:3" {
+		t.Errorf("unexpected audit entry %q", got)
+	}
+}
+
+func TestDenyVenueClaimHandler_AlreadyReviewed(t *testing.T) {
+	h := NewVenueClaimHandler(&testhelpers.MockVenueClaimService{
+		DenyClaimFn: func(uint, uint, string) (*contracts.VenueClaimResponse, error) {
+			return nil, apperrors.ErrVenueClaimNotPending("approved")
+		},
+	}, nil)
+
+	req := &ReviewVenueClaimRequest{ClaimID: 9}
+	req.Body.Note = "Could not verify"
+	_, err := h.DenyVenueClaimHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), req)
+	testhelpers.AssertHumaError(t, err, 409)
+}
+
+func TestRemoveVenueManagerHandler_NotFound(t *testing.T) {
+	h := NewVenueClaimHandler(&testhelpers.MockVenueClaimService{
+		RemoveVenueManagerFn: func(venueID, userID uint) error {
+			return apperrors.ErrVenueManagerNotFound(venueID, userID)
+		},
+	}, nil)
+
+	_, err := h.RemoveVenueManagerHandler(testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true}), &RemoveVenueManagerRequest{VenueID: 3, UserID: 5})
+	testhelpers.AssertHumaError(t, err, 404)
+}
@@ -2,10 +2,14 @@ package catalog
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	"psychic-homily-backend/internal/api/handlers/shared"
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/api/middleware"
 	apperrors "psychic-homily-backend/internal/errors"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
@@ -25,6 +29,73 @@ func TestUpdateVenueHandler_InvalidID(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 400)
 }
 
+func TestUpdateVenueHandler_NonAdminForbidden(t *testing.T) {
+	h := testVenueHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.UpdateVenueHandler(ctx, &UpdateVenueRequest{VenueID: "42"})
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
+func TestUpdateVenueHandler_VenueManager(t *testing.T) {
+	updated := false
+	h := NewVenueHandler(&testhelpers.MockVenueService{
+		UpdateVenueFn: func(venueID uint, _ *contracts.UpdateVenueRequest) (*contracts.VenueDetailResponse, error) {
+			updated = true
+			return &contracts.VenueDetailResponse{ID: venueID}, nil
+		},
+	}, nil, nil, nil)
+	h.SetVenueClaimService(&testhelpers.MockVenueClaimService{
+		IsVenueManagerFn: func(userID, venueID uint) (bool, error) {
+			return userID == 7 && venueID == 42, nil
+		},
+	})
+
+	if _, err := h.UpdateVenueHandler(testhelpers.CtxWithUser(&authm.User{ID: 7}), &UpdateVenueRequest{VenueID: "42"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !updated {
+		t.Error("expected manager update to reach the venue service")
+	}
+
+	_, err := h.UpdateVenueHandler(testhelpers.CtxWithUser(&authm.User{ID: 7}), &UpdateVenueRequest{VenueID: "43"})
+	testhelpers.AssertHumaError(t, err, 403)
+}
+
+func TestUpdateVenueHandler_ManagerLookupFailure(t *testing.T) {
+	h := testVenueHandler()
+	h.SetVenueClaimService(&testhelpers.MockVenueClaimService{
+		IsVenueManagerFn: func(uint, uint) (bool, error) { return false, fmt.Errorf("db down") },
+	})
+
+	_, err := h.UpdateVenueHandler(testhelpers.CtxWithUser(&authm.User{ID: 7}), &UpdateVenueRequest{VenueID: "42"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestUpdateVenueHandler_ReportsAllFieldErrors(t *testing.T) {
+	h := testVenueHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &UpdateVenueRequest{VenueID: "42"}
+	empty, badTZ, badURL := "", "Mars/Olympus", "javascript:alert(1)"
+	req.Body.Name = &empty
+	req.Body.Timezone = &badTZ
+	req.Body.Website = &badURL
+
+	_, err := h.UpdateVenueHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 422)
+	var ve *shared.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected *shared.ValidationError, got %T", err)
+	}
+	var fields []string
+	for _, fe := range ve.Errors {
+		fields = append(fields, fe.Field)
+	}
+	if got := strings.Join(fields, ","); got != "name,timezone,website" {
+		t.Errorf("expected name,timezone,website errors, got %q", got)
+	}
+}
+
 // --- DeleteVenueHandler ---
 
 func TestDeleteVenueHandler_NoAuth(t *testing.T) {
@@ -117,6 +188,38 @@ func TestUpdateVenueHandler_CarriesCapacity(t *testing.T) {
 	}
 }
 
+func TestUpdateVenueHandler_Timezone(t *testing.T) {
+	var gotReq *contracts.UpdateVenueRequest
+	mock := &testhelpers.MockVenueService{
+		UpdateVenueFn: func(_ uint, req *contracts.UpdateVenueRequest) (*contracts.VenueDetailResponse, error) {
+			gotReq = req
+			return &contracts.VenueDetailResponse{ID: 42}, nil
+		},
+	}
+	h := NewVenueHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	for _, tz := range []string{"Mars/Olympus_Mons", "Local"} {
+		req := &UpdateVenueRequest{VenueID: "42"}
+		req.Body.Timezone = &tz
+		_, err := h.UpdateVenueHandler(ctx, req)
+		testhelpers.AssertHumaError(t, err, 422)
+	}
+
+	// A valid zone and "" (clear the override) are forwarded.
+	for _, tz := range []string{"America/Denver", ""} {
+		gotReq = nil
+		req := &UpdateVenueRequest{VenueID: "42"}
+		req.Body.Timezone = &tz
+		if _, err := h.UpdateVenueHandler(ctx, req); err != nil {
+			t.Fatalf("timezone %q: unexpected error: %v", tz, err)
+		}
+		if gotReq == nil || gotReq.Timezone == nil || *gotReq.Timezone != tz {
+			t.Errorf("timezone %q not forwarded to service: %+v", tz, gotReq)
+		}
+	}
+}
+
 func TestDeleteVenueHandler_ZeroID(t *testing.T) {
 	mock := &testhelpers.MockVenueService{
 		GetVenueModelFn: func(venueID uint) (*catalogm.Venue, error) {
@@ -285,6 +388,39 @@ func TestAdminCreateVenue_Success(t *testing.T) {
 	}
 }
 
+func TestAdminCreateVenue_NormalizesSocialLinks(t *testing.T) {
+	mock := &testhelpers.MockVenueService{
+		CreateVenueFn: func(req *contracts.CreateVenueRequest, _ bool) (*contracts.VenueDetailResponse, error) {
+			if req.Instagram == nil || *req.Instagram != "https://instagram.com/valleybarphx" {
+				t.Errorf("expected instagram handle to become a URL, got %v", req.Instagram)
+			}
+			if req.TikTok == nil || *req.TikTok != "https://www.tiktok.com/@valleybar" {
+				t.Errorf("expected tiktok URL to be forwarded clean, got %v", req.TikTok)
+			}
+			return &contracts.VenueDetailResponse{ID: 1, Name: req.Name}, nil
+		},
+	}
+	h := NewVenueHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	instagram, tiktok := "valleybarphx", "https://www.tiktok.com/@valleybar?lang=en"
+	req := &AdminCreateVenueRequest{}
+	req.Body.Name = "Valley Bar"
+	req.Body.City = "Phoenix"
+	req.Body.State = "AZ"
+	req.Body.Instagram = &instagram
+	req.Body.TikTok = &tiktok
+
+	if _, err := h.AdminCreateVenueHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spam := "http://192.0.2.10/promo"
+	update := &UpdateVenueRequest{VenueID: "1"}
+	update.Body.Website = &spam
+	_, err := h.UpdateVenueHandler(ctx, update)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
 func TestAdminCreateVenue_CarriesCapacityAndDescription(t *testing.T) {
 	// Regression (PSY-1179): capacity + description were silently DROPPED on
 	// create because the HTTP body struct + handler->service mapping omitted them,
@@ -319,6 +455,41 @@ func TestAdminCreateVenue_CarriesCapacityAndDescription(t *testing.T) {
 	}
 }
 
+func TestAdminCreateVenue_CarriesOperatingInfo(t *testing.T) {
+	allAges := true
+	doorTime := "19:30"
+	notes := "Ramp at the side entrance."
+	var gotReq *contracts.CreateVenueRequest
+	mock := &testhelpers.MockVenueService{
+		CreateVenueFn: func(req *contracts.CreateVenueRequest, _ bool) (*contracts.VenueDetailResponse, error) {
+			gotReq = req
+			return &contracts.VenueDetailResponse{ID: 1, Name: req.Name}, nil
+		},
+	}
+	h := NewVenueHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &AdminCreateVenueRequest{}
+	req.Body.Name = "Valley Bar"
+	req.Body.City = "Phoenix"
+	req.Body.State = "AZ"
+	req.Body.AllAges = &allAges
+	req.Body.DoorTime = &doorTime
+	req.Body.AccessibilityNotes = &notes
+
+	if _, err := h.AdminCreateVenueHandler(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotReq == nil || gotReq.AllAges == nil || !*gotReq.AllAges {
+		t.Errorf("all_ages not forwarded to service: %+v", gotReq)
+	}
+	if gotReq.DoorTime == nil || *gotReq.DoorTime != "19:30" {
+		t.Errorf("door_time not forwarded to service: %+v", gotReq)
+	}
+	if gotReq.AccessibilityNotes == nil || *gotReq.AccessibilityNotes != notes {
+		t.Errorf("accessibility_notes not forwarded to service: %+v", gotReq)
+	}
+}
+
 func TestAdminCreateVenue_InvalidSocialURL(t *testing.T) {
 	// Social-URL validation runs before the service call; a non-http scheme
 	// is rejected without ever reaching CreateVenue.
@@ -353,3 +524,158 @@ func TestAdminCreateVenue_ServiceError(t *testing.T) {
 	_, err := h.AdminCreateVenueHandler(ctx, req)
 	testhelpers.AssertHumaError(t, err, 422)
 }
+
+// --- MergeVenueHandler ---
+
+func TestMergeVenueHandler_InvalidID(t *testing.T) {
+	h := testVenueHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &MergeVenueRequest{VenueID: "abc"}
+	req.Body.CanonicalVenueID = 1
+
+	_, err := h.MergeVenueHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestMergeVenueHandler_MissingCanonical(t *testing.T) {
+	h := testVenueHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.MergeVenueHandler(ctx, &MergeVenueRequest{VenueID: "2"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestMergeVenueHandler_ServiceErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"self merge", apperrors.ErrVenueMergeSelf(), 422},
+		{"not found", apperrors.ErrVenueNotFound(99), 404},
+		{"unexpected", fmt.Errorf("db down"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &testhelpers.MockVenueService{
+				MergeVenuesFn: func(uint, uint) (*contracts.MergeVenueResult, error) {
+					return nil, tt.err
+				},
+			}
+			h := NewVenueHandler(mock, nil, nil, nil)
+			ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+			req := &MergeVenueRequest{VenueID: "2"}
+			req.Body.CanonicalVenueID = 1
+
+			_, err := h.MergeVenueHandler(ctx, req)
+			testhelpers.AssertHumaError(t, err, tt.status)
+		})
+	}
+}
+
+func TestMergeVenueHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockVenueService{
+		MergeVenuesFn: func(canonicalID, mergeFromID uint) (*contracts.MergeVenueResult, error) {
+			if canonicalID != 1 || mergeFromID != 2 {
+				t.Errorf("expected canonical=1 mergeFrom=2, got %d, %d", canonicalID, mergeFromID)
+			}
+			return &contracts.MergeVenueResult{
+				CanonicalVenueID: 1,
+				MergedVenueID:    2,
+				MergedVenueName:  "The Rebel Lounge",
+				ShowsMoved:       4,
+			}, nil
+		},
+	}
+	h := NewVenueHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &MergeVenueRequest{VenueID: "2"}
+	req.Body.CanonicalVenueID = 1
+
+	resp, err := h.MergeVenueHandler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ShowsMoved != 4 || resp.Body.MergedVenueName != "The Rebel Lounge" {
+		t.Errorf("unexpected result: %+v", resp.Body)
+	}
+}
+
+// ============================================================================
+// GetVenueShowsHandler
+// ============================================================================
+
+func TestGetVenueShows_PastArchivePaging(t *testing.T) {
+	mock := &testhelpers.MockVenueService{
+		GetShowsForVenuePageFn: func(venueID uint, _ string, limit, offset int, timeFilter string, year int) ([]*contracts.VenueShowResponse, int64, error) {
+			if venueID != 7 || limit != 10 || offset != 10 || timeFilter != "past" || year != 2023 {
+				t.Errorf("unexpected args: venueID=%d limit=%d offset=%d timeFilter=%q year=%d", venueID, limit, offset, timeFilter, year)
+			}
+			return []*contracts.VenueShowResponse{{ID: 1}}, 12, nil
+		},
+		GetShowYearsForVenueFn: func(_ uint, _ string, _ string) ([]*contracts.ShowYearCount, error) {
+			return []*contracts.ShowYearCount{{Year: 2023, Count: 12}}, nil
+		},
+	}
+	h := NewVenueHandler(mock, nil, nil, nil)
+
+	resp, err := h.GetVenueShowsHandler(context.Background(), &GetVenueShowsRequest{
+		VenueID: "7", Limit: 10, Offset: 10, TimeFilter: "past", Year: 2023,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 12 || resp.Body.Offset != 10 {
+		t.Errorf("unexpected paging: total=%d offset=%d", resp.Body.Total, resp.Body.Offset)
+	}
+	if len(resp.Body.Years) != 1 || resp.Body.Years[0].Count != 12 {
+		t.Errorf("unexpected years: %+v", resp.Body.Years)
+	}
+}
+
+func TestGetVenueShows_YearCountError(t *testing.T) {
+	mock := &testhelpers.MockVenueService{
+		GetShowsForVenuePageFn: func(_ uint, _ string, _, _ int, _ string, _ int) ([]*contracts.VenueShowResponse, int64, error) {
+			return []*contracts.VenueShowResponse{}, 0, nil
+		},
+		GetShowYearsForVenueFn: func(_ uint, _ string, _ string) ([]*contracts.ShowYearCount, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewVenueHandler(mock, nil, nil, nil)
+
+	_, err := h.GetVenueShowsHandler(context.Background(), &GetVenueShowsRequest{VenueID: "7", TimeFilter: "past"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestListVenuesHandler_CityScope(t *testing.T) {
+	var got contracts.VenueListFilters
+	h := NewVenueHandler(&testhelpers.MockVenueService{
+		GetVenuesWithShowCountsFn: func(filters contracts.VenueListFilters, _, _ int) ([]*contracts.VenueWithShowCountResponse, int64, error) {
+			got = filters
+			return nil, 0, nil
+		},
+	}, nil, nil, nil)
+	ctx := context.WithValue(context.Background(), middleware.CityScopeContextKey, []contracts.CityStateFilter{{City: "Tucson", State: "AZ"}})
+
+	if _, err := h.ListVenuesHandler(ctx, &ListVenuesRequest{Limit: 50}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Cities) != 1 || got.Cities[0].City != "Tucson" {
+		t.Errorf("expected the host's cities by default, got %+v", got)
+	}
+
+	if _, err := h.ListVenuesHandler(ctx, &ListVenuesRequest{Limit: 50, State: "AZ"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Cities) != 0 || got.State != "AZ" {
+		t.Errorf("expected an explicit state to override the host's cities, got %+v", got)
+	}
+
+	if _, err := h.ListVenuesHandler(ctx, &ListVenuesRequest{Limit: 50, Metro: "38060"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Cities) != 0 || got.Metro != "38060" {
+		t.Errorf("expected a metro to override the host's cities, got %+v", got)
+	}
+}
@@ -0,0 +1,281 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/conditional"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Venue widgets are embedded on venues' own sites and polled from visitors'
+// browsers, so every response carries a strong ETag and a short public
+// Cache-Control: a poll that finds nothing new costs a 304 and no body.
+const (
+	venueWidgetCacheControl = "public, max-age=300"
+	venueWidgetDefaultCount = 5
+
+	// oEmbed rich embeds must state a size. The list reflows, so these are
+	// only the defaults a consumer's maxwidth/maxheight can shrink.
+	venueOEmbedWidth  = 400
+	venueOEmbedHeight = 480
+)
+
+// VenueWidgetHandler serves the embeddable venue widget: a JSON feed of
+// upcoming shows and the oEmbed endpoint that turns a venue page URL into
+// an HTML snippet.
+type VenueWidgetHandler struct {
+	venueService contracts.VenueServiceInterface
+	frontendURL  string
+}
+
+// NewVenueWidgetHandler creates a new venue widget handler. frontendURL is
+// the site the widget links back to, and the only host oEmbed URLs resolve
+// against.
+func NewVenueWidgetHandler(venueService contracts.VenueServiceInterface, frontendURL string) *VenueWidgetHandler {
+	return &VenueWidgetHandler{
+		venueService: venueService,
+		frontendURL:  strings.TrimRight(frontendURL, "/"),
+	}
+}
+
+// GetVenueWidgetRequest represents the request for a venue's widget feed
+type GetVenueWidgetRequest struct {
+	conditional.Params
+	VenueID string `path:"venue_id" doc:"Venue ID or slug" example:"valley-bar-phoenix-az"`
+	Count   int    `query:"count" default:"5" minimum:"1" maximum:"20" doc:"Number of upcoming shows to return (default 5, max 20)"`
+}
+
+// GetVenueWidgetResponse represents the response for a venue's widget feed.
+// Status is 304 with no body when If-None-Match matches the ETag.
+type GetVenueWidgetResponse struct {
+	Status       int
+	ETag         string `header:"ETag"`
+	CacheControl string `header:"Cache-Control"`
+	Body         *contracts.VenueWidgetResponse
+}
+
+// GetVenueWidgetHandler handles GET /venues/{venue_id}/widget.json - the
+// venue's next shows in a compact shape for third-party embeds
+func (h *VenueWidgetHandler) GetVenueWidgetHandler(ctx context.Context, req *GetVenueWidgetRequest) (*GetVenueWidgetResponse, error) {
+	count := req.Count
+	if count == 0 {
+		count = venueWidgetDefaultCount
+	}
+
+	widget, err := h.loadWidget(ctx, req.VenueID, count)
+	if err != nil {
+		return nil, err
+	}
+
+	etag, err := venueWidgetETag(widget)
+	if err != nil {
+		logger.FromContext(ctx).Error("venue_widget_etag_failed",
+			"venue_id", widget.Venue.ID,
+			"error", err.Error(),
+		)
+		return nil, huma.Error500InternalServerError("Failed to build venue widget")
+	}
+
+	resp := &GetVenueWidgetResponse{
+		Status:       http.StatusOK,
+		ETag:         `"` + etag + `"`,
+		CacheControl: venueWidgetCacheControl,
+	}
+	if req.HasConditionalParams() && req.PreconditionFailed(etag, time.Time{}) != nil {
+		resp.Status = http.StatusNotModified
+		return resp, nil
+	}
+	resp.Body = widget
+	return resp, nil
+}
+
+// GetVenueOEmbedRequest represents an oEmbed request (https://oembed.com)
+type GetVenueOEmbedRequest struct {
+	URL       string `query:"url" required:"true" doc:"Venue page URL, e.g. https://psychichomily.com/venues/valley-bar-phoenix-az"`
+	Format    string `query:"format" required:"false" doc:"Response format. Only json is supported."`
+	MaxWidth  int    `query:"maxwidth" required:"false" minimum:"0" doc:"Maximum embed width in pixels"`
+	MaxHeight int    `query:"maxheight" required:"false" minimum:"0" doc:"Maximum embed height in pixels"`
+	Count     int    `query:"count" default:"5" minimum:"1" maximum:"20" doc:"Number of upcoming shows in the embed (default 5, max 20)"`
+}
+
+// VenueOEmbedResponse is an oEmbed 1.0 "rich" response
+type VenueOEmbedResponse struct {
+	Version      string `json:"version"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	ProviderName string `json:"provider_name"`
+	ProviderURL  string `json:"provider_url"`
+	CacheAge     int    `json:"cache_age"`
+	HTML         string `json:"html"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+// GetVenueOEmbedResponse represents the response for the oEmbed endpoint
+type GetVenueOEmbedResponse struct {
+	CacheControl string `header:"Cache-Control"`
+	Body         VenueOEmbedResponse
+}
+
+// GetVenueOEmbedHandler handles GET /oembed - resolves a venue page URL to
+// an embeddable list of its upcoming shows
+func (h *VenueWidgetHandler) GetVenueOEmbedHandler(ctx context.Context, req *GetVenueOEmbedRequest) (*GetVenueOEmbedResponse, error) {
+	if req.Format != "" && req.Format != "json" {
+		return nil, huma.NewError(http.StatusNotImplemented, "Only the json format is supported")
+	}
+
+	slug, ok := h.venueSlugFromURL(req.URL)
+	if !ok {
+		return nil, huma.Error404NotFound("No embeddable venue at that URL")
+	}
+
+	count := req.Count
+	if count == 0 {
+		count = venueWidgetDefaultCount
+	}
+	widget, err := h.loadWidget(ctx, slug, count)
+	if err != nil {
+		return nil, err
+	}
+
+	var html bytes.Buffer
+	if err := venueOEmbedTemplate.Execute(&html, widget); err != nil {
+		logger.FromContext(ctx).Error("venue_oembed_render_failed",
+			"venue_id", widget.Venue.ID,
+			"error", err.Error(),
+		)
+		return nil, huma.Error500InternalServerError("Failed to render venue embed")
+	}
+
+	resp := &GetVenueOEmbedResponse{CacheControl: venueWidgetCacheControl}
+	resp.Body = VenueOEmbedResponse{
+		Version:      "1.0",
+		Type:         "rich",
+		Title:        widget.Venue.Name,
+		ProviderName: "Psychic Homily",
+		ProviderURL:  h.frontendURL,
+		CacheAge:     300,
+		HTML:         html.String(),
+		Width:        clampOEmbedSize(venueOEmbedWidth, req.MaxWidth),
+		Height:       clampOEmbedSize(venueOEmbedHeight, req.MaxHeight),
+	}
+	return resp, nil
+}
+
+// loadWidget resolves a venue ID or slug and fills in the links back to
+// the site.
+func (h *VenueWidgetHandler) loadWidget(ctx context.Context, venueRef string, count int) (*contracts.VenueWidgetResponse, error) {
+	var venueID uint
+	if id, parseErr := strconv.ParseUint(venueRef, 10, 32); parseErr == nil {
+		venueID = uint(id)
+	} else {
+		venue, err := h.venueService.GetVenueBySlug(venueRef)
+		if err != nil {
+			var venueErr *apperrors.VenueError
+			if errors.As(err, &venueErr) && venueErr.Code == apperrors.CodeVenueNotFound {
+				return nil, huma.Error404NotFound("Venue not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to fetch venue", err)
+		}
+		venueID = venue.ID
+	}
+
+	widget, err := h.venueService.GetVenueWidget(venueID, count)
+	if err != nil {
+		var venueErr *apperrors.VenueError
+		if errors.As(err, &venueErr) && venueErr.Code == apperrors.CodeVenueNotFound {
+			return nil, huma.Error404NotFound("Venue not found")
+		}
+		logger.FromContext(ctx).Error("venue_widget_failed",
+			"venue_id", venueID,
+			"error", err.Error(),
+		)
+		return nil, huma.Error500InternalServerError("Failed to fetch venue widget")
+	}
+
+	widget.Venue.URL = h.pageURL("venues", widget.Venue.Slug, widget.Venue.ID)
+	for i := range widget.Shows {
+		widget.Shows[i].URL = h.pageURL("shows", widget.Shows[i].Slug, widget.Shows[i].ID)
+	}
+	return widget, nil
+}
+
+// pageURL links to an entity page, by slug when it has one.
+func (h *VenueWidgetHandler) pageURL(kind, slug string, id uint) string {
+	if slug == "" {
+		slug = strconv.FormatUint(uint64(id), 10)
+	}
+	return fmt.Sprintf("%s/%s/%s", h.frontendURL, kind, url.PathEscape(slug))
+}
+
+// venueSlugFromURL extracts the slug from a venue page URL on the site.
+// Anything else — another host, another page — is not ours to embed.
+func (h *VenueWidgetHandler) venueSlugFromURL(raw string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	site, err := url.Parse(h.frontendURL)
+	if err != nil || !strings.EqualFold(u.Host, site.Host) {
+		return "", false
+	}
+	slug, found := strings.CutPrefix(strings.TrimSuffix(u.Path, "/"), "/venues/")
+	if !found || slug == "" || strings.Contains(slug, "/") {
+		return "", false
+	}
+	return slug, true
+}
+
+// venueWidgetETag hashes the payload, so the tag changes exactly when
+// something an embed would render does.
+func venueWidgetETag(widget *contracts.VenueWidgetResponse) (string, error) {
+	body, err := json.Marshal(widget)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// clampOEmbedSize applies an oEmbed maxwidth/maxheight (0 = no limit).
+func clampOEmbedSize(size, limit int) int {
+	if limit > 0 && limit < size {
+		return limit
+	}
+	return size
+}
+
+// venueOEmbedTemplate renders the embed: plain markup with no script or
+// styles, so it drops into any page and inherits the host site's look.
+var venueOEmbedTemplate = template.Must(template.New("venue_oembed").Funcs(template.FuncMap{
+	"showDate": func(local string) string {
+		t, err := time.Parse(time.RFC3339, local)
+		if err != nil {
+			return local
+		}
+		return t.Format("Mon, Jan 2 · 3:04 PM")
+	},
+	"join": strings.Join,
+}).Parse(`<div class="psychic-homily-venue-widget">` +
+	`<p><a href="{{.Venue.URL}}">Upcoming at {{.Venue.Name}}</a></p>` +
+	`{{if .Shows}}<ul>{{range .Shows}}<li>` +
+	`<a href="{{.URL}}">{{if .Artists}}{{join .Artists ", "}}{{else}}{{.Title}}{{end}}</a> — {{showDate .EventDateLocal}}` +
+	`{{if .IsCancelled}} (cancelled){{else if .IsPostponed}} (postponed){{else if .IsSoldOut}} (sold out){{end}}` +
+	`</li>{{end}}</ul>{{else}}<p>No upcoming shows.</p>{{end}}` +
+	`</div>`))
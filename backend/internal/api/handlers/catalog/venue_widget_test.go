@@ -0,0 +1,221 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/conditional"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func widgetVenueService() *testhelpers.MockVenueService {
+	return &testhelpers.MockVenueService{
+		GetVenueBySlugFn: func(slug string) (*contracts.VenueDetailResponse, error) {
+			if slug != "valley-bar" {
+				return nil, apperrors.ErrVenueNotFound(0)
+			}
+			return &contracts.VenueDetailResponse{ID: 3, Slug: slug}, nil
+		},
+		GetVenueWidgetFn: func(venueID uint, count int) (*contracts.VenueWidgetResponse, error) {
+			if venueID != 3 {
+				return nil, apperrors.ErrVenueNotFound(venueID)
+			}
+			shows := []contracts.VenueWidgetShow{
+				{ID: 10, Slug: "cursive-valley-bar", Title: "Cursive", EventDateLocal: "2030-07-04T20:00:00-07:00", Artists: []string{"Cursive", "Pile"}},
+				{ID: 11, Title: "<b>Untitled</b>", EventDateLocal: "2030-07-05T20:00:00-07:00", Artists: []string{}, IsSoldOut: true},
+			}
+			if count < len(shows) {
+				shows = shows[:count]
+			}
+			return &contracts.VenueWidgetResponse{
+				Venue: contracts.VenueWidgetVenue{ID: 3, Slug: "valley-bar", Name: "Valley Bar"},
+				Shows: shows,
+			}, nil
+		},
+	}
+}
+
+func testVenueWidgetHandler() *VenueWidgetHandler {
+	return NewVenueWidgetHandler(widgetVenueService(), "https://psychichomily.com/")
+}
+
+func TestGetVenueWidgetHandler_BySlugWithLinks(t *testing.T) {
+	h := testVenueWidgetHandler()
+
+	resp, err := h.GetVenueWidgetHandler(context.Background(), &GetVenueWidgetRequest{VenueID: "valley-bar", Count: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Status != 200 || resp.Body == nil {
+		t.Fatalf("status = %d, body = %v", resp.Status, resp.Body)
+	}
+	if resp.Body.Venue.URL != "https://psychichomily.com/venues/valley-bar" {
+		t.Errorf("venue url = %q", resp.Body.Venue.URL)
+	}
+	if got := resp.Body.Shows[0].URL; got != "https://psychichomily.com/shows/cursive-valley-bar" {
+		t.Errorf("show url = %q", got)
+	}
+	if got := resp.Body.Shows[1].URL; got != "https://psychichomily.com/shows/11" {
+		t.Errorf("slugless show url = %q, want the ID", got)
+	}
+	if !strings.HasPrefix(resp.ETag, `"`) || !strings.HasSuffix(resp.ETag, `"`) {
+		t.Errorf("etag %q is not quoted", resp.ETag)
+	}
+	if resp.CacheControl == "" {
+		t.Error("expected Cache-Control")
+	}
+}
+
+func TestGetVenueWidgetHandler_Count(t *testing.T) {
+	h := testVenueWidgetHandler()
+
+	resp, err := h.GetVenueWidgetHandler(context.Background(), &GetVenueWidgetRequest{VenueID: "3", Count: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Shows) != 1 {
+		t.Errorf("got %d shows, want 1", len(resp.Body.Shows))
+	}
+
+	all, err := h.GetVenueWidgetHandler(context.Background(), &GetVenueWidgetRequest{VenueID: "3", Count: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if all.ETag == resp.ETag {
+		t.Error("a different payload should have a different ETag")
+	}
+}
+
+func TestGetVenueWidgetHandler_NotModified(t *testing.T) {
+	h := testVenueWidgetHandler()
+	first, err := h.GetVenueWidgetHandler(context.Background(), &GetVenueWidgetRequest{VenueID: "3", Count: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, ifNoneMatch := range []string{first.ETag, "W/" + first.ETag} {
+		req := &GetVenueWidgetRequest{
+			Params:  conditional.Params{IfNoneMatch: []string{ifNoneMatch}},
+			VenueID: "3",
+			Count:   5,
+		}
+		resp, err := h.GetVenueWidgetHandler(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Status != 304 || resp.Body != nil {
+			t.Errorf("If-None-Match %s: status = %d, body = %v; want 304 without a body", ifNoneMatch, resp.Status, resp.Body)
+		}
+		if resp.ETag != first.ETag {
+			t.Errorf("304 etag = %q, want %q", resp.ETag, first.ETag)
+		}
+	}
+
+	stale := &GetVenueWidgetRequest{
+		Params:  conditional.Params{IfNoneMatch: []string{`"stale"`}},
+		VenueID: "3",
+		Count:   5,
+	}
+	resp, err := h.GetVenueWidgetHandler(context.Background(), stale)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Status != 200 || resp.Body == nil {
+		t.Errorf("stale etag: status = %d; want 200 with a body", resp.Status)
+	}
+}
+
+func TestGetVenueWidgetHandler_NotFound(t *testing.T) {
+	h := testVenueWidgetHandler()
+
+	_, err := h.GetVenueWidgetHandler(context.Background(), &GetVenueWidgetRequest{VenueID: "nowhere", Count: 5})
+	testhelpers.AssertHumaError(t, err, 404)
+
+	_, err = h.GetVenueWidgetHandler(context.Background(), &GetVenueWidgetRequest{VenueID: "99", Count: 5})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestGetVenueWidgetHandler_ServiceError(t *testing.T) {
+	h := NewVenueWidgetHandler(&testhelpers.MockVenueService{
+		GetVenueWidgetFn: func(uint, int) (*contracts.VenueWidgetResponse, error) {
+			return nil, fmt.Errorf("db down")
+		},
+	}, "https://psychichomily.com")
+
+	_, err := h.GetVenueWidgetHandler(context.Background(), &GetVenueWidgetRequest{VenueID: "3", Count: 5})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestGetVenueOEmbedHandler_RendersRichEmbed(t *testing.T) {
+	h := testVenueWidgetHandler()
+
+	resp, err := h.GetVenueOEmbedHandler(context.Background(), &GetVenueOEmbedRequest{
+		URL:      "https://psychichomily.com/venues/valley-bar/",
+		MaxWidth: 300,
+		Count:    5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body := resp.Body
+	if body.Version != "1.0" || body.Type != "rich" || body.Title != "Valley Bar" {
+		t.Errorf("unexpected oEmbed envelope: %+v", body)
+	}
+	if body.Width != 300 || body.Height != venueOEmbedHeight {
+		t.Errorf("size = %dx%d, want 300x%d", body.Width, body.Height, venueOEmbedHeight)
+	}
+	for _, want := range []string{
+		`href="https://psychichomily.com/venues/valley-bar"`,
+		"Cursive, Pile",
+		"Thu, Jul 4 · 8:00 PM",
+		"&lt;b&gt;Untitled&lt;/b&gt;",
+		"(sold out)",
+	} {
+		if !strings.Contains(body.HTML, want) {
+			t.Errorf("html missing %q:\n%s", want, body.HTML)
+		}
+	}
+}
+
+func TestGetVenueOEmbedHandler_RejectsOtherURLs(t *testing.T) {
+	h := testVenueWidgetHandler()
+
+	for _, u := range []string{
+		"https://example.com/venues/valley-bar",
+		"https://psychichomily.com/shows/cursive",
+		"https://psychichomily.com/venues/valley-bar/shows",
+		"not a url",
+	} {
+		_, err := h.GetVenueOEmbedHandler(context.Background(), &GetVenueOEmbedRequest{URL: u, Count: 5})
+		testhelpers.AssertHumaError(t, err, 404)
+	}
+}
+
+func TestGetVenueOEmbedHandler_JSONOnly(t *testing.T) {
+	h := testVenueWidgetHandler()
+
+	_, err := h.GetVenueOEmbedHandler(context.Background(), &GetVenueOEmbedRequest{
+		URL:    "https://psychichomily.com/venues/valley-bar",
+		Format: "xml",
+		Count:  5,
+	})
+	testhelpers.AssertHumaError(t, err, 501)
+}
+
+func TestClampOEmbedSize(t *testing.T) {
+	cases := []struct{ size, limit, want int }{
+		{400, 0, 400},
+		{400, 250, 250},
+		{400, 800, 400},
+	}
+	for _, tc := range cases {
+		if got := clampOEmbedSize(tc.size, tc.limit); got != tc.want {
+			t.Errorf("clampOEmbedSize(%d, %d) = %d, want %d", tc.size, tc.limit, got, tc.want)
+		}
+	}
+}
@@ -209,6 +209,21 @@ type GetUserFieldNotesResponse struct {
 	}
 }
 
+type GetUserSubmittedShowsRequest struct {
+	Username string `path:"username" doc:"Username of the contributor"`
+	Limit    int    `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Number of shows per page (max 100)"`
+	Offset   int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+type GetUserSubmittedShowsResponse struct {
+	Body struct {
+		Shows  []*contracts.PublicSubmittedShow `json:"shows"`
+		Total  int64                            `json:"total"`
+		Limit  int                              `json:"limit"`
+		Offset int                              `json:"offset"`
+	}
+}
+
 // --- Handlers ---
 
 // resolveProfileTarget looks up the profile owner by username and applies the
@@ -971,3 +986,51 @@ func (h *ContributorProfileHandler) GetUserFieldNotesHandler(ctx context.Context
 		},
 	}, nil
 }
+
+// GetUserSubmittedShowsHandler handles GET /users/{username}/shows. Lists the
+// approved shows a user submitted, gated by the profile's `submitted_shows`
+// privacy setting (default visible).
+func (h *ContributorProfileHandler) GetUserSubmittedShowsHandler(ctx context.Context, req *GetUserSubmittedShowsRequest) (*GetUserSubmittedShowsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	targetUser, isOwner, err := h.resolveProfileTarget(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	level := contracts.PrivacyVisible
+	if !isOwner {
+		level = granularPrivacy(targetUser).SubmittedShows
+	}
+	if level == contracts.PrivacyHidden {
+		return nil, huma.Error404NotFound("User not found")
+	}
+
+	limit, offset := req.Limit, req.Offset
+	if level == contracts.PrivacyCountOnly {
+		// Total only; limit=1 keeps the page fetch trivial.
+		limit, offset = 1, 0
+	}
+
+	shows, total, err := h.profileService.GetSubmittedShows(targetUser.ID, limit, offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("get_user_submitted_shows_failed",
+			"user_id", targetUser.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get submitted shows (request_id: %s)", requestID),
+		)
+	}
+	if level == contracts.PrivacyCountOnly || shows == nil {
+		shows = []*contracts.PublicSubmittedShow{}
+	}
+
+	resp := &GetUserSubmittedShowsResponse{}
+	resp.Body.Shows = shows
+	resp.Body.Total = total
+	resp.Body.Limit = req.Limit
+	resp.Body.Offset = req.Offset
+	return resp, nil
+}
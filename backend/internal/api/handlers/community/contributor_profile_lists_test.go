@@ -161,3 +161,111 @@ func TestGetUserFieldNotes_ServiceError(t *testing.T) {
 	})
 	testhelpers.AssertHumaError(t, err, 500)
 }
+
+// --- GetUserSubmittedShowsHandler ---
+
+func submittedShowsSettings(level contracts.PrivacyLevel) *contracts.PrivacySettings {
+	s := contracts.DefaultPrivacySettings()
+	s.SubmittedShows = level
+	return &s
+}
+
+func TestGetUserSubmittedShows_Visible(t *testing.T) {
+	mockUsers := listsMockUserService(t, 7, nil) // default: visible
+	var gotUser uint
+	var gotLimit, gotOffset int
+	mockProfile := &testhelpers.MockContributorProfileService{
+		GetSubmittedShowsFn: func(userID uint, limit, offset int) ([]*contracts.PublicSubmittedShow, int64, error) {
+			gotUser, gotLimit, gotOffset = userID, limit, offset
+			return []*contracts.PublicSubmittedShow{{ID: 1, Slug: "a-show"}}, 3, nil
+		},
+	}
+	h := NewContributorProfileHandler(mockProfile, mockUsers, nil, nil)
+
+	resp, err := h.GetUserSubmittedShowsHandler(context.Background(), &GetUserSubmittedShowsRequest{
+		Username: "publicuser", Limit: 10, Offset: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotUser != 7 || gotLimit != 10 || gotOffset != 2 {
+		t.Errorf("expected service call (7, 10, 2), got (%d, %d, %d)", gotUser, gotLimit, gotOffset)
+	}
+	if resp.Body.Total != 3 || len(resp.Body.Shows) != 1 {
+		t.Errorf("expected total=3 with 1 show, got total=%d shows=%d", resp.Body.Total, len(resp.Body.Shows))
+	}
+}
+
+func TestGetUserSubmittedShows_Hidden(t *testing.T) {
+	mockUsers := listsMockUserService(t, 7, submittedShowsSettings(contracts.PrivacyHidden))
+	h := NewContributorProfileHandler(&testhelpers.MockContributorProfileService{}, mockUsers, nil, nil)
+
+	_, err := h.GetUserSubmittedShowsHandler(context.Background(), &GetUserSubmittedShowsRequest{
+		Username: "publicuser", Limit: 20, Offset: 0,
+	})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestGetUserSubmittedShows_HiddenOwnerSeesList(t *testing.T) {
+	mockUsers := listsMockUserService(t, 7, submittedShowsSettings(contracts.PrivacyHidden))
+	mockProfile := &testhelpers.MockContributorProfileService{
+		GetSubmittedShowsFn: func(uint, int, int) ([]*contracts.PublicSubmittedShow, int64, error) {
+			return []*contracts.PublicSubmittedShow{{ID: 1}}, 1, nil
+		},
+	}
+	h := NewContributorProfileHandler(mockProfile, mockUsers, nil, nil)
+
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 7})
+	resp, err := h.GetUserSubmittedShowsHandler(ctx, &GetUserSubmittedShowsRequest{
+		Username: "publicuser", Limit: 20, Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Shows) != 1 {
+		t.Errorf("owner should see their own list, got %d shows", len(resp.Body.Shows))
+	}
+}
+
+func TestGetUserSubmittedShows_CountOnly(t *testing.T) {
+	mockUsers := listsMockUserService(t, 7, submittedShowsSettings(contracts.PrivacyCountOnly))
+	var gotLimit, gotOffset int
+	mockProfile := &testhelpers.MockContributorProfileService{
+		GetSubmittedShowsFn: func(_ uint, limit, offset int) ([]*contracts.PublicSubmittedShow, int64, error) {
+			gotLimit, gotOffset = limit, offset
+			return []*contracts.PublicSubmittedShow{{Title: "must not leak"}}, 12, nil
+		},
+	}
+	h := NewContributorProfileHandler(mockProfile, mockUsers, nil, nil)
+
+	resp, err := h.GetUserSubmittedShowsHandler(context.Background(), &GetUserSubmittedShowsRequest{
+		Username: "publicuser", Limit: 20, Offset: 5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotLimit != 1 || gotOffset != 0 {
+		t.Errorf("expected count-only service call (1, 0), got (%d, %d)", gotLimit, gotOffset)
+	}
+	if resp.Body.Total != 12 || len(resp.Body.Shows) != 0 {
+		t.Errorf("count_only must return total=12 and no shows, got total=%d shows=%d", resp.Body.Total, len(resp.Body.Shows))
+	}
+	if resp.Body.Limit != 20 || resp.Body.Offset != 5 {
+		t.Errorf("expected echoed pagination (20, 5), got (%d, %d)", resp.Body.Limit, resp.Body.Offset)
+	}
+}
+
+func TestGetUserSubmittedShows_ServiceError(t *testing.T) {
+	mockUsers := listsMockUserService(t, 7, nil)
+	mockProfile := &testhelpers.MockContributorProfileService{
+		GetSubmittedShowsFn: func(uint, int, int) ([]*contracts.PublicSubmittedShow, int64, error) {
+			return nil, 0, errors.New("query failed")
+		},
+	}
+	h := NewContributorProfileHandler(mockProfile, mockUsers, nil, nil)
+
+	_, err := h.GetUserSubmittedShowsHandler(context.Background(), &GetUserSubmittedShowsRequest{
+		Username: "publicuser", Limit: 20, Offset: 0,
+	})
+	testhelpers.AssertHumaError(t, err, 500)
+}
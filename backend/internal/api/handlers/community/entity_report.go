@@ -96,6 +96,13 @@ func (h *EntityReportHandler) ReportLabelHandler(ctx context.Context, req *Repor
 	return h.reportEntity(ctx, "label", req)
 }
 
+// ReportShowUpdateHandler handles POST /show-updates/{entity_id}/report.
+// A moderator acting on the report deletes the update through
+// DELETE /shows/{show_id}/updates/{update_id}.
+func (h *EntityReportHandler) ReportShowUpdateHandler(ctx context.Context, req *ReportEntityRequest) (*ReportEntityResponse, error) {
+	return h.reportEntity(ctx, "show_update", req)
+}
+
 // reportEntity is the shared implementation for all report endpoints.
 func (h *EntityReportHandler) reportEntity(ctx context.Context, entityType string, req *ReportEntityRequest) (*ReportEntityResponse, error) {
 	user := middleware.GetUserFromContext(ctx)
@@ -157,7 +164,7 @@ func (h *EntityReportHandler) reportEntity(ctx context.Context, entityType strin
 // AdminListEntityReportsRequest is the Huma request for GET /admin/entity-reports
 type AdminListEntityReportsRequest struct {
 	Status     string `query:"status" required:"false" doc:"Filter by status (pending, resolved, dismissed)"`
-	EntityType string `query:"entity_type" required:"false" doc:"Filter by entity type (artist, venue, festival, show)"`
+	EntityType string `query:"entity_type" required:"false" doc:"Filter by entity type (artist, venue, festival, show, comment, collection, release, label, show_update)"`
 	Limit      int    `query:"limit" required:"false" minimum:"1" maximum:"100" doc:"Max results (default 20, max 100)"`
 	Offset     int    `query:"offset" required:"false" minimum:"0" doc:"Offset for pagination"`
 }
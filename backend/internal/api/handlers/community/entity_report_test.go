@@ -340,6 +340,41 @@ func TestReportLabel_InvalidReportType(t *testing.T) {
 	testhelpers.AssertHumaError(t, err, 422)
 }
 
+// Show updates share the comment taxonomy.
+func TestReportShowUpdate_Success(t *testing.T) {
+	expected := makeEntityReportResponse(9, "show_update", "spam")
+	h := NewEntityReportHandler(
+		&testhelpers.MockEntityReportService{
+			CreateEntityReportFn: func(req *contracts.CreateEntityReportRequest) (*contracts.EntityReportResponse, error) {
+				if req.EntityType != "show_update" {
+					t.Errorf("expected entity_type=show_update, got %s", req.EntityType)
+				}
+				return expected, nil
+			},
+		},
+		nil,
+	)
+
+	req := &ReportEntityRequest{EntityID: "12"}
+	req.Body.ReportType = "spam"
+
+	resp, err := h.ReportShowUpdateHandler(entityReportUserCtx(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.EntityType != "show_update" {
+		t.Errorf("expected entity_type=show_update, got %s", resp.Body.EntityType)
+	}
+}
+
+func TestReportShowUpdate_InvalidReportType(t *testing.T) {
+	h := testEntityReportHandler()
+	req := &ReportEntityRequest{EntityID: "1"}
+	req.Body.ReportType = "wrong_venue"
+	_, err := h.ReportShowUpdateHandler(entityReportUserCtx(), req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
 // ============================================================================
 // Tests: Report Entity — Error Cases
 // ============================================================================
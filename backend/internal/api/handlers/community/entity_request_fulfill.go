@@ -348,12 +348,18 @@ func (h *EntityRequestHandler) fulfillEntity(req *communitym.EntityRequest, show
 		if perr != nil {
 			return 0, apperrors.ErrEntityRequestPayloadInvalid(req.EntityType, perr)
 		}
+		// The request payload carries one price, in the pre-range sense.
+		var price utils.PriceRange
+		if p.Price != nil {
+			price = utils.LegacyPrice(*p.Price)
+		}
 		created, err := h.fulfiller.CreateShow(&contracts.CreateShowRequest{
 			Title:          p.Title,
 			EventDate:      eventDate,
 			City:           shared.Deref(p.City),
 			State:          shared.Deref(p.State),
-			Price:          p.Price,
+			PriceMin:       price.Min,
+			IsFree:         price.IsFree,
 			AgeRequirement: shared.Deref(p.AgeRequirement),
 			Description:    shared.Deref(p.Description),
 			TicketURL:      shared.Deref(p.TicketURL),
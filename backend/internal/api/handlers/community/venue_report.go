@@ -0,0 +1,352 @@
+package community
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// VenueReportHandler handles venue report HTTP requests
+type VenueReportHandler struct {
+	venueReportService contracts.VenueReportServiceInterface
+	discordService     contracts.DiscordServiceInterface
+	userService        contracts.UserServiceInterface
+	auditLogService    contracts.AuditLogServiceInterface
+}
+
+// NewVenueReportHandler creates a new venue report handler
+func NewVenueReportHandler(
+	venueReportService contracts.VenueReportServiceInterface,
+	discordService contracts.DiscordServiceInterface,
+	userService contracts.UserServiceInterface,
+	auditLogService contracts.AuditLogServiceInterface,
+) *VenueReportHandler {
+	return &VenueReportHandler{
+		venueReportService: venueReportService,
+		discordService:     discordService,
+		userService:        userService,
+		auditLogService:    auditLogService,
+	}
+}
+
+// ============================================================================
+// User Endpoints
+// ============================================================================
+
+// ReportVenueRequest represents the HTTP request for reporting a venue
+type ReportVenueRequest struct {
+	VenueID string `path:"venue_id" validate:"required" doc:"Venue ID"`
+	Body    struct {
+		ReportType string  `json:"report_type" validate:"required" doc:"Type of report: inaccurate, closed or removal_request"`
+		Details    *string `json:"details" doc:"Optional details about the issue"`
+	}
+}
+
+// ReportVenueResponse represents the HTTP response for reporting a venue
+type ReportVenueResponse struct {
+	Body contracts.VenueReportResponse `json:"body"`
+}
+
+// ReportVenueHandler handles POST /venues/{venue_id}/report
+func (h *VenueReportHandler) ReportVenueHandler(ctx context.Context, req *ReportVenueRequest) (*ReportVenueResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	// Get authenticated user
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	// Parse venue ID
+	venueID, err := strconv.ParseUint(req.VenueID, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx).Warn("report_venue_invalid_id",
+			"venue_id_str", req.VenueID,
+			"request_id", requestID,
+		)
+		return nil, huma.Error400BadRequest("Invalid venue ID")
+	}
+
+	logger.FromContext(ctx).Debug("report_venue_attempt",
+		"user_id", user.ID,
+		"venue_id", venueID,
+		"report_type", req.Body.ReportType,
+	)
+
+	// Create the report
+	report, err := h.venueReportService.CreateReport(user.ID, uint(venueID), req.Body.ReportType, req.Body.Details)
+	if err != nil {
+		logger.FromContext(ctx).Error("report_venue_failed",
+			"user_id", user.ID,
+			"venue_id", venueID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error422UnprocessableEntity(
+			fmt.Sprintf("Failed to report venue (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("report_venue_success",
+		"user_id", user.ID,
+		"venue_id", venueID,
+		"report_id", report.ID,
+		"report_type", req.Body.ReportType,
+		"request_id", requestID,
+	)
+
+	// Send Discord notification
+	reportModel, _ := h.venueReportService.GetReportByID(report.ID)
+	if reportModel != nil {
+		reporterEmail := ""
+		if user.Email != nil {
+			reporterEmail = *user.Email
+		}
+		h.discordService.NotifyVenueReport(reportModel, reporterEmail)
+	}
+
+	return &ReportVenueResponse{Body: *report}, nil
+}
+
+// GetMyVenueReportRequest represents the HTTP request for checking user's report for a venue
+type GetMyVenueReportRequest struct {
+	VenueID string `path:"venue_id" validate:"required" doc:"Venue ID"`
+}
+
+// GetMyVenueReportResponse represents the HTTP response for checking user's report
+type GetMyVenueReportResponse struct {
+	Body struct {
+		Report *contracts.VenueReportResponse `json:"report"`
+	}
+}
+
+// GetMyVenueReportHandler handles GET /venues/{venue_id}/my-report
+func (h *VenueReportHandler) GetMyVenueReportHandler(ctx context.Context, req *GetMyVenueReportRequest) (*GetMyVenueReportResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	// Get authenticated user
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	// Parse venue ID
+	venueID, err := strconv.ParseUint(req.VenueID, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx).Warn("get_my_venue_report_invalid_id",
+			"venue_id_str", req.VenueID,
+			"request_id", requestID,
+		)
+		return nil, huma.Error400BadRequest("Invalid venue ID")
+	}
+
+	// Get user's report for this venue
+	report, err := h.venueReportService.GetUserReportForVenue(user.ID, uint(venueID))
+	if err != nil {
+		logger.FromContext(ctx).Error("get_my_venue_report_failed",
+			"user_id", user.ID,
+			"venue_id", venueID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get report (request_id: %s)", requestID),
+		)
+	}
+
+	return &GetMyVenueReportResponse{
+		Body: struct {
+			Report *contracts.VenueReportResponse `json:"report"`
+		}{
+			Report: report,
+		},
+	}, nil
+}
+
+// ============================================================================
+// Admin Endpoints
+// ============================================================================
+
+// GetPendingVenueReportsRequest represents the HTTP request for listing pending venue reports
+type GetPendingVenueReportsRequest struct {
+	Limit  int `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of reports to return (max 100)"`
+	Offset int `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetPendingVenueReportsResponse represents the HTTP response for listing pending venue reports
+type GetPendingVenueReportsResponse struct {
+	Body struct {
+		Reports []*contracts.VenueReportResponse `json:"reports"`
+		Total   int64                            `json:"total"`
+	}
+}
+
+// GetPendingVenueReportsHandler handles GET /admin/venue-reports
+func (h *VenueReportHandler) GetPendingVenueReportsHandler(ctx context.Context, req *GetPendingVenueReportsRequest) (*GetPendingVenueReportsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	// Validate limit
+	limit := req.Limit
+	if limit < 1 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	logger.FromContext(ctx).Debug("admin_pending_venue_reports_attempt",
+		"limit", limit,
+		"offset", req.Offset,
+	)
+
+	// Get pending reports
+	reports, total, err := h.venueReportService.GetPendingReports(limit, req.Offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_pending_venue_reports_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get pending venue reports (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Debug("admin_pending_venue_reports_success",
+		"count", len(reports),
+		"total", total,
+	)
+
+	return &GetPendingVenueReportsResponse{
+		Body: struct {
+			Reports []*contracts.VenueReportResponse `json:"reports"`
+			Total   int64                            `json:"total"`
+		}{
+			Reports: reports,
+			Total:   total,
+		},
+	}, nil
+}
+
+// DismissVenueReportRequest represents the HTTP request for dismissing a venue report
+type DismissVenueReportRequest struct {
+	ReportID string `path:"report_id" validate:"required" doc:"Report ID"`
+	Body     struct {
+		Notes *string `json:"notes" doc:"Optional admin notes about the dismissal"`
+	}
+}
+
+// DismissVenueReportResponse represents the HTTP response for dismissing a venue report
+type DismissVenueReportResponse struct {
+	Body contracts.VenueReportResponse `json:"body"`
+}
+
+// DismissVenueReportHandler handles POST /admin/venue-reports/{report_id}/dismiss
+func (h *VenueReportHandler) DismissVenueReportHandler(ctx context.Context, req *DismissVenueReportRequest) (*DismissVenueReportResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	// Parse report ID
+	reportID, err := strconv.ParseUint(req.ReportID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid report ID")
+	}
+
+	logger.FromContext(ctx).Debug("admin_dismiss_venue_report_attempt",
+		"report_id", reportID,
+		"admin_id", user.ID,
+	)
+
+	// Dismiss the report
+	report, err := h.venueReportService.DismissReport(uint(reportID), user.ID, req.Body.Notes)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_dismiss_venue_report_failed",
+			"report_id", reportID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error422UnprocessableEntity(
+			fmt.Sprintf("Failed to dismiss venue report (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("admin_dismiss_venue_report_success",
+		"report_id", reportID,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	// Audit log
+	metadata := map[string]interface{}{"venue_id": report.VenueID}
+	if req.Body.Notes != nil {
+		metadata["notes"] = *req.Body.Notes
+	}
+	h.auditLogService.LogAction(user.ID, "dismiss_venue_report", "venue_report", uint(reportID), metadata)
+
+	return &DismissVenueReportResponse{Body: *report}, nil
+}
+
+// ResolveVenueReportRequest represents the HTTP request for resolving a venue report
+type ResolveVenueReportRequest struct {
+	ReportID string `path:"report_id" validate:"required" doc:"Report ID"`
+	Body     struct {
+		Notes *string `json:"notes" doc:"Optional admin notes about the resolution"`
+	}
+}
+
+// ResolveVenueReportResponse represents the HTTP response for resolving a venue report
+type ResolveVenueReportResponse struct {
+	Body contracts.VenueReportResponse `json:"body"`
+}
+
+// ResolveVenueReportHandler handles POST /admin/venue-reports/{report_id}/resolve
+func (h *VenueReportHandler) ResolveVenueReportHandler(ctx context.Context, req *ResolveVenueReportRequest) (*ResolveVenueReportResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+
+	// Parse report ID
+	reportID, err := strconv.ParseUint(req.ReportID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid report ID")
+	}
+
+	logger.FromContext(ctx).Debug("admin_resolve_venue_report_attempt",
+		"report_id", reportID,
+		"admin_id", user.ID,
+	)
+
+	// Resolve the report
+	report, err := h.venueReportService.ResolveReport(uint(reportID), user.ID, req.Body.Notes)
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_resolve_venue_report_failed",
+			"report_id", reportID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error422UnprocessableEntity(
+			fmt.Sprintf("Failed to resolve venue report (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("admin_resolve_venue_report_success",
+		"report_id", reportID,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	// Audit log
+	auditMeta := map[string]interface{}{"venue_id": report.VenueID}
+	if req.Body.Notes != nil {
+		auditMeta["notes"] = *req.Body.Notes
+	}
+	h.auditLogService.LogAction(user.ID, "resolve_venue_report", "venue_report", uint(reportID), auditMeta)
+
+	return &ResolveVenueReportResponse{Body: *report}, nil
+}
@@ -0,0 +1,303 @@
+package community
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	authm "psychic-homily-backend/internal/models/auth"
+	communitym "psychic-homily-backend/internal/models/community"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func testVenueReportHandler() *VenueReportHandler {
+	return NewVenueReportHandler(nil, nil, nil, nil)
+}
+
+// --- ReportVenueHandler ---
+
+func TestReportVenueHandler_NoAuth(t *testing.T) {
+	h := testVenueReportHandler()
+	req := &ReportVenueRequest{VenueID: "1"}
+
+	_, err := h.ReportVenueHandler(context.Background(), req)
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestReportVenueHandler_InvalidID(t *testing.T) {
+	h := testVenueReportHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+	req := &ReportVenueRequest{VenueID: "abc"}
+
+	_, err := h.ReportVenueHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestReportVenueHandler_Success(t *testing.T) {
+	report := &contracts.VenueReportResponse{ID: 10, VenueID: 7, ReportType: "inaccurate", Status: "pending"}
+	mock := &testhelpers.MockVenueReportService{
+		CreateReportFn: func(userID, venueID uint, reportType string, details *string) (*contracts.VenueReportResponse, error) {
+			if userID != 1 || venueID != 7 {
+				t.Errorf("unexpected args: userID=%d, venueID=%d", userID, venueID)
+			}
+			if reportType != "inaccurate" {
+				t.Errorf("unexpected reportType=%s", reportType)
+			}
+			return report, nil
+		},
+		GetReportByIDFn: func(reportID uint) (*communitym.VenueReport, error) {
+			return &communitym.VenueReport{ID: reportID}, nil
+		},
+	}
+	email := "user@test.com"
+	h := NewVenueReportHandler(mock, &testhelpers.MockDiscordService{}, &testhelpers.MockUserService{}, &testhelpers.MockAuditLogService{})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, Email: &email})
+
+	req := &ReportVenueRequest{VenueID: "7"}
+	req.Body.ReportType = "inaccurate"
+	resp, err := h.ReportVenueHandler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.ID != 10 {
+		t.Errorf("expected report ID=10, got %d", resp.Body.ID)
+	}
+}
+
+func TestReportVenueHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockVenueReportService{
+		CreateReportFn: func(_, _ uint, _ string, _ *string) (*contracts.VenueReportResponse, error) {
+			return nil, fmt.Errorf("duplicate report")
+		},
+	}
+	h := NewVenueReportHandler(mock, &testhelpers.MockDiscordService{}, &testhelpers.MockUserService{}, &testhelpers.MockAuditLogService{})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	req := &ReportVenueRequest{VenueID: "7"}
+	req.Body.ReportType = "inaccurate"
+	_, err := h.ReportVenueHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+// --- GetMyVenueReportHandler ---
+
+func TestGetMyVenueReportHandler_NoAuth(t *testing.T) {
+	h := testVenueReportHandler()
+	req := &GetMyVenueReportRequest{VenueID: "1"}
+
+	_, err := h.GetMyVenueReportHandler(context.Background(), req)
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestGetMyVenueReportHandler_InvalidID(t *testing.T) {
+	h := testVenueReportHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+	req := &GetMyVenueReportRequest{VenueID: "abc"}
+
+	_, err := h.GetMyVenueReportHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetMyVenueReportHandler_Success(t *testing.T) {
+	report := &contracts.VenueReportResponse{ID: 10, VenueID: 7}
+	mock := &testhelpers.MockVenueReportService{
+		GetUserReportForVenueFn: func(userID, venueID uint) (*contracts.VenueReportResponse, error) {
+			if userID != 1 || venueID != 7 {
+				t.Errorf("unexpected args: userID=%d, venueID=%d", userID, venueID)
+			}
+			return report, nil
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.GetMyVenueReportHandler(ctx, &GetMyVenueReportRequest{VenueID: "7"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Report == nil {
+		t.Fatal("expected non-nil report")
+	}
+	if resp.Body.Report.ID != 10 {
+		t.Errorf("expected report ID=10, got %d", resp.Body.Report.ID)
+	}
+}
+
+func TestGetMyVenueReportHandler_NoReport(t *testing.T) {
+	mock := &testhelpers.MockVenueReportService{
+		GetUserReportForVenueFn: func(_, _ uint) (*contracts.VenueReportResponse, error) {
+			return nil, nil
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.GetMyVenueReportHandler(ctx, &GetMyVenueReportRequest{VenueID: "7"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Report != nil {
+		t.Errorf("expected nil report, got %+v", resp.Body.Report)
+	}
+}
+
+func TestGetMyVenueReportHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockVenueReportService{
+		GetUserReportForVenueFn: func(_, _ uint) (*contracts.VenueReportResponse, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.GetMyVenueReportHandler(ctx, &GetMyVenueReportRequest{VenueID: "7"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestGetPendingVenueReportsHandler_Success(t *testing.T) {
+	reports := []*contracts.VenueReportResponse{{ID: 1}, {ID: 2}}
+	mock := &testhelpers.MockVenueReportService{
+		GetPendingReportsFn: func(limit, offset int) ([]*contracts.VenueReportResponse, int64, error) {
+			return reports, 2, nil
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	resp, err := h.GetPendingVenueReportsHandler(ctx, &GetPendingVenueReportsRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 2 {
+		t.Errorf("expected total=2, got %d", resp.Body.Total)
+	}
+	if len(resp.Body.Reports) != 2 {
+		t.Errorf("expected 2 reports, got %d", len(resp.Body.Reports))
+	}
+}
+
+func TestGetPendingVenueReportsHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockVenueReportService{
+		GetPendingReportsFn: func(_, _ int) ([]*contracts.VenueReportResponse, int64, error) {
+			return nil, 0, fmt.Errorf("db error")
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.GetPendingVenueReportsHandler(ctx, &GetPendingVenueReportsRequest{Limit: 10})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestDismissVenueReportHandler_InvalidID(t *testing.T) {
+	h := testVenueReportHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &DismissVenueReportRequest{ReportID: "abc"}
+
+	_, err := h.DismissVenueReportHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestDismissVenueReportHandler_Success(t *testing.T) {
+	report := &contracts.VenueReportResponse{ID: 5, VenueID: 7, Status: "dismissed"}
+	var auditLogged bool
+	mock := &testhelpers.MockVenueReportService{
+		DismissReportFn: func(reportID, adminID uint, notes *string) (*contracts.VenueReportResponse, error) {
+			if reportID != 5 || adminID != 99 {
+				t.Errorf("unexpected args: reportID=%d, adminID=%d", reportID, adminID)
+			}
+			return report, nil
+		},
+	}
+	auditMock := &testhelpers.MockAuditLogService{
+		LogActionFn: func(actorID uint, action, entityType string, entityID uint, metadata map[string]interface{}) {
+			auditLogged = true
+			if action != "dismiss_venue_report" {
+				t.Errorf("expected action=dismiss_venue_report, got %s", action)
+			}
+			if entityType != "venue_report" {
+				t.Errorf("expected entityType=venue_report, got %s", entityType)
+			}
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, auditMock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 99, IsAdmin: true})
+
+	resp, err := h.DismissVenueReportHandler(ctx, &DismissVenueReportRequest{ReportID: "5"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Status != "dismissed" {
+		t.Errorf("expected status=dismissed, got %s", resp.Body.Status)
+	}
+	if !auditLogged {
+		t.Error("expected audit log to be called")
+	}
+}
+
+func TestDismissVenueReportHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockVenueReportService{
+		DismissReportFn: func(_, _ uint, _ *string) (*contracts.VenueReportResponse, error) {
+			return nil, fmt.Errorf("not found")
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, &testhelpers.MockAuditLogService{})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.DismissVenueReportHandler(ctx, &DismissVenueReportRequest{ReportID: "5"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestResolveVenueReportHandler_InvalidID(t *testing.T) {
+	h := testVenueReportHandler()
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+	req := &ResolveVenueReportRequest{ReportID: "abc"}
+
+	_, err := h.ResolveVenueReportHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestResolveVenueReportHandler_Success(t *testing.T) {
+	report := &contracts.VenueReportResponse{ID: 5, VenueID: 7, Status: "resolved"}
+	var auditAction string
+	mock := &testhelpers.MockVenueReportService{
+		ResolveReportFn: func(reportID, adminID uint, notes *string) (*contracts.VenueReportResponse, error) {
+			if reportID != 5 || adminID != 99 {
+				t.Errorf("unexpected args: reportID=%d, adminID=%d", reportID, adminID)
+			}
+			return report, nil
+		},
+	}
+	auditMock := &testhelpers.MockAuditLogService{
+		LogActionFn: func(_ uint, action, _ string, _ uint, _ map[string]interface{}) {
+			auditAction = action
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, auditMock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 99, IsAdmin: true})
+
+	resp, err := h.ResolveVenueReportHandler(ctx, &ResolveVenueReportRequest{ReportID: "5"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Status != "resolved" {
+		t.Errorf("expected status=resolved, got %s", resp.Body.Status)
+	}
+	if auditAction != "resolve_venue_report" {
+		t.Errorf("expected audit action=resolve_venue_report, got %s", auditAction)
+	}
+}
+
+func TestResolveVenueReportHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockVenueReportService{
+		ResolveReportFn: func(_, _ uint, _ *string) (*contracts.VenueReportResponse, error) {
+			return nil, fmt.Errorf("not found")
+		},
+	}
+	h := NewVenueReportHandler(mock, nil, nil, &testhelpers.MockAuditLogService{})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1, IsAdmin: true})
+
+	_, err := h.ResolveVenueReportHandler(ctx, &ResolveVenueReportRequest{ReportID: "5"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
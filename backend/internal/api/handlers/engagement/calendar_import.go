@@ -0,0 +1,126 @@
+package engagement
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// CalendarImportHandler handles importing past show attendance from an ICS
+// calendar export.
+type CalendarImportHandler struct {
+	importService contracts.CalendarImportServiceInterface
+}
+
+// NewCalendarImportHandler creates a new calendar import handler
+func NewCalendarImportHandler(importService contracts.CalendarImportServiceInterface) *CalendarImportHandler {
+	return &CalendarImportHandler{
+		importService: importService,
+	}
+}
+
+// CalendarImportPreviewRequest represents the HTTP request for previewing a calendar import
+type CalendarImportPreviewRequest struct {
+	Body struct {
+		// Content is the base64-encoded ICS file content
+		Content string `json:"content" validate:"required" doc:"Base64-encoded .ics calendar export (max 512 KB decoded)"`
+	}
+}
+
+// CalendarImportPreviewResponse represents the HTTP response for previewing a calendar import
+type CalendarImportPreviewResponse struct {
+	Body *contracts.CalendarImportPreview
+}
+
+// CalendarImportConfirmRequest represents the HTTP request for confirming a calendar import
+type CalendarImportConfirmRequest struct {
+	Body struct {
+		ShowIDs []uint `json:"show_ids" maxItems:"500" doc:"IDs of the candidate shows the user confirmed attending"`
+	}
+}
+
+// CalendarImportConfirmResponse represents the HTTP response for confirming a calendar import
+type CalendarImportConfirmResponse struct {
+	Body *contracts.CalendarImportResult
+}
+
+// calendarImportError logs a calendar import failure and maps it to an HTTP error.
+func calendarImportError(ctx context.Context, op string, userID uint, err error) error {
+	requestID := logger.GetRequestID(ctx)
+	if mapped := shared.MapCalendarImportError(err); mapped != nil {
+		logger.FromContext(ctx).Warn(op+"_rejected",
+			"user_id", userID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return mapped
+	}
+	logger.FromContext(ctx).Error(op+"_failed",
+		"user_id", userID,
+		"error", err.Error(),
+		"request_id", requestID,
+	)
+	return huma.Error500InternalServerError(
+		fmt.Sprintf("Failed to import calendar (request_id: %s)", requestID),
+	)
+}
+
+// PreviewCalendarImportHandler handles POST /saved-shows/import/preview
+func (h *CalendarImportHandler) PreviewCalendarImportHandler(ctx context.Context, req *CalendarImportPreviewRequest) (*CalendarImportPreviewResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	content, err := base64.StdEncoding.DecodeString(req.Body.Content)
+	if err != nil {
+		logger.FromContext(ctx).Warn("calendar_import_decode_failed",
+			"error", err.Error(),
+			"request_id", logger.GetRequestID(ctx),
+		)
+		return nil, huma.Error400BadRequest("Invalid base64 content")
+	}
+
+	preview, err := h.importService.PreviewCalendarImport(user.ID, content)
+	if err != nil {
+		return nil, calendarImportError(ctx, "calendar_import_preview", user.ID, err)
+	}
+
+	logger.FromContext(ctx).Debug("calendar_import_preview_success",
+		"user_id", user.ID,
+		"event_count", preview.EventCount,
+		"matched_count", preview.MatchedCount,
+	)
+
+	return &CalendarImportPreviewResponse{Body: preview}, nil
+}
+
+// ConfirmCalendarImportHandler handles POST /saved-shows/import/confirm
+func (h *CalendarImportHandler) ConfirmCalendarImportHandler(ctx context.Context, req *CalendarImportConfirmRequest) (*CalendarImportConfirmResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	result, err := h.importService.ConfirmCalendarImport(user.ID, req.Body.ShowIDs)
+	if err != nil {
+		return nil, calendarImportError(ctx, "calendar_import_confirm", user.ID, err)
+	}
+
+	logger.FromContext(ctx).Info("calendar_import_confirm_success",
+		"user_id", user.ID,
+		"saved", result.Saved,
+		"already_saved", result.AlreadySaved,
+		"skipped", len(result.Skipped),
+		"request_id", logger.GetRequestID(ctx),
+	)
+
+	return &CalendarImportConfirmResponse{Body: result}, nil
+}
@@ -0,0 +1,133 @@
+package engagement
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func previewRequest(content string) *CalendarImportPreviewRequest {
+	req := &CalendarImportPreviewRequest{}
+	req.Body.Content = content
+	return req
+}
+
+func confirmRequest(ids ...uint) *CalendarImportConfirmRequest {
+	req := &CalendarImportConfirmRequest{}
+	req.Body.ShowIDs = ids
+	return req
+}
+
+// --- PreviewCalendarImportHandler ---
+
+func TestPreviewCalendarImportHandler_NoAuth(t *testing.T) {
+	h := NewCalendarImportHandler(nil)
+
+	_, err := h.PreviewCalendarImportHandler(context.Background(), previewRequest(""))
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestPreviewCalendarImportHandler_InvalidBase64(t *testing.T) {
+	h := NewCalendarImportHandler(&testhelpers.MockCalendarImportService{})
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.PreviewCalendarImportHandler(ctx, previewRequest("not base64!"))
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestPreviewCalendarImportHandler_Success(t *testing.T) {
+	ics := "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+	mock := &testhelpers.MockCalendarImportService{
+		PreviewCalendarImportFn: func(userID uint, data []byte) (*contracts.CalendarImportPreview, error) {
+			if userID != 1 || string(data) != ics {
+				t.Errorf("unexpected args: userID=%d, data=%q", userID, data)
+			}
+			return &contracts.CalendarImportPreview{EventCount: 3, MatchedCount: 1}, nil
+		},
+	}
+	h := NewCalendarImportHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.PreviewCalendarImportHandler(ctx, previewRequest(base64.StdEncoding.EncodeToString([]byte(ics))))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.EventCount != 3 || resp.Body.MatchedCount != 1 {
+		t.Errorf("unexpected preview: %+v", resp.Body)
+	}
+}
+
+func TestPreviewCalendarImportHandler_ServiceErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"invalid calendar", apperrors.ErrCalendarImportInvalid(fmt.Errorf("bad")), 422},
+		{"too large", apperrors.ErrCalendarImportTooLarge(512 * 1024), 413},
+		{"unexpected", fmt.Errorf("db down"), 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &testhelpers.MockCalendarImportService{
+				PreviewCalendarImportFn: func(uint, []byte) (*contracts.CalendarImportPreview, error) {
+					return nil, tt.err
+				},
+			}
+			h := NewCalendarImportHandler(mock)
+			ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+			_, err := h.PreviewCalendarImportHandler(ctx, previewRequest(base64.StdEncoding.EncodeToString([]byte("x"))))
+			testhelpers.AssertHumaError(t, err, tt.status)
+		})
+	}
+}
+
+// --- ConfirmCalendarImportHandler ---
+
+func TestConfirmCalendarImportHandler_NoAuth(t *testing.T) {
+	h := NewCalendarImportHandler(nil)
+
+	_, err := h.ConfirmCalendarImportHandler(context.Background(), confirmRequest(1))
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestConfirmCalendarImportHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockCalendarImportService{
+		ConfirmCalendarImportFn: func(userID uint, showIDs []uint) (*contracts.CalendarImportResult, error) {
+			if userID != 1 || len(showIDs) != 2 {
+				t.Errorf("unexpected args: userID=%d, showIDs=%v", userID, showIDs)
+			}
+			return &contracts.CalendarImportResult{Saved: 1, AlreadySaved: 1, Skipped: []uint{}}, nil
+		},
+	}
+	h := NewCalendarImportHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.ConfirmCalendarImportHandler(ctx, confirmRequest(4, 5))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Saved != 1 || resp.Body.AlreadySaved != 1 {
+		t.Errorf("unexpected result: %+v", resp.Body)
+	}
+}
+
+func TestConfirmCalendarImportHandler_TooManyShows(t *testing.T) {
+	mock := &testhelpers.MockCalendarImportService{
+		ConfirmCalendarImportFn: func(uint, []uint) (*contracts.CalendarImportResult, error) {
+			return nil, apperrors.ErrCalendarImportTooManyShows(500)
+		},
+	}
+	h := NewCalendarImportHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.ConfirmCalendarImportHandler(ctx, confirmRequest(1))
+	testhelpers.AssertHumaError(t, err, 400)
+}
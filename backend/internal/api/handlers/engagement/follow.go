@@ -132,6 +132,22 @@ type GetMyFollowingResponse struct {
 	}
 }
 
+// GetMyFollowedArtistShowsRequest is the request for GET /me/following/shows
+type GetMyFollowedArtistShowsRequest struct {
+	Limit  int `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Number of shows per page"`
+	Offset int `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetMyFollowedArtistShowsResponse is the response for GET /me/following/shows
+type GetMyFollowedArtistShowsResponse struct {
+	Body struct {
+		Shows  []*contracts.FollowedArtistShow `json:"shows"`
+		Total  int64                           `json:"total"`
+		Limit  int                             `json:"limit"`
+		Offset int                             `json:"offset"`
+	}
+}
+
 type GetLibraryFollowingCountsResponse struct {
 	CacheControl string `header:"Cache-Control"`
 	Body         contracts.LibraryFollowingCounts
@@ -548,6 +564,36 @@ func (h *FollowHandler) GetMyFollowingHandler(ctx context.Context, req *GetMyFol
 	}, nil
 }
 
+// GetMyFollowedArtistShowsHandler handles GET /me/following/shows: upcoming
+// shows featuring artists the user follows.
+func (h *FollowHandler) GetMyFollowedArtistShowsHandler(ctx context.Context, req *GetMyFollowedArtistShowsRequest) (*GetMyFollowedArtistShowsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	shows, total, err := h.followService.GetFollowedArtistShows(user.ID, req.Limit, req.Offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("get_followed_artist_shows_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get followed artist shows (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &GetMyFollowedArtistShowsResponse{}
+	resp.Body.Shows = shows
+	resp.Body.Total = total
+	resp.Body.Limit = req.Limit
+	resp.Body.Offset = req.Offset
+	return resp, nil
+}
+
 // GetLibraryFollowingCountsHandler handles GET /me/library/following/counts.
 func (h *FollowHandler) GetLibraryFollowingCountsHandler(ctx context.Context, _ *struct{}) (*GetLibraryFollowingCountsResponse, error) {
 	user := middleware.GetUserFromContext(ctx)
@@ -950,3 +950,61 @@ func TestGetFollowersListHandler_PaginationClamping(t *testing.T) {
 		t.Errorf("expected limit=100, got %d", capturedLimit)
 	}
 }
+
+// --- GetMyFollowedArtistShowsHandler ---
+
+func TestGetMyFollowedArtistShowsHandler_NoAuth(t *testing.T) {
+	h := testFollowHandler()
+	req := &GetMyFollowedArtistShowsRequest{}
+
+	_, err := h.GetMyFollowedArtistShowsHandler(context.Background(), req)
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestGetMyFollowedArtistShowsHandler_Success(t *testing.T) {
+	shows := []*contracts.FollowedArtistShow{
+		{
+			ID:              10,
+			Slug:            "test-show",
+			Title:           "Test Show",
+			EventDate:       time.Now().UTC().Add(24 * time.Hour),
+			FollowedArtists: []contracts.FollowedArtistRef{{ID: 1, Name: "Test Artist", Slug: "test-artist"}},
+		},
+	}
+	mock := &testhelpers.MockFollowService{
+		GetFollowedArtistShowsFn: func(userID uint, limit, offset int) ([]*contracts.FollowedArtistShow, int64, error) {
+			if userID != 1 || limit != 5 || offset != 10 {
+				t.Errorf("unexpected args userID=%d limit=%d offset=%d", userID, limit, offset)
+			}
+			return shows, 11, nil
+		},
+	}
+	h := NewFollowHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+	req := &GetMyFollowedArtistShowsRequest{Limit: 5, Offset: 10}
+
+	resp, err := h.GetMyFollowedArtistShowsHandler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 11 || resp.Body.Limit != 5 || resp.Body.Offset != 10 {
+		t.Errorf("unexpected paging total=%d limit=%d offset=%d", resp.Body.Total, resp.Body.Limit, resp.Body.Offset)
+	}
+	if len(resp.Body.Shows) != 1 || len(resp.Body.Shows[0].FollowedArtists) != 1 {
+		t.Errorf("unexpected shows: %+v", resp.Body.Shows)
+	}
+}
+
+func TestGetMyFollowedArtistShowsHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockFollowService{
+		GetFollowedArtistShowsFn: func(_ uint, _, _ int) ([]*contracts.FollowedArtistShow, int64, error) {
+			return nil, 0, fmt.Errorf("db error")
+		},
+	}
+	h := NewFollowHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+	req := &GetMyFollowedArtistShowsRequest{Limit: 20}
+
+	_, err := h.GetMyFollowedArtistShowsHandler(ctx, req)
+	testhelpers.AssertHumaError(t, err, 500)
+}
@@ -0,0 +1,71 @@
+package engagement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// RecommendationHandler handles personalized recommendation HTTP requests
+type RecommendationHandler struct {
+	recommendationService contracts.RecommendationServiceInterface
+}
+
+// NewRecommendationHandler creates a new recommendation handler
+func NewRecommendationHandler(recommendationService contracts.RecommendationServiceInterface) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+	}
+}
+
+// GetRecommendedShowsRequest represents the HTTP request for recommended shows
+type GetRecommendedShowsRequest struct {
+	Limit int `query:"limit" default:"20" minimum:"1" maximum:"50" doc:"Maximum number of shows"`
+}
+
+// GetRecommendedShowsResponse represents the HTTP response for recommended shows
+type GetRecommendedShowsResponse struct {
+	Body struct {
+		Shows []*contracts.RecommendedShow `json:"shows"`
+	}
+}
+
+// GetRecommendedShowsHandler handles GET /users/me/recommended-shows: upcoming
+// shows scored from the user's follows, saved shows and favorite cities.
+func (h *RecommendationHandler) GetRecommendedShowsHandler(ctx context.Context, req *GetRecommendedShowsRequest) (*GetRecommendedShowsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	shows, err := h.recommendationService.GetRecommendedShows(user.ID, limit)
+	if err != nil {
+		logger.FromContext(ctx).Error("get_recommended_shows_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get recommended shows (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &GetRecommendedShowsResponse{}
+	resp.Body.Shows = shows
+	return resp, nil
+}
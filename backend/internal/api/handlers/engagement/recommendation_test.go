@@ -0,0 +1,81 @@
+package engagement
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestGetRecommendedShowsHandler_NoAuth(t *testing.T) {
+	h := NewRecommendationHandler(nil)
+
+	_, err := h.GetRecommendedShowsHandler(context.Background(), &GetRecommendedShowsRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestGetRecommendedShowsHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockRecommendationService{
+		GetRecommendedShowsFn: func(userID uint, limit int) ([]*contracts.RecommendedShow, error) {
+			if userID != 1 || limit != 20 {
+				t.Errorf("unexpected args: userID=%d limit=%d", userID, limit)
+			}
+			return []*contracts.RecommendedShow{{
+				ID:    42,
+				Score: 5,
+				Reasons: []contracts.RecommendationReason{{
+					Type:    contracts.RecommendationReasonFollowedArtist,
+					Message: "Because you follow The Band",
+				}},
+			}}, nil
+		},
+	}
+	h := NewRecommendationHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.GetRecommendedShowsHandler(ctx, &GetRecommendedShowsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Shows) != 1 || resp.Body.Shows[0].ID != 42 {
+		t.Fatalf("unexpected shows: %+v", resp.Body.Shows)
+	}
+	if len(resp.Body.Shows[0].Reasons) != 1 {
+		t.Errorf("expected the reason to pass through, got %+v", resp.Body.Shows[0].Reasons)
+	}
+}
+
+func TestGetRecommendedShowsHandler_LimitClamped(t *testing.T) {
+	var sawLimit int
+	mock := &testhelpers.MockRecommendationService{
+		GetRecommendedShowsFn: func(_ uint, limit int) ([]*contracts.RecommendedShow, error) {
+			sawLimit = limit
+			return []*contracts.RecommendedShow{}, nil
+		},
+	}
+	h := NewRecommendationHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	if _, err := h.GetRecommendedShowsHandler(ctx, &GetRecommendedShowsRequest{Limit: 500}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sawLimit != 50 {
+		t.Errorf("expected limit clamped to 50, got %d", sawLimit)
+	}
+}
+
+func TestGetRecommendedShowsHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockRecommendationService{
+		GetRecommendedShowsFn: func(_ uint, _ int) ([]*contracts.RecommendedShow, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewRecommendationHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.GetRecommendedShowsHandler(ctx, &GetRecommendedShowsRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
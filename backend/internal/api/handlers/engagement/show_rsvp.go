@@ -0,0 +1,267 @@
+package engagement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/middleware"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// ShowRSVPHandler handles show RSVP ("I'm going") HTTP requests
+type ShowRSVPHandler struct {
+	rsvpService contracts.ShowRSVPServiceInterface
+}
+
+// NewShowRSVPHandler creates a new show RSVP handler
+func NewShowRSVPHandler(rsvpService contracts.ShowRSVPServiceInterface) *ShowRSVPHandler {
+	return &ShowRSVPHandler{
+		rsvpService: rsvpService,
+	}
+}
+
+// ShowRSVPRequest represents the HTTP request for RSVPing to (or cancelling an
+// RSVP for) a show
+type ShowRSVPRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+}
+
+// ShowRSVPActionResponse represents the HTTP response for an RSVP change
+type ShowRSVPActionResponse struct {
+	Body struct {
+		Success    bool `json:"success"`
+		IsGoing    bool `json:"is_going"`
+		GoingCount int  `json:"going_count"`
+	}
+}
+
+// GetShowRSVPsRequest represents the HTTP request for a show's RSVPs
+type GetShowRSVPsRequest struct {
+	ShowID string `path:"show_id" validate:"required" doc:"Show ID"`
+	Limit  int    `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Number of attendees per page"`
+	Offset int    `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetShowRSVPsResponse carries a show's public going count and the attendees
+// who allow their RSVP to be listed, plus — for an authenticated caller only —
+// whether that caller is going. going_count includes unlisted attendees, so it
+// can exceed attendees_total.
+type GetShowRSVPsResponse struct {
+	Body struct {
+		ShowID         uint                              `json:"show_id"`
+		GoingCount     int                               `json:"going_count"`
+		IsGoing        bool                              `json:"is_going"`
+		Attendees      []*contracts.ShowAttendeeResponse `json:"attendees"`
+		AttendeesTotal int64                             `json:"attendees_total"`
+		Limit          int                               `json:"limit"`
+		Offset         int                               `json:"offset"`
+	}
+}
+
+// GetMyRSVPsRequest represents the HTTP request for listing the user's RSVPs
+type GetMyRSVPsRequest struct {
+	Limit  int `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of shows per page"`
+	Offset int `query:"offset" default:"0" minimum:"0" doc:"Offset for pagination"`
+}
+
+// GetMyRSVPsResponse represents the HTTP response for listing the user's RSVPs
+type GetMyRSVPsResponse struct {
+	Body struct {
+		Shows  []*contracts.ShowRSVPResponse `json:"shows"`
+		Total  int64                         `json:"total"`
+		Limit  int                           `json:"limit"`
+		Offset int                           `json:"offset"`
+	}
+}
+
+// RSVPHandler handles POST /shows/{show_id}/rsvp
+func (h *ShowRSVPHandler) RSVPHandler(ctx context.Context, req *ShowRSVPRequest) (*ShowRSVPActionResponse, error) {
+	return h.changeRSVP(ctx, req, true)
+}
+
+// CancelRSVPHandler handles DELETE /shows/{show_id}/rsvp
+func (h *ShowRSVPHandler) CancelRSVPHandler(ctx context.Context, req *ShowRSVPRequest) (*ShowRSVPActionResponse, error) {
+	return h.changeRSVP(ctx, req, false)
+}
+
+// changeRSVP applies an RSVP or cancellation and echoes the new state, so the
+// client can update its button and count without a second request.
+func (h *ShowRSVPHandler) changeRSVP(ctx context.Context, req *ShowRSVPRequest, going bool) (*ShowRSVPActionResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	op := "show_rsvp"
+	if going {
+		err = h.rsvpService.RSVP(user.ID, uint(showID))
+	} else {
+		op = "show_rsvp_cancel"
+		err = h.rsvpService.CancelRSVP(user.ID, uint(showID))
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error(op+"_failed",
+			"user_id", user.ID,
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		var showErr *apperrors.ShowError
+		if errors.As(err, &showErr) && showErr.Code == apperrors.CodeShowNotFound {
+			return nil, huma.Error404NotFound("Show not found")
+		}
+		return nil, huma.Error422UnprocessableEntity(
+			fmt.Sprintf("Failed to update RSVP (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info(op+"_success",
+		"user_id", user.ID,
+		"show_id", showID,
+		"request_id", requestID,
+	)
+
+	resp := &ShowRSVPActionResponse{}
+	resp.Body.Success = true
+	resp.Body.IsGoing = going
+
+	count, err := h.rsvpService.GetGoingCount(uint(showID))
+	if err != nil {
+		// Non-fatal: the change itself succeeded.
+		logger.FromContext(ctx).Warn(op+"_count_failed",
+			"show_id", showID,
+			"error", err.Error(),
+		)
+	} else {
+		resp.Body.GoingCount = count
+	}
+
+	return resp, nil
+}
+
+// GetShowRSVPsHandler handles GET /shows/{show_id}/rsvps
+// Uses optional auth: the count and opted-in attendees are public; is_going is
+// false for anonymous callers.
+func (h *ShowRSVPHandler) GetShowRSVPsHandler(ctx context.Context, req *GetShowRSVPsRequest) (*GetShowRSVPsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	showID, err := strconv.ParseUint(req.ShowID, 10, 32)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid show ID")
+	}
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	count, err := h.rsvpService.GetGoingCount(uint(showID))
+	if err != nil {
+		logger.FromContext(ctx).Error("get_show_rsvps_count_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get RSVPs (request_id: %s)", requestID),
+		)
+	}
+
+	attendees, total, err := h.rsvpService.GetPublicAttendees(uint(showID), limit, offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("get_show_rsvps_attendees_failed",
+			"show_id", showID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get RSVPs (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &GetShowRSVPsResponse{}
+	resp.Body.ShowID = uint(showID)
+	resp.Body.GoingCount = count
+	resp.Body.Attendees = attendees
+	resp.Body.AttendeesTotal = total
+	resp.Body.Limit = limit
+	resp.Body.Offset = offset
+
+	if user := middleware.GetUserFromContext(ctx); user != nil {
+		isGoing, err := h.rsvpService.IsGoing(user.ID, uint(showID))
+		if err != nil {
+			// Non-fatal: the public data is the primary payload.
+			logger.FromContext(ctx).Warn("get_show_rsvps_is_going_failed",
+				"user_id", user.ID,
+				"show_id", showID,
+				"error", err.Error(),
+			)
+		} else {
+			resp.Body.IsGoing = isGoing
+		}
+	}
+
+	return resp, nil
+}
+
+// GetMyRSVPsHandler handles GET /me/rsvps
+func (h *ShowRSVPHandler) GetMyRSVPsHandler(ctx context.Context, req *GetMyRSVPsRequest) (*GetMyRSVPsResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	shows, total, err := h.rsvpService.GetUserRSVPs(user.ID, limit, offset)
+	if err != nil {
+		logger.FromContext(ctx).Error("get_my_rsvps_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get RSVPs (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &GetMyRSVPsResponse{}
+	resp.Body.Shows = shows
+	resp.Body.Total = total
+	resp.Body.Limit = limit
+	resp.Body.Offset = offset
+
+	return resp, nil
+}
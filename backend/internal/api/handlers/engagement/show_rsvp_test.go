@@ -0,0 +1,256 @@
+package engagement
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// --- RSVPHandler / CancelRSVPHandler ---
+
+func TestRSVPHandler_NoAuth(t *testing.T) {
+	h := NewShowRSVPHandler(nil)
+
+	_, err := h.RSVPHandler(context.Background(), &ShowRSVPRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestRSVPHandler_InvalidID(t *testing.T) {
+	h := NewShowRSVPHandler(nil)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.RSVPHandler(ctx, &ShowRSVPRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestRSVPHandler_Success_EchoesCount(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		RSVPFn: func(userID, showID uint) error {
+			if userID != 1 || showID != 42 {
+				t.Errorf("unexpected args: userID=%d, showID=%d", userID, showID)
+			}
+			return nil
+		},
+		GetGoingCountFn: func(_ uint) (int, error) { return 4, nil },
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.RSVPHandler(ctx, &ShowRSVPRequest{ShowID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success || !resp.Body.IsGoing {
+		t.Errorf("expected success and is_going, got %+v", resp.Body)
+	}
+	if resp.Body.GoingCount != 4 {
+		t.Errorf("expected going_count 4, got %d", resp.Body.GoingCount)
+	}
+}
+
+func TestRSVPHandler_ShowNotFound(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		RSVPFn: func(_, showID uint) error { return apperrors.ErrShowNotFound(showID) },
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.RSVPHandler(ctx, &ShowRSVPRequest{ShowID: "42"})
+	testhelpers.AssertHumaError(t, err, 404)
+}
+
+func TestRSVPHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		RSVPFn: func(_, _ uint) error { return fmt.Errorf("db error") },
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.RSVPHandler(ctx, &ShowRSVPRequest{ShowID: "42"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestRSVPHandler_CountFailureIsNonFatal(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		RSVPFn:          func(_, _ uint) error { return nil },
+		GetGoingCountFn: func(_ uint) (int, error) { return 0, fmt.Errorf("db error") },
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.RSVPHandler(ctx, &ShowRSVPRequest{ShowID: "42"})
+	if err != nil {
+		t.Fatalf("count failure must be non-fatal, got %v", err)
+	}
+	if !resp.Body.IsGoing {
+		t.Error("expected is_going=true")
+	}
+}
+
+func TestCancelRSVPHandler_NoAuth(t *testing.T) {
+	h := NewShowRSVPHandler(nil)
+
+	_, err := h.CancelRSVPHandler(context.Background(), &ShowRSVPRequest{ShowID: "1"})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestCancelRSVPHandler_Success(t *testing.T) {
+	called := false
+	mock := &testhelpers.MockShowRSVPService{
+		CancelRSVPFn: func(userID, showID uint) error {
+			called = userID == 1 && showID == 42
+			return nil
+		},
+		GetGoingCountFn: func(_ uint) (int, error) { return 0, nil },
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.CancelRSVPHandler(ctx, &ShowRSVPRequest{ShowID: "42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("expected CancelRSVP(1, 42)")
+	}
+	if resp.Body.IsGoing {
+		t.Error("expected is_going=false after cancelling")
+	}
+}
+
+func TestCancelRSVPHandler_NotRSVPd(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		CancelRSVPFn: func(_, _ uint) error { return fmt.Errorf("no rsvp for this show") },
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.CancelRSVPHandler(ctx, &ShowRSVPRequest{ShowID: "42"})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+// --- GetShowRSVPsHandler (public, optional auth) ---
+
+func TestGetShowRSVPsHandler_Anonymous(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		GetGoingCountFn: func(_ uint) (int, error) { return 5, nil },
+		GetPublicAttendeesFn: func(showID uint, limit, offset int) ([]*contracts.ShowAttendeeResponse, int64, error) {
+			if showID != 42 || limit != 20 || offset != 0 {
+				t.Errorf("unexpected args: showID=%d limit=%d offset=%d", showID, limit, offset)
+			}
+			return []*contracts.ShowAttendeeResponse{{UserID: 3, Username: "pat"}}, 1, nil
+		},
+		IsGoingFn: func(_, _ uint) (bool, error) {
+			t.Fatal("IsGoing must not be called for an anonymous request")
+			return false, nil
+		},
+	}
+	h := NewShowRSVPHandler(mock)
+
+	resp, err := h.GetShowRSVPsHandler(context.Background(), &GetShowRSVPsRequest{ShowID: "42", Limit: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// going_count includes attendees who keep their RSVP unlisted.
+	if resp.Body.GoingCount != 5 || resp.Body.AttendeesTotal != 1 {
+		t.Errorf("expected going_count 5 and attendees_total 1, got %d/%d", resp.Body.GoingCount, resp.Body.AttendeesTotal)
+	}
+	if len(resp.Body.Attendees) != 1 || resp.Body.Attendees[0].Username != "pat" {
+		t.Errorf("unexpected attendees: %+v", resp.Body.Attendees)
+	}
+	if resp.Body.IsGoing {
+		t.Error("anonymous caller must never receive is_going=true")
+	}
+}
+
+func TestGetShowRSVPsHandler_Authenticated_IncludesOwnIsGoing(t *testing.T) {
+	var sawUserID uint
+	mock := &testhelpers.MockShowRSVPService{
+		GetGoingCountFn: func(_ uint) (int, error) { return 1, nil },
+		GetPublicAttendeesFn: func(_ uint, _, _ int) ([]*contracts.ShowAttendeeResponse, int64, error) {
+			return []*contracts.ShowAttendeeResponse{}, 0, nil
+		},
+		IsGoingFn: func(userID, _ uint) (bool, error) {
+			sawUserID = userID
+			return true, nil
+		},
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 9})
+
+	resp, err := h.GetShowRSVPsHandler(ctx, &GetShowRSVPsRequest{ShowID: "42", Limit: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.IsGoing {
+		t.Error("expected is_going=true")
+	}
+	if sawUserID != 9 {
+		t.Errorf("expected IsGoing scoped to user 9, got %d", sawUserID)
+	}
+}
+
+func TestGetShowRSVPsHandler_InvalidShowID(t *testing.T) {
+	h := NewShowRSVPHandler(nil)
+
+	_, err := h.GetShowRSVPsHandler(context.Background(), &GetShowRSVPsRequest{ShowID: "abc"})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestGetShowRSVPsHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		GetGoingCountFn: func(_ uint) (int, error) { return 0, fmt.Errorf("db error") },
+	}
+	h := NewShowRSVPHandler(mock)
+
+	_, err := h.GetShowRSVPsHandler(context.Background(), &GetShowRSVPsRequest{ShowID: "42"})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+// --- GetMyRSVPsHandler ---
+
+func TestGetMyRSVPsHandler_NoAuth(t *testing.T) {
+	h := NewShowRSVPHandler(nil)
+
+	_, err := h.GetMyRSVPsHandler(context.Background(), &GetMyRSVPsRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestGetMyRSVPsHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		GetUserRSVPsFn: func(userID uint, limit, offset int) ([]*contracts.ShowRSVPResponse, int64, error) {
+			if userID != 1 || limit != 50 || offset != 0 {
+				t.Errorf("unexpected args: userID=%d limit=%d offset=%d", userID, limit, offset)
+			}
+			return []*contracts.ShowRSVPResponse{{ShowResponse: contracts.ShowResponse{ID: 42}}}, 1, nil
+		},
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.GetMyRSVPsHandler(ctx, &GetMyRSVPsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Total != 1 || len(resp.Body.Shows) != 1 || resp.Body.Shows[0].ID != 42 {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestGetMyRSVPsHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockShowRSVPService{
+		GetUserRSVPsFn: func(_ uint, _, _ int) ([]*contracts.ShowRSVPResponse, int64, error) {
+			return nil, 0, fmt.Errorf("db error")
+		},
+	}
+	h := NewShowRSVPHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.GetMyRSVPsHandler(ctx, &GetMyRSVPsRequest{Limit: 10})
+	testhelpers.AssertHumaError(t, err, 500)
+}
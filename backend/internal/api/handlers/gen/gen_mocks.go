@@ -66,6 +66,8 @@ var ifaceConfigs = map[string]interfaceConfig{
 	"EnrichmentWorkerInterface": {skip: true}, // Not used in handler tests
 	"AppleAuthServiceInterface": {skip: true}, // Has its own mock in apple_auth_test.go
 	"OAuthCompleter":            {skip: true}, // Not a service
+	"AdminEventBusInterface":    {skip: true}, // Channel-returning Subscribe; fake in admin_events_test.go
+	"AdminEventPublisher":       {skip: true}, // Embedded in AdminEventBusInterface
 }
 
 // Custom method defaults for methods that need non-zero-value defaults.
@@ -541,7 +543,9 @@ package testhelpers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -562,7 +566,9 @@ import (
 // Suppress unused import warnings.
 var (
 	_ context.Context
+	_ json.RawMessage
 	_ fmt.Stringer
+	_ io.Reader
 	_ http.ResponseWriter
 	_ time.Duration
 	_ *protocol.CredentialCreation
@@ -722,6 +728,10 @@ func zeroValue(typ string) string {
 		return `""`
 	case "time.Duration":
 		return "0"
+	case "authm.Role":
+		// Named string types: the generator works from the AST alone, so
+		// it can't tell them from structs.
+		return `""`
 	case "[]byte":
 		return "nil"
 	default:
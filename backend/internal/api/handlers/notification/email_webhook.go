@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/respond"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/notification"
+)
+
+// maxBounceWebhookBytes caps a webhook body; provider payloads are a few KB.
+const maxBounceWebhookBytes = 1 << 20
+
+// EmailWebhookHandler ingests bounce and complaint webhooks from the email
+// provider into the suppression list. It's a plain chi handler because each
+// provider posts its own payload shape (SNS even sends text/plain).
+type EmailWebhookHandler struct {
+	suppressions contracts.EmailSuppressionServiceInterface
+	secret       string
+}
+
+// NewEmailWebhookHandler creates a new email webhook handler. secret is
+// EMAIL_WEBHOOK_SECRET.
+func NewEmailWebhookHandler(suppressions contracts.EmailSuppressionServiceInterface, secret string) *EmailWebhookHandler {
+	return &EmailWebhookHandler{
+		suppressions: suppressions,
+		secret:       secret,
+	}
+}
+
+// authorized checks the shared secret, given either as ?token= or as the
+// basic-auth password (Postmark webhooks carry credentials in the URL).
+func (h *EmailWebhookHandler) authorized(r *http.Request) bool {
+	presented := r.URL.Query().Get("token")
+	if _, password, ok := r.BasicAuth(); ok {
+		presented = password
+	}
+	return h.secret != "" && subtle.ConstantTimeCompare([]byte(presented), []byte(h.secret)) == 1
+}
+
+// BounceWebhookHandler handles POST /webhooks/email/{provider}
+func (h *EmailWebhookHandler) BounceWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	if !h.authorized(r) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBounceWebhookBytes))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	added, err := h.suppressions.IngestBounceWebhook(provider, body)
+	switch {
+	case errors.Is(err, notification.ErrUnknownBounceProvider):
+		http.NotFound(w, r)
+		return
+	case errors.Is(err, notification.ErrInvalidBouncePayload):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		logger.FromContext(r.Context()).Error("email_bounce_webhook_failed",
+			"provider", provider,
+			"error", err.Error(),
+			"request_id", logger.GetRequestID(r.Context()),
+		)
+		// 5xx so the provider redelivers.
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if added > 0 {
+		logger.FromContext(r.Context()).Info("email_addresses_suppressed",
+			"provider", provider,
+			"count", added,
+		)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	respond.SafeEncode(r.Context(), w, map[string]int{"suppressed": added})
+}
@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/services/notification"
+)
+
+// serveBounceWebhook routes one request through chi so {provider} resolves.
+func serveBounceWebhook(h *EmailWebhookHandler, target string, setup func(*http.Request)) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	r.Post("/webhooks/email/{provider}", h.BounceWebhookHandler)
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(`{"RecordType":"Bounce"}`))
+	if setup != nil {
+		setup(req)
+	}
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestBounceWebhookHandler_Success(t *testing.T) {
+	var gotProvider, gotBody string
+	h := NewEmailWebhookHandler(&testhelpers.MockEmailSuppressionService{
+		IngestBounceWebhookFn: func(provider string, body []byte) (int, error) {
+			gotProvider, gotBody = provider, string(body)
+			return 1, nil
+		},
+	}, "s3cret")
+
+	rr := serveBounceWebhook(h, "/webhooks/email/postmark?token=s3cret", nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rr.Code)
+	}
+	if gotProvider != "postmark" || gotBody != `{"RecordType":"Bounce"}` {
+		t.Errorf("unexpected ingest call: provider=%q body=%q", gotProvider, gotBody)
+	}
+	if !strings.Contains(rr.Body.String(), `"suppressed":1`) {
+		t.Errorf("unexpected body: %s", rr.Body.String())
+	}
+}
+
+func TestBounceWebhookHandler_BasicAuth(t *testing.T) {
+	h := NewEmailWebhookHandler(&testhelpers.MockEmailSuppressionService{}, "s3cret")
+
+	rr := serveBounceWebhook(h, "/webhooks/email/postmark", func(r *http.Request) { r.SetBasicAuth("postmark", "s3cret") })
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected basic-auth password to be accepted, got %d", rr.Code)
+	}
+}
+
+func TestBounceWebhookHandler_Unauthorized(t *testing.T) {
+	called := false
+	mock := &testhelpers.MockEmailSuppressionService{
+		IngestBounceWebhookFn: func(string, []byte) (int, error) {
+			called = true
+			return 0, nil
+		},
+	}
+
+	for _, target := range []string{"/webhooks/email/postmark", "/webhooks/email/postmark?token=wrong"} {
+		if rr := serveBounceWebhook(NewEmailWebhookHandler(mock, "s3cret"), target, nil); rr.Code != http.StatusUnauthorized {
+			t.Errorf("%s: expected 401, got %d", target, rr.Code)
+		}
+	}
+	if rr := serveBounceWebhook(NewEmailWebhookHandler(mock, ""), "/webhooks/email/postmark?token=", nil); rr.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 with no secret configured, got %d", rr.Code)
+	}
+	if called {
+		t.Error("unauthorized webhooks must not be ingested")
+	}
+}
+
+func TestBounceWebhookHandler_MapsErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"unknown provider", notification.ErrUnknownBounceProvider, http.StatusNotFound},
+		{"invalid payload", fmt.Errorf("%w: unexpected EOF", notification.ErrInvalidBouncePayload), http.StatusBadRequest},
+		{"other", fmt.Errorf("db down"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewEmailWebhookHandler(&testhelpers.MockEmailSuppressionService{
+				IngestBounceWebhookFn: func(string, []byte) (int, error) { return 0, tc.err },
+			}, "s3cret")
+
+			if rr := serveBounceWebhook(h, "/webhooks/email/postmark?token=s3cret", nil); rr.Code != tc.status {
+				t.Errorf("expected %d, got %d", tc.status, rr.Code)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"psychic-homily-backend/internal/api/handlers/shared"
 	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/config"
 	"psychic-homily-backend/internal/logger"
 	notificationm "psychic-homily-backend/internal/models/notification"
 	"psychic-homily-backend/internal/services/contracts"
@@ -19,17 +20,17 @@ import (
 // NotificationFilterHandler handles notification filter HTTP requests.
 type NotificationFilterHandler struct {
 	filterService contracts.NotificationFilterServiceInterface
-	jwtSecret     string
+	keys          *config.JWTKeyRing
 }
 
 // NewNotificationFilterHandler creates a new notification filter handler.
 func NewNotificationFilterHandler(
 	filterService contracts.NotificationFilterServiceInterface,
-	jwtSecret string,
+	keys *config.JWTKeyRing,
 ) *NotificationFilterHandler {
 	return &NotificationFilterHandler{
 		filterService: filterService,
-		jwtSecret:     jwtSecret,
+		keys:          keys,
 	}
 }
 
@@ -470,7 +471,9 @@ func (h *NotificationFilterHandler) UnsubscribeFilterHandler(ctx context.Context
 		return nil, huma.Error400BadRequest("Invalid filter ID")
 	}
 
-	if !notification.VerifyFilterUnsubscribeSignature(uint(filterID), req.Body.Sig, h.jwtSecret) {
+	if !h.keys.Verify(func(secret string) bool {
+		return notification.VerifyFilterUnsubscribeSignature(uint(filterID), req.Body.Sig, secret)
+	}) {
 		return nil, huma.Error403Forbidden("Invalid unsubscribe link")
 	}
 
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	"psychic-homily-backend/internal/config"
 	apperrors "psychic-homily-backend/internal/errors"
 	"psychic-homily-backend/internal/services/contracts"
 
@@ -19,7 +20,7 @@ import (
 )
 
 func testNotificationFilterHandler() *NotificationFilterHandler {
-	return NewNotificationFilterHandler(nil, "test-secret")
+	return NewNotificationFilterHandler(nil, config.NewJWTKeyRing("test-secret", nil))
 }
 
 // --- ListFiltersHandler ---
@@ -38,7 +39,7 @@ func TestListFiltersHandler_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	resp, err := h.ListFiltersHandler(ctx, &ListFiltersRequest{})
@@ -59,7 +60,7 @@ func TestListFiltersHandler_ServiceError(t *testing.T) {
 			return nil, fmt.Errorf("db error")
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.ListFiltersHandler(ctx, &ListFiltersRequest{})
@@ -91,7 +92,7 @@ func TestCreateFilterHandler_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &CreateFilterRequest{}
@@ -113,7 +114,7 @@ func TestCreateFilterHandler_ValidationError(t *testing.T) {
 			return nil, apperrors.ErrFilterValidation("at least one filter criteria is required")
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &CreateFilterRequest{}
@@ -129,7 +130,7 @@ func TestCreateFilterHandler_ServiceError(t *testing.T) {
 			return nil, apperrors.ErrFilterInternal(fmt.Errorf("db down"))
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &CreateFilterRequest{}
@@ -149,7 +150,7 @@ func TestUpdateFilterHandler_NoAuth(t *testing.T) {
 }
 
 func TestUpdateFilterHandler_InvalidID(t *testing.T) {
-	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, "test-secret")
+	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.UpdateFilterHandler(ctx, &UpdateFilterRequest{ID: "abc"})
@@ -162,7 +163,7 @@ func TestUpdateFilterHandler_NotFound(t *testing.T) {
 			return nil, apperrors.ErrFilterNotFound()
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.UpdateFilterHandler(ctx, &UpdateFilterRequest{ID: "99"})
@@ -175,7 +176,7 @@ func TestUpdateFilterHandler_ServiceError(t *testing.T) {
 			return nil, apperrors.ErrFilterInternal(fmt.Errorf("db down"))
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.UpdateFilterHandler(ctx, &UpdateFilterRequest{ID: "42"})
@@ -192,7 +193,7 @@ func TestUpdateFilterHandler_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &UpdateFilterRequest{ID: "42"}
@@ -217,7 +218,7 @@ func TestDeleteFilterHandler_NoAuth(t *testing.T) {
 }
 
 func TestDeleteFilterHandler_InvalidID(t *testing.T) {
-	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, "test-secret")
+	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.DeleteFilterHandler(ctx, &DeleteFilterRequest{ID: "abc"})
@@ -233,7 +234,7 @@ func TestDeleteFilterHandler_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	resp, err := h.DeleteFilterHandler(ctx, &DeleteFilterRequest{ID: "42"})
@@ -251,7 +252,7 @@ func TestDeleteFilterHandler_NotFound(t *testing.T) {
 			return apperrors.ErrFilterNotFound()
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.DeleteFilterHandler(ctx, &DeleteFilterRequest{ID: "99"})
@@ -264,7 +265,7 @@ func TestDeleteFilterHandler_ServiceError(t *testing.T) {
 			return apperrors.ErrFilterInternal(fmt.Errorf("db down"))
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.DeleteFilterHandler(ctx, &DeleteFilterRequest{ID: "42"})
@@ -280,7 +281,7 @@ func TestQuickCreateFilterHandler_NoAuth(t *testing.T) {
 }
 
 func TestQuickCreateFilterHandler_InvalidEntityID(t *testing.T) {
-	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, "test-secret")
+	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &QuickCreateFilterRequest{}
@@ -301,7 +302,7 @@ func TestQuickCreateFilterHandler_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &QuickCreateFilterRequest{}
@@ -336,7 +337,7 @@ func TestGetNotificationsHandler_Success(t *testing.T) {
 			return 3, nil
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	resp, err := h.GetNotificationsHandler(ctx, &GetNotificationsRequest{Limit: 20, Offset: 0})
@@ -363,7 +364,7 @@ func TestUnsubscribeFilterHandler_InvalidID(t *testing.T) {
 }
 
 func TestUnsubscribeFilterHandler_InvalidSignature(t *testing.T) {
-	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, "test-secret")
+	h := NewNotificationFilterHandler(&testhelpers.MockNotificationFilterService{}, config.NewJWTKeyRing("test-secret", nil))
 	req := &UnsubscribeFilterRequest{ID: "42"}
 	req.Body.Sig = "bad-signature"
 
@@ -380,7 +381,7 @@ func TestUnsubscribeFilterHandler_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 
 	// Compute valid HMAC signature — mirrors ComputeFilterUnsubscribeSignature
 	sig := computeTestFilterSig(42, "test-secret")
@@ -396,6 +397,24 @@ func TestUnsubscribeFilterHandler_Success(t *testing.T) {
 	}
 }
 
+func TestUnsubscribeFilterHandler_PreviousKey(t *testing.T) {
+	mock := &testhelpers.MockNotificationFilterService{
+		PauseFilterFn: func(uint) error { return nil },
+	}
+	// The email went out before JWT_SECRET_KEY rotated to new-secret.
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("new-secret", []string{"test-secret"}))
+	req := &UnsubscribeFilterRequest{ID: "42"}
+	req.Body.Sig = computeTestFilterSig(42, "test-secret")
+
+	resp, err := h.UnsubscribeFilterHandler(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success {
+		t.Error("expected success=true")
+	}
+}
+
 // --- MarkNotificationsReadHandler (PSY-595) ---
 
 func TestMarkNotificationsReadHandler_NoAuth(t *testing.T) {
@@ -413,7 +432,7 @@ func TestMarkNotificationsReadHandler_MarkAll(t *testing.T) {
 		},
 		GetUnreadCountFn: func(_ uint) (int64, error) { return 0, nil },
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 99})
 
 	req := &MarkNotificationsReadRequest{}
@@ -441,7 +460,7 @@ func TestMarkNotificationsReadHandler_MarkSpecific(t *testing.T) {
 		},
 		GetUnreadCountFn: func(_ uint) (int64, error) { return 2, nil },
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	req := &MarkNotificationsReadRequest{}
@@ -467,7 +486,7 @@ func TestMarkNotificationsReadHandler_ServiceError(t *testing.T) {
 			return 0, fmt.Errorf("db down")
 		},
 	}
-	h := NewNotificationFilterHandler(mock, "test-secret")
+	h := NewNotificationFilterHandler(mock, config.NewJWTKeyRing("test-secret", nil))
 	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
 
 	_, err := h.MarkNotificationsReadHandler(ctx, &MarkNotificationsReadRequest{})
@@ -0,0 +1,96 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// NotificationPreferenceHandler handles the per-user notification preference
+// matrix.
+type NotificationPreferenceHandler struct {
+	preferenceService contracts.NotificationPreferenceServiceInterface
+}
+
+// NewNotificationPreferenceHandler creates a new notification preference handler.
+func NewNotificationPreferenceHandler(preferenceService contracts.NotificationPreferenceServiceInterface) *NotificationPreferenceHandler {
+	return &NotificationPreferenceHandler{
+		preferenceService: preferenceService,
+	}
+}
+
+// GetNotificationPreferencesRequest is the request for GET /users/me/notification-preferences
+type GetNotificationPreferencesRequest struct{}
+
+// NotificationPreferencesResponse is the response for GET and PUT /users/me/notification-preferences
+type NotificationPreferencesResponse struct {
+	Body struct {
+		Preferences contracts.NotificationPreferenceMatrix `json:"preferences" doc:"Event type → channel → enabled"`
+	}
+}
+
+// UpdateNotificationPreferencesRequest is the request for PUT /users/me/notification-preferences
+type UpdateNotificationPreferencesRequest struct {
+	Body struct {
+		Preferences contracts.NotificationPreferenceMatrix `json:"preferences" doc:"Cells to change; omitted cells keep their current value"`
+	}
+}
+
+// GetNotificationPreferencesHandler handles GET /users/me/notification-preferences
+func (h *NotificationPreferenceHandler) GetNotificationPreferencesHandler(ctx context.Context, _ *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	prefs, err := h.preferenceService.GetPreferences(user.ID)
+	if err != nil {
+		requestID := logger.GetRequestID(ctx)
+		logger.FromContext(ctx).Error("get_notification_preferences_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to get notification preferences (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &NotificationPreferencesResponse{}
+	resp.Body.Preferences = prefs
+	return resp, nil
+}
+
+// UpdateNotificationPreferencesHandler handles PUT /users/me/notification-preferences
+func (h *NotificationPreferenceHandler) UpdateNotificationPreferencesHandler(ctx context.Context, req *UpdateNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	prefs, err := h.preferenceService.UpdatePreferences(user.ID, req.Body.Preferences)
+	if err != nil {
+		if mapped := shared.MapNotificationPreferenceError(err); mapped != nil {
+			return nil, mapped
+		}
+		requestID := logger.GetRequestID(ctx)
+		logger.FromContext(ctx).Error("update_notification_preferences_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to update notification preferences (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &NotificationPreferencesResponse{}
+	resp.Body.Preferences = prefs
+	return resp, nil
+}
@@ -0,0 +1,115 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// --- GetNotificationPreferencesHandler ---
+
+func TestGetNotificationPreferencesHandler_NoAuth(t *testing.T) {
+	h := NewNotificationPreferenceHandler(nil)
+	_, err := h.GetNotificationPreferencesHandler(context.Background(), &GetNotificationPreferencesRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestGetNotificationPreferencesHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockNotificationPreferenceService{
+		GetPreferencesFn: func(userID uint) (contracts.NotificationPreferenceMatrix, error) {
+			if userID != 1 {
+				t.Errorf("expected userID 1, got %d", userID)
+			}
+			return contracts.NotificationPreferenceMatrix{
+				contracts.NotificationEventDigest: {contracts.NotificationChannelEmail: true},
+			}, nil
+		},
+	}
+	h := NewNotificationPreferenceHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	resp, err := h.GetNotificationPreferencesHandler(ctx, &GetNotificationPreferencesRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Preferences[contracts.NotificationEventDigest][contracts.NotificationChannelEmail] {
+		t.Error("expected digest/email to be enabled")
+	}
+}
+
+func TestGetNotificationPreferencesHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockNotificationPreferenceService{
+		GetPreferencesFn: func(_ uint) (contracts.NotificationPreferenceMatrix, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewNotificationPreferenceHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.GetNotificationPreferencesHandler(ctx, &GetNotificationPreferencesRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+// --- UpdateNotificationPreferencesHandler ---
+
+func TestUpdateNotificationPreferencesHandler_NoAuth(t *testing.T) {
+	h := NewNotificationPreferenceHandler(nil)
+	_, err := h.UpdateNotificationPreferencesHandler(context.Background(), &UpdateNotificationPreferencesRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestUpdateNotificationPreferencesHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockNotificationPreferenceService{
+		UpdatePreferencesFn: func(_ uint, updates contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error) {
+			if updates[contracts.NotificationEventSubmissionStatus][contracts.NotificationChannelPush] != true {
+				t.Errorf("expected submission_status/push update, got %v", updates)
+			}
+			return updates, nil
+		},
+	}
+	h := NewNotificationPreferenceHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	req := &UpdateNotificationPreferencesRequest{}
+	req.Body.Preferences = contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventSubmissionStatus: {contracts.NotificationChannelPush: true},
+	}
+	resp, err := h.UpdateNotificationPreferencesHandler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Preferences[contracts.NotificationEventSubmissionStatus][contracts.NotificationChannelPush] {
+		t.Error("expected submission_status/push in response")
+	}
+}
+
+func TestUpdateNotificationPreferencesHandler_Invalid(t *testing.T) {
+	mock := &testhelpers.MockNotificationPreferenceService{
+		UpdatePreferencesFn: func(_ uint, _ contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error) {
+			return nil, apperrors.ErrNotificationPreferenceInvalid(`unknown channel "sms"`)
+		},
+	}
+	h := NewNotificationPreferenceHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.UpdateNotificationPreferencesHandler(ctx, &UpdateNotificationPreferencesRequest{})
+	testhelpers.AssertHumaError(t, err, 422)
+}
+
+func TestUpdateNotificationPreferencesHandler_ServiceError(t *testing.T) {
+	mock := &testhelpers.MockNotificationPreferenceService{
+		UpdatePreferencesFn: func(_ uint, _ contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error) {
+			return nil, fmt.Errorf("db error")
+		},
+	}
+	h := NewNotificationPreferenceHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.UpdateNotificationPreferencesHandler(ctx, &UpdateNotificationPreferencesRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
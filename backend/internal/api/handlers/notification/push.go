@@ -0,0 +1,140 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared"
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// PushHandler handles Web Push subscription endpoints.
+type PushHandler struct {
+	pushService contracts.PushServiceInterface
+}
+
+// NewPushHandler creates a new push handler.
+func NewPushHandler(pushService contracts.PushServiceInterface) *PushHandler {
+	return &PushHandler{
+		pushService: pushService,
+	}
+}
+
+// GetVAPIDPublicKeyRequest is the request for GET /push/vapid-public-key
+type GetVAPIDPublicKeyRequest struct{}
+
+// GetVAPIDPublicKeyResponse is the response for GET /push/vapid-public-key
+type GetVAPIDPublicKeyResponse struct {
+	Body struct {
+		Enabled   bool   `json:"enabled" doc:"Whether push notifications are available"`
+		PublicKey string `json:"public_key,omitempty" doc:"VAPID application server key (base64url) for PushManager.subscribe"`
+	}
+}
+
+// PushSubscriptionKeys mirrors PushSubscription.toJSON().keys in the browser.
+type PushSubscriptionKeys struct {
+	P256dh string `json:"p256dh" doc:"Browser's P-256 public key (base64url)"`
+	Auth   string `json:"auth" doc:"Browser's auth secret (base64url)"`
+}
+
+// SubscribePushRequest is the request for POST /me/push-subscriptions
+type SubscribePushRequest struct {
+	UserAgent string `header:"User-Agent"`
+	Body      struct {
+		Endpoint string               `json:"endpoint" doc:"Push service endpoint URL"`
+		Keys     PushSubscriptionKeys `json:"keys"`
+	}
+}
+
+// SubscribePushResponse is the response for POST /me/push-subscriptions
+type SubscribePushResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+// UnsubscribePushRequest is the request for DELETE /me/push-subscriptions
+type UnsubscribePushRequest struct {
+	Body struct {
+		Endpoint string `json:"endpoint" doc:"Push service endpoint URL to remove"`
+	}
+}
+
+// UnsubscribePushResponse is the response for DELETE /me/push-subscriptions
+type UnsubscribePushResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+// GetVAPIDPublicKeyHandler handles GET /push/vapid-public-key
+func (h *PushHandler) GetVAPIDPublicKeyHandler(_ context.Context, _ *GetVAPIDPublicKeyRequest) (*GetVAPIDPublicKeyResponse, error) {
+	resp := &GetVAPIDPublicKeyResponse{}
+	resp.Body.Enabled = h.pushService.IsConfigured()
+	resp.Body.PublicKey = h.pushService.PublicKey()
+	return resp, nil
+}
+
+// SubscribePushHandler handles POST /me/push-subscriptions
+func (h *PushHandler) SubscribePushHandler(ctx context.Context, req *SubscribePushRequest) (*SubscribePushResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	err := h.pushService.Subscribe(user.ID, contracts.PushSubscriptionInput{
+		Endpoint:  req.Body.Endpoint,
+		P256dh:    req.Body.Keys.P256dh,
+		Auth:      req.Body.Keys.Auth,
+		UserAgent: req.UserAgent,
+	})
+	if err != nil {
+		if mapped := shared.MapPushError(err); mapped != nil {
+			return nil, mapped
+		}
+		requestID := logger.GetRequestID(ctx)
+		logger.FromContext(ctx).Error("push_subscribe_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to save push subscription (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &SubscribePushResponse{}
+	resp.Body.Success = true
+	return resp, nil
+}
+
+// UnsubscribePushHandler handles DELETE /me/push-subscriptions
+func (h *PushHandler) UnsubscribePushHandler(ctx context.Context, req *UnsubscribePushRequest) (*UnsubscribePushResponse, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	if err := h.pushService.Unsubscribe(user.ID, req.Body.Endpoint); err != nil {
+		if mapped := shared.MapPushError(err); mapped != nil {
+			return nil, mapped
+		}
+		requestID := logger.GetRequestID(ctx)
+		logger.FromContext(ctx).Error("push_unsubscribe_failed",
+			"user_id", user.ID,
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to remove push subscription (request_id: %s)", requestID),
+		)
+	}
+
+	resp := &UnsubscribePushResponse{}
+	resp.Body.Success = true
+	return resp, nil
+}
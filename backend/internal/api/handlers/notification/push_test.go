@@ -0,0 +1,139 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// --- GetVAPIDPublicKeyHandler ---
+
+func TestGetVAPIDPublicKeyHandler(t *testing.T) {
+	mock := &testhelpers.MockPushService{
+		IsConfiguredFn: func() bool { return true },
+		PublicKeyFn:    func() string { return "BPubKey" },
+	}
+	h := NewPushHandler(mock)
+
+	resp, err := h.GetVAPIDPublicKeyHandler(context.Background(), &GetVAPIDPublicKeyRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Enabled || resp.Body.PublicKey != "BPubKey" {
+		t.Errorf("unexpected response: %+v", resp.Body)
+	}
+}
+
+func TestGetVAPIDPublicKeyHandler_Disabled(t *testing.T) {
+	h := NewPushHandler(&testhelpers.MockPushService{})
+
+	resp, err := h.GetVAPIDPublicKeyHandler(context.Background(), &GetVAPIDPublicKeyRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Body.Enabled || resp.Body.PublicKey != "" {
+		t.Errorf("expected disabled response, got %+v", resp.Body)
+	}
+}
+
+// --- SubscribePushHandler ---
+
+func TestSubscribePushHandler_NoAuth(t *testing.T) {
+	h := NewPushHandler(nil)
+	_, err := h.SubscribePushHandler(context.Background(), &SubscribePushRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestSubscribePushHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockPushService{
+		SubscribeFn: func(userID uint, input contracts.PushSubscriptionInput) error {
+			if userID != 1 {
+				t.Errorf("expected userID 1, got %d", userID)
+			}
+			if input.Endpoint != "https://fcm.googleapis.com/fcm/send/x" || input.P256dh != "p" || input.Auth != "a" || input.UserAgent != "Firefox" {
+				t.Errorf("unexpected input: %+v", input)
+			}
+			return nil
+		},
+	}
+	h := NewPushHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	req := &SubscribePushRequest{UserAgent: "Firefox"}
+	req.Body.Endpoint = "https://fcm.googleapis.com/fcm/send/x"
+	req.Body.Keys = PushSubscriptionKeys{P256dh: "p", Auth: "a"}
+	resp, err := h.SubscribePushHandler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success {
+		t.Error("expected success")
+	}
+}
+
+func TestSubscribePushHandler_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		status int
+	}{
+		{apperrors.ErrPushNotConfigured(), 503},
+		{apperrors.ErrPushSubscriptionInvalid("bad key"), 422},
+		{fmt.Errorf("db error"), 500},
+	} {
+		mock := &testhelpers.MockPushService{
+			SubscribeFn: func(_ uint, _ contracts.PushSubscriptionInput) error { return tc.err },
+		}
+		h := NewPushHandler(mock)
+		ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+		_, err := h.SubscribePushHandler(ctx, &SubscribePushRequest{})
+		testhelpers.AssertHumaError(t, err, tc.status)
+	}
+}
+
+// --- UnsubscribePushHandler ---
+
+func TestUnsubscribePushHandler_NoAuth(t *testing.T) {
+	h := NewPushHandler(nil)
+	_, err := h.UnsubscribePushHandler(context.Background(), &UnsubscribePushRequest{})
+	testhelpers.AssertHumaError(t, err, 401)
+}
+
+func TestUnsubscribePushHandler_Success(t *testing.T) {
+	mock := &testhelpers.MockPushService{
+		UnsubscribeFn: func(userID uint, endpoint string) error {
+			if userID != 1 || endpoint != "https://fcm.googleapis.com/fcm/send/x" {
+				t.Errorf("unexpected args %d %q", userID, endpoint)
+			}
+			return nil
+		},
+	}
+	h := NewPushHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	req := &UnsubscribePushRequest{}
+	req.Body.Endpoint = "https://fcm.googleapis.com/fcm/send/x"
+	resp, err := h.UnsubscribePushHandler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Body.Success {
+		t.Error("expected success")
+	}
+}
+
+func TestUnsubscribePushHandler_NotFound(t *testing.T) {
+	mock := &testhelpers.MockPushService{
+		UnsubscribeFn: func(_ uint, _ string) error { return apperrors.ErrPushSubscriptionNotFound() },
+	}
+	h := NewPushHandler(mock)
+	ctx := testhelpers.CtxWithUser(&authm.User{ID: 1})
+
+	_, err := h.UnsubscribePushHandler(ctx, &UnsubscribePushRequest{})
+	testhelpers.AssertHumaError(t, err, 404)
+}
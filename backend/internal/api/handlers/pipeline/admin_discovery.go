@@ -10,6 +10,7 @@ import (
 	"psychic-homily-backend/internal/logger"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	"psychic-homily-backend/internal/services/contracts"
+	pipelinesvc "psychic-homily-backend/internal/services/pipeline"
 )
 
 // AdminDiscoveryHandler handles admin discovery import/check endpoints
@@ -200,3 +201,93 @@ func (h *AdminDiscoveryHandler) DiscoveryCheckHandler(ctx context.Context, req *
 
 	return &DiscoveryCheckResponse{Body: *result}, nil
 }
+
+// ListDiscoverySourcesRequest represents the HTTP request for listing discovery source health
+type ListDiscoverySourcesRequest struct{}
+
+// ListDiscoverySourcesResponse represents the HTTP response for listing discovery source health
+type ListDiscoverySourcesResponse struct {
+	Body contracts.DiscoverySourceHealthList `json:"body"`
+}
+
+// ListDiscoverySourcesHandler handles GET /admin/discovery/sources
+func (h *AdminDiscoveryHandler) ListDiscoverySourcesHandler(ctx context.Context, req *ListDiscoverySourcesRequest) (*ListDiscoverySourcesResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	result, err := h.discoveryService.ListSourceHealth()
+	if err != nil {
+		logger.FromContext(ctx).Error("admin_discovery_sources_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to list discovery sources (request_id: %s)", requestID),
+		)
+	}
+
+	return &ListDiscoverySourcesResponse{Body: *result}, nil
+}
+
+// maxDiscoveryPushEvents caps one scraper push. A full scraper run covers
+// every configured venue, so this is looser than the admin UI import.
+const maxDiscoveryPushEvents = 1000
+
+// DiscoveryPushRequest represents the HTTP request for a scraper push. The
+// body is the same JSON the discovery-import CLI reads from disk: an array
+// of events, or an object mapping venue slugs to event arrays.
+type DiscoveryPushRequest struct {
+	DryRun  bool   `query:"dry_run" doc:"If true, report what would be imported without persisting"`
+	RawBody []byte `contentType:"application/json"`
+}
+
+// DiscoveryPushHandler handles POST /discovery/import. It mirrors the
+// discovery-import CLI (new shows are approved, existing shows are left
+// alone) so scrapers can push from any host with an admin API token.
+func (h *AdminDiscoveryHandler) DiscoveryPushHandler(ctx context.Context, req *DiscoveryPushRequest) (*DiscoveryImportResponse, error) {
+	requestID := logger.GetRequestID(ctx)
+
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+
+	events, err := pipelinesvc.ParseDiscoveredEvents(req.RawBody)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Body must be an array of events or an object of venue event arrays")
+	}
+
+	if len(events) == 0 {
+		return nil, huma.Error400BadRequest("At least one event is required")
+	}
+
+	if len(events) > maxDiscoveryPushEvents {
+		return nil, huma.Error400BadRequest(
+			fmt.Sprintf("Maximum %d events can be imported at once", maxDiscoveryPushEvents),
+		)
+	}
+
+	result, err := h.discoveryService.ImportEvents(events, req.DryRun, false, catalogm.ShowStatusApproved)
+	if err != nil {
+		logger.FromContext(ctx).Error("discovery_push_failed",
+			"error", err.Error(),
+			"request_id", requestID,
+		)
+		return nil, huma.Error500InternalServerError(
+			fmt.Sprintf("Failed to import events (request_id: %s)", requestID),
+		)
+	}
+
+	logger.FromContext(ctx).Info("discovery_push_success",
+		"dry_run", req.DryRun,
+		"total", result.Total,
+		"imported", result.Imported,
+		"duplicates", result.Duplicates,
+		"rejected", result.Rejected,
+		"pending_review", result.PendingReview,
+		"errors", result.Errors,
+		"admin_id", user.ID,
+		"request_id", requestID,
+	)
+
+	return &DiscoveryImportResponse{Body: *result}, nil
+}
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
@@ -129,3 +130,105 @@ func TestDiscoveryCheckHandler_ServiceError(t *testing.T) {
 	_, err := h.DiscoveryCheckHandler(adminCtx(), req)
 	testhelpers.AssertHumaError(t, err, 500)
 }
+
+func TestDiscoveryPushHandler_AcceptsCLIPayloadShapes(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"array", `[{"id":"ev1","venueSlug":"valley-bar"},{"id":"ev2","venueSlug":"valley-bar"}]`},
+		{"venue map", `{"valley-bar":[{"id":"ev1","venueSlug":"valley-bar"}],"crescent-ballroom":[{"id":"ev2","venueSlug":"crescent-ballroom"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotDryRun, gotAllowUpdates bool
+			var gotStatus catalogm.ShowStatus
+			h := adminDiscoveryHandler(func(ah *AdminDiscoveryHandler) {
+				ah.discoveryService = &testhelpers.MockDiscoveryService{
+					ImportEventsFn: func(events []contracts.DiscoveredEvent, dryRun, allowUpdates bool, initialStatus catalogm.ShowStatus) (*contracts.ImportResult, error) {
+						gotDryRun, gotAllowUpdates, gotStatus = dryRun, allowUpdates, initialStatus
+						return &contracts.ImportResult{Total: len(events)}, nil
+					},
+				}
+			})
+
+			resp, err := h.DiscoveryPushHandler(adminCtx(), &DiscoveryPushRequest{DryRun: true, RawBody: []byte(tt.body)})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Body.Total != 2 {
+				t.Errorf("expected total=2, got %d", resp.Body.Total)
+			}
+			if !gotDryRun || gotAllowUpdates || gotStatus != catalogm.ShowStatusApproved {
+				t.Errorf("import called with dryRun=%v allowUpdates=%v status=%q", gotDryRun, gotAllowUpdates, gotStatus)
+			}
+		})
+	}
+}
+
+func TestDiscoveryPushHandler_BadPayload(t *testing.T) {
+	h := testAdminDiscoveryHandler()
+	for _, body := range []string{``, `"nope"`, `[]`} {
+		_, err := h.DiscoveryPushHandler(adminCtx(), &DiscoveryPushRequest{RawBody: []byte(body)})
+		testhelpers.AssertHumaError(t, err, 400)
+	}
+}
+
+func TestDiscoveryPushHandler_TooMany(t *testing.T) {
+	h := testAdminDiscoveryHandler()
+	body := "[" + strings.TrimSuffix(strings.Repeat(`{"id":"ev"},`, maxDiscoveryPushEvents+1), ",") + "]"
+
+	_, err := h.DiscoveryPushHandler(adminCtx(), &DiscoveryPushRequest{RawBody: []byte(body)})
+	testhelpers.AssertHumaError(t, err, 400)
+}
+
+func TestDiscoveryPushHandler_ServiceError(t *testing.T) {
+	h := adminDiscoveryHandler(func(ah *AdminDiscoveryHandler) {
+		ah.discoveryService = &testhelpers.MockDiscoveryService{
+			ImportEventsFn: func(_ []contracts.DiscoveredEvent, _, _ bool, _ catalogm.ShowStatus) (*contracts.ImportResult, error) {
+				return nil, fmt.Errorf("import failed")
+			},
+		}
+	})
+
+	_, err := h.DiscoveryPushHandler(adminCtx(), &DiscoveryPushRequest{RawBody: []byte(`[{"id":"ev1"}]`)})
+	testhelpers.AssertHumaError(t, err, 500)
+}
+
+func TestListDiscoverySourcesHandler_Success(t *testing.T) {
+	h := adminDiscoveryHandler(func(ah *AdminDiscoveryHandler) {
+		ah.discoveryService = &testhelpers.MockDiscoveryService{
+			ListSourceHealthFn: func() (*contracts.DiscoverySourceHealthList, error) {
+				return &contracts.DiscoverySourceHealthList{
+					Sources:        []contracts.DiscoverySourceHealth{{SourceKey: "valley-bar", Status: "stale"}},
+					StaleAfterDays: 7,
+				}, nil
+			},
+		}
+	})
+
+	resp, err := h.ListDiscoverySourcesHandler(adminCtx(), &ListDiscoverySourcesRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Body.Sources) != 1 || resp.Body.Sources[0].SourceKey != "valley-bar" {
+		t.Errorf("unexpected sources: %+v", resp.Body.Sources)
+	}
+	if resp.Body.StaleAfterDays != 7 {
+		t.Errorf("expected stale_after_days=7, got %d", resp.Body.StaleAfterDays)
+	}
+}
+
+func TestListDiscoverySourcesHandler_ServiceError(t *testing.T) {
+	h := adminDiscoveryHandler(func(ah *AdminDiscoveryHandler) {
+		ah.discoveryService = &testhelpers.MockDiscoveryService{
+			ListSourceHealthFn: func() (*contracts.DiscoverySourceHealthList, error) {
+				return nil, fmt.Errorf("db error")
+			},
+		}
+	})
+
+	_, err := h.ListDiscoverySourcesHandler(adminCtx(), &ListDiscoverySourcesRequest{})
+	testhelpers.AssertHumaError(t, err, 500)
+}
@@ -3,6 +3,7 @@ package shared
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/danielgtaylor/huma/v2"
 
@@ -174,14 +175,15 @@ func MapArtistError(err error) error {
 // Returns nil if err is not a *apperrors.VenueError.
 //
 // Not-found → 404; HasShows → 422 (the "cannot delete, associated with shows"
-// status — intentionally distinct from artist HasShows, which is 409).
+// status — intentionally distinct from artist HasShows, which is 409);
+// merge-into-self → 422.
 func MapVenueError(err error) error {
 	var venueErr *apperrors.VenueError
 	if errors.As(err, &venueErr) {
 		switch venueErr.Code {
 		case apperrors.CodeVenueNotFound:
 			return huma.Error404NotFound(venueErr.Message)
-		case apperrors.CodeVenueHasShows:
+		case apperrors.CodeVenueHasShows, apperrors.CodeVenueMergeSelf:
 			return huma.Error422UnprocessableEntity(venueErr.Message)
 		}
 	}
@@ -252,7 +254,8 @@ func MapFestivalError(err error) error {
 // as SHOW_CREATE_FAILED → 422 there too); not-found maps to 404. The other
 // show codes (update/delete/unauthorized/invalid-id) are intentionally
 // unmapped — this mapper serves the entity_request fulfillment path, which
-// only creates.
+// only creates, and admin show restore, where restoring a show that is not
+// deleted is a validation failure.
 func MapShowError(err error) error {
 	var showErr *apperrors.ShowError
 	if errors.As(err, &showErr) {
@@ -583,3 +586,390 @@ func MapPendingEditError(err error) error {
 	}
 	return nil
 }
+
+// MapVisibilityError converts a VisibilityError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.VisibilityError.
+//
+// Show/user-not-found → 404; infra fault → 500.
+func MapVisibilityError(err error) error {
+	var visErr *apperrors.VisibilityError
+	if errors.As(err, &visErr) {
+		switch visErr.Code {
+		case apperrors.CodeVisibilityShowNotFound, apperrors.CodeVisibilityUserNotFound:
+			return huma.Error404NotFound(visErr.Message)
+		case apperrors.CodeVisibilityInternal:
+			return huma.Error500InternalServerError(visErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapShowSeriesError converts a ShowSeriesError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.ShowSeriesError.
+//
+// Series/occurrence-not-found → 404; invalid template → 422; infra fault → 500.
+func MapShowSeriesError(err error) error {
+	var seriesErr *apperrors.ShowSeriesError
+	if errors.As(err, &seriesErr) {
+		switch seriesErr.Code {
+		case apperrors.CodeShowSeriesNotFound, apperrors.CodeShowSeriesOccurrenceNotFound:
+			return huma.Error404NotFound(seriesErr.Message)
+		case apperrors.CodeShowSeriesInvalid:
+			return huma.Error422UnprocessableEntity(seriesErr.Message)
+		case apperrors.CodeShowSeriesInternal:
+			return huma.Error500InternalServerError(seriesErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapVenueClaimError converts a VenueClaimError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.VenueClaimError.
+//
+// Claim/manager not found → 404; invalid request → 422; duplicate claim or
+// already-reviewed claim → 409; infra fault → 500.
+func MapVenueClaimError(err error) error {
+	var claimErr *apperrors.VenueClaimError
+	if errors.As(err, &claimErr) {
+		switch claimErr.Code {
+		case apperrors.CodeVenueClaimNotFound, apperrors.CodeVenueManagerNotFound:
+			return huma.Error404NotFound(claimErr.Message)
+		case apperrors.CodeVenueClaimInvalid:
+			return huma.Error422UnprocessableEntity(claimErr.Message)
+		case apperrors.CodeVenueClaimConflict, apperrors.CodeVenueClaimNotPending:
+			return huma.Error409Conflict(claimErr.Message)
+		case apperrors.CodeVenueClaimInternal:
+			return huma.Error500InternalServerError(claimErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapArtistClaimError converts an ArtistClaimError to an appropriate Huma
+// HTTP error. Returns nil if err is not a *apperrors.ArtistClaimError.
+//
+// Same status mapping as MapVenueClaimError.
+func MapArtistClaimError(err error) error {
+	var claimErr *apperrors.ArtistClaimError
+	if errors.As(err, &claimErr) {
+		switch claimErr.Code {
+		case apperrors.CodeArtistClaimNotFound, apperrors.CodeArtistManagerNotFound:
+			return huma.Error404NotFound(claimErr.Message)
+		case apperrors.CodeArtistClaimInvalid:
+			return huma.Error422UnprocessableEntity(claimErr.Message)
+		case apperrors.CodeArtistClaimConflict, apperrors.CodeArtistClaimNotPending:
+			return huma.Error409Conflict(claimErr.Message)
+		case apperrors.CodeArtistClaimInternal:
+			return huma.Error500InternalServerError(claimErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapShowUpdateError converts a ShowUpdateError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.ShowUpdateError.
+//
+// Update not found → 404; invalid body → 422; infra fault → 500.
+func MapShowUpdateError(err error) error {
+	var updateErr *apperrors.ShowUpdateError
+	if errors.As(err, &updateErr) {
+		switch updateErr.Code {
+		case apperrors.CodeShowUpdateNotFound:
+			return huma.Error404NotFound(updateErr.Message)
+		case apperrors.CodeShowUpdateInvalid:
+			return huma.Error422UnprocessableEntity(updateErr.Message)
+		case apperrors.CodeShowUpdateInternal:
+			return huma.Error500InternalServerError(updateErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapSyncError converts a SyncError to an appropriate Huma HTTP error.
+// Returns nil if err is not a *apperrors.SyncError.
+//
+// Undecodable cursor → 400; infra fault → 500.
+func MapSyncError(err error) error {
+	var syncErr *apperrors.SyncError
+	if errors.As(err, &syncErr) {
+		switch syncErr.Code {
+		case apperrors.CodeSyncInvalidCursor:
+			return huma.Error400BadRequest(syncErr.Message)
+		case apperrors.CodeSyncInternal:
+			return huma.Error500InternalServerError(syncErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapCalendarImportError converts a CalendarImportError to an appropriate
+// Huma HTTP error. Returns nil if err is not a *apperrors.CalendarImportError.
+//
+// Unparseable file → 422; oversized file → 413; too many show IDs → 400.
+func MapCalendarImportError(err error) error {
+	var importErr *apperrors.CalendarImportError
+	if errors.As(err, &importErr) {
+		switch importErr.Code {
+		case apperrors.CodeCalendarImportInvalid:
+			return huma.Error422UnprocessableEntity(importErr.Message)
+		case apperrors.CodeCalendarImportTooLarge:
+			return huma.NewError(http.StatusRequestEntityTooLarge, importErr.Message)
+		case apperrors.CodeCalendarImportTooManyShows:
+			return huma.Error400BadRequest(importErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapAPIKeyError converts an APIKeyError to an appropriate Huma HTTP error.
+// Returns nil if err is not a *apperrors.APIKeyError.
+//
+// Unknown key → 404; unverified email → 403; invalid request → 422;
+// too many keys → 409; unusable presented key → 401.
+func MapAPIKeyError(err error) error {
+	var keyErr *apperrors.APIKeyError
+	if errors.As(err, &keyErr) {
+		switch keyErr.Code {
+		case apperrors.CodeAPIKeyNotFound:
+			return huma.Error404NotFound(keyErr.Message)
+		case apperrors.CodeAPIKeyEmailNotVerified:
+			return huma.Error403Forbidden(keyErr.Message)
+		case apperrors.CodeAPIKeyInvalid:
+			return huma.Error422UnprocessableEntity(keyErr.Message)
+		case apperrors.CodeAPIKeyLimitReached:
+			return huma.Error409Conflict(keyErr.Message)
+		case apperrors.CodeAPIKeyUnauthorized:
+			return huma.Error401Unauthorized(keyErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapEntityAliasError converts an EntityAliasError to an appropriate Huma
+// HTTP error. Returns nil if err is not a *apperrors.EntityAliasError.
+//
+// Unknown alias or target entity → 404; duplicate alias → 409;
+// invalid request → 422.
+func MapEntityAliasError(err error) error {
+	var aliasErr *apperrors.EntityAliasError
+	if errors.As(err, &aliasErr) {
+		switch aliasErr.Code {
+		case apperrors.CodeEntityAliasNotFound, apperrors.CodeEntityAliasTargetNotFound:
+			return huma.Error404NotFound(aliasErr.Message)
+		case apperrors.CodeEntityAliasExists:
+			return huma.Error409Conflict(aliasErr.Message)
+		case apperrors.CodeEntityAliasInvalid:
+			return huma.Error422UnprocessableEntity(aliasErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapFeatureFlagError converts a FeatureFlagError to an appropriate Huma
+// HTTP error. Returns nil if err is not a *apperrors.FeatureFlagError.
+//
+// Unknown key → 404; duplicate key → 409; invalid request → 422.
+func MapFeatureFlagError(err error) error {
+	var flagErr *apperrors.FeatureFlagError
+	if errors.As(err, &flagErr) {
+		switch flagErr.Code {
+		case apperrors.CodeFeatureFlagNotFound:
+			return huma.Error404NotFound(flagErr.Message)
+		case apperrors.CodeFeatureFlagExists:
+			return huma.Error409Conflict(flagErr.Message)
+		case apperrors.CodeFeatureFlagInvalid:
+			return huma.Error422UnprocessableEntity(flagErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapAdminAnnotationError converts an AdminAnnotationError to an
+// appropriate Huma HTTP error. Returns nil if err is not a
+// *apperrors.AdminAnnotationError.
+//
+// Unknown note/tag or entity → 404; invalid request → 422.
+func MapAdminAnnotationError(err error) error {
+	var annotationErr *apperrors.AdminAnnotationError
+	if errors.As(err, &annotationErr) {
+		switch annotationErr.Code {
+		case apperrors.CodeAdminAnnotationNotFound, apperrors.CodeAdminAnnotationEntityNotFound:
+			return huma.Error404NotFound(annotationErr.Message)
+		case apperrors.CodeAdminAnnotationInvalid:
+			return huma.Error422UnprocessableEntity(annotationErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapAnnouncementError converts an AnnouncementError to an appropriate Huma
+// HTTP error. Returns nil if err is not a *apperrors.AnnouncementError.
+//
+// Unknown announcement → 404; not dismissible → 409; invalid request → 422.
+func MapAnnouncementError(err error) error {
+	var announcementErr *apperrors.AnnouncementError
+	if errors.As(err, &announcementErr) {
+		switch announcementErr.Code {
+		case apperrors.CodeAnnouncementNotFound:
+			return huma.Error404NotFound(announcementErr.Message)
+		case apperrors.CodeAnnouncementNotDismissible:
+			return huma.Error409Conflict(announcementErr.Message)
+		case apperrors.CodeAnnouncementInvalid:
+			return huma.Error422UnprocessableEntity(announcementErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapMediaError converts a MediaError to an appropriate Huma HTTP error.
+// Returns nil if err is not a *apperrors.MediaError.
+//
+// Storage disabled → 503; oversized upload → 413; unsupported format → 415;
+// undecodable image → 422; nothing to remove → 404.
+func MapMediaError(err error) error {
+	var mediaErr *apperrors.MediaError
+	if errors.As(err, &mediaErr) {
+		switch mediaErr.Code {
+		case apperrors.CodeMediaNotConfigured:
+			return huma.Error503ServiceUnavailable(mediaErr.Message)
+		case apperrors.CodeMediaTooLarge:
+			return huma.NewError(http.StatusRequestEntityTooLarge, mediaErr.Message)
+		case apperrors.CodeMediaUnsupportedType:
+			return huma.NewError(http.StatusUnsupportedMediaType, mediaErr.Message)
+		case apperrors.CodeMediaInvalidImage:
+			return huma.Error422UnprocessableEntity(mediaErr.Message)
+		case apperrors.CodeMediaNotFound:
+			return huma.Error404NotFound(mediaErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapDataExportError converts a DataExportError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.DataExportError.
+//
+// Every code is a problem with the request's query parameters → 422.
+func MapDataExportError(err error) error {
+	var exportErr *apperrors.DataExportError
+	if errors.As(err, &exportErr) {
+		switch exportErr.Code {
+		case apperrors.CodeDataExportUnknownEntity,
+			apperrors.CodeDataExportInvalidColumn,
+			apperrors.CodeDataExportInvalidRange:
+			return huma.Error422UnprocessableEntity(exportErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapDataImportError converts a DataImportError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.DataImportError.
+func MapDataImportError(err error) error {
+	var importErr *apperrors.DataImportError
+	if errors.As(err, &importErr) {
+		switch importErr.Code {
+		case apperrors.CodeDataImportTokenNotFound:
+			return huma.Error404NotFound(importErr.Message)
+		case apperrors.CodeDataImportTokenExpired:
+			return huma.Error410Gone(importErr.Message)
+		case apperrors.CodeDataImportTokenUsed:
+			return huma.Error409Conflict(importErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapShowDraftError converts a ShowDraftError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.ShowDraftError.
+//
+// Unknown draft → 404; invalid payload → 422; too many drafts → 409.
+func MapShowDraftError(err error) error {
+	var draftErr *apperrors.ShowDraftError
+	if errors.As(err, &draftErr) {
+		switch draftErr.Code {
+		case apperrors.CodeShowDraftNotFound:
+			return huma.Error404NotFound(draftErr.Message)
+		case apperrors.CodeShowDraftInvalid:
+			return huma.Error422UnprocessableEntity(draftErr.Message)
+		case apperrors.CodeShowDraftLimitReached:
+			return huma.Error409Conflict(draftErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapNotificationPreferenceError converts a NotificationPreferenceError to an
+// appropriate Huma HTTP error. Returns nil if err is not a
+// *apperrors.NotificationPreferenceError.
+func MapNotificationPreferenceError(err error) error {
+	var prefErr *apperrors.NotificationPreferenceError
+	if errors.As(err, &prefErr) {
+		switch prefErr.Code {
+		case apperrors.CodeNotificationPreferenceInvalid:
+			return huma.Error422UnprocessableEntity(prefErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapPushError converts a PushError to an appropriate Huma HTTP error.
+// Returns nil if err is not a *apperrors.PushError.
+//
+// Push disabled → 503; bad subscription → 422; unknown endpoint → 404.
+func MapPushError(err error) error {
+	var pushErr *apperrors.PushError
+	if errors.As(err, &pushErr) {
+		switch pushErr.Code {
+		case apperrors.CodePushNotConfigured:
+			return huma.Error503ServiceUnavailable(pushErr.Message)
+		case apperrors.CodePushSubscriptionInvalid:
+			return huma.Error422UnprocessableEntity(pushErr.Message)
+		case apperrors.CodePushSubscriptionNotFound:
+			return huma.Error404NotFound(pushErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapRoleError converts a RoleError to an appropriate Huma HTTP error.
+// Returns nil if err is not a *apperrors.RoleError.
+//
+// Unknown role → 422; missing user → 404; own role → 403; last superadmin → 409.
+func MapRoleError(err error) error {
+	var roleErr *apperrors.RoleError
+	if errors.As(err, &roleErr) {
+		switch roleErr.Code {
+		case apperrors.CodeRoleInvalid:
+			return huma.Error422UnprocessableEntity(roleErr.Message)
+		case apperrors.CodeRoleUserNotFound:
+			return huma.Error404NotFound(roleErr.Message)
+		case apperrors.CodeRoleSelfChange:
+			return huma.Error403Forbidden(roleErr.Message)
+		case apperrors.CodeRoleLastSuperadmin:
+			return huma.Error409Conflict(roleErr.Message)
+		}
+	}
+	return nil
+}
+
+// MapSubmissionError converts a SubmissionError to an appropriate Huma HTTP
+// error. Returns nil if err is not a *apperrors.SubmissionError.
+//
+// Daily cap → 429 with Retry-After; missing user → 404; staff → 403.
+func MapSubmissionError(err error) error {
+	var subErr *apperrors.SubmissionError
+	if errors.As(err, &subErr) {
+		switch subErr.Code {
+		case apperrors.CodeSubmissionDailyCap:
+			return huma.ErrorWithHeaders(
+				huma.Error429TooManyRequests(subErr.Message),
+				http.Header{"Retry-After": []string{strconv.Itoa(subErr.RetryAfterSeconds)}},
+			)
+		case apperrors.CodeSubmissionUserNotFound:
+			return huma.Error404NotFound(subErr.Message)
+		case apperrors.CodeSubmissionStaffQuarantine:
+			return huma.Error403Forbidden(subErr.Message)
+		}
+	}
+	return nil
+}
@@ -253,6 +253,7 @@ func TestMapVenueError_CodeToStatus(t *testing.T) {
 	}{
 		{"not found", apperrors.ErrVenueNotFound(7), 404},
 		{"has shows", apperrors.ErrVenueHasShows(7, 3), 422},
+		{"merge self", apperrors.ErrVenueMergeSelf(), 422},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -730,3 +731,590 @@ func TestMapCommentVoteError_NonCommentVoteErrorReturnsNil(t *testing.T) {
 		t.Errorf("MapCommentVoteError(unknown code) = %v, want nil", got)
 	}
 }
+
+func TestMapVisibilityError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.VisibilityError
+		status int
+	}{
+		{"show not found", apperrors.ErrVisibilityShowNotFound(1), 404},
+		{"user not found", apperrors.ErrVisibilityUserNotFound(2), 404},
+		{"internal", apperrors.ErrVisibilityInternal(stderrors.New("db down")), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapVisibilityError(tc.err)
+			if got == nil {
+				t.Fatalf("MapVisibilityError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapVisibilityError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapVisibilityError_NonVisibilityErrorReturnsNil(t *testing.T) {
+	if got := MapVisibilityError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapVisibilityError(plain error) = %v, want nil", got)
+	}
+	unknown := &apperrors.VisibilityError{Code: "VISIBILITY_NEW_CODE", Message: "x"}
+	if got := MapVisibilityError(unknown); got != nil {
+		t.Errorf("MapVisibilityError(unknown code) = %v, want nil", got)
+	}
+}
+
+func TestMapShowSeriesError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.ShowSeriesError
+		status int
+	}{
+		{"series not found", apperrors.ErrShowSeriesNotFound(1), 404},
+		{"occurrence not found", apperrors.ErrShowSeriesOccurrenceNotFound(1, 2), 404},
+		{"invalid", apperrors.ErrShowSeriesInvalid("bad template"), 422},
+		{"internal", apperrors.ErrShowSeriesInternal(stderrors.New("db down")), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapShowSeriesError(tc.err)
+			if got == nil {
+				t.Fatalf("MapShowSeriesError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapShowSeriesError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapShowSeriesError_NonSeriesErrorReturnsNil(t *testing.T) {
+	if got := MapShowSeriesError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapShowSeriesError(plain error) = %v, want nil", got)
+	}
+	unknown := &apperrors.ShowSeriesError{Code: "SHOW_SERIES_NEW_CODE", Message: "x"}
+	if got := MapShowSeriesError(unknown); got != nil {
+		t.Errorf("MapShowSeriesError(unknown code) = %v, want nil", got)
+	}
+}
+
+func TestMapVenueClaimError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.VenueClaimError
+		status int
+	}{
+		{"claim not found", apperrors.ErrVenueClaimNotFound(1), 404},
+		{"manager not found", apperrors.ErrVenueManagerNotFound(1, 2), 404},
+		{"invalid", apperrors.ErrVenueClaimInvalid("evidence is required"), 422},
+		{"conflict", apperrors.ErrVenueClaimConflict("already pending"), 409},
+		{"not pending", apperrors.ErrVenueClaimNotPending("approved"), 409},
+		{"internal", apperrors.ErrVenueClaimInternal(stderrors.New("db down")), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapVenueClaimError(tc.err)
+			if got == nil {
+				t.Fatalf("MapVenueClaimError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapVenueClaimError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapVenueClaimError_NonClaimErrorReturnsNil(t *testing.T) {
+	if got := MapVenueClaimError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapVenueClaimError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapArtistClaimError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.ArtistClaimError
+		status int
+	}{
+		{"claim not found", apperrors.ErrArtistClaimNotFound(1), 404},
+		{"manager not found", apperrors.ErrArtistManagerNotFound(1, 2), 404},
+		{"invalid", apperrors.ErrArtistClaimInvalid("evidence is required"), 422},
+		{"conflict", apperrors.ErrArtistClaimConflict("already pending"), 409},
+		{"not pending", apperrors.ErrArtistClaimNotPending("denied"), 409},
+		{"internal", apperrors.ErrArtistClaimInternal(stderrors.New("db down")), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapArtistClaimError(tc.err)
+			if got == nil {
+				t.Fatalf("MapArtistClaimError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapArtistClaimError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapShowUpdateError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.ShowUpdateError
+		status int
+	}{
+		{"not found", apperrors.ErrShowUpdateNotFound(1), 404},
+		{"invalid", apperrors.ErrShowUpdateInvalid("body is required"), 422},
+		{"internal", apperrors.ErrShowUpdateInternal(stderrors.New("db down")), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapShowUpdateError(tc.err)
+			if got == nil {
+				t.Fatalf("MapShowUpdateError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapShowUpdateError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapSyncError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.SyncError
+		status int
+	}{
+		{"invalid cursor", apperrors.ErrSyncInvalidCursor(stderrors.New("bad base64")), 400},
+		{"internal", apperrors.ErrSyncInternal(stderrors.New("db down")), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapSyncError(tc.err)
+			if got == nil {
+				t.Fatalf("MapSyncError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapSyncError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapSyncError_NonSyncErrorReturnsNil(t *testing.T) {
+	if got := MapSyncError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapSyncError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapCalendarImportError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.CalendarImportError
+		status int
+	}{
+		{"invalid", apperrors.ErrCalendarImportInvalid(stderrors.New("no VCALENDAR")), 422},
+		{"too large", apperrors.ErrCalendarImportTooLarge(512 * 1024), 413},
+		{"too many shows", apperrors.ErrCalendarImportTooManyShows(500), 400},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapCalendarImportError(tc.err)
+			if got == nil {
+				t.Fatalf("MapCalendarImportError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapCalendarImportError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapCalendarImportError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapCalendarImportError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapCalendarImportError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapAPIKeyError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.APIKeyError
+		status int
+	}{
+		{"not found", apperrors.ErrAPIKeyNotFound(7), 404},
+		{"email not verified", apperrors.ErrAPIKeyEmailNotVerified(), 403},
+		{"invalid", apperrors.ErrAPIKeyInvalid("unknown scope"), 422},
+		{"limit reached", apperrors.ErrAPIKeyLimitReached(10), 409},
+		{"unauthorized", apperrors.ErrAPIKeyUnauthorized("invalid API key"), 401},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapAPIKeyError(tc.err)
+			if got == nil {
+				t.Fatalf("MapAPIKeyError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapAPIKeyError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapAPIKeyError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapAPIKeyError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapAPIKeyError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapEntityAliasError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.EntityAliasError
+		status int
+	}{
+		{"not found", apperrors.ErrEntityAliasNotFound(3), 404},
+		{"target not found", apperrors.ErrEntityAliasTargetNotFound("venue", 9), 404},
+		{"exists", apperrors.ErrEntityAliasExists("venue", "The Crescent"), 409},
+		{"invalid", apperrors.ErrEntityAliasInvalid("alias is required"), 422},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapEntityAliasError(tc.err)
+			if got == nil {
+				t.Fatalf("MapEntityAliasError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapEntityAliasError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapEntityAliasError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapEntityAliasError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapEntityAliasError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapFeatureFlagError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.FeatureFlagError
+		status int
+	}{
+		{"not found", apperrors.ErrFeatureFlagNotFound("graphql_endpoint"), 404},
+		{"exists", apperrors.ErrFeatureFlagExists("graphql_endpoint"), 409},
+		{"invalid", apperrors.ErrFeatureFlagInvalid("key is required"), 422},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapFeatureFlagError(tc.err)
+			if got == nil {
+				t.Fatalf("MapFeatureFlagError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapFeatureFlagError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapFeatureFlagError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapFeatureFlagError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapFeatureFlagError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapAdminAnnotationError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.AdminAnnotationError
+		status int
+	}{
+		{"not found", apperrors.ErrAdminAnnotationNotFound("note 3"), 404},
+		{"entity not found", apperrors.ErrAdminAnnotationEntityNotFound("show", 9), 404},
+		{"invalid", apperrors.ErrAdminAnnotationInvalid("note is required"), 422},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapAdminAnnotationError(tc.err)
+			if got == nil {
+				t.Fatalf("MapAdminAnnotationError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapAdminAnnotationError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapAdminAnnotationError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapAdminAnnotationError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapAdminAnnotationError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapAnnouncementError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.AnnouncementError
+		status int
+	}{
+		{"not found", apperrors.ErrAnnouncementNotFound(3), 404},
+		{"not dismissible", apperrors.ErrAnnouncementNotDismissible(3), 409},
+		{"invalid", apperrors.ErrAnnouncementInvalid("message is required"), 422},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapAnnouncementError(tc.err)
+			if got == nil {
+				t.Fatalf("MapAnnouncementError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapAnnouncementError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapAnnouncementError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapAnnouncementError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapAnnouncementError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapMediaError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.MediaError
+		status int
+	}{
+		{"not configured", apperrors.ErrMediaNotConfigured(), 503},
+		{"too large", apperrors.ErrMediaTooLarge(10 << 20), 413},
+		{"unsupported type", apperrors.ErrMediaUnsupportedType("image/webp"), 415},
+		{"invalid image", apperrors.ErrMediaInvalidImage(stderrors.New("bad header")), 422},
+		{"not found", apperrors.ErrMediaNotFound(4), 404},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapMediaError(tc.err)
+			if got == nil {
+				t.Fatalf("MapMediaError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapMediaError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapMediaError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapMediaError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapMediaError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapDataExportError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.DataExportError
+		status int
+	}{
+		{"unknown entity", apperrors.ErrDataExportUnknownEntity("artists"), 422},
+		{"invalid column", apperrors.ErrDataExportInvalidColumn("password_hash", []string{"id", "email"}), 422},
+		{"invalid range", apperrors.ErrDataExportInvalidRange("to_date is before from_date"), 422},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapDataExportError(tc.err)
+			if got == nil {
+				t.Fatalf("MapDataExportError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapDataExportError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapDataExportError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapDataExportError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapDataExportError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapDataImportError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.DataImportError
+		status int
+	}{
+		{"token not found", apperrors.ErrDataImportTokenNotFound(), 404},
+		{"token expired", apperrors.ErrDataImportTokenExpired(), 410},
+		{"token used", apperrors.ErrDataImportTokenUsed(), 409},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapDataImportError(tc.err)
+			if got == nil {
+				t.Fatalf("MapDataImportError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapDataImportError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapDataImportError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapDataImportError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapDataImportError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapShowDraftError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.ShowDraftError
+		status int
+	}{
+		{"not found", apperrors.ErrShowDraftNotFound(1), 404},
+		{"invalid", apperrors.ErrShowDraftInvalid("payload must be a JSON object"), 422},
+		{"limit reached", apperrors.ErrShowDraftLimitReached(20), 409},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapShowDraftError(tc.err)
+			if got == nil {
+				t.Fatalf("MapShowDraftError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapShowDraftError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapShowDraftError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapShowDraftError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapShowDraftError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapNotificationPreferenceError_CodeToStatus(t *testing.T) {
+	err := apperrors.ErrNotificationPreferenceInvalid(`unknown channel "sms"`)
+	got := MapNotificationPreferenceError(err)
+	if got == nil {
+		t.Fatalf("MapNotificationPreferenceError(%v) = nil, want status 422", err)
+	}
+	if s := statusOf(t, got); s != 422 {
+		t.Errorf("MapNotificationPreferenceError(%v) status = %d, want 422", err, s)
+	}
+}
+
+func TestMapNotificationPreferenceError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapNotificationPreferenceError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapNotificationPreferenceError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapPushError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.PushError
+		status int
+	}{
+		{"not configured", apperrors.ErrPushNotConfigured(), 503},
+		{"invalid", apperrors.ErrPushSubscriptionInvalid("endpoint must be https"), 422},
+		{"not found", apperrors.ErrPushSubscriptionNotFound(), 404},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapPushError(tc.err)
+			if got == nil {
+				t.Fatalf("MapPushError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapPushError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapPushError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapPushError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapPushError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapRoleError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.RoleError
+		status int
+	}{
+		{"invalid", apperrors.ErrRoleInvalid("owner"), 422},
+		{"user not found", apperrors.ErrRoleUserNotFound(), 404},
+		{"self change", apperrors.ErrRoleSelfChange(), 403},
+		{"last superadmin", apperrors.ErrRoleLastSuperadmin(), 409},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapRoleError(tc.err)
+			if got == nil {
+				t.Fatalf("MapRoleError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapRoleError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapRoleError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapRoleError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapRoleError(plain error) = %v, want nil", got)
+	}
+}
+
+func TestMapSubmissionError_CodeToStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *apperrors.SubmissionError
+		status int
+	}{
+		{"daily cap", apperrors.ErrSubmissionDailyCap(20, 3600), 429},
+		{"user not found", apperrors.ErrSubmissionUserNotFound(), 404},
+		{"staff", apperrors.ErrSubmissionStaffQuarantine(), 403},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapSubmissionError(tc.err)
+			if got == nil {
+				t.Fatalf("MapSubmissionError(%v) = nil, want status %d", tc.err, tc.status)
+			}
+			if s := statusOf(t, got); s != tc.status {
+				t.Errorf("MapSubmissionError(%v) status = %d, want %d", tc.err, s, tc.status)
+			}
+		})
+	}
+}
+
+func TestMapSubmissionError_DailyCapRetryAfter(t *testing.T) {
+	got := MapSubmissionError(apperrors.ErrSubmissionDailyCap(20, 3600))
+	var withHeaders huma.HeadersError
+	if !stderrors.As(got, &withHeaders) {
+		t.Fatalf("expected a headers error, got %T", got)
+	}
+	if ra := withHeaders.GetHeaders().Get("Retry-After"); ra != "3600" {
+		t.Errorf("Retry-After = %q, want 3600", ra)
+	}
+}
+
+func TestMapSubmissionError_OtherErrorReturnsNil(t *testing.T) {
+	if got := MapSubmissionError(stderrors.New("boom")); got != nil {
+		t.Errorf("MapSubmissionError(plain error) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,95 @@
+package shared
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// MaxAccessibilityNotesLength matches the maxLength tag on the venue
+// create/update request bodies.
+const MaxAccessibilityNotesLength = 1000
+
+// doorTimePattern is local 24h "HH:MM", matching the venues.door_time CHECK.
+var doorTimePattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// NormalizeFieldChangeValue validates a pending-edit value for the typed
+// (non-string) venue operating-info columns and converts it to the type the
+// column expects. The edit drawer sends every value as a string or null, and
+// ApprovePendingEdit applies values without conversion, so a "250" capacity
+// or "true" all_ages must become an int or bool before it is stored.
+//
+// nil and "" clear the field and normalize to nil. Field names this helper
+// doesn't recognize pass through unchanged. Returns a 422 for a value that
+// can't be converted.
+func NormalizeFieldChangeValue(fieldName string, value any) (any, error) {
+	switch fieldName {
+	case "capacity", "all_ages", "door_time", "accessibility_notes":
+	default:
+		return value, nil
+	}
+	if value == nil {
+		return nil, nil
+	}
+	if s, ok := value.(string); ok {
+		value = strings.TrimSpace(s)
+		if value == "" {
+			return nil, nil
+		}
+	}
+
+	switch fieldName {
+	case "capacity":
+		var n float64
+		switch v := value.(type) {
+		case float64:
+			n = v
+		case string:
+			parsed, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, huma.Error422UnprocessableEntity("Capacity must be a whole number")
+			}
+			n = float64(parsed)
+		default:
+			return nil, huma.Error422UnprocessableEntity("Capacity must be a whole number")
+		}
+		if n < 0 || n != math.Trunc(n) || n > math.MaxInt32 {
+			return nil, huma.Error422UnprocessableEntity("Capacity must be a whole number of 0 or more")
+		}
+		return int(n), nil
+
+	case "all_ages":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b, nil
+			}
+		}
+		return nil, huma.Error422UnprocessableEntity("All ages must be true or false")
+
+	case "door_time":
+		s, ok := value.(string)
+		if !ok || !doorTimePattern.MatchString(s) {
+			return nil, huma.Error422UnprocessableEntity("Door time must be 24-hour HH:MM, e.g. 19:00")
+		}
+		return s, nil
+
+	default: // accessibility_notes
+		s, ok := value.(string)
+		if !ok {
+			return nil, huma.Error422UnprocessableEntity("Accessibility notes must be a string")
+		}
+		if len(s) > MaxAccessibilityNotesLength {
+			return nil, huma.Error422UnprocessableEntity(
+				fmt.Sprintf("Accessibility notes must be %d characters or fewer", MaxAccessibilityNotesLength),
+			)
+		}
+		return s, nil
+	}
+}
@@ -0,0 +1,59 @@
+package shared
+
+import (
+	"testing"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+)
+
+func TestNormalizeFieldChangeValue_Converts(t *testing.T) {
+	cases := []struct {
+		field string
+		in    any
+		want  any
+	}{
+		{"capacity", "250", 250},
+		{"capacity", float64(250), 250},
+		{"capacity", " 0 ", 0},
+		{"all_ages", "true", true},
+		{"all_ages", "false", false},
+		{"all_ages", true, true},
+		{"door_time", "19:30", "19:30"},
+		{"accessibility_notes", "Step-free entry", "Step-free entry"},
+		{"capacity", "", nil},
+		{"all_ages", nil, nil},
+		{"door_time", "  ", nil},
+		{"name", "", ""}, // unknown fields pass through untouched
+	}
+	for _, c := range cases {
+		got, err := NormalizeFieldChangeValue(c.field, c.in)
+		if err != nil {
+			t.Errorf("%s %#v: unexpected error: %v", c.field, c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%s %#v: got %#v, want %#v", c.field, c.in, got, c.want)
+		}
+	}
+}
+
+func TestNormalizeFieldChangeValue_Rejects(t *testing.T) {
+	cases := []struct {
+		field string
+		in    any
+	}{
+		{"capacity", "lots"},
+		{"capacity", "-5"},
+		{"capacity", float64(2.5)},
+		{"all_ages", "sometimes"},
+		{"all_ages", float64(1)},
+		{"door_time", "7pm"},
+		{"door_time", "24:00"},
+		{"accessibility_notes", float64(3)},
+		{"accessibility_notes", string(make([]byte, MaxAccessibilityNotesLength+1))},
+	}
+	for _, c := range cases {
+		_, err := NormalizeFieldChangeValue(c.field, c.in)
+		testhelpers.AssertHumaError(t, err, 422)
+	}
+}
@@ -8,7 +8,9 @@ package testhelpers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -29,7 +31,9 @@ import (
 // Suppress unused import warnings.
 var (
 	_ context.Context
+	_ json.RawMessage
 	_ fmt.Stringer
+	_ io.Reader
 	_ http.ResponseWriter
 	_ time.Duration
 	_ *protocol.CredentialCreation
@@ -44,6 +48,49 @@ var (
 	_ notificationm.NotificationFilter
 )
 
+// ============================================================================
+// Mock: APIKeyServiceInterface
+// ============================================================================
+
+type MockAPIKeyService struct {
+	CreateKeyFn       func(uint, string, []string) (*contracts.APIKeyCreateResponse, error)
+	ListKeysFn        func(uint) ([]contracts.APIKeyResponse, error)
+	GetKeyFn          func(uint, uint) (*contracts.APIKeyResponse, error)
+	RevokeKeyFn       func(uint, uint) error
+	AuthenticateKeyFn func(string) (*contracts.APIKeyAuthResult, error)
+}
+
+func (m *MockAPIKeyService) CreateKey(userID uint, name string, scopes []string) (*contracts.APIKeyCreateResponse, error) {
+	if m.CreateKeyFn != nil {
+		return m.CreateKeyFn(userID, name, scopes)
+	}
+	return nil, nil
+}
+func (m *MockAPIKeyService) ListKeys(userID uint) ([]contracts.APIKeyResponse, error) {
+	if m.ListKeysFn != nil {
+		return m.ListKeysFn(userID)
+	}
+	return nil, nil
+}
+func (m *MockAPIKeyService) GetKey(userID uint, keyID uint) (*contracts.APIKeyResponse, error) {
+	if m.GetKeyFn != nil {
+		return m.GetKeyFn(userID, keyID)
+	}
+	return nil, nil
+}
+func (m *MockAPIKeyService) RevokeKey(userID uint, keyID uint) error {
+	if m.RevokeKeyFn != nil {
+		return m.RevokeKeyFn(userID, keyID)
+	}
+	return nil
+}
+func (m *MockAPIKeyService) AuthenticateKey(plainKey string) (*contracts.APIKeyAuthResult, error) {
+	if m.AuthenticateKeyFn != nil {
+		return m.AuthenticateKeyFn(plainKey)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: APITokenServiceInterface
 // ============================================================================
@@ -94,13 +141,87 @@ func (m *MockAPITokenService) CleanupExpiredTokens() (int64, error) {
 	return 0, nil
 }
 
+// ============================================================================
+// Mock: AdminAnnotationServiceInterface
+// ============================================================================
+
+type MockAdminAnnotationService struct {
+	GetAnnotationsFn func(string, uint) (*contracts.AdminAnnotationsResponse, error)
+	AddNoteFn        func(string, uint, string, uint) (*contracts.AdminNoteResponse, error)
+	UpdateNoteFn     func(uint, string) (*contracts.AdminNoteResponse, error)
+	DeleteNoteFn     func(uint) error
+	AddTagFn         func(string, uint, string, string, uint) (*contracts.AdminTagResponse, error)
+	RemoveTagFn      func(string, uint, string) error
+	GetSummariesFn   func(string, []uint) (map[uint]*contracts.AdminAnnotationSummary, error)
+	ListTagsFn       func() ([]*contracts.AdminTagCount, error)
+	ListTaggedFn     func(string, string, int, int) ([]*contracts.TaggedEntityResponse, int64, error)
+}
+
+func (m *MockAdminAnnotationService) GetAnnotations(entityType string, entityID uint) (*contracts.AdminAnnotationsResponse, error) {
+	if m.GetAnnotationsFn != nil {
+		return m.GetAnnotationsFn(entityType, entityID)
+	}
+	return nil, nil
+}
+func (m *MockAdminAnnotationService) AddNote(entityType string, entityID uint, body string, userID uint) (*contracts.AdminNoteResponse, error) {
+	if m.AddNoteFn != nil {
+		return m.AddNoteFn(entityType, entityID, body, userID)
+	}
+	return nil, nil
+}
+func (m *MockAdminAnnotationService) UpdateNote(noteID uint, body string) (*contracts.AdminNoteResponse, error) {
+	if m.UpdateNoteFn != nil {
+		return m.UpdateNoteFn(noteID, body)
+	}
+	return nil, nil
+}
+func (m *MockAdminAnnotationService) DeleteNote(noteID uint) error {
+	if m.DeleteNoteFn != nil {
+		return m.DeleteNoteFn(noteID)
+	}
+	return nil
+}
+func (m *MockAdminAnnotationService) AddTag(entityType string, entityID uint, label string, color string, userID uint) (*contracts.AdminTagResponse, error) {
+	if m.AddTagFn != nil {
+		return m.AddTagFn(entityType, entityID, label, color, userID)
+	}
+	return nil, nil
+}
+func (m *MockAdminAnnotationService) RemoveTag(entityType string, entityID uint, label string) error {
+	if m.RemoveTagFn != nil {
+		return m.RemoveTagFn(entityType, entityID, label)
+	}
+	return nil
+}
+func (m *MockAdminAnnotationService) GetSummaries(entityType string, entityIDs []uint) (map[uint]*contracts.AdminAnnotationSummary, error) {
+	if m.GetSummariesFn != nil {
+		return m.GetSummariesFn(entityType, entityIDs)
+	}
+	return nil, nil
+}
+func (m *MockAdminAnnotationService) ListTags() ([]*contracts.AdminTagCount, error) {
+	if m.ListTagsFn != nil {
+		return m.ListTagsFn()
+	}
+	return nil, nil
+}
+func (m *MockAdminAnnotationService) ListTagged(label string, entityType string, limit int, offset int) ([]*contracts.TaggedEntityResponse, int64, error) {
+	if m.ListTaggedFn != nil {
+		return m.ListTaggedFn(label, entityType, limit, offset)
+	}
+	return nil, 0, nil
+}
+
 // ============================================================================
 // Mock: AdminStatsServiceInterface
 // ============================================================================
 
 type MockAdminStatsService struct {
-	GetDashboardStatsFn func() (*contracts.AdminDashboardStats, error)
-	GetRecentActivityFn func() (*contracts.ActivityFeedResponse, error)
+	GetDashboardStatsFn   func() (*contracts.AdminDashboardStats, error)
+	GetRecentActivityFn   func() (*contracts.ActivityFeedResponse, error)
+	GetReviewQueueStatsFn func(time.Time, time.Time) (*contracts.ReviewQueueStats, error)
+	GetStatsTimeSeriesFn  func(string, string, time.Time) (*contracts.StatsTimeSeries, error)
+	GetModerationQueueFn  func(string, int, int) (*contracts.ModerationQueue, error)
 }
 
 func (m *MockAdminStatsService) GetDashboardStats() (*contracts.AdminDashboardStats, error) {
@@ -115,6 +236,24 @@ func (m *MockAdminStatsService) GetRecentActivity() (*contracts.ActivityFeedResp
 	}
 	return &contracts.ActivityFeedResponse{Events: []contracts.ActivityEvent{}}, nil
 }
+func (m *MockAdminStatsService) GetReviewQueueStats(from time.Time, to time.Time) (*contracts.ReviewQueueStats, error) {
+	if m.GetReviewQueueStatsFn != nil {
+		return m.GetReviewQueueStatsFn(from, to)
+	}
+	return nil, nil
+}
+func (m *MockAdminStatsService) GetStatsTimeSeries(metric string, interval string, from time.Time) (*contracts.StatsTimeSeries, error) {
+	if m.GetStatsTimeSeriesFn != nil {
+		return m.GetStatsTimeSeriesFn(metric, interval, from)
+	}
+	return nil, nil
+}
+func (m *MockAdminStatsService) GetModerationQueue(itemType string, limit int, offset int) (*contracts.ModerationQueue, error) {
+	if m.GetModerationQueueFn != nil {
+		return m.GetModerationQueueFn(itemType, limit, offset)
+	}
+	return nil, nil
+}
 
 // ============================================================================
 // Mock: AnalyticsServiceInterface
@@ -175,6 +314,127 @@ func (m *MockAnalyticsService) GetDataQualityTrends(months int) (*contracts.Data
 	}, nil
 }
 
+// ============================================================================
+// Mock: AnnouncementServiceInterface
+// ============================================================================
+
+type MockAnnouncementService struct {
+	ListAnnouncementsFn      func() ([]*contracts.AnnouncementResponse, error)
+	CreateAnnouncementFn     func(*contracts.CreateAnnouncementRequest, uint) (*contracts.AnnouncementResponse, error)
+	UpdateAnnouncementFn     func(uint, *contracts.UpdateAnnouncementRequest, uint) (*contracts.AnnouncementResponse, error)
+	ExpireAnnouncementFn     func(uint, uint) (*contracts.AnnouncementResponse, error)
+	GetActiveAnnouncementsFn func(uint) ([]*contracts.ActiveAnnouncementResponse, error)
+	DismissAnnouncementFn    func(uint, uint) error
+}
+
+func (m *MockAnnouncementService) ListAnnouncements() ([]*contracts.AnnouncementResponse, error) {
+	if m.ListAnnouncementsFn != nil {
+		return m.ListAnnouncementsFn()
+	}
+	return nil, nil
+}
+func (m *MockAnnouncementService) CreateAnnouncement(req *contracts.CreateAnnouncementRequest, userID uint) (*contracts.AnnouncementResponse, error) {
+	if m.CreateAnnouncementFn != nil {
+		return m.CreateAnnouncementFn(req, userID)
+	}
+	return nil, nil
+}
+func (m *MockAnnouncementService) UpdateAnnouncement(id uint, req *contracts.UpdateAnnouncementRequest, userID uint) (*contracts.AnnouncementResponse, error) {
+	if m.UpdateAnnouncementFn != nil {
+		return m.UpdateAnnouncementFn(id, req, userID)
+	}
+	return nil, nil
+}
+func (m *MockAnnouncementService) ExpireAnnouncement(id uint, userID uint) (*contracts.AnnouncementResponse, error) {
+	if m.ExpireAnnouncementFn != nil {
+		return m.ExpireAnnouncementFn(id, userID)
+	}
+	return nil, nil
+}
+func (m *MockAnnouncementService) GetActiveAnnouncements(userID uint) ([]*contracts.ActiveAnnouncementResponse, error) {
+	if m.GetActiveAnnouncementsFn != nil {
+		return m.GetActiveAnnouncementsFn(userID)
+	}
+	return nil, nil
+}
+func (m *MockAnnouncementService) DismissAnnouncement(id uint, userID uint) error {
+	if m.DismissAnnouncementFn != nil {
+		return m.DismissAnnouncementFn(id, userID)
+	}
+	return nil
+}
+
+// ============================================================================
+// Mock: ArtistClaimServiceInterface
+// ============================================================================
+
+type MockArtistClaimService struct {
+	SubmitClaimFn         func(uint, uint, string) (*contracts.ArtistClaimResponse, error)
+	GetUserClaimsFn       func(uint) ([]*contracts.ArtistClaimResponse, error)
+	ListClaimsFn          func(string, int, int) ([]*contracts.ArtistClaimResponse, int64, error)
+	ApproveClaimFn        func(uint, uint, string) (*contracts.ArtistClaimResponse, error)
+	DenyClaimFn           func(uint, uint, string) (*contracts.ArtistClaimResponse, error)
+	IsArtistManagerFn     func(uint, uint) (bool, error)
+	GetManagedArtistsFn   func(uint) ([]*contracts.ArtistManagerResponse, error)
+	ListArtistManagersFn  func(uint) ([]*contracts.ArtistManagerResponse, error)
+	RemoveArtistManagerFn func(uint, uint) error
+}
+
+func (m *MockArtistClaimService) SubmitClaim(artistID uint, userID uint, evidence string) (*contracts.ArtistClaimResponse, error) {
+	if m.SubmitClaimFn != nil {
+		return m.SubmitClaimFn(artistID, userID, evidence)
+	}
+	return nil, nil
+}
+func (m *MockArtistClaimService) GetUserClaims(userID uint) ([]*contracts.ArtistClaimResponse, error) {
+	if m.GetUserClaimsFn != nil {
+		return m.GetUserClaimsFn(userID)
+	}
+	return nil, nil
+}
+func (m *MockArtistClaimService) ListClaims(status string, limit int, offset int) ([]*contracts.ArtistClaimResponse, int64, error) {
+	if m.ListClaimsFn != nil {
+		return m.ListClaimsFn(status, limit, offset)
+	}
+	return nil, 0, nil
+}
+func (m *MockArtistClaimService) ApproveClaim(claimID uint, reviewerID uint, note string) (*contracts.ArtistClaimResponse, error) {
+	if m.ApproveClaimFn != nil {
+		return m.ApproveClaimFn(claimID, reviewerID, note)
+	}
+	return nil, nil
+}
+func (m *MockArtistClaimService) DenyClaim(claimID uint, reviewerID uint, reason string) (*contracts.ArtistClaimResponse, error) {
+	if m.DenyClaimFn != nil {
+		return m.DenyClaimFn(claimID, reviewerID, reason)
+	}
+	return nil, nil
+}
+func (m *MockArtistClaimService) IsArtistManager(userID uint, artistID uint) (bool, error) {
+	if m.IsArtistManagerFn != nil {
+		return m.IsArtistManagerFn(userID, artistID)
+	}
+	return false, nil
+}
+func (m *MockArtistClaimService) GetManagedArtists(userID uint) ([]*contracts.ArtistManagerResponse, error) {
+	if m.GetManagedArtistsFn != nil {
+		return m.GetManagedArtistsFn(userID)
+	}
+	return nil, nil
+}
+func (m *MockArtistClaimService) ListArtistManagers(artistID uint) ([]*contracts.ArtistManagerResponse, error) {
+	if m.ListArtistManagersFn != nil {
+		return m.ListArtistManagersFn(artistID)
+	}
+	return nil, nil
+}
+func (m *MockArtistClaimService) RemoveArtistManager(artistID uint, userID uint) error {
+	if m.RemoveArtistManagerFn != nil {
+		return m.RemoveArtistManagerFn(artistID, userID)
+	}
+	return nil
+}
+
 // ============================================================================
 // Mock: ArtistRelationshipServiceInterface
 // ============================================================================
@@ -348,6 +608,8 @@ type MockArtistService struct {
 	DeleteArtistFn             func(uint) error
 	SearchArtistsFn            func(string) ([]*contracts.ArtistDetailResponse, error)
 	GetShowsForArtistFn        func(uint, string, int, string) ([]*contracts.ArtistShowResponse, int64, error)
+	GetShowsForArtistPageFn    func(uint, string, int, int, string, int) ([]*contracts.ArtistShowResponse, int64, error)
+	GetShowYearsForArtistFn    func(uint, string, string) ([]*contracts.ShowYearCount, error)
 	GetNextShowForArtistFn     func(uint, string) (*contracts.ArtistShowResponse, error)
 	GetArtistCitiesFn          func() ([]*contracts.ArtistCityResponse, error)
 	GetLabelsForArtistFn       func(uint) ([]*contracts.ArtistLabelResponse, error)
@@ -429,6 +691,18 @@ func (m *MockArtistService) GetShowsForArtist(artistID uint, timezone string, li
 	}
 	return nil, 0, nil
 }
+func (m *MockArtistService) GetShowsForArtistPage(artistID uint, timezone string, limit int, offset int, timeFilter string, year int) ([]*contracts.ArtistShowResponse, int64, error) {
+	if m.GetShowsForArtistPageFn != nil {
+		return m.GetShowsForArtistPageFn(artistID, timezone, limit, offset, timeFilter, year)
+	}
+	return nil, 0, nil
+}
+func (m *MockArtistService) GetShowYearsForArtist(artistID uint, timezone string, timeFilter string) ([]*contracts.ShowYearCount, error) {
+	if m.GetShowYearsForArtistFn != nil {
+		return m.GetShowYearsForArtistFn(artistID, timezone, timeFilter)
+	}
+	return nil, nil
+}
 func (m *MockArtistService) GetNextShowForArtist(artistID uint, timezone string) (*contracts.ArtistShowResponse, error) {
 	if m.GetNextShowForArtistFn != nil {
 		return m.GetNextShowForArtistFn(artistID, timezone)
@@ -507,6 +781,7 @@ type MockAuthService struct {
 	OAuthLoginFn               func(http.ResponseWriter, *http.Request, string) error
 	OAuthCallbackFn            func(http.ResponseWriter, *http.Request, string) (*authm.User, string, error)
 	OAuthCallbackWithConsentFn func(http.ResponseWriter, *http.Request, string, *contracts.OAuthSignupConsent) (*authm.User, string, error)
+	OAuthLinkCallbackFn        func(http.ResponseWriter, *http.Request, string, uint) (*authm.User, error)
 	GetUserProfileFn           func(uint) (*authm.User, error)
 	RefreshUserTokenFn         func(*authm.User) (string, error)
 	LogoutFn                   func(http.ResponseWriter, *http.Request) error
@@ -531,6 +806,12 @@ func (m *MockAuthService) OAuthCallbackWithConsent(w http.ResponseWriter, r *htt
 	}
 	return nil, "", nil
 }
+func (m *MockAuthService) OAuthLinkCallback(w http.ResponseWriter, r *http.Request, provider string, userID uint) (*authm.User, error) {
+	if m.OAuthLinkCallbackFn != nil {
+		return m.OAuthLinkCallbackFn(w, r, provider, userID)
+	}
+	return nil, nil
+}
 func (m *MockAuthService) GetUserProfile(userID uint) (*authm.User, error) {
 	if m.GetUserProfileFn != nil {
 		return m.GetUserProfileFn(userID)
@@ -598,6 +879,28 @@ func (m *MockBandcampProfileFiller) FillProfileResolvedEmbedFromBandcamp(artistI
 	}
 }
 
+// ============================================================================
+// Mock: CalendarImportServiceInterface
+// ============================================================================
+
+type MockCalendarImportService struct {
+	PreviewCalendarImportFn func(uint, []byte) (*contracts.CalendarImportPreview, error)
+	ConfirmCalendarImportFn func(uint, []uint) (*contracts.CalendarImportResult, error)
+}
+
+func (m *MockCalendarImportService) PreviewCalendarImport(userID uint, ics []byte) (*contracts.CalendarImportPreview, error) {
+	if m.PreviewCalendarImportFn != nil {
+		return m.PreviewCalendarImportFn(userID, ics)
+	}
+	return nil, nil
+}
+func (m *MockCalendarImportService) ConfirmCalendarImport(userID uint, showIDs []uint) (*contracts.CalendarImportResult, error) {
+	if m.ConfirmCalendarImportFn != nil {
+		return m.ConfirmCalendarImportFn(userID, showIDs)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: CalendarServiceInterface
 // ============================================================================
@@ -664,9 +967,12 @@ type MockChartsService struct {
 	GetTopTagsFn                   func(contracts.ChartWindow, string, int, int) ([]contracts.TopTag, int, error)
 	GetChartsSummaryFn             func(contracts.ChartWindow, string) (*contracts.ChartsSummary, error)
 	GetCommunityPulseFn            func() (*contracts.CommunityPulse, error)
+	GetPublicStatsFn               func() (*contracts.PublicStats, error)
 	GetFreshlyAddedFn              func(string, int) ([]contracts.FreshlyAddedItem, error)
 	GetChartScenesFn               func(contracts.ChartWindow) ([]contracts.ChartScene, error)
 	GetPersonalChartsStatsFn       func(uint) (*contracts.PersonalChartsStats, error)
+	GetYearInReviewFn              func(int) (*contracts.YearInReview, error)
+	GetPersonalYearInReviewFn      func(uint, int) (*contracts.YearInReview, error)
 	GetPopularArtistsFn            func(int) ([]contracts.PopularArtist, error)
 	GetActiveVenuesFn              func(int) ([]contracts.ActiveVenue, error)
 	GetHotReleasesFn               func(int) ([]contracts.HotRelease, error)
@@ -750,6 +1056,12 @@ func (m *MockChartsService) GetCommunityPulse() (*contracts.CommunityPulse, erro
 	}
 	return &contracts.CommunityPulse{}, nil
 }
+func (m *MockChartsService) GetPublicStats() (*contracts.PublicStats, error) {
+	if m.GetPublicStatsFn != nil {
+		return m.GetPublicStatsFn()
+	}
+	return nil, nil
+}
 func (m *MockChartsService) GetFreshlyAdded(scene string, limit int) ([]contracts.FreshlyAddedItem, error) {
 	if m.GetFreshlyAddedFn != nil {
 		return m.GetFreshlyAddedFn(scene, limit)
@@ -768,6 +1080,18 @@ func (m *MockChartsService) GetPersonalChartsStats(userID uint) (*contracts.Pers
 	}
 	return &contracts.PersonalChartsStats{}, nil
 }
+func (m *MockChartsService) GetYearInReview(year int) (*contracts.YearInReview, error) {
+	if m.GetYearInReviewFn != nil {
+		return m.GetYearInReviewFn(year)
+	}
+	return nil, nil
+}
+func (m *MockChartsService) GetPersonalYearInReview(userID uint, year int) (*contracts.YearInReview, error) {
+	if m.GetPersonalYearInReviewFn != nil {
+		return m.GetPersonalYearInReviewFn(userID, year)
+	}
+	return nil, nil
+}
 func (m *MockChartsService) GetPopularArtists(limit int) ([]contracts.PopularArtist, error) {
 	if m.GetPopularArtistsFn != nil {
 		return m.GetPopularArtistsFn(limit)
@@ -1245,6 +1569,7 @@ type MockContributorProfileService struct {
 	DeleteSectionFn          func(uint, uint) error
 	GetActivityHeatmapFn     func(uint) (*contracts.ActivityHeatmapResponse, error)
 	GetPercentileRankingsFn  func(uint) (*contracts.PercentileRankings, error)
+	GetSubmittedShowsFn      func(uint, int, int) ([]*contracts.PublicSubmittedShow, int64, error)
 }
 
 func (m *MockContributorProfileService) GetPublicProfile(username string, viewerID *uint) (*contracts.PublicProfileResponse, error) {
@@ -1319,6 +1644,12 @@ func (m *MockContributorProfileService) GetPercentileRankings(userID uint) (*con
 	}
 	return nil, nil
 }
+func (m *MockContributorProfileService) GetSubmittedShows(userID uint, limit int, offset int) ([]*contracts.PublicSubmittedShow, int64, error) {
+	if m.GetSubmittedShowsFn != nil {
+		return m.GetSubmittedShowsFn(userID, limit, offset)
+	}
+	return nil, 0, nil
+}
 
 // ============================================================================
 // Mock: DataQualityServiceInterface
@@ -1361,10 +1692,14 @@ func (m *MockDataQualityService) GetContributeCategoryItems(category string, vie
 // ============================================================================
 
 type MockDataSyncService struct {
-	ExportShowsFn   func(contracts.ExportShowsParams) (*contracts.ExportShowsResult, error)
-	ExportArtistsFn func(contracts.ExportArtistsParams) (*contracts.ExportArtistsResult, error)
-	ExportVenuesFn  func(contracts.ExportVenuesParams) (*contracts.ExportVenuesResult, error)
-	ImportDataFn    func(contracts.DataImportRequest) (*contracts.DataImportResult, error)
+	ExportShowsFn       func(contracts.ExportShowsParams) (*contracts.ExportShowsResult, error)
+	ExportArtistsFn     func(contracts.ExportArtistsParams) (*contracts.ExportArtistsResult, error)
+	ExportVenuesFn      func(contracts.ExportVenuesParams) (*contracts.ExportVenuesResult, error)
+	ImportDataFn        func(contracts.DataImportRequest) (*contracts.DataImportResult, error)
+	ValidateImportFn    func(contracts.DataImportRequest, uint) (*contracts.DataImportValidationReport, error)
+	CommitImportFn      func(string, uint) (*contracts.DataImportResult, error)
+	ValidateCSVExportFn func(string, contracts.CSVExportParams) error
+	WriteCSVFn          func(context.Context, string, contracts.CSVExportParams, io.Writer) error
 }
 
 func (m *MockDataSyncService) ExportShows(params contracts.ExportShowsParams) (*contracts.ExportShowsResult, error) {
@@ -1391,22 +1726,73 @@ func (m *MockDataSyncService) ImportData(req contracts.DataImportRequest) (*cont
 	}
 	return nil, nil
 }
+func (m *MockDataSyncService) ValidateImport(req contracts.DataImportRequest, adminID uint) (*contracts.DataImportValidationReport, error) {
+	if m.ValidateImportFn != nil {
+		return m.ValidateImportFn(req, adminID)
+	}
+	return nil, nil
+}
+func (m *MockDataSyncService) CommitImport(token string, adminID uint) (*contracts.DataImportResult, error) {
+	if m.CommitImportFn != nil {
+		return m.CommitImportFn(token, adminID)
+	}
+	return nil, nil
+}
+func (m *MockDataSyncService) ValidateCSVExport(entity string, params contracts.CSVExportParams) error {
+	if m.ValidateCSVExportFn != nil {
+		return m.ValidateCSVExportFn(entity, params)
+	}
+	return nil
+}
+func (m *MockDataSyncService) WriteCSV(ctx context.Context, entity string, params contracts.CSVExportParams, w io.Writer) error {
+	if m.WriteCSVFn != nil {
+		return m.WriteCSVFn(ctx, entity, params, w)
+	}
+	return nil
+}
+
+// ============================================================================
+// Mock: DeletionReceiptServiceInterface
+// ============================================================================
+
+type MockDeletionReceiptService struct {
+	ListDeletionReceiptsFn func(int, int, string) ([]*contracts.DeletionReceiptRecord, int64, error)
+	GetDeletionReceiptFn   func(uint) (*contracts.DeletionReceiptRecord, error)
+}
+
+func (m *MockDeletionReceiptService) ListDeletionReceipts(limit int, offset int, email string) ([]*contracts.DeletionReceiptRecord, int64, error) {
+	if m.ListDeletionReceiptsFn != nil {
+		return m.ListDeletionReceiptsFn(limit, offset, email)
+	}
+	return nil, 0, nil
+}
+func (m *MockDeletionReceiptService) GetDeletionReceipt(id uint) (*contracts.DeletionReceiptRecord, error) {
+	if m.GetDeletionReceiptFn != nil {
+		return m.GetDeletionReceiptFn(id)
+	}
+	return nil, nil
+}
 
 // ============================================================================
 // Mock: DiscordServiceInterface
 // ============================================================================
 
 type MockDiscordService struct {
-	IsConfiguredFn           func() bool
-	NotifyNewUserFn          func(*authm.User)
-	NotifyNewShowFn          func(*contracts.ShowResponse, string)
-	NotifyShowStatusChangeFn func(string, uint, string, string, string)
-	NotifyShowApprovedFn     func(*contracts.ShowResponse)
-	NotifyShowRejectedFn     func(*contracts.ShowResponse, string)
-	NotifyShowReportFn       func(*communitym.ShowReport, string)
-	NotifyArtistReportFn     func(*communitym.ArtistReport, string)
-	NotifyNewVenueFn         func(uint, string, string, string, *string, string)
-	NotifyNewRadioShowsFn    func(string, []string)
+	IsConfiguredFn                func() bool
+	NotifyNewUserFn               func(*authm.User)
+	NotifyNewShowFn               func(*contracts.ShowResponse, string)
+	NotifyShowStatusChangeFn      func(string, uint, string, string, string)
+	NotifyShowApprovedFn          func(*contracts.ShowResponse)
+	NotifyShowRejectedFn          func(*contracts.ShowResponse, string)
+	NotifyShowReportFn            func(*communitym.ShowReport, string)
+	NotifyArtistReportFn          func(*communitym.ArtistReport, string)
+	NotifyVenueReportFn           func(*communitym.VenueReport, string)
+	NotifyNewVenueFn              func(uint, string, string, string, *string, string)
+	NotifyNewRadioShowsFn         func(string, []string)
+	NotifyBulkShowActionFn        func(*contracts.BulkShowActionResult, string)
+	NotifyStaleDiscoverySourcesFn func([]contracts.DiscoverySourceHealth, int)
+	NotifySubmissionThrottleFn    func(*authm.User, string, string)
+	NotifyMaintenanceModeFn       func(bool, string, string)
 }
 
 func (m *MockDiscordService) IsConfigured() bool {
@@ -1450,6 +1836,11 @@ func (m *MockDiscordService) NotifyArtistReport(report *communitym.ArtistReport,
 		m.NotifyArtistReportFn(report, reporterEmail)
 	}
 }
+func (m *MockDiscordService) NotifyVenueReport(report *communitym.VenueReport, reporterEmail string) {
+	if m.NotifyVenueReportFn != nil {
+		m.NotifyVenueReportFn(report, reporterEmail)
+	}
+}
 func (m *MockDiscordService) NotifyNewVenue(venueID uint, venueName string, city string, state string, address *string, submitterEmail string) {
 	if m.NotifyNewVenueFn != nil {
 		m.NotifyNewVenueFn(venueID, venueName, city, state, address, submitterEmail)
@@ -1460,6 +1851,26 @@ func (m *MockDiscordService) NotifyNewRadioShows(stationName string, newShowName
 		m.NotifyNewRadioShowsFn(stationName, newShowNames)
 	}
 }
+func (m *MockDiscordService) NotifyBulkShowAction(result *contracts.BulkShowActionResult, actorEmail string) {
+	if m.NotifyBulkShowActionFn != nil {
+		m.NotifyBulkShowActionFn(result, actorEmail)
+	}
+}
+func (m *MockDiscordService) NotifyStaleDiscoverySources(sources []contracts.DiscoverySourceHealth, staleAfterDays int) {
+	if m.NotifyStaleDiscoverySourcesFn != nil {
+		m.NotifyStaleDiscoverySourcesFn(sources, staleAfterDays)
+	}
+}
+func (m *MockDiscordService) NotifySubmissionThrottle(user *authm.User, event string, detail string) {
+	if m.NotifySubmissionThrottleFn != nil {
+		m.NotifySubmissionThrottleFn(user, event, detail)
+	}
+}
+func (m *MockDiscordService) NotifyMaintenanceMode(enabled bool, source string, actorEmail string) {
+	if m.NotifyMaintenanceModeFn != nil {
+		m.NotifyMaintenanceModeFn(enabled, source, actorEmail)
+	}
+}
 
 // ============================================================================
 // Mock: DiscoverMusicServiceInterface
@@ -1485,6 +1896,7 @@ type MockDiscoveryService struct {
 	ImportFromJSONWithDBFn func(string, bool, *gorm.DB) (*contracts.ImportResult, error)
 	CheckEventsFn          func([]contracts.CheckEventInput) (*contracts.CheckEventsResult, error)
 	ImportEventsFn         func([]contracts.DiscoveredEvent, bool, bool, catalogm.ShowStatus) (*contracts.ImportResult, error)
+	ListSourceHealthFn     func() (*contracts.DiscoverySourceHealthList, error)
 }
 
 func (m *MockDiscoveryService) ImportFromJSON(filepath string, dryRun bool) (*contracts.ImportResult, error) {
@@ -1511,6 +1923,34 @@ func (m *MockDiscoveryService) ImportEvents(events []contracts.DiscoveredEvent,
 	}
 	return nil, nil
 }
+func (m *MockDiscoveryService) ListSourceHealth() (*contracts.DiscoverySourceHealthList, error) {
+	if m.ListSourceHealthFn != nil {
+		return m.ListSourceHealthFn()
+	}
+	return nil, nil
+}
+
+// ============================================================================
+// Mock: EmailPreviewServiceInterface
+// ============================================================================
+
+type MockEmailPreviewService struct {
+	EmailTemplateNamesFn func() []string
+	PreviewEmailFn       func(string) (*contracts.EmailPreview, error)
+}
+
+func (m *MockEmailPreviewService) EmailTemplateNames() []string {
+	if m.EmailTemplateNamesFn != nil {
+		return m.EmailTemplateNamesFn()
+	}
+	return nil
+}
+func (m *MockEmailPreviewService) PreviewEmail(template string) (*contracts.EmailPreview, error) {
+	if m.PreviewEmailFn != nil {
+		return m.PreviewEmailFn(template)
+	}
+	return nil, nil
+}
 
 // ============================================================================
 // Mock: EmailServiceInterface
@@ -1521,7 +1961,7 @@ type MockEmailService struct {
 	SendVerificationEmailFn        func(string, string) error
 	SendMagicLinkEmailFn           func(string, string) error
 	SendAccountRecoveryEmailFn     func(string, string, int) error
-	SendShowReminderEmailFn        func(string, string, string, string, time.Time, []string) error
+	SendShowReminderEmailFn        func(string, string, string, string, time.Time, []string, int) error
 	SendFilterNotificationEmailFn  func(string, string, string, string) error
 	SendTierPromotionEmailFn       func(string, string, string, string, string, string, []string) error
 	SendTierDemotionEmailFn        func(string, string, string, string, string, string) error
@@ -1531,7 +1971,8 @@ type MockEmailService struct {
 	SendCommentNotificationFn      func(string, string, string, string, string, string, string) error
 	SendMentionNotificationFn      func(string, string, string, string, string, string, string) error
 	SendCollectionDigestEmailFn    func(string, []contracts.CollectionDigestGroup, string) error
-	SendSceneDigestEmailFn         func(string, []contracts.SceneDigestGroup, string) error
+	SendSceneDigestEmailFn         func(string, []contracts.SceneDigestGroup, []contracts.SceneDigestShow, string) error
+	SendShowStatusChangeEmailFn    func(string, contracts.ShowStatusChange, string) error
 }
 
 func (m *MockEmailService) IsConfigured() bool {
@@ -1558,9 +1999,9 @@ func (m *MockEmailService) SendAccountRecoveryEmail(toEmail string, token string
 	}
 	return nil
 }
-func (m *MockEmailService) SendShowReminderEmail(toEmail string, showTitle string, showURL string, unsubscribeURL string, eventDate time.Time, venues []string) error {
+func (m *MockEmailService) SendShowReminderEmail(toEmail string, showTitle string, showURL string, unsubscribeURL string, eventDate time.Time, venues []string, daysBefore int) error {
 	if m.SendShowReminderEmailFn != nil {
-		return m.SendShowReminderEmailFn(toEmail, showTitle, showURL, unsubscribeURL, eventDate, venues)
+		return m.SendShowReminderEmailFn(toEmail, showTitle, showURL, unsubscribeURL, eventDate, venues, daysBefore)
 	}
 	return nil
 }
@@ -1618,12 +2059,47 @@ func (m *MockEmailService) SendCollectionDigestEmail(toEmail string, groups []co
 	}
 	return nil
 }
-func (m *MockEmailService) SendSceneDigestEmail(toEmail string, groups []contracts.SceneDigestGroup, unsubscribeURL string) error {
+func (m *MockEmailService) SendSceneDigestEmail(toEmail string, groups []contracts.SceneDigestGroup, artistShows []contracts.SceneDigestShow, unsubscribeURL string) error {
 	if m.SendSceneDigestEmailFn != nil {
-		return m.SendSceneDigestEmailFn(toEmail, groups, unsubscribeURL)
+		return m.SendSceneDigestEmailFn(toEmail, groups, artistShows, unsubscribeURL)
+	}
+	return nil
+}
+func (m *MockEmailService) SendShowStatusChangeEmail(toEmail string, change contracts.ShowStatusChange, unsubscribeURL string) error {
+	if m.SendShowStatusChangeEmailFn != nil {
+		return m.SendShowStatusChangeEmailFn(toEmail, change, unsubscribeURL)
+	}
+	return nil
+}
+
+// ============================================================================
+// Mock: EmailSuppressionServiceInterface
+// ============================================================================
+
+type MockEmailSuppressionService struct {
+	IsSuppressedFn        func(string) (bool, error)
+	SuppressFn            func(string, string, string, string) error
+	IngestBounceWebhookFn func(string, []byte) (int, error)
+}
+
+func (m *MockEmailSuppressionService) IsSuppressed(email string) (bool, error) {
+	if m.IsSuppressedFn != nil {
+		return m.IsSuppressedFn(email)
+	}
+	return false, nil
+}
+func (m *MockEmailSuppressionService) Suppress(email string, reason string, provider string, detail string) error {
+	if m.SuppressFn != nil {
+		return m.SuppressFn(email, reason, provider, detail)
 	}
 	return nil
 }
+func (m *MockEmailSuppressionService) IngestBounceWebhook(provider string, body []byte) (int, error) {
+	if m.IngestBounceWebhookFn != nil {
+		return m.IngestBounceWebhookFn(provider, body)
+	}
+	return 0, nil
+}
 
 // ============================================================================
 // Mock: EnrichmentServiceInterface
@@ -1661,6 +2137,35 @@ func (m *MockEnrichmentService) GetQueueStats() (*contracts.EnrichmentQueueStats
 	return &contracts.EnrichmentQueueStats{}, nil
 }
 
+// ============================================================================
+// Mock: EntityAliasServiceInterface
+// ============================================================================
+
+type MockEntityAliasService struct {
+	CreateAliasFn func(string, uint, string, uint) (*contracts.EntityAliasResponse, error)
+	ListAliasesFn func(string) ([]*contracts.EntityAliasResponse, error)
+	DeleteAliasFn func(uint) (*contracts.EntityAliasResponse, error)
+}
+
+func (m *MockEntityAliasService) CreateAlias(entityType string, entityID uint, alias string, createdBy uint) (*contracts.EntityAliasResponse, error) {
+	if m.CreateAliasFn != nil {
+		return m.CreateAliasFn(entityType, entityID, alias, createdBy)
+	}
+	return nil, nil
+}
+func (m *MockEntityAliasService) ListAliases(entityType string) ([]*contracts.EntityAliasResponse, error) {
+	if m.ListAliasesFn != nil {
+		return m.ListAliasesFn(entityType)
+	}
+	return nil, nil
+}
+func (m *MockEntityAliasService) DeleteAlias(aliasID uint) (*contracts.EntityAliasResponse, error) {
+	if m.DeleteAliasFn != nil {
+		return m.DeleteAliasFn(aliasID)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: EntityReportServiceInterface
 // ============================================================================
@@ -1863,35 +2368,78 @@ func (m *MockExtractionService) ExtractShow(req *contracts.ExtractShowRequest) (
 }
 
 // ============================================================================
-// Mock: FestivalIntelligenceServiceInterface
+// Mock: FeatureFlagServiceInterface
 // ============================================================================
 
-type MockFestivalIntelligenceService struct {
-	GetSimilarFestivalsFn         func(uint, int) ([]contracts.SimilarFestival, error)
-	GetFestivalOverlapFn          func(uint, uint) (*contracts.FestivalOverlap, error)
-	GetArtistFestivalTrajectoryFn func(uint) (*contracts.ArtistTrajectory, error)
-	GetSeriesComparisonFn         func(string, []int) (*contracts.SeriesComparison, error)
+type MockFeatureFlagService struct {
+	ListFlagsFn  func() ([]*contracts.FeatureFlagResponse, error)
+	CreateFlagFn func(*contracts.CreateFeatureFlagRequest, uint) (*contracts.FeatureFlagResponse, error)
+	UpdateFlagFn func(string, *contracts.UpdateFeatureFlagRequest, uint) (*contracts.FeatureFlagResponse, error)
+	DeleteFlagFn func(string) error
+	IsEnabledFn  func(string, bool) bool
 }
 
-func (m *MockFestivalIntelligenceService) GetSimilarFestivals(festivalID uint, limit int) ([]contracts.SimilarFestival, error) {
-	if m.GetSimilarFestivalsFn != nil {
-		return m.GetSimilarFestivalsFn(festivalID, limit)
+func (m *MockFeatureFlagService) ListFlags() ([]*contracts.FeatureFlagResponse, error) {
+	if m.ListFlagsFn != nil {
+		return m.ListFlagsFn()
 	}
 	return nil, nil
 }
-func (m *MockFestivalIntelligenceService) GetFestivalOverlap(festivalAID uint, festivalBID uint) (*contracts.FestivalOverlap, error) {
-	if m.GetFestivalOverlapFn != nil {
-		return m.GetFestivalOverlapFn(festivalAID, festivalBID)
+func (m *MockFeatureFlagService) CreateFlag(req *contracts.CreateFeatureFlagRequest, userID uint) (*contracts.FeatureFlagResponse, error) {
+	if m.CreateFlagFn != nil {
+		return m.CreateFlagFn(req, userID)
 	}
 	return nil, nil
 }
-func (m *MockFestivalIntelligenceService) GetArtistFestivalTrajectory(artistID uint) (*contracts.ArtistTrajectory, error) {
-	if m.GetArtistFestivalTrajectoryFn != nil {
-		return m.GetArtistFestivalTrajectoryFn(artistID)
+func (m *MockFeatureFlagService) UpdateFlag(key string, req *contracts.UpdateFeatureFlagRequest, userID uint) (*contracts.FeatureFlagResponse, error) {
+	if m.UpdateFlagFn != nil {
+		return m.UpdateFlagFn(key, req, userID)
 	}
 	return nil, nil
 }
-func (m *MockFestivalIntelligenceService) GetSeriesComparison(seriesSlug string, years []int) (*contracts.SeriesComparison, error) {
+func (m *MockFeatureFlagService) DeleteFlag(key string) error {
+	if m.DeleteFlagFn != nil {
+		return m.DeleteFlagFn(key)
+	}
+	return nil
+}
+func (m *MockFeatureFlagService) IsEnabled(key string, isAdmin bool) bool {
+	if m.IsEnabledFn != nil {
+		return m.IsEnabledFn(key, isAdmin)
+	}
+	return false
+}
+
+// ============================================================================
+// Mock: FestivalIntelligenceServiceInterface
+// ============================================================================
+
+type MockFestivalIntelligenceService struct {
+	GetSimilarFestivalsFn         func(uint, int) ([]contracts.SimilarFestival, error)
+	GetFestivalOverlapFn          func(uint, uint) (*contracts.FestivalOverlap, error)
+	GetArtistFestivalTrajectoryFn func(uint) (*contracts.ArtistTrajectory, error)
+	GetSeriesComparisonFn         func(string, []int) (*contracts.SeriesComparison, error)
+}
+
+func (m *MockFestivalIntelligenceService) GetSimilarFestivals(festivalID uint, limit int) ([]contracts.SimilarFestival, error) {
+	if m.GetSimilarFestivalsFn != nil {
+		return m.GetSimilarFestivalsFn(festivalID, limit)
+	}
+	return nil, nil
+}
+func (m *MockFestivalIntelligenceService) GetFestivalOverlap(festivalAID uint, festivalBID uint) (*contracts.FestivalOverlap, error) {
+	if m.GetFestivalOverlapFn != nil {
+		return m.GetFestivalOverlapFn(festivalAID, festivalBID)
+	}
+	return nil, nil
+}
+func (m *MockFestivalIntelligenceService) GetArtistFestivalTrajectory(artistID uint) (*contracts.ArtistTrajectory, error) {
+	if m.GetArtistFestivalTrajectoryFn != nil {
+		return m.GetArtistFestivalTrajectoryFn(artistID)
+	}
+	return nil, nil
+}
+func (m *MockFestivalIntelligenceService) GetSeriesComparison(seriesSlug string, years []int) (*contracts.SeriesComparison, error) {
 	if m.GetSeriesComparisonFn != nil {
 		return m.GetSeriesComparisonFn(seriesSlug, years)
 	}
@@ -2047,6 +2595,28 @@ func (m *MockFieldNoteService) ListFieldNotesByAuthor(userID uint, limit int, of
 	return nil, 0, nil
 }
 
+// ============================================================================
+// Mock: FlyerServiceInterface
+// ============================================================================
+
+type MockFlyerService struct {
+	UploadShowFlyerFn func(context.Context, uint, io.Reader) (*contracts.ShowFlyerResponse, error)
+	DeleteShowFlyerFn func(context.Context, uint) error
+}
+
+func (m *MockFlyerService) UploadShowFlyer(ctx context.Context, showID uint, r io.Reader) (*contracts.ShowFlyerResponse, error) {
+	if m.UploadShowFlyerFn != nil {
+		return m.UploadShowFlyerFn(ctx, showID, r)
+	}
+	return nil, nil
+}
+func (m *MockFlyerService) DeleteShowFlyer(ctx context.Context, showID uint) error {
+	if m.DeleteShowFlyerFn != nil {
+		return m.DeleteShowFlyerFn(ctx, showID)
+	}
+	return nil
+}
+
 // ============================================================================
 // Mock: FollowServiceInterface
 // ============================================================================
@@ -2064,6 +2634,7 @@ type MockFollowService struct {
 	GetLibraryFollowingCountsFn func(uint) (*contracts.LibraryFollowingCounts, error)
 	GetLibraryFollowingFn       func(uint, string, int, *contracts.LibraryFollowingCursor) ([]*contracts.LibraryFollowingEntityResponse, *contracts.LibraryFollowingCursor, error)
 	GetFollowersFn              func(string, uint, int, int) ([]*contracts.FollowerResponse, int64, error)
+	GetFollowedArtistShowsFn    func(uint, int, int) ([]*contracts.FollowedArtistShow, int64, error)
 }
 
 func (m *MockFollowService) Follow(userID uint, entityType string, entityID uint) error {
@@ -2142,6 +2713,63 @@ func (m *MockFollowService) GetFollowers(entityType string, entityID uint, limit
 	}
 	return nil, 0, nil
 }
+func (m *MockFollowService) GetFollowedArtistShows(userID uint, limit int, offset int) ([]*contracts.FollowedArtistShow, int64, error) {
+	if m.GetFollowedArtistShowsFn != nil {
+		return m.GetFollowedArtistShowsFn(userID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// ============================================================================
+// Mock: IdempotencyServiceInterface
+// ============================================================================
+
+type MockIdempotencyService struct {
+	AcquireFn  func(contracts.IdempotencyRequest) (*adminm.IdempotencyKey, bool, error)
+	CompleteFn func(uint, int, string, []byte) error
+	ReleaseFn  func(uint) error
+}
+
+func (m *MockIdempotencyService) Acquire(req contracts.IdempotencyRequest) (*adminm.IdempotencyKey, bool, error) {
+	if m.AcquireFn != nil {
+		return m.AcquireFn(req)
+	}
+	return nil, false, nil
+}
+func (m *MockIdempotencyService) Complete(id uint, statusCode int, contentType string, body []byte) error {
+	if m.CompleteFn != nil {
+		return m.CompleteFn(id, statusCode, contentType, body)
+	}
+	return nil
+}
+func (m *MockIdempotencyService) Release(id uint) error {
+	if m.ReleaseFn != nil {
+		return m.ReleaseFn(id)
+	}
+	return nil
+}
+
+// ============================================================================
+// Mock: JWTKeyRotatorInterface
+// ============================================================================
+
+type MockJWTKeyRotator struct {
+	JWTKeyStatusFn  func() *contracts.JWTKeyStatus
+	RotateJWTKeysFn func(context.Context) (*contracts.JWTKeyStatus, error)
+}
+
+func (m *MockJWTKeyRotator) JWTKeyStatus() *contracts.JWTKeyStatus {
+	if m.JWTKeyStatusFn != nil {
+		return m.JWTKeyStatusFn()
+	}
+	return nil
+}
+func (m *MockJWTKeyRotator) RotateJWTKeys(ctx context.Context) (*contracts.JWTKeyStatus, error) {
+	if m.RotateJWTKeysFn != nil {
+		return m.RotateJWTKeysFn(ctx)
+	}
+	return nil, nil
+}
 
 // ============================================================================
 // Mock: JWTServiceInterface
@@ -2357,6 +2985,21 @@ func (m *MockLinkSuggestionService) RejectSuggestion(suggestionID uint, reviewer
 	return nil, nil
 }
 
+// ============================================================================
+// Mock: NearbyShowsServiceInterface
+// ============================================================================
+
+type MockNearbyShowsService struct {
+	GetNearbyShowsFn func(string, string, int) (*contracts.NearbyShowsResponse, error)
+}
+
+func (m *MockNearbyShowsService) GetNearbyShows(clientIP string, timezone string, limit int) (*contracts.NearbyShowsResponse, error) {
+	if m.GetNearbyShowsFn != nil {
+		return m.GetNearbyShowsFn(clientIP, timezone, limit)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: NotificationFilterServiceInterface
 // ============================================================================
@@ -2456,6 +3099,42 @@ func (m *MockNotificationFilterService) PauseFilter(filterID uint) error {
 	return nil
 }
 
+// ============================================================================
+// Mock: NotificationPreferenceServiceInterface
+// ============================================================================
+
+type MockNotificationPreferenceService struct {
+	GetPreferencesFn     func(uint) (contracts.NotificationPreferenceMatrix, error)
+	UpdatePreferencesFn  func(uint, contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error)
+	IsEnabledFn          func(uint, string, string) (bool, error)
+	FilterEnabledUsersFn func([]uint, string, string) ([]uint, error)
+}
+
+func (m *MockNotificationPreferenceService) GetPreferences(userID uint) (contracts.NotificationPreferenceMatrix, error) {
+	if m.GetPreferencesFn != nil {
+		return m.GetPreferencesFn(userID)
+	}
+	return contracts.NotificationPreferenceMatrix{}, nil
+}
+func (m *MockNotificationPreferenceService) UpdatePreferences(userID uint, updates contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error) {
+	if m.UpdatePreferencesFn != nil {
+		return m.UpdatePreferencesFn(userID, updates)
+	}
+	return contracts.NotificationPreferenceMatrix{}, nil
+}
+func (m *MockNotificationPreferenceService) IsEnabled(userID uint, eventType string, channel string) (bool, error) {
+	if m.IsEnabledFn != nil {
+		return m.IsEnabledFn(userID, eventType, channel)
+	}
+	return false, nil
+}
+func (m *MockNotificationPreferenceService) FilterEnabledUsers(userIDs []uint, eventType string, channel string) ([]uint, error) {
+	if m.FilterEnabledUsersFn != nil {
+		return m.FilterEnabledUsersFn(userIDs, eventType, channel)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: PasswordValidatorInterface
 // ============================================================================
@@ -2549,6 +3228,49 @@ func (m *MockPendingEditService) CancelPendingEdit(editID uint, userID uint) err
 	return nil
 }
 
+// ============================================================================
+// Mock: PushServiceInterface
+// ============================================================================
+
+type MockPushService struct {
+	IsConfiguredFn func() bool
+	PublicKeyFn    func() string
+	SubscribeFn    func(uint, contracts.PushSubscriptionInput) error
+	UnsubscribeFn  func(uint, string) error
+	SendToUserFn   func(uint, contracts.PushMessage) (int, error)
+}
+
+func (m *MockPushService) IsConfigured() bool {
+	if m.IsConfiguredFn != nil {
+		return m.IsConfiguredFn()
+	}
+	return false
+}
+func (m *MockPushService) PublicKey() string {
+	if m.PublicKeyFn != nil {
+		return m.PublicKeyFn()
+	}
+	return ""
+}
+func (m *MockPushService) Subscribe(userID uint, input contracts.PushSubscriptionInput) error {
+	if m.SubscribeFn != nil {
+		return m.SubscribeFn(userID, input)
+	}
+	return nil
+}
+func (m *MockPushService) Unsubscribe(userID uint, endpoint string) error {
+	if m.UnsubscribeFn != nil {
+		return m.UnsubscribeFn(userID, endpoint)
+	}
+	return nil
+}
+func (m *MockPushService) SendToUser(userID uint, msg contracts.PushMessage) (int, error) {
+	if m.SendToUserFn != nil {
+		return m.SendToUserFn(userID, msg)
+	}
+	return 0, nil
+}
+
 // ============================================================================
 // Mock: RadioPlayMatchSuggestionServiceInterface
 // ============================================================================
@@ -2943,6 +3665,21 @@ func (m *MockRadioService) ListStationHealth() ([]*contracts.RadioStationHealthR
 	return nil, nil
 }
 
+// ============================================================================
+// Mock: RecommendationServiceInterface
+// ============================================================================
+
+type MockRecommendationService struct {
+	GetRecommendedShowsFn func(uint, int) ([]*contracts.RecommendedShow, error)
+}
+
+func (m *MockRecommendationService) GetRecommendedShows(userID uint, limit int) ([]*contracts.RecommendedShow, error) {
+	if m.GetRecommendedShowsFn != nil {
+		return m.GetRecommendedShowsFn(userID, limit)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: ReleaseServiceInterface
 // ============================================================================
@@ -3170,11 +3907,13 @@ func (m *MockRequestService) ResolveEntityRef(entityType string, entityID uint)
 // ============================================================================
 
 type MockRevisionService struct {
-	RecordRevisionFn   func(string, uint, uint, []adminm.FieldChange, string) error
-	GetEntityHistoryFn func(string, uint, int, int) ([]adminm.Revision, int64, error)
-	GetRevisionFn      func(uint) (*adminm.Revision, error)
-	GetUserRevisionsFn func(uint, int, int) ([]adminm.Revision, int64, error)
-	RollbackFn         func(uint, uint) error
+	RecordRevisionFn      func(string, uint, uint, []adminm.FieldChange, string) error
+	GetEntityHistoryFn    func(string, uint, int, int) ([]adminm.Revision, int64, error)
+	GetRevisionFn         func(uint) (*adminm.Revision, error)
+	GetUserRevisionsFn    func(uint, int, int) ([]adminm.Revision, int64, error)
+	RollbackFn            func(uint, uint) error
+	GetRevisionSnapshotFn func(uint) (*contracts.RevisionSnapshot, error)
+	RestoreRevisionFn     func(uint, uint) (*contracts.RevisionSnapshot, error)
 }
 
 func (m *MockRevisionService) RecordRevision(entityType string, entityID uint, userID uint, changes []adminm.FieldChange, summary string) error {
@@ -3207,6 +3946,18 @@ func (m *MockRevisionService) Rollback(revisionID uint, adminUserID uint) error
 	}
 	return nil
 }
+func (m *MockRevisionService) GetRevisionSnapshot(revisionID uint) (*contracts.RevisionSnapshot, error) {
+	if m.GetRevisionSnapshotFn != nil {
+		return m.GetRevisionSnapshotFn(revisionID)
+	}
+	return nil, nil
+}
+func (m *MockRevisionService) RestoreRevision(revisionID uint, adminUserID uint) (*contracts.RevisionSnapshot, error) {
+	if m.RestoreRevisionFn != nil {
+		return m.RestoreRevisionFn(revisionID, adminUserID)
+	}
+	return nil, nil
+}
 
 // ============================================================================
 // Mock: SavedReleaseServiceInterface
@@ -3427,13 +4178,21 @@ func (m *MockSceneService) GetSceneNewArtistsSince(city string, state string, si
 // ============================================================================
 
 type MockShowAdminService struct {
-	GetPendingShowsFn   func(int, int, *contracts.PendingShowsFilter) ([]*contracts.ShowResponse, int64, error)
-	GetRejectedShowsFn  func(int, int, string) ([]*contracts.ShowResponse, int64, error)
-	ApproveShowFn       func(uint, bool) (*contracts.ShowResponse, error)
-	RejectShowFn        func(uint, string) (*contracts.ShowResponse, error)
-	BatchApproveShowsFn func([]uint) (*contracts.BatchShowResult, error)
-	BatchRejectShowsFn  func([]uint, string, string) (*contracts.BatchShowResult, error)
-	GetAdminShowsFn     func(int, int, contracts.AdminShowFilters) ([]*contracts.ShowResponse, int64, error)
+	GetPendingShowsFn         func(int, int, *contracts.PendingShowsFilter) ([]*contracts.ShowResponse, int64, error)
+	GetRejectedShowsFn        func(int, int, string) ([]*contracts.ShowResponse, int64, error)
+	ApproveShowFn             func(uint, bool) (*contracts.ShowResponse, error)
+	RejectShowFn              func(uint, string) (*contracts.ShowResponse, error)
+	BatchApproveShowsFn       func([]uint) (*contracts.BatchShowResult, error)
+	BatchRejectShowsFn        func([]uint, string, string) (*contracts.BatchShowResult, error)
+	BulkShowActionFn          func(*contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error)
+	GetAdminShowsFn           func(int, int, contracts.AdminShowFilters) ([]*contracts.ShowResponse, int64, error)
+	RestoreShowFn             func(uint) (*contracts.ShowResponse, error)
+	GetExpiredDeletedShowsFn  func() ([]uint, error)
+	PermanentlyDeleteShowFn   func(uint) error
+	FindDuplicateCandidatesFn func(uint) ([]contracts.DuplicateCandidate, error)
+	SetShowDuplicateOfFn      func(uint, *uint) (*contracts.ShowResponse, error)
+	GetShowTicketStatusFn     func(uint) (*contracts.ShowTicketStatusResponse, error)
+	GetShowShortLinkStatsFn   func(uint) (*contracts.ShowShortLinkStatsResponse, error)
 }
 
 func (m *MockShowAdminService) GetPendingShows(limit int, offset int, filters *contracts.PendingShowsFilter) ([]*contracts.ShowResponse, int64, error) {
@@ -3472,12 +4231,118 @@ func (m *MockShowAdminService) BatchRejectShows(showIDs []uint, reason string, c
 	}
 	return &contracts.BatchShowResult{Succeeded: showIDs, Errors: []contracts.BatchShowError{}}, nil
 }
+func (m *MockShowAdminService) BulkShowAction(req *contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error) {
+	if m.BulkShowActionFn != nil {
+		return m.BulkShowActionFn(req)
+	}
+	return nil, nil
+}
 func (m *MockShowAdminService) GetAdminShows(limit int, offset int, filters contracts.AdminShowFilters) ([]*contracts.ShowResponse, int64, error) {
 	if m.GetAdminShowsFn != nil {
 		return m.GetAdminShowsFn(limit, offset, filters)
 	}
 	return nil, 0, nil
 }
+func (m *MockShowAdminService) RestoreShow(showID uint) (*contracts.ShowResponse, error) {
+	if m.RestoreShowFn != nil {
+		return m.RestoreShowFn(showID)
+	}
+	return nil, nil
+}
+func (m *MockShowAdminService) GetExpiredDeletedShows() ([]uint, error) {
+	if m.GetExpiredDeletedShowsFn != nil {
+		return m.GetExpiredDeletedShowsFn()
+	}
+	return nil, nil
+}
+func (m *MockShowAdminService) PermanentlyDeleteShow(showID uint) error {
+	if m.PermanentlyDeleteShowFn != nil {
+		return m.PermanentlyDeleteShowFn(showID)
+	}
+	return nil
+}
+func (m *MockShowAdminService) FindDuplicateCandidates(showID uint) ([]contracts.DuplicateCandidate, error) {
+	if m.FindDuplicateCandidatesFn != nil {
+		return m.FindDuplicateCandidatesFn(showID)
+	}
+	return nil, nil
+}
+func (m *MockShowAdminService) SetShowDuplicateOf(showID uint, duplicateOfShowID *uint) (*contracts.ShowResponse, error) {
+	if m.SetShowDuplicateOfFn != nil {
+		return m.SetShowDuplicateOfFn(showID, duplicateOfShowID)
+	}
+	return nil, nil
+}
+func (m *MockShowAdminService) GetShowTicketStatus(showID uint) (*contracts.ShowTicketStatusResponse, error) {
+	if m.GetShowTicketStatusFn != nil {
+		return m.GetShowTicketStatusFn(showID)
+	}
+	return nil, nil
+}
+func (m *MockShowAdminService) GetShowShortLinkStats(showID uint) (*contracts.ShowShortLinkStatsResponse, error) {
+	if m.GetShowShortLinkStatsFn != nil {
+		return m.GetShowShortLinkStatsFn(showID)
+	}
+	return nil, nil
+}
+
+// ============================================================================
+// Mock: ShowChangeNotifierInterface
+// ============================================================================
+
+type MockShowChangeNotifier struct {
+	NotifyShowChangeFn func(uint, uint) error
+}
+
+func (m *MockShowChangeNotifier) NotifyShowChange(showID uint, actorID uint) error {
+	if m.NotifyShowChangeFn != nil {
+		return m.NotifyShowChangeFn(showID, actorID)
+	}
+	return nil
+}
+
+// ============================================================================
+// Mock: ShowDraftServiceInterface
+// ============================================================================
+
+type MockShowDraftService struct {
+	CreateDraftFn func(uint, json.RawMessage) (*contracts.ShowDraftResponse, error)
+	UpdateDraftFn func(uint, uint, json.RawMessage) (*contracts.ShowDraftResponse, error)
+	GetDraftFn    func(uint, uint) (*contracts.ShowDraftResponse, error)
+	ListDraftsFn  func(uint) ([]contracts.ShowDraftResponse, error)
+	DeleteDraftFn func(uint, uint) error
+}
+
+func (m *MockShowDraftService) CreateDraft(userID uint, payload json.RawMessage) (*contracts.ShowDraftResponse, error) {
+	if m.CreateDraftFn != nil {
+		return m.CreateDraftFn(userID, payload)
+	}
+	return nil, nil
+}
+func (m *MockShowDraftService) UpdateDraft(userID uint, draftID uint, payload json.RawMessage) (*contracts.ShowDraftResponse, error) {
+	if m.UpdateDraftFn != nil {
+		return m.UpdateDraftFn(userID, draftID, payload)
+	}
+	return nil, nil
+}
+func (m *MockShowDraftService) GetDraft(userID uint, draftID uint) (*contracts.ShowDraftResponse, error) {
+	if m.GetDraftFn != nil {
+		return m.GetDraftFn(userID, draftID)
+	}
+	return nil, nil
+}
+func (m *MockShowDraftService) ListDrafts(userID uint) ([]contracts.ShowDraftResponse, error) {
+	if m.ListDraftsFn != nil {
+		return m.ListDraftsFn(userID)
+	}
+	return nil, nil
+}
+func (m *MockShowDraftService) DeleteDraft(userID uint, draftID uint) error {
+	if m.DeleteDraftFn != nil {
+		return m.DeleteDraftFn(userID, draftID)
+	}
+	return nil
+}
 
 // ============================================================================
 // Mock: ShowImportServiceInterface
@@ -3515,6 +4380,63 @@ func (m *MockShowImportService) ConfirmShowImport(content []byte, isAdmin bool)
 	return nil, nil
 }
 
+// ============================================================================
+// Mock: ShowRSVPServiceInterface
+// ============================================================================
+
+type MockShowRSVPService struct {
+	RSVPFn                func(uint, uint) error
+	CancelRSVPFn          func(uint, uint) error
+	GetUserRSVPsFn        func(uint, int, int) ([]*contracts.ShowRSVPResponse, int64, error)
+	IsGoingFn             func(uint, uint) (bool, error)
+	GetGoingCountFn       func(uint) (int, error)
+	GetBatchGoingCountsFn func([]uint) (map[uint]int, error)
+	GetPublicAttendeesFn  func(uint, int, int) ([]*contracts.ShowAttendeeResponse, int64, error)
+}
+
+func (m *MockShowRSVPService) RSVP(userID uint, showID uint) error {
+	if m.RSVPFn != nil {
+		return m.RSVPFn(userID, showID)
+	}
+	return nil
+}
+func (m *MockShowRSVPService) CancelRSVP(userID uint, showID uint) error {
+	if m.CancelRSVPFn != nil {
+		return m.CancelRSVPFn(userID, showID)
+	}
+	return nil
+}
+func (m *MockShowRSVPService) GetUserRSVPs(userID uint, limit int, offset int) ([]*contracts.ShowRSVPResponse, int64, error) {
+	if m.GetUserRSVPsFn != nil {
+		return m.GetUserRSVPsFn(userID, limit, offset)
+	}
+	return nil, 0, nil
+}
+func (m *MockShowRSVPService) IsGoing(userID uint, showID uint) (bool, error) {
+	if m.IsGoingFn != nil {
+		return m.IsGoingFn(userID, showID)
+	}
+	return false, nil
+}
+func (m *MockShowRSVPService) GetGoingCount(showID uint) (int, error) {
+	if m.GetGoingCountFn != nil {
+		return m.GetGoingCountFn(showID)
+	}
+	return 0, nil
+}
+func (m *MockShowRSVPService) GetBatchGoingCounts(showIDs []uint) (map[uint]int, error) {
+	if m.GetBatchGoingCountsFn != nil {
+		return m.GetBatchGoingCountsFn(showIDs)
+	}
+	return nil, nil
+}
+func (m *MockShowRSVPService) GetPublicAttendees(showID uint, limit int, offset int) ([]*contracts.ShowAttendeeResponse, int64, error) {
+	if m.GetPublicAttendeesFn != nil {
+		return m.GetPublicAttendeesFn(showID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
 // ============================================================================
 // Mock: ShowReportServiceInterface
 // ============================================================================
@@ -3572,6 +4494,56 @@ func (m *MockShowReportService) GetReportByID(reportID uint) (*communitym.ShowRe
 	return nil, nil
 }
 
+// ============================================================================
+// Mock: ShowSeriesServiceInterface
+// ============================================================================
+
+type MockShowSeriesService struct {
+	CreateSeriesFn                func(*contracts.CreateShowSeriesRequest) (*contracts.ShowSeriesResponse, error)
+	GetSeriesFn                   func(uint) (*contracts.ShowSeriesResponse, error)
+	UpdateSeriesFn                func(uint, *contracts.UpdateShowSeriesRequest) (*contracts.ShowSeriesResponse, error)
+	RegenerateFutureOccurrencesFn func(uint) (*contracts.ShowSeriesResponse, error)
+	DetachOccurrenceFn            func(uint, uint) (*contracts.ShowSeriesOccurrence, error)
+	DeleteSeriesFn                func(uint) error
+}
+
+func (m *MockShowSeriesService) CreateSeries(req *contracts.CreateShowSeriesRequest) (*contracts.ShowSeriesResponse, error) {
+	if m.CreateSeriesFn != nil {
+		return m.CreateSeriesFn(req)
+	}
+	return nil, nil
+}
+func (m *MockShowSeriesService) GetSeries(seriesID uint) (*contracts.ShowSeriesResponse, error) {
+	if m.GetSeriesFn != nil {
+		return m.GetSeriesFn(seriesID)
+	}
+	return nil, nil
+}
+func (m *MockShowSeriesService) UpdateSeries(seriesID uint, req *contracts.UpdateShowSeriesRequest) (*contracts.ShowSeriesResponse, error) {
+	if m.UpdateSeriesFn != nil {
+		return m.UpdateSeriesFn(seriesID, req)
+	}
+	return nil, nil
+}
+func (m *MockShowSeriesService) RegenerateFutureOccurrences(seriesID uint) (*contracts.ShowSeriesResponse, error) {
+	if m.RegenerateFutureOccurrencesFn != nil {
+		return m.RegenerateFutureOccurrencesFn(seriesID)
+	}
+	return nil, nil
+}
+func (m *MockShowSeriesService) DetachOccurrence(seriesID uint, showID uint) (*contracts.ShowSeriesOccurrence, error) {
+	if m.DetachOccurrenceFn != nil {
+		return m.DetachOccurrenceFn(seriesID, showID)
+	}
+	return nil, nil
+}
+func (m *MockShowSeriesService) DeleteSeries(seriesID uint) error {
+	if m.DeleteSeriesFn != nil {
+		return m.DeleteSeriesFn(seriesID)
+	}
+	return nil
+}
+
 // ============================================================================
 // Mock: ShowServiceInterface
 // ============================================================================
@@ -3587,7 +4559,9 @@ type MockShowService struct {
 	GetUpcomingShowsFn        func(string, string, int, bool, *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error)
 	GetShowCitiesFn           func(string) ([]contracts.ShowCityResponse, error)
 	DeleteShowFn              func(uint) error
+	SetShowLineupFn           func(uint, []contracts.ShowLineupEntry) (*contracts.ShowResponse, error)
 	SearchShowsFn             func(string) ([]*contracts.ShowSearchResult, error)
+	GetShowArchiveFn          func(int, int, string, int, int) (*contracts.ShowArchive, error)
 }
 
 func (m *MockShowService) CreateShow(req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
@@ -3650,12 +4624,46 @@ func (m *MockShowService) DeleteShow(showID uint) error {
 	}
 	return nil
 }
+func (m *MockShowService) SetShowLineup(showID uint, lineup []contracts.ShowLineupEntry) (*contracts.ShowResponse, error) {
+	if m.SetShowLineupFn != nil {
+		return m.SetShowLineupFn(showID, lineup)
+	}
+	return nil, nil
+}
 func (m *MockShowService) SearchShows(query string) ([]*contracts.ShowSearchResult, error) {
 	if m.SearchShowsFn != nil {
 		return m.SearchShowsFn(query)
 	}
 	return nil, nil
 }
+func (m *MockShowService) GetShowArchive(year int, month int, timezone string, limit int, offset int) (*contracts.ShowArchive, error) {
+	if m.GetShowArchiveFn != nil {
+		return m.GetShowArchiveFn(year, month, timezone, limit, offset)
+	}
+	return nil, nil
+}
+
+// ============================================================================
+// Mock: ShowShortLinkServiceInterface
+// ============================================================================
+
+type MockShowShortLinkService struct {
+	ResolveShowShortCodeFn     func(string) (*contracts.ShowShortLinkTarget, error)
+	RecordShowShortLinkClickFn func(uint, string) error
+}
+
+func (m *MockShowShortLinkService) ResolveShowShortCode(code string) (*contracts.ShowShortLinkTarget, error) {
+	if m.ResolveShowShortCodeFn != nil {
+		return m.ResolveShowShortCodeFn(code)
+	}
+	return nil, nil
+}
+func (m *MockShowShortLinkService) RecordShowShortLinkClick(showID uint, referrer string) error {
+	if m.RecordShowShortLinkClickFn != nil {
+		return m.RecordShowShortLinkClickFn(showID, referrer)
+	}
+	return nil
+}
 
 // ============================================================================
 // Mock: ShowStateServiceInterface
@@ -3667,6 +4675,8 @@ type MockShowStateService struct {
 	PublishShowFn      func(uint, uint, bool) (*contracts.ShowResponse, error)
 	SetShowSoldOutFn   func(uint, bool) (*contracts.ShowResponse, error)
 	SetShowCancelledFn func(uint, bool) (*contracts.ShowResponse, error)
+	CancelShowFn       func(uint, string) (*contracts.ShowResponse, error)
+	PostponeShowFn     func(uint, string, *time.Time) (*contracts.ShowResponse, error)
 }
 
 func (m *MockShowStateService) UnpublishShow(showID uint, userID uint, isAdmin bool) (*contracts.ShowResponse, error) {
@@ -3699,6 +4709,69 @@ func (m *MockShowStateService) SetShowCancelled(showID uint, isCancelled bool) (
 	}
 	return nil, nil
 }
+func (m *MockShowStateService) CancelShow(showID uint, reason string) (*contracts.ShowResponse, error) {
+	if m.CancelShowFn != nil {
+		return m.CancelShowFn(showID, reason)
+	}
+	return nil, nil
+}
+func (m *MockShowStateService) PostponeShow(showID uint, reason string, newEventDate *time.Time) (*contracts.ShowResponse, error) {
+	if m.PostponeShowFn != nil {
+		return m.PostponeShowFn(showID, reason, newEventDate)
+	}
+	return nil, nil
+}
+
+// ============================================================================
+// Mock: ShowUpdateServiceInterface
+// ============================================================================
+
+type MockShowUpdateService struct {
+	CreateUpdateFn func(uint, uint, string) (*contracts.ShowUpdateResponse, error)
+	ListUpdatesFn  func(uint) ([]contracts.ShowUpdateResponse, error)
+	GetUpdateFn    func(uint) (*contracts.ShowUpdateResponse, error)
+	DeleteUpdateFn func(uint) error
+}
+
+func (m *MockShowUpdateService) CreateUpdate(showID uint, userID uint, body string) (*contracts.ShowUpdateResponse, error) {
+	if m.CreateUpdateFn != nil {
+		return m.CreateUpdateFn(showID, userID, body)
+	}
+	return nil, nil
+}
+func (m *MockShowUpdateService) ListUpdates(showID uint) ([]contracts.ShowUpdateResponse, error) {
+	if m.ListUpdatesFn != nil {
+		return m.ListUpdatesFn(showID)
+	}
+	return nil, nil
+}
+func (m *MockShowUpdateService) GetUpdate(updateID uint) (*contracts.ShowUpdateResponse, error) {
+	if m.GetUpdateFn != nil {
+		return m.GetUpdateFn(updateID)
+	}
+	return nil, nil
+}
+func (m *MockShowUpdateService) DeleteUpdate(updateID uint) error {
+	if m.DeleteUpdateFn != nil {
+		return m.DeleteUpdateFn(updateID)
+	}
+	return nil
+}
+
+// ============================================================================
+// Mock: SitemapServiceInterface
+// ============================================================================
+
+type MockSitemapService struct {
+	GetSitemapFn func(int) ([]byte, bool, error)
+}
+
+func (m *MockSitemapService) GetSitemap(page int) ([]byte, bool, error) {
+	if m.GetSitemapFn != nil {
+		return m.GetSitemapFn(page)
+	}
+	return nil, false, nil
+}
 
 // ============================================================================
 // Mock: StreamingWorklistServiceInterface
@@ -3722,6 +4795,71 @@ func (m *MockStreamingWorklistService) UpdateStreamingDiscoveryStatus(input cont
 	return nil, nil
 }
 
+// ============================================================================
+// Mock: SubmissionThrottleServiceInterface
+// ============================================================================
+
+type MockSubmissionThrottleService struct {
+	CheckSubmissionFn      func(*authm.User) (*contracts.SubmissionDecision, error)
+	QuarantineUserFn       func(uint, string) error
+	ReleaseUserFn          func(uint) error
+	ListQuarantinedUsersFn func() ([]contracts.QuarantinedUser, error)
+}
+
+func (m *MockSubmissionThrottleService) CheckSubmission(user *authm.User) (*contracts.SubmissionDecision, error) {
+	if m.CheckSubmissionFn != nil {
+		return m.CheckSubmissionFn(user)
+	}
+	return nil, nil
+}
+func (m *MockSubmissionThrottleService) QuarantineUser(userID uint, reason string) error {
+	if m.QuarantineUserFn != nil {
+		return m.QuarantineUserFn(userID, reason)
+	}
+	return nil
+}
+func (m *MockSubmissionThrottleService) ReleaseUser(userID uint) error {
+	if m.ReleaseUserFn != nil {
+		return m.ReleaseUserFn(userID)
+	}
+	return nil
+}
+func (m *MockSubmissionThrottleService) ListQuarantinedUsers() ([]contracts.QuarantinedUser, error) {
+	if m.ListQuarantinedUsersFn != nil {
+		return m.ListQuarantinedUsersFn()
+	}
+	return nil, nil
+}
+
+// ============================================================================
+// Mock: SyncServiceInterface
+// ============================================================================
+
+type MockSyncService struct {
+	GetShowChangesFn   func(string, int, []contracts.CityStateFilter) (*contracts.SyncShowDelta, error)
+	GetVenueChangesFn  func(string, int, []contracts.CityStateFilter) (*contracts.SyncVenueDelta, error)
+	GetArtistChangesFn func(string, int, []contracts.CityStateFilter) (*contracts.SyncArtistDelta, error)
+}
+
+func (m *MockSyncService) GetShowChanges(cursor string, limit int, market []contracts.CityStateFilter) (*contracts.SyncShowDelta, error) {
+	if m.GetShowChangesFn != nil {
+		return m.GetShowChangesFn(cursor, limit, market)
+	}
+	return nil, nil
+}
+func (m *MockSyncService) GetVenueChanges(cursor string, limit int, market []contracts.CityStateFilter) (*contracts.SyncVenueDelta, error) {
+	if m.GetVenueChangesFn != nil {
+		return m.GetVenueChangesFn(cursor, limit, market)
+	}
+	return nil, nil
+}
+func (m *MockSyncService) GetArtistChanges(cursor string, limit int, market []contracts.CityStateFilter) (*contracts.SyncArtistDelta, error) {
+	if m.GetArtistChangesFn != nil {
+		return m.GetArtistChangesFn(cursor, limit, market)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: TagServiceInterface
 // ============================================================================
@@ -3960,6 +5098,7 @@ func (m *MockTagService) PruneDownvotedTags() (int64, error) {
 
 type MockUserService struct {
 	ListUsersFn                       func(int, int, contracts.AdminUserFilters) ([]*contracts.AdminUserResponse, int64, error)
+	SetUserRoleFn                     func(uint, uint, authm.Role) (authm.Role, error)
 	FindOrCreateUserFn                func(goth.User, string) (*authm.User, error)
 	FindOrCreateUserWithConsentFn     func(goth.User, string, *contracts.OAuthSignupConsent) (*authm.User, error)
 	AuthenticateUserWithPasswordFn    func(string, string) (*authm.User, error)
@@ -3991,6 +5130,7 @@ type MockUserService struct {
 	PermanentlyDeleteUserFn           func(uint) error
 	CanUnlinkOAuthAccountFn           func(uint, string) (bool, string, error)
 	UnlinkOAuthAccountFn              func(uint, string) error
+	LinkOAuthAccountToUserFn          func(uint, goth.User, string) (*authm.User, error)
 	GetFavoriteCitiesFn               func(uint) ([]authm.FavoriteCity, error)
 	SetFavoriteCitiesFn               func(uint, []authm.FavoriteCity) error
 	SetChartDefaultsFn                func(uint, *authm.ChartDefaults) error
@@ -4010,6 +5150,12 @@ func (m *MockUserService) ListUsers(limit int, offset int, filters contracts.Adm
 	}
 	return nil, 0, nil
 }
+func (m *MockUserService) SetUserRole(actorID uint, userID uint, role authm.Role) (authm.Role, error) {
+	if m.SetUserRoleFn != nil {
+		return m.SetUserRoleFn(actorID, userID, role)
+	}
+	return "", nil
+}
 func (m *MockUserService) FindOrCreateUser(gothUser goth.User, provider string) (*authm.User, error) {
 	if m.FindOrCreateUserFn != nil {
 		return m.FindOrCreateUserFn(gothUser, provider)
@@ -4196,6 +5342,12 @@ func (m *MockUserService) UnlinkOAuthAccount(userID uint, provider string) error
 	}
 	return nil
 }
+func (m *MockUserService) LinkOAuthAccountToUser(userID uint, gothUser goth.User, provider string) (*authm.User, error) {
+	if m.LinkOAuthAccountToUserFn != nil {
+		return m.LinkOAuthAccountToUserFn(userID, gothUser, provider)
+	}
+	return nil, nil
+}
 func (m *MockUserService) GetFavoriteCities(userID uint) ([]authm.FavoriteCity, error) {
 	if m.GetFavoriteCitiesFn != nil {
 		return m.GetFavoriteCitiesFn(userID)
@@ -4263,6 +5415,134 @@ func (m *MockUserService) SetNotifyOnEditNotifications(userID uint, enabled bool
 	return nil
 }
 
+// ============================================================================
+// Mock: VenueClaimServiceInterface
+// ============================================================================
+
+type MockVenueClaimService struct {
+	SubmitClaimFn        func(uint, uint, string) (*contracts.VenueClaimResponse, error)
+	GetUserClaimsFn      func(uint) ([]*contracts.VenueClaimResponse, error)
+	ListClaimsFn         func(string, int, int) ([]*contracts.VenueClaimResponse, int64, error)
+	ApproveClaimFn       func(uint, uint, string) (*contracts.VenueClaimResponse, error)
+	DenyClaimFn          func(uint, uint, string) (*contracts.VenueClaimResponse, error)
+	IsVenueManagerFn     func(uint, uint) (bool, error)
+	IsShowVenueManagerFn func(uint, uint) (bool, error)
+	GetManagedVenuesFn   func(uint) ([]*contracts.VenueManagerResponse, error)
+	ListVenueManagersFn  func(uint) ([]*contracts.VenueManagerResponse, error)
+	RemoveVenueManagerFn func(uint, uint) error
+}
+
+func (m *MockVenueClaimService) SubmitClaim(venueID uint, userID uint, evidence string) (*contracts.VenueClaimResponse, error) {
+	if m.SubmitClaimFn != nil {
+		return m.SubmitClaimFn(venueID, userID, evidence)
+	}
+	return nil, nil
+}
+func (m *MockVenueClaimService) GetUserClaims(userID uint) ([]*contracts.VenueClaimResponse, error) {
+	if m.GetUserClaimsFn != nil {
+		return m.GetUserClaimsFn(userID)
+	}
+	return nil, nil
+}
+func (m *MockVenueClaimService) ListClaims(status string, limit int, offset int) ([]*contracts.VenueClaimResponse, int64, error) {
+	if m.ListClaimsFn != nil {
+		return m.ListClaimsFn(status, limit, offset)
+	}
+	return nil, 0, nil
+}
+func (m *MockVenueClaimService) ApproveClaim(claimID uint, reviewerID uint, note string) (*contracts.VenueClaimResponse, error) {
+	if m.ApproveClaimFn != nil {
+		return m.ApproveClaimFn(claimID, reviewerID, note)
+	}
+	return nil, nil
+}
+func (m *MockVenueClaimService) DenyClaim(claimID uint, reviewerID uint, reason string) (*contracts.VenueClaimResponse, error) {
+	if m.DenyClaimFn != nil {
+		return m.DenyClaimFn(claimID, reviewerID, reason)
+	}
+	return nil, nil
+}
+func (m *MockVenueClaimService) IsVenueManager(userID uint, venueID uint) (bool, error) {
+	if m.IsVenueManagerFn != nil {
+		return m.IsVenueManagerFn(userID, venueID)
+	}
+	return false, nil
+}
+func (m *MockVenueClaimService) IsShowVenueManager(userID uint, showID uint) (bool, error) {
+	if m.IsShowVenueManagerFn != nil {
+		return m.IsShowVenueManagerFn(userID, showID)
+	}
+	return false, nil
+}
+func (m *MockVenueClaimService) GetManagedVenues(userID uint) ([]*contracts.VenueManagerResponse, error) {
+	if m.GetManagedVenuesFn != nil {
+		return m.GetManagedVenuesFn(userID)
+	}
+	return nil, nil
+}
+func (m *MockVenueClaimService) ListVenueManagers(venueID uint) ([]*contracts.VenueManagerResponse, error) {
+	if m.ListVenueManagersFn != nil {
+		return m.ListVenueManagersFn(venueID)
+	}
+	return nil, nil
+}
+func (m *MockVenueClaimService) RemoveVenueManager(venueID uint, userID uint) error {
+	if m.RemoveVenueManagerFn != nil {
+		return m.RemoveVenueManagerFn(venueID, userID)
+	}
+	return nil
+}
+
+// ============================================================================
+// Mock: VenueReportServiceInterface
+// ============================================================================
+
+type MockVenueReportService struct {
+	CreateReportFn          func(uint, uint, string, *string) (*contracts.VenueReportResponse, error)
+	GetUserReportForVenueFn func(uint, uint) (*contracts.VenueReportResponse, error)
+	GetPendingReportsFn     func(int, int) ([]*contracts.VenueReportResponse, int64, error)
+	DismissReportFn         func(uint, uint, *string) (*contracts.VenueReportResponse, error)
+	ResolveReportFn         func(uint, uint, *string) (*contracts.VenueReportResponse, error)
+	GetReportByIDFn         func(uint) (*communitym.VenueReport, error)
+}
+
+func (m *MockVenueReportService) CreateReport(userID uint, venueID uint, reportType string, details *string) (*contracts.VenueReportResponse, error) {
+	if m.CreateReportFn != nil {
+		return m.CreateReportFn(userID, venueID, reportType, details)
+	}
+	return nil, nil
+}
+func (m *MockVenueReportService) GetUserReportForVenue(userID uint, venueID uint) (*contracts.VenueReportResponse, error) {
+	if m.GetUserReportForVenueFn != nil {
+		return m.GetUserReportForVenueFn(userID, venueID)
+	}
+	return nil, nil
+}
+func (m *MockVenueReportService) GetPendingReports(limit int, offset int) ([]*contracts.VenueReportResponse, int64, error) {
+	if m.GetPendingReportsFn != nil {
+		return m.GetPendingReportsFn(limit, offset)
+	}
+	return nil, 0, nil
+}
+func (m *MockVenueReportService) DismissReport(reportID uint, adminID uint, notes *string) (*contracts.VenueReportResponse, error) {
+	if m.DismissReportFn != nil {
+		return m.DismissReportFn(reportID, adminID, notes)
+	}
+	return nil, nil
+}
+func (m *MockVenueReportService) ResolveReport(reportID uint, adminID uint, notes *string) (*contracts.VenueReportResponse, error) {
+	if m.ResolveReportFn != nil {
+		return m.ResolveReportFn(reportID, adminID, notes)
+	}
+	return nil, nil
+}
+func (m *MockVenueReportService) GetReportByID(reportID uint) (*communitym.VenueReport, error) {
+	if m.GetReportByIDFn != nil {
+		return m.GetReportByIDFn(reportID)
+	}
+	return nil, nil
+}
+
 // ============================================================================
 // Mock: VenueServiceInterface
 // ============================================================================
@@ -4280,11 +5560,15 @@ type MockVenueService struct {
 	GetVenuesWithShowCountsFn  func(contracts.VenueListFilters, int, int) ([]*contracts.VenueWithShowCountResponse, int64, error)
 	GetUpcomingShowsForVenueFn func(uint, string, int) ([]*contracts.VenueShowResponse, int64, error)
 	GetShowsForVenueFn         func(uint, string, int, string) ([]*contracts.VenueShowResponse, int64, error)
+	GetShowsForVenuePageFn     func(uint, string, int, int, string, int) ([]*contracts.VenueShowResponse, int64, error)
+	GetShowYearsForVenueFn     func(uint, string, string) ([]*contracts.ShowYearCount, error)
+	GetVenueWidgetFn           func(uint, int) (*contracts.VenueWidgetResponse, error)
 	GetVenueCitiesFn           func() ([]*contracts.VenueCityResponse, error)
 	GetVenueModelFn            func(uint) (*catalogm.Venue, error)
 	GetUnverifiedVenuesFn      func(int, int) ([]*contracts.UnverifiedVenueResponse, int64, error)
 	GetVenueGenreProfileFn     func(uint) ([]contracts.GenreCount, error)
 	GetVenueBillNetworkFn      func(uint, string, *int) (*contracts.VenueBillNetworkResponse, error)
+	MergeVenuesFn              func(uint, uint) (*contracts.MergeVenueResult, error)
 }
 
 func (m *MockVenueService) CreateVenue(req *contracts.CreateVenueRequest, isAdmin bool) (*contracts.VenueDetailResponse, error) {
@@ -4359,6 +5643,24 @@ func (m *MockVenueService) GetShowsForVenue(venueID uint, timezone string, limit
 	}
 	return nil, 0, nil
 }
+func (m *MockVenueService) GetShowsForVenuePage(venueID uint, timezone string, limit int, offset int, timeFilter string, year int) ([]*contracts.VenueShowResponse, int64, error) {
+	if m.GetShowsForVenuePageFn != nil {
+		return m.GetShowsForVenuePageFn(venueID, timezone, limit, offset, timeFilter, year)
+	}
+	return nil, 0, nil
+}
+func (m *MockVenueService) GetShowYearsForVenue(venueID uint, timezone string, timeFilter string) ([]*contracts.ShowYearCount, error) {
+	if m.GetShowYearsForVenueFn != nil {
+		return m.GetShowYearsForVenueFn(venueID, timezone, timeFilter)
+	}
+	return nil, nil
+}
+func (m *MockVenueService) GetVenueWidget(venueID uint, count int) (*contracts.VenueWidgetResponse, error) {
+	if m.GetVenueWidgetFn != nil {
+		return m.GetVenueWidgetFn(venueID, count)
+	}
+	return nil, nil
+}
 func (m *MockVenueService) GetVenueCities() ([]*contracts.VenueCityResponse, error) {
 	if m.GetVenueCitiesFn != nil {
 		return m.GetVenueCitiesFn()
@@ -4389,6 +5691,27 @@ func (m *MockVenueService) GetVenueBillNetwork(venueID uint, window string, year
 	}
 	return nil, nil
 }
+func (m *MockVenueService) MergeVenues(canonicalID uint, mergeFromID uint) (*contracts.MergeVenueResult, error) {
+	if m.MergeVenuesFn != nil {
+		return m.MergeVenuesFn(canonicalID, mergeFromID)
+	}
+	return nil, nil
+}
+
+// ============================================================================
+// Mock: VisibilityDebugServiceInterface
+// ============================================================================
+
+type MockVisibilityDebugService struct {
+	ExplainShowVisibilityFn func(*uint, uint) (*contracts.VisibilityExplanation, error)
+}
+
+func (m *MockVisibilityDebugService) ExplainShowVisibility(userID *uint, showID uint) (*contracts.VisibilityExplanation, error) {
+	if m.ExplainShowVisibilityFn != nil {
+		return m.ExplainShowVisibilityFn(userID, showID)
+	}
+	return nil, nil
+}
 
 // ============================================================================
 // Mock: WebAuthnServiceInterface
@@ -4528,9 +5851,13 @@ func (m *MockWebAuthnService) FinishSignupRegistrationWithLegal(email string, se
 // Compile-time interface satisfaction checks
 // ============================================================================
 
+var _ contracts.APIKeyServiceInterface = (*MockAPIKeyService)(nil)
 var _ contracts.APITokenServiceInterface = (*MockAPITokenService)(nil)
+var _ contracts.AdminAnnotationServiceInterface = (*MockAdminAnnotationService)(nil)
 var _ contracts.AdminStatsServiceInterface = (*MockAdminStatsService)(nil)
 var _ contracts.AnalyticsServiceInterface = (*MockAnalyticsService)(nil)
+var _ contracts.AnnouncementServiceInterface = (*MockAnnouncementService)(nil)
+var _ contracts.ArtistClaimServiceInterface = (*MockArtistClaimService)(nil)
 var _ contracts.ArtistRelationshipServiceInterface = (*MockArtistRelationshipService)(nil)
 var _ contracts.ArtistReportServiceInterface = (*MockArtistReportService)(nil)
 var _ contracts.ArtistServiceInterface = (*MockArtistService)(nil)
@@ -4538,6 +5865,7 @@ var _ contracts.AuditLogServiceInterface = (*MockAuditLogService)(nil)
 var _ contracts.AuthServiceInterface = (*MockAuthService)(nil)
 var _ contracts.AutoPromotionServiceInterface = (*MockAutoPromotionService)(nil)
 var _ contracts.BandcampProfileFillerInterface = (*MockBandcampProfileFiller)(nil)
+var _ contracts.CalendarImportServiceInterface = (*MockCalendarImportService)(nil)
 var _ contracts.CalendarServiceInterface = (*MockCalendarService)(nil)
 var _ contracts.ChartsServiceInterface = (*MockChartsService)(nil)
 var _ contracts.CollectionServiceInterface = (*MockCollectionService)(nil)
@@ -4548,29 +5876,41 @@ var _ contracts.CommentVoteServiceInterface = (*MockCommentVoteService)(nil)
 var _ contracts.ContributorProfileServiceInterface = (*MockContributorProfileService)(nil)
 var _ contracts.DataQualityServiceInterface = (*MockDataQualityService)(nil)
 var _ contracts.DataSyncServiceInterface = (*MockDataSyncService)(nil)
+var _ contracts.DeletionReceiptServiceInterface = (*MockDeletionReceiptService)(nil)
 var _ contracts.DiscordServiceInterface = (*MockDiscordService)(nil)
 var _ contracts.DiscoverMusicServiceInterface = (*MockDiscoverMusicService)(nil)
 var _ contracts.DiscoveryServiceInterface = (*MockDiscoveryService)(nil)
+var _ contracts.EmailPreviewServiceInterface = (*MockEmailPreviewService)(nil)
 var _ contracts.EmailServiceInterface = (*MockEmailService)(nil)
+var _ contracts.EmailSuppressionServiceInterface = (*MockEmailSuppressionService)(nil)
 var _ contracts.EnrichmentServiceInterface = (*MockEnrichmentService)(nil)
+var _ contracts.EntityAliasServiceInterface = (*MockEntityAliasService)(nil)
 var _ contracts.EntityReportServiceInterface = (*MockEntityReportService)(nil)
 var _ contracts.EntityRequestFulfillerInterface = (*MockEntityRequestFulfiller)(nil)
 var _ contracts.EntityRequestServiceInterface = (*MockEntityRequestService)(nil)
 var _ contracts.ExploreServiceInterface = (*MockExploreService)(nil)
 var _ contracts.ExtractionServiceInterface = (*MockExtractionService)(nil)
+var _ contracts.FeatureFlagServiceInterface = (*MockFeatureFlagService)(nil)
 var _ contracts.FestivalIntelligenceServiceInterface = (*MockFestivalIntelligenceService)(nil)
 var _ contracts.FestivalServiceInterface = (*MockFestivalService)(nil)
 var _ contracts.FieldNoteServiceInterface = (*MockFieldNoteService)(nil)
+var _ contracts.FlyerServiceInterface = (*MockFlyerService)(nil)
 var _ contracts.FollowServiceInterface = (*MockFollowService)(nil)
+var _ contracts.IdempotencyServiceInterface = (*MockIdempotencyService)(nil)
+var _ contracts.JWTKeyRotatorInterface = (*MockJWTKeyRotator)(nil)
 var _ contracts.JWTServiceInterface = (*MockJWTService)(nil)
 var _ contracts.LabelServiceInterface = (*MockLabelService)(nil)
 var _ contracts.LeaderboardServiceInterface = (*MockLeaderboardService)(nil)
 var _ contracts.LinkSuggestionServiceInterface = (*MockLinkSuggestionService)(nil)
+var _ contracts.NearbyShowsServiceInterface = (*MockNearbyShowsService)(nil)
 var _ contracts.NotificationFilterServiceInterface = (*MockNotificationFilterService)(nil)
+var _ contracts.NotificationPreferenceServiceInterface = (*MockNotificationPreferenceService)(nil)
 var _ contracts.PasswordValidatorInterface = (*MockPasswordValidator)(nil)
 var _ contracts.PendingEditServiceInterface = (*MockPendingEditService)(nil)
+var _ contracts.PushServiceInterface = (*MockPushService)(nil)
 var _ contracts.RadioPlayMatchSuggestionServiceInterface = (*MockRadioPlayMatchSuggestionService)(nil)
 var _ contracts.RadioServiceInterface = (*MockRadioService)(nil)
+var _ contracts.RecommendationServiceInterface = (*MockRecommendationService)(nil)
 var _ contracts.ReleaseServiceInterface = (*MockReleaseService)(nil)
 var _ contracts.RequestServiceInterface = (*MockRequestService)(nil)
 var _ contracts.RevisionServiceInterface = (*MockRevisionService)(nil)
@@ -4578,12 +5918,24 @@ var _ contracts.SavedReleaseServiceInterface = (*MockSavedReleaseService)(nil)
 var _ contracts.SavedShowServiceInterface = (*MockSavedShowService)(nil)
 var _ contracts.SceneServiceInterface = (*MockSceneService)(nil)
 var _ contracts.ShowAdminServiceInterface = (*MockShowAdminService)(nil)
+var _ contracts.ShowChangeNotifierInterface = (*MockShowChangeNotifier)(nil)
+var _ contracts.ShowDraftServiceInterface = (*MockShowDraftService)(nil)
 var _ contracts.ShowImportServiceInterface = (*MockShowImportService)(nil)
+var _ contracts.ShowRSVPServiceInterface = (*MockShowRSVPService)(nil)
 var _ contracts.ShowReportServiceInterface = (*MockShowReportService)(nil)
+var _ contracts.ShowSeriesServiceInterface = (*MockShowSeriesService)(nil)
 var _ contracts.ShowServiceInterface = (*MockShowService)(nil)
+var _ contracts.ShowShortLinkServiceInterface = (*MockShowShortLinkService)(nil)
 var _ contracts.ShowStateServiceInterface = (*MockShowStateService)(nil)
+var _ contracts.ShowUpdateServiceInterface = (*MockShowUpdateService)(nil)
+var _ contracts.SitemapServiceInterface = (*MockSitemapService)(nil)
 var _ contracts.StreamingWorklistServiceInterface = (*MockStreamingWorklistService)(nil)
+var _ contracts.SubmissionThrottleServiceInterface = (*MockSubmissionThrottleService)(nil)
+var _ contracts.SyncServiceInterface = (*MockSyncService)(nil)
 var _ contracts.TagServiceInterface = (*MockTagService)(nil)
 var _ contracts.UserServiceInterface = (*MockUserService)(nil)
+var _ contracts.VenueClaimServiceInterface = (*MockVenueClaimService)(nil)
+var _ contracts.VenueReportServiceInterface = (*MockVenueReportService)(nil)
 var _ contracts.VenueServiceInterface = (*MockVenueService)(nil)
+var _ contracts.VisibilityDebugServiceInterface = (*MockVisibilityDebugService)(nil)
 var _ contracts.WebAuthnServiceInterface = (*MockWebAuthnService)(nil)
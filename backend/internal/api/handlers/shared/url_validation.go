@@ -44,6 +44,7 @@ var urlFieldSpecs = map[string]urlFieldSpec{
 	"spotify":         {displayName: "Spotify URL", maxLength: 500},
 	"soundcloud":      {displayName: "SoundCloud URL", maxLength: 500},
 	"bandcamp":        {displayName: "Bandcamp URL", maxLength: 500},
+	"tiktok":          {displayName: "TikTok URL", maxLength: 255},
 	"website":         {displayName: "Website URL", maxLength: 500},
 }
 
@@ -81,37 +82,13 @@ func ValidateURLField(fieldName string, value *string) error {
 	return nil
 }
 
-// socialHostSuffixes anchors each platform social field to its known hosts, so
-// a hostile value (e.g. https://evil.test/artist/x in the spotify field, which
-// renders as a SocialLinks href / embed source) can't be stored (PSY-1113). A
-// field absent here (website) accepts any host. A host matches when it equals a
-// base or is a subdomain of it — covering open.spotify.com, <artist>.bandcamp.com,
-// m.facebook.com, music.youtube.com, www.*, etc.
-//
-// This is a broad HOST floor for the free-form social fields. The dedicated
-// embed endpoints use the stricter, path-aware isValidBandcampURL /
-// isValidSpotifyURL (catalog/artist.go) — change platform-host rules with both
-// in mind.
-//
-// Redirector / short-link hosts (fb.me, t.co, youtube-nocookie.com) are
-// intentionally excluded: they can't be statically verified to land on-platform,
-// which is the point of the anchor. `website` is the escape hatch for any host.
-var socialHostSuffixes = map[string][]string{
-	"instagram":  {"instagram.com"},
-	"facebook":   {"facebook.com", "fb.com"},
-	"twitter":    {"twitter.com", "x.com"},
-	"youtube":    {"youtube.com", "youtu.be"},
-	"spotify":    {"spotify.com"},
-	"soundcloud": {"soundcloud.com"},
-	"bandcamp":   {"bandcamp.com"},
-}
-
 // validateSocialHost rejects a social-platform URL whose host isn't on that
-// platform's allowlist. Fields not in socialHostSuffixes (website, image_url,
-// ...) are unrestricted. Assumes `value` already passed the scheme check, so a
-// parse failure here just means "skip" (the scheme check already rejected it).
+// platform's allowlist (utils.SocialHostSuffixes). Fields not listed there
+// (website, image_url, ...) are unrestricted. Assumes `value` already passed the
+// scheme check, so a parse failure here just means "skip" (the scheme check
+// already rejected it).
 func validateSocialHost(field, value string) error {
-	bases, restricted := socialHostSuffixes[field]
+	bases, restricted := utils.SocialHostSuffixes[field]
 	if !restricted || strings.TrimSpace(value) == "" {
 		return nil
 	}
@@ -143,10 +120,81 @@ func validateSocialHost(field, value string) error {
 // Length is enforced separately by the request struct's maxLength tag at
 // JSON decode time.
 func ValidateSocialURLs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, website *string) error {
-	pairs := [...]struct {
-		field string
-		value *string
-	}{
+	for _, p := range socialURLPairs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, website) {
+		if p.value == nil {
+			continue
+		}
+		if err := validateSocialURL(p.field, *p.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SocialURLs is ValidateSocialURLs for a Validator: every invalid social
+// URL is recorded against its own field instead of returning the first.
+func (v *Validator) SocialURLs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, website *string) {
+	for _, p := range socialURLPairs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, website) {
+		if p.value == nil {
+			continue
+		}
+		v.CheckErr(p.field, ValidationCodeInvalidFormat, validateSocialURL(p.field, *p.value))
+	}
+}
+
+// NormalizeSocialURLs canonicalizes the social link fields of an artist or
+// venue write in place with utils.NormalizeSocialLink: handles become profile
+// URLs, schemeless URLs get https://, tracking parameters are dropped, and
+// link shorteners and IP hosts are rejected on top of the scheme and host
+// rules ValidateSocialURLs applies. Callers run it instead of
+// ValidateSocialURLs. Returns the first failure as a 422; nil fields are
+// skipped and empty strings stay empty.
+func NormalizeSocialURLs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, tiktok, website *string) error {
+	for _, p := range socialLinkPairs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, tiktok, website) {
+		if err := normalizeSocialURL(p); err != nil {
+			return huma.Error422UnprocessableEntity(err.Error())
+		}
+	}
+	return nil
+}
+
+// NormalizeSocialURLs is NormalizeSocialURLs for a Validator: every invalid
+// link is recorded against its own field, and left as submitted.
+func (v *Validator) NormalizeSocialURLs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, tiktok, website *string) {
+	for _, p := range socialLinkPairs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, tiktok, website) {
+		v.CheckErr(p.field, ValidationCodeInvalidFormat, normalizeSocialURL(p))
+	}
+}
+
+// normalizeSocialURL rewrites p.value with its normalized form.
+func normalizeSocialURL(p socialURLPair) error {
+	if p.value == nil {
+		return nil
+	}
+	normalized, err := utils.NormalizeSocialLink(p.field, *p.value, urlFieldSpecs[p.field].displayName)
+	if err != nil {
+		return err
+	}
+	*p.value = normalized
+	return nil
+}
+
+func socialLinkPairs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, tiktok, website *string) [9]socialURLPair {
+	pairs := socialURLPairs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, website)
+	return [...]socialURLPair{
+		pairs[0], pairs[1], pairs[2], pairs[3], pairs[4], pairs[5], pairs[6],
+		{"tiktok", tiktok},
+		pairs[7],
+	}
+}
+
+type socialURLPair struct {
+	field string
+	value *string
+}
+
+func socialURLPairs(instagram, facebook, twitter, youtube, spotify, soundcloud, bandcamp, website *string) [8]socialURLPair {
+	return [...]socialURLPair{
 		{"instagram", instagram},
 		{"facebook", facebook},
 		{"twitter", twitter},
@@ -156,18 +204,14 @@ func ValidateSocialURLs(instagram, facebook, twitter, youtube, spotify, soundclo
 		{"bandcamp", bandcamp},
 		{"website", website},
 	}
-	for _, p := range pairs {
-		if p.value == nil {
-			continue
-		}
-		if err := validateScheme(*p.value, urlFieldSpecs[p.field].displayName); err != nil {
-			return err
-		}
-		if err := validateSocialHost(p.field, *p.value); err != nil {
-			return err
-		}
+}
+
+// validateSocialURL applies the scheme check, then the per-field host rule.
+func validateSocialURL(field, value string) error {
+	if err := validateScheme(value, urlFieldSpecs[field].displayName); err != nil {
+		return err
 	}
-	return nil
+	return validateSocialHost(field, value)
 }
 
 // ValidateFieldChangeValue applies URL validation to a single FieldChange
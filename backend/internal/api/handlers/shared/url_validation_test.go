@@ -165,6 +165,58 @@ func TestValidateFieldChangeValue_HostAnchorsSocialFields(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// NormalizeSocialURLs
+// ============================================================================
+
+func TestNormalizeSocialURLs_RewritesInPlace(t *testing.T) {
+	instagram, bandcamp, tiktok, website, empty := "@pilemusic", "pile", "pilemusic", "pile.band/", ""
+	err := NormalizeSocialURLs(&instagram, nil, nil, nil, nil, nil, &bandcamp, &tiktok, &website)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, c := range []struct{ got, want string }{
+		{instagram, "https://instagram.com/pilemusic"},
+		{bandcamp, "https://pile.bandcamp.com"},
+		{tiktok, "https://www.tiktok.com/@pilemusic"},
+		{website, "https://pile.band"},
+	} {
+		if c.got != c.want {
+			t.Errorf("got %q, want %q", c.got, c.want)
+		}
+	}
+	if err := NormalizeSocialURLs(nil, nil, nil, nil, nil, nil, nil, nil, &empty); err != nil || empty != "" {
+		t.Errorf("empty should stay empty and pass, got %q, %v", empty, err)
+	}
+}
+
+func TestNormalizeSocialURLs_RejectsWith422(t *testing.T) {
+	err := NormalizeSocialURLs(nil, nil, nil, nil, nil, nil, nil, PtrString("https://evil.test/@x"), nil)
+	testhelpers.AssertHumaError(t, err, 422)
+	if !strings.Contains(err.Error(), "TikTok URL") {
+		t.Errorf("expected the TikTok field to be named, got %q", err.Error())
+	}
+}
+
+func TestValidator_NormalizeSocialURLs(t *testing.T) {
+	var v Validator
+	twitter, website := "@snakeclan", "https://bit.ly/x"
+	v.NormalizeSocialURLs(PtrString("javascript:alert(1)"), nil, &twitter, nil, nil, nil, nil, nil, &website)
+	fields := make([]string, 0, 2)
+	for _, fe := range v.FieldErrors() {
+		fields = append(fields, fe.Field)
+	}
+	if got := strings.Join(fields, ","); got != "instagram,website" {
+		t.Errorf("expected instagram,website errors, got %q", got)
+	}
+	if twitter != "https://twitter.com/snakeclan" {
+		t.Errorf("valid fields are still normalized, got %q", twitter)
+	}
+	if website != "https://bit.ly/x" {
+		t.Errorf("an invalid field is left as submitted, got %q", website)
+	}
+}
+
 // ============================================================================
 // ValidateFieldChangeValue (PSY-549)
 // ============================================================================
@@ -0,0 +1,312 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// Field-level validation errors
+// =============================
+//
+// Every 422 carries `errors: [{field, code, message}]`, whether it came from
+// Huma's struct-tag validation (required, maxLength, pattern, ...) or from a
+// handler's own checks via Validator. Each entry also keeps Huma's
+// `location` / `value` keys so clients that read the old ErrorDetail shape
+// keep working. See error_codes.go for when a failure is 422 rather than 400.
+
+// Validation codes: the stable, machine-readable half of a FieldError.
+const (
+	ValidationCodeRequired      = "required"
+	ValidationCodeTooLong       = "too_long"
+	ValidationCodeTooShort      = "too_short"
+	ValidationCodeOutOfRange    = "out_of_range"
+	ValidationCodeInvalidFormat = "invalid_format"
+	ValidationCodeInvalidType   = "invalid_type"
+	ValidationCodeInvalidValue  = "invalid_value"
+	ValidationCodeTooMany       = "too_many"
+	ValidationCodeUnknownField  = "unknown_field"
+)
+
+// FieldError is one field-level validation failure. Field is the JSON path
+// within its location without the location prefix ("title",
+// "artists[0].name"); Location is the full Huma path ("body.title").
+type FieldError struct {
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Location string `json:"location,omitempty"`
+	Value    any    `json:"value,omitempty"`
+}
+
+// NewFieldError builds a FieldError for a request-body field.
+func NewFieldError(field, code, message string) *FieldError {
+	return &FieldError{Field: field, Code: code, Message: message, Location: "body." + field}
+}
+
+// WithValue attaches the offending value and returns e for chaining.
+func (e *FieldError) WithValue(value any) *FieldError {
+	e.Value = value
+	return e
+}
+
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// ErrorDetail implements huma.ErrorDetailer so a FieldError returned from a
+// Resolve method still reads as a Huma ErrorDetail.
+func (e *FieldError) ErrorDetail() *huma.ErrorDetail {
+	return &huma.ErrorDetail{Message: e.Message, Location: e.Location, Value: e.Value}
+}
+
+// As lets errors.As(err, &*huma.ErrorDetail) callers that predate FieldError
+// keep reading Resolve errors by location.
+func (e *FieldError) As(target any) bool {
+	if d, ok := target.(**huma.ErrorDetail); ok {
+		*d = e.ErrorDetail()
+		return true
+	}
+	return false
+}
+
+// ValidationError is the 422 body carrying FieldErrors. It unwraps to the
+// embedded *huma.ErrorModel, so errors.As(err, &*huma.ErrorModel) callers
+// (and testhelpers.AssertHumaError) treat it like any other Huma error.
+type ValidationError struct {
+	*huma.ErrorModel
+	Errors []*FieldError `json:"errors,omitempty"`
+}
+
+// Unwrap exposes the embedded Huma error model.
+func (e *ValidationError) Unwrap() error {
+	return e.ErrorModel
+}
+
+// NewValidationError builds a 422 from fieldErrs. A single error's message
+// becomes the detail, so the top-level message stays as specific as the
+// free-text 422s this replaces.
+func NewValidationError(fieldErrs ...*FieldError) *ValidationError {
+	detail := "validation failed"
+	if len(fieldErrs) == 1 {
+		detail = fieldErrs[0].Message
+	}
+	details := make([]*huma.ErrorDetail, len(fieldErrs))
+	for i, fe := range fieldErrs {
+		details[i] = fe.ErrorDetail()
+	}
+	return &ValidationError{
+		ErrorModel: &huma.ErrorModel{
+			Status: http.StatusUnprocessableEntity,
+			Title:  http.StatusText(http.StatusUnprocessableEntity),
+			Detail: detail,
+			Errors: details,
+		},
+		Errors: fieldErrs,
+	}
+}
+
+// Validator collects field errors so a handler reports every invalid field
+// at once instead of returning on the first.
+//
+//	var v shared.Validator
+//	v.NotEmpty("name", req.Body.Name, "Venue name cannot be empty")
+//	v.MaxLength("description", req.Body.Description, 5000, "Description must be 5000 characters or fewer")
+//	if err := v.Err(); err != nil {
+//		return nil, err
+//	}
+type Validator struct {
+	errs []*FieldError
+}
+
+// Add records fe.
+func (v *Validator) Add(fe *FieldError) {
+	v.errs = append(v.errs, fe)
+}
+
+// Check records a body-field error unless ok.
+func (v *Validator) Check(ok bool, field, code, message string) {
+	if !ok {
+		v.Add(NewFieldError(field, code, message))
+	}
+}
+
+// CheckErr records err (typically an existing validate helper's huma 422)
+// against field; a nil err is a no-op.
+func (v *Validator) CheckErr(field, code string, err error) {
+	if err != nil {
+		v.Add(NewFieldError(field, code, err.Error()))
+	}
+}
+
+// Required records a required error when value is empty after trimming.
+func (v *Validator) Required(field, value, message string) {
+	v.Check(strings.TrimSpace(value) != "", field, ValidationCodeRequired, message)
+}
+
+// NotEmpty is Required for optional PATCH-style fields: nil means "not
+// being changed" and passes; a present value must not be empty.
+func (v *Validator) NotEmpty(field string, value *string, message string) {
+	if value != nil {
+		v.Required(field, *value, message)
+	}
+}
+
+// MaxLength records a too_long error when a present value exceeds max bytes.
+func (v *Validator) MaxLength(field string, value *string, max int, message string) {
+	if value != nil && len(*value) > max {
+		v.Add(NewFieldError(field, ValidationCodeTooLong, message).WithValue(len(*value)))
+	}
+}
+
+// Valid reports whether no errors were recorded.
+func (v *Validator) Valid() bool {
+	return len(v.errs) == 0
+}
+
+// FieldErrors returns the recorded errors.
+func (v *Validator) FieldErrors() []*FieldError {
+	return v.errs
+}
+
+// Errors returns the recorded errors as []error, the shape huma Resolve
+// methods return.
+func (v *Validator) Errors() []error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	out := make([]error, len(v.errs))
+	for i, fe := range v.errs {
+		out[i] = fe
+	}
+	return out
+}
+
+// Err returns the recorded errors as a 422 ValidationError, or nil.
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return NewValidationError(v.errs...)
+}
+
+// NewError replaces huma.NewError (installed by this package's init) so 422s
+// raised by Huma itself — struct-tag validation and Resolve methods — carry
+// FieldErrors too. Every other status keeps Huma's default model.
+func NewError(status int, msg string, errs ...error) huma.StatusError {
+	if status != http.StatusUnprocessableEntity || len(errs) == 0 {
+		return defaultNewError(status, msg, errs...)
+	}
+	fieldErrs := make([]*FieldError, 0, len(errs))
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		fieldErrs = append(fieldErrs, toFieldError(err))
+	}
+	ve := NewValidationError(fieldErrs...)
+	ve.Detail = msg
+	return ve
+}
+
+// defaultNewError is Huma's stock constructor, captured before NewError is
+// installed over it.
+var defaultNewError = huma.NewError
+
+// init installs NewError once, at process start, for every Huma API in the
+// process: the server's, separate humachi instances and the ones handler
+// tests build. Package variables are initialized before init runs, so
+// defaultNewError still holds Huma's constructor.
+func init() {
+	huma.NewError = NewError
+}
+
+// toFieldError converts a Huma validation error (or any error) to a
+// FieldError, deriving field from the location and code from Huma's
+// built-in message templates.
+func toFieldError(err error) *FieldError {
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		return fe
+	}
+	var detail *huma.ErrorDetail
+	if d, ok := err.(huma.ErrorDetailer); ok {
+		detail = d.ErrorDetail()
+	} else {
+		detail = &huma.ErrorDetail{Message: err.Error()}
+	}
+	return &FieldError{
+		Field:    fieldFromLocation(detail.Location, detail.Message),
+		Code:     codeFromHumaMessage(detail.Message),
+		Message:  detail.Message,
+		Location: detail.Location,
+		Value:    detail.Value,
+	}
+}
+
+// fieldFromLocation strips the location prefix ("body.", "query.", ...).
+// Huma reports a missing required property on its parent, so the property
+// name is lifted from the message.
+func fieldFromLocation(location, message string) string {
+	field := location
+	for _, prefix := range []string{"body", "query", "path", "header", "cookie"} {
+		if field == prefix {
+			field = ""
+			break
+		}
+		if strings.HasPrefix(field, prefix+".") {
+			field = strings.TrimPrefix(field, prefix+".")
+			break
+		}
+	}
+	var missing string
+	if _, err := fmt.Sscanf(message, "expected required property %s to be present", &missing); err == nil {
+		if field == "" {
+			return missing
+		}
+		return field + "." + missing
+	}
+	return field
+}
+
+// humaMessageCodes maps Huma's validation message prefixes
+// (huma/validation/messages.go) to validation codes. Longest-match order
+// matters only where one prefix extends another, so "array length" sits
+// ahead of "length".
+var humaMessageCodes = []struct {
+	prefix string
+	code   string
+}{
+	{"expected required property", ValidationCodeRequired},
+	{"expected property", ValidationCodeRequired},
+	{"expected array length <=", ValidationCodeTooMany},
+	{"expected array length >=", ValidationCodeTooShort},
+	{"expected length <=", ValidationCodeTooLong},
+	{"expected length >=", ValidationCodeTooShort},
+	{"expected number", ValidationCodeOutOfRange},
+	{"expected value to be one of", ValidationCodeInvalidValue},
+	{"expected string to", ValidationCodeInvalidFormat},
+	{"unexpected property", ValidationCodeUnknownField},
+	{"expected boolean", ValidationCodeInvalidType},
+	{"expected integer", ValidationCodeInvalidType},
+	{"expected string", ValidationCodeInvalidType},
+	{"expected array", ValidationCodeInvalidType},
+	{"expected object", ValidationCodeInvalidType},
+}
+
+func codeFromHumaMessage(message string) string {
+	for _, m := range humaMessageCodes {
+		if strings.HasPrefix(message, m.prefix) {
+			// "expected number >= 0" is a range; bare "expected number" is
+			// the wrong JSON type.
+			if m.code == ValidationCodeOutOfRange && message == "expected number" {
+				return ValidationCodeInvalidType
+			}
+			return m.code
+		}
+	}
+	return ValidationCodeInvalidValue
+}
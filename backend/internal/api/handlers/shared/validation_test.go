@@ -0,0 +1,201 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/api/handlers/shared/testhelpers"
+)
+
+// ============================================================================
+// Validator
+// ============================================================================
+
+func TestValidator_ValidHasNoError(t *testing.T) {
+	var v Validator
+	v.Required("name", "Valley Bar", "Name is required")
+	v.MaxLength("description", PtrString("short"), 10, "too long")
+	v.NotEmpty("city", nil, "City cannot be empty")
+	if !v.Valid() || v.Err() != nil || v.Errors() != nil {
+		t.Errorf("expected no errors, got: %v", v.FieldErrors())
+	}
+}
+
+func TestValidator_CollectsEveryFailure(t *testing.T) {
+	var v Validator
+	v.NotEmpty("name", PtrString("   "), "Venue name cannot be empty")
+	v.MaxLength("description", PtrString(strings.Repeat("x", 11)), 10, "Description must be 10 characters or fewer")
+	v.CheckErr("ticket_url", ValidationCodeInvalidFormat, errors.New("Ticket URL must use http or https"))
+	v.CheckErr("image_url", ValidationCodeInvalidFormat, nil)
+
+	err := v.Err()
+	testhelpers.AssertHumaError(t, err, http.StatusUnprocessableEntity)
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if ve.Detail != "validation failed" {
+		t.Errorf("expected generic detail for several errors, got %q", ve.Detail)
+	}
+	want := []struct{ field, code string }{
+		{"name", ValidationCodeRequired},
+		{"description", ValidationCodeTooLong},
+		{"ticket_url", ValidationCodeInvalidFormat},
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %d", len(want), len(ve.Errors))
+	}
+	for i, w := range want {
+		if ve.Errors[i].Field != w.field || ve.Errors[i].Code != w.code {
+			t.Errorf("error %d: expected %s/%s, got %s/%s", i, w.field, w.code, ve.Errors[i].Field, ve.Errors[i].Code)
+		}
+		if ve.Errors[i].Location != "body."+w.field {
+			t.Errorf("error %d: expected location body.%s, got %s", i, w.field, ve.Errors[i].Location)
+		}
+	}
+}
+
+func TestValidator_SingleErrorKeepsMessageAsDetail(t *testing.T) {
+	var v Validator
+	v.Check(false, "timezone", ValidationCodeInvalidValue, "Invalid timezone")
+
+	var model *huma.ErrorModel
+	if !errors.As(v.Err(), &model) {
+		t.Fatal("expected *huma.ErrorModel")
+	}
+	if model.Detail != "Invalid timezone" {
+		t.Errorf("expected the field message as detail, got %q", model.Detail)
+	}
+}
+
+func TestValidationError_JSONShape(t *testing.T) {
+	var v Validator
+	v.Check(false, "price", ValidationCodeOutOfRange, "Price must be between 0 and 10000")
+
+	raw, err := json.Marshal(v.Err())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var body struct {
+		Status int `json:"status"`
+		Errors []struct {
+			Field   string `json:"field"`
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if body.Status != http.StatusUnprocessableEntity {
+		t.Errorf("expected status 422, got %d", body.Status)
+	}
+	if len(body.Errors) != 1 || body.Errors[0].Field != "price" || body.Errors[0].Code != ValidationCodeOutOfRange {
+		t.Errorf("unexpected errors: %s", raw)
+	}
+}
+
+func TestValidator_SocialURLs(t *testing.T) {
+	var v Validator
+	v.SocialURLs(PtrString("javascript:alert(1)"), nil, nil, nil, nil, nil, nil, PtrString("ftp://example.com"))
+	fields := make([]string, 0, 2)
+	for _, fe := range v.FieldErrors() {
+		fields = append(fields, fe.Field)
+	}
+	if got := strings.Join(fields, ","); got != "instagram,website" {
+		t.Errorf("expected instagram,website errors, got %q", got)
+	}
+}
+
+func TestFieldError_AsErrorDetail(t *testing.T) {
+	var err error = NewFieldError("artists[0].name", ValidationCodeTooLong, "too long")
+	var detail *huma.ErrorDetail
+	if !errors.As(err, &detail) {
+		t.Fatal("expected FieldError to read as *huma.ErrorDetail")
+	}
+	if detail.Location != "body.artists[0].name" {
+		t.Errorf("unexpected location %q", detail.Location)
+	}
+}
+
+// ============================================================================
+// NewError (huma.NewError override)
+// ============================================================================
+
+func TestNewError_Non422UsesDefault(t *testing.T) {
+	err := NewError(http.StatusNotFound, "Show not found")
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		t.Error("404 must not become a ValidationError")
+	}
+	testhelpers.AssertHumaError(t, err, http.StatusNotFound)
+}
+
+func TestNewError_MapsHumaDetails(t *testing.T) {
+	err := NewError(http.StatusUnprocessableEntity, "validation failed",
+		&huma.ErrorDetail{Location: "body", Message: "expected required property title to be present"},
+		&huma.ErrorDetail{Location: "body.description", Message: "expected length <= 5000", Value: "x"},
+		&huma.ErrorDetail{Location: "query.limit", Message: "expected number <= 100"},
+		NewFieldError("artists", ValidationCodeTooMany, "A show may have at most 50 artists"),
+	)
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	want := []struct{ field, code string }{
+		{"title", ValidationCodeRequired},
+		{"description", ValidationCodeTooLong},
+		{"limit", ValidationCodeOutOfRange},
+		{"artists", ValidationCodeTooMany},
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %d", len(want), len(ve.Errors))
+	}
+	for i, w := range want {
+		if ve.Errors[i].Field != w.field || ve.Errors[i].Code != w.code {
+			t.Errorf("error %d: expected %s/%s, got %s/%s", i, w.field, w.code, ve.Errors[i].Field, ve.Errors[i].Code)
+		}
+	}
+	if len(ve.ErrorModel.Errors) != len(want) {
+		t.Errorf("expected Huma details to be kept, got %d", len(ve.ErrorModel.Errors))
+	}
+}
+
+func TestNewError_InstalledAtInit(t *testing.T) {
+	// No setup call: importing the package is enough for Huma's own 422s
+	// to carry field errors.
+	err := huma.Error422UnprocessableEntity("validation failed",
+		&huma.ErrorDetail{Location: "body.title", Message: "expected length >= 1"})
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected huma.NewError to build a *ValidationError, got %T", err)
+	}
+	if len(ve.Errors) != 1 || ve.Errors[0].Field != "title" {
+		t.Errorf("expected a title field error, got %+v", ve.Errors)
+	}
+}
+
+func TestCodeFromHumaMessage(t *testing.T) {
+	tests := map[string]string{
+		"expected number":                  ValidationCodeInvalidType,
+		"expected number >= 0":             ValidationCodeOutOfRange,
+		"expected array length <= 10":      ValidationCodeTooMany,
+		"expected length >= 1":             ValidationCodeTooShort,
+		"expected string to be RFC 5322 e": ValidationCodeInvalidFormat,
+		"unexpected property":              ValidationCodeUnknownField,
+		"something custom":                 ValidationCodeInvalidValue,
+	}
+	for msg, want := range tests {
+		if got := codeFromHumaMessage(msg); got != want {
+			t.Errorf("codeFromHumaMessage(%q) = %s, want %s", msg, got, want)
+		}
+	}
+}
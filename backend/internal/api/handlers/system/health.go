@@ -2,9 +2,11 @@ package system
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
 )
 
 // ComponentHealth represents the health status of a single component
@@ -84,3 +86,87 @@ func checkDatabaseHealth(ctx context.Context) ComponentHealth {
 		Latency: time.Since(start).String(),
 	}
 }
+
+// readinessCheckTimeout bounds each dependency check so a hung dependency
+// fails the probe instead of stalling it past the platform's own timeout.
+const readinessCheckTimeout = 2 * time.Second
+
+// LivenessResponse is the /healthz body.
+type LivenessResponse struct {
+	Body struct {
+		Status string `json:"status" example:"ok" doc:"Always ok while the process is serving requests"`
+	}
+}
+
+// LivenessHandler handles GET /healthz. It checks no dependencies: a slow or
+// unreachable database must not get a running process restarted, only taken
+// out of rotation by /readyz.
+func LivenessHandler(ctx context.Context, input *struct{}) (*LivenessResponse, error) {
+	resp := &LivenessResponse{}
+	resp.Body.Status = "ok"
+	return resp, nil
+}
+
+// ReadinessResponse is the /readyz response. Status is 503 when a critical
+// dependency is unhealthy, otherwise 200.
+type ReadinessResponse struct {
+	Status int
+	Body   struct {
+		Status     string                     `json:"status" example:"ready" doc:"Overall readiness: ready, degraded (a non-critical dependency is unhealthy), unavailable"`
+		Components map[string]ComponentHealth `json:"components" doc:"Status of each dependency: healthy, unhealthy or disabled (not configured)"`
+		Timestamp  string                     `json:"timestamp" example:"2024-01-15T10:30:00Z" doc:"Time of readiness check"`
+	}
+}
+
+// ProbeHandler serves the readiness probe, which needs the app config.
+type ProbeHandler struct {
+	cfg *config.Config
+}
+
+// NewProbeHandler creates a probe handler. cfg may be nil, in which case
+// config-only checks report their dependency as disabled.
+func NewProbeHandler(cfg *config.Config) *ProbeHandler {
+	return &ProbeHandler{cfg: cfg}
+}
+
+// ReadinessHandler handles GET /readyz: whether this instance can serve
+// traffic. The database is critical; when it is unhealthy the response is 503
+// so the platform routes around the instance. Email is checked from config
+// only (no call to the provider) and never fails readiness on its own.
+func (h *ProbeHandler) ReadinessHandler(ctx context.Context, input *struct{}) (*ReadinessResponse, error) {
+	resp := &ReadinessResponse{Status: http.StatusOK}
+	resp.Body.Components = make(map[string]ComponentHealth)
+	resp.Body.Timestamp = time.Now().UTC().Format(time.RFC3339)
+
+	dbCtx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+	dbHealth := checkDatabaseHealth(dbCtx)
+	resp.Body.Components["database"] = dbHealth
+
+	emailHealth := h.checkEmailConfig()
+	resp.Body.Components["email"] = emailHealth
+
+	switch {
+	case dbHealth.Status == "unhealthy":
+		resp.Status = http.StatusServiceUnavailable
+		resp.Body.Status = "unavailable"
+	case emailHealth.Status == "unhealthy":
+		resp.Body.Status = "degraded"
+	default:
+		resp.Body.Status = "ready"
+	}
+	return resp, nil
+}
+
+// checkEmailConfig reports the email provider as disabled when no Resend API
+// key is set (email is optional outside production) and unhealthy when a key
+// is set without a sender address.
+func (h *ProbeHandler) checkEmailConfig() ComponentHealth {
+	if h.cfg == nil || h.cfg.Email.ResendAPIKey == "" {
+		return ComponentHealth{Status: "disabled"}
+	}
+	if h.cfg.Email.FromEmail == "" {
+		return ComponentHealth{Status: "unhealthy", Error: "FROM_EMAIL not set"}
+	}
+	return ComponentHealth{Status: "healthy"}
+}
@@ -2,9 +2,11 @@ package system
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
 )
 
 // TestHealthHandler_DBNotInitialized exercises the no-DB branch with no
@@ -54,3 +56,61 @@ func TestCheckDatabaseHealth_NotInitialized(t *testing.T) {
 		t.Error("expected non-empty latency even on the failure path")
 	}
 }
+
+func TestLivenessHandler(t *testing.T) {
+	// Liveness never looks at dependencies, so a missing database is fine.
+	prev := db.DB
+	db.DB = nil
+	t.Cleanup(func() { db.DB = prev })
+
+	resp, err := LivenessHandler(context.Background(), &struct{}{})
+	if err != nil {
+		t.Fatalf("LivenessHandler returned error: %v", err)
+	}
+	if resp.Body.Status != "ok" {
+		t.Errorf("status = %q, want \"ok\"", resp.Body.Status)
+	}
+}
+
+// TestReadinessHandler_DBNotInitialized: the database is critical, so the
+// probe fails with 503 and reports each dependency.
+func TestReadinessHandler_DBNotInitialized(t *testing.T) {
+	prev := db.DB
+	db.DB = nil
+	t.Cleanup(func() { db.DB = prev })
+
+	resp, err := NewProbeHandler(nil).ReadinessHandler(context.Background(), &struct{}{})
+	if err != nil {
+		t.Fatalf("ReadinessHandler returned error: %v", err)
+	}
+	if resp.Status != http.StatusServiceUnavailable {
+		t.Errorf("HTTP status = %d, want 503", resp.Status)
+	}
+	if resp.Body.Status != "unavailable" {
+		t.Errorf("overall status = %q, want \"unavailable\"", resp.Body.Status)
+	}
+	if got := resp.Body.Components["database"].Status; got != "unhealthy" {
+		t.Errorf("database status = %q, want \"unhealthy\"", got)
+	}
+	if got := resp.Body.Components["email"].Status; got != "disabled" {
+		t.Errorf("email status = %q, want \"disabled\" with no config", got)
+	}
+}
+
+func TestCheckEmailConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		email config.EmailConfig
+		want  string
+	}{
+		{name: "no api key", email: config.EmailConfig{FromEmail: "noreply@example.com"}, want: "disabled"},
+		{name: "configured", email: config.EmailConfig{ResendAPIKey: "re_test", FromEmail: "noreply@example.com"}, want: "healthy"},
+		{name: "no sender", email: config.EmailConfig{ResendAPIKey: "re_test"}, want: "unhealthy"},
+	}
+	for _, tt := range tests {
+		h := NewProbeHandler(&config.Config{Email: tt.email})
+		if got := h.checkEmailConfig().Status; got != tt.want {
+			t.Errorf("%s: status = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package system
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	handler := MetricsHandler("scrape-token")
+
+	tests := []struct {
+		name   string
+		auth   string
+		status int
+	}{
+		{name: "no token", auth: "", status: http.StatusUnauthorized},
+		{name: "wrong token", auth: "Bearer nope", status: http.StatusUnauthorized},
+		{name: "not bearer", auth: "scrape-token", status: http.StatusUnauthorized},
+		{name: "valid token", auth: "Bearer scrape-token", status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code)
+			if tt.status == http.StatusOK {
+				assert.True(t, strings.Contains(rr.Body.String(), "go_goroutines"), "expected Prometheus exposition output")
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/respond"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// APIKeyHeader carries a public API key. Keys are deliberately not accepted
+// as Bearer tokens so they can't be mistaken for JWTs or admin API tokens.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyContextKey holds the resolved *authm.APIKey for the request
+const APIKeyContextKey contextKey = "api_key"
+
+// apiKeyScopeMetadataKey is the Operation.Metadata key naming the scope a
+// public API key needs to call the operation.
+const apiKeyScopeMetadataKey = "api_key_scope"
+
+// APIKeyScope is a Huma operation option that lets public API keys holding
+// scope call the operation. Operations without it reject API keys.
+//
+//	huma.Get(rc.API, "/shows", h.GetShowsHandler, middleware.APIKeyScope(authm.APIKeyScopeReadShows))
+func APIKeyScope(scope string) func(o *huma.Operation) {
+	return func(o *huma.Operation) {
+		if o.Metadata == nil {
+			o.Metadata = map[string]any{}
+		}
+		o.Metadata[apiKeyScopeMetadataKey] = scope
+	}
+}
+
+// HumaAPIKeyMiddleware resolves a public API key from the X-API-Key header.
+// Requests without the header pass through untouched. A presented key must
+// be valid, within its per-minute budget and hold the scope the operation
+// was tagged with; the key (with its owner) is then stored in the request
+// context. HumaJWTMiddleware falls back to the key's owner when the request
+// carries no JWT, so scoped keys can reach protected operations.
+func HumaAPIKeyMiddleware(apiKeyService contracts.APIKeyServiceInterface) func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		plainKey := ctx.Header(APIKeyHeader)
+		if plainKey == "" {
+			next(ctx)
+			return
+		}
+
+		var requestID string
+		if id, ok := ctx.Context().Value(logger.RequestIDContextKey).(string); ok {
+			requestID = id
+		}
+
+		var requiredScope string
+		if op := ctx.Operation(); op != nil {
+			requiredScope, _ = op.Metadata[apiKeyScopeMetadataKey].(string)
+		}
+		if requiredScope == "" {
+			writeAPIKeyError(ctx, requestID, http.StatusForbidden, apperrors.CodeAPIKeyScopeMissing,
+				"This endpoint does not accept API keys")
+			return
+		}
+
+		result, err := apiKeyService.AuthenticateKey(plainKey)
+		if err != nil {
+			var keyErr *apperrors.APIKeyError
+			if errors.As(err, &keyErr) {
+				logger.AuthWarn(ctx.Context(), "api_key_validation_failed",
+					"error", err.Error(),
+				)
+				writeAPIKeyError(ctx, requestID, http.StatusUnauthorized, keyErr.Code, keyErr.Message)
+				return
+			}
+			logger.FromContext(ctx.Context()).Error("api_key_validation_error",
+				"error", err.Error(),
+				"request_id", requestID,
+			)
+			writeAPIKeyError(ctx, requestID, http.StatusInternalServerError, apperrors.CodeAPIKeyUnauthorized,
+				"Failed to validate API key")
+			return
+		}
+
+		if !result.Allowed {
+			logger.AuthWarn(ctx.Context(), "api_key_rate_limited",
+				"api_key_id", result.Key.ID,
+			)
+			ctx.SetHeader("Retry-After", strconv.Itoa(result.RetryAfterSeconds))
+			writeAPIKeyError(ctx, requestID, http.StatusTooManyRequests, apperrors.CodeAPIKeyRateLimited,
+				"API key rate limit exceeded")
+			return
+		}
+
+		if !result.Key.HasScope(requiredScope) {
+			logger.AuthWarn(ctx.Context(), "api_key_scope_missing",
+				"api_key_id", result.Key.ID,
+				"required_scope", requiredScope,
+			)
+			writeAPIKeyError(ctx, requestID, http.StatusForbidden, apperrors.CodeAPIKeyScopeMissing,
+				"API key is missing the "+requiredScope+" scope")
+			return
+		}
+
+		logger.AuthDebug(ctx.Context(), "api_key_validation_success",
+			"api_key_id", result.Key.ID,
+			"user_id", result.Key.UserID,
+		)
+
+		next(huma.WithValue(ctx, APIKeyContextKey, result.Key))
+	}
+}
+
+// apiKeyUser is the user a public API key acts as: a copy of the key's
+// owner without staff access. Keys carry only their scopes, so an admin's
+// key gets neither admin submission handling nor the admin bypasses.
+func apiKeyUser(key *authm.APIKey) *authm.User {
+	user := key.User
+	user.IsAdmin = false
+	user.Role = authm.RoleUser
+	return &user
+}
+
+// GetAPIKeyFromContext returns the public API key the request was made
+// with, or nil if it was not made with one.
+func GetAPIKeyFromContext(ctx context.Context) *authm.APIKey {
+	if key, ok := ctx.Value(APIKeyContextKey).(*authm.APIKey); ok {
+		return key
+	}
+	return nil
+}
+
+// writeAPIKeyError writes a JSON error response for API key failures, in the
+// same shape as JWT authentication failures.
+func writeAPIKeyError(ctx huma.Context, requestID string, status int, errorCode, message string) {
+	ctx.SetHeader("Content-Type", "application/json")
+	ctx.SetStatus(status)
+	respond.SafeEncode(ctx.Context(), ctx.BodyWriter(), JWTErrorResponse{
+		Success:   false,
+		Message:   message,
+		ErrorCode: errorCode,
+		RequestID: requestID,
+	})
+}
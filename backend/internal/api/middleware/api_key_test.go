@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// fakeAPIKeyService implements contracts.APIKeyServiceInterface; only
+// AuthenticateKey is exercised by the middleware.
+type fakeAPIKeyService struct {
+	contracts.APIKeyServiceInterface
+	result *contracts.APIKeyAuthResult
+	err    error
+	calls  int
+}
+
+func (f *fakeAPIKeyService) AuthenticateKey(plainKey string) (*contracts.APIKeyAuthResult, error) {
+	f.calls++
+	return f.result, f.err
+}
+
+func newAPIKeyContext(t *testing.T, key, scope string) (huma.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/shows", nil)
+	if key != "" {
+		req.Header.Set(APIKeyHeader, key)
+	}
+	op := &huma.Operation{Method: http.MethodGet, Path: "/shows"}
+	if scope != "" {
+		APIKeyScope(scope)(op)
+	}
+	rr := httptest.NewRecorder()
+	return humatest.NewContext(op, req, rr), rr
+}
+
+func decodeAPIKeyError(t *testing.T, rr *httptest.ResponseRecorder) JWTErrorResponse {
+	t.Helper()
+	var body JWTErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+	return body
+}
+
+func TestHumaAPIKeyMiddleware_NoHeader_PassesThrough(t *testing.T) {
+	svc := &fakeAPIKeyService{}
+	ctx, _ := newAPIKeyContext(t, "", authm.APIKeyScopeReadShows)
+
+	called := false
+	HumaAPIKeyMiddleware(svc)(ctx, func(next huma.Context) {
+		called = true
+		if GetAPIKeyFromContext(next.Context()) != nil {
+			t.Error("expected no API key in context")
+		}
+	})
+
+	if !called {
+		t.Fatal("next() was not called without an API key")
+	}
+	if svc.calls != 0 {
+		t.Errorf("AuthenticateKey called %d times, want 0", svc.calls)
+	}
+}
+
+func TestHumaAPIKeyMiddleware_ValidKeyWithScope_StoresKey(t *testing.T) {
+	key := &authm.APIKey{ID: 3, UserID: 9, Scopes: "read:shows read:venues", User: authm.User{ID: 9}}
+	svc := &fakeAPIKeyService{result: &contracts.APIKeyAuthResult{Key: key, Allowed: true}}
+	ctx, _ := newAPIKeyContext(t, "phak_abc", authm.APIKeyScopeReadShows)
+
+	called := false
+	HumaAPIKeyMiddleware(svc)(ctx, func(next huma.Context) {
+		called = true
+		got := GetAPIKeyFromContext(next.Context())
+		if got == nil || got.ID != 3 {
+			t.Errorf("expected API key 3 in context, got %+v", got)
+		}
+	})
+
+	if !called {
+		t.Fatal("next() was not called for a valid key")
+	}
+}
+
+func TestHumaAPIKeyMiddleware_UntaggedOperation_Returns403(t *testing.T) {
+	svc := &fakeAPIKeyService{}
+	ctx, rr := newAPIKeyContext(t, "phak_abc", "")
+
+	HumaAPIKeyMiddleware(svc)(ctx, func(next huma.Context) {
+		t.Fatal("next() was called for an operation that does not accept API keys")
+	})
+
+	if rr.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want 403", rr.Code)
+	}
+	if body := decodeAPIKeyError(t, rr); body.ErrorCode != apperrors.CodeAPIKeyScopeMissing {
+		t.Errorf("error_code = %q, want %q", body.ErrorCode, apperrors.CodeAPIKeyScopeMissing)
+	}
+	if svc.calls != 0 {
+		t.Errorf("AuthenticateKey called %d times, want 0", svc.calls)
+	}
+}
+
+func TestHumaAPIKeyMiddleware_MissingScope_Returns403(t *testing.T) {
+	key := &authm.APIKey{ID: 3, Scopes: "read:venues"}
+	svc := &fakeAPIKeyService{result: &contracts.APIKeyAuthResult{Key: key, Allowed: true}}
+	ctx, rr := newAPIKeyContext(t, "phak_abc", authm.APIKeyScopeReadShows)
+
+	HumaAPIKeyMiddleware(svc)(ctx, func(next huma.Context) {
+		t.Fatal("next() was called for a key without the required scope")
+	})
+
+	if rr.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want 403", rr.Code)
+	}
+}
+
+func TestHumaAPIKeyMiddleware_InvalidKey_Returns401(t *testing.T) {
+	svc := &fakeAPIKeyService{err: apperrors.ErrAPIKeyUnauthorized("invalid API key")}
+	ctx, rr := newAPIKeyContext(t, "phak_nope", authm.APIKeyScopeReadShows)
+
+	HumaAPIKeyMiddleware(svc)(ctx, func(next huma.Context) {
+		t.Fatal("next() was called for an invalid key")
+	})
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", rr.Code)
+	}
+	if body := decodeAPIKeyError(t, rr); body.ErrorCode != apperrors.CodeAPIKeyUnauthorized {
+		t.Errorf("error_code = %q, want %q", body.ErrorCode, apperrors.CodeAPIKeyUnauthorized)
+	}
+}
+
+func TestHumaAPIKeyMiddleware_ServiceError_Returns500(t *testing.T) {
+	svc := &fakeAPIKeyService{err: fmt.Errorf("connection refused")}
+	ctx, rr := newAPIKeyContext(t, "phak_abc", authm.APIKeyScopeReadShows)
+
+	HumaAPIKeyMiddleware(svc)(ctx, func(next huma.Context) {
+		t.Fatal("next() was called after a service error")
+	})
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", rr.Code)
+	}
+}
+
+func TestHumaAPIKeyMiddleware_RateLimited_Returns429(t *testing.T) {
+	key := &authm.APIKey{ID: 3, Scopes: "read:shows"}
+	svc := &fakeAPIKeyService{result: &contracts.APIKeyAuthResult{Key: key, Allowed: false, RetryAfterSeconds: 42}}
+	ctx, rr := newAPIKeyContext(t, "phak_abc", authm.APIKeyScopeReadShows)
+
+	HumaAPIKeyMiddleware(svc)(ctx, func(next huma.Context) {
+		t.Fatal("next() was called for a rate-limited key")
+	})
+
+	if rr.Code != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want 429", rr.Code)
+	}
+	if got := rr.Header().Get("Retry-After"); got != "42" {
+		t.Errorf("Retry-After = %q, want 42", got)
+	}
+	if body := decodeAPIKeyError(t, rr); body.ErrorCode != apperrors.CodeAPIKeyRateLimited {
+		t.Errorf("error_code = %q, want %q", body.ErrorCode, apperrors.CodeAPIKeyRateLimited)
+	}
+}
+
+func TestHumaJWTMiddleware_FallsBackToAPIKeyUser(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/shows", nil)
+	ctx, _ := newHumaContext(t, req)
+	key := &authm.APIKey{ID: 3, UserID: 9, Scopes: "write:submissions", User: authm.User{ID: 9}}
+	ctx = huma.WithValue(ctx, APIKeyContextKey, key)
+
+	called := false
+	HumaJWTMiddleware(nil)(ctx, func(next huma.Context) {
+		called = true
+		if user := GetUserFromContext(next.Context()); user == nil || user.ID != 9 {
+			t.Errorf("expected API key owner (id=9) in context, got %+v", user)
+		}
+	})
+
+	if !called {
+		t.Fatal("next() was not called for a request authenticated by API key")
+	}
+}
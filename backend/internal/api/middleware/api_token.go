@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	autherrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// HumaAPITokenMiddleware authenticates machine callers (scrapers, the local
+// discovery app) that must present an admin API token as
+// "Authorization: Bearer phk_...". Unlike HumaJWTMiddleware it ignores the
+// auth cookie and refuses session JWTs, so routes behind it can't be driven
+// from a logged-in browser. The token's owner must still be an admin; the
+// user is stored at UserContextKey like the JWT middleware does.
+func HumaAPITokenMiddleware(apiTokenService contracts.APITokenServiceInterface) func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		var requestID string
+		if id, ok := ctx.Context().Value(logger.RequestIDContextKey).(string); ok {
+			requestID = id
+		}
+
+		token := strings.TrimPrefix(ctx.Header("Authorization"), "Bearer ")
+		if !strings.HasPrefix(token, APITokenPrefix) {
+			logger.AuthWarn(ctx.Context(), "huma_api_token_missing",
+				"path", ctx.URL().Path,
+			)
+			writeAPIKeyError(ctx, requestID, http.StatusUnauthorized, autherrors.CodeTokenMissing, "API token required")
+			return
+		}
+
+		user, _, err := apiTokenService.ValidateToken(token)
+		if err != nil {
+			logger.AuthWarn(ctx.Context(), "huma_api_token_validation_failed",
+				"error", err.Error(),
+			)
+			writeAPIKeyError(ctx, requestID, http.StatusUnauthorized, autherrors.CodeTokenInvalid, err.Error())
+			return
+		}
+
+		if !user.IsAdmin {
+			logger.AuthWarn(ctx.Context(), "huma_api_token_not_admin",
+				"user_id", user.ID,
+				"path", ctx.URL().Path,
+			)
+			writeHumaAdminError(ctx, requestID)
+			return
+		}
+
+		next(huma.WithValue(ctx, UserContextKey, user))
+	}
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+
+	autherrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// fakeAPITokenService implements contracts.APITokenServiceInterface; only
+// ValidateToken is exercised by the middleware.
+type fakeAPITokenService struct {
+	contracts.APITokenServiceInterface
+	user  *authm.User
+	err   error
+	calls int
+}
+
+func (f *fakeAPITokenService) ValidateToken(plainToken string) (*authm.User, *adminm.APIToken, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	return f.user, &adminm.APIToken{UserID: f.user.ID, Scope: "admin"}, nil
+}
+
+func newAPITokenContext(authorization, cookie string) (huma.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/discovery/import", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	if cookie != "" {
+		req.Header.Set("Cookie", cookie)
+	}
+	rr := httptest.NewRecorder()
+	op := &huma.Operation{Method: http.MethodPost, Path: "/discovery/import"}
+	return humatest.NewContext(op, req, rr), rr
+}
+
+func TestHumaAPITokenMiddleware_ValidAdminToken_StoresUser(t *testing.T) {
+	svc := &fakeAPITokenService{user: &authm.User{ID: 4, IsAdmin: true}}
+	ctx, _ := newAPITokenContext("Bearer phk_abc", "")
+
+	called := false
+	HumaAPITokenMiddleware(svc)(ctx, func(next huma.Context) {
+		called = true
+		if user := GetUserFromContext(next.Context()); user == nil || user.ID != 4 {
+			t.Errorf("expected token owner in context, got %+v", user)
+		}
+	})
+
+	if !called {
+		t.Fatal("next() was not called for a valid admin token")
+	}
+}
+
+func TestHumaAPITokenMiddleware_RefusesNonTokenCredentials(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		cookie        string
+	}{
+		{"no credentials", "", ""},
+		{"session JWT", "Bearer eyJhbGciOiJIUzI1NiJ9.e30.sig", ""},
+		{"auth cookie", "", "auth_token=phk_abc"},
+		{"public API key", "Bearer phak_abc", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeAPITokenService{user: &authm.User{ID: 4, IsAdmin: true}}
+			ctx, rr := newAPITokenContext(tt.authorization, tt.cookie)
+
+			HumaAPITokenMiddleware(svc)(ctx, func(huma.Context) {
+				t.Fatal("next() should not be called")
+			})
+
+			if rr.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want 401", rr.Code)
+			}
+			if body := decodeAPIKeyError(t, rr); body.ErrorCode != autherrors.CodeTokenMissing {
+				t.Errorf("error_code = %q, want %q", body.ErrorCode, autherrors.CodeTokenMissing)
+			}
+			if svc.calls != 0 {
+				t.Errorf("ValidateToken called %d times, want 0", svc.calls)
+			}
+		})
+	}
+}
+
+func TestHumaAPITokenMiddleware_InvalidToken_Returns401(t *testing.T) {
+	svc := &fakeAPITokenService{err: fmt.Errorf("token has been revoked")}
+	ctx, rr := newAPITokenContext("Bearer phk_revoked", "")
+
+	HumaAPITokenMiddleware(svc)(ctx, func(huma.Context) {
+		t.Fatal("next() should not be called")
+	})
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", rr.Code)
+	}
+	if body := decodeAPIKeyError(t, rr); body.ErrorCode != autherrors.CodeTokenInvalid {
+		t.Errorf("error_code = %q, want %q", body.ErrorCode, autherrors.CodeTokenInvalid)
+	}
+}
+
+func TestHumaAPITokenMiddleware_NonAdminOwner_Returns403(t *testing.T) {
+	svc := &fakeAPITokenService{user: &authm.User{ID: 5}}
+	ctx, rr := newAPITokenContext("Bearer phk_abc", "")
+
+	HumaAPITokenMiddleware(svc)(ctx, func(huma.Context) {
+		t.Fatal("next() should not be called")
+	})
+
+	if rr.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want 403", rr.Code)
+	}
+}
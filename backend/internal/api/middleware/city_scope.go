@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// CityScopeContextKey holds the cities a request is scoped to by the site
+// host it came from.
+const CityScopeContextKey contextKey = "city_scope"
+
+// HumaCityScopeMiddleware returns middleware that scopes requests from a city
+// subdomain (e.g. phoenix.psychichomily.com) to the cities hostCities lists
+// for it; handlers read the scope with CityScopeFromContext. Browsers call
+// the API cross-origin, so the site host is taken from Origin, then
+// X-Forwarded-Host (a proxy or server-side render in front of the API), then
+// Host, and the first one with cities configured wins. A scope is only a
+// default: list handlers ignore it when the request names a location itself.
+func HumaCityScopeMiddleware(hostCities map[string][]contracts.CityStateFilter) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		if len(hostCities) == 0 {
+			next(ctx)
+			return
+		}
+		// The same URL lists different shows depending on the site it is
+		// called from, so shared caches must key on these headers too.
+		ctx.AppendHeader("Vary", "Origin, X-Forwarded-Host")
+
+		cities := cityScopeForHosts(hostCities, ctx.Header("Origin"), ctx.Header("X-Forwarded-Host"), ctx.Host())
+		if len(cities) == 0 {
+			next(ctx)
+			return
+		}
+		next(huma.WithValue(ctx, CityScopeContextKey, cities))
+	}
+}
+
+// CityScopeFromContext returns the cities the request's site host scopes it
+// to, or nil when it came from a host without a scope.
+func CityScopeFromContext(ctx context.Context) []contracts.CityStateFilter {
+	cities, _ := ctx.Value(CityScopeContextKey).([]contracts.CityStateFilter)
+	return cities
+}
+
+// cityScopeForHosts returns the cities of the first configured site host
+// among the request's Origin, X-Forwarded-Host and Host headers.
+func cityScopeForHosts(hostCities map[string][]contracts.CityStateFilter, origin, forwardedHost, host string) []contracts.CityStateFilter {
+	var originHost string
+	if u, err := url.Parse(origin); err == nil {
+		originHost = u.Host
+	}
+	// X-Forwarded-Host may list every proxy hop; the first is the client's.
+	forwardedHost, _, _ = strings.Cut(forwardedHost, ",")
+
+	for _, candidate := range []string{originHost, forwardedHost, host} {
+		if cities := hostCities[normalizeSiteHost(candidate)]; len(cities) > 0 {
+			return cities
+		}
+	}
+	return nil
+}
+
+// normalizeSiteHost lowercases a host header value and strips its port and
+// any trailing dot, so it matches the CITY_HOSTS keys.
+func normalizeSiteHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+var testHostCities = map[string][]contracts.CityStateFilter{
+	"phoenix.psychichomily.com": {{City: "Phoenix", State: "AZ"}, {City: "Tempe", State: "AZ"}},
+	"tucson.psychichomily.com":  {{City: "Tucson", State: "AZ"}},
+}
+
+func TestCityScopeForHosts(t *testing.T) {
+	for _, tc := range []struct {
+		name                        string
+		origin, forwardedHost, host string
+		wantCity                    string
+	}{
+		{"origin", "https://phoenix.psychichomily.com", "", "api.psychichomily.com", "Phoenix"},
+		{"origin with port and case", "https://Tucson.PsychicHomily.com:443", "", "api.psychichomily.com", "Tucson"},
+		{"forwarded host", "", "tucson.psychichomily.com, proxy.internal", "api.psychichomily.com", "Tucson"},
+		{"host", "", "", "phoenix.psychichomily.com.", "Phoenix"},
+		{"origin wins", "https://tucson.psychichomily.com", "", "phoenix.psychichomily.com", "Tucson"},
+		{"unscoped origin falls through", "https://psychichomily.com", "", "phoenix.psychichomily.com", "Phoenix"},
+		{"no scope", "https://psychichomily.com", "", "api.psychichomily.com", ""},
+		{"bad origin", "::not a url", "", "api.psychichomily.com", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cities := cityScopeForHosts(testHostCities, tc.origin, tc.forwardedHost, tc.host)
+			got := ""
+			if len(cities) > 0 {
+				got = cities[0].City
+			}
+			if got != tc.wantCity {
+				t.Errorf("scoped to %q, want %q", got, tc.wantCity)
+			}
+		})
+	}
+}
+
+func TestHumaCityScopeMiddleware(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/shows/upcoming", nil)
+	req.Header.Set("Origin", "https://phoenix.psychichomily.com")
+	ctx, rr := newHumaContext(t, req)
+
+	var got []contracts.CityStateFilter
+	HumaCityScopeMiddleware(testHostCities)(ctx, func(next huma.Context) {
+		got = CityScopeFromContext(next.Context())
+	})
+
+	if len(got) != 2 || got[1].City != "Tempe" {
+		t.Errorf("scope = %+v, want Phoenix and Tempe", got)
+	}
+	if vary := rr.Header().Get("Vary"); vary != "Origin, X-Forwarded-Host" {
+		t.Errorf("Vary = %q", vary)
+	}
+}
+
+func TestHumaCityScopeMiddleware_Unconfigured(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/shows/upcoming", nil)
+	req.Header.Set("Origin", "https://phoenix.psychichomily.com")
+	ctx, rr := newHumaContext(t, req)
+
+	called := false
+	HumaCityScopeMiddleware(nil)(ctx, func(next huma.Context) {
+		called = true
+		if scope := CityScopeFromContext(next.Context()); scope != nil {
+			t.Errorf("expected no scope, got %+v", scope)
+		}
+	})
+
+	if !called {
+		t.Fatal("next was not called")
+	}
+	if vary := rr.Header().Get("Vary"); vary != "" {
+		t.Errorf("expected no Vary without CITY_HOSTS, got %q", vary)
+	}
+}
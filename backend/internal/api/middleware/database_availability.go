@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+)
+
+// databaseRetryAfterSeconds is the Retry-After hint sent with 503s while the
+// database is down; it matches the watchdog's early backoff steps.
+const databaseRetryAfterSeconds = 5
+
+// databaseAvailabilityExemptPaths answer without the database (or report on
+// it), so they keep responding during an outage.
+var databaseAvailabilityExemptPaths = map[string]bool{
+	"/health":  true,
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// DatabaseAvailability returns 503 SERVICE_UNAVAILABLE while available()
+// reports the database as down, instead of letting each handler fail its
+// queries and answer with an assortment of 500s. A 5xx written by a handler
+// because the database went down mid-request is rewritten to the same 503.
+func DatabaseAvailability(available func() bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if databaseAvailabilityExemptPaths[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !available() {
+				writeDatabaseUnavailable(w, r)
+				return
+			}
+			next.ServeHTTP(&databaseAvailabilityWriter{ResponseWriter: w, r: r, available: available}, r)
+		})
+	}
+}
+
+// writeDatabaseUnavailable writes the 503 problem response.
+func writeDatabaseUnavailable(w http.ResponseWriter, r *http.Request) {
+	requestID := logger.GetRequestID(r.Context())
+	body, _ := json.Marshal(&huma.ErrorModel{
+		Title:  http.StatusText(http.StatusServiceUnavailable),
+		Status: http.StatusServiceUnavailable,
+		Detail: fmt.Sprintf("Service temporarily unavailable [%s] (request_id: %s)", apperrors.CodeServiceUnavailable, requestID),
+	})
+	h := w.Header()
+	h.Del("Content-Length")
+	h.Set("Content-Type", "application/problem+json")
+	h.Set("Retry-After", strconv.Itoa(databaseRetryAfterSeconds))
+	w.WriteHeader(http.StatusServiceUnavailable)
+	_, _ = w.Write(body)
+}
+
+// databaseAvailabilityWriter swaps a handler's 5xx for the 503 response when
+// the database is down by the time the status is written.
+type databaseAvailabilityWriter struct {
+	http.ResponseWriter
+	r         *http.Request
+	available func() bool
+	replaced  bool
+}
+
+func (w *databaseAvailabilityWriter) WriteHeader(status int) {
+	if status >= http.StatusInternalServerError && !w.available() {
+		w.replaced = true
+		writeDatabaseUnavailable(w.ResponseWriter, w.r)
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *databaseAvailabilityWriter) Write(b []byte) (int, error) {
+	if w.replaced {
+		// Drop the handler's own error body; the 503 body is already written.
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush keeps streaming responses (SSE) working through the wrapper.
+func (w *databaseAvailabilityWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *databaseAvailabilityWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"psychic-homily-backend/internal/logger"
+)
+
+func serveDatabaseAvailability(t *testing.T, available *bool, path string, h http.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	handler := DatabaseAvailability(func() bool { return *available })(h)
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req = req.WithContext(logger.SetRequestID(req.Context(), "req-123"))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+func assertDatabaseUnavailable(t *testing.T, rr *httptest.ResponseRecorder) {
+	t.Helper()
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503", rr.Code)
+	}
+	if got := rr.Header().Get("Content-Type"); got != "application/problem+json" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if rr.Header().Get("Retry-After") == "" {
+		t.Error("expected a Retry-After header")
+	}
+	body := rr.Body.String()
+	if !strings.Contains(body, "[SERVICE_UNAVAILABLE]") || !strings.Contains(body, "req-123") {
+		t.Errorf("body = %s", body)
+	}
+}
+
+func TestDatabaseAvailability_PassesThroughWhenAvailable(t *testing.T) {
+	available := true
+	rr := serveDatabaseAvailability(t, &available, "/shows", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	if rr.Code != http.StatusOK || rr.Body.String() != `{"ok":true}` {
+		t.Errorf("got %d %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestDatabaseAvailability_ShortCircuitsWhenUnavailable(t *testing.T) {
+	available := false
+	called := false
+	rr := serveDatabaseAvailability(t, &available, "/shows", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	if called {
+		t.Error("handler should not run while the database is unavailable")
+	}
+	assertDatabaseUnavailable(t, rr)
+}
+
+func TestDatabaseAvailability_ProbesExempt(t *testing.T) {
+	for _, path := range []string{"/health", "/healthz", "/readyz"} {
+		available := false
+		rr := serveDatabaseAvailability(t, &available, path, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		if rr.Code != http.StatusOK {
+			t.Errorf("%s: status = %d, want 200", path, rr.Code)
+		}
+	}
+}
+
+func TestDatabaseAvailability_RewritesServerErrorAfterOutage(t *testing.T) {
+	available := true
+	rr := serveDatabaseAvailability(t, &available, "/shows", func(w http.ResponseWriter, r *http.Request) {
+		// The database goes down while the handler is running.
+		available = false
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"detail":"Failed to get shows"}`))
+	})
+
+	assertDatabaseUnavailable(t, rr)
+	if strings.Contains(rr.Body.String(), "Failed to get shows") {
+		t.Errorf("handler body should be replaced, got %s", rr.Body.String())
+	}
+}
+
+func TestDatabaseAvailability_KeepsServerErrorWhileAvailable(t *testing.T) {
+	available := true
+	rr := serveDatabaseAvailability(t, &available, "/shows", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", rr.Code)
+	}
+}
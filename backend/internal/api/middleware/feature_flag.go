@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"psychic-homily-backend/internal/logger"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/respond"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// FeatureEnabled reports whether the feature flag key is on for the caller
+// in ctx. Callers who can manage the site count as admins, so a flag with
+// only enabled_for_admins set is on for them alone. Anonymous callers, and a
+// nil flag service, see the flag as off unless it is enabled for everyone.
+//
+// Use it inside a handler to branch between old and new behavior; use
+// HumaFeatureFlagMiddleware to hide a whole endpoint.
+func FeatureEnabled(ctx context.Context, flags contracts.FeatureFlagServiceInterface, key string) bool {
+	if flags == nil {
+		return false
+	}
+	user := GetUserFromContext(ctx)
+	isAdmin := user != nil && user.Can(authm.PermissionManageSite)
+	return flags.IsEnabled(key, isAdmin)
+}
+
+// HumaFeatureFlagMiddleware returns middleware that answers 404 for every
+// operation in the group while the feature flag key is off for the caller,
+// so an unreleased endpoint looks like it doesn't exist. To let admins in
+// ahead of everyone else, chain it after HumaJWTMiddleware (or optional
+// auth) so the caller is known.
+func HumaFeatureFlagMiddleware(flags contracts.FeatureFlagServiceInterface, key string) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		if FeatureEnabled(ctx.Context(), flags, key) {
+			next(ctx)
+			return
+		}
+		logger.FromContext(ctx.Context()).Debug("feature_flag_off",
+			"flag", key,
+			"path", ctx.URL().Path,
+		)
+		ctx.SetHeader("Content-Type", "application/problem+json")
+		ctx.SetStatus(http.StatusNotFound)
+		respond.SafeEncode(ctx.Context(), ctx.BodyWriter(), &huma.ErrorModel{
+			Title:  http.StatusText(http.StatusNotFound),
+			Status: http.StatusNotFound,
+		})
+	}
+}
+
+// FeatureFlagGate is the chi equivalent of HumaFeatureFlagMiddleware, for
+// handlers mounted directly on the router. Plain chi routes don't run JWT
+// auth, so only flags enabled for everyone open them.
+func FeatureFlagGate(flags contracts.FeatureFlagServiceInterface, key string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !FeatureEnabled(r.Context(), flags, key) {
+				http.NotFound(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// stubFlags is a FeatureFlagServiceInterface whose IsEnabled answers from a
+// fixed on/admin-only pair and records the isAdmin it was asked with.
+type stubFlags struct {
+	contracts.FeatureFlagServiceInterface
+	enabled, enabledForAdmins bool
+	gotAdmin                  bool
+}
+
+func (s *stubFlags) IsEnabled(_ string, isAdmin bool) bool {
+	s.gotAdmin = isAdmin
+	return s.enabled || (isAdmin && s.enabledForAdmins)
+}
+
+func TestFeatureEnabled(t *testing.T) {
+	admin := context.WithValue(context.Background(), UserContextKey, &authm.User{ID: 1, IsAdmin: true})
+	member := context.WithValue(context.Background(), UserContextKey, &authm.User{ID: 2})
+
+	flags := &stubFlags{enabledForAdmins: true}
+	if !FeatureEnabled(admin, flags, "graphql_endpoint") || !flags.gotAdmin {
+		t.Error("expected an admin-only flag to be on for an admin")
+	}
+	if FeatureEnabled(member, flags, "graphql_endpoint") {
+		t.Error("expected an admin-only flag to be off for a member")
+	}
+	if FeatureEnabled(context.Background(), flags, "graphql_endpoint") {
+		t.Error("expected an admin-only flag to be off for an anonymous caller")
+	}
+	if FeatureEnabled(admin, nil, "graphql_endpoint") {
+		t.Error("expected flags to read as off without a flag service")
+	}
+}
+
+func TestHumaFeatureFlagMiddleware(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		enabled    bool
+		wantCalled bool
+	}{
+		{"on passes through", true, true},
+		{"off answers 404", false, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, rr := newHumaContext(t, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+
+			called := false
+			HumaFeatureFlagMiddleware(&stubFlags{enabled: tc.enabled}, "graphql_endpoint")(ctx, func(huma.Context) {
+				called = true
+			})
+
+			if called != tc.wantCalled {
+				t.Fatalf("next called = %v, want %v", called, tc.wantCalled)
+			}
+			if !tc.wantCalled && rr.Code != http.StatusNotFound {
+				t.Errorf("expected status 404, got %d", rr.Code)
+			}
+		})
+	}
+}
+
+func TestFeatureFlagGate(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+
+	rr := httptest.NewRecorder()
+	FeatureFlagGate(&stubFlags{}, "graphql_endpoint")(next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected 404 while the flag is off, got %d", rr.Code)
+	}
+
+	rr = httptest.NewRecorder()
+	FeatureFlagGate(&stubFlags{enabled: true}, "graphql_endpoint")(next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+	if rr.Code != http.StatusNoContent {
+		t.Errorf("expected the handler to run while the flag is on, got %d", rr.Code)
+	}
+}
@@ -8,9 +8,11 @@ import (
 
 	autherrors "psychic-homily-backend/internal/errors"
 	"psychic-homily-backend/internal/logger"
+	authm "psychic-homily-backend/internal/models/auth"
 )
 
-// HumaAdminMiddleware enforces that the authenticated user has IsAdmin=true.
+// HumaAdminMiddleware enforces that the authenticated user's role grants
+// manage_site (admin and superadmin, or a legacy IsAdmin account).
 //
 // Must be chained AFTER HumaJWTMiddleware: it reads the user that JWT placed
 // at UserContextKey. If the user is missing (auth never ran or was rejected
@@ -22,33 +24,44 @@ import (
 // scattered across pure-admin endpoints. Conditional-admin endpoints
 // (e.g. owner-or-admin) stay on rc.Protected with handler-side logic.
 func HumaAdminMiddleware(ctx huma.Context, next func(huma.Context)) {
-	user := GetUserFromContext(ctx.Context())
+	HumaPermissionMiddleware(authm.PermissionManageSite)(ctx, next)
+}
 
-	var requestID string
-	if id, ok := ctx.Context().Value(logger.RequestIDContextKey).(string); ok {
-		requestID = id
-	}
+// HumaPermissionMiddleware returns middleware that lets a request through
+// only when the authenticated user's role grants perm. Like
+// HumaAdminMiddleware it must be chained after HumaJWTMiddleware.
+func HumaPermissionMiddleware(perm authm.Permission) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		user := GetUserFromContext(ctx.Context())
 
-	if user == nil {
-		// JWT middleware should have set this. If it didn't, that's a wiring
-		// bug — but defensive: refuse rather than 500.
-		logger.AuthWarn(ctx.Context(), "huma_admin_missing_user",
-			"path", ctx.URL().Path,
-		)
-		writeHumaAdminError(ctx, requestID)
-		return
-	}
+		var requestID string
+		if id, ok := ctx.Context().Value(logger.RequestIDContextKey).(string); ok {
+			requestID = id
+		}
 
-	if !user.IsAdmin {
-		logger.AuthWarn(ctx.Context(), "huma_admin_access_denied",
-			"user_id", user.ID,
-			"path", ctx.URL().Path,
-		)
-		writeHumaAdminError(ctx, requestID)
-		return
-	}
+		if user == nil {
+			// JWT middleware should have set this. If it didn't, that's a wiring
+			// bug — but defensive: refuse rather than 500.
+			logger.AuthWarn(ctx.Context(), "huma_admin_missing_user",
+				"path", ctx.URL().Path,
+			)
+			writeHumaAdminError(ctx, requestID)
+			return
+		}
+
+		if !user.Can(perm) {
+			logger.AuthWarn(ctx.Context(), "huma_admin_access_denied",
+				"user_id", user.ID,
+				"role", user.EffectiveRole(),
+				"permission", perm,
+				"path", ctx.URL().Path,
+			)
+			writeHumaAdminError(ctx, requestID)
+			return
+		}
 
-	next(ctx)
+		next(ctx)
+	}
 }
 
 // writeHumaAdminError writes the 403 response for non-admin requests.
@@ -149,3 +149,58 @@ var _ func(ctx huma.Context, next func(huma.Context)) = HumaAdminMiddleware
 
 // avoid unused context import in build environments without other tests.
 var _ = context.Background
+
+// TestHumaAdminMiddleware_Moderator_ShortCircuits403 verifies moderators,
+// who only hold moderate_content, are kept off the general admin group.
+func TestHumaAdminMiddleware_Moderator_ShortCircuits403(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+	ctx, rr := newHumaContext(t, req)
+	ctx = huma.WithValue(ctx, UserContextKey, &authm.User{ID: 9, Role: authm.RoleModerator})
+
+	called := false
+	HumaAdminMiddleware(ctx, func(next huma.Context) {
+		called = true
+	})
+
+	if called {
+		t.Fatal("next() was called for a moderator on an admin route")
+	}
+	if rr.Code != http.StatusForbidden {
+		t.Errorf("expected status 403, got %d", rr.Code)
+	}
+}
+
+// TestHumaPermissionMiddleware checks each role against each permission
+// group.
+func TestHumaPermissionMiddleware(t *testing.T) {
+	cases := []struct {
+		user *authm.User
+		perm authm.Permission
+		want bool
+	}{
+		{&authm.User{Role: authm.RoleUser}, authm.PermissionModerateContent, false},
+		{&authm.User{Role: authm.RoleModerator}, authm.PermissionModerateContent, true},
+		{&authm.User{Role: authm.RoleModerator}, authm.PermissionManageUsers, false},
+		{&authm.User{Role: authm.RoleAdmin, IsAdmin: true}, authm.PermissionManageUsers, true},
+		{&authm.User{Role: authm.RoleAdmin, IsAdmin: true}, authm.PermissionManageRoles, false},
+		{&authm.User{Role: authm.RoleSuperadmin, IsAdmin: true}, authm.PermissionManageRoles, true},
+		{&authm.User{IsAdmin: true}, authm.PermissionManageRoles, true},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/admin/roles", nil)
+		ctx, rr := newHumaContext(t, req)
+		ctx = huma.WithValue(ctx, UserContextKey, tc.user)
+
+		called := false
+		HumaPermissionMiddleware(tc.perm)(ctx, func(next huma.Context) {
+			called = true
+		})
+
+		if called != tc.want {
+			t.Errorf("role %q perm %q: called=%v, want %v", tc.user.Role, tc.perm, called, tc.want)
+		}
+		if !tc.want && rr.Code != http.StatusForbidden {
+			t.Errorf("role %q perm %q: expected 403, got %d", tc.user.Role, tc.perm, rr.Code)
+		}
+	}
+}
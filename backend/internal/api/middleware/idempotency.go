@@ -0,0 +1,237 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+
+	"psychic-homily-backend/internal/logger"
+	"psychic-homily-backend/internal/respond"
+	"psychic-homily-backend/internal/services/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key for a mutation.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set to "true" on replayed responses.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// MaxIdempotencyKeyLength matches the idempotency_keys column.
+	MaxIdempotencyKeyLength = 255
+
+	// maxIdempotentBodyBytes bounds both the request body we fingerprint and
+	// the response body we store. Larger requests run without idempotency;
+	// larger responses aren't stored, so a retry runs again.
+	maxIdempotentBodyBytes = 1 << 20
+)
+
+// Idempotency makes mutations safe to retry. The first request carrying an
+// Idempotency-Key header claims the key and its response is stored; a retry
+// with the same key gets that response replayed (with Idempotent-Replayed:
+// true) instead of running the handler again.
+//
+// Keys are namespaced per caller, so two users can't collide. A key reused
+// for a different request (method, path or body) gets 422, and a retry that
+// arrives while the first request is still running gets 409.
+//
+// Only 2xx and 4xx responses are stored. 5xx, 408, 409 and 429 are
+// transient: the claim is released so the retry runs for real. Requests
+// without the header, and anonymous requests (which the auth middleware
+// rejects anyway), pass straight through. A store failure fails open.
+func Idempotency(jwtService *auth.JWTService, store contracts.IdempotencyServiceInterface) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || store == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > MaxIdempotencyKeyLength {
+				writeIdempotencyError(w, r, http.StatusBadRequest, "IDEMPOTENCY_KEY_INVALID",
+					"Idempotency-Key must be at most "+strconv.Itoa(MaxIdempotencyKeyLength)+" characters")
+				return
+			}
+
+			scope, ok := idempotencyScope(jwtService, r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBodyBytes+1))
+			if err != nil {
+				writeIdempotencyError(w, r, http.StatusBadRequest, "INVALID_BODY", "Failed to read request body")
+				return
+			}
+			if len(body) > maxIdempotentBodyBytes {
+				r.Body = readCloser{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+				next.ServeHTTP(w, r)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			log := logger.FromContext(r.Context())
+			hash := idempotencyRequestHash(r.Method, r.URL.RequestURI(), body)
+			record, acquired, err := store.Acquire(contracts.IdempotencyRequest{
+				Scope:       scope,
+				Key:         key,
+				Method:      r.Method,
+				Path:        r.URL.RequestURI(),
+				RequestHash: hash,
+			})
+			if err != nil {
+				log.Error("idempotency_acquire_failed", "path", r.URL.Path, "error", err.Error())
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !acquired {
+				switch {
+				case record.RequestHash != hash:
+					writeIdempotencyError(w, r, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED",
+						"Idempotency-Key was already used for a different request")
+				case record.InFlight():
+					w.Header().Set("Retry-After", "1")
+					writeIdempotencyError(w, r, http.StatusConflict, "IDEMPOTENCY_REQUEST_IN_PROGRESS",
+						"A request with this Idempotency-Key is still in progress")
+				default:
+					if record.ContentType != nil {
+						w.Header().Set("Content-Type", *record.ContentType)
+					}
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					w.WriteHeader(record.StatusCode)
+					respond.SafeWrite(r.Context(), w, record.ResponseBody)
+				}
+				return
+			}
+
+			rec := &idempotencyRecorder{ResponseWriter: w}
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := store.Release(record.ID); err != nil {
+					log.Error("idempotency_release_failed", "path", r.URL.Path, "error", err.Error())
+				}
+			}()
+
+			next.ServeHTTP(rec, r)
+
+			status := rec.statusCode()
+			if rec.overflow || !storableIdempotentStatus(status) {
+				return
+			}
+			if err := store.Complete(record.ID, status, w.Header().Get("Content-Type"), rec.body.Bytes()); err != nil {
+				log.Error("idempotency_complete_failed", "path", r.URL.Path, "error", err.Error())
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+// idempotencyScope namespaces keys by caller: the session user when the
+// request carries a valid session JWT, otherwise a hash of the API token or
+// API key it authenticates with.
+func idempotencyScope(jwtService *auth.JWTService, r *http.Request) (string, bool) {
+	if uid, ok := sessionUserID(jwtService, r); ok {
+		return "user:" + strconv.FormatUint(uint64(uid), 10), true
+	}
+	credential := r.Header.Get("Authorization")
+	if credential == "" {
+		credential = r.Header.Get(APIKeyHeader)
+	}
+	if credential == "" {
+		return "", false
+	}
+	sum := sha256.Sum256([]byte(credential))
+	return "cred:" + hex.EncodeToString(sum[:]), true
+}
+
+// idempotencyRequestHash fingerprints a request so a key reused for a
+// different request can be told apart from a retry.
+func idempotencyRequestHash(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// storableIdempotentStatus reports whether a response is final. Server
+// errors, timeouts, conflicts and rate limits are worth retrying.
+func storableIdempotentStatus(status int) bool {
+	switch status {
+	case http.StatusRequestTimeout, http.StatusConflict, http.StatusTooManyRequests:
+		return false
+	}
+	return status >= 200 && status < 500
+}
+
+func writeIdempotencyError(w http.ResponseWriter, r *http.Request, status int, errorCode, message string) {
+	requestID := logger.GetRequestID(r.Context())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	respond.SafeEncode(r.Context(), w, JWTErrorResponse{
+		Success:   false,
+		Message:   message,
+		ErrorCode: errorCode,
+		RequestID: requestID,
+	})
+}
+
+// idempotencyRecorder passes the response through while keeping a copy of
+// the status and body.
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (rec *idempotencyRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *idempotencyRecorder) Write(p []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	if !rec.overflow {
+		if rec.body.Len()+len(p) > maxIdempotentBodyBytes {
+			rec.overflow = true
+			rec.body.Reset()
+		} else {
+			rec.body.Write(p)
+		}
+	}
+	return rec.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (rec *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+func (rec *idempotencyRecorder) statusCode() int {
+	if rec.status == 0 {
+		return http.StatusOK
+	}
+	return rec.status
+}
+
+// readCloser pairs a replacement body reader with the original closer.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,302 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	adminm "psychic-homily-backend/internal/models/admin"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// memoryIdempotencyStore is an in-memory IdempotencyServiceInterface.
+type memoryIdempotencyStore struct {
+	mu         sync.Mutex
+	records    map[string]*adminm.IdempotencyKey
+	nextID     uint
+	acquireErr error
+	released   []uint
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{records: map[string]*adminm.IdempotencyKey{}}
+}
+
+func (s *memoryIdempotencyStore) Acquire(req contracts.IdempotencyRequest) (*adminm.IdempotencyKey, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.acquireErr != nil {
+		return nil, false, s.acquireErr
+	}
+	if existing, ok := s.records[req.Scope+"|"+req.Key]; ok {
+		copied := *existing
+		return &copied, false, nil
+	}
+	s.nextID++
+	record := &adminm.IdempotencyKey{ID: s.nextID, Scope: req.Scope, Key: req.Key, RequestHash: req.RequestHash}
+	s.records[req.Scope+"|"+req.Key] = record
+	return record, true, nil
+}
+
+func (s *memoryIdempotencyStore) Complete(id uint, statusCode int, contentType string, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, record := range s.records {
+		if record.ID == id {
+			record.StatusCode = statusCode
+			record.ContentType = &contentType
+			record.ResponseBody = append([]byte(nil), body...)
+		}
+	}
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(id uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.released = append(s.released, id)
+	for k, record := range s.records {
+		if record.ID == id && record.StatusCode == 0 {
+			delete(s.records, k)
+		}
+	}
+	return nil
+}
+
+// countingHandler replies with status and a body naming the call number.
+func countingHandler(status int, calls *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(*calls) + `,"body":"` + string(body) + `"}`))
+	})
+}
+
+func idempotentRequest(key, authHeader, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/shows", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	return req
+}
+
+func TestIdempotency_ReplaysStoredResponse(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	calls := 0
+	handler := Idempotency(nil, store)(countingHandler(http.StatusCreated, &calls))
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, idempotentRequest("k1", "Bearer phk_abc", "x"))
+	second := httptest.NewRecorder()
+	handler.ServeHTTP(second, idempotentRequest("k1", "Bearer phk_abc", "x"))
+
+	if calls != 1 {
+		t.Fatalf("handler ran %d times, want 1", calls)
+	}
+	if second.Code != http.StatusCreated {
+		t.Errorf("replay status = %d, want 201", second.Code)
+	}
+	if second.Body.String() != first.Body.String() {
+		t.Errorf("replay body = %q, want %q", second.Body.String(), first.Body.String())
+	}
+	if second.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Error("expected Idempotent-Replayed header on replay")
+	}
+	if first.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Error("first response must not be marked replayed")
+	}
+	if second.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("replay Content-Type = %q", second.Header().Get("Content-Type"))
+	}
+}
+
+func TestIdempotency_HandlerSeesBody(t *testing.T) {
+	calls := 0
+	handler := Idempotency(nil, newMemoryIdempotencyStore())(countingHandler(http.StatusOK, &calls))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, idempotentRequest("k1", "Bearer phk_abc", "payload"))
+
+	if !strings.Contains(rr.Body.String(), `"body":"payload"`) {
+		t.Errorf("handler did not see the request body: %s", rr.Body.String())
+	}
+}
+
+func TestIdempotency_KeysAreScopedPerCaller(t *testing.T) {
+	calls := 0
+	handler := Idempotency(nil, newMemoryIdempotencyStore())(countingHandler(http.StatusCreated, &calls))
+
+	handler.ServeHTTP(httptest.NewRecorder(), idempotentRequest("k1", "Bearer phk_alice", "x"))
+	handler.ServeHTTP(httptest.NewRecorder(), idempotentRequest("k1", "Bearer phk_bob", "x"))
+
+	if calls != 2 {
+		t.Errorf("handler ran %d times, want 2 (different callers)", calls)
+	}
+}
+
+func TestIdempotency_SessionUserScope(t *testing.T) {
+	jwtService := newTestJWTService()
+	token, err := jwtService.CreateToken(&authm.User{ID: 7})
+	if err != nil {
+		t.Fatalf("CreateToken: %v", err)
+	}
+
+	scope, ok := idempotencyScope(jwtService, idempotentRequest("k1", "Bearer "+token, ""))
+	if !ok || scope != "user:7" {
+		t.Errorf("scope = %q, %v; want user:7", scope, ok)
+	}
+
+	// A fresh token for the same user shares the scope, so a retry after a
+	// token refresh still replays.
+	other, _ := jwtService.CreateToken(&authm.User{ID: 7})
+	scope2, _ := idempotencyScope(jwtService, idempotentRequest("k1", "Bearer "+other, ""))
+	if scope2 != scope {
+		t.Errorf("scope after refresh = %q, want %q", scope2, scope)
+	}
+}
+
+func TestIdempotency_DifferentRequestSameKey422(t *testing.T) {
+	calls := 0
+	handler := Idempotency(nil, newMemoryIdempotencyStore())(countingHandler(http.StatusCreated, &calls))
+
+	handler.ServeHTTP(httptest.NewRecorder(), idempotentRequest("k1", "Bearer phk_abc", "x"))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, idempotentRequest("k1", "Bearer phk_abc", "y"))
+
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want 422", rr.Code)
+	}
+	if !strings.Contains(rr.Body.String(), "IDEMPOTENCY_KEY_REUSED") {
+		t.Errorf("unexpected body: %s", rr.Body.String())
+	}
+	if calls != 1 {
+		t.Errorf("handler ran %d times, want 1", calls)
+	}
+}
+
+func TestIdempotency_InFlight409(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	req := idempotentRequest("k1", "Bearer phk_abc", "x")
+	scope, _ := idempotencyScope(nil, req)
+	_, _, _ = store.Acquire(contracts.IdempotencyRequest{
+		Scope:       scope,
+		Key:         "k1",
+		RequestHash: idempotencyRequestHash(http.MethodPost, "/shows", []byte("x")),
+	})
+
+	calls := 0
+	rr := httptest.NewRecorder()
+	Idempotency(nil, store)(countingHandler(http.StatusCreated, &calls)).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Errorf("status = %d, want 409", rr.Code)
+	}
+	if rr.Header().Get("Retry-After") == "" {
+		t.Error("expected Retry-After on in-flight conflict")
+	}
+	if calls != 0 {
+		t.Errorf("handler ran %d times, want 0", calls)
+	}
+}
+
+func TestIdempotency_TransientResponsesAreNotStored(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusConflict} {
+		store := newMemoryIdempotencyStore()
+		calls := 0
+		handler := Idempotency(nil, store)(countingHandler(status, &calls))
+
+		handler.ServeHTTP(httptest.NewRecorder(), idempotentRequest("k1", "Bearer phk_abc", "x"))
+		handler.ServeHTTP(httptest.NewRecorder(), idempotentRequest("k1", "Bearer phk_abc", "x"))
+
+		if calls != 2 {
+			t.Errorf("status %d: handler ran %d times, want 2", status, calls)
+		}
+		if len(store.released) != 2 {
+			t.Errorf("status %d: released %d claims, want 2", status, len(store.released))
+		}
+	}
+}
+
+func TestIdempotency_ClientErrorsAreStored(t *testing.T) {
+	calls := 0
+	handler := Idempotency(nil, newMemoryIdempotencyStore())(countingHandler(http.StatusUnprocessableEntity, &calls))
+
+	handler.ServeHTTP(httptest.NewRecorder(), idempotentRequest("k1", "Bearer phk_abc", "x"))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, idempotentRequest("k1", "Bearer phk_abc", "x"))
+
+	if calls != 1 || rr.Code != http.StatusUnprocessableEntity {
+		t.Errorf("calls = %d, status = %d; want 1 call and a replayed 422", calls, rr.Code)
+	}
+}
+
+func TestIdempotency_PanicReleasesClaim(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	handler := Idempotency(nil, store)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+
+	func() {
+		defer func() { _ = recover() }()
+		handler.ServeHTTP(httptest.NewRecorder(), idempotentRequest("k1", "Bearer phk_abc", "x"))
+	}()
+
+	if len(store.released) != 1 || len(store.records) != 0 {
+		t.Errorf("expected the claim to be released, got released=%v records=%d", store.released, len(store.records))
+	}
+}
+
+func TestIdempotency_PassThrough(t *testing.T) {
+	cases := map[string]*http.Request{
+		"no key":    idempotentRequest("", "Bearer phk_abc", "x"),
+		"anonymous": idempotentRequest("k1", "", "x"),
+	}
+	for name, req := range cases {
+		store := newMemoryIdempotencyStore()
+		calls := 0
+		handler := Idempotency(nil, store)(countingHandler(http.StatusCreated, &calls))
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		if calls != 1 {
+			t.Errorf("%s: handler ran %d times, want 1", name, calls)
+		}
+		if len(store.records) != 0 {
+			t.Errorf("%s: expected nothing stored", name)
+		}
+	}
+}
+
+func TestIdempotency_KeyTooLong400(t *testing.T) {
+	calls := 0
+	handler := Idempotency(nil, newMemoryIdempotencyStore())(countingHandler(http.StatusCreated, &calls))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, idempotentRequest(strings.Repeat("k", MaxIdempotencyKeyLength+1), "Bearer phk_abc", "x"))
+
+	if rr.Code != http.StatusBadRequest || calls != 0 {
+		t.Errorf("status = %d, calls = %d; want 400 and no call", rr.Code, calls)
+	}
+}
+
+func TestIdempotency_StoreFailureFailsOpen(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	store.acquireErr = errors.New("db down")
+	calls := 0
+	rr := httptest.NewRecorder()
+	Idempotency(nil, store)(countingHandler(http.StatusCreated, &calls)).
+		ServeHTTP(rr, idempotentRequest("k1", "Bearer phk_abc", "x"))
+
+	if calls != 1 || rr.Code != http.StatusCreated {
+		t.Errorf("calls = %d, status = %d; want the request to run", calls, rr.Code)
+	}
+}
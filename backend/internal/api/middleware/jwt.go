@@ -161,6 +161,17 @@ func HumaJWTMiddleware(jwtService *auth.JWTService, sessionConfig ...config.Sess
 		}
 
 		if token == "" {
+			// A public API key resolved by HumaAPIKeyMiddleware (already
+			// checked against this operation's scope) stands in for a JWT.
+			if key := GetAPIKeyFromContext(ctx.Context()); key != nil {
+				logger.AuthDebug(ctx.Context(), "huma_jwt_api_key_user",
+					"api_key_id", key.ID,
+					"user_id", key.UserID,
+				)
+				next(huma.WithValue(ctx, UserContextKey, apiKeyUser(key)))
+				return
+			}
+
 			logger.AuthWarn(ctx.Context(), "huma_jwt_token_missing",
 				"path", url.Path,
 			)
@@ -379,6 +379,32 @@ func TestHumaJWTMiddleware_NoToken(t *testing.T) {
 	}
 }
 
+func TestHumaJWTMiddleware_APIKey_ActsAsNonStaffOwner(t *testing.T) {
+	jwtService := newTestJWTService()
+	mw := HumaJWTMiddleware(jwtService)
+
+	key := &authm.APIKey{ID: 3, UserID: 9, User: authm.User{ID: 9, IsAdmin: true, Role: authm.RoleSuperadmin, EmailVerified: true}}
+	req := httptest.NewRequest(http.MethodPost, "/shows", nil)
+	base, _ := newHumaContext(t, req)
+	ctx := huma.WithValue(base, APIKeyContextKey, key)
+
+	var got *authm.User
+	mw(ctx, func(next huma.Context) {
+		got = GetUserFromContext(next.Context())
+	})
+
+	require.NotNil(t, got, "next should be called with the key's owner")
+	if got.ID != 9 || !got.EmailVerified {
+		t.Errorf("expected the owner's identity to carry over, got %+v", got)
+	}
+	if got.IsAdmin || got.Role != authm.RoleUser {
+		t.Errorf("API key user must not be staff, got is_admin=%v role=%q", got.IsAdmin, got.Role)
+	}
+	if !key.User.IsAdmin || key.User.Role != authm.RoleSuperadmin {
+		t.Error("the key's own user must be left unchanged")
+	}
+}
+
 func TestHumaJWTMiddleware_InvalidBearerToken(t *testing.T) {
 	jwtService := newTestJWTService()
 	mw := HumaJWTMiddleware(jwtService)
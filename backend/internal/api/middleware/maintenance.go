@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/logger"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// maintenanceRetryAfterSeconds is the Retry-After hint sent with maintenance
+// 503s. Maintenance windows run minutes, not seconds.
+const maintenanceRetryAfterSeconds = 300
+
+// maintenanceSignInPrefixes keep sign-in working during maintenance so an
+// admin whose session has lapsed can still log in and turn it off.
+var maintenanceSignInPrefixes = []string{
+	"/auth/login",
+	"/auth/callback/",
+	"/auth/magic-link/",
+	"/auth/refresh",
+	"/auth/logout",
+	"/auth/profile",
+}
+
+// MaintenanceModeEnabled reports whether maintenance mode is on: forced by
+// the MAINTENANCE_MODE env var, or the maintenance_mode feature flag enabled
+// for everyone. An admin-only flag does not count; maintenance is a switch
+// for everyone else.
+func MaintenanceModeEnabled(forced bool, flags contracts.FeatureFlagServiceInterface) bool {
+	if forced {
+		return true
+	}
+	return flags != nil && flags.IsEnabled(adminm.FeatureFlagMaintenanceMode, false)
+}
+
+// isMaintenanceExempt reports whether r keeps working during maintenance:
+// health probes, admin routes (still guarded by their own admin checks) and
+// sign-in.
+func isMaintenanceExempt(r *http.Request) bool {
+	path := r.URL.Path
+	if databaseAvailabilityExemptPaths[path] || path == "/admin" || strings.HasPrefix(path, "/admin/") {
+		return true
+	}
+	for _, prefix := range maintenanceSignInPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaintenanceMode returns middleware that answers 503 MAINTENANCE_MODE with
+// a Retry-After header on every non-admin route while enabled() is true.
+// Health probes, /admin routes and sign-in stay up, and requests carrying an
+// admin JWT or an API token pass through everywhere so admins can check the
+// site before reopening it.
+func MaintenanceMode(jwtService *auth.JWTService, enabled func() bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !enabled() || isMaintenanceExempt(r) || isTrustedAPIToken(r) || isAdminTokenRequest(jwtService, r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			writeMaintenanceUnavailable(w, r)
+		})
+	}
+}
+
+// writeMaintenanceUnavailable writes the maintenance 503 problem response.
+func writeMaintenanceUnavailable(w http.ResponseWriter, r *http.Request) {
+	requestID := logger.GetRequestID(r.Context())
+	body, _ := json.Marshal(&huma.ErrorModel{
+		Title:  http.StatusText(http.StatusServiceUnavailable),
+		Status: http.StatusServiceUnavailable,
+		Detail: fmt.Sprintf("Down for maintenance [%s] (request_id: %s)", apperrors.CodeMaintenanceMode, requestID),
+	})
+	h := w.Header()
+	h.Set("Content-Type", "application/problem+json")
+	h.Set("Retry-After", strconv.Itoa(maintenanceRetryAfterSeconds))
+	w.WriteHeader(http.StatusServiceUnavailable)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func TestMaintenanceModeEnabled(t *testing.T) {
+	if !MaintenanceModeEnabled(true, nil) {
+		t.Error("expected MAINTENANCE_MODE to force maintenance on")
+	}
+	if MaintenanceModeEnabled(false, nil) {
+		t.Error("expected maintenance off without the env var or a flag service")
+	}
+	if !MaintenanceModeEnabled(false, &stubFlags{enabled: true}) {
+		t.Error("expected the maintenance_mode flag to turn maintenance on")
+	}
+	if MaintenanceModeEnabled(false, &stubFlags{enabledForAdmins: true}) {
+		t.Error("expected an admin-only maintenance_mode flag to leave maintenance off")
+	}
+}
+
+func TestMaintenanceMode(t *testing.T) {
+	on := true
+	handler := MaintenanceMode(nil, func() bool { return on })(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method, path, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := serve(http.MethodGet, "/shows", "")
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 for a public route, got %d", rr.Code)
+	}
+	if got := rr.Header().Get("Retry-After"); got != "300" {
+		t.Errorf("expected Retry-After 300, got %q", got)
+	}
+	if got := rr.Header().Get("Content-Type"); got != "application/problem+json" {
+		t.Errorf("expected problem+json, got %q", got)
+	}
+	var body huma.ErrorModel
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if !strings.Contains(body.Detail, "[MAINTENANCE_MODE]") {
+		t.Errorf("expected MAINTENANCE_MODE code in detail, got %q", body.Detail)
+	}
+
+	for _, path := range []string{"/health", "/readyz", "/admin/feature-flags", "/auth/login", "/auth/callback/google", "/auth/refresh"} {
+		if rr := serve(http.MethodGet, path, ""); rr.Code != http.StatusOK {
+			t.Errorf("expected %s to stay up during maintenance, got %d", path, rr.Code)
+		}
+	}
+
+	if rr := serve(http.MethodPost, "/shows", "Bearer "+APITokenPrefix+"deadbeef"); rr.Code != http.StatusOK {
+		t.Errorf("expected an API token to pass during maintenance, got %d", rr.Code)
+	}
+	if rr := serve(http.MethodGet, "/shows", "Bearer not-a-jwt"); rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected an invalid token to get 503, got %d", rr.Code)
+	}
+
+	on = false
+	if rr := serve(http.MethodGet, "/shows", ""); rr.Code != http.StatusOK {
+		t.Errorf("expected routes to serve once maintenance is off, got %d", rr.Code)
+	}
+}
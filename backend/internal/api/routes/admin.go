@@ -9,17 +9,20 @@ import (
 )
 
 // setupAdminRoutes configures admin-only endpoints.
-// PSY-423: every route here goes through rc.Admin, which chains
-// HumaJWTMiddleware + HumaAdminMiddleware. Handlers no longer need to call
-// shared.RequireAdmin(ctx) — the middleware short-circuits non-admin
-// requests before the handler runs.
+// PSY-423: every route here goes through one of the staff groups, which
+// chain HumaJWTMiddleware + a permission check. Handlers no longer need to
+// call shared.RequireAdmin(ctx) — the middleware short-circuits callers
+// without the permission before the handler runs. rc.Moderation carries the
+// review queues moderators work; rc.UserAdmin the user console; rc.Admin
+// everything else.
 func setupAdminRoutes(rc RouteContext) {
 	// Domain-specific admin handlers
 	statsHandler := adminh.NewAdminStatsHandler(rc.SC.AdminStats)
 	showHandler := adminh.NewAdminShowHandler(
-		rc.SC.Show, rc.SC.Show, rc.SC.Show, rc.SC.Discord, rc.SC.AuditLog, rc.SC.NotificationFilter,
+		rc.SC.Show, rc.SC.Show, rc.SC.Show, rc.SC.AdminNotifier, rc.SC.AuditLog, rc.SC.NotificationFilter, rc.SC.AdminAnnotation,
 	)
-	venueHandler := adminh.NewAdminVenueHandler(rc.SC.Venue, rc.SC.AuditLog)
+	showHandler.SetShowChangeNotifier(rc.SC.ShowChangeNotification)
+	venueHandler := adminh.NewAdminVenueHandler(rc.SC.Venue, rc.SC.AuditLog, rc.SC.AdminAnnotation)
 	userHandler := adminh.NewAdminUserHandler(rc.SC.User)
 	tokenHandler := adminh.NewAdminTokenHandler(rc.SC.APIToken)
 	dataHandler := adminh.NewAdminDataHandler(rc.SC.DataSync)
@@ -33,18 +36,33 @@ func setupAdminRoutes(rc RouteContext) {
 
 	// Admin dashboard stats endpoint
 	huma.Get(rc.Admin, "/admin/stats", statsHandler.GetAdminStatsHandler)
+	huma.Get(rc.Moderation, "/admin/stats/review-queue", statsHandler.GetReviewQueueStatsHandler)
+	huma.Get(rc.Admin, "/admin/stats/timeseries", statsHandler.GetStatsTimeSeriesHandler)
 	huma.Get(rc.Admin, "/admin/activity", statsHandler.GetActivityFeedHandler)
 
+	// Unified moderation inbox: pending shows, venue edits and reports in one
+	// type-tagged stream
+	huma.Get(rc.Moderation, "/admin/moderation-queue", statsHandler.GetModerationQueueHandler)
+
+	// Live admin queue updates (server-sent events)
+	huma.Get(rc.Moderation, "/admin/events", adminh.NewAdminEventsHandler(rc.SC.AdminEvents).StreamAdminEventsHandler)
+
 	// Admin show listing endpoint (for CLI export)
 	huma.Get(rc.Admin, "/admin/shows", showHandler.GetAdminShowsHandler)
 
 	// Admin show management endpoints
-	huma.Get(rc.Admin, "/admin/shows/pending", showHandler.GetPendingShowsHandler)
-	huma.Get(rc.Admin, "/admin/shows/rejected", showHandler.GetRejectedShowsHandler)
-	huma.Post(rc.Admin, "/admin/shows/{show_id}/approve", showHandler.ApproveShowHandler)
-	huma.Post(rc.Admin, "/admin/shows/{show_id}/reject", showHandler.RejectShowHandler)
-	huma.Post(rc.Admin, "/admin/shows/batch-approve", showHandler.BatchApproveShowsHandler)
-	huma.Post(rc.Admin, "/admin/shows/batch-reject", showHandler.BatchRejectShowsHandler)
+	huma.Get(rc.Moderation, "/admin/shows/pending", showHandler.GetPendingShowsHandler)
+	huma.Get(rc.Moderation, "/admin/shows/rejected", showHandler.GetRejectedShowsHandler)
+	huma.Post(rc.Moderation, "/admin/shows/{show_id}/approve", showHandler.ApproveShowHandler)
+	huma.Post(rc.Moderation, "/admin/shows/{show_id}/reject", showHandler.RejectShowHandler)
+	huma.Post(rc.Moderation, "/admin/shows/{show_id}/restore", showHandler.RestoreShowHandler)
+	huma.Get(rc.Moderation, "/admin/shows/{show_id}/duplicates", showHandler.GetShowDuplicatesHandler)
+	huma.Put(rc.Moderation, "/admin/shows/{show_id}/duplicate-of", showHandler.SetShowDuplicateOfHandler)
+	huma.Get(rc.Admin, "/admin/shows/{show_id}/ticket-status", showHandler.GetShowTicketStatusHandler)
+	huma.Get(rc.Admin, "/admin/shows/{show_id}/short-link-stats", showHandler.GetShowShortLinkStatsHandler)
+	huma.Post(rc.Moderation, "/admin/shows/batch-approve", showHandler.BatchApproveShowsHandler)
+	huma.Post(rc.Moderation, "/admin/shows/batch-reject", showHandler.BatchRejectShowsHandler)
+	huma.Post(rc.Admin, "/admin/shows/bulk", showHandler.BulkShowActionHandler)
 
 	// Admin show import endpoints (single)
 	huma.Post(rc.Admin, "/admin/shows/import/preview", showHandler.ImportShowPreviewHandler)
@@ -56,8 +74,15 @@ func setupAdminRoutes(rc RouteContext) {
 	huma.Post(rc.Admin, "/admin/shows/import/bulk/confirm", showHandler.BulkImportConfirmHandler)
 
 	// Admin venue management endpoints
-	huma.Get(rc.Admin, "/admin/venues/unverified", venueHandler.GetUnverifiedVenuesHandler)
-	huma.Post(rc.Admin, "/admin/venues/{venue_id}/verify", venueHandler.VerifyVenueHandler)
+	huma.Get(rc.Moderation, "/admin/venues/unverified", venueHandler.GetUnverifiedVenuesHandler)
+	huma.Post(rc.Moderation, "/admin/venues/{venue_id}/verify", venueHandler.VerifyVenueHandler)
+
+	// Admin command palette: batched approve-show / verify-venue /
+	// dismiss-report commands with per-command results and a dry-run mode.
+	commandHandler := adminh.NewAdminCommandHandler(
+		rc.SC.Show, rc.SC.Show, rc.SC.Venue, rc.SC.EntityReport, rc.SC.AdminNotifier, rc.SC.AuditLog, rc.SC.NotificationFilter,
+	)
+	huma.Post(rc.Moderation, "/admin/commands", commandHandler.RunAdminCommandsHandler)
 
 	// Admin artist management endpoints — UpdateArtistBandcamp/Spotify accept
 	// either an admin caller OR an internal-secret bypass for backfill bots,
@@ -76,6 +101,49 @@ func setupAdminRoutes(rc RouteContext) {
 	// Admin discovery endpoints (for local discovery app)
 	huma.Post(rc.Admin, "/admin/discovery/import", discoveryHandler.DiscoveryImportHandler)
 	huma.Post(rc.Admin, "/admin/discovery/check", discoveryHandler.DiscoveryCheckHandler)
+	huma.Get(rc.Admin, "/admin/discovery/sources", discoveryHandler.ListDiscoverySourcesHandler)
+
+	// Runtime feature flags. Handlers gate new behavior with
+	// middleware.FeatureEnabled / HumaFeatureFlagMiddleware.
+	featureFlagHandler := adminh.NewFeatureFlagHandler(rc.SC.FeatureFlags, rc.SC.AuditLog, rc.SC.AdminNotifier)
+	huma.Get(rc.Admin, "/admin/feature-flags", featureFlagHandler.ListFeatureFlagsHandler)
+	huma.Post(rc.Admin, "/admin/feature-flags", featureFlagHandler.CreateFeatureFlagHandler)
+	huma.Patch(rc.Admin, "/admin/feature-flags/{key}", featureFlagHandler.UpdateFeatureFlagHandler)
+	huma.Delete(rc.Admin, "/admin/feature-flags/{key}", featureFlagHandler.DeleteFeatureFlagHandler)
+
+	// JWT key ring: inspect key ids and reload after rotating the secrets.
+	jwtKeyHandler := adminh.NewAdminJWTKeyHandler(rc.SC.JWT, rc.SC.AuditLog)
+	huma.Get(rc.Admin, "/admin/auth/jwt-keys", jwtKeyHandler.GetJWTKeysHandler)
+	huma.Post(rc.Admin, "/admin/auth/jwt-keys/rotate", jwtKeyHandler.RotateJWTKeysHandler)
+
+	// Signed receipts for permanently deleted accounts (compliance requests).
+	deletionReceiptHandler := adminh.NewAdminDeletionReceiptHandler(rc.SC.User)
+	huma.Get(rc.Admin, "/admin/deletion-receipts", deletionReceiptHandler.ListDeletionReceiptsHandler)
+	huma.Get(rc.Admin, "/admin/deletion-receipts/{receipt_id}", deletionReceiptHandler.GetDeletionReceiptHandler)
+
+	// Email template previews, rendered with sample data.
+	emailPreviewHandler := adminh.NewEmailPreviewHandler(rc.SC.Email)
+	huma.Get(rc.Admin, "/admin/email-previews/{template}", emailPreviewHandler.GetEmailPreviewHandler)
+
+	// Internal admin notes and tags on shows, venues, artists, releases,
+	// labels and festivals. Never exposed outside admin endpoints.
+	annotationHandler := adminh.NewAdminAnnotationHandler(rc.SC.AdminAnnotation, rc.SC.AuditLog)
+	huma.Get(rc.Admin, "/admin/annotations/{entity_type}/{entity_id}", annotationHandler.GetAnnotationsHandler)
+	huma.Post(rc.Admin, "/admin/annotations/{entity_type}/{entity_id}/notes", annotationHandler.AddAdminNoteHandler)
+	huma.Post(rc.Admin, "/admin/annotations/{entity_type}/{entity_id}/tags", annotationHandler.AddAdminTagHandler)
+	huma.Delete(rc.Admin, "/admin/annotations/{entity_type}/{entity_id}/tags/{label}", annotationHandler.RemoveAdminTagHandler)
+	huma.Patch(rc.Admin, "/admin/annotation-notes/{note_id}", annotationHandler.UpdateAdminNoteHandler)
+	huma.Delete(rc.Admin, "/admin/annotation-notes/{note_id}", annotationHandler.DeleteAdminNoteHandler)
+	huma.Get(rc.Admin, "/admin/annotation-tags", annotationHandler.ListAdminTagsHandler)
+	huma.Get(rc.Admin, "/admin/annotation-tags/{label}", annotationHandler.ListTaggedEntitiesHandler)
+
+	// Admin import alias dictionary: maps scraped/seed venue and artist names
+	// to canonical entities, consulted by discovery and seed imports before
+	// name matching.
+	entityAliasHandler := adminh.NewEntityAliasHandler(rc.SC.EntityAlias, rc.SC.AuditLog)
+	huma.Get(rc.Admin, "/admin/entity-aliases", entityAliasHandler.ListEntityAliasesHandler)
+	huma.Post(rc.Admin, "/admin/entity-aliases", entityAliasHandler.CreateEntityAliasHandler)
+	huma.Delete(rc.Admin, "/admin/entity-aliases/{alias_id}", entityAliasHandler.DeleteEntityAliasHandler)
 
 	// Admin music-link suggestion review queue (PSY-1199). Pre-computed
 	// MusicBrainz-sourced Bandcamp/Spotify candidates the admin reviews in bulk.
@@ -97,28 +165,53 @@ func setupAdminRoutes(rc RouteContext) {
 	huma.Delete(rc.Admin, "/admin/tokens/{token_id}", tokenHandler.RevokeAPITokenHandler)
 
 	// Admin data export endpoints (for syncing local data to Stage/Production)
+	huma.Get(rc.Admin, "/admin/export/shows.csv", dataHandler.ExportShowsCSVHandler)
+	huma.Get(rc.Admin, "/admin/export/venues.csv", dataHandler.ExportVenuesCSVHandler)
+	huma.Get(rc.UserAdmin, "/admin/export/users.csv", dataHandler.ExportUsersCSVHandler)
 	huma.Get(rc.Admin, "/admin/export/shows", dataHandler.ExportShowsHandler)
 	huma.Get(rc.Admin, "/admin/export/artists", dataHandler.ExportArtistsHandler)
 	huma.Get(rc.Admin, "/admin/export/venues", dataHandler.ExportVenuesHandler)
 
 	// Admin data import endpoint (for syncing local data to Stage/Production)
 	huma.Post(rc.Admin, "/admin/data/import", dataHandler.DataImportHandler)
+	// Two-phase batch import: validate returns a per-row report and, when
+	// clean, a token that commit redeems for exactly the validated payload.
+	huma.Post(rc.Admin, "/admin/data/import/validate", dataHandler.ValidateDataImportHandler)
+	huma.Post(rc.Admin, "/admin/data/import/commit", dataHandler.CommitDataImportHandler)
 
 	// Admin audit log endpoint
 	huma.Get(rc.Admin, "/admin/audit-logs", auditLogHandler.GetAuditLogsHandler)
 
 	// Admin user list endpoint
-	huma.Get(rc.Admin, "/admin/users", userHandler.GetAdminUsersHandler)
+	huma.Get(rc.UserAdmin, "/admin/users", userHandler.GetAdminUsersHandler)
+
+	// Staff roles: any staff member can read the role matrix (and their own
+	// permissions); only superadmins grant roles.
+	roleHandler := adminh.NewAdminRoleHandler(rc.SC.User, rc.SC.AuditLog)
+	huma.Get(rc.Moderation, "/admin/roles", roleHandler.ListRolesHandler)
+	huma.Put(rc.RoleAdmin, "/admin/users/{user_id}/role", roleHandler.SetUserRoleHandler)
+
+	// Submission quarantine: users whose new shows are held for review
+	// (set automatically on a submission burst, or by a moderator).
+	quarantineHandler := adminh.NewAdminQuarantineHandler(rc.SC.SubmissionThrottle, rc.SC.AuditLog)
+	huma.Get(rc.Moderation, "/admin/quarantined-users", quarantineHandler.ListQuarantinedUsersHandler)
+	huma.Put(rc.Moderation, "/admin/users/{user_id}/quarantine", quarantineHandler.QuarantineUserHandler)
+	huma.Delete(rc.Moderation, "/admin/users/{user_id}/quarantine", quarantineHandler.ReleaseUserQuarantineHandler)
 
 	// Admin data quality endpoints
 	dataQualityHandler := adminh.NewDataQualityHandler(rc.SC.DataQuality)
 	huma.Get(rc.Admin, "/admin/data-quality", dataQualityHandler.GetDataQualitySummaryHandler)
 	huma.Get(rc.Admin, "/admin/data-quality/{category}", dataQualityHandler.GetDataQualityCategoryHandler)
 
+	// Admin visibility debugger: step-by-step report of why a show is or is
+	// not visible to a given user (omit user_id for the logged-out view).
+	visibilityDebugHandler := adminh.NewVisibilityDebugHandler(rc.SC.VisibilityDebug)
+	huma.Get(rc.Admin, "/admin/debug/visibility", visibilityDebugHandler.ExplainShowVisibilityHandler)
+
 	// Admin auto-promotion endpoints (manual trigger for tier evaluation)
 	autoPromotionHandler := adminh.NewAutoPromotionHandler(rc.SC.AutoPromotion)
-	huma.Post(rc.Admin, "/admin/auto-promotion/evaluate", autoPromotionHandler.EvaluateAllUsersHandler)
-	huma.Get(rc.Admin, "/admin/auto-promotion/evaluate/{user_id}", autoPromotionHandler.EvaluateUserHandler)
+	huma.Post(rc.UserAdmin, "/admin/auto-promotion/evaluate", autoPromotionHandler.EvaluateAllUsersHandler)
+	huma.Get(rc.UserAdmin, "/admin/auto-promotion/evaluate/{user_id}", autoPromotionHandler.EvaluateUserHandler)
 
 	// Admin analytics endpoints
 	analyticsHandler := adminh.NewAnalyticsHandler(rc.SC.Analytics)
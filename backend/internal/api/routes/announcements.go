@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/danielgtaylor/huma/v2"
+
+	adminh "psychic-homily-backend/internal/api/handlers/admin"
+	"psychic-homily-backend/internal/api/middleware"
+)
+
+// setupAnnouncementRoutes configures site-wide announcement banners: admin
+// management, the public read every page makes, and per-user dismissal.
+func setupAnnouncementRoutes(rc RouteContext) {
+	handler := adminh.NewAnnouncementHandler(rc.SC.Announcement, rc.SC.AuditLog)
+
+	huma.Get(rc.Admin, "/admin/announcements", handler.ListAnnouncementsHandler)
+	huma.Post(rc.Admin, "/admin/announcements", handler.CreateAnnouncementHandler)
+	huma.Patch(rc.Admin, "/admin/announcements/{announcement_id}", handler.UpdateAnnouncementHandler)
+	huma.Post(rc.Admin, "/admin/announcements/{announcement_id}/expire", handler.ExpireAnnouncementHandler)
+
+	// Optional auth: anonymous visitors get every active banner; signed-in
+	// users don't get the ones they dismissed.
+	optionalAuthGroup := huma.NewGroup(rc.API, "")
+	optionalAuthGroup.UseMiddleware(middleware.OptionalHumaJWTMiddleware(rc.SC.JWT))
+	huma.Get(optionalAuthGroup, "/announcements/active", handler.GetActiveAnnouncementsHandler)
+
+	huma.Post(rc.Protected, "/announcements/{announcement_id}/dismiss", handler.DismissAnnouncementHandler)
+}
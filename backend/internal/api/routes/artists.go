@@ -8,6 +8,8 @@ import (
 
 func setupArtistRoutes(rc RouteContext) {
 	artistHandler := catalogh.NewArtistHandler(rc.SC.Artist, rc.SC.AuditLog, rc.SC.Revision, rc.Cfg)
+	artistHandler.SetArtistClaimService(rc.SC.ArtistClaim)
+	claimHandler := catalogh.NewArtistClaimHandler(rc.SC.ArtistClaim, rc.SC.AuditLog)
 
 	// Public artist endpoints - registered on main API without middleware
 	// Note: Static routes must come before parameterized routes
@@ -21,6 +23,18 @@ func setupArtistRoutes(rc RouteContext) {
 
 	// Protected artist endpoints (any authenticated user)
 	huma.Delete(rc.Protected, "/artists/{artist_id}", artistHandler.DeleteArtistHandler)
+	huma.Patch(rc.Protected, "/artists/{artist_id}/profile", artistHandler.UpdateArtistProfileHandler)
+
+	// Artist claims: artists and their teams request to manage the artist's
+	// page; admins review the queue and manage the resulting grants.
+	huma.Post(rc.Protected, "/artists/{artist_id}/claim", claimHandler.SubmitArtistClaimHandler)
+	huma.Get(rc.Protected, "/my/artist-claims", claimHandler.GetMyArtistClaimsHandler)
+	huma.Get(rc.Protected, "/my/managed-artists", claimHandler.GetMyManagedArtistsHandler)
+	huma.Get(rc.Admin, "/admin/artist-claims", claimHandler.ListArtistClaimsHandler)
+	huma.Post(rc.Admin, "/admin/artist-claims/{claim_id}/approve", claimHandler.ApproveArtistClaimHandler)
+	huma.Post(rc.Admin, "/admin/artist-claims/{claim_id}/deny", claimHandler.DenyArtistClaimHandler)
+	huma.Get(rc.Admin, "/admin/artists/{artist_id}/managers", claimHandler.ListArtistManagersHandler)
+	huma.Delete(rc.Admin, "/admin/artists/{artist_id}/managers/{user_id}", claimHandler.RemoveArtistManagerHandler)
 
 	// Admin artist endpoints (PSY-423: rc.Admin enforces auth + IsAdmin)
 	huma.Post(rc.Admin, "/admin/artists", artistHandler.AdminCreateArtistHandler)
@@ -28,4 +42,5 @@ func setupArtistRoutes(rc RouteContext) {
 	huma.Post(rc.Admin, "/admin/artists/{artist_id}/aliases", artistHandler.AddArtistAliasHandler)
 	huma.Delete(rc.Admin, "/admin/artists/{artist_id}/aliases/{alias_id}", artistHandler.DeleteArtistAliasHandler)
 	huma.Post(rc.Admin, "/admin/artists/merge", artistHandler.MergeArtistsHandler)
+	huma.Post(rc.Admin, "/admin/artists/{artist_id}/merge", artistHandler.MergeArtistIntoHandler)
 }
@@ -16,7 +16,7 @@ import (
 
 // setupAuthRoutes configures all authentication-related endpoints
 func setupAuthRoutes(rc RouteContext) {
-	authHandler := authh.NewAuthHandler(rc.SC.Auth, rc.SC.JWT, rc.SC.User, rc.SC.Email, rc.SC.Discord, rc.SC.PasswordValidator, rc.Cfg)
+	authHandler := authh.NewAuthHandler(rc.SC.Auth, rc.SC.JWT, rc.SC.User, rc.SC.Email, rc.SC.AdminNotifier, rc.SC.PasswordValidator, rc.Cfg)
 	oauthHTTPHandler := authh.NewOAuthHTTPHandler(rc.SC.Auth, rc.Cfg)
 
 	// Create rate limiter for auth endpoints: 10 requests per minute per IP
@@ -65,7 +65,7 @@ func setupAuthRoutes(rc RouteContext) {
 		huma.Post(rateLimitedAPI, "/auth/magic-link/verify", authHandler.VerifyMagicLinkHandler)
 
 		// Sign in with Apple (public, rate-limited)
-		appleAuthHandler := authh.NewAppleAuthHandler(rc.SC.AppleAuth, rc.SC.Discord, rc.Cfg)
+		appleAuthHandler := authh.NewAppleAuthHandler(rc.SC.AppleAuth, rc.SC.AdminNotifier, rc.Cfg)
 		huma.Post(rateLimitedAPI, "/auth/apple/callback", appleAuthHandler.AppleCallbackHandler)
 
 		// Account recovery endpoints (public, rate-limited)
@@ -83,7 +83,7 @@ func setupAuthRoutes(rc RouteContext) {
 // and the email-verify confirm endpoint). Split out of SetupRoutes during the
 // PSY-422 routes.go decomposition; behavior unchanged.
 func setupProtectedAuthRoutes(rc RouteContext) {
-	authHandler := authh.NewAuthHandler(rc.SC.Auth, rc.SC.JWT, rc.SC.User, rc.SC.Email, rc.SC.Discord, rc.SC.PasswordValidator, rc.Cfg)
+	authHandler := authh.NewAuthHandler(rc.SC.Auth, rc.SC.JWT, rc.SC.User, rc.SC.Email, rc.SC.AdminNotifier, rc.SC.PasswordValidator, rc.Cfg)
 
 	huma.Get(rc.Protected, "/auth/profile", authHandler.GetProfileHandler)
 	huma.Patch(rc.Protected, "/auth/profile", authHandler.UpdateProfileHandler)
@@ -111,12 +111,23 @@ func setupProtectedAuthRoutes(rc RouteContext) {
 	huma.Post(rc.Admin, "/auth/cli-token", authHandler.GenerateCLITokenHandler)
 
 	// OAuth account management endpoints
-	oauthAccountHandler := authh.NewOAuthAccountHandler(rc.SC.User)
+	oauthAccountHandler := authh.NewOAuthAccountHandler(rc.SC.User, rc.Cfg)
 	huma.Get(rc.Protected, "/auth/oauth/accounts", oauthAccountHandler.GetOAuthAccountsHandler)
 	huma.Delete(rc.Protected, "/auth/oauth/accounts/{provider}", oauthAccountHandler.UnlinkOAuthAccountHandler)
+	// Link a provider to the signed-in account; completes in the OAuth callback.
+	huma.Post(rc.Protected, "/auth/link/{provider}", oauthAccountHandler.LinkOAuthAccountHandler)
+
+	// Public API key management. Keys are scoped and throttled per key; these
+	// endpoints are JWT-only (untagged, so API keys are refused).
+	apiKeyHandler := authh.NewAPIKeyHandler(rc.SC.APIKey)
+	huma.Get(rc.Protected, "/auth/api-keys", apiKeyHandler.ListAPIKeysHandler)
+	huma.Post(rc.Protected, "/auth/api-keys", apiKeyHandler.CreateAPIKeyHandler)
+	huma.Get(rc.Protected, "/auth/api-keys/{key_id}", apiKeyHandler.GetAPIKeyHandler)
+	huma.Delete(rc.Protected, "/auth/api-keys/{key_id}", apiKeyHandler.RevokeAPIKeyHandler)
 
 	// User preferences endpoints
-	userPrefsHandler := authh.NewUserPreferencesHandler(rc.SC.User, rc.Cfg.JWT.SecretKey)
+	userPrefsHandler := authh.NewUserPreferencesHandler(rc.SC.User, rc.Cfg.JWT.KeyRing())
+	userPrefsHandler.SetNotificationPreferenceService(rc.SC.NotificationPreference)
 	huma.Put(rc.Protected, "/auth/preferences/favorite-cities", userPrefsHandler.SetFavoriteCitiesHandler)
 	// PSY-1423: /charts window + scene landing defaults.
 	huma.Put(rc.Protected, "/auth/preferences/chart-defaults", userPrefsHandler.SetChartDefaultsHandler)
@@ -153,6 +164,9 @@ func setupProtectedAuthRoutes(rc RouteContext) {
 	// PSY-1342: weekly scene digest unsubscribe (same chi GET+POST shape).
 	rc.Router.Get("/unsubscribe/scene-digest", userPrefsHandler.UnsubscribeSceneDigestPageHandler)
 	rc.Router.Post("/unsubscribe/scene-digest", userPrefsHandler.UnsubscribeSceneDigestPageHandler)
+	// Cancelled/postponed saved-show emails (same chi GET+POST shape).
+	rc.Router.Get("/unsubscribe/saved-show-changes", userPrefsHandler.UnsubscribeSavedShowChangesPageHandler)
+	rc.Router.Post("/unsubscribe/saved-show-changes", userPrefsHandler.UnsubscribeSavedShowChangesPageHandler)
 
 	// Public email verification confirm endpoint (user clicks link from email)
 	huma.Post(rc.API, "/auth/verify-email/confirm", authHandler.ConfirmVerificationHandler)
@@ -168,7 +182,7 @@ func setupPasskeyRoutes(rc RouteContext) {
 		return
 	}
 
-	passkeyHandler := authh.NewPasskeyHandler(rc.SC.WebAuthn, rc.SC.JWT, rc.SC.User, rc.Cfg)
+	passkeyHandler := authh.NewPasskeyHandler(rc.SC.WebAuthn, rc.SC.JWT, rc.SC.User, rc.SC.AuditLog, rc.Cfg)
 
 	// Create rate limiter for passkey endpoints: 20 requests per minute per IP
 	// Slightly more lenient than auth due to multi-step WebAuthn flow.
@@ -7,7 +7,8 @@ import (
 )
 
 // setupChartsRoutes configures the top charts endpoints.
-// All endpoints are public except /charts/me, the authed personal stats strip.
+// All endpoints are public except /charts/me, the authed personal stats strip,
+// and /stats/year-in-review/me.
 func setupChartsRoutes(rc RouteContext) {
 	chartsHandler := catalogh.NewChartsHandler(rc.SC.Charts)
 
@@ -38,7 +39,15 @@ func setupChartsRoutes(rc RouteContext) {
 	// (same cheap COUNT + Cache-Control shape as /charts/summary).
 	huma.Get(rc.API, "/community/pulse", chartsHandler.GetCommunityPulseHandler)
 
+	// Homepage hero numbers, on the same masthead cache tier as the pulse.
+	huma.Get(rc.API, "/stats/public", chartsHandler.GetPublicStatsHandler)
+
 	// Personal stats strip: the user's own aggregates, so it requires auth
 	// (anonymous → 401; the frontend simply doesn't render the strip).
 	huma.Get(rc.Protected, "/charts/me", chartsHandler.GetPersonalChartsStatsHandler)
+
+	// End-of-year recap: the community version is public and TTL-cached;
+	// the /me variant is computed over the user's saved shows.
+	huma.Get(rc.API, "/stats/year-in-review", chartsHandler.GetYearInReviewHandler)
+	huma.Get(rc.Protected, "/stats/year-in-review/me", chartsHandler.GetPersonalYearInReviewHandler)
 }
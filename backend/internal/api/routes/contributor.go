@@ -25,6 +25,7 @@ func setupContributorProfileRoutes(rc RouteContext) {
 	// PSY-1046: public profile list surfaces (privacy-gated per field)
 	huma.Get(optionalAuthGroup, "/users/{username}/following", profileHandler.GetUserFollowingHandler)
 	huma.Get(optionalAuthGroup, "/users/{username}/field-notes", profileHandler.GetUserFieldNotesHandler)
+	huma.Get(optionalAuthGroup, "/users/{username}/shows", profileHandler.GetUserSubmittedShowsHandler)
 
 	// Protected endpoints for authenticated user's own profile
 	huma.Get(rc.Protected, "/auth/profile/contributor", profileHandler.GetOwnProfileHandler)
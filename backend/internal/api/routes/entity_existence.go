@@ -10,4 +10,8 @@ func setupEntityExistenceRoutes(rc RouteContext) {
 	entityExistenceHandler := catalogh.NewEntityExistenceHandler(rc.SC.EntityExistence)
 
 	huma.Head(rc.API, "/entities/{entity_type}/{entity_id}/exists", entityExistenceHandler.EntityExistsHandler)
+
+	// Legacy numeric-ID links ↔ canonical slugs, batched
+	entityResolveHandler := catalogh.NewEntityResolveHandler(rc.SC.EntityExistence)
+	huma.Get(rc.API, "/resolve", entityResolveHandler.ResolveEntitiesHandler)
 }
@@ -11,8 +11,9 @@ import (
 //
 //   - User queue-create on rc.Protected (auth required; trust-tier gating is
 //     in the service — contributor/new_user file a pending request).
-//   - Admin list + decide on rc.Admin (auth + IsAdmin enforced by middleware,
-//     per PSY-423; handlers carry no inline admin check).
+//   - Admin list + decide on rc.Moderation (auth + moderate_content enforced
+//     by middleware, per PSY-423; handlers carry no inline admin check).
+//     Fulfill stays on rc.Admin.
 //
 // These are SEPARATE from the pending_entity_edits admin endpoints — PSY-871's
 // frontend unifies the two queues into one page; the backend keeps them
@@ -28,8 +29,8 @@ func setupEntityRequestRoutes(rc RouteContext) {
 	huma.Post(rc.Protected, "/entity-requests", entityRequestHandler.CreateEntityRequestHandler)
 
 	// Admin: moderation queue (consumed by PSY-871).
-	huma.Get(rc.Admin, "/admin/entity-requests", entityRequestHandler.AdminListEntityRequestsHandler)
-	huma.Post(rc.Admin, "/admin/entity-requests/{id}/decide", entityRequestHandler.AdminDecideEntityRequestHandler)
+	huma.Get(rc.Moderation, "/admin/entity-requests", entityRequestHandler.AdminListEntityRequestsHandler)
+	huma.Post(rc.Moderation, "/admin/entity-requests/{id}/decide", entityRequestHandler.AdminDecideEntityRequestHandler)
 
 	// Admin: rescue an approved-but-unfulfilled request — fulfill (re-run the
 	// catalog create, supplying show associations) or void it (PSY-1088). The
@@ -52,6 +52,9 @@ func setupFollowRoutes(rc RouteContext) {
 	// User's following list (protected)
 	huma.Get(rc.Protected, "/me/following", followHandler.GetMyFollowingHandler)
 
+	// Upcoming shows featuring artists the user follows (protected)
+	huma.Get(rc.Protected, "/me/following/shows", followHandler.GetMyFollowedArtistShowsHandler)
+
 	// Library-specific following read model (protected): one aggregate-count
 	// query plus bounded, deterministic alphabetical pages by entity type.
 	huma.Get(rc.Protected, "/me/library/following/counts", followHandler.GetLibraryFollowingCountsHandler)
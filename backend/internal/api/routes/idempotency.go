@@ -0,0 +1,64 @@
+package routes
+
+import (
+	"net/http"
+	"regexp"
+
+	"psychic-homily-backend/internal/api/middleware"
+	"psychic-homily-backend/internal/services/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Idempotency-Key support for the mutations mobile clients retry on flaky
+// networks: show submission, reports, and save/follow toggles. Mounted
+// globally in cmd/server/main.go so the show-create and report sub-APIs
+// (their own humachi instances) are covered along with rc.Protected.
+
+// idempotentRoutes match the in-scope mutations on their concrete request
+// paths (path params already substituted).
+var idempotentRoutes = []struct {
+	methods []string
+	pattern *regexp.Regexp
+}{
+	// Show submission
+	{[]string{http.MethodPost}, regexp.MustCompile(`^/shows$`)},
+	// Report creation: /shows/{id}/report, /artists/{id}/report,
+	// /shows/{id}/entity-report, /venues/{id}/report, ...
+	{[]string{http.MethodPost}, regexp.MustCompile(`^/[^/]+/[^/]+/(report|entity-report)$`)},
+	// Save/unsave show and release
+	{[]string{http.MethodPost, http.MethodDelete}, regexp.MustCompile(`^/saved-(shows|releases)/[0-9]+$`)},
+	// Follow/unfollow (favorite venues are venue follows)
+	{[]string{http.MethodPost, http.MethodDelete}, regexp.MustCompile(`^/[^/]+/[^/]+/follow$`)},
+}
+
+// isIdempotentRequest reports whether r is one of the mutations above.
+func isIdempotentRequest(r *http.Request) bool {
+	for _, route := range idempotentRoutes {
+		if !route.pattern.MatchString(r.URL.Path) {
+			continue
+		}
+		for _, method := range route.methods {
+			if r.Method == method {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IdempotencyMiddleware returns the chi middleware that honors
+// Idempotency-Key on the in-scope mutations. Every other request passes
+// straight through, header or not.
+func IdempotencyMiddleware(jwtService *auth.JWTService, store contracts.IdempotencyServiceInterface) func(http.Handler) http.Handler {
+	idempotent := middleware.Idempotency(jwtService, store)
+	return func(next http.Handler) http.Handler {
+		wrapped := idempotent(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isIdempotentRequest(r) {
+				wrapped.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"psychic-homily-backend/internal/api/middleware"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestIsIdempotentRequest(t *testing.T) {
+	cases := []struct {
+		method, path string
+		want         bool
+	}{
+		{http.MethodPost, "/shows", true},
+		{http.MethodPost, "/shows/12/report", true},
+		{http.MethodPost, "/artists/3/report", true},
+		{http.MethodPost, "/shows/12/entity-report", true},
+		{http.MethodPost, "/venues/4/report", true},
+		{http.MethodPost, "/saved-shows/12", true},
+		{http.MethodDelete, "/saved-shows/12", true},
+		{http.MethodPost, "/saved-releases/5", true},
+		{http.MethodPost, "/venues/4/follow", true},
+		{http.MethodDelete, "/artists/3/follow", true},
+		{http.MethodPost, "/scenes/phoenix-az/follow", true},
+
+		{http.MethodGet, "/shows", false},
+		{http.MethodPut, "/shows/12", false},
+		{http.MethodPost, "/shows/ai-process", false},
+		{http.MethodPost, "/shows/drafts", false},
+		{http.MethodGet, "/saved-shows/12", false},
+		{http.MethodPost, "/saved-shows/import/confirm", false},
+		{http.MethodPost, "/follows/batch", false},
+		{http.MethodPost, "/admin/reports/3/dismiss", false},
+	}
+	for _, tc := range cases {
+		r := httptest.NewRequest(tc.method, tc.path, nil)
+		if got := isIdempotentRequest(r); got != tc.want {
+			t.Errorf("%s %s: got %v, want %v", tc.method, tc.path, got, tc.want)
+		}
+	}
+}
+
+// recordingIdempotencyStore counts Acquire calls and always grants the claim.
+type recordingIdempotencyStore struct{ acquired int }
+
+func (s *recordingIdempotencyStore) Acquire(req contracts.IdempotencyRequest) (*adminm.IdempotencyKey, bool, error) {
+	s.acquired++
+	return &adminm.IdempotencyKey{ID: uint(s.acquired), RequestHash: req.RequestHash}, true, nil
+}
+func (s *recordingIdempotencyStore) Complete(uint, int, string, []byte) error { return nil }
+func (s *recordingIdempotencyStore) Release(uint) error                       { return nil }
+
+func TestIdempotencyMiddleware_OnlyInScopeRoutes(t *testing.T) {
+	store := &recordingIdempotencyStore{}
+	handler := IdempotencyMiddleware(nil, store)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, path := range []string{"/shows", "/shows/12"} {
+		method := http.MethodPost
+		if path != "/shows" {
+			method = http.MethodPut
+		}
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(middleware.IdempotencyKeyHeader, "k1")
+		req.Header.Set("Authorization", "Bearer phk_abc")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if store.acquired != 1 {
+		t.Errorf("Acquire called %d times, want 1 (only POST /shows is in scope)", store.acquired)
+	}
+}
@@ -9,7 +9,7 @@ import (
 // setupNotificationFilterRoutes configures notification filter and notification log endpoints.
 // CRUD and notifications require authentication. Unsubscribe is public (HMAC-signed).
 func setupNotificationFilterRoutes(rc RouteContext) {
-	filterHandler := notificationh.NewNotificationFilterHandler(rc.SC.NotificationFilter, rc.Cfg.JWT.SecretKey)
+	filterHandler := notificationh.NewNotificationFilterHandler(rc.SC.NotificationFilter, rc.Cfg.JWT.KeyRing())
 
 	// Protected: filter CRUD
 	huma.Get(rc.Protected, "/me/notification-filters", filterHandler.ListFiltersHandler)
@@ -22,6 +22,24 @@ func setupNotificationFilterRoutes(rc RouteContext) {
 	huma.Get(rc.Protected, "/me/notifications", filterHandler.GetNotificationsHandler)
 	huma.Post(rc.Protected, "/me/notifications/mark-read", filterHandler.MarkNotificationsReadHandler)
 
+	// Protected: notification preference matrix
+	prefHandler := notificationh.NewNotificationPreferenceHandler(rc.SC.NotificationPreference)
+	huma.Get(rc.Protected, "/users/me/notification-preferences", prefHandler.GetNotificationPreferencesHandler)
+	huma.Put(rc.Protected, "/users/me/notification-preferences", prefHandler.UpdateNotificationPreferencesHandler)
+
+	// Web Push: the key is public so the client can check availability before login
+	pushHandler := notificationh.NewPushHandler(rc.SC.Push)
+	huma.Get(rc.API, "/push/vapid-public-key", pushHandler.GetVAPIDPublicKeyHandler)
+	huma.Post(rc.Protected, "/me/push-subscriptions", pushHandler.SubscribePushHandler)
+	huma.Delete(rc.Protected, "/me/push-subscriptions", pushHandler.UnsubscribePushHandler)
+
 	// Public: HMAC-signed unsubscribe
 	huma.Post(rc.API, "/unsubscribe/filter/{id}", filterHandler.UnsubscribeFilterHandler)
+
+	// Public: email provider bounce/complaint webhooks, authenticated by
+	// EMAIL_WEBHOOK_SECRET and only mounted when it is set
+	if rc.Cfg.Email.WebhookSecret != "" {
+		webhookHandler := notificationh.NewEmailWebhookHandler(rc.SC.EmailSuppressions, rc.Cfg.Email.WebhookSecret)
+		rc.Router.Post("/webhooks/email/{provider}", webhookHandler.BounceWebhookHandler)
+	}
 }
@@ -4,9 +4,11 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 
 	pipelineh "psychic-homily-backend/internal/api/handlers/pipeline"
+	"psychic-homily-backend/internal/api/middleware"
 )
 
-// setupPipelineRoutes configures the enrichment admin endpoints. The legacy
+// setupPipelineRoutes configures the enrichment admin endpoints and the
+// token-authenticated scraper push endpoint. The legacy
 // venue-extraction routes (extract/venues/imports) were removed with the
 // extraction pipeline in PSY-1165.
 // PSY-423: rc.Admin enforces auth + IsAdmin upstream so handlers don't need
@@ -16,4 +18,14 @@ func setupPipelineRoutes(rc RouteContext) {
 
 	huma.Get(rc.Admin, "/admin/pipeline/enrichment/status", pipelineHandler.EnrichmentStatusHandler)
 	huma.Post(rc.Admin, "/admin/pipeline/enrichment/trigger/{show_id}", pipelineHandler.TriggerEnrichmentHandler)
+
+	// Scraper push endpoint: same payload as the discovery-import CLI, so
+	// scrapers don't have to run next to the database. Admin API tokens only —
+	// session cookies and JWTs are refused.
+	apiTokenGroup := huma.NewGroup(rc.API, "")
+	apiTokenGroup.UseMiddleware(middleware.HumaAPITokenMiddleware(rc.SC.APIToken))
+	apiTokenGroup.UseMiddleware(middleware.HumaSentryContextMiddleware)
+
+	discoveryHandler := pipelineh.NewAdminDiscoveryHandler(rc.SC.Discovery)
+	huma.Post(apiTokenGroup, "/discovery/import", discoveryHandler.DiscoveryPushHandler)
 }
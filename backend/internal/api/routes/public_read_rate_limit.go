@@ -46,11 +46,11 @@ func IsPublicReadRateLimitEnabled(getenv func(string) string) bool {
 }
 
 // infraPathsExemptFromRateLimit are exact request paths a global anonymous
-// limiter must NEVER throttle. /health is polled anonymously and often from a
-// single IP by load balancers / uptime probes; a 429 there would flap the
-// service unhealthy and cause an outage — the opposite of what abuse-protection
-// should do.
-var infraPathsExemptFromRateLimit = []string{"/health"}
+// limiter must NEVER throttle. /health, /healthz and /readyz are polled
+// anonymously and often from a single IP by load balancers / uptime probes; a
+// 429 there would flap the service unhealthy and cause an outage — the
+// opposite of what abuse-protection should do.
+var infraPathsExemptFromRateLimit = []string{"/health", "/healthz", "/readyz"}
 
 // personalFeedPathPrefixesExemptFromRateLimit are token-authenticated personal
 // feeds (PSY-1430 iCal + PSY-1505 Atom). Google Calendar / Apple Calendar / RSS
@@ -246,21 +246,24 @@ func TestPublicReadRateLimiter_WritesNotLimited(t *testing.T) {
 	}
 }
 
-// /health is exempt — a load-balancer/uptime probe hammering it anonymously
-// from one IP must never be 429'd (that would flap the service unhealthy).
+// /health, /healthz and /readyz are exempt — a load-balancer/uptime probe
+// hammering them anonymously from one IP must never be 429'd (that would flap
+// the service unhealthy).
 func TestPublicReadRateLimiter_HealthPathExempt(t *testing.T) {
 	mw := PublicReadRateLimiter(nil, enableEnv)
 	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
 
-	for i := 0; i < middleware.APIRequestsPerMinute+5; i++ {
-		req := httptest.NewRequest(http.MethodGet, "/health", nil)
-		req.RemoteAddr = "7.7.7.9:100"
-		rr := httptest.NewRecorder()
-		handler.ServeHTTP(rr, req)
-		if rr.Code != http.StatusOK {
-			t.Fatalf("health probe %d: status = %d, want 200 (/health must be exempt)", i, rr.Code)
+	for _, path := range []string{"/health", "/healthz", "/readyz"} {
+		for i := 0; i < middleware.APIRequestsPerMinute+5; i++ {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.RemoteAddr = "7.7.7.9:100"
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if rr.Code != http.StatusOK {
+				t.Fatalf("%s probe %d: status = %d, want 200 (%s must be exempt)", path, i, rr.Code, path)
+			}
 		}
 	}
 }
@@ -26,7 +26,7 @@ func setupRadioRoutes(rc RouteContext) {
 		rc.SC.Email,
 		rc.Cfg.Email.FrontendURL,
 		engagement.DeriveBackendURL(rc.Cfg.Email.FrontendURL),
-		rc.Cfg.JWT.SecretKey,
+		rc.Cfg.JWT.KeyRing(),
 	)
 
 	// Public radio station endpoints
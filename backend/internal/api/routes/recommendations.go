@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/danielgtaylor/huma/v2"
+
+	engagementh "psychic-homily-backend/internal/api/handlers/engagement"
+)
+
+// setupRecommendationRoutes configures personalized recommendation endpoints.
+// Recommendations are built from the caller's private history, so they are
+// protected.
+func setupRecommendationRoutes(rc RouteContext) {
+	handler := engagementh.NewRecommendationHandler(rc.SC.Recommendation)
+
+	huma.Get(rc.Protected, "/users/me/recommended-shows", handler.GetRecommendedShowsHandler)
+}
@@ -15,7 +15,7 @@ import (
 // setupShowReportRoutes configures show report endpoints
 // All endpoints require authentication via protected group
 func setupShowReportRoutes(rc RouteContext) {
-	showReportHandler := communityh.NewShowReportHandler(rc.SC.ShowReport, rc.SC.Discord, rc.SC.User, rc.SC.AuditLog)
+	showReportHandler := communityh.NewShowReportHandler(rc.SC.ShowReport, rc.SC.AdminNotifier, rc.SC.User, rc.SC.AuditLog)
 
 	// Rate-limited report submission: 5 requests per minute per IP
 	// Prevents spamming admins with reports
@@ -35,15 +35,15 @@ func setupShowReportRoutes(rc RouteContext) {
 	// Protected report endpoints (no additional rate limiting)
 	huma.Get(rc.Protected, "/shows/{show_id}/my-report", showReportHandler.GetMyReportHandler)
 
-	// Admin endpoints for managing reports (PSY-423: rc.Admin enforces auth + IsAdmin)
-	huma.Get(rc.Admin, "/admin/reports", showReportHandler.GetPendingReportsHandler)
-	huma.Post(rc.Admin, "/admin/reports/{report_id}/dismiss", showReportHandler.DismissReportHandler)
-	huma.Post(rc.Admin, "/admin/reports/{report_id}/resolve", showReportHandler.ResolveReportHandler)
+	// Admin endpoints for managing reports (PSY-423; rc.Moderation enforces auth + moderate_content)
+	huma.Get(rc.Moderation, "/admin/reports", showReportHandler.GetPendingReportsHandler)
+	huma.Post(rc.Moderation, "/admin/reports/{report_id}/dismiss", showReportHandler.DismissReportHandler)
+	huma.Post(rc.Moderation, "/admin/reports/{report_id}/resolve", showReportHandler.ResolveReportHandler)
 }
 
 // setupArtistReportRoutes configures artist report endpoints
 func setupArtistReportRoutes(rc RouteContext) {
-	artistReportHandler := communityh.NewArtistReportHandler(rc.SC.ArtistReport, rc.SC.Discord, rc.SC.User, rc.SC.AuditLog)
+	artistReportHandler := communityh.NewArtistReportHandler(rc.SC.ArtistReport, rc.SC.AdminNotifier, rc.SC.User, rc.SC.AuditLog)
 
 	// Rate-limited report submission: 5 requests per minute per IP
 	rc.Router.Group(func(r chi.Router) {
@@ -62,10 +62,39 @@ func setupArtistReportRoutes(rc RouteContext) {
 	// Protected report endpoints (no additional rate limiting)
 	huma.Get(rc.Protected, "/artists/{artist_id}/my-report", artistReportHandler.GetMyArtistReportHandler)
 
-	// Admin endpoints for managing artist reports (PSY-423: rc.Admin enforces auth + IsAdmin)
-	huma.Get(rc.Admin, "/admin/artist-reports", artistReportHandler.GetPendingArtistReportsHandler)
-	huma.Post(rc.Admin, "/admin/artist-reports/{report_id}/dismiss", artistReportHandler.DismissArtistReportHandler)
-	huma.Post(rc.Admin, "/admin/artist-reports/{report_id}/resolve", artistReportHandler.ResolveArtistReportHandler)
+	// Admin endpoints for managing artist reports (PSY-423; rc.Moderation enforces auth + moderate_content)
+	huma.Get(rc.Moderation, "/admin/artist-reports", artistReportHandler.GetPendingArtistReportsHandler)
+	huma.Post(rc.Moderation, "/admin/artist-reports/{report_id}/dismiss", artistReportHandler.DismissArtistReportHandler)
+	huma.Post(rc.Moderation, "/admin/artist-reports/{report_id}/resolve", artistReportHandler.ResolveArtistReportHandler)
+}
+
+// setupVenueReportRoutes configures venue report endpoints. Registered
+// before the entity report routes, so POST /venues/{venue_id}/report
+// lands here as the artist endpoint does.
+func setupVenueReportRoutes(rc RouteContext) {
+	venueReportHandler := communityh.NewVenueReportHandler(rc.SC.VenueReport, rc.SC.AdminNotifier, rc.SC.User, rc.SC.AuditLog)
+
+	// Rate-limited report submission: 5 requests per minute per IP
+	rc.Router.Group(func(r chi.Router) {
+		r.Use(httprate.Limit(
+			middleware.ReportRequestsPerMinute,
+			time.Minute,
+			httprate.WithKeyFuncs(httprate.KeyByIP),
+			httprate.WithLimitHandler(rateLimitHandler),
+		))
+		reportAPI := humachi.New(r, huma.DefaultConfig("Psychic Homily Venue Reports", "1.0.0"))
+		reportAPI.UseMiddleware(middleware.HumaRequestIDMiddleware)
+		reportAPI.UseMiddleware(middleware.HumaJWTMiddleware(rc.SC.JWT, rc.Cfg.Session))
+		huma.Post(reportAPI, "/venues/{venue_id}/report", venueReportHandler.ReportVenueHandler)
+	})
+
+	// Protected report endpoints (no additional rate limiting)
+	huma.Get(rc.Protected, "/venues/{venue_id}/my-report", venueReportHandler.GetMyVenueReportHandler)
+
+	// Admin endpoints for managing venue reports (rc.Moderation enforces auth + moderate_content)
+	huma.Get(rc.Moderation, "/admin/venue-reports", venueReportHandler.GetPendingVenueReportsHandler)
+	huma.Post(rc.Moderation, "/admin/venue-reports/{report_id}/dismiss", venueReportHandler.DismissVenueReportHandler)
+	huma.Post(rc.Moderation, "/admin/venue-reports/{report_id}/resolve", venueReportHandler.ResolveVenueReportHandler)
 }
 
 // setupEntityReportRoutes configures entity report endpoints.
@@ -104,11 +133,12 @@ func setupEntityReportRoutes(rc RouteContext) {
 		// PSY-666: report a label. EntityID is the numeric label ID; the
 		// moderation queue deep-links via the resolved slug.
 		huma.Post(reportAPI, "/labels/{entity_id}/report", entityReportHandler.ReportLabelHandler)
+		huma.Post(reportAPI, "/show-updates/{entity_id}/report", entityReportHandler.ReportShowUpdateHandler)
 	})
 
-	// Admin: entity report management (PSY-423: rc.Admin enforces auth + IsAdmin)
-	huma.Get(rc.Admin, "/admin/entity-reports", entityReportHandler.AdminListEntityReportsHandler)
-	huma.Get(rc.Admin, "/admin/entity-reports/{report_id}", entityReportHandler.AdminGetEntityReportHandler)
-	huma.Post(rc.Admin, "/admin/entity-reports/{report_id}/resolve", entityReportHandler.AdminResolveEntityReportHandler)
-	huma.Post(rc.Admin, "/admin/entity-reports/{report_id}/dismiss", entityReportHandler.AdminDismissEntityReportHandler)
+	// Admin: entity report management (PSY-423; rc.Moderation enforces auth + moderate_content)
+	huma.Get(rc.Moderation, "/admin/entity-reports", entityReportHandler.AdminListEntityReportsHandler)
+	huma.Get(rc.Moderation, "/admin/entity-reports/{report_id}", entityReportHandler.AdminGetEntityReportHandler)
+	huma.Post(rc.Moderation, "/admin/entity-reports/{report_id}/resolve", entityReportHandler.AdminResolveEntityReportHandler)
+	huma.Post(rc.Moderation, "/admin/entity-reports/{report_id}/dismiss", entityReportHandler.AdminDismissEntityReportHandler)
 }
@@ -7,7 +7,8 @@ import (
 )
 
 // setupRevisionRoutes configures revision history endpoints.
-// Public endpoints for viewing history; admin endpoint for rollback.
+// Public endpoints for viewing history; admin endpoints for rollback,
+// restoring a prior revision, and the snapshot-backed show/venue history.
 func setupRevisionRoutes(rc RouteContext) {
 	revisionHandler := adminh.NewRevisionHandler(rc.SC.Revision, rc.SC.AuditLog)
 
@@ -16,6 +17,12 @@ func setupRevisionRoutes(rc RouteContext) {
 	huma.Get(rc.API, "/revisions/{revision_id}", revisionHandler.GetRevisionHandler)
 	huma.Get(rc.API, "/users/{user_id}/revisions", revisionHandler.GetUserRevisionsHandler)
 
-	// Admin rollback endpoint (PSY-423: rc.Admin enforces auth + IsAdmin)
+	// Admin rollback/restore endpoints (PSY-423: rc.Admin enforces auth + IsAdmin)
 	huma.Post(rc.Admin, "/admin/revisions/{revision_id}/rollback", revisionHandler.RollbackRevisionHandler)
+	huma.Get(rc.Admin, "/admin/revisions/{revision_id}/snapshot", revisionHandler.GetRevisionSnapshotHandler)
+	huma.Post(rc.Admin, "/admin/revisions/{revision_id}/restore", revisionHandler.RestoreRevisionHandler)
+
+	// Admin show/venue history with full snapshots
+	huma.Get(rc.Admin, "/admin/shows/{show_id}/history", revisionHandler.GetShowHistoryHandler)
+	huma.Get(rc.Admin, "/admin/venues/{venue_id}/history", revisionHandler.GetVenueHistoryHandler)
 }
@@ -16,14 +16,72 @@ import (
 	"github.com/danielgtaylor/huma/v2/adapters/humachi"
 	"github.com/go-chi/chi/v5"
 
+	_ "psychic-homily-backend/internal/api/handlers/shared" // installs shared.NewError as huma.NewError
 	"psychic-homily-backend/internal/api/middleware"
 	"psychic-homily-backend/internal/config"
+	authm "psychic-homily-backend/internal/models/auth"
 	"psychic-homily-backend/internal/services"
+	"psychic-homily-backend/internal/services/catalog"
+	"psychic-homily-backend/internal/services/contracts"
 )
 
+// APIVersion is the OpenAPI info.version; bump it with any breaking change to
+// the published spec.
+const APIVersion = "1.0.0"
+
+// Security scheme names referenced by authenticated operations in the spec.
+const (
+	bearerSecurityScheme = "bearerAuth"
+	cookieSecurityScheme = "cookieAuth"
+)
+
+// NewAPIConfig returns the Huma config shared by the server and the spec dump
+// command (cmd/dump-openapi). The spec is served at /v1/openapi.json (plus
+// .yaml and the -3.0 downgrades) with the docs UI at /v1/docs; system.go
+// keeps the unversioned /openapi.json the frontend already reads.
+func NewAPIConfig() huma.Config {
+	humaConfig := huma.DefaultConfig("Psychic Homily", APIVersion)
+	humaConfig.OpenAPIPath = "/v1/openapi"
+	humaConfig.DocsPath = "/v1/docs"
+	humaConfig.Components.SecuritySchemes = map[string]*huma.SecurityScheme{
+		bearerSecurityScheme: {
+			Type:         "http",
+			Scheme:       "bearer",
+			BearerFormat: "JWT",
+			Description:  "Session JWT or phk_ API token",
+		},
+		cookieSecurityScheme: {
+			Type:        "apiKey",
+			In:          "cookie",
+			Name:        "auth_token",
+			Description: "Browser session cookie",
+		},
+	}
+	humaConfig.OpenAPI.OnAddOperation = append(humaConfig.OpenAPI.OnAddOperation,
+		markDeprecatedOperations(deprecatedRoutes))
+	return humaConfig
+}
+
+// requireAuthInSpec marks every operation registered on group as needing a
+// bearer token or the session cookie, so authenticated routes (admin and
+// staff groups included) are distinguishable in the spec.
+func requireAuthInSpec(group *huma.Group) {
+	group.UseSimpleModifier(func(o *huma.Operation) {
+		if o.Security == nil {
+			o.Security = []map[string][]string{
+				{bearerSecurityScheme: {}},
+				{cookieSecurityScheme: {}},
+			}
+		}
+	})
+}
+
 // SetupRoutes configures all API routes
 func SetupRoutes(router *chi.Mux, sc *services.ServiceContainer, cfg *config.Config) huma.API {
-	api := humachi.New(router, huma.DefaultConfig("Psychic Homily", "1.0.0"))
+	// 422s raised by Huma itself (struct tags, Resolve) carry the same
+	// field-level errors as handler validation: importing handlers/shared
+	// installs shared.NewError as huma.NewError.
+	api := humachi.New(router, NewAPIConfig())
 
 	// Add request ID middleware to all Huma routes
 	api.UseMiddleware(middleware.HumaRequestIDMiddleware)
@@ -31,11 +89,24 @@ func SetupRoutes(router *chi.Mux, sc *services.ServiceContainer, cfg *config.Con
 	// Enrich Sentry scope with request ID and HTTP metadata on all routes
 	api.UseMiddleware(middleware.HumaSentryContextMiddleware)
 
+	// Resolve public API keys (X-API-Key) on all routes. Operations opt in
+	// with middleware.APIKeyScope; everything else refuses keys.
+	api.UseMiddleware(middleware.HumaAPIKeyMiddleware(sc.APIKey))
+
+	// City subdomains (CITY_HOSTS) scope show and venue lists to their
+	// cities unless the request names a location itself.
+	api.UseMiddleware(middleware.HumaCityScopeMiddleware(cityHostFilters(cfg)))
+
+	// Deprecation/Sunset headers for v1 operations slated for a breaking
+	// change (deprecatedRoutes in versioning.go).
+	api.UseMiddleware(deprecationHeadersMiddleware(deprecatedRoutes))
+
 	// Create a protected group that will require authentication
 	protectedGroup := huma.NewGroup(api, "")
 	protectedGroup.UseMiddleware(middleware.HumaJWTMiddleware(sc.JWT, cfg.Session))
 	// Enrich Sentry scope with authenticated user context (runs after JWT middleware)
 	protectedGroup.UseMiddleware(middleware.HumaSentryContextMiddleware)
+	requireAuthInSpec(protectedGroup)
 
 	// PSY-423: admin group — JWT auth + IsAdmin enforced via middleware so
 	// pure-admin handlers don't have to call shared.RequireAdmin(ctx)
@@ -45,15 +116,41 @@ func SetupRoutes(router *chi.Mux, sc *services.ServiceContainer, cfg *config.Con
 	adminGroup.UseMiddleware(middleware.HumaJWTMiddleware(sc.JWT, cfg.Session))
 	adminGroup.UseMiddleware(middleware.HumaSentryContextMiddleware)
 	adminGroup.UseMiddleware(middleware.HumaAdminMiddleware)
+	requireAuthInSpec(adminGroup)
+
+	// Staff roles: the review queues, user management and role management
+	// each get their own group so moderators can work the queues without
+	// the rest of the admin console.
+	permissionGroup := func(perm authm.Permission) *huma.Group {
+		group := huma.NewGroup(api, "")
+		group.UseMiddleware(middleware.HumaJWTMiddleware(sc.JWT, cfg.Session))
+		group.UseMiddleware(middleware.HumaSentryContextMiddleware)
+		group.UseMiddleware(middleware.HumaPermissionMiddleware(perm))
+		requireAuthInSpec(group)
+		return group
+	}
+
+	// v2 groups: public and authenticated, under /v2. Group middleware
+	// inherits the API-level middleware above.
+	v2Group := newV2Group(api)
+	v2ProtectedGroup := huma.NewGroup(v2Group, "")
+	v2ProtectedGroup.UseMiddleware(middleware.HumaJWTMiddleware(sc.JWT, cfg.Session))
+	v2ProtectedGroup.UseMiddleware(middleware.HumaSentryContextMiddleware)
+	requireAuthInSpec(v2ProtectedGroup)
 
 	// Build the shared RouteContext once, pass to all setup functions
 	rc := RouteContext{
-		Router:    router,
-		API:       api,
-		Protected: protectedGroup,
-		Admin:     adminGroup,
-		SC:        sc,
-		Cfg:       cfg,
+		Router:      router,
+		API:         api,
+		Protected:   protectedGroup,
+		Admin:       adminGroup,
+		Moderation:  permissionGroup(authm.PermissionModerateContent),
+		UserAdmin:   permissionGroup(authm.PermissionManageUsers),
+		RoleAdmin:   permissionGroup(authm.PermissionManageRoles),
+		V2:          v2Group,
+		V2Protected: v2ProtectedGroup,
+		SC:          sc,
+		Cfg:         cfg,
 	}
 
 	// Setup domain-specific routes. Order is preserved from the original
@@ -72,11 +169,16 @@ func SetupRoutes(router *chi.Mux, sc *services.ServiceContainer, cfg *config.Con
 	setupLabelRoutes(rc)
 	setupFestivalRoutes(rc)
 	setupVenueRoutes(rc)
+	setupWidgetRoutes(rc)
 	setupCalendarRoutes(rc)
 	setupSavedShowRoutes(rc)
+	setupShowRSVPRoutes(rc)
+	setupRecommendationRoutes(rc)
 	setupShowReportRoutes(rc)
 	setupArtistReportRoutes(rc)
+	setupVenueReportRoutes(rc)
 	setupAdminRoutes(rc)
+	setupAnnouncementRoutes(rc)
 	setupPipelineRoutes(rc)
 	setupAIExtractionRoutes(rc)
 	setupSourceRoutes(rc)
@@ -102,6 +204,10 @@ func SetupRoutes(router *chi.Mux, sc *services.ServiceContainer, cfg *config.Con
 	setupCommentSubscriptionRoutes(rc)
 	setupFieldNoteRoutes(rc)
 	setupExploreRoutes(rc)
+	setupSyncRoutes(rc)
+	setupSitemapRoutes(rc)
+	setupShortLinkRoutes(rc)
+	setupV2Routes(rc)
 
 	// PSY-432: test-fixtures reset endpoint — only registered when the env
 	// flag is set. cmd/server/main.go refuses to boot if the flag is on and
@@ -111,3 +217,15 @@ func SetupRoutes(router *chi.Mux, sc *services.ServiceContainer, cfg *config.Con
 
 	return api
 }
+
+// cityHostFilters parses the CITY_HOSTS cities of each host into filters.
+func cityHostFilters(cfg *config.Config) map[string][]contracts.CityStateFilter {
+	if cfg == nil || len(cfg.CityHosts.Hosts) == 0 {
+		return nil
+	}
+	filters := make(map[string][]contracts.CityStateFilter, len(cfg.CityHosts.Hosts))
+	for host, cities := range cfg.CityHosts.Hosts {
+		filters[host] = catalog.ParseCityStateFilters(cities, config.MaxNearbyDefaultCities)
+	}
+	return filters
+}
@@ -68,6 +68,74 @@ func TestSetupRoutes(t *testing.T) {
 	}
 }
 
+// TestSetupRoutes_VersionedSpec checks the versioned spec and docs paths and
+// that authenticated groups (admin included) carry security requirements
+// while public operations don't.
+func TestSetupRoutes_VersionedSpec(t *testing.T) {
+	cfg := testConfig()
+	router := chi.NewRouter()
+	SetupRoutes(router, testContainer(cfg), cfg)
+
+	req := httptest.NewRequest("GET", "/v1/openapi.json", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var spec struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Components struct {
+			SecuritySchemes map[string]any `json:"securitySchemes"`
+		} `json:"components"`
+		Paths map[string]map[string]struct {
+			Security []map[string][]string `json:"security"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("Failed to parse OpenAPI spec: %v", err)
+	}
+	if !strings.HasPrefix(spec.OpenAPI, "3.1") {
+		t.Errorf("Expected OpenAPI 3.1, got %q", spec.OpenAPI)
+	}
+	if spec.Info.Version != APIVersion {
+		t.Errorf("Expected info.version %q, got %q", APIVersion, spec.Info.Version)
+	}
+	for _, name := range []string{bearerSecurityScheme, cookieSecurityScheme} {
+		if _, ok := spec.Components.SecuritySchemes[name]; !ok {
+			t.Errorf("Expected security scheme %q", name)
+		}
+	}
+
+	for _, tc := range []struct {
+		path, method string
+		secured      bool
+	}{
+		{"/charts/me", "get", true},
+		{"/admin/activity", "get", true},
+		{"/health", "get", false},
+	} {
+		op, ok := spec.Paths[tc.path][tc.method]
+		if !ok {
+			t.Errorf("Expected %s %s in the spec", tc.method, tc.path)
+			continue
+		}
+		if got := len(op.Security) > 0; got != tc.secured {
+			t.Errorf("%s %s: secured=%v, want %v", tc.method, tc.path, got, tc.secured)
+		}
+	}
+
+	req = httptest.NewRequest("GET", "/v1/docs", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "/v1/openapi.yaml") {
+		t.Errorf("Expected docs UI pointing at /v1/openapi.yaml, got %d", w.Code)
+	}
+}
+
 // TestAdvancementRouteOpenAPI locks GET /auth/profile/advancement into the
 // protected OpenAPI surface (PSY-1087).
 func TestAdvancementRouteOpenAPI(t *testing.T) {
@@ -321,6 +389,39 @@ func TestSetupSystemRoutes(t *testing.T) {
 		}
 	})
 
+	t.Run("Liveness Route", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/healthz", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d", http.StatusOK, w.Code)
+		}
+	})
+
+	t.Run("Readiness Route", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// 503 without a database, 200 with one; the body names each dependency
+		if w.Code != http.StatusOK && w.Code != http.StatusServiceUnavailable {
+			t.Errorf("Expected status 200 or 503, got %d", w.Code)
+		}
+		var response struct {
+			Status     string                    `json:"status"`
+			Components map[string]map[string]any `json:"components"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to parse readiness response: %v", err)
+		}
+		if _, ok := response.Components["database"]; !ok {
+			t.Errorf("Expected a database component, got %v", response.Components)
+		}
+	})
+
 	// Test OpenAPI spec route
 	t.Run("OpenAPI Spec Route", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/openapi.json", nil)
@@ -352,6 +453,36 @@ func TestSetupSystemRoutes(t *testing.T) {
 	})
 }
 
+// TestSetupSystemRoutes_Metrics checks /metrics is only mounted with a token.
+func TestSetupSystemRoutes_Metrics(t *testing.T) {
+	t.Run("Not Mounted Without Token", func(t *testing.T) {
+		router := chi.NewRouter()
+		api := humachi.New(router, huma.DefaultConfig("Test", "1.0.0"))
+		setupSystemRoutes(RouteContext{Router: router, API: api, Cfg: &config.Config{}})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("Expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+
+	t.Run("Mounted With Token", func(t *testing.T) {
+		router := chi.NewRouter()
+		api := humachi.New(router, huma.DefaultConfig("Test", "1.0.0"))
+		cfg := &config.Config{Server: config.ServerConfig{MetricsToken: "scrape-token"}}
+		setupSystemRoutes(RouteContext{Router: router, API: api, Cfg: cfg})
+
+		req := httptest.NewRequest("GET", "/metrics", nil)
+		req.Header.Set("Authorization", "Bearer scrape-token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected status %d, got %d", http.StatusOK, w.Code)
+		}
+	})
+}
+
 // TestProtectedRoutes tests protected route behavior
 func TestProtectedRoutes(t *testing.T) {
 	cfg := testConfig()
@@ -33,4 +33,27 @@ func setupSavedShowRoutes(rc RouteContext) {
 	// (ios/PsychicHomily/Networking/APIEndpoints.swift). Do not remove it as
 	// "dead code" without updating that client.
 	huma.Get(rc.Protected, "/saved-shows/{show_id}/check", savedShowHandler.CheckSavedHandler)
+
+	// Attendance import from a calendar export: preview matches, then save
+	// the ones the user confirms.
+	calendarImportHandler := engagementh.NewCalendarImportHandler(rc.SC.CalendarImport)
+	huma.Post(rc.Protected, "/saved-shows/import/preview", calendarImportHandler.PreviewCalendarImportHandler)
+	huma.Post(rc.Protected, "/saved-shows/import/confirm", calendarImportHandler.ConfirmCalendarImportHandler)
+}
+
+// setupShowRSVPRoutes configures show RSVP ("I'm going") endpoints.
+//
+// Like saves, the going COUNT is public. The attendee list only names users
+// whose rsvps privacy setting is visible; everyone else is counted but never
+// listed.
+func setupShowRSVPRoutes(rc RouteContext) {
+	rsvpHandler := engagementh.NewShowRSVPHandler(rc.SC.ShowRSVP)
+
+	optionalAuthGroup := huma.NewGroup(rc.API, "")
+	optionalAuthGroup.UseMiddleware(middleware.OptionalHumaJWTMiddleware(rc.SC.JWT))
+	huma.Get(optionalAuthGroup, "/shows/{show_id}/rsvps", rsvpHandler.GetShowRSVPsHandler)
+
+	huma.Post(rc.Protected, "/shows/{show_id}/rsvp", rsvpHandler.RSVPHandler)
+	huma.Delete(rc.Protected, "/shows/{show_id}/rsvp", rsvpHandler.CancelRSVPHandler)
+	huma.Get(rc.Protected, "/me/rsvps", rsvpHandler.GetMyRSVPsHandler)
 }
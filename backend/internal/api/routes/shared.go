@@ -18,12 +18,17 @@ import (
 // RouteContext holds the shared dependencies passed to every route setup function.
 // Each function uses only what it needs from the struct.
 type RouteContext struct {
-	Router    *chi.Mux                   // The chi mux (for Chi-level middleware groups and raw HTTP routes)
-	API       huma.API                   // The public Huma API wrapper
-	Protected *huma.Group                // Protected (auth-required) Huma API group
-	Admin     *huma.Group                // Admin-only Huma API group (auth + IsAdmin enforced upstream)
-	SC        *services.ServiceContainer // All instantiated services
-	Cfg       *config.Config             // Application configuration
+	Router      *chi.Mux                   // The chi mux (for Chi-level middleware groups and raw HTTP routes)
+	API         huma.API                   // The public Huma API wrapper
+	Protected   *huma.Group                // Protected (auth-required) Huma API group
+	Admin       *huma.Group                // Admin-only Huma API group (auth + manage_site enforced upstream)
+	Moderation  *huma.Group                // Review queues (auth + moderate_content); moderators and up
+	UserAdmin   *huma.Group                // User management (auth + manage_users)
+	RoleAdmin   *huma.Group                // Role management (auth + manage_roles); superadmins only
+	V2          *huma.Group                // v2 routes under /v2 (see versioning.go)
+	V2Protected *huma.Group                // Auth-required v2 routes under /v2
+	SC          *services.ServiceContainer // All instantiated services
+	Cfg         *config.Config             // Application configuration
 }
 
 // rateLimitUnlessAPIToken wraps httprate.Limit but skips rate limiting for
@@ -68,3 +73,22 @@ func rateLimitHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusTooManyRequests)
 	respond.SafeWrite(r.Context(), w, []byte(`{"success":false,"error":"too_many_requests","message":"Rate limit exceeded. Please try again in 60 seconds."}`))
 }
+
+// limitRequestBody caps request bodies at maxBytes. A declared
+// Content-Length over the cap is rejected up front with 413; otherwise the
+// body is wrapped so reading past the cap fails. Huma's per-operation
+// MaxBodyBytes does not apply to multipart forms, so upload routes need this.
+func limitRequestBody(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				respond.SafeWrite(r.Context(), w, []byte(`{"success":false,"error":"request_too_large","message":"Request body is too large."}`))
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
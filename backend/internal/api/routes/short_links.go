@@ -0,0 +1,16 @@
+package routes
+
+import (
+	catalogh "psychic-homily-backend/internal/api/handlers/catalog"
+)
+
+// setupShortLinkRoutes configures show short links: /s/{code} redirects to
+// the show page. A raw chi route, like the sitemap, since the response is a
+// redirect rather than JSON.
+func setupShortLinkRoutes(rc RouteContext) {
+	handler := catalogh.NewShowShortLinkHandler(rc.SC.Show, rc.Cfg.Email.FrontendURL)
+
+	// HEAD redirects too but isn't counted as a click.
+	rc.Router.Get("/s/{code:[0-9A-Za-z]{1,12}}", handler.RedirectHandler)
+	rc.Router.Head("/s/{code:[0-9A-Za-z]{1,12}}", handler.RedirectHandler)
+}
@@ -11,23 +11,41 @@ import (
 
 	catalogh "psychic-homily-backend/internal/api/handlers/catalog"
 	"psychic-homily-backend/internal/api/middleware"
+	authm "psychic-homily-backend/internal/models/auth"
 )
 
+// flyerFormOverheadBytes is the multipart framing allowed on top of the
+// flyer file size limit.
+const flyerFormOverheadBytes = 64 << 10
+
 // setupShowRoutes configures all show-related endpoints
 func setupShowRoutes(rc RouteContext) {
-	showHandler := catalogh.NewShowHandler(rc.SC.Show, rc.SC.Show, rc.SC.Show, rc.SC.SavedShow, rc.SC.Discord, rc.SC.Extraction, rc.SC.Revision)
+	showHandler := catalogh.NewShowHandler(rc.SC.Show, rc.SC.Show, rc.SC.Show, rc.SC.SavedShow, rc.SC.AdminNotifier, rc.SC.Extraction, rc.SC.Revision)
+	showHandler.SetShowDraftService(rc.SC.ShowDraft)
+	showHandler.SetSubmissionThrottle(rc.SC.SubmissionThrottle)
+	showHandler.SetAuditLogService(rc.SC.AuditLog)
+	showHandler.SetVenueClaimService(rc.SC.VenueClaim)
+	showHandler.SetShowChangeNotifier(rc.SC.ShowChangeNotification)
+	showHandler.SetShowRSVPService(rc.SC.ShowRSVP)
+	showUpdateHandler := catalogh.NewShowUpdateHandler(rc.SC.Show, rc.SC.ShowUpdate, rc.SC.VenueClaim, rc.SC.AuditLog)
+
+	// Public API keys need read:shows for the public reads and
+	// write:submissions to submit shows.
+	readShows := middleware.APIKeyScope(authm.APIKeyScopeReadShows)
 
 	// Public show endpoints - registered on main API without middleware
 	// Note: Static routes must come before parameterized routes
-	huma.Get(rc.API, "/shows", showHandler.GetShowsHandler)
-	huma.Get(rc.API, "/shows/cities", showHandler.GetShowCitiesHandler)
-	huma.Get(rc.API, "/shows/upcoming", showHandler.GetUpcomingShowsHandler)
-	huma.Get(rc.API, "/shows/search", showHandler.SearchShowsHandler)
+	huma.Get(rc.API, "/shows", showHandler.GetShowsHandler, readShows)
+	huma.Get(rc.API, "/shows/cities", showHandler.GetShowCitiesHandler, readShows)
+	huma.Get(rc.API, "/shows/upcoming", showHandler.GetUpcomingShowsHandler, readShows)
+	huma.Get(rc.API, "/shows/archive", showHandler.GetShowArchiveHandler, readShows)
+	huma.Get(rc.API, "/shows/search", showHandler.SearchShowsHandler, readShows)
+	huma.Get(rc.API, "/shows/nearby", catalogh.NewNearbyShowsHandler(rc.SC.NearbyShows).GetNearbyShowsHandler, readShows)
 
 	// Show detail with optional auth for access control on non-approved shows
 	optionalAuthGroup := huma.NewGroup(rc.API, "")
 	optionalAuthGroup.UseMiddleware(middleware.OptionalHumaJWTMiddleware(rc.SC.JWT))
-	huma.Get(optionalAuthGroup, "/shows/{show_id}", showHandler.GetShowHandler)
+	huma.Get(optionalAuthGroup, "/shows/{show_id}", showHandler.GetShowHandler, readShows)
 
 	// Export endpoint - only register in development environment
 	if os.Getenv("ENVIRONMENT") == "development" {
@@ -44,8 +62,9 @@ func setupShowRoutes(rc RouteContext) {
 		))
 		showCreateAPI := humachi.New(r, huma.DefaultConfig("Psychic Homily Show Create", "1.0.0"))
 		showCreateAPI.UseMiddleware(middleware.HumaRequestIDMiddleware)
+		showCreateAPI.UseMiddleware(middleware.HumaAPIKeyMiddleware(rc.SC.APIKey))
 		showCreateAPI.UseMiddleware(middleware.HumaJWTMiddleware(rc.SC.JWT, rc.Cfg.Session))
-		huma.Post(showCreateAPI, "/shows", showHandler.CreateShowHandler)
+		huma.Post(showCreateAPI, "/shows", showHandler.CreateShowHandler, middleware.APIKeyScope(authm.APIKeyScopeWriteSubmissions))
 	})
 
 	// Rate-limited AI processing: 5 requests per minute per IP
@@ -71,5 +90,42 @@ func setupShowRoutes(rc RouteContext) {
 	huma.Post(rc.Protected, "/shows/{show_id}/publish", showHandler.PublishShowHandler)
 	huma.Post(rc.Protected, "/shows/{show_id}/sold-out", showHandler.SetShowSoldOutHandler)
 	huma.Post(rc.Protected, "/shows/{show_id}/cancelled", showHandler.SetShowCancelledHandler)
+	huma.Post(rc.Protected, "/shows/{show_id}/cancel", showHandler.CancelShowHandler)
+	huma.Post(rc.Protected, "/shows/{show_id}/postpone", showHandler.PostponeShowHandler)
+	huma.Put(rc.Protected, "/shows/{show_id}/lineup", showHandler.SetShowLineupHandler)
 	huma.Get(rc.Protected, "/shows/my-submissions", showHandler.GetMySubmissionsHandler)
+
+	// Show updates feed: submitter, venue managers and admins post; the author
+	// or a moderator deletes. Reports go through /show-updates/{id}/report.
+	huma.Post(rc.Protected, "/shows/{show_id}/updates", showUpdateHandler.PostShowUpdateHandler)
+	huma.Delete(rc.Protected, "/shows/{show_id}/updates/{update_id}", showUpdateHandler.DeleteShowUpdateHandler)
+
+	// Submission drafts: saved per user, removed when submitted with draft_id
+	draftHandler := catalogh.NewShowDraftHandler(rc.SC.ShowDraft)
+	huma.Get(rc.Protected, "/shows/drafts", draftHandler.ListShowDraftsHandler)
+	huma.Post(rc.Protected, "/shows/drafts", draftHandler.CreateShowDraftHandler)
+	huma.Get(rc.Protected, "/shows/drafts/{draft_id}", draftHandler.GetShowDraftHandler)
+	huma.Put(rc.Protected, "/shows/drafts/{draft_id}", draftHandler.UpdateShowDraftHandler)
+	huma.Delete(rc.Protected, "/shows/drafts/{draft_id}", draftHandler.DeleteShowDraftHandler)
+
+	// Flyer uploads: multipart bodies are capped at MEDIA_MAX_UPLOAD_MB plus
+	// room for the form framing. The service re-checks the file itself.
+	flyerHandler := catalogh.NewShowFlyerHandler(rc.SC.Show, rc.SC.Flyer, rc.SC.AuditLog)
+	rc.Router.Group(func(r chi.Router) {
+		r.Use(limitRequestBody(rc.Cfg.Media.MaxUploadBytes + flyerFormOverheadBytes))
+		flyerAPI := humachi.New(r, huma.DefaultConfig("Psychic Homily Show Flyer", "1.0.0"))
+		flyerAPI.UseMiddleware(middleware.HumaRequestIDMiddleware)
+		flyerAPI.UseMiddleware(middleware.HumaJWTMiddleware(rc.SC.JWT, rc.Cfg.Session))
+		huma.Post(flyerAPI, "/shows/{show_id}/flyer", flyerHandler.UploadShowFlyerHandler)
+	})
+	huma.Delete(rc.Protected, "/shows/{show_id}/flyer", flyerHandler.DeleteShowFlyerHandler)
+
+	// Recurring show series: public read, admin-only writes
+	seriesHandler := catalogh.NewShowSeriesHandler(rc.SC.ShowSeries, rc.SC.AuditLog)
+	huma.Get(rc.API, "/show-series/{series_id}", seriesHandler.GetShowSeriesHandler)
+	huma.Post(rc.Admin, "/admin/show-series", seriesHandler.CreateShowSeriesHandler)
+	huma.Patch(rc.Admin, "/admin/show-series/{series_id}", seriesHandler.UpdateShowSeriesHandler)
+	huma.Delete(rc.Admin, "/admin/show-series/{series_id}", seriesHandler.DeleteShowSeriesHandler)
+	huma.Post(rc.Admin, "/admin/show-series/{series_id}/regenerate", seriesHandler.RegenerateShowSeriesHandler)
+	huma.Post(rc.Admin, "/admin/show-series/{series_id}/occurrences/{show_id}/detach", seriesHandler.DetachShowSeriesOccurrenceHandler)
 }
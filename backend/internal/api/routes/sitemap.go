@@ -0,0 +1,15 @@
+package routes
+
+import (
+	catalogh "psychic-homily-backend/internal/api/handlers/catalog"
+)
+
+// setupSitemapRoutes configures the public sitemap served to search engines.
+// /sitemap-{page}.xml pages only exist once the catalog exceeds the 50k-URL
+// single-file limit and /sitemap.xml becomes a sitemap index.
+func setupSitemapRoutes(rc RouteContext) {
+	sitemapHandler := catalogh.NewSitemapHandler(rc.SC.Sitemap)
+
+	rc.Router.Get("/sitemap.xml", sitemapHandler.GetSitemapHandler)
+	rc.Router.Get("/sitemap-{page:[0-9]+}.xml", sitemapHandler.GetSitemapPageHandler)
+}
@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"compress/flate"
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	catalogh "psychic-homily-backend/internal/api/handlers/catalog"
+)
+
+// SyncPathPrefix is the path prefix shared by the differential sync feeds.
+// Declared once and used by both route registration and SyncCompression so
+// the two cannot drift apart.
+const SyncPathPrefix = "/sync/"
+
+// setupSyncRoutes registers the differential sync feeds for the offline
+// client. Each returns records created/updated and IDs deleted after an
+// opaque cursor, optionally scoped to a set of markets. Public, like the
+// read endpoints they mirror.
+func setupSyncRoutes(rc RouteContext) {
+	handler := catalogh.NewSyncHandler(rc.SC.Sync)
+
+	huma.Get(rc.API, SyncPathPrefix+"shows", handler.SyncShowsHandler)
+	huma.Get(rc.API, SyncPathPrefix+"venues", handler.SyncVenuesHandler)
+	huma.Get(rc.API, SyncPathPrefix+"artists", handler.SyncArtistsHandler)
+}
+
+// SyncCompression returns chi middleware that gzip/deflate-compresses sync
+// responses when the client accepts it. A full sync runs to megabytes of
+// repetitive JSON, which compresses well, and mobile clients pay for every
+// byte. Scoped to SyncPathPrefix so other endpoints are unchanged. Mounted
+// once, globally, before route registration.
+func SyncCompression() func(http.Handler) http.Handler {
+	compress := chimiddleware.Compress(flate.DefaultCompression, "application/json")
+	return func(next http.Handler) http.Handler {
+		compressed := compress(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, SyncPathPrefix) {
+				compressed.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
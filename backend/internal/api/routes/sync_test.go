@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSyncCompression_ScopedToSyncPaths(t *testing.T) {
+	body := strings.Repeat(`{"id":1,"title":"show"},`, 200)
+	handler := SyncCompression()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+
+	cases := map[string]string{
+		"/sync/shows":    "gzip",
+		"/sync/artists":  "gzip",
+		"/shows":         "",
+		"/syncing/shows": "",
+	}
+	for path, want := range cases {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		if got := rr.Header().Get("Content-Encoding"); got != want {
+			t.Errorf("%s: Content-Encoding = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestSyncCompression_RespectsAcceptEncoding(t *testing.T) {
+	handler := SyncCompression()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"created":[]}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/sync/shows", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if got := rr.Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("Content-Encoding = %q, want none without Accept-Encoding", got)
+	}
+	if rr.Body.String() != `{"created":[]}` {
+		t.Errorf("body = %q", rr.Body.String())
+	}
+}
@@ -14,7 +14,18 @@ func setupSystemRoutes(rc RouteContext) {
 	// Health check endpoint
 	huma.Get(rc.API, "/health", systemh.HealthHandler)
 
-	// OpenAPI specification endpoint
+	// Liveness and readiness probes for the deployment platform
+	huma.Get(rc.API, "/healthz", systemh.LivenessHandler)
+	probeHandler := systemh.NewProbeHandler(rc.Cfg)
+	huma.Get(rc.API, "/readyz", probeHandler.ReadinessHandler)
+
+	// Prometheus metrics, only mounted when a scrape token is configured
+	if rc.Cfg != nil && rc.Cfg.Server.MetricsToken != "" {
+		rc.Router.Handle("/metrics", systemh.MetricsHandler(rc.Cfg.Server.MetricsToken))
+	}
+
+	// Unversioned OpenAPI spec, kept for existing clients (the frontend reads
+	// it); Huma serves the versioned /v1/openapi.json and /v1/docs (NewAPIConfig).
 	api := rc.API
 	rc.Router.Get("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -4,28 +4,47 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 
 	catalogh "psychic-homily-backend/internal/api/handlers/catalog"
+	"psychic-homily-backend/internal/api/middleware"
+	authm "psychic-homily-backend/internal/models/auth"
 )
 
 func setupVenueRoutes(rc RouteContext) {
-	venueHandler := catalogh.NewVenueHandler(rc.SC.Venue, rc.SC.Discord, rc.SC.AuditLog, rc.SC.Revision)
+	venueHandler := catalogh.NewVenueHandler(rc.SC.Venue, rc.SC.AdminNotifier, rc.SC.AuditLog, rc.SC.Revision)
+	venueHandler.SetVenueClaimService(rc.SC.VenueClaim)
+	claimHandler := catalogh.NewVenueClaimHandler(rc.SC.VenueClaim, rc.SC.AuditLog)
+
+	// Public API keys need read:venues for the public reads
+	readVenues := middleware.APIKeyScope(authm.APIKeyScopeReadVenues)
 
 	// Public venue endpoints - registered on main API without middleware
 	// Note: Static routes must come before parameterized routes
-	huma.Get(rc.API, "/venues", venueHandler.ListVenuesHandler)
-	huma.Get(rc.API, "/venues/cities", venueHandler.GetVenueCitiesHandler)
-	huma.Get(rc.API, "/venues/search", venueHandler.SearchVenuesHandler)
-	huma.Get(rc.API, "/venues/{venue_id}", venueHandler.GetVenueHandler)
-	huma.Get(rc.API, "/venues/{venue_id}/shows", venueHandler.GetVenueShowsHandler)
-	huma.Get(rc.API, "/venues/{venue_id}/genres", venueHandler.GetVenueGenresHandler)
-	huma.Get(rc.API, "/venues/{venue_id}/bill-network", venueHandler.GetVenueBillNetworkHandler)
+	huma.Get(rc.API, "/venues", venueHandler.ListVenuesHandler, readVenues)
+	huma.Get(rc.API, "/venues/cities", venueHandler.GetVenueCitiesHandler, readVenues)
+	huma.Get(rc.API, "/venues/search", venueHandler.SearchVenuesHandler, readVenues)
+	huma.Get(rc.API, "/venues/{venue_id}", venueHandler.GetVenueHandler, readVenues)
+	huma.Get(rc.API, "/venues/{venue_id}/shows", venueHandler.GetVenueShowsHandler, readVenues)
+	huma.Get(rc.API, "/venues/{venue_id}/genres", venueHandler.GetVenueGenresHandler, readVenues)
+	huma.Get(rc.API, "/venues/{venue_id}/bill-network", venueHandler.GetVenueBillNetworkHandler, readVenues)
 
 	// Admin venue endpoints (PSY-423: rc.Admin enforces auth + IsAdmin)
 	huma.Post(rc.Admin, "/admin/venues", venueHandler.AdminCreateVenueHandler)
-	huma.Put(rc.Admin, "/venues/{venue_id}", venueHandler.UpdateVenueHandler)
+	huma.Post(rc.Admin, "/admin/venues/{venue_id}/merge", venueHandler.MergeVenueHandler)
 
-	// Protected venue endpoint: admin can delete any venue, non-admin can
-	// delete venues they submitted. Stays on rc.Protected with handler-side
-	// ownership check.
+	// Protected venue endpoints: admin can update/delete any venue. Managers
+	// (approved venue claim) can update their venue; non-admins can delete
+	// venues they submitted. Stay on rc.Protected with handler-side checks.
 	// conditional admin — see PSY-423 audit
+	huma.Put(rc.Protected, "/venues/{venue_id}", venueHandler.UpdateVenueHandler)
 	huma.Delete(rc.Protected, "/venues/{venue_id}", venueHandler.DeleteVenueHandler)
+
+	// Venue claims: staff request to manage a venue; admins review the queue
+	// and manage the resulting grants.
+	huma.Post(rc.Protected, "/venues/{venue_id}/claim", claimHandler.SubmitVenueClaimHandler)
+	huma.Get(rc.Protected, "/my/venue-claims", claimHandler.GetMyVenueClaimsHandler)
+	huma.Get(rc.Protected, "/my/managed-venues", claimHandler.GetMyManagedVenuesHandler)
+	huma.Get(rc.Admin, "/admin/venue-claims", claimHandler.ListVenueClaimsHandler)
+	huma.Post(rc.Admin, "/admin/venue-claims/{claim_id}/approve", claimHandler.ApproveVenueClaimHandler)
+	huma.Post(rc.Admin, "/admin/venue-claims/{claim_id}/deny", claimHandler.DenyVenueClaimHandler)
+	huma.Get(rc.Admin, "/admin/venues/{venue_id}/managers", claimHandler.ListVenueManagersHandler)
+	huma.Delete(rc.Admin, "/admin/venues/{venue_id}/managers/{user_id}", claimHandler.RemoveVenueManagerHandler)
 }
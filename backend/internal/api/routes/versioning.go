@@ -0,0 +1,116 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// API versions. v1 is the unprefixed surface every existing route lives on.
+// v2 routes mount under /v2 (RouteContext.V2 / V2Protected) so a breaking
+// response shape can ship next to the v1 shape it replaces; the v1 operation
+// is then listed in deprecatedRoutes until it is removed.
+const v2Prefix = "/v2"
+
+// newV2Group returns the /v2 group. Huma derives operation IDs from the
+// unprefixed path, so a v2 route would collide with the v1 route it
+// replaces; the modifier namespaces them.
+func newV2Group(api huma.API) *huma.Group {
+	group := huma.NewGroup(api, v2Prefix)
+	group.UseSimpleModifier(func(o *huma.Operation) {
+		if o.OperationID != "" {
+			o.OperationID = "v2-" + o.OperationID
+		}
+	})
+	return group
+}
+
+// routeDeprecation describes a v1 operation slated for a breaking change.
+type routeDeprecation struct {
+	// Since is when the operation was deprecated (Deprecation header).
+	Since time.Time
+	// Sunset is when it will be removed (Sunset header); zero until a
+	// removal date is set.
+	Sunset time.Time
+	// Successor is the replacement's path (Link rel="successor-version");
+	// empty until the v2 route ships.
+	Successor string
+	// Reason is appended to the operation description in the spec.
+	Reason string
+}
+
+// deprecatedRoutes is the versioned route registry: "METHOD /path", with the
+// path as registered (v1 paths unprefixed), mapped to its deprecation.
+// Adding an entry flags the operation as deprecated in the spec and stamps
+// the headers on every response; nothing else needs to change.
+var deprecatedRoutes = map[string]routeDeprecation{
+	"GET /shows/{show_id}": {
+		Since:  time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		Reason: "The price fields change shape in v2.",
+	},
+	"GET /artists/{artist_id}": {
+		Since:  time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		Reason: "The social links object changes shape in v2.",
+	},
+}
+
+func deprecationKey(method, path string) string {
+	return method + " " + path
+}
+
+// markDeprecatedOperations flags registry operations as deprecated in the
+// OpenAPI document as they are added.
+func markDeprecatedOperations(registry map[string]routeDeprecation) huma.AddOpFunc {
+	return func(_ *huma.OpenAPI, op *huma.Operation) {
+		d, ok := registry[deprecationKey(op.Method, op.Path)]
+		if !ok {
+			return
+		}
+		op.Deprecated = true
+		note := "Deprecated since " + d.Since.Format(time.DateOnly) + "."
+		if !d.Sunset.IsZero() {
+			note += " Removed on " + d.Sunset.Format(time.DateOnly) + "."
+		}
+		if d.Successor != "" {
+			note += " Use " + d.Successor + "."
+		}
+		if d.Reason != "" {
+			note += " " + d.Reason
+		}
+		if op.Description != "" {
+			op.Description += "\n\n"
+		}
+		op.Description += note
+	}
+}
+
+// deprecationHeadersMiddleware stamps Deprecation (RFC 9745), Sunset
+// (RFC 8594) and a successor-version Link on responses from registry
+// operations. Headers are set before the handler runs so error responses
+// carry them too.
+func deprecationHeadersMiddleware(registry map[string]routeDeprecation) func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		op := ctx.Operation()
+		if op != nil {
+			if d, ok := registry[deprecationKey(op.Method, op.Path)]; ok {
+				ctx.SetHeader("Deprecation", fmt.Sprintf("@%d", d.Since.Unix()))
+				if !d.Sunset.IsZero() {
+					ctx.SetHeader("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+				}
+				if d.Successor != "" {
+					ctx.AppendHeader("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", d.Successor))
+				}
+			}
+		}
+		next(ctx)
+	}
+}
+
+// setupV2Routes registers the /v2 operations. Each one replaces a v1
+// operation whose response shape had to break: register it on rc.V2 or
+// rc.V2Protected with the same path as v1 (the group adds the prefix), then
+// set Successor (and, once agreed, Sunset) on the v1 entry in
+// deprecatedRoutes.
+func setupV2Routes(_ RouteContext) {}
@@ -0,0 +1,144 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humachi"
+	"github.com/go-chi/chi/v5"
+)
+
+type versioningTestOutput struct {
+	Body struct {
+		OK bool `json:"ok"`
+	}
+}
+
+func versioningTestHandler(context.Context, *struct{}) (*versioningTestOutput, error) {
+	out := &versioningTestOutput{}
+	out.Body.OK = true
+	return out, nil
+}
+
+func newVersioningTestAPI(registry map[string]routeDeprecation) (*chi.Mux, huma.API) {
+	router := chi.NewRouter()
+	humaConfig := huma.DefaultConfig("Versioning", "1.0.0")
+	humaConfig.OpenAPI.OnAddOperation = append(humaConfig.OpenAPI.OnAddOperation, markDeprecatedOperations(registry))
+	api := humachi.New(router, humaConfig)
+	api.UseMiddleware(deprecationHeadersMiddleware(registry))
+	return router, api
+}
+
+func TestDeprecationHeaders(t *testing.T) {
+	since := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, 4, 1, 0, 0, 0, 0, time.UTC)
+	router, api := newVersioningTestAPI(map[string]routeDeprecation{
+		"GET /things/{id}": {Since: since, Sunset: sunset, Successor: "/v2/things/{id}", Reason: "Shape changes."},
+	})
+	huma.Get(api, "/things/{id}", func(ctx context.Context, _ *struct {
+		ID int `path:"id"`
+	}) (*versioningTestOutput, error) {
+		return versioningTestHandler(ctx, nil)
+	})
+	huma.Get(api, "/others", versioningTestHandler)
+	huma.Get(newV2Group(api), "/things/{id}", func(ctx context.Context, _ *struct {
+		ID int `path:"id"`
+	}) (*versioningTestOutput, error) {
+		return versioningTestHandler(ctx, nil)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/things/1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if got := w.Header().Get("Deprecation"); got != "@1792022400" {
+		t.Errorf("unexpected Deprecation header %q", got)
+	}
+	if got := w.Header().Get("Sunset"); got != "Thu, 01 Apr 2027 00:00:00 GMT" {
+		t.Errorf("unexpected Sunset header %q", got)
+	}
+	if got := w.Header().Get("Link"); !strings.Contains(got, `</v2/things/{id}>; rel="successor-version"`) {
+		t.Errorf("unexpected Link header %q", got)
+	}
+
+	for _, path := range []string{"/others", "/v2/things/1"} {
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", path, w.Code)
+		}
+		if w.Header().Get("Deprecation") != "" || w.Header().Get("Sunset") != "" {
+			t.Errorf("%s: expected no deprecation headers", path)
+		}
+	}
+
+	op := api.OpenAPI().Paths["/things/{id}"].Get
+	if !op.Deprecated || !strings.Contains(op.Description, "Removed on 2027-04-01") {
+		t.Errorf("expected the spec operation flagged deprecated, got %v %q", op.Deprecated, op.Description)
+	}
+	v2Op := api.OpenAPI().Paths["/v2/things/{id}"].Get
+	if v2Op.Deprecated {
+		t.Error("v2 operation must not be deprecated")
+	}
+	if v2Op.OperationID == op.OperationID {
+		t.Errorf("v1 and v2 operation IDs collide: %q", op.OperationID)
+	}
+}
+
+func TestDeprecationHeaders_NoSunsetYet(t *testing.T) {
+	router, api := newVersioningTestAPI(map[string]routeDeprecation{
+		"GET /others": {Since: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+	})
+	huma.Get(api, "/others", versioningTestHandler)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/others", nil))
+	if w.Header().Get("Deprecation") == "" {
+		t.Error("expected a Deprecation header")
+	}
+	if w.Header().Get("Sunset") != "" || strings.Contains(w.Header().Get("Link"), "successor-version") {
+		t.Error("expected no Sunset or successor Link before they are set")
+	}
+}
+
+// TestDeprecatedRoutesAreRegistered keeps the registry honest: every entry
+// must name an operation that SetupRoutes actually registers.
+func TestDeprecatedRoutesAreRegistered(t *testing.T) {
+	cfg := testConfig()
+	api := SetupRoutes(chi.NewRouter(), testContainer(cfg), cfg)
+
+	for key := range deprecatedRoutes {
+		method, path, _ := strings.Cut(key, " ")
+		item := api.OpenAPI().Paths[path]
+		if item == nil {
+			t.Errorf("%s: path not registered", key)
+			continue
+		}
+		var op *huma.Operation
+		switch method {
+		case http.MethodGet:
+			op = item.Get
+		case http.MethodPost:
+			op = item.Post
+		case http.MethodPut:
+			op = item.Put
+		case http.MethodPatch:
+			op = item.Patch
+		case http.MethodDelete:
+			op = item.Delete
+		}
+		if op == nil {
+			t.Errorf("%s: method not registered", key)
+			continue
+		}
+		if !op.Deprecated {
+			t.Errorf("%s: expected deprecated in the spec", key)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	catalogh "psychic-homily-backend/internal/api/handlers/catalog"
+	"psychic-homily-backend/internal/api/middleware"
+	authm "psychic-homily-backend/internal/models/auth"
+)
+
+// Embed endpoints. Declared once and used by both route registration and
+// WidgetCORS so the two cannot drift apart.
+const (
+	venueWidgetPathSuffix = "/widget.json"
+	OEmbedPath            = "/oembed"
+)
+
+// setupWidgetRoutes registers the embeddable venue widget feed and the
+// oEmbed endpoint. Both are public reads fetched from third-party pages;
+// see WidgetCORS for how they are exposed cross-origin.
+func setupWidgetRoutes(rc RouteContext) {
+	handler := catalogh.NewVenueWidgetHandler(rc.SC.Venue, rc.Cfg.Email.FrontendURL)
+
+	readVenues := middleware.APIKeyScope(authm.APIKeyScopeReadVenues)
+
+	huma.Get(rc.API, "/venues/{venue_id}"+venueWidgetPathSuffix, handler.GetVenueWidgetHandler, readVenues)
+	huma.Get(rc.API, OEmbedPath, handler.GetVenueOEmbedHandler, readVenues)
+}
+
+// isWidgetPath reports whether path is one of the embed endpoints.
+func isWidgetPath(path string) bool {
+	if path == OEmbedPath {
+		return true
+	}
+	rest, ok := strings.CutPrefix(path, "/venues/")
+	if !ok {
+		return false
+	}
+	ref, ok := strings.CutSuffix(rest, venueWidgetPathSuffix)
+	return ok && ref != "" && !strings.Contains(ref, "/")
+}
+
+// WidgetCORS returns chi middleware that opens the embed endpoints to every
+// origin and hands all other requests to restricted, the site's allowlist
+// CORS. Embeds run on venues' own sites, which can't all be listed, and the
+// endpoints are anonymous reads, so they answer "*" without credentials.
+// The ETag is exposed so browser-side pollers can send If-None-Match.
+// Mounted once, globally, in place of the allowlist middleware.
+func WidgetCORS(restricted func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		restrictedNext := restricted(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isWidgetPath(r.URL.Path) {
+				restrictedNext.ServeHTTP(w, r)
+				return
+			}
+			h := w.Header()
+			h.Add("Vary", "Origin")
+			h.Set("Access-Control-Allow-Origin", "*")
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				h.Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+				h.Set("Access-Control-Allow-Headers", "If-None-Match")
+				h.Set("Access-Control-Max-Age", "300")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			h.Set("Access-Control-Expose-Headers", "ETag")
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// restrictedCORSMarker stands in for the allowlist CORS middleware.
+func restrictedCORSMarker(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Restricted-CORS", "1")
+		next.ServeHTTP(w, r)
+	})
+}
+
+func TestIsWidgetPath(t *testing.T) {
+	cases := map[string]bool{
+		"/oembed":                        true,
+		"/venues/valley-bar/widget.json": true,
+		"/venues/42/widget.json":         true,
+		"/venues/widget.json":            false,
+		"/venues//widget.json":           false,
+		"/venues/a/b/widget.json":        false,
+		"/venues/valley-bar":             false,
+		"/venues/valley-bar/shows":       false,
+		"/oembed/extra":                  false,
+	}
+	for path, want := range cases {
+		if got := isWidgetPath(path); got != want {
+			t.Errorf("isWidgetPath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestWidgetCORS_OpensWidgetPaths(t *testing.T) {
+	handler := WidgetCORS(restrictedCORSMarker)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/venues/valley-bar/widget.json", nil)
+	req.Header.Set("Origin", "https://valleybarphx.com")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if got := rr.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want *", got)
+	}
+	if got := rr.Header().Get("Access-Control-Expose-Headers"); got != "ETag" {
+		t.Errorf("Access-Control-Expose-Headers = %q, want ETag", got)
+	}
+	if rr.Header().Get("Access-Control-Allow-Credentials") != "" {
+		t.Error("widget responses must not allow credentials")
+	}
+	if rr.Header().Get("X-Restricted-CORS") != "" {
+		t.Error("widget paths should bypass the allowlist CORS")
+	}
+}
+
+func TestWidgetCORS_AnswersPreflight(t *testing.T) {
+	reached := false
+	handler := WidgetCORS(restrictedCORSMarker)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		reached = true
+	}))
+
+	req := httptest.NewRequest(http.MethodOptions, "/oembed", nil)
+	req.Header.Set("Origin", "https://valleybarphx.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+	req.Header.Set("Access-Control-Request-Headers", "if-none-match")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want 204", rr.Code)
+	}
+	if reached {
+		t.Error("preflight should not reach the route")
+	}
+	if got := rr.Header().Get("Access-Control-Allow-Headers"); got != "If-None-Match" {
+		t.Errorf("Access-Control-Allow-Headers = %q", got)
+	}
+}
+
+func TestWidgetCORS_OtherPathsUseAllowlist(t *testing.T) {
+	handler := WidgetCORS(restrictedCORSMarker)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/venues/valley-bar", nil)
+	req.Header.Set("Origin", "https://valleybarphx.com")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if rr.Header().Get("X-Restricted-CORS") != "1" {
+		t.Error("non-widget paths should go through the allowlist CORS")
+	}
+	if rr.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Error("non-widget paths should not be opened")
+	}
+}
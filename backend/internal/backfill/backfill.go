@@ -0,0 +1,313 @@
+// Package backfill runs data backfills over large tables without loading
+// them into memory. Rows are visited in primary-key order a batch at a time
+// (keyset pagination, so a batch costs the same at row 1M as at row 1), each
+// batch in its own transaction. A run can be rate limited, reports progress,
+// records a checkpoint after every committed batch, and resumes from one.
+//
+// In a dry run every batch's transaction is rolled back, so the report comes
+// from the same code path as a live run. Anything a later batch must know
+// about an earlier one (e.g. slugs claimed so far) has to be kept in memory
+// by the caller, because the database won't show it.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultBatchSize is the batch size when Options.BatchSize is unset.
+const DefaultBatchSize = 500
+
+// Stage is one pass of a backfill over a table. Stages run in order; a
+// backfill with several passes (venues, then their shows) has one per pass.
+type Stage struct {
+	// Name identifies the stage in progress output and checkpoints.
+	Name string
+	// Query selects the rows to visit, e.g. db.Model(&Venue{}). Run adds
+	// the keyset condition, ordering and limit on Key.
+	Query func(db *gorm.DB) *gorm.DB
+	// Key is the unique, increasing column rows are paged by. Defaults to
+	// "id"; qualify it when Query joins.
+	Key string
+	// Batch handles one batch of keys inside a transaction. An error rolls
+	// the batch back and stops the run. Row-level failures that shouldn't
+	// stop it belong in a nested tx.Transaction (a savepoint), so they
+	// don't abort the batch's transaction.
+	Batch func(tx *gorm.DB, ids []uint) error
+}
+
+// Options configures a run. The zero value runs live, unthrottled, in
+// batches of DefaultBatchSize, from the start, without progress output.
+type Options struct {
+	// DryRun rolls back every batch.
+	DryRun bool
+	// BatchSize is how many rows each batch (and transaction) holds.
+	BatchSize int
+	// RateLimit caps throughput in rows per second; 0 means unlimited.
+	RateLimit float64
+	// ResumeFrom starts the run after this position instead of the start.
+	ResumeFrom Cursor
+	// CheckpointFile, when set, holds the position of the last committed
+	// batch: it is written after each batch of a live run, read when
+	// ResumeFrom is unset, and removed once the run completes.
+	CheckpointFile string
+	// Progress receives a progress bar per stage; nil disables it.
+	Progress io.Writer
+}
+
+// RegisterFlags binds the options a command exposes to fs. Dry-run is left
+// to the command, since commands spell it differently (--confirm, --dry-run).
+func (o *Options) RegisterFlags(fs *flag.FlagSet) {
+	fs.IntVar(&o.BatchSize, "batch-size", DefaultBatchSize, "Rows per batch (and per transaction)")
+	fs.Float64Var(&o.RateLimit, "rate", 0, "Maximum rows per second (0 = unlimited)")
+	fs.Var(&o.ResumeFrom, "resume-from", "Resume after this position ([stage:]id, as printed by an interrupted run)")
+	fs.StringVar(&o.CheckpointFile, "checkpoint", "", "File recording the last committed position; an interrupted run resumes from it")
+}
+
+// Result says how far a run got.
+type Result struct {
+	Rows    int // rows handed to Batch, across stages
+	Batches int
+	// Cursor is the position of the last committed batch (the last batch
+	// reached, in a dry run). Passing it as ResumeFrom continues the run.
+	Cursor Cursor
+	// Completed is false when the run stopped early.
+	Completed bool
+}
+
+// errDryRunRollback rolls back a dry-run batch.
+var errDryRunRollback = errors.New("dry run: rolled back")
+
+// Run runs the stages in order. It stops between batches when db's context
+// is cancelled, returning the context's error; a batch already started is
+// finished first, so the returned Cursor is always safe to resume from.
+func Run(db *gorm.DB, opts Options, stages ...Stage) (Result, error) {
+	var result Result
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	resume := opts.ResumeFrom
+	if resume.IsZero() && opts.CheckpointFile != "" {
+		c, err := ReadCheckpoint(opts.CheckpointFile)
+		if err != nil {
+			return result, err
+		}
+		resume = c
+	}
+
+	first := 0
+	if resume.Stage != "" {
+		first = -1
+		for i, s := range stages {
+			if s.Name == resume.Stage {
+				first = i
+			}
+		}
+		if first < 0 {
+			return result, fmt.Errorf("resume position %q names no stage of this backfill", resume)
+		}
+	}
+	result.Cursor = resume
+
+	ctx := db.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// Batches run to completion even once ctx is cancelled; cancellation is
+	// checked between them.
+	batchDB := db.WithContext(context.WithoutCancel(ctx))
+
+	for i := first; i < len(stages); i++ {
+		stage := stages[i]
+		after := uint(0)
+		if i == first {
+			after = resume.After
+		}
+		if err := runStage(ctx, batchDB, stage, opts, after, &result); err != nil {
+			return result, err
+		}
+	}
+
+	result.Completed = true
+	if opts.CheckpointFile != "" && !opts.DryRun {
+		if err := os.Remove(opts.CheckpointFile); err != nil && !os.IsNotExist(err) {
+			return result, fmt.Errorf("remove checkpoint: %w", err)
+		}
+	}
+	return result, nil
+}
+
+func runStage(ctx context.Context, db *gorm.DB, stage Stage, opts Options, after uint, result *Result) error {
+	key := stage.Key
+	if key == "" {
+		key = "id"
+	}
+	var total int64
+	if err := stage.Query(db).Where(key+" > ?", after).Count(&total).Error; err != nil {
+		return fmt.Errorf("%s: count rows: %w", stage.Name, err)
+	}
+	bar := newProgress(opts.Progress, stage.Name, int(total))
+	defer bar.finish()
+
+	start := time.Now()
+	done := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var ids []uint
+		if err := stage.Query(db).Where(key+" > ?", after).Order(key).
+			Limit(opts.BatchSize).Pluck(key, &ids).Error; err != nil {
+			return fmt.Errorf("%s: load batch after %d: %w", stage.Name, after, err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := stage.Batch(tx, ids); err != nil {
+				return err
+			}
+			if opts.DryRun {
+				return errDryRunRollback
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errDryRunRollback) {
+			return fmt.Errorf("%s: batch after %d: %w", stage.Name, after, err)
+		}
+
+		after = ids[len(ids)-1]
+		done += len(ids)
+		result.Rows += len(ids)
+		result.Batches++
+		result.Cursor = Cursor{Stage: stage.Name, After: after}
+		if opts.CheckpointFile != "" && !opts.DryRun {
+			if err := WriteCheckpoint(opts.CheckpointFile, result.Cursor); err != nil {
+				return err
+			}
+		}
+		bar.update(done, after)
+
+		if len(ids) < opts.BatchSize {
+			return nil
+		}
+		if err := sleep(ctx, throttle(time.Since(start), done, opts.RateLimit)); err != nil {
+			return err
+		}
+	}
+}
+
+// throttle returns how long to wait so that rows processed over elapsed
+// stay within rate rows per second.
+func throttle(elapsed time.Duration, rows int, rate float64) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	want := time.Duration(float64(rows) / rate * float64(time.Second))
+	if want <= elapsed {
+		return 0
+	}
+	return want - elapsed
+}
+
+// sleep waits for d, or until ctx is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Cursor is a position in a run: the last key handled in a stage. Its text
+// form is "stage:id", or a bare "id" for the first stage. It implements
+// flag.Value.
+type Cursor struct {
+	Stage string
+	After uint
+}
+
+// IsZero reports whether c is the start of a run.
+func (c Cursor) IsZero() bool { return c == Cursor{} }
+
+func (c Cursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	if c.Stage == "" {
+		return strconv.FormatUint(uint64(c.After), 10)
+	}
+	return c.Stage + ":" + strconv.FormatUint(uint64(c.After), 10)
+}
+
+// Set parses the text form of a cursor.
+func (c *Cursor) Set(s string) error {
+	parsed, err := ParseCursor(s)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// ParseCursor parses "stage:id" or "id". An empty string is the zero cursor.
+func ParseCursor(s string) (Cursor, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Cursor{}, nil
+	}
+	stage, id, found := strings.Cut(s, ":")
+	if !found {
+		stage, id = "", s
+	}
+	n, err := strconv.ParseUint(id, 10, 0)
+	if err != nil || (found && stage == "") {
+		return Cursor{}, fmt.Errorf("invalid position %q: want [stage:]id", s)
+	}
+	return Cursor{Stage: stage, After: uint(n)}, nil
+}
+
+// ReadCheckpoint reads a checkpoint file. A missing file is the zero cursor.
+func ReadCheckpoint(path string) (Cursor, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Cursor{}, nil
+	}
+	if err != nil {
+		return Cursor{}, fmt.Errorf("read checkpoint: %w", err)
+	}
+	c, err := ParseCursor(string(data))
+	if err != nil {
+		return Cursor{}, fmt.Errorf("checkpoint %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// WriteCheckpoint records c in a checkpoint file, replacing it atomically
+// so an interrupted write can't leave it half-written.
+func WriteCheckpoint(path string, c Cursor) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(c.String()+"\n"), 0o644); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestParseCursor(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Cursor
+	}{
+		{"", Cursor{}},
+		{"42", Cursor{After: 42}},
+		{"shows:1200", Cursor{Stage: "shows", After: 1200}},
+		{" venues:7\n", Cursor{Stage: "venues", After: 7}},
+	}
+	for _, c := range cases {
+		got, err := ParseCursor(c.in)
+		require.NoError(t, err, c.in)
+		assert.Equal(t, c.want, got, c.in)
+		if c.in != "" {
+			assert.Equal(t, strings.TrimSpace(c.in), got.String())
+		}
+	}
+	for _, bad := range []string{"abc", "shows:", ":12", "shows:-1"} {
+		_, err := ParseCursor(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestCheckpoint_RoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backfill.checkpoint")
+
+	c, err := ReadCheckpoint(path)
+	require.NoError(t, err)
+	assert.True(t, c.IsZero(), "a missing checkpoint is the start")
+
+	require.NoError(t, WriteCheckpoint(path, Cursor{Stage: "venues", After: 99}))
+	c, err = ReadCheckpoint(path)
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{Stage: "venues", After: 99}, c)
+}
+
+func TestThrottle(t *testing.T) {
+	assert.Zero(t, throttle(0, 1000, 0), "no limit")
+	assert.Equal(t, 2*time.Second, throttle(3*time.Second, 500, 100))
+	assert.Zero(t, throttle(6*time.Second, 500, 100), "already slower than the limit")
+}
+
+func TestProgressLine(t *testing.T) {
+	line := progressLine("venues", 300, 1000, 412, 2*time.Second)
+	assert.Equal(t, "venues [#########---------------------]  30% 300/1000  last id 412  150 rows/s", line)
+
+	full := progressLine("venues", 1100, 1000, 1500, 0)
+	assert.Contains(t, full, "[##############################] 100% 1100/1000")
+}
+
+type RunIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+}
+
+func (s *RunIntegrationTestSuite) SetupSuite() {
+	s.testDB = testutil.SharedTestPostgres(s.T())
+}
+
+func (s *RunIntegrationTestSuite) SetupTest() {
+	s.db = testutil.BeginTestTx(s.T(), s.testDB.DB)
+	s.Require().NoError(s.db.Exec(`CREATE TEMP TABLE backfill_rows (id BIGSERIAL PRIMARY KEY, touched BOOLEAN NOT NULL DEFAULT false) ON COMMIT DROP`).Error)
+	s.Require().NoError(s.db.Exec(`INSERT INTO backfill_rows (touched) SELECT false FROM generate_series(1, 25)`).Error)
+}
+
+func TestRunIntegration(t *testing.T) {
+	suite.Run(t, new(RunIntegrationTestSuite))
+}
+
+// touch is a stage marking its rows touched, recording the batches it saw.
+func (s *RunIntegrationTestSuite) touch(name string, batches *[][]uint) Stage {
+	return Stage{
+		Name:  name,
+		Query: func(db *gorm.DB) *gorm.DB { return db.Table("backfill_rows") },
+		Batch: func(tx *gorm.DB, ids []uint) error {
+			*batches = append(*batches, ids)
+			return tx.Exec(`UPDATE backfill_rows SET touched = true WHERE id IN ?`, ids).Error
+		},
+	}
+}
+
+func (s *RunIntegrationTestSuite) touched() int64 {
+	var n int64
+	s.Require().NoError(s.db.Table("backfill_rows").Where("touched").Count(&n).Error)
+	return n
+}
+
+func (s *RunIntegrationTestSuite) TestRun_VisitsEveryRowInBatches() {
+	var batches [][]uint
+	res, err := Run(s.db, Options{BatchSize: 10}, s.touch("rows", &batches))
+	s.Require().NoError(err)
+	s.True(res.Completed)
+	s.Equal(25, res.Rows)
+	s.Equal(3, res.Batches)
+	s.Len(batches, 3)
+	s.Len(batches[2], 5)
+	s.Equal(int64(25), s.touched())
+}
+
+func (s *RunIntegrationTestSuite) TestRun_DryRunRollsBackEveryBatch() {
+	var batches [][]uint
+	res, err := Run(s.db, Options{BatchSize: 10, DryRun: true}, s.touch("rows", &batches))
+	s.Require().NoError(err)
+	s.Equal(25, res.Rows)
+	s.Zero(s.touched(), "a dry run writes nothing")
+}
+
+func (s *RunIntegrationTestSuite) TestRun_ResumesAndCheckpoints() {
+	var ids []uint
+	s.Require().NoError(s.db.Table("backfill_rows").Order("id").Pluck("id", &ids).Error)
+	checkpoint := filepath.Join(s.T().TempDir(), "rows.checkpoint")
+
+	// The second batch fails; the checkpoint holds the first.
+	calls := 0
+	failing := Stage{
+		Name:  "rows",
+		Query: func(db *gorm.DB) *gorm.DB { return db.Table("backfill_rows") },
+		Batch: func(tx *gorm.DB, batch []uint) error {
+			if calls++; calls == 2 {
+				return errors.New("boom")
+			}
+			return tx.Exec(`UPDATE backfill_rows SET touched = true WHERE id IN ?`, batch).Error
+		},
+	}
+	res, err := Run(s.db, Options{BatchSize: 10, CheckpointFile: checkpoint}, failing)
+	s.Require().ErrorContains(err, "boom")
+	s.False(res.Completed)
+	s.Equal(Cursor{Stage: "rows", After: ids[9]}, res.Cursor)
+	saved, err := ReadCheckpoint(checkpoint)
+	s.Require().NoError(err)
+	s.Equal(res.Cursor, saved)
+
+	// A rerun picks the checkpoint up, skips what was done and clears it.
+	var batches [][]uint
+	res, err = Run(s.db, Options{BatchSize: 10, CheckpointFile: checkpoint}, s.touch("rows", &batches))
+	s.Require().NoError(err)
+	s.Equal(15, res.Rows)
+	s.Equal(ids[10], batches[0][0])
+	s.Equal(int64(25), s.touched())
+	saved, err = ReadCheckpoint(checkpoint)
+	s.Require().NoError(err)
+	s.True(saved.IsZero(), "a completed run removes its checkpoint")
+}
+
+func (s *RunIntegrationTestSuite) TestRun_ResumeSkipsEarlierStages() {
+	var first, second [][]uint
+	res, err := Run(s.db, Options{BatchSize: 10, ResumeFrom: Cursor{Stage: "second", After: 20}},
+		s.touch("first", &first), s.touch("second", &second))
+	s.Require().NoError(err)
+	s.Empty(first)
+	s.Equal(res.Rows, len(second[0]))
+
+	_, err = Run(s.db, Options{ResumeFrom: Cursor{Stage: "third"}}, s.touch("first", &first))
+	s.ErrorContains(err, "names no stage")
+}
+
+func (s *RunIntegrationTestSuite) TestRun_StopsBetweenBatchesWhenCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stage := Stage{
+		Name:  "rows",
+		Query: func(db *gorm.DB) *gorm.DB { return db.Table("backfill_rows") },
+		Batch: func(tx *gorm.DB, ids []uint) error {
+			cancel()
+			return tx.Exec(`UPDATE backfill_rows SET touched = true WHERE id IN ?`, ids).Error
+		},
+	}
+	res, err := Run(s.db.WithContext(ctx), Options{BatchSize: 10}, stage)
+	s.ErrorIs(err, context.Canceled)
+	s.Equal(1, res.Batches, "the batch in flight finishes")
+	s.Equal(int64(10), s.touched())
+}
@@ -0,0 +1,59 @@
+package backfill
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// barWidth is the width of the progress bar in characters.
+const barWidth = 30
+
+// progress draws a single-line progress bar, redrawn in place with \r.
+type progress struct {
+	w     io.Writer
+	stage string
+	total int
+	start time.Time
+	drawn bool
+}
+
+func newProgress(w io.Writer, stage string, total int) *progress {
+	return &progress{w: w, stage: stage, total: total, start: time.Now()}
+}
+
+func (p *progress) update(done int, lastID uint) {
+	if p.w == nil {
+		return
+	}
+	fmt.Fprint(p.w, "\r"+progressLine(p.stage, done, p.total, lastID, time.Since(p.start)))
+	p.drawn = true
+}
+
+// finish ends the bar's line so later output starts on a fresh one.
+func (p *progress) finish() {
+	if p.w != nil && p.drawn {
+		fmt.Fprintln(p.w)
+	}
+}
+
+// progressLine renders e.g.
+//
+//	venues [#########---------------------]  30% 300/1000  last id 412  150 rows/s
+//
+// total is counted when the stage starts, so done can overtake it when rows
+// are inserted during the run; the bar is capped at full.
+func progressLine(stage string, done, total int, lastID uint, elapsed time.Duration) string {
+	pct := 100
+	if total > 0 && done < total {
+		pct = done * 100 / total
+	}
+	filled := pct * barWidth / 100
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", barWidth-filled)
+	rate := ""
+	if secs := elapsed.Seconds(); secs > 0 {
+		rate = fmt.Sprintf("  %.0f rows/s", float64(done)/secs)
+	}
+	return fmt.Sprintf("%s [%s] %3d%% %d/%d  last id %d%s", stage, bar, pct, done, total, lastID, rate)
+}
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// JWT key ring
+// ============
+//
+// Session tokens are signed with the current key (JWT_SECRET_KEY) and carry
+// its id in the `kid` header. Keys listed in JWT_PREVIOUS_KEYS still verify,
+// so rotating is: move the old secret into JWT_PREVIOUS_KEYS, set a new
+// JWT_SECRET_KEY, then reload (POST /admin/auth/jwt-keys/rotate, or wait for
+// the secret watcher). Sessions and HMAC unsubscribe links signed with the
+// old key keep working until it is dropped from JWT_PREVIOUS_KEYS.
+
+// EnvJWTPreviousKeys lists retired secrets that still verify, comma-separated.
+const EnvJWTPreviousKeys = "JWT_PREVIOUS_KEYS"
+
+// JWTKey is one HMAC signing secret and its key id.
+type JWTKey struct {
+	ID     string
+	Secret string
+}
+
+// NewJWTKey derives the key id from the secret, so every instance agrees on
+// it without configuring one. The id is a truncated SHA-256 of the secret,
+// which reveals nothing useful about a high-entropy secret.
+func NewJWTKey(secret string) JWTKey {
+	sum := sha256.Sum256([]byte(secret))
+	return JWTKey{ID: hex.EncodeToString(sum[:8]), Secret: secret}
+}
+
+// JWTKeyRing is the current signing key plus the previous keys that still
+// verify. It is shared by every JWTService built from the same Config, so a
+// reload reaches all of them.
+type JWTKeyRing struct {
+	mu       sync.RWMutex
+	current  JWTKey
+	previous []JWTKey
+	// load re-reads the secrets; nil means the ring cannot reload.
+	load       func(ctx context.Context) (current string, previous []string, err error)
+	reloadMu   sync.Mutex
+	lastReload time.Time
+}
+
+// NewJWTKeyRing builds a ring from the current secret and previous secrets.
+// Previous secrets equal to the current one are dropped.
+func NewJWTKeyRing(current string, previous []string) *JWTKeyRing {
+	r := &JWTKeyRing{}
+	r.set(current, previous)
+	return r
+}
+
+func (r *JWTKeyRing) set(current string, previous []string) {
+	cur := NewJWTKey(current)
+	prev := make([]JWTKey, 0, len(previous))
+	seen := map[string]bool{cur.ID: true}
+	for _, secret := range previous {
+		key := NewJWTKey(secret)
+		if secret == "" || seen[key.ID] {
+			continue
+		}
+		seen[key.ID] = true
+		prev = append(prev, key)
+	}
+	r.mu.Lock()
+	r.current, r.previous = cur, prev
+	r.mu.Unlock()
+}
+
+// Current returns the signing key.
+func (r *JWTKeyRing) Current() JWTKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.current
+}
+
+// Previous returns the retired keys that still verify.
+func (r *JWTKeyRing) Previous() []JWTKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]JWTKey(nil), r.previous...)
+}
+
+// Lookup returns the key with id kid.
+func (r *JWTKeyRing) Lookup(kid string) (JWTKey, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.current.ID == kid {
+		return r.current, true
+	}
+	for _, key := range r.previous {
+		if key.ID == kid {
+			return key, true
+		}
+	}
+	return JWTKey{}, false
+}
+
+// All returns the current key followed by the previous keys.
+func (r *JWTKeyRing) All() []JWTKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]JWTKey{r.current}, r.previous...)
+}
+
+// Verify reports whether check accepts any key's secret, current first. HMAC
+// links (unsubscribe, one-click actions) carry no kid, so a link minted under
+// a since-rotated key is checked against every key still in the ring.
+func (r *JWTKeyRing) Verify(check func(secret string) bool) bool {
+	for _, key := range r.All() {
+		if check(key.Secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrJWTKeyRingStatic is returned by Reload on a ring built without a
+// secret source (tests, one-off commands).
+var ErrJWTKeyRingStatic = errors.New("jwt key ring has no secret source")
+
+// Reload re-reads JWT_SECRET_KEY and JWT_PREVIOUS_KEYS and swaps them in.
+// changed reports whether the signing key moved.
+func (r *JWTKeyRing) Reload(ctx context.Context) (changed bool, err error) {
+	if r.load == nil {
+		return false, ErrJWTKeyRingStatic
+	}
+	current, previous, err := r.load(ctx)
+	if err != nil {
+		return false, err
+	}
+	if current == "" {
+		return false, fmt.Errorf("%s resolved empty; keeping the current key ring", EnvJWTSecretKey)
+	}
+	before := r.Current().ID
+	r.set(current, previous)
+	return r.Current().ID != before, nil
+}
+
+// ReloadIfStale reloads unless the last attempt was under minInterval ago.
+// Validation calls it on an unknown kid: another instance may have rotated
+// first, and the throttle stops forged kids from hammering the store.
+func (r *JWTKeyRing) ReloadIfStale(ctx context.Context, minInterval time.Duration) bool {
+	if r.load == nil {
+		return false
+	}
+	r.reloadMu.Lock()
+	if time.Since(r.lastReload) < minInterval {
+		r.reloadMu.Unlock()
+		return false
+	}
+	r.lastReload = time.Now()
+	r.reloadMu.Unlock()
+	_, err := r.Reload(ctx)
+	return err == nil
+}
+
+// newJWTKeyRing builds the Load-time ring, reloading through the secrets
+// backend (or the environment when there is none).
+func newJWTKeyRing(current string, previous []string, secrets SecretsConfig) *JWTKeyRing {
+	ring := NewJWTKeyRing(current, previous)
+	ring.load = func(ctx context.Context) (string, []string, error) {
+		var provider SecretProvider = EnvSecretProvider{}
+		if secrets.Provider != nil {
+			secrets.Provider.Invalidate()
+			provider = secrets.Provider
+		}
+		cur, err := provider.GetSecret(ctx, EnvJWTSecretKey)
+		if err != nil {
+			return "", nil, fmt.Errorf("read %s: %w", EnvJWTSecretKey, err)
+		}
+		prev, err := provider.GetSecret(ctx, EnvJWTPreviousKeys)
+		if err != nil && !errors.Is(err, ErrSecretNotFound) {
+			return "", nil, fmt.Errorf("read %s: %w", EnvJWTPreviousKeys, err)
+		}
+		return cur, splitList(prev), nil
+	}
+	return ring
+}
+
+// KeyRing returns the configured ring, or a static one built from SecretKey
+// and PreviousKeys for configs assembled by hand (tests, tools).
+func (j *JWTConfig) KeyRing() *JWTKeyRing {
+	if j.Keys != nil {
+		return j.Keys
+	}
+	return NewJWTKeyRing(j.SecretKey, j.PreviousKeys)
+}
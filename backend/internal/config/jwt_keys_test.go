@@ -0,0 +1,127 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewJWTKey_StableID(t *testing.T) {
+	a, b := NewJWTKey("secret-a"), NewJWTKey("secret-a")
+	if a.ID != b.ID || len(a.ID) != 16 {
+		t.Errorf("expected a stable 16-char kid, got %q and %q", a.ID, b.ID)
+	}
+	if NewJWTKey("secret-b").ID == a.ID {
+		t.Error("different secrets must have different kids")
+	}
+}
+
+func TestJWTKeyRing_DropsDuplicatesAndBlanks(t *testing.T) {
+	ring := NewJWTKeyRing("current", []string{"current", "", "old", "old"})
+
+	prev := ring.Previous()
+	if len(prev) != 1 || prev[0].Secret != "old" {
+		t.Fatalf("Previous = %+v, want just old", prev)
+	}
+	if len(ring.All()) != 2 {
+		t.Errorf("All = %d keys, want 2", len(ring.All()))
+	}
+	if key, ok := ring.Lookup(NewJWTKey("old").ID); !ok || key.Secret != "old" {
+		t.Errorf("Lookup(old) = %+v, %v", key, ok)
+	}
+	if _, ok := ring.Lookup("unknown"); ok {
+		t.Error("Lookup of an unknown kid must miss")
+	}
+}
+
+func TestJWTKeyRing_VerifyTriesEveryKey(t *testing.T) {
+	ring := NewJWTKeyRing("current", []string{"old"})
+
+	var tried []string
+	ok := ring.Verify(func(secret string) bool {
+		tried = append(tried, secret)
+		return secret == "old"
+	})
+	if !ok || len(tried) != 2 || tried[0] != "current" {
+		t.Errorf("Verify = %v after trying %v, want true after current then old", ok, tried)
+	}
+	if ring.Verify(func(secret string) bool { return secret == "retired" }) {
+		t.Error("a secret no longer in the ring must not verify")
+	}
+}
+
+func TestJWTKeyRing_StaticCannotReload(t *testing.T) {
+	ring := NewJWTKeyRing("current", nil)
+	if _, err := ring.Reload(context.Background()); !errors.Is(err, ErrJWTKeyRingStatic) {
+		t.Errorf("Reload = %v, want ErrJWTKeyRingStatic", err)
+	}
+	if ring.ReloadIfStale(context.Background(), 0) {
+		t.Error("a static ring must not report a reload")
+	}
+}
+
+func TestJWTKeyRing_ReloadFromProvider(t *testing.T) {
+	stub := &stubSecretProvider{values: map[string]string{EnvJWTSecretKey: "v1"}}
+	ring := newJWTKeyRing("v1", nil, SecretsConfig{Backend: SecretsBackendVault, Provider: NewCachedSecretProvider(stub, time.Hour)})
+
+	stub.set(EnvJWTSecretKey, "v2")
+	stub.set(EnvJWTPreviousKeys, "v1")
+	changed, err := ring.Reload(context.Background())
+	if err != nil || !changed {
+		t.Fatalf("Reload = %v, %v; want changed", changed, err)
+	}
+	if ring.Current().Secret != "v2" {
+		t.Errorf("current = %q, want v2", ring.Current().Secret)
+	}
+	if _, ok := ring.Lookup(NewJWTKey("v1").ID); !ok {
+		t.Error("the previous key must still verify")
+	}
+
+	// Reloading unchanged secrets is a no-op.
+	if changed, err := ring.Reload(context.Background()); err != nil || changed {
+		t.Errorf("second Reload = %v, %v; want unchanged", changed, err)
+	}
+}
+
+func TestJWTKeyRing_ReloadKeepsRingOnEmptySecret(t *testing.T) {
+	stub := &stubSecretProvider{values: map[string]string{}}
+	ring := newJWTKeyRing("v1", nil, SecretsConfig{Provider: NewCachedSecretProvider(stub, time.Hour)})
+
+	if _, err := ring.Reload(context.Background()); err == nil {
+		t.Error("expected an error when JWT_SECRET_KEY is missing")
+	}
+	if ring.Current().Secret != "v1" {
+		t.Errorf("current = %q, want the ring untouched", ring.Current().Secret)
+	}
+}
+
+func TestJWTKeyRing_ReloadIfStaleThrottles(t *testing.T) {
+	t.Setenv(EnvJWTSecretKey, "v1")
+	t.Setenv(EnvJWTPreviousKeys, "")
+	ring := newJWTKeyRing("v1", nil, SecretsConfig{Backend: SecretsBackendEnv})
+
+	if !ring.ReloadIfStale(context.Background(), time.Hour) {
+		t.Fatal("first ReloadIfStale should reload")
+	}
+	t.Setenv(EnvJWTSecretKey, "v2")
+	if ring.ReloadIfStale(context.Background(), time.Hour) {
+		t.Error("second ReloadIfStale within the interval should be throttled")
+	}
+	if ring.Current().Secret != "v1" {
+		t.Errorf("current = %q, want v1 while throttled", ring.Current().Secret)
+	}
+}
+
+func TestJWTConfig_KeyRing(t *testing.T) {
+	cfg := JWTConfig{SecretKey: "current", PreviousKeys: []string{"old"}}
+	ring := cfg.KeyRing()
+	if ring.Current().Secret != "current" || len(ring.Previous()) != 1 {
+		t.Errorf("KeyRing built from SecretKey/PreviousKeys = %+v", ring.All())
+	}
+
+	cfg.Keys = NewJWTKeyRing("shared", nil)
+	if cfg.KeyRing() != cfg.Keys {
+		t.Error("KeyRing must return the configured ring")
+	}
+}
@@ -0,0 +1,339 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Secret backends
+// ===============
+//
+// DATABASE_URL, JWT_SECRET_KEY, JWT_PREVIOUS_KEYS, OAUTH_SECRET_KEY and the
+// OAuth client secrets can come from a secret store instead of the process
+// environment.
+// SECRETS_BACKEND picks the store ("env", the default, or "vault"); a key the
+// store does not hold falls back to its env var, so a deployment can move
+// secrets over one at a time.
+
+const (
+	EnvSecretsBackend         = "SECRETS_BACKEND"
+	EnvSecretsCacheTTLSeconds = "SECRETS_CACHE_TTL_SECONDS"
+
+	// Vault KV v2: the secret at <mount>/data/<path> holds one field per
+	// managed key, named like the env var (e.g. "JWT_SECRET_KEY").
+	EnvVaultAddr        = "VAULT_ADDR"
+	EnvVaultToken       = "VAULT_TOKEN"
+	EnvVaultSecretMount = "VAULT_SECRET_MOUNT"
+	EnvVaultSecretPath  = "VAULT_SECRET_PATH"
+)
+
+// Secret backend names accepted by SECRETS_BACKEND.
+const (
+	SecretsBackendEnv   = "env"
+	SecretsBackendVault = "vault"
+)
+
+// defaultSecretsCacheTTL is how long a fetched secret is served before the
+// store is asked again (which is what picks up a rotation).
+const defaultSecretsCacheTTL = 5 * time.Minute
+
+// ManagedSecretKeys are the env var names Load resolves through the secret
+// provider.
+var ManagedSecretKeys = []string{
+	EnvDatabaseURL,
+	EnvJWTSecretKey,
+	EnvJWTPreviousKeys,
+	EnvOAuthSecretKey,
+	EnvGoogleClientSecret,
+	EnvGitHubClientSecret,
+}
+
+// ErrSecretNotFound is returned when a provider does not hold a key.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretProvider fetches secrets by env var name.
+type SecretProvider interface {
+	GetSecret(ctx context.Context, key string) (string, error)
+}
+
+// EnvSecretProvider reads secrets from the process environment.
+type EnvSecretProvider struct{}
+
+// GetSecret returns the env var, or ErrSecretNotFound when it is unset.
+func (EnvSecretProvider) GetSecret(_ context.Context, key string) (string, error) {
+	if value := os.Getenv(key); value != "" {
+		return value, nil
+	}
+	return "", ErrSecretNotFound
+}
+
+// VaultSecretProvider reads one Vault KV v2 secret over the HTTP API.
+type VaultSecretProvider struct {
+	Addr   string
+	Token  string
+	Mount  string
+	Path   string
+	Client *http.Client
+}
+
+// NewVaultSecretProvider builds a provider for <addr>/v1/<mount>/data/<path>.
+func NewVaultSecretProvider(addr, token, mount, path string) (*VaultSecretProvider, error) {
+	if addr == "" || token == "" || path == "" {
+		return nil, fmt.Errorf("%s, %s and %s are required when %s=%s",
+			EnvVaultAddr, EnvVaultToken, EnvVaultSecretPath, EnvSecretsBackend, SecretsBackendVault)
+	}
+	if _, err := url.Parse(addr); err != nil {
+		return nil, fmt.Errorf("%s is not a valid URL: %w", EnvVaultAddr, err)
+	}
+	if mount == "" {
+		mount = "secret"
+	}
+	return &VaultSecretProvider{
+		Addr:   strings.TrimRight(addr, "/"),
+		Token:  token,
+		Mount:  strings.Trim(mount, "/"),
+		Path:   strings.Trim(path, "/"),
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// GetSecret returns one field of the configured secret.
+func (v *VaultSecretProvider) GetSecret(ctx context.Context, key string) (string, error) {
+	fields, err := v.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	value, ok := fields[key]
+	if !ok || value == "" {
+		return "", ErrSecretNotFound
+	}
+	return value, nil
+}
+
+// fetch reads the latest version of the secret's fields.
+func (v *VaultSecretProvider) fetch(ctx context.Context) (map[string]string, error) {
+	endpoint := fmt.Sprintf("%s/v1/%s/data/%s", v.Addr, v.Mount, v.Path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("vault: build request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault: read %s/%s: %w", v.Mount, v.Path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return map[string]string{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		return nil, fmt.Errorf("vault: read %s/%s: status %d", v.Mount, v.Path, resp.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("vault: decode %s/%s: %w", v.Mount, v.Path, err)
+	}
+	fields := make(map[string]string, len(body.Data.Data))
+	for k, raw := range body.Data.Data {
+		if s, ok := raw.(string); ok {
+			fields[k] = s
+		}
+	}
+	return fields, nil
+}
+
+// FallbackSecretProvider asks Primary first and Fallback for keys Primary
+// does not hold. With AllowFallbackOnError, a Primary failure (store down)
+// also falls back — set in development only, where the env file is the
+// source of truth anyway.
+type FallbackSecretProvider struct {
+	Primary              SecretProvider
+	Fallback             SecretProvider
+	AllowFallbackOnError bool
+}
+
+// GetSecret implements SecretProvider.
+func (f *FallbackSecretProvider) GetSecret(ctx context.Context, key string) (string, error) {
+	value, err := f.Primary.GetSecret(ctx, key)
+	if err == nil {
+		return value, nil
+	}
+	if !errors.Is(err, ErrSecretNotFound) && !f.AllowFallbackOnError {
+		return "", err
+	}
+	return f.Fallback.GetSecret(ctx, key)
+}
+
+// CachedSecretProvider serves secrets from memory for TTL. An expired entry
+// is re-fetched on the next read, which is how a rotated value is picked up;
+// if that fetch fails the stale value keeps being served.
+type CachedSecretProvider struct {
+	provider SecretProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedSecret
+}
+
+type cachedSecret struct {
+	value     string
+	fetchedAt time.Time
+}
+
+// NewCachedSecretProvider wraps provider with a TTL cache.
+func NewCachedSecretProvider(provider SecretProvider, ttl time.Duration) *CachedSecretProvider {
+	return &CachedSecretProvider{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[string]cachedSecret),
+	}
+}
+
+// GetSecret implements SecretProvider.
+func (c *CachedSecretProvider) GetSecret(ctx context.Context, key string) (string, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Sub(entry.fetchedAt) < c.ttl {
+		return entry.value, nil
+	}
+
+	value, err := c.provider.GetSecret(ctx, key)
+	if err != nil {
+		if ok && !errors.Is(err, ErrSecretNotFound) {
+			return entry.value, nil
+		}
+		return "", err
+	}
+	c.mu.Lock()
+	c.entries[key] = cachedSecret{value: value, fetchedAt: c.now()}
+	c.mu.Unlock()
+	return value, nil
+}
+
+// Invalidate drops every cached entry so the next read hits the store.
+func (c *CachedSecretProvider) Invalidate() {
+	c.mu.Lock()
+	c.entries = make(map[string]cachedSecret)
+	c.mu.Unlock()
+}
+
+// WatchRotation re-reads keys every interval until ctx is done and calls
+// onRotate with each key whose value changed. Values read at startup (the
+// database pool, the OAuth secrets) are not swapped in place; the callback
+// is where a caller decides what a rotation means for it.
+func (c *CachedSecretProvider) WatchRotation(ctx context.Context, interval time.Duration, keys []string, onRotate func(key string)) {
+	last := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if value, err := c.GetSecret(ctx, key); err == nil {
+			last[key] = value
+		}
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Invalidate()
+			for _, key := range keys {
+				value, err := c.GetSecret(ctx, key)
+				if err != nil {
+					continue
+				}
+				if prev, seen := last[key]; seen && prev != value {
+					onRotate(key)
+				}
+				last[key] = value
+			}
+		}
+	}
+}
+
+// SecretsConfig records the configured backend. Provider is nil for the
+// env backend, where there is nothing to watch.
+type SecretsConfig struct {
+	Backend  string
+	CacheTTL time.Duration
+	Provider *CachedSecretProvider
+}
+
+// newSecretsConfig builds the provider named by SECRETS_BACKEND.
+func newSecretsConfig() (SecretsConfig, error) {
+	backend := strings.ToLower(GetEnv(EnvSecretsBackend, SecretsBackendEnv))
+	ttl := time.Duration(getEnvAsInt(EnvSecretsCacheTTLSeconds, int(defaultSecretsCacheTTL/time.Second))) * time.Second
+	cfg := SecretsConfig{Backend: backend, CacheTTL: ttl}
+
+	switch backend {
+	case SecretsBackendEnv:
+		return cfg, nil
+	case SecretsBackendVault:
+		if ttl <= 0 {
+			return cfg, fmt.Errorf("%s must be positive", EnvSecretsCacheTTLSeconds)
+		}
+		vault, err := NewVaultSecretProvider(
+			os.Getenv(EnvVaultAddr), os.Getenv(EnvVaultToken),
+			os.Getenv(EnvVaultSecretMount), os.Getenv(EnvVaultSecretPath),
+		)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.Provider = NewCachedSecretProvider(&FallbackSecretProvider{
+			Primary:              vault,
+			Fallback:             EnvSecretProvider{},
+			AllowFallbackOnError: os.Getenv(EnvEnvironment) == EnvDevelopment,
+		}, ttl)
+		return cfg, nil
+	default:
+		return cfg, fmt.Errorf("%s must be %q or %q (got %q)", EnvSecretsBackend, SecretsBackendEnv, SecretsBackendVault, backend)
+	}
+}
+
+// resolveSecrets fetches every managed key once at startup. Keys nobody
+// holds are left out, so callers fall back to their defaults.
+func (s SecretsConfig) resolveSecrets(ctx context.Context) (map[string]string, error) {
+	resolved := make(map[string]string, len(ManagedSecretKeys))
+	var provider SecretProvider = EnvSecretProvider{}
+	if s.Provider != nil {
+		provider = s.Provider
+	}
+	for _, key := range ManagedSecretKeys {
+		value, err := provider.GetSecret(ctx, key)
+		if errors.Is(err, ErrSecretNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("resolve %s from %s: %w", key, s.Backend, err)
+		}
+		resolved[key] = value
+	}
+	return resolved, nil
+}
+
+// secretOr returns the resolved value for key, or defaultValue.
+func secretOr(resolved map[string]string, key, defaultValue string) string {
+	if value, ok := resolved[key]; ok {
+		return value
+	}
+	return defaultValue
+}
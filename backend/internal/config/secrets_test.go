@@ -0,0 +1,228 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeVault serves one KV v2 secret.
+func fakeVault(t *testing.T, status int, fields map[string]any) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/psychic-homily/production" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if r.Header.Get("X-Vault-Token") != "token" {
+			t.Errorf("missing vault token")
+		}
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": fields}})
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestVaultSecretProvider(t *testing.T) {
+	srv := fakeVault(t, http.StatusOK, map[string]any{"JWT_SECRET_KEY": "from-vault"})
+	vault, err := NewVaultSecretProvider(srv.URL, "token", "", "/psychic-homily/production/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := vault.GetSecret(context.Background(), "JWT_SECRET_KEY")
+	if err != nil || got != "from-vault" {
+		t.Errorf("GetSecret = %q, %v; want from-vault", got, err)
+	}
+	if _, err := vault.GetSecret(context.Background(), "DATABASE_URL"); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("expected ErrSecretNotFound for a missing field, got %v", err)
+	}
+}
+
+func TestVaultSecretProvider_ServerError(t *testing.T) {
+	srv := fakeVault(t, http.StatusForbidden, nil)
+	vault, _ := NewVaultSecretProvider(srv.URL, "token", "secret", "psychic-homily/production")
+
+	_, err := vault.GetSecret(context.Background(), "JWT_SECRET_KEY")
+	if err == nil || errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("expected a transport error for 403, got %v", err)
+	}
+}
+
+func TestNewVaultSecretProvider_RequiresSettings(t *testing.T) {
+	if _, err := NewVaultSecretProvider("", "token", "", "path"); err == nil {
+		t.Error("expected error without VAULT_ADDR")
+	}
+	if _, err := NewVaultSecretProvider("http://vault:8200", "", "", "path"); err == nil {
+		t.Error("expected error without VAULT_TOKEN")
+	}
+}
+
+type stubSecretProvider struct {
+	mu     sync.Mutex
+	values map[string]string
+	err    error
+	calls  int
+}
+
+func (s *stubSecretProvider) set(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+}
+
+func (s *stubSecretProvider) GetSecret(_ context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.err != nil {
+		return "", s.err
+	}
+	if v, ok := s.values[key]; ok {
+		return v, nil
+	}
+	return "", ErrSecretNotFound
+}
+
+func TestFallbackSecretProvider(t *testing.T) {
+	fallback := &stubSecretProvider{values: map[string]string{"K": "env"}}
+
+	t.Run("missing key falls back", func(t *testing.T) {
+		p := &FallbackSecretProvider{Primary: &stubSecretProvider{}, Fallback: fallback}
+		if got, err := p.GetSecret(context.Background(), "K"); err != nil || got != "env" {
+			t.Errorf("GetSecret = %q, %v; want env", got, err)
+		}
+	})
+
+	t.Run("store error fails closed", func(t *testing.T) {
+		p := &FallbackSecretProvider{Primary: &stubSecretProvider{err: errors.New("down")}, Fallback: fallback}
+		if _, err := p.GetSecret(context.Background(), "K"); err == nil {
+			t.Error("expected the store error outside development")
+		}
+	})
+
+	t.Run("store error falls back in development", func(t *testing.T) {
+		p := &FallbackSecretProvider{Primary: &stubSecretProvider{err: errors.New("down")}, Fallback: fallback, AllowFallbackOnError: true}
+		if got, err := p.GetSecret(context.Background(), "K"); err != nil || got != "env" {
+			t.Errorf("GetSecret = %q, %v; want env", got, err)
+		}
+	})
+}
+
+func TestCachedSecretProvider(t *testing.T) {
+	stub := &stubSecretProvider{values: map[string]string{"K": "v1"}}
+	cache := NewCachedSecretProvider(stub, time.Minute)
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if got, _ := cache.GetSecret(context.Background(), "K"); got != "v1" {
+			t.Fatalf("GetSecret = %q, want v1", got)
+		}
+	}
+	if stub.calls != 1 {
+		t.Errorf("expected 1 store read within the TTL, got %d", stub.calls)
+	}
+
+	// Rotation: the new value is picked up once the entry expires.
+	stub.set("K", "v2")
+	now = now.Add(2 * time.Minute)
+	if got, _ := cache.GetSecret(context.Background(), "K"); got != "v2" {
+		t.Errorf("GetSecret after TTL = %q, want v2", got)
+	}
+
+	// A failing store keeps serving the stale value.
+	stub.err = errors.New("down")
+	now = now.Add(2 * time.Minute)
+	if got, err := cache.GetSecret(context.Background(), "K"); err != nil || got != "v2" {
+		t.Errorf("GetSecret with store down = %q, %v; want stale v2", got, err)
+	}
+}
+
+func TestCachedSecretProvider_WatchRotation(t *testing.T) {
+	stub := &stubSecretProvider{values: map[string]string{"K": "v1"}}
+	cache := NewCachedSecretProvider(stub, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rotated := make(chan string, 1)
+	go cache.WatchRotation(ctx, 10*time.Millisecond, []string{"K"}, func(key string) {
+		select {
+		case rotated <- key:
+		default:
+		}
+	})
+
+	time.Sleep(20 * time.Millisecond)
+	stub.set("K", "v2")
+
+	select {
+	case key := <-rotated:
+		if key != "K" {
+			t.Errorf("rotated key = %q, want K", key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a rotation callback")
+	}
+}
+
+func TestNewSecretsConfig(t *testing.T) {
+	t.Run("env by default", func(t *testing.T) {
+		t.Setenv(EnvSecretsBackend, "")
+		cfg, err := newSecretsConfig()
+		if err != nil || cfg.Backend != SecretsBackendEnv || cfg.Provider != nil {
+			t.Errorf("newSecretsConfig = %+v, %v; want env backend without provider", cfg, err)
+		}
+	})
+
+	t.Run("unknown backend errors", func(t *testing.T) {
+		t.Setenv(EnvSecretsBackend, "ssm")
+		if _, err := newSecretsConfig(); err == nil {
+			t.Error("expected error for an unknown backend")
+		}
+	})
+
+	t.Run("vault without settings errors", func(t *testing.T) {
+		t.Setenv(EnvSecretsBackend, "vault")
+		t.Setenv(EnvVaultAddr, "")
+		if _, err := newSecretsConfig(); err == nil {
+			t.Error("expected error for vault without VAULT_ADDR")
+		}
+	})
+}
+
+func TestResolveSecrets_VaultOverridesEnv(t *testing.T) {
+	srv := fakeVault(t, http.StatusOK, map[string]any{"JWT_SECRET_KEY": "from-vault"})
+	t.Setenv(EnvEnvironment, "production")
+	t.Setenv(EnvSecretsBackend, "vault")
+	t.Setenv(EnvVaultAddr, srv.URL)
+	t.Setenv(EnvVaultToken, "token")
+	t.Setenv(EnvVaultSecretPath, "psychic-homily/production")
+	t.Setenv(EnvJWTSecretKey, "from-env")
+	t.Setenv(EnvDatabaseURL, "postgres://env")
+
+	cfg, err := newSecretsConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resolved, err := cfg.resolveSecrets(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := secretOr(resolved, EnvJWTSecretKey, ""); got != "from-vault" {
+		t.Errorf("JWT secret = %q, want from-vault", got)
+	}
+	if got := secretOr(resolved, EnvDatabaseURL, ""); got != "postgres://env" {
+		t.Errorf("DATABASE_URL = %q, want the env fallback", got)
+	}
+	if got := secretOr(resolved, EnvGitHubClientSecret, "default"); got != "default" {
+		t.Errorf("unset secret = %q, want default", got)
+	}
+}
@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Admin annotation error codes.
+const (
+	// CodeAdminAnnotationNotFound indicates the note or tag doesn't exist.
+	CodeAdminAnnotationNotFound = "ADMIN_ANNOTATION_NOT_FOUND"
+	// CodeAdminAnnotationEntityNotFound indicates the annotated entity
+	// doesn't exist.
+	CodeAdminAnnotationEntityNotFound = "ADMIN_ANNOTATION_ENTITY_NOT_FOUND"
+	// CodeAdminAnnotationInvalid indicates the request failed validation.
+	CodeAdminAnnotationInvalid = "ADMIN_ANNOTATION_INVALID"
+)
+
+// AdminAnnotationError represents an admin note/tag error with context.
+type AdminAnnotationError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *AdminAnnotationError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *AdminAnnotationError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrAdminAnnotationNotFound creates a note/tag-not-found error.
+func ErrAdminAnnotationNotFound(what string) *AdminAnnotationError {
+	return &AdminAnnotationError{
+		Code:    CodeAdminAnnotationNotFound,
+		Message: fmt.Sprintf("%s not found", what),
+	}
+}
+
+// ErrAdminAnnotationEntityNotFound creates an entity-not-found error.
+func ErrAdminAnnotationEntityNotFound(entityType string, entityID uint) *AdminAnnotationError {
+	return &AdminAnnotationError{
+		Code:    CodeAdminAnnotationEntityNotFound,
+		Message: fmt.Sprintf("%s %d not found", entityType, entityID),
+	}
+}
+
+// ErrAdminAnnotationInvalid creates a validation error with a user-facing
+// message.
+func ErrAdminAnnotationInvalid(message string) *AdminAnnotationError {
+	return &AdminAnnotationError{
+		Code:    CodeAdminAnnotationInvalid,
+		Message: message,
+	}
+}
@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Announcement error codes.
+const (
+	// CodeAnnouncementNotFound indicates no announcement has the ID.
+	CodeAnnouncementNotFound = "ANNOUNCEMENT_NOT_FOUND"
+	// CodeAnnouncementInvalid indicates the request failed validation.
+	CodeAnnouncementInvalid = "ANNOUNCEMENT_INVALID"
+	// CodeAnnouncementNotDismissible indicates a user tried to dismiss a
+	// banner that can't be dismissed.
+	CodeAnnouncementNotDismissible = "ANNOUNCEMENT_NOT_DISMISSIBLE"
+)
+
+// AnnouncementError represents an announcement error with context.
+type AnnouncementError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *AnnouncementError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *AnnouncementError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrAnnouncementNotFound creates an announcement-not-found error.
+func ErrAnnouncementNotFound(id uint) *AnnouncementError {
+	return &AnnouncementError{
+		Code:    CodeAnnouncementNotFound,
+		Message: fmt.Sprintf("announcement %d not found", id),
+	}
+}
+
+// ErrAnnouncementInvalid creates a validation error with a user-facing message.
+func ErrAnnouncementInvalid(message string) *AnnouncementError {
+	return &AnnouncementError{
+		Code:    CodeAnnouncementInvalid,
+		Message: message,
+	}
+}
+
+// ErrAnnouncementNotDismissible creates an error for dismissing a banner
+// that can't be dismissed.
+func ErrAnnouncementNotDismissible(id uint) *AnnouncementError {
+	return &AnnouncementError{
+		Code:    CodeAnnouncementNotDismissible,
+		Message: fmt.Sprintf("announcement %d can't be dismissed", id),
+	}
+}
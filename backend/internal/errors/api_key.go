@@ -0,0 +1,89 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// API key error codes.
+const (
+	// CodeAPIKeyNotFound indicates the key does not exist or belongs to
+	// another user.
+	CodeAPIKeyNotFound = "API_KEY_NOT_FOUND"
+	// CodeAPIKeyEmailNotVerified indicates the user must verify their email
+	// before creating keys.
+	CodeAPIKeyEmailNotVerified = "API_KEY_EMAIL_NOT_VERIFIED"
+	// CodeAPIKeyInvalid indicates the create request failed validation.
+	CodeAPIKeyInvalid = "API_KEY_INVALID"
+	// CodeAPIKeyLimitReached indicates the user already has the maximum
+	// number of active keys.
+	CodeAPIKeyLimitReached = "API_KEY_LIMIT_REACHED"
+	// CodeAPIKeyUnauthorized indicates a presented key is unknown, revoked,
+	// or belongs to an inactive user.
+	CodeAPIKeyUnauthorized = "API_KEY_UNAUTHORIZED"
+	// CodeAPIKeyScopeMissing indicates a presented key lacks the scope the
+	// endpoint requires, or the endpoint does not accept API keys.
+	CodeAPIKeyScopeMissing = "API_KEY_SCOPE_MISSING"
+	// CodeAPIKeyRateLimited indicates a presented key has used up its
+	// per-minute request budget.
+	CodeAPIKeyRateLimited = "API_KEY_RATE_LIMITED"
+)
+
+// APIKeyError represents a public API key error with context.
+type APIKeyError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *APIKeyError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *APIKeyError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrAPIKeyNotFound creates a key-not-found error.
+func ErrAPIKeyNotFound(keyID uint) *APIKeyError {
+	return &APIKeyError{
+		Code:    CodeAPIKeyNotFound,
+		Message: fmt.Sprintf("API key %d not found", keyID),
+	}
+}
+
+// ErrAPIKeyEmailNotVerified creates an unverified-email error.
+func ErrAPIKeyEmailNotVerified() *APIKeyError {
+	return &APIKeyError{
+		Code:    CodeAPIKeyEmailNotVerified,
+		Message: "verify your email address before creating API keys",
+	}
+}
+
+// ErrAPIKeyInvalid creates a validation error with a user-facing message.
+func ErrAPIKeyInvalid(message string) *APIKeyError {
+	return &APIKeyError{
+		Code:    CodeAPIKeyInvalid,
+		Message: message,
+	}
+}
+
+// ErrAPIKeyLimitReached creates a too-many-keys error.
+func ErrAPIKeyLimitReached(limit int) *APIKeyError {
+	return &APIKeyError{
+		Code:    CodeAPIKeyLimitReached,
+		Message: fmt.Sprintf("you can have at most %d active API keys", limit),
+	}
+}
+
+// ErrAPIKeyUnauthorized creates an error for a key that cannot be used.
+func ErrAPIKeyUnauthorized(message string) *APIKeyError {
+	return &APIKeyError{
+		Code:    CodeAPIKeyUnauthorized,
+		Message: message,
+	}
+}
@@ -0,0 +1,91 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Artist claim error codes.
+const (
+	// CodeArtistClaimNotFound indicates the claim does not exist.
+	CodeArtistClaimNotFound = "ARTIST_CLAIM_NOT_FOUND"
+	// CodeArtistClaimInvalid indicates the claim request failed validation.
+	CodeArtistClaimInvalid = "ARTIST_CLAIM_INVALID"
+	// CodeArtistClaimNotPending indicates the claim was already reviewed.
+	CodeArtistClaimNotPending = "ARTIST_CLAIM_NOT_PENDING"
+	// CodeArtistClaimConflict indicates the user already has a pending claim
+	// on the artist or already manages it.
+	CodeArtistClaimConflict = "ARTIST_CLAIM_CONFLICT"
+	// CodeArtistManagerNotFound indicates the user does not manage the artist.
+	CodeArtistManagerNotFound = "ARTIST_MANAGER_NOT_FOUND"
+	// CodeArtistClaimInternal indicates a database or infrastructure failure.
+	CodeArtistClaimInternal = "ARTIST_CLAIM_INTERNAL"
+)
+
+// ArtistClaimError represents an artist claim or artist manager error with context.
+type ArtistClaimError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *ArtistClaimError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *ArtistClaimError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrArtistClaimNotFound creates a claim-not-found error.
+func ErrArtistClaimNotFound(claimID uint) *ArtistClaimError {
+	return &ArtistClaimError{
+		Code:    CodeArtistClaimNotFound,
+		Message: fmt.Sprintf("artist claim %d not found", claimID),
+	}
+}
+
+// ErrArtistClaimInvalid creates a validation error with a user-facing message.
+func ErrArtistClaimInvalid(message string) *ArtistClaimError {
+	return &ArtistClaimError{
+		Code:    CodeArtistClaimInvalid,
+		Message: message,
+	}
+}
+
+// ErrArtistClaimNotPending creates an error for reviewing an already reviewed claim.
+func ErrArtistClaimNotPending(status string) *ArtistClaimError {
+	return &ArtistClaimError{
+		Code:    CodeArtistClaimNotPending,
+		Message: fmt.Sprintf("artist claim is already %s", status),
+	}
+}
+
+// ErrArtistClaimConflict creates an error for a duplicate claim.
+func ErrArtistClaimConflict(message string) *ArtistClaimError {
+	return &ArtistClaimError{
+		Code:    CodeArtistClaimConflict,
+		Message: message,
+	}
+}
+
+// ErrArtistManagerNotFound creates an error for removing a non-manager.
+func ErrArtistManagerNotFound(artistID, userID uint) *ArtistClaimError {
+	return &ArtistClaimError{
+		Code:    CodeArtistManagerNotFound,
+		Message: fmt.Sprintf("user %d does not manage artist %d", userID, artistID),
+	}
+}
+
+// ErrArtistClaimInternal wraps a database or infrastructure failure.
+func ErrArtistClaimInternal(internal error) *ArtistClaimError {
+	return &ArtistClaimError{
+		Code:     CodeArtistClaimInternal,
+		Message:  "artist claim operation failed",
+		Internal: internal,
+	}
+}
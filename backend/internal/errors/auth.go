@@ -19,6 +19,8 @@ const (
 	CodeTokenMissing = "TOKEN_MISSING"
 	// CodeServiceUnavailable indicates the database or a service is down
 	CodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	// CodeMaintenanceMode indicates the API is down for maintenance
+	CodeMaintenanceMode = "MAINTENANCE_MODE"
 	// CodeUserExists indicates an email is already registered
 	CodeUserExists = "USER_EXISTS"
 	// CodeValidationFailed indicates request validation failed
@@ -46,6 +48,12 @@ const (
 	CodeInvalidReplyPermission = "INVALID_REPLY_PERMISSION"
 	// CodeUsernameTaken indicates a username unique-constraint violation on profile update.
 	CodeUsernameTaken = "USERNAME_TAKEN"
+	// CodeOAuthAccountInUse indicates the provider account being linked already
+	// belongs to a different user.
+	CodeOAuthAccountInUse = "OAUTH_ACCOUNT_IN_USE"
+	// CodeOAuthProviderLinked indicates the user already has a different
+	// account from this provider linked.
+	CodeOAuthProviderLinked = "OAUTH_PROVIDER_ALREADY_LINKED"
 )
 
 // AuthError represents an authentication-related error with additional context.
@@ -212,6 +220,18 @@ func ErrUsernameTaken(internal error) *AuthError {
 	return NewAuthError(CodeUsernameTaken, "Username is already taken", internal)
 }
 
+// ErrOAuthAccountInUse creates an error for linking a provider account that is
+// already tied to another user.
+func ErrOAuthAccountInUse(provider string) *AuthError {
+	return NewAuthError(CodeOAuthAccountInUse, "That account is already connected to a different Psychic Homily account", fmt.Errorf("%s account linked to another user", provider))
+}
+
+// ErrOAuthProviderLinked creates an error for linking a second account from a
+// provider the user has already connected.
+func ErrOAuthProviderLinked(provider string) *AuthError {
+	return NewAuthError(CodeOAuthProviderLinked, "A different account from this provider is already connected. Disconnect it first.", fmt.Errorf("user already has a %s account", provider))
+}
+
 // ToExternalCode converts internal error codes to external (safe) codes.
 // This prevents leaking information like whether an email exists.
 func ToExternalCode(code string) string {
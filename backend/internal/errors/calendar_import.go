@@ -0,0 +1,60 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Calendar attendance import error codes.
+const (
+	// CodeCalendarImportInvalid indicates the upload is not a parseable ICS file.
+	CodeCalendarImportInvalid = "CALENDAR_IMPORT_INVALID"
+	// CodeCalendarImportTooLarge indicates the decoded ICS exceeds the size cap.
+	CodeCalendarImportTooLarge = "CALENDAR_IMPORT_TOO_LARGE"
+	// CodeCalendarImportTooManyShows indicates a confirm request over the ID cap.
+	CodeCalendarImportTooManyShows = "CALENDAR_IMPORT_TOO_MANY_SHOWS"
+)
+
+// CalendarImportError represents a calendar import error with context.
+type CalendarImportError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *CalendarImportError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *CalendarImportError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrCalendarImportInvalid creates an error for an unparseable ICS upload.
+func ErrCalendarImportInvalid(internal error) *CalendarImportError {
+	return &CalendarImportError{
+		Code:     CodeCalendarImportInvalid,
+		Message:  "could not read calendar file; upload an .ics export",
+		Internal: internal,
+	}
+}
+
+// ErrCalendarImportTooLarge creates an error for an ICS upload over maxBytes.
+func ErrCalendarImportTooLarge(maxBytes int) *CalendarImportError {
+	return &CalendarImportError{
+		Code:    CodeCalendarImportTooLarge,
+		Message: fmt.Sprintf("calendar file is larger than %d KB; export a shorter date range", maxBytes/1024),
+	}
+}
+
+// ErrCalendarImportTooManyShows creates an error for a confirm request over max.
+func ErrCalendarImportTooManyShows(max int) *CalendarImportError {
+	return &CalendarImportError{
+		Code:    CodeCalendarImportTooManyShows,
+		Message: fmt.Sprintf("at most %d shows can be imported at once", max),
+	}
+}
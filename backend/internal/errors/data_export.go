@@ -0,0 +1,64 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CSV data export error codes.
+const (
+	// CodeDataExportUnknownEntity indicates an export for an entity type
+	// that has no CSV export.
+	CodeDataExportUnknownEntity = "DATA_EXPORT_UNKNOWN_ENTITY"
+	// CodeDataExportInvalidColumn indicates a requested column that the
+	// entity's export does not offer.
+	CodeDataExportInvalidColumn = "DATA_EXPORT_INVALID_COLUMN"
+	// CodeDataExportInvalidRange indicates an unparseable or inverted date
+	// range.
+	CodeDataExportInvalidRange = "DATA_EXPORT_INVALID_RANGE"
+)
+
+// DataExportError represents a CSV export error with context.
+type DataExportError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *DataExportError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *DataExportError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrDataExportUnknownEntity creates an unknown-entity error.
+func ErrDataExportUnknownEntity(entity string) *DataExportError {
+	return &DataExportError{
+		Code:    CodeDataExportUnknownEntity,
+		Message: fmt.Sprintf("no CSV export for %q", entity),
+	}
+}
+
+// ErrDataExportInvalidColumn creates an invalid-column error listing the
+// columns that are available.
+func ErrDataExportInvalidColumn(column string, available []string) *DataExportError {
+	return &DataExportError{
+		Code:    CodeDataExportInvalidColumn,
+		Message: fmt.Sprintf("unknown column %q (available: %s)", column, strings.Join(available, ", ")),
+	}
+}
+
+// ErrDataExportInvalidRange creates an invalid date range error.
+func ErrDataExportInvalidRange(message string) *DataExportError {
+	return &DataExportError{
+		Code:    CodeDataExportInvalidRange,
+		Message: message,
+	}
+}
@@ -0,0 +1,60 @@
+package errors
+
+import "fmt"
+
+// Batch data import error codes.
+const (
+	// CodeDataImportTokenNotFound indicates a commit whose validation token
+	// does not exist or belongs to another admin.
+	CodeDataImportTokenNotFound = "DATA_IMPORT_TOKEN_NOT_FOUND"
+	// CodeDataImportTokenExpired indicates a commit after the validation
+	// token's lifetime; the batch must be validated again.
+	CodeDataImportTokenExpired = "DATA_IMPORT_TOKEN_EXPIRED"
+	// CodeDataImportTokenUsed indicates a validation token that has already
+	// been committed.
+	CodeDataImportTokenUsed = "DATA_IMPORT_TOKEN_USED"
+)
+
+// DataImportError represents a batch import error with context.
+type DataImportError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *DataImportError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *DataImportError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrDataImportTokenNotFound creates a token-not-found error.
+func ErrDataImportTokenNotFound() *DataImportError {
+	return &DataImportError{
+		Code:    CodeDataImportTokenNotFound,
+		Message: "validation token not found",
+	}
+}
+
+// ErrDataImportTokenExpired creates a token-expired error.
+func ErrDataImportTokenExpired() *DataImportError {
+	return &DataImportError{
+		Code:    CodeDataImportTokenExpired,
+		Message: "validation token has expired; validate the batch again",
+	}
+}
+
+// ErrDataImportTokenUsed creates a token-already-committed error.
+func ErrDataImportTokenUsed() *DataImportError {
+	return &DataImportError{
+		Code:    CodeDataImportTokenUsed,
+		Message: "this batch has already been imported",
+	}
+}
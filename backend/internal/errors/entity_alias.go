@@ -0,0 +1,71 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Entity alias error codes.
+const (
+	// CodeEntityAliasNotFound indicates the alias does not exist.
+	CodeEntityAliasNotFound = "ENTITY_ALIAS_NOT_FOUND"
+	// CodeEntityAliasExists indicates the alias is already mapped for the
+	// entity type.
+	CodeEntityAliasExists = "ENTITY_ALIAS_EXISTS"
+	// CodeEntityAliasInvalid indicates the create request failed validation.
+	CodeEntityAliasInvalid = "ENTITY_ALIAS_INVALID"
+	// CodeEntityAliasTargetNotFound indicates the venue or artist the alias
+	// should point at does not exist.
+	CodeEntityAliasTargetNotFound = "ENTITY_ALIAS_TARGET_NOT_FOUND"
+)
+
+// EntityAliasError represents an import alias error with context.
+type EntityAliasError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *EntityAliasError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *EntityAliasError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrEntityAliasNotFound creates an alias-not-found error.
+func ErrEntityAliasNotFound(aliasID uint) *EntityAliasError {
+	return &EntityAliasError{
+		Code:    CodeEntityAliasNotFound,
+		Message: fmt.Sprintf("alias %d not found", aliasID),
+	}
+}
+
+// ErrEntityAliasExists creates a duplicate-alias error.
+func ErrEntityAliasExists(entityType, alias string) *EntityAliasError {
+	return &EntityAliasError{
+		Code:    CodeEntityAliasExists,
+		Message: fmt.Sprintf("%s alias '%s' already exists", entityType, alias),
+	}
+}
+
+// ErrEntityAliasInvalid creates a validation error with a user-facing message.
+func ErrEntityAliasInvalid(message string) *EntityAliasError {
+	return &EntityAliasError{
+		Code:    CodeEntityAliasInvalid,
+		Message: message,
+	}
+}
+
+// ErrEntityAliasTargetNotFound creates an error for a missing venue or artist.
+func ErrEntityAliasTargetNotFound(entityType string, entityID uint) *EntityAliasError {
+	return &EntityAliasError{
+		Code:    CodeEntityAliasTargetNotFound,
+		Message: fmt.Sprintf("%s %d not found", entityType, entityID),
+	}
+}
@@ -0,0 +1,59 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Feature flag error codes.
+const (
+	// CodeFeatureFlagNotFound indicates no flag has the key.
+	CodeFeatureFlagNotFound = "FEATURE_FLAG_NOT_FOUND"
+	// CodeFeatureFlagExists indicates a flag with the key already exists.
+	CodeFeatureFlagExists = "FEATURE_FLAG_EXISTS"
+	// CodeFeatureFlagInvalid indicates the request failed validation.
+	CodeFeatureFlagInvalid = "FEATURE_FLAG_INVALID"
+)
+
+// FeatureFlagError represents a feature flag error with context.
+type FeatureFlagError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *FeatureFlagError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *FeatureFlagError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrFeatureFlagNotFound creates a flag-not-found error.
+func ErrFeatureFlagNotFound(key string) *FeatureFlagError {
+	return &FeatureFlagError{
+		Code:    CodeFeatureFlagNotFound,
+		Message: fmt.Sprintf("feature flag %q not found", key),
+	}
+}
+
+// ErrFeatureFlagExists creates a duplicate-key error.
+func ErrFeatureFlagExists(key string) *FeatureFlagError {
+	return &FeatureFlagError{
+		Code:    CodeFeatureFlagExists,
+		Message: fmt.Sprintf("feature flag %q already exists", key),
+	}
+}
+
+// ErrFeatureFlagInvalid creates a validation error with a user-facing message.
+func ErrFeatureFlagInvalid(message string) *FeatureFlagError {
+	return &FeatureFlagError{
+		Code:    CodeFeatureFlagInvalid,
+		Message: message,
+	}
+}
@@ -0,0 +1,83 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Media upload error codes.
+const (
+	// CodeMediaNotConfigured indicates media storage is not set up on this
+	// deployment.
+	CodeMediaNotConfigured = "MEDIA_NOT_CONFIGURED"
+	// CodeMediaTooLarge indicates the upload exceeds the size cap.
+	CodeMediaTooLarge = "MEDIA_TOO_LARGE"
+	// CodeMediaUnsupportedType indicates the upload is not an accepted
+	// image format.
+	CodeMediaUnsupportedType = "MEDIA_UNSUPPORTED_TYPE"
+	// CodeMediaInvalidImage indicates the upload claims a supported format
+	// but could not be decoded.
+	CodeMediaInvalidImage = "MEDIA_INVALID_IMAGE"
+	// CodeMediaNotFound indicates there is no stored media to remove.
+	CodeMediaNotFound = "MEDIA_NOT_FOUND"
+)
+
+// MediaError represents a media upload error with context.
+type MediaError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *MediaError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *MediaError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrMediaNotConfigured creates a storage-disabled error.
+func ErrMediaNotConfigured() *MediaError {
+	return &MediaError{
+		Code:    CodeMediaNotConfigured,
+		Message: "image uploads are not enabled",
+	}
+}
+
+// ErrMediaTooLarge creates an oversized-upload error.
+func ErrMediaTooLarge(maxBytes int64) *MediaError {
+	return &MediaError{
+		Code:    CodeMediaTooLarge,
+		Message: fmt.Sprintf("image must be at most %d MB", maxBytes>>20),
+	}
+}
+
+// ErrMediaUnsupportedType creates an unsupported-format error.
+func ErrMediaUnsupportedType(contentType string) *MediaError {
+	return &MediaError{
+		Code:    CodeMediaUnsupportedType,
+		Message: fmt.Sprintf("unsupported image type %q (use JPEG, PNG or GIF)", contentType),
+	}
+}
+
+// ErrMediaInvalidImage creates an undecodable-image error.
+func ErrMediaInvalidImage(err error) *MediaError {
+	return &MediaError{
+		Code:     CodeMediaInvalidImage,
+		Message:  "image could not be read",
+		Internal: err,
+	}
+}
+
+// ErrMediaNotFound creates an error for a show without a flyer.
+func ErrMediaNotFound(showID uint) *MediaError {
+	return &MediaError{
+		Code:    CodeMediaNotFound,
+		Message: fmt.Sprintf("show %d has no flyer", showID),
+	}
+}
@@ -0,0 +1,42 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Notification preference error codes.
+const (
+	// CodeNotificationPreferenceInvalid indicates an update naming an
+	// unknown event type or channel.
+	CodeNotificationPreferenceInvalid = "NOTIFICATION_PREFERENCE_INVALID"
+)
+
+// NotificationPreferenceError represents a notification preference error
+// with context.
+type NotificationPreferenceError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *NotificationPreferenceError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *NotificationPreferenceError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrNotificationPreferenceInvalid creates a validation error with a
+// user-facing message.
+func ErrNotificationPreferenceInvalid(message string) *NotificationPreferenceError {
+	return &NotificationPreferenceError{
+		Code:    CodeNotificationPreferenceInvalid,
+		Message: message,
+	}
+}
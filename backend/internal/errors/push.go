@@ -0,0 +1,63 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Web Push error codes.
+const (
+	// CodePushNotConfigured indicates Web Push is not set up on this
+	// deployment.
+	CodePushNotConfigured = "PUSH_NOT_CONFIGURED"
+	// CodePushSubscriptionInvalid indicates a subscription with a bad
+	// endpoint or keys.
+	CodePushSubscriptionInvalid = "PUSH_SUBSCRIPTION_INVALID"
+	// CodePushSubscriptionNotFound indicates the user has no subscription
+	// for the endpoint.
+	CodePushSubscriptionNotFound = "PUSH_SUBSCRIPTION_NOT_FOUND"
+)
+
+// PushError represents a Web Push error with context.
+type PushError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *PushError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *PushError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrPushNotConfigured creates a push-disabled error.
+func ErrPushNotConfigured() *PushError {
+	return &PushError{
+		Code:    CodePushNotConfigured,
+		Message: "push notifications are not enabled",
+	}
+}
+
+// ErrPushSubscriptionInvalid creates a validation error with a user-facing
+// message.
+func ErrPushSubscriptionInvalid(message string) *PushError {
+	return &PushError{
+		Code:    CodePushSubscriptionInvalid,
+		Message: message,
+	}
+}
+
+// ErrPushSubscriptionNotFound creates an error for an unknown endpoint.
+func ErrPushSubscriptionNotFound() *PushError {
+	return &PushError{
+		Code:    CodePushSubscriptionNotFound,
+		Message: "push subscription not found",
+	}
+}
@@ -0,0 +1,69 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Role management error codes.
+const (
+	// CodeRoleInvalid indicates an unknown role name.
+	CodeRoleInvalid = "ROLE_INVALID"
+	// CodeRoleUserNotFound indicates the target user does not exist.
+	CodeRoleUserNotFound = "ROLE_USER_NOT_FOUND"
+	// CodeRoleSelfChange indicates a caller tried to change their own role.
+	CodeRoleSelfChange = "ROLE_SELF_CHANGE"
+	// CodeRoleLastSuperadmin indicates the change would leave no superadmin.
+	CodeRoleLastSuperadmin = "ROLE_LAST_SUPERADMIN"
+)
+
+// RoleError represents a role management error with context.
+type RoleError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *RoleError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *RoleError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrRoleInvalid creates an unknown-role error.
+func ErrRoleInvalid(role string) *RoleError {
+	return &RoleError{
+		Code:    CodeRoleInvalid,
+		Message: fmt.Sprintf("unknown role %q", role),
+	}
+}
+
+// ErrRoleUserNotFound creates a user-not-found error.
+func ErrRoleUserNotFound() *RoleError {
+	return &RoleError{
+		Code:    CodeRoleUserNotFound,
+		Message: "user not found",
+	}
+}
+
+// ErrRoleSelfChange creates an error for changing one's own role.
+func ErrRoleSelfChange() *RoleError {
+	return &RoleError{
+		Code:    CodeRoleSelfChange,
+		Message: "you cannot change your own role",
+	}
+}
+
+// ErrRoleLastSuperadmin creates an error for demoting the last superadmin.
+func ErrRoleLastSuperadmin() *RoleError {
+	return &RoleError{
+		Code:    CodeRoleLastSuperadmin,
+		Message: "at least one superadmin is required",
+	}
+}
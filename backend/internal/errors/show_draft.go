@@ -0,0 +1,62 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Show draft error codes.
+const (
+	// CodeShowDraftNotFound indicates the draft does not exist or belongs to
+	// another user.
+	CodeShowDraftNotFound = "SHOW_DRAFT_NOT_FOUND"
+	// CodeShowDraftInvalid indicates a draft payload that is not a JSON
+	// object or is too large.
+	CodeShowDraftInvalid = "SHOW_DRAFT_INVALID"
+	// CodeShowDraftLimitReached indicates the user already has the maximum
+	// number of drafts.
+	CodeShowDraftLimitReached = "SHOW_DRAFT_LIMIT_REACHED"
+)
+
+// ShowDraftError represents a show submission draft error with context.
+type ShowDraftError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *ShowDraftError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *ShowDraftError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrShowDraftNotFound creates a draft-not-found error.
+func ErrShowDraftNotFound(draftID uint) *ShowDraftError {
+	return &ShowDraftError{
+		Code:    CodeShowDraftNotFound,
+		Message: fmt.Sprintf("show draft %d not found", draftID),
+	}
+}
+
+// ErrShowDraftInvalid creates a validation error with a user-facing message.
+func ErrShowDraftInvalid(message string) *ShowDraftError {
+	return &ShowDraftError{
+		Code:    CodeShowDraftInvalid,
+		Message: message,
+	}
+}
+
+// ErrShowDraftLimitReached creates a too-many-drafts error.
+func ErrShowDraftLimitReached(limit int) *ShowDraftError {
+	return &ShowDraftError{
+		Code:    CodeShowDraftLimitReached,
+		Message: fmt.Sprintf("you can have at most %d show drafts; submit or delete one first", limit),
+	}
+}
@@ -0,0 +1,71 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Show series error codes.
+const (
+	// CodeShowSeriesNotFound indicates the series does not exist.
+	CodeShowSeriesNotFound = "SHOW_SERIES_NOT_FOUND"
+	// CodeShowSeriesInvalid indicates the series template failed validation.
+	CodeShowSeriesInvalid = "SHOW_SERIES_INVALID"
+	// CodeShowSeriesOccurrenceNotFound indicates the show is not an occurrence
+	// of the given series.
+	CodeShowSeriesOccurrenceNotFound = "SHOW_SERIES_OCCURRENCE_NOT_FOUND"
+	// CodeShowSeriesInternal indicates a database or infrastructure failure.
+	CodeShowSeriesInternal = "SHOW_SERIES_INTERNAL"
+)
+
+// ShowSeriesError represents a show series error with context.
+type ShowSeriesError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *ShowSeriesError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *ShowSeriesError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrShowSeriesNotFound creates a series-not-found error.
+func ErrShowSeriesNotFound(seriesID uint) *ShowSeriesError {
+	return &ShowSeriesError{
+		Code:    CodeShowSeriesNotFound,
+		Message: fmt.Sprintf("show series %d not found", seriesID),
+	}
+}
+
+// ErrShowSeriesInvalid creates a validation error with a user-facing message.
+func ErrShowSeriesInvalid(message string) *ShowSeriesError {
+	return &ShowSeriesError{
+		Code:    CodeShowSeriesInvalid,
+		Message: message,
+	}
+}
+
+// ErrShowSeriesOccurrenceNotFound creates an occurrence-not-found error.
+func ErrShowSeriesOccurrenceNotFound(seriesID, showID uint) *ShowSeriesError {
+	return &ShowSeriesError{
+		Code:    CodeShowSeriesOccurrenceNotFound,
+		Message: fmt.Sprintf("show %d is not an occurrence of series %d", showID, seriesID),
+	}
+}
+
+// ErrShowSeriesInternal wraps a database or infrastructure failure.
+func ErrShowSeriesInternal(internal error) *ShowSeriesError {
+	return &ShowSeriesError{
+		Code:     CodeShowSeriesInternal,
+		Message:  "show series operation failed",
+		Internal: internal,
+	}
+}
@@ -0,0 +1,60 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Show update error codes.
+const (
+	// CodeShowUpdateNotFound indicates the update does not exist.
+	CodeShowUpdateNotFound = "SHOW_UPDATE_NOT_FOUND"
+	// CodeShowUpdateInvalid indicates the update failed validation.
+	CodeShowUpdateInvalid = "SHOW_UPDATE_INVALID"
+	// CodeShowUpdateInternal indicates a database or infrastructure failure.
+	CodeShowUpdateInternal = "SHOW_UPDATE_INTERNAL"
+)
+
+// ShowUpdateError represents a show update error with context.
+type ShowUpdateError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *ShowUpdateError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *ShowUpdateError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrShowUpdateNotFound creates an update-not-found error.
+func ErrShowUpdateNotFound(updateID uint) *ShowUpdateError {
+	return &ShowUpdateError{
+		Code:    CodeShowUpdateNotFound,
+		Message: fmt.Sprintf("show update %d not found", updateID),
+	}
+}
+
+// ErrShowUpdateInvalid creates a validation error with a user-facing message.
+func ErrShowUpdateInvalid(message string) *ShowUpdateError {
+	return &ShowUpdateError{
+		Code:    CodeShowUpdateInvalid,
+		Message: message,
+	}
+}
+
+// ErrShowUpdateInternal wraps a database or infrastructure failure.
+func ErrShowUpdateInternal(internal error) *ShowUpdateError {
+	return &ShowUpdateError{
+		Code:     CodeShowUpdateInternal,
+		Message:  "show update operation failed",
+		Internal: internal,
+	}
+}
@@ -0,0 +1,63 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Submission throttle error codes.
+const (
+	// CodeSubmissionDailyCap indicates the submitter hit their daily cap.
+	CodeSubmissionDailyCap = "SUBMISSION_DAILY_CAP"
+	// CodeSubmissionUserNotFound indicates the user to quarantine does not exist.
+	CodeSubmissionUserNotFound = "SUBMISSION_USER_NOT_FOUND"
+	// CodeSubmissionStaffQuarantine indicates an attempt to quarantine staff.
+	CodeSubmissionStaffQuarantine = "SUBMISSION_STAFF_QUARANTINE"
+)
+
+// SubmissionError represents a submission throttle error with context.
+type SubmissionError struct {
+	Code    string
+	Message string
+	// RetryAfterSeconds is set on CodeSubmissionDailyCap: the wait until the
+	// oldest submission in the window ages out.
+	RetryAfterSeconds int
+	Internal          error
+}
+
+// Error implements the error interface.
+func (e *SubmissionError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *SubmissionError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrSubmissionDailyCap creates a daily-cap error.
+func ErrSubmissionDailyCap(limit, retryAfterSeconds int) *SubmissionError {
+	return &SubmissionError{
+		Code:              CodeSubmissionDailyCap,
+		Message:           fmt.Sprintf("You can submit up to %d shows per day. Please try again later.", limit),
+		RetryAfterSeconds: retryAfterSeconds,
+	}
+}
+
+// ErrSubmissionUserNotFound creates a user-not-found error.
+func ErrSubmissionUserNotFound() *SubmissionError {
+	return &SubmissionError{
+		Code:    CodeSubmissionUserNotFound,
+		Message: "user not found",
+	}
+}
+
+// ErrSubmissionStaffQuarantine creates an error for quarantining staff.
+func ErrSubmissionStaffQuarantine() *SubmissionError {
+	return &SubmissionError{
+		Code:    CodeSubmissionStaffQuarantine,
+		Message: "staff accounts cannot be quarantined",
+	}
+}
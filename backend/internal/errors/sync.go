@@ -0,0 +1,52 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Differential sync error codes.
+const (
+	// CodeSyncInvalidCursor indicates the since cursor could not be decoded.
+	CodeSyncInvalidCursor = "SYNC_INVALID_CURSOR"
+	// CodeSyncInternal indicates a database or infrastructure failure.
+	CodeSyncInternal = "SYNC_INTERNAL"
+)
+
+// SyncError represents a differential sync error with context.
+type SyncError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *SyncError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *SyncError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrSyncInvalidCursor creates an invalid-cursor error. Clients should drop
+// their checkpoint and resync from scratch.
+func ErrSyncInvalidCursor(internal error) *SyncError {
+	return &SyncError{
+		Code:     CodeSyncInvalidCursor,
+		Message:  "invalid sync cursor; resync without since",
+		Internal: internal,
+	}
+}
+
+// ErrSyncInternal wraps a database or infrastructure failure.
+func ErrSyncInternal(internal error) *SyncError {
+	return &SyncError{
+		Code:     CodeSyncInternal,
+		Message:  "sync failed",
+		Internal: internal,
+	}
+}
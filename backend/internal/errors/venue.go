@@ -8,6 +8,8 @@ import (
 const (
 	CodeVenueNotFound = "VENUE_NOT_FOUND"
 	CodeVenueHasShows = "VENUE_HAS_SHOWS"
+	// CodeVenueMergeSelf indicates an attempt to merge a venue into itself.
+	CodeVenueMergeSelf = "VENUE_MERGE_SELF"
 )
 
 // VenueError represents a venue-related error with additional context.
@@ -49,3 +51,11 @@ func ErrVenueHasShows(venueID uint, count int64) *VenueError {
 		VenueID: venueID,
 	}
 }
+
+// ErrVenueMergeSelf creates an error for merging a venue with itself.
+func ErrVenueMergeSelf() *VenueError {
+	return &VenueError{
+		Code:    CodeVenueMergeSelf,
+		Message: "cannot merge a venue with itself",
+	}
+}
@@ -0,0 +1,91 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Venue claim error codes.
+const (
+	// CodeVenueClaimNotFound indicates the claim does not exist.
+	CodeVenueClaimNotFound = "VENUE_CLAIM_NOT_FOUND"
+	// CodeVenueClaimInvalid indicates the claim request failed validation.
+	CodeVenueClaimInvalid = "VENUE_CLAIM_INVALID"
+	// CodeVenueClaimNotPending indicates the claim was already reviewed.
+	CodeVenueClaimNotPending = "VENUE_CLAIM_NOT_PENDING"
+	// CodeVenueClaimConflict indicates the user already has a pending claim
+	// on the venue or already manages it.
+	CodeVenueClaimConflict = "VENUE_CLAIM_CONFLICT"
+	// CodeVenueManagerNotFound indicates the user does not manage the venue.
+	CodeVenueManagerNotFound = "VENUE_MANAGER_NOT_FOUND"
+	// CodeVenueClaimInternal indicates a database or infrastructure failure.
+	CodeVenueClaimInternal = "VENUE_CLAIM_INTERNAL"
+)
+
+// VenueClaimError represents a venue claim or venue manager error with context.
+type VenueClaimError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *VenueClaimError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *VenueClaimError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrVenueClaimNotFound creates a claim-not-found error.
+func ErrVenueClaimNotFound(claimID uint) *VenueClaimError {
+	return &VenueClaimError{
+		Code:    CodeVenueClaimNotFound,
+		Message: fmt.Sprintf("venue claim %d not found", claimID),
+	}
+}
+
+// ErrVenueClaimInvalid creates a validation error with a user-facing message.
+func ErrVenueClaimInvalid(message string) *VenueClaimError {
+	return &VenueClaimError{
+		Code:    CodeVenueClaimInvalid,
+		Message: message,
+	}
+}
+
+// ErrVenueClaimNotPending creates an error for reviewing an already reviewed claim.
+func ErrVenueClaimNotPending(status string) *VenueClaimError {
+	return &VenueClaimError{
+		Code:    CodeVenueClaimNotPending,
+		Message: fmt.Sprintf("venue claim is already %s", status),
+	}
+}
+
+// ErrVenueClaimConflict creates an error for a duplicate claim.
+func ErrVenueClaimConflict(message string) *VenueClaimError {
+	return &VenueClaimError{
+		Code:    CodeVenueClaimConflict,
+		Message: message,
+	}
+}
+
+// ErrVenueManagerNotFound creates an error for removing a non-manager.
+func ErrVenueManagerNotFound(venueID, userID uint) *VenueClaimError {
+	return &VenueClaimError{
+		Code:    CodeVenueManagerNotFound,
+		Message: fmt.Sprintf("user %d does not manage venue %d", userID, venueID),
+	}
+}
+
+// ErrVenueClaimInternal wraps a database or infrastructure failure.
+func ErrVenueClaimInternal(internal error) *VenueClaimError {
+	return &VenueClaimError{
+		Code:     CodeVenueClaimInternal,
+		Message:  "venue claim operation failed",
+		Internal: internal,
+	}
+}
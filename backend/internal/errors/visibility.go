@@ -0,0 +1,63 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Visibility debug error codes.
+//
+// The admin visibility debugger fails when either half of the
+// (viewer, show) pair does not exist, or on a database fault.
+const (
+	// CodeVisibilityShowNotFound indicates the target show does not exist.
+	CodeVisibilityShowNotFound = "VISIBILITY_SHOW_NOT_FOUND"
+	// CodeVisibilityUserNotFound indicates the viewer user does not exist.
+	CodeVisibilityUserNotFound = "VISIBILITY_USER_NOT_FOUND"
+	// CodeVisibilityInternal indicates a database or infrastructure failure.
+	CodeVisibilityInternal = "VISIBILITY_INTERNAL"
+)
+
+// VisibilityError represents a visibility debug error with context.
+type VisibilityError struct {
+	Code     string
+	Message  string
+	Internal error
+}
+
+// Error implements the error interface.
+func (e *VisibilityError) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s (internal: %v)", e.Code, e.Message, e.Internal)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal error for errors.Is/As compatibility.
+func (e *VisibilityError) Unwrap() error {
+	return e.Internal
+}
+
+// ErrVisibilityShowNotFound creates a show-not-found error.
+func ErrVisibilityShowNotFound(showID uint) *VisibilityError {
+	return &VisibilityError{
+		Code:    CodeVisibilityShowNotFound,
+		Message: fmt.Sprintf("show %d not found", showID),
+	}
+}
+
+// ErrVisibilityUserNotFound creates a user-not-found error.
+func ErrVisibilityUserNotFound(userID uint) *VisibilityError {
+	return &VisibilityError{
+		Code:    CodeVisibilityUserNotFound,
+		Message: fmt.Sprintf("user %d not found", userID),
+	}
+}
+
+// ErrVisibilityInternal wraps a database or infrastructure failure.
+func ErrVisibilityInternal(internal error) *VisibilityError {
+	return &VisibilityError{
+		Code:     CodeVisibilityInternal,
+		Message:  "failed to evaluate show visibility",
+		Internal: internal,
+	}
+}
@@ -0,0 +1,156 @@
+// Package httpclient builds the outbound HTTP clients every integration
+// (HIBP, Discord, OAuth, Apple, scrapers, enrichment APIs) uses, so the
+// deploy's egress policy — an optional forward proxy and a host allowlist —
+// is enforced in one place instead of per client.
+package httpclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Policy is the process-wide egress policy.
+type Policy struct {
+	// ProxyURL routes every outbound request through a forward proxy. Nil
+	// falls back to the standard HTTPS_PROXY / HTTP_PROXY / NO_PROXY env vars.
+	ProxyURL *url.URL
+	// AllowedHosts, when non-empty, is the egress allowlist. Entries are exact
+	// hostnames ("api.pwnedpasswords.com") or a leading-dot suffix
+	// (".bandcamp.com") matching the domain and every subdomain. Empty allows
+	// every host.
+	AllowedHosts []string
+}
+
+// EgressDeniedError is returned (wrapped in *url.Error by http.Client) when a
+// request targets a host outside the allowlist.
+type EgressDeniedError struct {
+	Host string
+}
+
+func (e *EgressDeniedError) Error() string {
+	return fmt.Sprintf("egress to host %q is not allowed (add it to EGRESS_ALLOWED_HOSTS)", e.Host)
+}
+
+var (
+	mu     sync.RWMutex
+	policy Policy
+
+	// baseTransport is shared by every client so connection pools are reused
+	// across integrations. Its Proxy func reads the current policy per request.
+	baseTransport = newBaseTransport()
+)
+
+func newBaseTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = proxyForRequest
+	return t
+}
+
+// Configure installs the process-wide policy. Call once at startup, before
+// serving traffic. Clients built earlier pick it up too: the policy is read
+// on every request, not captured at construction.
+//
+// It also points http.DefaultClient at the guarded transport so third-party
+// libraries that fall back to the default client (goth's OAuth providers) are
+// covered without per-library wiring.
+func Configure(p Policy) {
+	hosts := make([]string, 0, len(p.AllowedHosts))
+	for _, h := range p.AllowedHosts {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			hosts = append(hosts, h)
+		}
+	}
+
+	mu.Lock()
+	policy = Policy{ProxyURL: p.ProxyURL, AllowedHosts: hosts}
+	mu.Unlock()
+
+	http.DefaultClient.Transport = Transport()
+}
+
+// currentPolicy returns a snapshot of the installed policy.
+func currentPolicy() Policy {
+	mu.RLock()
+	defer mu.RUnlock()
+	return policy
+}
+
+// New returns a client with the given timeout that honours the egress policy.
+func New(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: Transport(),
+	}
+}
+
+// Transport returns the shared, policy-enforcing round tripper.
+func Transport() http.RoundTripper {
+	return WithEgressPolicy(baseTransport)
+}
+
+// WithEgressPolicy wraps rt so it refuses hosts outside the allowlist. Use it
+// for clients that need their own transport (e.g. a custom dialer) and so
+// don't go through the shared proxy.
+func WithEgressPolicy(rt http.RoundTripper) http.RoundTripper {
+	return &guardedTransport{next: rt}
+}
+
+type guardedTransport struct {
+	next http.RoundTripper
+}
+
+func (t *guardedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Hostname()
+	if !HostAllowed(host) {
+		return nil, &EgressDeniedError{Host: host}
+	}
+	return t.next.RoundTrip(req)
+}
+
+// HostAllowed reports whether host passes the installed allowlist.
+func HostAllowed(host string) bool {
+	return hostAllowed(currentPolicy().AllowedHosts, host)
+}
+
+func hostAllowed(allowed []string, host string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, entry := range allowed {
+		if strings.HasPrefix(entry, ".") {
+			if host == entry[1:] || strings.HasSuffix(host, entry) {
+				return true
+			}
+			continue
+		}
+		if host == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// MissingHosts returns the entries of required the installed allowlist would
+// refuse, for startup validation. Empty when no allowlist is configured.
+func MissingHosts(required []string) []string {
+	allowed := currentPolicy().AllowedHosts
+	var missing []string
+	for _, host := range required {
+		if !hostAllowed(allowed, host) {
+			missing = append(missing, host)
+		}
+	}
+	return missing
+}
+
+func proxyForRequest(req *http.Request) (*url.URL, error) {
+	if p := currentPolicy().ProxyURL; p != nil {
+		return p, nil
+	}
+	return http.ProxyFromEnvironment(req)
+}
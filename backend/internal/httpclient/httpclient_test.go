@@ -0,0 +1,117 @@
+package httpclient
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestHostAllowed(t *testing.T) {
+	allowed := []string{"api.pwnedpasswords.com", ".bandcamp.com"}
+
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"api.pwnedpasswords.com", true},
+		{"API.PwnedPasswords.com", true},
+		{"api.pwnedpasswords.com.", true},
+		{"pwnedpasswords.com", false},
+		{"bandcamp.com", true},
+		{"someband.bandcamp.com", true},
+		{"evilbandcamp.com", false},
+		{"discord.com", false},
+	}
+
+	for _, tt := range tests {
+		if got := hostAllowed(allowed, tt.host); got != tt.want {
+			t.Errorf("hostAllowed(%q) = %v, want %v", tt.host, got, tt.want)
+		}
+	}
+
+	if !hostAllowed(nil, "anything.example") {
+		t.Error("empty allowlist should allow every host")
+	}
+}
+
+func TestGuardedTransport_DeniesHostOutsideAllowlist(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	Configure(Policy{AllowedHosts: []string{"api.pwnedpasswords.com"}})
+	defer Configure(Policy{})
+
+	_, err := New(time.Second).Get(srv.URL)
+	var denied *EgressDeniedError
+	if !errors.As(err, &denied) {
+		t.Fatalf("expected *EgressDeniedError, got %v", err)
+	}
+	if denied.Host != "127.0.0.1" {
+		t.Errorf("denied.Host = %q, want 127.0.0.1", denied.Host)
+	}
+}
+
+func TestGuardedTransport_AllowsListedHost(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	Configure(Policy{AllowedHosts: []string{" 127.0.0.1 "}})
+	defer Configure(Policy{})
+
+	resp, err := New(time.Second).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestConfigure_CoversDefaultClient(t *testing.T) {
+	Configure(Policy{AllowedHosts: []string{"api.pwnedpasswords.com"}})
+	defer Configure(Policy{})
+
+	_, err := http.DefaultClient.Get("http://discord.com/")
+	var denied *EgressDeniedError
+	if !errors.As(err, &denied) {
+		t.Fatalf("expected http.DefaultClient to enforce the allowlist, got %v", err)
+	}
+}
+
+func TestMissingHosts(t *testing.T) {
+	Configure(Policy{})
+	if missing := MissingHosts([]string{"api.pwnedpasswords.com"}); len(missing) != 0 {
+		t.Errorf("no allowlist should report nothing missing, got %v", missing)
+	}
+
+	Configure(Policy{AllowedHosts: []string{"api.pwnedpasswords.com", ".discord.com"}})
+	defer Configure(Policy{})
+
+	missing := MissingHosts([]string{"api.pwnedpasswords.com", "discord.com", "api.resend.com"})
+	if len(missing) != 1 || missing[0] != "api.resend.com" {
+		t.Errorf("MissingHosts = %v, want [api.resend.com]", missing)
+	}
+}
+
+func TestProxyForRequest_UsesConfiguredProxy(t *testing.T) {
+	proxy, _ := url.Parse("http://egress-proxy:3128")
+	Configure(Policy{ProxyURL: proxy})
+	defer Configure(Policy{})
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.pwnedpasswords.com/range/ABCDE", nil)
+	got, err := proxyForRequest(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || got.String() != "http://egress-proxy:3128" {
+		t.Errorf("proxy = %v, want http://egress-proxy:3128", got)
+	}
+}
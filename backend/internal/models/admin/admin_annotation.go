@@ -0,0 +1,29 @@
+package admin
+
+import "time"
+
+// Admin annotation kinds.
+const (
+	AdminAnnotationNote = "note"
+	AdminAnnotationTag  = "tag"
+)
+
+// AdminAnnotation is an internal note or tag an admin attached to an entity.
+// For notes Body is the note text; for tags it is the label and Color the
+// tag's #rrggbb color.
+type AdminAnnotation struct {
+	ID         uint      `gorm:"primaryKey"`
+	EntityType string    `gorm:"column:entity_type;not null;size:50"`
+	EntityID   uint      `gorm:"column:entity_id;not null"`
+	Kind       string    `gorm:"column:kind;not null;size:10"`
+	Body       string    `gorm:"column:body;not null"`
+	Color      *string   `gorm:"column:color;size:7"`
+	CreatedBy  *uint     `gorm:"column:created_by"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName specifies the table name for AdminAnnotation
+func (AdminAnnotation) TableName() string {
+	return "admin_annotations"
+}
@@ -0,0 +1,48 @@
+package admin
+
+import "time"
+
+// Announcement severities, in increasing order of urgency.
+const (
+	AnnouncementSeverityInfo     = "info"
+	AnnouncementSeverityWarning  = "warning"
+	AnnouncementSeverityCritical = "critical"
+)
+
+// Announcement is a site-wide banner. It shows from StartsAt until EndsAt
+// (nil: until an admin expires it). A signed-in user can close a Dismissible
+// banner for good; see AnnouncementDismissal.
+type Announcement struct {
+	ID          uint       `gorm:"primaryKey"`
+	Message     string     `gorm:"column:message;not null"`
+	Severity    string     `gorm:"column:severity;not null;size:20;default:info"`
+	StartsAt    time.Time  `gorm:"column:starts_at;not null"`
+	EndsAt      *time.Time `gorm:"column:ends_at"`
+	Dismissible bool       `gorm:"column:dismissible;not null"`
+	CreatedBy   *uint      `gorm:"column:created_by"`
+	UpdatedBy   *uint      `gorm:"column:updated_by"`
+	CreatedAt   time.Time  `gorm:"column:created_at;not null"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;not null"`
+}
+
+// TableName specifies the table name for Announcement
+func (Announcement) TableName() string {
+	return "announcements"
+}
+
+// ActiveAt reports whether the banner is showing at t.
+func (a *Announcement) ActiveAt(t time.Time) bool {
+	return !a.StartsAt.After(t) && (a.EndsAt == nil || a.EndsAt.After(t))
+}
+
+// AnnouncementDismissal records that a user closed a dismissible banner.
+type AnnouncementDismissal struct {
+	AnnouncementID uint      `gorm:"column:announcement_id;primaryKey"`
+	UserID         uint      `gorm:"column:user_id;primaryKey"`
+	DismissedAt    time.Time `gorm:"column:dismissed_at;not null"`
+}
+
+// TableName specifies the table name for AnnouncementDismissal
+func (AnnouncementDismissal) TableName() string {
+	return "announcement_dismissals"
+}
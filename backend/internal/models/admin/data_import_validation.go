@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DataImportValidation is a validated batch import awaiting commit. The
+// payload is the exact request that was validated; the admin commits it by
+// presenting the plaintext token whose hash is stored here.
+type DataImportValidation struct {
+	ID          uint             `gorm:"primaryKey"`
+	TokenHash   string           `gorm:"column:token_hash;uniqueIndex;not null"`
+	AdminID     uint             `gorm:"column:admin_id;not null"`
+	Payload     *json.RawMessage `gorm:"column:payload;type:jsonb;not null"`
+	ExpiresAt   time.Time        `gorm:"column:expires_at;not null"`
+	CommittedAt *time.Time       `gorm:"column:committed_at"`
+	CreatedAt   time.Time
+}
+
+// TableName specifies the table name for DataImportValidation
+func (DataImportValidation) TableName() string {
+	return "data_import_validations"
+}
@@ -0,0 +1,22 @@
+package admin
+
+import "time"
+
+// DeletionReceipt is the signed record of a permanently deleted account.
+// UserID is not a foreign key: the user row is gone by the time anyone
+// reads the receipt. Payload is a json (not jsonb) column so the signed
+// bytes come back exactly as they were written.
+type DeletionReceipt struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    uint      `gorm:"column:user_id;not null"`
+	EmailHash *string   `gorm:"column:email_hash;size:64"`
+	Payload   string    `gorm:"column:payload;type:json;not null"`
+	Signature string    `gorm:"column:signature;not null"`
+	KeyID     string    `gorm:"column:key_id;not null;size:32"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for DeletionReceipt
+func (DeletionReceipt) TableName() string {
+	return "deletion_receipts"
+}
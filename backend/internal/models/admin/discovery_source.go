@@ -0,0 +1,39 @@
+package admin
+
+import "time"
+
+// Discovery source health statuses, derived when listing sources
+const (
+	DiscoverySourceStatusOK      = "ok"
+	DiscoverySourceStatusFailing = "failing"
+	DiscoverySourceStatusStale   = "stale"
+)
+
+// DiscoverySource tracks import health for one scraped venue source. Rows
+// are keyed by the scraper's venue slug and upserted by DiscoveryService on
+// every non-dry-run import.
+type DiscoverySource struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	SourceKey      string     `json:"source_key" gorm:"column:source_key;uniqueIndex;not null"`
+	VenueName      string     `json:"venue_name" gorm:"column:venue_name;not null"`
+	LastImportAt   *time.Time `json:"last_import_at" gorm:"column:last_import_at"`
+	LastSuccessAt  *time.Time `json:"last_success_at" gorm:"column:last_success_at"`
+	LastEventCount int        `json:"last_event_count" gorm:"column:last_event_count;not null;default:0"`
+	TotalEvents    int64      `json:"total_events" gorm:"column:total_events;not null;default:0"`
+	ErrorStreak    int        `json:"error_streak" gorm:"column:error_streak;not null;default:0"`
+	LastError      *string    `json:"last_error" gorm:"column:last_error"`
+	StaleAlertedAt *time.Time `json:"stale_alerted_at" gorm:"column:stale_alerted_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func (DiscoverySource) TableName() string { return "discovery_sources" }
+
+// StaleSince returns the time staleness is measured from: the last
+// successful import, or when the source was first seen if it never succeeded.
+func (s *DiscoverySource) StaleSince() time.Time {
+	if s.LastSuccessAt != nil {
+		return *s.LastSuccessAt
+	}
+	return s.CreatedAt
+}
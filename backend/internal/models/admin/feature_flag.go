@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"strings"
+	"time"
+)
+
+// FeatureFlagMaintenanceMode is the flag that puts the API into maintenance
+// mode: while it is enabled for everyone, non-admin routes answer 503.
+const FeatureFlagMaintenanceMode = "maintenance_mode"
+
+// FeatureFlag is a runtime switch for gating new behavior without a
+// redeploy. Enabled turns it on for everyone; EnabledForAdmins turns it on
+// for admins only. A non-empty Environments limits both to the listed
+// environments.
+type FeatureFlag struct {
+	ID               uint      `gorm:"primaryKey"`
+	Key              string    `gorm:"column:key;uniqueIndex;not null;size:100"`
+	Description      *string   `gorm:"column:description"`
+	Enabled          bool      `gorm:"column:enabled;not null;default:false"`
+	EnabledForAdmins bool      `gorm:"column:enabled_for_admins;not null;default:false"`
+	Environments     string    `gorm:"column:environments;not null;default:''"` // Space-separated
+	UpdatedBy        *uint     `gorm:"column:updated_by"`
+	CreatedAt        time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt        time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName specifies the table name for FeatureFlag
+func (FeatureFlag) TableName() string {
+	return "feature_flags"
+}
+
+// EnvironmentList returns the environments the flag is limited to; empty
+// means every environment.
+func (f *FeatureFlag) EnvironmentList() []string {
+	return strings.Fields(f.Environments)
+}
+
+// EnabledFor reports whether the flag is on in environment for a caller who
+// is (or isn't) an admin.
+func (f *FeatureFlag) EnabledFor(environment string, isAdmin bool) bool {
+	if envs := f.EnvironmentList(); len(envs) > 0 {
+		found := false
+		for _, env := range envs {
+			if env == environment {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return f.Enabled || (isAdmin && f.EnabledForAdmins)
+}
@@ -0,0 +1,28 @@
+package admin
+
+import "testing"
+
+func TestFeatureFlagEnabledFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    FeatureFlag
+		env     string
+		isAdmin bool
+		want    bool
+	}{
+		{"off", FeatureFlag{}, "production", true, false},
+		{"on for everyone", FeatureFlag{Enabled: true}, "production", false, true},
+		{"admins only, admin", FeatureFlag{EnabledForAdmins: true}, "production", true, true},
+		{"admins only, user", FeatureFlag{EnabledForAdmins: true}, "production", false, false},
+		{"listed environment", FeatureFlag{Enabled: true, Environments: "stage development"}, "stage", false, true},
+		{"unlisted environment", FeatureFlag{Enabled: true, Environments: "stage"}, "production", false, false},
+		{"unlisted environment, admin", FeatureFlag{EnabledForAdmins: true, Environments: "stage"}, "production", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.flag.EnabledFor(tt.env, tt.isAdmin); got != tt.want {
+				t.Errorf("EnabledFor(%q, %v) = %v, want %v", tt.env, tt.isAdmin, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package admin
+
+import "time"
+
+// IdempotencyKey is one claimed Idempotency-Key. StatusCode is 0 while the
+// first request is still running; once it finishes the response is stored
+// and replayed to retries until ExpiresAt.
+type IdempotencyKey struct {
+	ID           uint      `gorm:"primaryKey"`
+	Scope        string    `gorm:"column:scope;not null;size:100"`
+	Key          string    `gorm:"column:idempotency_key;not null;size:255"`
+	Method       string    `gorm:"column:method;not null;size:10"`
+	Path         string    `gorm:"column:path;not null"`
+	RequestHash  string    `gorm:"column:request_hash;not null;size:64"`
+	StatusCode   int       `gorm:"column:status_code;not null;default:0"`
+	ContentType  *string   `gorm:"column:content_type;size:255"`
+	ResponseBody []byte    `gorm:"column:response_body"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null"`
+	ExpiresAt    time.Time `gorm:"column:expires_at;not null"`
+}
+
+// TableName specifies the table name for IdempotencyKey
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+// InFlight reports whether the request that claimed the key hasn't
+// finished yet.
+func (k *IdempotencyKey) InFlight() bool {
+	return k.StatusCode == 0
+}
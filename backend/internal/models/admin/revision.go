@@ -15,6 +15,7 @@ type Revision struct {
 	UserID       uint             `json:"user_id" gorm:"column:user_id;not null"`
 	FieldChanges *json.RawMessage `json:"field_changes" gorm:"column:field_changes;type:jsonb;not null"`
 	Summary      *string          `json:"summary,omitempty" gorm:"column:summary"`
+	Snapshot     *json.RawMessage `json:"snapshot,omitempty" gorm:"column:snapshot;type:jsonb"` // entity row after the edit; shows and venues only
 	CreatedAt    time.Time        `json:"created_at"`
 
 	User auth.User `json:"-" gorm:"foreignKey:UserID"`
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"strings"
+	"time"
+)
+
+// Public API key scopes. A key can only reach endpoints tagged with one of
+// its scopes; untagged endpoints reject API keys outright.
+const (
+	APIKeyScopeReadShows        = "read:shows"
+	APIKeyScopeReadVenues       = "read:venues"
+	APIKeyScopeWriteSubmissions = "write:submissions"
+)
+
+// APIKeyScopes is the list of scopes a user can grant to a key
+var APIKeyScopes = []string{
+	APIKeyScopeReadShows,
+	APIKeyScopeReadVenues,
+	APIKeyScopeWriteSubmissions,
+}
+
+// IsValidAPIKeyScope reports whether s is a grantable scope
+func IsValidAPIKeyScope(s string) bool {
+	for _, scope := range APIKeyScopes {
+		if scope == s {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKey is a user-created public API key. Unlike admin API tokens it never
+// authenticates as the full user: it is limited to its scopes and throttled
+// per key.
+type APIKey struct {
+	ID                 uint       `gorm:"primaryKey"`
+	UserID             uint       `gorm:"not null"`
+	Name               string     `gorm:"not null"`
+	KeyPrefix          string     `gorm:"column:key_prefix;not null"`
+	KeyHash            string     `gorm:"column:key_hash;uniqueIndex;not null"`
+	Scopes             string     `gorm:"not null"` // Space-separated
+	RateLimitPerMinute int        `gorm:"column:rate_limit_per_minute;not null;default:60"`
+	RequestCount       int64      `gorm:"column:request_count;not null;default:0"`
+	WindowStartedAt    *time.Time `gorm:"column:window_started_at"`
+	WindowCount        int        `gorm:"column:window_count;not null;default:0"`
+	LastUsedAt         *time.Time `gorm:"column:last_used_at"`
+	CreatedAt          time.Time
+	RevokedAt          *time.Time `gorm:"column:revoked_at"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for APIKey
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// ScopeList returns the key's scopes as a slice
+func (k *APIKey) ScopeList() []string {
+	return strings.Fields(k.Scopes)
+}
+
+// HasScope reports whether the key was granted scope
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.ScopeList() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package auth
+
+// Role is a user's staff role. Permissions are fixed per role in code; the
+// users.role column only records which role a user holds.
+type Role string
+
+const (
+	// RoleUser is every non-staff account.
+	RoleUser Role = "user"
+	// RoleModerator works the review queues: pending shows, edits, reports,
+	// comments and entity requests.
+	RoleModerator Role = "moderator"
+	// RoleAdmin has the full admin console except role management.
+	RoleAdmin Role = "admin"
+	// RoleSuperadmin can do everything, including granting roles.
+	RoleSuperadmin Role = "superadmin"
+)
+
+// Roles lists every role, least privileged first.
+var Roles = []Role{RoleUser, RoleModerator, RoleAdmin, RoleSuperadmin}
+
+// Permission is a capability checked by the admin route groups.
+type Permission string
+
+const (
+	// PermissionModerateContent covers the review queues.
+	PermissionModerateContent Permission = "moderate_content"
+	// PermissionManageSite covers the rest of the admin console: catalog
+	// tooling, imports/exports, pipeline, analytics and API tokens.
+	PermissionManageSite Permission = "manage_site"
+	// PermissionManageUsers covers the user list, user export and tier
+	// evaluation.
+	PermissionManageUsers Permission = "manage_users"
+	// PermissionManageRoles covers granting and revoking roles.
+	PermissionManageRoles Permission = "manage_roles"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleUser:      nil,
+	RoleModerator: {PermissionModerateContent},
+	RoleAdmin:     {PermissionModerateContent, PermissionManageSite, PermissionManageUsers},
+	RoleSuperadmin: {
+		PermissionModerateContent, PermissionManageSite, PermissionManageUsers, PermissionManageRoles,
+	},
+}
+
+// Valid reports whether r is a known role.
+func (r Role) Valid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Permissions returns the permissions r grants.
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// Has reports whether r grants p.
+func (r Role) Has(p Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAdminRole reports whether r maps to the legacy is_admin flag, which
+// owner-or-admin checks throughout the handlers still read. Moderators
+// don't get it: they see the review queues, not other users' content.
+func (r Role) IsAdminRole() bool {
+	return r == RoleAdmin || r == RoleSuperadmin
+}
+
+// EffectiveRole is the role permission checks use. An account flagged
+// is_admin without a staff role (set directly in the database or by the
+// CLI tools) is treated as a superadmin, as it had full access before roles
+// existed.
+func (u *User) EffectiveRole() Role {
+	if u.Role.Valid() && u.Role != RoleUser {
+		return u.Role
+	}
+	if u.IsAdmin {
+		return RoleSuperadmin
+	}
+	return RoleUser
+}
+
+// Can reports whether the user's role grants p.
+func (u *User) Can(p Permission) bool {
+	return u.EffectiveRole().Has(p)
+}
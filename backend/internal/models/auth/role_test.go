@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolePermissions(t *testing.T) {
+	assert.Empty(t, RoleUser.Permissions())
+
+	assert.True(t, RoleModerator.Has(PermissionModerateContent))
+	assert.False(t, RoleModerator.Has(PermissionManageSite))
+	assert.False(t, RoleModerator.Has(PermissionManageUsers))
+
+	assert.True(t, RoleAdmin.Has(PermissionManageUsers))
+	assert.False(t, RoleAdmin.Has(PermissionManageRoles))
+
+	for _, p := range []Permission{PermissionModerateContent, PermissionManageSite, PermissionManageUsers, PermissionManageRoles} {
+		assert.True(t, RoleSuperadmin.Has(p), p)
+	}
+}
+
+func TestRoleValid(t *testing.T) {
+	for _, r := range Roles {
+		assert.True(t, r.Valid(), r)
+	}
+	assert.False(t, Role("").Valid())
+	assert.False(t, Role("owner").Valid())
+}
+
+func TestRoleIsAdminRole(t *testing.T) {
+	assert.False(t, RoleUser.IsAdminRole())
+	assert.False(t, RoleModerator.IsAdminRole())
+	assert.True(t, RoleAdmin.IsAdminRole())
+	assert.True(t, RoleSuperadmin.IsAdminRole())
+}
+
+func TestUserEffectiveRole(t *testing.T) {
+	assert.Equal(t, RoleUser, (&User{}).EffectiveRole())
+	assert.Equal(t, RoleUser, (&User{Role: RoleUser}).EffectiveRole())
+	assert.Equal(t, RoleModerator, (&User{Role: RoleModerator}).EffectiveRole())
+	assert.Equal(t, RoleAdmin, (&User{Role: RoleAdmin, IsAdmin: true}).EffectiveRole())
+
+	// Legacy is_admin accounts without a staff role keep full access.
+	assert.Equal(t, RoleSuperadmin, (&User{IsAdmin: true}).EffectiveRole())
+	assert.Equal(t, RoleSuperadmin, (&User{Role: RoleUser, IsAdmin: true}).EffectiveRole())
+
+	// An unknown stored role grants nothing.
+	assert.Equal(t, RoleUser, (&User{Role: "owner"}).EffectiveRole())
+}
+
+func TestUserCan(t *testing.T) {
+	mod := &User{Role: RoleModerator}
+	assert.True(t, mod.Can(PermissionModerateContent))
+	assert.False(t, mod.Can(PermissionManageSite))
+
+	assert.False(t, (&User{}).Can(PermissionModerateContent))
+	assert.True(t, (&User{IsAdmin: true}).Can(PermissionManageRoles))
+}
@@ -0,0 +1,57 @@
+package auth
+
+// SubmissionTrust is a user's show-submission reputation, derived from how
+// many of their submissions moderators approved and rejected.
+type SubmissionTrust string
+
+const (
+	// SubmissionTrustNew has too few reviewed submissions to judge.
+	SubmissionTrustNew SubmissionTrust = "new"
+	// SubmissionTrustStandard is a submitter with an unremarkable record.
+	SubmissionTrustStandard SubmissionTrust = "standard"
+	// SubmissionTrustHigh submitters are auto-approved: their submissions
+	// skip review holds.
+	SubmissionTrustHigh SubmissionTrust = "high"
+	// SubmissionTrustLow submitters have mostly been rejected; their
+	// submissions are held for review.
+	SubmissionTrustLow SubmissionTrust = "low"
+)
+
+// Submission trust thresholds.
+const (
+	// SubmissionTrustMinReviewed is how many reviewed submissions a user
+	// needs before they leave SubmissionTrustNew.
+	SubmissionTrustMinReviewed = 5
+	// SubmissionTrustHighMinApproved and SubmissionTrustHighMinRate gate
+	// auto-approval.
+	SubmissionTrustHighMinApproved = 10
+	SubmissionTrustHighMinRate     = 0.9
+	// SubmissionTrustLowMaxRate is the approval rate at or below which a
+	// submitter is held for review.
+	SubmissionTrustLowMaxRate = 0.5
+)
+
+// SubmissionApprovalRate returns the share of reviewed submissions that were
+// approved, and false when none have been reviewed.
+func (u *User) SubmissionApprovalRate() (float64, bool) {
+	reviewed := u.SubmissionsApproved + u.SubmissionsRejected
+	if reviewed == 0 {
+		return 0, false
+	}
+	return float64(u.SubmissionsApproved) / float64(reviewed), true
+}
+
+// SubmissionTrust derives the user's submission trust tier.
+func (u *User) SubmissionTrust() SubmissionTrust {
+	if u.SubmissionsApproved+u.SubmissionsRejected < SubmissionTrustMinReviewed {
+		return SubmissionTrustNew
+	}
+	rate, _ := u.SubmissionApprovalRate()
+	switch {
+	case u.SubmissionsApproved >= SubmissionTrustHighMinApproved && rate >= SubmissionTrustHighMinRate:
+		return SubmissionTrustHigh
+	case rate <= SubmissionTrustLowMaxRate:
+		return SubmissionTrustLow
+	}
+	return SubmissionTrustStandard
+}
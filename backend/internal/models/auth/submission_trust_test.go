@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmissionTrust(t *testing.T) {
+	tests := []struct {
+		name               string
+		approved, rejected int
+		want               SubmissionTrust
+	}{
+		{"no history", 0, 0, SubmissionTrustNew},
+		{"too few reviewed", 3, 1, SubmissionTrustNew},
+		{"standard", 6, 1, SubmissionTrustStandard},
+		{"high", 10, 1, SubmissionTrustHigh},
+		{"many approvals but below rate", 18, 3, SubmissionTrustStandard},
+		{"low", 2, 3, SubmissionTrustLow},
+		{"all rejected", 0, 5, SubmissionTrustLow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &User{SubmissionsApproved: tt.approved, SubmissionsRejected: tt.rejected}
+			assert.Equal(t, tt.want, u.SubmissionTrust())
+		})
+	}
+}
+
+func TestSubmissionApprovalRate(t *testing.T) {
+	_, ok := (&User{}).SubmissionApprovalRate()
+	assert.False(t, ok)
+
+	rate, ok := (&User{SubmissionsApproved: 3, SubmissionsRejected: 1}).SubmissionApprovalRate()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.75, rate, 1e-9)
+}
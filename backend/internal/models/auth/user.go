@@ -41,11 +41,12 @@ type User struct {
 	Location            *string          `json:"location" gorm:"column:location"` // Free-text "City, state" (PSY-1416); not in attribution chain
 	Bio                 *string          `json:"bio"`
 	ProfileVisibility   string           `json:"profile_visibility" gorm:"column:profile_visibility;not null;default:'public'"`
-	PrivacySettings     *json.RawMessage `json:"privacy_settings" gorm:"column:privacy_settings;type:jsonb;not null;default:'{\"contributions\":\"visible\",\"saved_shows\":\"hidden\",\"following\":\"visible\",\"collections\":\"visible\",\"last_active\":\"visible\",\"profile_sections\":\"visible\"}'"`
+	PrivacySettings     *json.RawMessage `json:"privacy_settings" gorm:"column:privacy_settings;type:jsonb;not null;default:'{\"contributions\":\"visible\",\"saved_shows\":\"hidden\",\"following\":\"visible\",\"collections\":\"visible\",\"last_active\":\"visible\",\"profile_sections\":\"visible\",\"submitted_shows\":\"visible\",\"rsvps\":\"hidden\"}'"`
 	NavMode             string           `json:"nav_mode" gorm:"column:nav_mode;not null;default:'top'"` // Global nav chrome preference: 'top' | 'side' (PSY-1115)
 	UserTier            string           `json:"user_tier" gorm:"column:user_tier;not null;default:'new_user'"`
 	IsActive            bool             `json:"is_active" gorm:"default:true"`
-	IsAdmin             bool             `json:"is_admin" gorm:"default:false"`
+	IsAdmin             bool             `json:"is_admin" gorm:"default:false"` // Kept in sync with Role (admin/superadmin); see role.go
+	Role                Role             `json:"role" gorm:"column:role;size:20;not null;default:'user'"`
 	EmailVerified       bool             `json:"email_verified" gorm:"default:false"`
 	TermsAcceptedAt     *time.Time       `json:"-" gorm:"column:terms_accepted_at"` // Legal acceptance evidence
 	TermsVersion        *string          `json:"-" gorm:"column:terms_version"`
@@ -54,6 +55,10 @@ type User struct {
 	MinAgeAttested      *int             `json:"-" gorm:"column:min_age_attested"` // Minimum age the user attested to at signup (e.g. 16)
 	FailedLoginAttempts int              `json:"-" gorm:"default:0"`
 	LockedUntil         *time.Time       `json:"-" gorm:"column:locked_until"`
+	QuarantinedAt       *time.Time       `json:"-" gorm:"column:quarantined_at"` // New show submissions are held for review while set
+	QuarantineReason    *string          `json:"-" gorm:"column:quarantine_reason"`
+	SubmissionsApproved int              `json:"-" gorm:"column:submissions_approved_count;not null;default:0"` // Submissions a moderator approved; see SubmissionTrust
+	SubmissionsRejected int              `json:"-" gorm:"column:submissions_rejected_count;not null;default:0"`
 	CreatedAt           time.Time        `json:"created_at"`
 	UpdatedAt           time.Time        `json:"updated_at"`
 	DeletedAt           *time.Time       `json:"deleted_at,omitempty" gorm:"column:deleted_at"`
@@ -96,15 +101,13 @@ func (OAuthAccount) TableName() string {
 
 // UserPreferences represents user preferences
 type UserPreferences struct {
-	ID                uint             `json:"id" gorm:"primaryKey"`
-	UserID            uint             `json:"user_id" gorm:"uniqueIndex;not null"`
-	NotificationEmail bool             `json:"notification_email" gorm:"default:true"`
-	NotificationPush  bool             `json:"notification_push" gorm:"default:false"`
-	Theme             string           `json:"theme" gorm:"default:light"`
-	Timezone          string           `json:"timezone" gorm:"default:UTC"`
-	Language          string           `json:"language" gorm:"default:en"`
-	ShowReminders     bool             `json:"show_reminders" gorm:"default:false"`
-	FavoriteCities    *json.RawMessage `json:"favorite_cities" gorm:"type:jsonb;default:'[]'"`
+	ID             uint             `json:"id" gorm:"primaryKey"`
+	UserID         uint             `json:"user_id" gorm:"uniqueIndex;not null"`
+	Theme          string           `json:"theme" gorm:"default:light"`
+	Timezone       string           `json:"timezone" gorm:"default:UTC"`
+	Language       string           `json:"language" gorm:"default:en"`
+	ShowReminders  bool             `json:"show_reminders" gorm:"default:false"`
+	FavoriteCities *json.RawMessage `json:"favorite_cities" gorm:"type:jsonb;default:'[]'"`
 	// PSY-1423: saved /charts window + scene. NULL = no saved defaults.
 	ChartDefaults *json.RawMessage `json:"chart_defaults" gorm:"type:jsonb"`
 	CreatedAt     time.Time        `json:"created_at"`
@@ -86,6 +86,12 @@ type Artist struct {
 	// ImageLicense="Public domain" (not the public_domain source id).
 	ImageLicense *string `json:"image_license,omitempty" gorm:"column:image_license;size:64"`
 	ImageAuthor  *string `json:"image_author,omitempty" gorm:"column:image_author"`
+	// Bio is the artist's own markdown profile text, cleaned on write
+	// (utils.SanitizeMarkdownSource) and rendered to sanitized HTML in
+	// responses. Hometown and Pronouns are free text shown on the profile.
+	Bio      *string `json:"bio,omitempty" gorm:"column:bio;type:text"`
+	Hometown *string `json:"hometown,omitempty" gorm:"column:hometown;size:255"`
+	Pronouns *string `json:"pronouns,omitempty" gorm:"column:pronouns;size:64"`
 	// ImageEnrichAttemptedAt records when the ongoing image-enrichment sweep
 	// (PSY-1246) last tried to resolve a photo for this artist, so it can skip rows
 	// attempted within the re-attempt window instead of re-querying the imageless
@@ -137,6 +143,11 @@ type Artist struct {
 	StreamingDiscoveryStatus StreamingDiscoveryStatus `json:"streaming_discovery_status" gorm:"column:streaming_discovery_status;size:32;not null;default:unreviewed"`
 	StreamingDiscoveryReason *string                  `json:"streaming_discovery_reason,omitempty" gorm:"column:streaming_discovery_reason;type:text"`
 
+	// Verified is true while the artist has at least one manager (an approved
+	// artist claim). Maintained by ArtistClaimService; surfaced as the
+	// verified badge.
+	Verified bool `gorm:"not null;default:false"`
+
 	CreatedAt time.Time `gorm:"not null"`
 	UpdatedAt time.Time `gorm:"not null"`
 
@@ -31,5 +31,6 @@ var ArtistAllowedEditFields = map[string]bool{
 	"spotify":            true,
 	"soundcloud":         true,
 	"bandcamp":           true,
+	"tiktok":             true,
 	"website":            true,
 }
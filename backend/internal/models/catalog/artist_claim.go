@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"time"
+
+	"psychic-homily-backend/internal/models/auth"
+)
+
+// ArtistClaimStatus is the review state of an artist claim.
+type ArtistClaimStatus string
+
+const (
+	ArtistClaimStatusPending  ArtistClaimStatus = "pending"
+	ArtistClaimStatusApproved ArtistClaimStatus = "approved"
+	ArtistClaimStatusDenied   ArtistClaimStatus = "denied"
+)
+
+// ArtistClaim is a request from an artist or their team to manage the
+// artist's page. Approving it creates an ArtistManager row and verifies the
+// artist.
+type ArtistClaim struct {
+	ID         uint              `gorm:"primaryKey"`
+	ArtistID   uint              `gorm:"column:artist_id;not null"`
+	UserID     uint              `gorm:"column:user_id;not null"`
+	Evidence   string            `gorm:"not null"`
+	Status     ArtistClaimStatus `gorm:"not null;default:'pending'"`
+	ReviewedBy *uint             `gorm:"column:reviewed_by"`
+	ReviewedAt *time.Time        `gorm:"column:reviewed_at"`
+	ReviewNote *string           `gorm:"column:review_note"`
+	CreatedAt  time.Time         `gorm:"not null"`
+	UpdatedAt  time.Time         `gorm:"not null"`
+
+	// Relationships
+	Artist   Artist     `gorm:"foreignKey:ArtistID"`
+	User     auth.User  `gorm:"foreignKey:UserID"`
+	Reviewer *auth.User `gorm:"foreignKey:ReviewedBy"`
+}
+
+// TableName specifies the table name for ArtistClaim
+func (ArtistClaim) TableName() string {
+	return "artist_claims"
+}
+
+// ArtistManager grants a user edit rights on one artist's bio and social
+// links.
+type ArtistManager struct {
+	ArtistID  uint      `gorm:"primaryKey;column:artist_id"`
+	UserID    uint      `gorm:"primaryKey;column:user_id"`
+	ClaimID   *uint     `gorm:"column:claim_id"`
+	GrantedBy *uint     `gorm:"column:granted_by"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	// Relationships
+	Artist Artist    `gorm:"foreignKey:ArtistID"`
+	User   auth.User `gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for ArtistManager
+func (ArtistManager) TableName() string {
+	return "artist_managers"
+}
@@ -0,0 +1,27 @@
+package catalog
+
+import "time"
+
+// Entity alias types, matching the CHECK constraint in the
+// create_entity_aliases migration.
+const (
+	EntityAliasTypeVenue  = "venue"
+	EntityAliasTypeArtist = "artist"
+)
+
+// EntityAlias maps an alternate name, as it appears in scraper or seed data,
+// to a canonical venue or artist. Discovery and seed imports consult it
+// before falling back to name matching.
+type EntityAlias struct {
+	ID         uint      `gorm:"primaryKey"`
+	EntityType string    `gorm:"column:entity_type;not null"`
+	EntityID   uint      `gorm:"column:entity_id;not null"`
+	Alias      string    `gorm:"column:alias;not null;size:255"`
+	CreatedBy  *uint     `gorm:"column:created_by"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null"`
+}
+
+// TableName specifies the table name for EntityAlias
+func (EntityAlias) TableName() string {
+	return "entity_aliases"
+}
@@ -24,6 +24,39 @@ const (
 	ShowSourceDiscovery ShowSource = "discovery" // Automatically imported from the discovery app
 )
 
+// Ticket provider values for shows.ticket_provider. "box_office" covers sales
+// run by the venue itself; "other" is any vendor not listed.
+const (
+	TicketProviderEventbrite = "eventbrite"
+	TicketProviderDice       = "dice"
+	TicketProviderSeeTickets = "seetickets"
+	TicketProviderBoxOffice  = "box_office"
+	TicketProviderOther      = "other"
+)
+
+// TicketProviders is the list of valid ticket providers
+var TicketProviders = []string{
+	TicketProviderEventbrite,
+	TicketProviderDice,
+	TicketProviderSeeTickets,
+	TicketProviderBoxOffice,
+	TicketProviderOther,
+}
+
+// IsValidTicketProvider reports whether s is an accepted ticket_provider. The
+// empty string is valid and means "not set".
+func IsValidTicketProvider(s string) bool {
+	if s == "" {
+		return true
+	}
+	for _, tp := range TicketProviders {
+		if tp == s {
+			return true
+		}
+	}
+	return false
+}
+
 // DataSource constants for provenance tracking across all entities
 const (
 	DataSourceUser          = "user"
@@ -39,11 +72,12 @@ const (
 type Show struct {
 	ID             uint `gorm:"primaryKey"`
 	Title          string
-	Slug           *string   `gorm:"column:slug;uniqueIndex"`
-	EventDate      time.Time `gorm:"not null"`
+	Slug           *string    `gorm:"column:slug;uniqueIndex"`
+	ShortCode      *string    `gorm:"column:short_code"` // /s/{code} short link; set once the show is approved
+	EventDate      time.Time  `gorm:"not null"`
+	DoorsTime      *time.Time `gorm:"column:doors_time"` // When doors open (UTC); nil if unknown
 	City           *string
 	State          *string
-	Price          *float64
 	AgeRequirement *string
 	Description    *string
 	CreatedAt      time.Time `gorm:"not null"`
@@ -69,16 +103,60 @@ type Show struct {
 	// Duplicate detection (for discovery imports flagged as potential duplicates)
 	DuplicateOfShowID *uint `gorm:"column:duplicate_of_show_id"`
 
-	// Ticket URL (optional)
-	TicketURL *string `json:"ticket_url,omitempty" gorm:"type:varchar(500)"`
+	// Ticket price in PriceCurrency (ISO 4217). A single price sets only
+	// PriceMin; PriceMax is the top of a range. IsFree marks free or donation
+	// entry, with PriceMin/PriceMax, if set, the suggested donation.
+	PriceMin      *float64 `gorm:"column:price_min;type:decimal(10,2)"`
+	PriceMax      *float64 `gorm:"column:price_max;type:decimal(10,2)"`
+	PriceCurrency string   `gorm:"column:price_currency;size:3;not null;default:'USD'"`
+	IsFree        bool     `gorm:"column:is_free;not null;default:false"`
+
+	// EventDate as a wall-clock reading in EventTimezone, the primary venue's
+	// zone when the show was last saved. Kept so the time the submitter meant
+	// survives a later change to the venue's timezone.
+	EventLocalTime *time.Time `gorm:"column:event_local_time;type:timestamp"`
+	EventTimezone  *string    `gorm:"column:event_timezone;size:64"`
+
+	// Ticket URL and the vendor selling through it (optional)
+	TicketURL      *string `json:"ticket_url,omitempty" gorm:"type:varchar(500)"`
+	TicketProvider *string `json:"ticket_provider,omitempty" gorm:"column:ticket_provider;size:32"`
 
 	// Image URL (optional) — show flyer when distinct from associated
 	// release/festival imagery. PSY-521.
 	ImageURL *string `json:"image_url,omitempty" gorm:"column:image_url"`
 
+	// Uploaded flyer (web-sized copies in media storage). FlyerKey is the
+	// storage prefix, used to delete the objects on replace or purge.
+	FlyerKey          *string `json:"-" gorm:"column:flyer_key"`
+	FlyerURL          *string `json:"flyer_url,omitempty" gorm:"column:flyer_url"`
+	FlyerThumbnailURL *string `json:"flyer_thumbnail_url,omitempty" gorm:"column:flyer_thumbnail_url"`
+
 	// Status flags (admin-controlled)
 	IsSoldOut   bool `gorm:"column:is_sold_out;not null;default:false"`
 	IsCancelled bool `gorm:"column:is_cancelled;not null;default:false"`
+	IsPostponed bool `gorm:"column:is_postponed;not null;default:false"`
+
+	// When the ticket availability job last read the show's ticket page
+	TicketLastCheckedAt *time.Time `gorm:"column:ticket_last_checked_at"`
+
+	// Why the show was cancelled or postponed, shown alongside the flag.
+	StatusReason *string `gorm:"column:status_reason"`
+
+	// Postponement to a new date creates a new show: the postponed show links
+	// forward to it, and it links back and keeps the date it was first billed
+	// for in OriginalDate.
+	RescheduledShowID     *uint      `gorm:"column:rescheduled_show_id"`
+	RescheduledFromShowID *uint      `gorm:"column:rescheduled_from_show_id"`
+	OriginalDate          *time.Time `gorm:"column:original_date"`
+
+	// Recurring series membership. SeriesDetached marks an occurrence that
+	// was edited as a one-off, so series edits and regeneration skip it.
+	SeriesID       *uint `gorm:"column:series_id"`
+	SeriesDetached bool  `gorm:"column:series_detached;not null;default:false"`
+
+	// Soft delete. Set when the show is deleted; the row is hard-deleted by
+	// the cleanup job once the retention period has passed.
+	DeletedAt *time.Time `gorm:"column:deleted_at"`
 
 	// Relationships
 	Venues  []Venue  `gorm:"many2many:show_venues;"`
@@ -92,6 +170,20 @@ func (Show) TableName() string {
 	return "shows"
 }
 
+// LowestPrice is the cheapest way in: PriceMin, or 0 for a free show with no
+// suggested donation. Nil when the price is unknown. This is the single
+// value shows carried before price ranges.
+func (s *Show) LowestPrice() *float64 {
+	if s.PriceMin != nil {
+		return s.PriceMin
+	}
+	if s.IsFree {
+		zero := 0.0
+		return &zero
+	}
+	return nil
+}
+
 // ShowArtist represents the junction table with ordering information.
 //
 // EventDate + VenueID are denormalized from the parent show + show_venues
@@ -106,10 +198,47 @@ type ShowArtist struct {
 	ArtistID  uint       `gorm:"primaryKey;column:artist_id"`
 	Position  int        `gorm:"not null;default:0"`
 	SetType   string     `gorm:"default:performer"`
+	SetTime   *time.Time `gorm:"column:set_time"` // When the artist goes on (UTC); nil if unknown
 	EventDate *time.Time `gorm:"column:event_date"`
 	VenueID   *uint      `gorm:"column:venue_id"`
 }
 
+// Set type values for show_artists.set_type, in billing order. "support" is
+// the direct support act; "performer" is the default for rows that predate
+// set types or whose slot on the bill is unknown.
+const (
+	SetTypeHeadliner    = "headliner"
+	SetTypeSupport      = "support"
+	SetTypeOpener       = "opener"
+	SetTypeDJ           = "dj"
+	SetTypeSpecialGuest = "special_guest"
+	SetTypePerformer    = "performer"
+)
+
+// SetTypes is the list of valid set types
+var SetTypes = []string{
+	SetTypeHeadliner,
+	SetTypeSupport,
+	SetTypeOpener,
+	SetTypeDJ,
+	SetTypeSpecialGuest,
+	SetTypePerformer,
+}
+
+// IsValidSetType reports whether s is an accepted set_type.
+func IsValidSetType(s string) bool {
+	for _, st := range SetTypes {
+		if st == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ShowArtistLineupOrder orders a show's show_artists rows for display: by set
+// time when one is set, otherwise (and for rows without one) by position.
+const ShowArtistLineupOrder = "set_time ASC NULLS LAST, position ASC"
+
 // TableName specifies the table name for ShowArtist
 func (ShowArtist) TableName() string {
 	return "show_artists"
@@ -0,0 +1,21 @@
+package catalog
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ShowDraft is a user's in-progress show submission. Payload holds the
+// submit form's CreateShowRequest body, possibly incomplete.
+type ShowDraft struct {
+	ID        uint             `gorm:"primaryKey"`
+	UserID    uint             `gorm:"column:user_id;not null"`
+	Payload   *json.RawMessage `gorm:"column:payload;type:jsonb;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName specifies the table name for ShowDraft
+func (ShowDraft) TableName() string {
+	return "show_drafts"
+}
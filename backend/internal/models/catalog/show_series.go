@@ -0,0 +1,53 @@
+package catalog
+
+import "time"
+
+// ShowSeries is a recurring-event template. Individual Show rows are
+// generated from it (one per matching weekday between StartDate and EndDate)
+// and point back via Show.SeriesID. The price columns mirror Show's and are
+// copied onto every occurrence.
+type ShowSeries struct {
+	ID      uint   `gorm:"primaryKey"`
+	Title   string `gorm:"not null"`
+	VenueID uint   `gorm:"column:venue_id;not null"`
+	// DayOfWeek uses time.Weekday numbering (0 = Sunday).
+	DayOfWeek int `gorm:"column:day_of_week;not null"`
+	// StartTime is the venue-local wall-clock start, "HH:MM".
+	StartTime      string   `gorm:"column:start_time;not null"`
+	StartDate      string   `gorm:"column:start_date;type:date;not null"`
+	EndDate        string   `gorm:"column:end_date;type:date;not null"`
+	PriceMin       *float64 `gorm:"column:price_min;type:decimal(10,2)"`
+	PriceMax       *float64 `gorm:"column:price_max;type:decimal(10,2)"`
+	PriceCurrency  string   `gorm:"column:price_currency;size:3;not null;default:'USD'"`
+	IsFree         bool     `gorm:"column:is_free;not null;default:false"`
+	AgeRequirement *string
+	Description    *string
+	TicketURL      *string   `gorm:"column:ticket_url"`
+	CreatedBy      *uint     `gorm:"column:created_by"`
+	CreatedAt      time.Time `gorm:"not null"`
+	UpdatedAt      time.Time `gorm:"not null"`
+
+	// Relationships
+	Venue   Venue              `gorm:"foreignKey:VenueID"`
+	Artists []ShowSeriesArtist `gorm:"foreignKey:SeriesID"`
+}
+
+// TableName specifies the table name for ShowSeries
+func (ShowSeries) TableName() string {
+	return "show_series"
+}
+
+// ShowSeriesArtist is the template billing copied onto each occurrence.
+type ShowSeriesArtist struct {
+	SeriesID uint   `gorm:"primaryKey;column:series_id"`
+	ArtistID uint   `gorm:"primaryKey;column:artist_id"`
+	Position int    `gorm:"not null;default:0"`
+	SetType  string `gorm:"not null;default:performer"`
+
+	Artist Artist `gorm:"foreignKey:ArtistID"`
+}
+
+// TableName specifies the table name for ShowSeriesArtist
+func (ShowSeriesArtist) TableName() string {
+	return "show_series_artists"
+}
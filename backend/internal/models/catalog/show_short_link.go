@@ -0,0 +1,18 @@
+package catalog
+
+import "time"
+
+// ShowShortLinkReferrer counts click-throughs on a show's short link from
+// one referring site. Referrer is the referring host (or the link's ref
+// parameter); "direct" when there was neither.
+type ShowShortLinkReferrer struct {
+	ShowID        uint      `gorm:"primaryKey;column:show_id"`
+	Referrer      string    `gorm:"primaryKey;column:referrer;size:255"`
+	Clicks        int64     `gorm:"column:clicks;not null;default:0"`
+	LastClickedAt time.Time `gorm:"column:last_clicked_at;not null"`
+}
+
+// TableName specifies the table name for ShowShortLinkReferrer
+func (ShowShortLinkReferrer) TableName() string {
+	return "show_short_link_referrers"
+}
@@ -0,0 +1,26 @@
+package catalog
+
+import "time"
+
+// Sources of a change to a show's sold-out flag
+const (
+	TicketStatusSourceManual      = "manual"       // Set by the submitter, a venue manager or an admin
+	TicketStatusSourceTicketCheck = "ticket_check" // Read from the provider's ticket page
+)
+
+// ShowTicketStatusChange records a change to a show's is_sold_out flag.
+// Provider is the ticket provider whose page was read, for ticket_check
+// changes.
+type ShowTicketStatusChange struct {
+	ID        uint      `gorm:"primaryKey"`
+	ShowID    uint      `gorm:"column:show_id;not null"`
+	IsSoldOut bool      `gorm:"column:is_sold_out;not null"`
+	Source    string    `gorm:"column:source;size:20;not null"`
+	Provider  *string   `gorm:"column:provider;size:32"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for ShowTicketStatusChange
+func (ShowTicketStatusChange) TableName() string {
+	return "show_ticket_status_changes"
+}
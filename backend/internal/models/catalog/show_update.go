@@ -0,0 +1,26 @@
+package catalog
+
+import (
+	"time"
+
+	"psychic-homily-backend/internal/models/auth"
+)
+
+// ShowUpdate is a short note on a show page, such as a lineup change or a
+// new set time, posted by the submitter, a venue manager or an admin.
+type ShowUpdate struct {
+	ID        uint      `gorm:"primaryKey"`
+	ShowID    uint      `gorm:"column:show_id;not null"`
+	UserID    uint      `gorm:"column:user_id;not null"`
+	Body      string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+
+	// Relationships
+	User auth.User `gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for ShowUpdate
+func (ShowUpdate) TableName() string {
+	return "show_updates"
+}
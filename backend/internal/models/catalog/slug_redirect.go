@@ -0,0 +1,26 @@
+package catalog
+
+import "time"
+
+// Slug redirect entity types, matching the CHECK constraint in the
+// create_slug_redirects migration.
+const (
+	SlugRedirectEntityShow   = "show"
+	SlugRedirectEntityVenue  = "venue"
+	SlugRedirectEntityArtist = "artist"
+)
+
+// SlugRedirect maps a slug that no longer names any row to the entity that
+// replaced it (e.g. the canonical artist after a duplicate was merged away).
+type SlugRedirect struct {
+	ID         uint64    `gorm:"primaryKey"`
+	EntityType string    `gorm:"column:entity_type;not null"`
+	OldSlug    string    `gorm:"column:old_slug;not null"`
+	EntityID   uint      `gorm:"column:entity_id;not null"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null"`
+}
+
+// TableName specifies the table name for SlugRedirect
+func (SlugRedirect) TableName() string {
+	return "slug_redirects"
+}
@@ -9,5 +9,6 @@ type Social struct {
 	Spotify    *string `gorm:"column:spotify"`
 	SoundCloud *string `gorm:"column:soundcloud"`
 	Bandcamp   *string `gorm:"column:bandcamp"`
+	TikTok     *string `gorm:"column:tiktok"`
 	Website    *string `gorm:"column:website"`
 }
@@ -0,0 +1,28 @@
+package catalog
+
+import "time"
+
+// Sync tombstone entity types, matching the trigger arguments in the
+// create_sync_tombstones migration.
+const (
+	SyncEntityShow   = "show"
+	SyncEntityVenue  = "venue"
+	SyncEntityArtist = "artist"
+)
+
+// SyncTombstone records a hard-deleted show, venue or artist so offline
+// clients can drop it on their next differential sync. Rows are written by a
+// database trigger, never by application code.
+type SyncTombstone struct {
+	ID         uint64    `gorm:"primaryKey"`
+	EntityType string    `gorm:"column:entity_type;not null"`
+	EntityID   uint      `gorm:"column:entity_id;not null"`
+	City       *string   `gorm:"column:city"`
+	State      *string   `gorm:"column:state"`
+	DeletedAt  time.Time `gorm:"column:deleted_at;not null"`
+}
+
+// TableName specifies the table name for SyncTombstone
+func (SyncTombstone) TableName() string {
+	return "sync_tombstones"
+}
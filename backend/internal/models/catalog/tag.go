@@ -88,6 +88,22 @@ type EntityTag struct {
 // TableName specifies the table name for EntityTag.
 func (EntityTag) TableName() string { return "entity_tags" }
 
+// ArtistGenre is a genre tag inferred for an artist from import metadata
+// rather than applied by a user. Source is one of the DataSource* values.
+// An artist's genres are these plus its genre-category entity_tags.
+type ArtistGenre struct {
+	ArtistID  uint      `json:"artist_id" gorm:"column:artist_id;primaryKey"`
+	TagID     uint      `json:"tag_id" gorm:"column:tag_id;primaryKey"`
+	Source    string    `json:"source" gorm:"column:source;not null;size:50"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Relationships
+	Tag Tag `json:"-" gorm:"foreignKey:TagID"`
+}
+
+// TableName specifies the table name for ArtistGenre.
+func (ArtistGenre) TableName() string { return "artist_genres" }
+
 // TagVote represents a user's relevance vote on a tag for a specific entity.
 type TagVote struct {
 	TagID      uint      `json:"tag_id" gorm:"column:tag_id;primaryKey"`
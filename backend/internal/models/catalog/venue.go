@@ -6,6 +6,22 @@ import (
 	"psychic-homily-backend/internal/models/auth"
 )
 
+// Venue coordinate precision, stored in venues.geocode_precision.
+const (
+	GeocodePrecisionCity    = "city"
+	GeocodePrecisionAddress = "address"
+)
+
+// CentroidGeocodePrecision is the precision stored with offline centroid
+// coordinates: GeocodePrecisionCity when lat is set, nil on a geocode miss.
+func CentroidGeocodePrecision(lat *float64) *string {
+	if lat == nil {
+		return nil
+	}
+	p := GeocodePrecisionCity
+	return &p
+}
+
 type Venue struct {
 	ID      uint    `gorm:"primaryKey"`
 	Name    string  `gorm:"not null"` // Unique with city via composite index
@@ -19,12 +35,25 @@ type Venue struct {
 	// unknown for most rows. Not sensitive, so unlike Address/Zipcode it is not
 	// redacted for unverified venues.
 	Capacity *int `gorm:"column:capacity"`
+	// Operating info. All nullable — unknown until someone fills it in.
+	// AllAges is tri-state: nil means unknown, not "21+". DoorTime is the
+	// venue's typical door time as 24h "HH:MM" in the venue's local time.
+	AccessibilityNotes *string `gorm:"column:accessibility_notes;type:text"`
+	AllAges            *bool   `gorm:"column:all_ages"`
+	DoorTime           *string `gorm:"column:door_time;size:5"`
 	// Geocoding (PSY-985): resolved offline from city/state/country at create/update.
 	// Timezone is the IANA zone used to anchor show times to the venue's locale.
 	// Nullable — a geocode miss falls back to the legacy state->tz map.
 	Latitude  *float64 `gorm:"column:latitude;type:numeric(9,6)"`
 	Longitude *float64 `gorm:"column:longitude;type:numeric(9,6)"`
 	Timezone  *string  `gorm:"column:timezone"`
+	// TimezoneManual is set when an admin chose Timezone by hand; geocoding
+	// then leaves Timezone alone until the override is cleared.
+	TimezoneManual bool `gorm:"column:timezone_manual;not null;default:false"`
+	// GeocodePrecision is GeocodePrecisionCity for the offline centroid or
+	// GeocodePrecisionAddress once the address geocoder refines the point.
+	// NULL when Latitude/Longitude are NULL.
+	GeocodePrecision *string `json:"-" gorm:"column:geocode_precision;size:16"`
 	// Metro is the US Census CBSA code the venue's (city, state, country) rolls up
 	// to, set alongside the geocoding in applyGeocoding. DERIVED; NULL on a miss.
 	// Internal grouping key, not exposed in the API. (PSY-1255 step B)
@@ -22,5 +22,13 @@ var VenueAllowedEditFields = map[string]bool{
 	"spotify":     true,
 	"soundcloud":  true,
 	"bandcamp":    true,
+	"tiktok":      true,
 	"website":     true,
+
+	// Operating info. The suggest-edit handler normalizes these values to
+	// their column types (shared.NormalizeFieldChangeValue).
+	"capacity":            true,
+	"accessibility_notes": true,
+	"all_ages":            true,
+	"door_time":           true,
 }
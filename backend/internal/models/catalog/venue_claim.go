@@ -0,0 +1,60 @@
+package catalog
+
+import (
+	"time"
+
+	"psychic-homily-backend/internal/models/auth"
+)
+
+// VenueClaimStatus is the review state of a venue claim.
+type VenueClaimStatus string
+
+const (
+	VenueClaimStatusPending  VenueClaimStatus = "pending"
+	VenueClaimStatusApproved VenueClaimStatus = "approved"
+	VenueClaimStatusDenied   VenueClaimStatus = "denied"
+)
+
+// VenueClaim is a request from venue staff to manage a venue. Approving it
+// creates a VenueManager row.
+type VenueClaim struct {
+	ID         uint             `gorm:"primaryKey"`
+	VenueID    uint             `gorm:"column:venue_id;not null"`
+	UserID     uint             `gorm:"column:user_id;not null"`
+	Evidence   string           `gorm:"not null"`
+	Status     VenueClaimStatus `gorm:"not null;default:'pending'"`
+	ReviewedBy *uint            `gorm:"column:reviewed_by"`
+	ReviewedAt *time.Time       `gorm:"column:reviewed_at"`
+	ReviewNote *string          `gorm:"column:review_note"`
+	CreatedAt  time.Time        `gorm:"not null"`
+	UpdatedAt  time.Time        `gorm:"not null"`
+
+	// Relationships
+	Venue    Venue      `gorm:"foreignKey:VenueID"`
+	User     auth.User  `gorm:"foreignKey:UserID"`
+	Reviewer *auth.User `gorm:"foreignKey:ReviewedBy"`
+}
+
+// TableName specifies the table name for VenueClaim
+func (VenueClaim) TableName() string {
+	return "venue_claims"
+}
+
+// VenueManager grants a user edit rights on one venue and its shows' status
+// flags.
+type VenueManager struct {
+	VenueID   uint      `gorm:"primaryKey;column:venue_id"`
+	UserID    uint      `gorm:"primaryKey;column:user_id"`
+	ClaimID   *uint     `gorm:"column:claim_id"`
+	GrantedBy *uint     `gorm:"column:granted_by"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	// Relationships
+	Venue Venue     `gorm:"foreignKey:VenueID"`
+	User  auth.User `gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for VenueManager
+func (VenueManager) TableName() string {
+	return "venue_managers"
+}
@@ -25,6 +25,7 @@ const (
 	EntityReportEntityCollection = "collection"
 	EntityReportEntityRelease    = "release"
 	EntityReportEntityLabel      = "label"
+	EntityReportEntityShowUpdate = "show_update"
 )
 
 // Valid report types per entity type.
@@ -111,6 +112,15 @@ var validReportTypes = map[string]map[string]bool{
 		"wrong_image":  true,
 		"missing_info": true,
 	},
+	// Show updates are short posts on a show page, so they share the
+	// comment taxonomy.
+	EntityReportEntityShowUpdate: {
+		"spam":       true,
+		"harassment": true,
+		"off_topic":  true,
+		"inaccurate": true,
+		"other":      true,
+	},
 }
 
 // EntityReport represents a user report about an entity issue.
@@ -146,6 +156,7 @@ func ValidEntityReportEntityTypes() []string {
 		EntityReportEntityCollection,
 		EntityReportEntityRelease,
 		EntityReportEntityLabel,
+		EntityReportEntityShowUpdate,
 	}
 }
 
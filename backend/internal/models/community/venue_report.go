@@ -0,0 +1,42 @@
+package community
+
+import (
+	"time"
+
+	"psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/models/catalog"
+)
+
+// VenueReportType represents the type of issue being reported
+type VenueReportType string
+
+const (
+	VenueReportTypeInaccurate     VenueReportType = "inaccurate"
+	VenueReportTypeClosed         VenueReportType = "closed"
+	VenueReportTypeRemovalRequest VenueReportType = "removal_request"
+)
+
+// VenueReport represents a user report about a venue issue
+type VenueReport struct {
+	ID         uint             `gorm:"primaryKey"`
+	VenueID    uint             `gorm:"not null"`
+	ReportedBy uint             `gorm:"column:reported_by;not null"`
+	ReportType VenueReportType  `gorm:"type:venue_report_type;not null"`
+	Details    *string          `gorm:"column:details"`
+	Status     ShowReportStatus `gorm:"type:show_report_status;not null;default:'pending'"`
+	AdminNotes *string          `gorm:"column:admin_notes"`
+	ReviewedBy *uint            `gorm:"column:reviewed_by"`
+	ReviewedAt *time.Time       `gorm:"column:reviewed_at"`
+	CreatedAt  time.Time        `gorm:"not null"`
+	UpdatedAt  time.Time        `gorm:"not null"`
+
+	// Relationships
+	Venue    catalog.Venue `gorm:"foreignKey:VenueID"`
+	Reporter *auth.User    `gorm:"foreignKey:ReportedBy"`
+	Reviewer *auth.User    `gorm:"foreignKey:ReviewedBy"`
+}
+
+// TableName specifies the table name for VenueReport
+func (VenueReport) TableName() string {
+	return "venue_reports"
+}
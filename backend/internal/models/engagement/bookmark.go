@@ -35,6 +35,10 @@ const (
 	BookmarkActionSave     BookmarkAction = "save"
 	BookmarkActionFollow   BookmarkAction = "follow"
 	BookmarkActionBookmark BookmarkAction = "bookmark"
+	// BookmarkActionGoing is a show RSVP ("I'm going"). Unlike a save it is a
+	// public statement: the per-show going count is public, and the user is
+	// listed on the show when their rsvps privacy setting is visible.
+	BookmarkActionGoing BookmarkAction = "going"
 	// BookmarkActionReleaseSave names the release Save/Saved relationship while
 	// preserving compatibility with historical release bookmark rows.
 	BookmarkActionReleaseSave BookmarkAction = BookmarkActionBookmark
@@ -42,13 +46,12 @@ const (
 
 // UserBookmark represents a generic user-entity relationship
 type UserBookmark struct {
-	ID             uint               `gorm:"primaryKey;column:id"`
-	UserID         uint               `gorm:"not null;column:user_id"`
-	EntityType     BookmarkEntityType `gorm:"not null;column:entity_type"`
-	EntityID       uint               `gorm:"not null;column:entity_id"`
-	Action         BookmarkAction     `gorm:"not null;column:action"`
-	CreatedAt      time.Time          `gorm:"not null;column:created_at"`
-	ReminderSentAt *time.Time         `gorm:"column:reminder_sent_at"`
+	ID         uint               `gorm:"primaryKey;column:id"`
+	UserID     uint               `gorm:"not null;column:user_id"`
+	EntityType BookmarkEntityType `gorm:"not null;column:entity_type"`
+	EntityID   uint               `gorm:"not null;column:entity_id"`
+	Action     BookmarkAction     `gorm:"not null;column:action"`
+	CreatedAt  time.Time          `gorm:"not null;column:created_at"`
 	// Settings holds follow-scoped preferences (PSY-1341, +off in PSY-1466).
 	// First key: "scene_notify_mode" — "all" (default when absent),
 	// "followed_bands_only", or "off" for scene follows' new-show
@@ -0,0 +1,26 @@
+package notification
+
+import "time"
+
+// Email suppression reasons
+const (
+	EmailSuppressionBounce    = "bounce"
+	EmailSuppressionComplaint = "complaint"
+	EmailSuppressionManual    = "manual"
+)
+
+// EmailSuppression is an address no email is sent to, recorded from a
+// provider's bounce/complaint webhook or by hand. Email is lowercased.
+type EmailSuppression struct {
+	ID        uint      `gorm:"primaryKey"`
+	Email     string    `gorm:"column:email;uniqueIndex;not null;size:320"`
+	Reason    string    `gorm:"column:reason;not null;size:20"`
+	Provider  string    `gorm:"column:provider;not null;default:'';size:20"`
+	Detail    *string   `gorm:"column:detail"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+}
+
+// TableName specifies the table name for EmailSuppression
+func (EmailSuppression) TableName() string {
+	return "email_suppressions"
+}
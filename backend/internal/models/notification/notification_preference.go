@@ -0,0 +1,19 @@
+package notification
+
+import "time"
+
+// NotificationPreference is one cell of a user's notification preference
+// matrix: whether events of EventType are delivered over Channel. Cells the
+// user hasn't set have no row and take the service default.
+type NotificationPreference struct {
+	UserID    uint      `gorm:"column:user_id;primaryKey"`
+	EventType string    `gorm:"column:event_type;primaryKey;size:40"`
+	Channel   string    `gorm:"column:channel;primaryKey;size:16"`
+	Enabled   bool      `gorm:"column:enabled;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName specifies the table name for NotificationPreference
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
@@ -0,0 +1,22 @@
+package notification
+
+import "time"
+
+// PushSubscription is one browser's Web Push subscription: the push service
+// endpoint plus the keys (base64url, as the browser reports them) messages
+// for it are encrypted with.
+type PushSubscription struct {
+	ID            uint       `gorm:"primaryKey"`
+	UserID        uint       `gorm:"column:user_id;not null"`
+	Endpoint      string     `gorm:"column:endpoint;not null"`
+	P256dh        string     `gorm:"column:p256dh;not null;size:128"`
+	Auth          string     `gorm:"column:auth;not null;size:64"`
+	UserAgent     *string    `gorm:"column:user_agent;size:512"`
+	CreatedAt     time.Time  `gorm:"column:created_at;not null"`
+	LastSuccessAt *time.Time `gorm:"column:last_success_at"`
+}
+
+// TableName specifies the table name for PushSubscription
+func (PushSubscription) TableName() string {
+	return "push_subscriptions"
+}
@@ -0,0 +1,21 @@
+package notification
+
+import "time"
+
+// SentNotification records one notification delivered to a user about an
+// entity over a channel. Scheduled senders check it before sending so each
+// (user, type, entity, channel) goes out at most once.
+type SentNotification struct {
+	ID               uint      `gorm:"primaryKey"`
+	UserID           uint      `gorm:"column:user_id;not null"`
+	NotificationType string    `gorm:"column:notification_type;not null;size:64"`
+	EntityType       string    `gorm:"column:entity_type;not null;size:32"`
+	EntityID         uint      `gorm:"column:entity_id;not null"`
+	Channel          string    `gorm:"column:channel;not null;size:16"`
+	SentAt           time.Time `gorm:"column:sent_at;not null"`
+}
+
+// TableName specifies the table name for SentNotification
+func (SentNotification) TableName() string {
+	return "sent_notifications"
+}
@@ -1,4 +1,4 @@
-package main
+package seed
 
 import (
 	"encoding/json"
@@ -16,7 +16,7 @@ import (
 
 // Rich exemplar seed (PSY-665).
 //
-// The minimum-viable dev seed (cmd/seed/main.go) leaves most optional fields
+// The minimum-viable dev seed (seed.go) leaves most optional fields
 // NULL/empty, so rich-data render paths (About sections, Listen/Buy grids,
 // social-link rows, tag clouds, multi-day festival lineups) are untestable
 // locally and agents can't visually verify them during repro. This file adds
@@ -582,14 +582,16 @@ func seedExemplarShow(db *gorm.DB, userID, headlinerID, venueID uint) uint {
 	}
 
 	eventDate := time.Now().Add(21 * 24 * time.Hour).UTC()
-	price := 22.0
+	price, doorPrice := 22.0, 25.0
 	show := &catalogm.Show{
 		Title:          "Marissa Nadler (Exemplar) + guests at The Rhythm Room",
 		Slug:           strptr(exemplarShowSlug),
 		EventDate:      eventDate,
 		City:           strptr("Phoenix"),
 		State:          strptr("AZ"),
-		Price:          &price,
+		PriceMin:       &price,
+		PriceMax:       &doorPrice,
+		PriceCurrency:  "USD",
 		AgeRequirement: strptr("21+"),
 		Description:    strptr("An evening of dream-folk and experimental sounds with a five-act bill spanning headliner through host. Doors at 7, music at 8.\n\nSeeded as the PSY-665 rich show exemplar: description, flyer image, age requirement, ticket URL, tags, and a multi-artist bill with full set_type variety so the show detail page renders the lineup with role labels and every metadata field."),
 		Status:         catalogm.ShowStatusApproved,
@@ -0,0 +1,278 @@
+package seed
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/utils"
+)
+
+// ExportOptions says where Export writes.
+type ExportOptions struct {
+	// Dir holds content/shows and data, as the repository root does.
+	// Defaults to "..", the repository root seen from the backend directory.
+	Dir string
+	// DryRun reports what would change without writing or removing files.
+	DryRun bool
+}
+
+// ExportReport counts the files Export wrote. Unchanged files (equal apart
+// from their exported_at stamp) are left as they are, so an export of an
+// unchanged catalog leaves git clean.
+type ExportReport struct {
+	Shows, UnchangedShows, RemovedShows int
+	Venues, Artists                     int
+	DataFilesWritten                    int
+}
+
+// exportedAtLine matches the timestamp ExportShowToMarkdown stamps on every
+// file, ignored when comparing with what is on disk.
+var exportedAtLine = regexp.MustCompile(`(?m)^exported_at: .*\n`)
+
+// Export writes the catalog back to the Hugo content layout Run reads:
+// every approved show as content/shows/<file>.md in the ExportShowToMarkdown
+// format, and every venue and artist to data/venues.yaml and data/bands.yaml.
+// The show directory becomes a snapshot: other .md files in it (except
+// _index.md) are removed, so shows that were deleted or unapproved drop out
+// of the export.
+func Export(db *gorm.DB, opts ExportOptions) (*ExportReport, error) {
+	if opts.Dir == "" {
+		opts.Dir = ".."
+	}
+	showDir := filepath.Join(opts.Dir, "content", "shows")
+	dataDir := filepath.Join(opts.Dir, "data")
+	if !opts.DryRun {
+		for _, dir := range []string{showDir, dataDir} {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return nil, err
+			}
+		}
+	}
+	report := &ExportReport{}
+
+	fmt.Println("Exporting shows...")
+	var showIDs []uint
+	if err := db.Model(&catalogm.Show{}).Where("status = ?", catalogm.ShowStatusApproved).
+		Order("event_date, id").Pluck("id", &showIDs).Error; err != nil {
+		return nil, fmt.Errorf("list approved shows: %w", err)
+	}
+	showService := catalog.NewShowService(db)
+	keep := map[string]bool{"_index.md": true}
+	for _, id := range showIDs {
+		content, filename, err := showService.ExportShowToMarkdown(id)
+		if err != nil {
+			return report, fmt.Errorf("export show %d: %w", id, err)
+		}
+		if keep[filename] {
+			filename = strings.TrimSuffix(filename, ".md") + fmt.Sprintf("-%d.md", id)
+		}
+		keep[filename] = true
+
+		path := filepath.Join(showDir, filename)
+		if old, err := os.ReadFile(path); err == nil &&
+			bytes.Equal(exportedAtLine.ReplaceAll(old, nil), exportedAtLine.ReplaceAll(content, nil)) {
+			report.UnchangedShows++
+			continue
+		}
+		report.Shows++
+		if !opts.DryRun {
+			if err := os.WriteFile(path, content, 0o644); err != nil {
+				return report, err
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(showDir)
+	if err != nil && !os.IsNotExist(err) {
+		return report, err
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".md") || keep[e.Name()] {
+			continue
+		}
+		report.RemovedShows++
+		if !opts.DryRun {
+			if err := os.Remove(filepath.Join(showDir, e.Name())); err != nil {
+				return report, err
+			}
+		}
+	}
+	fmt.Printf("✅ Shows: %d written, %d unchanged, %d removed\n", report.Shows, report.UnchangedShows, report.RemovedShows)
+
+	fmt.Println("Exporting venues and artists...")
+	venues, err := exportVenues(db)
+	if err != nil {
+		return report, err
+	}
+	artists, err := exportArtists(db)
+	if err != nil {
+		return report, err
+	}
+	report.Venues, report.Artists = len(venues), len(artists)
+	for name, data := range map[string]yaml.MapSlice{"venues.yaml": venues, "bands.yaml": artists} {
+		written, err := writeDataFile(filepath.Join(dataDir, name), data, opts.DryRun)
+		if err != nil {
+			return report, err
+		}
+		if written {
+			report.DataFilesWritten++
+		}
+	}
+	fmt.Printf("✅ %d venues, %d artists (%d data files changed)\n", report.Venues, report.Artists, report.DataFilesWritten)
+
+	if opts.DryRun {
+		fmt.Println("DRY RUN — no files written.")
+	}
+	return report, nil
+}
+
+// exportVenues returns venues.yaml's entries, keyed by Hugo slug.
+func exportVenues(db *gorm.DB) (yaml.MapSlice, error) {
+	var venues []catalogm.Venue
+	if err := db.Order("LOWER(name), LOWER(city), id").Find(&venues).Error; err != nil {
+		return nil, fmt.Errorf("list venues: %w", err)
+	}
+	keys := map[string]bool{}
+	out := make(yaml.MapSlice, 0, len(venues))
+	for _, v := range venues {
+		entry := VenueData{
+			Name:    v.Name,
+			Address: derefString(v.Address),
+			City:    v.City,
+			State:   v.State,
+			Zip:     derefString(v.Zipcode),
+		}
+		entry.Social.Instagram = instagramHandle(derefString(v.Social.Instagram))
+		entry.Social.Website = derefString(v.Social.Website)
+		key := uniqueKey(keys, hugoSlug(v.Name), hugoSlug(v.Name+" "+v.City), v.ID)
+		out = append(out, yaml.MapItem{Key: key, Value: entry})
+	}
+	return out, nil
+}
+
+// exportArtists returns bands.yaml's entries, keyed by Hugo slug.
+func exportArtists(db *gorm.DB) (yaml.MapSlice, error) {
+	var artists []catalogm.Artist
+	if err := db.Order("LOWER(name), id").Find(&artists).Error; err != nil {
+		return nil, fmt.Errorf("list artists: %w", err)
+	}
+	keys := map[string]bool{}
+	out := make(yaml.MapSlice, 0, len(artists))
+	for _, a := range artists {
+		entry := ArtistData{
+			Name:        a.Name,
+			ArizonaBand: a.State != nil && *a.State == "AZ",
+		}
+		entry.Social.Instagram = instagramHandle(derefString(a.Social.Instagram))
+		entry.Social.Website = derefString(a.Social.Website)
+		key := uniqueKey(keys, hugoSlug(a.Name), hugoSlug(a.Name), a.ID)
+		out = append(out, yaml.MapItem{Key: key, Value: entry})
+	}
+	return out, nil
+}
+
+// writeDataFile writes entries as YAML unless the file already holds
+// exactly that. It reports whether the file changed.
+func writeDataFile(path string, entries yaml.MapSlice, dryRun bool) (bool, error) {
+	data, err := yaml.Marshal(entries)
+	if err != nil {
+		return false, fmt.Errorf("marshal %s: %w", filepath.Base(path), err)
+	}
+	if old, err := os.ReadFile(path); err == nil && bytes.Equal(old, data) {
+		return false, nil
+	}
+	if dryRun {
+		return true, nil
+	}
+	return true, os.WriteFile(path, data, 0o644)
+}
+
+// hugoSlug is the Hugo content's slug for a name: lowercased, spaces as
+// hyphens ("Where's Lucy?" -> "where's-lucy?"). findVenueBySlug and
+// findArtistBySlug resolve it back to the name.
+func hugoSlug(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), "-"))
+}
+
+// uniqueKey returns key, or fallback when key is taken, or fallback with
+// the row ID when both are.
+func uniqueKey(taken map[string]bool, key, fallback string, id uint) string {
+	for _, k := range []string{key, fallback, fmt.Sprintf("%s-%d", fallback, id)} {
+		if !taken[k] {
+			taken[k] = true
+			return k
+		}
+	}
+	return key // unreachable: IDs are unique
+}
+
+// instagramHandle returns the handle of an instagram.com profile URL, the
+// form the Hugo data files keep (the seed turns it back into the URL); any
+// other value is returned unchanged.
+func instagramHandle(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	if host := strings.ToLower(u.Hostname()); host != "instagram.com" && host != "www.instagram.com" {
+		return link
+	}
+	handle := strings.Trim(u.Path, "/")
+	if handle == "" || strings.Contains(handle, "/") {
+		return link
+	}
+	return handle
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// exportedShowData maps a show file in the ExportShowToMarkdown format (as
+// Export writes) to ShowData, so an exported content directory seeds like
+// the hand-written one. Venues and artists are referenced by Hugo slug;
+// shows that aren't approved are treated as drafts.
+func exportedShowData(fm contracts.ExportFrontmatter) (ShowData, error) {
+	eventDate, err := time.Parse(time.RFC3339, fm.Show.EventDate)
+	if err != nil {
+		return ShowData{}, fmt.Errorf("failed to parse event date: %w", err)
+	}
+	show := ShowData{
+		Title:          fm.Show.Title,
+		Date:           fm.ExportedAt,
+		EventDate:      eventDate.Format("2006-01-02T15:04:05-07:00"),
+		Draft:          fm.Show.Status != "" && fm.Show.Status != string(catalogm.ShowStatusApproved),
+		City:           fm.Show.City,
+		State:          fm.Show.State,
+		Price:          utils.FormatPrice(fm.Show.PriceMin, fm.Show.PriceMax, fm.Show.PriceCurrency, fm.Show.IsFree),
+		AgeRequirement: fm.Show.AgeRequirement,
+	}
+	if show.Price == "" && fm.Show.Price != nil {
+		show.Price = utils.FormatPrice(fm.Show.Price, nil, fm.Show.PriceCurrency, *fm.Show.Price == 0)
+	}
+	for _, v := range fm.Venues {
+		show.Venues = append(show.Venues, hugoSlug(v.Name))
+	}
+	artists := append([]contracts.ExportArtistData(nil), fm.Artists...)
+	sort.SliceStable(artists, func(i, j int) bool { return artists[i].Position < artists[j].Position })
+	for _, a := range artists {
+		show.Bands = append(show.Bands, hugoSlug(a.Name))
+	}
+	return show, nil
+}
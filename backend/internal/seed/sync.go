@@ -0,0 +1,415 @@
+package seed
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/utils"
+)
+
+// SyncCounts tallies one entity type in a Sync.
+type SyncCounts struct {
+	Created, Updated, Unchanged, Failed int
+}
+
+func (c SyncCounts) String() string {
+	s := fmt.Sprintf("%d created, %d updated, %d unchanged", c.Created, c.Updated, c.Unchanged)
+	if c.Failed > 0 {
+		s += fmt.Sprintf(", %d failed", c.Failed)
+	}
+	return s
+}
+
+// SyncReport counts what Sync did.
+type SyncReport struct {
+	Venues, Artists, Shows SyncCounts
+}
+
+// Sync brings the database in line with the Hugo content (data/venues.yaml,
+// data/bands.yaml and content/shows), keeping it the source of truth: rows
+// missing from the database are created as Run would, and existing ones are
+// updated where the content differs. Venues match on name and city, artists
+// on name, and shows on slug, falling back to a show at the same time at one
+// of the same venues (a changed headliner changes the slug, which is left
+// alone so links keep working).
+//
+// For shows Sync updates the title, price, age requirement, city and state,
+// the venue associations, and the lineup (set times of artists still on it
+// are kept). Rows that aren't in the content are never deleted. Labels,
+// radio, users and exemplars aren't touched; Run seeds those.
+func Sync(db *gorm.DB) (*SyncReport, error) {
+	report := &SyncReport{}
+
+	fmt.Println("Syncing venues...")
+	for _, venue := range getVenueData() {
+		changed, created, err := syncVenue(db, venue)
+		report.Venues.tally(changed, created, err)
+		if err != nil {
+			log.Printf("❌ Failed to sync venue '%s': %v", venue.Name, err)
+		}
+	}
+	fmt.Printf("✅ Venues: %s\n", report.Venues)
+
+	fmt.Println("Syncing artists...")
+	for _, artist := range getArtistData() {
+		changed, created, err := syncArtist(db, artist)
+		report.Artists.tally(changed, created, err)
+		if err != nil {
+			log.Printf("❌ Failed to sync artist '%s': %v", artist.Name, err)
+		}
+	}
+	fmt.Printf("✅ Artists: %s\n", report.Artists)
+
+	fmt.Println("Syncing shows...")
+	for _, show := range getShowData() {
+		if show.Draft {
+			continue
+		}
+		changed, created, err := syncShow(db, show)
+		report.Shows.tally(changed, created, err)
+		switch {
+		case err != nil:
+			log.Printf("❌ Failed to sync show '%s': %v", show.Title, err)
+		case created:
+			fmt.Printf("✅ Created show: %s\n", show.Title)
+		case len(changed) > 0:
+			fmt.Printf("✏️  Updated show: %s (%v)\n", show.Title, changed)
+		}
+	}
+	fmt.Printf("✅ Shows: %s\n", report.Shows)
+
+	if report.Venues.Failed+report.Artists.Failed+report.Shows.Failed > 0 {
+		return report, fmt.Errorf("%d venues, %d artists and %d shows failed to sync",
+			report.Venues.Failed, report.Artists.Failed, report.Shows.Failed)
+	}
+	return report, nil
+}
+
+func (c *SyncCounts) tally(changed []string, created bool, err error) {
+	switch {
+	case err != nil:
+		c.Failed++
+	case created:
+		c.Created++
+	case len(changed) > 0:
+		c.Updated++
+	default:
+		c.Unchanged++
+	}
+}
+
+// syncVenue creates or updates one venue in its own transaction, so a
+// failure doesn't abort the rest of the sync. It returns the changed columns.
+func syncVenue(db *gorm.DB, venue VenueData) (changed []string, created bool, err error) {
+	err = db.Transaction(func(tx *gorm.DB) error {
+		changed, created, err = syncVenueTx(tx, venue)
+		return err
+	})
+	return changed, created, err
+}
+
+func syncVenueTx(db *gorm.DB, venue VenueData) (changed []string, created bool, err error) {
+	var existing catalogm.Venue
+	err = db.Where("LOWER(name) = LOWER(?) AND LOWER(city) = LOWER(?)", venue.Name, venue.City).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		slug := utils.GenerateVenueSlug(venue.Name, venue.City, venue.State)
+		v := &catalogm.Venue{
+			Name:    venue.Name,
+			Slug:    &slug,
+			Address: &venue.Address,
+			City:    venue.City,
+			State:   venue.State,
+			Zipcode: &venue.Zip,
+			Social: catalogm.Social{
+				Instagram: &venue.Social.Instagram,
+				Website:   &venue.Social.Website,
+			},
+		}
+		return nil, true, db.Create(v).Error
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	updates := map[string]any{}
+	diffString(updates, "address", existing.Address, venue.Address)
+	diffString(updates, "zipcode", existing.Zipcode, venue.Zip)
+	diffString(updates, "instagram", existing.Social.Instagram, venue.Social.Instagram)
+	diffString(updates, "website", existing.Social.Website, venue.Social.Website)
+	if existing.State != venue.State {
+		updates["state"] = venue.State
+	}
+	if len(updates) == 0 {
+		return nil, false, nil
+	}
+	return sortedKeys(updates), false, db.Model(&existing).Updates(updates).Error
+}
+
+// syncArtist creates or updates one artist, like syncVenue.
+func syncArtist(db *gorm.DB, artist ArtistData) (changed []string, created bool, err error) {
+	err = db.Transaction(func(tx *gorm.DB) error {
+		changed, created, err = syncArtistTx(tx, artist)
+		return err
+	})
+	return changed, created, err
+}
+
+func syncArtistTx(db *gorm.DB, artist ArtistData) (changed []string, created bool, err error) {
+	var state string
+	if artist.ArizonaBand {
+		state = "AZ"
+	}
+
+	var existing catalogm.Artist
+	err = db.Where("LOWER(name) = LOWER(?)", artist.Name).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		slug := utils.GenerateArtistSlug(artist.Name)
+		a := &catalogm.Artist{
+			Name: artist.Name,
+			Slug: &slug,
+			Social: catalogm.Social{
+				Instagram: &artist.Social.Instagram,
+				Website:   &artist.Social.Website,
+			},
+		}
+		if state != "" {
+			a.State = &state
+		}
+		return nil, true, db.Create(a).Error
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	updates := map[string]any{}
+	diffString(updates, "instagram", existing.Social.Instagram, artist.Social.Instagram)
+	diffString(updates, "website", existing.Social.Website, artist.Social.Website)
+	// Only the Arizona flag lives in bands.yaml: set AZ, or clear an AZ it
+	// no longer claims, but leave states set elsewhere alone.
+	switch {
+	case state != "" && (existing.State == nil || *existing.State != state):
+		updates["state"] = state
+	case state == "" && existing.State != nil && *existing.State == "AZ":
+		updates["state"] = nil
+	}
+	if len(updates) == 0 {
+		return nil, false, nil
+	}
+	return sortedKeys(updates), false, db.Model(&existing).Updates(updates).Error
+}
+
+// syncShow creates or updates one show with its venues and lineup. It
+// returns what changed: column names, "venues" and "lineup".
+func syncShow(db *gorm.DB, showData ShowData) (changed []string, created bool, err error) {
+	want, err := buildShow(showData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		venueIDs, primaryVenueID, err := resolveShowVenues(tx, showData)
+		if err != nil {
+			return err
+		}
+		existing, err := findSyncedShow(tx, want, venueIDs)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			created = true
+			return nil
+		}
+
+		updates := map[string]any{}
+		if existing.Title != want.Title {
+			updates["title"] = want.Title
+		}
+		diffString(updates, "city", existing.City, *want.City)
+		diffString(updates, "state", existing.State, *want.State)
+		diffString(updates, "age_requirement", existing.AgeRequirement, *want.AgeRequirement)
+		if !equalFloat(existing.PriceMin, want.PriceMin) {
+			updates["price_min"] = want.PriceMin
+		}
+		if !equalFloat(existing.PriceMax, want.PriceMax) {
+			updates["price_max"] = want.PriceMax
+		}
+		if existing.PriceCurrency != want.PriceCurrency {
+			updates["price_currency"] = want.PriceCurrency
+		}
+		if existing.IsFree != want.IsFree {
+			updates["is_free"] = want.IsFree
+		}
+		if len(updates) > 0 {
+			if err := tx.Model(existing).Updates(updates).Error; err != nil {
+				return fmt.Errorf("failed to update show: %w", err)
+			}
+			changed = sortedKeys(updates)
+		}
+
+		venuesChanged, err := syncShowVenues(tx, existing.ID, venueIDs)
+		if err != nil {
+			return err
+		}
+		if venuesChanged {
+			changed = append(changed, "venues")
+		}
+
+		artistIDs, err := resolveShowLineup(tx, showData)
+		if err != nil {
+			return err
+		}
+		lineupChanged, err := syncShowLineup(tx, existing, artistIDs, primaryVenueID, venuesChanged)
+		if err != nil {
+			return err
+		}
+		if lineupChanged {
+			changed = append(changed, "lineup")
+		}
+		return nil
+	})
+	if err != nil || !created {
+		return changed, false, err
+	}
+	return nil, true, createShowWithAssociations(db, showData)
+}
+
+// findSyncedShow finds the database row for a Hugo show: by slug, else the
+// show at the same time at one of its venues. It returns nil when there is
+// none.
+func findSyncedShow(tx *gorm.DB, want *catalogm.Show, venueIDs []uint) (*catalogm.Show, error) {
+	var show catalogm.Show
+	err := tx.Where("slug = ?", *want.Slug).First(&show).Error
+	if err == nil {
+		return &show, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if len(venueIDs) == 0 {
+		return nil, nil
+	}
+	err = tx.Where("event_date = ? AND id IN (?)", want.EventDate,
+		tx.Model(&catalogm.ShowVenue{}).Select("show_id").Where("venue_id IN ?", venueIDs)).
+		Order("id").First(&show).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &show, nil
+}
+
+// syncShowVenues replaces a show's venues when they differ from venueIDs.
+func syncShowVenues(tx *gorm.DB, showID uint, venueIDs []uint) (bool, error) {
+	var current []uint
+	if err := tx.Model(&catalogm.ShowVenue{}).Where("show_id = ?", showID).Pluck("venue_id", &current).Error; err != nil {
+		return false, err
+	}
+	if sameIDSet(current, venueIDs) {
+		return false, nil
+	}
+	if err := tx.Where("show_id = ?", showID).Delete(&catalogm.ShowVenue{}).Error; err != nil {
+		return false, fmt.Errorf("failed to clear show venues: %w", err)
+	}
+	for _, venueID := range venueIDs {
+		if err := tx.Create(&catalogm.ShowVenue{ShowID: showID, VenueID: venueID}).Error; err != nil {
+			return false, fmt.Errorf("failed to create show-venue association: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// syncShowLineup rewrites a show's lineup when the artists, their order or
+// set types differ, or when the venues changed (show_artists.venue_id
+// denormalizes the primary venue, PSY-576). Set times of artists still on
+// the bill are kept.
+func syncShowLineup(tx *gorm.DB, show *catalogm.Show, artistIDs []uint, primaryVenueID *uint, venuesChanged bool) (bool, error) {
+	var current []catalogm.ShowArtist
+	if err := tx.Where("show_id = ?", show.ID).Order("position").Find(&current).Error; err != nil {
+		return false, err
+	}
+	same := !venuesChanged && len(current) == len(artistIDs)
+	for i := 0; same && i < len(current); i++ {
+		same = current[i].ArtistID == artistIDs[i] && current[i].SetType == lineupSetType(i)
+	}
+	if same {
+		return false, nil
+	}
+
+	setTimes := map[uint]*catalogm.ShowArtist{}
+	for i := range current {
+		setTimes[current[i].ArtistID] = &current[i]
+	}
+	if err := tx.Where("show_id = ?", show.ID).Delete(&catalogm.ShowArtist{}).Error; err != nil {
+		return false, fmt.Errorf("failed to clear show lineup: %w", err)
+	}
+	eventDate := show.EventDate
+	for position, artistID := range artistIDs {
+		link := catalogm.ShowArtist{
+			ShowID:    show.ID,
+			ArtistID:  artistID,
+			Position:  position,
+			SetType:   lineupSetType(position),
+			EventDate: &eventDate,
+			VenueID:   primaryVenueID,
+		}
+		if prev, ok := setTimes[artistID]; ok {
+			link.SetTime = prev.SetTime
+		}
+		if err := tx.Create(&link).Error; err != nil {
+			return false, fmt.Errorf("failed to create show-artist association: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// diffString records column = want when the stored value differs. Empty
+// content and NULL compare equal, and an empty value is written as NULL.
+func diffString(updates map[string]any, column string, have *string, want string) {
+	var cur string
+	if have != nil {
+		cur = *have
+	}
+	if cur == want {
+		return
+	}
+	if want == "" {
+		updates[column] = nil
+		return
+	}
+	updates[column] = want
+}
+
+func equalFloat(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func sameIDSet(a, b []uint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !containsUint(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
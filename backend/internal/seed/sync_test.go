@@ -0,0 +1,139 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+type SyncIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+}
+
+func (s *SyncIntegrationTestSuite) SetupSuite() {
+	s.testDB = testutil.SharedTestPostgres(s.T())
+}
+
+func (s *SyncIntegrationTestSuite) SetupTest() {
+	s.db = testutil.BeginTestTx(s.T(), s.testDB.DB)
+	for _, v := range []VenueData{
+		{Name: "Sync Test Hall", City: "Phoenix", State: "AZ"},
+		{Name: "Sync Test Annex", City: "Phoenix", State: "AZ"},
+	} {
+		_, created, err := syncVenue(s.db, v)
+		s.Require().NoError(err)
+		s.Require().True(created)
+	}
+	for _, name := range []string{"Syncband Alpha", "Syncband Beta", "Syncband Gamma"} {
+		_, created, err := syncArtist(s.db, ArtistData{Name: name})
+		s.Require().NoError(err)
+		s.Require().True(created)
+	}
+}
+
+func TestSyncIntegration(t *testing.T) {
+	suite.Run(t, new(SyncIntegrationTestSuite))
+}
+
+func (s *SyncIntegrationTestSuite) show() ShowData {
+	return ShowData{
+		Title:          "Syncband Alpha at Sync Test Hall",
+		EventDate:      "2026-05-01T20:00:00-07:00",
+		Venues:         []string{"sync-test-hall"},
+		City:           "Phoenix",
+		State:          "AZ",
+		Price:          "$12",
+		AgeRequirement: "21+",
+		Bands:          []string{"syncband-alpha", "syncband-beta"},
+	}
+}
+
+func (s *SyncIntegrationTestSuite) lineup(showID uint) []uint {
+	var ids []uint
+	s.Require().NoError(s.db.Model(&catalogm.ShowArtist{}).Where("show_id = ?", showID).
+		Order("position").Pluck("artist_id", &ids).Error)
+	return ids
+}
+
+func (s *SyncIntegrationTestSuite) artistID(name string) uint {
+	var a catalogm.Artist
+	s.Require().NoError(s.db.Where("name = ?", name).First(&a).Error)
+	return a.ID
+}
+
+func (s *SyncIntegrationTestSuite) TestSyncShow_CreatesThenLeavesUnchanged() {
+	changed, created, err := syncShow(s.db, s.show())
+	s.Require().NoError(err)
+	s.True(created)
+	s.Empty(changed)
+
+	changed, created, err = syncShow(s.db, s.show())
+	s.Require().NoError(err)
+	s.False(created)
+	s.Empty(changed, "a second sync of the same content should change nothing")
+}
+
+func (s *SyncIntegrationTestSuite) TestSyncShow_UpdatesPriceLineupAndVenues() {
+	_, _, err := syncShow(s.db, s.show())
+	s.Require().NoError(err)
+	var show catalogm.Show
+	s.Require().NoError(s.db.Where("title = ?", "Syncband Alpha, Syncband Beta at Sync Test Hall").First(&show).Error)
+
+	edited := s.show()
+	edited.Price = "$15-$20"
+	edited.Bands = []string{"syncband-alpha", "syncband-gamma", "syncband-beta"}
+	edited.Venues = []string{"sync-test-hall", "sync-test-annex"}
+	changed, created, err := syncShow(s.db, edited)
+	s.Require().NoError(err)
+	s.False(created)
+	s.ElementsMatch([]string{"title", "price_min", "price_max", "venues", "lineup"}, changed)
+
+	var updated catalogm.Show
+	s.Require().NoError(s.db.First(&updated, show.ID).Error)
+	s.Require().NotNil(updated.PriceMin)
+	s.Require().NotNil(updated.PriceMax)
+	s.Equal(15.0, *updated.PriceMin)
+	s.Equal(20.0, *updated.PriceMax)
+	s.Equal([]uint{s.artistID("Syncband Alpha"), s.artistID("Syncband Gamma"), s.artistID("Syncband Beta")}, s.lineup(show.ID))
+
+	var venues int64
+	s.Require().NoError(s.db.Model(&catalogm.ShowVenue{}).Where("show_id = ?", show.ID).Count(&venues).Error)
+	s.Equal(int64(2), venues)
+}
+
+func (s *SyncIntegrationTestSuite) TestSyncShow_MatchesByVenueAndTimeWhenHeadlinerChanges() {
+	_, _, err := syncShow(s.db, s.show())
+	s.Require().NoError(err)
+	var before int64
+	s.Require().NoError(s.db.Model(&catalogm.Show{}).Count(&before).Error)
+
+	// A new headliner changes the slug; the show is still found by its
+	// venue and time, and keeps its slug.
+	edited := s.show()
+	edited.Bands = []string{"syncband-gamma", "syncband-alpha"}
+	changed, created, err := syncShow(s.db, edited)
+	s.Require().NoError(err)
+	s.False(created)
+	s.Contains(changed, "lineup")
+
+	var after int64
+	s.Require().NoError(s.db.Model(&catalogm.Show{}).Count(&after).Error)
+	s.Equal(before, after)
+}
+
+func (s *SyncIntegrationTestSuite) TestSyncVenue_UpdatesChangedFields() {
+	changed, created, err := syncVenue(s.db, VenueData{Name: "Sync Test Hall", City: "Phoenix", State: "AZ", Address: "1 Main St"})
+	s.Require().NoError(err)
+	s.False(created)
+	s.Equal([]string{"address"}, changed)
+
+	changed, _, err = syncVenue(s.db, VenueData{Name: "sync test hall", City: "phoenix", State: "AZ", Address: "1 Main St"})
+	s.Require().NoError(err)
+	s.Empty(changed, "venues match case-insensitively on name and city")
+}
@@ -0,0 +1,388 @@
+package seed
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/utils"
+)
+
+// SyntheticDataSource is the data_source stamped on every synthetic venue,
+// artist and show, so they can be told apart from (and deleted without
+// touching) real or Hugo-seeded rows.
+const SyntheticDataSource = "synthetic"
+
+// SyntheticOptions sizes a synthetic dataset. The same options always
+// generate the same rows.
+type SyntheticOptions struct {
+	// Seed drives the pseudo-random generator.
+	Seed uint64
+	// Shows is the number of shows to generate.
+	Shows int
+	// Venues and Artists default to Shows/10 and Shows/2 (with floors of 10
+	// and 20) when zero.
+	Venues  int
+	Artists int
+	// Anchor is the day shows are spread around: a quarter fall in the Days
+	// before it, the rest in the Days after. Defaults to today (UTC), which
+	// keeps upcoming-show pages populated but means a rerun on another day
+	// adds a new batch; pin it for byte-identical datasets.
+	Anchor time.Time
+	Days   int
+}
+
+func (o SyntheticOptions) withDefaults() SyntheticOptions {
+	if o.Venues == 0 {
+		o.Venues = max(10, o.Shows/10)
+	}
+	if o.Artists == 0 {
+		o.Artists = max(20, o.Shows/2)
+	}
+	if o.Days == 0 {
+		o.Days = 180
+	}
+	if o.Anchor.IsZero() {
+		o.Anchor = time.Now().UTC()
+	}
+	o.Anchor = time.Date(o.Anchor.Year(), o.Anchor.Month(), o.Anchor.Day(), 0, 0, 0, 0, time.UTC)
+	return o
+}
+
+// SyntheticShow is a generated show with its lineup, as indexes into the
+// dataset's Venues and Artists. Lineup[0] is the headliner.
+type SyntheticShow struct {
+	Show   catalogm.Show
+	Venue  int
+	Lineup []int
+}
+
+// SyntheticData is a generated dataset, not yet written.
+type SyntheticData struct {
+	Venues  []catalogm.Venue
+	Artists []catalogm.Artist
+	Shows   []SyntheticShow
+}
+
+type syntheticCity struct{ City, State string }
+
+var syntheticCities = []syntheticCity{
+	{"Phoenix", "AZ"}, {"Tempe", "AZ"}, {"Mesa", "AZ"}, {"Tucson", "AZ"}, {"Flagstaff", "AZ"},
+	{"Los Angeles", "CA"}, {"San Diego", "CA"}, {"Albuquerque", "NM"}, {"Denver", "CO"},
+	{"Austin", "TX"}, {"Portland", "OR"}, {"Seattle", "WA"}, {"Chicago", "IL"},
+}
+
+var (
+	syntheticAdjectives = []string{
+		"Velvet", "Copper", "Silver", "Hollow", "Electric", "Crimson", "Golden", "Paper",
+		"Neon", "Quiet", "Static", "Desert", "Lunar", "Rusty", "Wild", "Broken", "Glass",
+		"Midnight", "Saguaro", "Feral", "Gentle", "Cosmic", "Faded", "Sunken",
+	}
+	syntheticNouns = []string{
+		"Lantern", "Coyote", "Mirror", "Canyon", "Harbor", "Signal", "Garden", "Orbit",
+		"Tiger", "Fountain", "Anchor", "Comet", "Wolf", "Palace", "River", "Engine",
+		"Ghost", "Meadow", "Raven", "Monsoon", "Mesa", "Arrow", "Echo", "Cactus",
+	}
+	syntheticPluralNouns = []string{
+		"Lanterns", "Coyotes", "Mirrors", "Signals", "Tigers", "Comets", "Wolves",
+		"Ghosts", "Ravens", "Arrows", "Echoes", "Horses", "Satellites", "Saints",
+	}
+	syntheticVenueKinds = []string{
+		"Room", "Hall", "Lounge", "Ballroom", "Tavern", "Theater", "Club", "Bar", "House",
+	}
+	syntheticStreets = []string{
+		"Main St", "Mill Ave", "Central Ave", "Roosevelt St", "Grand Ave", "4th Ave", "Broadway",
+	}
+	syntheticAges = []string{"All Ages", "18+", "21+"}
+)
+
+// GenerateSynthetic builds a dataset from opts without touching a database.
+// It can return fewer shows than asked for when Venues, Artists and Days are
+// too small to hold them.
+func GenerateSynthetic(opts SyntheticOptions) *SyntheticData {
+	opts = opts.withDefaults()
+	// PCG's output is fixed by its spec, so a seed means the same dataset on
+	// every Go version.
+	rng := rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x5eed))
+	data := &SyntheticData{}
+
+	venueNames := map[string]bool{}
+	for i := 0; i < opts.Venues; i++ {
+		city := pick(rng, syntheticCities)
+		name := uniqueName(venueNames, venueName(rng), city.City)
+		address := fmt.Sprintf("%d %s", 100+rng.IntN(4900), pick(rng, syntheticStreets))
+		capacity := 50 + rng.IntN(30)*50
+		data.Venues = append(data.Venues, catalogm.Venue{
+			Name:       name,
+			Slug:       strPtr(utils.GenerateVenueSlug(name, city.City, city.State)),
+			Address:    &address,
+			City:       city.City,
+			State:      city.State,
+			Capacity:   &capacity,
+			Verified:   rng.IntN(10) < 8,
+			DataSource: strPtr(SyntheticDataSource),
+		})
+	}
+
+	artistNames := map[string]bool{}
+	for i := 0; i < opts.Artists; i++ {
+		name := uniqueName(artistNames, artistName(rng), "")
+		var state *string
+		if rng.IntN(3) == 0 {
+			state = strPtr(pick(rng, syntheticCities).State)
+		}
+		data.Artists = append(data.Artists, catalogm.Artist{
+			Name:       name,
+			Slug:       strPtr(utils.GenerateArtistSlug(name)),
+			State:      state,
+			DataSource: strPtr(SyntheticDataSource),
+		})
+	}
+
+	// show_artists has a unique (artist, venue, event_date) index, so an
+	// artist plays a venue at most once per date.
+	type booking struct {
+		artist, venue int
+		date          time.Time
+	}
+	booked := map[booking]bool{}
+	slugs := map[string]bool{}
+
+	// A tiny venue/artist pool can't fill every requested show without
+	// repeating a slug or booking; give up rather than spin.
+	for attempts := 0; len(data.Shows) < opts.Shows && attempts < opts.Shows*20; attempts++ {
+		venueIdx := rng.IntN(len(data.Venues))
+		venue := data.Venues[venueIdx]
+		offset := rng.IntN(opts.Days+opts.Days/3) - opts.Days/3
+		// 8:00–10:30pm in Arizona (UTC-7) the evening of the chosen day.
+		eventDate := opts.Anchor.AddDate(0, 0, offset).Add(27*time.Hour + time.Duration(rng.IntN(6))*30*time.Minute)
+
+		size := 1 + rng.IntN(4)
+		lineup := make([]int, 0, size)
+		for tries := 0; len(lineup) < size && tries < size*4; tries++ {
+			a := rng.IntN(len(data.Artists))
+			key := booking{a, venueIdx, eventDate}
+			if booked[key] || containsInt(lineup, a) {
+				continue
+			}
+			lineup = append(lineup, a)
+		}
+		if len(lineup) == 0 {
+			continue
+		}
+
+		headliner := data.Artists[lineup[0]].Name
+		slug := utils.GenerateShowSlug(eventDate, headliner, venue.Name, venue.State)
+		if slugs[slug] {
+			continue
+		}
+		slugs[slug] = true
+		for _, a := range lineup {
+			booked[booking{a, venueIdx, eventDate}] = true
+		}
+
+		names := make([]string, len(lineup))
+		for i, a := range lineup {
+			names[i] = data.Artists[a].Name
+		}
+		show := catalogm.Show{
+			Title:          fmt.Sprintf("%s at %s", strings.Join(names, ", "), venue.Name),
+			Slug:           &slug,
+			EventDate:      eventDate,
+			City:           strPtr(venue.City),
+			State:          strPtr(venue.State),
+			AgeRequirement: strPtr(pick(rng, syntheticAges)),
+			Status:         catalogm.ShowStatusApproved,
+			Source:         catalogm.ShowSourceUser,
+			DataSource:     strPtr(SyntheticDataSource),
+			PriceCurrency:  "USD",
+		}
+		if rng.IntN(10) == 0 {
+			show.Status = catalogm.ShowStatusPending
+		}
+		if rng.IntN(5) == 0 {
+			show.IsFree = true
+		} else {
+			low := float64(5 + rng.IntN(26))
+			show.PriceMin = &low
+			if rng.IntN(3) == 0 {
+				high := low + float64(3+rng.IntN(8))
+				show.PriceMax = &high
+			}
+		}
+		show.IsSoldOut = rng.IntN(20) == 0
+		show.IsCancelled = !show.IsSoldOut && rng.IntN(50) == 0
+
+		data.Shows = append(data.Shows, SyntheticShow{Show: show, Venue: venueIdx, Lineup: lineup})
+	}
+	return data
+}
+
+func venueName(rng *rand.Rand) string {
+	switch rng.IntN(3) {
+	case 0:
+		return fmt.Sprintf("The %s %s", pick(rng, syntheticAdjectives), pick(rng, syntheticNouns))
+	case 1:
+		return fmt.Sprintf("%s %s", pick(rng, syntheticNouns), pick(rng, syntheticVenueKinds))
+	default:
+		return fmt.Sprintf("The %s %s", pick(rng, syntheticAdjectives), pick(rng, syntheticVenueKinds))
+	}
+}
+
+func artistName(rng *rand.Rand) string {
+	switch rng.IntN(4) {
+	case 0:
+		return fmt.Sprintf("The %s", pick(rng, syntheticPluralNouns))
+	case 1:
+		return fmt.Sprintf("%s %s", pick(rng, syntheticAdjectives), pick(rng, syntheticPluralNouns))
+	case 2:
+		return fmt.Sprintf("%s %s", pick(rng, syntheticNouns), pick(rng, syntheticNouns))
+	default:
+		return fmt.Sprintf("%s %s", pick(rng, syntheticAdjectives), pick(rng, syntheticNouns))
+	}
+}
+
+// uniqueName numbers repeats ("Copper Hall 2"). Names are unique per scope
+// (a city for venues), compared case-insensitively like the DB indexes.
+func uniqueName(seen map[string]bool, name, scope string) string {
+	candidate := name
+	for n := 2; seen[strings.ToLower(candidate+"|"+scope)]; n++ {
+		candidate = fmt.Sprintf("%s %d", name, n)
+	}
+	seen[strings.ToLower(candidate+"|"+scope)] = true
+	return candidate
+}
+
+func pick[T any](rng *rand.Rand, items []T) T {
+	return items[rng.IntN(len(items))]
+}
+
+func containsInt(xs []int, x int) bool {
+	for _, v := range xs {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+func strPtr(s string) *string { return &s }
+
+// SyntheticReport counts the rows RunSynthetic wrote. Rows already present
+// from an earlier run with the same options are counted as Existing.
+type SyntheticReport struct {
+	Venues, Artists, Shows                         int
+	ExistingVenues, ExistingArtists, ExistingShows int
+}
+
+// RunSynthetic generates a dataset and writes it. It is idempotent: venues
+// match on name and city, artists on name, and shows on slug, so rerunning
+// with the same options writes nothing new.
+func RunSynthetic(db *gorm.DB, opts SyntheticOptions) (*SyntheticReport, error) {
+	if opts.Shows < 0 || opts.Venues < 0 || opts.Artists < 0 || opts.Days < 0 {
+		return nil, fmt.Errorf("synthetic counts must not be negative")
+	}
+	data := GenerateSynthetic(opts)
+	report := &SyntheticReport{}
+
+	fmt.Printf("Seeding synthetic data (seed %d): %d venues, %d artists, %d shows...\n",
+		opts.Seed, len(data.Venues), len(data.Artists), len(data.Shows))
+
+	venueIDs := make([]uint, len(data.Venues))
+	for i := range data.Venues {
+		venue := &data.Venues[i]
+		var existing catalogm.Venue
+		err := db.Where("LOWER(name) = LOWER(?) AND LOWER(city) = LOWER(?)", venue.Name, venue.City).First(&existing).Error
+		switch {
+		case err == nil:
+			venueIDs[i] = existing.ID
+			report.ExistingVenues++
+			continue
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return report, fmt.Errorf("look up venue %s: %w", venue.Name, err)
+		}
+		if err := db.Create(venue).Error; err != nil {
+			return report, fmt.Errorf("create venue %s: %w", venue.Name, err)
+		}
+		venueIDs[i] = venue.ID
+		report.Venues++
+	}
+
+	artistIDs := make([]uint, len(data.Artists))
+	for i := range data.Artists {
+		artist := &data.Artists[i]
+		var existing catalogm.Artist
+		err := db.Where("LOWER(name) = LOWER(?)", artist.Name).First(&existing).Error
+		switch {
+		case err == nil:
+			artistIDs[i] = existing.ID
+			report.ExistingArtists++
+			continue
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return report, fmt.Errorf("look up artist %s: %w", artist.Name, err)
+		}
+		if err := db.Create(artist).Error; err != nil {
+			return report, fmt.Errorf("create artist %s: %w", artist.Name, err)
+		}
+		artistIDs[i] = artist.ID
+		report.Artists++
+	}
+
+	for i := range data.Shows {
+		s := &data.Shows[i]
+		var count int64
+		if err := db.Model(&catalogm.Show{}).Where("slug = ?", *s.Show.Slug).Count(&count).Error; err != nil {
+			return report, fmt.Errorf("look up show %s: %w", *s.Show.Slug, err)
+		}
+		if count > 0 {
+			report.ExistingShows++
+			continue
+		}
+		if err := createSyntheticShow(db, s, venueIDs[s.Venue], artistIDs); err != nil {
+			return report, err
+		}
+		report.Shows++
+	}
+
+	fmt.Printf("✅ Synthetic data: %d venues, %d artists, %d shows created (%d, %d, %d already present)\n",
+		report.Venues, report.Artists, report.Shows,
+		report.ExistingVenues, report.ExistingArtists, report.ExistingShows)
+	return report, nil
+}
+
+func createSyntheticShow(db *gorm.DB, s *SyntheticShow, venueID uint, artistIDs []uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&s.Show).Error; err != nil {
+			return fmt.Errorf("create show %s: %w", *s.Show.Slug, err)
+		}
+		if err := tx.Create(&catalogm.ShowVenue{ShowID: s.Show.ID, VenueID: venueID}).Error; err != nil {
+			return fmt.Errorf("link show %s venue: %w", *s.Show.Slug, err)
+		}
+		eventDate := s.Show.EventDate
+		for position, a := range s.Lineup {
+			setType := "opener"
+			if position == 0 {
+				setType = "headliner"
+			}
+			// EventDate + VenueID denormalize the dedup key, as in
+			// createShowWithAssociations (PSY-576).
+			link := catalogm.ShowArtist{
+				ShowID:    s.Show.ID,
+				ArtistID:  artistIDs[a],
+				Position:  position,
+				SetType:   setType,
+				EventDate: &eventDate,
+				VenueID:   &venueID,
+			}
+			if err := tx.Create(&link).Error; err != nil {
+				return fmt.Errorf("link show %s artist: %w", *s.Show.Slug, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,112 @@
+package seed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testAnchor = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func TestGenerateSynthetic_Deterministic(t *testing.T) {
+	opts := SyntheticOptions{Seed: 42, Shows: 200, Anchor: testAnchor}
+	a := GenerateSynthetic(opts)
+	b := GenerateSynthetic(opts)
+	if !reflect.DeepEqual(a, b) {
+		t.Fatal("same options generated different datasets")
+	}
+
+	c := GenerateSynthetic(SyntheticOptions{Seed: 43, Shows: 200, Anchor: testAnchor})
+	if reflect.DeepEqual(a.Shows, c.Shows) {
+		t.Fatal("different seeds generated the same shows")
+	}
+}
+
+func TestGenerateSynthetic_Sizes(t *testing.T) {
+	data := GenerateSynthetic(SyntheticOptions{Seed: 1, Shows: 500, Anchor: testAnchor})
+	if len(data.Shows) != 500 {
+		t.Errorf("shows = %d, want 500", len(data.Shows))
+	}
+	if len(data.Venues) != 50 || len(data.Artists) != 250 {
+		t.Errorf("venues, artists = %d, %d, want 50, 250", len(data.Venues), len(data.Artists))
+	}
+
+	small := GenerateSynthetic(SyntheticOptions{Seed: 1, Shows: 5, Venues: 3, Artists: 7, Anchor: testAnchor})
+	if len(small.Shows) != 5 || len(small.Venues) != 3 || len(small.Artists) != 7 {
+		t.Errorf("explicit sizes not honoured: %d shows, %d venues, %d artists",
+			len(small.Shows), len(small.Venues), len(small.Artists))
+	}
+}
+
+func TestGenerateSynthetic_RespectsUniqueIndexes(t *testing.T) {
+	data := GenerateSynthetic(SyntheticOptions{Seed: 7, Shows: 1000, Anchor: testAnchor})
+
+	venues := map[string]bool{}
+	for _, v := range data.Venues {
+		key := strings.ToLower(v.Name + "|" + v.City)
+		if venues[key] {
+			t.Errorf("duplicate venue %q in %s", v.Name, v.City)
+		}
+		venues[key] = true
+	}
+	artists := map[string]bool{}
+	for _, a := range data.Artists {
+		if artists[strings.ToLower(a.Name)] {
+			t.Errorf("duplicate artist %q", a.Name)
+		}
+		artists[strings.ToLower(a.Name)] = true
+	}
+
+	slugs := map[string]bool{}
+	type booking struct {
+		artist, venue int
+		date          time.Time
+	}
+	booked := map[booking]bool{}
+	for _, s := range data.Shows {
+		if slugs[*s.Show.Slug] {
+			t.Errorf("duplicate show slug %s", *s.Show.Slug)
+		}
+		slugs[*s.Show.Slug] = true
+		if len(s.Lineup) == 0 {
+			t.Errorf("show %s has no lineup", *s.Show.Slug)
+		}
+		for _, a := range s.Lineup {
+			key := booking{a, s.Venue, s.Show.EventDate}
+			if booked[key] {
+				t.Errorf("artist %d booked twice at venue %d on %s", a, s.Venue, s.Show.EventDate)
+			}
+			booked[key] = true
+		}
+	}
+}
+
+func TestGenerateSynthetic_DateWindow(t *testing.T) {
+	data := GenerateSynthetic(SyntheticOptions{Seed: 3, Shows: 400, Anchor: testAnchor, Days: 90})
+	earliest := testAnchor.AddDate(0, 0, -30)
+	latest := testAnchor.AddDate(0, 0, 91)
+	past := 0
+	for _, s := range data.Shows {
+		d := s.Show.EventDate
+		if d.Before(earliest) || !d.Before(latest) {
+			t.Errorf("event date %s outside [%s, %s)", d, earliest, latest)
+		}
+		if d.Before(testAnchor) {
+			past++
+		}
+		if *s.Show.DataSource != SyntheticDataSource {
+			t.Errorf("show data_source = %q", *s.Show.DataSource)
+		}
+	}
+	if past == 0 || past == len(data.Shows) {
+		t.Errorf("want a mix of past and upcoming shows, got %d past of %d", past, len(data.Shows))
+	}
+}
+
+func TestGenerateSynthetic_SmallPoolStops(t *testing.T) {
+	data := GenerateSynthetic(SyntheticOptions{Seed: 1, Shows: 100, Venues: 1, Artists: 1, Days: 3, Anchor: testAnchor})
+	if len(data.Shows) == 0 || len(data.Shows) >= 100 {
+		t.Errorf("shows = %d, want some but fewer than 100", len(data.Shows))
+	}
+}
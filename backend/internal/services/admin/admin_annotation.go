@@ -0,0 +1,442 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	adminNoteMaxLength     = 5000
+	adminTagMaxLength      = 50
+	adminTagDefaultColor   = "#6B7280"
+	adminTaggedListMaxSize = 100
+)
+
+// adminAnnotationTables maps the entity types that can be annotated to their
+// tables, for existence checks.
+var adminAnnotationTables = map[string]string{
+	"show":     "shows",
+	"venue":    "venues",
+	"artist":   "artists",
+	"release":  "releases",
+	"label":    "labels",
+	"festival": "festivals",
+}
+
+var adminTagColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// AdminAnnotationService manages internal admin notes and tags on entities.
+// Annotations are for admins only: nothing outside admin endpoints reads
+// them.
+type AdminAnnotationService struct {
+	db *gorm.DB
+}
+
+// NewAdminAnnotationService creates a new admin annotation service
+func NewAdminAnnotationService(database *gorm.DB) *AdminAnnotationService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &AdminAnnotationService{db: database}
+}
+
+// GetAnnotations returns the entity's notes (newest first) and tags (by
+// label).
+func (s *AdminAnnotationService) GetAnnotations(entityType string, entityID uint) (*contracts.AdminAnnotationsResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	if err := validateAdminAnnotationEntityType(entityType); err != nil {
+		return nil, err
+	}
+
+	var rows []adminm.AdminAnnotation
+	if err := s.db.Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("kind ASC, created_at DESC, id DESC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get annotations: %w", err)
+	}
+
+	resp := &contracts.AdminAnnotationsResponse{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Notes:      []*contracts.AdminNoteResponse{},
+		Tags:       []*contracts.AdminTagResponse{},
+	}
+	for i := range rows {
+		if rows[i].Kind == adminm.AdminAnnotationNote {
+			resp.Notes = append(resp.Notes, adminNoteResponse(&rows[i]))
+		} else {
+			resp.Tags = append(resp.Tags, adminTagResponse(&rows[i]))
+		}
+	}
+	sortAdminTags(resp.Tags)
+	return resp, nil
+}
+
+// AddNote attaches a note to the entity.
+func (s *AdminAnnotationService) AddNote(entityType string, entityID uint, body string, userID uint) (*contracts.AdminNoteResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	body, err := normalizeAdminNote(body)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkEntity(entityType, entityID); err != nil {
+		return nil, err
+	}
+
+	row := &adminm.AdminAnnotation{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Kind:       adminm.AdminAnnotationNote,
+		Body:       body,
+	}
+	if userID != 0 {
+		row.CreatedBy = &userID
+	}
+	if err := s.db.Create(row).Error; err != nil {
+		return nil, fmt.Errorf("failed to create note: %w", err)
+	}
+	return adminNoteResponse(row), nil
+}
+
+// UpdateNote replaces a note's text.
+func (s *AdminAnnotationService) UpdateNote(noteID uint, body string) (*contracts.AdminNoteResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	body, err := normalizeAdminNote(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var row adminm.AdminAnnotation
+	if err := s.db.Where("id = ? AND kind = ?", noteID, adminm.AdminAnnotationNote).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrAdminAnnotationNotFound(fmt.Sprintf("note %d", noteID))
+		}
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+	if err := s.db.Model(&row).Update("body", body).Error; err != nil {
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+	row.Body = body
+	return adminNoteResponse(&row), nil
+}
+
+// DeleteNote deletes a note.
+func (s *AdminAnnotationService) DeleteNote(noteID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	result := s.db.Where("id = ? AND kind = ?", noteID, adminm.AdminAnnotationNote).Delete(&adminm.AdminAnnotation{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete note: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrAdminAnnotationNotFound(fmt.Sprintf("note %d", noteID))
+	}
+	return nil
+}
+
+// AddTag tags the entity. Labels match case-insensitively, so tagging an
+// entity again with the same label only changes the tag's color.
+func (s *AdminAnnotationService) AddTag(entityType string, entityID uint, label, color string, userID uint) (*contracts.AdminTagResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	label, err := normalizeAdminTagLabel(label)
+	if err != nil {
+		return nil, err
+	}
+	color, err = normalizeAdminTagColor(color)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkEntity(entityType, entityID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.findTag(entityType, entityID, label)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if err := s.db.Model(existing).Update("color", color).Error; err != nil {
+			return nil, fmt.Errorf("failed to update tag: %w", err)
+		}
+		existing.Color = &color
+		return adminTagResponse(existing), nil
+	}
+
+	row := &adminm.AdminAnnotation{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Kind:       adminm.AdminAnnotationTag,
+		Body:       label,
+		Color:      &color,
+	}
+	if userID != 0 {
+		row.CreatedBy = &userID
+	}
+	if err := s.db.Create(row).Error; err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return adminTagResponse(row), nil
+}
+
+// RemoveTag removes a tag from the entity.
+func (s *AdminAnnotationService) RemoveTag(entityType string, entityID uint, label string) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	if err := validateAdminAnnotationEntityType(entityType); err != nil {
+		return err
+	}
+
+	result := s.db.Where("entity_type = ? AND entity_id = ? AND kind = ? AND LOWER(body) = LOWER(?)",
+		entityType, entityID, adminm.AdminAnnotationTag, strings.Join(strings.Fields(label), " ")).
+		Delete(&adminm.AdminAnnotation{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove tag: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrAdminAnnotationNotFound(fmt.Sprintf("tag %q on %s %d", label, entityType, entityID))
+	}
+	return nil
+}
+
+// GetSummaries returns the tags and note count of each of entityIDs that
+// has any annotations, keyed by entity ID. Admin list endpoints use it to
+// show and filter by tags without a request per row.
+func (s *AdminAnnotationService) GetSummaries(entityType string, entityIDs []uint) (map[uint]*contracts.AdminAnnotationSummary, error) {
+	summaries := map[uint]*contracts.AdminAnnotationSummary{}
+	if len(entityIDs) == 0 {
+		return summaries, nil
+	}
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var rows []adminm.AdminAnnotation
+	if err := s.db.Select("id", "entity_id", "kind", "body", "color").
+		Where("entity_type = ? AND entity_id IN ?", entityType, entityIDs).
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get annotation summaries: %w", err)
+	}
+	for i := range rows {
+		summary, ok := summaries[rows[i].EntityID]
+		if !ok {
+			summary = &contracts.AdminAnnotationSummary{Tags: []*contracts.AdminTagResponse{}}
+			summaries[rows[i].EntityID] = summary
+		}
+		if rows[i].Kind == adminm.AdminAnnotationNote {
+			summary.NoteCount++
+		} else {
+			summary.Tags = append(summary.Tags, adminTagResponse(&rows[i]))
+		}
+	}
+	for _, summary := range summaries {
+		sortAdminTags(summary.Tags)
+	}
+	return summaries, nil
+}
+
+// ListTags returns every tag label in use with the number of entities
+// carrying it, most used first. Labels differing only in case are counted
+// together under the most recently used spelling and color.
+func (s *AdminAnnotationService) ListTags() ([]*contracts.AdminTagCount, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var rows []struct {
+		Label string
+		Color *string
+		Count int64
+	}
+	err := s.db.Raw(`
+		SELECT DISTINCT ON (LOWER(body)) body AS label, color,
+			COUNT(*) OVER (PARTITION BY LOWER(body)) AS count
+		FROM admin_annotations
+		WHERE kind = ?
+		ORDER BY LOWER(body), created_at DESC`, adminm.AdminAnnotationTag).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tags := make([]*contracts.AdminTagCount, len(rows))
+	for i, row := range rows {
+		tags[i] = &contracts.AdminTagCount{Label: row.Label, Color: adminTagColor(row.Color), Count: row.Count}
+	}
+	// Most used first; DISTINCT ON left them alphabetical, which breaks ties.
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].Count > tags[j].Count })
+	return tags, nil
+}
+
+// ListTagged returns the entities carrying the tag, most recently tagged
+// first, optionally limited to one entity type.
+func (s *AdminAnnotationService) ListTagged(label, entityType string, limit, offset int) ([]*contracts.TaggedEntityResponse, int64, error) {
+	if s.db == nil {
+		return nil, 0, fmt.Errorf("database not initialized")
+	}
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return nil, 0, apperrors.ErrAdminAnnotationInvalid("tag is required")
+	}
+	if entityType != "" {
+		if err := validateAdminAnnotationEntityType(entityType); err != nil {
+			return nil, 0, err
+		}
+	}
+	if limit <= 0 || limit > adminTaggedListMaxSize {
+		limit = adminTaggedListMaxSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := s.db.Model(&adminm.AdminAnnotation{}).
+		Where("kind = ? AND LOWER(body) = LOWER(?)", adminm.AdminAnnotationTag, label)
+	if entityType != "" {
+		query = query.Where("entity_type = ?", entityType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count tagged entities: %w", err)
+	}
+	var rows []adminm.AdminAnnotation
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list tagged entities: %w", err)
+	}
+
+	out := make([]*contracts.TaggedEntityResponse, len(rows))
+	for i := range rows {
+		out[i] = &contracts.TaggedEntityResponse{
+			EntityType: rows[i].EntityType,
+			EntityID:   rows[i].EntityID,
+			EntityName: resolveEntityName(s.db, rows[i].EntityType, rows[i].EntityID),
+			Tag:        adminTagResponse(&rows[i]),
+		}
+	}
+	return out, total, nil
+}
+
+// checkEntity validates the entity type and that the entity exists.
+func (s *AdminAnnotationService) checkEntity(entityType string, entityID uint) error {
+	if err := validateAdminAnnotationEntityType(entityType); err != nil {
+		return err
+	}
+	var count int64
+	if err := s.db.Table(adminAnnotationTables[entityType]).Where("id = ?", entityID).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to look up %s: %w", entityType, err)
+	}
+	if count == 0 {
+		return apperrors.ErrAdminAnnotationEntityNotFound(entityType, entityID)
+	}
+	return nil
+}
+
+func (s *AdminAnnotationService) findTag(entityType string, entityID uint, label string) (*adminm.AdminAnnotation, error) {
+	var row adminm.AdminAnnotation
+	err := s.db.Where("entity_type = ? AND entity_id = ? AND kind = ? AND LOWER(body) = LOWER(?)",
+		entityType, entityID, adminm.AdminAnnotationTag, label).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return &row, nil
+}
+
+func validateAdminAnnotationEntityType(entityType string) error {
+	if _, ok := adminAnnotationTables[entityType]; !ok {
+		return apperrors.ErrAdminAnnotationInvalid(fmt.Sprintf("unsupported entity type %q", entityType))
+	}
+	return nil
+}
+
+func normalizeAdminNote(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", apperrors.ErrAdminAnnotationInvalid("note is required")
+	}
+	if utf8.RuneCountInString(body) > adminNoteMaxLength {
+		return "", apperrors.ErrAdminAnnotationInvalid(fmt.Sprintf("note must be at most %d characters", adminNoteMaxLength))
+	}
+	return body, nil
+}
+
+// normalizeAdminTagLabel trims the label and collapses inner whitespace.
+func normalizeAdminTagLabel(label string) (string, error) {
+	label = strings.Join(strings.Fields(label), " ")
+	if label == "" {
+		return "", apperrors.ErrAdminAnnotationInvalid("tag label is required")
+	}
+	if utf8.RuneCountInString(label) > adminTagMaxLength {
+		return "", apperrors.ErrAdminAnnotationInvalid(fmt.Sprintf("tag label must be at most %d characters", adminTagMaxLength))
+	}
+	return label, nil
+}
+
+func normalizeAdminTagColor(color string) (string, error) {
+	color = strings.TrimSpace(color)
+	if color == "" {
+		return adminTagDefaultColor, nil
+	}
+	if !adminTagColorPattern.MatchString(color) {
+		return "", apperrors.ErrAdminAnnotationInvalid("tag color must be a hex color like #FFAA00")
+	}
+	return strings.ToUpper(color), nil
+}
+
+func adminTagColor(color *string) string {
+	if color == nil || *color == "" {
+		return adminTagDefaultColor
+	}
+	return *color
+}
+
+func sortAdminTags(tags []*contracts.AdminTagResponse) {
+	sort.SliceStable(tags, func(i, j int) bool {
+		return strings.ToLower(tags[i].Label) < strings.ToLower(tags[j].Label)
+	})
+}
+
+func adminNoteResponse(row *adminm.AdminAnnotation) *contracts.AdminNoteResponse {
+	return &contracts.AdminNoteResponse{
+		ID:         row.ID,
+		EntityType: row.EntityType,
+		EntityID:   row.EntityID,
+		Body:       row.Body,
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  row.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func adminTagResponse(row *adminm.AdminAnnotation) *contracts.AdminTagResponse {
+	return &contracts.AdminTagResponse{
+		ID:    row.ID,
+		Label: row.Body,
+		Color: adminTagColor(row.Color),
+	}
+}
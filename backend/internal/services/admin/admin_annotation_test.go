@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestAdminAnnotationService_NilDB(t *testing.T) {
+	svc := &AdminAnnotationService{}
+
+	_, err := svc.GetAnnotations("show", 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.AddNote("show", 1, "x", 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.AddTag("show", 1, "x", "", 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	assert.ErrorContains(t, svc.DeleteNote(1), "database not initialized")
+	_, err = svc.GetSummaries("show", []uint{1})
+	assert.ErrorContains(t, err, "database not initialized")
+
+	summaries, err := svc.GetSummaries("show", nil)
+	assert.NoError(t, err, "no IDs needs no database")
+	assert.Empty(t, summaries)
+}
+
+func TestNormalizeAdminTag(t *testing.T) {
+	label, err := normalizeAdminTagLabel("  needs   flyer ")
+	assert.NoError(t, err)
+	assert.Equal(t, "needs flyer", label)
+
+	var annotationErr *apperrors.AdminAnnotationError
+	_, err = normalizeAdminTagLabel("   ")
+	assert.ErrorAs(t, err, &annotationErr)
+
+	color, err := normalizeAdminTagColor("")
+	assert.NoError(t, err)
+	assert.Equal(t, adminTagDefaultColor, color)
+	color, err = normalizeAdminTagColor("#f59e0b")
+	assert.NoError(t, err)
+	assert.Equal(t, "#F59E0B", color)
+	_, err = normalizeAdminTagColor("orange")
+	assert.ErrorAs(t, err, &annotationErr)
+}
+
+func TestValidateAdminAnnotationEntityType(t *testing.T) {
+	assert.NoError(t, validateAdminAnnotationEntityType("festival"))
+	var annotationErr *apperrors.AdminAnnotationError
+	assert.ErrorAs(t, validateAdminAnnotationEntityType("user"), &annotationErr)
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type AdminAnnotationIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *AdminAnnotationService
+}
+
+func (suite *AdminAnnotationIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.svc = &AdminAnnotationService{db: suite.db}
+}
+
+func (suite *AdminAnnotationIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *AdminAnnotationIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM admin_annotations")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+}
+
+func TestAdminAnnotationIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminAnnotationIntegrationTestSuite))
+}
+
+func (suite *AdminAnnotationIntegrationTestSuite) createShow(title string) *catalogm.Show {
+	show := &catalogm.Show{
+		Title:     title,
+		EventDate: time.Now().Add(7 * 24 * time.Hour),
+		Status:    catalogm.ShowStatusApproved,
+		Source:    catalogm.ShowSourceUser,
+	}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	return show
+}
+
+func (suite *AdminAnnotationIntegrationTestSuite) TestNotesAndTags() {
+	show := suite.createShow("Rock Night")
+
+	note, err := suite.svc.AddNote("show", show.ID, "  Promoter unsure of date  ", 0)
+	suite.Require().NoError(err)
+	suite.Equal("Promoter unsure of date", note.Body)
+
+	_, err = suite.svc.AddTag("show", show.ID, "Needs Flyer", "", 0)
+	suite.Require().NoError(err)
+	recolored, err := suite.svc.AddTag("show", show.ID, "needs flyer", "#ff0000", 0)
+	suite.Require().NoError(err)
+	suite.Equal("Needs Flyer", recolored.Label, "re-adding a label keeps its spelling")
+	suite.Equal("#FF0000", recolored.Color)
+
+	annotations, err := suite.svc.GetAnnotations("show", show.ID)
+	suite.Require().NoError(err)
+	suite.Len(annotations.Notes, 1)
+	suite.Require().Len(annotations.Tags, 1)
+	suite.Equal("#FF0000", annotations.Tags[0].Color)
+
+	updated, err := suite.svc.UpdateNote(note.ID, "Date confirmed")
+	suite.Require().NoError(err)
+	suite.Equal("Date confirmed", updated.Body)
+
+	suite.Require().NoError(suite.svc.DeleteNote(note.ID))
+	var annotationErr *apperrors.AdminAnnotationError
+	suite.Require().ErrorAs(suite.svc.DeleteNote(note.ID), &annotationErr)
+	suite.Equal(apperrors.CodeAdminAnnotationNotFound, annotationErr.Code)
+
+	suite.Require().NoError(suite.svc.RemoveTag("show", show.ID, "NEEDS FLYER"))
+	suite.Require().ErrorAs(suite.svc.RemoveTag("show", show.ID, "needs flyer"), &annotationErr)
+}
+
+func (suite *AdminAnnotationIntegrationTestSuite) TestAddNote_UnknownEntity() {
+	_, err := suite.svc.AddNote("show", 999999, "hello", 0)
+	var annotationErr *apperrors.AdminAnnotationError
+	suite.Require().ErrorAs(err, &annotationErr)
+	suite.Equal(apperrors.CodeAdminAnnotationEntityNotFound, annotationErr.Code)
+}
+
+func (suite *AdminAnnotationIntegrationTestSuite) TestSummariesTagsAndTaggedList() {
+	rock := suite.createShow("Rock Night")
+	jazz := suite.createShow("Jazz Brunch")
+	plain := suite.createShow("Plain Show")
+
+	_, err := suite.svc.AddTag("show", rock.ID, "unconfirmed date", "", 0)
+	suite.Require().NoError(err)
+	_, err = suite.svc.AddTag("show", jazz.ID, "Unconfirmed Date", "", 0)
+	suite.Require().NoError(err)
+	_, err = suite.svc.AddTag("show", jazz.ID, "needs flyer", "", 0)
+	suite.Require().NoError(err)
+	_, err = suite.svc.AddNote("show", jazz.ID, "note", 0)
+	suite.Require().NoError(err)
+
+	summaries, err := suite.svc.GetSummaries("show", []uint{rock.ID, jazz.ID, plain.ID})
+	suite.Require().NoError(err)
+	suite.Len(summaries, 2, "entities without annotations are omitted")
+	suite.Equal(1, summaries[jazz.ID].NoteCount)
+	suite.Require().Len(summaries[jazz.ID].Tags, 2)
+	suite.Equal("needs flyer", summaries[jazz.ID].Tags[0].Label, "tags are sorted by label")
+
+	tags, err := suite.svc.ListTags()
+	suite.Require().NoError(err)
+	suite.Require().Len(tags, 2)
+	suite.Equal(int64(2), tags[0].Count, "labels differing in case count together")
+
+	tagged, total, err := suite.svc.ListTagged("UNCONFIRMED date", "show", 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), total)
+	suite.Require().Len(tagged, 2)
+	suite.Equal("Jazz Brunch", tagged[0].EntityName, "most recently tagged first")
+}
@@ -8,6 +8,7 @@ import (
 
 	"psychic-homily-backend/db"
 	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
 )
 
 // AnalyticsService handles platform analytics dashboard queries.
@@ -265,7 +266,7 @@ func (s *AnalyticsService) GetEngagementMetrics(months int) (*contracts.Engageme
 	// above, which also counts release bookmarks.
 	saves, err := s.queryEngagementMetricWithCondition(
 		"user_bookmarks",
-		"action = 'save' AND entity_type = 'show'",
+		"action = 'save' AND entity_type = 'show' AND "+shared.LiveShowBookmarkSQL("user_bookmarks"),
 		since,
 	)
 	if err != nil {
@@ -453,14 +454,14 @@ func (s *AnalyticsService) GetDataQualityTrends(months int) (*contracts.DataQual
 	resp := &contracts.DataQualityTrendsResponse{}
 
 	// Monthly approved shows (status changed to approved — we use shows with status='approved')
-	approved, err := s.queryMonthlyCountsWithCondition("shows", "status = 'approved'", since)
+	approved, err := s.queryMonthlyCountsWithCondition("shows", "status = 'approved' AND deleted_at IS NULL", since)
 	if err != nil {
 		return nil, fmt.Errorf("querying approved shows: %w", err)
 	}
 	resp.ShowsApproved = fillMonthlyGaps(approved, monthKeys)
 
 	// Monthly rejected shows
-	rejected, err := s.queryMonthlyCountsWithCondition("shows", "status = 'rejected'", since)
+	rejected, err := s.queryMonthlyCountsWithCondition("shows", "status = 'rejected' AND deleted_at IS NULL", since)
 	if err != nil {
 		return nil, fmt.Errorf("querying rejected shows: %w", err)
 	}
@@ -468,7 +469,7 @@ func (s *AnalyticsService) GetDataQualityTrends(months int) (*contracts.DataQual
 
 	// Current pending review count
 	var pendingCount int
-	err = s.db.Raw(`SELECT COUNT(*) FROM shows WHERE status = 'pending'`).Scan(&pendingCount).Error
+	err = s.db.Raw(`SELECT COUNT(*) FROM shows WHERE status = 'pending' AND deleted_at IS NULL`).Scan(&pendingCount).Error
 	if err != nil {
 		return nil, fmt.Errorf("querying pending shows: %w", err)
 	}
@@ -0,0 +1,342 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// announcementMessageMaxLength caps a banner's message, in characters. A
+// banner is a line or two across the top of every page, not a post.
+const announcementMessageMaxLength = 500
+
+// Announcement statuses reported to admins.
+const (
+	announcementStatusScheduled = "scheduled"
+	announcementStatusActive    = "active"
+	announcementStatusExpired   = "expired"
+)
+
+// announcementSeverityRank orders active banners, most urgent first.
+var announcementSeverityRank = map[string]int{
+	adminm.AnnouncementSeverityCritical: 0,
+	adminm.AnnouncementSeverityWarning:  1,
+	adminm.AnnouncementSeverityInfo:     2,
+}
+
+// AnnouncementService manages site-wide announcement banners and which
+// users have dismissed them.
+type AnnouncementService struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// NewAnnouncementService creates a new announcement service.
+func NewAnnouncementService(database *gorm.DB) *AnnouncementService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &AnnouncementService{
+		db:  database,
+		now: time.Now,
+	}
+}
+
+// ListAnnouncements returns every announcement, latest start first.
+func (s *AnnouncementService) ListAnnouncements() ([]*contracts.AnnouncementResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var rows []adminm.Announcement
+	if err := s.db.Order("starts_at DESC, id DESC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+	now := s.now()
+	out := make([]*contracts.AnnouncementResponse, len(rows))
+	for i := range rows {
+		out[i] = announcementResponse(&rows[i], now)
+	}
+	return out, nil
+}
+
+// CreateAnnouncement creates a banner. It starts now unless StartsAt says
+// otherwise, and runs until EndsAt or until it is expired.
+func (s *AnnouncementService) CreateAnnouncement(req *contracts.CreateAnnouncementRequest, userID uint) (*contracts.AnnouncementResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	message, err := normalizeAnnouncementMessage(req.Message)
+	if err != nil {
+		return nil, err
+	}
+	severity := adminm.AnnouncementSeverityInfo
+	if strings.TrimSpace(req.Severity) != "" {
+		if severity, err = normalizeAnnouncementSeverity(req.Severity); err != nil {
+			return nil, err
+		}
+	}
+	now := s.now()
+	startsAt := now
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+	if err := validateAnnouncementWindow(startsAt, req.EndsAt); err != nil {
+		return nil, err
+	}
+
+	row := &adminm.Announcement{
+		Message:     message,
+		Severity:    severity,
+		StartsAt:    startsAt,
+		EndsAt:      req.EndsAt,
+		Dismissible: req.Dismissible,
+	}
+	if userID != 0 {
+		row.CreatedBy = &userID
+		row.UpdatedBy = &userID
+	}
+	if err := s.db.Create(row).Error; err != nil {
+		return nil, fmt.Errorf("failed to create announcement: %w", err)
+	}
+	return announcementResponse(row, now), nil
+}
+
+// UpdateAnnouncement applies the non-nil fields of req to the announcement.
+func (s *AnnouncementService) UpdateAnnouncement(id uint, req *contracts.UpdateAnnouncementRequest, userID uint) (*contracts.AnnouncementResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	row, err := s.getAnnouncement(id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if req.Message != nil {
+		message, err := normalizeAnnouncementMessage(*req.Message)
+		if err != nil {
+			return nil, err
+		}
+		updates["message"] = message
+	}
+	if req.Severity != nil {
+		severity, err := normalizeAnnouncementSeverity(*req.Severity)
+		if err != nil {
+			return nil, err
+		}
+		updates["severity"] = severity
+	}
+	if req.Dismissible != nil {
+		updates["dismissible"] = *req.Dismissible
+	}
+	if req.StartsAt != nil || req.EndsAt != nil {
+		startsAt, endsAt := row.StartsAt, row.EndsAt
+		if req.StartsAt != nil {
+			startsAt = *req.StartsAt
+			updates["starts_at"] = startsAt
+		}
+		if req.EndsAt != nil {
+			endsAt = req.EndsAt
+			updates["ends_at"] = *endsAt
+		}
+		if err := validateAnnouncementWindow(startsAt, endsAt); err != nil {
+			return nil, err
+		}
+	}
+	if len(updates) == 0 {
+		return announcementResponse(row, s.now()), nil
+	}
+	if userID != 0 {
+		updates["updated_by"] = userID
+	}
+
+	if err := s.db.Model(row).Updates(updates).Error; err != nil {
+		return nil, fmt.Errorf("failed to update announcement: %w", err)
+	}
+
+	row, err = s.getAnnouncement(id)
+	if err != nil {
+		return nil, err
+	}
+	return announcementResponse(row, s.now()), nil
+}
+
+// ExpireAnnouncement ends the banner now. A banner that has already ended is
+// returned unchanged; one that hasn't started yet is ended before it shows.
+func (s *AnnouncementService) ExpireAnnouncement(id uint, userID uint) (*contracts.AnnouncementResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	row, err := s.getAnnouncement(id)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if row.EndsAt != nil && !row.EndsAt.After(now) {
+		return announcementResponse(row, now), nil
+	}
+
+	updates := map[string]interface{}{"ends_at": now}
+	if row.StartsAt.After(now) {
+		updates["starts_at"] = now
+	}
+	if userID != 0 {
+		updates["updated_by"] = userID
+	}
+	if err := s.db.Model(row).Updates(updates).Error; err != nil {
+		return nil, fmt.Errorf("failed to expire announcement: %w", err)
+	}
+
+	row, err = s.getAnnouncement(id)
+	if err != nil {
+		return nil, err
+	}
+	return announcementResponse(row, now), nil
+}
+
+// GetActiveAnnouncements returns the banners showing now, most severe first,
+// leaving out dismissible banners the user has dismissed. userID 0 is an
+// anonymous visitor, who gets every active banner.
+func (s *AnnouncementService) GetActiveAnnouncements(userID uint) ([]*contracts.ActiveAnnouncementResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	now := s.now()
+	query := s.db.Where("starts_at <= ? AND (ends_at IS NULL OR ends_at > ?)", now, now)
+	if userID != 0 {
+		query = query.Where(
+			"NOT (dismissible AND EXISTS (SELECT 1 FROM announcement_dismissals d WHERE d.announcement_id = announcements.id AND d.user_id = ?))",
+			userID,
+		)
+	}
+
+	var rows []adminm.Announcement
+	if err := query.Order("starts_at DESC, id DESC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get active announcements: %w", err)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return announcementSeverityRank[rows[i].Severity] < announcementSeverityRank[rows[j].Severity]
+	})
+
+	out := make([]*contracts.ActiveAnnouncementResponse, len(rows))
+	for i := range rows {
+		out[i] = &contracts.ActiveAnnouncementResponse{
+			ID:          rows[i].ID,
+			Message:     rows[i].Message,
+			Severity:    rows[i].Severity,
+			Dismissible: rows[i].Dismissible,
+			StartsAt:    rows[i].StartsAt.UTC().Format(time.RFC3339),
+			EndsAt:      formatAnnouncementTime(rows[i].EndsAt),
+		}
+	}
+	return out, nil
+}
+
+// DismissAnnouncement hides a dismissible banner from the user for good.
+// Dismissing twice is a no-op.
+func (s *AnnouncementService) DismissAnnouncement(id uint, userID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	row, err := s.getAnnouncement(id)
+	if err != nil {
+		return err
+	}
+	if !row.Dismissible {
+		return apperrors.ErrAnnouncementNotDismissible(id)
+	}
+
+	err = s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&adminm.AnnouncementDismissal{
+		AnnouncementID: id,
+		UserID:         userID,
+		DismissedAt:    s.now(),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to dismiss announcement: %w", err)
+	}
+	return nil
+}
+
+func (s *AnnouncementService) getAnnouncement(id uint) (*adminm.Announcement, error) {
+	var row adminm.Announcement
+	if err := s.db.First(&row, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrAnnouncementNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+	return &row, nil
+}
+
+func normalizeAnnouncementMessage(message string) (string, error) {
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return "", apperrors.ErrAnnouncementInvalid("message is required")
+	}
+	if utf8.RuneCountInString(message) > announcementMessageMaxLength {
+		return "", apperrors.ErrAnnouncementInvalid(fmt.Sprintf("message must be at most %d characters", announcementMessageMaxLength))
+	}
+	return message, nil
+}
+
+func normalizeAnnouncementSeverity(severity string) (string, error) {
+	severity = strings.ToLower(strings.TrimSpace(severity))
+	if _, ok := announcementSeverityRank[severity]; !ok {
+		return "", apperrors.ErrAnnouncementInvalid("severity must be info, warning or critical")
+	}
+	return severity, nil
+}
+
+func validateAnnouncementWindow(startsAt time.Time, endsAt *time.Time) error {
+	if endsAt != nil && !endsAt.After(startsAt) {
+		return apperrors.ErrAnnouncementInvalid("ends_at must be after starts_at")
+	}
+	return nil
+}
+
+func formatAnnouncementTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+func announcementResponse(row *adminm.Announcement, now time.Time) *contracts.AnnouncementResponse {
+	status := announcementStatusActive
+	if row.StartsAt.After(now) {
+		status = announcementStatusScheduled
+	} else if !row.ActiveAt(now) {
+		status = announcementStatusExpired
+	}
+	return &contracts.AnnouncementResponse{
+		ID:          row.ID,
+		Message:     row.Message,
+		Severity:    row.Severity,
+		StartsAt:    row.StartsAt.UTC().Format(time.RFC3339),
+		EndsAt:      formatAnnouncementTime(row.EndsAt),
+		Dismissible: row.Dismissible,
+		Status:      status,
+		CreatedBy:   row.CreatedBy,
+		UpdatedBy:   row.UpdatedBy,
+		CreatedAt:   row.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   row.UpdatedAt.Format(time.RFC3339),
+	}
+}
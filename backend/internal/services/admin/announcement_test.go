@@ -0,0 +1,187 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestAnnouncementService_NilDB(t *testing.T) {
+	svc := &AnnouncementService{now: time.Now}
+
+	_, err := svc.ListAnnouncements()
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "x"}, 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.UpdateAnnouncement(1, &contracts.UpdateAnnouncementRequest{}, 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.ExpireAnnouncement(1, 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.GetActiveAnnouncements(1)
+	assert.ErrorContains(t, err, "database not initialized")
+	assert.ErrorContains(t, svc.DismissAnnouncement(1, 1), "database not initialized")
+}
+
+func TestAnnouncementStatus(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	assert.Equal(t, "scheduled", announcementResponse(&adminm.Announcement{StartsAt: later}, now).Status)
+	assert.Equal(t, "active", announcementResponse(&adminm.Announcement{StartsAt: earlier}, now).Status)
+	assert.Equal(t, "active", announcementResponse(&adminm.Announcement{StartsAt: earlier, EndsAt: &later}, now).Status)
+	assert.Equal(t, "expired", announcementResponse(&adminm.Announcement{StartsAt: earlier, EndsAt: &now}, now).Status)
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type AnnouncementIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *AnnouncementService
+	now    time.Time
+}
+
+func (suite *AnnouncementIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+}
+
+func (suite *AnnouncementIntegrationTestSuite) SetupTest() {
+	suite.now = time.Now().UTC().Truncate(time.Second)
+	suite.svc = &AnnouncementService{db: suite.db, now: func() time.Time { return suite.now }}
+}
+
+func (suite *AnnouncementIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *AnnouncementIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM announcement_dismissals")
+	_, _ = sqlDB.Exec("DELETE FROM announcements")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestAnnouncementIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementIntegrationTestSuite))
+}
+
+func (suite *AnnouncementIntegrationTestSuite) TestCreateUpdateExpire() {
+	admin := testutil.CreateUser(suite.T(), suite.db)
+
+	created, err := suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{
+		Message:     "  Down for maintenance Sunday  ",
+		Dismissible: true,
+	}, admin.ID)
+	suite.Require().NoError(err)
+	suite.Equal("Down for maintenance Sunday", created.Message)
+	suite.Equal(adminm.AnnouncementSeverityInfo, created.Severity)
+	suite.Equal("active", created.Status)
+	suite.Nil(created.EndsAt)
+
+	var annErr *apperrors.AnnouncementError
+	_, err = suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "  "}, admin.ID)
+	suite.Require().ErrorAs(err, &annErr)
+	suite.Equal(apperrors.CodeAnnouncementInvalid, annErr.Code)
+	_, err = suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "x", Severity: "urgent"}, admin.ID)
+	suite.Require().ErrorAs(err, &annErr)
+	past := suite.now.Add(-time.Hour)
+	_, err = suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "x", EndsAt: &past}, admin.ID)
+	suite.Require().ErrorAs(err, &annErr)
+	suite.Equal(apperrors.CodeAnnouncementInvalid, annErr.Code)
+
+	severity := "Critical"
+	updated, err := suite.svc.UpdateAnnouncement(created.ID, &contracts.UpdateAnnouncementRequest{Severity: &severity}, admin.ID)
+	suite.Require().NoError(err)
+	suite.Equal(adminm.AnnouncementSeverityCritical, updated.Severity)
+	suite.True(updated.Dismissible, "omitted fields are unchanged")
+
+	_, err = suite.svc.UpdateAnnouncement(created.ID, &contracts.UpdateAnnouncementRequest{EndsAt: &past}, admin.ID)
+	suite.Require().ErrorAs(err, &annErr)
+	suite.Equal(apperrors.CodeAnnouncementInvalid, annErr.Code, "ends_at must stay after the stored starts_at")
+
+	expired, err := suite.svc.ExpireAnnouncement(created.ID, admin.ID)
+	suite.Require().NoError(err)
+	suite.Equal("expired", expired.Status)
+	suite.Require().NotNil(expired.EndsAt)
+
+	_, err = suite.svc.ExpireAnnouncement(999999, admin.ID)
+	suite.Require().ErrorAs(err, &annErr)
+	suite.Equal(apperrors.CodeAnnouncementNotFound, annErr.Code)
+}
+
+func (suite *AnnouncementIntegrationTestSuite) TestExpireScheduled() {
+	startsAt := suite.now.Add(24 * time.Hour)
+	created, err := suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "Tomorrow", StartsAt: &startsAt}, 0)
+	suite.Require().NoError(err)
+	suite.Equal("scheduled", created.Status)
+
+	expired, err := suite.svc.ExpireAnnouncement(created.ID, 0)
+	suite.Require().NoError(err)
+	suite.Equal("expired", expired.Status)
+
+	suite.now = suite.now.Add(48 * time.Hour)
+	active, err := suite.svc.GetActiveAnnouncements(0)
+	suite.Require().NoError(err)
+	suite.Empty(active, "an expired scheduled banner never shows")
+}
+
+func (suite *AnnouncementIntegrationTestSuite) TestActiveAndDismissals() {
+	user := testutil.CreateUser(suite.T(), suite.db)
+	other := testutil.CreateUser(suite.T(), suite.db)
+
+	later := suite.now.Add(time.Hour)
+	info, err := suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "New feature", Dismissible: true}, 0)
+	suite.Require().NoError(err)
+	critical, err := suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "Outage", Severity: "critical"}, 0)
+	suite.Require().NoError(err)
+	_, err = suite.svc.CreateAnnouncement(&contracts.CreateAnnouncementRequest{Message: "Soon", StartsAt: &later}, 0)
+	suite.Require().NoError(err)
+
+	active, err := suite.svc.GetActiveAnnouncements(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(active, 2, "scheduled banners don't show yet")
+	suite.Equal(critical.ID, active[0].ID, "most severe first")
+	suite.Equal(info.ID, active[1].ID)
+
+	suite.Require().NoError(suite.svc.DismissAnnouncement(info.ID, user.ID))
+	suite.Require().NoError(suite.svc.DismissAnnouncement(info.ID, user.ID), "dismissing twice is a no-op")
+
+	err = suite.svc.DismissAnnouncement(critical.ID, user.ID)
+	var annErr *apperrors.AnnouncementError
+	suite.Require().ErrorAs(err, &annErr)
+	suite.Equal(apperrors.CodeAnnouncementNotDismissible, annErr.Code)
+
+	active, err = suite.svc.GetActiveAnnouncements(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(active, 1)
+	suite.Equal(critical.ID, active[0].ID)
+
+	active, err = suite.svc.GetActiveAnnouncements(other.ID)
+	suite.Require().NoError(err)
+	suite.Len(active, 2, "dismissals are per user")
+	active, err = suite.svc.GetActiveAnnouncements(0)
+	suite.Require().NoError(err)
+	suite.Len(active, 2)
+
+	// A dismissal only counts while the banner is dismissible.
+	notDismissible := false
+	_, err = suite.svc.UpdateAnnouncement(info.ID, &contracts.UpdateAnnouncementRequest{Dismissible: &notDismissible}, 0)
+	suite.Require().NoError(err)
+	active, err = suite.svc.GetActiveAnnouncements(user.ID)
+	suite.Require().NoError(err)
+	suite.Len(active, 2)
+}
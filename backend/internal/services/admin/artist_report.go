@@ -15,7 +15,8 @@ import (
 
 // ArtistReportService handles artist report business logic
 type ArtistReportService struct {
-	db *gorm.DB
+	db     *gorm.DB
+	events contracts.AdminEventPublisher
 }
 
 // NewArtistReportService creates a new artist report service
@@ -28,6 +29,12 @@ func NewArtistReportService(database *gorm.DB) *ArtistReportService {
 	}
 }
 
+// SetEventPublisher wires the admin event bus. Optional — when nil, new
+// reports are not announced to open admin event streams.
+func (s *ArtistReportService) SetEventPublisher(p contracts.AdminEventPublisher) {
+	s.events = p
+}
+
 // CreateReport creates a new artist report
 func (s *ArtistReportService) CreateReport(userID, artistID uint, reportType string, details *string) (*contracts.ArtistReportResponse, error) {
 	if s.db == nil {
@@ -76,6 +83,16 @@ func (s *ArtistReportService) CreateReport(userID, artistID uint, reportType str
 		return nil, fmt.Errorf("failed to create report: %w", err)
 	}
 
+	if s.events != nil {
+		s.events.Publish(contracts.AdminEvent{
+			Type:       contracts.AdminEventReportCreated,
+			ID:         report.ID,
+			EntityType: "artist",
+			EntityID:   artistID,
+			Summary:    reportType,
+		})
+	}
+
 	return s.buildReportResponse(&report, &artist), nil
 }
 
@@ -14,9 +14,12 @@ import (
 	"gorm.io/gorm"
 
 	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
 	apperrors "psychic-homily-backend/internal/errors"
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
 	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/services/engagement"
 	"psychic-homily-backend/internal/services/notification"
@@ -34,7 +37,7 @@ const (
 // Promotion thresholds.
 const (
 	ContributorMinEdits           = 5
-	ContributorMinAccountAge      = 14 * 24 * time.Hour // 2 weeks
+	ContributorMinAccountAge      = 30 * 24 * time.Hour // ~1 month
 	TrustedMinEdits               = 25
 	TrustedMinApprovalRate        = 0.95
 	TrustedMinAccountAge          = 60 * 24 * time.Hour // ~2 months
@@ -45,6 +48,16 @@ const (
 	DemotionMinEditsForRate       = 3 // must have at least 3 edits in 30d window to evaluate rate
 )
 
+// Tier change rules. Recorded on every tier change (and in its audit log
+// entry) so admins can see which rule moved a user.
+const (
+	TierRulePromoteContributor = "promote_contributor"
+	TierRulePromoteTrusted     = "promote_trusted_contributor"
+	TierRulePromoteAmbassador  = "promote_local_ambassador"
+	TierRuleDemoteApprovalRate = "demote_approval_rate"
+	TierRuleDemoteUpheldReport = "demote_upheld_report"
+)
+
 // DefaultAutoPromotionInterval is the default interval for the background scheduler (24 hours).
 const DefaultAutoPromotionInterval = 24 * time.Hour
 
@@ -72,14 +85,14 @@ type AutoPromotionService struct {
 	stopCh       chan struct{}
 	wg           sync.WaitGroup
 	logger       *slog.Logger
-	// backendURL + jwtSecret mint the HMAC-signed tier-notifications
+	// backendURL + keys mint the HMAC-signed tier-notifications
 	// unsubscribe URL placed in the tier-change emails.
 	backendURL string
-	jwtSecret  string
+	keys       *config.JWTKeyRing
 }
 
 // NewAutoPromotionService creates a new auto-promotion service.
-func NewAutoPromotionService(database *gorm.DB, emailService contracts.EmailServiceInterface, backendURL, jwtSecret string) *AutoPromotionService {
+func NewAutoPromotionService(database *gorm.DB, emailService contracts.EmailServiceInterface, backendURL string, keys *config.JWTKeyRing) *AutoPromotionService {
 	if database == nil {
 		database = db.GetDB()
 	}
@@ -98,7 +111,7 @@ func NewAutoPromotionService(database *gorm.DB, emailService contracts.EmailServ
 		stopCh:       make(chan struct{}),
 		logger:       slog.Default(),
 		backendURL:   backendURL,
-		jwtSecret:    jwtSecret,
+		keys:         keys,
 	}
 }
 
@@ -213,6 +226,7 @@ func (s *AutoPromotionService) EvaluateAllUsers() (*contracts.AutoPromotionResul
 			OldTier:  evalResult.CurrentTier,
 			NewTier:  evalResult.NewTier,
 			Reason:   evalResult.Reason,
+			Rule:     evalResult.Rule,
 		}
 
 		// Apply the tier change
@@ -260,7 +274,7 @@ func (s *AutoPromotionService) sendTierChangeEmail(user *authm.User, change cont
 
 	email := *user.Email
 	username := change.Username
-	unsubURL := engagement.GenerateScopedUnsubscribeURL(s.backendURL, user.ID, engagement.UnsubscribeScopeTierNotifications, s.jwtSecret)
+	unsubURL := engagement.GenerateScopedUnsubscribeURL(s.backendURL, user.ID, engagement.UnsubscribeScopeTierNotifications, s.keys.Current().Secret)
 
 	if isPromotion {
 		newPermissions := notification.TierPermissions(change.NewTier)
@@ -308,6 +322,7 @@ func (s *AutoPromotionService) writeTierChangeAuditLog(user *authm.User, change
 		"old_tier": change.OldTier,
 		"new_tier": change.NewTier,
 		"reason":   change.Reason,
+		"rule":     change.Rule,
 		"username": change.Username,
 	}
 	metadataJSON, err := json.Marshal(metadata)
@@ -398,6 +413,7 @@ func buildAdvancementProgress(eval *contracts.UserEvaluationResult) *contracts.A
 			numericReq(contracts.AdvancementReqApprovedEdits, approved, float64(ContributorMinEdits)),
 			numericReq(contracts.AdvancementReqAccountAgeDays, accountAgeDays, ContributorMinAccountAge.Hours()/24),
 			boolReq(contracts.AdvancementReqEmailVerified, eval.EmailVerified),
+			boolReq(contracts.AdvancementReqNoUpheldReports, eval.UpheldReports == 0),
 		}
 	case TierContributor:
 		progress.NextTier = TierTrustedContributor
@@ -405,6 +421,7 @@ func buildAdvancementProgress(eval *contracts.UserEvaluationResult) *contracts.A
 			numericReq(contracts.AdvancementReqApprovedEdits, approved, float64(TrustedMinEdits)),
 			numericReq(contracts.AdvancementReqApprovalRate, approvalPct, TrustedMinApprovalRate*100),
 			numericReq(contracts.AdvancementReqAccountAgeDays, accountAgeDays, TrustedMinAccountAge.Hours()/24),
+			boolReq(contracts.AdvancementReqNoUpheldReports, eval.UpheldReports == 0),
 		}
 	case TierTrustedContributor:
 		progress.NextTier = TierLocalAmbassador
@@ -412,6 +429,7 @@ func buildAdvancementProgress(eval *contracts.UserEvaluationResult) *contracts.A
 			numericReq(contracts.AdvancementReqApprovedEdits, approved, float64(AmbassadorMinEdits)),
 			numericReq(contracts.AdvancementReqCityEdits, cityEdits, float64(AmbassadorMinCityEdits)),
 			numericReq(contracts.AdvancementReqAccountAgeDays, accountAgeDays, AmbassadorMinAccountAge.Hours()/24),
+			boolReq(contracts.AdvancementReqNoUpheldReports, eval.UpheldReports == 0),
 		}
 	case TierLocalAmbassador:
 		// Highest tier — empty next + requirements.
@@ -469,8 +487,22 @@ func (s *AutoPromotionService) evaluateUserInternal(user *authm.User, includeDem
 		return nil, fmt.Errorf("failed to count revisions: %w", err)
 	}
 
-	totalApproved := int(pendingApproved) + int(revisionCount)
-	totalEdits := int(pendingTotal) + int(revisionCount)
+	// Count reviewed show submissions (approved shows are approved edits,
+	// rejected ones count against the approval rate)
+	var showsApproved, showsRejected int64
+	if err := s.db.Model(&catalogm.Show{}).
+		Where("submitted_by = ? AND status = ? AND deleted_at IS NULL", user.ID, catalogm.ShowStatusApproved).
+		Count(&showsApproved).Error; err != nil {
+		return nil, fmt.Errorf("failed to count approved show submissions: %w", err)
+	}
+	if err := s.db.Model(&catalogm.Show{}).
+		Where("submitted_by = ? AND status = ?", user.ID, catalogm.ShowStatusRejected).
+		Count(&showsRejected).Error; err != nil {
+		return nil, fmt.Errorf("failed to count rejected show submissions: %w", err)
+	}
+
+	totalApproved := int(pendingApproved) + int(revisionCount) + int(showsApproved)
+	totalEdits := int(pendingTotal) + int(revisionCount) + int(showsApproved) + int(showsRejected)
 
 	// Calculate approval rate (revisions are always "approved" — they're direct edits)
 	var approvalRate float64
@@ -481,6 +513,11 @@ func (s *AutoPromotionService) evaluateUserInternal(user *authm.User, includeDem
 	// Count city edits for local ambassador check
 	cityEditCount := s.countCityEdits(user.ID)
 
+	upheldReports, err := s.countUpheldReports(user.ID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
 	eval := &contracts.UserEvaluationResult{
 		UserID:        user.ID,
 		CurrentTier:   user.UserTier,
@@ -490,6 +527,7 @@ func (s *AutoPromotionService) evaluateUserInternal(user *authm.User, includeDem
 		AccountAge:    accountAge,
 		EmailVerified: user.EmailVerified,
 		CityEditCount: cityEditCount,
+		UpheldReports: upheldReports,
 	}
 
 	if !includeDemotion {
@@ -531,22 +569,38 @@ func (s *AutoPromotionService) evaluateUserInternal(user *authm.User, includeDem
 	eval.Rolling30dRate = rolling30dRate
 	eval.Rolling30dTotal = rolling30dEditTotal
 
+	rolling30dUpheld, err := s.countUpheldReports(user.ID, thirtyDaysAgo)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check demotion first (rolling 30-day window)
-	if s.shouldDemote(user, rolling30dRate, rolling30dEditTotal) {
-		currentOrder := tierOrder[user.UserTier]
-		if currentOrder > 0 {
-			newTier := tierByOrder[currentOrder-1]
+	if currentOrder := tierOrder[user.UserTier]; currentOrder > 0 {
+		switch {
+		case rolling30dUpheld > 0:
+			eval.Changed = true
+			eval.NewTier = tierByOrder[currentOrder-1]
+			eval.Rule = TierRuleDemoteUpheldReport
+			eval.Reason = fmt.Sprintf("%d upheld report(s) against your content in the last 30 days", rolling30dUpheld)
+			return eval, nil
+		case s.shouldDemote(user, rolling30dRate, rolling30dEditTotal):
 			eval.Changed = true
-			eval.NewTier = newTier
+			eval.NewTier = tierByOrder[currentOrder-1]
+			eval.Rule = TierRuleDemoteApprovalRate
 			eval.Reason = fmt.Sprintf("approval rate %.0f%% below 80%% threshold in rolling 30-day window (%d edits)", rolling30dRate*100, rolling30dEditTotal)
 			return eval, nil
 		}
 	}
 
-	// Check promotion (at most one tier per evaluation)
-	if promoted, newTier, reason := s.shouldPromote(user, totalApproved, approvalRate, accountAge, cityEditCount); promoted {
+	// Check promotion (at most one tier per evaluation). Any upheld report
+	// blocks promotion.
+	if upheldReports > 0 {
+		return eval, nil
+	}
+	if promoted, newTier, rule, reason := s.shouldPromote(user, totalApproved, approvalRate, accountAge, cityEditCount); promoted {
 		eval.Changed = true
 		eval.NewTier = newTier
+		eval.Rule = rule
 		eval.Reason = reason
 		return eval, nil
 	}
@@ -567,14 +621,14 @@ func (s *AutoPromotionService) shouldDemote(user *authm.User, rolling30dRate flo
 	return rolling30dRate < DemotionApprovalRateThreshold
 }
 
-// shouldPromote checks if the user should be promoted and returns (shouldPromote, newTier, reason).
-func (s *AutoPromotionService) shouldPromote(user *authm.User, approvedEdits int, approvalRate float64, accountAge time.Duration, cityEdits int) (bool, string, string) {
+// shouldPromote checks if the user should be promoted and returns (shouldPromote, newTier, rule, reason).
+func (s *AutoPromotionService) shouldPromote(user *authm.User, approvedEdits int, approvalRate float64, accountAge time.Duration, cityEdits int) (bool, string, string, string) {
 	switch user.UserTier {
 	case TierNewUser:
 		if approvedEdits >= ContributorMinEdits &&
 			accountAge >= ContributorMinAccountAge &&
 			user.EmailVerified {
-			return true, TierContributor, fmt.Sprintf(
+			return true, TierContributor, TierRulePromoteContributor, fmt.Sprintf(
 				"%d approved edits, account age %d days, email verified",
 				approvedEdits, int(accountAge.Hours()/24),
 			)
@@ -583,7 +637,7 @@ func (s *AutoPromotionService) shouldPromote(user *authm.User, approvedEdits int
 		if approvedEdits >= TrustedMinEdits &&
 			approvalRate >= TrustedMinApprovalRate &&
 			accountAge >= TrustedMinAccountAge {
-			return true, TierTrustedContributor, fmt.Sprintf(
+			return true, TierTrustedContributor, TierRulePromoteTrusted, fmt.Sprintf(
 				"%d approved edits, %.0f%% approval rate, account age %d days",
 				approvedEdits, approvalRate*100, int(accountAge.Hours()/24),
 			)
@@ -592,7 +646,7 @@ func (s *AutoPromotionService) shouldPromote(user *authm.User, approvedEdits int
 		if approvedEdits >= AmbassadorMinEdits &&
 			accountAge >= AmbassadorMinAccountAge &&
 			cityEdits >= AmbassadorMinCityEdits {
-			return true, TierLocalAmbassador, fmt.Sprintf(
+			return true, TierLocalAmbassador, TierRulePromoteAmbassador, fmt.Sprintf(
 				"%d approved edits, %d city edits, account age %d days",
 				approvedEdits, cityEdits, int(accountAge.Hours()/24),
 			)
@@ -600,7 +654,7 @@ func (s *AutoPromotionService) shouldPromote(user *authm.User, approvedEdits int
 	case TierLocalAmbassador:
 		// Already at the highest tier
 	}
-	return false, "", ""
+	return false, "", "", ""
 }
 
 // countCityEdits counts edits related to a specific city for a user.
@@ -632,3 +686,23 @@ func (s *AutoPromotionService) countCityEdits(userID uint) int {
 
 	return int(venueEdits + artistEdits + venueRevisions + artistRevisions)
 }
+
+// countUpheldReports counts resolved reports against content the user
+// authored (comments and collections). A zero since counts all time;
+// otherwise only reports resolved at or after since are counted.
+func (s *AutoPromotionService) countUpheldReports(userID uint, since time.Time) (int, error) {
+	query := s.db.Table("entity_reports r").
+		Joins("LEFT JOIN comments c ON r.entity_type = ? AND c.id = r.entity_id", communitym.EntityReportEntityComment).
+		Joins("LEFT JOIN collections col ON r.entity_type = ? AND col.id = r.entity_id", communitym.EntityReportEntityCollection).
+		Where("r.status = ?", communitym.EntityReportStatusResolved).
+		Where("c.user_id = ? OR col.creator_id = ?", userID, userID)
+	if !since.IsZero() {
+		query = query.Where("r.reviewed_at >= ?", since)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count upheld reports: %w", err)
+	}
+	return int(count), nil
+}
@@ -19,19 +19,30 @@ func TestBuildAdvancementProgress_NewUser(t *testing.T) {
 	if got.CurrentTier != TierNewUser || got.NextTier != TierContributor {
 		t.Fatalf("tiers: current=%q next=%q", got.CurrentTier, got.NextTier)
 	}
-	if len(got.Requirements) != 3 {
-		t.Fatalf("want 3 requirements, got %d", len(got.Requirements))
+	if len(got.Requirements) != 4 {
+		t.Fatalf("want 4 requirements, got %d", len(got.Requirements))
 	}
 	assertNumericReq(t, got.Requirements[0], contracts.AdvancementReqApprovedEdits, 3, 5, false)
-	assertNumericReq(t, got.Requirements[1], contracts.AdvancementReqAccountAgeDays, 10, 14, false)
+	assertNumericReq(t, got.Requirements[1], contracts.AdvancementReqAccountAgeDays, 10, 30, false)
 	assertBoolReq(t, got.Requirements[2], contracts.AdvancementReqEmailVerified, true)
+	assertBoolReq(t, got.Requirements[3], contracts.AdvancementReqNoUpheldReports, true)
+}
+
+func TestBuildAdvancementProgress_UpheldReportsUnmet(t *testing.T) {
+	eval := &contracts.UserEvaluationResult{
+		CurrentTier:   TierContributor,
+		UpheldReports: 2,
+	}
+	got := buildAdvancementProgress(eval)
+	last := got.Requirements[len(got.Requirements)-1]
+	assertBoolReq(t, last, contracts.AdvancementReqNoUpheldReports, false)
 }
 
 func TestBuildAdvancementProgress_NewUserFullyMet(t *testing.T) {
 	eval := &contracts.UserEvaluationResult{
 		CurrentTier:   TierNewUser,
 		ApprovedEdits: 5,
-		AccountAge:    14 * 24 * time.Hour,
+		AccountAge:    30 * 24 * time.Hour,
 		EmailVerified: true,
 	}
 	got := buildAdvancementProgress(eval)
@@ -53,8 +64,8 @@ func TestBuildAdvancementProgress_Contributor(t *testing.T) {
 	if got.NextTier != TierTrustedContributor {
 		t.Fatalf("next=%q", got.NextTier)
 	}
-	if len(got.Requirements) != 3 {
-		t.Fatalf("want 3 requirements, got %d", len(got.Requirements))
+	if len(got.Requirements) != 4 {
+		t.Fatalf("want 4 requirements, got %d", len(got.Requirements))
 	}
 	assertNumericReq(t, got.Requirements[0], contracts.AdvancementReqApprovedEdits, 20, 25, false)
 	assertNumericReq(t, got.Requirements[1], contracts.AdvancementReqApprovalRate, 97, 95, true)
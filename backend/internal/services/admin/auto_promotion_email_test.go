@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	"psychic-homily-backend/internal/config"
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
@@ -57,7 +59,7 @@ func (m *mockEmailService) IsConfigured() bool                                {
 func (m *mockEmailService) SendVerificationEmail(_, _ string) error           { return nil }
 func (m *mockEmailService) SendMagicLinkEmail(_, _ string) error              { return nil }
 func (m *mockEmailService) SendAccountRecoveryEmail(_, _ string, _ int) error { return nil }
-func (m *mockEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string) error {
+func (m *mockEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string, _ int) error {
 	return nil
 }
 func (m *mockEmailService) SendFilterNotificationEmail(_, _, _, _ string) error { return nil }
@@ -85,7 +87,10 @@ func (m *mockEmailService) SendCommentNotification(_, _, _, _, _, _, _ string) e
 func (m *mockEmailService) SendMentionNotification(_, _, _, _, _, _, _ string) error {
 	return nil
 }
-func (m *mockEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ string) error {
+func (m *mockEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ []contracts.SceneDigestShow, _ string) error {
+	return nil
+}
+func (m *mockEmailService) SendShowStatusChangeEmail(_ string, _ contracts.ShowStatusChange, _ string) error {
 	return nil
 }
 
@@ -194,9 +199,9 @@ func (s *AutoPromotionEmailTestSuite) createTestArtist(name string) *catalogm.Ar
 // TestPromotionSendsEmail verifies that a promotion triggers a promotion email.
 func (s *AutoPromotionEmailTestSuite) TestPromotionSendsEmail() {
 	emailSvc := &mockEmailService{configured: true}
-	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
-	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-15*24*time.Hour), "promo@test.com")
+	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-31*24*time.Hour), "promo@test.com")
 	artist := s.createTestArtist("Promo Artist")
 
 	for i := 0; i < 5; i++ {
@@ -224,9 +229,9 @@ func (s *AutoPromotionEmailTestSuite) TestPromotionSendsEmail() {
 // flag suppresses the email but not the tier change itself (PSY-756).
 func (s *AutoPromotionEmailTestSuite) TestPromotionSuppressedWhenOptedOut() {
 	emailSvc := &mockEmailService{configured: true}
-	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
-	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-15*24*time.Hour), "optout@test.com")
+	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-31*24*time.Hour), "optout@test.com")
 	// Opt the user out of tier-change emails.
 	s.Require().NoError(s.db.Create(&authm.UserPreferences{
 		UserID:                    user.ID,
@@ -259,7 +264,7 @@ func (s *AutoPromotionEmailTestSuite) TestPromotionSuppressedWhenOptedOut() {
 // TestDemotionSendsEmail verifies that a demotion triggers a demotion email.
 func (s *AutoPromotionEmailTestSuite) TestDemotionSendsEmail() {
 	emailSvc := &mockEmailService{configured: true}
-	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
 	user := s.createUserWithEmail(TierContributor, true, time.Now().Add(-60*24*time.Hour), "demote@test.com")
 	artist := s.createTestArtist("Demote Artist")
@@ -288,9 +293,9 @@ func (s *AutoPromotionEmailTestSuite) TestEmailErrorDoesNotFailPromotion() {
 		configured:     true,
 		promotionError: fmt.Errorf("email send failed"),
 	}
-	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
-	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-15*24*time.Hour), "fail@test.com")
+	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-31*24*time.Hour), "fail@test.com")
 	artist := s.createTestArtist("Error Artist")
 
 	for i := 0; i < 5; i++ {
@@ -313,9 +318,9 @@ func (s *AutoPromotionEmailTestSuite) TestEmailErrorDoesNotFailPromotion() {
 
 // TestNilEmailServiceDoesNotPanic verifies that nil email service is handled gracefully.
 func (s *AutoPromotionEmailTestSuite) TestNilEmailServiceDoesNotPanic() {
-	svc := NewAutoPromotionService(s.db, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
-	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-15*24*time.Hour), "nil@test.com")
+	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-31*24*time.Hour), "nil@test.com")
 	artist := s.createTestArtist("Nil Artist")
 
 	for i := 0; i < 5; i++ {
@@ -331,9 +336,9 @@ func (s *AutoPromotionEmailTestSuite) TestNilEmailServiceDoesNotPanic() {
 // TestUnconfiguredEmailServiceSkipsEmail verifies that unconfigured email service is handled.
 func (s *AutoPromotionEmailTestSuite) TestUnconfiguredEmailServiceSkipsEmail() {
 	emailSvc := &mockEmailService{configured: false}
-	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, emailSvc, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
-	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-15*24*time.Hour), "unconfig@test.com")
+	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-31*24*time.Hour), "unconfig@test.com")
 	artist := s.createTestArtist("Unconfig Artist")
 
 	for i := 0; i < 5; i++ {
@@ -350,9 +355,9 @@ func (s *AutoPromotionEmailTestSuite) TestUnconfiguredEmailServiceSkipsEmail() {
 
 // TestAuditLogWrittenOnPromotion verifies that an audit log entry is created for promotions.
 func (s *AutoPromotionEmailTestSuite) TestAuditLogWrittenOnPromotion() {
-	svc := NewAutoPromotionService(s.db, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
-	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-15*24*time.Hour), "audit@test.com")
+	user := s.createUserWithEmail(TierNewUser, true, time.Now().Add(-31*24*time.Hour), "audit@test.com")
 	artist := s.createTestArtist("Audit Artist")
 
 	for i := 0; i < 5; i++ {
@@ -372,12 +377,16 @@ func (s *AutoPromotionEmailTestSuite) TestAuditLogWrittenOnPromotion() {
 	s.Equal("user", auditLog.EntityType)
 	s.Equal(user.ID, auditLog.EntityID)
 	s.Nil(auditLog.ActorID) // system action
-	s.NotNil(auditLog.Metadata)
+	s.Require().NotNil(auditLog.Metadata)
+
+	var metadata map[string]interface{}
+	s.Require().NoError(json.Unmarshal(*auditLog.Metadata, &metadata))
+	s.Equal(TierRulePromoteContributor, metadata["rule"])
 }
 
 // TestAuditLogWrittenOnDemotion verifies that an audit log entry is created for demotions.
 func (s *AutoPromotionEmailTestSuite) TestAuditLogWrittenOnDemotion() {
-	svc := NewAutoPromotionService(s.db, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(s.db, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
 	user := s.createUserWithEmail(TierContributor, true, time.Now().Add(-60*24*time.Hour), "audit-demote@test.com")
 	artist := s.createTestArtist("Audit Demote Artist")
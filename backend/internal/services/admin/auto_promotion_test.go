@@ -11,9 +11,11 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	"psychic-homily-backend/internal/config"
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
 	"psychic-homily-backend/internal/testutil"
 )
 
@@ -22,7 +24,7 @@ import (
 // =============================================================================
 
 func TestNewAutoPromotionService(t *testing.T) {
-	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 	assert.NotNil(t, svc)
 	assert.Equal(t, DefaultAutoPromotionInterval, svc.interval)
 	assert.NotNil(t, svc.stopCh)
@@ -31,19 +33,19 @@ func TestNewAutoPromotionService(t *testing.T) {
 
 func TestNewAutoPromotionService_EnvOverride(t *testing.T) {
 	t.Setenv("AUTO_PROMOTION_INTERVAL_HOURS", "12")
-	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 	assert.Equal(t, 12*time.Hour, svc.interval)
 }
 
 func TestNewAutoPromotionService_InvalidEnvIgnored(t *testing.T) {
 	t.Setenv("AUTO_PROMOTION_INTERVAL_HOURS", "not-a-number")
-	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 	assert.Equal(t, DefaultAutoPromotionInterval, svc.interval)
 }
 
 func TestNewAutoPromotionService_ZeroEnvIgnored(t *testing.T) {
 	t.Setenv("AUTO_PROMOTION_INTERVAL_HOURS", "0")
-	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 	assert.Equal(t, DefaultAutoPromotionInterval, svc.interval)
 }
 
@@ -64,7 +66,7 @@ func TestAutoPromotionService_EvaluateUser_NilDB(t *testing.T) {
 }
 
 func TestAutoPromotionService_StartStop(t *testing.T) {
-	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", "test-jwt-secret")
+	svc := NewAutoPromotionService(nil, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -110,7 +112,7 @@ type AutoPromotionIntegrationTestSuite struct {
 func (s *AutoPromotionIntegrationTestSuite) SetupSuite() {
 	s.testDB = testutil.SetupTestPostgres(s.T())
 	s.db = s.testDB.DB
-	s.svc = NewAutoPromotionService(s.db, nil, "http://localhost:8080", "test-jwt-secret")
+	s.svc = NewAutoPromotionService(s.db, nil, "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 }
 
 func (s *AutoPromotionIntegrationTestSuite) TearDownSuite() {
@@ -122,6 +124,9 @@ func (s *AutoPromotionIntegrationTestSuite) TearDownTest() {
 	s.Require().NoError(err)
 	_, _ = sqlDB.Exec("DELETE FROM pending_entity_edits")
 	_, _ = sqlDB.Exec("DELETE FROM revisions")
+	_, _ = sqlDB.Exec("DELETE FROM entity_reports")
+	_, _ = sqlDB.Exec("DELETE FROM collections")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
 	_, _ = sqlDB.Exec("DELETE FROM artists")
 	_, _ = sqlDB.Exec("DELETE FROM venues")
 	_, _ = sqlDB.Exec("DELETE FROM users")
@@ -221,6 +226,39 @@ func (s *AutoPromotionIntegrationTestSuite) createTestVenue(name, city, state st
 	return venue
 }
 
+func (s *AutoPromotionIntegrationTestSuite) createSubmittedShow(userID uint, status catalogm.ShowStatus) {
+	show := &catalogm.Show{
+		Title:       fmt.Sprintf("Submitted Show %d", time.Now().UnixNano()),
+		EventDate:   time.Now().Add(24 * time.Hour),
+		Status:      status,
+		Source:      catalogm.ShowSourceUser,
+		SubmittedBy: &userID,
+	}
+	s.Require().NoError(s.db.Create(show).Error)
+}
+
+// createUpheldCollectionReport files a report against a collection owned by
+// userID and resolves it at reviewedAt.
+func (s *AutoPromotionIntegrationTestSuite) createUpheldCollectionReport(userID uint, reviewedAt time.Time) {
+	collection := &communitym.Collection{
+		Title:     "Reported Collection",
+		Slug:      fmt.Sprintf("reported-collection-%d", time.Now().UnixNano()),
+		CreatorID: userID,
+	}
+	s.Require().NoError(s.db.Create(collection).Error)
+
+	reporter := s.createUser(TierNewUser, true, time.Now())
+	report := &communitym.EntityReport{
+		EntityType: communitym.EntityReportEntityCollection,
+		EntityID:   collection.ID,
+		ReportedBy: reporter.ID,
+		ReportType: "spam",
+		Status:     communitym.EntityReportStatusResolved,
+		ReviewedAt: &reviewedAt,
+	}
+	s.Require().NoError(s.db.Create(report).Error)
+}
+
 func testRawJSON() *json.RawMessage {
 	raw := json.RawMessage(`[{"field":"name","old_value":"old","new_value":"new"}]`)
 	return &raw
@@ -231,8 +269,8 @@ func testRawJSON() *json.RawMessage {
 // =============================================================================
 
 func (s *AutoPromotionIntegrationTestSuite) TestPromoteNewUserToContributor() {
-	// User with 5+ approved edits, 2+ weeks, email verified
-	user := s.createUser(TierNewUser, true, time.Now().Add(-15*24*time.Hour))
+	// User with 5+ approved edits, 30+ days, email verified
+	user := s.createUser(TierNewUser, true, time.Now().Add(-31*24*time.Hour))
 	artist := s.createTestArtist("Test Artist")
 
 	for i := 0; i < 5; i++ {
@@ -243,13 +281,14 @@ func (s *AutoPromotionIntegrationTestSuite) TestPromoteNewUserToContributor() {
 	s.Require().NoError(err)
 	s.True(result.Changed)
 	s.Equal(TierContributor, result.NewTier)
+	s.Equal(TierRulePromoteContributor, result.Rule)
 	s.Equal(5, result.ApprovedEdits)
 	s.True(result.EmailVerified)
 }
 
 func (s *AutoPromotionIntegrationTestSuite) TestNotPromotedNewUser_NotEnoughEdits() {
 	// User with only 4 approved edits (needs 5)
-	user := s.createUser(TierNewUser, true, time.Now().Add(-15*24*time.Hour))
+	user := s.createUser(TierNewUser, true, time.Now().Add(-31*24*time.Hour))
 	artist := s.createTestArtist("Test Artist")
 
 	for i := 0; i < 4; i++ {
@@ -263,7 +302,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestNotPromotedNewUser_NotEnoughEdit
 }
 
 func (s *AutoPromotionIntegrationTestSuite) TestNotPromotedNewUser_AccountTooNew() {
-	// User with enough edits but account created today (needs 2 weeks)
+	// User with enough edits but account created today (needs 30 days)
 	user := s.createUser(TierNewUser, true, time.Now().Add(-1*24*time.Hour))
 	artist := s.createTestArtist("Test Artist")
 
@@ -278,7 +317,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestNotPromotedNewUser_AccountTooNew
 
 func (s *AutoPromotionIntegrationTestSuite) TestNotPromotedNewUser_EmailNotVerified() {
 	// User with enough edits and account age, but email not verified
-	user := s.createUser(TierNewUser, false, time.Now().Add(-15*24*time.Hour))
+	user := s.createUser(TierNewUser, false, time.Now().Add(-31*24*time.Hour))
 	artist := s.createTestArtist("Test Artist")
 
 	for i := 0; i < 5; i++ {
@@ -427,10 +466,67 @@ func (s *AutoPromotionIntegrationTestSuite) TestLocalAmbassadorAlreadyAtTop() {
 	s.False(result.Changed)
 }
 
+func (s *AutoPromotionIntegrationTestSuite) TestPromoteNewUser_ApprovedShowSubmissionsCount() {
+	user := s.createUser(TierNewUser, true, time.Now().Add(-31*24*time.Hour))
+	artist := s.createTestArtist("Test Artist")
+
+	for i := 0; i < 2; i++ {
+		s.createApprovedPendingEdit(user.ID, "artist", artist.ID)
+	}
+	for i := 0; i < 3; i++ {
+		s.createSubmittedShow(user.ID, catalogm.ShowStatusApproved)
+	}
+	s.createSubmittedShow(user.ID, catalogm.ShowStatusPending)
+
+	result, err := s.svc.EvaluateUser(user.ID)
+	s.Require().NoError(err)
+	s.Equal(5, result.ApprovedEdits)
+	s.Equal(5, result.TotalEdits)
+	s.True(result.Changed)
+	s.Equal(TierContributor, result.NewTier)
+}
+
+func (s *AutoPromotionIntegrationTestSuite) TestNotPromotedNewUser_UpheldReport() {
+	user := s.createUser(TierNewUser, true, time.Now().Add(-90*24*time.Hour))
+	artist := s.createTestArtist("Test Artist")
+
+	for i := 0; i < 5; i++ {
+		s.createApprovedPendingEdit(user.ID, "artist", artist.ID)
+	}
+	s.createUpheldCollectionReport(user.ID, time.Now().Add(-60*24*time.Hour))
+
+	result, err := s.svc.EvaluateUser(user.ID)
+	s.Require().NoError(err)
+	s.False(result.Changed)
+	s.Equal(1, result.UpheldReports)
+}
+
 // =============================================================================
 // DEMOTION TESTS
 // =============================================================================
 
+func (s *AutoPromotionIntegrationTestSuite) TestDemoteContributor_RecentUpheldReport() {
+	user := s.createUser(TierContributor, true, time.Now().Add(-90*24*time.Hour))
+	s.createUpheldCollectionReport(user.ID, time.Now().Add(-2*24*time.Hour))
+
+	result, err := s.svc.EvaluateUser(user.ID)
+	s.Require().NoError(err)
+	s.True(result.Changed)
+	s.Equal(TierNewUser, result.NewTier)
+	s.Equal(TierRuleDemoteUpheldReport, result.Rule)
+}
+
+func (s *AutoPromotionIntegrationTestSuite) TestNoDemotion_OldUpheldReport() {
+	// Reports resolved outside the rolling window block promotion but no
+	// longer demote.
+	user := s.createUser(TierContributor, true, time.Now().Add(-90*24*time.Hour))
+	s.createUpheldCollectionReport(user.ID, time.Now().Add(-45*24*time.Hour))
+
+	result, err := s.svc.EvaluateUser(user.ID)
+	s.Require().NoError(err)
+	s.False(result.Changed)
+}
+
 func (s *AutoPromotionIntegrationTestSuite) TestDemoteContributor_LowRolling30dApproval() {
 	// Contributor with <80% approval in last 30 days
 	user := s.createUser(TierContributor, true, time.Now().Add(-60*24*time.Hour))
@@ -446,6 +542,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestDemoteContributor_LowRolling30dA
 	s.Require().NoError(err)
 	s.True(result.Changed)
 	s.Equal(TierNewUser, result.NewTier)
+	s.Equal(TierRuleDemoteApprovalRate, result.Rule)
 	s.Contains(result.Reason, "approval rate")
 	s.Contains(result.Reason, "below 80%")
 }
@@ -519,7 +616,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestEvaluateAllUsers_MultipleMixed()
 	artist := s.createTestArtist("Test Artist")
 
 	// User 1: should be promoted (new_user -> contributor)
-	user1 := s.createUser(TierNewUser, true, time.Now().Add(-15*24*time.Hour))
+	user1 := s.createUser(TierNewUser, true, time.Now().Add(-31*24*time.Hour))
 	for i := 0; i < 5; i++ {
 		s.createApprovedPendingEdit(user1.ID, "artist", artist.ID)
 	}
@@ -556,7 +653,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestEvaluateAllUsers_AppliesTierChan
 	artist := s.createTestArtist("Test Artist")
 
 	// User should be promoted
-	user := s.createUser(TierNewUser, true, time.Now().Add(-15*24*time.Hour))
+	user := s.createUser(TierNewUser, true, time.Now().Add(-31*24*time.Hour))
 	for i := 0; i < 5; i++ {
 		s.createApprovedPendingEdit(user.ID, "artist", artist.ID)
 	}
@@ -584,7 +681,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestEvaluateAllUsers_SkipsAdminUsers
 	}
 	err := s.db.Create(adminUser).Error
 	s.Require().NoError(err)
-	err = s.db.Model(adminUser).Update("created_at", time.Now().Add(-15*24*time.Hour)).Error
+	err = s.db.Model(adminUser).Update("created_at", time.Now().Add(-31*24*time.Hour)).Error
 	s.Require().NoError(err)
 
 	for i := 0; i < 10; i++ {
@@ -646,7 +743,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestEvaluateAllUsers_NoChangeWhenAlr
 
 func (s *AutoPromotionIntegrationTestSuite) TestRevisionsCountAsApprovedEdits() {
 	// User has revisions (from direct edits) instead of pending edits
-	user := s.createUser(TierNewUser, true, time.Now().Add(-15*24*time.Hour))
+	user := s.createUser(TierNewUser, true, time.Now().Add(-31*24*time.Hour))
 	artist := s.createTestArtist("Test Artist")
 
 	// 3 approved pending edits + 2 revisions = 5 total approved
@@ -666,7 +763,7 @@ func (s *AutoPromotionIntegrationTestSuite) TestRevisionsCountAsApprovedEdits()
 
 func (s *AutoPromotionIntegrationTestSuite) TestRevisionsOnlyCountAsApproved() {
 	// User with only revisions (all count as approved)
-	user := s.createUser(TierNewUser, true, time.Now().Add(-15*24*time.Hour))
+	user := s.createUser(TierNewUser, true, time.Now().Add(-31*24*time.Hour))
 	artist := s.createTestArtist("Test Artist")
 
 	for i := 0; i < 5; i++ {
@@ -37,10 +37,17 @@ type cleanupUserService interface {
 	PermanentlyDeleteUser(userID uint) error
 }
 
+// cleanupShowService is the minimal interface CleanupService needs from ShowService.
+type cleanupShowService interface {
+	GetExpiredDeletedShows() ([]uint, error)
+	PermanentlyDeleteShow(showID uint) error
+}
+
 // CleanupService handles background cleanup tasks
 type CleanupService struct {
 	db               *gorm.DB
 	userService      cleanupUserService
+	showService      cleanupShowService
 	interval         time.Duration
 	tagPruneInterval time.Duration
 	tagPruneEnabled  bool
@@ -51,8 +58,9 @@ type CleanupService struct {
 }
 
 // NewCleanupService creates a new cleanup service.
-// userSvc must implement GetExpiredDeletedAccounts and PermanentlyDeleteUser.
-func NewCleanupService(database *gorm.DB, userSvc cleanupUserService) *CleanupService {
+// userSvc must implement GetExpiredDeletedAccounts and PermanentlyDeleteUser;
+// showSvc must implement GetExpiredDeletedShows and PermanentlyDeleteShow.
+func NewCleanupService(database *gorm.DB, userSvc cleanupUserService, showSvc cleanupShowService) *CleanupService {
 	if database == nil {
 		database = db.GetDB()
 	}
@@ -88,6 +96,7 @@ func NewCleanupService(database *gorm.DB, userSvc cleanupUserService) *CleanupSe
 	return &CleanupService{
 		db:               database,
 		userService:      userSvc,
+		showService:      showSvc,
 		interval:         interval,
 		tagPruneInterval: tagPruneInterval,
 		tagPruneEnabled:  tagPruneEnabled,
@@ -138,8 +147,17 @@ func (s *CleanupService) runTagPruneLoop(ctx context.Context) {
 	})
 }
 
-// runCleanupCycle performs a single cleanup cycle
+// runCleanupCycle performs a single cleanup cycle: expired soft-deleted
+// accounts first, then expired soft-deleted shows, then expired
+// Idempotency-Key responses.
 func (s *CleanupService) runCleanupCycle() {
+	s.purgeExpiredAccounts()
+	s.purgeExpiredShows()
+	s.purgeExpiredIdempotencyKeys()
+}
+
+// purgeExpiredAccounts permanently deletes accounts past the recovery grace period.
+func (s *CleanupService) purgeExpiredAccounts() {
 	s.logger.Info("starting account cleanup cycle")
 
 	expiredAccounts, err := s.userService.GetExpiredDeletedAccounts()
@@ -198,6 +216,61 @@ func (s *CleanupService) runCleanupCycle() {
 	)
 }
 
+// purgeExpiredShows permanently deletes shows past the retention period.
+func (s *CleanupService) purgeExpiredShows() {
+	expiredShows, err := s.showService.GetExpiredDeletedShows()
+	if err != nil {
+		s.logger.Error("failed to get expired deleted shows",
+			"error", err,
+		)
+		return
+	}
+
+	if len(expiredShows) == 0 {
+		s.logger.Info("no expired shows to purge")
+		return
+	}
+
+	purgedCount := 0
+	for _, showID := range expiredShows {
+		if err := s.showService.PermanentlyDeleteShow(showID); err != nil {
+			s.logger.Error("failed to permanently delete show",
+				"show_id", showID,
+				"error", err,
+			)
+			continue
+		}
+		purgedCount++
+	}
+
+	s.logger.Info("show retention sweep completed",
+		"total_expired", len(expiredShows),
+		"purged", purgedCount,
+		"failed", len(expiredShows)-purgedCount,
+	)
+}
+
+// purgeExpiredIdempotencyKeys deletes stored responses past their replay
+// window. Expired rows are already ignored (and overwritten) on claim, so
+// this only keeps the table small.
+func (s *CleanupService) purgeExpiredIdempotencyKeys() {
+	if s.db == nil {
+		return
+	}
+	result := s.db.Where("expires_at <= ?", time.Now()).Delete(&adminm.IdempotencyKey{})
+	if result.Error != nil {
+		s.logger.Error("failed to purge expired idempotency keys",
+			"error", result.Error,
+		)
+		return
+	}
+	if result.RowsAffected > 0 {
+		s.logger.Info("purged expired idempotency keys",
+			"count", result.RowsAffected,
+		)
+	}
+}
+
 // RunCleanupNow triggers an immediate cleanup cycle (useful for testing)
 func (s *CleanupService) RunCleanupNow() {
 	s.runCleanupCycle()
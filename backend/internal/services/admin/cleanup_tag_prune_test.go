@@ -341,7 +341,7 @@ func (s *CleanupTagPruneTestSuite) TestPrune_OnlyTargetApplicationRemoved() {
 
 // Constructor env-var parsing: flags are picked up correctly.
 func TestNewCleanupService_TagPruneDefaults(t *testing.T) {
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	if svc.tagPruneInterval != DefaultTagPruneInterval {
 		t.Errorf("expected default tag prune interval %v, got %v", DefaultTagPruneInterval, svc.tagPruneInterval)
 	}
@@ -358,7 +358,7 @@ func TestNewCleanupService_TagPruneEnvOverrides(t *testing.T) {
 	t.Setenv("TAG_PRUNE_ENABLED", "false")
 	t.Setenv("TAG_PRUNE_DRY_RUN", "true")
 
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	if svc.tagPruneInterval != 6*time.Hour {
 		t.Errorf("expected 6h interval, got %v", svc.tagPruneInterval)
 	}
@@ -375,7 +375,7 @@ func TestNewCleanupService_TagPruneInvalidEnvIgnored(t *testing.T) {
 	t.Setenv("TAG_PRUNE_ENABLED", "not-a-bool")
 	t.Setenv("TAG_PRUNE_DRY_RUN", "nope")
 
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	if svc.tagPruneInterval != DefaultTagPruneInterval {
 		t.Errorf("expected default interval on invalid env, got %v", svc.tagPruneInterval)
 	}
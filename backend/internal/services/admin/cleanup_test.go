@@ -21,11 +21,29 @@ func (s *stubUserService) PermanentlyDeleteUser(userID uint) error {
 	return fmt.Errorf("database not initialized")
 }
 
+// stubShowService records which shows the retention sweep purged.
+type stubShowService struct {
+	expired []uint
+	failIDs map[uint]bool
+	purged  []uint
+}
+
+func (s *stubShowService) GetExpiredDeletedShows() ([]uint, error) {
+	return s.expired, nil
+}
+func (s *stubShowService) PermanentlyDeleteShow(showID uint) error {
+	if s.failIDs[showID] {
+		return fmt.Errorf("delete failed")
+	}
+	s.purged = append(s.purged, showID)
+	return nil
+}
+
 // --- NewCleanupService ---
 
 func TestNewCleanupService(t *testing.T) {
 	// Pass nil DB — will call db.GetDB() which returns nil in test env
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	assert.NotNil(t, svc)
 	assert.Equal(t, DefaultCleanupInterval, svc.interval)
 	assert.NotNil(t, svc.stopCh)
@@ -34,32 +52,44 @@ func TestNewCleanupService(t *testing.T) {
 
 func TestNewCleanupService_EnvOverride(t *testing.T) {
 	t.Setenv("CLEANUP_INTERVAL_HOURS", "12")
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	assert.Equal(t, 12*time.Hour, svc.interval)
 }
 
 func TestNewCleanupService_InvalidEnvIgnored(t *testing.T) {
 	t.Setenv("CLEANUP_INTERVAL_HOURS", "not-a-number")
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	assert.Equal(t, DefaultCleanupInterval, svc.interval)
 }
 
 func TestNewCleanupService_ZeroEnvIgnored(t *testing.T) {
 	t.Setenv("CLEANUP_INTERVAL_HOURS", "0")
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	assert.Equal(t, DefaultCleanupInterval, svc.interval)
 }
 
 func TestNewCleanupService_NegativeEnvIgnored(t *testing.T) {
 	t.Setenv("CLEANUP_INTERVAL_HOURS", "-5")
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	assert.Equal(t, DefaultCleanupInterval, svc.interval)
 }
 
+// --- Show retention sweep ---
+
+func TestCleanupService_PurgesExpiredShows(t *testing.T) {
+	shows := &stubShowService{expired: []uint{3, 7, 9}, failIDs: map[uint]bool{7: true}}
+	svc := NewCleanupService(nil, &stubUserService{}, shows)
+
+	// The account purge fails (stub has no DB); shows must still be swept.
+	svc.RunCleanupNow()
+
+	assert.Equal(t, []uint{3, 9}, shows.purged)
+}
+
 // --- Start / Stop lifecycle ---
 
 func TestCleanupService_StartStop(t *testing.T) {
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -85,7 +115,7 @@ func TestCleanupService_StartStop(t *testing.T) {
 }
 
 func TestCleanupService_ContextCancellation(t *testing.T) {
-	svc := NewCleanupService(nil, &stubUserService{})
+	svc := NewCleanupService(nil, &stubUserService{}, &stubShowService{})
 	ctx, cancel := context.WithCancel(context.Background())
 
 	svc.Start(ctx)
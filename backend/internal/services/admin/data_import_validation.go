@@ -0,0 +1,338 @@
+package admin
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// dataImportTokenTTL is how long a validated batch can be committed. Short,
+// because the database checks behind the report go stale.
+const dataImportTokenTTL = time.Hour
+
+// validShowStatuses are the statuses an imported show may carry; empty means
+// approved, matching importShow.
+var validShowStatuses = map[string]bool{
+	"":         true,
+	"approved": true,
+	"pending":  true,
+	"rejected": true,
+	"private":  true,
+}
+
+// importVenueKey identifies a venue the way the importer matches them.
+func importVenueKey(name, city string) string {
+	return strings.ToLower(strings.TrimSpace(name)) + "|" + strings.ToLower(strings.TrimSpace(city))
+}
+
+// importRowErrors collects validation problems for one batch.
+type importRowErrors []contracts.DataImportRowError
+
+func (e *importRowErrors) add(entity string, row int, field, code, message string) {
+	*e = append(*e, contracts.DataImportRowError{
+		Entity:  entity,
+		Row:     row,
+		Field:   field,
+		Code:    code,
+		Message: message,
+	})
+}
+
+// checkImportRows performs the checks that need no database: required
+// fields, date and status formats, and duplicates within the batch. It
+// returns the row errors and each show's parsed event date (zero when
+// invalid).
+func checkImportRows(req contracts.DataImportRequest) (importRowErrors, []time.Time) {
+	var errs importRowErrors
+
+	seenArtists := make(map[string]int)
+	for i, artist := range req.Artists {
+		name := strings.TrimSpace(artist.Name)
+		if name == "" {
+			errs.add("artist", i, "name", contracts.DataImportRowRequired, "artist name is required")
+			continue
+		}
+		key := strings.ToLower(name)
+		if first, ok := seenArtists[key]; ok {
+			errs.add("artist", i, "name", contracts.DataImportRowConflict,
+				fmt.Sprintf("artist '%s' duplicates artist row %d", name, first))
+			continue
+		}
+		seenArtists[key] = i
+	}
+
+	seenVenues := make(map[string]int)
+	for i, venue := range req.Venues {
+		missing := false
+		for _, f := range []struct{ field, value string }{
+			{"name", venue.Name}, {"city", venue.City}, {"state", venue.State},
+		} {
+			if strings.TrimSpace(f.value) == "" {
+				errs.add("venue", i, f.field, contracts.DataImportRowRequired, "venue "+f.field+" is required")
+				missing = true
+			}
+		}
+		if missing {
+			continue
+		}
+		key := importVenueKey(venue.Name, venue.City)
+		if first, ok := seenVenues[key]; ok {
+			errs.add("venue", i, "name", contracts.DataImportRowConflict,
+				fmt.Sprintf("venue '%s' in %s duplicates venue row %d", venue.Name, venue.City, first))
+			continue
+		}
+		seenVenues[key] = i
+	}
+
+	eventDates := make([]time.Time, len(req.Shows))
+	seenShows := make(map[string]int)
+	// Shows billing the same artist at the same venue and time would violate
+	// the show_artists (artist, venue, event_date) unique index.
+	seenBillings := make(map[string]int)
+	for i, show := range req.Shows {
+		if strings.TrimSpace(show.Title) == "" {
+			errs.add("show", i, "title", contracts.DataImportRowRequired, "show title is required")
+		}
+		if show.EventDate == "" {
+			errs.add("show", i, "eventDate", contracts.DataImportRowRequired, "event date is required")
+		} else if t, err := time.Parse(time.RFC3339, show.EventDate); err != nil {
+			errs.add("show", i, "eventDate", contracts.DataImportRowInvalidDate,
+				fmt.Sprintf("event date '%s' is not an RFC 3339 timestamp", show.EventDate))
+		} else {
+			eventDates[i] = t
+		}
+		if !validShowStatuses[strings.ToLower(show.Status)] {
+			errs.add("show", i, "status", contracts.DataImportRowInvalidValue,
+				fmt.Sprintf("unknown status '%s'", show.Status))
+		}
+		if len(show.Venues) == 0 {
+			errs.add("show", i, "venues", contracts.DataImportRowRequired, "at least one venue is required")
+		}
+		for j, venue := range show.Venues {
+			if strings.TrimSpace(venue.Name) == "" || strings.TrimSpace(venue.City) == "" {
+				errs.add("show", i, fmt.Sprintf("venues[%d]", j), contracts.DataImportRowRequired,
+					"show venue name and city are required")
+			}
+		}
+		for j, artist := range show.Artists {
+			if strings.TrimSpace(artist.Name) == "" {
+				errs.add("show", i, fmt.Sprintf("artists[%d]", j), contracts.DataImportRowRequired,
+					"show artist name is required")
+			}
+		}
+
+		if eventDates[i].IsZero() || len(show.Venues) == 0 || strings.TrimSpace(show.Title) == "" {
+			continue
+		}
+		when := eventDates[i].UTC().Format(time.RFC3339)
+		venueKey := importVenueKey(show.Venues[0].Name, show.Venues[0].City)
+		showKey := strings.ToLower(strings.TrimSpace(show.Title)) + "|" + venueKey + "|" + when
+		if first, ok := seenShows[showKey]; ok {
+			errs.add("show", i, "title", contracts.DataImportRowConflict,
+				fmt.Sprintf("show '%s' duplicates show row %d", show.Title, first))
+			continue
+		}
+		seenShows[showKey] = i
+		for _, venue := range show.Venues {
+			for _, artist := range show.Artists {
+				billing := strings.ToLower(strings.TrimSpace(artist.Name)) + "|" +
+					importVenueKey(venue.Name, venue.City) + "|" + when
+				if first, ok := seenBillings[billing]; ok && first != i {
+					errs.add("show", i, "artists", contracts.DataImportRowConflict,
+						fmt.Sprintf("'%s' is already billed at %s at this time in show row %d", artist.Name, venue.Name, first))
+					continue
+				}
+				seenBillings[billing] = i
+			}
+		}
+	}
+
+	return errs, eventDates
+}
+
+// ValidateImport runs a validation-only pass over a batch import and returns
+// a per-row report. When the batch is clean the payload is stored and the
+// report carries a one-time token for CommitImport; nothing else is written.
+func (s *DataSyncService) ValidateImport(req contracts.DataImportRequest, adminID uint) (*contracts.DataImportValidationReport, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	errs, eventDates := checkImportRows(req)
+	if err := s.checkImportAgainstDB(req, eventDates, &errs); err != nil {
+		return nil, err
+	}
+
+	report := &contracts.DataImportValidationReport{
+		Valid:   len(errs) == 0,
+		Shows:   len(req.Shows),
+		Artists: len(req.Artists),
+		Venues:  len(req.Venues),
+		Errors:  errs,
+	}
+	if report.Errors == nil {
+		report.Errors = []contracts.DataImportRowError{}
+	}
+	if !report.Valid {
+		return report, nil
+	}
+
+	req.DryRun = false
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode import payload: %w", err)
+	}
+	raw := json.RawMessage(payload)
+
+	token, err := generateImportToken()
+	if err != nil {
+		return nil, err
+	}
+	validation := &adminm.DataImportValidation{
+		TokenHash: hashToken(token),
+		AdminID:   adminID,
+		Payload:   &raw,
+		ExpiresAt: time.Now().Add(dataImportTokenTTL),
+	}
+	if err := s.db.Create(validation).Error; err != nil {
+		return nil, fmt.Errorf("failed to store import validation: %w", err)
+	}
+
+	report.Token = token
+	report.ExpiresAt = &validation.ExpiresAt
+	return report, nil
+}
+
+// checkImportAgainstDB adds the row errors that depend on existing data:
+// show venues that are neither in the database nor in the batch, and
+// billings that collide with a different existing show. Shows that already
+// exist (same title, venue and time) are not errors; the import skips them
+// as duplicates.
+func (s *DataSyncService) checkImportAgainstDB(req contracts.DataImportRequest, eventDates []time.Time, errs *importRowErrors) error {
+	batchVenues := make(map[string]bool, len(req.Venues))
+	for _, venue := range req.Venues {
+		batchVenues[importVenueKey(venue.Name, venue.City)] = true
+	}
+	knownVenues := make(map[string]bool)
+
+	for i, show := range req.Shows {
+		for j, venue := range show.Venues {
+			if strings.TrimSpace(venue.Name) == "" || strings.TrimSpace(venue.City) == "" {
+				continue
+			}
+			key := importVenueKey(venue.Name, venue.City)
+			if batchVenues[key] {
+				continue
+			}
+			known, checked := knownVenues[key]
+			if !checked {
+				var count int64
+				if err := s.db.Model(&catalogm.Venue{}).
+					Where("LOWER(name) = LOWER(?) AND LOWER(city) = LOWER(?)", strings.TrimSpace(venue.Name), strings.TrimSpace(venue.City)).
+					Count(&count).Error; err != nil {
+					return fmt.Errorf("failed to check venue '%s': %w", venue.Name, err)
+				}
+				known = count > 0
+				knownVenues[key] = known
+			}
+			if !known {
+				errs.add("show", i, fmt.Sprintf("venues[%d]", j), contracts.DataImportRowUnknownVenue,
+					fmt.Sprintf("venue '%s' in %s does not exist; add it to the batch's venues", venue.Name, venue.City))
+			}
+		}
+
+		if eventDates[i].IsZero() {
+			continue
+		}
+		for _, venue := range show.Venues {
+			for _, artist := range show.Artists {
+				if strings.TrimSpace(artist.Name) == "" {
+					continue
+				}
+				var existing catalogm.Show
+				err := s.db.Model(&catalogm.Show{}).
+					Select("shows.id, shows.title").
+					Joins("JOIN show_artists ON show_artists.show_id = shows.id").
+					Joins("JOIN artists ON artists.id = show_artists.artist_id").
+					Joins("JOIN venues ON venues.id = show_artists.venue_id").
+					Where("LOWER(artists.name) = LOWER(?) AND LOWER(venues.name) = LOWER(?) AND LOWER(venues.city) = LOWER(?)",
+						strings.TrimSpace(artist.Name), strings.TrimSpace(venue.Name), strings.TrimSpace(venue.City)).
+					Where("show_artists.event_date = ? AND LOWER(shows.title) <> LOWER(?)", eventDates[i].UTC(), show.Title).
+					First(&existing).Error
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("failed to check billing for '%s': %w", artist.Name, err)
+				}
+				errs.add("show", i, "artists", contracts.DataImportRowConflict,
+					fmt.Sprintf("'%s' is already billed at %s at this time on show '%s' (ID: %d)",
+						artist.Name, venue.Name, existing.Title, existing.ID))
+			}
+		}
+	}
+	return nil
+}
+
+// CommitImport imports the payload stored by ValidateImport. The token is
+// single-use, expires after dataImportTokenTTL, and only works for the admin
+// who validated the batch.
+func (s *DataSyncService) CommitImport(token string, adminID uint) (*contracts.DataImportResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var validation adminm.DataImportValidation
+	err := s.db.Where("token_hash = ? AND admin_id = ?", hashToken(token), adminID).First(&validation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrDataImportTokenNotFound()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up import validation: %w", err)
+	}
+	if validation.CommittedAt != nil {
+		return nil, apperrors.ErrDataImportTokenUsed()
+	}
+	if time.Now().After(validation.ExpiresAt) {
+		return nil, apperrors.ErrDataImportTokenExpired()
+	}
+
+	// Claim the token before importing so concurrent commits of the same
+	// batch cannot both run.
+	claim := s.db.Model(&adminm.DataImportValidation{}).
+		Where("id = ? AND committed_at IS NULL", validation.ID).
+		Update("committed_at", time.Now())
+	if claim.Error != nil {
+		return nil, fmt.Errorf("failed to claim import validation: %w", claim.Error)
+	}
+	if claim.RowsAffected == 0 {
+		return nil, apperrors.ErrDataImportTokenUsed()
+	}
+
+	var req contracts.DataImportRequest
+	if err := json.Unmarshal(*validation.Payload, &req); err != nil {
+		return nil, fmt.Errorf("failed to decode import payload: %w", err)
+	}
+	req.DryRun = false
+	return s.ImportData(req)
+}
+
+// generateImportToken creates a random validation token.
+func generateImportToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate import token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,89 @@
+package admin
+
+import (
+	"testing"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestCheckImportRows(t *testing.T) {
+	venue := contracts.ExportedVenue{Name: "Valley Bar", City: "Phoenix", State: "AZ"}
+	req := contracts.DataImportRequest{
+		Artists: []contracts.ExportedArtist{
+			{Name: "Sundressed"},
+			{Name: ""},
+			{Name: "sundressed"},
+		},
+		Venues: []contracts.ExportedVenue{
+			venue,
+			{Name: "No City", State: "AZ"},
+		},
+		Shows: []contracts.ExportedShow{
+			// 0: valid
+			{Title: "Night One", EventDate: "2026-09-01T03:00:00Z", Venues: []contracts.ExportedVenue{venue},
+				Artists: []contracts.ExportedShowArtist{{Name: "Sundressed"}}},
+			// 1: bad date and status
+			{Title: "Night Two", EventDate: "09/02/2026", Status: "maybe", Venues: []contracts.ExportedVenue{venue}},
+			// 2: no venue
+			{Title: "Night Three", EventDate: "2026-09-03T03:00:00Z"},
+			// 3: duplicates row 0
+			{Title: "night one", EventDate: "2026-09-01T03:00:00Z", Venues: []contracts.ExportedVenue{venue}},
+			// 4: same artist, venue and time as row 0 under another title
+			{Title: "Late Add", EventDate: "2026-09-01T03:00:00Z", Venues: []contracts.ExportedVenue{venue},
+				Artists: []contracts.ExportedShowArtist{{Name: "SUNDRESSED"}}},
+		},
+	}
+
+	errs, dates := checkImportRows(req)
+
+	type key struct {
+		entity string
+		row    int
+		field  string
+		code   string
+	}
+	got := make(map[key]bool)
+	for _, e := range errs {
+		got[key{e.Entity, e.Row, e.Field, e.Code}] = true
+	}
+	want := []key{
+		{"artist", 1, "name", contracts.DataImportRowRequired},
+		{"artist", 2, "name", contracts.DataImportRowConflict},
+		{"venue", 1, "city", contracts.DataImportRowRequired},
+		{"show", 1, "eventDate", contracts.DataImportRowInvalidDate},
+		{"show", 1, "status", contracts.DataImportRowInvalidValue},
+		{"show", 2, "venues", contracts.DataImportRowRequired},
+		{"show", 3, "title", contracts.DataImportRowConflict},
+		{"show", 4, "artists", contracts.DataImportRowConflict},
+	}
+	for _, w := range want {
+		if !got[w] {
+			t.Errorf("missing error %+v", w)
+		}
+	}
+	if len(errs) != len(want) {
+		t.Errorf("expected %d errors, got %d: %+v", len(want), len(errs), errs)
+	}
+
+	if dates[0].IsZero() || !dates[1].IsZero() {
+		t.Errorf("expected only valid event dates parsed, got %v", dates)
+	}
+}
+
+func TestCheckImportRows_CleanBatch(t *testing.T) {
+	venue := contracts.ExportedVenue{Name: "Crescent Ballroom", City: "Phoenix", State: "AZ"}
+	req := contracts.DataImportRequest{
+		Venues: []contracts.ExportedVenue{venue},
+		Shows: []contracts.ExportedShow{
+			// A matinee and an evening show are distinct rows.
+			{Title: "Fest", EventDate: "2026-09-01T20:00:00Z", Status: "Pending", Venues: []contracts.ExportedVenue{venue},
+				Artists: []contracts.ExportedShowArtist{{Name: "Playboy Manbaby"}}},
+			{Title: "Fest", EventDate: "2026-09-02T03:00:00Z", Venues: []contracts.ExportedVenue{venue},
+				Artists: []contracts.ExportedShowArtist{{Name: "Playboy Manbaby"}}},
+		},
+	}
+
+	if errs, _ := checkImportRows(req); len(errs) != 0 {
+		t.Errorf("expected no errors, got %+v", errs)
+	}
+}
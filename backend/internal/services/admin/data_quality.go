@@ -351,7 +351,7 @@ func (s *DataQualityService) countChartingArtistsMissingLinks() (int, error) {
 			FROM artists a
 			JOIN show_artists sa ON sa.artist_id = a.id
 			JOIN shows s ON s.id = sa.show_id
-			  AND s.status = 'approved'
+			  AND s.status = 'approved' AND s.deleted_at IS NULL
 			  AND s.is_cancelled = FALSE
 			  AND s.event_date >= ? AND s.event_date <= ?
 			WHERE `+looseEndsMissingLinksSQL+`
@@ -386,7 +386,7 @@ func (s *DataQualityService) getFollowedArtistsMissingLinks(viewerID uint, limit
 		SELECT a.id, a.name, a.slug, COUNT(sa.show_id) as show_count
 		FROM artists a
 		LEFT JOIN show_artists sa ON sa.artist_id = a.id
-		LEFT JOIN shows s ON s.id = sa.show_id AND s.status = 'approved'
+		LEFT JOIN shows s ON s.id = sa.show_id AND s.status = 'approved' AND s.deleted_at IS NULL
 		WHERE `+looseEndsMissingLinksSQL+`
 		  AND EXISTS (
 		    SELECT 1 FROM user_bookmarks ub
@@ -442,7 +442,7 @@ func (s *DataQualityService) getChartingArtistsMissingLinks(viewerID *uint, limi
 		FROM artists a
 		JOIN show_artists sa ON sa.artist_id = a.id
 		JOIN shows s ON s.id = sa.show_id
-		  AND s.status = 'approved'
+		  AND s.status = 'approved' AND s.deleted_at IS NULL
 		  AND s.is_cancelled = FALSE
 		  AND s.event_date >= ? AND s.event_date <= ?
 		WHERE `+looseEndsMissingLinksSQL+`
@@ -514,14 +514,14 @@ func (s *DataQualityService) getCategoryCount(category string) (int, error) {
 			SELECT COUNT(*) FROM venues v
 			WHERE v.verified = false
 			  AND (SELECT COUNT(*) FROM show_venues sv
-			       JOIN shows s ON s.id = sv.show_id AND s.status = 'approved'
+			       JOIN shows s ON s.id = sv.show_id AND s.status = 'approved' AND s.deleted_at IS NULL
 			       WHERE sv.venue_id = v.id) >= 3
 		`).Scan(&count).Error
 
 	case "shows_no_billing_order":
 		err = s.db.Raw(`
 			SELECT COUNT(*) FROM shows s
-			WHERE s.status = 'approved' AND s.event_date >= NOW()
+			WHERE s.status = 'approved' AND s.deleted_at IS NULL AND s.event_date >= NOW()
 			  AND (SELECT COUNT(*) FROM show_artists WHERE show_id = s.id) >= 2
 			  AND NOT EXISTS (
 			    SELECT 1 FROM show_artists WHERE show_id = s.id AND position > 0
@@ -531,7 +531,7 @@ func (s *DataQualityService) getCategoryCount(category string) (int, error) {
 	case "shows_missing_price":
 		err = s.db.Raw(`
 			SELECT COUNT(*) FROM shows
-			WHERE status = 'approved' AND event_date >= NOW() AND price IS NULL
+			WHERE status = 'approved' AND deleted_at IS NULL AND event_date >= NOW() AND price_min IS NULL AND NOT is_free
 		`).Scan(&count).Error
 
 	case "releases_missing_year":
@@ -572,7 +572,7 @@ func (s *DataQualityService) getArtistsMissingLinks(limit, offset int) ([]*contr
 		SELECT a.id, a.name, a.slug, COUNT(sa.show_id) as show_count
 		FROM artists a
 		LEFT JOIN show_artists sa ON sa.artist_id = a.id
-		LEFT JOIN shows s ON s.id = sa.show_id AND s.status = 'approved'
+		LEFT JOIN shows s ON s.id = sa.show_id AND s.status = 'approved' AND s.deleted_at IS NULL
 		WHERE a.instagram IS NULL AND a.facebook IS NULL AND a.twitter IS NULL
 		  AND a.youtube IS NULL AND a.spotify IS NULL AND a.soundcloud IS NULL
 		  AND a.bandcamp IS NULL AND a.website IS NULL
@@ -623,7 +623,7 @@ func (s *DataQualityService) getArtistsMissingLocation(limit, offset int) ([]*co
 		SELECT a.id, a.name, a.slug, COUNT(sa.show_id) as show_count
 		FROM artists a
 		LEFT JOIN show_artists sa ON sa.artist_id = a.id
-		LEFT JOIN shows s ON s.id = sa.show_id AND s.status = 'approved'
+		LEFT JOIN shows s ON s.id = sa.show_id AND s.status = 'approved' AND s.deleted_at IS NULL
 		WHERE a.city IS NULL AND a.state IS NULL
 		GROUP BY a.id
 		ORDER BY show_count DESC, a.name ASC
@@ -724,7 +724,7 @@ func (s *DataQualityService) getVenuesMissingSocial(limit, offset int) ([]*contr
 		SELECT v.id, v.name, v.slug, COUNT(sv.show_id) as show_count
 		FROM venues v
 		LEFT JOIN show_venues sv ON sv.venue_id = v.id
-		LEFT JOIN shows s ON s.id = sv.show_id AND s.status = 'approved'
+		LEFT JOIN shows s ON s.id = sv.show_id AND s.status = 'approved' AND s.deleted_at IS NULL
 		WHERE v.instagram IS NULL AND v.facebook IS NULL AND v.twitter IS NULL
 		  AND v.youtube IS NULL AND v.spotify IS NULL AND v.soundcloud IS NULL
 		  AND v.bandcamp IS NULL AND v.website IS NULL
@@ -760,7 +760,7 @@ func (s *DataQualityService) getVenuesUnverifiedWithShows(limit, offset int) ([]
 		SELECT COUNT(*) FROM venues v
 		WHERE v.verified = false
 		  AND (SELECT COUNT(*) FROM show_venues sv
-		       JOIN shows s ON s.id = sv.show_id AND s.status = 'approved'
+		       JOIN shows s ON s.id = sv.show_id AND s.status = 'approved' AND s.deleted_at IS NULL
 		       WHERE sv.venue_id = v.id) >= 3
 	`).Scan(&total).Error
 	if err != nil {
@@ -778,7 +778,7 @@ func (s *DataQualityService) getVenuesUnverifiedWithShows(limit, offset int) ([]
 		SELECT v.id, v.name, v.slug, COUNT(sv.show_id) as show_count
 		FROM venues v
 		JOIN show_venues sv ON sv.venue_id = v.id
-		JOIN shows s ON s.id = sv.show_id AND s.status = 'approved'
+		JOIN shows s ON s.id = sv.show_id AND s.status = 'approved' AND s.deleted_at IS NULL
 		WHERE v.verified = false
 		GROUP BY v.id
 		HAVING COUNT(sv.show_id) >= 3
@@ -811,7 +811,7 @@ func (s *DataQualityService) getShowsNoBillingOrder(limit, offset int) ([]*contr
 	var total int64
 	err := s.db.Raw(`
 		SELECT COUNT(*) FROM shows s
-		WHERE s.status = 'approved' AND s.event_date >= NOW()
+		WHERE s.status = 'approved' AND s.deleted_at IS NULL AND s.event_date >= NOW()
 		  AND (SELECT COUNT(*) FROM show_artists WHERE show_id = s.id) >= 2
 		  AND NOT EXISTS (
 		    SELECT 1 FROM show_artists WHERE show_id = s.id AND position > 0
@@ -830,7 +830,7 @@ func (s *DataQualityService) getShowsNoBillingOrder(limit, offset int) ([]*contr
 	err = s.db.Raw(`
 		SELECT s.id, s.title, s.slug
 		FROM shows s
-		WHERE s.status = 'approved' AND s.event_date >= NOW()
+		WHERE s.status = 'approved' AND s.deleted_at IS NULL AND s.event_date >= NOW()
 		  AND (SELECT COUNT(*) FROM show_artists WHERE show_id = s.id) >= 2
 		  AND NOT EXISTS (
 		    SELECT 1 FROM show_artists WHERE show_id = s.id AND position > 0
@@ -864,7 +864,7 @@ func (s *DataQualityService) getShowsMissingPrice(limit, offset int) ([]*contrac
 	var total int64
 	err := s.db.Raw(`
 		SELECT COUNT(*) FROM shows
-		WHERE status = 'approved' AND event_date >= NOW() AND price IS NULL
+		WHERE status = 'approved' AND deleted_at IS NULL AND event_date >= NOW() AND price_min IS NULL AND NOT is_free
 	`).Scan(&total).Error
 	if err != nil {
 		return nil, 0, err
@@ -879,7 +879,7 @@ func (s *DataQualityService) getShowsMissingPrice(limit, offset int) ([]*contrac
 	err = s.db.Raw(`
 		SELECT id, title, slug
 		FROM shows
-		WHERE status = 'approved' AND event_date >= NOW() AND price IS NULL
+		WHERE status = 'approved' AND deleted_at IS NULL AND event_date >= NOW() AND price_min IS NULL AND NOT is_free
 		ORDER BY event_date ASC
 		LIMIT ? OFFSET ?
 	`, limit, offset).Scan(&rows).Error
@@ -117,7 +117,7 @@ func (suite *DataQualityServiceIntegrationTestSuite) createShow(title string, st
 		EventDate: time.Now().Add(7 * 24 * time.Hour), // future
 		Status:    status,
 		Source:    catalogm.ShowSourceUser,
-		Price:     price,
+		PriceMin:  price,
 	}
 	err := suite.db.Create(show).Error
 	suite.Require().NoError(err)
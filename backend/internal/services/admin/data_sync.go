@@ -38,6 +38,8 @@ func (s *DataSyncService) ExportShows(params contracts.ExportShowsParams) (*cont
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Set defaults
 	if params.Limit <= 0 {
 		params.Limit = 50
@@ -47,9 +49,10 @@ func (s *DataSyncService) ExportShows(params contracts.ExportShowsParams) (*cont
 	}
 
 	// Build query
-	query := s.db.Model(&catalogm.Show{}).
+	query := replica.Model(&catalogm.Show{}).
 		Preload("Venues").
-		Preload("Artists")
+		Preload("Artists").
+		Where("deleted_at IS NULL")
 
 	// Apply status filter
 	switch params.Status {
@@ -101,7 +104,7 @@ func (s *DataSyncService) ExportShows(params contracts.ExportShowsParams) (*cont
 
 	var showArtists []catalogm.ShowArtist
 	if len(showIDs) > 0 {
-		if err := s.db.Where("show_id IN ?", showIDs).Find(&showArtists).Error; err != nil {
+		if err := replica.Where("show_id IN ?", showIDs).Find(&showArtists).Error; err != nil {
 			return nil, fmt.Errorf("failed to fetch show artists: %w", err)
 		}
 	}
@@ -120,7 +123,6 @@ func (s *DataSyncService) ExportShows(params contracts.ExportShowsParams) (*cont
 			EventDate:      show.EventDate.Format(time.RFC3339),
 			City:           show.City,
 			State:          show.State,
-			Price:          show.Price,
 			AgeRequirement: show.AgeRequirement,
 			Description:    show.Description,
 			Status:         string(show.Status),
@@ -128,6 +130,11 @@ func (s *DataSyncService) ExportShows(params contracts.ExportShowsParams) (*cont
 			IsCancelled:    show.IsCancelled,
 			Venues:         make([]contracts.ExportedVenue, len(show.Venues)),
 			Artists:        make([]contracts.ExportedShowArtist, 0),
+			Price:          show.LowestPrice(),
+			PriceMin:       show.PriceMin,
+			PriceMax:       show.PriceMax,
+			PriceCurrency:  show.PriceCurrency,
+			IsFree:         show.IsFree,
 		}
 
 		// Convert venues
@@ -146,6 +153,7 @@ func (s *DataSyncService) ExportShows(params contracts.ExportShowsParams) (*cont
 				Spotify:    venue.Social.Spotify,
 				SoundCloud: venue.Social.SoundCloud,
 				Bandcamp:   venue.Social.Bandcamp,
+				TikTok:     venue.Social.TikTok,
 				Website:    venue.Social.Website,
 			}
 		}
@@ -184,6 +192,8 @@ func (s *DataSyncService) ExportArtists(params contracts.ExportArtistsParams) (*
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Set defaults
 	if params.Limit <= 0 {
 		params.Limit = 50
@@ -192,7 +202,7 @@ func (s *DataSyncService) ExportArtists(params contracts.ExportArtistsParams) (*
 		params.Limit = 200
 	}
 
-	query := s.db.Model(&catalogm.Artist{})
+	query := replica.Model(&catalogm.Artist{})
 
 	if params.Search != "" {
 		query = query.Where("LOWER(name) LIKE LOWER(?)", shared.LikePattern(params.Search))
@@ -218,6 +228,10 @@ func (s *DataSyncService) ExportArtists(params contracts.ExportArtistsParams) (*
 			City:             artist.City,
 			State:            artist.State,
 			BandcampEmbedURL: artist.BandcampEmbedURL,
+			Bio:              artist.Bio,
+			Hometown:         artist.Hometown,
+			Pronouns:         artist.Pronouns,
+			ImageURL:         artist.ImageURL,
 			Instagram:        artist.Social.Instagram,
 			Facebook:         artist.Social.Facebook,
 			Twitter:          artist.Social.Twitter,
@@ -225,6 +239,7 @@ func (s *DataSyncService) ExportArtists(params contracts.ExportArtistsParams) (*
 			Spotify:          artist.Social.Spotify,
 			SoundCloud:       artist.Social.SoundCloud,
 			Bandcamp:         artist.Social.Bandcamp,
+			TikTok:           artist.Social.TikTok,
 			Website:          artist.Social.Website,
 		}
 	}
@@ -242,6 +257,8 @@ func (s *DataSyncService) ExportVenues(params contracts.ExportVenuesParams) (*co
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Set defaults
 	if params.Limit <= 0 {
 		params.Limit = 50
@@ -250,7 +267,7 @@ func (s *DataSyncService) ExportVenues(params contracts.ExportVenuesParams) (*co
 		params.Limit = 200
 	}
 
-	query := s.db.Model(&catalogm.Venue{})
+	query := replica.Model(&catalogm.Venue{})
 
 	if params.Search != "" {
 		query = query.Where("LOWER(name) LIKE LOWER(?)", shared.LikePattern(params.Search))
@@ -294,6 +311,7 @@ func (s *DataSyncService) ExportVenues(params contracts.ExportVenuesParams) (*co
 			Spotify:    venue.Social.Spotify,
 			SoundCloud: venue.Social.SoundCloud,
 			Bandcamp:   venue.Social.Bandcamp,
+			TikTok:     venue.Social.TikTok,
 			Website:    venue.Social.Website,
 		}
 	}
@@ -400,6 +418,14 @@ func (s *DataSyncService) importArtist(artist *contracts.ExportedArtist, dryRun
 		a.City = artist.City
 		a.State = artist.State
 		a.BandcampEmbedURL = artist.BandcampEmbedURL
+		// The bio is cleaned like any other write; the photo's provenance
+		// isn't exported, so image_source stays unknown.
+		if artist.Bio != nil {
+			a.Bio = utils.NilIfEmpty(utils.SanitizeMarkdownSource(*artist.Bio))
+		}
+		a.Hometown = artist.Hometown
+		a.Pronouns = artist.Pronouns
+		a.ImageURL = artist.ImageURL
 		a.Social = catalogm.Social{
 			Instagram:  artist.Instagram,
 			Facebook:   artist.Facebook,
@@ -408,6 +434,7 @@ func (s *DataSyncService) importArtist(artist *contracts.ExportedArtist, dryRun
 			Spotify:    artist.Spotify,
 			SoundCloud: artist.SoundCloud,
 			Bandcamp:   artist.Bandcamp,
+			TikTok:     artist.TikTok,
 			Website:    artist.Website,
 		}
 	})
@@ -471,6 +498,7 @@ func (s *DataSyncService) importVenue(venue *contracts.ExportedVenue, dryRun boo
 			Spotify:    venue.Spotify,
 			SoundCloud: venue.SoundCloud,
 			Bandcamp:   venue.Bandcamp,
+			TikTok:     venue.TikTok,
 			Website:    venue.Website,
 		},
 	}
@@ -478,6 +506,7 @@ func (s *DataSyncService) importVenue(venue *contracts.ExportedVenue, dryRun boo
 	// PSY-985: geocode imported venues so timezone/coordinates are populated like
 	// the VenueService create path (nil on a miss → legacy state->tz fallback).
 	newVenue.Latitude, newVenue.Longitude, newVenue.Timezone = geo.LookupPointers(geo.Default(), newVenue.City, newVenue.State, "")
+	newVenue.GeocodePrecision = catalogm.CentroidGeocodePrecision(newVenue.Latitude)
 	newVenue.Metro = geo.MetroPointer(geo.Default(), newVenue.City, newVenue.State, "") // PSY-1255 step B
 
 	if err := s.db.Create(&newVenue).Error; err != nil {
@@ -576,18 +605,32 @@ func (s *DataSyncService) importShow(show *contracts.ExportedShow, dryRun bool)
 			EventDate:      eventDate.UTC(),
 			City:           show.City,
 			State:          show.State,
-			Price:          show.Price,
 			AgeRequirement: show.AgeRequirement,
 			Description:    show.Description,
 			Status:         status,
 			Source:         catalogm.ShowSourceUser,
 			IsSoldOut:      show.IsSoldOut,
 			IsCancelled:    show.IsCancelled,
+			PriceMin:       show.PriceMin,
+			PriceMax:       show.PriceMax,
+			PriceCurrency:  show.PriceCurrency,
+			IsFree:         show.IsFree,
+		}
+		// Exports from before price ranges carry only the single price.
+		if show.PriceMin == nil && !show.IsFree && show.Price != nil {
+			legacy := utils.LegacyPrice(*show.Price)
+			newShow.PriceMin, newShow.IsFree = legacy.Min, legacy.IsFree
+		}
+		if newShow.PriceCurrency == "" {
+			newShow.PriceCurrency = utils.DefaultPriceCurrency
 		}
 
 		if err := tx.Create(&newShow).Error; err != nil {
 			return fmt.Errorf("failed to create show: %w", err)
 		}
+		if err := catalog.AssignShowShortCode(tx, &newShow); err != nil {
+			return err
+		}
 
 		// Link venues. Track the lowest venue.ID for the denormalized
 		// show_artists.venue_id below — matches the 20260512023704
@@ -616,6 +659,7 @@ func (s *DataSyncService) importShow(show *contracts.ExportedShow, dryRun bool)
 				}
 				// PSY-985: geocode imported venues (see importVenue).
 				venue.Latitude, venue.Longitude, venue.Timezone = geo.LookupPointers(geo.Default(), venue.City, venue.State, "")
+				venue.GeocodePrecision = catalogm.CentroidGeocodePrecision(venue.Latitude)
 				venue.Metro = geo.MetroPointer(geo.Default(), venue.City, venue.State, "") // PSY-1255 step B
 				if err := tx.Create(&venue).Error; err != nil {
 					return fmt.Errorf("failed to create venue: %w", err)
@@ -0,0 +1,211 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// csvColumn is one exportable column: its header name and the SQL
+// expression (cast to text) that produces it.
+type csvColumn struct {
+	name string
+	expr string
+}
+
+// csvExport describes the query behind one entity's CSV export.
+type csvExport struct {
+	from       string // FROM clause, including the table alias
+	where      string // always-on filter; "" for none
+	dateColumn string // column the from/to range applies to
+	orderBy    string
+	columns    []csvColumn
+}
+
+// csvTimestamp renders a timestamptz column as RFC 3339 UTC text.
+func csvTimestamp(col string) string {
+	return fmt.Sprintf(`to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`, col)
+}
+
+// csvExports lists the CSV exports by entity. Users deliberately omit
+// credentials, lockout state and legal-acceptance evidence.
+var csvExports = map[string]csvExport{
+	contracts.CSVExportShows: {
+		from:       "shows s",
+		where:      "s.deleted_at IS NULL",
+		dateColumn: "s.event_date",
+		orderBy:    "s.event_date, s.id",
+		columns: []csvColumn{
+			{"id", "s.id::text"},
+			{"title", "s.title"},
+			{"slug", "s.slug"},
+			{"event_date", csvTimestamp("s.event_date")},
+			{"status", "s.status"},
+			{"venues", `(SELECT string_agg(v.name, '; ' ORDER BY v.name) FROM show_venues sv JOIN venues v ON v.id = sv.venue_id WHERE sv.show_id = s.id)`},
+			{"artists", `(SELECT string_agg(a.name, '; ' ORDER BY sa.position, a.name) FROM show_artists sa JOIN artists a ON a.id = sa.artist_id WHERE sa.show_id = s.id)`},
+			{"city", "s.city"},
+			{"state", "s.state"},
+			{"price_min", "s.price_min::text"},
+			{"price_max", "s.price_max::text"},
+			{"price_currency", "s.price_currency"},
+			{"is_free", "s.is_free::text"},
+			{"age_requirement", "s.age_requirement"},
+			{"ticket_url", "s.ticket_url"},
+			{"is_sold_out", "s.is_sold_out::text"},
+			{"is_cancelled", "s.is_cancelled::text"},
+			{"submitted_by", "s.submitted_by::text"},
+			{"created_at", csvTimestamp("s.created_at")},
+			{"updated_at", csvTimestamp("s.updated_at")},
+		},
+	},
+	contracts.CSVExportVenues: {
+		from:       "venues v",
+		dateColumn: "v.created_at",
+		orderBy:    "v.id",
+		columns: []csvColumn{
+			{"id", "v.id::text"},
+			{"name", "v.name"},
+			{"slug", "v.slug"},
+			{"address", "v.address"},
+			{"city", "v.city"},
+			{"state", "v.state"},
+			{"zipcode", "v.zipcode"},
+			{"verified", "v.verified::text"},
+			{"website", "v.website"},
+			{"instagram", "v.instagram"},
+			{"created_at", csvTimestamp("v.created_at")},
+			{"updated_at", csvTimestamp("v.updated_at")},
+		},
+	},
+	contracts.CSVExportUsers: {
+		from:       "users u",
+		where:      "u.deleted_at IS NULL",
+		dateColumn: "u.created_at",
+		orderBy:    "u.id",
+		columns: []csvColumn{
+			{"id", "u.id::text"},
+			{"email", "u.email"},
+			{"username", "u.username"},
+			{"display_name", "u.display_name"},
+			{"first_name", "u.first_name"},
+			{"last_name", "u.last_name"},
+			{"user_tier", "u.user_tier"},
+			{"is_admin", "u.is_admin::text"},
+			{"is_active", "u.is_active::text"},
+			{"email_verified", "u.email_verified::text"},
+			{"created_at", csvTimestamp("u.created_at")},
+		},
+	},
+}
+
+// resolveCSVExport validates an export request and returns its spec and the
+// selected columns in request order.
+func resolveCSVExport(entity string, params contracts.CSVExportParams) (csvExport, []csvColumn, error) {
+	spec, ok := csvExports[entity]
+	if !ok {
+		return csvExport{}, nil, apperrors.ErrDataExportUnknownEntity(entity)
+	}
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return csvExport{}, nil, apperrors.ErrDataExportInvalidRange("to_date must be after from_date")
+	}
+	if len(params.Columns) == 0 {
+		return spec, spec.columns, nil
+	}
+
+	byName := make(map[string]csvColumn, len(spec.columns))
+	available := make([]string, len(spec.columns))
+	for i, col := range spec.columns {
+		byName[col.name] = col
+		available[i] = col.name
+	}
+	selected := make([]csvColumn, 0, len(params.Columns))
+	for _, name := range params.Columns {
+		col, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			return csvExport{}, nil, apperrors.ErrDataExportInvalidColumn(name, available)
+		}
+		selected = append(selected, col)
+	}
+	return spec, selected, nil
+}
+
+// ValidateCSVExport checks an export request without running it, so a
+// handler can reject it before committing to a streamed 200 response.
+func (s *DataSyncService) ValidateCSVExport(entity string, params contracts.CSVExportParams) error {
+	_, _, err := resolveCSVExport(entity, params)
+	return err
+}
+
+// WriteCSV streams an entity export to w as RFC 4180 CSV (CRLF line endings,
+// fields quoted only when needed) with a header row. Rows are read from a
+// database cursor and written as they arrive, so memory use is flat however
+// large the export.
+func (s *DataSyncService) WriteCSV(ctx context.Context, entity string, params contracts.CSVExportParams, w io.Writer) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	spec, columns, err := resolveCSVExport(entity, params)
+	if err != nil {
+		return err
+	}
+
+	selects := make([]string, len(columns))
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		selects[i] = col.expr
+		header[i] = col.name
+	}
+
+	query := s.db.WithContext(ctx).Table(spec.from).Select(strings.Join(selects, ", "))
+	if spec.where != "" {
+		query = query.Where(spec.where)
+	}
+	if params.From != nil {
+		query = query.Where(spec.dateColumn+" >= ?", *params.From)
+	}
+	if params.To != nil {
+		query = query.Where(spec.dateColumn+" < ?", *params.To)
+	}
+
+	rows, err := query.Order(spec.orderBy).Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query %s export: %w", entity, err)
+	}
+	defer rows.Close()
+
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	record := make([]string, len(columns))
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return fmt.Errorf("failed to scan %s export row: %w", entity, err)
+		}
+		for i, v := range values {
+			record[i] = v.String // NULL exports as an empty field
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("failed to write csv row: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read %s export: %w", entity, err)
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestResolveCSVExport_DefaultsToAllColumns(t *testing.T) {
+	_, cols, err := resolveCSVExport(contracts.CSVExportVenues, contracts.CSVExportParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cols) != len(csvExports[contracts.CSVExportVenues].columns) {
+		t.Errorf("expected every venue column, got %d", len(cols))
+	}
+}
+
+func TestResolveCSVExport_KeepsRequestedOrder(t *testing.T) {
+	_, cols, err := resolveCSVExport(contracts.CSVExportShows, contracts.CSVExportParams{
+		Columns: []string{"event_date", " title", "id"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := []string{cols[0].name, cols[1].name, cols[2].name}
+	if got[0] != "event_date" || got[1] != "title" || got[2] != "id" {
+		t.Errorf("unexpected column order %v", got)
+	}
+}
+
+func TestResolveCSVExport_Errors(t *testing.T) {
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		entity string
+		params contracts.CSVExportParams
+		code   string
+	}{
+		{"unknown entity", "artists", contracts.CSVExportParams{}, apperrors.CodeDataExportUnknownEntity},
+		{"unknown column", contracts.CSVExportUsers, contracts.CSVExportParams{Columns: []string{"password_hash"}}, apperrors.CodeDataExportInvalidColumn},
+		{"inverted range", contracts.CSVExportShows, contracts.CSVExportParams{From: &feb, To: &jan}, apperrors.CodeDataExportInvalidRange},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := resolveCSVExport(tc.entity, tc.params)
+			exportErr, ok := err.(*apperrors.DataExportError)
+			if !ok || exportErr.Code != tc.code {
+				t.Fatalf("expected %s, got %v", tc.code, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -9,6 +10,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/testutil"
@@ -873,3 +876,176 @@ func (suite *DataSyncServiceIntegrationTestSuite) TestImportData_FullRoundTrip()
 	suite.Require().Len(show.Artists, 1)
 	suite.Equal("RT Band", show.Artists[0].Name)
 }
+
+// =============================================================================
+// WriteCSV Tests
+// =============================================================================
+
+func (suite *DataSyncServiceIntegrationTestSuite) TestWriteCSV_ShowsWithQuotingAndDateRange() {
+	venue := suite.createVenue("The Rebel Lounge", "Phoenix", "AZ", true)
+	band := suite.createArtist("Band, The")
+	suite.createShow(`Night "One"`, time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue, band)
+	suite.createShow("Later Show", time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue)
+
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	var buf strings.Builder
+	err := suite.service.WriteCSV(context.Background(), contracts.CSVExportShows, contracts.CSVExportParams{
+		Columns: []string{"title", "event_date", "venues", "artists"},
+		From:    &from,
+		To:      &to,
+	}, &buf)
+	suite.Require().NoError(err)
+
+	suite.Equal(
+		"title,event_date,venues,artists\r\n"+
+			`"Night ""One""",2026-03-01T20:00:00Z,The Rebel Lounge,"Band, The"`+"\r\n",
+		buf.String(),
+	)
+}
+
+func (suite *DataSyncServiceIntegrationTestSuite) TestWriteCSV_InvalidColumn() {
+	var buf strings.Builder
+	err := suite.service.WriteCSV(context.Background(), contracts.CSVExportUsers, contracts.CSVExportParams{
+		Columns: []string{"id", "password_hash"},
+	}, &buf)
+
+	var exportErr *apperrors.DataExportError
+	suite.Require().ErrorAs(err, &exportErr)
+	suite.Equal(apperrors.CodeDataExportInvalidColumn, exportErr.Code)
+	suite.Empty(buf.String(), "nothing should be written for an invalid request")
+}
+
+// =============================================================================
+// ValidateImport / CommitImport Tests
+// =============================================================================
+
+func (suite *DataSyncServiceIntegrationTestSuite) createImportAdmin() *authm.User {
+	user := &authm.User{
+		Email:   stringPtr(fmt.Sprintf("import-admin-%d@test.com", time.Now().UnixNano())),
+		IsAdmin: true,
+	}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	return user
+}
+
+func (suite *DataSyncServiceIntegrationTestSuite) TestValidateImport_ReportsRowErrorsWithoutWriting() {
+	admin := suite.createImportAdmin()
+	req := contracts.DataImportRequest{
+		Shows: []contracts.ExportedShow{
+			{
+				Title:     "Unknown Room Show",
+				EventDate: "2026-09-01T03:00:00Z",
+				Venues:    []contracts.ExportedVenue{{Name: "Nowhere Hall", City: "Phoenix", State: "AZ"}},
+			},
+			{
+				Title:     "Bad Date Show",
+				EventDate: "2026-13-45",
+				Venues:    []contracts.ExportedVenue{{Name: "Nowhere Hall", City: "Phoenix", State: "AZ"}},
+			},
+		},
+	}
+
+	report, err := suite.service.ValidateImport(req, admin.ID)
+	suite.Require().NoError(err)
+	suite.False(report.Valid)
+	suite.Empty(report.Token, "no token for a batch with errors")
+
+	codes := make(map[string]int)
+	for _, e := range report.Errors {
+		codes[e.Code]++
+	}
+	suite.Equal(2, codes[contracts.DataImportRowUnknownVenue])
+	suite.Equal(1, codes[contracts.DataImportRowInvalidDate])
+
+	var stored int64
+	suite.db.Table("data_import_validations").Count(&stored)
+	suite.Zero(stored)
+	var shows int64
+	suite.db.Model(&catalogm.Show{}).Count(&shows)
+	suite.Zero(shows)
+}
+
+func (suite *DataSyncServiceIntegrationTestSuite) TestValidateThenCommitImport() {
+	admin := suite.createImportAdmin()
+	suite.createVenue("Valley Bar", "Phoenix", "AZ", true)
+	req := contracts.DataImportRequest{
+		Venues: []contracts.ExportedVenue{{Name: "Crescent Ballroom", City: "Phoenix", State: "AZ"}},
+		Shows: []contracts.ExportedShow{
+			{
+				Title:     "Night One",
+				EventDate: "2026-09-01T03:00:00Z",
+				Venues:    []contracts.ExportedVenue{{Name: "Valley Bar", City: "Phoenix", State: "AZ"}},
+				Artists:   []contracts.ExportedShowArtist{{Name: "Sundressed", Position: 0}},
+			},
+			{
+				Title:     "Night Two",
+				EventDate: "2026-09-02T03:00:00Z",
+				Venues:    []contracts.ExportedVenue{{Name: "Crescent Ballroom", City: "Phoenix", State: "AZ"}},
+			},
+		},
+	}
+
+	report, err := suite.service.ValidateImport(req, admin.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(report.Valid, "unexpected errors: %+v", report.Errors)
+	suite.Require().NotEmpty(report.Token)
+	suite.NotNil(report.ExpiresAt)
+
+	var shows int64
+	suite.db.Model(&catalogm.Show{}).Count(&shows)
+	suite.Zero(shows, "validation must not import")
+
+	// Another admin can't redeem the token.
+	other := suite.createImportAdmin()
+	_, err = suite.service.CommitImport(report.Token, other.ID)
+	var importErr *apperrors.DataImportError
+	suite.Require().ErrorAs(err, &importErr)
+	suite.Equal(apperrors.CodeDataImportTokenNotFound, importErr.Code)
+
+	result, err := suite.service.CommitImport(report.Token, admin.ID)
+	suite.Require().NoError(err)
+	suite.Equal(2, result.Shows.Imported)
+	suite.Equal(1, result.Venues.Imported)
+
+	_, err = suite.service.CommitImport(report.Token, admin.ID)
+	suite.Require().ErrorAs(err, &importErr)
+	suite.Equal(apperrors.CodeDataImportTokenUsed, importErr.Code)
+
+	// The imported billing now conflicts with a differently titled show at
+	// the same venue and time.
+	conflict := contracts.DataImportRequest{
+		Shows: []contracts.ExportedShow{{
+			Title:     "Renamed Night",
+			EventDate: "2026-09-01T03:00:00Z",
+			Venues:    []contracts.ExportedVenue{{Name: "Valley Bar", City: "Phoenix", State: "AZ"}},
+			Artists:   []contracts.ExportedShowArtist{{Name: "sundressed"}},
+		}},
+	}
+	report, err = suite.service.ValidateImport(conflict, admin.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Errors, 1)
+	suite.Equal(contracts.DataImportRowConflict, report.Errors[0].Code)
+}
+
+func (suite *DataSyncServiceIntegrationTestSuite) TestCommitImport_ExpiredToken() {
+	admin := suite.createImportAdmin()
+	req := contracts.DataImportRequest{
+		Artists: []contracts.ExportedArtist{{Name: "Playboy Manbaby"}},
+	}
+	report, err := suite.service.ValidateImport(req, admin.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(report.Valid)
+
+	suite.db.Table("data_import_validations").Where("admin_id = ?", admin.ID).
+		Update("expires_at", time.Now().Add(-time.Minute))
+
+	_, err = suite.service.CommitImport(report.Token, admin.ID)
+	var importErr *apperrors.DataImportError
+	suite.Require().ErrorAs(err, &importErr)
+	suite.Equal(apperrors.CodeDataImportTokenExpired, importErr.Code)
+
+	var artists int64
+	suite.db.Model(&catalogm.Artist{}).Count(&artists)
+	suite.Zero(artists)
+}
@@ -43,6 +43,15 @@ func resolveEntityName(db *gorm.DB, entityType string, entityID uint) string {
 			}
 			return result.Body
 		}
+	case "show_update":
+		// Like comments, a truncated body stands in for the name
+		var result struct{ Body string }
+		if err := db.Table("show_updates").Select("body").Where("id = ?", entityID).Scan(&result).Error; err == nil && result.Body != "" {
+			if len(result.Body) > 60 {
+				return result.Body[:60] + "..."
+			}
+			return result.Body
+		}
 	case "collection":
 		// resolveEntityNameAndSlug is the canonical path for collections; this
 		// arm exists for callers that only need the name. It still issues a
@@ -64,7 +73,7 @@ func resolveEntityName(db *gorm.DB, entityType string, entityID uint) string {
 //
 // Slug is non-nil for entity types whose public URLs are slug-based:
 // `collection`, `artist`, `venue`, `festival`, `release`, `label`. For
-// types not in this list (`show`, `comment`), slug is always nil so the
+// types not in this list (`show`, `comment`, `show_update`), slug is always nil so the
 // JSON response omits the field.
 //
 // PSY-600: extended past `collection` so the contributor-facing
@@ -16,7 +16,8 @@ import (
 
 // EntityReportService handles business logic for generalized entity reports.
 type EntityReportService struct {
-	db *gorm.DB
+	db     *gorm.DB
+	events contracts.AdminEventPublisher
 }
 
 // NewEntityReportService creates a new EntityReportService.
@@ -27,6 +28,12 @@ func NewEntityReportService(database *gorm.DB) *EntityReportService {
 	return &EntityReportService{db: database}
 }
 
+// SetEventPublisher wires the admin event bus. Optional — when nil, new
+// reports are not announced to open admin event streams.
+func (s *EntityReportService) SetEventPublisher(p contracts.AdminEventPublisher) {
+	s.events = p
+}
+
 // CreateEntityReport submits a new report for an entity.
 func (s *EntityReportService) CreateEntityReport(req *contracts.CreateEntityReportRequest) (*contracts.EntityReportResponse, error) {
 	if s.db == nil {
@@ -96,6 +103,16 @@ func (s *EntityReportService) CreateEntityReport(req *contracts.CreateEntityRepo
 		}
 	}
 
+	if s.events != nil {
+		s.events.Publish(contracts.AdminEvent{
+			Type:       contracts.AdminEventReportCreated,
+			ID:         report.ID,
+			EntityType: req.EntityType,
+			EntityID:   req.EntityID,
+			Summary:    req.ReportType,
+		})
+	}
+
 	// Reload with relationships
 	return s.GetEntityReport(report.ID)
 }
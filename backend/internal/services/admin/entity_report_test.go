@@ -125,7 +125,7 @@ func TestValidReportTypesForEntity(t *testing.T) {
 
 func TestValidEntityReportEntityTypes(t *testing.T) {
 	types := communitym.ValidEntityReportEntityTypes()
-	assert.Len(t, types, 8)
+	assert.Len(t, types, 9)
 	assert.Contains(t, types, "artist")
 	assert.Contains(t, types, "venue")
 	assert.Contains(t, types, "festival")
@@ -136,6 +136,7 @@ func TestValidEntityReportEntityTypes(t *testing.T) {
 	assert.Contains(t, types, "release")
 	// PSY-666
 	assert.Contains(t, types, "label")
+	assert.Contains(t, types, "show_update")
 }
 
 // =============================================================================
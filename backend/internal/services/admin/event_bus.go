@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"sync"
+	"time"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// adminEventBuffer is how many undelivered events a subscriber can fall
+// behind by before new events are dropped for it.
+const adminEventBuffer = 32
+
+// AdminEventBus is an in-process pub/sub bus for admin queue events. Services
+// publish when they create a queue item; each open admin event stream holds
+// one subscription.
+//
+// The bus is per process: with several API instances, an admin only sees
+// events published by the instance their stream is connected to. The stream
+// is a refresh hint, so the queue endpoints stay the source of truth.
+type AdminEventBus struct {
+	mu          sync.RWMutex
+	subscribers map[chan contracts.AdminEvent]struct{}
+}
+
+// NewAdminEventBus creates an empty admin event bus
+func NewAdminEventBus() *AdminEventBus {
+	return &AdminEventBus{
+		subscribers: make(map[chan contracts.AdminEvent]struct{}),
+	}
+}
+
+// Publish delivers event to every subscriber without blocking. A subscriber
+// whose buffer is full misses the event rather than stalling the publisher.
+func (b *AdminEventBus) Publish(event contracts.AdminEvent) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe registers a new subscriber. The returned function unsubscribes
+// and closes the channel; it is safe to call more than once.
+func (b *AdminEventBus) Subscribe() (<-chan contracts.AdminEvent, func()) {
+	ch := make(chan contracts.AdminEvent, adminEventBuffer)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"testing"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestAdminEventBus_DeliversToAllSubscribers(t *testing.T) {
+	bus := NewAdminEventBus()
+	a, unsubA := bus.Subscribe()
+	defer unsubA()
+	b, unsubB := bus.Subscribe()
+	defer unsubB()
+
+	bus.Publish(contracts.AdminEvent{Type: contracts.AdminEventShowSubmitted, ID: 7})
+
+	for name, ch := range map[string]<-chan contracts.AdminEvent{"a": a, "b": b} {
+		select {
+		case got := <-ch:
+			if got.Type != contracts.AdminEventShowSubmitted || got.ID != 7 {
+				t.Errorf("subscriber %s got %+v", name, got)
+			}
+			if got.OccurredAt.IsZero() {
+				t.Errorf("subscriber %s: OccurredAt not set", name)
+			}
+		default:
+			t.Errorf("subscriber %s received nothing", name)
+		}
+	}
+}
+
+func TestAdminEventBus_UnsubscribeClosesChannel(t *testing.T) {
+	bus := NewAdminEventBus()
+	ch, unsubscribe := bus.Subscribe()
+	unsubscribe()
+	unsubscribe() // safe to call twice
+
+	if _, ok := <-ch; ok {
+		t.Fatal("expected channel to be closed after unsubscribe")
+	}
+	// Publishing with no subscribers must not panic.
+	bus.Publish(contracts.AdminEvent{Type: contracts.AdminEventReportCreated})
+}
+
+func TestAdminEventBus_SlowSubscriberDoesNotBlock(t *testing.T) {
+	bus := NewAdminEventBus()
+	ch, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < adminEventBuffer+10; i++ {
+		bus.Publish(contracts.AdminEvent{Type: contracts.AdminEventReportCreated, ID: uint(i)})
+	}
+
+	if got := len(ch); got != adminEventBuffer {
+		t.Errorf("buffered %d events, want %d", got, adminEventBuffer)
+	}
+}
@@ -0,0 +1,288 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+	apperrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// featureFlagCacheTTL bounds how stale IsEnabled can be. Writes on this
+// instance invalidate the cache at once; other instances pick a change up
+// within the TTL.
+const featureFlagCacheTTL = 30 * time.Second
+
+var (
+	featureFlagKeyPattern         = regexp.MustCompile(`^[a-z][a-z0-9_]{0,99}$`)
+	featureFlagEnvironmentPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+)
+
+// FeatureFlagService manages runtime feature flags and answers IsEnabled
+// checks from an in-memory copy of the feature_flags table.
+type FeatureFlagService struct {
+	db          *gorm.DB
+	environment string
+	now         func() time.Time
+
+	mu       sync.RWMutex
+	flags    map[string]adminm.FeatureFlag
+	loadedAt time.Time
+}
+
+// NewFeatureFlagService creates a new feature flag service evaluating flags
+// for environment (the ENVIRONMENT setting; empty means development).
+func NewFeatureFlagService(database *gorm.DB, environment string) *FeatureFlagService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	if environment == "" {
+		environment = config.EnvDevelopment
+	}
+	return &FeatureFlagService{
+		db:          database,
+		environment: environment,
+		now:         time.Now,
+	}
+}
+
+// IsEnabled reports whether the flag is on in this environment for a caller
+// who is (or isn't) an admin. Unknown keys read as off. When the table can't
+// be read the last loaded copy is used (or every flag reads as off before
+// the first load), so a database blip never turns gated behavior on.
+func (s *FeatureFlagService) IsEnabled(key string, isAdmin bool) bool {
+	flags := s.cachedFlags()
+	flag, ok := flags[key]
+	return ok && flag.EnabledFor(s.environment, isAdmin)
+}
+
+// cachedFlags returns the flags, reloading them once the cache is older than
+// featureFlagCacheTTL.
+func (s *FeatureFlagService) cachedFlags() map[string]adminm.FeatureFlag {
+	s.mu.RLock()
+	flags, loadedAt := s.flags, s.loadedAt
+	s.mu.RUnlock()
+	if flags != nil && s.now().Sub(loadedAt) < featureFlagCacheTTL {
+		return flags
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.flags != nil && s.now().Sub(s.loadedAt) < featureFlagCacheTTL {
+		return s.flags
+	}
+	// Stamp the attempt even when it fails so an outage costs one query per
+	// TTL, not one per check.
+	s.loadedAt = s.now()
+	var rows []adminm.FeatureFlag
+	if s.db == nil {
+		return s.flags
+	}
+	if err := s.db.Find(&rows).Error; err != nil {
+		log.Printf("WARN feature flags: reload failed, keeping %d cached flags: %v", len(s.flags), err)
+		return s.flags
+	}
+	loaded := make(map[string]adminm.FeatureFlag, len(rows))
+	for _, row := range rows {
+		loaded[row.Key] = row
+	}
+	s.flags = loaded
+	return loaded
+}
+
+// invalidate drops the cache so the next IsEnabled reloads.
+func (s *FeatureFlagService) invalidate() {
+	s.mu.Lock()
+	s.flags = nil
+	s.loadedAt = time.Time{}
+	s.mu.Unlock()
+}
+
+// ListFlags returns every flag ordered by key.
+func (s *FeatureFlagService) ListFlags() ([]*contracts.FeatureFlagResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var rows []adminm.FeatureFlag
+	if err := s.db.Order("key ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	out := make([]*contracts.FeatureFlagResponse, len(rows))
+	for i := range rows {
+		out[i] = featureFlagResponse(&rows[i])
+	}
+	return out, nil
+}
+
+// CreateFlag creates a flag. Keys are lowercase snake_case and unique.
+func (s *FeatureFlagService) CreateFlag(req *contracts.CreateFeatureFlagRequest, userID uint) (*contracts.FeatureFlagResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	key := strings.TrimSpace(req.Key)
+	if !featureFlagKeyPattern.MatchString(key) {
+		return nil, apperrors.ErrFeatureFlagInvalid("key must be lowercase letters, digits and underscores, starting with a letter (at most 100 characters)")
+	}
+	environments, err := normalizeFeatureFlagEnvironments(req.Environments)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &adminm.FeatureFlag{
+		Key:              key,
+		Description:      normalizeFeatureFlagDescription(req.Description),
+		Enabled:          req.Enabled,
+		EnabledForAdmins: req.EnabledForAdmins,
+		Environments:     environments,
+	}
+	if userID != 0 {
+		row.UpdatedBy = &userID
+	}
+	if err := s.db.Create(row).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, apperrors.ErrFeatureFlagExists(key)
+		}
+		return nil, fmt.Errorf("failed to create feature flag: %w", err)
+	}
+
+	s.invalidate()
+	return featureFlagResponse(row), nil
+}
+
+// UpdateFlag applies the non-nil fields of req to the flag.
+func (s *FeatureFlagService) UpdateFlag(key string, req *contracts.UpdateFeatureFlagRequest, userID uint) (*contracts.FeatureFlagResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	row, err := s.getFlag(key)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if req.Description != nil {
+		updates["description"] = normalizeFeatureFlagDescription(req.Description)
+	}
+	if req.Enabled != nil {
+		updates["enabled"] = *req.Enabled
+	}
+	if req.EnabledForAdmins != nil {
+		updates["enabled_for_admins"] = *req.EnabledForAdmins
+	}
+	if req.Environments != nil {
+		environments, err := normalizeFeatureFlagEnvironments(*req.Environments)
+		if err != nil {
+			return nil, err
+		}
+		updates["environments"] = environments
+	}
+	if len(updates) == 0 {
+		return featureFlagResponse(row), nil
+	}
+	if userID != 0 {
+		updates["updated_by"] = userID
+	}
+
+	if err := s.db.Model(row).Updates(updates).Error; err != nil {
+		return nil, fmt.Errorf("failed to update feature flag: %w", err)
+	}
+
+	s.invalidate()
+	row, err = s.getFlag(key)
+	if err != nil {
+		return nil, err
+	}
+	return featureFlagResponse(row), nil
+}
+
+// DeleteFlag deletes the flag; checks against its key then read as off.
+func (s *FeatureFlagService) DeleteFlag(key string) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	result := s.db.Where("key = ?", key).Delete(&adminm.FeatureFlag{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete feature flag: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrFeatureFlagNotFound(key)
+	}
+
+	s.invalidate()
+	return nil
+}
+
+func (s *FeatureFlagService) getFlag(key string) (*adminm.FeatureFlag, error) {
+	var row adminm.FeatureFlag
+	if err := s.db.Where("key = ?", key).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrFeatureFlagNotFound(key)
+		}
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+	return &row, nil
+}
+
+// normalizeFeatureFlagEnvironments validates environment names and returns
+// them de-duplicated, sorted and space-separated for storage.
+func normalizeFeatureFlagEnvironments(environments []string) (string, error) {
+	seen := make(map[string]bool, len(environments))
+	var out []string
+	for _, env := range environments {
+		env = strings.ToLower(strings.TrimSpace(env))
+		if env == "" || seen[env] {
+			continue
+		}
+		if !featureFlagEnvironmentPattern.MatchString(env) {
+			return "", apperrors.ErrFeatureFlagInvalid(fmt.Sprintf("invalid environment %q", env))
+		}
+		seen[env] = true
+		out = append(out, env)
+	}
+	sort.Strings(out)
+	return strings.Join(out, " "), nil
+}
+
+func normalizeFeatureFlagDescription(description *string) *string {
+	if description == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*description)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func featureFlagResponse(row *adminm.FeatureFlag) *contracts.FeatureFlagResponse {
+	environments := row.EnvironmentList()
+	if environments == nil {
+		environments = []string{}
+	}
+	return &contracts.FeatureFlagResponse{
+		ID:               row.ID,
+		Key:              row.Key,
+		Description:      row.Description,
+		Enabled:          row.Enabled,
+		EnabledForAdmins: row.EnabledForAdmins,
+		Environments:     environments,
+		UpdatedBy:        row.UpdatedBy,
+		CreatedAt:        row.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        row.UpdatedAt.Format(time.RFC3339),
+	}
+}
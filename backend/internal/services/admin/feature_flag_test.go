@@ -0,0 +1,149 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestFeatureFlagService_NilDB(t *testing.T) {
+	svc := &FeatureFlagService{environment: "production", now: time.Now}
+
+	_, err := svc.ListFlags()
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.CreateFlag(&contracts.CreateFeatureFlagRequest{Key: "x"}, 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	_, err = svc.UpdateFlag("x", &contracts.UpdateFeatureFlagRequest{}, 1)
+	assert.ErrorContains(t, err, "database not initialized")
+	assert.ErrorContains(t, svc.DeleteFlag("x"), "database not initialized")
+
+	assert.False(t, svc.IsEnabled("x", true), "flags read as off without a database")
+}
+
+func TestNormalizeFeatureFlagEnvironments(t *testing.T) {
+	got, err := normalizeFeatureFlagEnvironments([]string{" Stage", "production", "", "stage"})
+	assert.NoError(t, err)
+	assert.Equal(t, "production stage", got)
+
+	got, err = normalizeFeatureFlagEnvironments(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	_, err = normalizeFeatureFlagEnvironments([]string{"prod uction"})
+	var flagErr *apperrors.FeatureFlagError
+	assert.ErrorAs(t, err, &flagErr)
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type FeatureFlagIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *FeatureFlagService
+	now    time.Time
+}
+
+func (suite *FeatureFlagIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+}
+
+func (suite *FeatureFlagIntegrationTestSuite) SetupTest() {
+	suite.now = time.Now().UTC().Truncate(time.Second)
+	suite.svc = &FeatureFlagService{db: suite.db, environment: "production", now: func() time.Time { return suite.now }}
+}
+
+func (suite *FeatureFlagIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *FeatureFlagIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM feature_flags")
+}
+
+func TestFeatureFlagIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(FeatureFlagIntegrationTestSuite))
+}
+
+func (suite *FeatureFlagIntegrationTestSuite) TestCreateListUpdateDelete() {
+	desc := "  New submission flow  "
+	created, err := suite.svc.CreateFlag(&contracts.CreateFeatureFlagRequest{
+		Key:              "new_submission_flow",
+		Description:      &desc,
+		EnabledForAdmins: true,
+		Environments:     []string{"stage", "production"},
+	}, 0)
+	suite.Require().NoError(err)
+	suite.Equal("New submission flow", *created.Description)
+	suite.Equal([]string{"production", "stage"}, created.Environments)
+
+	_, err = suite.svc.CreateFlag(&contracts.CreateFeatureFlagRequest{Key: "new_submission_flow"}, 0)
+	var flagErr *apperrors.FeatureFlagError
+	suite.Require().ErrorAs(err, &flagErr)
+	suite.Equal(apperrors.CodeFeatureFlagExists, flagErr.Code)
+
+	_, err = suite.svc.CreateFlag(&contracts.CreateFeatureFlagRequest{Key: "New-Flow"}, 0)
+	suite.Require().ErrorAs(err, &flagErr)
+	suite.Equal(apperrors.CodeFeatureFlagInvalid, flagErr.Code)
+
+	enabled := true
+	noEnvs := []string{}
+	updated, err := suite.svc.UpdateFlag("new_submission_flow", &contracts.UpdateFeatureFlagRequest{
+		Enabled:      &enabled,
+		Environments: &noEnvs,
+	}, 0)
+	suite.Require().NoError(err)
+	suite.True(updated.Enabled)
+	suite.True(updated.EnabledForAdmins, "omitted fields are unchanged")
+	suite.Empty(updated.Environments)
+
+	flags, err := suite.svc.ListFlags()
+	suite.Require().NoError(err)
+	suite.Require().Len(flags, 1)
+
+	suite.Require().NoError(suite.svc.DeleteFlag("new_submission_flow"))
+	err = suite.svc.DeleteFlag("new_submission_flow")
+	suite.Require().ErrorAs(err, &flagErr)
+	suite.Equal(apperrors.CodeFeatureFlagNotFound, flagErr.Code)
+}
+
+func (suite *FeatureFlagIntegrationTestSuite) TestIsEnabled_AdminsAndEnvironments() {
+	suite.Require().NoError(suite.db.Create(&adminm.FeatureFlag{Key: "admins_only", EnabledForAdmins: true}).Error)
+	suite.Require().NoError(suite.db.Create(&adminm.FeatureFlag{Key: "stage_only", Enabled: true, Environments: "stage"}).Error)
+
+	suite.True(suite.svc.IsEnabled("admins_only", true))
+	suite.False(suite.svc.IsEnabled("admins_only", false))
+	suite.False(suite.svc.IsEnabled("stage_only", true), "limited to stage; this is production")
+	suite.False(suite.svc.IsEnabled("unknown_flag", true))
+}
+
+func (suite *FeatureFlagIntegrationTestSuite) TestIsEnabled_CachesUntilTTLOrWrite() {
+	suite.Require().NoError(suite.db.Create(&adminm.FeatureFlag{Key: "graphql_endpoint"}).Error)
+	suite.False(suite.svc.IsEnabled("graphql_endpoint", false))
+
+	// A change made elsewhere (another instance) shows up after the TTL.
+	suite.Require().NoError(suite.db.Model(&adminm.FeatureFlag{}).
+		Where("key = ?", "graphql_endpoint").Update("enabled", true).Error)
+	suite.False(suite.svc.IsEnabled("graphql_endpoint", false), "served from cache")
+	suite.now = suite.now.Add(featureFlagCacheTTL)
+	suite.True(suite.svc.IsEnabled("graphql_endpoint", false))
+
+	// A change made through the service shows up immediately.
+	disabled := false
+	_, err := suite.svc.UpdateFlag("graphql_endpoint", &contracts.UpdateFeatureFlagRequest{Enabled: &disabled}, 0)
+	suite.Require().NoError(err)
+	suite.False(suite.svc.IsEnabled("graphql_endpoint", false))
+}
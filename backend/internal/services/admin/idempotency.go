@@ -0,0 +1,125 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	// IdempotencyKeyTTL is how long a completed response is replayed.
+	IdempotencyKeyTTL = 24 * time.Hour
+	// IdempotencyLockTimeout is how long an in-flight claim blocks retries.
+	// A claim older than this belongs to a request that died without
+	// completing or releasing it, so the next retry takes it over.
+	IdempotencyLockTimeout = 2 * time.Minute
+)
+
+// acquireIdempotencyKeySQL inserts the claim, or takes over a row that has
+// expired or whose in-flight claim went stale. RETURNING yields nothing when
+// a live row holds the key.
+const acquireIdempotencyKeySQL = `
+INSERT INTO idempotency_keys (scope, idempotency_key, method, path, request_hash, status_code, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?, 0, ?, ?)
+ON CONFLICT (scope, idempotency_key) DO UPDATE SET
+	method = EXCLUDED.method,
+	path = EXCLUDED.path,
+	request_hash = EXCLUDED.request_hash,
+	status_code = 0,
+	content_type = NULL,
+	response_body = NULL,
+	created_at = EXCLUDED.created_at,
+	expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= ?
+	OR (idempotency_keys.status_code = 0 AND idempotency_keys.created_at <= ?)
+RETURNING id`
+
+// IdempotencyService stores Idempotency-Key claims and the responses
+// replayed to retries.
+type IdempotencyService struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// NewIdempotencyService creates a new idempotency service
+func NewIdempotencyService(database *gorm.DB) *IdempotencyService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &IdempotencyService{
+		db:  database,
+		now: time.Now,
+	}
+}
+
+// Acquire claims req.Key within req.Scope. The upsert makes the claim atomic:
+// of two concurrent retries exactly one gets a row back.
+func (s *IdempotencyService) Acquire(req contracts.IdempotencyRequest) (*adminm.IdempotencyKey, bool, error) {
+	if s.db == nil {
+		return nil, false, fmt.Errorf("database not initialized")
+	}
+
+	now := s.now().UTC()
+	var ids []uint
+	if err := s.db.Raw(acquireIdempotencyKeySQL,
+		req.Scope, req.Key, req.Method, req.Path, req.RequestHash, now, now.Add(IdempotencyKeyTTL),
+		now, now.Add(-IdempotencyLockTimeout),
+	).Scan(&ids).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	if len(ids) > 0 {
+		return &adminm.IdempotencyKey{
+			ID:          ids[0],
+			Scope:       req.Scope,
+			Key:         req.Key,
+			Method:      req.Method,
+			Path:        req.Path,
+			RequestHash: req.RequestHash,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(IdempotencyKeyTTL),
+		}, true, nil
+	}
+
+	var existing adminm.IdempotencyKey
+	if err := s.db.Where("scope = ? AND idempotency_key = ?", req.Scope, req.Key).
+		First(&existing).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to load idempotency key: %w", err)
+	}
+	return &existing, false, nil
+}
+
+// Complete records the response for a claimed key.
+func (s *IdempotencyService) Complete(id uint, statusCode int, contentType string, body []byte) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	updates := map[string]interface{}{
+		"status_code":   statusCode,
+		"response_body": body,
+		"content_type":  nil,
+	}
+	if contentType != "" {
+		updates["content_type"] = contentType
+	}
+	if err := s.db.Model(&adminm.IdempotencyKey{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Release deletes a claimed key that never completed.
+func (s *IdempotencyService) Release(id uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	if err := s.db.Where("id = ? AND status_code = 0", id).Delete(&adminm.IdempotencyKey{}).Error; err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
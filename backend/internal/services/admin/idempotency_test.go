@@ -0,0 +1,152 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestIdempotencyService_NilDB(t *testing.T) {
+	svc := &IdempotencyService{}
+
+	record, acquired, err := svc.Acquire(contracts.IdempotencyRequest{Scope: "user:1", Key: "k"})
+	assert.Nil(t, record)
+	assert.False(t, acquired)
+	assert.ErrorContains(t, err, "database not initialized")
+
+	assert.ErrorContains(t, svc.Complete(1, 201, "application/json", nil), "database not initialized")
+	assert.ErrorContains(t, svc.Release(1), "database not initialized")
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type IdempotencyIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *IdempotencyService
+	now    time.Time
+}
+
+func (suite *IdempotencyIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+}
+
+func (suite *IdempotencyIntegrationTestSuite) SetupTest() {
+	suite.now = time.Now().UTC().Truncate(time.Second)
+	suite.svc = &IdempotencyService{db: suite.db, now: func() time.Time { return suite.now }}
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM idempotency_keys")
+}
+
+func TestIdempotencyIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyIntegrationTestSuite))
+}
+
+func idempotencyReq(scope, key, hash string) contracts.IdempotencyRequest {
+	return contracts.IdempotencyRequest{Scope: scope, Key: key, Method: "POST", Path: "/shows", RequestHash: hash}
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TestAcquire_ThenReplay() {
+	record, acquired, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.True(acquired)
+
+	// Second claim while in flight sees the in-flight row.
+	existing, acquired, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.False(acquired)
+	suite.True(existing.InFlight())
+
+	suite.Require().NoError(suite.svc.Complete(record.ID, 201, "application/json", []byte(`{"id":5}`)))
+
+	existing, acquired, err = suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.False(acquired)
+	suite.Equal(201, existing.StatusCode)
+	suite.Equal(`{"id":5}`, string(existing.ResponseBody))
+	suite.Require().NotNil(existing.ContentType)
+	suite.Equal("application/json", *existing.ContentType)
+	suite.Equal("h1", existing.RequestHash)
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TestAcquire_ScopedPerCaller() {
+	_, acquired, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.True(acquired)
+
+	_, acquired, err = suite.svc.Acquire(idempotencyReq("user:2", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.True(acquired)
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TestAcquire_TakesOverExpiredRow() {
+	record, _, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.svc.Complete(record.ID, 201, "", nil))
+
+	suite.now = suite.now.Add(IdempotencyKeyTTL + time.Minute)
+	_, acquired, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h2"))
+	suite.Require().NoError(err)
+	suite.True(acquired)
+
+	var row adminm.IdempotencyKey
+	suite.Require().NoError(suite.db.First(&row, "scope = ? AND idempotency_key = ?", "user:1", "k1").Error)
+	suite.Equal("h2", row.RequestHash)
+	suite.True(row.InFlight())
+	suite.Nil(row.ResponseBody)
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TestAcquire_TakesOverStaleClaim() {
+	_, _, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+
+	suite.now = suite.now.Add(IdempotencyLockTimeout - time.Second)
+	_, acquired, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.False(acquired, "a fresh claim still blocks")
+
+	suite.now = suite.now.Add(2 * time.Second)
+	_, acquired, err = suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.True(acquired, "a stale claim is taken over")
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TestRelease() {
+	record, _, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.svc.Release(record.ID))
+
+	_, acquired, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.True(acquired)
+}
+
+func (suite *IdempotencyIntegrationTestSuite) TestRelease_KeepsCompletedRow() {
+	record, _, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.svc.Complete(record.ID, 200, "", nil))
+	suite.Require().NoError(suite.svc.Release(record.ID))
+
+	_, acquired, err := suite.svc.Acquire(idempotencyReq("user:1", "k1", "h1"))
+	suite.Require().NoError(err)
+	suite.False(acquired)
+}
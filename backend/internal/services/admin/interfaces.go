@@ -4,17 +4,23 @@ import "psychic-homily-backend/internal/services/contracts"
 
 // Compile-time interface satisfaction checks for admin services.
 var (
-	_ contracts.AdminStatsServiceInterface    = (*AdminStatsService)(nil)
-	_ contracts.AuditLogServiceInterface      = (*AuditLogService)(nil)
-	_ contracts.DataSyncServiceInterface      = (*DataSyncService)(nil)
-	_ contracts.ShowReportServiceInterface    = (*ShowReportService)(nil)
-	_ contracts.ArtistReportServiceInterface  = (*ArtistReportService)(nil)
-	_ contracts.APITokenServiceInterface      = (*APITokenService)(nil)
-	_ contracts.RevisionServiceInterface      = (*RevisionService)(nil)
-	_ contracts.DataQualityServiceInterface   = (*DataQualityService)(nil)
-	_ contracts.AnalyticsServiceInterface     = (*AnalyticsService)(nil)
-	_ contracts.PendingEditServiceInterface   = (*PendingEditService)(nil)
-	_ contracts.EntityReportServiceInterface  = (*EntityReportService)(nil)
-	_ contracts.AutoPromotionServiceInterface = (*AutoPromotionService)(nil)
+	_ contracts.AdminStatsServiceInterface      = (*AdminStatsService)(nil)
+	_ contracts.AuditLogServiceInterface        = (*AuditLogService)(nil)
+	_ contracts.DataSyncServiceInterface        = (*DataSyncService)(nil)
+	_ contracts.ShowReportServiceInterface      = (*ShowReportService)(nil)
+	_ contracts.ArtistReportServiceInterface    = (*ArtistReportService)(nil)
+	_ contracts.VenueReportServiceInterface     = (*VenueReportService)(nil)
+	_ contracts.APITokenServiceInterface        = (*APITokenService)(nil)
+	_ contracts.RevisionServiceInterface        = (*RevisionService)(nil)
+	_ contracts.DataQualityServiceInterface     = (*DataQualityService)(nil)
+	_ contracts.AnalyticsServiceInterface       = (*AnalyticsService)(nil)
+	_ contracts.PendingEditServiceInterface     = (*PendingEditService)(nil)
+	_ contracts.EntityReportServiceInterface    = (*EntityReportService)(nil)
+	_ contracts.AutoPromotionServiceInterface   = (*AutoPromotionService)(nil)
+	_ contracts.VisibilityDebugServiceInterface = (*VisibilityDebugService)(nil)
+	_ contracts.AdminEventBusInterface          = (*AdminEventBus)(nil)
+	_ contracts.IdempotencyServiceInterface     = (*IdempotencyService)(nil)
+	_ contracts.FeatureFlagServiceInterface     = (*FeatureFlagService)(nil)
+	_ contracts.AdminAnnotationServiceInterface = (*AdminAnnotationService)(nil)
 	// CleanupService has no interface in contracts — it's a lifecycle service.
 )
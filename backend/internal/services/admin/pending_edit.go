@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm"
 
 	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
 	apperrors "psychic-homily-backend/internal/errors"
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
@@ -35,16 +36,23 @@ type PendingEditService struct {
 	revisionService contracts.RevisionServiceInterface
 	emailService    contracts.EmailServiceInterface
 	frontendURL     string
-	// backendURL + jwtSecret mint the HMAC-signed edit-notifications
+	// backendURL + keys mint the HMAC-signed edit-notifications
 	// unsubscribe URL placed in the approval/rejection emails.
 	backendURL string
-	jwtSecret  string
+	keys       *config.JWTKeyRing
 	md         *utils.MarkdownRenderer
 	// bandcampFiller resolves a newly-applied artist Bandcamp PROFILE root → an
 	// embed (PSY-1190 fill-when-empty). Optional/nil-safe — when unset (older
 	// tests), the approval applies the bandcamp change but skips embed resolution.
 	// Wired in the service container (SetBandcampFiller).
 	bandcampFiller contracts.BandcampProfileFillerInterface
+	// venueAddressGeocoder queues a street-level geocode after an approved
+	// edit changes a venue's address or location. Optional/nil-safe; wired in
+	// the service container (SetVenueAddressGeocoder).
+	venueAddressGeocoder func(venueID uint)
+	// events announces new pending venue edits to open admin event streams.
+	// Optional/nil-safe; wired in the service container (SetEventPublisher).
+	events contracts.AdminEventPublisher
 }
 
 // SetBandcampFiller wires the PSY-1190 profile→embed resolver used after a
@@ -54,8 +62,20 @@ func (s *PendingEditService) SetBandcampFiller(f contracts.BandcampProfileFiller
 	s.bandcampFiller = f
 }
 
+// SetVenueAddressGeocoder wires the hook run after an approved venue edit
+// touches the address or location (VenueService.QueueAddressGeocode).
+func (s *PendingEditService) SetVenueAddressGeocoder(f func(venueID uint)) {
+	s.venueAddressGeocoder = f
+}
+
+// SetEventPublisher wires the admin event bus used to announce new pending
+// venue edits.
+func (s *PendingEditService) SetEventPublisher(p contracts.AdminEventPublisher) {
+	s.events = p
+}
+
 // NewPendingEditService creates a new PendingEditService.
-func NewPendingEditService(database *gorm.DB, revisionService contracts.RevisionServiceInterface, emailService contracts.EmailServiceInterface, frontendURL, backendURL, jwtSecret string) *PendingEditService {
+func NewPendingEditService(database *gorm.DB, revisionService contracts.RevisionServiceInterface, emailService contracts.EmailServiceInterface, frontendURL, backendURL string, keys *config.JWTKeyRing) *PendingEditService {
 	if database == nil {
 		database = db.GetDB()
 	}
@@ -65,7 +85,7 @@ func NewPendingEditService(database *gorm.DB, revisionService contracts.Revision
 		emailService:    emailService,
 		frontendURL:     frontendURL,
 		backendURL:      backendURL,
-		jwtSecret:       jwtSecret,
+		keys:            keys,
 		md:              utils.NewMarkdownRenderer(),
 	}
 }
@@ -150,6 +170,16 @@ func (s *PendingEditService) CreatePendingEdit(req *contracts.CreatePendingEditR
 		return nil, apperrors.ErrPendingEditInternal(fmt.Errorf("failed to create pending edit: %w", err))
 	}
 
+	if s.events != nil && req.EntityType == adminm.PendingEditEntityVenue {
+		s.events.Publish(contracts.AdminEvent{
+			Type:       contracts.AdminEventVenueEditPending,
+			ID:         edit.ID,
+			EntityType: req.EntityType,
+			EntityID:   req.EntityID,
+			Summary:    req.Summary,
+		})
+	}
+
 	// Reload with relationships
 	return s.GetPendingEdit(edit.ID)
 }
@@ -362,7 +392,7 @@ func (s *PendingEditService) ApprovePendingEdit(editID uint, reviewerID uint) (*
 		_, countryChanged := updates["country"]
 		if cityChanged || stateChanged || countryChanged {
 			var current catalogm.Venue
-			if err := s.db.Select("city", "state", "country").First(&current, edit.EntityID).Error; err == nil {
+			if err := s.db.Select("city", "state", "country", "timezone_manual").First(&current, edit.EntityID).Error; err == nil {
 				currentCountry := ""
 				if current.Country != nil {
 					currentCountry = *current.Country
@@ -375,7 +405,11 @@ func (s *PendingEditService) ApprovePendingEdit(editID uint, reviewerID uint) (*
 				)
 				updates["latitude"] = lat
 				updates["longitude"] = lng
-				updates["timezone"] = tz
+				// An admin-set timezone survives a relocation.
+				if !current.TimezoneManual {
+					updates["timezone"] = tz
+				}
+				updates["geocode_precision"] = catalogm.CentroidGeocodePrecision(lat)
 				// metro is a sibling of the geocoding (PSY-1255 step B): keep it
 				// fresh when a contribution edit relocates the venue.
 				updates["metro"] = geo.MetroPointer(geo.Default(),
@@ -487,6 +521,17 @@ func (s *PendingEditService) ApprovePendingEdit(editID uint, reviewerID uint) (*
 		}
 	}
 
+	// An approved venue edit that moves the venue or changes its street
+	// address needs a fresh street-level point; the hook runs off-thread.
+	if s.venueAddressGeocoder != nil && edit.EntityType == "venue" {
+		for _, field := range []string{"address", "zipcode", "city", "state", "country"} {
+			if _, ok := updates[field]; ok {
+				s.venueAddressGeocoder(edit.EntityID)
+				break
+			}
+		}
+	}
+
 	// Record revision (fire-and-forget — don't fail the approval if this errors)
 	if s.revisionService != nil {
 		_ = s.revisionService.RecordRevision(edit.EntityType, edit.EntityID, edit.SubmittedBy, changes, edit.Summary)
@@ -654,7 +699,7 @@ func (s *PendingEditService) sendApprovalEmail(edit *adminm.PendingEntityEdit) {
 
 	entityName, entityURL := s.resolveEntityInfo(edit.EntityType, edit.EntityID)
 	username := shared.ResolveUserName(&user)
-	unsubURL := engagement.GenerateScopedUnsubscribeURL(s.backendURL, user.ID, engagement.UnsubscribeScopeEditNotifications, s.jwtSecret)
+	unsubURL := engagement.GenerateScopedUnsubscribeURL(s.backendURL, user.ID, engagement.UnsubscribeScopeEditNotifications, s.keys.Current().Secret)
 
 	if err := s.emailService.SendEditApprovedEmail(*user.Email, username, edit.EntityType, entityName, entityURL, unsubURL); err != nil {
 		log.Printf("sendApprovalEmail: failed to send email to %s: %v", *user.Email, err)
@@ -684,7 +729,7 @@ func (s *PendingEditService) sendRejectionEmail(edit *adminm.PendingEntityEdit,
 
 	entityName, _ := s.resolveEntityInfo(edit.EntityType, edit.EntityID)
 	username := shared.ResolveUserName(&user)
-	unsubURL := engagement.GenerateScopedUnsubscribeURL(s.backendURL, user.ID, engagement.UnsubscribeScopeEditNotifications, s.jwtSecret)
+	unsubURL := engagement.GenerateScopedUnsubscribeURL(s.backendURL, user.ID, engagement.UnsubscribeScopeEditNotifications, s.keys.Current().Secret)
 
 	if err := s.emailService.SendEditRejectedEmail(*user.Email, username, edit.EntityType, entityName, reason, unsubURL); err != nil {
 		log.Printf("sendRejectionEmail: failed to send email to %s: %v", *user.Email, err)
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	"psychic-homily-backend/internal/config"
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
@@ -70,7 +71,7 @@ func (m *mockEmailServiceForPendingEdit) SendMagicLinkEmail(_, _ string) error
 func (m *mockEmailServiceForPendingEdit) SendAccountRecoveryEmail(_, _ string, _ int) error {
 	return nil
 }
-func (m *mockEmailServiceForPendingEdit) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string) error {
+func (m *mockEmailServiceForPendingEdit) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string, _ int) error {
 	return nil
 }
 func (m *mockEmailServiceForPendingEdit) SendFilterNotificationEmail(_, _, _, _ string) error {
@@ -105,7 +106,10 @@ func (m *mockEmailServiceForPendingEdit) SendCommentNotification(_, _, _, _, _,
 func (m *mockEmailServiceForPendingEdit) SendMentionNotification(_, _, _, _, _, _, _ string) error {
 	return nil
 }
-func (m *mockEmailServiceForPendingEdit) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ string) error {
+func (m *mockEmailServiceForPendingEdit) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ []contracts.SceneDigestShow, _ string) error {
+	return nil
+}
+func (m *mockEmailServiceForPendingEdit) SendShowStatusChangeEmail(_ string, _ contracts.ShowStatusChange, _ string) error {
 	return nil
 }
 
@@ -127,7 +131,7 @@ func (s *PendingEditServiceIntegrationTestSuite) SetupSuite() {
 	s.db = s.testDB.DB
 	s.revisionSvc = NewRevisionService(s.db)
 	s.mockEmail = &mockEmailServiceForPendingEdit{configured: true}
-	s.svc = NewPendingEditService(s.db, s.revisionSvc, s.mockEmail, "http://localhost:3000", "http://localhost:8080", "test-jwt-secret")
+	s.svc = NewPendingEditService(s.db, s.revisionSvc, s.mockEmail, "http://localhost:3000", "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 }
 
 func (s *PendingEditServiceIntegrationTestSuite) TearDownSuite() {
@@ -1369,7 +1373,7 @@ func (s *PendingEditServiceIntegrationTestSuite) TestApprovePendingEdit_Festival
 
 func TestPendingEditService_NilEmailServiceDoesNotPanic(t *testing.T) {
 	// Constructor with nil email service should work fine
-	svc := NewPendingEditService(nil, nil, nil, "", "", "")
+	svc := NewPendingEditService(nil, nil, nil, "", "", nil)
 	assert.NotNil(t, svc)
 
 	// sendApprovalEmail and sendRejectionEmail should not panic with nil email service
@@ -1379,7 +1383,7 @@ func TestPendingEditService_NilEmailServiceDoesNotPanic(t *testing.T) {
 
 func TestPendingEditService_UnconfiguredEmailServiceDoesNotPanic(t *testing.T) {
 	mockEmail := &mockEmailServiceForPendingEdit{configured: false}
-	svc := NewPendingEditService(nil, nil, mockEmail, "http://localhost:3000", "http://localhost:8080", "test-jwt-secret")
+	svc := NewPendingEditService(nil, nil, mockEmail, "http://localhost:3000", "http://localhost:8080", config.NewJWTKeyRing("test-jwt-secret", nil))
 
 	// Should return early without attempting to send
 	svc.sendApprovalEmail(&adminm.PendingEntityEdit{SubmittedBy: 1, EntityType: "artist", EntityID: 1})
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 
 	"psychic-homily-backend/db"
 	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
 )
 
 // RevisionService handles revision history business logic.
@@ -35,9 +37,54 @@ func (s *RevisionService) RecordRevision(entityType string, entityID uint, userI
 		return nil // No changes, nothing to record
 	}
 
+	revision, err := newRevision(entityType, entityID, userID, changes, summary)
+	if err != nil {
+		return err
+	}
+	if revision.Snapshot, err = entitySnapshot(s.db, entityType, entityID); err != nil {
+		return err
+	}
+
+	if err := s.db.Create(revision).Error; err != nil {
+		return fmt.Errorf("failed to create revision: %w", err)
+	}
+	return nil
+}
+
+// entitySnapshotSQL selects an entity row as JSON for the entity types whose
+// revisions carry a snapshot. A show's snapshot adds its venue and artist IDs
+// (in billing order), since field_changes never records bill edits.
+var entitySnapshotSQL = map[string]string{
+	"show": `SELECT to_jsonb(s) || jsonb_build_object(
+			'venue_ids', COALESCE((SELECT jsonb_agg(sv.venue_id ORDER BY sv.venue_id) FROM show_venues sv WHERE sv.show_id = s.id), '[]'::jsonb),
+			'artist_ids', COALESCE((SELECT jsonb_agg(sa.artist_id ORDER BY sa.position, sa.artist_id) FROM show_artists sa WHERE sa.show_id = s.id), '[]'::jsonb)
+		) FROM shows s WHERE s.id = ?`,
+	"venue": `SELECT to_jsonb(v) FROM venues v WHERE v.id = ?`,
+}
+
+// entitySnapshot returns the entity's current row as JSON, or nil when the
+// entity type has no snapshot or the row no longer exists.
+func entitySnapshot(tx *gorm.DB, entityType string, entityID uint) (*json.RawMessage, error) {
+	query, ok := entitySnapshotSQL[entityType]
+	if !ok {
+		return nil, nil
+	}
+	var snapshot []byte
+	if err := tx.Raw(query, entityID).Row().Scan(&snapshot); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to snapshot %s %d: %w", entityType, entityID, err)
+	}
+	raw := json.RawMessage(snapshot)
+	return &raw, nil
+}
+
+// newRevision builds an unsaved revision row, marshalling changes to JSON.
+func newRevision(entityType string, entityID uint, userID uint, changes []adminm.FieldChange, summary string) (*adminm.Revision, error) {
 	changesJSON, err := json.Marshal(changes)
 	if err != nil {
-		return fmt.Errorf("failed to marshal field changes: %w", err)
+		return nil, fmt.Errorf("failed to marshal field changes: %w", err)
 	}
 	raw := json.RawMessage(changesJSON)
 
@@ -46,18 +93,13 @@ func (s *RevisionService) RecordRevision(entityType string, entityID uint, userI
 		summaryPtr = &summary
 	}
 
-	revision := &adminm.Revision{
+	return &adminm.Revision{
 		EntityType:   entityType,
 		EntityID:     entityID,
 		UserID:       userID,
 		FieldChanges: &raw,
 		Summary:      summaryPtr,
-	}
-
-	if err := s.db.Create(revision).Error; err != nil {
-		return fmt.Errorf("failed to create revision: %w", err)
-	}
-	return nil
+	}, nil
 }
 
 // GetEntityHistory returns paginated revision history for a specific entity.
@@ -156,16 +198,16 @@ func (s *RevisionService) Rollback(revisionID uint, adminUserID uint) error {
 	}
 
 	// Parse field changes
-	var changes []adminm.FieldChange
-	if err := json.Unmarshal(*revision.FieldChanges, &changes); err != nil {
-		return fmt.Errorf("failed to parse field changes: %w", err)
+	changes, err := parseFieldChanges(revision)
+	if err != nil {
+		return err
 	}
 
 	// Build update map from old values (reversing the change)
 	updates := make(map[string]interface{})
 	var rollbackChanges []adminm.FieldChange
 	for _, c := range changes {
-		updates[c.Field] = c.OldValue
+		updates[c.Field] = revisionColumnValue(revision.EntityType, c.Field, c.OldValue)
 		rollbackChanges = append(rollbackChanges, adminm.FieldChange{
 			Field:    c.Field,
 			OldValue: c.NewValue,
@@ -189,3 +231,181 @@ func (s *RevisionService) Rollback(revisionID uint, adminUserID uint) error {
 	summary := fmt.Sprintf("Rollback of revision #%d", revisionID)
 	return s.RecordRevision(revision.EntityType, revision.EntityID, adminUserID, rollbackChanges, summary)
 }
+
+// GetRevisionSnapshot reconstructs the entity's tracked fields as they stood
+// right after the given revision. A field's value comes from the revision
+// itself when it touched the field, otherwise from the old value of the first
+// later revision that did. Returns nil, nil if the revision is not found.
+func (s *RevisionService) GetRevisionSnapshot(revisionID uint) (*contracts.RevisionSnapshot, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	revision, err := s.GetRevision(revisionID)
+	if err != nil || revision == nil {
+		return nil, err
+	}
+
+	var later []adminm.Revision
+	err = s.db.Where("entity_type = ? AND entity_id = ? AND id > ?", revision.EntityType, revision.EntityID, revision.ID).
+		Order("id ASC").
+		Find(&later).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get later revisions: %w", err)
+	}
+
+	changes, err := parseFieldChanges(revision)
+	if err != nil {
+		return nil, err
+	}
+
+	var order []string
+	fields := make(map[string]*contracts.RevisionSnapshotField)
+	for _, c := range changes {
+		if _, ok := fields[c.Field]; !ok {
+			order = append(order, c.Field)
+		}
+		fields[c.Field] = &contracts.RevisionSnapshotField{Field: c.Field, Value: c.NewValue, CurrentValue: c.NewValue}
+	}
+	for i := range later {
+		laterChanges, err := parseFieldChanges(&later[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range laterChanges {
+			f, ok := fields[c.Field]
+			if !ok {
+				order = append(order, c.Field)
+				f = &contracts.RevisionSnapshotField{Field: c.Field, Value: c.OldValue}
+				fields[c.Field] = f
+			}
+			f.CurrentValue = c.NewValue
+		}
+	}
+
+	snapshot := &contracts.RevisionSnapshot{
+		RevisionID:     revision.ID,
+		EntityType:     revision.EntityType,
+		EntityID:       revision.EntityID,
+		CreatedAt:      revision.CreatedAt,
+		LaterRevisions: len(later),
+		Fields:         make([]contracts.RevisionSnapshotField, 0, len(order)),
+	}
+	for _, name := range order {
+		f := fields[name]
+		f.Changed = !sameFieldValue(f.Value, f.CurrentValue)
+		snapshot.Fields = append(snapshot.Fields, *f)
+	}
+	return snapshot, nil
+}
+
+// RestoreRevision resets the entity's tracked fields to the snapshot of the
+// given revision, undoing every later edit at once (Rollback undoes a single
+// revision). The restore is recorded as a new revision in the same
+// transaction. Restoring a snapshot that already matches is a no-op.
+func (s *RevisionService) RestoreRevision(revisionID uint, adminUserID uint) (*contracts.RevisionSnapshot, error) {
+	snapshot, err := s.GetRevisionSnapshot(revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("revision not found")
+	}
+
+	updates := make(map[string]interface{})
+	var restoreChanges []adminm.FieldChange
+	for _, f := range snapshot.Fields {
+		if !f.Changed {
+			continue
+		}
+		updates[f.Field] = revisionColumnValue(snapshot.EntityType, f.Field, f.Value)
+		restoreChanges = append(restoreChanges, adminm.FieldChange{
+			Field:    f.Field,
+			OldValue: f.CurrentValue,
+			NewValue: f.Value,
+		})
+	}
+	if len(updates) == 0 {
+		return snapshot, nil
+	}
+
+	summary := fmt.Sprintf("Restore to revision #%d", revisionID)
+	restore, err := newRevision(snapshot.EntityType, snapshot.EntityID, adminUserID, restoreChanges, summary)
+	if err != nil {
+		return nil, err
+	}
+
+	tableName := snapshot.EntityType + "s" // same mapping as Rollback
+	updates["updated_at"] = time.Now()
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Table(tableName).Where("id = ?", snapshot.EntityID).Updates(updates)
+		if result.Error != nil {
+			return fmt.Errorf("failed to apply restore: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("entity not found: %s %d", snapshot.EntityType, snapshot.EntityID)
+		}
+		var err error
+		if restore.Snapshot, err = entitySnapshot(tx, snapshot.EntityType, snapshot.EntityID); err != nil {
+			return err
+		}
+		if err := tx.Create(restore).Error; err != nil {
+			return fmt.Errorf("failed to create revision: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// renamedRevisionFields maps field names recorded by older revisions to the
+// column that holds them now, per entity type, so Rollback and RestoreRevision
+// still write a real column after a rename.
+var renamedRevisionFields = map[string]map[string]string{
+	"show": {"price": "price_min"},
+}
+
+// zeroIsNullRevisionFields are nullable columns whose NULL a revision records
+// as 0 (revisiondiff's nil-is-zero rule). Writing that 0 back would break a
+// constraint, so Rollback and RestoreRevision write NULL instead.
+var zeroIsNullRevisionFields = map[string]map[string]bool{
+	"show": {"price_max": true},
+}
+
+// revisionColumnValue is the value Rollback and RestoreRevision write for a
+// recorded field value.
+func revisionColumnValue(entityType, field string, value interface{}) interface{} {
+	if zeroIsNullRevisionFields[entityType][field] && value == float64(0) {
+		return nil
+	}
+	return value
+}
+
+// parseFieldChanges decodes a revision's JSON field changes, applying
+// renamedRevisionFields.
+func parseFieldChanges(revision *adminm.Revision) ([]adminm.FieldChange, error) {
+	if revision.FieldChanges == nil {
+		return nil, nil
+	}
+	var changes []adminm.FieldChange
+	if err := json.Unmarshal(*revision.FieldChanges, &changes); err != nil {
+		return nil, fmt.Errorf("failed to parse field changes: %w", err)
+	}
+	for i := range changes {
+		if column, ok := renamedRevisionFields[revision.EntityType][changes[i].Field]; ok {
+			changes[i].Field = column
+		}
+	}
+	return changes, nil
+}
+
+// sameFieldValue compares two decoded JSON values by their encoding, so
+// 1 and 1.0 or two equal strings compare equal regardless of Go type.
+func sameFieldValue(a, b interface{}) bool {
+	aj, errA := json.Marshal(a)
+	bj, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(aj) == string(bj)
+}
@@ -41,6 +41,8 @@ func (s *RevisionServiceIntegrationTestSuite) TearDownTest() {
 	sqlDB, err := s.db.DB()
 	s.Require().NoError(err)
 	_, _ = sqlDB.Exec("DELETE FROM revisions")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
 	_, _ = sqlDB.Exec("DELETE FROM venues")
 	_, _ = sqlDB.Exec("DELETE FROM users")
 }
@@ -154,6 +156,55 @@ func (s *RevisionServiceIntegrationTestSuite) TestRecordRevision_EmptySummary()
 	s.Nil(revision.Summary) // Empty summary stored as nil
 }
 
+func (s *RevisionServiceIntegrationTestSuite) TestRecordRevision_SnapshotsVenue() {
+	user := s.createTestUser()
+	venue := s.createTestVenue("Snapshot Venue")
+
+	changes := []adminm.FieldChange{{Field: "name", OldValue: "Old", NewValue: "Snapshot Venue"}}
+	s.Require().NoError(s.svc.RecordRevision("venue", venue.ID, user.ID, changes, ""))
+
+	var revision adminm.Revision
+	s.Require().NoError(s.db.First(&revision).Error)
+	s.Require().NotNil(revision.Snapshot)
+
+	var snapshot map[string]interface{}
+	s.Require().NoError(json.Unmarshal(*revision.Snapshot, &snapshot))
+	s.Equal("Snapshot Venue", snapshot["name"])
+	s.Equal("Phoenix", snapshot["city"])
+}
+
+func (s *RevisionServiceIntegrationTestSuite) TestRecordRevision_SnapshotsShowWithBill() {
+	user := s.createTestUser()
+	venue := s.createTestVenue("Bill Venue")
+	show := &catalogm.Show{Title: "Snapshot Show", EventDate: time.Now().Add(24 * time.Hour)}
+	s.Require().NoError(s.db.Create(show).Error)
+	s.Require().NoError(s.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: venue.ID}).Error)
+
+	changes := []adminm.FieldChange{{Field: "title", OldValue: "Old", NewValue: "Snapshot Show"}}
+	s.Require().NoError(s.svc.RecordRevision("show", show.ID, user.ID, changes, ""))
+
+	var revision adminm.Revision
+	s.Require().NoError(s.db.First(&revision).Error)
+	s.Require().NotNil(revision.Snapshot)
+
+	var snapshot map[string]interface{}
+	s.Require().NoError(json.Unmarshal(*revision.Snapshot, &snapshot))
+	s.Equal("Snapshot Show", snapshot["title"])
+	s.Equal([]interface{}{float64(venue.ID)}, snapshot["venue_ids"])
+	s.Equal([]interface{}{}, snapshot["artist_ids"])
+}
+
+func (s *RevisionServiceIntegrationTestSuite) TestRecordRevision_NoSnapshotForOtherTypes() {
+	user := s.createTestUser()
+
+	changes := []adminm.FieldChange{{Field: "name", OldValue: "Old", NewValue: "New"}}
+	s.Require().NoError(s.svc.RecordRevision("artist", 1, user.ID, changes, ""))
+
+	var revision adminm.Revision
+	s.Require().NoError(s.db.First(&revision).Error)
+	s.Nil(revision.Snapshot)
+}
+
 // =============================================================================
 // GetEntityHistory tests
 // =============================================================================
@@ -438,3 +489,119 @@ func (s *RevisionServiceIntegrationTestSuite) TestRollback_EntityNotFound() {
 	s.Error(err)
 	s.Contains(err.Error(), "entity not found")
 }
+
+// =============================================================================
+// Snapshot / Restore tests
+// =============================================================================
+
+// recordVenueEdits records name/city edits in order and applies the final
+// values to the venue, returning the revisions oldest first.
+func (s *RevisionServiceIntegrationTestSuite) recordVenueEdits(venueID, userID uint, edits ...[]adminm.FieldChange) []adminm.Revision {
+	final := map[string]interface{}{}
+	for _, changes := range edits {
+		s.Require().NoError(s.svc.RecordRevision("venue", venueID, userID, changes, ""))
+		for _, c := range changes {
+			final[c.Field] = c.NewValue
+		}
+	}
+	s.Require().NoError(s.db.Table("venues").Where("id = ?", venueID).Updates(final).Error)
+
+	var revisions []adminm.Revision
+	s.db.Where("entity_type = ? AND entity_id = ?", "venue", venueID).Order("id ASC").Find(&revisions)
+	s.Require().Len(revisions, len(edits))
+	return revisions
+}
+
+func (s *RevisionServiceIntegrationTestSuite) TestGetRevisionSnapshot_ReconstructsFields() {
+	user := s.createTestUser()
+	venue := s.createTestVenue("First")
+
+	revisions := s.recordVenueEdits(venue.ID, user.ID,
+		[]adminm.FieldChange{{Field: "name", OldValue: "First", NewValue: "Second"}},
+		[]adminm.FieldChange{{Field: "city", OldValue: "Phoenix", NewValue: "Tempe"}},
+		[]adminm.FieldChange{{Field: "name", OldValue: "Second", NewValue: "Third"}},
+	)
+
+	snapshot, err := s.svc.GetRevisionSnapshot(revisions[0].ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(snapshot)
+	s.Equal(2, snapshot.LaterRevisions)
+	s.Require().Len(snapshot.Fields, 2)
+
+	s.Equal("name", snapshot.Fields[0].Field)
+	s.Equal("Second", snapshot.Fields[0].Value)
+	s.Equal("Third", snapshot.Fields[0].CurrentValue)
+	s.True(snapshot.Fields[0].Changed)
+
+	s.Equal("city", snapshot.Fields[1].Field)
+	s.Equal("Phoenix", snapshot.Fields[1].Value)
+	s.Equal("Tempe", snapshot.Fields[1].CurrentValue)
+	s.True(snapshot.Fields[1].Changed)
+}
+
+func (s *RevisionServiceIntegrationTestSuite) TestGetRevisionSnapshot_NotFound() {
+	snapshot, err := s.svc.GetRevisionSnapshot(99999)
+	s.NoError(err)
+	s.Nil(snapshot)
+}
+
+func (s *RevisionServiceIntegrationTestSuite) TestRestoreRevision_UndoesLaterEdits() {
+	user := s.createTestUser()
+	adminUser := s.createTestUser()
+	venue := s.createTestVenue("First")
+
+	revisions := s.recordVenueEdits(venue.ID, user.ID,
+		[]adminm.FieldChange{{Field: "name", OldValue: "First", NewValue: "Second"}},
+		[]adminm.FieldChange{{Field: "city", OldValue: "Phoenix", NewValue: "Tempe"}},
+		[]adminm.FieldChange{{Field: "name", OldValue: "Second", NewValue: "Third"}},
+	)
+
+	_, err := s.svc.RestoreRevision(revisions[0].ID, adminUser.ID)
+	s.Require().NoError(err)
+
+	var restored catalogm.Venue
+	s.db.First(&restored, venue.ID)
+	s.Equal("Second", restored.Name)
+	s.Equal("Phoenix", restored.City)
+
+	var latest adminm.Revision
+	s.db.Where("entity_type = ? AND entity_id = ?", "venue", venue.ID).Order("id DESC").First(&latest)
+	s.Equal(adminUser.ID, latest.UserID)
+	s.Require().NotNil(latest.Summary)
+	s.Equal(fmt.Sprintf("Restore to revision #%d", revisions[0].ID), *latest.Summary)
+
+	var changes []adminm.FieldChange
+	s.Require().NoError(json.Unmarshal(*latest.FieldChanges, &changes))
+	s.Require().Len(changes, 2)
+	s.Equal(adminm.FieldChange{Field: "name", OldValue: "Third", NewValue: "Second"}, changes[0])
+	s.Equal(adminm.FieldChange{Field: "city", OldValue: "Tempe", NewValue: "Phoenix"}, changes[1])
+
+	// The restore revision snapshots the restored row.
+	s.Require().NotNil(latest.Snapshot)
+	var snapshot map[string]interface{}
+	s.Require().NoError(json.Unmarshal(*latest.Snapshot, &snapshot))
+	s.Equal("Second", snapshot["name"])
+}
+
+func (s *RevisionServiceIntegrationTestSuite) TestRestoreRevision_LatestIsNoOp() {
+	user := s.createTestUser()
+	venue := s.createTestVenue("First")
+
+	revisions := s.recordVenueEdits(venue.ID, user.ID,
+		[]adminm.FieldChange{{Field: "name", OldValue: "First", NewValue: "Second"}},
+	)
+
+	snapshot, err := s.svc.RestoreRevision(revisions[0].ID, user.ID)
+	s.Require().NoError(err)
+	s.False(snapshot.Fields[0].Changed)
+
+	var count int64
+	s.db.Model(&adminm.Revision{}).Where("entity_type = ? AND entity_id = ?", "venue", venue.ID).Count(&count)
+	s.Equal(int64(1), count)
+}
+
+func (s *RevisionServiceIntegrationTestSuite) TestRestoreRevision_RevisionNotFound() {
+	_, err := s.svc.RestoreRevision(99999, 1)
+	s.Error(err)
+	s.Contains(err.Error(), "revision not found")
+}
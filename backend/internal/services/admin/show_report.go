@@ -15,7 +15,8 @@ import (
 
 // ShowReportService handles show report business logic
 type ShowReportService struct {
-	db *gorm.DB
+	db     *gorm.DB
+	events contracts.AdminEventPublisher
 }
 
 // NewShowReportService creates a new show report service
@@ -28,6 +29,12 @@ func NewShowReportService(database *gorm.DB) *ShowReportService {
 	}
 }
 
+// SetEventPublisher wires the admin event bus. Optional — when nil, new
+// reports are not announced to open admin event streams.
+func (s *ShowReportService) SetEventPublisher(p contracts.AdminEventPublisher) {
+	s.events = p
+}
+
 // CreateReport creates a new show report
 func (s *ShowReportService) CreateReport(userID, showID uint, reportType string, details *string) (*contracts.ShowReportResponse, error) {
 	if s.db == nil {
@@ -77,6 +84,16 @@ func (s *ShowReportService) CreateReport(userID, showID uint, reportType string,
 		return nil, fmt.Errorf("failed to create report: %w", err)
 	}
 
+	if s.events != nil {
+		s.events.Publish(contracts.AdminEvent{
+			Type:       contracts.AdminEventReportCreated,
+			ID:         report.ID,
+			EntityType: "show",
+			EntityID:   showID,
+			Summary:    reportType,
+		})
+	}
+
 	return s.buildReportResponse(&report, &show), nil
 }
 
@@ -11,6 +11,7 @@ import (
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	communitym "psychic-homily-backend/internal/models/community"
+	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/testutil"
 )
 
@@ -119,6 +120,27 @@ func (suite *ShowReportServiceIntegrationTestSuite) TestCreateReport_Success() {
 	suite.Equal(show.Title, resp.Show.Title)
 }
 
+func (suite *ShowReportServiceIntegrationTestSuite) TestCreateReport_PublishesAdminEvent() {
+	bus := NewAdminEventBus()
+	events, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+	suite.reportService.SetEventPublisher(bus)
+	defer suite.reportService.SetEventPublisher(nil)
+
+	show := suite.createApprovedShow("Evented Show")
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.CreateReport(user.ID, show.ID, "cancelled", nil)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(events, 1)
+	event := <-events
+	suite.Equal(contracts.AdminEventReportCreated, event.Type)
+	suite.Equal(resp.ID, event.ID)
+	suite.Equal("show", event.EntityType)
+	suite.Equal(show.ID, event.EntityID)
+}
+
 func (suite *ShowReportServiceIntegrationTestSuite) TestCreateReport_AllReportTypes() {
 	for _, reportType := range []string{"cancelled", "sold_out", "inaccurate"} {
 		show := suite.createApprovedShow(fmt.Sprintf("Show for %s", reportType))
@@ -19,7 +19,8 @@ import (
 
 // AdminStatsService handles admin dashboard statistics
 type AdminStatsService struct {
-	db *gorm.DB
+	db         *gorm.DB
+	timeSeries timeSeriesCache
 }
 
 // NewAdminStatsService creates a new admin stats service
@@ -41,7 +42,7 @@ func (s *AdminStatsService) GetDashboardStats() (*contracts.AdminDashboardStats,
 	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
 
 	// Action items
-	if err := s.db.Model(&catalogm.Show{}).Where("status = ?", catalogm.ShowStatusPending).Count(&stats.PendingShows).Error; err != nil {
+	if err := s.db.Model(&catalogm.Show{}).Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusPending).Count(&stats.PendingShows).Error; err != nil {
 		return nil, err
 	}
 	if err := s.db.Model(&adminm.PendingEntityEdit{}).
@@ -55,12 +56,15 @@ func (s *AdminStatsService) GetDashboardStats() (*contracts.AdminDashboardStats,
 	if err := s.db.Model(&communitym.ArtistReport{}).Where("status = ?", communitym.ShowReportStatusPending).Count(&stats.PendingArtistReports).Error; err != nil {
 		return nil, err
 	}
+	if err := s.db.Model(&communitym.VenueReport{}).Where("status = ?", communitym.ShowReportStatusPending).Count(&stats.PendingVenueReports).Error; err != nil {
+		return nil, err
+	}
 	if err := s.db.Model(&catalogm.Venue{}).Where("verified = ?", false).Count(&stats.UnverifiedVenues).Error; err != nil {
 		return nil, err
 	}
 
 	// Content totals
-	if err := s.db.Model(&catalogm.Show{}).Where("status = ?", catalogm.ShowStatusApproved).Count(&stats.TotalShows).Error; err != nil {
+	if err := s.db.Model(&catalogm.Show{}).Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusApproved).Count(&stats.TotalShows).Error; err != nil {
 		return nil, err
 	}
 	if err := s.db.Model(&catalogm.Venue{}).Where("verified = ?", true).Count(&stats.TotalVenues).Error; err != nil {
@@ -76,7 +80,7 @@ func (s *AdminStatsService) GetDashboardStats() (*contracts.AdminDashboardStats,
 	}
 
 	// Recent activity
-	if err := s.db.Model(&catalogm.Show{}).Where("created_at > ?", sevenDaysAgo).Count(&stats.ShowsSubmittedLast7Days).Error; err != nil {
+	if err := s.db.Model(&catalogm.Show{}).Where("created_at > ? AND deleted_at IS NULL", sevenDaysAgo).Count(&stats.ShowsSubmittedLast7Days).Error; err != nil {
 		return nil, err
 	}
 	if err := s.db.Model(&authm.User{}).Where("created_at > ?", sevenDaysAgo).Count(&stats.UsersRegisteredLast7Days).Error; err != nil {
@@ -87,11 +91,11 @@ func (s *AdminStatsService) GetDashboardStats() (*contracts.AdminDashboardStats,
 	fourteenDaysAgo := time.Now().AddDate(0, 0, -14)
 
 	var showsCurrent, showsPrevious int64
-	if err := s.db.Model(&catalogm.Show{}).Where("status = ? AND created_at > ?", catalogm.ShowStatusApproved, sevenDaysAgo).Count(&showsCurrent).Error; err != nil {
+	if err := s.db.Model(&catalogm.Show{}).Where("status = ? AND created_at > ? AND deleted_at IS NULL", catalogm.ShowStatusApproved, sevenDaysAgo).Count(&showsCurrent).Error; err != nil {
 		// Log but don't fail — trends are non-critical
 		showsCurrent = 0
 	}
-	if err := s.db.Model(&catalogm.Show{}).Where("status = ? AND created_at > ? AND created_at <= ?", catalogm.ShowStatusApproved, fourteenDaysAgo, sevenDaysAgo).Count(&showsPrevious).Error; err != nil {
+	if err := s.db.Model(&catalogm.Show{}).Where("status = ? AND created_at > ? AND created_at <= ? AND deleted_at IS NULL", catalogm.ShowStatusApproved, fourteenDaysAgo, sevenDaysAgo).Count(&showsPrevious).Error; err != nil {
 		showsPrevious = 0
 	}
 	stats.TotalShowsTrend = showsCurrent - showsPrevious
@@ -198,11 +202,214 @@ func (s *AdminStatsService) GetRecentActivity() (*contracts.ActivityFeedResponse
 	return &contracts.ActivityFeedResponse{Events: events}, nil
 }
 
+// uncategorizedRejection labels rejections that were made without a category.
+const uncategorizedRejection = "uncategorized"
+
+// GetReviewQueueStats returns show review-queue analytics for [from, to).
+// Approval and rejection figures come from the audit log, so they cover
+// every show an admin acted on in the range; time-to-approval is measured
+// from the show's submission to the approve_show entry.
+func (s *AdminStatsService) GetReviewQueueStats(from, to time.Time) (*contracts.ReviewQueueStats, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	stats := &contracts.ReviewQueueStats{
+		From:             from,
+		To:               to,
+		ApprovalsByAdmin: []contracts.ReviewerApprovalCount{},
+		RejectionReasons: []contracts.RejectionReasonCount{},
+	}
+
+	inRange := func(action string) *gorm.DB {
+		return s.db.Model(&adminm.AuditLog{}).
+			Where("action = ? AND entity_type = ? AND created_at >= ? AND created_at < ?", action, "show", from, to)
+	}
+
+	if err := inRange("approve_show").Count(&stats.Approvals).Error; err != nil {
+		return nil, err
+	}
+	if err := inRange("reject_show").Count(&stats.Rejections).Error; err != nil {
+		return nil, err
+	}
+
+	var median struct{ Hours *float64 }
+	if err := s.db.Raw(`
+		SELECT percentile_cont(0.5) WITHIN GROUP (
+			ORDER BY EXTRACT(EPOCH FROM (a.created_at - s.created_at)) / 3600
+		) AS hours
+		FROM audit_logs a
+		JOIN shows s ON s.id = a.entity_id
+		WHERE a.action = 'approve_show' AND a.entity_type = 'show'
+			AND a.created_at >= ? AND a.created_at < ?`, from, to).
+		Scan(&median).Error; err != nil {
+		return nil, err
+	}
+	stats.MedianHoursToApproval = median.Hours
+
+	// Aging buckets
+	now := time.Now()
+	pending := func() *gorm.DB {
+		return s.db.Model(&catalogm.Show{}).Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusPending)
+	}
+	if err := pending().Count(&stats.Aging.Total).Error; err != nil {
+		return nil, err
+	}
+	if err := pending().Where("created_at < ?", now.Add(-24*time.Hour)).Count(&stats.Aging.Over24h).Error; err != nil {
+		return nil, err
+	}
+	if err := pending().Where("created_at < ?", now.AddDate(0, 0, -3)).Count(&stats.Aging.Over3Days).Error; err != nil {
+		return nil, err
+	}
+	if err := pending().Where("created_at < ?", now.AddDate(0, 0, -7)).Count(&stats.Aging.Over7Days).Error; err != nil {
+		return nil, err
+	}
+
+	// Per-admin approvals
+	var byAdmin []struct {
+		ActorID uint
+		Count   int64
+	}
+	if err := inRange("approve_show").
+		Select("actor_id, COUNT(*) AS count").
+		Where("actor_id IS NOT NULL").
+		Group("actor_id").
+		Order("count DESC, actor_id").
+		Scan(&byAdmin).Error; err != nil {
+		return nil, err
+	}
+	if len(byAdmin) > 0 {
+		ids := make([]uint, len(byAdmin))
+		for i, row := range byAdmin {
+			ids[i] = row.ActorID
+		}
+		var users []authm.User
+		if err := s.db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+			return nil, err
+		}
+		usersByID := make(map[uint]*authm.User, len(users))
+		for i := range users {
+			usersByID[users[i].ID] = &users[i]
+		}
+		for _, row := range byAdmin {
+			stats.ApprovalsByAdmin = append(stats.ApprovalsByAdmin, contracts.ReviewerApprovalCount{
+				AdminID:   row.ActorID,
+				AdminName: shared.ResolveUserName(usersByID[row.ActorID]),
+				Approvals: row.Count,
+			})
+		}
+	}
+
+	// Rejection reasons
+	if err := s.db.Raw(`
+		SELECT COALESCE(NULLIF(s.rejection_category, ''), ?) AS category, COUNT(*) AS count
+		FROM audit_logs a
+		LEFT JOIN shows s ON s.id = a.entity_id
+		WHERE a.action = 'reject_show' AND a.entity_type = 'show'
+			AND a.created_at >= ? AND a.created_at < ?
+		GROUP BY 1
+		ORDER BY count DESC, category`, uncategorizedRejection, from, to).
+		Scan(&stats.RejectionReasons).Error; err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// moderationQueueSQL selects every pending moderation item as one
+// type-tagged row set. Its placeholders are filled by moderationQueueArgs.
+const moderationQueueSQL = `
+	SELECT 'show' AS type, s.id, 'show' AS entity_type, s.id AS entity_id,
+		s.title, NULL::text AS detail, s.submitted_by, s.created_at
+	FROM shows s
+	WHERE s.status = ? AND s.deleted_at IS NULL
+	UNION ALL
+	SELECT 'venue_edit', e.id, 'venue', e.entity_id,
+		COALESCE(v.name, ''), e.summary, e.submitted_by, e.created_at
+	FROM pending_entity_edits e
+	LEFT JOIN venues v ON v.id = e.entity_id
+	WHERE e.status = ? AND e.entity_type = ?
+	UNION ALL
+	SELECT 'show_report', r.id, 'show', r.show_id,
+		COALESCE(s.title, ''), r.report_type::text, r.reported_by, r.created_at
+	FROM show_reports r
+	LEFT JOIN shows s ON s.id = r.show_id
+	WHERE r.status = ?
+	UNION ALL
+	SELECT 'artist_report', r.id, 'artist', r.artist_id,
+		COALESCE(a.name, ''), r.report_type::text, r.reported_by, r.created_at
+	FROM artist_reports r
+	LEFT JOIN artists a ON a.id = r.artist_id
+	WHERE r.status = ?
+	UNION ALL
+	SELECT 'venue_report', r.id, 'venue', r.venue_id,
+		COALESCE(v.name, ''), r.report_type::text, r.reported_by, r.created_at
+	FROM venue_reports r
+	LEFT JOIN venues v ON v.id = r.venue_id
+	WHERE r.status = ?`
+
+// moderationQueueArgs returns the status filters for moderationQueueSQL.
+func moderationQueueArgs() []interface{} {
+	return []interface{}{
+		catalogm.ShowStatusPending,
+		adminm.PendingEditStatusPending, adminm.PendingEditEntityVenue,
+		communitym.ShowReportStatusPending,
+		communitym.ShowReportStatusPending,
+		communitym.ShowReportStatusPending,
+	}
+}
+
+// GetModerationQueue merges pending shows, venue edits and show, artist and
+// venue reports into one queue, oldest first, so admins work a single inbox
+// instead of polling each queue. An empty itemType returns every type.
+func (s *AdminStatsService) GetModerationQueue(itemType string, limit, offset int) (*contracts.ModerationQueue, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	queue := &contracts.ModerationQueue{
+		Items:  []contracts.ModerationQueueItem{},
+		Counts: make(map[string]int64, len(contracts.ModerationItemTypes)),
+	}
+	for _, t := range contracts.ModerationItemTypes {
+		queue.Counts[t] = 0
+	}
+
+	var counts []struct {
+		Type  string
+		Count int64
+	}
+	if err := s.db.Raw(
+		"SELECT type, COUNT(*) AS count FROM ("+moderationQueueSQL+") q GROUP BY type",
+		moderationQueueArgs()...,
+	).Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count moderation queue: %w", err)
+	}
+	for _, c := range counts {
+		queue.Counts[c.Type] = c.Count
+		if itemType == "" || itemType == c.Type {
+			queue.Total += c.Count
+		}
+	}
+
+	args := append(moderationQueueArgs(), itemType, itemType, limit, offset)
+	if err := s.db.Raw(
+		"SELECT * FROM ("+moderationQueueSQL+") q"+
+			" WHERE (? = '' OR type = ?)"+
+			" ORDER BY created_at ASC, type, id LIMIT ? OFFSET ?",
+		args...,
+	).Scan(&queue.Items).Error; err != nil {
+		return nil, fmt.Errorf("failed to list moderation queue: %w", err)
+	}
+
+	return queue, nil
+}
+
 // mapActionToEventType maps an audit log action string to a human-friendly event type.
 func mapActionToEventType(action string) string {
 	mapping := map[string]string{
 		"approve_show":               "show_approved",
 		"reject_show":                "show_rejected",
+		"auto_approve_show":          "show_auto_approved",
 		"verify_venue":               "venue_verified",
 		"create_artist":              "artist_created",
 		"edit_artist":                "artist_edited",
@@ -255,6 +462,7 @@ func buildDescription(action, entityType string, entityID uint) string {
 	actionDescriptions := map[string]string{
 		"approve_show":               "Show #%d was approved",
 		"reject_show":                "Show #%d was rejected",
+		"auto_approve_show":          "Show #%d was auto-approved (trusted submitter)",
 		"verify_venue":               "Venue #%d was verified",
 		"create_artist":              "Artist #%d was created",
 		"edit_artist":                "Artist #%d was edited",
@@ -12,6 +12,7 @@ import (
 	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/testutil"
 )
 
@@ -43,6 +44,7 @@ func (suite *AdminStatsServiceIntegrationTestSuite) TearDownTest() {
 	_, _ = sqlDB.Exec("DELETE FROM pending_entity_edits")
 	_, _ = sqlDB.Exec("DELETE FROM audit_logs")
 	_, _ = sqlDB.Exec("DELETE FROM artist_reports")
+	_, _ = sqlDB.Exec("DELETE FROM venue_reports")
 	_, _ = sqlDB.Exec("DELETE FROM show_reports")
 	_, _ = sqlDB.Exec("DELETE FROM show_artists")
 	_, _ = sqlDB.Exec("DELETE FROM show_venues")
@@ -610,3 +612,261 @@ func (suite *AdminStatsServiceIntegrationTestSuite) TestGetDashboardStats_Trends
 	suite.Equal(int64(0), stats.TotalArtistsTrend)
 	suite.Equal(int64(0), stats.TotalUsersTrend)
 }
+
+// =============================================================================
+// GetReviewQueueStats
+// =============================================================================
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetReviewQueueStats_Empty() {
+	now := time.Now()
+	stats, err := suite.service.GetReviewQueueStats(now.AddDate(0, 0, -30), now)
+	suite.Require().NoError(err)
+
+	suite.Equal(int64(0), stats.Approvals)
+	suite.Equal(int64(0), stats.Rejections)
+	suite.Nil(stats.MedianHoursToApproval)
+	suite.Equal(int64(0), stats.Aging.Total)
+	suite.Empty(stats.ApprovalsByAdmin)
+	suite.Empty(stats.RejectionReasons)
+}
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetReviewQueueStats_Aging() {
+	now := time.Now()
+	suite.createShowWithTime("Fresh", catalogm.ShowStatusPending, now.Add(-2*time.Hour))
+	suite.createShowWithTime("Two Days", catalogm.ShowStatusPending, now.Add(-48*time.Hour))
+	suite.createShowWithTime("Five Days", catalogm.ShowStatusPending, now.AddDate(0, 0, -5))
+	suite.createShowWithTime("Ten Days", catalogm.ShowStatusPending, now.AddDate(0, 0, -10))
+	suite.createShowWithTime("Approved", catalogm.ShowStatusApproved, now.AddDate(0, 0, -10))
+	deleted := suite.createShowWithTime("Deleted", catalogm.ShowStatusPending, now.AddDate(0, 0, -10))
+	suite.db.Exec("UPDATE shows SET deleted_at = NOW() WHERE id = ?", deleted.ID)
+
+	stats, err := suite.service.GetReviewQueueStats(now.AddDate(0, 0, -30), now)
+	suite.Require().NoError(err)
+
+	suite.Equal(int64(4), stats.Aging.Total)
+	suite.Equal(int64(3), stats.Aging.Over24h)
+	suite.Equal(int64(2), stats.Aging.Over3Days)
+	suite.Equal(int64(1), stats.Aging.Over7Days)
+}
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetReviewQueueStats_ApprovalsAndRejections() {
+	now := time.Now()
+	alice := suite.createUser("alice@test.com")
+	aliceName := "alice"
+	suite.db.Model(alice).Update("username", aliceName)
+	bob := suite.createUser("bob@test.com")
+
+	// Submitted 2h, 4h and 10h before approval: median is 4h.
+	s1 := suite.createShowWithTime("S1", catalogm.ShowStatusApproved, now.Add(-12*time.Hour))
+	s2 := suite.createShowWithTime("S2", catalogm.ShowStatusApproved, now.Add(-14*time.Hour))
+	s3 := suite.createShowWithTime("S3", catalogm.ShowStatusApproved, now.Add(-20*time.Hour))
+	suite.createAuditLogWithTime(alice.ID, "approve_show", "show", s1.ID, now.Add(-10*time.Hour))
+	suite.createAuditLogWithTime(alice.ID, "approve_show", "show", s2.ID, now.Add(-10*time.Hour))
+	suite.createAuditLogWithTime(bob.ID, "approve_show", "show", s3.ID, now.Add(-10*time.Hour))
+
+	// Rejections: two spam, one without a category.
+	r1 := suite.createShow("R1", catalogm.ShowStatusRejected)
+	r2 := suite.createShow("R2", catalogm.ShowStatusRejected)
+	r3 := suite.createShow("R3", catalogm.ShowStatusRejected)
+	suite.db.Exec("UPDATE shows SET rejection_category = 'spam' WHERE id IN ?", []uint{r1.ID, r2.ID})
+	suite.createAuditLog(bob.ID, "reject_show", "show", r1.ID)
+	suite.createAuditLog(bob.ID, "reject_show", "show", r2.ID)
+	suite.createAuditLog(bob.ID, "reject_show", "show", r3.ID)
+
+	// Outside the range; ignored.
+	old := suite.createShowWithTime("Old", catalogm.ShowStatusApproved, now.AddDate(0, 0, -60))
+	suite.createAuditLogWithTime(bob.ID, "approve_show", "show", old.ID, now.AddDate(0, 0, -45))
+
+	stats, err := suite.service.GetReviewQueueStats(now.AddDate(0, 0, -30), now.Add(time.Hour))
+	suite.Require().NoError(err)
+
+	suite.Equal(int64(3), stats.Approvals)
+	suite.Equal(int64(3), stats.Rejections)
+	suite.Require().NotNil(stats.MedianHoursToApproval)
+	suite.InDelta(4.0, *stats.MedianHoursToApproval, 0.01)
+
+	suite.Require().Len(stats.ApprovalsByAdmin, 2)
+	suite.Equal(alice.ID, stats.ApprovalsByAdmin[0].AdminID)
+	suite.Equal(aliceName, stats.ApprovalsByAdmin[0].AdminName)
+	suite.Equal(int64(2), stats.ApprovalsByAdmin[0].Approvals)
+	suite.Equal(bob.ID, stats.ApprovalsByAdmin[1].AdminID)
+	suite.Equal(int64(1), stats.ApprovalsByAdmin[1].Approvals)
+
+	suite.Equal([]contracts.RejectionReasonCount{
+		{Category: "spam", Count: 2},
+		{Category: uncategorizedRejection, Count: 1},
+	}, stats.RejectionReasons)
+}
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetModerationQueue_Empty() {
+	queue, err := suite.service.GetModerationQueue("", 50, 0)
+	suite.Require().NoError(err)
+
+	suite.Empty(queue.Items)
+	suite.Equal(int64(0), queue.Total)
+	suite.Len(queue.Counts, len(contracts.ModerationItemTypes))
+}
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetModerationQueue_MergesTypesOldestFirst() {
+	now := time.Now()
+	reporter := suite.createUser("reporter@test.com")
+	venue := suite.createVenue("Queue Venue", "Phoenix", "AZ", true)
+	artist := suite.createArtist("Queue Artist")
+	show := suite.createShowWithTime("Pending Show", catalogm.ShowStatusPending, now.Add(-5*time.Hour))
+	suite.createShowWithTime("Approved Show", catalogm.ShowStatusApproved, now.Add(-9*time.Hour))
+
+	changesJSON := json.RawMessage(`[{"field":"name","old_value":"Queue Venue","new_value":"New Name"}]`)
+	edit := &adminm.PendingEntityEdit{
+		EntityType:   adminm.PendingEditEntityVenue,
+		EntityID:     venue.ID,
+		SubmittedBy:  reporter.ID,
+		FieldChanges: &changesJSON,
+		Summary:      "rename",
+		Status:       adminm.PendingEditStatusPending,
+	}
+	suite.Require().NoError(suite.db.Create(edit).Error)
+	suite.db.Exec("UPDATE pending_entity_edits SET created_at = ? WHERE id = ?", now.Add(-8*time.Hour), edit.ID)
+
+	sqlDB, _ := suite.db.DB()
+	_, err := sqlDB.Exec(
+		"INSERT INTO show_reports (show_id, reported_by, report_type, status, created_at) VALUES ($1, $2, 'cancelled', 'pending', $3)",
+		show.ID, reporter.ID, now.Add(-1*time.Hour))
+	suite.Require().NoError(err)
+	_, err = sqlDB.Exec(
+		"INSERT INTO artist_reports (artist_id, reported_by, report_type, status, created_at) VALUES ($1, $2, 'inaccurate', 'pending', $3)",
+		artist.ID, reporter.ID, now.Add(-3*time.Hour))
+	suite.Require().NoError(err)
+	_, err = sqlDB.Exec(
+		"INSERT INTO venue_reports (venue_id, reported_by, report_type, status, created_at) VALUES ($1, $2, 'closed', 'pending', $3)",
+		venue.ID, reporter.ID, now.Add(-7*time.Hour))
+	suite.Require().NoError(err)
+	// Reviewed reports stay out of the queue.
+	other := suite.createUser("other@test.com")
+	_, err = sqlDB.Exec(
+		"INSERT INTO venue_reports (venue_id, reported_by, report_type, status) VALUES ($1, $2, 'inaccurate', 'dismissed')",
+		venue.ID, other.ID)
+	suite.Require().NoError(err)
+
+	queue, err := suite.service.GetModerationQueue("", 50, 0)
+	suite.Require().NoError(err)
+
+	suite.Equal(int64(5), queue.Total)
+	suite.Require().Len(queue.Items, 5)
+	types := make([]string, len(queue.Items))
+	for i, item := range queue.Items {
+		types[i] = item.Type
+	}
+	suite.Equal([]string{
+		contracts.ModerationItemVenueEdit,
+		contracts.ModerationItemVenueReport,
+		contracts.ModerationItemShow,
+		contracts.ModerationItemArtistReport,
+		contracts.ModerationItemShowReport,
+	}, types)
+
+	venueEdit := queue.Items[0]
+	suite.Equal(edit.ID, venueEdit.ID)
+	suite.Equal("venue", venueEdit.EntityType)
+	suite.Equal(venue.ID, venueEdit.EntityID)
+	suite.Equal("Queue Venue", venueEdit.Title)
+	suite.Require().NotNil(venueEdit.Detail)
+	suite.Equal("rename", *venueEdit.Detail)
+
+	venueReport := queue.Items[1]
+	suite.Require().NotNil(venueReport.Detail)
+	suite.Equal("closed", *venueReport.Detail)
+	suite.Require().NotNil(venueReport.SubmittedBy)
+	suite.Equal(reporter.ID, *venueReport.SubmittedBy)
+
+	suite.Nil(queue.Items[2].Detail)
+	suite.Equal(show.ID, queue.Items[2].EntityID)
+
+	for _, t := range contracts.ModerationItemTypes {
+		suite.Equal(int64(1), queue.Counts[t], t)
+	}
+}
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetModerationQueue_TypeFilterAndPagination() {
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		suite.createShowWithTime(fmt.Sprintf("Show %d", i), catalogm.ShowStatusPending, now.Add(-time.Duration(10-i)*time.Hour))
+	}
+	reporter := suite.createUser("reporter@test.com")
+	artist := suite.createArtist("Filtered Out")
+	sqlDB, _ := suite.db.DB()
+	_, err := sqlDB.Exec(
+		"INSERT INTO artist_reports (artist_id, reported_by, report_type, status) VALUES ($1, $2, 'inaccurate', 'pending')",
+		artist.ID, reporter.ID)
+	suite.Require().NoError(err)
+
+	queue, err := suite.service.GetModerationQueue(contracts.ModerationItemShow, 2, 1)
+	suite.Require().NoError(err)
+
+	suite.Equal(int64(3), queue.Total)
+	suite.Equal(int64(1), queue.Counts[contracts.ModerationItemArtistReport])
+	suite.Require().Len(queue.Items, 2)
+	suite.Equal("Show 1", queue.Items[0].Title)
+	suite.Equal("Show 2", queue.Items[1].Title)
+}
+
+// =============================================================================
+// GetStatsTimeSeries
+// =============================================================================
+
+func TestStatsBucketStart(t *testing.T) {
+	// A Thursday afternoon.
+	at := time.Date(2026, 5, 14, 15, 30, 0, 0, time.UTC)
+	if got, want := statsBucketStart(contracts.StatsIntervalDay, at), time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("day = %v, want %v", got, want)
+	}
+	if got, want := statsBucketStart(contracts.StatsIntervalWeek, at), time.Date(2026, 5, 11, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("week = %v, want %v (Monday)", got, want)
+	}
+	if got, want := statsBucketStart(contracts.StatsIntervalMonth, at), time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("month = %v, want %v", got, want)
+	}
+}
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetStatsTimeSeries_BucketsAndZeroFills() {
+	today := statsBucketStart(contracts.StatsIntervalDay, time.Now())
+	suite.createUserWithTime("ts-a@test.com", today.Add(-2*24*time.Hour+time.Hour))
+	suite.createUserWithTime("ts-b@test.com", today.Add(-2*24*time.Hour+2*time.Hour))
+	suite.createUserWithTime("ts-c@test.com", today)
+	suite.createUserWithTime("ts-old@test.com", today.Add(-30*24*time.Hour))
+
+	service := &AdminStatsService{db: suite.db}
+	series, err := service.GetStatsTimeSeries(contracts.StatsMetricSignups, contracts.StatsIntervalDay, today.Add(-2*24*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Len(series.Points, 3, "two days ago, yesterday and today")
+	suite.Equal(today.Add(-2*24*time.Hour), series.Points[0].Bucket)
+	suite.Equal([]int64{2, 0, 1}, []int64{series.Points[0].Count, series.Points[1].Count, series.Points[2].Count})
+	suite.Equal(int64(3), series.Total)
+
+	// Served from cache: a new signup doesn't show until the entry expires.
+	suite.createUser("ts-d@test.com")
+	cached, err := service.GetStatsTimeSeries(contracts.StatsMetricSignups, contracts.StatsIntervalDay, today.Add(-2*24*time.Hour))
+	suite.Require().NoError(err)
+	suite.Equal(int64(3), cached.Total)
+}
+
+func (suite *AdminStatsServiceIntegrationTestSuite) TestGetStatsTimeSeries_ApprovalsAndReports() {
+	admin := suite.createUser("ts-admin@test.com")
+	show := suite.createShow("Timeseries Show", catalogm.ShowStatusApproved)
+	suite.createAuditLog(admin.ID, "approve_show", "show", show.ID)
+	suite.createAuditLog(admin.ID, "auto_approve_show", "show", show.ID)
+	suite.createAuditLog(admin.ID, "reject_show", "show", show.ID)
+
+	service := &AdminStatsService{db: suite.db}
+	from := time.Now().AddDate(0, 0, -7)
+	approvals, err := service.GetStatsTimeSeries(contracts.StatsMetricShowsApproved, contracts.StatsIntervalWeek, from)
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), approvals.Total)
+
+	reports, err := service.GetStatsTimeSeries(contracts.StatsMetricReports, contracts.StatsIntervalMonth, from)
+	suite.Require().NoError(err)
+	suite.Zero(reports.Total)
+	suite.NotEmpty(reports.Points)
+
+	_, err = service.GetStatsTimeSeries("page_views", contracts.StatsIntervalDay, from)
+	suite.Error(err)
+}
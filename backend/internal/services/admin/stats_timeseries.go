@@ -0,0 +1,154 @@
+package admin
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// statsTimeSeriesTTL is how long a time series is served from cache. The
+// dashboard charts tolerate a few minutes' lag; the aggregation scans whole
+// tables.
+const statsTimeSeriesTTL = 5 * time.Minute
+
+// statsMetricSources maps each time-series metric to a query for the
+// timestamps it counts, one row per event, as column "at". Approvals include
+// trusted submitters' auto-approvals; reports span shows, artists and venues.
+var statsMetricSources = map[string]string{
+	contracts.StatsMetricShowsSubmitted: `SELECT created_at AS at FROM shows WHERE deleted_at IS NULL`,
+	contracts.StatsMetricShowsApproved: `SELECT created_at AS at FROM audit_logs
+		WHERE entity_type = 'show' AND action IN ('approve_show', 'auto_approve_show')`,
+	contracts.StatsMetricSignups: `SELECT created_at AS at FROM users`,
+	contracts.StatsMetricReports: `SELECT created_at AS at FROM show_reports
+		UNION ALL SELECT created_at FROM artist_reports
+		UNION ALL SELECT created_at FROM venue_reports`,
+}
+
+// statsIntervalSteps maps each interval to its Postgres interval literal.
+var statsIntervalSteps = map[string]string{
+	contracts.StatsIntervalDay:   "1 day",
+	contracts.StatsIntervalWeek:  "1 week",
+	contracts.StatsIntervalMonth: "1 month",
+}
+
+// timeSeriesCacheEntry is one cached series.
+type timeSeriesCacheEntry struct {
+	series    *contracts.StatsTimeSeries
+	expiresAt time.Time
+}
+
+// timeSeriesCache holds series by metric, interval and first bucket. The
+// first bucket only moves once per interval, so repeated dashboard loads
+// hit the same key; the key space is bounded by the metrics and intervals
+// times the ranges the handler accepts.
+type timeSeriesCache struct {
+	mu      sync.Mutex
+	entries map[string]timeSeriesCacheEntry
+}
+
+func (c *timeSeriesCache) get(key string, now time.Time) (*contracts.StatsTimeSeries, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.series, true
+}
+
+func (c *timeSeriesCache) put(key string, series *contracts.StatsTimeSeries, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]timeSeriesCacheEntry)
+	}
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = timeSeriesCacheEntry{series: series, expiresAt: now.Add(statsTimeSeriesTTL)}
+}
+
+// statsBucketStart returns the start of the UTC bucket containing t.
+func statsBucketStart(interval string, t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case contracts.StatsIntervalWeek:
+		// Monday-based, like Postgres date_trunc('week', ...).
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case contracts.StatsIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// GetStatsTimeSeries returns the metric's counts per interval bucket, from
+// the bucket containing from up to now. Buckets are computed in SQL with
+// date_trunc against a generate_series of every bucket, so empty buckets
+// come back as zero. Series are cached for statsTimeSeriesTTL; callers must
+// treat the result as read-only.
+func (s *AdminStatsService) GetStatsTimeSeries(metric, interval string, from time.Time) (*contracts.StatsTimeSeries, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	source, ok := statsMetricSources[metric]
+	if !ok {
+		return nil, fmt.Errorf("unknown metric %q", metric)
+	}
+	step, ok := statsIntervalSteps[interval]
+	if !ok {
+		return nil, fmt.Errorf("unknown interval %q", interval)
+	}
+
+	now := time.Now().UTC()
+	start := statsBucketStart(interval, from)
+	key := metric + "|" + interval + "|" + start.Format(time.RFC3339)
+	if series, ok := s.timeSeries.get(key, now); ok {
+		return series, nil
+	}
+
+	var rows []struct {
+		Bucket time.Time
+		Count  int64
+	}
+	// Bounds are passed as timestamptz and converted to UTC wall time
+	// explicitly, so the session time zone can't shift the buckets.
+	if err := s.db.Raw(`
+		SELECT b.bucket, COUNT(e.bucket) AS count
+		FROM generate_series(
+			date_trunc(?, ?::timestamptz AT TIME ZONE 'UTC'),
+			date_trunc(?, ?::timestamptz AT TIME ZONE 'UTC'),
+			?::interval
+		) AS b(bucket)
+		LEFT JOIN (
+			SELECT date_trunc(?, src.at AT TIME ZONE 'UTC') AS bucket
+			FROM (`+source+`) src
+			WHERE src.at >= ? AND src.at <= ?
+		) e ON e.bucket = b.bucket
+		GROUP BY b.bucket
+		ORDER BY b.bucket`,
+		interval, start, interval, now, step,
+		interval, start, now,
+	).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to compute %s time series: %w", metric, err)
+	}
+
+	series := &contracts.StatsTimeSeries{
+		Metric:   metric,
+		Interval: interval,
+		From:     start,
+		To:       now,
+		Points:   make([]contracts.StatsTimeSeriesPoint, len(rows)),
+	}
+	for i, r := range rows {
+		series.Points[i] = contracts.StatsTimeSeriesPoint{Bucket: r.Bucket.UTC(), Count: r.Count}
+		series.Total += r.Count
+	}
+	s.timeSeries.put(key, series, now)
+	return series, nil
+}
@@ -0,0 +1,271 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// VenueReportService handles venue report business logic
+type VenueReportService struct {
+	db     *gorm.DB
+	events contracts.AdminEventPublisher
+}
+
+// NewVenueReportService creates a new venue report service
+func NewVenueReportService(database *gorm.DB) *VenueReportService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &VenueReportService{
+		db: database,
+	}
+}
+
+// SetEventPublisher wires the admin event bus. Optional — when nil, new
+// reports are not announced to open admin event streams.
+func (s *VenueReportService) SetEventPublisher(p contracts.AdminEventPublisher) {
+	s.events = p
+}
+
+// CreateReport creates a new venue report
+func (s *VenueReportService) CreateReport(userID, venueID uint, reportType string, details *string) (*contracts.VenueReportResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	// Validate report type
+	if reportType != string(communitym.VenueReportTypeInaccurate) &&
+		reportType != string(communitym.VenueReportTypeClosed) &&
+		reportType != string(communitym.VenueReportTypeRemovalRequest) {
+		return nil, fmt.Errorf("invalid report type: %s", reportType)
+	}
+
+	// Verify venue exists
+	var venue catalogm.Venue
+	if err := s.db.First(&venue, venueID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("venue not found")
+		}
+		return nil, fmt.Errorf("failed to verify venue: %w", err)
+	}
+
+	// Check for existing report from this user for this venue
+	var existingCount int64
+	if err := s.db.Model(&communitym.VenueReport{}).
+		Where("venue_id = ? AND reported_by = ?", venueID, userID).
+		Count(&existingCount).Error; err != nil {
+		return nil, fmt.Errorf("failed to check existing report: %w", err)
+	}
+
+	if existingCount > 0 {
+		return nil, fmt.Errorf("you have already reported this venue")
+	}
+
+	// Create the report
+	report := communitym.VenueReport{
+		VenueID:    venueID,
+		ReportedBy: userID,
+		ReportType: communitym.VenueReportType(reportType),
+		Details:    details,
+		Status:     communitym.ShowReportStatusPending,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+	}
+
+	if err := s.db.Create(&report).Error; err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	if s.events != nil {
+		s.events.Publish(contracts.AdminEvent{
+			Type:       contracts.AdminEventReportCreated,
+			ID:         report.ID,
+			EntityType: "venue",
+			EntityID:   venueID,
+			Summary:    reportType,
+		})
+	}
+
+	return s.buildReportResponse(&report, &venue), nil
+}
+
+// GetUserReportForVenue returns the user's existing report for a venue, if any
+func (s *VenueReportService) GetUserReportForVenue(userID, venueID uint) (*contracts.VenueReportResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var report communitym.VenueReport
+	err := s.db.Where("venue_id = ? AND reported_by = ?", venueID, userID).
+		First(&report).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // No report found
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return s.buildReportResponse(&report, nil), nil
+}
+
+// GetPendingReports returns pending reports for admin review
+func (s *VenueReportService) GetPendingReports(limit, offset int) ([]*contracts.VenueReportResponse, int64, error) {
+	if s.db == nil {
+		return nil, 0, fmt.Errorf("database not initialized")
+	}
+
+	// Get total count
+	var total int64
+	if err := s.db.Model(&communitym.VenueReport{}).
+		Where("status = ?", communitym.ShowReportStatusPending).
+		Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count pending reports: %w", err)
+	}
+
+	// Get reports with venue info
+	var reports []communitym.VenueReport
+	err := s.db.Preload("Venue").
+		Where("status = ?", communitym.ShowReportStatusPending).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&reports).Error
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get pending reports: %w", err)
+	}
+
+	// Build responses
+	responses := make([]*contracts.VenueReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = s.buildReportResponse(&report, &report.Venue)
+	}
+
+	return responses, total, nil
+}
+
+// DismissReport marks a report as dismissed (spam/invalid)
+func (s *VenueReportService) DismissReport(reportID, adminID uint, notes *string) (*contracts.VenueReportResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var report communitym.VenueReport
+	if err := s.db.Preload("Venue").First(&report, reportID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("report not found")
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if report.Status != communitym.ShowReportStatusPending {
+		return nil, fmt.Errorf("report has already been reviewed")
+	}
+
+	now := time.Now().UTC()
+	report.Status = communitym.ShowReportStatusDismissed
+	report.ReviewedBy = &adminID
+	report.ReviewedAt = &now
+	report.AdminNotes = notes
+	report.UpdatedAt = now
+
+	if err := s.db.Save(&report).Error; err != nil {
+		return nil, fmt.Errorf("failed to dismiss report: %w", err)
+	}
+
+	return s.buildReportResponse(&report, &report.Venue), nil
+}
+
+// ResolveReport marks a report as resolved (action was taken)
+func (s *VenueReportService) ResolveReport(reportID, adminID uint, notes *string) (*contracts.VenueReportResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var report communitym.VenueReport
+	if err := s.db.Preload("Venue").First(&report, reportID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("report not found")
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if report.Status != communitym.ShowReportStatusPending {
+		return nil, fmt.Errorf("report has already been reviewed")
+	}
+
+	now := time.Now().UTC()
+	report.Status = communitym.ShowReportStatusResolved
+	report.ReviewedBy = &adminID
+	report.ReviewedAt = &now
+	report.AdminNotes = notes
+	report.UpdatedAt = now
+
+	if err := s.db.Save(&report).Error; err != nil {
+		return nil, fmt.Errorf("failed to resolve report: %w", err)
+	}
+
+	return s.buildReportResponse(&report, &report.Venue), nil
+}
+
+// GetReportByID returns a report by ID (used for Discord notifications)
+func (s *VenueReportService) GetReportByID(reportID uint) (*communitym.VenueReport, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var report communitym.VenueReport
+	if err := s.db.Preload("Venue").First(&report, reportID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("report not found")
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return &report, nil
+}
+
+// buildReportResponse builds an contracts.VenueReportResponse from a model
+func (s *VenueReportService) buildReportResponse(report *communitym.VenueReport, venue *catalogm.Venue) *contracts.VenueReportResponse {
+	resp := &contracts.VenueReportResponse{
+		ID:         report.ID,
+		VenueID:    report.VenueID,
+		ReportType: string(report.ReportType),
+		Details:    report.Details,
+		Status:     string(report.Status),
+		AdminNotes: report.AdminNotes,
+		ReviewedBy: report.ReviewedBy,
+		CreatedAt:  report.CreatedAt,
+		UpdatedAt:  report.UpdatedAt,
+	}
+
+	if report.ReviewedAt != nil {
+		reviewedAtStr := report.ReviewedAt.Format(time.RFC3339)
+		resp.ReviewedAt = &reviewedAtStr
+	}
+
+	if venue != nil {
+		slug := ""
+		if venue.Slug != nil {
+			slug = *venue.Slug
+		}
+		resp.Venue = &contracts.VenueReportVenueInfo{
+			ID:    venue.ID,
+			Name:  venue.Name,
+			Slug:  slug,
+			City:  venue.City,
+			State: venue.State,
+		}
+	}
+
+	return resp
+}
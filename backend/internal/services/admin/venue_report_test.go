@@ -0,0 +1,526 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type VenueReportServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB        *testutil.TestDatabase
+	db            *gorm.DB
+	reportService *VenueReportService
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+
+	suite.reportService = &VenueReportService{db: suite.testDB.DB}
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM venue_reports")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestVenueReportServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(VenueReportServiceIntegrationTestSuite))
+}
+
+// =============================================================================
+// HELPERS
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) createTestUser() *authm.User {
+	user := &authm.User{
+		Email:         stringPtr(fmt.Sprintf("user-%d@test.com", time.Now().UnixNano())),
+		FirstName:     stringPtr("Test"),
+		LastName:      stringPtr("User"),
+		IsActive:      true,
+		EmailVerified: true,
+	}
+	err := suite.db.Create(user).Error
+	suite.Require().NoError(err)
+	return user
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) createTestVenue(name string) *catalogm.Venue {
+	slug := fmt.Sprintf("%s-%d", name, time.Now().UnixNano())
+	venue := &catalogm.Venue{
+		Name:  name,
+		Slug:  &slug,
+		City:  "Phoenix",
+		State: "AZ",
+	}
+	err := suite.db.Create(venue).Error
+	suite.Require().NoError(err)
+	return venue
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) createPendingReport(userID, venueID uint, reportType string) *communitym.VenueReport {
+	report := &communitym.VenueReport{
+		VenueID:    venueID,
+		ReportedBy: userID,
+		ReportType: communitym.VenueReportType(reportType),
+		Status:     communitym.ShowReportStatusPending,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+	}
+	err := suite.db.Create(report).Error
+	suite.Require().NoError(err)
+	return report
+}
+
+// =============================================================================
+// Group 1: CreateReport
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestCreateReport_Success() {
+	venue := suite.createTestVenue("Reported Venue")
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.CreateReport(user.ID, venue.ID, "inaccurate", stringPtr("Wrong genre listed"))
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp)
+	suite.NotZero(resp.ID)
+	suite.Equal(venue.ID, resp.VenueID)
+	suite.Equal("inaccurate", resp.ReportType)
+	suite.Equal("pending", resp.Status)
+	suite.Equal("Wrong genre listed", *resp.Details)
+	suite.Require().NotNil(resp.Venue)
+	suite.Equal(venue.Name, resp.Venue.Name)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestCreateReport_AllReportTypes() {
+	for _, reportType := range []string{"inaccurate", "closed", "removal_request"} {
+		venue := suite.createTestVenue(fmt.Sprintf("Venue for %s", reportType))
+		user := suite.createTestUser()
+
+		resp, err := suite.reportService.CreateReport(user.ID, venue.ID, reportType, nil)
+
+		suite.Require().NoError(err, "report type %s should succeed", reportType)
+		suite.Equal(reportType, resp.ReportType)
+	}
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestCreateReport_InvalidType_Fails() {
+	venue := suite.createTestVenue("Invalid Type Venue")
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.CreateReport(user.ID, venue.ID, "bogus_type", nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "invalid report type")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestCreateReport_VenueNotFound() {
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.CreateReport(user.ID, 99999, "inaccurate", nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "venue not found")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestCreateReport_DuplicateReport_Fails() {
+	venue := suite.createTestVenue("Dup Report Venue")
+	user := suite.createTestUser()
+
+	_, err := suite.reportService.CreateReport(user.ID, venue.ID, "inaccurate", nil)
+	suite.Require().NoError(err)
+
+	// Same user, same venue — should fail
+	resp, err := suite.reportService.CreateReport(user.ID, venue.ID, "removal_request", nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "already reported")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestCreateReport_DifferentUsers_OK() {
+	venue := suite.createTestVenue("Multi Report Venue")
+	user1 := suite.createTestUser()
+	user2 := suite.createTestUser()
+
+	_, err := suite.reportService.CreateReport(user1.ID, venue.ID, "inaccurate", nil)
+	suite.Require().NoError(err)
+
+	resp, err := suite.reportService.CreateReport(user2.ID, venue.ID, "inaccurate", nil)
+
+	suite.Require().NoError(err)
+	suite.NotNil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestCreateReport_WithoutDetails() {
+	venue := suite.createTestVenue("No Details Venue")
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.CreateReport(user.ID, venue.ID, "inaccurate", nil)
+
+	suite.Require().NoError(err)
+	suite.Nil(resp.Details)
+}
+
+// =============================================================================
+// Group 2: GetUserReportForVenue
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetUserReportForVenue_Found() {
+	venue := suite.createTestVenue("User Report Venue")
+	user := suite.createTestUser()
+
+	created, err := suite.reportService.CreateReport(user.ID, venue.ID, "inaccurate", nil)
+	suite.Require().NoError(err)
+
+	resp, err := suite.reportService.GetUserReportForVenue(user.ID, venue.ID)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp)
+	suite.Equal(created.ID, resp.ID)
+	suite.Equal("inaccurate", resp.ReportType)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetUserReportForVenue_NotFound() {
+	venue := suite.createTestVenue("No Report Venue")
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.GetUserReportForVenue(user.ID, venue.ID)
+
+	suite.Require().NoError(err)
+	suite.Nil(resp) // Returns nil, nil — not an error
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetUserReportForVenue_DifferentUser_ReturnsNil() {
+	venue := suite.createTestVenue("Other User Venue")
+	user1 := suite.createTestUser()
+	user2 := suite.createTestUser()
+
+	_, err := suite.reportService.CreateReport(user1.ID, venue.ID, "inaccurate", nil)
+	suite.Require().NoError(err)
+
+	// user2 has no report for this venue
+	resp, err := suite.reportService.GetUserReportForVenue(user2.ID, venue.ID)
+
+	suite.Require().NoError(err)
+	suite.Nil(resp)
+}
+
+// =============================================================================
+// Group 3: GetPendingReports
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetPendingReports_Success() {
+	venue1 := suite.createTestVenue("Pending Venue 1")
+	venue2 := suite.createTestVenue("Pending Venue 2")
+	user1 := suite.createTestUser()
+	user2 := suite.createTestUser()
+
+	_, err := suite.reportService.CreateReport(user1.ID, venue1.ID, "inaccurate", nil)
+	suite.Require().NoError(err)
+	_, err = suite.reportService.CreateReport(user2.ID, venue2.ID, "removal_request", nil)
+	suite.Require().NoError(err)
+
+	resp, total, err := suite.reportService.GetPendingReports(10, 0)
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), total)
+	suite.Len(resp, 2)
+	// Should include venue info
+	suite.NotNil(resp[0].Venue)
+	suite.NotNil(resp[1].Venue)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetPendingReports_ExcludesReviewed() {
+	venue := suite.createTestVenue("Reviewed Report Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+
+	// Dismiss the report
+	_, err := suite.reportService.DismissReport(report.ID, admin.ID, nil)
+	suite.Require().NoError(err)
+
+	// Create another pending one
+	venue2 := suite.createTestVenue("Still Pending Venue")
+	user2 := suite.createTestUser()
+	_, err = suite.reportService.CreateReport(user2.ID, venue2.ID, "removal_request", nil)
+	suite.Require().NoError(err)
+
+	resp, total, err := suite.reportService.GetPendingReports(10, 0)
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Len(resp, 1)
+	suite.Equal("removal_request", resp[0].ReportType)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetPendingReports_Pagination() {
+	// Create 5 pending reports
+	for i := 0; i < 5; i++ {
+		venue := suite.createTestVenue(fmt.Sprintf("Paginated Venue %d", i))
+		user := suite.createTestUser()
+		_, err := suite.reportService.CreateReport(user.ID, venue.ID, "inaccurate", nil)
+		suite.Require().NoError(err)
+	}
+
+	// Page 1
+	resp1, total, err := suite.reportService.GetPendingReports(2, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(5), total)
+	suite.Len(resp1, 2)
+
+	// Page 2
+	resp2, _, err := suite.reportService.GetPendingReports(2, 2)
+	suite.Require().NoError(err)
+	suite.Len(resp2, 2)
+
+	// Page 3
+	resp3, _, err := suite.reportService.GetPendingReports(2, 4)
+	suite.Require().NoError(err)
+	suite.Len(resp3, 1)
+
+	// No overlap
+	suite.NotEqual(resp1[0].ID, resp2[0].ID)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetPendingReports_Empty() {
+	resp, total, err := suite.reportService.GetPendingReports(10, 0)
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(0), total)
+	suite.Empty(resp)
+}
+
+// =============================================================================
+// Group 4: DismissReport
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestDismissReport_Success() {
+	venue := suite.createTestVenue("Dismiss Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+
+	resp, err := suite.reportService.DismissReport(report.ID, admin.ID, stringPtr("Not a real issue"))
+
+	suite.Require().NoError(err)
+	suite.Equal("dismissed", resp.Status)
+	suite.Equal("Not a real issue", *resp.AdminNotes)
+	suite.Require().NotNil(resp.ReviewedBy)
+	suite.Equal(admin.ID, *resp.ReviewedBy)
+	suite.NotNil(resp.ReviewedAt)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestDismissReport_NotFound() {
+	resp, err := suite.reportService.DismissReport(99999, 1, nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "report not found")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestDismissReport_AlreadyReviewed_Fails() {
+	venue := suite.createTestVenue("Already Dismissed Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+	_, err := suite.reportService.DismissReport(report.ID, admin.ID, nil)
+	suite.Require().NoError(err)
+
+	// Try to dismiss again
+	resp, err := suite.reportService.DismissReport(report.ID, admin.ID, nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "already been reviewed")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestDismissReport_WithoutNotes() {
+	venue := suite.createTestVenue("No Notes Dismiss Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+
+	resp, err := suite.reportService.DismissReport(report.ID, admin.ID, nil)
+
+	suite.Require().NoError(err)
+	suite.Equal("dismissed", resp.Status)
+	suite.Nil(resp.AdminNotes)
+}
+
+// =============================================================================
+// Group 5: ResolveReport
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestResolveReport_Success() {
+	venue := suite.createTestVenue("Resolve Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+
+	resp, err := suite.reportService.ResolveReport(report.ID, admin.ID, stringPtr("Fixed the info"))
+
+	suite.Require().NoError(err)
+	suite.Equal("resolved", resp.Status)
+	suite.Equal("Fixed the info", *resp.AdminNotes)
+	suite.Require().NotNil(resp.ReviewedBy)
+	suite.Equal(admin.ID, *resp.ReviewedBy)
+	suite.NotNil(resp.ReviewedAt)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestResolveReport_NotFound() {
+	resp, err := suite.reportService.ResolveReport(99999, 1, nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "report not found")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestResolveReport_AlreadyReviewed_Fails() {
+	venue := suite.createTestVenue("Already Resolved Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+	_, err := suite.reportService.ResolveReport(report.ID, admin.ID, nil)
+	suite.Require().NoError(err)
+
+	resp, err := suite.reportService.ResolveReport(report.ID, admin.ID, nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "already been reviewed")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestResolveReport_CannotResolveAfterDismiss() {
+	venue := suite.createTestVenue("Dismiss Then Resolve Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+	_, err := suite.reportService.DismissReport(report.ID, admin.ID, nil)
+	suite.Require().NoError(err)
+
+	resp, err := suite.reportService.ResolveReport(report.ID, admin.ID, nil)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "already been reviewed")
+	suite.Nil(resp)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestResolveReport_WithoutNotes() {
+	venue := suite.createTestVenue("No Notes Resolve Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "removal_request")
+
+	resp, err := suite.reportService.ResolveReport(report.ID, admin.ID, nil)
+
+	suite.Require().NoError(err)
+	suite.Equal("resolved", resp.Status)
+	suite.Nil(resp.AdminNotes)
+}
+
+// =============================================================================
+// Group 6: GetReportByID
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetReportByID_Success() {
+	venue := suite.createTestVenue("Get By ID Venue")
+	user := suite.createTestUser()
+
+	created := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+
+	report, err := suite.reportService.GetReportByID(created.ID)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(report)
+	suite.Equal(created.ID, report.ID)
+	suite.Equal(venue.ID, report.VenueID)
+	suite.Equal(communitym.VenueReportTypeInaccurate, report.ReportType)
+	// Venue should be preloaded
+	suite.Equal(venue.Name, report.Venue.Name)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestGetReportByID_NotFound() {
+	report, err := suite.reportService.GetReportByID(99999)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "report not found")
+	suite.Nil(report)
+}
+
+// =============================================================================
+// Group 7: buildReportResponse behavior
+// =============================================================================
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestBuildReportResponse_IncludesVenueInfo() {
+	venue := suite.createTestVenue("Response Venue")
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.CreateReport(user.ID, venue.ID, "inaccurate", nil)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.Venue)
+	suite.Equal(venue.ID, resp.Venue.ID)
+	suite.Equal("Response Venue", resp.Venue.Name)
+	suite.NotEmpty(resp.Venue.Slug)
+	suite.Equal("Phoenix", resp.Venue.City)
+	suite.Equal("AZ", resp.Venue.State)
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestBuildReportResponse_ReviewedAtFormatted() {
+	venue := suite.createTestVenue("Reviewed At Venue")
+	user := suite.createTestUser()
+	admin := suite.createTestUser()
+
+	report := suite.createPendingReport(user.ID, venue.ID, "inaccurate")
+	resp, err := suite.reportService.DismissReport(report.ID, admin.ID, nil)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.ReviewedAt)
+	// Should be RFC3339 formatted
+	_, parseErr := time.Parse(time.RFC3339, *resp.ReviewedAt)
+	suite.NoError(parseErr, "ReviewedAt should be RFC3339 formatted")
+}
+
+func (suite *VenueReportServiceIntegrationTestSuite) TestBuildReportResponse_PendingHasNoReviewedAt() {
+	venue := suite.createTestVenue("Pending At Venue")
+	user := suite.createTestUser()
+
+	resp, err := suite.reportService.CreateReport(user.ID, venue.ID, "inaccurate", nil)
+
+	suite.Require().NoError(err)
+	suite.Nil(resp.ReviewedAt)
+	suite.Nil(resp.ReviewedBy)
+}
@@ -0,0 +1,309 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Viewer roles reported by the visibility debugger.
+const (
+	viewerRoleAnonymous = "anonymous"
+	viewerRoleUser      = "user"
+	viewerRoleSubmitter = "submitter"
+	viewerRoleAdmin     = "admin"
+)
+
+// ageRequirementPattern extracts the minimum age from free-text requirements
+// such as "21+", "18 and over" or "16+ w/ guardian".
+var ageRequirementPattern = regexp.MustCompile(`(\d{1,2})\s*(\+|and over|and up)`)
+
+// VisibilityDebugService explains why a show is (or is not) visible to a
+// given viewer. It is read-only and mirrors the checks in ShowHandler.GetShow
+// and ShowService.GetUpcomingShows — when those change, update the rules here.
+type VisibilityDebugService struct {
+	db *gorm.DB
+}
+
+// NewVisibilityDebugService creates a new visibility debug service.
+func NewVisibilityDebugService(database *gorm.DB) *VisibilityDebugService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &VisibilityDebugService{db: database}
+}
+
+// ExplainShowVisibility evaluates every visibility rule for showID as seen by
+// userID. A nil userID evaluates the anonymous view.
+func (s *VisibilityDebugService) ExplainShowVisibility(userID *uint, showID uint) (*contracts.VisibilityExplanation, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrVisibilityInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var show catalogm.Show
+	if err := s.db.First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrVisibilityShowNotFound(showID)
+		}
+		return nil, apperrors.ErrVisibilityInternal(err)
+	}
+
+	var user *authm.User
+	if userID != nil {
+		var u authm.User
+		if err := s.db.Preload("Preferences").First(&u, *userID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, apperrors.ErrVisibilityUserNotFound(*userID)
+			}
+			return nil, apperrors.ErrVisibilityInternal(err)
+		}
+		user = &u
+	}
+
+	return explainShowVisibility(&show, user, time.Now()), nil
+}
+
+// explainShowVisibility is the pure rule pipeline behind ExplainShowVisibility.
+// A nil user is the anonymous viewer.
+func explainShowVisibility(show *catalogm.Show, user *authm.User, now time.Time) *contracts.VisibilityExplanation {
+	exp := &contracts.VisibilityExplanation{
+		ShowID:     show.ID,
+		ShowStatus: string(show.Status),
+	}
+	if user != nil {
+		id := user.ID
+		exp.UserID = &id
+	}
+
+	// Account: an inactive or deleted account fails JWT validation, so the
+	// public read paths treat the request as logged out.
+	viewer := user
+	var steps []contracts.VisibilityStep
+	switch {
+	case user == nil:
+		steps = append(steps, visibilityStep(contracts.VisibilityRuleAccount, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"No user supplied; evaluating the logged-out view"))
+	case !user.IsActive || user.DeletedAt != nil:
+		viewer = nil
+		steps = append(steps, visibilityStep(contracts.VisibilityRuleAccount, contracts.VisibilityOutcomeWarn, contracts.VisibilitySurfaceDetail,
+			"Account is inactive or deleted; its session is rejected, so the user sees the logged-out view"))
+	default:
+		steps = append(steps, visibilityStep(contracts.VisibilityRuleAccount, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"Account is active"))
+	}
+
+	isAdmin := viewer != nil && viewer.IsAdmin
+	isSubmitter := viewer != nil && show.SubmittedBy != nil && *show.SubmittedBy == viewer.ID
+	switch {
+	case isAdmin:
+		exp.ViewerRole = viewerRoleAdmin
+	case isSubmitter:
+		exp.ViewerRole = viewerRoleSubmitter
+	case viewer != nil:
+		exp.ViewerRole = viewerRoleUser
+	default:
+		exp.ViewerRole = viewerRoleAnonymous
+	}
+
+	steps = append(steps,
+		deletedStep(show),
+		statusStep(show, isAdmin, isSubmitter),
+		privacyStep(show, isAdmin, isSubmitter),
+		upcomingWindowStep(show, viewer, now),
+		marketStep(show, viewer),
+		ageStep(show, viewer),
+		duplicateStep(show),
+		cancelledStep(show),
+	)
+	exp.Steps = steps
+
+	exp.VisibleOnDetail = true
+	exp.VisibleInUpcoming = true
+	for _, step := range steps {
+		if step.Outcome != contracts.VisibilityOutcomeHide {
+			continue
+		}
+		if exp.HiddenBy == nil {
+			rule := step.Rule
+			exp.HiddenBy = &rule
+		}
+		// A detail-scoped hide also hides the list.
+		exp.VisibleInUpcoming = false
+		if step.Surface == contracts.VisibilitySurfaceDetail {
+			exp.VisibleOnDetail = false
+		}
+	}
+
+	return exp
+}
+
+func visibilityStep(rule, outcome, surface, explanation string) contracts.VisibilityStep {
+	return contracts.VisibilityStep{
+		Rule:        rule,
+		Outcome:     outcome,
+		Surface:     surface,
+		Explanation: explanation,
+	}
+}
+
+// deletedStep: a soft-deleted show is in the trash; every read path filters
+// it out, whoever is asking.
+func deletedStep(show *catalogm.Show) contracts.VisibilityStep {
+	if show.DeletedAt == nil {
+		return visibilityStep(contracts.VisibilityRuleDeleted, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"Show is not deleted")
+	}
+	return visibilityStep(contracts.VisibilityRuleDeleted, contracts.VisibilityOutcomeHide, contracts.VisibilitySurfaceDetail,
+		fmt.Sprintf("Show was deleted at %s; it is hidden everywhere until an admin restores it",
+			show.DeletedAt.UTC().Format(time.RFC3339)))
+}
+
+// statusStep: pending/rejected shows open on detail only for admins and the
+// submitter; the upcoming feed includes them for admins only.
+func statusStep(show *catalogm.Show, isAdmin, isSubmitter bool) contracts.VisibilityStep {
+	switch {
+	case show.Status == catalogm.ShowStatusApproved:
+		return visibilityStep(contracts.VisibilityRuleStatus, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"Show is approved")
+	case show.Status == catalogm.ShowStatusPrivate:
+		return visibilityStep(contracts.VisibilityRuleStatus, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"Show is private; see the privacy rule")
+	case isAdmin:
+		return visibilityStep(contracts.VisibilityRuleStatus, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceList,
+			fmt.Sprintf("Show is %s; admins see non-approved shows on detail and in the upcoming feed", show.Status))
+	case isSubmitter:
+		return visibilityStep(contracts.VisibilityRuleStatus, contracts.VisibilityOutcomeHide, contracts.VisibilitySurfaceList,
+			fmt.Sprintf("Show is %s; the submitter can open it, but the upcoming feed lists approved shows only", show.Status))
+	default:
+		return visibilityStep(contracts.VisibilityRuleStatus, contracts.VisibilityOutcomeHide, contracts.VisibilitySurfaceDetail,
+			fmt.Sprintf("Show is %s; only admins and the submitter can see it", show.Status))
+	}
+}
+
+// privacyStep: private shows are personal to the submitter and never listed,
+// not even in the admin view of the upcoming feed.
+func privacyStep(show *catalogm.Show, isAdmin, isSubmitter bool) contracts.VisibilityStep {
+	if show.Status != catalogm.ShowStatusPrivate {
+		return visibilityStep(contracts.VisibilityRulePrivacy, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"Show is not private")
+	}
+	if isAdmin || isSubmitter {
+		return visibilityStep(contracts.VisibilityRulePrivacy, contracts.VisibilityOutcomeHide, contracts.VisibilitySurfaceList,
+			"Show is private; it opens on its page but is never listed in the upcoming feed")
+	}
+	return visibilityStep(contracts.VisibilityRulePrivacy, contracts.VisibilityOutcomeHide, contracts.VisibilitySurfaceDetail,
+		"Show is private; only the submitter and admins can see it")
+}
+
+// upcomingWindowStep: the feed starts at midnight today in the viewer's
+// timezone, falling back to UTC like GetUpcomingShows.
+func upcomingWindowStep(show *catalogm.Show, viewer *authm.User, now time.Time) contracts.VisibilityStep {
+	loc := time.UTC
+	if viewer != nil && viewer.Preferences != nil && viewer.Preferences.Timezone != "" {
+		if l, err := time.LoadLocation(viewer.Preferences.Timezone); err == nil {
+			loc = l
+		}
+	}
+	local := now.In(loc)
+	startOfToday := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	if show.EventDate.Before(startOfToday) {
+		return visibilityStep(contracts.VisibilityRuleUpcomingWindow, contracts.VisibilityOutcomeHide, contracts.VisibilitySurfaceList,
+			fmt.Sprintf("Event date %s is before today in %s; past shows drop out of the upcoming feed",
+				show.EventDate.UTC().Format(time.RFC3339), loc))
+	}
+	return visibilityStep(contracts.VisibilityRuleUpcomingWindow, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceList,
+		"Event date is today or later")
+}
+
+// marketStep: the city filter is applied from query params the frontend
+// picks (favorite cities are the default selection), so a mismatch is
+// advisory rather than a hide.
+func marketStep(show *catalogm.Show, viewer *authm.User) contracts.VisibilityStep {
+	var favorites []authm.FavoriteCity
+	if viewer != nil && viewer.Preferences != nil && viewer.Preferences.FavoriteCities != nil {
+		_ = json.Unmarshal(*viewer.Preferences.FavoriteCities, &favorites)
+	}
+	if len(favorites) == 0 {
+		return visibilityStep(contracts.VisibilityRuleMarket, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceList,
+			"Viewer has no favorite cities; the feed is not narrowed by market")
+	}
+
+	city, state := "", ""
+	if show.City != nil {
+		city = *show.City
+	}
+	if show.State != nil {
+		state = *show.State
+	}
+	for _, fc := range favorites {
+		if strings.EqualFold(fc.City, city) && strings.EqualFold(fc.State, state) {
+			return visibilityStep(contracts.VisibilityRuleMarket, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceList,
+				fmt.Sprintf("%s, %s is one of the viewer's favorite cities", city, state))
+		}
+	}
+	return visibilityStep(contracts.VisibilityRuleMarket, contracts.VisibilityOutcomeWarn, contracts.VisibilitySurfaceList,
+		fmt.Sprintf("%s, %s is not in the viewer's favorite cities; the default city filter will not include it", city, state))
+}
+
+// ageStep: age requirements are informational only — nothing is gated
+// server-side on the viewer's attested age.
+func ageStep(show *catalogm.Show, viewer *authm.User) contracts.VisibilityStep {
+	required := parseMinimumAge(show.AgeRequirement)
+	if required == 0 {
+		return visibilityStep(contracts.VisibilityRuleAge, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"Show has no parseable age requirement")
+	}
+	if viewer != nil && viewer.MinAgeAttested != nil && *viewer.MinAgeAttested < required {
+		return visibilityStep(contracts.VisibilityRuleAge, contracts.VisibilityOutcomeWarn, contracts.VisibilitySurfaceDetail,
+			fmt.Sprintf("Show is %d+ and the viewer attested to %d+; shown anyway, age is not enforced", required, *viewer.MinAgeAttested))
+	}
+	return visibilityStep(contracts.VisibilityRuleAge, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+		fmt.Sprintf("Show is %d+; age requirements are informational only", required))
+}
+
+// parseMinimumAge returns the minimum age in a free-text requirement, or 0
+// when there is none ("All Ages", empty, unrecognised).
+func parseMinimumAge(requirement *string) int {
+	if requirement == nil {
+		return 0
+	}
+	m := ageRequirementPattern.FindStringSubmatch(strings.ToLower(*requirement))
+	if m == nil {
+		return 0
+	}
+	age, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0
+	}
+	return age
+}
+
+func duplicateStep(show *catalogm.Show) contracts.VisibilityStep {
+	if show.DuplicateOfShowID == nil {
+		return visibilityStep(contracts.VisibilityRuleDuplicate, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceDetail,
+			"Not flagged as a duplicate")
+	}
+	return visibilityStep(contracts.VisibilityRuleDuplicate, contracts.VisibilityOutcomeWarn, contracts.VisibilitySurfaceDetail,
+		fmt.Sprintf("Flagged as a possible duplicate of show %d; stays visible until an admin resolves it", *show.DuplicateOfShowID))
+}
+
+func cancelledStep(show *catalogm.Show) contracts.VisibilityStep {
+	if !show.IsCancelled {
+		return visibilityStep(contracts.VisibilityRuleCancelled, contracts.VisibilityOutcomePass, contracts.VisibilitySurfaceList,
+			"Show is not cancelled")
+	}
+	return visibilityStep(contracts.VisibilityRuleCancelled, contracts.VisibilityOutcomeWarn, contracts.VisibilitySurfaceList,
+		"Show is cancelled; it stays listed with a cancelled badge")
+}
@@ -0,0 +1,191 @@
+package admin
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+var visibilityNow = time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+
+func visibilityShow(status catalogm.ShowStatus) *catalogm.Show {
+	submitter := uint(7)
+	return &catalogm.Show{
+		ID:          42,
+		Status:      status,
+		SubmittedBy: &submitter,
+		EventDate:   visibilityNow.Add(72 * time.Hour),
+		City:        stringPtr("Phoenix"),
+		State:       stringPtr("AZ"),
+	}
+}
+
+func visibilityStepFor(t *testing.T, exp *contracts.VisibilityExplanation, rule string) contracts.VisibilityStep {
+	t.Helper()
+	for _, s := range exp.Steps {
+		if s.Rule == rule {
+			return s
+		}
+	}
+	t.Fatalf("no step for rule %q", rule)
+	return contracts.VisibilityStep{}
+}
+
+func TestVisibilityDebugService_ExplainShowVisibility_NilDB(t *testing.T) {
+	svc := &VisibilityDebugService{}
+	result, err := svc.ExplainShowVisibility(nil, 1)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "database not initialized")
+}
+
+func TestExplainShowVisibility_ApprovedAnonymous(t *testing.T) {
+	exp := explainShowVisibility(visibilityShow(catalogm.ShowStatusApproved), nil, visibilityNow)
+
+	assert.Equal(t, "anonymous", exp.ViewerRole)
+	assert.True(t, exp.VisibleOnDetail)
+	assert.True(t, exp.VisibleInUpcoming)
+	assert.Nil(t, exp.HiddenBy)
+	assert.Nil(t, exp.UserID)
+	require.Len(t, exp.Steps, 9)
+	assert.Equal(t, contracts.VisibilityRuleAccount, exp.Steps[0].Rule)
+	for _, s := range exp.Steps {
+		assert.Equal(t, contracts.VisibilityOutcomePass, s.Outcome, s.Rule)
+	}
+}
+
+func TestExplainShowVisibility_PendingByRole(t *testing.T) {
+	show := visibilityShow(catalogm.ShowStatusPending)
+
+	anon := explainShowVisibility(show, nil, visibilityNow)
+	assert.False(t, anon.VisibleOnDetail)
+	assert.False(t, anon.VisibleInUpcoming)
+	require.NotNil(t, anon.HiddenBy)
+	assert.Equal(t, contracts.VisibilityRuleStatus, *anon.HiddenBy)
+
+	submitter := explainShowVisibility(show, &authm.User{ID: 7, IsActive: true}, visibilityNow)
+	assert.Equal(t, "submitter", submitter.ViewerRole)
+	assert.True(t, submitter.VisibleOnDetail)
+	assert.False(t, submitter.VisibleInUpcoming)
+	assert.Equal(t, contracts.VisibilitySurfaceList, visibilityStepFor(t, submitter, contracts.VisibilityRuleStatus).Surface)
+
+	admin := explainShowVisibility(show, &authm.User{ID: 1, IsActive: true, IsAdmin: true}, visibilityNow)
+	assert.Equal(t, "admin", admin.ViewerRole)
+	assert.True(t, admin.VisibleOnDetail)
+	assert.True(t, admin.VisibleInUpcoming)
+}
+
+func TestExplainShowVisibility_PrivateNeverListed(t *testing.T) {
+	show := visibilityShow(catalogm.ShowStatusPrivate)
+
+	admin := explainShowVisibility(show, &authm.User{ID: 1, IsActive: true, IsAdmin: true}, visibilityNow)
+	assert.True(t, admin.VisibleOnDetail)
+	assert.False(t, admin.VisibleInUpcoming)
+	require.NotNil(t, admin.HiddenBy)
+	assert.Equal(t, contracts.VisibilityRulePrivacy, *admin.HiddenBy)
+
+	other := explainShowVisibility(show, &authm.User{ID: 99, IsActive: true}, visibilityNow)
+	assert.Equal(t, "user", other.ViewerRole)
+	assert.False(t, other.VisibleOnDetail)
+}
+
+func TestExplainShowVisibility_DeletedHiddenForEveryone(t *testing.T) {
+	show := visibilityShow(catalogm.ShowStatusApproved)
+	deletedAt := visibilityNow.Add(-time.Hour)
+	show.DeletedAt = &deletedAt
+
+	for _, user := range []*authm.User{nil, {ID: 7, IsActive: true}, {ID: 1, IsActive: true, IsAdmin: true}} {
+		exp := explainShowVisibility(show, user, visibilityNow)
+		assert.False(t, exp.VisibleOnDetail, exp.ViewerRole)
+		assert.False(t, exp.VisibleInUpcoming, exp.ViewerRole)
+		require.NotNil(t, exp.HiddenBy)
+		assert.Equal(t, contracts.VisibilityRuleDeleted, *exp.HiddenBy)
+	}
+}
+
+func TestExplainShowVisibility_InactiveAccountIsAnonymous(t *testing.T) {
+	show := visibilityShow(catalogm.ShowStatusPending)
+	exp := explainShowVisibility(show, &authm.User{ID: 7, IsActive: false}, visibilityNow)
+
+	require.NotNil(t, exp.UserID)
+	assert.Equal(t, uint(7), *exp.UserID)
+	assert.Equal(t, "anonymous", exp.ViewerRole)
+	assert.Equal(t, contracts.VisibilityOutcomeWarn, exp.Steps[0].Outcome)
+	assert.False(t, exp.VisibleOnDetail)
+}
+
+func TestExplainShowVisibility_PastShowUsesViewerTimezone(t *testing.T) {
+	show := visibilityShow(catalogm.ShowStatusApproved)
+	// 23:00 UTC on the 9th is already past for a UTC viewer on the 10th.
+	show.EventDate = time.Date(2026, 3, 9, 23, 0, 0, 0, time.UTC)
+
+	utc := explainShowVisibility(show, nil, visibilityNow)
+	assert.False(t, utc.VisibleInUpcoming)
+	assert.True(t, utc.VisibleOnDetail)
+	require.NotNil(t, utc.HiddenBy)
+	assert.Equal(t, contracts.VisibilityRuleUpcomingWindow, *utc.HiddenBy)
+
+	// 05:00 UTC on the 10th is still the evening of the 9th in Phoenix (UTC-7),
+	// so the show is upcoming for that viewer.
+	early := time.Date(2026, 3, 10, 5, 0, 0, 0, time.UTC)
+	user := &authm.User{ID: 3, IsActive: true, Preferences: &authm.UserPreferences{Timezone: "America/Phoenix"}}
+	local := explainShowVisibility(show, user, early)
+	assert.True(t, local.VisibleInUpcoming)
+}
+
+func TestExplainShowVisibility_AdvisoryRules(t *testing.T) {
+	show := visibilityShow(catalogm.ShowStatusApproved)
+	dupOf := uint(5)
+	show.DuplicateOfShowID = &dupOf
+	show.IsCancelled = true
+	show.AgeRequirement = stringPtr("21+")
+
+	favorites := json.RawMessage(`[{"city":"Tucson","state":"AZ"}]`)
+	attested := 16
+	user := &authm.User{
+		ID:             3,
+		IsActive:       true,
+		MinAgeAttested: &attested,
+		Preferences:    &authm.UserPreferences{FavoriteCities: &favorites},
+	}
+	exp := explainShowVisibility(show, user, visibilityNow)
+
+	assert.True(t, exp.VisibleOnDetail)
+	assert.True(t, exp.VisibleInUpcoming)
+	assert.Nil(t, exp.HiddenBy)
+	for _, rule := range []string{
+		contracts.VisibilityRuleMarket,
+		contracts.VisibilityRuleAge,
+		contracts.VisibilityRuleDuplicate,
+		contracts.VisibilityRuleCancelled,
+	} {
+		assert.Equal(t, contracts.VisibilityOutcomeWarn, visibilityStepFor(t, exp, rule).Outcome, rule)
+	}
+}
+
+func TestParseMinimumAge(t *testing.T) {
+	cases := map[string]int{
+		"21+":              21,
+		"18 and over":      18,
+		"16+ w/ guardian":  16,
+		"All Ages":         0,
+		"":                 0,
+		"Ages 21 and up":   21,
+		"no minimum given": 0,
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, parseMinimumAge(&in), in)
+	}
+	assert.Equal(t, 0, parseMinimumAge(nil))
+}
@@ -0,0 +1,289 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	// APIKeyPrefix is prepended to public API keys. It must not start with
+	// the admin token prefix ("phk_"), which authenticates as the full user
+	// and bypasses rate limits.
+	APIKeyPrefix = "phak_" // "psychic homily api key"
+	// apiKeyLength is the random part of a key in bytes (32 bytes = 64 hex chars)
+	apiKeyLength = 32
+	// apiKeyDisplayLength is how much of the key is kept for display
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+
+	// MaxActiveAPIKeys caps the unrevoked keys a single user can hold
+	MaxActiveAPIKeys = 10
+	// DefaultAPIKeyRateLimit is the per-key request budget per minute
+	DefaultAPIKeyRateLimit = 60
+	// apiKeyRateWindow is the fixed window the per-key budget applies over
+	apiKeyRateWindow = time.Minute
+
+	maxAPIKeyNameLength = 100
+)
+
+// APIKeyService manages user-created public API keys
+type APIKeyService struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyService creates a new public API key service
+func NewAPIKeyService(database *gorm.DB) *APIKeyService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &APIKeyService{
+		db: database,
+	}
+}
+
+// generateAPIKey creates a cryptographically secure random key
+func generateAPIKey() (string, error) {
+	bytes := make([]byte, apiKeyLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return APIKeyPrefix + hex.EncodeToString(bytes), nil
+}
+
+// hashAPIKey creates a SHA-256 hash of a key for storage
+func hashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// normalizeAPIKeyScopes validates the requested scopes and returns them
+// de-duplicated in request order.
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]bool, len(scopes))
+	var out []string
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !authm.IsValidAPIKeyScope(scope) {
+			return nil, apperrors.ErrAPIKeyInvalid(fmt.Sprintf("unknown scope %q", scope))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			out = append(out, scope)
+		}
+	}
+	if len(out) == 0 {
+		return nil, apperrors.ErrAPIKeyInvalid("at least one scope is required")
+	}
+	return out, nil
+}
+
+// CreateKey generates a new scoped key for a verified user. The plaintext
+// key is only returned here; it is stored as a hash.
+func (s *APIKeyService) CreateKey(userID uint, name string, scopes []string) (*contracts.APIKeyCreateResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, apperrors.ErrAPIKeyInvalid("name is required")
+	}
+	if len([]rune(name)) > maxAPIKeyNameLength {
+		return nil, apperrors.ErrAPIKeyInvalid(fmt.Sprintf("name must be at most %d characters", maxAPIKeyNameLength))
+	}
+	scopes, err := normalizeAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	var user authm.User
+	if err := s.db.First(&user, userID).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.EmailVerified {
+		return nil, apperrors.ErrAPIKeyEmailNotVerified()
+	}
+
+	var active int64
+	if err := s.db.Model(&authm.APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Count(&active).Error; err != nil {
+		return nil, fmt.Errorf("failed to count API keys: %w", err)
+	}
+	if active >= MaxActiveAPIKeys {
+		return nil, apperrors.ErrAPIKeyLimitReached(MaxActiveAPIKeys)
+	}
+
+	plainKey, err := generateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	key := &authm.APIKey{
+		UserID:             userID,
+		Name:               name,
+		KeyPrefix:          plainKey[:apiKeyDisplayLength],
+		KeyHash:            hashAPIKey(plainKey),
+		Scopes:             strings.Join(scopes, " "),
+		RateLimitPerMinute: DefaultAPIKeyRateLimit,
+	}
+	if err := s.db.Create(key).Error; err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	return &contracts.APIKeyCreateResponse{
+		APIKeyResponse: toAPIKeyResponse(key),
+		Key:            plainKey, // Return plaintext only this once
+	}, nil
+}
+
+// ListKeys returns the user's active keys, newest first
+func (s *APIKeyService) ListKeys(userID uint) ([]contracts.APIKeyResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var keys []authm.APIKey
+	err := s.db.Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("created_at DESC").
+		Find(&keys).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	responses := make([]contracts.APIKeyResponse, len(keys))
+	for i := range keys {
+		responses[i] = toAPIKeyResponse(&keys[i])
+	}
+	return responses, nil
+}
+
+// GetKey returns one of the user's active keys with its usage counters
+func (s *APIKeyService) GetKey(userID uint, keyID uint) (*contracts.APIKeyResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var key authm.APIKey
+	err := s.db.Where("id = ? AND user_id = ? AND revoked_at IS NULL", keyID, userID).First(&key).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrAPIKeyNotFound(keyID)
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	resp := toAPIKeyResponse(&key)
+	return &resp, nil
+}
+
+// RevokeKey revokes one of the user's keys. Revoked keys stop working
+// immediately and no longer count toward MaxActiveAPIKeys.
+func (s *APIKeyService) RevokeKey(userID uint, keyID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	result := s.db.Model(&authm.APIKey{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", keyID, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke API key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrAPIKeyNotFound(keyID)
+	}
+	return nil
+}
+
+// AuthenticateKey resolves a presented key, records the request against its
+// usage counters and reports whether it fits in the key's per-minute budget.
+//
+// The counter update, window reset and budget read happen in one UPDATE ...
+// RETURNING so concurrent requests on the same key can't both slip under the
+// limit. Requests rejected for being over budget still count as usage.
+func (s *APIKeyService) AuthenticateKey(plainKey string) (*contracts.APIKeyAuthResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	if !strings.HasPrefix(plainKey, APIKeyPrefix) {
+		return nil, apperrors.ErrAPIKeyUnauthorized("invalid API key")
+	}
+
+	windowSeconds := int(apiKeyRateWindow / time.Second)
+
+	var key authm.APIKey
+	err := s.db.Raw(`
+		UPDATE api_keys SET
+			request_count = request_count + 1,
+			last_used_at = NOW(),
+			window_started_at = CASE
+				WHEN window_started_at IS NULL OR window_started_at + make_interval(secs => ?) <= NOW()
+					THEN NOW()
+				ELSE window_started_at
+			END,
+			window_count = CASE
+				WHEN window_started_at IS NULL OR window_started_at + make_interval(secs => ?) <= NOW()
+					THEN 1
+				ELSE window_count + 1
+			END
+		WHERE key_hash = ? AND revoked_at IS NULL
+		RETURNING *
+	`, windowSeconds, windowSeconds, hashAPIKey(plainKey)).Scan(&key).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate API key: %w", err)
+	}
+	if key.ID == 0 {
+		return nil, apperrors.ErrAPIKeyUnauthorized("invalid API key")
+	}
+
+	var user authm.User
+	if err := s.db.First(&user, key.UserID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrAPIKeyUnauthorized("invalid API key")
+		}
+		return nil, fmt.Errorf("failed to get API key owner: %w", err)
+	}
+	if !user.IsActive || user.DeletedAt != nil {
+		return nil, apperrors.ErrAPIKeyUnauthorized("API key owner account is not active")
+	}
+
+	key.User = user
+	result := &contracts.APIKeyAuthResult{Key: &key, Allowed: true}
+	if key.WindowCount > key.RateLimitPerMinute {
+		result.Allowed = false
+		result.RetryAfterSeconds = 1
+		if key.WindowStartedAt != nil {
+			resetAt := key.WindowStartedAt.Add(apiKeyRateWindow)
+			if retryAfter := int(math.Ceil(time.Until(resetAt).Seconds())); retryAfter > 1 {
+				result.RetryAfterSeconds = retryAfter
+			}
+		}
+	}
+	return result, nil
+}
+
+func toAPIKeyResponse(key *authm.APIKey) contracts.APIKeyResponse {
+	return contracts.APIKeyResponse{
+		ID:                 key.ID,
+		Name:               key.Name,
+		KeyPrefix:          key.KeyPrefix,
+		Scopes:             key.ScopeList(),
+		RateLimitPerMinute: key.RateLimitPerMinute,
+		RequestCount:       key.RequestCount,
+		LastUsedAt:         key.LastUsedAt,
+		CreatedAt:          key.CreatedAt,
+	}
+}
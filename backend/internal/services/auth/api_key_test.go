@@ -0,0 +1,229 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestNormalizeAPIKeyScopes(t *testing.T) {
+	got, err := normalizeAPIKeyScopes([]string{"read:shows", " read:venues ", "read:shows"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(got, " ") != "read:shows read:venues" {
+		t.Errorf("scopes = %v, want [read:shows read:venues]", got)
+	}
+
+	if _, err := normalizeAPIKeyScopes(nil); err == nil {
+		t.Error("expected error for no scopes")
+	}
+	if _, err := normalizeAPIKeyScopes([]string{"admin"}); err == nil {
+		t.Error("expected error for unknown scope")
+	}
+}
+
+func TestGenerateAPIKey_DoesNotLookLikeAdminToken(t *testing.T) {
+	key, err := generateAPIKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(key, APIKeyPrefix) || strings.HasPrefix(key, "phk_") {
+		t.Errorf("key %q must start with %q and not phk_", key, APIKeyPrefix)
+	}
+}
+
+// =============================================================================
+// API KEY INTEGRATION TEST SUITE
+// =============================================================================
+
+type APIKeyServiceIntegrationTestSuite struct {
+	suite.Suite
+	db     *gorm.DB
+	testDB *testutil.TestDatabase
+	svc    *APIKeyService
+}
+
+func TestAPIKeyServiceIntegrationTestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(APIKeyServiceIntegrationTestSuite))
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) SetupSuite() {
+	s.testDB = testutil.SetupTestPostgres(s.T())
+	s.db = s.testDB.DB
+	s.svc = NewAPIKeyService(s.db)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, _ := s.db.DB()
+	_, _ = sqlDB.Exec("DELETE FROM api_keys")
+	_, _ = sqlDB.Exec("DELETE FROM user_preferences")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TearDownSuite() {
+	s.testDB.Cleanup()
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) createUser(email string, verified bool) *authm.User {
+	s.T().Helper()
+	user := &authm.User{Email: &email, IsActive: true, EmailVerified: true}
+	s.Require().NoError(s.db.Create(user).Error)
+	if !verified {
+		// GORM skips bool zero values on create; clear the flag afterwards
+		s.Require().NoError(s.db.Model(user).Update("email_verified", false).Error)
+	}
+	return user
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestCreateKey_ReturnsPlaintextOnce() {
+	user := s.createUser("keys@test.com", true)
+
+	created, err := s.svc.CreateKey(user.ID, "  Widget  ", []string{"read:shows", "read:venues"})
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(created.Key, APIKeyPrefix))
+	s.Equal("Widget", created.Name)
+	s.Equal([]string{"read:shows", "read:venues"}, created.Scopes)
+	s.Equal(DefaultAPIKeyRateLimit, created.RateLimitPerMinute)
+	s.True(strings.HasPrefix(created.Key, created.KeyPrefix))
+
+	var stored authm.APIKey
+	s.Require().NoError(s.db.First(&stored, created.ID).Error)
+	s.Equal(hashAPIKey(created.Key), stored.KeyHash)
+	s.NotContains(stored.KeyHash, created.Key)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestCreateKey_RequiresVerifiedEmail() {
+	user := s.createUser("unverified@test.com", false)
+
+	_, err := s.svc.CreateKey(user.ID, "Widget", []string{"read:shows"})
+	var keyErr *apperrors.APIKeyError
+	s.Require().ErrorAs(err, &keyErr)
+	s.Equal(apperrors.CodeAPIKeyEmailNotVerified, keyErr.Code)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestCreateKey_CapsActiveKeys() {
+	user := s.createUser("many@test.com", true)
+	for i := 0; i < MaxActiveAPIKeys; i++ {
+		_, err := s.svc.CreateKey(user.ID, "Key", []string{"read:shows"})
+		s.Require().NoError(err)
+	}
+
+	_, err := s.svc.CreateKey(user.ID, "One too many", []string{"read:shows"})
+	var keyErr *apperrors.APIKeyError
+	s.Require().ErrorAs(err, &keyErr)
+	s.Equal(apperrors.CodeAPIKeyLimitReached, keyErr.Code)
+
+	// Revoking one frees a slot
+	keys, err := s.svc.ListKeys(user.ID)
+	s.Require().NoError(err)
+	s.Require().NoError(s.svc.RevokeKey(user.ID, keys[0].ID))
+	_, err = s.svc.CreateKey(user.ID, "Replacement", []string{"read:shows"})
+	s.NoError(err)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestGetAndRevokeKey_ScopedToOwner() {
+	owner := s.createUser("owner@test.com", true)
+	other := s.createUser("other@test.com", true)
+	created, err := s.svc.CreateKey(owner.ID, "Widget", []string{"read:shows"})
+	s.Require().NoError(err)
+
+	_, err = s.svc.GetKey(other.ID, created.ID)
+	var keyErr *apperrors.APIKeyError
+	s.Require().ErrorAs(err, &keyErr)
+	s.Equal(apperrors.CodeAPIKeyNotFound, keyErr.Code)
+	s.Error(s.svc.RevokeKey(other.ID, created.ID))
+
+	s.Require().NoError(s.svc.RevokeKey(owner.ID, created.ID))
+	_, err = s.svc.GetKey(owner.ID, created.ID)
+	s.Error(err)
+	keys, err := s.svc.ListKeys(owner.ID)
+	s.Require().NoError(err)
+	s.Empty(keys)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestAuthenticateKey_CountsUsage() {
+	user := s.createUser("usage@test.com", true)
+	created, err := s.svc.CreateKey(user.ID, "Widget", []string{"read:shows"})
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		result, err := s.svc.AuthenticateKey(created.Key)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.Equal(user.ID, result.Key.User.ID)
+		s.True(result.Key.HasScope(authm.APIKeyScopeReadShows))
+	}
+
+	key, err := s.svc.GetKey(user.ID, created.ID)
+	s.Require().NoError(err)
+	s.Equal(int64(3), key.RequestCount)
+	s.NotNil(key.LastUsedAt)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestAuthenticateKey_EnforcesPerKeyLimit() {
+	user := s.createUser("limit@test.com", true)
+	created, err := s.svc.CreateKey(user.ID, "Widget", []string{"read:shows"})
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.Model(&authm.APIKey{}).Where("id = ?", created.ID).
+		Update("rate_limit_per_minute", 2).Error)
+
+	for i := 0; i < 2; i++ {
+		result, err := s.svc.AuthenticateKey(created.Key)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+	}
+	result, err := s.svc.AuthenticateKey(created.Key)
+	s.Require().NoError(err)
+	s.False(result.Allowed)
+	s.GreaterOrEqual(result.RetryAfterSeconds, 1)
+
+	// An elapsed window resets the budget
+	s.Require().NoError(s.db.Model(&authm.APIKey{}).Where("id = ?", created.ID).
+		Update("window_started_at", time.Now().Add(-2*time.Minute)).Error)
+	result, err = s.svc.AuthenticateKey(created.Key)
+	s.Require().NoError(err)
+	s.True(result.Allowed)
+	s.Equal(1, result.Key.WindowCount)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestAuthenticateKey_RejectsUnknownAndRevoked() {
+	user := s.createUser("revoked@test.com", true)
+	created, err := s.svc.CreateKey(user.ID, "Widget", []string{"read:shows"})
+	s.Require().NoError(err)
+
+	_, err = s.svc.AuthenticateKey(APIKeyPrefix + "nope")
+	var keyErr *apperrors.APIKeyError
+	s.Require().ErrorAs(err, &keyErr)
+	s.Equal(apperrors.CodeAPIKeyUnauthorized, keyErr.Code)
+
+	_, err = s.svc.AuthenticateKey("phk_" + strings.TrimPrefix(created.Key, APIKeyPrefix))
+	s.Error(err)
+
+	s.Require().NoError(s.svc.RevokeKey(user.ID, created.ID))
+	_, err = s.svc.AuthenticateKey(created.Key)
+	s.Require().ErrorAs(err, &keyErr)
+	s.Equal(apperrors.CodeAPIKeyUnauthorized, keyErr.Code)
+}
+
+func (s *APIKeyServiceIntegrationTestSuite) TestAuthenticateKey_RejectsInactiveOwner() {
+	user := s.createUser("inactive@test.com", true)
+	created, err := s.svc.CreateKey(user.ID, "Widget", []string{"read:shows"})
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.Model(user).Update("is_active", false).Error)
+
+	_, err = s.svc.AuthenticateKey(created.Key)
+	var keyErr *apperrors.APIKeyError
+	s.Require().ErrorAs(err, &keyErr)
+	s.Equal(apperrors.CodeAPIKeyUnauthorized, keyErr.Code)
+}
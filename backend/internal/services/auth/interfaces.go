@@ -9,4 +9,5 @@ var (
 	_ contracts.PasswordValidatorInterface = (*PasswordValidator)(nil)
 	_ contracts.AppleAuthServiceInterface  = (*AppleAuthService)(nil)
 	_ contracts.WebAuthnServiceInterface   = (*WebAuthnService)(nil)
+	_ contracts.APIKeyServiceInterface     = (*APIKeyService)(nil)
 )
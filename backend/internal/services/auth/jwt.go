@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -25,16 +26,79 @@ const (
 	jwtSessionSubject = "session"
 )
 
+// unknownKidReloadInterval throttles key-ring reloads triggered by a token
+// whose kid this instance does not know (another instance rotated first).
+const unknownKidReloadInterval = 30 * time.Second
+
 type JWTService struct {
 	config      *config.Config
 	userService contracts.UserServiceInterface
+	keys        *config.JWTKeyRing
 }
 
 func NewJWTService(database interface{}, cfg *config.Config, userService contracts.UserServiceInterface) *JWTService {
 	return &JWTService{
 		config:      cfg,
 		userService: userService,
+		keys:        cfg.JWT.KeyRing(),
+	}
+}
+
+// sign signs claims with the ring's current key and stamps its kid.
+func (s *JWTService) sign(claims jwt.Claims) (string, error) {
+	key := s.keys.Current()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString([]byte(key.Secret))
+}
+
+// verificationKey is the jwt.Keyfunc for every token this service mints. A
+// token with a kid verifies against that key only; tokens minted before key
+// ids verify against any key in the ring.
+func (s *JWTService) verificationKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+		if key, found := s.keys.Lookup(kid); found {
+			return []byte(key.Secret), nil
+		}
+		if s.keys.ReloadIfStale(context.Background(), unknownKidReloadInterval) {
+			if key, found := s.keys.Lookup(kid); found {
+				return []byte(key.Secret), nil
+			}
+		}
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	var set jwt.VerificationKeySet
+	for _, key := range s.keys.All() {
+		set.Keys = append(set.Keys, []byte(key.Secret))
+	}
+	return set, nil
+}
+
+// JWTKeyStatus reports the ring's key ids (never the secrets).
+func (s *JWTService) JWTKeyStatus() *contracts.JWTKeyStatus {
+	status := &contracts.JWTKeyStatus{
+		CurrentKeyID:   s.keys.Current().ID,
+		PreviousKeyIDs: []string{},
+	}
+	for _, key := range s.keys.Previous() {
+		status.PreviousKeyIDs = append(status.PreviousKeyIDs, key.ID)
 	}
+	return status
+}
+
+// RotateJWTKeys reloads the ring from the secrets backend: the new
+// JWT_SECRET_KEY starts signing and JWT_PREVIOUS_KEYS keep verifying.
+func (s *JWTService) RotateJWTKeys(ctx context.Context) (*contracts.JWTKeyStatus, error) {
+	changed, err := s.keys.Reload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := s.JWTKeyStatus()
+	status.Changed = changed
+	return status, nil
 }
 
 // CreateToken generates a JWT for a user
@@ -49,8 +113,7 @@ func (s *JWTService) CreateToken(user *authm.User) (string, error) {
 		"sub":     jwtSessionSubject,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.config.JWT.SecretKey))
+	return s.sign(claims)
 }
 
 // parseSessionToken parses and cryptographically verifies a session JWT —
@@ -63,12 +126,7 @@ func (s *JWTService) CreateToken(user *authm.User) (string, error) {
 // legacy session tokens minted before the subject was added are rejected here
 // rather than being honored as session credentials.
 func (s *JWTService) parseSessionToken(tokenString string) (jwt.MapClaims, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.config.JWT.SecretKey), nil
-	}, jwt.WithIssuer(jwtIssuer), jwt.WithAudience(jwtAudience), jwt.WithSubject(jwtSessionSubject))
+	token, err := jwt.Parse(tokenString, s.verificationKey, jwt.WithIssuer(jwtIssuer), jwt.WithAudience(jwtAudience), jwt.WithSubject(jwtSessionSubject))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -155,12 +213,7 @@ func (s *JWTService) ValidateTokenLenient(tokenString string, gracePeriod time.D
 
 	// If strict validation failed, try parsing without expiration validation
 	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
-	token, parseErr := parser.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.config.JWT.SecretKey), nil
-	})
+	token, parseErr := parser.Parse(tokenString, s.verificationKey)
 
 	if parseErr != nil {
 		return nil, apperrors.ErrTokenInvalid(parseErr)
@@ -221,18 +274,12 @@ func (s *JWTService) CreateVerificationToken(userID uint, email string) (string,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.config.JWT.SecretKey))
+	return s.sign(claims)
 }
 
 // ValidateVerificationToken validates an email verification token and returns the claims
 func (s *JWTService) ValidateVerificationToken(tokenString string) (*contracts.VerificationTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &contracts.VerificationTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.config.JWT.SecretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &contracts.VerificationTokenClaims{}, s.verificationKey)
 
 	if err != nil {
 		return nil, fmt.Errorf("invalid verification token: %w", err)
@@ -265,18 +312,12 @@ func (s *JWTService) CreateMagicLinkToken(userID uint, email string) (string, er
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.config.JWT.SecretKey))
+	return s.sign(claims)
 }
 
 // ValidateMagicLinkToken validates a magic link token and returns the claims
 func (s *JWTService) ValidateMagicLinkToken(tokenString string) (*contracts.MagicLinkTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &contracts.MagicLinkTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.config.JWT.SecretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &contracts.MagicLinkTokenClaims{}, s.verificationKey)
 
 	if err != nil {
 		return nil, fmt.Errorf("invalid magic link token: %w", err)
@@ -309,18 +350,12 @@ func (s *JWTService) CreateAccountRecoveryToken(userID uint, email string) (stri
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.config.JWT.SecretKey))
+	return s.sign(claims)
 }
 
 // ValidateAccountRecoveryToken validates an account recovery token and returns the claims
 func (s *JWTService) ValidateAccountRecoveryToken(tokenString string) (*contracts.AccountRecoveryTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &contracts.AccountRecoveryTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(s.config.JWT.SecretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &contracts.AccountRecoveryTokenClaims{}, s.verificationKey)
 
 	if err != nil {
 		return nil, fmt.Errorf("invalid account recovery token: %w", err)
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -665,3 +666,80 @@ func TestJWTService_SessionUserID(t *testing.T) {
 		assert.False(t, ok, "a token signed with the wrong secret must not yield a user id")
 	})
 }
+
+// TestJWTService_KeyRing covers kid headers and validation across the key ring.
+func TestJWTService_KeyRing(t *testing.T) {
+	user := &authm.User{ID: 77, Email: stringPtr("ring@example.com")}
+
+	oldService := NewJWTService(nil, &config.Config{JWT: config.JWTConfig{SecretKey: "old-secret", Expiry: 24}}, newNilDBUserService())
+	rotatedService := NewJWTService(nil, &config.Config{JWT: config.JWTConfig{
+		SecretKey:    "new-secret",
+		PreviousKeys: []string{"old-secret"},
+		Expiry:       24,
+	}}, newNilDBUserService())
+
+	t.Run("tokens carry the current key id", func(t *testing.T) {
+		token, err := rotatedService.CreateToken(user)
+		require.NoError(t, err)
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, config.NewJWTKey("new-secret").ID, parsed.Header["kid"])
+	})
+
+	t.Run("token signed with a previous key still validates", func(t *testing.T) {
+		token, err := oldService.CreateToken(user)
+		require.NoError(t, err)
+		uid, ok := rotatedService.SessionUserID(token)
+		assert.True(t, ok)
+		assert.Equal(t, uint(77), uid)
+	})
+
+	t.Run("token from a retired key is rejected", func(t *testing.T) {
+		token, err := rotatedService.CreateToken(user)
+		require.NoError(t, err)
+		_, ok := oldService.SessionUserID(token)
+		assert.False(t, ok, "the old ring does not know the new key")
+	})
+
+	t.Run("legacy token without kid validates against the ring", func(t *testing.T) {
+		claims := jwt.MapClaims{
+			"user_id": float64(77),
+			"exp":     time.Now().Add(time.Hour).Unix(),
+			"iat":     time.Now().Unix(),
+			"iss":     jwtIssuer,
+			"aud":     jwtAudience,
+			"sub":     jwtSessionSubject,
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("old-secret"))
+		require.NoError(t, err)
+		uid, ok := rotatedService.SessionUserID(token)
+		assert.True(t, ok)
+		assert.Equal(t, uint(77), uid)
+	})
+
+	t.Run("kid pointing at the wrong key is rejected", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"user_id": float64(77),
+			"exp":     time.Now().Add(time.Hour).Unix(),
+			"iss":     jwtIssuer,
+			"aud":     jwtAudience,
+			"sub":     jwtSessionSubject,
+		})
+		token.Header["kid"] = config.NewJWTKey("new-secret").ID
+		signed, err := token.SignedString([]byte("old-secret"))
+		require.NoError(t, err)
+		_, ok := rotatedService.SessionUserID(signed)
+		assert.False(t, ok)
+	})
+
+	t.Run("status lists key ids", func(t *testing.T) {
+		status := rotatedService.JWTKeyStatus()
+		assert.Equal(t, config.NewJWTKey("new-secret").ID, status.CurrentKeyID)
+		assert.Equal(t, []string{config.NewJWTKey("old-secret").ID}, status.PreviousKeyIDs)
+	})
+
+	t.Run("static ring cannot rotate", func(t *testing.T) {
+		_, err := rotatedService.RotateJWTKeys(context.Background())
+		assert.ErrorIs(t, err, config.ErrJWTKeyRingStatic)
+	})
+}
@@ -122,6 +122,26 @@ func (s *AuthService) oauthCallbackInternal(
 	return user, token, nil
 }
 
+// OAuthLinkCallback completes an account-linking OAuth flow: the provider
+// account is attached to userID instead of logging anyone in, so no token is
+// minted.
+func (s *AuthService) OAuthLinkCallback(w http.ResponseWriter, r *http.Request, provider string, userID uint) (*authm.User, error) {
+	if r == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	gothUser, err := s.oauthCompleter.CompleteUserAuth(w, r)
+	if err != nil {
+		return nil, fmt.Errorf("OAuth completion failed: %w", err)
+	}
+
+	user, err := s.userService.LinkOAuthAccountToUser(userID, gothUser, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link OAuth account: %w", err)
+	}
+	return user, nil
+}
+
 // GetUserProfile retrieves user profile using the user service.
 //
 // Discriminates the not-found case (the principal was hard- or soft-deleted
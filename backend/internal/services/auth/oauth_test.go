@@ -306,3 +306,71 @@ func TestAuthService_Logout(t *testing.T) {
 	// Logout should always succeed (JWT tokens are stateless)
 	assert.NoError(t, err)
 }
+
+// stubUserServiceWithLink overrides LinkOAuthAccountToUser so the link
+// callback can be exercised without a database.
+type stubUserServiceWithLink struct {
+	nilDBUserService
+	link func(userID uint, gothUser goth.User, provider string) (*authm.User, error)
+}
+
+func (s *stubUserServiceWithLink) LinkOAuthAccountToUser(userID uint, gothUser goth.User, provider string) (*authm.User, error) {
+	return s.link(userID, gothUser, provider)
+}
+
+func TestAuthService_OAuthLinkCallback(t *testing.T) {
+	cfg := &config.Config{JWT: config.JWTConfig{SecretKey: "test-secret-key-32-chars-minimum", Expiry: 24}}
+	gothUser := goth.User{UserID: "google-789", Email: "link@example.com", Provider: "google"}
+
+	t.Run("links to the given user", func(t *testing.T) {
+		var gotUser uint
+		var gotProviderID string
+		authService := NewAuthService(nil, cfg, &stubUserServiceWithLink{
+			link: func(userID uint, gu goth.User, provider string) (*authm.User, error) {
+				gotUser, gotProviderID = userID, gu.UserID
+				return &authm.User{ID: userID}, nil
+			},
+		})
+		completer := new(MockOAuthCompleter)
+		completer.On("CompleteUserAuth", mock.Anything, mock.Anything).Return(gothUser, nil)
+		authService.SetOAuthCompleter(completer)
+
+		req := httptest.NewRequest("GET", "/auth/callback/google", nil)
+		user, err := authService.OAuthLinkCallback(httptest.NewRecorder(), req, "google", 31)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(31), user.ID)
+		assert.Equal(t, uint(31), gotUser)
+		assert.Equal(t, "google-789", gotProviderID)
+	})
+
+	t.Run("keeps the typed error from the user service", func(t *testing.T) {
+		authService := NewAuthService(nil, cfg, &stubUserServiceWithLink{
+			link: func(uint, goth.User, string) (*authm.User, error) {
+				return nil, apperrors.ErrOAuthAccountInUse("google")
+			},
+		})
+		completer := new(MockOAuthCompleter)
+		completer.On("CompleteUserAuth", mock.Anything, mock.Anything).Return(gothUser, nil)
+		authService.SetOAuthCompleter(completer)
+
+		req := httptest.NewRequest("GET", "/auth/callback/google", nil)
+		_, err := authService.OAuthLinkCallback(httptest.NewRecorder(), req, "google", 31)
+
+		var authErr *apperrors.AuthError
+		if assert.True(t, errors.As(err, &authErr)) {
+			assert.Equal(t, apperrors.CodeOAuthAccountInUse, authErr.Code)
+		}
+	})
+
+	t.Run("completion failure", func(t *testing.T) {
+		authService := NewAuthService(nil, cfg, newNilDBUserService())
+		completer := new(MockOAuthCompleter)
+		completer.On("CompleteUserAuth", mock.Anything, mock.Anything).Return(goth.User{}, errors.New("state mismatch"))
+		authService.SetOAuthCompleter(completer)
+
+		req := httptest.NewRequest("GET", "/auth/callback/google", nil)
+		_, err := authService.OAuthLinkCallback(httptest.NewRecorder(), req, "google", 31)
+		assert.ErrorContains(t, err, "OAuth completion failed")
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"psychic-homily-backend/internal/httpclient"
 	"psychic-homily-backend/internal/services/contracts"
 )
 
@@ -27,9 +28,7 @@ type PasswordValidator struct {
 // NewPasswordValidator creates a new password validator
 func NewPasswordValidator() *PasswordValidator {
 	return &PasswordValidator{
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
-		},
+		httpClient:      httpclient.New(5 * time.Second),
 		commonPasswords: buildCommonPasswordsMap(),
 	}
 }
@@ -19,6 +19,10 @@ func (n *nilDBUserService) ListUsers(limit, offset int, filters contracts.AdminU
 	return nil, 0, fmt.Errorf("database not initialized")
 }
 
+func (n *nilDBUserService) SetUserRole(actorID, userID uint, role authm.Role) (authm.Role, error) {
+	return "", fmt.Errorf("database not initialized")
+}
+
 func (n *nilDBUserService) FindOrCreateUser(gothUser goth.User, provider string) (*authm.User, error) {
 	return nil, fmt.Errorf("database not initialized")
 }
@@ -135,6 +139,10 @@ func (n *nilDBUserService) UnlinkOAuthAccount(userID uint, provider string) erro
 	return fmt.Errorf("database not initialized")
 }
 
+func (n *nilDBUserService) LinkOAuthAccountToUser(userID uint, gothUser goth.User, provider string) (*authm.User, error) {
+	return nil, fmt.Errorf("database not initialized")
+}
+
 func (n *nilDBUserService) GetFavoriteCities(userID uint) ([]authm.FavoriteCity, error) {
 	return nil, fmt.Errorf("database not initialized")
 }
@@ -173,7 +173,10 @@ func (s *WebAuthnService) FinishLogin(user *authm.User, session *webauthn.Sessio
 	return &webauthnCred, nil
 }
 
-// FinishDiscoverableLogin completes a discoverable login and returns the user
+// FinishDiscoverableLogin completes a discoverable login and returns the user.
+// When the credential is known but the assertion fails, the owner is still
+// returned (with a nil credential) so the caller can count the failure
+// toward the account lockout.
 func (s *WebAuthnService) FinishDiscoverableLogin(session *webauthn.SessionData, response *protocol.ParsedCredentialAssertionData) (*authm.User, *authm.WebAuthnCredential, error) {
 	// Find the credential by ID
 	var webauthnCred authm.WebAuthnCredential
@@ -196,7 +199,7 @@ func (s *WebAuthnService) FinishDiscoverableLogin(session *webauthn.SessionData,
 		response,
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to validate login: %w", err)
+		return &user, nil, fmt.Errorf("failed to validate login: %w", err)
 	}
 
 	// Update the credential
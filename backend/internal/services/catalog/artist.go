@@ -167,7 +167,11 @@ func (s *ArtistService) CreateArtist(req *contracts.CreateArtistRequest) (*contr
 		a.Country = req.Country
 		// (metro is derived from this location by the create funnel — PSY-1255 step B.)
 		a.Description = req.Description
+		a.Bio = sanitizeArtistBio(req.Bio)
+		a.Hometown = req.Hometown
+		a.Pronouns = req.Pronouns
 		a.ImageURL = req.ImageURL
+		a.ImageSource = userImageSourceIfSet(req.ImageURL)
 		a.BandcampEmbedURL = req.BandcampEmbedURL
 		// Stamp provenance whenever this create sets an embed (a human/admin/AI
 		// value): "manual" so the PSY-1189 keep-fresh hook never auto-refreshes a
@@ -182,6 +186,7 @@ func (s *ArtistService) CreateArtist(req *contracts.CreateArtistRequest) (*contr
 			Spotify:    req.Spotify,
 			SoundCloud: req.SoundCloud,
 			Bandcamp:   req.Bandcamp,
+			TikTok:     req.TikTok,
 			Website:    req.Website,
 		}
 	})
@@ -258,7 +263,23 @@ func (s *ArtistService) GetArtistBySlug(slug string) (*contracts.ArtistDetailRes
 	}
 
 	var artist catalogm.Artist
+	canonicalSlug := ""
 	err := s.db.Where("slug = ?", slug).First(&artist).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Fall back to slug history so links to a renamed or merged artist
+		// resolve.
+		artistID, rerr := resolveSlugRedirect(s.db, catalogm.SlugRedirectEntityArtist, slug)
+		if rerr != nil {
+			return nil, rerr
+		}
+		if artistID == 0 {
+			return nil, apperrors.ErrArtistNotFound(0)
+		}
+		err = s.db.First(&artist, artistID).Error
+		if artist.Slug != nil {
+			canonicalSlug = *artist.Slug
+		}
+	}
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, apperrors.ErrArtistNotFound(0)
@@ -268,6 +289,7 @@ func (s *ArtistService) GetArtistBySlug(slug string) (*contracts.ArtistDetailRes
 
 	resp := s.buildArtistResponse(&artist)
 	resp.Stats = s.buildArtistStats(artist.ID)
+	resp.CanonicalSlug = canonicalSlug
 	return resp, nil
 }
 
@@ -344,6 +366,9 @@ func (s *ArtistService) GetArtists(filters map[string]interface{}) ([]*contracts
 	if tf, ok := filters["tag_filter"].(TagFilter); ok {
 		query = ApplyTagFilter(query, s.db, catalogm.TagEntityArtist, "artists.id", tf)
 	}
+	if genres, ok := filters["genre_slugs"].([]string); ok {
+		query = ApplyArtistGenreFilter(query, s.db, "artists.id", genres)
+	}
 
 	// Default ordering by name
 	query = query.Order("name ASC")
@@ -397,9 +422,10 @@ func (s *ArtistService) UpdateArtist(artistID uint, req *contracts.UpdateArtistR
 	// are written so omitted fields stay unchanged. Every column is nullable
 	// except name, so empty input normalizes to SQL NULL via utils.NilIfEmpty.
 	updates := map[string]interface{}{}
+	var oldSlug *string
 
 	// Name maps to a NOT NULL column and additionally drives the uniqueness
-	// guard and slug regeneration.
+	// guard and slug regeneration; the previous slug is kept as a redirect.
 	if req.Name != nil {
 		name := *req.Name
 		var existingArtist catalogm.Artist
@@ -411,6 +437,13 @@ func (s *ArtistService) UpdateArtist(artistID uint, req *contracts.UpdateArtistR
 		}
 		updates["name"] = name
 
+		var current catalogm.Artist
+		if err := s.db.Select("slug").First(&current, artistID).Error; err == nil {
+			oldSlug = current.Slug
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get artist: %w", err)
+		}
+
 		// Regenerate slug when name changes
 		baseSlug := utils.GenerateArtistSlug(name)
 		slug := utils.GenerateUniqueSlug(baseSlug, func(candidate string) bool {
@@ -446,6 +479,25 @@ func (s *ArtistService) UpdateArtist(artistID uint, req *contracts.UpdateArtistR
 	if req.Description != nil {
 		updates["description"] = utils.NilIfEmpty(*req.Description)
 	}
+	if req.Bio != nil {
+		updates["bio"] = sanitizeArtistBio(req.Bio)
+	}
+	if req.Hometown != nil {
+		updates["hometown"] = utils.NilIfEmpty(*req.Hometown)
+	}
+	if req.Pronouns != nil {
+		updates["pronouns"] = utils.NilIfEmpty(*req.Pronouns)
+	}
+	if req.ImageURL != nil {
+		// A photo set here replaces whatever enrichment found, so the old
+		// photo's attribution goes with it. Explicit nils, as for the embed
+		// source below, so GORM writes them.
+		updates["image_url"] = utils.NilIfEmpty(*req.ImageURL)
+		updates["image_source"] = userImageSourceIfSet(req.ImageURL)
+		updates["image_source_url"] = nil
+		updates["image_license"] = nil
+		updates["image_author"] = nil
+	}
 	if req.BandcampEmbedURL != nil {
 		embed := utils.NilIfEmpty(*req.BandcampEmbedURL)
 		updates["bandcamp_embed_url"] = embed
@@ -481,12 +533,23 @@ func (s *ArtistService) UpdateArtist(artistID uint, req *contracts.UpdateArtistR
 	if req.Bandcamp != nil {
 		updates["bandcamp"] = utils.NilIfEmpty(*req.Bandcamp)
 	}
+	if req.TikTok != nil {
+		updates["tiktok"] = utils.NilIfEmpty(*req.TikTok)
+	}
 	if req.Website != nil {
 		updates["website"] = utils.NilIfEmpty(*req.Website)
 	}
 
 	if len(updates) > 0 {
-		err := s.db.Model(&catalogm.Artist{}).Where("id = ?", artistID).Updates(updates).Error
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(&catalogm.Artist{}).Where("id = ?", artistID).Updates(updates).Error; err != nil {
+				return err
+			}
+			if newSlug, ok := updates["slug"].(string); ok {
+				return recordSlugChangeTx(tx, catalogm.SlugRedirectEntityArtist, oldSlug, newSlug, artistID)
+			}
+			return nil
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to update artist: %w", err)
 		}
@@ -551,6 +614,8 @@ func (s *ArtistService) SearchArtists(query string) ([]*contracts.ArtistDetailRe
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Return empty results for empty query
 	if query == "" {
 		return []*contracts.ArtistDetailResponse{}, nil
@@ -563,12 +628,12 @@ func (s *ArtistService) SearchArtists(query string) ([]*contracts.ArtistDetailRe
 	if len(query) <= 2 {
 		// For short queries: use fast case-insensitive prefix search on name + aliases
 		prefixPattern := shared.LikePrefixPattern(query)
-		err = s.db.
+		err = replica.
 			Where("id IN (?)",
-				s.db.Table("artists").Select("id").Where("LOWER(name) LIKE LOWER(?)", prefixPattern),
+				replica.Table("artists").Select("id").Where("LOWER(name) LIKE LOWER(?)", prefixPattern),
 			).
 			Or("id IN (?)",
-				s.db.Table("artist_aliases").Select("artist_id").Where("LOWER(alias) LIKE LOWER(?)", prefixPattern),
+				replica.Table("artist_aliases").Select("artist_id").Where("LOWER(alias) LIKE LOWER(?)", prefixPattern),
 			).
 			Order("name ASC").
 			Limit(10).
@@ -579,7 +644,7 @@ func (s *ArtistService) SearchArtists(query string) ([]*contracts.ArtistDetailRe
 		// `name % ?` / `alias % ?` are the pg_trgm similarity operator and take the
 		// raw term; the ILIKE branches escape via LikePattern.
 		substringPattern := shared.LikePattern(query)
-		err = s.db.Raw(`
+		err = replica.Raw(`
 			SELECT a.* FROM artists a
 			WHERE a.id IN (
 				SELECT id FROM artists WHERE name ILIKE ? OR name % ?
@@ -643,7 +708,7 @@ func (s *ArtistService) GetArtistsWithShowCounts(filters map[string]interface{})
 	upcomingSubquery := s.db.Table("show_artists").
 		Select("show_artists.artist_id, COUNT(*) as show_count").
 		Joins("JOIN shows ON show_artists.show_id = shows.id").
-		Where("shows.event_date >= ? AND shows.status = ?", now, catalogm.ShowStatusApproved).
+		Where("shows.event_date >= ? AND shows.status = ? AND shows.deleted_at IS NULL", now, catalogm.ShowStatusApproved).
 		Group("show_artists.artist_id")
 
 	var query *gorm.DB
@@ -654,7 +719,7 @@ func (s *ArtistService) GetArtistsWithShowCounts(filters map[string]interface{})
 		pastSubquery := s.db.Table("show_artists").
 			Select("show_artists.artist_id, MAX(shows.event_date) as last_show_date").
 			Joins("JOIN shows ON show_artists.show_id = shows.id").
-			Where("shows.event_date < ? AND shows.status = ?", now, catalogm.ShowStatusApproved).
+			Where("shows.event_date < ? AND shows.status = ? AND shows.deleted_at IS NULL", now, catalogm.ShowStatusApproved).
 			Group("show_artists.artist_id")
 
 		query = s.db.Table("artists").
@@ -694,6 +759,9 @@ func (s *ArtistService) GetArtistsWithShowCounts(filters map[string]interface{})
 	if tf, ok := filters["tag_filter"].(TagFilter); ok {
 		query = ApplyTagFilter(query, s.db, catalogm.TagEntityArtist, "artists.id", tf)
 	}
+	if genres, ok := filters["genre_slugs"].([]string); ok {
+		query = ApplyArtistGenreFilter(query, s.db, "artists.id", genres)
+	}
 
 	var artistsWithCount []ArtistWithCount
 	if err := query.Order("upcoming_show_count DESC, artists.name ASC").Find(&artistsWithCount).Error; err != nil {
@@ -737,7 +805,7 @@ func (s *ArtistService) GetArtistCities() ([]*contracts.ArtistCityResponse, erro
 	artistsWithShows := s.db.Table("show_artists").
 		Select("DISTINCT show_artists.artist_id").
 		Joins("JOIN shows ON show_artists.show_id = shows.id").
-		Where("shows.event_date >= ? AND shows.status = ?", now, catalogm.ShowStatusApproved)
+		Where("shows.event_date >= ? AND shows.status = ? AND shows.deleted_at IS NULL", now, catalogm.ShowStatusApproved)
 
 	var results []CityResult
 	err := s.db.Table("artists").
@@ -820,6 +888,10 @@ func (s *ArtistService) buildArtistResponse(artist *catalogm.Artist) *contracts.
 		Country:          artist.Country,
 		BandcampEmbedURL: artist.BandcampEmbedURL,
 		Description:      artist.Description,
+		Bio:              artist.Bio,
+		BioHTML:          renderArtistBio(artist.Bio),
+		Hometown:         artist.Hometown,
+		Pronouns:         artist.Pronouns,
 		ImageURL:         artist.ImageURL,
 		ImageSource:      artist.ImageSource,
 		ImageSourceURL:   artist.ImageSourceURL,
@@ -833,8 +905,10 @@ func (s *ArtistService) buildArtistResponse(artist *catalogm.Artist) *contracts.
 			Spotify:    artist.Social.Spotify,
 			SoundCloud: artist.Social.SoundCloud,
 			Bandcamp:   artist.Social.Bandcamp,
+			TikTok:     artist.Social.TikTok,
 			Website:    artist.Social.Website,
 		},
+		Verified:  artist.Verified,
 		CreatedAt: artist.CreatedAt,
 		UpdatedAt: artist.UpdatedAt,
 	}
@@ -895,6 +969,36 @@ func (s *ArtistService) GetLabelsForArtist(artistID uint) ([]*contracts.ArtistLa
 // timeFilter can be: "upcoming" (event_date >= today), "past" (event_date < today), or "all"
 // Only returns approved shows.
 func (s *ArtistService) GetShowsForArtist(artistID uint, timezone string, limit int, timeFilter string) ([]*contracts.ArtistShowResponse, int64, error) {
+	return s.GetShowsForArtistPage(artistID, timezone, limit, 0, timeFilter, 0)
+}
+
+// GetShowYearsForArtist counts a artist's approved shows per calendar year in
+// timezone, most recent year first, under the same timeFilter as
+// GetShowsForArtistPage.
+func (s *ArtistService) GetShowYearsForArtist(artistID uint, timezone string, timeFilter string) ([]*contracts.ShowYearCount, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var artist catalogm.Artist
+	if err := s.db.First(&artist, artistID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrArtistNotFound(artistID)
+		}
+		return nil, fmt.Errorf("failed to get artist: %w", err)
+	}
+
+	loc, startOfToday := archiveStartOfToday(timezone)
+	q := s.db.Table("show_artists").
+		Joins("JOIN shows ON show_artists.show_id = shows.id").
+		Where("show_artists.artist_id = ? AND shows.status = ? AND shows.deleted_at IS NULL", artistID, catalogm.ShowStatusApproved)
+	return showYearCounts(applyShowTimeFilter(q, timeFilter, startOfToday), loc)
+}
+
+// GetShowsForArtistPage retrieves one page of a artist's shows. year, when
+// non-zero, keeps only shows in that calendar year in the given timezone —
+// the archive pages pair it with timeFilter="past".
+func (s *ArtistService) GetShowsForArtistPage(artistID uint, timezone string, limit, offset int, timeFilter string, year int) ([]*contracts.ArtistShowResponse, int64, error) {
 	if s.db == nil {
 		return nil, 0, fmt.Errorf("database not initialized")
 	}
@@ -943,10 +1047,13 @@ func (s *ArtistService) GetShowsForArtist(artistID uint, timezone string, limit
 	var total int64
 	countQuery := s.db.Table("show_artists").
 		Joins("JOIN shows ON show_artists.show_id = shows.id").
-		Where("show_artists.artist_id = ? AND shows.status = ?", artistID, catalogm.ShowStatusApproved)
+		Where("show_artists.artist_id = ? AND shows.status = ? AND shows.deleted_at IS NULL", artistID, catalogm.ShowStatusApproved)
 	if dateCondition != "" {
 		countQuery = countQuery.Where(dateCondition, startOfTodayUTC)
 	}
+	if year > 0 {
+		countQuery = countQuery.Where(showYearSQL+" = ?", loc.String(), year)
+	}
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count shows: %w", err)
 	}
@@ -956,11 +1063,14 @@ func (s *ArtistService) GetShowsForArtist(artistID uint, timezone string, limit
 	showQuery := s.db.Table("show_artists").
 		Select("show_artists.show_id").
 		Joins("JOIN shows ON show_artists.show_id = shows.id").
-		Where("show_artists.artist_id = ? AND shows.status = ?", artistID, catalogm.ShowStatusApproved)
+		Where("show_artists.artist_id = ? AND shows.status = ? AND shows.deleted_at IS NULL", artistID, catalogm.ShowStatusApproved)
 	if dateCondition != "" {
 		showQuery = showQuery.Where(dateCondition, startOfTodayUTC)
 	}
-	if err := showQuery.Order(orderDirection).Limit(limit).Pluck("show_artists.show_id", &showIDs).Error; err != nil {
+	if year > 0 {
+		showQuery = showQuery.Where(showYearSQL+" = ?", loc.String(), year)
+	}
+	if err := showQuery.Order(orderDirection).Limit(limit).Offset(offset).Pluck("show_artists.show_id", &showIDs).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to get show IDs: %w", err)
 	}
 
@@ -1057,7 +1167,7 @@ func (s *ArtistService) GetShowsForArtist(artistID uint, timezone string, limit
 			ID:             show.ID,
 			Title:          show.Title,
 			EventDate:      show.EventDate,
-			Price:          show.Price,
+			ShowPrice:      shared.ShowPriceOf(&show),
 			AgeRequirement: show.AgeRequirement,
 			Venue:          venue,
 			Artists:        artists,
@@ -1089,7 +1199,7 @@ func (s *ArtistService) GetNextShowForArtist(artistID uint, timezone string) (*c
 	var show catalogm.Show
 	err = s.db.
 		Joins("JOIN show_artists ON show_artists.show_id = shows.id").
-		Where("show_artists.artist_id = ? AND shows.status = ? AND shows.event_date >= ?",
+		Where("show_artists.artist_id = ? AND shows.status = ? AND shows.deleted_at IS NULL AND shows.event_date >= ?",
 			artistID, catalogm.ShowStatusApproved, startOfTodayUTC).
 		// Explicit shows.id tiebreak so a same-event_date tie is deterministic
 		// AND matches GetShowsForArtist (PSY-1352). (First would also append the
@@ -1108,7 +1218,7 @@ func (s *ArtistService) GetNextShowForArtist(artistID uint, timezone string) (*c
 		ID:             show.ID,
 		Title:          show.Title,
 		EventDate:      show.EventDate,
-		Price:          show.Price,
+		ShowPrice:      shared.ShowPriceOf(&show),
 		AgeRequirement: show.AgeRequirement,
 	}
 
@@ -1248,8 +1358,8 @@ func (s *ArtistService) GetArtistAliases(artistID uint) ([]*contracts.ArtistAlia
 // ──────────────────────────────────────────────
 
 // MergeArtists merges the "mergeFrom" artist into the "canonical" artist.
-// All relationships (shows, releases, labels, festivals, etc.) are transferred
-// to the canonical artist. Conflicts (duplicate rows) are deleted before transfer.
+// All relationships (shows, releases, labels, festivals, managers, etc.) are
+// transferred to the canonical artist. Conflicts (duplicate rows) are deleted before transfer.
 // The merged artist's name is added as an alias, then the merged artist is deleted.
 func (s *ArtistService) MergeArtists(canonicalID, mergeFromID uint) (*contracts.MergeArtistResult, error) {
 	if s.db == nil {
@@ -1318,9 +1428,11 @@ func (s *ArtistService) MergeArtists(canonicalID, mergeFromID uint) (*contracts.
 		r = tx.Exec("DELETE FROM artist_relationships WHERE source_artist_id = ? OR target_artist_id = ?", mergeFromID, mergeFromID)
 		result.RelationshipsMoved = r.RowsAffected
 
-		// 6. entity_tags: delete conflicts, then update remaining
+		// 6. entity_tags and inferred artist_genres: delete conflicts, then update remaining
 		tx.Exec("DELETE FROM entity_tags WHERE entity_type = 'artist' AND entity_id = ? AND tag_id IN (SELECT tag_id FROM entity_tags WHERE entity_type = 'artist' AND entity_id = ?)", mergeFromID, canonicalID)
 		tx.Exec("UPDATE entity_tags SET entity_id = ? WHERE entity_type = 'artist' AND entity_id = ?", canonicalID, mergeFromID)
+		tx.Exec("DELETE FROM artist_genres WHERE artist_id = ? AND tag_id IN (SELECT tag_id FROM artist_genres WHERE artist_id = ?)", mergeFromID, canonicalID)
+		tx.Exec("UPDATE artist_genres SET artist_id = ? WHERE artist_id = ?", canonicalID, mergeFromID)
 
 		// 7. user_bookmarks: delete conflicts, then update remaining
 		tx.Exec("DELETE FROM user_bookmarks WHERE entity_type = 'artist' AND entity_id = ? AND (user_id, action) IN (SELECT user_id, action FROM user_bookmarks WHERE entity_type = 'artist' AND entity_id = ?)", mergeFromID, canonicalID)
@@ -1368,6 +1480,68 @@ func (s *ArtistService) MergeArtists(canonicalID, mergeFromID uint) (*contracts.
 		// 15. Transfer aliases from merged artist to canonical
 		tx.Exec("UPDATE artist_aliases SET artist_id = ? WHERE artist_id = ?", canonicalID, mergeFromID)
 
+		// 15a. entity_reports: plain re-point (no uniqueness on the entity)
+		r = tx.Exec("UPDATE entity_reports SET entity_id = ? WHERE entity_type = 'artist' AND entity_id = ?", canonicalID, mergeFromID)
+		result.ReportsMoved = r.RowsAffected
+
+		// 15b. show_series_artists: delete conflicts, then update remaining.
+		// The FK cascades, so skipping this would silently drop the merged
+		// artist from recurring-series billings.
+		tx.Exec("DELETE FROM show_series_artists WHERE artist_id = ? AND series_id IN (SELECT series_id FROM show_series_artists WHERE artist_id = ?)", mergeFromID, canonicalID)
+		tx.Exec("UPDATE show_series_artists SET artist_id = ? WHERE artist_id = ?", canonicalID, mergeFromID)
+
+		// 15c. radio_plays (FK is ON DELETE SET NULL, which would unmatch the
+		// plays) and the pending match suggestions that point at the artist.
+		r = tx.Exec("UPDATE radio_plays SET artist_id = ? WHERE artist_id = ?", canonicalID, mergeFromID)
+		result.RadioPlaysMoved = r.RowsAffected
+		tx.Exec("UPDATE radio_play_match_suggestions SET suggested_artist_id = ? WHERE suggested_artist_id = ?", canonicalID, mergeFromID)
+
+		// 15d. artist_link_suggestions: delete conflicts, then update remaining
+		tx.Exec("DELETE FROM artist_link_suggestions WHERE artist_id = ? AND (platform, url) IN (SELECT platform, url FROM artist_link_suggestions WHERE artist_id = ?)", mergeFromID, canonicalID)
+		tx.Exec("UPDATE artist_link_suggestions SET artist_id = ? WHERE artist_id = ?", canonicalID, mergeFromID)
+
+		// 15e. Music links: copy any link the canonical artist lacks. Links
+		// already on the canonical artist win. The Bandcamp embed travels with
+		// its provenance so the keep-fresh hook still treats it correctly.
+		updates, copied := fillMissingSocialLinks(canonical.Social, mergeFrom.Social)
+		if isBlank(canonical.BandcampEmbedURL) && !isBlank(mergeFrom.BandcampEmbedURL) {
+			updates["bandcamp_embed_url"] = *mergeFrom.BandcampEmbedURL
+			updates["bandcamp_embed_source"] = mergeFrom.BandcampEmbedSource
+			copied = append(copied, "bandcamp_embed_url")
+		}
+		if len(updates) > 0 {
+			if err := tx.Model(&catalogm.Artist{}).Where("id = ?", canonicalID).Updates(updates).Error; err != nil {
+				return fmt.Errorf("failed to copy music links: %w", err)
+			}
+		}
+		result.LinksCopied = copied
+
+		// 15f. Keep the merged artist's URL working
+		redirected, err := redirectSlugTx(tx, catalogm.SlugRedirectEntityArtist, mergeFrom.Slug, mergeFromID, canonicalID)
+		if err != nil {
+			return err
+		}
+		result.SlugRedirected = redirected
+
+		// 15g. Keep import aliases pointing at a live artist
+		if err := repointEntityAliasesTx(tx, catalogm.EntityAliasTypeArtist, mergeFromID, canonicalID); err != nil {
+			return err
+		}
+
+		// 15h. Artist managers carry over (a grant the canonical artist
+		// already has wins), as do claims; a pending claim yields to the
+		// claimant's pending claim on the canonical artist. The verified badge
+		// follows the managers.
+		tx.Exec("DELETE FROM artist_managers WHERE artist_id = ? AND user_id IN (SELECT user_id FROM artist_managers WHERE artist_id = ?)", mergeFromID, canonicalID)
+		tx.Exec("UPDATE artist_managers SET artist_id = ? WHERE artist_id = ?", canonicalID, mergeFromID)
+		tx.Exec("DELETE FROM artist_claims WHERE artist_id = ? AND status = 'pending' AND user_id IN (SELECT user_id FROM artist_claims WHERE artist_id = ? AND status = 'pending')", mergeFromID, canonicalID)
+		tx.Exec("UPDATE artist_claims SET artist_id = ? WHERE artist_id = ?", canonicalID, mergeFromID)
+		if mergeFrom.Verified && !canonical.Verified {
+			if err := tx.Model(&catalogm.Artist{}).Where("id = ?", canonicalID).Update("verified", true).Error; err != nil {
+				return fmt.Errorf("failed to carry over verified badge: %w", err)
+			}
+		}
+
 		// 16. Create alias from merged artist's name (if not conflicting)
 		var aliasCount int64
 		tx.Model(&catalogm.ArtistAlias{}).Where("LOWER(alias) = LOWER(?)", mergeFrom.Name).Count(&aliasCount)
@@ -1415,6 +1589,42 @@ func manualEmbedSourceIfSet(embed *string) *string {
 	return &src
 }
 
+// artistImageSourceUser is the image_source of a photo set through the
+// create/update endpoints rather than found by an enrichment sweep.
+const artistImageSourceUser = "user"
+
+// userImageSourceIfSet returns a pointer to the "user" image source when
+// imageURL is a non-empty photo URL, and nil otherwise — the image_source
+// counterpart of manualEmbedSourceIfSet.
+func userImageSourceIfSet(imageURL *string) *string {
+	if imageURL == nil || strings.TrimSpace(*imageURL) == "" {
+		return nil
+	}
+	src := artistImageSourceUser
+	return &src
+}
+
+// artistBioMarkdown renders artist bios. Stateless after construction, so
+// one renderer serves every request (like the contributor profile's).
+var artistBioMarkdown = utils.NewMarkdownRenderer()
+
+// sanitizeArtistBio cleans a bio for storage; an empty result is nil so the
+// column lands as SQL NULL.
+func sanitizeArtistBio(bio *string) *string {
+	if bio == nil {
+		return nil
+	}
+	return utils.NilIfEmpty(utils.SanitizeMarkdownSource(*bio))
+}
+
+// renderArtistBio renders a stored bio to sanitized HTML; "" when unset.
+func renderArtistBio(bio *string) string {
+	if bio == nil {
+		return ""
+	}
+	return artistBioMarkdown.Render(*bio)
+}
+
 // ──────────────────────────────────────────────
 // Profile→album embed resolution (PSY-1190)
 //
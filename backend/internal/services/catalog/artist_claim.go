@@ -0,0 +1,379 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
+)
+
+// ArtistClaimService handles artist claims, the artist_managers grants that
+// approving a claim creates, and the artist's verified badge that follows them.
+type ArtistClaimService struct {
+	db *gorm.DB
+}
+
+// NewArtistClaimService creates a new artist claim service
+func NewArtistClaimService(database *gorm.DB) *ArtistClaimService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &ArtistClaimService{db: database}
+}
+
+// SubmitClaim files a pending claim on an artist.
+func (s *ArtistClaimService) SubmitClaim(artistID, userID uint, evidence string) (*contracts.ArtistClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	evidence = strings.TrimSpace(evidence)
+	if evidence == "" {
+		return nil, apperrors.ErrArtistClaimInvalid("evidence is required")
+	}
+	if utf8.RuneCountInString(evidence) > contracts.MaxArtistClaimEvidenceLength {
+		return nil, apperrors.ErrArtistClaimInvalid(fmt.Sprintf("evidence must be %d characters or fewer", contracts.MaxArtistClaimEvidenceLength))
+	}
+
+	var artistCount int64
+	if err := s.db.Model(&catalogm.Artist{}).Where("id = ?", artistID).Count(&artistCount).Error; err != nil {
+		return nil, apperrors.ErrArtistClaimInternal(err)
+	}
+	if artistCount == 0 {
+		return nil, apperrors.ErrArtistNotFound(artistID)
+	}
+
+	managed, err := s.IsArtistManager(userID, artistID)
+	if err != nil {
+		return nil, err
+	}
+	if managed {
+		return nil, apperrors.ErrArtistClaimConflict("you already manage this artist")
+	}
+
+	claim := &catalogm.ArtistClaim{
+		ArtistID: artistID,
+		UserID:   userID,
+		Evidence: evidence,
+		Status:   catalogm.ArtistClaimStatusPending,
+	}
+	if err := s.db.Create(claim).Error; err != nil {
+		// idx_artist_claims_pending: one pending claim per user and artist.
+		if shared.IsDuplicateKey(err) {
+			return nil, apperrors.ErrArtistClaimConflict("you already have a pending claim on this artist")
+		}
+		return nil, apperrors.ErrArtistClaimInternal(err)
+	}
+
+	return s.getClaim(claim.ID)
+}
+
+// GetUserClaims returns the user's own claims, newest first.
+func (s *ArtistClaimService) GetUserClaims(userID uint) ([]*contracts.ArtistClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var claims []catalogm.ArtistClaim
+	err := s.preloadClaims(s.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&claims).Error
+	if err != nil {
+		return nil, apperrors.ErrArtistClaimInternal(err)
+	}
+	return toArtistClaimResponses(claims), nil
+}
+
+// ListClaims returns claims for the admin review queue, oldest first.
+func (s *ArtistClaimService) ListClaims(status string, limit, offset int) ([]*contracts.ArtistClaimResponse, int64, error) {
+	if s.db == nil {
+		return nil, 0, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := s.db.Model(&catalogm.ArtistClaim{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.ErrArtistClaimInternal(err)
+	}
+
+	var claims []catalogm.ArtistClaim
+	err := s.preloadClaims(query).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&claims).Error
+	if err != nil {
+		return nil, 0, apperrors.ErrArtistClaimInternal(err)
+	}
+	return toArtistClaimResponses(claims), total, nil
+}
+
+// ApproveClaim approves a pending claim, makes the claimant a manager of the
+// artist and verifies the artist, in one transaction.
+func (s *ArtistClaimService) ApproveClaim(claimID, reviewerID uint, note string) (*contracts.ArtistClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		claim, err := reviewableArtistClaim(tx, claimID)
+		if err != nil {
+			return err
+		}
+		if err := markArtistClaimReviewed(tx, claim, catalogm.ArtistClaimStatusApproved, reviewerID, note); err != nil {
+			return err
+		}
+
+		// A manager row can already exist when an admin approves a second
+		// claim for the same user; keep the original grant.
+		manager := &catalogm.ArtistManager{
+			ArtistID:  claim.ArtistID,
+			UserID:    claim.UserID,
+			ClaimID:   &claim.ID,
+			GrantedBy: &reviewerID,
+		}
+		if err := tx.Where("artist_id = ? AND user_id = ?", claim.ArtistID, claim.UserID).
+			FirstOrCreate(manager).Error; err != nil {
+			return apperrors.ErrArtistClaimInternal(err)
+		}
+		return setArtistVerified(tx, claim.ArtistID, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getClaim(claimID)
+}
+
+// DenyClaim denies a pending claim with a reason shown to the claimant.
+func (s *ArtistClaimService) DenyClaim(claimID, reviewerID uint, reason string) (*contracts.ArtistClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return nil, apperrors.ErrArtistClaimInvalid("a reason is required to deny a claim")
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		claim, err := reviewableArtistClaim(tx, claimID)
+		if err != nil {
+			return err
+		}
+		return markArtistClaimReviewed(tx, claim, catalogm.ArtistClaimStatusDenied, reviewerID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getClaim(claimID)
+}
+
+// IsArtistManager reports whether the user manages the artist.
+func (s *ArtistClaimService) IsArtistManager(userID, artistID uint) (bool, error) {
+	if s.db == nil {
+		return false, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var count int64
+	err := s.db.Model(&catalogm.ArtistManager{}).
+		Where("artist_id = ? AND user_id = ?", artistID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, apperrors.ErrArtistClaimInternal(err)
+	}
+	return count > 0, nil
+}
+
+// GetManagedArtists returns the artists the user manages, by artist name.
+func (s *ArtistClaimService) GetManagedArtists(userID uint) ([]*contracts.ArtistManagerResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var managers []catalogm.ArtistManager
+	err := s.db.Preload("Artist").Preload("User").
+		Joins("JOIN artists ON artists.id = artist_managers.artist_id").
+		Where("artist_managers.user_id = ?", userID).
+		Order("artists.name ASC").
+		Find(&managers).Error
+	if err != nil {
+		return nil, apperrors.ErrArtistClaimInternal(err)
+	}
+	return toArtistManagerResponses(managers), nil
+}
+
+// ListArtistManagers returns the managers of an artist, oldest grant first.
+func (s *ArtistClaimService) ListArtistManagers(artistID uint) ([]*contracts.ArtistManagerResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var managers []catalogm.ArtistManager
+	err := s.db.Preload("Artist").Preload("User").
+		Where("artist_id = ?", artistID).
+		Order("created_at ASC").
+		Find(&managers).Error
+	if err != nil {
+		return nil, apperrors.ErrArtistClaimInternal(err)
+	}
+	return toArtistManagerResponses(managers), nil
+}
+
+// RemoveArtistManager revokes a user's manager rights on an artist. The
+// approved claim is kept as history; the artist stays verified only while it
+// has at least one manager.
+func (s *ArtistClaimService) RemoveArtistManager(artistID, userID uint) error {
+	if s.db == nil {
+		return apperrors.ErrArtistClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("artist_id = ? AND user_id = ?", artistID, userID).Delete(&catalogm.ArtistManager{})
+		if result.Error != nil {
+			return apperrors.ErrArtistClaimInternal(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.ErrArtistManagerNotFound(artistID, userID)
+		}
+
+		var remaining int64
+		if err := tx.Model(&catalogm.ArtistManager{}).Where("artist_id = ?", artistID).Count(&remaining).Error; err != nil {
+			return apperrors.ErrArtistClaimInternal(err)
+		}
+		return setArtistVerified(tx, artistID, remaining > 0)
+	})
+}
+
+// ──────────────────────────────────────────────
+// Helpers
+// ──────────────────────────────────────────────
+
+func (s *ArtistClaimService) preloadClaims(query *gorm.DB) *gorm.DB {
+	return query.Preload("Artist").Preload("User").Preload("Reviewer")
+}
+
+func (s *ArtistClaimService) getClaim(claimID uint) (*contracts.ArtistClaimResponse, error) {
+	var claim catalogm.ArtistClaim
+	if err := s.preloadClaims(s.db).First(&claim, claimID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrArtistClaimNotFound(claimID)
+		}
+		return nil, apperrors.ErrArtistClaimInternal(err)
+	}
+	return toArtistClaimResponse(&claim), nil
+}
+
+// reviewableArtistClaim loads a claim for review, locking the row so two reviewers
+// cannot decide it concurrently.
+func reviewableArtistClaim(tx *gorm.DB, claimID uint) (*catalogm.ArtistClaim, error) {
+	var claim catalogm.ArtistClaim
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&claim, claimID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrArtistClaimNotFound(claimID)
+		}
+		return nil, apperrors.ErrArtistClaimInternal(err)
+	}
+	if claim.Status != catalogm.ArtistClaimStatusPending {
+		return nil, apperrors.ErrArtistClaimNotPending(string(claim.Status))
+	}
+	return &claim, nil
+}
+
+func setArtistVerified(tx *gorm.DB, artistID uint, verified bool) error {
+	if err := tx.Model(&catalogm.Artist{}).Where("id = ?", artistID).Update("verified", verified).Error; err != nil {
+		return apperrors.ErrArtistClaimInternal(err)
+	}
+	return nil
+}
+
+func markArtistClaimReviewed(tx *gorm.DB, claim *catalogm.ArtistClaim, status catalogm.ArtistClaimStatus, reviewerID uint, note string) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":      status,
+		"reviewed_by": reviewerID,
+		"reviewed_at": now,
+		"updated_at":  now,
+	}
+	if note = strings.TrimSpace(note); note != "" {
+		updates["review_note"] = note
+	}
+	if err := tx.Model(claim).Updates(updates).Error; err != nil {
+		return apperrors.ErrArtistClaimInternal(err)
+	}
+	return nil
+}
+
+func toArtistClaimResponses(claims []catalogm.ArtistClaim) []*contracts.ArtistClaimResponse {
+	responses := make([]*contracts.ArtistClaimResponse, len(claims))
+	for i := range claims {
+		responses[i] = toArtistClaimResponse(&claims[i])
+	}
+	return responses
+}
+
+func toArtistClaimResponse(claim *catalogm.ArtistClaim) *contracts.ArtistClaimResponse {
+	resp := &contracts.ArtistClaimResponse{
+		ID:         claim.ID,
+		ArtistID:   claim.ArtistID,
+		ArtistName: claim.Artist.Name,
+		UserID:     claim.UserID,
+		UserName:   shared.ResolveUserName(&claim.User),
+		Evidence:   claim.Evidence,
+		Status:     string(claim.Status),
+		ReviewedBy: claim.ReviewedBy,
+		ReviewedAt: claim.ReviewedAt,
+		ReviewNote: claim.ReviewNote,
+		CreatedAt:  claim.CreatedAt,
+		UpdatedAt:  claim.UpdatedAt,
+	}
+	if claim.Artist.Slug != nil {
+		resp.ArtistSlug = *claim.Artist.Slug
+	}
+	if claim.Reviewer != nil {
+		resp.ReviewerName = shared.ResolveUserName(claim.Reviewer)
+	}
+	return resp
+}
+
+func toArtistManagerResponses(managers []catalogm.ArtistManager) []*contracts.ArtistManagerResponse {
+	responses := make([]*contracts.ArtistManagerResponse, len(managers))
+	for i := range managers {
+		m := &managers[i]
+		responses[i] = &contracts.ArtistManagerResponse{
+			ArtistID:   m.ArtistID,
+			ArtistName: m.Artist.Name,
+			UserID:     m.UserID,
+			UserName:   shared.ResolveUserName(&m.User),
+			ClaimID:    m.ClaimID,
+			GrantedBy:  m.GrantedBy,
+			CreatedAt:  m.CreatedAt,
+		}
+		if m.Artist.Slug != nil {
+			responses[i].ArtistSlug = *m.Artist.Slug
+		}
+	}
+	return responses
+}
@@ -0,0 +1,193 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestArtistClaimService_NilDatabase(t *testing.T) {
+	svc := &ArtistClaimService{}
+
+	_, err := svc.SubmitClaim(1, 1, "I play bass in the band")
+	var claimErr *apperrors.ArtistClaimError
+	require.True(t, errors.As(err, &claimErr))
+	assert.Equal(t, apperrors.CodeArtistClaimInternal, claimErr.Code)
+
+	_, err = svc.IsArtistManager(1, 1)
+	assert.Error(t, err)
+	assert.Error(t, svc.RemoveArtistManager(1, 1))
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type ArtistClaimServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *ArtistClaimService
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.svc = NewArtistClaimService(suite.testDB.DB)
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+// TearDownTest cleans up data between tests for isolation
+func (suite *ArtistClaimServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	// Delete in FK-safe order
+	_, _ = sqlDB.Exec("DELETE FROM artist_managers")
+	_, _ = sqlDB.Exec("DELETE FROM artist_claims")
+	_, _ = sqlDB.Exec("DELETE FROM artists")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestArtistClaimServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ArtistClaimServiceIntegrationTestSuite))
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) createUser(name string) *authm.User {
+	email := fmt.Sprintf("%s-%d@test.com", name, time.Now().UnixNano())
+	user := &authm.User{Email: &email, FirstName: &name, IsActive: true, EmailVerified: true}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	return user
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) createArtist(name string) *catalogm.Artist {
+	artist := &catalogm.Artist{Name: name}
+	suite.Require().NoError(suite.db.Create(artist).Error)
+	return artist
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) requireClaimCode(err error, code string) {
+	var claimErr *apperrors.ArtistClaimError
+	suite.Require().ErrorAs(err, &claimErr)
+	suite.Equal(code, claimErr.Code)
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) isVerified(artistID uint) bool {
+	var artist catalogm.Artist
+	suite.Require().NoError(suite.db.First(&artist, artistID).Error)
+	return artist.Verified
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) TestSubmitClaim_Validation() {
+	user := suite.createUser("bassist")
+	artist := suite.createArtist("Snake Clan")
+
+	_, err := suite.svc.SubmitClaim(artist.ID, user.ID, "   ")
+	suite.requireClaimCode(err, apperrors.CodeArtistClaimInvalid)
+
+	_, err = suite.svc.SubmitClaim(artist.ID, user.ID, strings.Repeat("x", contracts.MaxArtistClaimEvidenceLength+1))
+	suite.requireClaimCode(err, apperrors.CodeArtistClaimInvalid)
+
+	_, err = suite.svc.SubmitClaim(999999, user.ID, "I play bass in the band")
+	var artistErr *apperrors.ArtistError
+	suite.Require().ErrorAs(err, &artistErr)
+	suite.Equal(apperrors.CodeArtistNotFound, artistErr.Code)
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) TestApproveClaim_GrantsManagerAndVerifies() {
+	user := suite.createUser("bassist")
+	admin := suite.createUser("admin")
+	artist := suite.createArtist("Snake Clan")
+
+	claim, err := suite.svc.SubmitClaim(artist.ID, user.ID, "  I play bass in the band  ")
+	suite.Require().NoError(err)
+	suite.Equal("I play bass in the band", claim.Evidence)
+	suite.Equal("pending", claim.Status)
+	suite.Equal("Snake Clan", claim.ArtistName)
+	suite.False(suite.isVerified(artist.ID))
+
+	_, err = suite.svc.SubmitClaim(artist.ID, user.ID, "again")
+	suite.requireClaimCode(err, apperrors.CodeArtistClaimConflict)
+
+	approved, err := suite.svc.ApproveClaim(claim.ID, admin.ID, "")
+	suite.Require().NoError(err)
+	suite.Equal("approved", approved.Status)
+	suite.Require().NotNil(approved.ReviewedBy)
+	suite.Equal(admin.ID, *approved.ReviewedBy)
+	suite.True(suite.isVerified(artist.ID))
+
+	managed, err := suite.svc.IsArtistManager(user.ID, artist.ID)
+	suite.Require().NoError(err)
+	suite.True(managed)
+
+	_, err = suite.svc.ApproveClaim(claim.ID, admin.ID, "")
+	suite.requireClaimCode(err, apperrors.CodeArtistClaimNotPending)
+
+	artists, err := suite.svc.GetManagedArtists(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(artists, 1)
+	suite.Equal(artist.ID, artists[0].ArtistID)
+	suite.Require().NotNil(artists[0].ClaimID)
+	suite.Equal(claim.ID, *artists[0].ClaimID)
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) TestRemoveArtistManager_ClearsVerifiedWithLastManager() {
+	first := suite.createUser("singer")
+	second := suite.createUser("drummer")
+	artist := suite.createArtist("Snake Clan")
+	suite.Require().NoError(suite.db.Create(&catalogm.ArtistManager{ArtistID: artist.ID, UserID: first.ID}).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ArtistManager{ArtistID: artist.ID, UserID: second.ID}).Error)
+	suite.Require().NoError(suite.db.Model(artist).Update("verified", true).Error)
+
+	suite.Require().NoError(suite.svc.RemoveArtistManager(artist.ID, first.ID))
+	suite.True(suite.isVerified(artist.ID), "another manager remains")
+
+	suite.Require().NoError(suite.svc.RemoveArtistManager(artist.ID, second.ID))
+	suite.False(suite.isVerified(artist.ID))
+
+	suite.requireClaimCode(suite.svc.RemoveArtistManager(artist.ID, second.ID), apperrors.CodeArtistManagerNotFound)
+}
+
+func (suite *ArtistClaimServiceIntegrationTestSuite) TestDenyClaim() {
+	user := suite.createUser("bassist")
+	admin := suite.createUser("admin")
+	artist := suite.createArtist("Snake Clan")
+
+	claim, err := suite.svc.SubmitClaim(artist.ID, user.ID, "I play bass in the band")
+	suite.Require().NoError(err)
+
+	_, err = suite.svc.DenyClaim(claim.ID, admin.ID, " ")
+	suite.requireClaimCode(err, apperrors.CodeArtistClaimInvalid)
+
+	denied, err := suite.svc.DenyClaim(claim.ID, admin.ID, "Could not verify")
+	suite.Require().NoError(err)
+	suite.Equal("denied", denied.Status)
+	suite.False(suite.isVerified(artist.ID))
+
+	// A denied claim does not block a new one.
+	_, err = suite.svc.SubmitClaim(artist.ID, user.ID, "Here is our Bandcamp credits page")
+	suite.Require().NoError(err)
+	mine, err := suite.svc.GetUserClaims(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(mine, 2)
+	suite.Equal("pending", mine[0].Status, "newest first")
+}
@@ -35,7 +35,7 @@ func batchArtistUpcomingShowCounts(db *gorm.DB, artistIDs []uint) map[uint]int {
 	db.Table("show_artists").
 		Select("show_artists.artist_id, COUNT(DISTINCT shows.id) AS show_count").
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
-		Where("show_artists.artist_id IN ? AND shows.status = ? AND shows.event_date > NOW()",
+		Where("show_artists.artist_id IN ? AND shows.status = ? AND shows.deleted_at IS NULL AND shows.event_date > NOW()",
 			artistIDs, catalogm.ShowStatusApproved).
 		Group("show_artists.artist_id").
 		Scan(&rows)
@@ -88,7 +88,7 @@ func batchArtistNextShows(db *gorm.DB, artistIDs []uint) map[uint]*contracts.Art
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
 		Joins("LEFT JOIN show_venues ON show_venues.show_id = shows.id").
 		Joins("LEFT JOIN venues ON venues.id = show_venues.venue_id").
-		Where("show_artists.artist_id IN ? AND shows.status = ? AND shows.event_date > NOW()",
+		Where("show_artists.artist_id IN ? AND shows.status = ? AND shows.deleted_at IS NULL AND shows.event_date > NOW()",
 			artistIDs, catalogm.ShowStatusApproved).
 		Order("show_artists.artist_id, shows.event_date ASC, shows.id ASC, show_venues.venue_id ASC").
 		Scan(&rows)
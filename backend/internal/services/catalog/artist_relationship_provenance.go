@@ -173,7 +173,7 @@ func (s *ArtistRelationshipService) sharedShowEntities(a, b uint) ([]contracts.R
 		FROM show_artists sa1
 		JOIN show_artists sa2 ON sa2.show_id = sa1.show_id AND sa2.artist_id = ?
 		JOIN shows s ON s.id = sa1.show_id
-		WHERE sa1.artist_id = ? AND s.status = ?
+		WHERE sa1.artist_id = ? AND s.status = ? AND s.deleted_at IS NULL
 			AND s.slug IS NOT NULL AND s.slug <> ''
 		ORDER BY s.event_date DESC, s.id DESC
 		LIMIT ?
@@ -379,7 +379,7 @@ func (s *ArtistRelationshipService) GetArtistGraph(artistID uint, types []string
 	var centerShowCount int64
 	s.db.Table("show_artists").
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
-		Where("show_artists.artist_id = ? AND shows.status = 'approved' AND shows.event_date > NOW()", artistID).
+		Where("show_artists.artist_id = ? AND shows.status = 'approved' AND shows.deleted_at IS NULL AND shows.event_date > NOW()", artistID).
 		Count(&centerShowCount)
 
 	graph := &contracts.ArtistGraph{
@@ -525,7 +525,7 @@ func (s *ArtistRelationshipService) GetArtistGraph(artistID uint, types []string
 	s.db.Table("show_artists").
 		Select("show_artists.artist_id, COUNT(DISTINCT shows.id) as show_count").
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
-		Where("show_artists.artist_id IN ? AND shows.status = 'approved' AND shows.event_date > NOW()", relatedIDs).
+		Where("show_artists.artist_id IN ? AND shows.status = 'approved' AND shows.deleted_at IS NULL AND shows.event_date > NOW()", relatedIDs).
 		Group("show_artists.artist_id").
 		Scan(&showCounts)
 
@@ -820,7 +820,7 @@ func (s *ArtistRelationshipService) GetArtistBillComposition(artistID uint, mont
 			COALESCE(SUM(CASE WHEN NOT (sa.position = 0 OR sa.set_type = 'headliner') THEN 1 ELSE 0 END), 0) AS opener_count
 		FROM show_artists sa
 		JOIN shows s ON s.id = sa.show_id
-		WHERE sa.artist_id = ? AND s.status = 'approved'
+		WHERE sa.artist_id = ? AND s.status = 'approved' AND s.deleted_at IS NULL
 		  AND (? = 0 OR s.event_date >= NOW() - make_interval(months => ?))
 	`
 
@@ -853,7 +853,7 @@ func (s *ArtistRelationshipService) GetArtistBillComposition(artistID uint, mont
 		FROM show_artists sa1
 		JOIN show_artists sa2 ON sa2.show_id = sa1.show_id AND sa2.artist_id != sa1.artist_id
 		JOIN shows s ON s.id = sa1.show_id
-		WHERE sa1.artist_id = ? AND s.status = 'approved'
+		WHERE sa1.artist_id = ? AND s.status = 'approved' AND s.deleted_at IS NULL
 		  AND (? = 0 OR s.event_date >= NOW() - make_interval(months => ?))
 		GROUP BY sa2.artist_id, a_role, co_role
 		ORDER BY shared_count DESC
@@ -959,7 +959,7 @@ func (s *ArtistRelationshipService) GetArtistBillComposition(artistID uint, mont
 	s.db.Table("show_artists").
 		Select("show_artists.artist_id, COUNT(DISTINCT shows.id) as show_count").
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
-		Where("show_artists.artist_id IN ? AND shows.status = 'approved' AND shows.event_date > NOW()", idList).
+		Where("show_artists.artist_id IN ? AND shows.status = 'approved' AND shows.deleted_at IS NULL AND shows.event_date > NOW()", idList).
 		Group("show_artists.artist_id").
 		Scan(&showCounts)
 	upcomingByID := make(map[uint]int, len(showCounts))
@@ -1014,7 +1014,7 @@ func (s *ArtistRelationshipService) GetArtistBillComposition(artistID uint, mont
 			FROM show_artists sa1
 			JOIN show_artists sa2 ON sa1.show_id = sa2.show_id AND sa1.artist_id < sa2.artist_id
 			JOIN shows s ON s.id = sa1.show_id
-			WHERE sa1.artist_id IN ? AND sa2.artist_id IN ? AND s.status = 'approved'
+			WHERE sa1.artist_id IN ? AND sa2.artist_id IN ? AND s.status = 'approved' AND s.deleted_at IS NULL
 			  AND (? = 0 OR s.event_date >= NOW() - make_interval(months => ?))
 			GROUP BY sa1.artist_id, sa2.artist_id
 			HAVING COUNT(DISTINCT sa1.show_id) >= 1
@@ -1159,7 +1159,7 @@ func (s *ArtistRelationshipService) DeriveSharedBills(minShows int) (int64, erro
 		JOIN show_artists sa2 ON sa1.show_id = sa2.show_id
 			AND sa1.artist_id < sa2.artist_id
 		JOIN shows s ON s.id = sa1.show_id
-		WHERE s.status = 'approved'
+		WHERE s.status = 'approved' AND s.deleted_at IS NULL
 		GROUP BY sa1.artist_id, sa2.artist_id
 		HAVING COUNT(DISTINCT sa1.show_id) >= ?
 	`, minShows).Scan(&rows).Error
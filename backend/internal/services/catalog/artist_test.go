@@ -49,6 +49,8 @@ func (suite *ArtistServiceIntegrationTestSuite) TearDownTest() {
 	_, _ = sqlDB.Exec("DELETE FROM entity_tags")
 	_, _ = sqlDB.Exec("DELETE FROM artist_aliases")
 	_, _ = sqlDB.Exec("DELETE FROM artist_reports")
+	_, _ = sqlDB.Exec("DELETE FROM entity_reports")
+	_, _ = sqlDB.Exec("DELETE FROM slug_redirects")
 	_, _ = sqlDB.Exec("DELETE FROM artist_releases")
 	_, _ = sqlDB.Exec("DELETE FROM artist_labels")
 	_, _ = sqlDB.Exec("DELETE FROM festival_artists")
@@ -394,6 +396,52 @@ func (suite *ArtistServiceIntegrationTestSuite) TestUpdateArtist_BasicFields() {
 	suite.Equal("AZ", *resp.State)
 }
 
+func (suite *ArtistServiceIntegrationTestSuite) TestUpdateArtist_ProfileFields() {
+	created, err := suite.artistService.CreateArtist(&contracts.CreateArtistRequest{
+		Name: "Profile Band",
+		Bio:  stringPtr("  **Loud** <script>alert(1)</script>since 2009\r\n"),
+	})
+	suite.Require().NoError(err)
+	suite.Equal("**Loud** alert(1)since 2009", *created.Bio, "bio is cleaned on write")
+	suite.Equal("<p><strong>Loud</strong> alert(1)since 2009</p>\n", created.BioHTML)
+
+	resp, err := suite.artistService.UpdateArtist(created.ID, &contracts.UpdateArtistRequest{
+		Bio:      stringPtr("<div>   </div>"),
+		Hometown: stringPtr("Flagstaff, AZ"),
+		Pronouns: stringPtr("they/them"),
+	})
+	suite.Require().NoError(err)
+	suite.Nil(resp.Bio, "a bio that cleans to nothing is cleared")
+	suite.Empty(resp.BioHTML)
+	suite.Equal("Flagstaff, AZ", *resp.Hometown)
+	suite.Equal("they/them", *resp.Pronouns)
+}
+
+func (suite *ArtistServiceIntegrationTestSuite) TestUpdateArtist_PhotoReplacesAttribution() {
+	created, err := suite.artistService.CreateArtist(&contracts.CreateArtistRequest{Name: "Photo Band"})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.db.Model(&catalogm.Artist{}).Where("id = ?", created.ID).Updates(map[string]any{
+		"image_url":     "https://upload.wikimedia.org/band.jpg",
+		"image_source":  "commons",
+		"image_license": "CC BY-SA 4.0",
+		"image_author":  "A. Photographer",
+	}).Error)
+
+	resp, err := suite.artistService.UpdateArtist(created.ID, &contracts.UpdateArtistRequest{
+		ImageURL: stringPtr("https://example.com/band.jpg"),
+	})
+	suite.Require().NoError(err)
+	suite.Equal("https://example.com/band.jpg", *resp.ImageURL)
+	suite.Equal("user", *resp.ImageSource)
+	suite.Nil(resp.ImageLicense)
+	suite.Nil(resp.ImageAuthor)
+
+	resp, err = suite.artistService.UpdateArtist(created.ID, &contracts.UpdateArtistRequest{ImageURL: stringPtr("")})
+	suite.Require().NoError(err)
+	suite.Nil(resp.ImageURL)
+	suite.Nil(resp.ImageSource)
+}
+
 func (suite *ArtistServiceIntegrationTestSuite) TestUpdateArtist_NameChangeRegeneratesSlug() {
 	created, err := suite.artistService.CreateArtist(&contracts.CreateArtistRequest{Name: "Old Name Band"})
 	suite.Require().NoError(err)
@@ -1405,6 +1453,109 @@ func (suite *ArtistServiceIntegrationTestSuite) TestMergeArtists_NotificationFil
 	suite.NotContains(artistIDs, fmt.Sprintf("%d", mergeFrom.ID))
 }
 
+func (suite *ArtistServiceIntegrationTestSuite) TestMergeArtists_CopiesMissingMusicLinks() {
+	canonical := suite.createTestArtist("Links Canonical")
+	mergeFrom := suite.createTestArtist("Links MergeFrom")
+	suite.Require().NoError(suite.db.Model(&catalogm.Artist{}).Where("id = ?", canonical.ID).
+		Update("spotify", "https://open.spotify.com/artist/keep").Error)
+	suite.Require().NoError(suite.db.Model(&catalogm.Artist{}).Where("id = ?", mergeFrom.ID).Updates(map[string]interface{}{
+		"spotify":               "https://open.spotify.com/artist/drop",
+		"bandcamp":              "https://links.bandcamp.com",
+		"bandcamp_embed_url":    "https://links.bandcamp.com/album/a",
+		"bandcamp_embed_source": catalogm.BandcampEmbedSourceManual,
+	}).Error)
+
+	result, err := suite.artistService.MergeArtists(canonical.ID, mergeFrom.ID)
+	suite.Require().NoError(err)
+	suite.Equal([]string{"bandcamp", "bandcamp_embed_url"}, result.LinksCopied)
+
+	var got catalogm.Artist
+	suite.Require().NoError(suite.db.First(&got, canonical.ID).Error)
+	suite.Equal("https://open.spotify.com/artist/keep", *got.Social.Spotify, "canonical links win")
+	suite.Equal("https://links.bandcamp.com", *got.Social.Bandcamp)
+	suite.Equal("https://links.bandcamp.com/album/a", *got.BandcampEmbedURL)
+	suite.Equal(catalogm.BandcampEmbedSourceManual, *got.BandcampEmbedSource)
+}
+
+func (suite *ArtistServiceIntegrationTestSuite) TestMergeArtists_RecordsSlugRedirect() {
+	first, _ := suite.artistService.CreateArtist(&contracts.CreateArtistRequest{Name: "Fashion Club (LA)"})
+	second, _ := suite.artistService.CreateArtist(&contracts.CreateArtistRequest{Name: "Fashion Club LA"})
+	canonical, _ := suite.artistService.CreateArtist(&contracts.CreateArtistRequest{Name: "Fashion Club"})
+
+	// Merge first into second, then second into canonical: both old slugs
+	// must point straight at the canonical artist.
+	result, err := suite.artistService.MergeArtists(second.ID, first.ID)
+	suite.Require().NoError(err)
+	suite.True(result.SlugRedirected)
+	_, err = suite.artistService.MergeArtists(canonical.ID, second.ID)
+	suite.Require().NoError(err)
+
+	var redirects []catalogm.SlugRedirect
+	suite.Require().NoError(suite.db.Where("entity_type = ?", catalogm.SlugRedirectEntityArtist).Order("old_slug").Find(&redirects).Error)
+	suite.Require().Len(redirects, 2)
+	for _, r := range redirects {
+		suite.Equal(canonical.ID, r.EntityID)
+	}
+	suite.ElementsMatch([]string{first.Slug, second.Slug}, []string{redirects[0].OldSlug, redirects[1].OldSlug})
+}
+
+func (suite *ArtistServiceIntegrationTestSuite) TestUpdateArtist_RenameKeepsOldSlugResolving() {
+	artist, err := suite.artistService.CreateArtist(&contracts.CreateArtistRequest{Name: "Old Band Name"})
+	suite.Require().NoError(err)
+	oldSlug := artist.Slug
+
+	newName := "New Band Name"
+	updated, err := suite.artistService.UpdateArtist(artist.ID, &contracts.UpdateArtistRequest{Name: &newName})
+	suite.Require().NoError(err)
+	suite.NotEqual(oldSlug, updated.Slug)
+
+	resp, err := suite.artistService.GetArtistBySlug(oldSlug)
+	suite.Require().NoError(err)
+	suite.Equal(artist.ID, resp.ID)
+	suite.Equal(updated.Slug, resp.CanonicalSlug)
+
+	// The live slug is not flagged as a redirect.
+	resp, err = suite.artistService.GetArtistBySlug(updated.Slug)
+	suite.Require().NoError(err)
+	suite.Empty(resp.CanonicalSlug)
+
+	// Renaming back makes the old slug live again and drops its redirect.
+	oldName := "Old Band Name"
+	_, err = suite.artistService.UpdateArtist(artist.ID, &contracts.UpdateArtistRequest{Name: &oldName})
+	suite.Require().NoError(err)
+	resp, err = suite.artistService.GetArtistBySlug(oldSlug)
+	suite.Require().NoError(err)
+	suite.Empty(resp.CanonicalSlug)
+	resp, err = suite.artistService.GetArtistBySlug(updated.Slug)
+	suite.Require().NoError(err)
+	suite.Equal(oldSlug, resp.CanonicalSlug)
+}
+
+func (suite *ArtistServiceIntegrationTestSuite) TestGetArtistBySlug_UnknownSlug() {
+	_, err := suite.artistService.GetArtistBySlug("never-existed")
+	var artistErr *apperrors.ArtistError
+	suite.Require().ErrorAs(err, &artistErr)
+	suite.Equal(apperrors.CodeArtistNotFound, artistErr.Code)
+}
+
+func (suite *ArtistServiceIntegrationTestSuite) TestMergeArtists_TransfersEntityReports() {
+	canonical := suite.createTestArtist("Reports Canonical")
+	mergeFrom := suite.createTestArtist("Reports MergeFrom")
+	user := suite.createTestUser()
+	suite.Require().NoError(suite.db.Exec(
+		"INSERT INTO entity_reports (entity_type, entity_id, reported_by, report_type) VALUES ('artist', ?, ?, 'inaccurate')",
+		mergeFrom.ID, user.ID,
+	).Error)
+
+	result, err := suite.artistService.MergeArtists(canonical.ID, mergeFrom.ID)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), result.ReportsMoved)
+
+	var count int64
+	suite.db.Table("entity_reports").Where("entity_type = 'artist' AND entity_id = ?", canonical.ID).Count(&count)
+	suite.Equal(int64(1), count)
+}
+
 // =============================================================================
 // PSY-639: GetArtist with stats
 // =============================================================================
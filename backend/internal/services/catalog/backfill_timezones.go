@@ -7,6 +7,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"psychic-homily-backend/internal/backfill"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	"psychic-homily-backend/internal/services/geo"
 	"psychic-homily-backend/internal/utils"
@@ -58,6 +59,10 @@ const coordPrecision = 1e6
 type BackfillOptions struct {
 	DryRun  bool
 	Verbose bool
+	// Batch sets batching, rate limiting, progress and resume for both
+	// passes (stages "venues" and "shows"). Its DryRun is ignored in favour
+	// of DryRun above.
+	Batch backfill.Options
 }
 
 // VenueGeoChange records the geocoding outcome for a single venue.
@@ -68,7 +73,7 @@ type VenueGeoChange struct {
 	State   string
 	OldTz   *string
 	NewTz   *string
-	Action  string // "set", "updated", "unchanged", "miss"
+	Action  string // "set", "updated", "unchanged", "miss", "manual"
 }
 
 // ShowReanchorChange records the re-anchor outcome for a single show.
@@ -105,12 +110,19 @@ type BackfillReport struct {
 	ShowChanges     []ShowReanchorChange
 
 	Errors []string
+	// Cursor is where the run stopped; pass it as Batch.ResumeFrom to
+	// continue an interrupted run.
+	Cursor backfill.Cursor
 }
 
 // BackfillVenueTimezones geocodes every venue and re-anchors mis-zoned show
 // instants. With opts.DryRun the report describes exactly what a live run would
 // change without writing anything; the resolved-timezone map is computed the
 // same way in both modes so the dry-run is faithful.
+//
+// Both passes run in id-ordered batches (see package backfill). A run resumed
+// in the show pass has no resolved zones from this run's venue pass; shows
+// then use their venue's stored zone, which the earlier run already wrote.
 func BackfillVenueTimezones(database *gorm.DB, g geo.Geocoder, opts BackfillOptions) (*BackfillReport, error) {
 	if database == nil {
 		return nil, fmt.Errorf("database not initialized")
@@ -127,30 +139,48 @@ func BackfillVenueTimezones(database *gorm.DB, g geo.Geocoder, opts BackfillOpti
 	// zones a live run WOULD have written.
 	effectiveTz := make(map[uint]*string)
 
-	if err := backfillVenuePass(database, g, opts, report, effectiveTz); err != nil {
-		return report, err
-	}
-	if err := reanchorShowPass(database, opts, report, effectiveTz); err != nil {
-		return report, err
+	batch := opts.Batch
+	batch.DryRun = opts.DryRun
+	result, err := backfill.Run(database, batch,
+		backfill.Stage{
+			Name:  "venues",
+			Query: func(db *gorm.DB) *gorm.DB { return db.Model(&catalogm.Venue{}) },
+			Batch: func(tx *gorm.DB, ids []uint) error {
+				return backfillVenuePass(tx, ids, g, opts, report, effectiveTz)
+			},
+		},
+		backfill.Stage{
+			Name:  "shows",
+			Query: func(db *gorm.DB) *gorm.DB { return db.Model(&catalogm.Show{}) },
+			Batch: func(tx *gorm.DB, ids []uint) error {
+				return reanchorShowPass(tx, ids, opts, report, effectiveTz)
+			},
+		},
+	)
+	report.Cursor = result.Cursor
+	if err != nil {
+		// Report the failure alongside what earlier batches committed, so the
+		// operator can see how far the run got and resume from there.
+		report.Errors = append(report.Errors, err.Error())
 	}
-
 	return report, nil
 }
 
-// backfillVenuePass geocodes each venue and (on a live run) writes the resolved
-// latitude/longitude/timezone.
+// backfillVenuePass geocodes a batch of venues and (on a live run) writes the
+// resolved latitude/longitude/timezone.
 func backfillVenuePass(
 	database *gorm.DB,
+	ids []uint,
 	g geo.Geocoder,
 	opts BackfillOptions,
 	report *BackfillReport,
 	effectiveTz map[uint]*string,
 ) error {
 	var venues []catalogm.Venue
-	if err := database.Find(&venues).Error; err != nil {
+	if err := database.Where("id IN ?", ids).Order("id").Find(&venues).Error; err != nil {
 		return fmt.Errorf("load venues: %w", err)
 	}
-	report.VenuesScanned = len(venues)
+	report.VenuesScanned += len(venues)
 
 	for i := range venues {
 		v := &venues[i]
@@ -159,6 +189,19 @@ func backfillVenuePass(
 			country = *v.Country
 		}
 
+		// An admin-set timezone is authoritative: leave the venue as it is.
+		if v.TimezoneManual {
+			effectiveTz[v.ID] = v.Timezone
+			report.VenuesUnchanged++
+			if opts.Verbose {
+				report.VenueChanges = append(report.VenueChanges, VenueGeoChange{
+					VenueID: v.ID, Name: v.Name, City: v.City, State: v.State,
+					OldTz: v.Timezone, NewTz: v.Timezone, Action: "manual",
+				})
+			}
+			continue
+		}
+
 		res, ok := g.Resolve(v.City, v.State, country)
 		if !ok {
 			// Leave existing values untouched — the backfill only adds data.
@@ -219,14 +262,17 @@ func backfillVenuePass(
 			continue
 		}
 		// Plain values, not pointers: a geocode hit always yields all three, and
-		// GORM's map-Updates rejects pointer values with "invalid field".
-		if err := database.Model(&catalogm.Venue{}).
-			Where("id = ?", v.ID).
-			Updates(map[string]interface{}{
-				"latitude":  newLat,
-				"longitude": newLng,
-				"timezone":  newTz,
-			}).Error; err != nil {
+		// GORM's map-Updates rejects pointer values with "invalid field". The
+		// savepoint keeps one failed update from aborting the batch.
+		if err := database.Transaction(func(tx *gorm.DB) error {
+			return tx.Model(&catalogm.Venue{}).
+				Where("id = ?", v.ID).
+				Updates(map[string]interface{}{
+					"latitude":  newLat,
+					"longitude": newLng,
+					"timezone":  newTz,
+				}).Error
+		}); err != nil {
 			report.Errors = append(report.Errors, fmt.Sprintf("venue %d update: %v", v.ID, err))
 		}
 	}
@@ -234,19 +280,21 @@ func backfillVenuePass(
 	return nil
 }
 
-// reanchorShowPass re-anchors show instants that were stored under a wrong
-// assumed timezone, using the freshly resolved venue zones in effectiveTz.
+// reanchorShowPass re-anchors a batch of show instants that were stored under
+// a wrong assumed timezone, using the freshly resolved venue zones in
+// effectiveTz (or the stored zone of a venue this run didn't resolve).
 func reanchorShowPass(
 	database *gorm.DB,
+	ids []uint,
 	opts BackfillOptions,
 	report *BackfillReport,
 	effectiveTz map[uint]*string,
 ) error {
 	var shows []catalogm.Show
-	if err := database.Preload("Venues").Find(&shows).Error; err != nil {
+	if err := database.Preload("Venues").Where("id IN ?", ids).Order("id").Find(&shows).Error; err != nil {
 		return fmt.Errorf("load shows: %w", err)
 	}
-	report.ShowsScanned = len(shows)
+	report.ShowsScanned += len(shows)
 
 	for i := range shows {
 		show := &shows[i]
@@ -257,7 +305,10 @@ func reanchorShowPass(
 			continue
 		}
 		venueID := primary.ID
-		tzPtr := effectiveTz[venueID]
+		tzPtr, resolved := effectiveTz[venueID]
+		if !resolved {
+			tzPtr = primary.Timezone
+		}
 		if tzPtr == nil || *tzPtr == "" {
 			report.ShowsNoVenueTz++
 			if opts.Verbose {
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"psychic-homily-backend/internal/httpclient"
 	"psychic-homily-backend/internal/utils"
 )
 
@@ -104,7 +105,8 @@ func NewBandcampProfileResolverWithClient(client *http.Client) *BandcampProfileR
 // host, so this allows that hop while refusing a cross-host one.
 func newBandcampResolverClient() *http.Client {
 	return &http.Client{
-		Timeout: bandcampFetchTimeout,
+		Timeout:   bandcampFetchTimeout,
+		Transport: httpclient.Transport(),
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			if len(via) >= 10 {
 				return fmt.Errorf("stopped after 10 redirects")
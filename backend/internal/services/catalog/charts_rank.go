@@ -147,7 +147,7 @@ func (s *ChartsService) mostActiveArtistRank(artistID uint, window contracts.Cha
 		FROM show_artists sa
 		JOIN artists a ON a.id = sa.artist_id
 		JOIN shows s ON s.id = sa.show_id
-		WHERE s.status = ?`
+		WHERE s.status = ? AND s.deleted_at IS NULL`
 	coreArgs := []any{catalogm.ShowStatusApproved}
 	coreSQL, coreArgs = appendChartShowWindow(coreSQL, coreArgs, bounds)
 	// Same GROUP BY columns as getMostActiveArtistsUncached so the ranked
@@ -193,7 +193,7 @@ func (s *ChartsService) busiestVenueRank(venueID uint, window contracts.ChartWin
 		FROM show_venues sv
 		JOIN venues v ON v.id = sv.venue_id
 		JOIN shows s ON s.id = sv.show_id
-		WHERE s.status = ?`
+		WHERE s.status = ? AND s.deleted_at IS NULL`
 	coreArgs := []any{catalogm.ShowStatusApproved}
 	coreSQL, coreArgs = appendChartShowWindow(coreSQL, coreArgs, bounds)
 	coreSQL += `
@@ -11,6 +11,7 @@ import (
 	engagementm "psychic-homily-backend/internal/models/engagement"
 	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/services/geo"
+	"psychic-homily-backend/internal/services/shared"
 )
 
 // ChartsService computes top charts / trending content from engagement signals.
@@ -84,7 +85,7 @@ func (s *ChartsService) GetTrendingShows(limit int) ([]contracts.TrendingShow, e
 		LEFT JOIN user_bookmarks ub ON ub.entity_id = s.id
 			AND ub.entity_type = ?
 			AND ub.action = ?
-		WHERE s.status = ?
+		WHERE s.status = ? AND s.deleted_at IS NULL
 			AND s.event_date >= ?
 		GROUP BY s.id, v.name, v.slug, v.city
 		ORDER BY save_count DESC, s.event_date ASC
@@ -292,7 +293,7 @@ const (
 	// are most likely to act on. Same start-of-today idea as
 	// GetUpcomingShows, but fixed to UTC (a public chart has no requester
 	// timezone to resolve against).
-	mostAnticipatedEligibilitySQL = `WHERE s.status = ?
+	mostAnticipatedEligibilitySQL = `WHERE s.status = ? AND s.deleted_at IS NULL
 			AND s.is_cancelled = FALSE
 			AND s.event_date >= ?`
 )
@@ -742,7 +743,7 @@ func (s *ChartsService) getMostActiveArtistsUncached(window contracts.ChartWindo
 		FROM show_artists sa
 		JOIN artists a ON a.id = sa.artist_id
 		JOIN shows s ON s.id = sa.show_id
-		WHERE s.status = ?`
+		WHERE s.status = ? AND s.deleted_at IS NULL`
 	coreArgs := []any{catalogm.ShowStatusApproved}
 	coreSQL, coreArgs = appendChartShowWindow(coreSQL, coreArgs, bounds)
 	coreSQL, coreArgs = appendEntityMetroScope(coreSQL, coreArgs, "a", scene)
@@ -811,7 +812,7 @@ func (s *ChartsService) getMostActiveArtistsUncached(window contracts.ChartWindo
 			LEFT JOIN show_venues sv ON sv.show_id = s.id
 			LEFT JOIN venues v ON v.id = sv.venue_id
 			WHERE sa.artist_id IN ?
-				AND s.status = ?`
+				AND s.status = ? AND s.deleted_at IS NULL`
 		lastArgs := []any{artistIDs, catalogm.ShowStatusApproved}
 		lastQuery, lastArgs = appendChartShowWindow(lastQuery, lastArgs, bounds)
 		// s.id and v.name tiebreaks keep the picked row deterministic when an
@@ -887,7 +888,7 @@ func (s *ChartsService) getBusiestVenuesUncached(window contracts.ChartWindow, s
 		FROM show_venues sv
 		JOIN venues v ON v.id = sv.venue_id
 		JOIN shows s ON s.id = sv.show_id
-		WHERE s.status = ?`
+		WHERE s.status = ? AND s.deleted_at IS NULL`
 	// COUNT(*) == COUNT(DISTINCT s.id) here: show_venues' composite PK
 	// (show_id, venue_id) guarantees one row per show within a venue group.
 	coreArgs := []any{catalogm.ShowStatusApproved}
@@ -984,7 +985,7 @@ func (s *ChartsService) getOpenersToWatchUncached(window contracts.ChartWindow,
 		FROM show_artists sa
 		JOIN artists a ON a.id = sa.artist_id
 		JOIN shows s ON s.id = sa.show_id
-		WHERE s.status = ?`
+		WHERE s.status = ? AND s.deleted_at IS NULL`
 	coreArgs := []any{catalogm.ShowStatusApproved}
 	coreSQL, coreArgs = appendChartShowWindow(coreSQL, coreArgs, bounds)
 	// Artist-home scoping filters WHICH artists appear without touching their
@@ -1204,7 +1205,7 @@ func (s *ChartsService) getTopTagsUncached(window contracts.ChartWindow, scene s
 		LEFT JOIN user_bookmarks ub ON ub.entity_id = s.id
 			AND ub.entity_type = ?
 			AND ub.action = ?
-		WHERE s.status = ?`
+		WHERE s.status = ? AND s.deleted_at IS NULL`
 	showSavesArgs := []any{
 		engagementm.BookmarkEntityShow,
 		engagementm.BookmarkActionSave,
@@ -1311,7 +1312,7 @@ func (s *ChartsService) GetPopularArtists(limit int) ([]contracts.PopularArtist,
 			SELECT sa.artist_id, COUNT(DISTINCT s.id) AS cnt
 			FROM show_artists sa
 			JOIN shows s ON s.id = sa.show_id
-			WHERE s.status = ? AND s.event_date >= ?
+			WHERE s.status = ? AND s.deleted_at IS NULL AND s.event_date >= ?
 			GROUP BY sa.artist_id
 		) show_counts ON show_counts.artist_id = a.id
 		WHERE (COALESCE(follow_counts.cnt, 0) > 0 OR COALESCE(show_counts.cnt, 0) > 0)
@@ -1376,7 +1377,7 @@ func (s *ChartsService) GetActiveVenues(limit int) ([]contracts.ActiveVenue, err
 			SELECT sv.venue_id, COUNT(DISTINCT s.id) AS cnt
 			FROM show_venues sv
 			JOIN shows s ON s.id = sv.show_id
-			WHERE s.status = ? AND s.event_date >= ?
+			WHERE s.status = ? AND s.deleted_at IS NULL AND s.event_date >= ?
 			GROUP BY sv.venue_id
 		) show_counts ON show_counts.venue_id = v.id
 		LEFT JOIN (
@@ -1802,7 +1803,7 @@ func (s *ChartsService) getChartsSummaryUncached(window contracts.ChartWindow, s
 		SELECT
 		(SELECT COUNT(*)
 			FROM shows s
-			WHERE s.status = ?
+			WHERE s.status = ? AND s.deleted_at IS NULL
 				AND s.is_cancelled = FALSE`
 	args = append(args, catalogm.ShowStatusApproved)
 	query, args = appendWindowBounds(query, args, "s.created_at", bounds)
@@ -1851,7 +1852,7 @@ func (s *ChartsService) getChartsSummaryUncached(window contracts.ChartWindow, s
 			FROM shows s
 			JOIN show_venues sv ON sv.show_id = s.id
 			JOIN venues v ON v.id = sv.venue_id
-			WHERE s.status = ?
+			WHERE s.status = ? AND s.deleted_at IS NULL
 			  ` + sceneVenueEligibilitySQL
 	args = append(args, catalogm.ShowStatusApproved)
 	query, args = appendChartShowWindow(query, args, bounds)
@@ -1914,7 +1915,7 @@ func (s *ChartsService) getCommunityPulseUncached() (*contracts.CommunityPulse,
 		SELECT
 			(SELECT COUNT(*)
 				FROM shows s
-				WHERE s.status = ?
+				WHERE s.status = ? AND s.deleted_at IS NULL
 					AND s.is_cancelled = FALSE
 					AND s.event_date >= ?
 					AND s.event_date < ?
@@ -1922,7 +1923,7 @@ func (s *ChartsService) getCommunityPulseUncached() (*contracts.CommunityPulse,
 			(
 				(SELECT COUNT(*) FROM artists) +
 				(SELECT COUNT(*) FROM venues) +
-				(SELECT COUNT(*) FROM shows WHERE status = ?) +
+				(SELECT COUNT(*) FROM shows WHERE status = ? AND deleted_at IS NULL) +
 				(SELECT COUNT(*) FROM releases) +
 				(SELECT COUNT(*) FROM labels) +
 				(SELECT COUNT(*) FROM festivals)
@@ -1938,6 +1939,71 @@ func (s *ChartsService) getCommunityPulseUncached() (*contracts.CommunityPulse,
 	}, nil
 }
 
+// GetPublicStats returns the homepage hero numbers: upcoming shows, verified
+// venues, artists, and this week's shows per city. The show windows match
+// GetCommunityPulse (start-of-today UTC, sceneThisWeekDays long), so the
+// per-city counts add up to its ShowsThisWeek less the shows without a
+// city. Viewer-independent; cached on the masthead cache like the pulse.
+func (s *ChartsService) GetPublicStats() (*contracts.PublicStats, error) {
+	return chartsCached(s.mastheadCache, "public-stats", chartsMastheadTTL, func() (*contracts.PublicStats, error) {
+		return s.getPublicStatsUncached()
+	})
+}
+
+func (s *ChartsService) getPublicStatsUncached() (*contracts.PublicStats, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	startOfToday := time.Now().UTC().Truncate(24 * time.Hour)
+	weekAhead := startOfToday.AddDate(0, 0, sceneThisWeekDays)
+
+	var totals struct {
+		UpcomingShows int `gorm:"column:upcoming_shows"`
+		Venues        int `gorm:"column:venues"`
+		Artists       int `gorm:"column:artists"`
+	}
+	err := s.db.Raw(`
+		SELECT
+			(SELECT COUNT(*)
+				FROM shows s
+				WHERE s.status = ? AND s.deleted_at IS NULL
+					AND s.is_cancelled = FALSE
+					AND s.event_date >= ?
+			) AS upcoming_shows,
+			(SELECT COUNT(*) FROM venues WHERE verified = TRUE) AS venues,
+			(SELECT COUNT(*) FROM artists) AS artists
+	`, catalogm.ShowStatusApproved, startOfToday).Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public stats: %w", err)
+	}
+
+	// The show's own city/state, not its venues': a multi-venue show
+	// counts once.
+	cities := []contracts.CityShowCount{}
+	err = s.db.Raw(`
+		SELECT s.city, COALESCE(s.state, '') AS state, COUNT(*) AS shows
+		FROM shows s
+		WHERE s.status = ? AND s.deleted_at IS NULL
+			AND s.is_cancelled = FALSE
+			AND s.event_date >= ?
+			AND s.event_date < ?
+			AND COALESCE(s.city, '') <> ''
+		GROUP BY s.city, COALESCE(s.state, '')
+		ORDER BY shows DESC, s.city, state
+	`, catalogm.ShowStatusApproved, startOfToday, weekAhead).Scan(&cities).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shows this week by city: %w", err)
+	}
+
+	return &contracts.PublicStats{
+		UpcomingShows:       totals.UpcomingShows,
+		Venues:              totals.Venues,
+		Artists:             totals.Artists,
+		ShowsThisWeekByCity: cities,
+	}, nil
+}
+
 // GetFreshlyAdded returns the most recently added entities across types
 // (artist/venue/release/station) interleaved newest-first — the footer
 // ticker. Each branch pre-limits to the requested size before the global
@@ -2010,7 +2076,7 @@ func (s *ChartsService) getFreshlyAddedUncached(scene string, limit int) ([]cont
 			(SELECT 'artist' AS entity_type, a.id AS entity_id, a.name, COALESCE(a.slug, '') AS slug, a.created_at AS added_at
 			 FROM artists a
 			 WHERE (EXISTS (SELECT 1 FROM show_artists sa JOIN shows s ON s.id = sa.show_id
-				WHERE sa.artist_id = a.id AND s.status = ? AND s.is_cancelled = FALSE)
+				WHERE sa.artist_id = a.id AND s.status = ? AND s.deleted_at IS NULL AND s.is_cancelled = FALSE)
 				OR EXISTS (SELECT 1 FROM artist_releases ar WHERE ar.artist_id = a.id)
 				OR EXISTS (SELECT 1 FROM radio_plays rp WHERE rp.artist_id = a.id))` + artistScene + `
 			 ORDER BY a.created_at DESC, a.id DESC LIMIT ?)
@@ -2132,7 +2198,7 @@ func (s *ChartsService) getChartScenesUncached(window contracts.ChartWindow) ([]
 		FROM venues v
 		JOIN show_venues sv ON sv.venue_id = v.id
 		JOIN shows s ON s.id = sv.show_id
-		WHERE s.status = ?
+		WHERE s.status = ? AND s.deleted_at IS NULL
 		  AND v.metro IS NOT NULL
 		  ` + sceneVenueEligibilitySQL
 	args := []any{catalogm.ShowStatusApproved}
@@ -2261,7 +2327,7 @@ func (s *ChartsService) GetPersonalChartsStats(userID uint) (*contracts.Personal
 			tv.saved_show_count
 		FROM (
 			SELECT
-				COUNT(*) FILTER (WHERE entity_type = ? AND action = ?) AS saved_shows,
+				COUNT(*) FILTER (WHERE entity_type = ? AND action = ? AND `+shared.LiveShowBookmarkSQL("user_bookmarks")+`) AS saved_shows,
 				COUNT(*) FILTER (WHERE entity_type = ? AND action = ?) AS artists_followed,
 				COUNT(*) FILTER (WHERE entity_type = ? AND action = ?) AS venues_followed,
 				COUNT(*) FILTER (WHERE entity_type = ? AND action = ?) AS labels_followed,
@@ -2281,6 +2347,7 @@ func (s *ChartsService) GetPersonalChartsStats(userID uint) (*contracts.Personal
 			JOIN LATERAL `+primaryVenueLateralSQL(
 		"iv.id AS venue_id, iv.name AS venue_name, COALESCE(iv.slug, '') AS venue_slug", "ub.entity_id")+` v ON TRUE
 			WHERE ub.user_id = ? AND ub.entity_type = ? AND ub.action = ?
+			  AND `+shared.LiveShowBookmarkSQL("ub")+`
 			GROUP BY v.venue_id, v.venue_name, v.venue_slug
 			ORDER BY saved_show_count DESC, v.venue_name ASC, v.venue_id ASC
 			LIMIT 1
@@ -2369,6 +2436,7 @@ func (s *ChartsService) personalTopScenes(userID uint, limit int) ([]contracts.P
 			JOIN LATERAL `+primaryVenueLateralSQL(
 		"iv.metro, iv.city, iv.state", "ub.entity_id")+` v ON TRUE
 			WHERE ub.user_id = ? AND ub.entity_type = ? AND ub.action = ?
+			  AND `+shared.LiveShowBookmarkSQL("ub")+`
 			  AND v.metro IS NOT NULL AND v.metro <> ''
 			GROUP BY v.metro
 			UNION ALL
@@ -2479,6 +2547,7 @@ func (s *ChartsService) personalTopTags(userID uint, limit int) ([]contracts.Per
 			JOIN show_artists sa ON sa.show_id = ub.entity_id
 			JOIN entity_tags et ON et.entity_type = ? AND et.entity_id = sa.artist_id
 			WHERE ub.user_id = ? AND ub.entity_type = ? AND ub.action = ?
+			  AND `+shared.LiveShowBookmarkSQL("ub")+`
 			GROUP BY et.tag_id
 			UNION ALL
 			SELECT et.tag_id, COUNT(DISTINCT ub.entity_id)::int AS cnt
@@ -2536,6 +2605,7 @@ func (s *ChartsService) personalTopArtists(userID uint, limit int) ([]contracts.
 			FROM user_bookmarks ub
 			JOIN show_artists sa ON sa.show_id = ub.entity_id
 			WHERE ub.user_id = ? AND ub.entity_type = ? AND ub.action = ?
+			  AND `+shared.LiveShowBookmarkSQL("ub")+`
 			GROUP BY sa.artist_id
 			UNION ALL
 			SELECT ub.entity_id AS artist_id, 1 AS cnt
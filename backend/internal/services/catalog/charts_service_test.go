@@ -2008,6 +2008,47 @@ func (suite *ChartsServiceIntegrationTestSuite) TestGetCommunityPulse_ShowsThisW
 	suite.Equal(9, pulse.EntitiesInGraph)
 }
 
+func (suite *ChartsServiceIntegrationTestSuite) TestGetPublicStats_Empty() {
+	stats, err := suite.chartsService.GetPublicStats()
+	suite.Require().NoError(err)
+	suite.Equal(&contracts.PublicStats{ShowsThisWeekByCity: []contracts.CityShowCount{}}, stats)
+}
+
+func (suite *ChartsServiceIntegrationTestSuite) TestGetPublicStats_CountsAndCities() {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	user := suite.createUser("public-stats@test.com")
+	venue := suite.createVenue("Public Venue", "Phoenix", "AZ")
+	suite.Require().NoError(suite.db.Model(venue).Update("verified", true).Error)
+	suite.createVenue("Unverified Venue", "Tucson", "AZ")
+	artist := suite.createArtist("Public Artist")
+	suite.createArtist("Second Artist")
+
+	suite.createApprovedShow("Phoenix A", venue.ID, artist.ID, user.ID, today)
+	suite.createApprovedShow("Phoenix B", venue.ID, artist.ID, user.ID, today.AddDate(0, 0, 6))
+	tucson := suite.createApprovedShow("Tucson", venue.ID, artist.ID, user.ID, today.AddDate(0, 0, 1))
+	suite.Require().NoError(suite.db.Model(tucson).Update("city", "Tucson").Error)
+	noCity := suite.createApprovedShow("No City", venue.ID, artist.ID, user.ID, today.AddDate(0, 0, 2))
+	suite.Require().NoError(suite.db.Model(noCity).Update("city", nil).Error)
+	// Upcoming, but outside this week.
+	suite.createApprovedShow("Next Week", venue.ID, artist.ID, user.ID, today.AddDate(0, 0, 7))
+	// Never counted.
+	suite.createApprovedShow("Past", venue.ID, artist.ID, user.ID, today.AddDate(0, 0, -1))
+	pending := suite.createApprovedShow("Pending", venue.ID, artist.ID, user.ID, today.AddDate(0, 0, 2))
+	suite.Require().NoError(suite.db.Model(pending).Update("status", catalogm.ShowStatusPending).Error)
+	cancelled := suite.createApprovedShow("Cancelled", venue.ID, artist.ID, user.ID, today.AddDate(0, 0, 3))
+	suite.Require().NoError(suite.db.Model(cancelled).Update("is_cancelled", true).Error)
+
+	stats, err := suite.chartsService.GetPublicStats()
+	suite.Require().NoError(err)
+	suite.Equal(5, stats.UpcomingShows, "approved non-cancelled shows from start-of-today")
+	suite.Equal(1, stats.Venues, "verified venues only")
+	suite.Equal(2, stats.Artists)
+	suite.Equal([]contracts.CityShowCount{
+		{City: "Phoenix", State: "AZ", Shows: 2},
+		{City: "Tucson", State: "AZ", Shows: 1},
+	}, stats.ShowsThisWeekByCity)
+}
+
 func (suite *ChartsServiceIntegrationTestSuite) TestGetChartsSummary_CalendarQuarterCreatedAtBoundaries() {
 	user := suite.createUser("summary-calendar@test.com")
 	venue := suite.createVenue("Summary Calendar Venue", "Phoenix", "AZ")
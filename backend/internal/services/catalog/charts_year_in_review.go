@@ -0,0 +1,135 @@
+package catalog
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Year-in-review recap for the end-of-year page. The year is treated as the
+// calendar ChartWindow "YYYY", so the show window, the "on/before now" cap on
+// the current year, and the cache TTL (closed years hold for a day) are the
+// same definitions every calendar chart uses.
+
+// GetYearInReview returns the community recap for year, cached in the module
+// tier under one key per year.
+func (s *ChartsService) GetYearInReview(year int) (*contracts.YearInReview, error) {
+	window := contracts.ChartWindow(strconv.Itoa(year))
+	ttl := chartWindowTTL(s.cache, window, chartsModuleTTL)
+	return chartsCached(s.cache, "year-in-review|"+string(window), ttl, func() (*contracts.YearInReview, error) {
+		return s.getYearInReview(year, nil)
+	})
+}
+
+// GetPersonalYearInReview returns the recap over the shows userID saved.
+// Per-user, so deliberately uncached like GetPersonalChartsStats.
+func (s *ChartsService) GetPersonalYearInReview(userID uint, year int) (*contracts.YearInReview, error) {
+	return s.getYearInReview(year, &userID)
+}
+
+func (s *ChartsService) getYearInReview(year int, userID *uint) (*contracts.YearInReview, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	window := contracts.ChartWindow(strconv.Itoa(year))
+	if _, _, ok := window.CalendarBounds(); !ok {
+		return nil, fmt.Errorf("invalid year %d", year)
+	}
+
+	// scopeSQL is the shared FROM/WHERE over the year's shows (aliased s);
+	// every aggregate below builds on it so the totals can't disagree.
+	scopeSQL := `
+		FROM shows s
+		WHERE s.status = ? AND s.deleted_at IS NULL`
+	scopeArgs := []any{catalogm.ShowStatusApproved}
+	scopeSQL, scopeArgs = appendChartShowWindow(scopeSQL, scopeArgs, chartWindowBounds(window, time.Now().UTC()))
+	if userID != nil {
+		scopeSQL += `
+			AND EXISTS (
+				SELECT 1 FROM user_bookmarks ub
+				WHERE ub.entity_id = s.id AND ub.user_id = ? AND ub.entity_type = ? AND ub.action = ?
+			)`
+		scopeArgs = append(scopeArgs, *userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave)
+	}
+
+	review := &contracts.YearInReview{Year: year, Cities: []contracts.YearInReviewCity{}}
+
+	// event_date is a UTC wall-clock TIMESTAMP, so EXTRACT needs no zone.
+	type monthRow struct {
+		Month     int `gorm:"column:month"`
+		ShowCount int `gorm:"column:show_count"`
+	}
+	var months []monthRow
+	if err := s.db.Raw(`
+		SELECT EXTRACT(MONTH FROM s.event_date)::int AS month, COUNT(*) AS show_count`+scopeSQL+`
+		GROUP BY month
+		ORDER BY show_count DESC, month ASC`, scopeArgs...).Scan(&months).Error; err != nil {
+		return nil, fmt.Errorf("failed to get year in review months: %w", err)
+	}
+	for _, m := range months {
+		review.TotalShows += m.ShowCount
+	}
+	if len(months) == 0 {
+		return review, nil
+	}
+	review.BusiestMonth = &contracts.YearInReviewMonth{Month: months[0].Month, ShowCount: months[0].ShowCount}
+
+	type leaderRow struct {
+		ID        uint   `gorm:"column:id"`
+		Name      string `gorm:"column:name"`
+		Slug      string `gorm:"column:slug"`
+		ShowCount int    `gorm:"column:show_count"`
+	}
+
+	// COUNT(*) is per show: show_venues / show_artists composite PKs allow
+	// one row per (show, entity).
+	var venues []leaderRow
+	if err := s.db.Raw(`
+		SELECT v.id, v.name, COALESCE(v.slug, '') AS slug, COUNT(*) AS show_count
+		FROM show_venues sv
+		JOIN venues v ON v.id = sv.venue_id
+		WHERE sv.show_id IN (SELECT s.id`+scopeSQL+`)
+		GROUP BY v.id, v.name, v.slug
+		ORDER BY show_count DESC, v.name ASC, v.id ASC
+		LIMIT 1`, scopeArgs...).Scan(&venues).Error; err != nil {
+		return nil, fmt.Errorf("failed to get year in review venue: %w", err)
+	}
+	if len(venues) > 0 {
+		v := contracts.YearInReviewVenue(venues[0])
+		review.MostActiveVenue = &v
+	}
+
+	var artists []leaderRow
+	if err := s.db.Raw(`
+		SELECT a.id, a.name, COALESCE(a.slug, '') AS slug, COUNT(*) AS show_count
+		FROM show_artists sa
+		JOIN artists a ON a.id = sa.artist_id
+		WHERE sa.show_id IN (SELECT s.id`+scopeSQL+`)
+		GROUP BY a.id, a.name, a.slug
+		ORDER BY show_count DESC, a.name ASC, a.id ASC
+		LIMIT 1`, scopeArgs...).Scan(&artists).Error; err != nil {
+		return nil, fmt.Errorf("failed to get year in review artist: %w", err)
+	}
+	if len(artists) > 0 {
+		a := contracts.YearInReviewArtist(artists[0])
+		review.MostBookedArtist = &a
+	}
+
+	var cities []contracts.YearInReviewCity
+	if err := s.db.Raw(`
+		SELECT s.city, COALESCE(s.state, '') AS state, COUNT(*) AS show_count`+scopeSQL+`
+			AND COALESCE(s.city, '') <> ''
+		GROUP BY s.city, s.state
+		ORDER BY show_count DESC, s.city ASC, state ASC`, scopeArgs...).Scan(&cities).Error; err != nil {
+		return nil, fmt.Errorf("failed to get year in review cities: %w", err)
+	}
+	if cities != nil {
+		review.Cities = cities
+	}
+
+	return review, nil
+}
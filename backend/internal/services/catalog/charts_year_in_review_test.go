@@ -0,0 +1,95 @@
+package catalog
+
+// Year-in-review recap. Integration methods hang off
+// ChartsServiceIntegrationTestSuite (charts_service_test.go).
+
+import (
+	"testing"
+	"time"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+)
+
+func TestGetYearInReview_NilDatabase(t *testing.T) {
+	svc := &ChartsService{}
+
+	if _, err := svc.GetYearInReview(2025); err == nil {
+		t.Error("expected error for nil database")
+	}
+	if _, err := svc.GetPersonalYearInReview(1, 2025); err == nil {
+		t.Error("expected error for nil database")
+	}
+}
+
+func (suite *ChartsServiceIntegrationTestSuite) TestYearInReview_Aggregates() {
+	user := suite.createUser("yir@test.com")
+	bar := suite.createVenue("Valley Bar", "Phoenix", "AZ")
+	club := suite.createVenue("Club Congress", "Tucson", "AZ")
+	band := suite.createArtist("Band")
+	other := suite.createArtist("Other")
+
+	suite.createApprovedShow("A", bar.ID, band.ID, user.ID, time.Date(2024, 3, 2, 20, 0, 0, 0, time.UTC))
+	suite.createApprovedShow("B", bar.ID, band.ID, user.ID, time.Date(2024, 3, 20, 20, 0, 0, 0, time.UTC))
+	tucson := suite.createApprovedShow("C", club.ID, other.ID, user.ID, time.Date(2024, 7, 4, 20, 0, 0, 0, time.UTC))
+	suite.Require().NoError(suite.db.Model(tucson).Update("city", "Tucson").Error)
+
+	// Out of scope: other years, cancelled, and unapproved shows.
+	suite.createApprovedShow("Last Year", club.ID, other.ID, user.ID, time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC))
+	suite.createApprovedShow("Next Year", club.ID, other.ID, user.ID, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cancelled := suite.createApprovedShow("Cancelled", club.ID, other.ID, user.ID, time.Date(2024, 7, 5, 20, 0, 0, 0, time.UTC))
+	suite.Require().NoError(suite.db.Model(cancelled).Update("is_cancelled", true).Error)
+	pending := suite.createApprovedShow("Pending", club.ID, other.ID, user.ID, time.Date(2024, 7, 6, 20, 0, 0, 0, time.UTC))
+	suite.Require().NoError(suite.db.Model(pending).Update("status", catalogm.ShowStatusPending).Error)
+
+	review, err := suite.chartsService.GetYearInReview(2024)
+	suite.Require().NoError(err)
+	suite.Equal(2024, review.Year)
+	suite.Equal(3, review.TotalShows)
+	suite.Require().NotNil(review.MostActiveVenue)
+	suite.Equal(bar.ID, review.MostActiveVenue.ID)
+	suite.Equal(2, review.MostActiveVenue.ShowCount)
+	suite.Require().NotNil(review.MostBookedArtist)
+	suite.Equal(band.ID, review.MostBookedArtist.ID)
+	suite.Require().NotNil(review.BusiestMonth)
+	suite.Equal(3, review.BusiestMonth.Month)
+	suite.Equal(2, review.BusiestMonth.ShowCount)
+	suite.Require().Len(review.Cities, 2)
+	suite.Equal("Phoenix", review.Cities[0].City)
+	suite.Equal(2, review.Cities[0].ShowCount)
+	suite.Equal("Tucson", review.Cities[1].City)
+}
+
+func (suite *ChartsServiceIntegrationTestSuite) TestYearInReview_EmptyYear() {
+	review, err := suite.chartsService.GetYearInReview(2010)
+	suite.Require().NoError(err)
+	suite.Equal(0, review.TotalShows)
+	suite.Nil(review.MostActiveVenue)
+	suite.Nil(review.MostBookedArtist)
+	suite.Nil(review.BusiestMonth)
+	suite.NotNil(review.Cities)
+	suite.Empty(review.Cities)
+}
+
+func (suite *ChartsServiceIntegrationTestSuite) TestPersonalYearInReview_OnlySavedShows() {
+	user := suite.createUser("yir-personal@test.com")
+	bar := suite.createVenue("Valley Bar", "Phoenix", "AZ")
+	club := suite.createVenue("Club Congress", "Tucson", "AZ")
+	band := suite.createArtist("Band")
+	other := suite.createArtist("Other")
+
+	saved := suite.createApprovedShow("Saved", club.ID, other.ID, user.ID, time.Date(2024, 9, 1, 20, 0, 0, 0, time.UTC))
+	going := suite.createApprovedShow("Going Only", bar.ID, band.ID, user.ID, time.Date(2024, 9, 2, 20, 0, 0, 0, time.UTC))
+	suite.createApprovedShow("Unsaved", bar.ID, band.ID, user.ID, time.Date(2024, 9, 3, 20, 0, 0, 0, time.UTC))
+	suite.createBookmark(user.ID, engagementm.BookmarkEntityShow, saved.ID, engagementm.BookmarkActionSave)
+	suite.createBookmark(user.ID, engagementm.BookmarkEntityShow, going.ID, engagementm.BookmarkActionGoing)
+
+	review, err := suite.chartsService.GetPersonalYearInReview(user.ID, 2024)
+	suite.Require().NoError(err)
+	suite.Equal(1, review.TotalShows)
+	suite.Require().NotNil(review.MostActiveVenue)
+	suite.Equal(club.ID, review.MostActiveVenue.ID)
+	suite.Require().NotNil(review.MostBookedArtist)
+	suite.Equal(other.ID, review.MostBookedArtist.ID)
+	suite.Equal(9, review.BusiestMonth.Month)
+}
@@ -12,6 +12,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 // Wikimedia Commons client for artist-photo enrichment (PSY-1232).
@@ -45,7 +47,7 @@ type CommonsClient struct {
 // NewCommonsClient builds a production client pointed at the real Commons API.
 func NewCommonsClient() *CommonsClient {
 	return &CommonsClient{
-		httpClient:  &http.Client{Timeout: commonsTimeout},
+		httpClient:  httpclient.New(commonsTimeout),
 		baseURL:     commonsBaseURL,
 		rateLimiter: time.NewTicker(commonsRateLimit),
 	}
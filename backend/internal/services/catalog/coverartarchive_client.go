@@ -9,6 +9,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 // Cover Art Archive client for the image-enrichment backfill (PSY-1216).
@@ -51,7 +53,7 @@ type CoverArtArchiveClient struct {
 // Archive + the real MusicBrainz site.
 func NewCoverArtArchiveClient() *CoverArtArchiveClient {
 	return &CoverArtArchiveClient{
-		httpClient:  &http.Client{Timeout: caaTimeout},
+		httpClient:  httpclient.New(caaTimeout),
 		baseURL:     caaBaseURL,
 		mbWebURL:    mbWebBaseURL,
 		rateLimiter: time.NewTicker(caaRateLimit),
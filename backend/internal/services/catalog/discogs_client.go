@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 // Discogs database client for the image-enrichment backfill (PSY-1216).
@@ -56,7 +58,7 @@ type DiscogsClient struct {
 // + the 60/min authenticated rate).
 func NewDiscogsClient(token string) *DiscogsClient {
 	return &DiscogsClient{
-		httpClient:  &http.Client{Timeout: discogsTimeout},
+		httpClient:  httpclient.New(discogsTimeout),
 		baseURL:     discogsBaseURL,
 		webURL:      discogsWebBaseURL,
 		token:       token,
@@ -0,0 +1,228 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Import alias dictionary. Scrapers and seed files name venues and artists
+// however the source site does ("The Crescent" for Crescent Ballroom), so
+// exact name matching misses and partial matching guesses wrong. Admins map
+// those names to the canonical row here; the import paths call
+// ResolveVenueAliasTx / ResolveArtistAliasTx before any name matching.
+//
+// This is separate from artist_aliases, which are public alternate names
+// shown on the artist page and used by search.
+
+// maxEntityAliasLength matches the entity_aliases.alias column.
+const maxEntityAliasLength = 255
+
+// EntityAliasService manages the admin-curated import alias dictionary.
+type EntityAliasService struct {
+	db *gorm.DB
+}
+
+// NewEntityAliasService creates a new entity alias service.
+func NewEntityAliasService(database *gorm.DB) *EntityAliasService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &EntityAliasService{db: database}
+}
+
+// entityAliasRow is an alias joined with its entity's current name.
+type entityAliasRow struct {
+	catalogm.EntityAlias
+	EntityName string
+}
+
+const entityAliasSelect = `
+	SELECT ea.*, COALESCE(v.name, a.name, '') AS entity_name
+	FROM entity_aliases ea
+	LEFT JOIN venues v ON ea.entity_type = 'venue' AND v.id = ea.entity_id
+	LEFT JOIN artists a ON ea.entity_type = 'artist' AND a.id = ea.entity_id`
+
+// CreateAlias maps alias to the given venue or artist. Aliases are unique
+// per entity type, case-insensitively.
+func (s *EntityAliasService) CreateAlias(entityType string, entityID uint, alias string, createdBy uint) (*contracts.EntityAliasResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	alias = strings.TrimSpace(alias)
+	if alias == "" {
+		return nil, apperrors.ErrEntityAliasInvalid("alias is required")
+	}
+	if len(alias) > maxEntityAliasLength {
+		return nil, apperrors.ErrEntityAliasInvalid(fmt.Sprintf("alias must be at most %d characters", maxEntityAliasLength))
+	}
+
+	var target interface{}
+	switch entityType {
+	case catalogm.EntityAliasTypeVenue:
+		target = &catalogm.Venue{}
+	case catalogm.EntityAliasTypeArtist:
+		target = &catalogm.Artist{}
+	default:
+		return nil, apperrors.ErrEntityAliasInvalid("entity_type must be 'venue' or 'artist'")
+	}
+	if err := s.db.Select("id").First(target, entityID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrEntityAliasTargetNotFound(entityType, entityID)
+		}
+		return nil, fmt.Errorf("failed to get %s: %w", entityType, err)
+	}
+
+	var count int64
+	if err := s.db.Model(&catalogm.EntityAlias{}).
+		Where("entity_type = ? AND LOWER(alias) = LOWER(?)", entityType, alias).
+		Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to check alias: %w", err)
+	}
+	if count > 0 {
+		return nil, apperrors.ErrEntityAliasExists(entityType, alias)
+	}
+
+	row := &catalogm.EntityAlias{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Alias:      alias,
+	}
+	if createdBy != 0 {
+		row.CreatedBy = &createdBy
+	}
+	if err := s.db.Create(row).Error; err != nil {
+		return nil, fmt.Errorf("failed to create alias: %w", err)
+	}
+
+	return s.getAlias(row.ID)
+}
+
+// ListAliases returns every alias, or only those of entityType when it is
+// non-empty, ordered by type then alias.
+func (s *EntityAliasService) ListAliases(entityType string) ([]*contracts.EntityAliasResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	query := entityAliasSelect
+	var args []interface{}
+	switch entityType {
+	case "":
+	case catalogm.EntityAliasTypeVenue, catalogm.EntityAliasTypeArtist:
+		query += " WHERE ea.entity_type = ?"
+		args = append(args, entityType)
+	default:
+		return nil, apperrors.ErrEntityAliasInvalid("entity_type must be 'venue' or 'artist'")
+	}
+	query += " ORDER BY ea.entity_type, LOWER(ea.alias)"
+
+	var rows []entityAliasRow
+	if err := s.db.Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list aliases: %w", err)
+	}
+
+	responses := make([]*contracts.EntityAliasResponse, len(rows))
+	for i := range rows {
+		responses[i] = buildEntityAliasResponse(&rows[i])
+	}
+	return responses, nil
+}
+
+// DeleteAlias removes an alias and returns what was deleted.
+func (s *EntityAliasService) DeleteAlias(aliasID uint) (*contracts.EntityAliasResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	deleted, err := s.getAlias(aliasID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.Delete(&catalogm.EntityAlias{}, aliasID).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete alias: %w", err)
+	}
+	return deleted, nil
+}
+
+func (s *EntityAliasService) getAlias(aliasID uint) (*contracts.EntityAliasResponse, error) {
+	var rows []entityAliasRow
+	if err := s.db.Raw(entityAliasSelect+" WHERE ea.id = ?", aliasID).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get alias: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, apperrors.ErrEntityAliasNotFound(aliasID)
+	}
+	return buildEntityAliasResponse(&rows[0]), nil
+}
+
+func buildEntityAliasResponse(row *entityAliasRow) *contracts.EntityAliasResponse {
+	return &contracts.EntityAliasResponse{
+		ID:         row.ID,
+		EntityType: row.EntityType,
+		EntityID:   row.EntityID,
+		EntityName: row.EntityName,
+		Alias:      row.Alias,
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// ResolveVenueAliasTx returns the venue an alias maps name to, or nil when
+// there is no alias (or its venue no longer exists).
+func ResolveVenueAliasTx(tx *gorm.DB, name string) (*catalogm.Venue, error) {
+	var venue catalogm.Venue
+	found, err := resolveEntityAliasTx(tx, catalogm.EntityAliasTypeVenue, "venues", name, &venue)
+	if !found {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// ResolveArtistAliasTx returns the artist an alias maps name to, or nil when
+// there is no alias (or its artist no longer exists).
+func ResolveArtistAliasTx(tx *gorm.DB, name string) (*catalogm.Artist, error) {
+	var artist catalogm.Artist
+	found, err := resolveEntityAliasTx(tx, catalogm.EntityAliasTypeArtist, "artists", name, &artist)
+	if !found {
+		return nil, err
+	}
+	return &artist, nil
+}
+
+func resolveEntityAliasTx(tx *gorm.DB, entityType, table, name string, dest interface{}) (bool, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false, nil
+	}
+	err := tx.Joins(
+		fmt.Sprintf("JOIN entity_aliases ea ON ea.entity_id = %s.id AND ea.entity_type = ?", table), entityType,
+	).Where("LOWER(ea.alias) = LOWER(?)", name).First(dest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve %s alias %q: %w", entityType, name, err)
+	}
+	return true, nil
+}
+
+// repointEntityAliasesTx moves fromID's import aliases to toID. Runs inside
+// the caller's merge transaction, alongside redirectSlugTx.
+func repointEntityAliasesTx(tx *gorm.DB, entityType string, fromID, toID uint) error {
+	if err := tx.Model(&catalogm.EntityAlias{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, fromID).
+		Update("entity_id", toID).Error; err != nil {
+		return fmt.Errorf("failed to re-point entity aliases: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+type EntityAliasIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *EntityAliasService
+}
+
+func (suite *EntityAliasIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.svc = NewEntityAliasService(suite.db)
+}
+
+func (suite *EntityAliasIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *EntityAliasIntegrationTestSuite) TearDownTest() {
+	suite.Require().NoError(suite.db.Exec("DELETE FROM entity_aliases").Error)
+	suite.Require().NoError(suite.db.Exec("DELETE FROM venues").Error)
+	suite.Require().NoError(suite.db.Exec("DELETE FROM artists").Error)
+}
+
+func TestEntityAliasIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(EntityAliasIntegrationTestSuite))
+}
+
+func (suite *EntityAliasIntegrationTestSuite) seedVenue(name string) *catalogm.Venue {
+	v := &catalogm.Venue{Name: name, City: "Phoenix", State: "AZ"}
+	suite.Require().NoError(suite.db.Create(v).Error)
+	return v
+}
+
+func (suite *EntityAliasIntegrationTestSuite) seedArtist(name string) *catalogm.Artist {
+	a := &catalogm.Artist{Name: name}
+	suite.Require().NoError(suite.db.Create(a).Error)
+	return a
+}
+
+func (suite *EntityAliasIntegrationTestSuite) aliasCode(err error) string {
+	var aliasErr *apperrors.EntityAliasError
+	suite.Require().True(errors.As(err, &aliasErr), "expected EntityAliasError, got %v", err)
+	return aliasErr.Code
+}
+
+func (suite *EntityAliasIntegrationTestSuite) TestCreateAlias_ResolvesCaseInsensitively() {
+	venue := suite.seedVenue("Crescent Ballroom")
+
+	alias, err := suite.svc.CreateAlias(catalogm.EntityAliasTypeVenue, venue.ID, "  The Crescent ", 1)
+	suite.Require().NoError(err)
+	suite.Equal("The Crescent", alias.Alias)
+	suite.Equal("Crescent Ballroom", alias.EntityName)
+
+	resolved, err := ResolveVenueAliasTx(suite.db, "the crescent")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resolved)
+	suite.Equal(venue.ID, resolved.ID)
+
+	// Aliases are scoped to their entity type
+	artist, err := ResolveArtistAliasTx(suite.db, "The Crescent")
+	suite.Require().NoError(err)
+	suite.Nil(artist)
+}
+
+func (suite *EntityAliasIntegrationTestSuite) TestCreateAlias_Validation() {
+	venue := suite.seedVenue("Crescent Ballroom")
+
+	_, err := suite.svc.CreateAlias("label", venue.ID, "The Crescent", 1)
+	suite.Equal(apperrors.CodeEntityAliasInvalid, suite.aliasCode(err))
+
+	_, err = suite.svc.CreateAlias(catalogm.EntityAliasTypeVenue, venue.ID, "   ", 1)
+	suite.Equal(apperrors.CodeEntityAliasInvalid, suite.aliasCode(err))
+
+	_, err = suite.svc.CreateAlias(catalogm.EntityAliasTypeArtist, 999999, "Nobody", 1)
+	suite.Equal(apperrors.CodeEntityAliasTargetNotFound, suite.aliasCode(err))
+
+	_, err = suite.svc.CreateAlias(catalogm.EntityAliasTypeVenue, venue.ID, "The Crescent", 1)
+	suite.Require().NoError(err)
+	_, err = suite.svc.CreateAlias(catalogm.EntityAliasTypeVenue, venue.ID, "THE CRESCENT", 1)
+	suite.Equal(apperrors.CodeEntityAliasExists, suite.aliasCode(err))
+}
+
+func (suite *EntityAliasIntegrationTestSuite) TestListAndDelete() {
+	venue := suite.seedVenue("Crescent Ballroom")
+	artist := suite.seedArtist("Khruangbin")
+
+	venueAlias, err := suite.svc.CreateAlias(catalogm.EntityAliasTypeVenue, venue.ID, "The Crescent", 1)
+	suite.Require().NoError(err)
+	_, err = suite.svc.CreateAlias(catalogm.EntityAliasTypeArtist, artist.ID, "Khruangbin (Houston)", 1)
+	suite.Require().NoError(err)
+
+	all, err := suite.svc.ListAliases("")
+	suite.Require().NoError(err)
+	suite.Require().Len(all, 2)
+	suite.Equal(catalogm.EntityAliasTypeArtist, all[0].EntityType)
+	suite.Equal("Khruangbin", all[0].EntityName)
+
+	venues, err := suite.svc.ListAliases(catalogm.EntityAliasTypeVenue)
+	suite.Require().NoError(err)
+	suite.Require().Len(venues, 1)
+
+	deleted, err := suite.svc.DeleteAlias(venueAlias.ID)
+	suite.Require().NoError(err)
+	suite.Equal("The Crescent", deleted.Alias)
+
+	_, err = suite.svc.DeleteAlias(venueAlias.ID)
+	suite.Equal(apperrors.CodeEntityAliasNotFound, suite.aliasCode(err))
+
+	resolved, err := ResolveVenueAliasTx(suite.db, "The Crescent")
+	suite.Require().NoError(err)
+	suite.Nil(resolved)
+}
+
+func (suite *EntityAliasIntegrationTestSuite) TestMergeVenues_RepointsAliases() {
+	canonical := suite.seedVenue("Crescent Ballroom")
+	dup := suite.seedVenue("Crescent Ballroom PHX")
+	_, err := suite.svc.CreateAlias(catalogm.EntityAliasTypeVenue, dup.ID, "The Crescent", 1)
+	suite.Require().NoError(err)
+
+	_, err = NewVenueService(suite.db).MergeVenues(canonical.ID, dup.ID)
+	suite.Require().NoError(err)
+
+	resolved, err := ResolveVenueAliasTx(suite.db, "The Crescent")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resolved)
+	suite.Equal(canonical.ID, resolved.ID)
+}
@@ -9,6 +9,7 @@ import (
 
 	"psychic-homily-backend/db"
 	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/services/geo"
 )
 
@@ -36,7 +37,7 @@ func (s *EntityExistenceService) Exists(entityType, idOrSlug string) (bool, erro
 		return s.existsByIDOrSlug(
 			&catalogm.Show{},
 			idOrSlug,
-			"status = ?",
+			"status = ? AND deleted_at IS NULL",
 			catalogm.ShowStatusApproved,
 		)
 	case "venues":
@@ -82,6 +83,146 @@ func (s *EntityExistenceService) existsByIDOrSlug(model any, idOrSlug string, ex
 	return id != 0, nil
 }
 
+// resolvableEntity describes one entity type the resolve endpoint can map
+// between numeric IDs and slugs.
+type resolvableEntity struct {
+	table      string
+	pathPrefix string
+	// approvedOnly hides non-approved rows, matching the public detail pages.
+	approvedOnly bool
+	// redirectType is the slug_redirects entity_type for types whose old
+	// slugs are kept; empty when the type has none.
+	redirectType string
+}
+
+// resolvableEntities is keyed by the singular type name legacy links use.
+// Scenes are slug-only (no numeric IDs) so they are not resolvable.
+var resolvableEntities = map[string]resolvableEntity{
+	"show":     {table: "shows", pathPrefix: "/shows/", approvedOnly: true, redirectType: catalogm.SlugRedirectEntityShow},
+	"venue":    {table: "venues", pathPrefix: "/venues/", redirectType: catalogm.SlugRedirectEntityVenue},
+	"artist":   {table: "artists", pathPrefix: "/artists/", redirectType: catalogm.SlugRedirectEntityArtist},
+	"release":  {table: "releases", pathPrefix: "/releases/"},
+	"label":    {table: "labels", pathPrefix: "/labels/"},
+	"festival": {table: "festivals", pathPrefix: "/festivals/"},
+	"tag":      {table: "tags", pathPrefix: "/tags/"},
+}
+
+// Resolve maps legacy numeric IDs and slugs of one entity type to their
+// canonical ID, slug and frontend path. A slug that no longer matches falls
+// back to slug_redirects, like the GetXBySlug lookups, and resolves to the
+// entity's current slug. Results follow the input order (IDs first, then
+// slugs); unmatched references come back with Found=false rather than failing
+// the batch.
+func (s *EntityExistenceService) Resolve(entityType string, ids []uint, slugs []string) ([]contracts.EntityResolution, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	entity, ok := resolvableEntities[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported entity type %q", entityType)
+	}
+
+	byID, bySlug, err := s.resolveRows(entity, ids, slugs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Old slugs: follow slug_redirects to the entity, then report its
+	// current slug.
+	redirected := make(map[string]uint)
+	if entity.redirectType != "" {
+		var stale []string
+		for _, slug := range slugs {
+			if _, ok := bySlug[slug]; !ok {
+				stale = append(stale, slug)
+			}
+		}
+		if len(stale) > 0 {
+			var redirects []catalogm.SlugRedirect
+			if err := s.db.Where("entity_type = ? AND old_slug IN ?", entity.redirectType, stale).
+				Find(&redirects).Error; err != nil {
+				return nil, err
+			}
+			targetIDs := make([]uint, 0, len(redirects))
+			for _, redirect := range redirects {
+				if _, ok := byID[redirect.EntityID]; !ok {
+					targetIDs = append(targetIDs, redirect.EntityID)
+				}
+			}
+			targets, _, err := s.resolveRows(entity, targetIDs, nil)
+			if err != nil {
+				return nil, err
+			}
+			for id, slug := range targets {
+				byID[id] = slug
+			}
+			for _, redirect := range redirects {
+				redirected[redirect.OldSlug] = redirect.EntityID
+			}
+		}
+	}
+
+	results := make([]contracts.EntityResolution, 0, len(ids)+len(slugs))
+	for _, id := range ids {
+		res := contracts.EntityResolution{Query: strconv.FormatUint(uint64(id), 10)}
+		if slug, ok := byID[id]; ok {
+			res.Found, res.ID, res.Slug, res.Path = true, id, slug, entity.pathPrefix+slug
+		}
+		results = append(results, res)
+	}
+	for _, slug := range slugs {
+		res := contracts.EntityResolution{Query: slug}
+		if id, ok := bySlug[slug]; ok {
+			res.Found, res.ID, res.Slug, res.Path = true, id, slug, entity.pathPrefix+slug
+		} else if id, ok := redirected[slug]; ok {
+			if current, ok := byID[id]; ok {
+				res.Found, res.ID, res.Slug, res.Path = true, id, current, entity.pathPrefix+current
+			}
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// resolveRows loads the visible rows of entity matching ids or slugs, keyed
+// both ways.
+func (s *EntityExistenceService) resolveRows(entity resolvableEntity, ids []uint, slugs []string) (map[uint]string, map[string]uint, error) {
+	var rows []struct {
+		ID   uint
+		Slug *string
+	}
+	if len(ids) > 0 || len(slugs) > 0 {
+		query := s.db.Table(entity.table).Select("id, slug")
+		switch {
+		case len(ids) > 0 && len(slugs) > 0:
+			query = query.Where("id IN ? OR slug IN ?", ids, slugs)
+		case len(ids) > 0:
+			query = query.Where("id IN ?", ids)
+		default:
+			query = query.Where("slug IN ?", slugs)
+		}
+		if entity.approvedOnly {
+			query = query.Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusApproved)
+		}
+		if err := query.Scan(&rows).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+
+	byID := make(map[uint]string, len(rows))
+	bySlug := make(map[string]uint, len(rows))
+	for _, row := range rows {
+		// A row without a slug has no canonical URL yet; treat it as
+		// unresolvable rather than emitting a broken path.
+		if row.Slug == nil || *row.Slug == "" {
+			continue
+		}
+		byID[row.ID] = *row.Slug
+		bySlug[*row.Slug] = row.ID
+	}
+	return byID, bySlug, nil
+}
+
 // sceneExists gates the proxy soft-404 for /scenes/{slug}. It mirrors
 // GetSceneDetail's existence rule (>= sceneMinVenues verified venues), now
 // metro-aware (PSY-1255 step C): a US slug whose (city,state) pins a CBSA counts
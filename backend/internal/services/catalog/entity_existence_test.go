@@ -46,6 +46,7 @@ func (suite *EntityExistenceServiceIntegrationTestSuite) TearDownTest() {
 	_, _ = sqlDB.Exec("DELETE FROM artists")
 	_, _ = sqlDB.Exec("DELETE FROM venues")
 	_, _ = sqlDB.Exec("DELETE FROM users")
+	_, _ = sqlDB.Exec("DELETE FROM slug_redirects")
 }
 
 func TestEntityExistenceServiceIntegrationTestSuite(t *testing.T) {
@@ -181,3 +182,79 @@ func (suite *EntityExistenceServiceIntegrationTestSuite) TestExists_UnsupportedE
 	suite.Require().NoError(err)
 	suite.False(exists)
 }
+
+func (suite *EntityExistenceServiceIntegrationTestSuite) TestResolve_ShowIDsAndSlugsInInputOrder() {
+	user := suite.createEntityExistenceUser()
+	approvedSlug := "resolve-approved-show"
+	privateSlug := "resolve-private-show"
+
+	approved := &catalogm.Show{
+		Title:       "Approved Show",
+		Slug:        &approvedSlug,
+		EventDate:   time.Now().UTC().Add(24 * time.Hour),
+		Status:      catalogm.ShowStatusApproved,
+		SubmittedBy: &user.ID,
+	}
+	private := &catalogm.Show{
+		Title:       "Private Show",
+		Slug:        &privateSlug,
+		EventDate:   time.Now().UTC().Add(48 * time.Hour),
+		Status:      catalogm.ShowStatusPrivate,
+		SubmittedBy: &user.ID,
+	}
+	suite.Require().NoError(suite.db.Create(approved).Error)
+	suite.Require().NoError(suite.db.Create(private).Error)
+
+	results, err := suite.svc.Resolve("show", []uint{private.ID, approved.ID}, []string{approvedSlug, "missing-show"})
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 4)
+
+	// Private shows resolve like a missing show: the public page would 404.
+	suite.False(results[0].Found)
+	suite.Equal(fmt.Sprintf("%d", private.ID), results[0].Query)
+
+	suite.True(results[1].Found)
+	suite.Equal(approvedSlug, results[1].Slug)
+	suite.Equal("/shows/"+approvedSlug, results[1].Path)
+
+	suite.True(results[2].Found)
+	suite.Equal(approved.ID, results[2].ID)
+
+	suite.False(results[3].Found)
+	suite.Equal("missing-show", results[3].Query)
+}
+
+func (suite *EntityExistenceServiceIntegrationTestSuite) TestResolve_FollowsSlugRedirects() {
+	currentSlug := "resolve-renamed-artist"
+	artist := &catalogm.Artist{Name: "Renamed Artist", Slug: &currentSlug}
+	suite.Require().NoError(suite.db.Create(artist).Error)
+	suite.Require().NoError(insertSlugRedirect(suite.db, catalogm.SlugRedirectEntityArtist, "resolve-old-artist", artist.ID))
+
+	results, err := suite.svc.Resolve("artist", nil, []string{"resolve-old-artist", "resolve-never-existed"})
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 2)
+
+	suite.True(results[0].Found)
+	suite.Equal("resolve-old-artist", results[0].Query)
+	suite.Equal(artist.ID, results[0].ID)
+	suite.Equal(currentSlug, results[0].Slug)
+	suite.Equal("/artists/"+currentSlug, results[0].Path)
+
+	suite.False(results[1].Found)
+}
+
+func (suite *EntityExistenceServiceIntegrationTestSuite) TestResolve_Tag() {
+	tag := &catalogm.Tag{Name: "Shoegaze", Slug: "shoegaze", Category: catalogm.TagCategoryGenre}
+	suite.Require().NoError(suite.db.Create(tag).Error)
+
+	results, err := suite.svc.Resolve("tag", []uint{tag.ID}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+	suite.True(results[0].Found)
+	suite.Equal("/tags/shoegaze", results[0].Path)
+}
+
+func (suite *EntityExistenceServiceIntegrationTestSuite) TestResolve_UnsupportedEntityType() {
+	_, err := suite.svc.Resolve("scene", nil, []string{"phoenix-az"})
+	suite.Error(err)
+}
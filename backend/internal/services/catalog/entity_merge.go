@@ -0,0 +1,43 @@
+package catalog
+
+import (
+	"strings"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+// Helpers shared by the admin artist and venue merges (artist.go MergeArtists,
+// venue_merge.go MergeVenues). Slug redirects live in slug_redirect.go.
+
+// fillMissingSocialLinks returns the social/music link columns that dst
+// lacks and src has, keyed by column name, plus the copied column names in
+// a stable order. Merges use it so the canonical record keeps every link
+// either duplicate knew about; a link already set on dst always wins.
+func fillMissingSocialLinks(dst, src catalogm.Social) (map[string]interface{}, []string) {
+	updates := map[string]interface{}{}
+	copied := []string{}
+	for _, f := range []struct {
+		column   string
+		dst, src *string
+	}{
+		{"instagram", dst.Instagram, src.Instagram},
+		{"facebook", dst.Facebook, src.Facebook},
+		{"twitter", dst.Twitter, src.Twitter},
+		{"youtube", dst.YouTube, src.YouTube},
+		{"spotify", dst.Spotify, src.Spotify},
+		{"soundcloud", dst.SoundCloud, src.SoundCloud},
+		{"bandcamp", dst.Bandcamp, src.Bandcamp},
+		{"tiktok", dst.TikTok, src.TikTok},
+		{"website", dst.Website, src.Website},
+	} {
+		if isBlank(f.dst) && !isBlank(f.src) {
+			updates[f.column] = *f.src
+			copied = append(copied, f.column)
+		}
+	}
+	return updates, copied
+}
+
+func isBlank(s *string) bool {
+	return s == nil || strings.TrimSpace(*s) == ""
+}
@@ -0,0 +1,36 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+func TestFillMissingSocialLinks(t *testing.T) {
+	dst := catalogm.Social{
+		Spotify: stringPtr("https://open.spotify.com/artist/keep"),
+		Website: stringPtr("  "),
+	}
+	src := catalogm.Social{
+		Spotify:   stringPtr("https://open.spotify.com/artist/drop"),
+		Bandcamp:  stringPtr("https://band.bandcamp.com"),
+		Website:   stringPtr("https://band.example"),
+		Instagram: stringPtr(""),
+	}
+
+	updates, copied := fillMissingSocialLinks(dst, src)
+	assert.Equal(t, []string{"bandcamp", "website"}, copied)
+	assert.Equal(t, map[string]interface{}{
+		"bandcamp": "https://band.bandcamp.com",
+		"website":  "https://band.example",
+	}, updates)
+}
+
+func TestFillMissingSocialLinks_NothingToCopy(t *testing.T) {
+	updates, copied := fillMissingSocialLinks(catalogm.Social{}, catalogm.Social{})
+	assert.Empty(t, updates)
+	assert.NotNil(t, copied)
+	assert.Empty(t, copied)
+}
@@ -272,6 +272,8 @@ func (s *FestivalService) SearchFestivals(query string) ([]*contracts.FestivalLi
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Return empty results for empty query
 	if query == "" {
 		return []*contracts.FestivalListResponse{}, nil
@@ -282,14 +284,14 @@ func (s *FestivalService) SearchFestivals(query string) ([]*contracts.FestivalLi
 
 	if len(query) <= 2 {
 		// For short queries: prefix match
-		err = s.db.
+		err = replica.
 			Where("LOWER(name) LIKE LOWER(?)", shared.LikePrefixPattern(query)).
 			Order("name ASC").
 			Limit(20).
 			Find(&festivals).Error
 	} else {
 		// For longer queries: ILIKE substring match, ordered by name
-		err = s.db.
+		err = replica.
 			Where("name ILIKE ?", shared.LikePattern(query)).
 			Order("name ASC").
 			Limit(20).
@@ -317,7 +319,7 @@ func (s *FestivalService) SearchFestivals(query string) ([]*contracts.FestivalLi
 
 		// Artist counts
 		var aCounts []CountResult
-		s.db.Table("festival_artists").
+		replica.Table("festival_artists").
 			Select("festival_id, COUNT(DISTINCT artist_id) as count").
 			Where("festival_id IN ?", festivalIDs).
 			Group("festival_id").
@@ -328,7 +330,7 @@ func (s *FestivalService) SearchFestivals(query string) ([]*contracts.FestivalLi
 
 		// Venue counts
 		var vCounts []CountResult
-		s.db.Table("festival_venues").
+		replica.Table("festival_venues").
 			Select("festival_id, COUNT(DISTINCT venue_id) as count").
 			Where("festival_id IN ?", festivalIDs).
 			Group("festival_id").
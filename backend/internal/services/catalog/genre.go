@@ -0,0 +1,186 @@
+package catalog
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Genres are the category='genre' tags, nested through parent_id and edited
+// through the admin tag endpoints. An artist's genres are its genre-category
+// entity_tags (applied by users) plus its artist_genres rows (inferred from
+// import metadata). Shows carry no genres of their own; they take them from
+// the lineup, the same way the transitive tag filter does (PSY-499).
+
+// maxShowGenres caps the genre chips on a show response.
+const maxShowGenres = 5
+
+// ParseGenreFilter parses the comma-separated `genre=` query param into
+// trimmed, lowercased, de-duplicated genre slugs. Empty input ⇒ nil (no
+// filter).
+func ParseGenreFilter(raw string) []string {
+	return ParseTagFilter(raw, "").TagSlugs
+}
+
+// genreTagIDs is a subquery for the IDs of the genre tags named by slugs and
+// every genre nested beneath them, so filtering by "punk" also matches an
+// artist tagged "post-punk" when post-punk's parent is punk.
+func genreTagIDs(db *gorm.DB, slugs []string) *gorm.DB {
+	return db.Raw(`WITH RECURSIVE genre_ids AS (
+			SELECT id FROM tags WHERE category = ? AND LOWER(slug) IN ?
+			UNION
+			SELECT tags.id FROM tags JOIN genre_ids ON tags.parent_id = genre_ids.id
+			WHERE tags.category = ?
+		)
+		SELECT id FROM genre_ids`,
+		catalogm.TagCategoryGenre, slugs, catalogm.TagCategoryGenre)
+}
+
+// artistIDsWithGenres is a subquery for the artists with any of the genres
+// (or their subgenres), from either entity_tags or artist_genres.
+func artistIDsWithGenres(db *gorm.DB, slugs []string) *gorm.DB {
+	ids := genreTagIDs(db, slugs)
+	return db.Raw(`SELECT entity_tags.entity_id FROM entity_tags
+		WHERE entity_tags.entity_type = ? AND entity_tags.tag_id IN (?)
+		UNION
+		SELECT artist_genres.artist_id FROM artist_genres
+		WHERE artist_genres.tag_id IN (?)`,
+		catalogm.TagEntityArtist, ids, ids)
+}
+
+// ApplyArtistGenreFilter narrows an artist query (idColumn fully qualified,
+// e.g. `artists.id`) to artists with any of the genre slugs. Matching is OR
+// across slugs and includes subgenres. Empty slugs leave the query unchanged.
+func ApplyArtistGenreFilter(query *gorm.DB, db *gorm.DB, idColumn string, slugs []string) *gorm.DB {
+	if len(slugs) == 0 {
+		return query
+	}
+	return query.Where(idColumn+" IN (?)", artistIDsWithGenres(db, slugs))
+}
+
+// ApplyShowGenreFilter narrows a show query to shows whose lineup includes an
+// artist with any of the genre slugs (or their subgenres). Empty slugs leave
+// the query unchanged.
+func ApplyShowGenreFilter(query *gorm.DB, db *gorm.DB, slugs []string) *gorm.DB {
+	if len(slugs) == 0 {
+		return query
+	}
+	sub := db.Table("show_artists").
+		Select("show_artists.show_id").
+		Where("show_artists.artist_id IN (?)", artistIDsWithGenres(db, slugs))
+	return query.Where("shows.id IN (?)", sub)
+}
+
+// lineupGenres returns the genre chips for a lineup: the distinct genres of
+// the given artists, most widely shared across the lineup first (then by
+// name), capped at maxShowGenres. Returns an empty slice when no artist has
+// a genre.
+func lineupGenres(db *gorm.DB, artistIDs []uint) ([]contracts.GenreChip, error) {
+	chips := []contracts.GenreChip{}
+	if len(artistIDs) == 0 {
+		return chips, nil
+	}
+	type row struct {
+		ID          uint
+		Name        string
+		Slug        string
+		ArtistCount int
+	}
+	var rows []row
+	err := db.Raw(`SELECT tags.id, tags.name, tags.slug, COUNT(DISTINCT g.artist_id) AS artist_count
+		FROM (
+			SELECT entity_tags.entity_id AS artist_id, entity_tags.tag_id FROM entity_tags
+			WHERE entity_tags.entity_type = ? AND entity_tags.entity_id IN ?
+			UNION
+			SELECT artist_genres.artist_id, artist_genres.tag_id FROM artist_genres
+			WHERE artist_genres.artist_id IN ?
+		) g
+		JOIN tags ON tags.id = g.tag_id AND tags.category = ?
+		GROUP BY tags.id, tags.name, tags.slug
+		ORDER BY artist_count DESC, tags.name ASC
+		LIMIT ?`,
+		catalogm.TagEntityArtist, artistIDs, artistIDs, catalogm.TagCategoryGenre, maxShowGenres,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load lineup genres: %w", err)
+	}
+	for _, r := range rows {
+		chips = append(chips, contracts.GenreChip{ID: r.ID, Name: r.Name, Slug: r.Slug})
+	}
+	return chips, nil
+}
+
+// ResolveGenreTagsTx maps free-text genre names from import metadata
+// ("Post-Punk", "post punk", "shoegaze") to existing genre tags, matching by
+// slug or by tag alias. Names with no matching genre are dropped; imports
+// never grow the taxonomy. The result is de-duplicated and sorted by ID.
+func ResolveGenreTagsTx(tx *gorm.DB, names []string) ([]uint, error) {
+	seen := make(map[uint]struct{})
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		var ids []uint
+		err := tx.Model(&catalogm.Tag{}).
+			Where("category = ?", catalogm.TagCategoryGenre).
+			Where("slug = ? OR id IN (?)", utils.GenerateSlug(name),
+				tx.Model(&catalogm.TagAlias{}).Select("tag_id").Where("LOWER(alias) = LOWER(?)", name)).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve genre %q: %w", name, err)
+		}
+		for _, id := range ids {
+			seen[id] = struct{}{}
+		}
+	}
+	out := make([]uint, 0, len(seen))
+	for id := range seen {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
+
+// InferArtistGenresTx records genres read off import metadata for an artist.
+// names are resolved with ResolveGenreTagsTx; genres the artist already has
+// as inferred genres are left as they are. Returns how many were added.
+func InferArtistGenresTx(tx *gorm.DB, artistID uint, names []string, source string) (int, error) {
+	tagIDs, err := ResolveGenreTagsTx(tx, names)
+	if err != nil || len(tagIDs) == 0 {
+		return 0, err
+	}
+	rows := make([]catalogm.ArtistGenre, len(tagIDs))
+	for i, tagID := range tagIDs {
+		rows[i] = catalogm.ArtistGenre{ArtistID: artistID, TagID: tagID, Source: source}
+	}
+	res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows)
+	if res.Error != nil {
+		return 0, fmt.Errorf("failed to record artist genres: %w", res.Error)
+	}
+	return int(res.RowsAffected), nil
+}
+
+// setShowGenres fills resp.Genres from the genres of resp.Artists. A failed
+// lookup is logged and leaves the show without genres rather than failing
+// the response.
+func setShowGenres(db *gorm.DB, resp *contracts.ShowResponse) {
+	artistIDs := make([]uint, len(resp.Artists))
+	for i, a := range resp.Artists {
+		artistIDs[i] = a.ID
+	}
+	genres, err := lineupGenres(db, artistIDs)
+	if err != nil {
+		log.Printf("WARN setShowGenres: show_id=%d: %v", resp.ID, err)
+		genres = []contracts.GenreChip{}
+	}
+	resp.Genres = genres
+}
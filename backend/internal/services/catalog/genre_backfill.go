@@ -0,0 +1,83 @@
+package catalog
+
+import (
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/internal/backfill"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+// ArtistGenreBackfillOptions configures a BackfillArtistGenres run.
+type ArtistGenreBackfillOptions struct {
+	// DryRun computes and reports every change without writing.
+	DryRun bool
+	// Batch sets batching, rate limiting, progress and resume. Its DryRun
+	// is ignored in favour of DryRun above.
+	Batch backfill.Options
+}
+
+// ArtistGenreBackfillReport summarizes a BackfillArtistGenres run.
+type ArtistGenreBackfillReport struct {
+	Scanned        int
+	ArtistsChanged int // artists that gained at least one genre
+	GenresAdded    int
+	Errors         []string
+	// Cursor is where the run stopped; pass it as Batch.ResumeFrom to
+	// continue an interrupted run.
+	Cursor backfill.Cursor
+}
+
+// BackfillArtistGenres gives artists that predate genre inference the genres
+// their releases are tagged with. For every artist it records, as inferred
+// artist_genres, the genre tags on the releases it is the main artist of
+// (featured, producer and other credits don't carry a release's genre to the
+// artist). Genres the artist already has, inferred or tagged, are skipped.
+// Release genre tags are community-applied, so that is the recorded source.
+//
+// Artists are visited in id order in batches (see package backfill). It is
+// idempotent: a second run adds nothing.
+func BackfillArtistGenres(database *gorm.DB, opts ArtistGenreBackfillOptions) (*ArtistGenreBackfillReport, error) {
+	report := &ArtistGenreBackfillReport{}
+
+	batch := opts.Batch
+	batch.DryRun = opts.DryRun
+	result, err := backfill.Run(database, batch, backfill.Stage{
+		Name:  "artists",
+		Query: func(db *gorm.DB) *gorm.DB { return db.Model(&catalogm.Artist{}) },
+		Batch: func(tx *gorm.DB, ids []uint) error {
+			var added []uint
+			err := tx.Raw(`INSERT INTO artist_genres (artist_id, tag_id, source, created_at)
+				SELECT DISTINCT artist_releases.artist_id, entity_tags.tag_id, ?, NOW()
+				FROM artist_releases
+				JOIN entity_tags ON entity_tags.entity_type = ? AND entity_tags.entity_id = artist_releases.release_id
+				JOIN tags ON tags.id = entity_tags.tag_id AND tags.category = ?
+				WHERE artist_releases.artist_id IN ? AND artist_releases.role = ?
+				AND NOT EXISTS (
+					SELECT 1 FROM entity_tags artist_tags
+					WHERE artist_tags.entity_type = ? AND artist_tags.entity_id = artist_releases.artist_id
+					AND artist_tags.tag_id = entity_tags.tag_id
+				)
+				ON CONFLICT (artist_id, tag_id) DO NOTHING
+				RETURNING artist_id`,
+				catalogm.DataSourceCommunity, catalogm.TagEntityRelease, catalogm.TagCategoryGenre,
+				ids, catalogm.ArtistReleaseRoleMain, catalogm.TagEntityArtist,
+			).Scan(&added).Error
+			if err != nil {
+				return err
+			}
+			report.Scanned += len(ids)
+			report.GenresAdded += len(added)
+			artists := make(map[uint]bool)
+			for _, id := range added {
+				artists[id] = true
+			}
+			report.ArtistsChanged += len(artists)
+			return nil
+		},
+	})
+	report.Cursor = result.Cursor
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+	return report, nil
+}
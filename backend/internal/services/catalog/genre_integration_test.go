@@ -0,0 +1,220 @@
+package catalog
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// GenreIntegrationTestSuite covers the genre filter (with subgenres), lineup
+// genre chips and genre inference against the artist_genres table.
+type GenreIntegrationTestSuite struct {
+	suite.Suite
+	testDB        *testutil.TestDatabase
+	db            *gorm.DB
+	artistService *ArtistService
+	showService   *ShowService
+
+	user *authm.User
+	// Genre tags keyed by slug: punk (parent of post-punk), post-punk, shoegaze.
+	genres map[string]*catalogm.Tag
+}
+
+func (s *GenreIntegrationTestSuite) SetupSuite() {
+	s.testDB = testutil.SetupTestPostgres(s.T())
+	s.db = s.testDB.DB
+	s.artistService = &ArtistService{db: s.db}
+	s.showService = &ShowService{db: s.db}
+}
+
+func (s *GenreIntegrationTestSuite) TearDownSuite() {
+	s.testDB.Cleanup()
+}
+
+func (s *GenreIntegrationTestSuite) SetupTest() {
+	sqlDB, err := s.db.DB()
+	s.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM artist_genres")
+	_, _ = sqlDB.Exec("DELETE FROM artist_releases")
+	_, _ = sqlDB.Exec("DELETE FROM releases")
+	_, _ = sqlDB.Exec("DELETE FROM entity_tags")
+	_, _ = sqlDB.Exec("DELETE FROM tag_aliases")
+	_, _ = sqlDB.Exec("DELETE FROM show_artists")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM artists")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM tags")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+
+	email := fmt.Sprintf("genre-user-%d@test.com", time.Now().UnixNano())
+	u := &authm.User{Email: &email, IsActive: true, EmailVerified: true}
+	s.Require().NoError(s.db.Create(u).Error)
+	s.user = u
+
+	s.genres = map[string]*catalogm.Tag{}
+	for _, name := range []string{"punk", "post-punk", "shoegaze"} {
+		t := &catalogm.Tag{Name: name, Slug: name, Category: catalogm.TagCategoryGenre}
+		if name == "post-punk" {
+			t.ParentID = &s.genres["punk"].ID
+		}
+		s.Require().NoError(s.db.Create(t).Error)
+		s.genres[name] = t
+	}
+}
+
+func TestGenreIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(GenreIntegrationTestSuite))
+}
+
+// seedShow creates an approved upcoming show billing the named artists and
+// returns the show and artist IDs in lineup order.
+func (s *GenreIntegrationTestSuite) seedShow(names ...string) (uint, []uint) {
+	v := &catalogm.Venue{Name: fmt.Sprintf("Venue %d", time.Now().UnixNano()), City: "Phoenix", State: "AZ"}
+	s.Require().NoError(s.db.Create(v).Error)
+	show := &catalogm.Show{
+		Title:       names[0],
+		EventDate:   time.Now().Add(7 * 24 * time.Hour).UTC(),
+		Status:      catalogm.ShowStatusApproved,
+		SubmittedBy: &s.user.ID,
+	}
+	s.Require().NoError(s.db.Create(show).Error)
+	s.Require().NoError(s.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: v.ID}).Error)
+
+	ids := make([]uint, len(names))
+	for i, name := range names {
+		slug := fmt.Sprintf("%s-%d", name, time.Now().UnixNano())
+		a := &catalogm.Artist{Name: name, Slug: &slug}
+		s.Require().NoError(s.db.Create(a).Error)
+		s.Require().NoError(s.db.Create(&catalogm.ShowArtist{ShowID: show.ID, ArtistID: a.ID, Position: i}).Error)
+		ids[i] = a.ID
+	}
+	return show.ID, ids
+}
+
+func (s *GenreIntegrationTestSuite) tagArtist(artistID uint, slug string) {
+	s.Require().NoError(s.db.Create(&catalogm.EntityTag{
+		TagID:         s.genres[slug].ID,
+		EntityType:    catalogm.TagEntityArtist,
+		EntityID:      artistID,
+		AddedByUserID: s.user.ID,
+	}).Error)
+}
+
+func (s *GenreIntegrationTestSuite) inferGenre(artistID uint, slug string) {
+	s.Require().NoError(s.db.Create(&catalogm.ArtistGenre{
+		ArtistID: artistID,
+		TagID:    s.genres[slug].ID,
+		Source:   catalogm.DataSourceDiscovery,
+	}).Error)
+}
+
+func (s *GenreIntegrationTestSuite) TestArtists_GenreFilterIncludesSubgenresAndInferred() {
+	_, ids := s.seedShow("Tagged Post-Punk", "Inferred Punk", "Shoegazer")
+	s.tagArtist(ids[0], "post-punk")
+	s.inferGenre(ids[1], "punk")
+	s.tagArtist(ids[2], "shoegaze")
+
+	resp, err := s.artistService.GetArtistsWithShowCounts(map[string]interface{}{
+		"genre_slugs":        []string{"punk"},
+		"skip_active_filter": true,
+	})
+	s.Require().NoError(err)
+	names := []string{}
+	for _, a := range resp {
+		names = append(names, a.Name)
+	}
+	s.ElementsMatch([]string{"Tagged Post-Punk", "Inferred Punk"}, names)
+}
+
+func (s *GenreIntegrationTestSuite) TestUpcomingShows_GenreFilterAndChips() {
+	showID, ids := s.seedShow("Headliner", "Opener")
+	s.tagArtist(ids[0], "shoegaze")
+	s.inferGenre(ids[0], "post-punk")
+	s.inferGenre(ids[1], "post-punk")
+	s.seedShow("Untagged Band")
+
+	shows, _, err := s.showService.GetUpcomingShows("UTC", "", 50, false, &contracts.UpcomingShowsFilter{
+		GenreSlugs: []string{"post-punk"},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(shows, 1)
+	s.Equal(showID, shows[0].ID)
+
+	// post-punk is shared by both billed artists, so it leads.
+	s.Require().Len(shows[0].Genres, 2)
+	s.Equal("post-punk", shows[0].Genres[0].Slug)
+	s.Equal("shoegaze", shows[0].Genres[1].Slug)
+}
+
+func (s *GenreIntegrationTestSuite) TestInferArtistGenres_ResolvesSlugsAndAliases() {
+	s.Require().NoError(s.db.Create(&catalogm.TagAlias{TagID: s.genres["shoegaze"].ID, Alias: "nu gaze"}).Error)
+	_, ids := s.seedShow("Listing Band")
+
+	added, err := InferArtistGenresTx(s.db, ids[0], []string{"Post Punk", "Nu Gaze", "vaporwave", ""}, catalogm.DataSourceDiscovery)
+	s.Require().NoError(err)
+	s.Equal(2, added)
+
+	// Re-importing the same listing adds nothing.
+	added, err = InferArtistGenresTx(s.db, ids[0], []string{"post-punk"}, catalogm.DataSourceDiscovery)
+	s.Require().NoError(err)
+	s.Equal(0, added)
+
+	var slugs []string
+	s.Require().NoError(s.db.Table("artist_genres").
+		Joins("JOIN tags ON tags.id = artist_genres.tag_id").
+		Where("artist_genres.artist_id = ?", ids[0]).
+		Order("tags.slug").
+		Pluck("tags.slug", &slugs).Error)
+	s.Equal([]string{"post-punk", "shoegaze"}, slugs)
+
+	var vaporwave int64
+	s.Require().NoError(s.db.Model(&catalogm.Tag{}).Where("slug = ?", "vaporwave").Count(&vaporwave).Error)
+	s.Zero(vaporwave, "inference must not create genres")
+}
+
+func (s *GenreIntegrationTestSuite) TestBackfillArtistGenres_FromMainReleaseTags() {
+	_, ids := s.seedShow("Release Band", "Guest Band")
+	s.tagArtist(ids[0], "shoegaze")
+	release := &catalogm.Release{Title: "First LP"}
+	s.Require().NoError(s.db.Create(release).Error)
+	s.Require().NoError(s.db.Create(&catalogm.ArtistRelease{ArtistID: ids[0], ReleaseID: release.ID, Role: catalogm.ArtistReleaseRoleMain}).Error)
+	s.Require().NoError(s.db.Create(&catalogm.ArtistRelease{ArtistID: ids[1], ReleaseID: release.ID, Role: catalogm.ArtistReleaseRoleFeatured}).Error)
+	for _, slug := range []string{"post-punk", "shoegaze"} {
+		s.Require().NoError(s.db.Create(&catalogm.EntityTag{
+			TagID: s.genres[slug].ID, EntityType: catalogm.TagEntityRelease, EntityID: release.ID, AddedByUserID: s.user.ID,
+		}).Error)
+	}
+
+	dry, err := BackfillArtistGenres(s.db, ArtistGenreBackfillOptions{DryRun: true})
+	s.Require().NoError(err)
+	s.Empty(dry.Errors)
+	s.Equal(1, dry.GenresAdded)
+	var count int64
+	s.Require().NoError(s.db.Model(&catalogm.ArtistGenre{}).Count(&count).Error)
+	s.Zero(count, "dry run must not write")
+
+	report, err := BackfillArtistGenres(s.db, ArtistGenreBackfillOptions{})
+	s.Require().NoError(err)
+	s.Equal(2, report.Scanned)
+	s.Equal(1, report.ArtistsChanged)
+	s.Equal(1, report.GenresAdded, "shoegaze is already tagged; the featured artist gets nothing")
+
+	var genre catalogm.ArtistGenre
+	s.Require().NoError(s.db.First(&genre).Error)
+	s.Equal(ids[0], genre.ArtistID)
+	s.Equal(s.genres["post-punk"].ID, genre.TagID)
+	s.Equal(catalogm.DataSourceCommunity, genre.Source)
+
+	again, err := BackfillArtistGenres(s.db, ArtistGenreBackfillOptions{})
+	s.Require().NoError(err)
+	s.Zero(again.GenresAdded)
+}
@@ -9,8 +9,14 @@ var (
 	_ contracts.ShowImportServiceInterface           = (*ShowService)(nil)
 	_ contracts.ShowStateServiceInterface            = (*ShowService)(nil)
 	_ contracts.ShowFullServiceInterface             = (*ShowService)(nil)
+	_ contracts.ShowSeriesServiceInterface           = (*ShowSeriesService)(nil)
+	_ contracts.ShowUpdateServiceInterface           = (*ShowUpdateService)(nil)
+	_ contracts.SyncServiceInterface                 = (*SyncService)(nil)
+	_ contracts.NearbyShowsServiceInterface          = (*NearbyShowsService)(nil)
 	_ contracts.VenueServiceInterface                = (*VenueService)(nil)
+	_ contracts.VenueClaimServiceInterface           = (*VenueClaimService)(nil)
 	_ contracts.ArtistServiceInterface               = (*ArtistService)(nil)
+	_ contracts.ArtistClaimServiceInterface          = (*ArtistClaimService)(nil)
 	_ contracts.FestivalServiceInterface             = (*FestivalService)(nil)
 	_ contracts.LabelServiceInterface                = (*LabelService)(nil)
 	_ contracts.ReleaseServiceInterface              = (*ReleaseService)(nil)
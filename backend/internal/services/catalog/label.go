@@ -235,6 +235,8 @@ func (s *LabelService) SearchLabels(query string) ([]*contracts.LabelListRespons
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Return empty results for empty query
 	if query == "" {
 		return []*contracts.LabelListResponse{}, nil
@@ -245,14 +247,14 @@ func (s *LabelService) SearchLabels(query string) ([]*contracts.LabelListRespons
 
 	if len(query) <= 2 {
 		// For short queries: prefix match
-		err = s.db.
+		err = replica.
 			Where("LOWER(name) LIKE LOWER(?)", shared.LikePrefixPattern(query)).
 			Order("name ASC").
 			Limit(20).
 			Find(&labels).Error
 	} else {
 		// For longer queries: ILIKE substring match, ordered by name
-		err = s.db.
+		err = replica.
 			Where("name ILIKE ?", shared.LikePattern(query)).
 			Order("name ASC").
 			Limit(20).
@@ -280,7 +282,7 @@ func (s *LabelService) SearchLabels(query string) ([]*contracts.LabelListRespons
 
 		// Artist counts
 		var aCounts []CountResult
-		s.db.Table("artist_labels").
+		replica.Table("artist_labels").
 			Select("label_id, COUNT(DISTINCT artist_id) as count").
 			Where("label_id IN ?", labelIDs).
 			Group("label_id").
@@ -291,7 +293,7 @@ func (s *LabelService) SearchLabels(query string) ([]*contracts.LabelListRespons
 
 		// Release counts
 		var rCounts []CountResult
-		s.db.Table("release_labels").
+		replica.Table("release_labels").
 			Select("label_id, COUNT(DISTINCT release_id) as count").
 			Where("label_id IN ?", labelIDs).
 			Group("label_id").
@@ -658,6 +660,7 @@ func (s *LabelService) buildDetailResponse(label *catalogm.Label) (*contracts.La
 			Spotify:    label.Social.Spotify,
 			SoundCloud: label.Social.SoundCloud,
 			Bandcamp:   label.Social.Bandcamp,
+			TikTok:     label.Social.TikTok,
 			Website:    label.Social.Website,
 		},
 		ArtistCount:  int(artistCount),
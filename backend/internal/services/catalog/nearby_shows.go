@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/geo"
+	"psychic-homily-backend/internal/services/geoip"
+)
+
+// NearbyShowsService serves upcoming shows for the area a visitor's IP
+// resolves to (GET /shows/nearby). The IP lookup only yields a city, so the
+// city is rolled up to its CBSA metro: a visitor placed in Scottsdale sees
+// shows across Phoenix-Mesa-Chandler, not just the two venues in Scottsdale.
+type NearbyShowsService struct {
+	shows         contracts.ShowServiceInterface
+	locator       geoip.Locator
+	geocoder      geo.Geocoder
+	defaultCities []contracts.CityStateFilter
+}
+
+// NewNearbyShowsService creates a nearby shows service. locator may be nil
+// (no GeoIP database configured), in which case every request is served the
+// default cities.
+func NewNearbyShowsService(shows contracts.ShowServiceInterface, locator geoip.Locator, defaultCities []contracts.CityStateFilter) *NearbyShowsService {
+	return &NearbyShowsService{
+		shows:         shows,
+		locator:       locator,
+		geocoder:      geo.Default(),
+		defaultCities: defaultCities,
+	}
+}
+
+// GetNearbyShows returns up to limit upcoming shows for the area inferred
+// from clientIP. It falls back to the default cities when the IP can't be
+// placed in a city or that area has no upcoming shows.
+func (s *NearbyShowsService) GetNearbyShows(clientIP string, timezone string, limit int) (*contracts.NearbyShowsResponse, error) {
+	loc := s.locate(clientIP)
+
+	var location *contracts.NearbyLocation
+	if loc != nil {
+		location = &contracts.NearbyLocation{City: loc.City, State: loc.State, Country: loc.Country}
+		if resp, filter := s.inferredArea(*loc); filter != nil {
+			shows, _, err := s.shows.GetUpcomingShows(timezone, "", limit, false, filter)
+			if err != nil {
+				return nil, err
+			}
+			if len(shows) > 0 {
+				resp.Location = location
+				resp.Shows = shows
+				return resp, nil
+			}
+		}
+	}
+
+	resp := &contracts.NearbyShowsResponse{
+		Source:   contracts.NearbySourceDefault,
+		Location: location,
+		Shows:    []*contracts.ShowResponse{},
+	}
+	if len(s.defaultCities) == 0 {
+		return resp, nil
+	}
+	labels := make([]string, 0, len(s.defaultCities))
+	for _, c := range s.defaultCities {
+		resp.Cities = append(resp.Cities, contracts.NearbyCity{City: c.City, State: c.State})
+		labels = append(labels, cityLabel(c.City, c.State))
+	}
+	resp.Area = strings.Join(labels, " · ")
+
+	shows, _, err := s.shows.GetUpcomingShows(timezone, "", limit, false, &contracts.UpcomingShowsFilter{Cities: s.defaultCities})
+	if err != nil {
+		return nil, err
+	}
+	if shows != nil {
+		resp.Shows = shows
+	}
+	return resp, nil
+}
+
+// locate resolves clientIP to a location with at least a city. Lookup
+// failures are logged and treated as "unknown" — the defaults still serve.
+func (s *NearbyShowsService) locate(clientIP string) *geoip.Location {
+	if s.locator == nil {
+		return nil
+	}
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return nil
+	}
+	loc, ok, err := s.locator.Lookup(ip)
+	if err != nil {
+		slog.Warn("geoip lookup failed", "error", err)
+		return nil
+	}
+	if !ok || loc.City == "" {
+		return nil
+	}
+	return &loc
+}
+
+// inferredArea builds the show filter for a located visitor: their metro
+// when the city belongs to one, else the exact city.
+func (s *NearbyShowsService) inferredArea(loc geoip.Location) (*contracts.NearbyShowsResponse, *contracts.UpcomingShowsFilter) {
+	resp := &contracts.NearbyShowsResponse{Source: contracts.NearbySourceIP}
+	if metro, ok := s.geocoder.ResolveMetro(loc.City, loc.State, loc.Country); ok {
+		resp.Area = metro.Name
+		resp.MetroCode = metro.CBSACode
+		return resp, &contracts.UpcomingShowsFilter{Metro: metro.CBSACode}
+	}
+	if loc.State == "" {
+		// Show city/state are both required columns; a bare city name would
+		// match every namesake.
+		return nil, nil
+	}
+	resp.Area = cityLabel(loc.City, loc.State)
+	resp.Cities = []contracts.NearbyCity{{City: loc.City, State: loc.State}}
+	return resp, &contracts.UpcomingShowsFilter{City: loc.City, State: loc.State}
+}
+
+func cityLabel(city, state string) string {
+	return fmt.Sprintf("%s, %s", city, state)
+}
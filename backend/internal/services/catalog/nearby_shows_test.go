@@ -0,0 +1,162 @@
+package catalog
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/geoip"
+)
+
+// stubLocator returns a fixed lookup result.
+type stubLocator struct {
+	loc geoip.Location
+	ok  bool
+	err error
+}
+
+func (s stubLocator) Lookup(net.IP) (geoip.Location, bool, error) {
+	return s.loc, s.ok, s.err
+}
+
+// stubUpcomingShows records GetUpcomingShows filters and answers from a
+// per-call function. Other ShowServiceInterface methods are not used.
+type stubUpcomingShows struct {
+	contracts.ShowServiceInterface
+	filters []*contracts.UpcomingShowsFilter
+	fn      func(f *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, error)
+}
+
+func (s *stubUpcomingShows) GetUpcomingShows(_ string, _ string, _ int, _ bool, f *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, *string, error) {
+	s.filters = append(s.filters, f)
+	shows, err := s.fn(f)
+	return shows, nil, err
+}
+
+var nearbyDefaults = []contracts.CityStateFilter{{City: "Phoenix", State: "AZ"}, {City: "Mesa", State: "AZ"}}
+
+func oneShow(id uint) func(*contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, error) {
+	return func(*contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, error) {
+		return []*contracts.ShowResponse{{ID: id}}, nil
+	}
+}
+
+func TestGetNearbyShows_InfersMetroFromIP(t *testing.T) {
+	shows := &stubUpcomingShows{fn: oneShow(7)}
+	locator := stubLocator{loc: geoip.Location{City: "Tempe", State: "AZ", Country: "US"}, ok: true}
+	svc := NewNearbyShowsService(shows, locator, nearbyDefaults)
+
+	resp, err := svc.GetNearbyShows("203.0.113.9", "America/Phoenix", 20)
+	require.NoError(t, err)
+
+	assert.Equal(t, contracts.NearbySourceIP, resp.Source)
+	assert.Equal(t, "38060", resp.MetroCode, "Tempe rolls up to Phoenix-Mesa-Chandler")
+	assert.Contains(t, resp.Area, "Phoenix")
+	require.NotNil(t, resp.Location)
+	assert.Equal(t, "Tempe", resp.Location.City)
+	require.Len(t, resp.Shows, 1)
+	assert.Equal(t, uint(7), resp.Shows[0].ID)
+	require.Len(t, shows.filters, 1)
+	assert.Equal(t, "38060", shows.filters[0].Metro)
+}
+
+func TestGetNearbyShows_CityOutsideAnyMetro(t *testing.T) {
+	shows := &stubUpcomingShows{fn: oneShow(3)}
+	locator := stubLocator{loc: geoip.Location{City: "Berlin", State: "BE", Country: "DE"}, ok: true}
+	svc := NewNearbyShowsService(shows, locator, nearbyDefaults)
+
+	resp, err := svc.GetNearbyShows("203.0.113.9", "UTC", 20)
+	require.NoError(t, err)
+
+	assert.Equal(t, contracts.NearbySourceIP, resp.Source)
+	assert.Empty(t, resp.MetroCode)
+	assert.Equal(t, "Berlin, BE", resp.Area)
+	assert.Equal(t, []contracts.NearbyCity{{City: "Berlin", State: "BE"}}, resp.Cities)
+	require.Len(t, shows.filters, 1)
+	assert.Equal(t, "Berlin", shows.filters[0].City)
+	assert.Equal(t, "BE", shows.filters[0].State)
+}
+
+func TestGetNearbyShows_FallsBackWhenAreaHasNoShows(t *testing.T) {
+	shows := &stubUpcomingShows{fn: func(f *contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, error) {
+		if f.Metro != "" {
+			return nil, nil
+		}
+		return []*contracts.ShowResponse{{ID: 1}}, nil
+	}}
+	locator := stubLocator{loc: geoip.Location{City: "Tempe", State: "AZ", Country: "US"}, ok: true}
+	svc := NewNearbyShowsService(shows, locator, nearbyDefaults)
+
+	resp, err := svc.GetNearbyShows("203.0.113.9", "UTC", 20)
+	require.NoError(t, err)
+
+	assert.Equal(t, contracts.NearbySourceDefault, resp.Source)
+	require.NotNil(t, resp.Location, "the inferred location is still reported")
+	assert.Equal(t, "Tempe", resp.Location.City)
+	assert.Empty(t, resp.MetroCode)
+	assert.Equal(t, "Phoenix, AZ · Mesa, AZ", resp.Area)
+	assert.Len(t, resp.Cities, 2)
+	require.Len(t, shows.filters, 2)
+	assert.Equal(t, nearbyDefaults, shows.filters[1].Cities)
+}
+
+func TestGetNearbyShows_DefaultsWhenNothingInferred(t *testing.T) {
+	tests := []struct {
+		name    string
+		locator geoip.Locator
+		ip      string
+	}{
+		{"no geoip database", nil, "203.0.113.9"},
+		{"unparseable ip", stubLocator{ok: true, loc: geoip.Location{City: "Tempe", State: "AZ"}}, "not-an-ip"},
+		{"unknown address", stubLocator{ok: false}, "203.0.113.9"},
+		{"country-only record", stubLocator{ok: true, loc: geoip.Location{Country: "US"}}, "203.0.113.9"},
+		{"bare city without metro or state", stubLocator{ok: true, loc: geoip.Location{City: "Nowhereville"}}, "203.0.113.9"},
+		{"lookup error", stubLocator{err: errors.New("corrupt")}, "203.0.113.9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shows := &stubUpcomingShows{fn: oneShow(1)}
+			svc := NewNearbyShowsService(shows, tt.locator, nearbyDefaults)
+
+			resp, err := svc.GetNearbyShows(tt.ip, "UTC", 20)
+			require.NoError(t, err)
+
+			assert.Equal(t, contracts.NearbySourceDefault, resp.Source)
+			require.Len(t, shows.filters, 1)
+			assert.Equal(t, nearbyDefaults, shows.filters[0].Cities)
+			assert.Len(t, resp.Shows, 1)
+		})
+	}
+}
+
+func TestGetNearbyShows_NoDefaultsConfigured(t *testing.T) {
+	shows := &stubUpcomingShows{fn: oneShow(1)}
+	svc := NewNearbyShowsService(shows, nil, nil)
+
+	resp, err := svc.GetNearbyShows("203.0.113.9", "UTC", 20)
+	require.NoError(t, err)
+	assert.Equal(t, contracts.NearbySourceDefault, resp.Source)
+	assert.NotNil(t, resp.Shows)
+	assert.Empty(t, resp.Shows)
+	assert.Empty(t, shows.filters, "no unfiltered query is run")
+}
+
+func TestGetNearbyShows_PropagatesQueryError(t *testing.T) {
+	shows := &stubUpcomingShows{fn: func(*contracts.UpcomingShowsFilter) ([]*contracts.ShowResponse, error) {
+		return nil, errors.New("db down")
+	}}
+	svc := NewNearbyShowsService(shows, nil, nearbyDefaults)
+
+	_, err := svc.GetNearbyShows("203.0.113.9", "UTC", 20)
+	assert.Error(t, err)
+}
+
+func TestParseCityStateFilters(t *testing.T) {
+	got := ParseCityStateFilters(" Phoenix , AZ|bad|Mesa,|Tucson,AZ|Tempe,AZ", 2)
+	assert.Equal(t, []contracts.CityStateFilter{{City: "Phoenix", State: "AZ"}, {City: "Tucson", State: "AZ"}}, got)
+	assert.Nil(t, ParseCityStateFilters("", 10))
+}
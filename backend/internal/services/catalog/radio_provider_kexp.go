@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 const (
@@ -35,9 +37,7 @@ type KEXPProvider struct {
 // NewKEXPProvider creates a new KEXP provider with rate limiting.
 func NewKEXPProvider() *KEXPProvider {
 	return &KEXPProvider{
-		httpClient: &http.Client{
-			Timeout: kexpDefaultTimeout,
-		},
+		httpClient:  httpclient.New(kexpDefaultTimeout),
 		baseURL:     kexpBaseURL,
 		rateLimiter: time.NewTicker(kexpRateLimit),
 	}
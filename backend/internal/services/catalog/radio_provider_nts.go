@@ -11,6 +11,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 // errNTSNotFound is returned by doGet when the NTS API responds with 404.
@@ -41,9 +43,7 @@ type NTSProvider struct {
 // NewNTSProvider creates a new NTS provider with rate limiting.
 func NewNTSProvider() *NTSProvider {
 	return &NTSProvider{
-		httpClient: &http.Client{
-			Timeout: ntsDefaultTimeout,
-		},
+		httpClient:  httpclient.New(ntsDefaultTimeout),
 		baseURL:     ntsBaseURL,
 		rateLimiter: time.NewTicker(ntsRateLimit),
 	}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"golang.org/x/net/html"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 const (
@@ -29,9 +31,7 @@ type WFMUProvider struct {
 // NewWFMUProvider creates a new WFMU provider with rate limiting.
 func NewWFMUProvider() *WFMUProvider {
 	return &WFMUProvider{
-		httpClient: &http.Client{
-			Timeout: wfmuDefaultTimeout,
-		},
+		httpClient:  httpclient.New(wfmuDefaultTimeout),
 		baseURL:     wfmuBaseURL,
 		rateLimiter: time.NewTicker(wfmuRateLimit),
 	}
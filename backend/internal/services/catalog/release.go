@@ -202,6 +202,8 @@ func (s *ReleaseService) SearchReleases(query string) ([]*contracts.ReleaseListR
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Return empty results for empty query
 	if query == "" {
 		return []*contracts.ReleaseListResponse{}, nil
@@ -212,14 +214,14 @@ func (s *ReleaseService) SearchReleases(query string) ([]*contracts.ReleaseListR
 
 	if len(query) <= 2 {
 		// For short queries: prefix match
-		err = s.db.
+		err = replica.
 			Where("LOWER(title) LIKE LOWER(?)", shared.LikePrefixPattern(query)).
 			Order("title ASC").
 			Limit(20).
 			Find(&releases).Error
 	} else {
 		// For longer queries: ILIKE substring match, ordered by title
-		err = s.db.
+		err = replica.
 			Where("title ILIKE ?", shared.LikePattern(query)).
 			Order("title ASC").
 			Limit(20).
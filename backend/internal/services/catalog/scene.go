@@ -267,7 +267,7 @@ func (s *SceneService) ListScenes() ([]*contracts.SceneListResponse, error) {
 		       COUNT(DISTINCT s.id) FILTER (WHERE s.event_date >= ? AND s.event_date < ?) AS this_week_count
 		FROM venues v
 		LEFT JOIN show_venues sv ON sv.venue_id = v.id
-		LEFT JOIN shows s ON s.id = sv.show_id AND s.status = ?
+		LEFT JOIN shows s ON s.id = sv.show_id AND s.status = ? AND s.deleted_at IS NULL
 		WHERE true
 		  `+sceneVenueEligibilitySQL+`
 		GROUP BY `+sceneGroupKeySQL+`
@@ -349,6 +349,7 @@ func (s *SceneService) ListScenes() ([]*contracts.SceneListResponse, error) {
 			Latitude:          lat,
 			Longitude:         lng,
 			DominantGenre:     dominantGenreFamily(genresByScene[sceneKey]),
+			Metro:             g.Metro,
 		})
 	}
 
@@ -404,7 +405,7 @@ func (s *SceneService) GetSceneDetail(city, state string) (*contracts.SceneDetai
 		JOIN show_venues sv ON sv.show_id = s.id
 		JOIN venues v ON v.id = sv.venue_id
 		WHERE `+vp+`
-		  AND s.status = ?
+		  AND s.status = ? AND s.deleted_at IS NULL
 		  AND s.event_date >= ?
 	`, venueArgs(catalogm.ShowStatusApproved, now)...).Scan(&upcomingShowCount).Error; err != nil {
 		return nil, fmt.Errorf("failed to count upcoming shows: %w", err)
@@ -449,7 +450,7 @@ func (s *SceneService) GetSceneDetail(city, state string) (*contracts.SceneDetai
 		JOIN show_venues sv ON sv.show_id = s.id
 		JOIN venues v ON v.id = sv.venue_id
 		WHERE `+vp+`
-		  AND s.status = ?
+		  AND s.status = ? AND s.deleted_at IS NULL
 		  AND s.event_date >= ? AND s.event_date < ?
 	`, venueArgs(catalogm.ShowStatusApproved, thisMonthStart, nextMonthStart)...).Scan(&showsThisMonth)
 
@@ -461,7 +462,7 @@ func (s *SceneService) GetSceneDetail(city, state string) (*contracts.SceneDetai
 		JOIN show_venues sv ON sv.show_id = s.id
 		JOIN venues v ON v.id = sv.venue_id
 		WHERE `+vp+`
-		  AND s.status = ?
+		  AND s.status = ? AND s.deleted_at IS NULL
 		  AND s.event_date >= ? AND s.event_date < ?
 	`, venueArgs(catalogm.ShowStatusApproved, prevMonthStart, thisMonthStart)...).Scan(&showsPrevMonth)
 
@@ -487,7 +488,7 @@ func (s *SceneService) GetSceneDetail(city, state string) (*contracts.SceneDetai
 			SELECT sa.artist_id, MIN(s.event_date) AS first_show
 			FROM show_artists sa
 			JOIN shows s ON s.id = sa.show_id
-			WHERE s.status = ?
+			WHERE s.status = ? AND s.deleted_at IS NULL
 			  AND sa.artist_id IN (SELECT a2.id FROM artists a2 WHERE `+ap+`)
 			GROUP BY sa.artist_id
 			HAVING MIN(s.event_date) >= ?
@@ -502,7 +503,7 @@ func (s *SceneService) GetSceneDetail(city, state string) (*contracts.SceneDetai
 		JOIN show_venues sv ON sv.venue_id = v.id
 		JOIN shows s ON s.id = sv.show_id
 		WHERE `+vp+`
-		  AND s.status = ?
+		  AND s.status = ? AND s.deleted_at IS NULL
 		  AND s.event_date >= ? AND s.event_date < ?
 	`, venueArgs(catalogm.ShowStatusApproved, thisMonthStart, nextMonthStart)...).Scan(&activeVenuesThisMonth)
 
@@ -518,7 +519,7 @@ func (s *SceneService) GetSceneDetail(city, state string) (*contracts.SceneDetai
 			JOIN show_venues sv ON sv.show_id = s.id
 			JOIN venues v ON v.id = sv.venue_id
 			WHERE `+vp+`
-			  AND s.status = ?
+			  AND s.status = ? AND s.deleted_at IS NULL
 			  AND s.event_date >= ? AND s.event_date < ?
 		`, venueArgs(catalogm.ShowStatusApproved, monthStart, monthEnd)...).Scan(&count)
 		showsByMonth[5-i] = int(count)
@@ -585,7 +586,7 @@ func (s *SceneService) GetSceneUpcomingShows(city, state string, windowDays, lim
 		JOIN show_venues sv ON sv.show_id = s.id
 		JOIN venues v ON v.id = sv.venue_id
 		WHERE `+vp+`
-		  AND s.status = ?
+		  AND s.status = ? AND s.deleted_at IS NULL
 		  AND s.event_date >= ?
 		  AND s.event_date < ?
 		GROUP BY s.id, s.slug, s.title, s.event_date -- id is the PK; slug/title/date ride along
@@ -740,7 +741,7 @@ func (s *SceneService) GetActiveArtists(city, state string, activeWindowDays, li
 			       MAX(s.event_date) AS last_show
 			FROM show_artists sa
 			JOIN shows s ON s.id = sa.show_id
-			WHERE s.status = ?
+			WHERE s.status = ? AND s.deleted_at IS NULL
 			GROUP BY sa.artist_id
 		) ss ON ss.artist_id = a.id
 		WHERE `+ap+`
@@ -820,7 +821,7 @@ func (s *SceneService) GetRepresentativeEmbed(city, state string, activeWindowDa
 			       MAX(s.event_date) AS last_show
 			FROM show_artists sa
 			JOIN shows s ON s.id = sa.show_id
-			WHERE s.status = ?
+			WHERE s.status = ? AND s.deleted_at IS NULL
 			GROUP BY sa.artist_id
 		) ss ON ss.artist_id = a.id
 		WHERE `+ap+` AND a.bandcamp_embed_url IS NOT NULL AND a.bandcamp_embed_url <> ''
@@ -1343,7 +1344,7 @@ func (s *SceneService) querySceneArtistsWithPrimaryVenue(scope sceneScope) ([]sc
 				COUNT(DISTINCT s.id) AS show_count,
 				MAX(s.event_date) AS last_show
 			FROM show_artists sa
-			JOIN shows s ON s.id = sa.show_id AND s.status = ?
+			JOIN shows s ON s.id = sa.show_id AND s.status = ? AND s.deleted_at IS NULL
 			JOIN show_venues sv ON sv.show_id = s.id
 			JOIN venues v ON v.id = sv.venue_id AND %s
 			WHERE sa.artist_id IN (SELECT artist_id FROM scene_artists)
@@ -1379,7 +1380,7 @@ func (s *SceneService) querySceneArtistsWithPrimaryVenue(scope sceneScope) ([]sc
 			JOIN shows s ON s.id = sa.show_id
 			JOIN show_venues sv ON sv.show_id = s.id
 			JOIN venues v ON v.id = sv.venue_id
-			WHERE %s AND s.status = ?
+			WHERE %s AND s.status = ? AND s.deleted_at IS NULL
 				AND sa.artist_id IN (SELECT artist_id FROM selected_roster)
 			GROUP BY sa.artist_id, v.id, v.name
 		)
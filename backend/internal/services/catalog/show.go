@@ -3,11 +3,14 @@ package catalog
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"hash/fnv"
 	"log"
+	"log/slog"
 	"regexp"
 	"sort"
 	"strconv"
@@ -19,6 +22,7 @@ import (
 
 	"psychic-homily-backend/db"
 	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	engagementm "psychic-homily-backend/internal/models/engagement"
 	"psychic-homily-backend/internal/services/contracts"
@@ -44,6 +48,12 @@ type ShowService struct {
 	// has-shows city for a new visitor (PSY-981). It's process-wide and
 	// stateless, so sharing geo.Default() is safe.
 	geocoder geo.Geocoder
+	// events announces pending submissions to open admin event streams.
+	// Optional/nil-safe; wired in the service container (SetEventPublisher).
+	events contracts.AdminEventPublisher
+	// flyerRemover deletes a purged show's stored flyer objects.
+	// Optional/nil-safe; wired in the service container (SetFlyerRemover).
+	flyerRemover func(ctx context.Context, flyerKey string)
 }
 
 // NewShowService creates a new show service
@@ -57,144 +67,239 @@ func NewShowService(database *gorm.DB) *ShowService {
 	}
 }
 
+// SetEventPublisher wires the admin event bus used to announce pending
+// show submissions.
+func (s *ShowService) SetEventPublisher(p contracts.AdminEventPublisher) {
+	s.events = p
+}
+
+// SetFlyerRemover wires the hook that deletes uploaded flyer objects when a
+// show is permanently deleted.
+func (s *ShowService) SetFlyerRemover(fn func(ctx context.Context, flyerKey string)) {
+	s.flyerRemover = fn
+}
+
 // CreateShow creates a new show with associated venues and artists.
 // Prevents duplicate headliners at the same venue on the same date/time.
 // Prevents duplicate venues with the same name in the same city.
 // Status is determined based on venue verification and submitter admin status.
+// Looser matches (nearby times, similar names, partial lineups) don't block
+// the submission; they are returned as PossibleDuplicates.
 func (s *ShowService) CreateShow(req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
 	if s.db == nil {
 		return nil, fmt.Errorf("database not initialized")
 	}
 
-	// Use transaction for data consistency
 	var response *contracts.ShowResponse
 	err := s.db.Transaction(func(tx *gorm.DB) error {
-		// Check for duplicate headliner-venue-date conflicts
-		if err := s.checkDuplicateHeadlinerConflicts(tx, req); err != nil {
-			return err
-		}
+		var err error
+		response, err = s.createShowTx(tx, req)
+		return err
+	})
 
-		// Determine show status based on venue verification and privacy preference
-		status := s.determineShowStatus(tx, req.Venues, req.SubmitterIsAdmin, req.IsPrivate)
+	if err != nil {
+		return nil, err
+	}
 
-		// Create the show
-		show := &catalogm.Show{
-			Title:          req.Title,
-			EventDate:      req.EventDate.UTC(), // Ensure UTC storage
-			City:           &req.City,
-			State:          &req.State,
-			Price:          req.Price,
-			AgeRequirement: &req.AgeRequirement,
-			Description:    &req.Description,
-			ImageURL:       req.ImageURL,
-			Status:         status,
-			SubmittedBy:    req.SubmittedByUserID,
-		}
-		if req.TicketURL != "" {
-			show.TicketURL = &req.TicketURL
-		}
+	// Duplicate scoring is advisory; a failure never fails the submission.
+	candidates, err := s.FindDuplicateCandidates(response.ID)
+	if err != nil {
+		slog.Warn("show duplicate detection failed", "show_id", response.ID, "error", err)
+	} else if len(candidates) > 0 {
+		response.PossibleDuplicates = candidates
+	}
+
+	if s.events != nil && response.Status == string(catalogm.ShowStatusPending) {
+		s.events.Publish(contracts.AdminEvent{
+			Type:       contracts.AdminEventShowSubmitted,
+			ID:         response.ID,
+			EntityType: "show",
+			EntityID:   response.ID,
+			Summary:    response.Title,
+		})
+	}
 
-		if err := tx.Create(show).Error; err != nil {
-			return fmt.Errorf("failed to create show: %w", err)
-		}
+	return response, nil
+}
 
-		// Associate venues (pass admin status for venue verification)
-		venues, err := s.associateVenues(tx, show.ID, req.Venues, req.SubmitterIsAdmin)
-		if err != nil {
-			return fmt.Errorf("failed to associate venues: %w", err)
-		}
+// createShowTx inserts a show with its venues, artists and slug inside the
+// caller's transaction. Shared by CreateShow and series occurrence generation.
+func (s *ShowService) createShowTx(tx *gorm.DB, req *contracts.CreateShowRequest) (*contracts.ShowResponse, error) {
+	// Check for duplicate headliner-venue-date conflicts
+	if err := s.checkDuplicateHeadlinerConflicts(tx, req); err != nil {
+		return nil, err
+	}
 
-		// Associate artists
-		artists, err := s.associateArtists(tx, show.ID, req.Artists)
-		if err != nil {
-			return fmt.Errorf("failed to associate artists: %w", err)
-		}
+	// Determine show status based on venue verification and privacy preference
+	status := s.determineShowStatus(tx, req.Venues, req.SubmitterIsAdmin, req.IsPrivate, req.HoldForReview)
 
-		// Stamp the denormalized (event_date, venue_id) columns on the
-		// just-created show_artists rows so the partial unique index
-		// `shows_artist_venue_eventdate_uniq` covers them (PSY-576).
-		if err := syncShowArtistDedupColumns(tx, show.ID); err != nil {
-			return fmt.Errorf("failed to sync show_artists dedup columns: %w", err)
-		}
+	// Create the show
+	show := &catalogm.Show{
+		Title:          req.Title,
+		EventDate:      req.EventDate.UTC(), // Ensure UTC storage
+		City:           &req.City,
+		State:          &req.State,
+		AgeRequirement: &req.AgeRequirement,
+		Description:    &req.Description,
+		ImageURL:       req.ImageURL,
+		Status:         status,
+		SubmittedBy:    req.SubmittedByUserID,
+		PriceMin:       req.PriceMin,
+		PriceMax:       req.PriceMax,
+		PriceCurrency:  req.PriceCurrency,
+		IsFree:         req.IsFree,
+	}
+	if show.PriceCurrency == "" {
+		show.PriceCurrency = utils.DefaultPriceCurrency
+	}
+	if req.DoorsTime != nil {
+		doors := req.DoorsTime.UTC()
+		show.DoorsTime = &doors
+	}
+	if req.TicketURL != "" {
+		show.TicketURL = &req.TicketURL
+	}
+	if req.TicketProvider != "" {
+		show.TicketProvider = &req.TicketProvider
+	}
+
+	if err := tx.Create(show).Error; err != nil {
+		return nil, fmt.Errorf("failed to create show: %w", err)
+	}
+
+	// Associate venues (pass admin status for venue verification)
+	venues, err := s.associateVenues(tx, show.ID, req.Venues, req.SubmitterIsAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to associate venues: %w", err)
+	}
 
-		// Generate slug after artists and venues are associated
-		headlinerName := "unknown"
-		venueName := "unknown"
-		for _, a := range artists {
-			if a.IsHeadliner != nil && *a.IsHeadliner {
-				headlinerName = a.Name
-				break
+	// Default a blank city/state to the primary venue's and flag a submitted
+	// location that disagrees with it.
+	var warnings []string
+	if primary, ok := primaryShowVenue(venues); ok {
+		city, state, warning := resolveShowLocation(req.City, req.State, primary)
+		if city != req.City || state != req.State {
+			if err := tx.Model(show).Updates(map[string]interface{}{"city": city, "state": state}).Error; err != nil {
+				return nil, fmt.Errorf("failed to set show location: %w", err)
 			}
+			show.City, show.State = &city, &state
 		}
-		if len(artists) > 0 && headlinerName == "unknown" {
-			headlinerName = artists[0].Name
-		}
-		if len(venues) > 0 {
-			venueName = venues[0].Name
+		if warning != "" {
+			warnings = append(warnings, warning)
 		}
+	}
 
-		// Use show state for timezone-aware slug date
-		showState := ""
-		if show.State != nil {
-			showState = *show.State
-		}
-		baseSlug := utils.GenerateShowSlug(show.EventDate, headlinerName, venueName, showState)
-		slug := utils.GenerateUniqueSlug(baseSlug, func(candidate string) bool {
-			var count int64
-			tx.Model(&catalogm.Show{}).Where("slug = ?", candidate).Count(&count)
-			return count > 0
-		})
+	// Associate artists
+	artists, err := s.associateArtists(tx, show.ID, req.Artists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to associate artists: %w", err)
+	}
 
-		// Update show with slug
-		if err := tx.Model(show).Update("slug", slug).Error; err != nil {
-			return fmt.Errorf("failed to update show slug: %w", err)
-		}
-
-		// Build response
-		response = &contracts.ShowResponse{
-			ID:              show.ID,
-			Slug:            slug,
-			Title:           show.Title,
-			EventDate:       show.EventDate,
-			City:            show.City,
-			State:           show.State,
-			Price:           show.Price,
-			AgeRequirement:  show.AgeRequirement,
-			Description:     show.Description,
-			TicketURL:       show.TicketURL,
-			ImageURL:        show.ImageURL,
-			Status:          string(show.Status),
-			SubmittedBy:     show.SubmittedBy,
-			RejectionReason: show.RejectionReason,
-			Venues:          venues,
-			Artists:         artists,
-			CreatedAt:       show.CreatedAt,
-			UpdatedAt:       show.UpdatedAt,
+	// Stamp the denormalized (event_date, venue_id) columns on the
+	// just-created show_artists rows so the partial unique index
+	// `shows_artist_venue_eventdate_uniq` covers them (PSY-576).
+	if err := syncShowArtistDedupColumns(tx, show.ID); err != nil {
+		return nil, fmt.Errorf("failed to sync show_artists dedup columns: %w", err)
+	}
+	if err := stampShowLocalTime(tx, show.ID); err != nil {
+		return nil, err
+	}
+
+	// Generate slug after artists and venues are associated
+	headlinerName := "unknown"
+	venueName := "unknown"
+	for _, a := range artists {
+		if a.IsHeadliner != nil && *a.IsHeadliner {
+			headlinerName = a.Name
+			break
 		}
+	}
+	if len(artists) > 0 && headlinerName == "unknown" {
+		headlinerName = artists[0].Name
+	}
+	if len(venues) > 0 {
+		venueName = venues[0].Name
+	}
 
-		return nil
+	// Use show state for timezone-aware slug date
+	showState := ""
+	if show.State != nil {
+		showState = *show.State
+	}
+	baseSlug := utils.GenerateShowSlug(show.EventDate, headlinerName, venueName, showState)
+	slug := utils.GenerateUniqueSlug(baseSlug, func(candidate string) bool {
+		var count int64
+		tx.Model(&catalogm.Show{}).Where("slug = ?", candidate).Count(&count)
+		return count > 0
 	})
 
-	if err != nil {
+	// Update show with slug
+	if err := tx.Model(show).Update("slug", slug).Error; err != nil {
+		return nil, fmt.Errorf("failed to update show slug: %w", err)
+	}
+	if err := AssignShowShortCode(tx, show); err != nil {
 		return nil, err
 	}
 
+	// Build response
+	response := &contracts.ShowResponse{
+		ID:              show.ID,
+		Slug:            slug,
+		Title:           show.Title,
+		EventDate:       show.EventDate,
+		DoorsTime:       show.DoorsTime,
+		City:            show.City,
+		State:           show.State,
+		ShowPrice:       shared.ShowPriceOf(show),
+		AgeRequirement:  show.AgeRequirement,
+		Description:     show.Description,
+		TicketURL:       show.TicketURL,
+		TicketProvider:  show.TicketProvider,
+		ImageURL:        show.ImageURL,
+		Status:          string(show.Status),
+		SubmittedBy:     show.SubmittedBy,
+		RejectionReason: show.RejectionReason,
+		Venues:          venues,
+		Artists:         artists,
+		CreatedAt:       show.CreatedAt,
+		UpdatedAt:       show.UpdatedAt,
+		Warnings:        warnings,
+		ShortCode:       show.ShortCode,
+	}
+	shared.SetShowLocalTimes(response)
+	setShowGenres(tx, response)
+
 	return response, nil
 }
 
 // determineShowStatus determines whether a show should be approved or private.
 // Shows from unverified venues are now approved but display city-only until venue is verified.
-// Private shows remain private (user's list only).
-func (s *ShowService) determineShowStatus(tx *gorm.DB, venues []contracts.CreateShowVenue, isAdmin bool, isPrivate bool) catalogm.ShowStatus {
+// Private shows remain private (user's list only). Public shows from a
+// throttled submitter are held as pending for moderator review.
+func (s *ShowService) determineShowStatus(tx *gorm.DB, venues []contracts.CreateShowVenue, isAdmin bool, isPrivate bool, holdForReview bool) catalogm.ShowStatus {
 	// Private shows stay private regardless of venue status
 	if isPrivate {
 		return catalogm.ShowStatusPrivate
 	}
 
+	if holdForReview {
+		return catalogm.ShowStatusPending
+	}
+
 	// All other shows are approved - unverified venues will show city-only on frontend
 	return catalogm.ShowStatusApproved
 }
 
+// headlinerConflictError reports a duplicate (headliner, venue, event_date)
+// show. Typed so series generation can skip the date instead of failing.
+type headlinerConflictError struct {
+	msg string
+}
+
+func (e *headlinerConflictError) Error() string {
+	return e.msg
+}
+
 // checkDuplicateHeadlinerConflicts checks if any headliners are already performing
 // at the same venue on the same date/time.
 // Uses pg_advisory_xact_lock to prevent race conditions where two concurrent
@@ -266,8 +371,8 @@ func (s *ShowService) checkDuplicateHeadlinerConflicts(tx *gorm.DB, req *contrac
 			}
 
 			if len(existingShows) > 0 {
-				return fmt.Errorf("headliner '%s' is already performing at venue '%s' on %s",
-					headlinerName, venueName, req.EventDate.Format("2006-01-02 15:04:05 UTC"))
+				return &headlinerConflictError{msg: fmt.Sprintf("headliner '%s' is already performing at venue '%s' on %s",
+					headlinerName, venueName, req.EventDate.Format("2006-01-02 15:04:05 UTC"))}
 			}
 		}
 	}
@@ -282,7 +387,7 @@ func (s *ShowService) GetShow(showID uint) (*contracts.ShowResponse, error) {
 	}
 
 	var show catalogm.Show
-	err := s.db.Preload("Venues").Preload("Artists").First(&show, showID).Error
+	err := s.db.Preload("Venues").Preload("Artists").Where("deleted_at IS NULL").First(&show, showID).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, apperrors.ErrShowNotFound(showID)
@@ -290,7 +395,7 @@ func (s *ShowService) GetShow(showID uint) (*contracts.ShowResponse, error) {
 		return nil, fmt.Errorf("failed to get show: %w", err)
 	}
 
-	return s.buildShowResponse(&show), nil
+	return s.buildShowDetailResponse(&show), nil
 }
 
 // GetShowBySlug retrieves a show by slug with all associations
@@ -300,15 +405,57 @@ func (s *ShowService) GetShowBySlug(slug string) (*contracts.ShowResponse, error
 	}
 
 	var show catalogm.Show
-	err := s.db.Preload("Venues").Preload("Artists").Where("slug = ?", slug).First(&show).Error
+	err := s.db.Preload("Venues").Preload("Artists").Where("slug = ? AND deleted_at IS NULL", slug).First(&show).Error
+	if err == nil {
+		return s.buildShowDetailResponse(&show), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get show: %w", err)
+	}
+
+	// Fall back to slug history so links to a renamed or merged show resolve.
+	showID, err := resolveSlugRedirect(s.db, catalogm.SlugRedirectEntityShow, slug)
 	if err != nil {
+		return nil, err
+	}
+	if showID == 0 {
+		return nil, apperrors.ErrShowNotFound(0)
+	}
+	if err := s.db.Preload("Venues").Preload("Artists").Where("deleted_at IS NULL").First(&show, showID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, apperrors.ErrShowNotFound(0)
 		}
 		return nil, fmt.Errorf("failed to get show: %w", err)
 	}
+	resp := s.buildShowDetailResponse(&show)
+	resp.CanonicalSlug = resp.Slug
+	return resp, nil
+}
 
-	return s.buildShowResponse(&show), nil
+// buildShowDetailResponse adds the detail-only fields (LastEditedAt and the
+// updates feed) to a show response.
+func (s *ShowService) buildShowDetailResponse(show *catalogm.Show) *contracts.ShowResponse {
+	resp := s.withLastEditedAt(s.buildShowResponse(show))
+	// Like LastEditedAt, a failed lookup leaves the feed empty rather than
+	// failing the read.
+	if updates, err := listShowUpdates(s.db, resp.ID); err == nil {
+		resp.Updates = updates
+	}
+	return resp
+}
+
+// withLastEditedAt sets LastEditedAt from the show's newest revision. A lookup
+// failure only leaves the timestamp unset; it never fails the read.
+func (s *ShowService) withLastEditedAt(resp *contracts.ShowResponse) *contracts.ShowResponse {
+	var last sql.NullTime
+	err := s.db.Table("revisions").
+		Select("MAX(created_at)").
+		Where("entity_type = ? AND entity_id = ?", "show", resp.ID).
+		Row().Scan(&last)
+	if err == nil && last.Valid {
+		resp.LastEditedAt = &last.Time
+	}
+	return resp
 }
 
 // GetShows retrieves shows with optional filtering
@@ -318,9 +465,21 @@ func (s *ShowService) GetShows(filters map[string]interface{}) ([]*contracts.Sho
 	}
 
 	query := s.db.Preload("Venues").Preload("Artists").
-		Where("status = ?", catalogm.ShowStatusApproved)
+		Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusApproved)
 
 	// Apply filters
+	if cities, ok := filters["cities"].([]contracts.CityStateFilter); ok && len(cities) > 0 {
+		// Multi-city filter: (city = ? AND state = ?) OR ...
+		conditions := s.db
+		for i, cs := range cities {
+			if i == 0 {
+				conditions = conditions.Where("(city = ? AND state = ?)", cs.City, cs.State)
+			} else {
+				conditions = conditions.Or("(city = ? AND state = ?)", cs.City, cs.State)
+			}
+		}
+		query = query.Where(conditions)
+	}
 	if city, ok := filters["city"].(string); ok && city != "" {
 		query = query.Where("city = ?", city)
 	}
@@ -351,6 +510,15 @@ func (s *ShowService) GetShows(filters map[string]interface{}) ([]*contracts.Sho
 			"shows.id", tf,
 		)
 	}
+	if genres, ok := filters["genre_slugs"].([]string); ok {
+		query = ApplyShowGenreFilter(query, s.db, genres)
+	}
+	if allAges, ok := filters["all_ages"].(bool); ok {
+		query = query.Where("shows.id IN (?)", showIDsWithAllAges(s.db, allAges))
+	}
+	if metro, ok := filters["metro"].(string); ok && metro != "" {
+		query = query.Where("shows.id IN (?)", showIDsInMetro(s.db, metro))
+	}
 
 	// Default ordering by event date
 	query = query.Order("event_date ASC")
@@ -378,14 +546,14 @@ func (s *ShowService) GetUserSubmissions(userID uint, limit, offset int) ([]cont
 
 	// Get total count first
 	var total int64
-	if err := s.db.Model(&catalogm.Show{}).Where("submitted_by = ?", userID).Count(&total).Error; err != nil {
+	if err := s.db.Model(&catalogm.Show{}).Where("submitted_by = ? AND deleted_at IS NULL", userID).Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count user submissions: %w", err)
 	}
 
 	// Query shows with pagination
 	var shows []catalogm.Show
 	err := s.db.Preload("Venues").Preload("Artists").
-		Where("submitted_by = ?", userID).
+		Where("submitted_by = ? AND deleted_at IS NULL", userID).
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -422,14 +590,24 @@ func showUpdatesToMap(req *contracts.UpdateShowRequest) map[string]interface{} {
 	if req.EventDate != nil {
 		updates["event_date"] = req.EventDate.UTC()
 	}
+	if req.DoorsTime != nil {
+		updates["doors_time"] = req.DoorsTime.UTC()
+	}
 	if req.City != nil {
 		updates["city"] = *req.City
 	}
 	if req.State != nil {
 		updates["state"] = *req.State
 	}
-	if req.Price != nil {
-		updates["price"] = *req.Price
+	if req.PriceMin != nil || req.PriceMax != nil || req.ClearPrice {
+		updates["price_min"] = req.PriceMin
+		updates["price_max"] = req.PriceMax
+	}
+	if req.PriceCurrency != nil {
+		updates["price_currency"] = *req.PriceCurrency
+	}
+	if req.IsFree != nil {
+		updates["is_free"] = *req.IsFree
 	}
 	if req.AgeRequirement != nil {
 		updates["age_requirement"] = *req.AgeRequirement
@@ -440,6 +618,9 @@ func showUpdatesToMap(req *contracts.UpdateShowRequest) map[string]interface{} {
 	if req.TicketURL != nil {
 		updates["ticket_url"] = *req.TicketURL
 	}
+	if req.TicketProvider != nil {
+		updates["ticket_provider"] = utils.NilIfEmpty(*req.TicketProvider)
+	}
 	if req.ImageURL != nil {
 		updates["image_url"] = utils.NilIfEmpty(*req.ImageURL)
 	}
@@ -465,6 +646,9 @@ func (s *ShowService) UpdateShow(showID uint, req *contracts.UpdateShowRequest)
 			if err := syncShowArtistDedupColumns(tx, showID); err != nil {
 				return fmt.Errorf("failed to sync show_artists dedup columns: %w", err)
 			}
+			if err := stampShowLocalTime(tx, showID); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
@@ -526,6 +710,21 @@ func (s *ShowService) UpdateShowWithRelations(
 				return fmt.Errorf("failed to sync show_artists dedup columns: %w", err)
 			}
 		}
+		// The local wall-clock is read in the primary venue's zone, so it
+		// moves with the event date or the venue set.
+		if venues != nil || eventDateChanged {
+			if err := stampShowLocalTime(tx, showID); err != nil {
+				return err
+			}
+		}
+
+		// Association-only edits skip Updates, so bump updated_at by hand:
+		// the differential sync feed finds changed shows by it.
+		if len(updates) == 0 && (venues != nil || artists != nil) {
+			if err := tx.Model(show).Update("updated_at", time.Now()).Error; err != nil {
+				return fmt.Errorf("failed to touch show: %w", err)
+			}
+		}
 
 		response, err = s.buildUpdatedShowResponse(tx, show, venues, artists, venueResponses, artistResponses)
 		return err
@@ -653,6 +852,103 @@ func (s *ShowService) replaceShowArtists(tx *gorm.DB, showID uint, artists []con
 	return artistResponses, orphanedArtists, nil
 }
 
+// SetShowLineup reorders a show's bill. lineup must list each artist already
+// on the show exactly once, in billing order, with exactly one headliner:
+// position follows slice order, set_type is replaced, and set_time is replaced
+// only when given. Adding or removing artists stays with
+// UpdateShowWithRelations. Validation failures are CodeShowValidationFailed.
+func (s *ShowService) SetShowLineup(showID uint, lineup []contracts.ShowLineupEntry) (*contracts.ShowResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	if err := validateShowLineup(lineup); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var show catalogm.Show
+		if err := tx.First(&show, showID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.ErrShowNotFound(showID)
+			}
+			return fmt.Errorf("failed to find show: %w", err)
+		}
+
+		var current []catalogm.ShowArtist
+		if err := tx.Where("show_id = ?", showID).Find(&current).Error; err != nil {
+			return fmt.Errorf("failed to fetch show artists: %w", err)
+		}
+		onShow := make(map[uint]bool, len(current))
+		for _, sa := range current {
+			onShow[sa.ArtistID] = true
+		}
+		for _, entry := range lineup {
+			if !onShow[entry.ArtistID] {
+				return apperrors.ErrShowValidationFailed(fmt.Sprintf("artist %d is not on this show", entry.ArtistID))
+			}
+		}
+		if len(lineup) != len(current) {
+			return apperrors.ErrShowValidationFailed(
+				fmt.Sprintf("lineup must list all %d artists on this show", len(current)))
+		}
+
+		for position, entry := range lineup {
+			updates := map[string]interface{}{
+				"position": position,
+				"set_type": entry.SetType,
+			}
+			if entry.SetTime != nil {
+				updates["set_time"] = entry.SetTime.UTC()
+			}
+			if err := tx.Model(&catalogm.ShowArtist{}).
+				Where("show_id = ? AND artist_id = ?", showID, entry.ArtistID).
+				Updates(updates).Error; err != nil {
+				return fmt.Errorf("failed to update show artist %d: %w", entry.ArtistID, err)
+			}
+		}
+
+		// The differential sync feed finds changed shows by updated_at.
+		if err := tx.Model(&show).Update("updated_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to touch show: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetShow(showID)
+}
+
+// validateShowLineup checks the parts of a lineup that don't need the
+// database: it is non-empty, every set type is known, no artist is listed
+// twice, and exactly one artist headlines.
+func validateShowLineup(lineup []contracts.ShowLineupEntry) error {
+	if len(lineup) == 0 {
+		return apperrors.ErrShowValidationFailed("lineup must list at least one artist")
+	}
+	seen := make(map[uint]bool, len(lineup))
+	headliners := 0
+	for _, entry := range lineup {
+		if !catalogm.IsValidSetType(entry.SetType) {
+			return apperrors.ErrShowValidationFailed(fmt.Sprintf(
+				"invalid set type %q; must be one of: %s", entry.SetType, strings.Join(catalogm.SetTypes, ", ")))
+		}
+		if seen[entry.ArtistID] {
+			return apperrors.ErrShowValidationFailed(fmt.Sprintf("artist %d is listed more than once", entry.ArtistID))
+		}
+		seen[entry.ArtistID] = true
+		if entry.SetType == catalogm.SetTypeHeadliner {
+			headliners++
+		}
+	}
+	if headliners != 1 {
+		return apperrors.ErrShowValidationFailed(
+			fmt.Sprintf("lineup must have exactly one headliner (got %d)", headliners))
+	}
+	return nil
+}
+
 // buildUpdatedShowResponse assembles the ShowResponse from the updated show
 // row and its associations. venueResponses / artistResponses carry the rebuilt
 // associations when the corresponding slice was provided; when it was nil the
@@ -682,25 +978,32 @@ func (s *ShowService) buildUpdatedShowResponse(
 		artistResponses = loaded
 	}
 
-	return &contracts.ShowResponse{
-		ID:              show.ID,
-		Title:           show.Title,
-		EventDate:       show.EventDate,
-		City:            show.City,
-		State:           show.State,
-		Price:           show.Price,
-		AgeRequirement:  show.AgeRequirement,
-		Description:     show.Description,
-		TicketURL:       show.TicketURL,
-		ImageURL:        show.ImageURL,
-		Status:          string(show.Status),
-		SubmittedBy:     show.SubmittedBy,
-		RejectionReason: show.RejectionReason,
-		Venues:          venueResponses,
-		Artists:         artistResponses,
-		CreatedAt:       show.CreatedAt,
-		UpdatedAt:       show.UpdatedAt,
-	}, nil
+	response := &contracts.ShowResponse{
+		ID:                show.ID,
+		Title:             show.Title,
+		EventDate:         show.EventDate,
+		DoorsTime:         show.DoorsTime,
+		City:              show.City,
+		State:             show.State,
+		ShowPrice:         shared.ShowPriceOf(show),
+		AgeRequirement:    show.AgeRequirement,
+		Description:       show.Description,
+		TicketURL:         show.TicketURL,
+		TicketProvider:    show.TicketProvider,
+		ImageURL:          show.ImageURL,
+		FlyerURL:          show.FlyerURL,
+		FlyerThumbnailURL: show.FlyerThumbnailURL,
+		Status:            string(show.Status),
+		SubmittedBy:       show.SubmittedBy,
+		RejectionReason:   show.RejectionReason,
+		Venues:            venueResponses,
+		Artists:           artistResponses,
+		CreatedAt:         show.CreatedAt,
+		UpdatedAt:         show.UpdatedAt,
+	}
+	shared.SetShowLocalTimes(response)
+	setShowGenres(tx, response)
+	return response, nil
 }
 
 // loadShowVenueResponses batch-loads the existing venue associations for a show
@@ -749,10 +1052,10 @@ func (s *ShowService) loadShowVenueResponses(tx *gorm.DB, showID uint) ([]contra
 }
 
 // loadShowArtistResponses batch-loads the existing artist associations for a
-// show in position order and maps them to ArtistResponse.
+// show in lineup order and maps them to ArtistResponse.
 func (s *ShowService) loadShowArtistResponses(tx *gorm.DB, showID uint) ([]contracts.ArtistResponse, error) {
 	var showArtists []catalogm.ShowArtist
-	if err := tx.Where("show_id = ?", showID).Order("position ASC").Find(&showArtists).Error; err != nil {
+	if err := tx.Where("show_id = ?", showID).Order(catalogm.ShowArtistLineupOrder).Find(&showArtists).Error; err != nil {
 		return nil, fmt.Errorf("failed to fetch show artists: %w", err)
 	}
 	if len(showArtists) == 0 {
@@ -785,6 +1088,7 @@ func (s *ShowService) loadShowArtistResponses(tx *gorm.DB, showID uint) ([]contr
 				Spotify:    artist.Social.Spotify,
 				SoundCloud: artist.Social.SoundCloud,
 				Bandcamp:   artist.Social.Bandcamp,
+				TikTok:     artist.Social.TikTok,
 				Website:    artist.Social.Website,
 			}
 			artistResponses = append(artistResponses, contracts.ArtistResponse{
@@ -795,6 +1099,7 @@ func (s *ShowService) loadShowArtistResponses(tx *gorm.DB, showID uint) ([]contr
 				IsHeadliner:      &isHeadliner,
 				SetType:          sa.SetType,
 				Position:         sa.Position,
+				SetTime:          sa.SetTime,
 				IsNewArtist:      &isNewArtist,
 				BandcampEmbedURL: artist.BandcampEmbedURL,
 				Socials:          socials,
@@ -885,6 +1190,8 @@ func (s *ShowService) GetUpcomingShows(timezone string, cursor string, limit int
 		return nil, nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Load timezone, default to UTC
 	loc, err := time.LoadLocation(timezone)
 	if err != nil {
@@ -897,8 +1204,8 @@ func (s *ShowService) GetUpcomingShows(timezone string, cursor string, limit int
 	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
 	startOfTodayUTC := startOfToday.UTC()
 
-	// Build query
-	query := s.db.Preload("Venues").Preload("Artists")
+	// Build query. Soft-deleted shows are hidden from both views.
+	query := replica.Preload("Venues").Preload("Artists").Where("deleted_at IS NULL")
 
 	// Filter by status for non-admin users (public view shows only approved)
 	if !includeNonApproved {
@@ -912,7 +1219,7 @@ func (s *ShowService) GetUpcomingShows(timezone string, cursor string, limit int
 	if filters != nil {
 		if len(filters.Cities) > 0 {
 			// Multi-city filter: (city = ? AND state = ?) OR ...
-			conditions := s.db
+			conditions := replica
 			for i, cs := range filters.Cities {
 				if i == 0 {
 					conditions = conditions.Where("(city = ? AND state = ?)", cs.City, cs.State)
@@ -935,7 +1242,7 @@ func (s *ShowService) GetUpcomingShows(timezone string, cursor string, limit int
 			// any billed artist has the tag. Direct `entity_type='show'` tags
 			// are ignored because shows are not directly tagged with genres.
 			query = ApplyTransitiveArtistTagFilter(
-				query, s.db,
+				query, replica,
 				"show_artists", "show_id", "artist_id",
 				"shows.id",
 				TagFilter{
@@ -944,6 +1251,19 @@ func (s *ShowService) GetUpcomingShows(timezone string, cursor string, limit int
 				},
 			)
 		}
+		query = ApplyShowGenreFilter(query, replica, filters.GenreSlugs)
+		if filters.Near != nil {
+			query = query.Where("shows.id IN (?)", showIDsNear(replica, filters.Near))
+		}
+		if filters.Metro != "" {
+			query = query.Where("shows.id IN (?)", showIDsInMetro(replica, filters.Metro))
+		}
+		if filters.AllAges != nil {
+			query = query.Where("shows.id IN (?)", showIDsWithAllAges(replica, *filters.AllAges))
+		}
+		if filters.MaxPrice != nil {
+			query = query.Where("(shows.is_free OR shows.price_min <= ?)", *filters.MaxPrice)
+		}
 	}
 
 	// Apply cursor filter if provided
@@ -1012,7 +1332,7 @@ func (s *ShowService) GetShowCities(timezone string) ([]contracts.ShowCityRespon
 
 	err = s.db.Model(&catalogm.Show{}).
 		Select("city, state, COUNT(*) as show_count").
-		Where("status = ?", catalogm.ShowStatusApproved).
+		Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusApproved).
 		Where("event_date >= ?", startOfTodayUTC).
 		Where("city IS NOT NULL AND city != ''").
 		Where("state IS NOT NULL AND state != ''").
@@ -1031,11 +1351,16 @@ func (s *ShowService) GetShowCities(timezone string) ([]contracts.ShowCityRespon
 	// mismatch), it has no dependency on every venue row being backfilled, and
 	// it's an in-memory lookup (no extra query, no N+1). A miss leaves both
 	// coords nil — the frontend then falls back to exact city-name matching.
+	// The CBSA metro comes from the same in-memory lookup.
 	if s.geocoder != nil {
 		for i := range results {
 			lat, lng, _ := geo.LookupPointers(s.geocoder, results[i].City, results[i].State, "")
 			results[i].Latitude = lat
 			results[i].Longitude = lng
+			if m, ok := s.geocoder.ResolveMetro(results[i].City, results[i].State, ""); ok {
+				results[i].Metro = m.CBSACode
+				results[i].MetroName = m.Name
+			}
 		}
 	}
 
@@ -1066,6 +1391,8 @@ func (s *ShowService) SearchShows(query string) ([]*contracts.ShowSearchResult,
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	// Empty / whitespace-only queries return no results — never "all shows".
 	// The handler also short-circuits before calling here as a defensive
 	// boundary; this keeps the service safe if it's ever called directly
@@ -1080,7 +1407,7 @@ func (s *ShowService) SearchShows(query string) ([]*contracts.ShowSearchResult,
 	// bill matches, then resolve headliner/venue via correlated subqueries.
 	// DISTINCT on shows.id prevents duplicate rows when both title and
 	// multiple artists match.
-	rows, err := s.db.Raw(`
+	rows, err := replica.Raw(`
 		SELECT DISTINCT ON (shows.id)
 			shows.id,
 			shows.slug,
@@ -1114,8 +1441,8 @@ func (s *ShowService) SearchShows(query string) ([]*contracts.ShowSearchResult,
 		FROM shows
 		LEFT JOIN show_artists sa_match ON sa_match.show_id = shows.id
 		LEFT JOIN artists a_match ON a_match.id = sa_match.artist_id
-		WHERE shows.title ILIKE ?
-		   OR a_match.name ILIKE ?
+		WHERE shows.deleted_at IS NULL
+		  AND (shows.title ILIKE ? OR a_match.name ILIKE ?)
 	`, pattern, pattern).Rows()
 
 	if err != nil {
@@ -1180,39 +1507,151 @@ func (s *ShowService) SearchShows(query string) ([]*contracts.ShowSearchResult,
 	return results, nil
 }
 
-// DeleteShow deletes a show and its associations
+// ShowRetentionPeriod is how long a soft-deleted show can be restored before
+// the cleanup job permanently deletes it.
+const ShowRetentionPeriod = 90 * 24 * time.Hour // 90 days
+
+// DeleteShow soft-deletes a show. The row and its venues and artists are kept
+// so an admin can restore it within ShowRetentionPeriod; every public query
+// excludes shows with deleted_at set.
 func (s *ShowService) DeleteShow(showID uint) error {
 	if s.db == nil {
 		return fmt.Errorf("database not initialized")
 	}
 
 	return s.db.Transaction(func(tx *gorm.DB) error {
-		// Polymorphic bookmarks have no FK to shows. Remove every action for
-		// this entity inside the same transaction so saved-show totals cannot
-		// retain a dangling row after deletion.
-		if err := tx.Where(
-			"entity_type = ? AND entity_id = ?",
-			engagementm.BookmarkEntityShow,
-			showID,
-		).Delete(&engagementm.UserBookmark{}).Error; err != nil {
-			return fmt.Errorf("failed to delete show bookmarks: %w", err)
-		}
+		return softDeleteShowTx(tx, showID)
+	})
+}
 
-		// Delete show associations first (cascade will handle this, but being explicit)
-		if err := tx.Where("show_id = ?", showID).Delete(&catalogm.ShowVenue{}).Error; err != nil {
-			return fmt.Errorf("failed to delete show venues: %w", err)
-		}
-		if err := tx.Where("show_id = ?", showID).Delete(&catalogm.ShowArtist{}).Error; err != nil {
-			return fmt.Errorf("failed to delete show artists: %w", err)
+// softDeleteShowTx marks a show deleted inside the caller's transaction.
+// Bookmarks are kept so RestoreShow brings saves and RSVPs back; reads skip
+// bookmarks on deleted shows, and deleteShowTx removes them once the
+// retention period is up. Bumping updated_at lets the sync feed report it in
+// the next delta's deleted list.
+func softDeleteShowTx(tx *gorm.DB, showID uint) error {
+	now := time.Now().UTC()
+	result := tx.Model(&catalogm.Show{}).
+		Where("id = ? AND deleted_at IS NULL", showID).
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete show: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrShowNotFound(showID)
+	}
+	return nil
+}
+
+// RestoreShow clears a soft-deleted show's deleted_at and returns it.
+func (s *ShowService) RestoreShow(showID uint) (*contracts.ShowResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	if err := s.db.First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(showID)
 		}
+		return nil, fmt.Errorf("failed to get show: %w", err)
+	}
+	if show.DeletedAt == nil {
+		return nil, apperrors.ErrShowValidationFailed("show is not deleted")
+	}
+
+	if err := s.db.Model(&catalogm.Show{}).
+		Where("id = ?", showID).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"updated_at": time.Now().UTC(),
+		}).Error; err != nil {
+		return nil, fmt.Errorf("failed to restore show: %w", err)
+	}
+
+	return s.GetShow(showID)
+}
 
-		// Delete the show
-		if err := tx.Delete(&catalogm.Show{}, showID).Error; err != nil {
-			return fmt.Errorf("failed to delete show: %w", err)
+// GetExpiredDeletedShows returns the IDs of shows that have been soft-deleted
+// for longer than ShowRetentionPeriod and are ready for permanent deletion.
+func (s *ShowService) GetExpiredDeletedShows() ([]uint, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	cutoff := time.Now().Add(-ShowRetentionPeriod)
+
+	var ids []uint
+	if err := s.db.Model(&catalogm.Show{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to get expired deleted shows: %w", err)
+	}
+
+	return ids, nil
+}
+
+// PermanentlyDeleteShow hard-deletes a show, its associations and its
+// bookmarks. This should only be called for shows past the retention period.
+func (s *ShowService) PermanentlyDeleteShow(showID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	// Read the flyer key before the row is gone; its stored objects are
+	// removed only once the delete has committed.
+	var show catalogm.Show
+	if s.flyerRemover != nil {
+		if err := s.db.Select("id", "flyer_key").Where("id = ?", showID).
+			Limit(1).Find(&show).Error; err != nil {
+			return fmt.Errorf("failed to get show flyer: %w", err)
 		}
+	}
 
-		return nil
-	})
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		return deleteShowTx(tx, showID)
+	}); err != nil {
+		return err
+	}
+
+	if show.FlyerKey != nil {
+		s.flyerRemover(context.Background(), *show.FlyerKey)
+	}
+	return nil
+}
+
+// deleteShowTx removes a show, its junction rows and its bookmarks inside the
+// caller's transaction.
+func deleteShowTx(tx *gorm.DB, showID uint) error {
+	// Polymorphic bookmarks have no FK to shows. Remove every action for
+	// this entity inside the same transaction so saved-show totals cannot
+	// retain a dangling row after deletion.
+	if err := tx.Where(
+		"entity_type = ? AND entity_id = ?",
+		engagementm.BookmarkEntityShow,
+		showID,
+	).Delete(&engagementm.UserBookmark{}).Error; err != nil {
+		return fmt.Errorf("failed to delete show bookmarks: %w", err)
+	}
+
+	// Delete show associations first (cascade will handle this, but being explicit)
+	if err := tx.Where("show_id = ?", showID).Delete(&catalogm.ShowVenue{}).Error; err != nil {
+		return fmt.Errorf("failed to delete show venues: %w", err)
+	}
+	if err := tx.Where("show_id = ?", showID).Delete(&catalogm.ShowArtist{}).Error; err != nil {
+		return fmt.Errorf("failed to delete show artists: %w", err)
+	}
+
+	// Delete the show
+	if err := tx.Delete(&catalogm.Show{}, showID).Error; err != nil {
+		return fmt.Errorf("failed to delete show: %w", err)
+	}
+
+	return nil
 }
 
 // GetPendingShows retrieves shows with pending status for admin review.
@@ -1223,7 +1662,7 @@ func (s *ShowService) GetPendingShows(limit, offset int, filters *contracts.Pend
 	}
 
 	// Build base query with optional filters
-	countQuery := s.db.Model(&catalogm.Show{}).Where("status = ?", catalogm.ShowStatusPending)
+	countQuery := s.db.Model(&catalogm.Show{}).Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusPending)
 	if filters != nil {
 		if filters.VenueID != nil {
 			countQuery = countQuery.Joins("JOIN show_venues ON shows.id = show_venues.show_id").
@@ -1242,7 +1681,7 @@ func (s *ShowService) GetPendingShows(limit, offset int, filters *contracts.Pend
 
 	// Get pending shows with pagination
 	findQuery := s.db.Preload("Venues").Preload("Artists").
-		Where("status = ?", catalogm.ShowStatusPending)
+		Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusPending)
 	if filters != nil {
 		if filters.VenueID != nil {
 			findQuery = findQuery.Joins("JOIN show_venues ON shows.id = show_venues.show_id").
@@ -1281,7 +1720,7 @@ func (s *ShowService) GetRejectedShows(limit, offset int, search string) ([]*con
 	}
 
 	// Build base query
-	baseQuery := s.db.Model(&catalogm.Show{}).Where("status = ?", catalogm.ShowStatusRejected)
+	baseQuery := s.db.Model(&catalogm.Show{}).Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusRejected)
 
 	// Add search filter if provided
 	if search != "" {
@@ -1298,7 +1737,7 @@ func (s *ShowService) GetRejectedShows(limit, offset int, search string) ([]*con
 	// Get rejected shows with pagination
 	var shows []catalogm.Show
 	err := s.db.Preload("Venues").Preload("Artists").
-		Where("status = ?", catalogm.ShowStatusRejected).
+		Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusRejected).
 		Scopes(func(db *gorm.DB) *gorm.DB {
 			if search != "" {
 				searchPattern := shared.LikePattern(search)
@@ -1340,18 +1779,8 @@ func (s *ShowService) ApproveShow(showID uint, verifyVenues bool) (*contracts.Sh
 			return fmt.Errorf("failed to get show: %w", err)
 		}
 
-		// Verify the show is pending or rejected
-		if show.Status != catalogm.ShowStatusPending && show.Status != catalogm.ShowStatusRejected {
-			return fmt.Errorf("show cannot be approved (current status: %s)", show.Status)
-		}
-
-		// Update show status to approved (clear rejection reason if previously rejected)
-		updates := map[string]interface{}{
-			"status":           catalogm.ShowStatusApproved,
-			"rejection_reason": "",
-		}
-		if err := tx.Model(&show).Updates(updates).Error; err != nil {
-			return fmt.Errorf("failed to approve show: %w", err)
+		if err := approveShowTx(tx, &show); err != nil {
+			return err
 		}
 
 		// Optionally verify the venues
@@ -1398,18 +1827,8 @@ func (s *ShowService) RejectShow(showID uint, reason string) (*contracts.ShowRes
 			return fmt.Errorf("failed to get show: %w", err)
 		}
 
-		// Verify the show is pending
-		if show.Status != catalogm.ShowStatusPending {
-			return fmt.Errorf("show is not pending (current status: %s)", show.Status)
-		}
-
-		// Update show status to rejected with reason
-		updates := map[string]interface{}{
-			"status":           catalogm.ShowStatusRejected,
-			"rejection_reason": reason,
-		}
-		if err := tx.Model(&show).Updates(updates).Error; err != nil {
-			return fmt.Errorf("failed to reject show: %w", err)
+		if err := rejectShowTx(tx, &show, reason, ""); err != nil {
+			return err
 		}
 
 		// Reload the show to get updated data
@@ -1428,6 +1847,74 @@ func (s *ShowService) RejectShow(showID uint, reason string) (*contracts.ShowRes
 	return response, nil
 }
 
+// approveShowTx moves a pending or rejected show to approved, clearing any
+// rejection reason, and credits the submitter and assigns the short code.
+// Shared by ApproveShow and the bulk approve so the two can't drift.
+func approveShowTx(tx *gorm.DB, show *catalogm.Show) error {
+	if show.Status != catalogm.ShowStatusPending && show.Status != catalogm.ShowStatusRejected {
+		return fmt.Errorf("show cannot be approved (current status: %s)", show.Status)
+	}
+
+	previous := show.Status
+	if err := tx.Model(show).Updates(map[string]interface{}{
+		"status":           catalogm.ShowStatusApproved,
+		"rejection_reason": "",
+	}).Error; err != nil {
+		return fmt.Errorf("failed to approve show: %w", err)
+	}
+	show.Status = catalogm.ShowStatusApproved
+	if err := recordSubmissionReview(tx, show.SubmittedBy, previous, catalogm.ShowStatusApproved); err != nil {
+		return err
+	}
+	return AssignShowShortCode(tx, show)
+}
+
+// rejectShowTx moves a pending show to rejected with reason and, when set,
+// category. Shared by RejectShow and the batch and bulk rejects.
+func rejectShowTx(tx *gorm.DB, show *catalogm.Show, reason, category string) error {
+	if show.Status != catalogm.ShowStatusPending {
+		return fmt.Errorf("show is not pending (current status: %s)", show.Status)
+	}
+
+	updates := map[string]interface{}{
+		"status":           catalogm.ShowStatusRejected,
+		"rejection_reason": reason,
+	}
+	if category != "" {
+		updates["rejection_category"] = category
+	}
+	if err := tx.Model(show).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to reject show: %w", err)
+	}
+	show.Status = catalogm.ShowStatusRejected
+	return recordSubmissionReview(tx, show.SubmittedBy, catalogm.ShowStatusPending, catalogm.ShowStatusRejected)
+}
+
+// recordSubmissionReview updates the submitter's reputation counters for a
+// moderator decision. Approving a previously rejected show moves it from the
+// rejected count to the approved one.
+func recordSubmissionReview(tx *gorm.DB, submittedBy *uint, from, to catalogm.ShowStatus) error {
+	if submittedBy == nil {
+		return nil
+	}
+	updates := map[string]interface{}{}
+	switch to {
+	case catalogm.ShowStatusApproved:
+		updates["submissions_approved_count"] = gorm.Expr("submissions_approved_count + 1")
+		if from == catalogm.ShowStatusRejected {
+			updates["submissions_rejected_count"] = gorm.Expr("GREATEST(submissions_rejected_count - 1, 0)")
+		}
+	case catalogm.ShowStatusRejected:
+		updates["submissions_rejected_count"] = gorm.Expr("submissions_rejected_count + 1")
+	default:
+		return nil
+	}
+	if err := tx.Model(&authm.User{}).Where("id = ?", *submittedBy).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update submitter reputation: %w", err)
+	}
+	return nil
+}
+
 // BatchApproveShows approves multiple pending shows at once.
 func (s *ShowService) BatchApproveShows(showIDs []uint) (*contracts.BatchShowResult, error) {
 	if s.db == nil {
@@ -1463,14 +1950,19 @@ func (s *ShowService) BatchRejectShows(showIDs []uint, reason string, category s
 	}
 
 	for _, id := range showIDs {
-		_, err := s.RejectShow(id, reason)
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			var show catalogm.Show
+			if err := tx.First(&show, id).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return apperrors.ErrShowNotFound(id)
+				}
+				return fmt.Errorf("failed to get show: %w", err)
+			}
+			return rejectShowTx(tx, &show, reason, category)
+		})
 		if err != nil {
 			result.Errors = append(result.Errors, contracts.BatchShowError{ShowID: id, Error: err.Error()})
 		} else {
-			// Update rejection_category separately since RejectShow doesn't handle it
-			if category != "" {
-				s.db.Model(&catalogm.Show{}).Where("id = ?", id).Update("rejection_category", category)
-			}
 			result.Succeeded = append(result.Succeeded, id)
 		}
 	}
@@ -1478,6 +1970,81 @@ func (s *ShowService) BatchRejectShows(showIDs []uint, reason string, category s
 	return result, nil
 }
 
+// BulkShowAction applies one admin action (approve, reject, cancel, delete) to
+// every show in req.ShowIDs. Each show runs in its own transaction so a
+// failure is recorded in its result row without affecting the others.
+func (s *ShowService) BulkShowAction(req *contracts.BulkShowActionRequest) (*contracts.BulkShowActionResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	switch req.Action {
+	case contracts.BulkShowActionApprove, contracts.BulkShowActionCancel, contracts.BulkShowActionDelete:
+	case contracts.BulkShowActionReject:
+		if strings.TrimSpace(req.Reason) == "" {
+			return nil, apperrors.NewShowError(apperrors.CodeShowValidationFailed, "rejection reason is required", nil)
+		}
+	default:
+		return nil, apperrors.NewShowError(apperrors.CodeShowValidationFailed, fmt.Sprintf("unknown bulk action: %s", req.Action), nil)
+	}
+
+	result := &contracts.BulkShowActionResult{
+		Action:  req.Action,
+		Results: make([]contracts.BulkShowItemResult, 0, len(req.ShowIDs)),
+	}
+
+	for _, id := range req.ShowIDs {
+		item := contracts.BulkShowItemResult{ShowID: id}
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			var show catalogm.Show
+			if err := tx.Where("deleted_at IS NULL").First(&show, id).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return apperrors.ErrShowNotFound(id)
+				}
+				return fmt.Errorf("failed to get show: %w", err)
+			}
+			item.Title = show.Title
+			return s.applyBulkShowAction(tx, &show, req)
+		})
+		if err != nil {
+			item.Error = err.Error()
+			result.Failed++
+		} else {
+			item.Success = true
+			result.Succeeded++
+		}
+		result.Results = append(result.Results, item)
+	}
+
+	return result, nil
+}
+
+// applyBulkShowAction runs a single bulk action against an already-loaded
+// show inside the caller's transaction, through the same tx helpers as the
+// single-show paths.
+func (s *ShowService) applyBulkShowAction(tx *gorm.DB, show *catalogm.Show, req *contracts.BulkShowActionRequest) error {
+	switch req.Action {
+	case contracts.BulkShowActionApprove:
+		return approveShowTx(tx, show)
+
+	case contracts.BulkShowActionReject:
+		return rejectShowTx(tx, show, req.Reason, req.Category)
+
+	case contracts.BulkShowActionCancel:
+		// Unlike CancelShow, a bulk cancel doesn't rewrite the reason on a
+		// show that is already cancelled.
+		if show.IsCancelled {
+			return fmt.Errorf("show is already cancelled")
+		}
+		return cancelShowTx(tx, show, req.Reason)
+
+	case contracts.BulkShowActionDelete:
+		return softDeleteShowTx(tx, show.ID)
+	}
+
+	return fmt.Errorf("unknown bulk action: %s", req.Action)
+}
+
 // UnpublishShow changes an approved show's status back to pending.
 // Only the submitter or an admin can unpublish a show.
 func (s *ShowService) UnpublishShow(showID uint, userID uint, isAdmin bool) (*contracts.ShowResponse, error) {
@@ -1621,6 +2188,9 @@ func (s *ShowService) PublishShow(showID uint, userID uint, isAdmin bool) (*cont
 		if err := tx.Preload("Venues").Preload("Artists").First(&show, showID).Error; err != nil {
 			return fmt.Errorf("failed to reload show: %w", err)
 		}
+		if err := AssignShowShortCode(tx, &show); err != nil {
+			return err
+		}
 
 		response = s.buildShowResponse(&show)
 		return nil
@@ -1763,17 +2333,18 @@ func (s *ShowService) associateArtists(tx *gorm.DB, showID uint, requestArtists
 			isNewArtist = created
 		}
 
-		// Determine set type and IsHeadliner flag
-		setType := "opener"
-		isHeadliner := false
-		if requestArtist.IsHeadliner != nil && *requestArtist.IsHeadliner {
-			setType = "headliner"
-			isHeadliner = true
+		// Determine set type and IsHeadliner flag. An explicit set type
+		// (markdown import) wins over IsHeadliner.
+		setType := catalogm.SetTypeOpener
+		if catalogm.IsValidSetType(requestArtist.SetType) {
+			setType = requestArtist.SetType
+		} else if requestArtist.IsHeadliner != nil && *requestArtist.IsHeadliner {
+			setType = catalogm.SetTypeHeadliner
 		} else if requestArtist.IsHeadliner == nil && position == 0 {
 			// Fallback: first artist is headliner if not explicitly specified
-			setType = "headliner"
-			isHeadliner = true
+			setType = catalogm.SetTypeHeadliner
 		}
+		isHeadliner := setType == catalogm.SetTypeHeadliner
 
 		// Create show-artist association with position
 		showArtist := catalogm.ShowArtist{
@@ -1782,6 +2353,10 @@ func (s *ShowService) associateArtists(tx *gorm.DB, showID uint, requestArtists
 			Position: position,
 			SetType:  setType,
 		}
+		if requestArtist.SetTime != nil {
+			setTime := requestArtist.SetTime.UTC()
+			showArtist.SetTime = &setTime
+		}
 		if err := tx.Create(&showArtist).Error; err != nil {
 			return nil, fmt.Errorf("failed to create show-artist association: %w", err)
 		}
@@ -1795,6 +2370,7 @@ func (s *ShowService) associateArtists(tx *gorm.DB, showID uint, requestArtists
 			Spotify:    artist.Social.Spotify,
 			SoundCloud: artist.Social.SoundCloud,
 			Bandcamp:   artist.Social.Bandcamp,
+			TikTok:     artist.Social.TikTok,
 			Website:    artist.Social.Website,
 		}
 
@@ -1811,15 +2387,33 @@ func (s *ShowService) associateArtists(tx *gorm.DB, showID uint, requestArtists
 			IsHeadliner:      &isHeadliner,
 			SetType:          setType,
 			Position:         position,
+			SetTime:          showArtist.SetTime,
 			IsNewArtist:      &isNewArtist,
 			BandcampEmbedURL: artist.BandcampEmbedURL,
 			Socials:          socials,
 		})
 	}
 
+	sortLineupBySetTime(artists)
 	return artists, nil
 }
 
+// sortLineupBySetTime puts a lineup built in request order into the same order
+// catalogm.ShowArtistLineupOrder gives when it is read back: artists with a set
+// time first, earliest first, then the rest by position.
+func sortLineupBySetTime(artists []contracts.ArtistResponse) {
+	sort.SliceStable(artists, func(i, j int) bool {
+		a, b := artists[i].SetTime, artists[j].SetTime
+		switch {
+		case a != nil && b != nil:
+			return a.Before(*b)
+		case a != nil || b != nil:
+			return a != nil
+		}
+		return false
+	})
+}
+
 // buildShowResponse converts a Show model to contracts.ShowResponse
 func (s *ShowService) buildShowResponse(show *catalogm.Show) *contracts.ShowResponse {
 	// Build venue responses
@@ -1851,7 +2445,7 @@ func (s *ShowService) buildShowResponse(show *catalogm.Show) *contracts.ShowResp
 
 	// Get ordered artists from show_artists table
 	var showArtists []catalogm.ShowArtist
-	if err := s.db.Where("show_id = ?", show.ID).Order("position ASC").Find(&showArtists).Error; err != nil {
+	if err := s.db.Where("show_id = ?", show.ID).Order(catalogm.ShowArtistLineupOrder).Find(&showArtists).Error; err != nil {
 		log.Printf("WARN buildShowResponse: failed to fetch show_artists for show_id=%d: %v", show.ID, err)
 	}
 
@@ -1873,7 +2467,7 @@ func (s *ShowService) buildShowResponse(show *catalogm.Show) *contracts.ShowResp
 			artistMap[allArtists[i].ID] = &allArtists[i]
 		}
 
-		// Iterate in position order
+		// Iterate in lineup order
 		for _, sa := range showArtists {
 			artist, ok := artistMap[sa.ArtistID]
 			if !ok {
@@ -1888,6 +2482,7 @@ func (s *ShowService) buildShowResponse(show *catalogm.Show) *contracts.ShowResp
 				Spotify:    artist.Social.Spotify,
 				SoundCloud: artist.Social.SoundCloud,
 				Bandcamp:   artist.Social.Bandcamp,
+				TikTok:     artist.Social.TikTok,
 				Website:    artist.Social.Website,
 			}
 
@@ -1907,6 +2502,7 @@ func (s *ShowService) buildShowResponse(show *catalogm.Show) *contracts.ShowResp
 				IsHeadliner:      &isHeadliner,
 				SetType:          sa.SetType,
 				Position:         sa.Position,
+				SetTime:          sa.SetTime,
 				IsNewArtist:      &isNewArtist,
 				BandcampEmbedURL: artist.BandcampEmbedURL,
 				Socials:          socials,
@@ -1918,18 +2514,22 @@ func (s *ShowService) buildShowResponse(show *catalogm.Show) *contracts.ShowResp
 	if show.Slug != nil {
 		showSlug = *show.Slug
 	}
-	return &contracts.ShowResponse{
+	response := &contracts.ShowResponse{
 		ID:                show.ID,
 		Slug:              showSlug,
 		Title:             show.Title,
 		EventDate:         show.EventDate,
+		DoorsTime:         show.DoorsTime,
 		City:              show.City,
 		State:             show.State,
-		Price:             show.Price,
+		ShowPrice:         shared.ShowPriceOf(show),
 		AgeRequirement:    show.AgeRequirement,
 		Description:       show.Description,
 		TicketURL:         show.TicketURL,
+		TicketProvider:    show.TicketProvider,
 		ImageURL:          show.ImageURL,
+		FlyerURL:          show.FlyerURL,
+		FlyerThumbnailURL: show.FlyerThumbnailURL,
 		Status:            string(show.Status),
 		SubmittedBy:       show.SubmittedBy,
 		RejectionReason:   show.RejectionReason,
@@ -1940,11 +2540,22 @@ func (s *ShowService) buildShowResponse(show *catalogm.Show) *contracts.ShowResp
 		UpdatedAt:         show.UpdatedAt,
 		IsSoldOut:         show.IsSoldOut,
 		IsCancelled:       show.IsCancelled,
+		IsPostponed:       show.IsPostponed,
+		StatusReason:      show.StatusReason,
+		RescheduledShowID: show.RescheduledShowID,
 		Source:            string(show.Source),
 		SourceVenue:       show.SourceVenue,
 		ScrapedAt:         show.ScrapedAt,
 		DuplicateOfShowID: show.DuplicateOfShowID,
+
+		RescheduledFromShowID: show.RescheduledFromShowID,
+		OriginalDate:          show.OriginalDate,
+		TicketLastCheckedAt:   show.TicketLastCheckedAt,
+		ShortCode:             show.ShortCode,
 	}
+	shared.SetShowLocalTimes(response)
+	setShowGenres(s.db, response)
+	return response
 }
 
 // ============================================================================
@@ -1986,18 +2597,30 @@ func (s *ShowService) ExportShowToMarkdown(showID uint) ([]byte, string, error)
 	}
 
 	// Add optional show fields
+	if show.DoorsTime != nil {
+		frontmatter.Show.DoorsTime = show.DoorsTime.UTC().Format(time.RFC3339)
+	}
 	if show.City != nil {
 		frontmatter.Show.City = *show.City
 	}
 	if show.State != nil {
 		frontmatter.Show.State = *show.State
 	}
-	if show.Price != nil {
-		frontmatter.Show.Price = show.Price
+	if show.PriceMin != nil {
+		frontmatter.Show.PriceMin = show.PriceMin
+		frontmatter.Show.PriceMax = show.PriceMax
+		frontmatter.Show.PriceCurrency = show.PriceCurrency
 	}
+	frontmatter.Show.IsFree = show.IsFree
 	if show.AgeRequirement != nil && *show.AgeRequirement != "" {
 		frontmatter.Show.AgeRequirement = *show.AgeRequirement
 	}
+	if show.TicketURL != nil && *show.TicketURL != "" {
+		frontmatter.Show.TicketURL = *show.TicketURL
+	}
+	if show.TicketProvider != nil {
+		frontmatter.Show.TicketProvider = *show.TicketProvider
+	}
 
 	// Build venues
 	for _, venue := range show.Venues {
@@ -2064,6 +2687,9 @@ func (s *ShowService) ExportShowToMarkdown(showID uint) ([]byte, string, error)
 			Position: sa.Position,
 			SetType:  sa.SetType,
 		}
+		if sa.SetTime != nil {
+			artistData.SetTime = sa.SetTime.UTC().Format(time.RFC3339)
+		}
 		if artist.City != nil {
 			artistData.City = *artist.City
 		}
@@ -2153,6 +2779,12 @@ func (s *ShowService) ParseShowMarkdown(content []byte) (*contracts.ParsedShowIm
 	if err := yaml.Unmarshal([]byte(frontmatterYAML), &frontmatter); err != nil {
 		return nil, fmt.Errorf("failed to parse frontmatter: %w", err)
 	}
+	// Artists are imported in billing order. Exports already list them by
+	// position; a hand-edited file may not, and one without positions keeps
+	// its file order.
+	sort.SliceStable(frontmatter.Artists, func(i, j int) bool {
+		return frontmatter.Artists[i].Position < frontmatter.Artists[j].Position
+	})
 
 	// Extract description from body (look for ## Description section)
 	description := ""
@@ -2200,6 +2832,7 @@ func (s *ShowService) PreviewShowImport(content []byte) (*contracts.ImportPrevie
 		Show:      parsed.Frontmatter.Show,
 		Venues:    make([]contracts.VenueMatchResult, 0),
 		Artists:   make([]contracts.ArtistMatchResult, 0),
+		Creates:   make([]contracts.ImportEntity, 0),
 		Warnings:  make([]string, 0),
 		CanImport: true,
 	}
@@ -2220,6 +2853,21 @@ func (s *ShowService) PreviewShowImport(content []byte) (*contracts.ImportPrevie
 		response.CanImport = false
 	}
 
+	if err := validateImportTicketFields(parsed.Frontmatter.Show); err != nil {
+		response.Warnings = append(response.Warnings, err.Error())
+		response.CanImport = false
+	}
+
+	if err := validateImportTimes(parsed.Frontmatter); err != nil {
+		response.Warnings = append(response.Warnings, err.Error())
+		response.CanImport = false
+	}
+
+	if err := validateImportPrice(parsed.Frontmatter.Show); err != nil {
+		response.Warnings = append(response.Warnings, err.Error())
+		response.CanImport = false
+	}
+
 	// Check venues
 	for _, venueData := range parsed.Frontmatter.Venues {
 		result := contracts.VenueMatchResult{
@@ -2240,6 +2888,7 @@ func (s *ShowService) PreviewShowImport(content []byte) (*contracts.ImportPrevie
 			result.WillCreate = false
 		} else if errors.Is(err, gorm.ErrRecordNotFound) {
 			result.WillCreate = true
+			addImportEntity(response, "venue", venueData.Name)
 		} else {
 			return nil, fmt.Errorf("failed to check venue: %w", err)
 		}
@@ -2264,6 +2913,7 @@ func (s *ShowService) PreviewShowImport(content []byte) (*contracts.ImportPrevie
 			result.WillCreate = false
 		} else if errors.Is(err, gorm.ErrRecordNotFound) {
 			result.WillCreate = true
+			addImportEntity(response, "artist", artistData.Name)
 		} else {
 			return nil, fmt.Errorf("failed to check artist: %w", err)
 		}
@@ -2299,11 +2949,29 @@ func (s *ShowService) PreviewShowImport(content []byte) (*contracts.ImportPrevie
 		}
 	}
 
+	if response.CanImport {
+		response.Creates = append([]contracts.ImportEntity{{Type: "show", Name: parsed.Frontmatter.Show.Title}}, response.Creates...)
+	}
+
 	return response, nil
 }
 
+// addImportEntity adds an entity to the preview's creates list. A name listed
+// twice in one import is only created once, so it is only listed once.
+func addImportEntity(response *contracts.ImportPreviewResponse, entityType, name string) {
+	for _, e := range response.Creates {
+		if e.Type == entityType && strings.EqualFold(e.Name, name) {
+			return
+		}
+	}
+	response.Creates = append(response.Creates, contracts.ImportEntity{Type: entityType, Name: name})
+}
+
 // contracts.AdminShowFilters contains filters for GetAdminShows
 
+// adminTagFilterSQL limits a shows query to shows carrying an admin tag.
+const adminTagFilterSQL = "id IN (SELECT entity_id FROM admin_annotations WHERE entity_type = 'show' AND kind = 'tag' AND LOWER(body) = LOWER(?))"
+
 // GetAdminShows retrieves shows for admin with optional filters (for CLI export)
 // Returns shows with all statuses including pending, rejected, and private
 func (s *ShowService) GetAdminShows(limit, offset int, filters contracts.AdminShowFilters) ([]*contracts.ShowResponse, int64, error) {
@@ -2312,7 +2980,7 @@ func (s *ShowService) GetAdminShows(limit, offset int, filters contracts.AdminSh
 	}
 
 	// Build base query
-	baseQuery := s.db.Model(&catalogm.Show{})
+	baseQuery := s.db.Model(&catalogm.Show{}).Where("deleted_at IS NULL")
 
 	// Apply status filter
 	if filters.Status != "" {
@@ -2338,6 +3006,11 @@ func (s *ShowService) GetAdminShows(limit, offset int, filters contracts.AdminSh
 		baseQuery = baseQuery.Where("city = ?", filters.City)
 	}
 
+	// Apply admin tag filter
+	if filters.Tag != "" {
+		baseQuery = baseQuery.Where(adminTagFilterSQL, strings.TrimSpace(filters.Tag))
+	}
+
 	// Get total count
 	var total int64
 	if err := baseQuery.Count(&total).Error; err != nil {
@@ -2347,6 +3020,7 @@ func (s *ShowService) GetAdminShows(limit, offset int, filters contracts.AdminSh
 	// Get shows with pagination
 	var shows []catalogm.Show
 	err := s.db.Preload("Venues").Preload("Artists").
+		Where("deleted_at IS NULL").
 		Scopes(func(db *gorm.DB) *gorm.DB {
 			if filters.Status != "" {
 				db = db.Where("status = ?", filters.Status)
@@ -2366,6 +3040,9 @@ func (s *ShowService) GetAdminShows(limit, offset int, filters contracts.AdminSh
 			if filters.City != "" {
 				db = db.Where("city = ?", filters.City)
 			}
+			if filters.Tag != "" {
+				db = db.Where(adminTagFilterSQL, strings.TrimSpace(filters.Tag))
+			}
 			return db
 		}).
 		Order("event_date DESC").
@@ -2386,7 +3063,9 @@ func (s *ShowService) GetAdminShows(limit, offset int, filters contracts.AdminSh
 }
 
 // ConfirmShowImport creates a show from the parsed markdown content
-// Admin imports auto-verify venues
+// Admin imports auto-verify venues.
+// The venues, artists and show are created in one transaction: if any step
+// fails, every entity the import created before it is rolled back too.
 func (s *ShowService) ConfirmShowImport(content []byte, isAdmin bool) (*contracts.ShowResponse, error) {
 	if s.db == nil {
 		return nil, fmt.Errorf("database not initialized")
@@ -2404,6 +3083,27 @@ func (s *ShowService) ConfirmShowImport(content []byte, isAdmin bool) (*contract
 		return nil, fmt.Errorf("invalid event date: %w", err)
 	}
 
+	if err := validateImportTicketFields(parsed.Frontmatter.Show); err != nil {
+		return nil, err
+	}
+	if err := validateImportTimes(parsed.Frontmatter); err != nil {
+		return nil, err
+	}
+	if err := validateImportPrice(parsed.Frontmatter.Show); err != nil {
+		return nil, err
+	}
+	doorsTime, _ := parseImportTime("doors time", parsed.Frontmatter.Show.DoorsTime)
+	price := importPrice(parsed.Frontmatter.Show)
+
+	// Preview reports these as blocking; reject them before opening the
+	// transaction rather than creating a show with no bill or venue.
+	if len(parsed.Frontmatter.Venues) == 0 {
+		return nil, fmt.Errorf("no venues specified")
+	}
+	if len(parsed.Frontmatter.Artists) == 0 {
+		return nil, fmt.Errorf("no artists specified")
+	}
+
 	// Build venues for contracts.CreateShowRequest
 	var requestVenues []contracts.CreateShowVenue
 	for _, venueData := range parsed.Frontmatter.Venues {
@@ -2418,10 +3118,13 @@ func (s *ShowService) ConfirmShowImport(content []byte, isAdmin bool) (*contract
 	// Build artists for contracts.CreateShowRequest
 	var requestArtists []contracts.CreateShowArtist
 	for _, artistData := range parsed.Frontmatter.Artists {
-		isHeadliner := artistData.SetType == "headliner"
+		isHeadliner := artistData.SetType == catalogm.SetTypeHeadliner
+		setTime, _ := parseImportTime("set time", artistData.SetTime)
 		requestArtists = append(requestArtists, contracts.CreateShowArtist{
 			Name:        artistData.Name,
 			IsHeadliner: &isHeadliner,
+			SetType:     artistData.SetType,
+			SetTime:     setTime,
 		})
 	}
 
@@ -2429,18 +3132,101 @@ func (s *ShowService) ConfirmShowImport(content []byte, isAdmin bool) (*contract
 	req := &contracts.CreateShowRequest{
 		Title:            parsed.Frontmatter.Show.Title,
 		EventDate:        eventDate,
+		DoorsTime:        doorsTime,
 		City:             parsed.Frontmatter.Show.City,
 		State:            parsed.Frontmatter.Show.State,
-		Price:            parsed.Frontmatter.Show.Price,
 		AgeRequirement:   parsed.Frontmatter.Show.AgeRequirement,
 		Description:      parsed.Description,
+		TicketURL:        parsed.Frontmatter.Show.TicketURL,
+		TicketProvider:   parsed.Frontmatter.Show.TicketProvider,
 		Venues:           requestVenues,
 		Artists:          requestArtists,
 		SubmitterIsAdmin: isAdmin,
+		PriceMin:         price.Min,
+		PriceMax:         price.Max,
+		PriceCurrency:    price.Currency,
+		IsFree:           price.IsFree,
 	}
 
-	// Create the show
-	return s.CreateShow(req)
+	var response *contracts.ShowResponse
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		response, err = s.createShowTx(tx, req)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("show import rolled back: %w", err)
+	}
+
+	return response, nil
+}
+
+// validateImportTicketFields applies the API's ticket rules to imported
+// frontmatter, which bypasses the create handler's request validation.
+func validateImportTicketFields(show contracts.ExportShowData) error {
+	if show.TicketURL != "" {
+		if len(show.TicketURL) > 500 {
+			return fmt.Errorf("Ticket URL must be 500 characters or fewer")
+		}
+		if err := utils.ValidateHTTPURL(show.TicketURL, "Ticket URL"); err != nil {
+			return err
+		}
+	}
+	if !catalogm.IsValidTicketProvider(show.TicketProvider) {
+		return fmt.Errorf("invalid ticket provider %q (must be one of: %s)",
+			show.TicketProvider, strings.Join(catalogm.TicketProviders, ", "))
+	}
+	return nil
+}
+
+// validateImportTimes checks that the optional doors and set times in imported
+// frontmatter are RFC3339, the format ExportShowToMarkdown writes.
+func validateImportTimes(fm contracts.ExportFrontmatter) error {
+	if _, err := parseImportTime("doors time", fm.Show.DoorsTime); err != nil {
+		return err
+	}
+	for _, artist := range fm.Artists {
+		if _, err := parseImportTime(fmt.Sprintf("set time for %s", artist.Name), artist.SetTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateImportPrice applies the API's price rules to imported frontmatter.
+func validateImportPrice(show contracts.ExportShowData) error {
+	price := importPrice(show)
+	return utils.ValidatePrice(price.Min, price.Max, price.Currency)
+}
+
+// importPrice reads the structured price from frontmatter, falling back to
+// the single price older exports carry when price_min is absent.
+func importPrice(show contracts.ExportShowData) utils.PriceRange {
+	if show.PriceMin == nil && !show.IsFree && show.Price != nil {
+		return utils.LegacyPrice(*show.Price)
+	}
+	price := utils.PriceRange{
+		Min:      show.PriceMin,
+		Max:      show.PriceMax,
+		Currency: show.PriceCurrency,
+		IsFree:   show.IsFree,
+	}
+	if price.Currency == "" {
+		price.Currency = utils.DefaultPriceCurrency
+	}
+	return price
+}
+
+// parseImportTime parses an optional RFC3339 frontmatter time. Empty is nil.
+func parseImportTime(label, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q (must be RFC3339)", label, value)
+	}
+	return &t, nil
 }
 
 // ============================================================================
@@ -2461,14 +3247,25 @@ func (s *ShowService) SetShowSoldOut(showID uint, isSoldOut bool) (*contracts.Sh
 		return nil, fmt.Errorf("failed to find show: %w", err)
 	}
 
-	if err := s.db.Model(&show).Update("is_sold_out", isSoldOut).Error; err != nil {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&show).Update("is_sold_out", isSoldOut).Error; err != nil {
+			return err
+		}
+		if show.IsSoldOut == isSoldOut {
+			return nil
+		}
+		return recordTicketStatusChange(tx, showID, isSoldOut, catalogm.TicketStatusSourceManual, nil)
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to update show sold out status: %w", err)
 	}
 
 	return s.GetShow(showID)
 }
 
-// SetShowCancelled sets or clears the is_cancelled flag on a show
+// SetShowCancelled sets or clears the is_cancelled flag on a show. Setting
+// it goes through the same path as CancelShow without a reason; a show
+// that is already cancelled keeps the reason it has.
 func (s *ShowService) SetShowCancelled(showID uint, isCancelled bool) (*contracts.ShowResponse, error) {
 	if s.db == nil {
 		return nil, fmt.Errorf("database not initialized")
@@ -2482,8 +3279,19 @@ func (s *ShowService) SetShowCancelled(showID uint, isCancelled bool) (*contract
 		return nil, fmt.Errorf("failed to find show: %w", err)
 	}
 
-	if err := s.db.Model(&show).Update("is_cancelled", isCancelled).Error; err != nil {
-		return nil, fmt.Errorf("failed to update show cancelled status: %w", err)
+	switch {
+	case isCancelled && !show.IsCancelled:
+		if err := cancelShowTx(s.db, &show, ""); err != nil {
+			return nil, err
+		}
+	case !isCancelled && show.IsCancelled:
+		// The reason explained the cancellation; it goes with it.
+		if err := s.db.Model(&show).Updates(map[string]interface{}{
+			"is_cancelled":  false,
+			"status_reason": gorm.Expr("NULL"),
+		}).Error; err != nil {
+			return nil, fmt.Errorf("failed to update show cancelled status: %w", err)
+		}
 	}
 
 	return s.GetShow(showID)
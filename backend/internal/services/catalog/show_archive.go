@@ -0,0 +1,140 @@
+package catalog
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// showYearSQL is a show's calendar year in an IANA zone (the one arg).
+const showYearSQL = "EXTRACT(YEAR FROM shows.event_date AT TIME ZONE ?)::int"
+
+// showMonthSQL is a show's calendar month in an IANA zone (the one arg).
+const showMonthSQL = "EXTRACT(MONTH FROM shows.event_date AT TIME ZONE ?)::int"
+
+// archiveStartOfToday resolves timezone (UTC when invalid) and returns it
+// with the start of today there, in UTC — the upcoming/past boundary the
+// venue and artist listings use.
+func archiveStartOfToday(timezone string) (*time.Location, time.Time) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	now := time.Now().In(loc)
+	return loc, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc).UTC()
+}
+
+// applyShowTimeFilter narrows a query joined to shows by timeFilter:
+// "past", "all", or anything else for upcoming.
+func applyShowTimeFilter(q *gorm.DB, timeFilter string, startOfToday time.Time) *gorm.DB {
+	switch timeFilter {
+	case "past":
+		return q.Where("shows.event_date < ?", startOfToday)
+	case "all":
+		return q
+	default:
+		return q.Where("shows.event_date >= ?", startOfToday)
+	}
+}
+
+// showYearCounts groups a query joined to shows by year in loc, most recent
+// year first.
+func showYearCounts(q *gorm.DB, loc *time.Location) ([]*contracts.ShowYearCount, error) {
+	years := []*contracts.ShowYearCount{}
+	err := q.Select(showYearSQL+" AS year, COUNT(*) AS count", loc.String()).
+		Group("year").
+		Order("year DESC").
+		Scan(&years).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count shows by year: %w", err)
+	}
+	return years, nil
+}
+
+// GetShowArchive returns one page of past approved shows, most recent first.
+// year and month (0 = any) are calendar values in timezone; month is ignored
+// without a year.
+func (s *ShowService) GetShowArchive(year, month int, timezone string, limit, offset int) (*contracts.ShowArchive, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	loc, startOfToday := archiveStartOfToday(timezone)
+	if year == 0 {
+		month = 0
+	}
+
+	// Fresh builder per query: GORM builders accumulate clauses.
+	past := func() *gorm.DB {
+		return s.db.Table("shows").
+			Where("shows.status = ? AND shows.deleted_at IS NULL AND shows.event_date < ?",
+				catalogm.ShowStatusApproved, startOfToday)
+	}
+	filtered := func() *gorm.DB {
+		q := past()
+		if year > 0 {
+			q = q.Where(showYearSQL+" = ?", loc.String(), year)
+		}
+		if month > 0 {
+			q = q.Where(showMonthSQL+" = ?", loc.String(), month)
+		}
+		return q
+	}
+
+	archive := &contracts.ShowArchive{Shows: []*contracts.ShowResponse{}}
+
+	years, err := showYearCounts(past(), loc)
+	if err != nil {
+		return nil, err
+	}
+	archive.Years = years
+
+	if year > 0 {
+		months := []*contracts.ShowMonthCount{}
+		err := past().
+			Where(showYearSQL+" = ?", loc.String(), year).
+			Select(showMonthSQL+" AS month, COUNT(*) AS count", loc.String()).
+			Group("month").
+			Order("month DESC").
+			Scan(&months).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to count shows by month: %w", err)
+		}
+		archive.Months = months
+	}
+
+	if err := filtered().Count(&archive.Total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count archive shows: %w", err)
+	}
+	if archive.Total == 0 {
+		return archive, nil
+	}
+
+	var showIDs []uint
+	if err := filtered().
+		Order("shows.event_date DESC, shows.id DESC").
+		Limit(limit).Offset(offset).
+		Pluck("shows.id", &showIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get archive show IDs: %w", err)
+	}
+	if len(showIDs) == 0 {
+		return archive, nil
+	}
+
+	var shows []catalogm.Show
+	if err := s.db.Preload("Venues").Preload("Artists").
+		Where("id IN ?", showIDs).
+		Order("event_date DESC, id DESC").
+		Find(&shows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get archive shows: %w", err)
+	}
+	for i := range shows {
+		archive.Shows = append(archive.Shows, s.buildShowResponse(&shows[i]))
+	}
+
+	return archive, nil
+}
@@ -0,0 +1,89 @@
+package catalog
+
+import (
+	"time"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+// =============================================================================
+// Show archive (past shows by year/month)
+// =============================================================================
+
+func (suite *ShowServiceIntegrationTestSuite) createArchiveShow(title string, eventDate time.Time, status catalogm.ShowStatus, venue *catalogm.Venue) *catalogm.Show {
+	show := &catalogm.Show{Title: title, EventDate: eventDate, Status: status}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	if venue != nil {
+		suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: venue.ID}).Error)
+	}
+	return show
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetShowArchive_PagesPastApprovedShows() {
+	venue := suite.createTestVenue("Archive Hall", "Phoenix", "AZ", true)
+	june := suite.createArchiveShow("June", time.Date(2023, 6, 15, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue)
+	march := suite.createArchiveShow("March", time.Date(2023, 3, 10, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue)
+	suite.createArchiveShow("Older", time.Date(2022, 11, 1, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue)
+	suite.createArchiveShow("Pending", time.Date(2023, 5, 1, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusPending, venue)
+	suite.createArchiveShow("Upcoming", time.Now().UTC().AddDate(0, 0, 10), catalogm.ShowStatusApproved, venue)
+
+	archive, err := suite.showService.GetShowArchive(0, 0, "UTC", 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(3), archive.Total)
+	suite.Require().Len(archive.Shows, 3)
+	suite.Equal(june.ID, archive.Shows[0].ID, "most recent first")
+	suite.Require().Len(archive.Years, 2)
+	suite.Equal(2023, archive.Years[0].Year)
+	suite.Equal(int64(2), archive.Years[0].Count)
+	suite.Nil(archive.Months)
+
+	page, err := suite.showService.GetShowArchive(2023, 0, "UTC", 1, 1)
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), page.Total)
+	suite.Require().Len(page.Shows, 1)
+	suite.Equal(march.ID, page.Shows[0].ID)
+	suite.Require().Len(page.Months, 2)
+	suite.Equal(6, page.Months[0].Month)
+
+	month, err := suite.showService.GetShowArchive(2023, 3, "UTC", 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), month.Total)
+	suite.Len(month.Years, 2, "year counts always cover the whole archive")
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetShowArchive_YearUsesTimezone() {
+	// 03:00 UTC on Jan 1 is still Dec 31 in Phoenix.
+	suite.createArchiveShow("New Year's Eve", time.Date(2023, 1, 1, 3, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, nil)
+
+	archive, err := suite.showService.GetShowArchive(2022, 12, "America/Phoenix", 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), archive.Total)
+
+	archive, err = suite.showService.GetShowArchive(2023, 0, "UTC", 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), archive.Total)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestVenueShowArchive_YearFilterAndCounts() {
+	venueService := NewVenueService(suite.db)
+	venue := suite.createTestVenue("Archive Room", "Phoenix", "AZ", true)
+	suite.createArchiveShow("2023 A", time.Date(2023, 6, 15, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue)
+	suite.createArchiveShow("2023 B", time.Date(2023, 6, 15, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue)
+	suite.createArchiveShow("2022", time.Date(2022, 2, 1, 20, 0, 0, 0, time.UTC), catalogm.ShowStatusApproved, venue)
+
+	shows, total, err := venueService.GetShowsForVenuePage(venue.ID, "UTC", 1, 1, "past", 2023)
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), total)
+	suite.Require().Len(shows, 1)
+	suite.Equal("2023 B", shows[0].Title, "same-date shows page in id order")
+
+	years, err := venueService.GetShowYearsForVenue(venue.ID, "UTC", "past")
+	suite.Require().NoError(err)
+	suite.Require().Len(years, 2)
+	suite.Equal(2023, years[0].Year)
+	suite.Equal(int64(2), years[0].Count)
+	suite.Equal(2022, years[1].Year)
+
+	_, err = venueService.GetShowYearsForVenue(999999, "UTC", "past")
+	suite.Error(err)
+}
@@ -0,0 +1,111 @@
+package catalog_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"psychic-homily-backend/internal/seed"
+	"psychic-homily-backend/internal/services/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// Show list benchmarks run against a private container seeded with the
+// synthetic catalog, so numbers are comparable between runs:
+//
+//	go test ./internal/services/catalog -run '^$' -bench 'Shows' -benchtime 200x
+//
+// They are in an external test package because internal/seed imports
+// catalog. The container is separate from SharedTestPostgres so the seeded
+// rows never leak into the transaction-isolated suites.
+
+const benchShows = 2000
+
+var (
+	benchOnce sync.Once
+	benchDB   *testutil.TestDatabase
+	benchErr  error
+)
+
+func benchShowService(b *testing.B) *catalog.ShowService {
+	b.Helper()
+	if testing.Short() {
+		b.Skip("Skipping database benchmark in short mode")
+	}
+	benchOnce.Do(func() {
+		// Set first: SetupTestPostgres fails via b.Fatal, which would leave
+		// the Once done with no database for the next benchmark.
+		benchErr = errors.New("benchmark database setup failed")
+		benchDB = testutil.SetupTestPostgres(b)
+		_, benchErr = seed.RunSynthetic(benchDB.DB, seed.SyntheticOptions{Seed: 1, Shows: benchShows})
+	})
+	if benchErr != nil {
+		b.Fatalf("seed synthetic catalog: %v", benchErr)
+	}
+	return catalog.NewShowService(benchDB.DB)
+}
+
+func BenchmarkGetUpcomingShows_FirstPage(b *testing.B) {
+	svc := benchShowService(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		shows, _, err := svc.GetUpcomingShows("America/Phoenix", "", 50, false, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(shows) == 0 {
+			b.Fatal("no upcoming shows in the synthetic catalog")
+		}
+	}
+}
+
+// BenchmarkGetUpcomingShows_CursorWalk pages through every upcoming show,
+// the path a cursor-pagination regression shows up on first.
+func BenchmarkGetUpcomingShows_CursorWalk(b *testing.B) {
+	svc := benchShowService(b)
+	b.ResetTimer()
+	pages := 0
+	for i := 0; i < b.N; i++ {
+		cursor := ""
+		for {
+			_, next, err := svc.GetUpcomingShows("America/Phoenix", cursor, 50, false, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+			pages++
+			if next == nil {
+				break
+			}
+			cursor = *next
+		}
+	}
+	b.ReportMetric(float64(pages)/float64(b.N), "pages/op")
+}
+
+func BenchmarkGetUpcomingShows_CityFilter(b *testing.B) {
+	svc := benchShowService(b)
+	filters := &contracts.UpcomingShowsFilter{
+		Cities: []contracts.CityStateFilter{{City: "Phoenix", State: "AZ"}, {City: "Tempe", State: "AZ"}},
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := svc.GetUpcomingShows("America/Phoenix", "", 50, false, filters); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSearchShows(b *testing.B) {
+	svc := benchShowService(b)
+	for _, query := range []string{"velvet", "the", "copper hall"} {
+		b.Run(fmt.Sprintf("q=%s", query), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := svc.SearchShows(query); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
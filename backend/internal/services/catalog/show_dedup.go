@@ -96,7 +96,7 @@ func FindShowDedupClusters(db *gorm.DB) ([]ShowDedupCluster, error) {
 		FROM shows s
 		JOIN show_artists sa ON sa.show_id = s.id
 		JOIN show_venues  sv ON sv.show_id = s.id
-		WHERE s.status IN ('approved','private')
+		WHERE s.status IN ('approved','private') AND s.deleted_at IS NULL
 		ORDER BY sa.artist_id, sv.venue_id, s.event_date, s.created_at ASC, s.id ASC
 	`).Scan(&rows).Error
 	if err != nil {
@@ -278,6 +278,27 @@ func MergeDuplicateShow(tx *gorm.DB, winnerID, loserID uint, summary *ShowDedupS
 		*op.skipped += skipped
 	}
 
+	// Keep the loser's URL (and any slugs already redirecting to it)
+	// resolving to the winner.
+	var loser catalogm.Show
+	if err := tx.Select("slug").First(&loser, loserID).Error; err != nil {
+		return fmt.Errorf("load loser show %d: %w", loserID, err)
+	}
+	if _, err := redirectSlugTx(tx, catalogm.SlugRedirectEntityShow, loser.Slug, loserID, winnerID); err != nil {
+		return err
+	}
+
+	// Carry an uploaded flyer over when the winner has none. If both have
+	// one the winner's is kept and the loser's stored objects are orphaned.
+	if err := tx.Exec(`
+		UPDATE shows w
+		SET flyer_key = l.flyer_key, flyer_url = l.flyer_url, flyer_thumbnail_url = l.flyer_thumbnail_url
+		FROM shows l
+		WHERE w.id = ? AND l.id = ? AND w.flyer_key IS NULL AND l.flyer_key IS NOT NULL
+	`, winnerID, loserID).Error; err != nil {
+		return fmt.Errorf("carry over flyer: %w", err)
+	}
+
 	// Delete the loser show. CASCADE handles anything left in
 	// show_venues / show_artists / show_reports / enrichment_queue
 	// (i.e. nothing — all repointed above).
@@ -326,6 +347,12 @@ func movePolymorphicJunction(tx *gorm.DB, table, primaryCol, otherCol string, wi
 // collection_items and user_bookmarks — `correlation` differs per
 // table (e.g. `["user_id", "action"]` for user_bookmarks).
 func movePolymorphicEntity(tx *gorm.DB, table string, correlation []string, winnerID, loserID uint) (moved, skipped int64, err error) {
+	return movePolymorphicEntityOfType(tx, table, "show", correlation, winnerID, loserID)
+}
+
+// movePolymorphicEntityOfType is movePolymorphicEntity for any entity_type;
+// the venue merge (venue_merge.go) uses it with "venue".
+func movePolymorphicEntityOfType(tx *gorm.DB, table, entityType string, correlation []string, winnerID, loserID uint) (moved, skipped int64, err error) {
 	if len(correlation) == 0 {
 		return 0, 0, fmt.Errorf("correlation must not be empty")
 	}
@@ -335,16 +362,16 @@ func movePolymorphicEntity(tx *gorm.DB, table string, correlation []string, winn
 	}
 	delSQL := fmt.Sprintf(`
 		DELETE FROM %s
-		WHERE entity_type = 'show'
+		WHERE entity_type = ?
 		  AND entity_id = ?
 		  AND EXISTS (
 			SELECT 1 FROM %s t2
-			WHERE t2.entity_type = 'show'
+			WHERE t2.entity_type = ?
 			  AND t2.entity_id = ?
 			  AND %s
 		  )
 	`, table, table, strings.Join(conds, " AND "))
-	del := tx.Exec(delSQL, loserID, winnerID)
+	del := tx.Exec(delSQL, entityType, loserID, entityType, winnerID)
 	if del.Error != nil {
 		return 0, 0, del.Error
 	}
@@ -353,9 +380,9 @@ func movePolymorphicEntity(tx *gorm.DB, table string, correlation []string, winn
 	updSQL := fmt.Sprintf(`
 		UPDATE %s
 		SET entity_id = ?
-		WHERE entity_type = 'show' AND entity_id = ?
+		WHERE entity_type = ? AND entity_id = ?
 	`, table)
-	upd := tx.Exec(updSQL, winnerID, loserID)
+	upd := tx.Exec(updSQL, winnerID, entityType, loserID)
 	if upd.Error != nil {
 		return 0, 0, upd.Error
 	}
@@ -369,7 +396,8 @@ func movePolymorphicEntity(tx *gorm.DB, table string, correlation []string, winn
 //
 // Used by the dedup cmd to fix slugs left in the legacy
 // migration-000019 form ("…YYYY-MM-DD" derived from raw UTC date) on
-// shows that survive a merge. Returns true if the slug was rewritten.
+// shows that survive a merge. The old slug is kept as a redirect.
+// Returns true if the slug was rewritten.
 func RecanonicaliseShowSlug(tx *gorm.DB, showID uint) (bool, error) {
 	var show catalogm.Show
 	if err := tx.First(&show, showID).Error; err != nil {
@@ -432,5 +460,8 @@ func RecanonicaliseShowSlug(tx *gorm.DB, showID uint) (bool, error) {
 	if err := tx.Model(&catalogm.Show{}).Where("id = ?", showID).Update("slug", unique).Error; err != nil {
 		return false, fmt.Errorf("update slug: %w", err)
 	}
+	if err := recordSlugChangeTx(tx, catalogm.SlugRedirectEntityShow, show.Slug, unique, showID); err != nil {
+		return false, err
+	}
 	return true, nil
 }
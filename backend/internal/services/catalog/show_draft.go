@@ -0,0 +1,176 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	// MaxShowDraftsPerUser caps the drafts a single user can hold
+	MaxShowDraftsPerUser = 20
+	// maxShowDraftBytes bounds a draft payload. A full submission with the
+	// maximum artists and venues is well under this.
+	maxShowDraftBytes = 64 << 10
+)
+
+// ShowDraftService stores users' in-progress show submissions
+type ShowDraftService struct {
+	db *gorm.DB
+}
+
+// NewShowDraftService creates a new show draft service
+func NewShowDraftService(database *gorm.DB) *ShowDraftService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &ShowDraftService{
+		db: database,
+	}
+}
+
+// validateDraftPayload checks a draft is a JSON object of reasonable size.
+// Fields are deliberately not validated: drafts are incomplete by nature,
+// and the payload is validated as a CreateShowRequest when it's submitted.
+func validateDraftPayload(payload json.RawMessage) error {
+	if len(payload) > maxShowDraftBytes {
+		return apperrors.ErrShowDraftInvalid(fmt.Sprintf("draft must be at most %d KB", maxShowDraftBytes>>10))
+	}
+	trimmed := bytes.TrimSpace(payload)
+	if len(trimmed) == 0 || trimmed[0] != '{' || !json.Valid(trimmed) {
+		return apperrors.ErrShowDraftInvalid("draft payload must be a JSON object")
+	}
+	return nil
+}
+
+// CreateDraft saves a new draft for the user
+func (s *ShowDraftService) CreateDraft(userID uint, payload json.RawMessage) (*contracts.ShowDraftResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	if err := validateDraftPayload(payload); err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := s.db.Model(&catalogm.ShowDraft{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to count show drafts: %w", err)
+	}
+	if count >= MaxShowDraftsPerUser {
+		return nil, apperrors.ErrShowDraftLimitReached(MaxShowDraftsPerUser)
+	}
+
+	raw := json.RawMessage(bytes.TrimSpace(payload))
+	draft := &catalogm.ShowDraft{
+		UserID:  userID,
+		Payload: &raw,
+	}
+	if err := s.db.Create(draft).Error; err != nil {
+		return nil, fmt.Errorf("failed to create show draft: %w", err)
+	}
+	return toShowDraftResponse(draft), nil
+}
+
+// UpdateDraft replaces the payload of one of the user's drafts
+func (s *ShowDraftService) UpdateDraft(userID, draftID uint, payload json.RawMessage) (*contracts.ShowDraftResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	if err := validateDraftPayload(payload); err != nil {
+		return nil, err
+	}
+
+	draft, err := s.findDraft(userID, draftID)
+	if err != nil {
+		return nil, err
+	}
+	raw := json.RawMessage(bytes.TrimSpace(payload))
+	draft.Payload = &raw
+	if err := s.db.Save(draft).Error; err != nil {
+		return nil, fmt.Errorf("failed to update show draft: %w", err)
+	}
+	return toShowDraftResponse(draft), nil
+}
+
+// GetDraft returns one of the user's drafts
+func (s *ShowDraftService) GetDraft(userID, draftID uint) (*contracts.ShowDraftResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	draft, err := s.findDraft(userID, draftID)
+	if err != nil {
+		return nil, err
+	}
+	return toShowDraftResponse(draft), nil
+}
+
+// ListDrafts returns the user's drafts, most recently edited first
+func (s *ShowDraftService) ListDrafts(userID uint) ([]contracts.ShowDraftResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var drafts []catalogm.ShowDraft
+	if err := s.db.Where("user_id = ?", userID).
+		Order("updated_at DESC, id DESC").
+		Find(&drafts).Error; err != nil {
+		return nil, fmt.Errorf("failed to list show drafts: %w", err)
+	}
+
+	result := make([]contracts.ShowDraftResponse, len(drafts))
+	for i := range drafts {
+		result[i] = *toShowDraftResponse(&drafts[i])
+	}
+	return result, nil
+}
+
+// DeleteDraft removes one of the user's drafts. It is also how a draft is
+// promoted: once the show it holds is submitted, the draft is deleted.
+func (s *ShowDraftService) DeleteDraft(userID, draftID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	result := s.db.Where("id = ? AND user_id = ?", draftID, userID).Delete(&catalogm.ShowDraft{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete show draft: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrShowDraftNotFound(draftID)
+	}
+	return nil
+}
+
+func (s *ShowDraftService) findDraft(userID, draftID uint) (*catalogm.ShowDraft, error) {
+	var draft catalogm.ShowDraft
+	err := s.db.Where("id = ? AND user_id = ?", draftID, userID).First(&draft).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrShowDraftNotFound(draftID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get show draft: %w", err)
+	}
+	return &draft, nil
+}
+
+func toShowDraftResponse(draft *catalogm.ShowDraft) *contracts.ShowDraftResponse {
+	resp := &contracts.ShowDraftResponse{
+		ID:        draft.ID,
+		CreatedAt: draft.CreatedAt,
+		UpdatedAt: draft.UpdatedAt,
+	}
+	if draft.Payload != nil {
+		resp.Payload = *draft.Payload
+	}
+	return resp
+}
@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/testutil"
+)
+
+type ShowDraftIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *ShowDraftService
+}
+
+func (suite *ShowDraftIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.svc = NewShowDraftService(suite.db)
+}
+
+func (suite *ShowDraftIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *ShowDraftIntegrationTestSuite) TearDownTest() {
+	suite.Require().NoError(suite.db.Exec("DELETE FROM show_drafts").Error)
+	suite.Require().NoError(suite.db.Exec("DELETE FROM users").Error)
+}
+
+func TestShowDraftIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShowDraftIntegrationTestSuite))
+}
+
+func (suite *ShowDraftIntegrationTestSuite) seedUser() *authm.User {
+	email := fmt.Sprintf("drafter-%d@test.com", time.Now().UnixNano())
+	u := &authm.User{Email: &email, IsActive: true}
+	suite.Require().NoError(suite.db.Create(u).Error)
+	return u
+}
+
+func (suite *ShowDraftIntegrationTestSuite) draftCode(err error) string {
+	var draftErr *apperrors.ShowDraftError
+	suite.Require().True(errors.As(err, &draftErr), "expected ShowDraftError, got %v", err)
+	return draftErr.Code
+}
+
+func (suite *ShowDraftIntegrationTestSuite) TestDraftLifecycle() {
+	user := suite.seedUser()
+
+	draft, err := suite.svc.CreateDraft(user.ID, json.RawMessage(`{"title":"Half done"}`))
+	suite.Require().NoError(err)
+	suite.JSONEq(`{"title":"Half done"}`, string(draft.Payload))
+
+	updated, err := suite.svc.UpdateDraft(user.ID, draft.ID, json.RawMessage(`{"title":"Done","venues":[{"id":1}]}`))
+	suite.Require().NoError(err)
+	suite.JSONEq(`{"title":"Done","venues":[{"id":1}]}`, string(updated.Payload))
+
+	drafts, err := suite.svc.ListDrafts(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(drafts, 1)
+	suite.Equal(draft.ID, drafts[0].ID)
+
+	suite.Require().NoError(suite.svc.DeleteDraft(user.ID, draft.ID))
+	_, err = suite.svc.GetDraft(user.ID, draft.ID)
+	suite.Equal(apperrors.CodeShowDraftNotFound, suite.draftCode(err))
+}
+
+func (suite *ShowDraftIntegrationTestSuite) TestDraftsAreScopedToOwner() {
+	owner := suite.seedUser()
+	other := suite.seedUser()
+
+	draft, err := suite.svc.CreateDraft(owner.ID, json.RawMessage(`{}`))
+	suite.Require().NoError(err)
+
+	_, err = suite.svc.GetDraft(other.ID, draft.ID)
+	suite.Equal(apperrors.CodeShowDraftNotFound, suite.draftCode(err))
+	_, err = suite.svc.UpdateDraft(other.ID, draft.ID, json.RawMessage(`{}`))
+	suite.Equal(apperrors.CodeShowDraftNotFound, suite.draftCode(err))
+	err = suite.svc.DeleteDraft(other.ID, draft.ID)
+	suite.Equal(apperrors.CodeShowDraftNotFound, suite.draftCode(err))
+
+	drafts, err := suite.svc.ListDrafts(other.ID)
+	suite.Require().NoError(err)
+	suite.Empty(drafts)
+}
+
+func (suite *ShowDraftIntegrationTestSuite) TestCreateDraft_EnforcesPerUserCap() {
+	user := suite.seedUser()
+	for i := 0; i < MaxShowDraftsPerUser; i++ {
+		_, err := suite.svc.CreateDraft(user.ID, json.RawMessage(`{}`))
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.svc.CreateDraft(user.ID, json.RawMessage(`{}`))
+	suite.Equal(apperrors.CodeShowDraftLimitReached, suite.draftCode(err))
+
+	// Other users are unaffected.
+	_, err = suite.svc.CreateDraft(suite.seedUser().ID, json.RawMessage(`{}`))
+	suite.NoError(err)
+}
+
+func TestValidateDraftPayload(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		ok      bool
+	}{
+		{"object", `{"title":"x"}`, true},
+		{"padded object", "  {}\n", true},
+		{"empty", ``, false},
+		{"array", `[1,2]`, false},
+		{"string", `"hello"`, false},
+		{"malformed", `{"title":`, false},
+		{"too large", `{"d":"` + strings.Repeat("x", maxShowDraftBytes) + `"}`, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDraftPayload(json.RawMessage(tc.payload))
+			if tc.ok && err != nil {
+				t.Errorf("expected valid, got %v", err)
+			}
+			if !tc.ok && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
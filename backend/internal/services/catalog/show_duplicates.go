@@ -0,0 +1,270 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Duplicate scoring. A candidate's score is the weighted sum of its venue,
+// start-time and lineup agreement with the submitted show, from 0 to 1.
+const (
+	duplicateVenueWeight  = 0.4
+	duplicateDateWeight   = 0.2
+	duplicateArtistWeight = 0.4
+
+	// duplicateWindow bounds how far apart two start times can be and still
+	// be compared at all.
+	duplicateWindow = 24 * time.Hour
+
+	// duplicateNameThreshold is the similarity above which two artist or
+	// venue names are treated as the same act or room.
+	duplicateNameThreshold = 0.85
+
+	// DuplicateMinScore is the lowest score reported as a possible duplicate.
+	DuplicateMinScore = 0.5
+
+	maxDuplicateCandidates = 5
+)
+
+// duplicateProbe is the submitted show as the duplicate scorer sees it.
+type duplicateProbe struct {
+	EventDate  time.Time
+	VenueIDs   []uint
+	VenueNames []string
+	Artists    []string
+}
+
+// FindDuplicateCandidates returns existing shows that look like the same
+// event as showID: near the same start time, at the same (or a similarly
+// named) venue in the same city, with an overlapping lineup. Artist and
+// venue names are fuzzy-matched so "The Black Keys" and "Black Keys" agree.
+// Results are sorted by score, highest first.
+func (s *ShowService) FindDuplicateCandidates(showID uint) ([]contracts.DuplicateCandidate, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	if err := s.db.Preload("Venues").Preload("Artists").Where("deleted_at IS NULL").First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(showID)
+		}
+		return nil, fmt.Errorf("failed to get show: %w", err)
+	}
+
+	probe := duplicateProbe{EventDate: show.EventDate}
+	var cities []string
+	for _, v := range show.Venues {
+		probe.VenueIDs = append(probe.VenueIDs, v.ID)
+		probe.VenueNames = append(probe.VenueNames, v.Name)
+		cities = append(cities, strings.ToLower(v.City))
+	}
+	for _, a := range show.Artists {
+		probe.Artists = append(probe.Artists, a.Name)
+	}
+	if len(probe.VenueIDs) == 0 {
+		return []contracts.DuplicateCandidate{}, nil
+	}
+
+	// Narrow to shows in the time window at one of the venues or in one of
+	// their cities; the fuzzy comparison happens in Go.
+	var shows []catalogm.Show
+	err := s.db.Preload("Venues").Preload("Artists").
+		Where("shows.id <> ? AND shows.deleted_at IS NULL", showID).
+		Where("shows.status IN ?", []catalogm.ShowStatus{catalogm.ShowStatusApproved, catalogm.ShowStatusPending}).
+		Where("shows.event_date BETWEEN ? AND ?", show.EventDate.Add(-duplicateWindow), show.EventDate.Add(duplicateWindow)).
+		Where(`EXISTS (
+			SELECT 1 FROM show_venues sv JOIN venues v ON v.id = sv.venue_id
+			WHERE sv.show_id = shows.id AND (v.id IN ? OR LOWER(v.city) IN ?)
+		)`, probe.VenueIDs, cities).
+		Order("shows.id").
+		Limit(100).
+		Find(&shows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+
+	candidates := []contracts.DuplicateCandidate{}
+	for i := range shows {
+		score, reasons := scoreDuplicate(probe, &shows[i])
+		if score < DuplicateMinScore {
+			continue
+		}
+		slug := ""
+		if shows[i].Slug != nil {
+			slug = *shows[i].Slug
+		}
+		candidates = append(candidates, contracts.DuplicateCandidate{
+			ShowID:    shows[i].ID,
+			Slug:      slug,
+			Title:     shows[i].Title,
+			EventDate: shows[i].EventDate,
+			Status:    string(shows[i].Status),
+			Score:     score,
+			Reasons:   reasons,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	if len(candidates) > maxDuplicateCandidates {
+		candidates = candidates[:maxDuplicateCandidates]
+	}
+	return candidates, nil
+}
+
+// scoreDuplicate scores how likely show is the same event as probe and
+// explains the parts that matched.
+func scoreDuplicate(probe duplicateProbe, show *catalogm.Show) (float64, []string) {
+	var score float64
+	var reasons []string
+
+	// Venue: a shared venue row counts fully, a similarly named one mostly.
+	venueScore := 0.0
+	for _, v := range show.Venues {
+		for i, id := range probe.VenueIDs {
+			if v.ID == id {
+				venueScore = 1
+			} else if venueScore < 0.75 && nameSimilarity(v.Name, probe.VenueNames[i]) >= duplicateNameThreshold {
+				venueScore = 0.75
+			}
+		}
+	}
+	switch {
+	case venueScore == 1:
+		reasons = append(reasons, "same venue")
+	case venueScore > 0:
+		reasons = append(reasons, "similar venue name")
+	}
+	score += duplicateVenueWeight * venueScore
+
+	// Start time: full credit for an exact match, decaying across the window.
+	delta := show.EventDate.Sub(probe.EventDate)
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta < duplicateWindow {
+		score += duplicateDateWeight * (1 - float64(delta)/float64(duplicateWindow))
+		if delta == 0 {
+			reasons = append(reasons, "same start time")
+		} else {
+			reasons = append(reasons, "same day")
+		}
+	}
+
+	// Lineup: share of the larger bill that fuzzy-matches the other.
+	matched := 0
+	for _, name := range probe.Artists {
+		for _, a := range show.Artists {
+			if nameSimilarity(name, a.Name) >= duplicateNameThreshold {
+				matched++
+				break
+			}
+		}
+	}
+	if matched > 0 {
+		bill := len(probe.Artists)
+		if len(show.Artists) > bill {
+			bill = len(show.Artists)
+		}
+		score += duplicateArtistWeight * float64(matched) / float64(bill)
+		reasons = append(reasons, fmt.Sprintf("%d of %d artists match", matched, bill))
+	}
+
+	return score, reasons
+}
+
+// nameSimilarity compares two names after normalization (accents, case,
+// boundary punctuation and a leading "the" are ignored) and returns a
+// Levenshtein-based similarity from 0 (nothing alike) to 1 (identical).
+func nameSimilarity(a, b string) float64 {
+	a = strings.TrimPrefix(normalizeName(a), "the ")
+	b = strings.TrimPrefix(normalizeName(b), "the ")
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// SetShowDuplicateOf links a pending show to the existing show it
+// duplicates, or clears the link when duplicateOfShowID is nil. The link is
+// advisory; the admin still approves or rejects the pending show.
+func (s *ShowService) SetShowDuplicateOf(showID uint, duplicateOfShowID *uint) (*contracts.ShowResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	if err := s.db.Where("deleted_at IS NULL").First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(showID)
+		}
+		return nil, fmt.Errorf("failed to get show: %w", err)
+	}
+	if show.Status != catalogm.ShowStatusPending {
+		return nil, apperrors.ErrShowValidationFailed("only pending shows can be linked as duplicates")
+	}
+
+	if duplicateOfShowID != nil {
+		if *duplicateOfShowID == showID {
+			return nil, apperrors.ErrShowValidationFailed("a show cannot be a duplicate of itself")
+		}
+		var target catalogm.Show
+		if err := s.db.Where("deleted_at IS NULL").First(&target, *duplicateOfShowID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, apperrors.ErrShowNotFound(*duplicateOfShowID)
+			}
+			return nil, fmt.Errorf("failed to get duplicate target: %w", err)
+		}
+		if target.DuplicateOfShowID != nil {
+			return nil, apperrors.ErrShowValidationFailed("target show is itself flagged as a duplicate")
+		}
+	}
+
+	if err := s.db.Model(&catalogm.Show{}).
+		Where("id = ?", showID).
+		Update("duplicate_of_show_id", duplicateOfShowID).Error; err != nil {
+		return nil, fmt.Errorf("failed to link duplicate show: %w", err)
+	}
+
+	return s.GetShow(showID)
+}
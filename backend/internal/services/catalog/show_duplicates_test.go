@@ -0,0 +1,65 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{"The Black Keys", "Black Keys", 1, 1},
+		{"Sigur Rós", "sigur ros", 1, 1},
+		{"Crescent Ballroom", "Crescent Ballroom!", 1, 1},
+		{"Japanese Breakfast", "Japanese Brekfast", duplicateNameThreshold, 1},
+		{"Valley Bar", "Rebel Lounge", 0, 0.5},
+		{"", "", 1, 1},
+	}
+	for _, tt := range tests {
+		got := nameSimilarity(tt.a, tt.b)
+		if got < tt.min || got > tt.max {
+			t.Errorf("nameSimilarity(%q, %q) = %.2f, want in [%.2f, %.2f]", tt.a, tt.b, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestScoreDuplicate(t *testing.T) {
+	eventDate := time.Date(2026, 6, 15, 20, 0, 0, 0, time.UTC)
+	probe := duplicateProbe{
+		EventDate:  eventDate,
+		VenueIDs:   []uint{7},
+		VenueNames: []string{"The Rebel Lounge"},
+		Artists:    []string{"Black Keys", "Opener"},
+	}
+	show := func(venueID uint, venueName string, at time.Time, artists ...string) *catalogm.Show {
+		s := &catalogm.Show{EventDate: at, Venues: []catalogm.Venue{{ID: venueID, Name: venueName}}}
+		for _, a := range artists {
+			s.Artists = append(s.Artists, catalogm.Artist{Name: a})
+		}
+		return s
+	}
+
+	tests := []struct {
+		name      string
+		show      *catalogm.Show
+		wantScore float64
+	}{
+		{"identical", show(7, "The Rebel Lounge", eventDate, "The Black Keys", "Opener"), 1},
+		{"similar venue name", show(9, "Rebel Lounge", eventDate, "The Black Keys", "Opener"), 0.9},
+		{"half the lineup, twelve hours off", show(7, "The Rebel Lounge", eventDate.Add(12*time.Hour), "The Black Keys", "Someone Else"), 0.4 + 0.1 + 0.2},
+		{"different venue and lineup", show(9, "Valley Bar", eventDate, "Someone Else"), 0.2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reasons := scoreDuplicate(probe, tt.show)
+			if diff := got - tt.wantScore; diff > 0.001 || diff < -0.001 {
+				t.Errorf("score = %.3f, want %.3f (reasons %v)", got, tt.wantScore, reasons)
+			}
+		})
+	}
+}
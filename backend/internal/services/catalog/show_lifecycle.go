@@ -0,0 +1,225 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Cancellation and postponement. Both keep the show (and its URL) around
+// with a flag and an optional reason; postponing to a known date also
+// creates the show for that date, linked to the original both ways.
+
+// CancelShow marks a show cancelled with an optional reason. Cancelling an
+// already-cancelled show replaces the reason. A show that was rescheduled
+// can't be cancelled; its rescheduled show is the one to cancel.
+func (s *ShowService) CancelShow(showID uint, reason string) (*contracts.ShowResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	if err := s.db.First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(showID)
+		}
+		return nil, fmt.Errorf("failed to find show: %w", err)
+	}
+	if err := cancelShowTx(s.db, &show, reason); err != nil {
+		return nil, err
+	}
+
+	return s.GetShow(showID)
+}
+
+// cancelShowTx cancels a loaded show: sets is_cancelled, clears is_postponed
+// and stores reason. Shared by CancelShow and the bulk cancel.
+func cancelShowTx(tx *gorm.DB, show *catalogm.Show, reason string) error {
+	if show.RescheduledShowID != nil {
+		return apperrors.ErrShowValidationFailed(fmt.Sprintf(
+			"show was rescheduled to show %d; cancel that show instead", *show.RescheduledShowID))
+	}
+
+	if err := tx.Model(show).Updates(map[string]interface{}{
+		"is_cancelled":  true,
+		"is_postponed":  false,
+		"status_reason": statusReasonValue(reason),
+	}).Error; err != nil {
+		return fmt.Errorf("failed to cancel show: %w", err)
+	}
+	show.IsCancelled, show.IsPostponed = true, false
+	return nil
+}
+
+// PostponeShow marks a show postponed with an optional reason. With a new
+// event date it also creates the rescheduled show: same bill, venues and
+// details, set and doors times moved by the same offset, and the original
+// date kept in OriginalDate. A show postponed without a date can be
+// postponed again once the new date is known. Returns the postponed show.
+func (s *ShowService) PostponeShow(showID uint, reason string, newEventDate *time.Time) (*contracts.ShowResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var show catalogm.Show
+		if err := tx.Preload("Venues").First(&show, showID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.ErrShowNotFound(showID)
+			}
+			return fmt.Errorf("failed to find show: %w", err)
+		}
+		if show.IsCancelled {
+			return apperrors.ErrShowValidationFailed("a cancelled show can't be postponed")
+		}
+		if show.RescheduledShowID != nil {
+			return apperrors.ErrShowValidationFailed(fmt.Sprintf(
+				"show was already rescheduled to show %d", *show.RescheduledShowID))
+		}
+
+		updates := map[string]interface{}{"is_postponed": true}
+		// Keep the reason given when the show was first postponed unless a
+		// new one is supplied with the date.
+		if strings.TrimSpace(reason) != "" || !show.IsPostponed {
+			updates["status_reason"] = statusReasonValue(reason)
+		}
+
+		if newEventDate != nil {
+			newDate := newEventDate.UTC()
+			if !newDate.After(time.Now()) {
+				return apperrors.ErrShowValidationFailed("new event date must be in the future")
+			}
+			if newDate.Equal(show.EventDate) {
+				return apperrors.ErrShowValidationFailed("new event date must differ from the current date")
+			}
+
+			rescheduledID, err := s.createRescheduledShowTx(tx, &show, newDate)
+			if err != nil {
+				return err
+			}
+			updates["rescheduled_show_id"] = rescheduledID
+		}
+
+		if err := tx.Model(&show).Updates(updates).Error; err != nil {
+			return fmt.Errorf("failed to postpone show: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetShow(showID)
+}
+
+// createRescheduledShowTx creates the show a postponed show moves to and
+// returns its ID. It keeps the original's status, so a private or pending
+// show doesn't become public by being rescheduled. An uploaded flyer stays
+// with the original, which owns the stored objects.
+func (s *ShowService) createRescheduledShowTx(tx *gorm.DB, show *catalogm.Show, newDate time.Time) (uint, error) {
+	var lineup []struct {
+		catalogm.ShowArtist
+		Name string
+	}
+	if err := tx.Table("show_artists").
+		Select("show_artists.*, artists.name").
+		Joins("JOIN artists ON artists.id = show_artists.artist_id").
+		Where("show_artists.show_id = ?", show.ID).
+		Order("show_artists.position ASC").
+		Scan(&lineup).Error; err != nil {
+		return 0, fmt.Errorf("failed to load show artists: %w", err)
+	}
+	if len(lineup) == 0 || len(show.Venues) == 0 {
+		return 0, apperrors.ErrShowValidationFailed("show needs at least one artist and venue to be rescheduled")
+	}
+
+	offset := newDate.Sub(show.EventDate)
+	shift := func(t *time.Time) *time.Time {
+		if t == nil {
+			return nil
+		}
+		shifted := t.Add(offset)
+		return &shifted
+	}
+
+	venues := make([]contracts.CreateShowVenue, len(show.Venues))
+	for i, v := range show.Venues {
+		venueID := v.ID
+		venues[i] = contracts.CreateShowVenue{
+			ID:    &venueID,
+			Name:  v.Name,
+			City:  v.City,
+			State: v.State,
+		}
+	}
+	artists := make([]contracts.CreateShowArtist, len(lineup))
+	for i, sa := range lineup {
+		artistID := sa.ArtistID
+		isHeadliner := sa.SetType == catalogm.SetTypeHeadliner
+		artists[i] = contracts.CreateShowArtist{
+			ID:          &artistID,
+			Name:        sa.Name,
+			IsHeadliner: &isHeadliner,
+			SetType:     sa.SetType,
+			SetTime:     shift(sa.SetTime),
+		}
+	}
+
+	req := &contracts.CreateShowRequest{
+		Title:             show.Title,
+		EventDate:         newDate,
+		DoorsTime:         shift(show.DoorsTime),
+		City:              derefString(show.City),
+		State:             derefString(show.State),
+		AgeRequirement:    derefString(show.AgeRequirement),
+		Description:       derefString(show.Description),
+		TicketURL:         derefString(show.TicketURL),
+		TicketProvider:    derefString(show.TicketProvider),
+		ImageURL:          show.ImageURL,
+		Venues:            venues,
+		Artists:           artists,
+		PriceMin:          show.PriceMin,
+		PriceMax:          show.PriceMax,
+		PriceCurrency:     show.PriceCurrency,
+		IsFree:            show.IsFree,
+		SubmittedByUserID: show.SubmittedBy,
+		SubmitterIsAdmin:  true,
+		IsPrivate:         show.Status == catalogm.ShowStatusPrivate,
+		HoldForReview:     show.Status == catalogm.ShowStatusPending,
+	}
+
+	created, err := s.createShowTx(tx, req)
+	if err != nil {
+		var conflict *headlinerConflictError
+		if errors.As(err, &conflict) {
+			return 0, apperrors.ErrShowValidationFailed(conflict.Error())
+		}
+		return 0, err
+	}
+
+	if err := tx.Model(&catalogm.Show{}).Where("id = ?", created.ID).Updates(map[string]interface{}{
+		"rescheduled_from_show_id": show.ID,
+		"original_date":            show.EventDate,
+	}).Error; err != nil {
+		return 0, fmt.Errorf("failed to link rescheduled show: %w", err)
+	}
+
+	return created.ID, nil
+}
+
+// statusReasonValue is the status_reason column value for reason: NULL when
+// blank.
+func statusReasonValue(reason string) interface{} {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return gorm.Expr("NULL")
+	}
+	return reason
+}
@@ -0,0 +1,64 @@
+package catalog
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/utils"
+)
+
+// showLocalTimeRow is a show's event instant joined to its primary venue.
+type showLocalTimeRow struct {
+	EventDate     time.Time
+	State         *string
+	VenueTimezone *string
+	VenueState    *string
+}
+
+// stampShowLocalTime records a show's event time as a wall-clock reading in
+// the zone the API renders it in (shows.event_local_time / event_timezone):
+// the primary venue's timezone, else its state's (see utils.EventLocation).
+// Call it whenever event_date or the venue set may have changed. Idempotent,
+// and it leaves updated_at alone.
+//
+// Package-level like syncShowArtistDedupColumns so every show write path in
+// this package can share it.
+func stampShowLocalTime(tx *gorm.DB, showID uint) error {
+	var row showLocalTimeRow
+	if err := tx.Raw(`
+		SELECT s.event_date, s.state, v.timezone AS venue_timezone, v.state AS venue_state
+		FROM shows s
+		LEFT JOIN LATERAL (
+		    SELECT venue_id FROM show_venues WHERE show_id = s.id ORDER BY venue_id LIMIT 1
+		) pv ON TRUE
+		LEFT JOIN venues v ON v.id = pv.venue_id
+		WHERE s.id = ?
+	`, showID).Scan(&row).Error; err != nil {
+		return fmt.Errorf("failed to load show %d for local time: %w", showID, err)
+	}
+
+	wall, zone := showLocalWallTime(row)
+	if err := tx.Model(&catalogm.Show{}).Where("id = ?", showID).
+		UpdateColumns(map[string]interface{}{"event_local_time": wall, "event_timezone": zone}).Error; err != nil {
+		return fmt.Errorf("failed to stamp show %d local time: %w", showID, err)
+	}
+	return nil
+}
+
+// showLocalWallTime returns row's event time as a zone-less wall-clock value
+// (UTC-tagged, for a TIMESTAMP column) and the name of the zone it was read in.
+// The show's own state is the fallback when it has no venue.
+func showLocalWallTime(row showLocalTimeRow) (time.Time, string) {
+	timezone, state := row.VenueTimezone, derefString(row.State)
+	if row.VenueState != nil {
+		state = *row.VenueState
+	}
+	loc := utils.EventLocation(timezone, state)
+	local := row.EventDate.In(loc)
+	wall := time.Date(local.Year(), local.Month(), local.Day(),
+		local.Hour(), local.Minute(), local.Second(), 0, time.UTC)
+	return wall, loc.String()
+}
@@ -0,0 +1,33 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShowLocalWallTime(t *testing.T) {
+	phoenix, state := "America/Phoenix", "AZ"
+	event := time.Date(2026, 7, 11, 3, 0, 0, 0, time.UTC)
+
+	wall, zone := showLocalWallTime(showLocalTimeRow{
+		EventDate:     event,
+		VenueTimezone: &phoenix,
+		VenueState:    &state,
+	})
+	if zone != phoenix {
+		t.Errorf("zone = %q, want %q", zone, phoenix)
+	}
+	if want := time.Date(2026, 7, 10, 20, 0, 0, 0, time.UTC); !wall.Equal(want) {
+		t.Errorf("wall = %v, want %v", wall, want)
+	}
+
+	// No venue: the show's own state picks the zone.
+	showState := "NY"
+	wall, zone = showLocalWallTime(showLocalTimeRow{EventDate: event, State: &showState})
+	if zone != "America/New_York" {
+		t.Errorf("zone = %q, want America/New_York", zone)
+	}
+	if want := time.Date(2026, 7, 10, 23, 0, 0, 0, time.UTC); !wall.Equal(want) {
+		t.Errorf("wall = %v, want %v", wall, want)
+	}
+}
@@ -0,0 +1,195 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/utils"
+)
+
+// primaryShowVenue returns the venue a show's city/state is derived from: the
+// lowest venue ID, the same choice syncShowArtistDedupColumns makes for the
+// denormalized show_artists.venue_id. ok is false when there are no venues.
+func primaryShowVenue(venues []contracts.VenueResponse) (primary contracts.VenueResponse, ok bool) {
+	for i, v := range venues {
+		if i == 0 || v.ID < primary.ID {
+			primary = v
+		}
+	}
+	return primary, len(venues) > 0
+}
+
+// sameCity compares two city names ignoring case and surrounding whitespace.
+func sameCity(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+// sameState compares two states, treating a full US state name and its
+// abbreviation as equal ("Arizona" == "AZ"). Unknown values fall back to a
+// case-insensitive comparison.
+func sameState(a, b string) bool {
+	if abbrA, ok := utils.StateNameToAbbrev(a); ok {
+		if abbrB, ok := utils.StateNameToAbbrev(b); ok {
+			return abbrA == abbrB
+		}
+	}
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+// resolveShowLocation picks the city/state a submitted show is stored with.
+// A blank city or state defaults to the primary venue's. A provided value
+// that disagrees with the venue is kept as submitted (touring shows listed
+// under a metro, venues on a city line) and reported in warning; warning is
+// empty when the location is consistent.
+func resolveShowLocation(city, state string, venue contracts.VenueResponse) (outCity, outState, warning string) {
+	outCity, outState = strings.TrimSpace(city), strings.TrimSpace(state)
+	if outCity == "" {
+		outCity = venue.City
+	}
+	if outState == "" {
+		outState = venue.State
+	}
+	if !sameCity(outCity, venue.City) || !sameState(outState, venue.State) {
+		warning = fmt.Sprintf("show location %q does not match venue %q (%s)",
+			formatCityState(outCity, outState), venue.Name, formatCityState(venue.City, venue.State))
+	}
+	return outCity, outState, warning
+}
+
+func formatCityState(city, state string) string {
+	switch {
+	case city == "":
+		return state
+	case state == "":
+		return city
+	}
+	return city + ", " + state
+}
+
+// ShowLocationBackfillOptions configures a BackfillShowLocations run.
+type ShowLocationBackfillOptions struct {
+	// DryRun computes and reports every change without writing (the CLI default).
+	DryRun bool
+}
+
+// ShowLocationChange records one show whose city/state disagrees with its
+// primary venue and the venue location it will be (or was) rewritten to.
+type ShowLocationChange struct {
+	ShowID    uint
+	VenueID   uint
+	VenueName string
+	OldCity   string
+	OldState  string
+	NewCity   string
+	NewState  string
+	Applied   bool // true only when a live run committed the change
+}
+
+// ShowLocationBackfillReport summarizes a BackfillShowLocations run.
+type ShowLocationBackfillReport struct {
+	Scanned   int
+	Changed   int // planned (dry-run) or applied (live) changes
+	Unchanged int
+	Changes   []ShowLocationChange
+	Errors    []string
+}
+
+// showLocationRow is one show joined to its primary venue.
+type showLocationRow struct {
+	ShowID     uint
+	City       *string
+	State      *string
+	VenueID    uint
+	VenueName  string
+	VenueCity  string
+	VenueState string
+}
+
+// showLocationTarget is the pure decision core of BackfillShowLocations: a
+// show's stored location is rewritten to its primary venue's when either
+// part is blank or disagrees with the venue (per sameCity/sameState, so
+// "Arizona" vs "AZ" is not a mismatch).
+func showLocationTarget(city, state, venueCity, venueState string) (newCity, newState string, needsUpdate bool) {
+	if strings.TrimSpace(city) != "" && strings.TrimSpace(state) != "" &&
+		sameCity(city, venueCity) && sameState(state, venueState) {
+		return city, state, false
+	}
+	return venueCity, venueState, true
+}
+
+// BackfillShowLocations aligns existing shows' city/state with their primary
+// venue (lowest venue ID), the rule CreateShow now applies to new
+// submissions. Shows without a venue are skipped. Phase 1 computes the plan
+// (reads only); phase 2 (live only) applies it in a single transaction, so a
+// failure rolls back rather than leaving shows half-updated. It is
+// idempotent: a second run reports zero changes.
+func BackfillShowLocations(database *gorm.DB, opts ShowLocationBackfillOptions) (*ShowLocationBackfillReport, error) {
+	var rows []showLocationRow
+	if err := database.Raw(`
+		SELECT s.id AS show_id, s.city, s.state,
+		       v.id AS venue_id, v.name AS venue_name, v.city AS venue_city, v.state AS venue_state
+		FROM shows s
+		JOIN LATERAL (
+		    SELECT venue_id FROM show_venues WHERE show_id = s.id ORDER BY venue_id LIMIT 1
+		) pv ON TRUE
+		JOIN venues v ON v.id = pv.venue_id
+		ORDER BY s.id
+	`).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("load shows: %w", err)
+	}
+
+	report := &ShowLocationBackfillReport{Scanned: len(rows)}
+	var plan []ShowLocationChange
+
+	// Phase 1 — compute the plan (reads only).
+	for _, r := range rows {
+		city, state := derefString(r.City), derefString(r.State)
+		newCity, newState, needsUpdate := showLocationTarget(city, state, r.VenueCity, r.VenueState)
+		if !needsUpdate {
+			report.Unchanged++
+			continue
+		}
+		plan = append(plan, ShowLocationChange{
+			ShowID:    r.ShowID,
+			VenueID:   r.VenueID,
+			VenueName: r.VenueName,
+			OldCity:   city,
+			OldState:  state,
+			NewCity:   newCity,
+			NewState:  newState,
+		})
+	}
+
+	// Dry-run: report the plan, write nothing.
+	if opts.DryRun {
+		report.Changed = len(plan)
+		report.Changes = plan
+		return report, nil
+	}
+
+	// Phase 2 — apply the whole plan atomically.
+	if err := database.Transaction(func(tx *gorm.DB) error {
+		for _, c := range plan {
+			if err := tx.Model(&catalogm.Show{}).
+				Where("id = ?", c.ShowID).
+				Updates(map[string]interface{}{"city": c.NewCity, "state": c.NewState}).Error; err != nil {
+				return fmt.Errorf("show %d: update location: %w", c.ShowID, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return report, nil
+	}
+
+	for _, c := range plan {
+		c.Applied = true
+		report.Changes = append(report.Changes, c)
+	}
+	report.Changed = len(plan)
+	return report, nil
+}
@@ -0,0 +1,103 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+type ShowLocationBackfillIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+}
+
+func (suite *ShowLocationBackfillIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+}
+
+func (suite *ShowLocationBackfillIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *ShowLocationBackfillIntegrationTestSuite) TearDownTest() {
+	suite.Require().NoError(suite.db.Exec("DELETE FROM show_venues").Error)
+	suite.Require().NoError(suite.db.Exec("DELETE FROM shows").Error)
+	suite.Require().NoError(suite.db.Exec("DELETE FROM venues").Error)
+}
+
+func TestShowLocationBackfillIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShowLocationBackfillIntegrationTestSuite))
+}
+
+func (suite *ShowLocationBackfillIntegrationTestSuite) seedVenue(name, city, state string) uint {
+	v := &catalogm.Venue{Name: name, City: city, State: state}
+	suite.Require().NoError(suite.db.Create(v).Error)
+	return v.ID
+}
+
+// seedShow inserts a show with a raw city/state at the given venues, bypassing
+// CreateShow so the historical inconsistent data can be reproduced.
+func (suite *ShowLocationBackfillIntegrationTestSuite) seedShow(city, state string, venueIDs ...uint) uint {
+	s := &catalogm.Show{
+		Title:     "Show",
+		EventDate: time.Date(2025, 5, 1, 20, 0, 0, 0, time.UTC),
+		City:      &city,
+		State:     &state,
+		Status:    catalogm.ShowStatusApproved,
+	}
+	suite.Require().NoError(suite.db.Create(s).Error)
+	for _, vid := range venueIDs {
+		suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: s.ID, VenueID: vid}).Error)
+	}
+	return s.ID
+}
+
+func (suite *ShowLocationBackfillIntegrationTestSuite) locationOf(id uint) (string, string) {
+	var s catalogm.Show
+	suite.Require().NoError(suite.db.First(&s, id).Error)
+	return derefString(s.City), derefString(s.State)
+}
+
+func (suite *ShowLocationBackfillIntegrationTestSuite) TestBackfill_AlignsShowsWithPrimaryVenue() {
+	phoenix := suite.seedVenue("Valley Bar", "Phoenix", "AZ")
+	tempe := suite.seedVenue("Yucca Tap Room", "Tempe", "AZ")
+
+	blankID := suite.seedShow("", "", phoenix)
+	wrongID := suite.seedShow("Tucson", "AZ", phoenix)
+	okID := suite.seedShow("phoenix", "Arizona", phoenix)
+	// Multi-venue: the lowest venue ID (Valley Bar) is primary.
+	multiID := suite.seedShow("Tempe", "AZ", tempe, phoenix)
+	suite.seedShow("Nowhere", "ZZ") // no venue: not scanned
+
+	dry, err := BackfillShowLocations(suite.db, ShowLocationBackfillOptions{DryRun: true})
+	suite.Require().NoError(err)
+	suite.Equal(4, dry.Scanned)
+	suite.Equal(3, dry.Changed)
+	suite.Equal(1, dry.Unchanged)
+	city, _ := suite.locationOf(wrongID)
+	suite.Equal("Tucson", city, "dry-run must not write")
+
+	report, err := BackfillShowLocations(suite.db, ShowLocationBackfillOptions{DryRun: false})
+	suite.Require().NoError(err)
+	suite.Equal(3, report.Changed)
+	suite.Empty(report.Errors)
+	for _, id := range []uint{blankID, wrongID, multiID} {
+		city, state := suite.locationOf(id)
+		suite.Equal("Phoenix", city)
+		suite.Equal("AZ", state)
+	}
+	city, state := suite.locationOf(okID)
+	suite.Equal("phoenix", city, "an equivalent location is left as stored")
+	suite.Equal("Arizona", state)
+
+	again, err := BackfillShowLocations(suite.db, ShowLocationBackfillOptions{DryRun: false})
+	suite.Require().NoError(err)
+	suite.Equal(0, again.Changed)
+}
@@ -0,0 +1,73 @@
+package catalog
+
+import (
+	"testing"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestPrimaryShowVenue_LowestID(t *testing.T) {
+	if _, ok := primaryShowVenue(nil); ok {
+		t.Fatal("expected no primary venue for an empty list")
+	}
+	got, ok := primaryShowVenue([]contracts.VenueResponse{{ID: 9, Name: "B"}, {ID: 3, Name: "A"}, {ID: 5, Name: "C"}})
+	if !ok || got.ID != 3 {
+		t.Errorf("primaryShowVenue = %d (ok=%v), want 3", got.ID, ok)
+	}
+}
+
+func TestResolveShowLocation(t *testing.T) {
+	venue := contracts.VenueResponse{Name: "Valley Bar", City: "Phoenix", State: "AZ"}
+	cases := []struct {
+		name        string
+		city, state string
+		wantCity    string
+		wantState   string
+		wantWarning bool
+	}{
+		{"both blank default to venue", "", "", "Phoenix", "AZ", false},
+		{"blank state defaults to venue", "Phoenix", "", "Phoenix", "AZ", false},
+		{"matching location is kept", "Phoenix", "AZ", "Phoenix", "AZ", false},
+		{"case and whitespace are ignored", " phoenix ", "az", "phoenix", "az", false},
+		{"full state name matches abbreviation", "Phoenix", "Arizona", "Phoenix", "Arizona", false},
+		{"mismatched city is kept and warned", "Tucson", "AZ", "Tucson", "AZ", true},
+		{"mismatched state is kept and warned", "Phoenix", "NM", "Phoenix", "NM", true},
+		{"blank state with mismatched city still warns", "Tucson", "", "Tucson", "AZ", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			city, state, warning := resolveShowLocation(c.city, c.state, venue)
+			if city != c.wantCity || state != c.wantState {
+				t.Errorf("location = %q, %q; want %q, %q", city, state, c.wantCity, c.wantState)
+			}
+			if (warning != "") != c.wantWarning {
+				t.Errorf("warning = %q, want warning=%v", warning, c.wantWarning)
+			}
+		})
+	}
+}
+
+func TestShowLocationTarget(t *testing.T) {
+	cases := []struct {
+		name        string
+		city, state string
+		wantChanged bool
+	}{
+		{"consistent location is unchanged", "Phoenix", "AZ", false},
+		{"state name vs abbreviation is unchanged", "phoenix", "Arizona", false},
+		{"blank city is filled", "", "AZ", true},
+		{"blank state is filled", "Phoenix", "", true},
+		{"mismatched city is rewritten", "Tempe", "AZ", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			city, state, changed := showLocationTarget(c.city, c.state, "Phoenix", "AZ")
+			if changed != c.wantChanged {
+				t.Fatalf("needsUpdate = %v, want %v", changed, c.wantChanged)
+			}
+			if changed && (city != "Phoenix" || state != "AZ") {
+				t.Errorf("target = %q, %q; want the venue's location", city, state)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/geo"
+)
+
+// haversineKmSQL is the great-circle distance in km from the bound point
+// (?, ?, ? = lat, lat, lng) to venues.latitude/longitude. Plain SQL rather
+// than PostGIS so it runs on the stock Postgres image; geo.HaversineKm is the
+// Go twin. LEAST guards ASIN against rounding just above 1.
+var haversineKmSQL = fmt.Sprintf(`2 * %g * ASIN(LEAST(1, SQRT(
+	POWER(SIN(RADIANS(v.latitude::float8 - ?) / 2), 2) +
+	COS(RADIANS(?)) * COS(RADIANS(v.latitude::float8)) *
+	POWER(SIN(RADIANS(v.longitude::float8 - ?) / 2), 2))))`, geo.EarthRadiusKm)
+
+// showIDsNear returns a subquery of show IDs with at least one venue within
+// near.RadiusKm of the point. The bounding box lets idx_venues_lat_lng
+// discard far-away venues before the exact distance check.
+func showIDsNear(db *gorm.DB, near *contracts.NearFilter) *gorm.DB {
+	box := geo.BoundingBoxAround(near.Latitude, near.Longitude, near.RadiusKm)
+	q := db.Table("show_venues sv").
+		Select("sv.show_id").
+		Joins("JOIN venues v ON v.id = sv.venue_id").
+		Where("v.latitude BETWEEN ? AND ?", box.MinLat, box.MaxLat)
+	if !box.SpansAllLng {
+		q = q.Where("v.longitude BETWEEN ? AND ?", box.MinLng, box.MaxLng)
+	}
+	return q.Where(haversineKmSQL+" <= ?", near.Latitude, near.Latitude, near.Longitude, near.RadiusKm)
+}
+
+// showIDsInMetro returns a subquery of show IDs with at least one venue in
+// the given CBSA metro.
+func showIDsInMetro(db *gorm.DB, metro string) *gorm.DB {
+	return db.Table("show_venues sv").
+		Select("sv.show_id").
+		Joins("JOIN venues v ON v.id = sv.venue_id").
+		Where("v.metro = ?", metro)
+}
+
+// showIDsWithAllAges returns a subquery of show IDs with at least one venue
+// whose all_ages flag equals allAges. Venues with an unknown flag don't match.
+func showIDsWithAllAges(db *gorm.DB, allAges bool) *gorm.DB {
+	return db.Table("show_venues sv").
+		Select("sv.show_id").
+		Joins("JOIN venues v ON v.id = sv.venue_id").
+		Where("v.all_ages = ?", allAges)
+}
@@ -0,0 +1,753 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
+	"psychic-homily-backend/internal/utils"
+)
+
+// maxSeriesOccurrences caps how many shows one series may span (two years of
+// weekly dates). Keeps a typo'd end_date from generating thousands of rows.
+const maxSeriesOccurrences = 104
+
+const seriesDateLayout = "2006-01-02"
+
+// Reasons reported on ShowSeriesSkippedDate.
+const (
+	seriesSkipDetached = "detached occurrence already on this date"
+	seriesSkipDeleted  = "deleted occurrence on this date"
+)
+
+// ShowSeriesService manages recurring-event templates and the shows they
+// generate. Occurrences are created through ShowService so they get the same
+// slugs, dedup columns and headliner-conflict checks as hand-entered shows.
+type ShowSeriesService struct {
+	db    *gorm.DB
+	shows *ShowService
+	now   func() time.Time // injectable for regeneration tests
+}
+
+// NewShowSeriesService creates a new show series service. shows should be the
+// shared ShowService so occurrences get its hooks (admin events, flyer
+// removal); nil builds a bare one.
+func NewShowSeriesService(database *gorm.DB, shows *ShowService) *ShowSeriesService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	if shows == nil {
+		shows = NewShowService(database)
+	}
+	return &ShowSeriesService{
+		db:    database,
+		shows: shows,
+		now:   time.Now,
+	}
+}
+
+// CreateSeries stores a template and generates its upcoming occurrences.
+// Dates already in the past are not generated.
+func (s *ShowSeriesService) CreateSeries(req *contracts.CreateShowSeriesRequest) (*contracts.ShowSeriesResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowSeriesInternal(fmt.Errorf("database not initialized"))
+	}
+
+	series := &catalogm.ShowSeries{
+		Title:         req.Title,
+		VenueID:       req.VenueID,
+		DayOfWeek:     req.DayOfWeek,
+		StartTime:     req.StartTime,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+		PriceMin:      req.PriceMin,
+		PriceMax:      req.PriceMax,
+		PriceCurrency: seriesPriceCurrency(req.PriceCurrency),
+		IsFree:        req.IsFree,
+		CreatedBy:     req.CreatedBy,
+	}
+	if req.AgeRequirement != "" {
+		series.AgeRequirement = &req.AgeRequirement
+	}
+	if req.Description != "" {
+		series.Description = &req.Description
+	}
+	if req.TicketURL != "" {
+		series.TicketURL = &req.TicketURL
+	}
+	if err := validateSeriesTemplate(series, req.Artists); err != nil {
+		return nil, err
+	}
+
+	var response *contracts.ShowSeriesResponse
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkSeriesReferences(tx, series.VenueID, req.Artists); err != nil {
+			return err
+		}
+		if err := tx.Create(series).Error; err != nil {
+			return apperrors.ErrShowSeriesInternal(err)
+		}
+		if err := replaceSeriesArtists(tx, series.ID, req.Artists); err != nil {
+			return err
+		}
+
+		skipped, err := s.generateOccurrences(tx, series.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err = loadSeriesResponse(tx, series.ID)
+		if err != nil {
+			return err
+		}
+		response.Skipped = skipped
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetSeries returns a series template with all of its occurrences.
+func (s *ShowSeriesService) GetSeries(seriesID uint) (*contracts.ShowSeriesResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowSeriesInternal(fmt.Errorf("database not initialized"))
+	}
+	return loadSeriesResponse(s.db, seriesID)
+}
+
+// UpdateSeries applies a partial template edit, then syncs future
+// non-detached occurrences so they reflect the new template.
+func (s *ShowSeriesService) UpdateSeries(seriesID uint, req *contracts.UpdateShowSeriesRequest) (*contracts.ShowSeriesResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowSeriesInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var response *contracts.ShowSeriesResponse
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		series, err := loadSeries(tx, seriesID)
+		if err != nil {
+			return err
+		}
+
+		applySeriesUpdate(series, req)
+
+		artists := seriesArtistInputs(series.Artists)
+		if req.Artists != nil {
+			artists = *req.Artists
+		}
+		if err := validateSeriesTemplate(series, artists); err != nil {
+			return err
+		}
+
+		if err := tx.Model(series).Select(
+			"title", "day_of_week", "start_time", "start_date", "end_date",
+			"price_min", "price_max", "price_currency", "is_free",
+			"age_requirement", "description", "ticket_url",
+		).Updates(series).Error; err != nil {
+			return apperrors.ErrShowSeriesInternal(err)
+		}
+
+		if req.Artists != nil {
+			if err := checkSeriesReferences(tx, series.VenueID, artists); err != nil {
+				return err
+			}
+			if err := replaceSeriesArtists(tx, series.ID, artists); err != nil {
+				return err
+			}
+		}
+
+		response, err = s.regenerateTx(tx, series.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// RegenerateFutureOccurrences brings every future, non-detached occurrence
+// back in line with the current template.
+func (s *ShowSeriesService) RegenerateFutureOccurrences(seriesID uint) (*contracts.ShowSeriesResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowSeriesInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var response *contracts.ShowSeriesResponse
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := loadSeries(tx, seriesID); err != nil {
+			return err
+		}
+		var err error
+		response, err = s.regenerateTx(tx, seriesID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DetachOccurrence marks one occurrence as a one-off. It keeps its series_id
+// for provenance but series edits and regeneration no longer touch it.
+func (s *ShowSeriesService) DetachOccurrence(seriesID, showID uint) (*contracts.ShowSeriesOccurrence, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowSeriesInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var show catalogm.Show
+	err := s.db.Where("id = ? AND series_id = ? AND deleted_at IS NULL", showID, seriesID).First(&show).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrShowSeriesOccurrenceNotFound(seriesID, showID)
+	}
+	if err != nil {
+		return nil, apperrors.ErrShowSeriesInternal(err)
+	}
+
+	if !show.SeriesDetached {
+		if err := s.db.Model(&show).Update("series_detached", true).Error; err != nil {
+			return nil, apperrors.ErrShowSeriesInternal(err)
+		}
+	}
+
+	occurrence := buildSeriesOccurrence(&show)
+	occurrence.Detached = true
+	return &occurrence, nil
+}
+
+// DeleteSeries removes the template and moves its future, non-detached
+// occurrences to the trash. Past and detached occurrences survive as ordinary
+// shows (the FK nulls their series_id).
+func (s *ShowSeriesService) DeleteSeries(seriesID uint) error {
+	if s.db == nil {
+		return apperrors.ErrShowSeriesInternal(fmt.Errorf("database not initialized"))
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := loadSeries(tx, seriesID); err != nil {
+			return err
+		}
+		if err := s.removeFutureOccurrences(tx, seriesID); err != nil {
+			return err
+		}
+		if err := tx.Delete(&catalogm.ShowSeries{}, seriesID).Error; err != nil {
+			return apperrors.ErrShowSeriesInternal(err)
+		}
+		return nil
+	})
+}
+
+// regenerateTx brings future non-detached occurrences in line with the
+// template and returns the refreshed series.
+func (s *ShowSeriesService) regenerateTx(tx *gorm.DB, seriesID uint) (*contracts.ShowSeriesResponse, error) {
+	skipped, err := s.generateOccurrences(tx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	response, err := loadSeriesResponse(tx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	response.Skipped = skipped
+	return response, nil
+}
+
+// removeFutureOccurrences soft-deletes the series' live, non-detached shows
+// dated now or later, like an admin deleting them by hand: saves and RSVPs
+// come back on restore, and the retention sweep removes the rest. Past
+// occurrences are history.
+func (s *ShowSeriesService) removeFutureOccurrences(tx *gorm.DB, seriesID uint) error {
+	var showIDs []uint
+	if err := tx.Model(&catalogm.Show{}).
+		Where("series_id = ? AND series_detached = ? AND event_date >= ? AND deleted_at IS NULL", seriesID, false, s.now().UTC()).
+		Pluck("id", &showIDs).Error; err != nil {
+		return apperrors.ErrShowSeriesInternal(err)
+	}
+	for _, showID := range showIDs {
+		if err := softDeleteShowTx(tx, showID); err != nil {
+			return apperrors.ErrShowSeriesInternal(err)
+		}
+	}
+	return nil
+}
+
+// generateOccurrences syncs the series' future shows with its template dates.
+// A non-detached occurrence on a template date is updated in place, so it
+// keeps its ID, slug, short link and saves; only dates the template dropped
+// are soft-deleted, and only new dates are created. A date held by a detached
+// occurrence is skipped, and so is a date whose only occurrence is in the
+// trash, so deleted occurrences don't come back (restoring one does). A new
+// date whose headliner already has a show at the venue is skipped, not fatal,
+// so one clash doesn't block the rest of the series.
+func (s *ShowSeriesService) generateOccurrences(tx *gorm.DB, seriesID uint) ([]contracts.ShowSeriesSkippedDate, error) {
+	series, err := loadSeries(tx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := utils.EventLocation(series.Venue.Timezone, series.Venue.State)
+	dates, err := seriesOccurrenceDates(series, loc, s.now())
+	if err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(dates))
+	for _, eventDate := range dates {
+		wanted[eventDate.In(loc).Format(seriesDateLayout)] = true
+	}
+
+	var existing []catalogm.Show
+	if err := tx.Where("series_id = ? AND event_date >= ?", seriesID, s.now().UTC()).
+		Order("event_date ASC, id ASC").
+		Find(&existing).Error; err != nil {
+		return nil, apperrors.ErrShowSeriesInternal(err)
+	}
+	// taken maps a local date to the reason it can't hold an occurrence.
+	// deleted dates only block one when no live occurrence holds it.
+	taken := make(map[string]string, len(existing))
+	deleted := make(map[string]bool, len(existing))
+	current := make(map[string]uint, len(existing))
+	for _, show := range existing {
+		localDate := show.EventDate.In(loc).Format(seriesDateLayout)
+		switch {
+		case show.DeletedAt != nil:
+			deleted[localDate] = true
+		case show.SeriesDetached:
+			taken[localDate] = seriesSkipDetached
+		case !wanted[localDate]:
+			// The template no longer covers this date.
+			if err := softDeleteShowTx(tx, show.ID); err != nil {
+				return nil, apperrors.ErrShowSeriesInternal(err)
+			}
+		case current[localDate] != 0:
+			// A second occurrence on one date can only be a leftover; keep one.
+			if err := softDeleteShowTx(tx, show.ID); err != nil {
+				return nil, apperrors.ErrShowSeriesInternal(err)
+			}
+		default:
+			current[localDate] = show.ID
+		}
+	}
+
+	artists := make([]contracts.CreateShowArtist, 0, len(series.Artists))
+	for _, sa := range series.Artists {
+		artistID := sa.ArtistID
+		isHeadliner := sa.SetType == "headliner"
+		artists = append(artists, contracts.CreateShowArtist{
+			ID:          &artistID,
+			Name:        sa.Artist.Name,
+			IsHeadliner: &isHeadliner,
+		})
+	}
+	venueID := series.VenueID
+
+	price := utils.PriceRange{
+		Min:      series.PriceMin,
+		Max:      series.PriceMax,
+		Currency: series.PriceCurrency,
+		IsFree:   series.IsFree,
+	}
+
+	skipped := []contracts.ShowSeriesSkippedDate{}
+	for _, eventDate := range dates {
+		localDate := eventDate.In(loc).Format(seriesDateLayout)
+		if reason, ok := taken[localDate]; ok {
+			skipped = append(skipped, contracts.ShowSeriesSkippedDate{Date: localDate, Reason: reason})
+			continue
+		}
+
+		if showID, ok := current[localDate]; ok {
+			if err := updateOccurrenceTx(tx, showID, series, eventDate, price, artists); err != nil {
+				return nil, apperrors.ErrShowSeriesInternal(err)
+			}
+			continue
+		}
+		if deleted[localDate] {
+			skipped = append(skipped, contracts.ShowSeriesSkippedDate{Date: localDate, Reason: seriesSkipDeleted})
+			continue
+		}
+
+		req := &contracts.CreateShowRequest{
+			Title:          series.Title,
+			EventDate:      eventDate,
+			City:           series.Venue.City,
+			State:          series.Venue.State,
+			PriceMin:       price.Min,
+			PriceMax:       price.Max,
+			PriceCurrency:  price.Currency,
+			IsFree:         price.IsFree,
+			AgeRequirement: derefString(series.AgeRequirement),
+			Description:    derefString(series.Description),
+			TicketURL:      derefString(series.TicketURL),
+			Venues: []contracts.CreateShowVenue{{
+				ID:    &venueID,
+				Name:  series.Venue.Name,
+				City:  series.Venue.City,
+				State: series.Venue.State,
+			}},
+			Artists:           artists,
+			SubmittedByUserID: series.CreatedBy,
+			SubmitterIsAdmin:  true,
+		}
+
+		// Savepoint per occurrence so a skipped date leaves no partial rows.
+		err := tx.Transaction(func(sp *gorm.DB) error {
+			created, err := s.shows.createShowTx(sp, req)
+			if err != nil {
+				return err
+			}
+			return sp.Model(&catalogm.Show{}).Where("id = ?", created.ID).
+				Update("series_id", seriesID).Error
+		})
+		var conflict *headlinerConflictError
+		if errors.As(err, &conflict) {
+			skipped = append(skipped, contracts.ShowSeriesSkippedDate{Date: localDate, Reason: conflict.Error()})
+			continue
+		}
+		if err != nil {
+			return nil, apperrors.ErrShowSeriesInternal(err)
+		}
+	}
+
+	return skipped, nil
+}
+
+// updateOccurrenceTx rewrites an existing occurrence from the template: the
+// template fields, its start time on the same date, and the bill. The show's
+// row, venue and engagement are kept.
+func updateOccurrenceTx(tx *gorm.DB, showID uint, series *catalogm.ShowSeries, eventDate time.Time, price utils.PriceRange, artists []contracts.CreateShowArtist) error {
+	var ticketURL *string
+	if series.TicketURL != nil && *series.TicketURL != "" {
+		ticketURL = series.TicketURL
+	}
+	ageRequirement := derefString(series.AgeRequirement)
+	description := derefString(series.Description)
+	if err := tx.Model(&catalogm.Show{}).Where("id = ?", showID).Updates(map[string]interface{}{
+		"title":           series.Title,
+		"event_date":      eventDate.UTC(),
+		"price_min":       price.Min,
+		"price_max":       price.Max,
+		"price_currency":  price.Currency,
+		"is_free":         price.IsFree,
+		"age_requirement": &ageRequirement,
+		"description":     &description,
+		"ticket_url":      ticketURL,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to update occurrence %d: %w", showID, err)
+	}
+
+	// Same billing rules as associateArtists: the headliner flag picks the
+	// set type and template order is the position. An unchanged bill is left
+	// alone so per-show details like set times survive.
+	var billed []catalogm.ShowArtist
+	if err := tx.Where("show_id = ?", showID).Order("position ASC").Find(&billed).Error; err != nil {
+		return fmt.Errorf("failed to load occurrence %d artists: %w", showID, err)
+	}
+	if !sameBill(billed, artists) {
+		if err := tx.Where("show_id = ?", showID).Delete(&catalogm.ShowArtist{}).Error; err != nil {
+			return fmt.Errorf("failed to clear occurrence %d artists: %w", showID, err)
+		}
+		for position, a := range artists {
+			if err := tx.Create(&catalogm.ShowArtist{
+				ShowID:   showID,
+				ArtistID: *a.ID,
+				Position: position,
+				SetType:  occurrenceSetType(a),
+			}).Error; err != nil {
+				return fmt.Errorf("failed to bill occurrence %d: %w", showID, err)
+			}
+		}
+	}
+	// The dedup columns mirror event_date, which may have moved.
+	if err := syncShowArtistDedupColumns(tx, showID); err != nil {
+		return fmt.Errorf("failed to sync occurrence %d dedup columns: %w", showID, err)
+	}
+	return stampShowLocalTime(tx, showID)
+}
+
+// sameBill reports whether an occurrence is already billed as the template.
+func sameBill(billed []catalogm.ShowArtist, artists []contracts.CreateShowArtist) bool {
+	if len(billed) != len(artists) {
+		return false
+	}
+	for i, a := range artists {
+		if billed[i].ArtistID != *a.ID || billed[i].SetType != occurrenceSetType(a) {
+			return false
+		}
+	}
+	return true
+}
+
+func occurrenceSetType(a contracts.CreateShowArtist) string {
+	if a.IsHeadliner != nil && *a.IsHeadliner {
+		return catalogm.SetTypeHeadliner
+	}
+	return catalogm.SetTypeOpener
+}
+
+// seriesOccurrenceDates returns the UTC instants of every template date on or
+// after from. Each date is the series weekday at StartTime in loc, so a
+// weekly 8pm show stays at 8pm local across DST changes. Errors when the full
+// range exceeds maxSeriesOccurrences.
+func seriesOccurrenceDates(series *catalogm.ShowSeries, loc *time.Location, from time.Time) ([]time.Time, error) {
+	startDate, err := time.Parse(seriesDateLayout, formatDateString(series.StartDate))
+	if err != nil {
+		return nil, apperrors.ErrShowSeriesInvalid("start_date must be YYYY-MM-DD")
+	}
+	endDate, err := time.Parse(seriesDateLayout, formatDateString(series.EndDate))
+	if err != nil {
+		return nil, apperrors.ErrShowSeriesInvalid("end_date must be YYYY-MM-DD")
+	}
+	startTime, err := time.Parse("15:04", series.StartTime)
+	if err != nil {
+		return nil, apperrors.ErrShowSeriesInvalid("start_time must be HH:MM")
+	}
+
+	offset := (series.DayOfWeek - int(startDate.Weekday()) + 7) % 7
+	first := startDate.AddDate(0, 0, offset)
+
+	var dates []time.Time
+	count := 0
+	for d := first; !d.After(endDate); d = d.AddDate(0, 0, 7) {
+		count++
+		if count > maxSeriesOccurrences {
+			return nil, apperrors.ErrShowSeriesInvalid(
+				fmt.Sprintf("series spans more than %d occurrences; shorten the date range", maxSeriesOccurrences))
+		}
+		eventDate := time.Date(d.Year(), d.Month(), d.Day(), startTime.Hour(), startTime.Minute(), 0, 0, loc)
+		if eventDate.Before(from) {
+			continue
+		}
+		dates = append(dates, eventDate.UTC())
+	}
+	return dates, nil
+}
+
+// validateSeriesTemplate checks the template fields that don't need the DB.
+func validateSeriesTemplate(series *catalogm.ShowSeries, artists []contracts.ShowSeriesArtistInput) error {
+	if series.Title == "" {
+		return apperrors.ErrShowSeriesInvalid("title is required")
+	}
+	if series.DayOfWeek < 0 || series.DayOfWeek > 6 {
+		return apperrors.ErrShowSeriesInvalid("day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if len(artists) == 0 {
+		return apperrors.ErrShowSeriesInvalid("at least one artist is required")
+	}
+	seen := make(map[uint]bool, len(artists))
+	for _, a := range artists {
+		if seen[a.ArtistID] {
+			return apperrors.ErrShowSeriesInvalid(fmt.Sprintf("artist %d is listed more than once", a.ArtistID))
+		}
+		seen[a.ArtistID] = true
+	}
+	if err := utils.ValidatePrice(series.PriceMin, series.PriceMax, series.PriceCurrency); err != nil {
+		return apperrors.ErrShowSeriesInvalid(err.Error())
+	}
+	// Parses dates/time and enforces the occurrence cap over the full range.
+	if _, err := seriesOccurrenceDates(series, time.UTC, time.Time{}); err != nil {
+		return err
+	}
+	if formatDateString(series.EndDate) < formatDateString(series.StartDate) {
+		return apperrors.ErrShowSeriesInvalid("end_date must not be before start_date")
+	}
+	return nil
+}
+
+// checkSeriesReferences verifies the venue and every artist exist.
+func checkSeriesReferences(tx *gorm.DB, venueID uint, artists []contracts.ShowSeriesArtistInput) error {
+	var venueCount int64
+	if err := tx.Model(&catalogm.Venue{}).Where("id = ?", venueID).Count(&venueCount).Error; err != nil {
+		return apperrors.ErrShowSeriesInternal(err)
+	}
+	if venueCount == 0 {
+		return apperrors.ErrShowSeriesInvalid(fmt.Sprintf("venue %d not found", venueID))
+	}
+
+	ids := make([]uint, 0, len(artists))
+	for _, a := range artists {
+		ids = append(ids, a.ArtistID)
+	}
+	var artistCount int64
+	if err := tx.Model(&catalogm.Artist{}).Where("id IN ?", ids).Count(&artistCount).Error; err != nil {
+		return apperrors.ErrShowSeriesInternal(err)
+	}
+	if int(artistCount) != len(ids) {
+		return apperrors.ErrShowSeriesInvalid("one or more artists not found")
+	}
+	return nil
+}
+
+// replaceSeriesArtists rewrites the template billing in request order.
+func replaceSeriesArtists(tx *gorm.DB, seriesID uint, artists []contracts.ShowSeriesArtistInput) error {
+	if err := tx.Where("series_id = ?", seriesID).Delete(&catalogm.ShowSeriesArtist{}).Error; err != nil {
+		return apperrors.ErrShowSeriesInternal(err)
+	}
+	for position, a := range artists {
+		setType := "performer"
+		if a.IsHeadliner {
+			setType = "headliner"
+		}
+		row := catalogm.ShowSeriesArtist{
+			SeriesID: seriesID,
+			ArtistID: a.ArtistID,
+			Position: position,
+			SetType:  setType,
+		}
+		if err := tx.Create(&row).Error; err != nil {
+			return apperrors.ErrShowSeriesInternal(err)
+		}
+	}
+	return nil
+}
+
+// applySeriesUpdate copies the non-nil fields of req onto series.
+func applySeriesUpdate(series *catalogm.ShowSeries, req *contracts.UpdateShowSeriesRequest) {
+	if req.Title != nil {
+		series.Title = *req.Title
+	}
+	if req.DayOfWeek != nil {
+		series.DayOfWeek = *req.DayOfWeek
+	}
+	if req.StartTime != nil {
+		series.StartTime = *req.StartTime
+	}
+	if req.StartDate != nil {
+		series.StartDate = *req.StartDate
+	}
+	if req.EndDate != nil {
+		series.EndDate = *req.EndDate
+	}
+	if req.PriceMin != nil || req.PriceMax != nil || req.ClearPrice {
+		series.PriceMin = req.PriceMin
+		series.PriceMax = req.PriceMax
+	}
+	if req.PriceCurrency != nil {
+		series.PriceCurrency = seriesPriceCurrency(*req.PriceCurrency)
+	}
+	if req.IsFree != nil {
+		series.IsFree = *req.IsFree
+	}
+	if req.AgeRequirement != nil {
+		series.AgeRequirement = req.AgeRequirement
+	}
+	if req.Description != nil {
+		series.Description = req.Description
+	}
+	if req.TicketURL != nil {
+		series.TicketURL = req.TicketURL
+	}
+}
+
+// seriesPriceCurrency normalizes a template currency code; empty is the
+// default currency.
+func seriesPriceCurrency(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return utils.DefaultPriceCurrency
+	}
+	return code
+}
+
+// seriesArtistInputs converts stored template billing back to input form.
+func seriesArtistInputs(rows []catalogm.ShowSeriesArtist) []contracts.ShowSeriesArtistInput {
+	inputs := make([]contracts.ShowSeriesArtistInput, 0, len(rows))
+	for _, row := range rows {
+		inputs = append(inputs, contracts.ShowSeriesArtistInput{
+			ArtistID:    row.ArtistID,
+			IsHeadliner: row.SetType == "headliner",
+		})
+	}
+	return inputs
+}
+
+// loadSeries fetches a series with its venue and ordered billing.
+func loadSeries(tx *gorm.DB, seriesID uint) (*catalogm.ShowSeries, error) {
+	var series catalogm.ShowSeries
+	err := tx.Preload("Venue").
+		Preload("Artists", func(q *gorm.DB) *gorm.DB { return q.Order("position ASC") }).
+		Preload("Artists.Artist").
+		First(&series, seriesID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrShowSeriesNotFound(seriesID)
+	}
+	if err != nil {
+		return nil, apperrors.ErrShowSeriesInternal(err)
+	}
+	return &series, nil
+}
+
+// loadSeriesResponse builds the API view of a series and its occurrences.
+func loadSeriesResponse(tx *gorm.DB, seriesID uint) (*contracts.ShowSeriesResponse, error) {
+	series, err := loadSeries(tx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	var shows []catalogm.Show
+	if err := tx.Where("series_id = ? AND deleted_at IS NULL", seriesID).Order("event_date ASC").Find(&shows).Error; err != nil {
+		return nil, apperrors.ErrShowSeriesInternal(err)
+	}
+
+	response := &contracts.ShowSeriesResponse{
+		ID:             series.ID,
+		Title:          series.Title,
+		VenueID:        series.VenueID,
+		VenueName:      series.Venue.Name,
+		DayOfWeek:      series.DayOfWeek,
+		StartTime:      series.StartTime,
+		StartDate:      formatDateString(series.StartDate),
+		EndDate:        formatDateString(series.EndDate),
+		AgeRequirement: series.AgeRequirement,
+		Description:    series.Description,
+		TicketURL:      series.TicketURL,
+		Artists:        make([]contracts.ShowSeriesArtistResponse, 0, len(series.Artists)),
+		Occurrences:    make([]contracts.ShowSeriesOccurrence, 0, len(shows)),
+		CreatedAt:      series.CreatedAt,
+		UpdatedAt:      series.UpdatedAt,
+		// Same shape, and same legacy Price, as the occurrences' own prices.
+		ShowPrice: shared.ShowPriceOf(&catalogm.Show{
+			PriceMin:      series.PriceMin,
+			PriceMax:      series.PriceMax,
+			PriceCurrency: series.PriceCurrency,
+			IsFree:        series.IsFree,
+		}),
+	}
+	for _, sa := range series.Artists {
+		slug := ""
+		if sa.Artist.Slug != nil {
+			slug = *sa.Artist.Slug
+		}
+		response.Artists = append(response.Artists, contracts.ShowSeriesArtistResponse{
+			ArtistID:    sa.ArtistID,
+			Name:        sa.Artist.Name,
+			Slug:        slug,
+			IsHeadliner: sa.SetType == "headliner",
+		})
+	}
+	for i := range shows {
+		response.Occurrences = append(response.Occurrences, buildSeriesOccurrence(&shows[i]))
+	}
+	return response, nil
+}
+
+func buildSeriesOccurrence(show *catalogm.Show) contracts.ShowSeriesOccurrence {
+	slug := ""
+	if show.Slug != nil {
+		slug = *show.Slug
+	}
+	return contracts.ShowSeriesOccurrence{
+		ShowID:    show.ID,
+		Slug:      slug,
+		EventDate: show.EventDate,
+		Detached:  show.SeriesDetached,
+	}
+}
@@ -0,0 +1,519 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestShowSeriesService_NilDatabase(t *testing.T) {
+	svc := &ShowSeriesService{now: time.Now}
+
+	_, err := svc.GetSeries(1)
+	var seriesErr *apperrors.ShowSeriesError
+	require.True(t, errors.As(err, &seriesErr))
+	assert.Equal(t, apperrors.CodeShowSeriesInternal, seriesErr.Code)
+	assert.Error(t, svc.DeleteSeries(1))
+}
+
+func TestSeriesOccurrenceDates_WeeklyInVenueTimezone(t *testing.T) {
+	phoenix, err := time.LoadLocation("America/Phoenix")
+	require.NoError(t, err)
+
+	series := &catalogm.ShowSeries{
+		DayOfWeek: int(time.Tuesday),
+		StartTime: "20:00",
+		StartDate: "2026-11-01", // a Sunday
+		EndDate:   "2026-11-30",
+	}
+
+	dates, err := seriesOccurrenceDates(series, phoenix, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, dates, 4)
+	for i, day := range []int{3, 10, 17, 24} {
+		want := time.Date(2026, 11, day, 20, 0, 0, 0, phoenix).UTC()
+		assert.Equal(t, want, dates[i])
+		assert.Equal(t, time.UTC, dates[i].Location())
+	}
+}
+
+func TestSeriesOccurrenceDates_KeepsLocalTimeAcrossDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// DST ends 2026-11-01; the Friday before and after straddle it.
+	series := &catalogm.ShowSeries{
+		DayOfWeek: int(time.Friday),
+		StartTime: "21:30",
+		StartDate: "2026-10-30",
+		EndDate:   "2026-11-06",
+	}
+
+	dates, err := seriesOccurrenceDates(series, newYork, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, dates, 2)
+	for _, d := range dates {
+		local := d.In(newYork)
+		assert.Equal(t, 21, local.Hour())
+		assert.Equal(t, 30, local.Minute())
+	}
+	assert.Equal(t, 7*24*time.Hour+time.Hour, dates[1].Sub(dates[0]))
+}
+
+func TestSeriesOccurrenceDates_SkipsPastDates(t *testing.T) {
+	series := &catalogm.ShowSeries{
+		DayOfWeek: int(time.Monday),
+		StartTime: "19:00",
+		StartDate: "2026-01-05",
+		EndDate:   "2026-01-26",
+	}
+	from := time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC)
+
+	dates, err := seriesOccurrenceDates(series, time.UTC, from)
+	require.NoError(t, err)
+	require.Len(t, dates, 2)
+	assert.Equal(t, time.Date(2026, 1, 19, 19, 0, 0, 0, time.UTC), dates[0])
+	assert.Equal(t, time.Date(2026, 1, 26, 19, 0, 0, 0, time.UTC), dates[1])
+}
+
+func TestSeriesOccurrenceDates_AcceptsDatabaseDateFormat(t *testing.T) {
+	series := &catalogm.ShowSeries{
+		DayOfWeek: int(time.Saturday),
+		StartTime: "12:00",
+		StartDate: "2026-02-07T00:00:00Z",
+		EndDate:   "2026-02-07T00:00:00Z",
+	}
+
+	dates, err := seriesOccurrenceDates(series, time.UTC, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, dates, 1)
+}
+
+func TestValidateSeriesTemplate(t *testing.T) {
+	valid := func() *catalogm.ShowSeries {
+		return &catalogm.ShowSeries{
+			Title:     "Weekly Night",
+			DayOfWeek: 3,
+			StartTime: "20:00",
+			StartDate: "2026-01-01",
+			EndDate:   "2026-03-01",
+		}
+	}
+	artists := []contracts.ShowSeriesArtistInput{{ArtistID: 1, IsHeadliner: true}}
+
+	tests := []struct {
+		name    string
+		mutate  func(*catalogm.ShowSeries)
+		artists []contracts.ShowSeriesArtistInput
+		wantErr bool
+	}{
+		{"valid", func(*catalogm.ShowSeries) {}, artists, false},
+		{"missing title", func(s *catalogm.ShowSeries) { s.Title = "" }, artists, true},
+		{"day out of range", func(s *catalogm.ShowSeries) { s.DayOfWeek = 7 }, artists, true},
+		{"bad start time", func(s *catalogm.ShowSeries) { s.StartTime = "8pm" }, artists, true},
+		{"bad start date", func(s *catalogm.ShowSeries) { s.StartDate = "01/01/2026" }, artists, true},
+		{"end before start", func(s *catalogm.ShowSeries) { s.EndDate = "2025-12-01" }, artists, true},
+		{"too many occurrences", func(s *catalogm.ShowSeries) { s.EndDate = "2029-01-01" }, artists, true},
+		{"no artists", func(*catalogm.ShowSeries) {}, nil, true},
+		{"duplicate artist", func(*catalogm.ShowSeries) {}, append(artists, artists[0]), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			series := valid()
+			tc.mutate(series)
+			err := validateSeriesTemplate(series, tc.artists)
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var seriesErr *apperrors.ShowSeriesError
+			require.True(t, errors.As(err, &seriesErr), "expected ShowSeriesError, got %v", err)
+			assert.Equal(t, apperrors.CodeShowSeriesInvalid, seriesErr.Code)
+		})
+	}
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type ShowSeriesServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB        *testutil.TestDatabase
+	db            *gorm.DB
+	seriesService *ShowSeriesService
+	now           time.Time
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+
+	suite.seriesService = NewShowSeriesService(suite.testDB.DB, nil)
+	suite.seriesService.now = func() time.Time { return suite.now }
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+// TearDownTest cleans up data between tests for isolation
+func (suite *ShowSeriesServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	// Delete in FK-safe order
+	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
+	_, _ = sqlDB.Exec("DELETE FROM show_artists")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM show_series_artists")
+	_, _ = sqlDB.Exec("DELETE FROM show_series")
+	_, _ = sqlDB.Exec("DELETE FROM artists")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestShowSeriesServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShowSeriesServiceIntegrationTestSuite))
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) createSeriesFixtures() (*catalogm.Venue, *catalogm.Artist) {
+	venue := &catalogm.Venue{Name: "Series Venue", City: "Phoenix", State: "AZ", Verified: true}
+	suite.Require().NoError(suite.db.Create(venue).Error)
+	artist := &catalogm.Artist{Name: fmt.Sprintf("Series Band %d", time.Now().UnixNano())}
+	suite.Require().NoError(suite.db.Create(artist).Error)
+	return venue, artist
+}
+
+// createWeeklySeries creates a Wednesday 20:00 series spanning January 2026
+// (four occurrences: the 7th, 14th, 21st and 28th).
+func (suite *ShowSeriesServiceIntegrationTestSuite) createWeeklySeries() *contracts.ShowSeriesResponse {
+	venue, artist := suite.createSeriesFixtures()
+	resp, err := suite.seriesService.CreateSeries(&contracts.CreateShowSeriesRequest{
+		Title:     "Wednesday Residency",
+		VenueID:   venue.ID,
+		DayOfWeek: int(time.Wednesday),
+		StartTime: "20:00",
+		StartDate: "2026-01-01",
+		EndDate:   "2026-01-31",
+		Artists:   []contracts.ShowSeriesArtistInput{{ArtistID: artist.ID, IsHeadliner: true}},
+	})
+	suite.Require().NoError(err)
+	return resp
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestCreateSeries_GeneratesOccurrences() {
+	resp := suite.createWeeklySeries()
+
+	suite.Len(resp.Occurrences, 4)
+	suite.Empty(resp.Skipped)
+	suite.Equal("2026-01-01", resp.StartDate)
+	suite.Len(resp.Artists, 1)
+	suite.True(resp.Artists[0].IsHeadliner)
+
+	var shows []catalogm.Show
+	suite.Require().NoError(suite.db.Preload("Venues").Preload("Artists").
+		Where("series_id = ?", resp.ID).Order("event_date").Find(&shows).Error)
+	suite.Require().Len(shows, 4)
+	for _, show := range shows {
+		suite.Equal("Wednesday Residency", show.Title)
+		suite.Equal(catalogm.ShowStatusApproved, show.Status)
+		suite.Len(show.Venues, 1)
+		suite.Len(show.Artists, 1)
+		suite.NotNil(show.Slug)
+		// Phoenix has no DST: 20:00 MST is 03:00 UTC the next day.
+		suite.Equal(3, show.EventDate.UTC().Hour())
+	}
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestCreateSeries_UnknownVenue() {
+	_, artist := suite.createSeriesFixtures()
+	_, err := suite.seriesService.CreateSeries(&contracts.CreateShowSeriesRequest{
+		Title:     "Ghost Venue Night",
+		VenueID:   999999,
+		DayOfWeek: 1,
+		StartTime: "20:00",
+		StartDate: "2026-01-01",
+		EndDate:   "2026-01-31",
+		Artists:   []contracts.ShowSeriesArtistInput{{ArtistID: artist.ID}},
+	})
+
+	var seriesErr *apperrors.ShowSeriesError
+	suite.Require().True(errors.As(err, &seriesErr))
+	suite.Equal(apperrors.CodeShowSeriesInvalid, seriesErr.Code)
+
+	var count int64
+	suite.db.Model(&catalogm.ShowSeries{}).Count(&count)
+	suite.Zero(count)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestCreateSeries_SkipsHeadlinerConflict() {
+	venue, artist := suite.createSeriesFixtures()
+	clash := time.Date(2026, 1, 14, 20, 0, 0, 0, time.FixedZone("MST", -7*3600))
+	_, err := suite.seriesService.shows.CreateShow(&contracts.CreateShowRequest{
+		Title:     "Existing Show",
+		EventDate: clash,
+		City:      "Phoenix",
+		State:     "AZ",
+		Venues:    []contracts.CreateShowVenue{{ID: &venue.ID, Name: venue.Name, City: venue.City, State: venue.State}},
+		Artists:   []contracts.CreateShowArtist{{ID: &artist.ID, Name: artist.Name, IsHeadliner: boolPtr(true)}},
+	})
+	suite.Require().NoError(err)
+
+	resp, err := suite.seriesService.CreateSeries(&contracts.CreateShowSeriesRequest{
+		Title:     "Wednesday Residency",
+		VenueID:   venue.ID,
+		DayOfWeek: int(time.Wednesday),
+		StartTime: "20:00",
+		StartDate: "2026-01-01",
+		EndDate:   "2026-01-31",
+		Artists:   []contracts.ShowSeriesArtistInput{{ArtistID: artist.ID, IsHeadliner: true}},
+	})
+	suite.Require().NoError(err)
+	suite.Len(resp.Occurrences, 3)
+	suite.Require().Len(resp.Skipped, 1)
+	suite.Equal("2026-01-14", resp.Skipped[0].Date)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestGetSeries_NotFound() {
+	_, err := suite.seriesService.GetSeries(999999)
+
+	var seriesErr *apperrors.ShowSeriesError
+	suite.Require().True(errors.As(err, &seriesErr))
+	suite.Equal(apperrors.CodeShowSeriesNotFound, seriesErr.Code)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestUpdateSeries_RegeneratesFutureOnly() {
+	resp := suite.createWeeklySeries()
+	pastID := resp.Occurrences[0].ShowID
+
+	// Move the clock past the first occurrence, then retime the template.
+	suite.now = time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	newTime := "21:00"
+	updated, err := suite.seriesService.UpdateSeries(resp.ID, &contracts.UpdateShowSeriesRequest{StartTime: &newTime})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(updated.Occurrences, 4)
+	suite.Equal(pastID, updated.Occurrences[0].ShowID, "past occurrence must survive untouched")
+	suite.Equal(3, updated.Occurrences[0].EventDate.UTC().Hour())
+	for _, occ := range updated.Occurrences[1:] {
+		suite.Equal(4, occ.EventDate.UTC().Hour())
+	}
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestUpdateSeries_KeepsOccurrenceIdentity() {
+	resp := suite.createWeeklySeries()
+	target := resp.Occurrences[1]
+
+	user := &authm.User{Email: stringPtr("series-saver@test.com"), IsActive: true}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	suite.Require().NoError(suite.db.Create(&engagementm.UserBookmark{
+		UserID:     user.ID,
+		EntityType: engagementm.BookmarkEntityShow,
+		EntityID:   target.ShowID,
+		Action:     engagementm.BookmarkActionSave,
+	}).Error)
+	var before catalogm.Show
+	suite.Require().NoError(suite.db.First(&before, target.ShowID).Error)
+
+	newTitle := "Wednesday Residency (Renamed)"
+	updated, err := suite.seriesService.UpdateSeries(resp.ID, &contracts.UpdateShowSeriesRequest{Title: &newTitle})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(updated.Occurrences, 4)
+	for i, occ := range updated.Occurrences {
+		suite.Equal(resp.Occurrences[i].ShowID, occ.ShowID, "occurrence must be updated in place")
+	}
+	var after catalogm.Show
+	suite.Require().NoError(suite.db.First(&after, target.ShowID).Error)
+	suite.Equal(newTitle, after.Title)
+	suite.Equal(before.Slug, after.Slug)
+	suite.Equal(before.ShortCode, after.ShortCode)
+
+	var saves int64
+	suite.db.Model(&engagementm.UserBookmark{}).Where("entity_id = ?", target.ShowID).Count(&saves)
+	suite.Equal(int64(1), saves, "saves on an occurrence must survive a template edit")
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestUpdateSeries_RemovesOnlyDroppedDates() {
+	resp := suite.createWeeklySeries()
+
+	// Shorten the run so the 28th drops out.
+	endDate := "2026-01-25"
+	updated, err := suite.seriesService.UpdateSeries(resp.ID, &contracts.UpdateShowSeriesRequest{EndDate: &endDate})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(updated.Occurrences, 3)
+	for i, occ := range updated.Occurrences {
+		suite.Equal(resp.Occurrences[i].ShowID, occ.ShowID)
+	}
+	var dropped catalogm.Show
+	suite.Require().NoError(suite.db.First(&dropped, resp.Occurrences[3].ShowID).Error)
+	suite.NotNil(dropped.DeletedAt, "the dropped date's occurrence must go to the trash")
+
+	// Extending the run again leaves the trashed occurrence for a restore.
+	endDate = "2026-01-31"
+	extended, err := suite.seriesService.UpdateSeries(resp.ID, &contracts.UpdateShowSeriesRequest{EndDate: &endDate})
+	suite.Require().NoError(err)
+	suite.Len(extended.Occurrences, 3)
+	suite.Require().Len(extended.Skipped, 1)
+	suite.Equal(seriesSkipDeleted, extended.Skipped[0].Reason)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestDetachOccurrence_SurvivesRegeneration() {
+	resp := suite.createWeeklySeries()
+	detachedID := resp.Occurrences[2].ShowID
+
+	occ, err := suite.seriesService.DetachOccurrence(resp.ID, detachedID)
+	suite.Require().NoError(err)
+	suite.True(occ.Detached)
+
+	regenerated, err := suite.seriesService.RegenerateFutureOccurrences(resp.ID)
+	suite.Require().NoError(err)
+
+	suite.Len(regenerated.Occurrences, 4)
+	suite.Require().Len(regenerated.Skipped, 1)
+	suite.Equal(seriesSkipDetached, regenerated.Skipped[0].Reason)
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, detachedID).Error)
+	suite.True(show.SeriesDetached)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestRegenerate_LeavesDeletedOccurrence() {
+	resp := suite.createWeeklySeries()
+	deletedID := resp.Occurrences[1].ShowID
+	suite.Require().NoError(suite.db.Model(&catalogm.Show{}).Where("id = ?", deletedID).
+		Update("deleted_at", suite.now).Error)
+
+	regenerated, err := suite.seriesService.RegenerateFutureOccurrences(resp.ID)
+	suite.Require().NoError(err)
+
+	suite.Len(regenerated.Occurrences, 3, "the deleted occurrence must not come back")
+	suite.Require().Len(regenerated.Skipped, 1)
+	suite.Equal(seriesSkipDeleted, regenerated.Skipped[0].Reason)
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, deletedID).Error)
+	suite.NotNil(show.DeletedAt, "the soft-deleted row must be left alone")
+
+	// Deleting the series leaves the soft-deleted row to the trash as well.
+	suite.Require().NoError(suite.seriesService.DeleteSeries(resp.ID))
+	suite.Require().NoError(suite.db.First(&show, deletedID).Error)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestDetachOccurrence_WrongSeries() {
+	resp := suite.createWeeklySeries()
+
+	_, err := suite.seriesService.DetachOccurrence(resp.ID+1, resp.Occurrences[0].ShowID)
+
+	var seriesErr *apperrors.ShowSeriesError
+	suite.Require().True(errors.As(err, &seriesErr))
+	suite.Equal(apperrors.CodeShowSeriesOccurrenceNotFound, seriesErr.Code)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestDeleteSeries_KeepsPastAndDetached() {
+	resp := suite.createWeeklySeries()
+	_, err := suite.seriesService.DetachOccurrence(resp.ID, resp.Occurrences[3].ShowID)
+	suite.Require().NoError(err)
+
+	suite.now = time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	suite.Require().NoError(suite.seriesService.DeleteSeries(resp.ID))
+
+	var remaining []catalogm.Show
+	suite.Require().NoError(suite.db.Where("deleted_at IS NULL").Order("event_date").Find(&remaining).Error)
+	suite.Require().Len(remaining, 2)
+	suite.Equal(resp.Occurrences[0].ShowID, remaining[0].ID)
+	suite.Equal(resp.Occurrences[3].ShowID, remaining[1].ID)
+	for _, show := range remaining {
+		suite.Nil(show.SeriesID)
+	}
+
+	// The future occurrences are in the trash, not gone.
+	var trashed int64
+	suite.db.Model(&catalogm.Show{}).Where("deleted_at IS NOT NULL").Count(&trashed)
+	suite.Equal(int64(2), trashed)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestSeriesPrice_CopiedToOccurrences() {
+	venue, artist := suite.createSeriesFixtures()
+	minPrice, maxPrice := 12.0, 15.0
+	resp, err := suite.seriesService.CreateSeries(&contracts.CreateShowSeriesRequest{
+		Title:         "Priced Residency",
+		VenueID:       venue.ID,
+		DayOfWeek:     int(time.Wednesday),
+		StartTime:     "20:00",
+		StartDate:     "2026-01-01",
+		EndDate:       "2026-01-31",
+		PriceMin:      &minPrice,
+		PriceMax:      &maxPrice,
+		PriceCurrency: "cad",
+		Artists:       []contracts.ShowSeriesArtistInput{{ArtistID: artist.ID, IsHeadliner: true}},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(12.0, *resp.PriceMin)
+	suite.Equal(15.0, *resp.PriceMax)
+	suite.Equal("CAD", resp.PriceCurrency)
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, resp.Occurrences[0].ShowID).Error)
+	suite.Equal(12.0, *show.PriceMin)
+	suite.Equal(15.0, *show.PriceMax)
+	suite.Equal("CAD", show.PriceCurrency)
+	suite.False(show.IsFree)
+
+	// Free with no suggested donation, updated onto the existing occurrences.
+	updated, err := suite.seriesService.UpdateSeries(resp.ID, &contracts.UpdateShowSeriesRequest{
+		IsFree:     boolPtr(true),
+		ClearPrice: true,
+	})
+	suite.Require().NoError(err)
+	suite.True(updated.IsFree)
+	suite.Nil(updated.PriceMin)
+	suite.Require().NoError(suite.db.First(&show, resp.Occurrences[0].ShowID).Error)
+	suite.True(show.IsFree)
+	suite.Nil(show.PriceMin)
+	suite.Nil(show.PriceMax)
+}
+
+func (suite *ShowSeriesServiceIntegrationTestSuite) TestCreateSeries_InvalidPriceRange() {
+	venue, artist := suite.createSeriesFixtures()
+	minPrice, maxPrice := 15.0, 12.0
+	_, err := suite.seriesService.CreateSeries(&contracts.CreateShowSeriesRequest{
+		Title:     "Backwards Price",
+		VenueID:   venue.ID,
+		DayOfWeek: int(time.Wednesday),
+		StartTime: "20:00",
+		StartDate: "2026-01-01",
+		EndDate:   "2026-01-31",
+		PriceMin:  &minPrice,
+		PriceMax:  &maxPrice,
+		Artists:   []contracts.ShowSeriesArtistInput{{ArtistID: artist.ID}},
+	})
+
+	var seriesErr *apperrors.ShowSeriesError
+	suite.Require().True(errors.As(err, &seriesErr))
+	suite.Equal(apperrors.CodeShowSeriesInvalid, seriesErr.Code)
+}
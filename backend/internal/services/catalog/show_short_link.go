@@ -0,0 +1,195 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Short codes are the base-36 digits of (ID * showShortCodeMultiplier) mod
+// 36^7. The multiplier is coprime to 36, so the mapping is a bijection on
+// IDs below 36^7: every show gets a distinct code with no lookup or retry,
+// and consecutive shows don't get consecutive codes. The short links
+// migration backfills approved shows with the same formula, so don't change
+// these constants.
+const (
+	showShortCodeLength     = 7
+	showShortCodeAlphabet   = "0123456789abcdefghijklmnopqrstuvwxyz"
+	showShortCodeModulus    = 78364164096 // 36^7
+	showShortCodeMultiplier = 1580030173
+
+	// ShowShortLinkDirectReferrer is the referrer recorded for a click with
+	// no referring site.
+	ShowShortLinkDirectReferrer = "direct"
+
+	// ShowShortLinkOtherReferrer collects clicks from new referrers once a
+	// show already has showShortLinkMaxReferrers of them, so a stream of
+	// one-off ref tags can't grow a show's stats without bound.
+	ShowShortLinkOtherReferrer = "other"
+
+	showShortLinkReferrerMaxLength = 255
+	showShortLinkMaxReferrers      = 50
+)
+
+// ShowShortCode returns the short link code for a show ID.
+func ShowShortCode(showID uint) string {
+	n := (uint64(showID) * showShortCodeMultiplier) % showShortCodeModulus
+	code := make([]byte, showShortCodeLength)
+	for i := showShortCodeLength - 1; i >= 0; i-- {
+		code[i] = showShortCodeAlphabet[n%36]
+		n /= 36
+	}
+	return string(code)
+}
+
+// AssignShowShortCode gives an approved show its short code, once. Called
+// wherever a show becomes approved; a show that is later unpublished keeps
+// its code, so links already shared work again if it comes back.
+func AssignShowShortCode(tx *gorm.DB, show *catalogm.Show) error {
+	if show.Status != catalogm.ShowStatusApproved || show.ShortCode != nil {
+		return nil
+	}
+	code := ShowShortCode(show.ID)
+	// UpdateColumn: a generated code isn't an edit, so updated_at (and the
+	// sync feeds keyed on it) stay put.
+	if err := tx.Model(&catalogm.Show{}).Where("id = ? AND short_code IS NULL", show.ID).
+		UpdateColumn("short_code", code).Error; err != nil {
+		return fmt.Errorf("failed to assign show short code: %w", err)
+	}
+	show.ShortCode = &code
+	return nil
+}
+
+// ResolveShowShortCode returns the show a short link points at. Only
+// approved, undeleted shows resolve.
+func (s *ShowService) ResolveShowShortCode(code string) (*contracts.ShowShortLinkTarget, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	err := s.db.Select("id", "slug").
+		Where("short_code = ? AND status = ? AND deleted_at IS NULL", strings.ToLower(code), catalogm.ShowStatusApproved).
+		First(&show).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(0)
+		}
+		return nil, fmt.Errorf("failed to resolve short code: %w", err)
+	}
+
+	target := &contracts.ShowShortLinkTarget{ShowID: show.ID}
+	if show.Slug != nil {
+		target.Slug = *show.Slug
+	}
+	return target, nil
+}
+
+// RecordShowShortLinkClick counts one click-through on a show's short link.
+// A referrer the show hasn't seen before is counted as "other" once the show
+// has showShortLinkMaxReferrers distinct referrers.
+func (s *ShowService) RecordShowShortLinkClick(showID uint, referrer string) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	referrer = normalizeShortLinkReferrer(referrer)
+	if referrer != ShowShortLinkDirectReferrer && referrer != ShowShortLinkOtherReferrer {
+		var known int64
+		if err := s.db.Model(&catalogm.ShowShortLinkReferrer{}).
+			Where("show_id = ? AND referrer = ?", showID, referrer).
+			Count(&known).Error; err != nil {
+			return fmt.Errorf("failed to look up short link referrer: %w", err)
+		}
+		if known == 0 {
+			var distinct int64
+			if err := s.db.Model(&catalogm.ShowShortLinkReferrer{}).
+				Where("show_id = ?", showID).
+				Count(&distinct).Error; err != nil {
+				return fmt.Errorf("failed to count short link referrers: %w", err)
+			}
+			if distinct >= showShortLinkMaxReferrers {
+				referrer = ShowShortLinkOtherReferrer
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	return s.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "show_id"}, {Name: "referrer"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"clicks":          gorm.Expr("show_short_link_referrers.clicks + 1"),
+			"last_clicked_at": now,
+		}),
+	}).Create(&catalogm.ShowShortLinkReferrer{
+		ShowID:        showID,
+		Referrer:      referrer,
+		Clicks:        1,
+		LastClickedAt: now,
+	}).Error
+}
+
+// normalizeShortLinkReferrer reduces a referrer to the name stats are kept
+// under: a full URL becomes its host (without "www."), empty is "direct",
+// and anything longer than the column is cut on a rune boundary.
+func normalizeShortLinkReferrer(referrer string) string {
+	referrer = strings.ToLower(strings.TrimSpace(referrer))
+	if strings.Contains(referrer, "://") {
+		u, err := url.Parse(referrer)
+		if err != nil || u.Hostname() == "" {
+			return ShowShortLinkOtherReferrer
+		}
+		referrer = strings.TrimPrefix(u.Hostname(), "www.")
+	}
+	if referrer == "" {
+		return ShowShortLinkDirectReferrer
+	}
+	return truncateRunes(referrer, showShortLinkReferrerMaxLength)
+}
+
+// GetShowShortLinkStats returns a show's short code and its click-throughs
+// by referrer, most clicks first.
+func (s *ShowService) GetShowShortLinkStats(showID uint) (*contracts.ShowShortLinkStatsResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	if err := s.db.Select("id", "short_code").First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(showID)
+		}
+		return nil, fmt.Errorf("failed to get show: %w", err)
+	}
+
+	var rows []catalogm.ShowShortLinkReferrer
+	if err := s.db.Where("show_id = ?", showID).
+		Order("clicks DESC, referrer ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get short link clicks: %w", err)
+	}
+
+	resp := &contracts.ShowShortLinkStatsResponse{
+		ShowID:    show.ID,
+		ShortCode: show.ShortCode,
+		Referrers: make([]contracts.ShowShortLinkReferrerStats, len(rows)),
+	}
+	for i, row := range rows {
+		resp.TotalClicks += row.Clicks
+		resp.Referrers[i] = contracts.ShowShortLinkReferrerStats{
+			Referrer:      row.Referrer,
+			Clicks:        row.Clicks,
+			LastClickedAt: row.LastClickedAt,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,206 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestShowShortCode(t *testing.T) {
+	seen := make(map[string]uint)
+	for id := uint(1); id <= 20000; id++ {
+		code := ShowShortCode(id)
+		if len(code) != showShortCodeLength {
+			t.Fatalf("ShowShortCode(%d) = %q, want %d characters", id, code, showShortCodeLength)
+		}
+		if strings.Trim(code, showShortCodeAlphabet) != "" {
+			t.Fatalf("ShowShortCode(%d) = %q has characters outside the alphabet", id, code)
+		}
+		if other, dup := seen[code]; dup {
+			t.Fatalf("ShowShortCode(%d) = ShowShortCode(%d) = %q", id, other, code)
+		}
+		seen[code] = id
+	}
+	// Pinned: the short links migration backfilled existing shows with
+	// this formula, so it must never change.
+	for id, want := range map[uint]string{1: "0q4pj31", 2: "1g9f262", 12345: "woup99x"} {
+		if got := ShowShortCode(id); got != want {
+			t.Errorf("ShowShortCode(%d) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestNormalizeShortLinkReferrer(t *testing.T) {
+	long := strings.Repeat("é", showShortLinkReferrerMaxLength+10)
+	for in, want := range map[string]string{
+		"":                                    ShowShortLinkDirectReferrer,
+		"  ":                                  ShowShortLinkDirectReferrer,
+		"Flyer-QR":                            "flyer-qr",
+		"https://www.Instagram.com/p/abc?x=1": "instagram.com",
+		"https://":                            ShowShortLinkOtherReferrer,
+		long:                                  strings.Repeat("é", showShortLinkReferrerMaxLength),
+	} {
+		if got := normalizeShortLinkReferrer(in); got != want {
+			t.Errorf("normalizeShortLinkReferrer(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type ShowShortLinkIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SharedTestPostgres(suite.T())
+}
+
+// SetupTest runs each test in its own rolled-back transaction.
+func (suite *ShowShortLinkIntegrationTestSuite) SetupTest() {
+	suite.db = testutil.BeginTestTx(suite.T(), suite.testDB.DB)
+}
+
+func TestShowShortLinkIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShowShortLinkIntegrationTestSuite))
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) createShow(status catalogm.ShowStatus) *catalogm.Show {
+	f := testutil.CreateShow(suite.T(), suite.db, func(f *testutil.ShowFixture) {
+		f.Show.Status = status
+	})
+	return &f.Show
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestApproveAssignsCode() {
+	show := suite.createShow(catalogm.ShowStatusPending)
+	suite.Nil(show.ShortCode, "pending shows have no short link")
+
+	resp, err := NewShowService(suite.db).ApproveShow(show.ID, false)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.ShortCode)
+	suite.Equal(ShowShortCode(show.ID), *resp.ShortCode)
+	var fresh catalogm.Show
+	suite.Require().NoError(suite.db.First(&fresh, show.ID).Error)
+	suite.Equal(resp.ShortCode, fresh.ShortCode)
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestPublishAssignsCode() {
+	show := suite.createShow(catalogm.ShowStatusPrivate)
+
+	resp, err := NewShowService(suite.db).PublishShow(show.ID, 0, true)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.ShortCode)
+	suite.Equal(ShowShortCode(show.ID), *resp.ShortCode)
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestAssignKeepsExistingCode() {
+	show := suite.createShow(catalogm.ShowStatusApproved)
+	custom := "custom1"
+	suite.Require().NoError(suite.db.Model(show).UpdateColumn("short_code", custom).Error)
+	show.ShortCode = nil // stale copy: the conditional update must still leave it alone
+
+	suite.Require().NoError(AssignShowShortCode(suite.db, show))
+
+	var fresh catalogm.Show
+	suite.Require().NoError(suite.db.First(&fresh, show.ID).Error)
+	suite.Equal(custom, *fresh.ShortCode)
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestResolveShowShortCode() {
+	svc := NewShowService(suite.db)
+	show := suite.createShow(catalogm.ShowStatusApproved)
+	suite.Require().NoError(AssignShowShortCode(suite.db, show))
+
+	target, err := svc.ResolveShowShortCode(strings.ToUpper(*show.ShortCode))
+	suite.Require().NoError(err)
+	suite.Equal(show.ID, target.ShowID)
+	suite.Equal(*show.Slug, target.Slug)
+
+	_, err = svc.ResolveShowShortCode("zzzzzzz")
+	suite.assertShowNotFound(err)
+
+	suite.Require().NoError(suite.db.Model(show).Update("deleted_at", time.Now()).Error)
+	_, err = svc.ResolveShowShortCode(*show.ShortCode)
+	suite.assertShowNotFound(err)
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestResolveSkipsUnpublishedShows() {
+	show := suite.createShow(catalogm.ShowStatusApproved)
+	suite.Require().NoError(AssignShowShortCode(suite.db, show))
+	suite.Require().NoError(suite.db.Model(show).Update("status", catalogm.ShowStatusPrivate).Error)
+
+	_, err := NewShowService(suite.db).ResolveShowShortCode(*show.ShortCode)
+
+	suite.assertShowNotFound(err)
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestClickStats() {
+	svc := NewShowService(suite.db)
+	show := suite.createShow(catalogm.ShowStatusApproved)
+	suite.Require().NoError(AssignShowShortCode(suite.db, show))
+
+	for _, referrer := range []string{"instagram.com", "", "instagram.com", "instagram.com", "flyer-qr"} {
+		suite.Require().NoError(svc.RecordShowShortLinkClick(show.ID, referrer))
+	}
+
+	stats, err := svc.GetShowShortLinkStats(show.ID)
+	suite.Require().NoError(err)
+	suite.Equal(show.ShortCode, stats.ShortCode)
+	suite.Equal(int64(5), stats.TotalClicks)
+	suite.Require().Len(stats.Referrers, 3)
+	suite.Equal("instagram.com", stats.Referrers[0].Referrer)
+	suite.Equal(int64(3), stats.Referrers[0].Clicks)
+	suite.Equal(ShowShortLinkDirectReferrer, stats.Referrers[1].Referrer)
+	suite.Equal("flyer-qr", stats.Referrers[2].Referrer)
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestClickStatsCapsReferrers() {
+	svc := NewShowService(suite.db)
+	show := suite.createShow(catalogm.ShowStatusApproved)
+
+	for i := 0; i < showShortLinkMaxReferrers; i++ {
+		suite.Require().NoError(svc.RecordShowShortLinkClick(show.ID, fmt.Sprintf("ref-%d", i)))
+	}
+	// Past the cap, new referrers pool into "other"; known ones still count.
+	suite.Require().NoError(svc.RecordShowShortLinkClick(show.ID, "ref-new-1"))
+	suite.Require().NoError(svc.RecordShowShortLinkClick(show.ID, "ref-new-2"))
+	suite.Require().NoError(svc.RecordShowShortLinkClick(show.ID, "ref-0"))
+
+	stats, err := svc.GetShowShortLinkStats(show.ID)
+	suite.Require().NoError(err)
+	suite.Equal(int64(showShortLinkMaxReferrers+3), stats.TotalClicks)
+	suite.Len(stats.Referrers, showShortLinkMaxReferrers+1)
+	clicks := make(map[string]int64, len(stats.Referrers))
+	for _, r := range stats.Referrers {
+		clicks[r.Referrer] = r.Clicks
+	}
+	suite.Equal(int64(2), clicks[ShowShortLinkOtherReferrer])
+	suite.Equal(int64(2), clicks["ref-0"])
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) TestStatsNotFound() {
+	_, err := NewShowService(suite.db).GetShowShortLinkStats(999999)
+	suite.assertShowNotFound(err)
+}
+
+func (suite *ShowShortLinkIntegrationTestSuite) assertShowNotFound(err error) {
+	suite.Require().Error(err)
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+}
@@ -1,6 +1,7 @@
 package catalog
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -11,8 +12,10 @@ import (
 	"gorm.io/gorm"
 
 	apperrors "psychic-homily-backend/internal/errors"
+	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
 	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/services/engagement"
 	"psychic-homily-backend/internal/testutil"
@@ -30,28 +33,13 @@ type ShowServiceIntegrationTestSuite struct {
 }
 
 func (suite *ShowServiceIntegrationTestSuite) SetupSuite() {
-	suite.testDB = testutil.SetupTestPostgres(suite.T())
-	suite.db = suite.testDB.DB
-
-	suite.showService = NewShowService(suite.testDB.DB)
-}
-
-func (suite *ShowServiceIntegrationTestSuite) TearDownSuite() {
-	suite.testDB.Cleanup()
+	suite.testDB = testutil.SharedTestPostgres(suite.T())
 }
 
-// TearDownTest cleans up data between tests for isolation
-func (suite *ShowServiceIntegrationTestSuite) TearDownTest() {
-	sqlDB, err := suite.db.DB()
-	suite.Require().NoError(err)
-	// Delete in FK-safe order
-	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
-	_, _ = sqlDB.Exec("DELETE FROM show_artists")
-	_, _ = sqlDB.Exec("DELETE FROM show_venues")
-	_, _ = sqlDB.Exec("DELETE FROM shows")
-	_, _ = sqlDB.Exec("DELETE FROM artists")
-	_, _ = sqlDB.Exec("DELETE FROM venues")
-	_, _ = sqlDB.Exec("DELETE FROM users")
+// SetupTest runs each test in its own rolled-back transaction.
+func (suite *ShowServiceIntegrationTestSuite) SetupTest() {
+	suite.db = testutil.BeginTestTx(suite.T(), suite.testDB.DB)
+	suite.showService = NewShowService(suite.db)
 }
 
 func TestShowServiceIntegrationTestSuite(t *testing.T) {
@@ -63,28 +51,13 @@ func TestShowServiceIntegrationTestSuite(t *testing.T) {
 // =============================================================================
 
 func (suite *ShowServiceIntegrationTestSuite) createTestUser() *authm.User {
-	user := &authm.User{
-		Email:         stringPtr(fmt.Sprintf("user-%d@test.com", time.Now().UnixNano())),
-		FirstName:     stringPtr("Test"),
-		LastName:      stringPtr("User"),
-		IsActive:      true,
-		EmailVerified: true,
-	}
-	err := suite.db.Create(user).Error
-	suite.Require().NoError(err)
-	return user
+	return testutil.CreateUser(suite.T(), suite.db)
 }
 
 func (suite *ShowServiceIntegrationTestSuite) createTestVenue(name, city, state string, verified bool) *catalogm.Venue {
-	venue := &catalogm.Venue{
-		Name:     name,
-		City:     city,
-		State:    state,
-		Verified: verified,
-	}
-	err := suite.db.Create(venue).Error
-	suite.Require().NoError(err)
-	return venue
+	return testutil.CreateVenue(suite.T(), suite.db, func(v *catalogm.Venue) {
+		v.Name, v.City, v.State, v.Verified = name, city, state, verified
+	})
 }
 
 func (suite *ShowServiceIntegrationTestSuite) createTestShow(opts ...func(*contracts.CreateShowRequest)) *contracts.ShowResponse {
@@ -158,6 +131,173 @@ func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_Success() {
 	suite.False(*resp.Artists[1].IsHeadliner)
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_DoorsAndSetTimes() {
+	doors := time.Date(2026, 7, 10, 19, 0, 0, 0, time.UTC)
+	headlinerSet := time.Date(2026, 7, 10, 22, 0, 0, 0, time.UTC)
+	openerSet := time.Date(2026, 7, 10, 20, 0, 0, 0, time.UTC)
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.EventDate = time.Date(2026, 7, 10, 20, 0, 0, 0, time.UTC)
+		req.DoorsTime = &doors
+		req.Artists = []contracts.CreateShowArtist{
+			{Name: "Headliner", IsHeadliner: boolPtr(true), SetTime: &headlinerSet},
+			{Name: "No Set Time", IsHeadliner: boolPtr(false)},
+			{Name: "Opener", IsHeadliner: boolPtr(false), SetTime: &openerSet},
+		}
+	})
+
+	suite.Require().NotNil(created.DoorsTime)
+	suite.True(created.DoorsTime.Equal(doors))
+	wantOrder := []string{"Opener", "Headliner", "No Set Time"}
+	for i, name := range wantOrder {
+		suite.Equal(name, created.Artists[i].Name)
+	}
+
+	fetched, err := suite.showService.GetShow(created.ID)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(fetched.DoorsTime)
+	suite.True(fetched.DoorsTime.Equal(doors))
+	suite.Require().Len(fetched.Artists, 3)
+	for i, name := range wantOrder {
+		suite.Equal(name, fetched.Artists[i].Name)
+	}
+	suite.Require().NotNil(fetched.Artists[0].SetTime)
+	suite.True(fetched.Artists[0].SetTime.Equal(openerSet))
+	suite.Equal(2, fetched.Artists[0].Position)
+	suite.Nil(fetched.Artists[2].SetTime)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_StampsVenueLocalTime() {
+	// 03:00Z is 20:00 the previous evening in Phoenix.
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.EventDate = time.Date(2026, 7, 11, 3, 0, 0, 0, time.UTC)
+	})
+
+	suite.Equal("America/Phoenix", created.Timezone)
+	suite.Equal("2026-07-10T20:00:00-07:00", created.EventDateLocal)
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, created.ID).Error)
+	suite.Require().NotNil(show.EventTimezone)
+	suite.Equal("America/Phoenix", *show.EventTimezone)
+	suite.Require().NotNil(show.EventLocalTime)
+	suite.Equal("2026-07-10 20:00", show.EventLocalTime.Format("2006-01-02 15:04"))
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_DefaultsLocationFromVenue() {
+	user := suite.createTestUser()
+	req := &contracts.CreateShowRequest{
+		EventDate: time.Date(2026, 7, 11, 21, 0, 0, 0, time.UTC),
+		Venues: []contracts.CreateShowVenue{
+			{Name: "Crescent Ballroom", City: "Phoenix", State: "AZ"},
+		},
+		Artists: []contracts.CreateShowArtist{
+			{Name: "Located Band", IsHeadliner: boolPtr(true)},
+		},
+		SubmittedByUserID: &user.ID,
+		SubmitterIsAdmin:  true,
+	}
+
+	resp, err := suite.showService.CreateShow(req)
+
+	suite.Require().NoError(err)
+	suite.Equal("Phoenix", *resp.City)
+	suite.Equal("AZ", *resp.State)
+	suite.Empty(resp.Warnings)
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, resp.ID).Error)
+	suite.Equal("Phoenix", *show.City)
+	suite.Equal("AZ", *show.State)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_WarnsOnLocationMismatch() {
+	user := suite.createTestUser()
+	req := &contracts.CreateShowRequest{
+		EventDate: time.Date(2026, 7, 12, 21, 0, 0, 0, time.UTC),
+		City:      "Tucson",
+		State:     "AZ",
+		Venues: []contracts.CreateShowVenue{
+			{Name: "Valley Bar", City: "Phoenix", State: "AZ"},
+		},
+		Artists: []contracts.CreateShowArtist{
+			{Name: "Misplaced Band", IsHeadliner: boolPtr(true)},
+		},
+		SubmittedByUserID: &user.ID,
+		SubmitterIsAdmin:  true,
+	}
+
+	resp, err := suite.showService.CreateShow(req)
+
+	suite.Require().NoError(err)
+	suite.Equal("Tucson", *resp.City, "a provided location is kept")
+	suite.Require().Len(resp.Warnings, 1)
+	suite.Contains(resp.Warnings[0], "Valley Bar")
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetShowBySlug_ResolvesHistoricalSlug() {
+	user := suite.createTestUser()
+	show, err := suite.showService.CreateShow(&contracts.CreateShowRequest{
+		EventDate:         time.Date(2026, 7, 13, 21, 0, 0, 0, time.UTC),
+		Venues:            []contracts.CreateShowVenue{{Name: "Valley Bar", City: "Phoenix", State: "AZ"}},
+		Artists:           []contracts.CreateShowArtist{{Name: "Renamed Band", IsHeadliner: boolPtr(true)}},
+		SubmittedByUserID: &user.ID,
+		SubmitterIsAdmin:  true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.db.Create(&catalogm.SlugRedirect{
+		EntityType: catalogm.SlugRedirectEntityShow,
+		OldSlug:    "old-show-slug",
+		EntityID:   show.ID,
+	}).Error)
+
+	resp, err := suite.showService.GetShowBySlug("old-show-slug")
+	suite.Require().NoError(err)
+	suite.Equal(show.ID, resp.ID)
+	suite.Equal(show.Slug, resp.CanonicalSlug)
+
+	resp, err = suite.showService.GetShowBySlug(show.Slug)
+	suite.Require().NoError(err)
+	suite.Empty(resp.CanonicalSlug)
+
+	_, err = suite.showService.GetShowBySlug("never-existed")
+	suite.Require().Error(err)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetShow_LastEditedAtFromRevisions() {
+	user := suite.createTestUser()
+	show, err := suite.showService.CreateShow(&contracts.CreateShowRequest{
+		EventDate:         time.Date(2026, 7, 13, 21, 0, 0, 0, time.UTC),
+		Venues:            []contracts.CreateShowVenue{{Name: "Valley Bar", City: "Phoenix", State: "AZ"}},
+		Artists:           []contracts.CreateShowArtist{{Name: "Edited Band", IsHeadliner: boolPtr(true)}},
+		SubmittedByUserID: &user.ID,
+		SubmitterIsAdmin:  true,
+	})
+	suite.Require().NoError(err)
+
+	resp, err := suite.showService.GetShow(show.ID)
+	suite.Require().NoError(err)
+	suite.Nil(resp.LastEditedAt)
+
+	editedAt := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	changes := json.RawMessage(`[{"field":"title","old_value":"A","new_value":"B"}]`)
+	suite.Require().NoError(suite.db.Create(&adminm.Revision{
+		EntityType:   "show",
+		EntityID:     show.ID,
+		UserID:       user.ID,
+		FieldChanges: &changes,
+		CreatedAt:    editedAt,
+	}).Error)
+
+	resp, err = suite.showService.GetShow(show.ID)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.LastEditedAt)
+	suite.True(editedAt.Equal(*resp.LastEditedAt))
+
+	resp, err = suite.showService.GetShowBySlug(show.Slug)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.LastEditedAt)
+}
+
 // PSY-1037: image_url round-trips from the create request onto the persisted
 // show (the entity_request fulfiller carries the payload's flyer through it).
 func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_CarriesImageURL() {
@@ -499,13 +639,90 @@ func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_Success() {
 	suite.Error(err)
 }
 
-func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_AssociationsCleanedUp() {
+func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_SoftDeleteKeepsRow() {
+	created := suite.createTestShow()
+	showID := created.ID
+
+	suite.Require().NoError(suite.showService.DeleteShow(showID))
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, showID).Error)
+	suite.Require().NotNil(show.DeletedAt)
+
+	// Junction rows are kept so a restore brings the bill back intact.
+	var svCount int64
+	suite.db.Model(&catalogm.ShowVenue{}).Where("show_id = ?", showID).Count(&svCount)
+	suite.Equal(int64(1), svCount)
+
+	// Hidden from public lookups and listings.
+	_, err := suite.showService.GetShow(showID)
+	suite.Error(err)
+	_, err = suite.showService.GetShowBySlug(created.Slug)
+	suite.Error(err)
+	shows, err := suite.showService.GetShows(map[string]interface{}{})
+	suite.Require().NoError(err)
+	for _, sh := range shows {
+		suite.NotEqual(showID, sh.ID)
+	}
+
+	// Deleting twice reports not found.
+	err = suite.showService.DeleteShow(showID)
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestRestoreShow() {
+	created := suite.createTestShow()
+	suite.Require().NoError(suite.showService.DeleteShow(created.ID))
+
+	resp, err := suite.showService.RestoreShow(created.ID)
+	suite.Require().NoError(err)
+	suite.Equal(created.ID, resp.ID)
+	suite.Len(resp.Venues, 1)
+
+	_, err = suite.showService.GetShow(created.ID)
+	suite.NoError(err)
+
+	// Restoring a show that isn't deleted is a validation failure.
+	_, err = suite.showService.RestoreShow(created.ID)
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowValidationFailed, showErr.Code)
+
+	_, err = suite.showService.RestoreShow(999999)
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetExpiredDeletedShows() {
+	expired := suite.createTestShow()
+	recent := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.EventDate = r.EventDate.AddDate(0, 0, 1)
+		r.Artists = []contracts.CreateShowArtist{{Name: "Other Artist", IsHeadliner: boolPtr(true)}}
+	})
+	suite.Require().NoError(suite.showService.DeleteShow(expired.ID))
+	suite.Require().NoError(suite.showService.DeleteShow(recent.ID))
+	suite.db.Model(&catalogm.Show{}).Where("id = ?", expired.ID).
+		Update("deleted_at", time.Now().Add(-ShowRetentionPeriod-time.Hour))
+
+	ids, err := suite.showService.GetExpiredDeletedShows()
+	suite.Require().NoError(err)
+	suite.Equal([]uint{expired.ID}, ids)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestPermanentlyDeleteShow_AssociationsCleanedUp() {
 	created := suite.createTestShow()
 	showID := created.ID
 
-	err := suite.showService.DeleteShow(showID)
+	suite.Require().NoError(suite.showService.DeleteShow(showID))
+	err := suite.showService.PermanentlyDeleteShow(showID)
 	suite.Require().NoError(err)
 
+	var showCount int64
+	suite.db.Model(&catalogm.Show{}).Where("id = ?", showID).Count(&showCount)
+	suite.Zero(showCount)
+
 	// Verify junction table rows are gone
 	var svCount int64
 	suite.db.Model(&catalogm.ShowVenue{}).Where("show_id = ?", showID).Count(&svCount)
@@ -516,7 +733,7 @@ func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_AssociationsCleaned
 	suite.Zero(saCount)
 }
 
-func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_RemovesSavedShowFromBookmarkBackedTotals() {
+func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_HidesSavedShowUntilRestored() {
 	user := suite.createTestUser()
 	created := suite.createTestShow()
 	savedShows := engagement.NewSavedShowService(suite.db)
@@ -540,6 +757,20 @@ func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_RemovesSavedShowFro
 	stats, err = charts.GetPersonalChartsStats(user.ID)
 	suite.Require().NoError(err)
 	suite.Zero(stats.SavedShows)
+
+	// The bookmark row survives the soft delete so a restore brings it back.
+	var bookmarks int64
+	suite.db.Model(&engagementm.UserBookmark{}).
+		Where("entity_type = ? AND entity_id = ?", engagementm.BookmarkEntityShow, created.ID).
+		Count(&bookmarks)
+	suite.Equal(int64(1), bookmarks)
+
+	_, err = suite.showService.RestoreShow(created.ID)
+	suite.Require().NoError(err)
+	shows, total, err = savedShows.GetUserSavedShows(user.ID, 10, 0, "")
+	suite.Require().NoError(err)
+	suite.Len(shows, 1)
+	suite.Equal(int64(1), total)
 }
 
 // =============================================================================
@@ -768,6 +999,46 @@ func (suite *ShowServiceIntegrationTestSuite) TestRejectShow_NotPending_Fails()
 	suite.Contains(err.Error(), "not pending")
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_HoldForReviewIsPending() {
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.HoldForReview = true
+	})
+	suite.Equal("pending", created.Status)
+
+	private := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.Title = "Private Held Show"
+		req.HoldForReview = true
+		req.IsPrivate = true
+	})
+	suite.Equal("private", private.Status)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestReviewUpdatesSubmitterReputation() {
+	submitterCounts := func(showID uint) (int, int) {
+		var show catalogm.Show
+		suite.Require().NoError(suite.db.First(&show, showID).Error)
+		var user authm.User
+		suite.Require().NoError(suite.db.First(&user, *show.SubmittedBy).Error)
+		return user.SubmissionsApproved, user.SubmissionsRejected
+	}
+
+	created := suite.createTestShow()
+	suite.db.Model(&catalogm.Show{}).Where("id = ?", created.ID).Update("status", catalogm.ShowStatusPending)
+
+	_, err := suite.showService.RejectShow(created.ID, "Bad info")
+	suite.Require().NoError(err)
+	approved, rejected := submitterCounts(created.ID)
+	suite.Equal(0, approved)
+	suite.Equal(1, rejected)
+
+	// Overturning the rejection moves it to the approved count
+	_, err = suite.showService.ApproveShow(created.ID, false)
+	suite.Require().NoError(err)
+	approved, rejected = submitterCounts(created.ID)
+	suite.Equal(1, approved)
+	suite.Equal(0, rejected)
+}
+
 func (suite *ShowServiceIntegrationTestSuite) TestUnpublishShow_AsSubmitter() {
 	user := suite.createTestUser()
 	req := &contracts.CreateShowRequest{
@@ -1352,6 +1623,58 @@ func (suite *ShowServiceIntegrationTestSuite) TestGetShows_FilterByCity() {
 	suite.Equal("Phoenix Show", resp[0].Title)
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestGetShows_FilterByCities() {
+	user := suite.createTestUser()
+
+	for _, city := range []string{"Phoenix", "Tempe", "Tucson"} {
+		_, err := suite.showService.CreateShow(&contracts.CreateShowRequest{
+			Title:             city + " Show",
+			EventDate:         time.Date(2026, 12, 1, 20, 0, 0, 0, time.UTC),
+			City:              city,
+			State:             "AZ",
+			Venues:            []contracts.CreateShowVenue{{Name: city + " Venue", City: city, State: "AZ"}},
+			Artists:           []contracts.CreateShowArtist{{Name: city + " Artist", IsHeadliner: boolPtr(true)}},
+			SubmittedByUserID: &user.ID,
+			SubmitterIsAdmin:  true,
+		})
+		suite.Require().NoError(err)
+	}
+
+	resp, err := suite.showService.GetShows(map[string]interface{}{
+		"cities": []contracts.CityStateFilter{{City: "Phoenix", State: "AZ"}, {City: "Tempe", State: "AZ"}},
+	})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(resp, 2)
+	for _, show := range resp {
+		suite.NotEqual("Tucson Show", show.Title)
+	}
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetShows_FilterByMetro() {
+	eventDate := time.Now().UTC().AddDate(0, 1, 0)
+	tempe := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Metro Tempe"
+		r.EventDate = eventDate
+		r.City = "Tempe"
+		r.Venues = []contracts.CreateShowVenue{{Name: "Metro Venue Tempe", City: "Tempe", State: "AZ"}}
+	})
+	tucson := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Metro Tucson"
+		r.EventDate = eventDate
+		r.City = "Tucson"
+		r.Venues = []contracts.CreateShowVenue{{Name: "Metro Venue Tucson", City: "Tucson", State: "AZ"}}
+	})
+	suite.Require().NoError(suite.db.Model(&catalogm.Venue{}).Where("id = ?", tempe.Venues[0].ID).Update("metro", "38060").Error)
+	suite.Require().NoError(suite.db.Model(&catalogm.Venue{}).Where("id = ?", tucson.Venues[0].ID).Update("metro", "46060").Error)
+
+	resp, err := suite.showService.GetShows(map[string]interface{}{"metro": "38060"})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(resp, 1)
+	suite.Equal(tempe.ID, resp[0].ID)
+}
+
 func (suite *ShowServiceIntegrationTestSuite) TestGetShows_FilterByDateRange() {
 	user := suite.createTestUser()
 
@@ -1625,6 +1948,11 @@ func (suite *ShowServiceIntegrationTestSuite) TestGetShowCities_Success() {
 	suite.Require().NotNil(tuc.Latitude, "Tucson latitude should be populated from the geocoder")
 	suite.Require().NotNil(tuc.Longitude, "Tucson longitude should be populated from the geocoder")
 	suite.InDelta(32.2217, *tuc.Latitude, 0.5, "Tucson latitude near the known centroid")
+
+	// Each city also names its CBSA metro, so the picker can group cities.
+	suite.Equal("38060", phx.Metro)
+	suite.Equal("Phoenix-Mesa-Chandler, AZ", phx.MetroName)
+	suite.Equal("46060", tuc.Metro)
 }
 
 // =============================================================================
@@ -1659,75 +1987,373 @@ func (suite *ShowServiceIntegrationTestSuite) TestSetShowCancelled() {
 	suite.False(resp.IsCancelled)
 }
 
-// =============================================================================
-// Group 7: ParseShowMarkdown (Pure Logic -- no DB needed)
-// =============================================================================
+func (suite *ShowServiceIntegrationTestSuite) TestSetShowCancelled_ClearsReason() {
+	created := suite.createTestShow()
+	_, err := suite.showService.CancelShow(created.ID, "Venue flooded")
+	suite.Require().NoError(err)
 
-func TestParseShowMarkdown_Valid(t *testing.T) {
-	svc := &ShowService{} // nil db is fine -- ParseShowMarkdown doesn't use it
-	content := []byte(`---
-version: "1.0"
-exported_at: "2026-01-15T12:00:00Z"
-show:
-  title: "Rock Night"
-  event_date: "2026-07-15T20:00:00Z"
-  city: "Phoenix"
-  state: "AZ"
-  status: "approved"
-venues:
-  - name: "Valley Bar"
-    city: "Phoenix"
-    state: "AZ"
-artists:
-  - name: "The Band"
-    position: 0
-    set_type: "headliner"
-  - name: "Opener"
-    position: 1
-    set_type: "opener"
----
+	resp, err := suite.showService.SetShowCancelled(created.ID, false)
+	suite.Require().NoError(err)
+	suite.False(resp.IsCancelled)
+	suite.Nil(resp.StatusReason)
+}
 
-## Description
+func (suite *ShowServiceIntegrationTestSuite) TestSetShowCancelled_FollowsCancelShow() {
+	// Setting the flag on a postponed show clears the postponement, as
+	// CancelShow does.
+	created := suite.createTestShow()
+	_, err := suite.showService.PostponeShow(created.ID, "Illness", nil)
+	suite.Require().NoError(err)
 
-A great rock show with two bands.
-`)
+	resp, err := suite.showService.SetShowCancelled(created.ID, true)
+	suite.Require().NoError(err)
+	suite.True(resp.IsCancelled)
+	suite.False(resp.IsPostponed)
 
-	parsed, err := svc.ParseShowMarkdown(content)
+	// Setting it again keeps the reason an earlier cancel gave.
+	_, err = suite.showService.CancelShow(created.ID, "Venue flooded")
+	suite.Require().NoError(err)
+	resp, err = suite.showService.SetShowCancelled(created.ID, true)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.StatusReason)
+	suite.Equal("Venue flooded", *resp.StatusReason)
 
-	assert.NoError(t, err)
-	assert.NotNil(t, parsed)
-	assert.Equal(t, "Rock Night", parsed.Frontmatter.Show.Title)
-	assert.Equal(t, "2026-07-15T20:00:00Z", parsed.Frontmatter.Show.EventDate)
-	assert.Equal(t, "Phoenix", parsed.Frontmatter.Show.City)
-	assert.Equal(t, "AZ", parsed.Frontmatter.Show.State)
-	assert.Equal(t, "approved", parsed.Frontmatter.Show.Status)
-	assert.Len(t, parsed.Frontmatter.Venues, 1)
-	assert.Equal(t, "Valley Bar", parsed.Frontmatter.Venues[0].Name)
-	assert.Len(t, parsed.Frontmatter.Artists, 2)
-	assert.Equal(t, "The Band", parsed.Frontmatter.Artists[0].Name)
-	assert.Equal(t, "headliner", parsed.Frontmatter.Artists[0].SetType)
-	assert.Equal(t, "Opener", parsed.Frontmatter.Artists[1].Name)
-	assert.Equal(t, "opener", parsed.Frontmatter.Artists[1].SetType)
-	assert.Equal(t, "A great rock show with two bands.", parsed.Description)
+	// A rescheduled show is refused; its new show is the one to cancel.
+	moved := suite.createTestShow()
+	newDate := time.Now().UTC().AddDate(0, 2, 0).Truncate(time.Hour)
+	_, err = suite.showService.PostponeShow(moved.ID, "", &newDate)
+	suite.Require().NoError(err)
+	_, err = suite.showService.SetShowCancelled(moved.ID, true)
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowValidationFailed, showErr.Code)
 }
 
-func TestParseShowMarkdown_MinimalFrontmatter(t *testing.T) {
-	svc := &ShowService{}
-	content := []byte(`---
-show:
-  title: "Minimal"
-  event_date: "2026-01-01T00:00:00Z"
-  status: "pending"
----
-`)
+func (suite *ShowServiceIntegrationTestSuite) TestCancelShow() {
+	created := suite.createTestShow()
 
-	parsed, err := svc.ParseShowMarkdown(content)
+	resp, err := suite.showService.CancelShow(created.ID, "  Venue flooded ")
+	suite.Require().NoError(err)
+	suite.True(resp.IsCancelled)
+	suite.Require().NotNil(resp.StatusReason)
+	suite.Equal("Venue flooded", *resp.StatusReason)
 
-	assert.NoError(t, err)
-	assert.NotNil(t, parsed)
-	assert.Equal(t, "Minimal", parsed.Frontmatter.Show.Title)
-	assert.Empty(t, parsed.Frontmatter.Venues)
-	assert.Empty(t, parsed.Frontmatter.Artists)
+	// Cancelling again without a reason clears it.
+	resp, err = suite.showService.CancelShow(created.ID, "")
+	suite.Require().NoError(err)
+	suite.True(resp.IsCancelled)
+	suite.Nil(resp.StatusReason)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestCancelShow_NotFound() {
+	_, err := suite.showService.CancelShow(99999, "")
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestCancelShow_PostponedWithoutDate() {
+	created := suite.createTestShow()
+	_, err := suite.showService.PostponeShow(created.ID, "Illness", nil)
+	suite.Require().NoError(err)
+
+	resp, err := suite.showService.CancelShow(created.ID, "Tour cancelled")
+	suite.Require().NoError(err)
+	suite.True(resp.IsCancelled)
+	suite.False(resp.IsPostponed)
+	suite.Equal("Tour cancelled", *resp.StatusReason)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestPostponeShow_WithoutDate() {
+	created := suite.createTestShow()
+
+	resp, err := suite.showService.PostponeShow(created.ID, "Illness", nil)
+	suite.Require().NoError(err)
+	suite.True(resp.IsPostponed)
+	suite.False(resp.IsCancelled)
+	suite.Equal("Illness", *resp.StatusReason)
+	suite.Nil(resp.RescheduledShowID)
+	suite.Equal(created.EventDate.UTC(), resp.EventDate.UTC(), "the original date stays on the show")
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestPostponeShow_WithNewDate() {
+	newDate := time.Now().UTC().AddDate(0, 2, 0).Truncate(time.Hour)
+	doors := time.Date(2026, 6, 15, 19, 0, 0, 0, time.UTC)
+	setTime := time.Date(2026, 6, 15, 21, 30, 0, 0, time.UTC)
+	price := 15.0
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.DoorsTime = &doors
+		req.PriceMin = &price
+		req.TicketURL = "https://tickets.example.com/1"
+		req.Artists = []contracts.CreateShowArtist{
+			{Name: "Postponed Opener", SetType: catalogm.SetTypeOpener},
+			{Name: "Postponed Headliner", SetType: catalogm.SetTypeHeadliner, SetTime: &setTime},
+		}
+	})
+
+	resp, err := suite.showService.PostponeShow(created.ID, "Illness", &newDate)
+	suite.Require().NoError(err)
+	suite.True(resp.IsPostponed)
+	suite.Equal("Illness", *resp.StatusReason)
+	suite.Require().NotNil(resp.RescheduledShowID)
+
+	rescheduled, err := suite.showService.GetShow(*resp.RescheduledShowID)
+	suite.Require().NoError(err)
+	suite.False(rescheduled.IsPostponed)
+	suite.Nil(rescheduled.StatusReason)
+	suite.Equal(created.Title, rescheduled.Title)
+	suite.Equal(created.Status, rescheduled.Status)
+	suite.Equal(newDate, rescheduled.EventDate.UTC())
+	suite.Require().NotNil(rescheduled.RescheduledFromShowID)
+	suite.Equal(created.ID, *rescheduled.RescheduledFromShowID)
+	suite.Require().NotNil(rescheduled.OriginalDate)
+	suite.Equal(created.EventDate.UTC(), rescheduled.OriginalDate.UTC())
+	suite.NotEqual(created.Slug, rescheduled.Slug)
+	suite.Require().NotNil(rescheduled.TicketURL)
+	suite.Equal("https://tickets.example.com/1", *rescheduled.TicketURL)
+	suite.Require().NotNil(rescheduled.PriceMin)
+	suite.Equal(15.0, *rescheduled.PriceMin)
+
+	// Doors and set times keep their offset from the start.
+	offset := newDate.Sub(created.EventDate)
+	suite.Require().NotNil(rescheduled.DoorsTime)
+	suite.Equal(doors.Add(offset), rescheduled.DoorsTime.UTC())
+	suite.Require().Len(rescheduled.Artists, 2)
+	for _, a := range rescheduled.Artists {
+		switch a.Name {
+		case "Postponed Opener":
+			suite.Equal(catalogm.SetTypeOpener, a.SetType)
+			suite.Nil(a.SetTime)
+		case "Postponed Headliner":
+			suite.Equal(catalogm.SetTypeHeadliner, a.SetType)
+			suite.Require().NotNil(a.SetTime)
+			suite.Equal(setTime.Add(offset), a.SetTime.UTC())
+		default:
+			suite.Failf("unexpected artist", "%q", a.Name)
+		}
+	}
+	suite.Require().Len(rescheduled.Venues, 1)
+	suite.Equal(created.Venues[0].ID, rescheduled.Venues[0].ID)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestPostponeShow_DateAnnouncedLater() {
+	created := suite.createTestShow()
+	_, err := suite.showService.PostponeShow(created.ID, "Illness", nil)
+	suite.Require().NoError(err)
+
+	newDate := time.Now().UTC().AddDate(0, 3, 0).Truncate(time.Hour)
+	resp, err := suite.showService.PostponeShow(created.ID, "", &newDate)
+	suite.Require().NoError(err)
+	suite.NotNil(resp.RescheduledShowID)
+	suite.Equal("Illness", *resp.StatusReason, "the earlier reason is kept")
+
+	// Once rescheduled, the new show is the one to change.
+	_, err = suite.showService.PostponeShow(created.ID, "", &newDate)
+	suite.assertShowValidationFailed(err)
+	_, err = suite.showService.CancelShow(created.ID, "")
+	suite.assertShowValidationFailed(err)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestPostponeShow_Rejected() {
+	created := suite.createTestShow()
+
+	past := time.Now().UTC().AddDate(0, 0, -1)
+	_, err := suite.showService.PostponeShow(created.ID, "", &past)
+	suite.assertShowValidationFailed(err)
+
+	_, err = suite.showService.CancelShow(created.ID, "")
+	suite.Require().NoError(err)
+	_, err = suite.showService.PostponeShow(created.ID, "", nil)
+	suite.assertShowValidationFailed(err)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestPostponeShow_KeepsPrivateStatus() {
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.IsPrivate = true
+	})
+	newDate := time.Now().UTC().AddDate(0, 2, 0).Truncate(time.Hour)
+
+	resp, err := suite.showService.PostponeShow(created.ID, "", &newDate)
+	suite.Require().NoError(err)
+	rescheduled, err := suite.showService.GetShow(*resp.RescheduledShowID)
+	suite.Require().NoError(err)
+	suite.Equal(string(catalogm.ShowStatusPrivate), rescheduled.Status)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestSetShowLineup() {
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.Artists = []contracts.CreateShowArtist{
+			{Name: "Lineup Headliner", IsHeadliner: boolPtr(true)},
+			{Name: "Lineup Opener", IsHeadliner: boolPtr(false)},
+			{Name: "Lineup DJ", IsHeadliner: boolPtr(false)},
+		}
+	})
+	headliner, opener, dj := created.Artists[0].ID, created.Artists[1].ID, created.Artists[2].ID
+	setTime := time.Date(2026, 6, 16, 4, 0, 0, 0, time.UTC)
+
+	// The old opener now headlines; the old headliner is direct support.
+	resp, err := suite.showService.SetShowLineup(created.ID, []contracts.ShowLineupEntry{
+		{ArtistID: opener, SetType: catalogm.SetTypeHeadliner},
+		{ArtistID: headliner, SetType: catalogm.SetTypeSupport},
+		{ArtistID: dj, SetType: catalogm.SetTypeDJ, SetTime: &setTime},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Artists, 3)
+
+	var rows []catalogm.ShowArtist
+	suite.Require().NoError(suite.db.Where("show_id = ?", created.ID).Order("position ASC").Find(&rows).Error)
+	suite.Require().Len(rows, 3)
+	suite.Equal(opener, rows[0].ArtistID)
+	suite.Equal(catalogm.SetTypeHeadliner, rows[0].SetType)
+	suite.Equal(headliner, rows[1].ArtistID)
+	suite.Equal(catalogm.SetTypeSupport, rows[1].SetType)
+	suite.Equal(dj, rows[2].ArtistID)
+	suite.Equal(catalogm.SetTypeDJ, rows[2].SetType)
+	suite.Require().NotNil(rows[2].SetTime)
+	suite.True(rows[2].SetTime.Equal(setTime))
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestSetShowLineup_MustListEveryArtist() {
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.Artists = []contracts.CreateShowArtist{
+			{Name: "Partial Headliner", IsHeadliner: boolPtr(true)},
+			{Name: "Partial Opener", IsHeadliner: boolPtr(false)},
+		}
+	})
+	other := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.Title = "Other Show"
+		req.Artists = []contracts.CreateShowArtist{{Name: "Not On The Bill", IsHeadliner: boolPtr(true)}}
+	})
+
+	// Dropping an artist is not a reorder.
+	_, err := suite.showService.SetShowLineup(created.ID, []contracts.ShowLineupEntry{
+		{ArtistID: created.Artists[0].ID, SetType: catalogm.SetTypeHeadliner},
+	})
+	suite.assertShowValidationFailed(err)
+
+	// Neither is adding one.
+	_, err = suite.showService.SetShowLineup(created.ID, []contracts.ShowLineupEntry{
+		{ArtistID: created.Artists[0].ID, SetType: catalogm.SetTypeHeadliner},
+		{ArtistID: other.Artists[0].ID, SetType: catalogm.SetTypeOpener},
+	})
+	suite.assertShowValidationFailed(err)
+
+	// Nothing was written.
+	var sa catalogm.ShowArtist
+	suite.Require().NoError(suite.db.Where("show_id = ? AND artist_id = ?", created.ID, created.Artists[0].ID).First(&sa).Error)
+	suite.Equal(0, sa.Position)
+	suite.Equal(catalogm.SetTypeHeadliner, sa.SetType)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestSetShowLineup_NotFound() {
+	_, err := suite.showService.SetShowLineup(99999, []contracts.ShowLineupEntry{
+		{ArtistID: 1, SetType: catalogm.SetTypeHeadliner},
+	})
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) assertShowValidationFailed(err error) {
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowValidationFailed, showErr.Code)
+}
+
+func TestValidateShowLineup(t *testing.T) {
+	tests := []struct {
+		name    string
+		lineup  []contracts.ShowLineupEntry
+		wantErr string
+	}{
+		{"empty", nil, "at least one artist"},
+		{"unknown set type", []contracts.ShowLineupEntry{{ArtistID: 1, SetType: "closer"}}, "invalid set type"},
+		{"no headliner", []contracts.ShowLineupEntry{{ArtistID: 1, SetType: "support"}, {ArtistID: 2, SetType: "opener"}}, "exactly one headliner (got 0)"},
+		{"two headliners", []contracts.ShowLineupEntry{{ArtistID: 1, SetType: "headliner"}, {ArtistID: 2, SetType: "headliner"}}, "exactly one headliner (got 2)"},
+		{"duplicate artist", []contracts.ShowLineupEntry{{ArtistID: 1, SetType: "headliner"}, {ArtistID: 1, SetType: "dj"}}, "more than once"},
+		{"valid", []contracts.ShowLineupEntry{{ArtistID: 1, SetType: "headliner"}, {ArtistID: 2, SetType: "support"}, {ArtistID: 3, SetType: "dj"}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateShowLineup(tt.lineup)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+// =============================================================================
+// Group 7: ParseShowMarkdown (Pure Logic -- no DB needed)
+// =============================================================================
+
+func TestParseShowMarkdown_Valid(t *testing.T) {
+	svc := &ShowService{} // nil db is fine -- ParseShowMarkdown doesn't use it
+	content := []byte(`---
+version: "1.0"
+exported_at: "2026-01-15T12:00:00Z"
+show:
+  title: "Rock Night"
+  event_date: "2026-07-15T20:00:00Z"
+  city: "Phoenix"
+  state: "AZ"
+  status: "approved"
+venues:
+  - name: "Valley Bar"
+    city: "Phoenix"
+    state: "AZ"
+artists:
+  - name: "The Band"
+    position: 0
+    set_type: "headliner"
+  - name: "Opener"
+    position: 1
+    set_type: "opener"
+---
+
+## Description
+
+A great rock show with two bands.
+`)
+
+	parsed, err := svc.ParseShowMarkdown(content)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, parsed)
+	assert.Equal(t, "Rock Night", parsed.Frontmatter.Show.Title)
+	assert.Equal(t, "2026-07-15T20:00:00Z", parsed.Frontmatter.Show.EventDate)
+	assert.Equal(t, "Phoenix", parsed.Frontmatter.Show.City)
+	assert.Equal(t, "AZ", parsed.Frontmatter.Show.State)
+	assert.Equal(t, "approved", parsed.Frontmatter.Show.Status)
+	assert.Len(t, parsed.Frontmatter.Venues, 1)
+	assert.Equal(t, "Valley Bar", parsed.Frontmatter.Venues[0].Name)
+	assert.Len(t, parsed.Frontmatter.Artists, 2)
+	assert.Equal(t, "The Band", parsed.Frontmatter.Artists[0].Name)
+	assert.Equal(t, "headliner", parsed.Frontmatter.Artists[0].SetType)
+	assert.Equal(t, "Opener", parsed.Frontmatter.Artists[1].Name)
+	assert.Equal(t, "opener", parsed.Frontmatter.Artists[1].SetType)
+	assert.Equal(t, "A great rock show with two bands.", parsed.Description)
+}
+
+func TestParseShowMarkdown_MinimalFrontmatter(t *testing.T) {
+	svc := &ShowService{}
+	content := []byte(`---
+show:
+  title: "Minimal"
+  event_date: "2026-01-01T00:00:00Z"
+  status: "pending"
+---
+`)
+
+	parsed, err := svc.ParseShowMarkdown(content)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, parsed)
+	assert.Equal(t, "Minimal", parsed.Frontmatter.Show.Title)
+	assert.Empty(t, parsed.Frontmatter.Venues)
+	assert.Empty(t, parsed.Frontmatter.Artists)
 	assert.Empty(t, parsed.Description)
 }
 
@@ -1851,6 +2477,35 @@ Festival description.
 	assert.Equal(t, 2, parsed.Frontmatter.Artists[2].Position)
 }
 
+func TestParseShowMarkdown_ArtistsInBillingOrder(t *testing.T) {
+	svc := &ShowService{}
+	content := []byte(`---
+show:
+  title: "Reordered"
+  event_date: "2026-08-01T18:00:00Z"
+artists:
+  - name: "Opener"
+    position: 2
+    set_type: "opener"
+  - name: "Headliner"
+    position: 0
+    set_type: "headliner"
+  - name: "Support"
+    position: 1
+    set_type: "support"
+---
+`)
+
+	parsed, err := svc.ParseShowMarkdown(content)
+
+	assert.NoError(t, err)
+	names := make([]string, len(parsed.Frontmatter.Artists))
+	for i, a := range parsed.Frontmatter.Artists {
+		names[i] = a.Name
+	}
+	assert.Equal(t, []string{"Headliner", "Support", "Opener"}, names)
+}
+
 func TestParseShowMarkdown_DescriptionStopsAtNextHeading(t *testing.T) {
 	svc := &ShowService{}
 	content := []byte(`---
@@ -1876,6 +2531,108 @@ This should not be included.
 	assert.NotContains(t, parsed.Description, "This should not be included")
 }
 
+func TestParseShowMarkdown_TicketFields(t *testing.T) {
+	svc := &ShowService{}
+	content := []byte(`---
+show:
+  title: "Ticketed"
+  event_date: "2026-07-15T20:00:00Z"
+  ticket_url: "https://dice.fm/event/abc123"
+  ticket_provider: "dice"
+  status: "approved"
+---
+`)
+
+	parsed, err := svc.ParseShowMarkdown(content)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://dice.fm/event/abc123", parsed.Frontmatter.Show.TicketURL)
+	assert.Equal(t, "dice", parsed.Frontmatter.Show.TicketProvider)
+	assert.NoError(t, validateImportTicketFields(parsed.Frontmatter.Show))
+}
+
+func TestValidateImportTicketFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		show    contracts.ExportShowData
+		wantErr string
+	}{
+		{name: "no ticket fields", show: contracts.ExportShowData{}},
+		{name: "box office without link", show: contracts.ExportShowData{TicketProvider: "box_office"}},
+		{name: "javascript url", show: contracts.ExportShowData{TicketURL: "javascript:alert(1)"}, wantErr: "Ticket URL"},
+		{name: "overlong url", show: contracts.ExportShowData{TicketURL: "https://example.com/" + strings.Repeat("a", 500)}, wantErr: "500 characters"},
+		{name: "unknown provider", show: contracts.ExportShowData{TicketProvider: "ticketmaster"}, wantErr: "invalid ticket provider"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateImportTicketFields(tt.show)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateImportTimes(t *testing.T) {
+	tests := []struct {
+		name    string
+		fm      contracts.ExportFrontmatter
+		wantErr string
+	}{
+		{name: "no times", fm: contracts.ExportFrontmatter{}},
+		{
+			name: "valid times",
+			fm: contracts.ExportFrontmatter{
+				Show:    contracts.ExportShowData{DoorsTime: "2026-07-15T19:00:00Z"},
+				Artists: []contracts.ExportArtistData{{Name: "A", SetTime: "2026-07-15T21:00:00-07:00"}},
+			},
+		},
+		{
+			name:    "bad doors time",
+			fm:      contracts.ExportFrontmatter{Show: contracts.ExportShowData{DoorsTime: "7pm"}},
+			wantErr: "invalid doors time",
+		},
+		{
+			name:    "bad set time",
+			fm:      contracts.ExportFrontmatter{Artists: []contracts.ExportArtistData{{Name: "A", SetTime: "21:00"}}},
+			wantErr: "invalid set time for A",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateImportTimes(tt.fm)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSortLineupBySetTime(t *testing.T) {
+	early := time.Date(2026, 7, 15, 20, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+	artists := []contracts.ArtistResponse{
+		{Name: "headliner", Position: 0, SetTime: &late},
+		{Name: "unset-1", Position: 1},
+		{Name: "opener", Position: 2, SetTime: &early},
+		{Name: "unset-2", Position: 3},
+	}
+
+	sortLineupBySetTime(artists)
+
+	var got []string
+	for _, a := range artists {
+		got = append(got, a.Name)
+	}
+	assert.Equal(t, []string{"opener", "headliner", "unset-1", "unset-2"}, got)
+}
+
 // =============================================================================
 // Group 8: ExportShowToMarkdown (DB required)
 // =============================================================================
@@ -1884,8 +2641,9 @@ func (suite *ShowServiceIntegrationTestSuite) TestExportShowToMarkdown_Success()
 	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
 		req.Title = "Export Test Show"
 		req.Description = "A great test show"
-		price := 25.0
-		req.Price = &price
+		minPrice, maxPrice := 25.0, 30.0
+		req.PriceMin = &minPrice
+		req.PriceMax = &maxPrice
 	})
 
 	data, filename, err := suite.showService.ExportShowToMarkdown(created.ID)
@@ -1898,10 +2656,46 @@ func (suite *ShowServiceIntegrationTestSuite) TestExportShowToMarkdown_Success()
 	content := string(data)
 	suite.True(strings.HasPrefix(content, "---\n"), "should start with frontmatter delimiter")
 	suite.Contains(content, "Export Test Show")
+	suite.Contains(content, "price_min: 25")
+	suite.Contains(content, "price_max: 30")
+	suite.Contains(content, "price_currency: USD")
 	suite.Contains(content, "## Description")
 	suite.Contains(content, "A great test show")
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestExportImport_PreservesLineup() {
+	created := suite.createTestShow(func(req *contracts.CreateShowRequest) {
+		req.Artists = []contracts.CreateShowArtist{
+			{Name: "Round Trip Headliner", IsHeadliner: boolPtr(true)},
+			{Name: "Round Trip Support", IsHeadliner: boolPtr(false)},
+			{Name: "Round Trip DJ", IsHeadliner: boolPtr(false)},
+		}
+	})
+	_, err := suite.showService.SetShowLineup(created.ID, []contracts.ShowLineupEntry{
+		{ArtistID: created.Artists[0].ID, SetType: catalogm.SetTypeHeadliner},
+		{ArtistID: created.Artists[2].ID, SetType: catalogm.SetTypeDJ},
+		{ArtistID: created.Artists[1].ID, SetType: catalogm.SetTypeSupport},
+	})
+	suite.Require().NoError(err)
+
+	data, _, err := suite.showService.ExportShowToMarkdown(created.ID)
+	suite.Require().NoError(err)
+
+	// Re-import on another date so it doesn't collide with the original.
+	data = []byte(strings.Replace(string(data), "2026-06-15T20:00:00Z", "2026-06-16T20:00:00Z", 1))
+	imported, err := suite.showService.ConfirmShowImport(data, true)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(created.ID, imported.ID)
+
+	var rows []catalogm.ShowArtist
+	suite.Require().NoError(suite.db.Where("show_id = ?", imported.ID).Order("position ASC").Find(&rows).Error)
+	suite.Require().Len(rows, 3)
+	suite.Equal([]uint{created.Artists[0].ID, created.Artists[2].ID, created.Artists[1].ID},
+		[]uint{rows[0].ArtistID, rows[1].ArtistID, rows[2].ArtistID})
+	suite.Equal([]string{catalogm.SetTypeHeadliner, catalogm.SetTypeDJ, catalogm.SetTypeSupport},
+		[]string{rows[0].SetType, rows[1].SetType, rows[2].SetType})
+}
+
 func (suite *ShowServiceIntegrationTestSuite) TestExportShowToMarkdown_NotFound() {
 	_, _, err := suite.showService.ExportShowToMarkdown(99999)
 
@@ -2021,6 +2815,36 @@ func (suite *ShowServiceIntegrationTestSuite) TestGetAdminShows_StatusFilter() {
 	suite.Equal("Pending Admin Show", shows[0].Title)
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestGetAdminShows_TagFilter() {
+	user := suite.createTestUser()
+	var showIDs []uint
+	for i := 0; i < 2; i++ {
+		req := &contracts.CreateShowRequest{
+			Title:             fmt.Sprintf("Tagged Admin %d", i),
+			EventDate:         time.Date(2026, 12, 10+i, 20, 0, 0, 0, time.UTC),
+			City:              "Phoenix",
+			State:             "AZ",
+			Venues:            []contracts.CreateShowVenue{{Name: fmt.Sprintf("TagF Venue %d", i), City: "Phoenix", State: "AZ"}},
+			Artists:           []contracts.CreateShowArtist{{Name: fmt.Sprintf("TagF Artist %d", i), IsHeadliner: boolPtr(true)}},
+			SubmittedByUserID: &user.ID,
+			SubmitterIsAdmin:  true,
+		}
+		show, err := suite.showService.CreateShow(req)
+		suite.Require().NoError(err)
+		showIDs = append(showIDs, show.ID)
+	}
+	suite.Require().NoError(suite.db.Exec(
+		"INSERT INTO admin_annotations (entity_type, entity_id, kind, body) VALUES ('show', ?, 'tag', 'Needs Flyer')", showIDs[1],
+	).Error)
+
+	shows, total, err := suite.showService.GetAdminShows(10, 0, contracts.AdminShowFilters{Tag: "needs flyer"})
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Require().Len(shows, 1)
+	suite.Equal(showIDs[1], shows[0].ID)
+}
+
 func (suite *ShowServiceIntegrationTestSuite) TestGetAdminShows_Pagination() {
 	user := suite.createTestUser()
 	for i := 0; i < 5; i++ {
@@ -2266,6 +3090,118 @@ func (suite *ShowServiceIntegrationTestSuite) TestBatchRejectShows_EmptyList() {
 	suite.Empty(result.Errors)
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestBulkShowAction_ApproveAssignsShortCode() {
+	id := suite.createPendingShow("Bulk Approve", 29)
+
+	result, err := suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs: []uint{id},
+		Action:  contracts.BulkShowActionApprove,
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal(1, result.Succeeded)
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, id).Error)
+	suite.Equal(catalogm.ShowStatusApproved, show.Status)
+	suite.Require().NotNil(show.ShortCode)
+	suite.Equal(ShowShortCode(id), *show.ShortCode)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestBulkShowAction_RejectSetsReasonAndCategory() {
+	id := suite.createPendingShow("Bulk Reject", 30)
+
+	result, err := suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs:  []uint{id, 999996},
+		Action:   contracts.BulkShowActionReject,
+		Reason:   "Not a music event",
+		Category: "non_music",
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal(1, result.Succeeded)
+	suite.Equal(1, result.Failed)
+	suite.Require().Len(result.Results, 2)
+	suite.True(result.Results[0].Success)
+	suite.Equal("Bulk Reject", result.Results[0].Title)
+	suite.False(result.Results[1].Success)
+	suite.Contains(result.Results[1].Error, "not found")
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, id).Error)
+	suite.Equal(catalogm.ShowStatusRejected, show.Status)
+	suite.Require().NotNil(show.RejectionCategory)
+	suite.Equal("non_music", *show.RejectionCategory)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestBulkShowAction_CancelAndDelete() {
+	cancelID := suite.createPendingShow("Bulk Cancel", 31)
+	deleteID := suite.createPendingShow("Bulk Delete", 32)
+
+	suite.Require().NoError(suite.db.Model(&catalogm.Show{}).Where("id = ?", cancelID).Update("is_postponed", true).Error)
+
+	cancelled, err := suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs: []uint{cancelID},
+		Action:  contracts.BulkShowActionCancel,
+		Reason:  "Venue closed",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(1, cancelled.Succeeded)
+
+	// Same result as CancelShow: reason stored, postponement cleared.
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.First(&show, cancelID).Error)
+	suite.True(show.IsCancelled)
+	suite.False(show.IsPostponed)
+	suite.Require().NotNil(show.StatusReason)
+	suite.Equal("Venue closed", *show.StatusReason)
+
+	// Cancelling twice is reported per item, not as a request failure.
+	again, err := suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs: []uint{cancelID},
+		Action:  contracts.BulkShowActionCancel,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(1, again.Failed)
+
+	deleted, err := suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs: []uint{deleteID},
+		Action:  contracts.BulkShowActionDelete,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(1, deleted.Succeeded)
+	suite.Equal("Bulk Delete", deleted.Results[0].Title)
+
+	// Bulk delete is a soft delete, same as DeleteShow.
+	var deletedShow catalogm.Show
+	suite.Require().NoError(suite.db.First(&deletedShow, deleteID).Error)
+	suite.NotNil(deletedShow.DeletedAt)
+
+	// A soft-deleted show can't be acted on again.
+	again, err = suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs: []uint{deleteID},
+		Action:  contracts.BulkShowActionDelete,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(1, again.Failed)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestBulkShowAction_Validation() {
+	_, err := suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs: []uint{1},
+		Action:  contracts.BulkShowActionReject,
+	})
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "reason is required")
+
+	_, err = suite.showService.BulkShowAction(&contracts.BulkShowActionRequest{
+		ShowIDs: []uint{1},
+		Action:  "archive",
+	})
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "unknown bulk action")
+}
+
 func (suite *ShowServiceIntegrationTestSuite) TestBatchRejectShows_MixedValidAndInvalid() {
 	pendingID := suite.createPendingShow("Mixed Reject", 25)
 
@@ -2327,6 +3263,12 @@ Preview test description.
 	suite.Equal("Brand New Preview Artist", resp.Artists[0].Name)
 	suite.Equal("headliner", resp.Artists[0].SetType)
 
+	suite.Equal([]contracts.ImportEntity{
+		{Type: "show", Name: "Preview New Show"},
+		{Type: "venue", Name: "Brand New Preview Venue"},
+		{Type: "artist", Name: "Brand New Preview Artist"},
+	}, resp.Creates)
+
 	suite.Empty(resp.Warnings)
 }
 
@@ -2371,6 +3313,9 @@ artists:
 	suite.False(resp.Artists[0].WillCreate)
 	suite.Require().NotNil(resp.Artists[0].ExistingID)
 	suite.Equal(artist.ID, *resp.Artists[0].ExistingID)
+
+	// Only the show itself is new.
+	suite.Equal([]contracts.ImportEntity{{Type: "show", Name: "Preview Existing Show"}}, resp.Creates)
 }
 
 func (suite *ShowServiceIntegrationTestSuite) TestPreviewShowImport_MixedNewAndExisting() {
@@ -2586,6 +3531,196 @@ An imported show description.
 	suite.Equal("Import Admin Show", show.Title)
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestConfirmShowImport_LegacyPrice() {
+	content := []byte(`---
+show:
+  title: "Legacy Price Show"
+  event_date: "2026-11-20T03:00:00Z"
+  city: "Phoenix"
+  state: "AZ"
+  price: 0
+  status: "approved"
+venues:
+  - name: "Legacy Price Venue"
+    city: "Phoenix"
+    state: "AZ"
+artists:
+  - name: "Legacy Price Band"
+    position: 0
+    set_type: "headliner"
+---
+`)
+
+	resp, err := suite.showService.ConfirmShowImport(content, true)
+
+	suite.Require().NoError(err)
+	suite.True(resp.IsFree, "a legacy price of 0 imports as free")
+	suite.Nil(resp.PriceMin)
+	suite.Equal("USD", resp.PriceCurrency)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestConfirmShowImport_ArtistFailureRollsBackEverything() {
+	// The venue, show and first artist are written before the blank second
+	// artist fails; none of them may survive.
+	content := []byte(`---
+show:
+  title: "Rollback Artist Show"
+  event_date: "2026-09-23T20:00:00Z"
+  city: "Phoenix"
+  state: "AZ"
+  status: "pending"
+venues:
+  - name: "Rollback Venue"
+    city: "Phoenix"
+    state: "AZ"
+artists:
+  - name: "Rollback Headliner"
+    position: 0
+    set_type: "headliner"
+  - name: ""
+    position: 1
+    set_type: "opener"
+---
+`)
+
+	_, err := suite.showService.ConfirmShowImport(content, true)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "rolled back")
+
+	suite.assertImportLeftNothing("Rollback Artist Show", "Rollback Venue", "Rollback Headliner")
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestConfirmShowImport_VenueFailureRollsBackEverything() {
+	// The second venue has no state, so it fails after the show and the
+	// first venue have been created.
+	content := []byte(`---
+show:
+  title: "Rollback Venue Show"
+  event_date: "2026-09-24T20:00:00Z"
+  city: "Phoenix"
+  state: "AZ"
+  status: "pending"
+venues:
+  - name: "Rollback First Venue"
+    city: "Phoenix"
+    state: "AZ"
+  - name: "Rollback Stateless Venue"
+    city: "Phoenix"
+artists:
+  - name: "Rollback Venue Headliner"
+    position: 0
+    set_type: "headliner"
+---
+`)
+
+	_, err := suite.showService.ConfirmShowImport(content, true)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "venue state is required")
+
+	suite.assertImportLeftNothing("Rollback Venue Show", "Rollback First Venue", "Rollback Venue Headliner")
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestConfirmShowImport_MissingArtists() {
+	content := []byte(`---
+show:
+  title: "No Bill Show"
+  event_date: "2026-09-25T20:00:00Z"
+  status: "pending"
+venues:
+  - name: "No Bill Venue"
+    city: "Phoenix"
+    state: "AZ"
+---
+`)
+
+	_, err := suite.showService.ConfirmShowImport(content, true)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "no artists specified")
+
+	var count int64
+	suite.db.Model(&catalogm.Venue{}).Where("name = ?", "No Bill Venue").Count(&count)
+	suite.Zero(count)
+}
+
+// assertImportLeftNothing checks a failed import wrote no show, venue or artist.
+func (suite *ShowServiceIntegrationTestSuite) assertImportLeftNothing(showTitle, venueName, artistName string) {
+	var count int64
+	suite.db.Model(&catalogm.Show{}).Where("title = ?", showTitle).Count(&count)
+	suite.Zero(count, "show should have been rolled back")
+	suite.db.Model(&catalogm.Venue{}).Where("name = ?", venueName).Count(&count)
+	suite.Zero(count, "venue should have been rolled back")
+	suite.db.Model(&catalogm.Artist{}).Where("name = ?", artistName).Count(&count)
+	suite.Zero(count, "artist should have been rolled back")
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestConfirmShowImport_TicketFields() {
+	content := []byte(`---
+show:
+  title: "Import Ticketed Show"
+  event_date: "2026-09-21T20:00:00Z"
+  city: "Phoenix"
+  state: "AZ"
+  ticket_url: "https://www.eventbrite.com/e/import-ticketed-show"
+  ticket_provider: "eventbrite"
+  status: "pending"
+venues:
+  - name: "Import Venue Tickets"
+    city: "Phoenix"
+    state: "AZ"
+artists:
+  - name: "Import Artist Tickets"
+    position: 0
+    set_type: "headliner"
+---
+`)
+
+	resp, err := suite.showService.ConfirmShowImport(content, true)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.TicketURL)
+	suite.Equal("https://www.eventbrite.com/e/import-ticketed-show", *resp.TicketURL)
+	suite.Require().NotNil(resp.TicketProvider)
+	suite.Equal("eventbrite", *resp.TicketProvider)
+
+	// Export carries both fields back out.
+	data, _, err := suite.showService.ExportShowToMarkdown(resp.ID)
+	suite.Require().NoError(err)
+	suite.Contains(string(data), "ticket_provider: eventbrite")
+
+	// An empty provider on update clears the column.
+	empty := ""
+	updated, err := suite.showService.UpdateShow(resp.ID, &contracts.UpdateShowRequest{TicketProvider: &empty})
+	suite.Require().NoError(err)
+	suite.Nil(updated.TicketProvider)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestConfirmShowImport_InvalidTicketProvider() {
+	content := []byte(`---
+show:
+  title: "Bad Provider Show"
+  event_date: "2026-09-22T20:00:00Z"
+  ticket_provider: "ticketmaster"
+  status: "pending"
+venues:
+  - name: "Import Venue Bad Provider"
+    city: "Phoenix"
+    state: "AZ"
+artists:
+  - name: "Import Artist Bad Provider"
+    position: 0
+    set_type: "headliner"
+---
+`)
+
+	_, err := suite.showService.ConfirmShowImport(content, true)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "invalid ticket provider")
+
+	preview, err := suite.showService.PreviewShowImport(content)
+	suite.Require().NoError(err)
+	suite.False(preview.CanImport)
+}
+
 func (suite *ShowServiceIntegrationTestSuite) TestConfirmShowImport_Success_AsNonAdmin() {
 	content := []byte(`---
 show:
@@ -2953,6 +4088,159 @@ func (suite *ShowServiceIntegrationTestSuite) TestGetUpcomingShows_LimitOne() {
 	suite.NotNil(cursor, "should have cursor when more results exist")
 }
 
+func (suite *ShowServiceIntegrationTestSuite) TestGetUpcomingShows_NearFilter() {
+	eventDate := time.Now().UTC().AddDate(0, 1, 0)
+	phoenix := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Near Phoenix"
+		r.EventDate = eventDate
+		r.Venues = []contracts.CreateShowVenue{{Name: "Near Venue PHX", City: "Phoenix", State: "AZ"}}
+	})
+	tucson := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Near Tucson"
+		r.EventDate = eventDate
+		r.City = "Tucson"
+		r.Venues = []contracts.CreateShowVenue{{Name: "Near Venue TUS", City: "Tucson", State: "AZ"}}
+	})
+	unlocated := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "No Coordinates"
+		r.EventDate = eventDate
+		r.Venues = []contracts.CreateShowVenue{{Name: "Near Venue Unknown", City: "Phoenix", State: "AZ"}}
+	})
+
+	setCoords := func(show *contracts.ShowResponse, lat, lng *float64) {
+		suite.Require().NoError(suite.db.Model(&catalogm.Venue{}).Where("id = ?", show.Venues[0].ID).
+			Updates(map[string]interface{}{"latitude": lat, "longitude": lng}).Error)
+	}
+	phxLat, phxLng := 33.4484, -112.0740
+	tusLat, tusLng := 32.2226, -110.9747
+	setCoords(phoenix, &phxLat, &phxLng)
+	setCoords(tucson, &tusLat, &tusLng)
+	setCoords(unlocated, nil, nil)
+
+	near := func(radiusKm float64) []uint {
+		shows, _, err := suite.showService.GetUpcomingShows("UTC", "", 50, false, &contracts.UpcomingShowsFilter{
+			Near: &contracts.NearFilter{Latitude: phxLat, Longitude: phxLng, RadiusKm: radiusKm},
+		})
+		suite.Require().NoError(err)
+		var ids []uint
+		for _, s := range shows {
+			ids = append(ids, s.ID)
+		}
+		return ids
+	}
+
+	suite.Equal([]uint{phoenix.ID}, near(40))
+	suite.ElementsMatch([]uint{phoenix.ID, tucson.ID}, near(200), "Tucson is ~171 km from Phoenix")
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetUpcomingShows_MetroFilter() {
+	eventDate := time.Now().UTC().AddDate(0, 1, 0)
+	tempe := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Metro Tempe"
+		r.EventDate = eventDate
+		r.City = "Tempe"
+		r.Venues = []contracts.CreateShowVenue{{Name: "Metro Venue Tempe", City: "Tempe", State: "AZ"}}
+	})
+	tucson := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Metro Tucson"
+		r.EventDate = eventDate
+		r.City = "Tucson"
+		r.Venues = []contracts.CreateShowVenue{{Name: "Metro Venue Tucson", City: "Tucson", State: "AZ"}}
+	})
+
+	setMetro := func(show *contracts.ShowResponse, metro string) {
+		suite.Require().NoError(suite.db.Model(&catalogm.Venue{}).Where("id = ?", show.Venues[0].ID).
+			Update("metro", metro).Error)
+	}
+	setMetro(tempe, "38060")
+	setMetro(tucson, "46060")
+
+	shows, _, err := suite.showService.GetUpcomingShows("UTC", "", 50, false, &contracts.UpcomingShowsFilter{Metro: "38060"})
+	suite.Require().NoError(err)
+	suite.Require().Len(shows, 1)
+	suite.Equal(tempe.ID, shows[0].ID)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetUpcomingShows_AllAgesFilter() {
+	eventDate := time.Now().UTC().AddDate(0, 1, 0)
+	allAges := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "All Ages Show"
+		r.EventDate = eventDate
+		r.Venues = []contracts.CreateShowVenue{{Name: "All Ages Venue", City: "Phoenix", State: "AZ"}}
+	})
+	barOnly := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Bar Show"
+		r.EventDate = eventDate
+		r.Venues = []contracts.CreateShowVenue{{Name: "Bar Venue", City: "Phoenix", State: "AZ"}}
+	})
+	suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Unknown Ages Show"
+		r.EventDate = eventDate
+		r.Venues = []contracts.CreateShowVenue{{Name: "Unknown Ages Venue", City: "Phoenix", State: "AZ"}}
+	})
+
+	suite.Require().NoError(suite.db.Model(&catalogm.Venue{}).Where("id = ?", allAges.Venues[0].ID).Update("all_ages", true).Error)
+	suite.Require().NoError(suite.db.Model(&catalogm.Venue{}).Where("id = ?", barOnly.Venues[0].ID).Update("all_ages", false).Error)
+
+	shows, _, err := suite.showService.GetUpcomingShows("UTC", "", 50, false, &contracts.UpcomingShowsFilter{AllAges: boolPtr(true)})
+	suite.Require().NoError(err)
+	suite.Require().Len(shows, 1)
+	suite.Equal(allAges.ID, shows[0].ID)
+
+	shows, _, err = suite.showService.GetUpcomingShows("UTC", "", 50, false, &contracts.UpcomingShowsFilter{AllAges: boolPtr(false)})
+	suite.Require().NoError(err)
+	suite.Require().Len(shows, 1, "venues with no flag match neither value")
+	suite.Equal(barOnly.ID, shows[0].ID)
+
+	listed, err := suite.showService.GetShows(map[string]interface{}{"all_ages": true})
+	suite.Require().NoError(err)
+	suite.Require().Len(listed, 1)
+	suite.Equal(allAges.ID, listed[0].ID)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestGetUpcomingShows_MaxPriceFilter() {
+	eventDate := time.Now().UTC().AddDate(0, 1, 0)
+	cheap := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Cheap Show"
+		r.EventDate = eventDate
+		minPrice, maxPrice := 10.0, 25.0
+		r.PriceMin, r.PriceMax = &minPrice, &maxPrice
+	})
+	free := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Free Show"
+		r.EventDate = eventDate
+		r.IsFree = true
+	})
+	suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Pricey Show"
+		r.EventDate = eventDate
+		minPrice := 40.0
+		r.PriceMin = &minPrice
+	})
+	suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Title = "Unpriced Show"
+		r.EventDate = eventDate
+	})
+
+	maxPrice := 20.0
+	shows, _, err := suite.showService.GetUpcomingShows("UTC", "", 50, false, &contracts.UpcomingShowsFilter{MaxPrice: &maxPrice})
+	suite.Require().NoError(err)
+	ids := []uint{}
+	for _, show := range shows {
+		ids = append(ids, show.ID)
+	}
+	suite.ElementsMatch([]uint{cheap.ID, free.ID}, ids, "lowest price at most 20, or free; unpriced never matches")
+
+	zero := 0.0
+	shows, _, err = suite.showService.GetUpcomingShows("UTC", "", 50, false, &contracts.UpcomingShowsFilter{MaxPrice: &zero})
+	suite.Require().NoError(err)
+	suite.Require().Len(shows, 1)
+	suite.Equal(free.ID, shows[0].ID)
+	suite.True(shows[0].IsFree)
+	suite.Require().NotNil(shows[0].Price)
+	suite.Zero(*shows[0].Price, "legacy price is 0 for a free show")
+}
+
 func (suite *ShowServiceIntegrationTestSuite) TestGetUpcomingShows_EmptyResult() {
 	shows, cursor, err := suite.showService.GetUpcomingShows("UTC", "", 10, false, nil)
 	suite.Require().NoError(err)
@@ -3066,8 +4354,9 @@ func (suite *ShowServiceIntegrationTestSuite) TestGetShow_VeryLargeID() {
 
 func (suite *ShowServiceIntegrationTestSuite) TestDeleteShow_ZeroID() {
 	err := suite.showService.DeleteShow(0)
-	// GORM Delete with ID=0 silently affects zero rows — no error returned.
-	suite.NoError(err)
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
 }
 
 // =============================================================================
@@ -3147,3 +4436,61 @@ A show with all new entities.
 	suite.Require().NoError(suite.db.Where("name = ?", "Freshly Created Opener").First(&opener).Error)
 	suite.NotZero(opener.ID)
 }
+
+func (suite *ShowServiceIntegrationTestSuite) TestCreateShow_ReturnsPossibleDuplicates() {
+	original := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.Artists = []contracts.CreateShowArtist{{Name: "The Black Keys", IsHeadliner: boolPtr(true)}}
+	})
+
+	// Same venue, an hour later, headliner spelled without "The".
+	resp := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.EventDate = r.EventDate.Add(time.Hour)
+		r.Artists = []contracts.CreateShowArtist{{Name: "Black Keys", IsHeadliner: boolPtr(true)}}
+	})
+
+	suite.Require().Len(resp.PossibleDuplicates, 1)
+	candidate := resp.PossibleDuplicates[0]
+	suite.Equal(original.ID, candidate.ShowID)
+	suite.Greater(candidate.Score, 0.9)
+	suite.Contains(candidate.Reasons, "same venue")
+
+	// An unrelated show in another city is not flagged.
+	other := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.City, r.State = "Tucson", "AZ"
+		r.Venues = []contracts.CreateShowVenue{{Name: "Club Congress", City: "Tucson", State: "AZ"}}
+		r.Artists = []contracts.CreateShowArtist{{Name: "Someone Else", IsHeadliner: boolPtr(true)}}
+	})
+	suite.Empty(other.PossibleDuplicates)
+}
+
+func (suite *ShowServiceIntegrationTestSuite) TestSetShowDuplicateOf() {
+	original := suite.createTestShow()
+	pending := suite.createTestShow(func(r *contracts.CreateShowRequest) {
+		r.EventDate = r.EventDate.Add(time.Hour)
+		r.Artists = []contracts.CreateShowArtist{{Name: "Test Artist!", IsHeadliner: boolPtr(true)}}
+	})
+	suite.db.Model(&catalogm.Show{}).Where("id = ?", pending.ID).Update("status", catalogm.ShowStatusPending)
+
+	resp, err := suite.showService.SetShowDuplicateOf(pending.ID, &original.ID)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(resp.DuplicateOfShowID)
+	suite.Equal(original.ID, *resp.DuplicateOfShowID)
+
+	resp, err = suite.showService.SetShowDuplicateOf(pending.ID, nil)
+	suite.Require().NoError(err)
+	suite.Nil(resp.DuplicateOfShowID)
+
+	var showErr *apperrors.ShowError
+	_, err = suite.showService.SetShowDuplicateOf(pending.ID, &pending.ID)
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowValidationFailed, showErr.Code)
+
+	_, err = suite.showService.SetShowDuplicateOf(pending.ID, uintPtr(999999))
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+
+	// Only pending shows can be linked.
+	_, err = suite.showService.SetShowDuplicateOf(original.ID, &pending.ID)
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowValidationFailed, showErr.Code)
+}
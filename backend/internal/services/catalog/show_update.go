@@ -0,0 +1,140 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
+)
+
+// ShowUpdateService stores the updates feed shown on show pages.
+type ShowUpdateService struct {
+	db *gorm.DB
+}
+
+// NewShowUpdateService creates a new show update service
+func NewShowUpdateService(database *gorm.DB) *ShowUpdateService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &ShowUpdateService{db: database}
+}
+
+// CreateUpdate posts an update on a show.
+func (s *ShowUpdateService) CreateUpdate(showID, userID uint, body string) (*contracts.ShowUpdateResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowUpdateInternal(fmt.Errorf("database not initialized"))
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, apperrors.ErrShowUpdateInvalid("body is required")
+	}
+	if utf8.RuneCountInString(body) > contracts.MaxShowUpdateBodyLength {
+		return nil, apperrors.ErrShowUpdateInvalid(fmt.Sprintf("body must be %d characters or fewer", contracts.MaxShowUpdateBodyLength))
+	}
+
+	var showCount int64
+	if err := s.db.Model(&catalogm.Show{}).Where("id = ? AND deleted_at IS NULL", showID).Count(&showCount).Error; err != nil {
+		return nil, apperrors.ErrShowUpdateInternal(err)
+	}
+	if showCount == 0 {
+		return nil, apperrors.ErrShowNotFound(showID)
+	}
+
+	update := &catalogm.ShowUpdate{ShowID: showID, UserID: userID, Body: body}
+	if err := s.db.Create(update).Error; err != nil {
+		return nil, apperrors.ErrShowUpdateInternal(err)
+	}
+
+	return s.GetUpdate(update.ID)
+}
+
+// ListUpdates returns a show's updates, oldest first.
+func (s *ShowUpdateService) ListUpdates(showID uint) ([]contracts.ShowUpdateResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowUpdateInternal(fmt.Errorf("database not initialized"))
+	}
+
+	updates, err := listShowUpdates(s.db, showID)
+	if err != nil {
+		return nil, apperrors.ErrShowUpdateInternal(err)
+	}
+	return updates, nil
+}
+
+// GetUpdate returns a single update.
+func (s *ShowUpdateService) GetUpdate(updateID uint) (*contracts.ShowUpdateResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrShowUpdateInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var update catalogm.ShowUpdate
+	if err := s.db.Preload("User").First(&update, updateID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowUpdateNotFound(updateID)
+		}
+		return nil, apperrors.ErrShowUpdateInternal(err)
+	}
+	resp := toShowUpdateResponse(&update)
+	return &resp, nil
+}
+
+// DeleteUpdate removes an update. Reports filed against it stay in the
+// moderation queue as history.
+func (s *ShowUpdateService) DeleteUpdate(updateID uint) error {
+	if s.db == nil {
+		return apperrors.ErrShowUpdateInternal(fmt.Errorf("database not initialized"))
+	}
+
+	result := s.db.Delete(&catalogm.ShowUpdate{}, updateID)
+	if result.Error != nil {
+		return apperrors.ErrShowUpdateInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrShowUpdateNotFound(updateID)
+	}
+	return nil
+}
+
+// ──────────────────────────────────────────────
+// Helpers
+// ──────────────────────────────────────────────
+
+// listShowUpdates loads a show's updates, oldest first. Shared with
+// ShowService, which attaches the feed to show detail responses.
+func listShowUpdates(database *gorm.DB, showID uint) ([]contracts.ShowUpdateResponse, error) {
+	var updates []catalogm.ShowUpdate
+	err := database.Preload("User").
+		Where("show_id = ?", showID).
+		Order("created_at ASC, id ASC").
+		Find(&updates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]contracts.ShowUpdateResponse, len(updates))
+	for i := range updates {
+		responses[i] = toShowUpdateResponse(&updates[i])
+	}
+	return responses, nil
+}
+
+func toShowUpdateResponse(update *catalogm.ShowUpdate) contracts.ShowUpdateResponse {
+	return contracts.ShowUpdateResponse{
+		ID:        update.ID,
+		ShowID:    update.ShowID,
+		UserID:    update.UserID,
+		UserName:  shared.ResolveUserName(&update.User),
+		Body:      update.Body,
+		CreatedAt: update.CreatedAt,
+	}
+}
@@ -0,0 +1,141 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestShowUpdateService_NilDatabase(t *testing.T) {
+	svc := &ShowUpdateService{}
+
+	_, err := svc.CreateUpdate(1, 1, "Moved to 8pm")
+	var updateErr *apperrors.ShowUpdateError
+	require.True(t, errors.As(err, &updateErr))
+	assert.Equal(t, apperrors.CodeShowUpdateInternal, updateErr.Code)
+
+	_, err = svc.ListUpdates(1)
+	assert.Error(t, err)
+	assert.Error(t, svc.DeleteUpdate(1))
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type ShowUpdateServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB  *testutil.TestDatabase
+	db      *gorm.DB
+	svc     *ShowUpdateService
+	showSvc *ShowService
+}
+
+func (suite *ShowUpdateServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.svc = NewShowUpdateService(suite.testDB.DB)
+	suite.showSvc = NewShowService(suite.testDB.DB)
+}
+
+func (suite *ShowUpdateServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+// TearDownTest cleans up data between tests for isolation
+func (suite *ShowUpdateServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	// Delete in FK-safe order
+	_, _ = sqlDB.Exec("DELETE FROM show_updates")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestShowUpdateServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShowUpdateServiceIntegrationTestSuite))
+}
+
+func (suite *ShowUpdateServiceIntegrationTestSuite) createUser(name string) *authm.User {
+	email := fmt.Sprintf("%s-%d@test.com", name, time.Now().UnixNano())
+	user := &authm.User{Email: &email, FirstName: &name, IsActive: true, EmailVerified: true}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	return user
+}
+
+func (suite *ShowUpdateServiceIntegrationTestSuite) createShow() *catalogm.Show {
+	show := &catalogm.Show{
+		Title:     "Late Show",
+		EventDate: time.Now().Add(7 * 24 * time.Hour),
+		Status:    catalogm.ShowStatusApproved,
+		Source:    catalogm.ShowSourceUser,
+	}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	return show
+}
+
+func (suite *ShowUpdateServiceIntegrationTestSuite) requireUpdateCode(err error, code string) {
+	var updateErr *apperrors.ShowUpdateError
+	suite.Require().ErrorAs(err, &updateErr)
+	suite.Equal(code, updateErr.Code)
+}
+
+func (suite *ShowUpdateServiceIntegrationTestSuite) TestCreateUpdate_Validation() {
+	user := suite.createUser("booker")
+	show := suite.createShow()
+
+	_, err := suite.svc.CreateUpdate(show.ID, user.ID, "   ")
+	suite.requireUpdateCode(err, apperrors.CodeShowUpdateInvalid)
+
+	_, err = suite.svc.CreateUpdate(show.ID, user.ID, strings.Repeat("x", contracts.MaxShowUpdateBodyLength+1))
+	suite.requireUpdateCode(err, apperrors.CodeShowUpdateInvalid)
+
+	_, err = suite.svc.CreateUpdate(999999, user.ID, "Moved to 8pm")
+	var showErr *apperrors.ShowError
+	suite.Require().ErrorAs(err, &showErr)
+	suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+}
+
+func (suite *ShowUpdateServiceIntegrationTestSuite) TestFeedOnShowDetail() {
+	user := suite.createUser("booker")
+	show := suite.createShow()
+
+	first, err := suite.svc.CreateUpdate(show.ID, user.ID, "  Lineup change: the openers dropped off  ")
+	suite.Require().NoError(err)
+	suite.Equal("Lineup change: the openers dropped off", first.Body)
+	suite.Equal("booker", first.UserName)
+	_, err = suite.svc.CreateUpdate(show.ID, user.ID, "Moved to 8pm")
+	suite.Require().NoError(err)
+
+	resp, err := suite.showSvc.GetShow(show.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Updates, 2)
+	suite.Equal(first.ID, resp.Updates[0].ID, "oldest first")
+	suite.Equal("Moved to 8pm", resp.Updates[1].Body)
+
+	suite.Require().NoError(suite.svc.DeleteUpdate(first.ID))
+	suite.requireUpdateCode(suite.svc.DeleteUpdate(first.ID), apperrors.CodeShowUpdateNotFound)
+	_, err = suite.svc.GetUpdate(first.ID)
+	suite.requireUpdateCode(err, apperrors.CodeShowUpdateNotFound)
+
+	updates, err := suite.svc.ListUpdates(show.ID)
+	suite.Require().NoError(err)
+	suite.Len(updates, 1)
+}
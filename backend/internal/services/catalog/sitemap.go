@@ -0,0 +1,246 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+const (
+	// SitemapMaxURLs is the per-file URL limit from the sitemaps.org
+	// protocol. Catalogs beyond it are served as a sitemap index whose
+	// entries point at /sitemap-{n}.xml pages.
+	SitemapMaxURLs = 50000
+
+	// sitemapCacheTTL is how long generated documents are served before the
+	// next request rebuilds them. Crawlers fetch sitemaps a few times a day
+	// at most, so an hour keeps new slugs reasonably fresh.
+	sitemapCacheTTL = time.Hour
+
+	sitemapXMLNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+// Sitemap XML types (sitemaps.org protocol 0.9).
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name         `xml:"sitemapindex"`
+	Xmlns    string           `xml:"xmlns,attr"`
+	Sitemaps []sitemapPointer `xml:"sitemap"`
+}
+
+type sitemapPointer struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// sitemapSlugRow is one indexable entity page.
+type sitemapSlugRow struct {
+	Slug      string
+	UpdatedAt time.Time
+}
+
+// SitemapService renders the public catalog (approved shows, verified
+// venues, and artists billed on an approved show) as sitemap XML. Page URLs
+// point at the frontend; index entries point at this API's /sitemap-{n}.xml.
+type SitemapService struct {
+	db          *gorm.DB
+	frontendURL string
+	backendURL  string
+	ttl         time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex
+	pages   [][]byte // pages[0] is /sitemap.xml; pages[n] is /sitemap-{n}.xml
+	builtAt time.Time
+}
+
+// NewSitemapService creates a sitemap service.
+func NewSitemapService(database *gorm.DB, frontendURL, backendURL string) *SitemapService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &SitemapService{
+		db:          database,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+		backendURL:  strings.TrimRight(backendURL, "/"),
+		ttl:         sitemapCacheTTL,
+		now:         time.Now,
+	}
+}
+
+// GetSitemap returns the document for a sitemap page: 0 is /sitemap.xml,
+// n ≥ 1 is /sitemap-{n}.xml. found is false for pages that don't exist,
+// including every n ≥ 1 while the catalog fits in a single file.
+func (s *SitemapService) GetSitemap(page int) (doc []byte, found bool, err error) {
+	if s.db == nil {
+		return nil, false, fmt.Errorf("database not initialized")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pages == nil || s.now().Sub(s.builtAt) >= s.ttl {
+		pages, err := s.build()
+		if err != nil {
+			return nil, false, err
+		}
+		s.pages = pages
+		s.builtAt = s.now()
+	}
+
+	if page < 0 || page >= len(s.pages) {
+		return nil, false, nil
+	}
+	return s.pages[page], true, nil
+}
+
+// build renders every sitemap page from the current catalog.
+func (s *SitemapService) build() ([][]byte, error) {
+	urls, err := s.collectURLs()
+	if err != nil {
+		return nil, err
+	}
+	return renderSitemapPages(urls, s.backendURL, SitemapMaxURLs)
+}
+
+// renderSitemapPages renders urls as a single URL set when they fit in
+// perPage, otherwise as a sitemap index (page 0) over URL-set pages 1..n.
+func renderSitemapPages(urls []sitemapURL, backendURL string, perPage int) ([][]byte, error) {
+	if len(urls) <= perPage {
+		doc, err := renderSitemapXML(sitemapURLSet{Xmlns: sitemapXMLNS, URLs: urls})
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{doc}, nil
+	}
+
+	index := sitemapIndex{Xmlns: sitemapXMLNS}
+	pages := [][]byte{nil}
+	for start := 0; start < len(urls); start += perPage {
+		chunk := urls[start:min(start+perPage, len(urls))]
+		doc, err := renderSitemapXML(sitemapURLSet{Xmlns: sitemapXMLNS, URLs: chunk})
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, doc)
+		index.Sitemaps = append(index.Sitemaps, sitemapPointer{
+			Loc:     fmt.Sprintf("%s/sitemap-%d.xml", backendURL, len(pages)-1),
+			LastMod: latestLastMod(chunk),
+		})
+	}
+
+	doc, err := renderSitemapXML(index)
+	if err != nil {
+		return nil, err
+	}
+	pages[0] = doc
+	return pages, nil
+}
+
+// collectURLs lists shows, then venues, then artists, each in ID order so
+// page boundaries stay stable between rebuilds.
+func (s *SitemapService) collectURLs() ([]sitemapURL, error) {
+	var shows []sitemapSlugRow
+	if err := s.db.Model(&catalogm.Show{}).
+		Select("slug, updated_at").
+		Where("status = ? AND deleted_at IS NULL", catalogm.ShowStatusApproved).
+		Where("slug IS NOT NULL AND slug <> ''").
+		Order("id").
+		Scan(&shows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list sitemap shows: %w", err)
+	}
+
+	var venues []sitemapSlugRow
+	if err := s.db.Model(&catalogm.Venue{}).
+		Select("slug, updated_at").
+		Where("verified = ?", true).
+		Where("slug IS NOT NULL AND slug <> ''").
+		Order("id").
+		Scan(&venues).Error; err != nil {
+		return nil, fmt.Errorf("failed to list sitemap venues: %w", err)
+	}
+
+	// Artists have no approval state of their own; only those billed on an
+	// approved show are public enough to index.
+	var artists []sitemapSlugRow
+	if err := s.db.Model(&catalogm.Artist{}).
+		Select("slug, updated_at").
+		Where("slug IS NOT NULL AND slug <> ''").
+		Where(`EXISTS (
+			SELECT 1 FROM show_artists sa
+			JOIN shows ON shows.id = sa.show_id
+			WHERE sa.artist_id = artists.id AND shows.status = ? AND shows.deleted_at IS NULL
+		)`, catalogm.ShowStatusApproved).
+		Order("id").
+		Scan(&artists).Error; err != nil {
+		return nil, fmt.Errorf("failed to list sitemap artists: %w", err)
+	}
+
+	urls := make([]sitemapURL, 0, len(shows)+len(venues)+len(artists))
+	for _, group := range []struct {
+		path string
+		rows []sitemapSlugRow
+	}{
+		{"shows", shows},
+		{"venues", venues},
+		{"artists", artists},
+	} {
+		for _, row := range group.rows {
+			urls = append(urls, sitemapURL{
+				Loc:     fmt.Sprintf("%s/%s/%s", s.frontendURL, group.path, row.Slug),
+				LastMod: sitemapLastMod(row.UpdatedAt),
+			})
+		}
+	}
+	return urls, nil
+}
+
+// sitemapLastMod formats a timestamp in the W3C datetime form sitemaps use.
+func sitemapLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// latestLastMod returns the newest lastmod in urls. The RFC 3339 UTC strings
+// sort lexically in time order.
+func latestLastMod(urls []sitemapURL) string {
+	latest := ""
+	for _, u := range urls {
+		if u.LastMod > latest {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
+
+func renderSitemapXML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode sitemap: %w", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
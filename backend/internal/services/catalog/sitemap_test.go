@@ -0,0 +1,83 @@
+package catalog
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSitemapURLs(n int) []sitemapURL {
+	urls := make([]sitemapURL, n)
+	for i := range urls {
+		urls[i] = sitemapURL{
+			Loc:     fmt.Sprintf("https://psychichomily.com/shows/show-%d", i),
+			LastMod: sitemapLastMod(time.Date(2026, 1, 1+i, 0, 0, 0, 0, time.UTC)),
+		}
+	}
+	return urls
+}
+
+func TestRenderSitemapPages_SingleURLSet(t *testing.T) {
+	pages, err := renderSitemapPages(testSitemapURLs(3), "https://api.psychichomily.com", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) != 1 {
+		t.Fatalf("expected 1 page, got %d", len(pages))
+	}
+
+	var set sitemapURLSet
+	if err := xml.Unmarshal(pages[0], &set); err != nil {
+		t.Fatalf("invalid XML: %v", err)
+	}
+	if set.XMLName.Local != "urlset" || len(set.URLs) != 3 {
+		t.Fatalf("expected a urlset with 3 URLs, got %s with %d", set.XMLName.Local, len(set.URLs))
+	}
+	if set.URLs[1].Loc != "https://psychichomily.com/shows/show-1" || set.URLs[1].LastMod != "2026-01-02T00:00:00Z" {
+		t.Errorf("unexpected entry: %+v", set.URLs[1])
+	}
+	if !strings.HasPrefix(string(pages[0]), xml.Header) {
+		t.Error("expected XML declaration")
+	}
+}
+
+func TestRenderSitemapPages_IndexBeyondLimit(t *testing.T) {
+	pages, err := renderSitemapPages(testSitemapURLs(5), "https://api.psychichomily.com", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) != 4 {
+		t.Fatalf("expected index + 3 pages, got %d documents", len(pages))
+	}
+
+	var index sitemapIndex
+	if err := xml.Unmarshal(pages[0], &index); err != nil {
+		t.Fatalf("invalid index XML: %v", err)
+	}
+	if index.XMLName.Local != "sitemapindex" || len(index.Sitemaps) != 3 {
+		t.Fatalf("expected a sitemapindex with 3 entries, got %s with %d", index.XMLName.Local, len(index.Sitemaps))
+	}
+	if got := index.Sitemaps[2].Loc; got != "https://api.psychichomily.com/sitemap-3.xml" {
+		t.Errorf("unexpected page loc %q", got)
+	}
+	// lastmod of a page is its newest entry.
+	if got := index.Sitemaps[0].LastMod; got != "2026-01-02T00:00:00Z" {
+		t.Errorf("unexpected page lastmod %q", got)
+	}
+
+	var last sitemapURLSet
+	if err := xml.Unmarshal(pages[3], &last); err != nil {
+		t.Fatalf("invalid page XML: %v", err)
+	}
+	if len(last.URLs) != 1 || last.URLs[0].Loc != "https://psychichomily.com/shows/show-4" {
+		t.Errorf("unexpected last page: %+v", last.URLs)
+	}
+}
+
+func TestSitemapLastMod_ZeroOmitted(t *testing.T) {
+	if got := sitemapLastMod(time.Time{}); got != "" {
+		t.Errorf("expected empty lastmod for zero time, got %q", got)
+	}
+}
@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+// Slug redirect history. Every path that changes or retires a show, venue or
+// artist slug records the old slug in slug_redirects; the GetXBySlug lookups
+// fall back to it so old URLs keep resolving, and report the current slug as
+// canonical_slug so the frontend can 301 to it.
+
+// redirectSlugTx makes oldSlug (and every slug that already redirected to
+// fromID) resolve to toID. Runs inside the caller's merge transaction so the
+// redirect and the delete of fromID commit together. A nil or empty oldSlug
+// only re-points existing redirects. Returns whether oldSlug was recorded.
+//
+// Re-pointing before inserting keeps redirects one hop deep: merging A into B
+// and later B into C leaves both A and B pointing straight at C.
+func redirectSlugTx(tx *gorm.DB, entityType string, oldSlug *string, fromID, toID uint) (bool, error) {
+	if err := tx.Exec(
+		`UPDATE slug_redirects SET entity_id = ? WHERE entity_type = ? AND entity_id = ?`,
+		toID, entityType, fromID,
+	).Error; err != nil {
+		return false, fmt.Errorf("failed to re-point slug redirects: %w", err)
+	}
+
+	if oldSlug == nil || *oldSlug == "" {
+		return false, nil
+	}
+	if err := insertSlugRedirect(tx, entityType, *oldSlug, toID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// recordSlugChangeTx records that entityID's slug changed from oldSlug to
+// newSlug. A redirect for newSlug itself is dropped, since that slug is live
+// again (e.g. an artist renamed back). No-op when the slug did not change or
+// there was no previous slug.
+func recordSlugChangeTx(tx *gorm.DB, entityType string, oldSlug *string, newSlug string, entityID uint) error {
+	if oldSlug == nil || *oldSlug == "" || *oldSlug == newSlug {
+		return nil
+	}
+	if err := tx.Where("entity_type = ? AND old_slug = ?", entityType, newSlug).
+		Delete(&catalogm.SlugRedirect{}).Error; err != nil {
+		return fmt.Errorf("failed to clear slug redirect: %w", err)
+	}
+	return insertSlugRedirect(tx, entityType, *oldSlug, entityID)
+}
+
+func insertSlugRedirect(tx *gorm.DB, entityType, oldSlug string, entityID uint) error {
+	if err := tx.Exec(`
+		INSERT INTO slug_redirects (entity_type, old_slug, entity_id, created_at)
+		VALUES (?, ?, ?, NOW())
+		ON CONFLICT (entity_type, old_slug) DO UPDATE SET entity_id = EXCLUDED.entity_id
+	`, entityType, oldSlug, entityID).Error; err != nil {
+		return fmt.Errorf("failed to record slug redirect: %w", err)
+	}
+	return nil
+}
+
+// resolveSlugRedirect returns the ID of the entity a historical slug now
+// belongs to, or 0 when slug has no redirect.
+func resolveSlugRedirect(db *gorm.DB, entityType, slug string) (uint, error) {
+	var redirect catalogm.SlugRedirect
+	err := db.Where("entity_type = ? AND old_slug = ?", entityType, slug).First(&redirect).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve slug redirect: %w", err)
+	}
+	return redirect.EntityID, nil
+}
@@ -12,6 +12,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 // Spotify Web API client for the image-enrichment backfill (PSY-1185).
@@ -88,7 +90,7 @@ func NewSpotifyClient(clientID, clientSecret string, rateLimit time.Duration) *S
 		rateLimit = spotifyRateLimit
 	}
 	return &SpotifyClient{
-		httpClient:   &http.Client{Timeout: spotifyDefaultTimeout},
+		httpClient:   httpclient.New(spotifyDefaultTimeout),
 		apiBaseURL:   spotifyAPIBaseURL,
 		accountsURL:  spotifyAccountsBaseURL,
 		rateLimiter:  time.NewTicker(rateLimit),
@@ -0,0 +1,468 @@
+package catalog
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	defaultSyncPageSize = 500
+	maxSyncPageSize     = 1000
+
+	// syncSettleWindow holds back rows touched in the last few seconds.
+	// updated_at is stamped before commit, so a slow transaction can land
+	// behind a cursor that already moved past it; waiting out the window
+	// keeps the keyset scan from skipping it.
+	syncSettleWindow = 5 * time.Second
+)
+
+// SyncService serves differential change feeds for shows, venues and
+// artists. Inserts and edits are found by keyset over (updated_at, id);
+// hard deletes come from the trigger-maintained sync_tombstones table.
+type SyncService struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// NewSyncService creates a new sync service
+func NewSyncService(database *gorm.DB) *SyncService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &SyncService{
+		db:  database,
+		now: time.Now,
+	}
+}
+
+// syncCursor is the decoded checkpoint. Since is fixed for a whole paged
+// session and splits created from updated; UpdatedAt/ID is the keyset
+// position in the entity table and TombstoneID the position in
+// sync_tombstones.
+type syncCursor struct {
+	Since       time.Time
+	UpdatedAt   time.Time
+	ID          uint
+	TombstoneID uint64
+}
+
+// encode formats the cursor as base64(since:updated_at:id:tombstone_id),
+// timestamps in unix nanos, mirroring the upcoming-shows cursor.
+func (c syncCursor) encode() string {
+	raw := fmt.Sprintf("%d:%d:%d:%d", syncUnixNano(c.Since), syncUnixNano(c.UpdatedAt), c.ID, c.TombstoneID)
+	return base64.URLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeSyncCursor parses a cursor. Empty input is the zero cursor (full sync).
+func decodeSyncCursor(cursor string) (syncCursor, error) {
+	if cursor == "" {
+		return syncCursor{}, nil
+	}
+	decoded, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return syncCursor{}, fmt.Errorf("invalid cursor encoding: %w", err)
+	}
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 4 {
+		return syncCursor{}, fmt.Errorf("invalid cursor format")
+	}
+	var nums [4]uint64
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return syncCursor{}, fmt.Errorf("invalid cursor field %d: %w", i, err)
+		}
+		nums[i] = n
+	}
+	if nums[2] > uint64(^uint32(0)) {
+		return syncCursor{}, fmt.Errorf("invalid cursor id")
+	}
+	return syncCursor{
+		Since:       syncTimeFromUnixNano(nums[0]),
+		UpdatedAt:   syncTimeFromUnixNano(nums[1]),
+		ID:          uint(nums[2]),
+		TombstoneID: nums[3],
+	}, nil
+}
+
+// syncUnixNano maps the zero time to 0 so a fresh cursor round-trips.
+func syncUnixNano(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+func syncTimeFromUnixNano(n uint64) time.Time {
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(n)).UTC()
+}
+
+// advance returns the cursor for the next call. When the session is complete
+// (no more pages), Since moves up to the keyset position so the next
+// session only reports rows created after it as "created".
+func (c syncCursor) advance(lastUpdatedAt time.Time, lastID uint, lastTombstoneID uint64, hasMore bool) syncCursor {
+	next := c
+	if lastID != 0 {
+		next.UpdatedAt = lastUpdatedAt
+		next.ID = lastID
+	}
+	if lastTombstoneID != 0 {
+		next.TombstoneID = lastTombstoneID
+	}
+	if !hasMore {
+		next.Since = next.UpdatedAt
+	}
+	return next
+}
+
+// isNew reports whether a record created at createdAt is new to the client.
+func (c syncCursor) isNew(createdAt time.Time) bool {
+	return createdAt.After(c.Since)
+}
+
+// clampSyncLimit applies the default and maximum page size.
+func clampSyncLimit(limit int) int {
+	if limit < 1 {
+		return defaultSyncPageSize
+	}
+	if limit > maxSyncPageSize {
+		return maxSyncPageSize
+	}
+	return limit
+}
+
+// marketSQL builds "((city = ? AND state = ?) OR ...)" over the given
+// columns, matching the upcoming-shows multi-city filter.
+func marketSQL(cityCol, stateCol string, market []contracts.CityStateFilter) (string, []interface{}) {
+	clauses := make([]string, 0, len(market))
+	args := make([]interface{}, 0, len(market)*2)
+	for _, cs := range market {
+		clauses = append(clauses, fmt.Sprintf("(%s = ? AND %s = ?)", cityCol, stateCol))
+		args = append(args, cs.City, cs.State)
+	}
+	return "(" + strings.Join(clauses, " OR ") + ")", args
+}
+
+// begin decodes the cursor and computes the settle horizon for one call.
+func (s *SyncService) begin(cursor string, limit int) (syncCursor, time.Time, int, error) {
+	c, err := decodeSyncCursor(cursor)
+	if err != nil {
+		return syncCursor{}, time.Time{}, 0, apperrors.ErrSyncInvalidCursor(err)
+	}
+	return c, s.now().Add(-syncSettleWindow), clampSyncLimit(limit), nil
+}
+
+// changedRows scans table for rows past the cursor's keyset position, up to
+// the horizon, fetching one extra row to detect another page.
+func (s *SyncService) changedRows(table string, c syncCursor, horizon time.Time, limit int) *gorm.DB {
+	return s.db.Table(table).
+		Where(table+".updated_at <= ?", horizon).
+		Where("("+table+".updated_at, "+table+".id) > (?, ?)", c.UpdatedAt, c.ID).
+		Order(table + ".updated_at ASC, " + table + ".id ASC").
+		Limit(limit + 1)
+}
+
+// tombstones returns deletions of entityType past the cursor, up to the
+// horizon. With a market, tombstones carrying another market's city/state
+// are skipped; artist tombstones carry the artist's hometown rather than
+// where they play, so callers pass scoped=false for them.
+func (s *SyncService) tombstones(entityType string, c syncCursor, horizon time.Time, limit int, market []contracts.CityStateFilter, scoped bool) ([]catalogm.SyncTombstone, bool, error) {
+	q := s.db.Where("entity_type = ? AND id > ? AND deleted_at <= ?", entityType, c.TombstoneID, horizon)
+	if scoped && len(market) > 0 {
+		clause, args := marketSQL("city", "state", market)
+		q = q.Where("(city IS NULL OR "+clause+")", args...)
+	}
+	var rows []catalogm.SyncTombstone
+	if err := q.Order("id ASC").Limit(limit + 1).Find(&rows).Error; err != nil {
+		return nil, false, apperrors.ErrSyncInternal(err)
+	}
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+	return rows, hasMore, nil
+}
+
+// lastTombstoneID returns the keyset position after a tombstone page.
+func lastTombstoneID(rows []catalogm.SyncTombstone) uint64 {
+	if len(rows) == 0 {
+		return 0
+	}
+	return rows[len(rows)-1].ID
+}
+
+// GetShowChanges returns show changes after cursor. Only approved shows are
+// sent; a show that left public view (rejected, made private, unpublished)
+// is reported as deleted.
+func (s *SyncService) GetShowChanges(cursor string, limit int, market []contracts.CityStateFilter) (*contracts.SyncShowDelta, error) {
+	c, horizon, limit, err := s.begin(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	q := s.changedRows("shows", c, horizon, limit)
+	if len(market) > 0 {
+		clause, args := marketSQL("shows.city", "shows.state", market)
+		q = q.Where(clause, args...)
+	}
+	var shows []catalogm.Show
+	if err := q.Find(&shows).Error; err != nil {
+		return nil, apperrors.ErrSyncInternal(err)
+	}
+	hasMore := len(shows) > limit
+	if hasMore {
+		shows = shows[:limit]
+	}
+
+	tombs, tombsMore, err := s.tombstones(catalogm.SyncEntityShow, c, horizon, limit, market, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var visibleIDs []uint
+	for _, sh := range shows {
+		if sh.Status == catalogm.ShowStatusApproved && sh.DeletedAt == nil {
+			visibleIDs = append(visibleIDs, sh.ID)
+		}
+	}
+	venueIDs, err := s.showRefs("show_venues", "venue_id", "venue_id", visibleIDs)
+	if err != nil {
+		return nil, err
+	}
+	artistIDs, err := s.showRefs("show_artists", "artist_id", "position, artist_id", visibleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	delta := &contracts.SyncShowDelta{
+		Created: []contracts.SyncShow{},
+		Updated: []contracts.SyncShow{},
+		Deleted: []uint{},
+		HasMore: hasMore || tombsMore,
+	}
+	for _, sh := range shows {
+		if sh.Status != catalogm.ShowStatusApproved || sh.DeletedAt != nil {
+			delta.Deleted = append(delta.Deleted, sh.ID)
+			continue
+		}
+		rec := contracts.SyncShow{
+			ID:             sh.ID,
+			Slug:           derefString(sh.Slug),
+			Title:          sh.Title,
+			EventDate:      sh.EventDate,
+			City:           sh.City,
+			State:          sh.State,
+			AgeRequirement: sh.AgeRequirement,
+			TicketURL:      sh.TicketURL,
+			TicketProvider: sh.TicketProvider,
+			IsSoldOut:      sh.IsSoldOut,
+			IsCancelled:    sh.IsCancelled,
+			VenueIDs:       nonNilIDs(venueIDs[sh.ID]),
+			ArtistIDs:      nonNilIDs(artistIDs[sh.ID]),
+			UpdatedAt:      sh.UpdatedAt,
+			Price:          sh.LowestPrice(),
+			PriceMin:       sh.PriceMin,
+			PriceMax:       sh.PriceMax,
+			PriceCurrency:  sh.PriceCurrency,
+			IsFree:         sh.IsFree,
+		}
+		if c.isNew(sh.CreatedAt) {
+			delta.Created = append(delta.Created, rec)
+		} else {
+			delta.Updated = append(delta.Updated, rec)
+		}
+	}
+	for _, t := range tombs {
+		delta.Deleted = append(delta.Deleted, t.EntityID)
+	}
+
+	var lastAt time.Time
+	var lastID uint
+	if n := len(shows); n > 0 {
+		lastAt, lastID = shows[n-1].UpdatedAt, shows[n-1].ID
+	}
+	delta.NextCursor = c.advance(lastAt, lastID, lastTombstoneID(tombs), delta.HasMore).encode()
+	return delta, nil
+}
+
+// showRefs loads the venue or artist IDs for a page of shows from a junction
+// table, keyed by show ID and ordered by orderBy.
+func (s *SyncService) showRefs(table, refColumn, orderBy string, showIDs []uint) (map[uint][]uint, error) {
+	refs := make(map[uint][]uint, len(showIDs))
+	if len(showIDs) == 0 {
+		return refs, nil
+	}
+	var rows []struct {
+		ShowID uint
+		RefID  uint
+	}
+	err := s.db.Table(table).
+		Select("show_id, "+refColumn+" AS ref_id").
+		Where("show_id IN ?", showIDs).
+		Order("show_id, " + orderBy).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, apperrors.ErrSyncInternal(err)
+	}
+	for _, r := range rows {
+		refs[r.ShowID] = append(refs[r.ShowID], r.RefID)
+	}
+	return refs, nil
+}
+
+// nonNilIDs keeps empty reference lists as [] rather than null in JSON.
+func nonNilIDs(ids []uint) []uint {
+	if ids == nil {
+		return []uint{}
+	}
+	return ids
+}
+
+// GetVenueChanges returns venue changes after cursor. Only verified venues
+// are sent, matching the public venue list; a venue that loses verification
+// is reported as deleted.
+func (s *SyncService) GetVenueChanges(cursor string, limit int, market []contracts.CityStateFilter) (*contracts.SyncVenueDelta, error) {
+	c, horizon, limit, err := s.begin(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	q := s.changedRows("venues", c, horizon, limit)
+	if len(market) > 0 {
+		clause, args := marketSQL("venues.city", "venues.state", market)
+		q = q.Where(clause, args...)
+	}
+	var venues []catalogm.Venue
+	if err := q.Find(&venues).Error; err != nil {
+		return nil, apperrors.ErrSyncInternal(err)
+	}
+	hasMore := len(venues) > limit
+	if hasMore {
+		venues = venues[:limit]
+	}
+
+	tombs, tombsMore, err := s.tombstones(catalogm.SyncEntityVenue, c, horizon, limit, market, true)
+	if err != nil {
+		return nil, err
+	}
+
+	delta := &contracts.SyncVenueDelta{
+		Created: []contracts.SyncVenue{},
+		Updated: []contracts.SyncVenue{},
+		Deleted: []uint{},
+		HasMore: hasMore || tombsMore,
+	}
+	for _, v := range venues {
+		if !v.Verified {
+			delta.Deleted = append(delta.Deleted, v.ID)
+			continue
+		}
+		rec := contracts.SyncVenue{
+			ID:        v.ID,
+			Slug:      derefString(v.Slug),
+			Name:      v.Name,
+			Address:   v.Address,
+			City:      v.City,
+			State:     v.State,
+			Zipcode:   v.Zipcode,
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
+			Timezone:  v.Timezone,
+			UpdatedAt: v.UpdatedAt,
+		}
+		if c.isNew(v.CreatedAt) {
+			delta.Created = append(delta.Created, rec)
+		} else {
+			delta.Updated = append(delta.Updated, rec)
+		}
+	}
+	for _, t := range tombs {
+		delta.Deleted = append(delta.Deleted, t.EntityID)
+	}
+
+	var lastAt time.Time
+	var lastID uint
+	if n := len(venues); n > 0 {
+		lastAt, lastID = venues[n-1].UpdatedAt, venues[n-1].ID
+	}
+	delta.NextCursor = c.advance(lastAt, lastID, lastTombstoneID(tombs), delta.HasMore).encode()
+	return delta, nil
+}
+
+// GetArtistChanges returns artist changes after cursor. With a market, only
+// artists billed on an approved show in that market are sent.
+func (s *SyncService) GetArtistChanges(cursor string, limit int, market []contracts.CityStateFilter) (*contracts.SyncArtistDelta, error) {
+	c, horizon, limit, err := s.begin(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	q := s.changedRows("artists", c, horizon, limit)
+	if len(market) > 0 {
+		clause, args := marketSQL("sh.city", "sh.state", market)
+		args = append([]interface{}{catalogm.ShowStatusApproved}, args...)
+		q = q.Where(`EXISTS (
+			SELECT 1 FROM show_artists sa
+			JOIN shows sh ON sh.id = sa.show_id
+			WHERE sa.artist_id = artists.id AND sh.status = ? AND sh.deleted_at IS NULL AND `+clause+`)`, args...)
+	}
+	var artists []catalogm.Artist
+	if err := q.Find(&artists).Error; err != nil {
+		return nil, apperrors.ErrSyncInternal(err)
+	}
+	hasMore := len(artists) > limit
+	if hasMore {
+		artists = artists[:limit]
+	}
+
+	tombs, tombsMore, err := s.tombstones(catalogm.SyncEntityArtist, c, horizon, limit, market, false)
+	if err != nil {
+		return nil, err
+	}
+
+	delta := &contracts.SyncArtistDelta{
+		Created: []contracts.SyncArtist{},
+		Updated: []contracts.SyncArtist{},
+		Deleted: []uint{},
+		HasMore: hasMore || tombsMore,
+	}
+	for _, a := range artists {
+		rec := contracts.SyncArtist{
+			ID:        a.ID,
+			Slug:      derefString(a.Slug),
+			Name:      a.Name,
+			City:      a.City,
+			State:     a.State,
+			UpdatedAt: a.UpdatedAt,
+		}
+		if c.isNew(a.CreatedAt) {
+			delta.Created = append(delta.Created, rec)
+		} else {
+			delta.Updated = append(delta.Updated, rec)
+		}
+	}
+	for _, t := range tombs {
+		delta.Deleted = append(delta.Deleted, t.EntityID)
+	}
+
+	var lastAt time.Time
+	var lastID uint
+	if n := len(artists); n > 0 {
+		lastAt, lastID = artists[n-1].UpdatedAt, artists[n-1].ID
+	}
+	delta.NextCursor = c.advance(lastAt, lastID, lastTombstoneID(tombs), delta.HasMore).encode()
+	return delta, nil
+}
@@ -0,0 +1,332 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestSyncCursor_RoundTrip(t *testing.T) {
+	c := syncCursor{
+		Since:       time.Date(2026, 3, 1, 12, 0, 0, 123, time.UTC),
+		UpdatedAt:   time.Date(2026, 3, 2, 8, 30, 0, 456, time.UTC),
+		ID:          42,
+		TombstoneID: 7,
+	}
+	got, err := decodeSyncCursor(c.encode())
+	require.NoError(t, err)
+	assert.True(t, c.Since.Equal(got.Since))
+	assert.True(t, c.UpdatedAt.Equal(got.UpdatedAt))
+	assert.Equal(t, uint(42), got.ID)
+	assert.Equal(t, uint64(7), got.TombstoneID)
+}
+
+func TestSyncCursor_EmptyIsFullSync(t *testing.T) {
+	got, err := decodeSyncCursor("")
+	require.NoError(t, err)
+	assert.Equal(t, syncCursor{}, got)
+
+	// The zero cursor survives a round trip so clients can echo it back.
+	got, err = decodeSyncCursor(syncCursor{}.encode())
+	require.NoError(t, err)
+	assert.True(t, got.Since.IsZero())
+	assert.True(t, got.UpdatedAt.IsZero())
+}
+
+func TestDecodeSyncCursor_Invalid(t *testing.T) {
+	for _, cursor := range []string{"!!!", "MTox", "YTpiOmM6ZA=="} {
+		_, err := decodeSyncCursor(cursor)
+		assert.Error(t, err, cursor)
+	}
+}
+
+func TestSyncCursor_Advance(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	last := since.Add(time.Hour)
+	c := syncCursor{Since: since}
+
+	mid := c.advance(last, 9, 3, true)
+	assert.Equal(t, since, mid.Since, "Since is fixed while pages remain")
+	assert.Equal(t, last, mid.UpdatedAt)
+	assert.Equal(t, uint(9), mid.ID)
+	assert.Equal(t, uint64(3), mid.TombstoneID)
+
+	done := mid.advance(time.Time{}, 0, 0, false)
+	assert.Equal(t, last, done.Since, "completed session moves Since to the keyset position")
+	assert.Equal(t, uint(9), done.ID)
+	assert.Equal(t, uint64(3), done.TombstoneID)
+}
+
+func TestClampSyncLimit(t *testing.T) {
+	assert.Equal(t, defaultSyncPageSize, clampSyncLimit(0))
+	assert.Equal(t, 25, clampSyncLimit(25))
+	assert.Equal(t, maxSyncPageSize, clampSyncLimit(5000))
+}
+
+func TestMarketSQL(t *testing.T) {
+	clause, args := marketSQL("city", "state", []contracts.CityStateFilter{
+		{City: "Phoenix", State: "AZ"},
+		{City: "Mesa", State: "AZ"},
+	})
+	assert.Equal(t, "((city = ? AND state = ?) OR (city = ? AND state = ?))", clause)
+	assert.Equal(t, []interface{}{"Phoenix", "AZ", "Mesa", "AZ"}, args)
+}
+
+func TestSyncService_InvalidCursor(t *testing.T) {
+	svc := &SyncService{now: time.Now}
+
+	_, err := svc.GetShowChanges("not-a-cursor", 0, nil)
+	var syncErr *apperrors.SyncError
+	require.True(t, errors.As(err, &syncErr))
+	assert.Equal(t, apperrors.CodeSyncInvalidCursor, syncErr.Code)
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type SyncServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB      *testutil.TestDatabase
+	db          *gorm.DB
+	syncService *SyncService
+	now         time.Time
+}
+
+func (suite *SyncServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+
+	suite.syncService = NewSyncService(suite.testDB.DB)
+	suite.syncService.now = func() time.Time { return suite.now }
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *SyncServiceIntegrationTestSuite) SetupTest() {
+	// Past the settle window for every fixture written during the test.
+	suite.now = time.Now().Add(time.Minute)
+}
+
+// TearDownTest cleans up data between tests for isolation
+func (suite *SyncServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	// Delete in FK-safe order
+	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
+	_, _ = sqlDB.Exec("DELETE FROM show_artists")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM artists")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM sync_tombstones")
+}
+
+func TestSyncServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(SyncServiceIntegrationTestSuite))
+}
+
+func (suite *SyncServiceIntegrationTestSuite) createShow(title, city string, status catalogm.ShowStatus) *catalogm.Show {
+	state := "AZ"
+	show := &catalogm.Show{
+		Title:     title,
+		EventDate: time.Now().Add(48 * time.Hour),
+		City:      &city,
+		State:     &state,
+		Status:    status,
+	}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	return show
+}
+
+// touch moves a row's updated_at forward, as an edit would.
+func (suite *SyncServiceIntegrationTestSuite) touch(table string, id uint) {
+	suite.Require().NoError(suite.db.Exec("UPDATE "+table+" SET updated_at = NOW() WHERE id = ?", id).Error)
+}
+
+// advanceClock makes rows written from here on land after the last cursor.
+func (suite *SyncServiceIntegrationTestSuite) advanceClock() {
+	time.Sleep(5 * time.Millisecond)
+	suite.now = time.Now().Add(time.Minute)
+}
+
+func showIDs(shows []contracts.SyncShow) []uint {
+	ids := make([]uint, 0, len(shows))
+	for _, s := range shows {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestShows_FullSyncThenDelta() {
+	kept := suite.createShow("Kept", "Phoenix", catalogm.ShowStatusApproved)
+	edited := suite.createShow("Edited", "Phoenix", catalogm.ShowStatusApproved)
+	removed := suite.createShow("Removed", "Phoenix", catalogm.ShowStatusApproved)
+	suite.createShow("Pending", "Phoenix", catalogm.ShowStatusPending)
+
+	full, err := suite.syncService.GetShowChanges("", 0, nil)
+	suite.Require().NoError(err)
+	suite.ElementsMatch([]uint{kept.ID, edited.ID, removed.ID}, showIDs(full.Created))
+	suite.Empty(full.Updated)
+	suite.False(full.HasMore)
+
+	suite.advanceClock()
+	suite.touch("shows", edited.ID)
+	suite.Require().NoError(suite.db.Delete(&catalogm.Show{}, removed.ID).Error)
+	added := suite.createShow("Added", "Phoenix", catalogm.ShowStatusApproved)
+	suite.advanceClock()
+
+	delta, err := suite.syncService.GetShowChanges(full.NextCursor, 0, nil)
+	suite.Require().NoError(err)
+	suite.Equal([]uint{added.ID}, showIDs(delta.Created))
+	suite.Equal([]uint{edited.ID}, showIDs(delta.Updated))
+	suite.Equal([]uint{removed.ID}, delta.Deleted)
+
+	// Nothing changed since: an empty delta that keeps the checkpoint usable.
+	again, err := suite.syncService.GetShowChanges(delta.NextCursor, 0, nil)
+	suite.Require().NoError(err)
+	suite.Empty(again.Created)
+	suite.Empty(again.Updated)
+	suite.Empty(again.Deleted)
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestShows_WithdrawnShowIsDeleted() {
+	show := suite.createShow("Soon Private", "Phoenix", catalogm.ShowStatusApproved)
+	full, err := suite.syncService.GetShowChanges("", 0, nil)
+	suite.Require().NoError(err)
+
+	suite.advanceClock()
+	suite.Require().NoError(suite.db.Model(show).Update("status", catalogm.ShowStatusPrivate).Error)
+	suite.advanceClock()
+
+	delta, err := suite.syncService.GetShowChanges(full.NextCursor, 0, nil)
+	suite.Require().NoError(err)
+	suite.Equal([]uint{show.ID}, delta.Deleted)
+	suite.Empty(delta.Updated)
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestShows_Paginates() {
+	var want []uint
+	for _, title := range []string{"One", "Two", "Three"} {
+		want = append(want, suite.createShow(title, "Phoenix", catalogm.ShowStatusApproved).ID)
+	}
+
+	var got []uint
+	cursor := ""
+	for pages := 0; pages < 5; pages++ {
+		delta, err := suite.syncService.GetShowChanges(cursor, 2, nil)
+		suite.Require().NoError(err)
+		got = append(got, showIDs(delta.Created)...)
+		cursor = delta.NextCursor
+		if !delta.HasMore {
+			break
+		}
+	}
+	suite.Equal(want, got)
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestShows_MarketScope() {
+	phx := suite.createShow("Phoenix Show", "Phoenix", catalogm.ShowStatusApproved)
+	tuc := suite.createShow("Tucson Show", "Tucson", catalogm.ShowStatusApproved)
+	market := []contracts.CityStateFilter{{City: "Phoenix", State: "AZ"}}
+
+	full, err := suite.syncService.GetShowChanges("", 0, market)
+	suite.Require().NoError(err)
+	suite.Equal([]uint{phx.ID}, showIDs(full.Created))
+
+	suite.advanceClock()
+	suite.Require().NoError(suite.db.Delete(&catalogm.Show{}, tuc.ID).Error)
+	suite.Require().NoError(suite.db.Delete(&catalogm.Show{}, phx.ID).Error)
+	suite.advanceClock()
+
+	delta, err := suite.syncService.GetShowChanges(full.NextCursor, 0, market)
+	suite.Require().NoError(err)
+	suite.Equal([]uint{phx.ID}, delta.Deleted, "out-of-market tombstones are skipped")
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestShows_IncludesReferencesInBillingOrder() {
+	venue := &catalogm.Venue{Name: "Sync Venue", City: "Phoenix", State: "AZ", Verified: true}
+	suite.Require().NoError(suite.db.Create(venue).Error)
+	headliner := &catalogm.Artist{Name: "Sync Headliner"}
+	opener := &catalogm.Artist{Name: "Sync Opener"}
+	suite.Require().NoError(suite.db.Create(opener).Error)
+	suite.Require().NoError(suite.db.Create(headliner).Error)
+
+	show := suite.createShow("Billed", "Phoenix", catalogm.ShowStatusApproved)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: venue.ID}).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: show.ID, ArtistID: headliner.ID, Position: 0}).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: show.ID, ArtistID: opener.ID, Position: 1}).Error)
+
+	full, err := suite.syncService.GetShowChanges("", 0, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(full.Created, 1)
+	suite.Equal([]uint{venue.ID}, full.Created[0].VenueIDs)
+	suite.Equal([]uint{headliner.ID, opener.ID}, full.Created[0].ArtistIDs)
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestShows_SkipsUnsettledRows() {
+	suite.now = time.Now()
+	suite.createShow("Just Written", "Phoenix", catalogm.ShowStatusApproved)
+
+	full, err := suite.syncService.GetShowChanges("", 0, nil)
+	suite.Require().NoError(err)
+	suite.Empty(full.Created)
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestVenues_UnverifiedIsDeleted() {
+	verified := &catalogm.Venue{Name: "Verified Room", City: "Phoenix", State: "AZ", Verified: true}
+	unverified := &catalogm.Venue{Name: "Unverified Room", City: "Phoenix", State: "AZ"}
+	suite.Require().NoError(suite.db.Create(verified).Error)
+	suite.Require().NoError(suite.db.Create(unverified).Error)
+
+	full, err := suite.syncService.GetVenueChanges("", 0, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(full.Created, 1)
+	suite.Equal(verified.ID, full.Created[0].ID)
+	suite.Equal([]uint{unverified.ID}, full.Deleted)
+
+	suite.advanceClock()
+	suite.Require().NoError(suite.db.Delete(&catalogm.Venue{}, verified.ID).Error)
+	suite.advanceClock()
+
+	delta, err := suite.syncService.GetVenueChanges(full.NextCursor, 0, nil)
+	suite.Require().NoError(err)
+	suite.Equal([]uint{verified.ID}, delta.Deleted)
+}
+
+func (suite *SyncServiceIntegrationTestSuite) TestArtists_MarketScopeFollowsShows() {
+	local := &catalogm.Artist{Name: "Plays Phoenix"}
+	elsewhere := &catalogm.Artist{Name: "Plays Tucson"}
+	suite.Require().NoError(suite.db.Create(local).Error)
+	suite.Require().NoError(suite.db.Create(elsewhere).Error)
+
+	phx := suite.createShow("Phoenix Bill", "Phoenix", catalogm.ShowStatusApproved)
+	tuc := suite.createShow("Tucson Bill", "Tucson", catalogm.ShowStatusApproved)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: phx.ID, ArtistID: local.ID}).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: tuc.ID, ArtistID: elsewhere.ID}).Error)
+
+	delta, err := suite.syncService.GetArtistChanges("", 0, []contracts.CityStateFilter{{City: "Phoenix", State: "AZ"}})
+	suite.Require().NoError(err)
+	suite.Require().Len(delta.Created, 1)
+	suite.Equal(local.ID, delta.Created[0].ID)
+
+	all, err := suite.syncService.GetArtistChanges("", 0, nil)
+	suite.Require().NoError(err)
+	suite.Len(all.Created, 2)
+}
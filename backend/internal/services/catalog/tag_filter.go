@@ -53,6 +53,33 @@ func ParseTagFilter(tags string, match string) TagFilter {
 	}
 }
 
+// ParseCityStateFilters turns the pipe-delimited "City,ST|City,ST" wire
+// format used by the /shows `cities` param into typed filters. Malformed
+// pairs (not exactly city,state, or blank after trimming) are skipped and
+// the list is capped at max. Empty input ⇒ nil (no filter).
+func ParseCityStateFilters(raw string, max int) []contracts.CityStateFilter {
+	if raw == "" {
+		return nil
+	}
+	var filters []contracts.CityStateFilter
+	for _, pair := range strings.Split(raw, "|") {
+		parts := strings.Split(pair, ",")
+		if len(parts) != 2 {
+			continue
+		}
+		city := strings.TrimSpace(parts[0])
+		state := strings.TrimSpace(parts[1])
+		if city == "" || state == "" {
+			continue
+		}
+		filters = append(filters, contracts.CityStateFilter{City: city, State: state})
+		if len(filters) >= max {
+			break
+		}
+	}
+	return filters
+}
+
 // ApplyTagFilter narrows a GORM query so that the entity identified by
 // `entityType` and `idColumn` (fully qualified, e.g. `artists.id`) is
 // constrained to rows matching the tag filter. It uses a subquery with
@@ -208,7 +235,7 @@ func CountTransitiveArtistTagUsageInShowCities(
 		Select("entity_tags.tag_id AS tag_id, COUNT(DISTINCT show_artists.show_id) AS count").
 		Joins("JOIN entity_tags ON entity_tags.entity_type = ? AND entity_tags.entity_id = show_artists.artist_id", catalogm.TagEntityArtist).
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
-		Where("entity_tags.tag_id IN ?", tagIDs).
+		Where("entity_tags.tag_id IN ? AND shows.deleted_at IS NULL", tagIDs).
 		Where(cityCond).
 		Group("entity_tags.tag_id").
 		Scan(&rows).Error
@@ -234,7 +234,7 @@ func (s *TagService) intersectBaseQuery(entityType string) *gorm.DB {
 		// "show all shows" link target and must point it at an upcoming-scoped
 		// shows surface so the linked list agrees with this count.
 		return s.db.Table("shows").
-			Where("shows.status = ?", catalogm.ShowStatusApproved).
+			Where("shows.status = ? AND shows.deleted_at IS NULL", catalogm.ShowStatusApproved).
 			Where("shows.event_date >= ?", startOfTodayUTC())
 	case catalogm.TagEntityFestival:
 		return s.db.Table("festivals")
@@ -279,7 +279,7 @@ func (s *TagService) applyPreviewOrder(query *gorm.DB, entityType string) *gorm.
 				SELECT sa.artist_id, COUNT(DISTINCT s.id) AS cnt
 				FROM show_artists sa
 				JOIN shows s ON s.id = sa.show_id
-				WHERE s.event_date >= NOW()
+				WHERE s.event_date >= NOW() AND s.deleted_at IS NULL
 				GROUP BY sa.artist_id
 			) usc ON usc.artist_id = artists.id`).
 			Order("COALESCE(usc.cnt, 0) DESC, artists.name ASC")
@@ -289,7 +289,7 @@ func (s *TagService) applyPreviewOrder(query *gorm.DB, entityType string) *gorm.
 				SELECT sv.venue_id, COUNT(DISTINCT s.id) AS cnt
 				FROM show_venues sv
 				JOIN shows s ON s.id = sv.show_id
-				WHERE s.event_date >= NOW()
+				WHERE s.event_date >= NOW() AND s.deleted_at IS NULL
 				GROUP BY sv.venue_id
 			) usc ON usc.venue_id = venues.id`).
 			Order("COALESCE(usc.cnt, 0) DESC, venues.name ASC")
@@ -174,6 +174,12 @@ func (s *TagService) MergeTags(sourceID, targetID uint, actorUserID uint) (*cont
 		result.MovedEntityTags = moved
 		result.SkippedEntityTags = skipped
 
+		// Inferred artist genres follow the entity tags; they are not
+		// user-applied, so they stay out of the moved/skipped counts.
+		if err := moveArtistGenres(tx, source.ID, target.ID); err != nil {
+			return err
+		}
+
 		// 2. Votes.
 		movedVotes, skippedVotes, err := moveVotes(tx, source.ID, target.ID)
 		if err != nil {
@@ -306,6 +312,22 @@ func moveEntityTags(db *gorm.DB, sourceID, targetID uint) (moved, skipped int64,
 	return moved, skipped, nil
 }
 
+// moveArtistGenres re-points source's artist_genres rows to target, dropping
+// those whose artist already has target.
+func moveArtistGenres(db *gorm.DB, sourceID, targetID uint) error {
+	if err := db.Exec(`
+		DELETE FROM artist_genres
+		WHERE tag_id = ?
+		  AND artist_id IN (SELECT artist_id FROM artist_genres WHERE tag_id = ?)
+	`, sourceID, targetID).Error; err != nil {
+		return fmt.Errorf("failed to drop conflicting artist_genres: %w", err)
+	}
+	if err := db.Model(&catalogm.ArtistGenre{}).Where("tag_id = ?", sourceID).Update("tag_id", targetID).Error; err != nil {
+		return fmt.Errorf("failed to move artist_genres: %w", err)
+	}
+	return nil
+}
+
 // countEntityTagMoves is the preview-only counterpart to moveEntityTags.
 func countEntityTagMoves(db *gorm.DB, sourceID, targetID uint) (moved, skipped int64, err error) {
 	var total int64
@@ -964,6 +964,8 @@ func (s *TagService) SearchTags(query string, limit int, category string) ([]con
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	if limit <= 0 {
 		limit = 10
 	}
@@ -973,10 +975,10 @@ func (s *TagService) SearchTags(query string, limit int, category string) ([]con
 	// Search tags by name and by alias, dedup by tag ID.
 	// Group the OR conditions to ensure category filter applies to both.
 	pattern := shared.LikePattern(q)
-	db := s.db.Where(
-		s.db.Where("LOWER(name) LIKE ?", pattern).
+	db := replica.Where(
+		replica.Where("LOWER(name) LIKE ?", pattern).
 			Or("id IN (?)",
-				s.db.Model(&catalogm.TagAlias{}).Select("tag_id").Where("LOWER(alias) LIKE ?", pattern),
+				replica.Model(&catalogm.TagAlias{}).Select("tag_id").Where("LOWER(alias) LIKE ?", pattern),
 			),
 	)
 
@@ -1011,7 +1013,7 @@ func (s *TagService) SearchTags(query string, limit int, category string) ([]con
 		// Fetch matching aliases in one query, ordered so the first (lexicographic)
 		// match wins deterministically when a tag has multiple matching aliases.
 		var aliases []catalogm.TagAlias
-		err := s.db.Where("tag_id IN ? AND LOWER(alias) LIKE ?", aliasMatchIDs, pattern).
+		err := replica.Where("tag_id IN ? AND LOWER(alias) LIKE ?", aliasMatchIDs, pattern).
 			Order("tag_id, alias ASC").
 			Find(&aliases).Error
 		if err != nil {
@@ -1279,7 +1281,7 @@ func (s *TagService) enrichArtists(ids []uint) map[uint]contracts.TaggedEntityIt
 		    SELECT sa.artist_id, COUNT(DISTINCT s.id) AS cnt
 		    FROM show_artists sa
 		    JOIN shows s ON s.id = sa.show_id
-		    WHERE s.event_date >= NOW()
+		    WHERE s.event_date >= NOW() AND s.deleted_at IS NULL
 		    GROUP BY sa.artist_id
 		) c ON c.artist_id = a.id
 		WHERE a.id IN ?
@@ -1327,7 +1329,7 @@ func (s *TagService) enrichVenues(ids []uint) map[uint]contracts.TaggedEntityIte
 		    SELECT sv.venue_id, COUNT(DISTINCT s.id) AS cnt
 		    FROM show_venues sv
 		    JOIN shows s ON s.id = sv.show_id
-		    WHERE s.event_date >= NOW()
+		    WHERE s.event_date >= NOW() AND s.deleted_at IS NULL
 		    GROUP BY sv.venue_id
 		) c ON c.venue_id = v.id
 		WHERE v.id IN ?
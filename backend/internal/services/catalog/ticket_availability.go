@@ -0,0 +1,392 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/httpclient"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
+)
+
+// Ticket availability checks. For upcoming shows whose ticket_url is on a
+// known provider, a background job reads the ticket page and keeps
+// is_sold_out in step with it: a show is marked sold out when the page says
+// so, and the flag expires on its own when tickets come back (a released
+// hold, an added date). Every flip is recorded in show_ticket_status_changes.
+//
+// The page is the source of truth once a provider is enabled, so a sold-out
+// flag set by hand on a show whose page still sells tickets is cleared on
+// the next check. A page the job can't read, or that doesn't say either way,
+// leaves the flag alone.
+//
+// Each provider is configured separately and has its own request spacing,
+// so a slow or strict provider doesn't hold up the others. The provider
+// hosts must be on the egress allowlist (EGRESS_ALLOWED_HOSTS) where one is
+// set.
+
+const (
+	// DefaultTicketCheckInterval is how often the job looks for shows due a
+	// check.
+	DefaultTicketCheckInterval = 1 * time.Hour
+
+	// DefaultTicketCheckBatchSize caps the shows checked per provider per
+	// cycle; the rest wait for the next cycle, oldest check first.
+	DefaultTicketCheckBatchSize = 50
+
+	defaultTicketRecheckHours          = 6
+	defaultTicketRequestIntervalSecond = 5
+
+	ticketCheckTimeout   = 20 * time.Second
+	ticketCheckUserAgent = "PsychicHomily/1.0 (ticket-availability; https://psychichomily.com)"
+
+	// ticketStatusHistoryLimit caps the changes returned with a show's
+	// ticket status.
+	ticketStatusHistoryLimit = 100
+)
+
+// ticketProviderCheck configures checks for one ticket provider.
+type ticketProviderCheck struct {
+	Provider string   // shows.ticket_provider value
+	Hosts    []string // ticket URL hosts that are read; each also matches its subdomains
+
+	// How long a check stays fresh before the show is due again. Zero
+	// disables the provider.
+	RecheckInterval time.Duration
+
+	// Minimum gap between two requests to the provider
+	RequestInterval time.Duration
+}
+
+// defaultTicketProviderChecks returns the checks for the providers whose
+// ticket pages publish availability (schema.org offers), tuned by env vars.
+// For each provider (EVENTBRITE, DICE, SEETICKETS):
+//   - TICKET_CHECK_<PROVIDER>_RECHECK_HOURS (default 6; 0 disables the provider)
+//   - TICKET_CHECK_<PROVIDER>_REQUEST_INTERVAL_SECONDS (default 5)
+func defaultTicketProviderChecks() []ticketProviderCheck {
+	providers := []struct {
+		provider string
+		hosts    []string
+	}{
+		{catalogm.TicketProviderEventbrite, []string{"eventbrite.com", "eventbrite.ca", "eventbrite.co.uk", "eventbrite.com.au", "eventbrite.ie"}},
+		{catalogm.TicketProviderDice, []string{"dice.fm"}},
+		{catalogm.TicketProviderSeeTickets, []string{"seetickets.us", "seetickets.com"}},
+	}
+
+	checks := make([]ticketProviderCheck, len(providers))
+	for i, p := range providers {
+		prefix := "TICKET_CHECK_" + strings.ToUpper(p.provider)
+		checks[i] = ticketProviderCheck{
+			Provider:        p.provider,
+			Hosts:           p.hosts,
+			RecheckInterval: time.Duration(envNonNegativeInt(prefix+"_RECHECK_HOURS", defaultTicketRecheckHours)) * time.Hour,
+			RequestInterval: time.Duration(envPositiveInt(prefix+"_REQUEST_INTERVAL_SECONDS", defaultTicketRequestIntervalSecond)) * time.Second,
+		}
+	}
+	return checks
+}
+
+// TicketCheckResult summarizes one check cycle.
+type TicketCheckResult struct {
+	Checked     int // ticket pages read
+	MarkedSold  int // shows newly marked sold out
+	MarkedAvail int // sold-out flags cleared
+	Unknown     int // pages that didn't say either way
+	Failed      int // pages that couldn't be read
+}
+
+// TicketAvailabilityService is a background service that keeps the sold-out
+// flag of upcoming shows in step with their ticket pages.
+//
+// It follows the same Start/Stop pattern as RelationshipDerivationService.
+type TicketAvailabilityService struct {
+	db         *gorm.DB
+	httpClient *http.Client
+	providers  []ticketProviderCheck
+	interval   time.Duration
+	batchSize  int
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+	logger *slog.Logger
+}
+
+// NewTicketAvailabilityService creates the ticket availability checker.
+// Env vars:
+//   - TICKET_CHECK_INTERVAL_HOURS (default 1)
+//   - TICKET_CHECK_BATCH_SIZE (default 50, per provider per cycle)
+//   - per-provider knobs, see defaultTicketProviderChecks
+func NewTicketAvailabilityService(database *gorm.DB) *TicketAvailabilityService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return newTicketAvailabilityService(
+		database,
+		httpclient.New(ticketCheckTimeout),
+		defaultTicketProviderChecks(),
+		envPositiveHours("TICKET_CHECK_INTERVAL_HOURS", DefaultTicketCheckInterval),
+		envPositiveInt("TICKET_CHECK_BATCH_SIZE", DefaultTicketCheckBatchSize),
+	)
+}
+
+func newTicketAvailabilityService(database *gorm.DB, httpClient *http.Client, providers []ticketProviderCheck, interval time.Duration, batchSize int) *TicketAvailabilityService {
+	return &TicketAvailabilityService{
+		db:         database,
+		httpClient: httpClient,
+		providers:  providers,
+		interval:   interval,
+		batchSize:  batchSize,
+		stopCh:     make(chan struct{}),
+		logger:     slog.Default(),
+	}
+}
+
+// Start begins the background ticket availability checks.
+func (s *TicketAvailabilityService) Start(ctx context.Context) {
+	s.wg.Add(1)
+	go s.runLoop(ctx)
+
+	enabled := make([]string, 0, len(s.providers))
+	for _, p := range s.providers {
+		if p.RecheckInterval > 0 {
+			enabled = append(enabled, p.Provider)
+		}
+	}
+	s.logger.Info("ticket availability service started",
+		"interval_hours", s.interval.Hours(),
+		"providers", strings.Join(enabled, ","),
+	)
+}
+
+// Stop gracefully stops the ticket availability checks.
+func (s *TicketAvailabilityService) Stop() {
+	close(s.stopCh)
+	s.wg.Wait()
+	s.logger.Info("ticket availability service stopped")
+}
+
+func (s *TicketAvailabilityService) runLoop(ctx context.Context) {
+	defer s.wg.Done()
+	shared.RunTickerLoop(ctx, "ticket_availability", s.interval, s.stopCh, true, func(cycleCtx context.Context) {
+		s.RunCheckCycle(cycleCtx)
+	})
+}
+
+// ticketCheckShow is a show due a ticket check.
+type ticketCheckShow struct {
+	ID        uint
+	TicketURL string
+	IsSoldOut bool
+}
+
+// RunCheckCycle checks the ticket pages of the shows due a check, provider
+// by provider, and returns the combined result.
+func (s *TicketAvailabilityService) RunCheckCycle(ctx context.Context) TicketCheckResult {
+	start := time.Now()
+	var total TicketCheckResult
+
+	for _, check := range s.providers {
+		if check.RecheckInterval <= 0 {
+			continue
+		}
+		result, err := s.checkProvider(ctx, check)
+		if err != nil {
+			s.logger.Error("ticket availability check failed",
+				"provider", check.Provider,
+				"error", err,
+			)
+		}
+		total.Checked += result.Checked
+		total.MarkedSold += result.MarkedSold
+		total.MarkedAvail += result.MarkedAvail
+		total.Unknown += result.Unknown
+		total.Failed += result.Failed
+	}
+
+	s.logger.Info("ticket availability cycle complete",
+		"checked", total.Checked,
+		"marked_sold_out", total.MarkedSold,
+		"marked_available", total.MarkedAvail,
+		"unknown", total.Unknown,
+		"failed", total.Failed,
+		"duration", time.Since(start),
+	)
+	return total
+}
+
+// checkProvider checks the provider's shows that are due, spacing requests
+// by the provider's RequestInterval.
+func (s *TicketAvailabilityService) checkProvider(ctx context.Context, check ticketProviderCheck) (TicketCheckResult, error) {
+	var result TicketCheckResult
+
+	var shows []ticketCheckShow
+	err := s.db.Model(&catalogm.Show{}).
+		Select("id, ticket_url, is_sold_out").
+		Where("ticket_provider = ?", check.Provider).
+		Where("ticket_url IS NOT NULL AND ticket_url <> ''").
+		Where("status = ?", catalogm.ShowStatusApproved).
+		Where("is_cancelled = false AND is_postponed = false AND deleted_at IS NULL").
+		Where("event_date > ?", time.Now().UTC()).
+		Where("(ticket_last_checked_at IS NULL OR ticket_last_checked_at < ?)", time.Now().UTC().Add(-check.RecheckInterval)).
+		Order("ticket_last_checked_at ASC NULLS FIRST, event_date ASC").
+		Limit(s.batchSize).
+		Scan(&shows).Error
+	if err != nil {
+		return result, fmt.Errorf("failed to load shows due a check: %w", err)
+	}
+	if len(shows) == 0 {
+		return result, nil
+	}
+
+	limiter := time.NewTicker(check.RequestInterval)
+	defer limiter.Stop()
+
+	for i, show := range shows {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-s.stopCh:
+				return result, nil
+			case <-limiter.C:
+			}
+		}
+
+		availability, err := s.fetchTicketAvailability(ctx, check, show.TicketURL)
+		if err != nil {
+			s.logger.Warn("ticket page check failed",
+				"show_id", show.ID,
+				"provider", check.Provider,
+				"error", err,
+			)
+			result.Failed++
+		}
+
+		changed, applyErr := s.applyTicketCheck(show, check.Provider, availability)
+		if applyErr != nil {
+			return result, applyErr
+		}
+		if err != nil {
+			continue
+		}
+
+		result.Checked++
+		switch {
+		case availability == ticketAvailabilityUnknown:
+			result.Unknown++
+		case !changed:
+		case availability == ticketSoldOut:
+			result.MarkedSold++
+		default:
+			result.MarkedAvail++
+		}
+	}
+	return result, nil
+}
+
+// applyTicketCheck stamps ticket_last_checked_at (also after a failed read,
+// so a broken page waits for its next recheck instead of being retried every
+// cycle) and, when the page gave a definite answer that differs from the
+// flag, flips is_sold_out and records the change. Reports whether the flag
+// changed.
+func (s *TicketAvailabilityService) applyTicketCheck(show ticketCheckShow, provider string, availability ticketAvailability) (bool, error) {
+	changed := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&catalogm.Show{}).Where("id = ?", show.ID).
+			UpdateColumn("ticket_last_checked_at", time.Now().UTC()).Error; err != nil {
+			return err
+		}
+		if availability == ticketAvailabilityUnknown {
+			return nil
+		}
+
+		soldOut := availability == ticketSoldOut
+		// Conditional on the current value, so a flag changed by hand since
+		// the show was loaded isn't recorded as changed twice.
+		res := tx.Model(&catalogm.Show{}).
+			Where("id = ? AND is_sold_out <> ?", show.ID, soldOut).
+			Update("is_sold_out", soldOut)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		changed = true
+		return recordTicketStatusChange(tx, show.ID, soldOut, catalogm.TicketStatusSourceTicketCheck, &provider)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record ticket check for show %d: %w", show.ID, err)
+	}
+	if changed {
+		s.logger.Info("ticket availability changed",
+			"show_id", show.ID,
+			"provider", provider,
+			"sold_out", availability == ticketSoldOut,
+		)
+	}
+	return changed, nil
+}
+
+// recordTicketStatusChange appends a change to the show's sold-out history.
+func recordTicketStatusChange(tx *gorm.DB, showID uint, isSoldOut bool, source string, provider *string) error {
+	return tx.Create(&catalogm.ShowTicketStatusChange{
+		ShowID:    showID,
+		IsSoldOut: isSoldOut,
+		Source:    source,
+		Provider:  provider,
+	}).Error
+}
+
+// GetShowTicketStatus returns a show's sold-out flag, when its ticket page
+// was last checked, and the flag's recent history, newest first.
+func (s *ShowService) GetShowTicketStatus(showID uint) (*contracts.ShowTicketStatusResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	if err := s.db.First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(showID)
+		}
+		return nil, fmt.Errorf("failed to find show: %w", err)
+	}
+
+	var changes []catalogm.ShowTicketStatusChange
+	if err := s.db.Where("show_id = ?", showID).
+		Order("created_at DESC, id DESC").
+		Limit(ticketStatusHistoryLimit).
+		Find(&changes).Error; err != nil {
+		return nil, fmt.Errorf("failed to load ticket status history: %w", err)
+	}
+
+	resp := &contracts.ShowTicketStatusResponse{
+		ShowID:         show.ID,
+		TicketURL:      show.TicketURL,
+		TicketProvider: show.TicketProvider,
+		IsSoldOut:      show.IsSoldOut,
+		LastCheckedAt:  show.TicketLastCheckedAt,
+		Changes:        make([]contracts.ShowTicketStatusChangeResponse, len(changes)),
+	}
+	for i, c := range changes {
+		resp.Changes[i] = contracts.ShowTicketStatusChangeResponse{
+			IsSoldOut: c.IsSoldOut,
+			Source:    c.Source,
+			Provider:  c.Provider,
+			CreatedAt: c.CreatedAt,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,319 @@
+package catalog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func ticketPage(jsonLD ...string) []byte {
+	page := "<html><head><title>Show</title>"
+	for _, doc := range jsonLD {
+		page += `<script type="application/ld+json">` + doc + `</script>`
+	}
+	return []byte(page + "</head><body>Tickets</body></html>")
+}
+
+func TestParseTicketAvailability(t *testing.T) {
+	cases := []struct {
+		name string
+		page []byte
+		want ticketAvailability
+	}{
+		{
+			name: "single offer in stock",
+			page: ticketPage(`{"@type":"MusicEvent","offers":{"@type":"Offer","availability":"https://schema.org/InStock"}}`),
+			want: ticketAvailable,
+		},
+		{
+			name: "single offer sold out",
+			page: ticketPage(`{"@type":"MusicEvent","offers":{"@type":"Offer","availability":"http://schema.org/SoldOut"}}`),
+			want: ticketSoldOut,
+		},
+		{
+			name: "one ticket type left",
+			page: ticketPage(`{"@type":"MusicEvent","offers":[{"availability":"SoldOut"},{"availability":"LimitedAvailability"}]}`),
+			want: ticketAvailable,
+		},
+		{
+			name: "every ticket type gone",
+			page: ticketPage(`{"@type":"MusicEvent","offers":[{"availability":"SoldOut"},{"availability":"OutOfStock"}]}`),
+			want: ticketSoldOut,
+		},
+		{
+			name: "offers under @graph",
+			page: ticketPage(`{"@graph":[{"@type":"Organization"},{"@type":"Event","offers":{"@type":"AggregateOffer","availability":"https://schema.org/SoldOut"}}]}`),
+			want: ticketSoldOut,
+		},
+		{
+			name: "sold out across several scripts",
+			page: ticketPage(`{"@type":"BreadcrumbList"}`, `[{"@type":"Event","offers":{"availability":"https://schema.org/SoldOut"}}]`),
+			want: ticketSoldOut,
+		},
+		{
+			name: "no availability",
+			page: ticketPage(`{"@type":"MusicEvent","offers":{"price":"20"}}`),
+			want: ticketAvailabilityUnknown,
+		},
+		{
+			name: "unrecognised availability",
+			page: ticketPage(`{"@type":"MusicEvent","offers":{"availability":"https://schema.org/Discontinued"}}`),
+			want: ticketAvailabilityUnknown,
+		},
+		{
+			name: "malformed JSON-LD",
+			page: ticketPage(`{"@type":"MusicEvent","offers":`),
+			want: ticketAvailabilityUnknown,
+		},
+		{
+			name: "no JSON-LD",
+			page: []byte("<html><body>SOLD OUT</body></html>"),
+			want: ticketAvailabilityUnknown,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseTicketAvailability(tc.page))
+		})
+	}
+}
+
+func TestTicketHostMatches(t *testing.T) {
+	hosts := []string{"dice.fm", "eventbrite.com"}
+	assert.True(t, ticketHostMatches("dice.fm", hosts))
+	assert.True(t, ticketHostMatches("link.dice.fm", hosts))
+	assert.True(t, ticketHostMatches("WWW.Eventbrite.com", hosts))
+	assert.False(t, ticketHostMatches("notdice.fm", hosts))
+	assert.False(t, ticketHostMatches("dice.fm.example.com", hosts))
+	assert.False(t, ticketHostMatches("example.com", hosts))
+}
+
+func TestDefaultTicketProviderChecks(t *testing.T) {
+	t.Setenv("TICKET_CHECK_DICE_RECHECK_HOURS", "0")
+	t.Setenv("TICKET_CHECK_EVENTBRITE_REQUEST_INTERVAL_SECONDS", "30")
+
+	checks := map[string]ticketProviderCheck{}
+	for _, c := range defaultTicketProviderChecks() {
+		checks[c.Provider] = c
+	}
+
+	require.Len(t, checks, 3)
+	assert.Equal(t, time.Duration(0), checks[catalogm.TicketProviderDice].RecheckInterval, "0 disables the provider")
+	assert.Equal(t, 30*time.Second, checks[catalogm.TicketProviderEventbrite].RequestInterval)
+	assert.Equal(t, 6*time.Hour, checks[catalogm.TicketProviderSeeTickets].RecheckInterval)
+	assert.Equal(t, 5*time.Second, checks[catalogm.TicketProviderSeeTickets].RequestInterval)
+}
+
+func TestFetchTicketAvailability(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sold-out":
+			_, _ = w.Write(ticketPage(`{"offers":{"availability":"https://schema.org/SoldOut"}}`))
+		case "/gone":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_, _ = w.Write(ticketPage(`{"offers":{"availability":"https://schema.org/InStock"}}`))
+		}
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	svc := newTicketAvailabilityService(nil, server.Client(), nil, time.Hour, 10)
+	check := ticketProviderCheck{Provider: catalogm.TicketProviderDice, Hosts: []string{serverURL.Hostname()}}
+
+	got, err := svc.fetchTicketAvailability(context.Background(), check, server.URL+"/sold-out")
+	require.NoError(t, err)
+	assert.Equal(t, ticketSoldOut, got)
+
+	got, err = svc.fetchTicketAvailability(context.Background(), check, server.URL+"/on-sale")
+	require.NoError(t, err)
+	assert.Equal(t, ticketAvailable, got)
+
+	_, err = svc.fetchTicketAvailability(context.Background(), check, server.URL+"/gone")
+	assert.Error(t, err)
+
+	_, err = svc.fetchTicketAvailability(context.Background(), check, "https://example.com/event")
+	assert.ErrorContains(t, err, "not a dice host")
+
+	_, err = svc.fetchTicketAvailability(context.Background(), check, "ftp://"+serverURL.Host+"/event")
+	assert.Error(t, err)
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type TicketAvailabilityIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	server *httptest.Server
+
+	mu    sync.Mutex
+	pages map[string][]byte // path -> page
+	hits  map[string]int
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SharedTestPostgres(suite.T())
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.mu.Lock()
+		defer suite.mu.Unlock()
+		suite.hits[r.URL.Path]++
+		page, ok := suite.pages[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(page)
+	}))
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) TearDownSuite() {
+	suite.server.Close()
+}
+
+// SetupTest runs each test in its own rolled-back transaction.
+func (suite *TicketAvailabilityIntegrationTestSuite) SetupTest() {
+	suite.db = testutil.BeginTestTx(suite.T(), suite.testDB.DB)
+	suite.pages = map[string][]byte{}
+	suite.hits = map[string]int{}
+}
+
+func TestTicketAvailabilityIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(TicketAvailabilityIntegrationTestSuite))
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) service(recheck time.Duration) *TicketAvailabilityService {
+	serverURL, _ := url.Parse(suite.server.URL)
+	return newTicketAvailabilityService(suite.db, suite.server.Client(), []ticketProviderCheck{{
+		Provider:        catalogm.TicketProviderDice,
+		Hosts:           []string{serverURL.Hostname()},
+		RecheckInterval: recheck,
+		RequestInterval: time.Millisecond,
+	}}, time.Hour, 100)
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) createShow(path string, opts ...func(*catalogm.Show)) *catalogm.Show {
+	f := testutil.CreateShow(suite.T(), suite.db, func(f *testutil.ShowFixture) {
+		ticketURL := suite.server.URL + path
+		provider := catalogm.TicketProviderDice
+		f.Show.TicketURL = &ticketURL
+		f.Show.TicketProvider = &provider
+		for _, opt := range opts {
+			opt(&f.Show)
+		}
+	})
+	return &f.Show
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) reload(show *catalogm.Show) *catalogm.Show {
+	var fresh catalogm.Show
+	suite.Require().NoError(suite.db.First(&fresh, show.ID).Error)
+	return &fresh
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) TestMarksSoldOutAndExpires() {
+	show := suite.createShow("/cursive")
+	suite.pages["/cursive"] = ticketPage(`{"offers":{"availability":"https://schema.org/SoldOut"}}`)
+	svc := suite.service(time.Hour)
+
+	result := svc.RunCheckCycle(context.Background())
+	suite.GreaterOrEqual(result.MarkedSold, 1)
+	fresh := suite.reload(show)
+	suite.True(fresh.IsSoldOut)
+	suite.Require().NotNil(fresh.TicketLastCheckedAt)
+
+	// Still fresh: not re-read.
+	svc.RunCheckCycle(context.Background())
+	suite.Equal(1, suite.hits["/cursive"])
+
+	// Tickets come back; once the check is stale the flag clears.
+	suite.pages["/cursive"] = ticketPage(`{"offers":{"availability":"https://schema.org/InStock"}}`)
+	suite.Require().NoError(suite.db.Model(&catalogm.Show{}).Where("id = ?", show.ID).
+		UpdateColumn("ticket_last_checked_at", time.Now().Add(-2*time.Hour)).Error)
+	svc.RunCheckCycle(context.Background())
+	suite.False(suite.reload(show).IsSoldOut)
+
+	status, err := NewShowService(suite.db).GetShowTicketStatus(show.ID)
+	suite.Require().NoError(err)
+	suite.False(status.IsSoldOut)
+	suite.NotNil(status.LastCheckedAt)
+	suite.Require().Len(status.Changes, 2)
+	suite.False(status.Changes[0].IsSoldOut, "newest first")
+	suite.True(status.Changes[1].IsSoldOut)
+	suite.Equal(catalogm.TicketStatusSourceTicketCheck, status.Changes[0].Source)
+	suite.Require().NotNil(status.Changes[0].Provider)
+	suite.Equal(catalogm.TicketProviderDice, *status.Changes[0].Provider)
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) TestUnreadablePageLeavesFlag() {
+	show := suite.createShow("/missing", func(s *catalogm.Show) { s.IsSoldOut = true })
+	unknown := suite.createShow("/no-offers", func(s *catalogm.Show) { s.IsSoldOut = true })
+	suite.pages["/no-offers"] = ticketPage(`{"@type":"MusicEvent"}`)
+
+	suite.service(time.Hour).RunCheckCycle(context.Background())
+
+	for _, s := range []*catalogm.Show{show, unknown} {
+		fresh := suite.reload(s)
+		suite.True(fresh.IsSoldOut)
+		suite.NotNil(fresh.TicketLastCheckedAt, "a failed check still waits for the recheck")
+	}
+	var changes int64
+	suite.Require().NoError(suite.db.Model(&catalogm.ShowTicketStatusChange{}).
+		Where("show_id IN ?", []uint{show.ID, unknown.ID}).Count(&changes).Error)
+	suite.Zero(changes)
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) TestSkipsShowsNotDue() {
+	suite.createShow("/past", func(s *catalogm.Show) { s.EventDate = time.Now().Add(-24 * time.Hour) })
+	suite.createShow("/cancelled", func(s *catalogm.Show) { s.IsCancelled = true })
+	suite.createShow("/pending", func(s *catalogm.Show) { s.Status = catalogm.ShowStatusPending })
+	for _, path := range []string{"/past", "/cancelled", "/pending"} {
+		suite.pages[path] = ticketPage(`{"offers":{"availability":"https://schema.org/SoldOut"}}`)
+	}
+
+	suite.service(time.Hour).RunCheckCycle(context.Background())
+	suite.service(0).RunCheckCycle(context.Background())
+
+	suite.Empty(suite.hits)
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) TestManualChangesAreRecorded() {
+	show := suite.createShow("/manual")
+	showSvc := NewShowService(suite.db)
+
+	_, err := showSvc.SetShowSoldOut(show.ID, true)
+	suite.Require().NoError(err)
+	_, err = showSvc.SetShowSoldOut(show.ID, true)
+	suite.Require().NoError(err)
+
+	status, err := showSvc.GetShowTicketStatus(show.ID)
+	suite.Require().NoError(err)
+	suite.True(status.IsSoldOut)
+	suite.Nil(status.LastCheckedAt)
+	suite.Require().Len(status.Changes, 1, "only changes are recorded")
+	suite.Equal(catalogm.TicketStatusSourceManual, status.Changes[0].Source)
+	suite.Nil(status.Changes[0].Provider)
+}
+
+func (suite *TicketAvailabilityIntegrationTestSuite) TestGetShowTicketStatus_NotFound() {
+	_, err := NewShowService(suite.db).GetShowTicketStatus(999999)
+	suite.Error(err)
+}
@@ -0,0 +1,154 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Reading availability off a ticket page. The supported providers publish
+// the event as schema.org JSON-LD, with an offer (or aggregate offer) per
+// ticket type whose availability is e.g. "https://schema.org/InStock" or
+// ".../SoldOut". That markup is there for search engines, so it is far more
+// stable than the page's visible HTML.
+
+// ticketAvailability is what a ticket page says about its tickets.
+type ticketAvailability int
+
+const (
+	ticketAvailabilityUnknown ticketAvailability = iota // No offers, or none with a recognised availability
+	ticketAvailable                                     // At least one ticket type is on sale
+	ticketSoldOut                                       // Every ticket type with an availability is sold out
+)
+
+// ticketPageMaxBytes caps how much of a ticket page is read.
+const ticketPageMaxBytes = 2 << 20
+
+var jsonLDScriptRe = regexp.MustCompile(`(?is)<script[^>]*type\s*=\s*["']application/ld\+json["'][^>]*>(.*?)</script>`)
+
+// schema.org ItemAvailability values, by name, that mean tickets can be had
+// and that mean they can't. Anything else (Discontinued, an empty string)
+// says nothing either way.
+var (
+	ticketAvailableValues = map[string]bool{
+		"instock":             true,
+		"limitedavailability": true,
+		"onlineonly":          true,
+		"instoreonly":         true,
+		"preorder":            true,
+		"presale":             true,
+		"backorder":           true,
+	}
+	ticketSoldOutValues = map[string]bool{
+		"soldout":    true,
+		"outofstock": true,
+	}
+)
+
+// fetchTicketAvailability reads a show's ticket page. Only URLs on the
+// provider's hosts are fetched, so a mislabelled ticket_provider can't point
+// the job at an arbitrary site.
+func (s *TicketAvailabilityService) fetchTicketAvailability(ctx context.Context, check ticketProviderCheck, rawURL string) (ticketAvailability, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return ticketAvailabilityUnknown, fmt.Errorf("invalid ticket URL %q", rawURL)
+	}
+	if !ticketHostMatches(u.Hostname(), check.Hosts) {
+		return ticketAvailabilityUnknown, fmt.Errorf("ticket URL host %q is not a %s host", u.Hostname(), check.Provider)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return ticketAvailabilityUnknown, fmt.Errorf("creating ticket page request: %w", err)
+	}
+	req.Header.Set("User-Agent", ticketCheckUserAgent)
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return ticketAvailabilityUnknown, fmt.Errorf("fetching ticket page: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // deferred Close; nothing actionable on failure
+
+	if resp.StatusCode != http.StatusOK {
+		return ticketAvailabilityUnknown, fmt.Errorf("ticket page returned status %d", resp.StatusCode)
+	}
+
+	page, err := io.ReadAll(io.LimitReader(resp.Body, ticketPageMaxBytes))
+	if err != nil {
+		return ticketAvailabilityUnknown, fmt.Errorf("reading ticket page: %w", err)
+	}
+	return parseTicketAvailability(page), nil
+}
+
+// ticketHostMatches reports whether host is one of hosts or a subdomain of
+// one.
+func ticketHostMatches(host string, hosts []string) bool {
+	host = strings.ToLower(host)
+	for _, h := range hosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTicketAvailability reads the offers in a page's JSON-LD. Any ticket
+// type on sale makes the show available; it is sold out only when every
+// offer with a recognised availability is sold out.
+func parseTicketAvailability(page []byte) ticketAvailability {
+	var available, soldOut bool
+	for _, m := range jsonLDScriptRe.FindAllSubmatch(page, -1) {
+		var doc interface{}
+		if err := json.Unmarshal(m[1], &doc); err != nil {
+			continue
+		}
+		for _, value := range collectAvailability(doc, nil) {
+			name := strings.ToLower(value)
+			if i := strings.LastIndex(name, "/"); i >= 0 {
+				name = name[i+1:]
+			}
+			switch {
+			case ticketAvailableValues[name]:
+				available = true
+			case ticketSoldOutValues[name]:
+				soldOut = true
+			}
+		}
+	}
+
+	switch {
+	case available:
+		return ticketAvailable
+	case soldOut:
+		return ticketSoldOut
+	default:
+		return ticketAvailabilityUnknown
+	}
+}
+
+// collectAvailability appends every "availability" string found anywhere
+// in a decoded JSON-LD document (offers are nested under the event, under
+// @graph, or in arrays, depending on the provider).
+func collectAvailability(v interface{}, out []string) []string {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		for key, child := range node {
+			if s, ok := child.(string); ok && key == "availability" {
+				out = append(out, s)
+				continue
+			}
+			out = collectAvailability(child, out)
+		}
+	case []interface{}:
+		for _, child := range node {
+			out = collectAvailability(child, out)
+		}
+	}
+	return out
+}
@@ -21,6 +21,9 @@ import (
 type VenueService struct {
 	db       *gorm.DB
 	geocoder geo.Geocoder
+	// addressGeocoder refines coordinates to street level (optional; see
+	// SetAddressGeocoder).
+	addressGeocoder geo.AddressGeocoder
 }
 
 // NewVenueService creates a new venue service
@@ -44,6 +47,7 @@ func (s *VenueService) applyGeocoding(v *catalogm.Venue) {
 		country = *v.Country
 	}
 	v.Latitude, v.Longitude, v.Timezone = geo.LookupPointers(s.geocoder, v.City, v.State, country)
+	v.GeocodePrecision = catalogm.CentroidGeocodePrecision(v.Latitude)
 	v.Metro = geo.MetroPointer(s.geocoder, v.City, v.State, country)
 }
 
@@ -72,20 +76,31 @@ func (s *VenueService) CreateVenue(req *contracts.CreateVenueRequest, isAdmin bo
 		return count > 0
 	})
 
+	// Operating info treats "" as unknown, as UpdateVenue does.
+	if req.AccessibilityNotes != nil && *req.AccessibilityNotes == "" {
+		req.AccessibilityNotes = nil
+	}
+	if req.DoorTime != nil && *req.DoorTime == "" {
+		req.DoorTime = nil
+	}
+
 	// Create the venue - verified if created by admin, unverified otherwise
 	venue := &catalogm.Venue{
-		Name:        req.Name,
-		Slug:        &slug,
-		Address:     req.Address,
-		City:        req.City,
-		State:       req.State,
-		Country:     req.Country,
-		Zipcode:     req.Zipcode,
-		Capacity:    req.Capacity,
-		Description: req.Description,
-		ImageURL:    req.ImageURL,
-		Verified:    isAdmin, // Admins create verified venues, non-admins require approval
-		SubmittedBy: req.SubmittedBy,
+		Name:               req.Name,
+		Slug:               &slug,
+		Address:            req.Address,
+		City:               req.City,
+		State:              req.State,
+		Country:            req.Country,
+		Zipcode:            req.Zipcode,
+		Capacity:           req.Capacity,
+		AccessibilityNotes: req.AccessibilityNotes,
+		AllAges:            req.AllAges,
+		DoorTime:           req.DoorTime,
+		Description:        req.Description,
+		ImageURL:           req.ImageURL,
+		Verified:           isAdmin, // Admins create verified venues, non-admins require approval
+		SubmittedBy:        req.SubmittedBy,
 		Social: catalogm.Social{
 			Instagram:  req.Instagram,
 			Facebook:   req.Facebook,
@@ -94,6 +109,7 @@ func (s *VenueService) CreateVenue(req *contracts.CreateVenueRequest, isAdmin bo
 			Spotify:    req.Spotify,
 			SoundCloud: req.SoundCloud,
 			Bandcamp:   req.Bandcamp,
+			TikTok:     req.TikTok,
 			Website:    req.Website,
 		},
 	}
@@ -103,6 +119,7 @@ func (s *VenueService) CreateVenue(req *contracts.CreateVenueRequest, isAdmin bo
 	if err := s.db.Create(venue).Error; err != nil {
 		return nil, fmt.Errorf("failed to create venue: %w", err)
 	}
+	s.QueueAddressGeocode(venue.ID)
 
 	return s.buildVenueResponse(venue), nil
 }
@@ -133,14 +150,30 @@ func (s *VenueService) GetVenueBySlug(slug string) (*contracts.VenueDetailRespon
 
 	var venue catalogm.Venue
 	err := s.db.Where("slug = ?", slug).First(&venue).Error
+	if err == nil {
+		return s.buildVenueResponse(&venue), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+
+	// Fall back to slug history so links to a merged venue resolve.
+	venueID, err := resolveSlugRedirect(s.db, catalogm.SlugRedirectEntityVenue, slug)
 	if err != nil {
+		return nil, err
+	}
+	if venueID == 0 {
+		return nil, apperrors.ErrVenueNotFound(0)
+	}
+	if err := s.db.First(&venue, venueID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, apperrors.ErrVenueNotFound(0)
 		}
 		return nil, fmt.Errorf("failed to get venue: %w", err)
 	}
-
-	return s.buildVenueResponse(&venue), nil
+	resp := s.buildVenueResponse(&venue)
+	resp.CanonicalSlug = resp.Slug
+	return resp, nil
 }
 
 // GetVenues retrieves venues with optional filtering
@@ -223,9 +256,10 @@ func (s *VenueService) UpdateVenue(venueID uint, req *contracts.UpdateVenueReque
 
 	// Translate the typed request into a GORM update map. Only non-nil fields
 	// are written so omitted fields stay unchanged. Name/City/State are NOT
-	// NULL columns written verbatim; Description and ImageURL are nullable and
-	// normalize empty input to SQL NULL. Address/Country/Zipcode and the social
-	// fields are written through as-is to preserve prior behavior.
+	// NULL columns written verbatim; Description, ImageURL, AccessibilityNotes
+	// and DoorTime are nullable and normalize empty input to SQL NULL.
+	// Address/Country/Zipcode and the social fields are written through as-is
+	// to preserve prior behavior.
 	updates := map[string]interface{}{}
 	if req.Name != nil {
 		updates["name"] = *req.Name
@@ -248,6 +282,15 @@ func (s *VenueService) UpdateVenue(venueID uint, req *contracts.UpdateVenueReque
 	if req.Capacity != nil {
 		updates["capacity"] = *req.Capacity
 	}
+	if req.AccessibilityNotes != nil {
+		updates["accessibility_notes"] = utils.NilIfEmpty(*req.AccessibilityNotes)
+	}
+	if req.AllAges != nil {
+		updates["all_ages"] = *req.AllAges
+	}
+	if req.DoorTime != nil {
+		updates["door_time"] = utils.NilIfEmpty(*req.DoorTime)
+	}
 	if req.Instagram != nil {
 		updates["instagram"] = *req.Instagram
 	}
@@ -269,6 +312,9 @@ func (s *VenueService) UpdateVenue(venueID uint, req *contracts.UpdateVenueReque
 	if req.Bandcamp != nil {
 		updates["bandcamp"] = *req.Bandcamp
 	}
+	if req.TikTok != nil {
+		updates["tiktok"] = *req.TikTok
+	}
 	if req.Website != nil {
 		updates["website"] = *req.Website
 	}
@@ -279,11 +325,24 @@ func (s *VenueService) UpdateVenue(venueID uint, req *contracts.UpdateVenueReque
 		updates["image_url"] = utils.NilIfEmpty(*req.ImageURL)
 	}
 
+	// A non-empty Timezone is an admin override that geocoding then leaves
+	// alone; an empty one clears the override and re-infers the zone from the
+	// venue's location below.
+	manualTimezone := currentVenue.TimezoneManual
+	if req.Timezone != nil {
+		manualTimezone = *req.Timezone != ""
+		updates["timezone_manual"] = manualTimezone
+		if manualTimezone {
+			updates["timezone"] = *req.Timezone
+		}
+	}
+
 	// Re-geocode when any location field changes so latitude/longitude/timezone
 	// stay consistent with the new city/state/country (PSY-985). Reuses the
 	// create-path resolver (applyGeocoding) on the effective post-update values
 	// (checkCity already coalesced city); a miss clears all three to NULL (below).
-	if req.City != nil || req.State != nil || req.Country != nil {
+	locationChanged := req.City != nil || req.State != nil || req.Country != nil
+	if locationChanged || (req.Timezone != nil && !manualTimezone) {
 		effective := catalogm.Venue{City: checkCity, State: currentVenue.State, Country: currentVenue.Country}
 		if req.State != nil {
 			effective.State = *req.State
@@ -298,10 +357,17 @@ func (s *VenueService) UpdateVenue(venueID uint, req *contracts.UpdateVenueReque
 		// stale timezone/coordinates (mirrors the create path's miss->NULL). metro
 		// is a sibling here — forward it too, or a relocated venue keeps the OLD
 		// metro's CBSA and is mis-rostered in the Atlas scene (PSY-1255 step B).
-		updates["latitude"] = effective.Latitude
-		updates["longitude"] = effective.Longitude
-		updates["timezone"] = effective.Timezone
-		updates["metro"] = effective.Metro
+		// Clearing the override alone only re-infers the timezone, so an
+		// address-precision point isn't reset to the city centroid.
+		if locationChanged {
+			updates["latitude"] = effective.Latitude
+			updates["longitude"] = effective.Longitude
+			updates["geocode_precision"] = effective.GeocodePrecision
+			updates["metro"] = effective.Metro
+		}
+		if !manualTimezone {
+			updates["timezone"] = effective.Timezone
+		}
 	}
 
 	// Update the venue
@@ -311,6 +377,11 @@ func (s *VenueService) UpdateVenue(venueID uint, req *contracts.UpdateVenueReque
 			return nil, fmt.Errorf("failed to update venue: %w", err)
 		}
 	}
+	// A new street address (or a relocation, which just reset the point to
+	// the city centroid) needs a fresh street-level lookup.
+	if locationChanged || req.Address != nil || req.Zipcode != nil {
+		s.QueueAddressGeocode(venueID)
+	}
 
 	return s.GetVenue(venueID)
 }
@@ -356,6 +427,8 @@ func (venueService *VenueService) SearchVenues(query string) ([]*contracts.Venue
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(venueService.db)
+
 	if query == "" {
 		return []*contracts.VenueDetailResponse{}, nil
 	}
@@ -364,7 +437,7 @@ func (venueService *VenueService) SearchVenues(query string) ([]*contracts.Venue
 	var err error
 
 	if len(query) <= 2 {
-		err = venueService.db.
+		err = replica.
 			Where("LOWER(name) LIKE LOWER(?)", shared.LikePrefixPattern(query)).
 			Order("name ASC").
 			Limit(10).
@@ -372,7 +445,7 @@ func (venueService *VenueService) SearchVenues(query string) ([]*contracts.Venue
 	} else {
 		// `name % ?` is the pg_trgm similarity operator (NOT a LIKE pattern),
 		// so its argument stays raw. The ILIKE branch escapes via LikePattern.
-		err = venueService.db.
+		err = replica.
 			Select("venues.*, similarity(name, ?) as sim_score", query).
 			Where("name ILIKE ? OR name % ?", shared.LikePattern(query), query).
 			Order("sim_score DESC, name ASC").
@@ -508,6 +581,9 @@ func (s *VenueService) VerifyVenue(venueID uint) (*contracts.VenueDetailResponse
 	if err := s.db.Model(&venue).Updates(updates).Error; err != nil {
 		return nil, fmt.Errorf("failed to verify venue: %w", err)
 	}
+	// Venues submitted with a show are created unverified inside the show's
+	// transaction; approval is their first chance at a street-level point.
+	s.QueueAddressGeocode(venueID)
 
 	// Reload to get updated data
 	if err := s.db.First(&venue, venueID).Error; err != nil {
@@ -532,22 +608,26 @@ func (s *VenueService) buildVenueResponse(venue *catalogm.Venue) *contracts.Venu
 	}
 
 	return &contracts.VenueDetailResponse{
-		ID:          venue.ID,
-		Slug:        slug,
-		Name:        venue.Name,
-		Address:     address,
-		City:        venue.City,
-		State:       venue.State,
-		Country:     venue.Country,
-		Latitude:    venue.Latitude,
-		Longitude:   venue.Longitude,
-		Timezone:    venue.Timezone,
-		Zipcode:     zipcode,
-		Capacity:    venue.Capacity, // not redacted — capacity is not sensitive
-		Description: venue.Description,
-		ImageURL:    venue.ImageURL,
-		Verified:    venue.Verified,
-		SubmittedBy: venue.SubmittedBy,
+		ID:                 venue.ID,
+		Slug:               slug,
+		Name:               venue.Name,
+		Address:            address,
+		City:               venue.City,
+		State:              venue.State,
+		Country:            venue.Country,
+		Latitude:           venue.Latitude,
+		Longitude:          venue.Longitude,
+		Timezone:           venue.Timezone,
+		TimezoneManual:     venue.TimezoneManual,
+		Zipcode:            zipcode,
+		Capacity:           venue.Capacity, // not redacted — capacity is not sensitive
+		AccessibilityNotes: venue.AccessibilityNotes,
+		AllAges:            venue.AllAges,
+		DoorTime:           venue.DoorTime,
+		Description:        venue.Description,
+		ImageURL:           venue.ImageURL,
+		Verified:           venue.Verified,
+		SubmittedBy:        venue.SubmittedBy,
 		Social: contracts.SocialResponse{
 			Instagram:  venue.Social.Instagram,
 			Facebook:   venue.Social.Facebook,
@@ -556,6 +636,7 @@ func (s *VenueService) buildVenueResponse(venue *catalogm.Venue) *contracts.Venu
 			Spotify:    venue.Social.Spotify,
 			SoundCloud: venue.Social.SoundCloud,
 			Bandcamp:   venue.Social.Bandcamp,
+			TikTok:     venue.Social.TikTok,
 			Website:    venue.Social.Website,
 		},
 		CreatedAt: venue.CreatedAt,
@@ -579,18 +660,20 @@ func (s *VenueService) GetVenuesWithShowCounts(filters contracts.VenueListFilter
 		return nil, 0, fmt.Errorf("database not initialized")
 	}
 
+	replica := shared.ReadReplica(s.db)
+
 	now := time.Now().UTC()
 
 	// Build the base query with show count subquery
 	// This allows us to sort by show count while also paginating correctly
-	subquery := s.db.Table("show_venues").
+	subquery := replica.Table("show_venues").
 		Select("show_venues.venue_id, COUNT(*) as show_count").
 		Joins("JOIN shows ON show_venues.show_id = shows.id").
-		Where("shows.event_date >= ? AND shows.status = ?", now, catalogm.ShowStatusApproved).
+		Where("shows.event_date >= ? AND shows.status = ? AND shows.deleted_at IS NULL", now, catalogm.ShowStatusApproved).
 		Group("show_venues.venue_id")
 
 	// Start with verified venues only for public display
-	query := s.db.Table("venues").
+	query := replica.Table("venues").
 		Select("venues.*, COALESCE(sc.show_count, 0) as upcoming_show_count").
 		Joins("LEFT JOIN (?) as sc ON venues.id = sc.venue_id", subquery).
 		Where("venues.verified = ?", true)
@@ -616,12 +699,15 @@ func (s *VenueService) GetVenuesWithShowCounts(filters contracts.VenueListFilter
 			query = query.Where("venues.city = ?", filters.City)
 		}
 	}
+	if filters.Metro != "" {
+		query = query.Where("venues.metro = ?", filters.Metro)
+	}
 	tf := TagFilter{TagSlugs: filters.TagSlugs, MatchAny: filters.TagMatchAny}
-	query = ApplyTagFilter(query, s.db, catalogm.TagEntityVenue, "venues.id", tf)
+	query = ApplyTagFilter(query, replica, catalogm.TagEntityVenue, "venues.id", tf)
 
 	// Get total count of matching venues
 	var total int64
-	countQuery := s.db.Table("venues").Where("verified = ?", true)
+	countQuery := replica.Table("venues").Where("verified = ?", true)
 	if len(filters.Cities) > 0 {
 		var conditions []string
 		var args []interface{}
@@ -642,7 +728,10 @@ func (s *VenueService) GetVenuesWithShowCounts(filters contracts.VenueListFilter
 			countQuery = countQuery.Where("city = ?", filters.City)
 		}
 	}
-	countQuery = ApplyTagFilter(countQuery, s.db, catalogm.TagEntityVenue, "venues.id", tf)
+	if filters.Metro != "" {
+		countQuery = countQuery.Where("metro = ?", filters.Metro)
+	}
+	countQuery = ApplyTagFilter(countQuery, replica, catalogm.TagEntityVenue, "venues.id", tf)
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count venues: %w", err)
 	}
@@ -676,6 +765,36 @@ func (s *VenueService) GetUpcomingShowsForVenue(venueID uint, timezone string, l
 // timeFilter can be: "upcoming" (event_date >= today), "past" (event_date < today), or "all"
 // Only returns approved shows.
 func (s *VenueService) GetShowsForVenue(venueID uint, timezone string, limit int, timeFilter string) ([]*contracts.VenueShowResponse, int64, error) {
+	return s.GetShowsForVenuePage(venueID, timezone, limit, 0, timeFilter, 0)
+}
+
+// GetShowYearsForVenue counts a venue's approved shows per calendar year in
+// timezone, most recent year first, under the same timeFilter as
+// GetShowsForVenuePage.
+func (s *VenueService) GetShowYearsForVenue(venueID uint, timezone string, timeFilter string) ([]*contracts.ShowYearCount, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var venue catalogm.Venue
+	if err := s.db.First(&venue, venueID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrVenueNotFound(venueID)
+		}
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+
+	loc, startOfToday := archiveStartOfToday(timezone)
+	q := s.db.Table("show_venues").
+		Joins("JOIN shows ON show_venues.show_id = shows.id").
+		Where("show_venues.venue_id = ? AND shows.status = ? AND shows.deleted_at IS NULL", venueID, catalogm.ShowStatusApproved)
+	return showYearCounts(applyShowTimeFilter(q, timeFilter, startOfToday), loc)
+}
+
+// GetShowsForVenuePage retrieves one page of a venue's shows. year, when
+// non-zero, keeps only shows in that calendar year in the given timezone —
+// the archive pages pair it with timeFilter="past".
+func (s *VenueService) GetShowsForVenuePage(venueID uint, timezone string, limit, offset int, timeFilter string, year int) ([]*contracts.VenueShowResponse, int64, error) {
 	if s.db == nil {
 		return nil, 0, fmt.Errorf("database not initialized")
 	}
@@ -714,15 +833,20 @@ func (s *VenueService) GetShowsForVenue(venueID uint, timezone string, limit int
 		dateCondition = "shows.event_date >= ?"
 		orderDirection = "shows.event_date ASC" // Soonest upcoming shows first
 	}
+	// id tiebreak keeps offset pages stable across shows on the same date.
+	orderDirection += ", shows.id ASC"
 
 	// Count total shows matching the filter
 	var total int64
 	countQuery := s.db.Table("show_venues").
 		Joins("JOIN shows ON show_venues.show_id = shows.id").
-		Where("show_venues.venue_id = ? AND shows.status = ?", venueID, catalogm.ShowStatusApproved)
+		Where("show_venues.venue_id = ? AND shows.status = ? AND shows.deleted_at IS NULL", venueID, catalogm.ShowStatusApproved)
 	if dateCondition != "" {
 		countQuery = countQuery.Where(dateCondition, startOfTodayUTC)
 	}
+	if year > 0 {
+		countQuery = countQuery.Where(showYearSQL+" = ?", loc.String(), year)
+	}
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count shows: %w", err)
 	}
@@ -732,11 +856,14 @@ func (s *VenueService) GetShowsForVenue(venueID uint, timezone string, limit int
 	showQuery := s.db.Table("show_venues").
 		Select("show_venues.show_id").
 		Joins("JOIN shows ON show_venues.show_id = shows.id").
-		Where("show_venues.venue_id = ? AND shows.status = ?", venueID, catalogm.ShowStatusApproved)
+		Where("show_venues.venue_id = ? AND shows.status = ? AND shows.deleted_at IS NULL", venueID, catalogm.ShowStatusApproved)
 	if dateCondition != "" {
 		showQuery = showQuery.Where(dateCondition, startOfTodayUTC)
 	}
-	if err := showQuery.Order(orderDirection).Limit(limit).Pluck("show_venues.show_id", &showIDs).Error; err != nil {
+	if year > 0 {
+		showQuery = showQuery.Where(showYearSQL+" = ?", loc.String(), year)
+	}
+	if err := showQuery.Order(orderDirection).Limit(limit).Offset(offset).Pluck("show_venues.show_id", &showIDs).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to get show IDs: %w", err)
 	}
 
@@ -793,6 +920,7 @@ func (s *VenueService) GetShowsForVenue(venueID uint, timezone string, limit int
 				Spotify:    artist.Social.Spotify,
 				SoundCloud: artist.Social.SoundCloud,
 				Bandcamp:   artist.Social.Bandcamp,
+				TikTok:     artist.Social.TikTok,
 				Website:    artist.Social.Website,
 			}
 			var slug string
@@ -819,7 +947,7 @@ func (s *VenueService) GetShowsForVenue(venueID uint, timezone string, limit int
 			EventDate:      show.EventDate,
 			City:           show.City,
 			State:          show.State,
-			Price:          show.Price,
+			ShowPrice:      shared.ShowPriceOf(&show),
 			AgeRequirement: show.AgeRequirement,
 			Artists:        artists,
 		}
@@ -883,7 +1011,7 @@ func (s *VenueService) GetVenueGenreProfile(venueID uint) ([]contracts.GenreCoun
 		JOIN shows s ON s.id = sa.show_id
 		JOIN entity_tags et ON et.entity_type = 'artist' AND et.entity_id = sa.artist_id
 		JOIN tags t ON t.id = et.tag_id AND t.category = 'genre'
-		WHERE sv.venue_id = ? AND s.status = ?
+		WHERE sv.venue_id = ? AND s.status = ? AND s.deleted_at IS NULL
 	`, venueID, catalogm.ShowStatusApproved).Scan(&showCount).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to count tagged shows for venue: %w", err)
@@ -908,7 +1036,7 @@ func (s *VenueService) GetVenueGenreProfile(venueID uint) ([]contracts.GenreCoun
 		JOIN shows s ON s.id = sa.show_id
 		JOIN entity_tags et ON et.entity_type = 'artist' AND et.entity_id = sa.artist_id
 		JOIN tags t ON t.id = et.tag_id AND t.category = 'genre'
-		WHERE sv.venue_id = ? AND s.status = ?
+		WHERE sv.venue_id = ? AND s.status = ? AND s.deleted_at IS NULL
 		GROUP BY t.id, t.name, t.slug
 		ORDER BY count DESC
 		LIMIT 5
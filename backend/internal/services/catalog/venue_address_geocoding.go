@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/geo"
+	"psychic-homily-backend/internal/services/shared"
+)
+
+// venueAddressGeocodeTimeout bounds one provider call, including the
+// Nominatim 1 req/s queue.
+const venueAddressGeocodeTimeout = 30 * time.Second
+
+// SetAddressGeocoder wires the optional street-address geocoder. When set,
+// creating a venue, editing its location and verifying it queue a refinement
+// of the offline city centroid to a street-level point. Nil disables it.
+func (s *VenueService) SetAddressGeocoder(g geo.AddressGeocoder) {
+	s.addressGeocoder = g
+}
+
+// QueueAddressGeocode refines the venue's coordinates from its street address
+// off the request goroutine. A no-op without an address geocoder. Failures
+// are logged; the venue keeps its city centroid.
+func (s *VenueService) QueueAddressGeocode(venueID uint) {
+	if s.addressGeocoder == nil {
+		return
+	}
+	g, database := s.addressGeocoder, s.db
+	shared.GoSafe(context.Background(), "venue_address_geocode", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), venueAddressGeocodeTimeout)
+		defer cancel()
+		if err := GeocodeVenueAddressByID(ctx, database, g, venueID); err != nil {
+			slog.Warn("venue address geocode failed", "venue_id", venueID, "error", err)
+		}
+	})
+}
+
+// GeocodeVenueAddressByID looks the venue's street address up with g and, on
+// a hit, replaces its coordinates with the street-level point. Venues without
+// an address, and provider misses, keep their centroid and return nil.
+//
+// The write is conditioned on the address, city and state still matching what
+// was geocoded, so an edit that lands mid-lookup is never overwritten with
+// coordinates for the old location.
+func GeocodeVenueAddressByID(ctx context.Context, database *gorm.DB, g geo.AddressGeocoder, venueID uint) error {
+	if g == nil {
+		return nil
+	}
+	var venue catalogm.Venue
+	if err := database.Select("id", "address", "city", "state", "zipcode", "country").
+		First(&venue, venueID).Error; err != nil {
+		return fmt.Errorf("failed to load venue: %w", err)
+	}
+	address := strings.TrimSpace(derefString(venue.Address))
+	if address == "" {
+		return nil
+	}
+
+	point, ok, err := g.GeocodeAddress(ctx, geo.AddressQuery{
+		Street:  address,
+		City:    venue.City,
+		State:   venue.State,
+		Zipcode: derefString(venue.Zipcode),
+		Country: derefString(venue.Country),
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	return database.Model(&catalogm.Venue{}).
+		Where("id = ? AND address = ? AND city = ? AND state = ?", venue.ID, *venue.Address, venue.City, venue.State).
+		Updates(map[string]interface{}{
+			"latitude":          point.Latitude,
+			"longitude":         point.Longitude,
+			"geocode_precision": catalogm.GeocodePrecisionAddress,
+		}).Error
+}
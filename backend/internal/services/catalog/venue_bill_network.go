@@ -395,7 +395,7 @@ func (s *VenueService) queryVenueBillSourceRows(venueID uint, startDate, endDate
 		Select("sa.show_id AS show_id, s.event_date AS event_date, sa.artist_id AS artist_id").
 		Joins("JOIN shows s ON s.id = sa.show_id").
 		Joins("JOIN show_venues sv ON sv.show_id = sa.show_id").
-		Where("sv.venue_id = ? AND s.status = ?", venueID, catalogm.ShowStatusApproved)
+		Where("sv.venue_id = ? AND s.status = ? AND s.deleted_at IS NULL", venueID, catalogm.ShowStatusApproved)
 	if !startDate.IsZero() {
 		q = q.Where("s.event_date >= ?", startDate)
 	}
@@ -0,0 +1,382 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
+)
+
+// VenueClaimService handles venue claims and the venue_managers grants that
+// approving a claim creates.
+type VenueClaimService struct {
+	db *gorm.DB
+}
+
+// NewVenueClaimService creates a new venue claim service
+func NewVenueClaimService(database *gorm.DB) *VenueClaimService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &VenueClaimService{db: database}
+}
+
+// SubmitClaim files a pending claim on a venue.
+func (s *VenueClaimService) SubmitClaim(venueID, userID uint, evidence string) (*contracts.VenueClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	evidence = strings.TrimSpace(evidence)
+	if evidence == "" {
+		return nil, apperrors.ErrVenueClaimInvalid("evidence is required")
+	}
+	if utf8.RuneCountInString(evidence) > contracts.MaxVenueClaimEvidenceLength {
+		return nil, apperrors.ErrVenueClaimInvalid(fmt.Sprintf("evidence must be %d characters or fewer", contracts.MaxVenueClaimEvidenceLength))
+	}
+
+	var venueCount int64
+	if err := s.db.Model(&catalogm.Venue{}).Where("id = ?", venueID).Count(&venueCount).Error; err != nil {
+		return nil, apperrors.ErrVenueClaimInternal(err)
+	}
+	if venueCount == 0 {
+		return nil, apperrors.ErrVenueNotFound(venueID)
+	}
+
+	managed, err := s.IsVenueManager(userID, venueID)
+	if err != nil {
+		return nil, err
+	}
+	if managed {
+		return nil, apperrors.ErrVenueClaimConflict("you already manage this venue")
+	}
+
+	claim := &catalogm.VenueClaim{
+		VenueID:  venueID,
+		UserID:   userID,
+		Evidence: evidence,
+		Status:   catalogm.VenueClaimStatusPending,
+	}
+	if err := s.db.Create(claim).Error; err != nil {
+		// idx_venue_claims_pending: one pending claim per user and venue.
+		if shared.IsDuplicateKey(err) {
+			return nil, apperrors.ErrVenueClaimConflict("you already have a pending claim on this venue")
+		}
+		return nil, apperrors.ErrVenueClaimInternal(err)
+	}
+
+	return s.getClaim(claim.ID)
+}
+
+// GetUserClaims returns the user's own claims, newest first.
+func (s *VenueClaimService) GetUserClaims(userID uint) ([]*contracts.VenueClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var claims []catalogm.VenueClaim
+	err := s.preloadClaims(s.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&claims).Error
+	if err != nil {
+		return nil, apperrors.ErrVenueClaimInternal(err)
+	}
+	return toVenueClaimResponses(claims), nil
+}
+
+// ListClaims returns claims for the admin review queue, oldest first.
+func (s *VenueClaimService) ListClaims(status string, limit, offset int) ([]*contracts.VenueClaimResponse, int64, error) {
+	if s.db == nil {
+		return nil, 0, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := s.db.Model(&catalogm.VenueClaim{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.ErrVenueClaimInternal(err)
+	}
+
+	var claims []catalogm.VenueClaim
+	err := s.preloadClaims(query).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&claims).Error
+	if err != nil {
+		return nil, 0, apperrors.ErrVenueClaimInternal(err)
+	}
+	return toVenueClaimResponses(claims), total, nil
+}
+
+// ApproveClaim approves a pending claim and makes the claimant a manager of
+// the venue, in one transaction.
+func (s *VenueClaimService) ApproveClaim(claimID, reviewerID uint, note string) (*contracts.VenueClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		claim, err := reviewableClaim(tx, claimID)
+		if err != nil {
+			return err
+		}
+		if err := markClaimReviewed(tx, claim, catalogm.VenueClaimStatusApproved, reviewerID, note); err != nil {
+			return err
+		}
+
+		// A manager row can already exist when an admin approves a second
+		// claim for the same user; keep the original grant.
+		manager := &catalogm.VenueManager{
+			VenueID:   claim.VenueID,
+			UserID:    claim.UserID,
+			ClaimID:   &claim.ID,
+			GrantedBy: &reviewerID,
+		}
+		if err := tx.Where("venue_id = ? AND user_id = ?", claim.VenueID, claim.UserID).
+			FirstOrCreate(manager).Error; err != nil {
+			return apperrors.ErrVenueClaimInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getClaim(claimID)
+}
+
+// DenyClaim denies a pending claim with a reason shown to the claimant.
+func (s *VenueClaimService) DenyClaim(claimID, reviewerID uint, reason string) (*contracts.VenueClaimResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return nil, apperrors.ErrVenueClaimInvalid("a reason is required to deny a claim")
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		claim, err := reviewableClaim(tx, claimID)
+		if err != nil {
+			return err
+		}
+		return markClaimReviewed(tx, claim, catalogm.VenueClaimStatusDenied, reviewerID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getClaim(claimID)
+}
+
+// IsVenueManager reports whether the user manages the venue.
+func (s *VenueClaimService) IsVenueManager(userID, venueID uint) (bool, error) {
+	if s.db == nil {
+		return false, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var count int64
+	err := s.db.Model(&catalogm.VenueManager{}).
+		Where("venue_id = ? AND user_id = ?", venueID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, apperrors.ErrVenueClaimInternal(err)
+	}
+	return count > 0, nil
+}
+
+// IsShowVenueManager reports whether the user manages any venue the show is
+// at. Co-billed shows across venues can be flagged by any venue's manager.
+func (s *VenueClaimService) IsShowVenueManager(userID, showID uint) (bool, error) {
+	if s.db == nil {
+		return false, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var count int64
+	err := s.db.Table("show_venues").
+		Joins("JOIN venue_managers vm ON vm.venue_id = show_venues.venue_id").
+		Where("show_venues.show_id = ? AND vm.user_id = ?", showID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, apperrors.ErrVenueClaimInternal(err)
+	}
+	return count > 0, nil
+}
+
+// GetManagedVenues returns the venues the user manages, by venue name.
+func (s *VenueClaimService) GetManagedVenues(userID uint) ([]*contracts.VenueManagerResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var managers []catalogm.VenueManager
+	err := s.db.Preload("Venue").Preload("User").
+		Joins("JOIN venues ON venues.id = venue_managers.venue_id").
+		Where("venue_managers.user_id = ?", userID).
+		Order("venues.name ASC").
+		Find(&managers).Error
+	if err != nil {
+		return nil, apperrors.ErrVenueClaimInternal(err)
+	}
+	return toVenueManagerResponses(managers), nil
+}
+
+// ListVenueManagers returns the managers of a venue, oldest grant first.
+func (s *VenueClaimService) ListVenueManagers(venueID uint) ([]*contracts.VenueManagerResponse, error) {
+	if s.db == nil {
+		return nil, apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	var managers []catalogm.VenueManager
+	err := s.db.Preload("Venue").Preload("User").
+		Where("venue_id = ?", venueID).
+		Order("created_at ASC").
+		Find(&managers).Error
+	if err != nil {
+		return nil, apperrors.ErrVenueClaimInternal(err)
+	}
+	return toVenueManagerResponses(managers), nil
+}
+
+// RemoveVenueManager revokes a user's manager rights on a venue. The
+// approved claim is kept as history.
+func (s *VenueClaimService) RemoveVenueManager(venueID, userID uint) error {
+	if s.db == nil {
+		return apperrors.ErrVenueClaimInternal(fmt.Errorf("database not initialized"))
+	}
+
+	result := s.db.Where("venue_id = ? AND user_id = ?", venueID, userID).Delete(&catalogm.VenueManager{})
+	if result.Error != nil {
+		return apperrors.ErrVenueClaimInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrVenueManagerNotFound(venueID, userID)
+	}
+	return nil
+}
+
+// ──────────────────────────────────────────────
+// Helpers
+// ──────────────────────────────────────────────
+
+func (s *VenueClaimService) preloadClaims(query *gorm.DB) *gorm.DB {
+	return query.Preload("Venue").Preload("User").Preload("Reviewer")
+}
+
+func (s *VenueClaimService) getClaim(claimID uint) (*contracts.VenueClaimResponse, error) {
+	var claim catalogm.VenueClaim
+	if err := s.preloadClaims(s.db).First(&claim, claimID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrVenueClaimNotFound(claimID)
+		}
+		return nil, apperrors.ErrVenueClaimInternal(err)
+	}
+	return toVenueClaimResponse(&claim), nil
+}
+
+// reviewableClaim loads a claim for review, locking the row so two reviewers
+// cannot decide it concurrently.
+func reviewableClaim(tx *gorm.DB, claimID uint) (*catalogm.VenueClaim, error) {
+	var claim catalogm.VenueClaim
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&claim, claimID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrVenueClaimNotFound(claimID)
+		}
+		return nil, apperrors.ErrVenueClaimInternal(err)
+	}
+	if claim.Status != catalogm.VenueClaimStatusPending {
+		return nil, apperrors.ErrVenueClaimNotPending(string(claim.Status))
+	}
+	return &claim, nil
+}
+
+func markClaimReviewed(tx *gorm.DB, claim *catalogm.VenueClaim, status catalogm.VenueClaimStatus, reviewerID uint, note string) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":      status,
+		"reviewed_by": reviewerID,
+		"reviewed_at": now,
+		"updated_at":  now,
+	}
+	if note = strings.TrimSpace(note); note != "" {
+		updates["review_note"] = note
+	}
+	if err := tx.Model(claim).Updates(updates).Error; err != nil {
+		return apperrors.ErrVenueClaimInternal(err)
+	}
+	return nil
+}
+
+func toVenueClaimResponses(claims []catalogm.VenueClaim) []*contracts.VenueClaimResponse {
+	responses := make([]*contracts.VenueClaimResponse, len(claims))
+	for i := range claims {
+		responses[i] = toVenueClaimResponse(&claims[i])
+	}
+	return responses
+}
+
+func toVenueClaimResponse(claim *catalogm.VenueClaim) *contracts.VenueClaimResponse {
+	resp := &contracts.VenueClaimResponse{
+		ID:         claim.ID,
+		VenueID:    claim.VenueID,
+		VenueName:  claim.Venue.Name,
+		UserID:     claim.UserID,
+		UserName:   shared.ResolveUserName(&claim.User),
+		Evidence:   claim.Evidence,
+		Status:     string(claim.Status),
+		ReviewedBy: claim.ReviewedBy,
+		ReviewedAt: claim.ReviewedAt,
+		ReviewNote: claim.ReviewNote,
+		CreatedAt:  claim.CreatedAt,
+		UpdatedAt:  claim.UpdatedAt,
+	}
+	if claim.Venue.Slug != nil {
+		resp.VenueSlug = *claim.Venue.Slug
+	}
+	if claim.Reviewer != nil {
+		resp.ReviewerName = shared.ResolveUserName(claim.Reviewer)
+	}
+	return resp
+}
+
+func toVenueManagerResponses(managers []catalogm.VenueManager) []*contracts.VenueManagerResponse {
+	responses := make([]*contracts.VenueManagerResponse, len(managers))
+	for i := range managers {
+		m := &managers[i]
+		responses[i] = &contracts.VenueManagerResponse{
+			VenueID:   m.VenueID,
+			VenueName: m.Venue.Name,
+			UserID:    m.UserID,
+			UserName:  shared.ResolveUserName(&m.User),
+			ClaimID:   m.ClaimID,
+			GrantedBy: m.GrantedBy,
+			CreatedAt: m.CreatedAt,
+		}
+		if m.Venue.Slug != nil {
+			responses[i].VenueSlug = *m.Venue.Slug
+		}
+	}
+	return responses
+}
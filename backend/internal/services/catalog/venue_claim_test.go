@@ -0,0 +1,215 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestVenueClaimService_NilDatabase(t *testing.T) {
+	svc := &VenueClaimService{}
+
+	_, err := svc.SubmitClaim(1, 1, "I book the room")
+	var claimErr *apperrors.VenueClaimError
+	require.True(t, errors.As(err, &claimErr))
+	assert.Equal(t, apperrors.CodeVenueClaimInternal, claimErr.Code)
+
+	_, err = svc.IsVenueManager(1, 1)
+	assert.Error(t, err)
+	_, err = svc.IsShowVenueManager(1, 1)
+	assert.Error(t, err)
+	assert.Error(t, svc.RemoveVenueManager(1, 1))
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type VenueClaimServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *VenueClaimService
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.svc = NewVenueClaimService(suite.testDB.DB)
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+// TearDownTest cleans up data between tests for isolation
+func (suite *VenueClaimServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	// Delete in FK-safe order
+	_, _ = sqlDB.Exec("DELETE FROM venue_managers")
+	_, _ = sqlDB.Exec("DELETE FROM venue_claims")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestVenueClaimServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(VenueClaimServiceIntegrationTestSuite))
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) createUser(name string) *authm.User {
+	email := fmt.Sprintf("%s-%d@test.com", name, time.Now().UnixNano())
+	user := &authm.User{Email: &email, FirstName: &name, IsActive: true, EmailVerified: true}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	return user
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) createVenue(name string) *catalogm.Venue {
+	venue := &catalogm.Venue{Name: name, City: "Phoenix", State: "AZ", Verified: true}
+	suite.Require().NoError(suite.db.Create(venue).Error)
+	return venue
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) requireClaimCode(err error, code string) {
+	var claimErr *apperrors.VenueClaimError
+	suite.Require().ErrorAs(err, &claimErr)
+	suite.Equal(code, claimErr.Code)
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) TestSubmitClaim_Validation() {
+	user := suite.createUser("staff")
+	venue := suite.createVenue("Valley Bar")
+
+	_, err := suite.svc.SubmitClaim(venue.ID, user.ID, "   ")
+	suite.requireClaimCode(err, apperrors.CodeVenueClaimInvalid)
+
+	_, err = suite.svc.SubmitClaim(venue.ID, user.ID, strings.Repeat("x", contracts.MaxVenueClaimEvidenceLength+1))
+	suite.requireClaimCode(err, apperrors.CodeVenueClaimInvalid)
+
+	_, err = suite.svc.SubmitClaim(999999, user.ID, "I book the room")
+	var venueErr *apperrors.VenueError
+	suite.Require().ErrorAs(err, &venueErr)
+	suite.Equal(apperrors.CodeVenueNotFound, venueErr.Code)
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) TestApproveClaim_GrantsManager() {
+	user := suite.createUser("staff")
+	admin := suite.createUser("admin")
+	venue := suite.createVenue("Valley Bar")
+
+	claim, err := suite.svc.SubmitClaim(venue.ID, user.ID, "  I book the room  ")
+	suite.Require().NoError(err)
+	suite.Equal("I book the room", claim.Evidence)
+	suite.Equal("pending", claim.Status)
+	suite.Equal("Valley Bar", claim.VenueName)
+
+	_, err = suite.svc.SubmitClaim(venue.ID, user.ID, "again")
+	suite.requireClaimCode(err, apperrors.CodeVenueClaimConflict)
+
+	pending, total, err := suite.svc.ListClaims("pending", 20, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Require().Len(pending, 1)
+
+	approved, err := suite.svc.ApproveClaim(claim.ID, admin.ID, "")
+	suite.Require().NoError(err)
+	suite.Equal("approved", approved.Status)
+	suite.Require().NotNil(approved.ReviewedBy)
+	suite.Equal(admin.ID, *approved.ReviewedBy)
+
+	managed, err := suite.svc.IsVenueManager(user.ID, venue.ID)
+	suite.Require().NoError(err)
+	suite.True(managed)
+
+	_, err = suite.svc.ApproveClaim(claim.ID, admin.ID, "")
+	suite.requireClaimCode(err, apperrors.CodeVenueClaimNotPending)
+	_, err = suite.svc.SubmitClaim(venue.ID, user.ID, "again")
+	suite.requireClaimCode(err, apperrors.CodeVenueClaimConflict)
+
+	venues, err := suite.svc.GetManagedVenues(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(venues, 1)
+	suite.Equal(venue.ID, venues[0].VenueID)
+	suite.Require().NotNil(venues[0].ClaimID)
+	suite.Equal(claim.ID, *venues[0].ClaimID)
+
+	suite.Require().NoError(suite.svc.RemoveVenueManager(venue.ID, user.ID))
+	suite.requireClaimCode(suite.svc.RemoveVenueManager(venue.ID, user.ID), apperrors.CodeVenueManagerNotFound)
+	managed, err = suite.svc.IsVenueManager(user.ID, venue.ID)
+	suite.Require().NoError(err)
+	suite.False(managed)
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) TestDenyClaim() {
+	user := suite.createUser("staff")
+	admin := suite.createUser("admin")
+	venue := suite.createVenue("Crescent Ballroom")
+
+	claim, err := suite.svc.SubmitClaim(venue.ID, user.ID, "I work the door")
+	suite.Require().NoError(err)
+
+	_, err = suite.svc.DenyClaim(claim.ID, admin.ID, " ")
+	suite.requireClaimCode(err, apperrors.CodeVenueClaimInvalid)
+
+	denied, err := suite.svc.DenyClaim(claim.ID, admin.ID, "Could not verify")
+	suite.Require().NoError(err)
+	suite.Equal("denied", denied.Status)
+	suite.Require().NotNil(denied.ReviewNote)
+	suite.Equal("Could not verify", *denied.ReviewNote)
+
+	managed, err := suite.svc.IsVenueManager(user.ID, venue.ID)
+	suite.Require().NoError(err)
+	suite.False(managed)
+
+	// A denied claim does not block a new one.
+	_, err = suite.svc.SubmitClaim(venue.ID, user.ID, "Here is my staff page")
+	suite.Require().NoError(err)
+	mine, err := suite.svc.GetUserClaims(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(mine, 2)
+	suite.Equal("pending", mine[0].Status, "newest first")
+}
+
+func (suite *VenueClaimServiceIntegrationTestSuite) TestIsShowVenueManager() {
+	user := suite.createUser("staff")
+	managedVenue := suite.createVenue("Valley Bar")
+	otherVenue := suite.createVenue("Rebel Lounge")
+	suite.Require().NoError(suite.db.Create(&catalogm.VenueManager{VenueID: managedVenue.ID, UserID: user.ID}).Error)
+
+	show := &catalogm.Show{
+		Title:     "Late Show",
+		EventDate: time.Now().Add(7 * 24 * time.Hour),
+		Status:    catalogm.ShowStatusApproved,
+		Source:    catalogm.ShowSourceUser,
+	}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: otherVenue.ID}).Error)
+
+	managed, err := suite.svc.IsShowVenueManager(user.ID, show.ID)
+	suite.Require().NoError(err)
+	suite.False(managed)
+
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: managedVenue.ID}).Error)
+	managed, err = suite.svc.IsShowVenueManager(user.ID, show.ID)
+	suite.Require().NoError(err)
+	suite.True(managed, "managing any of the show's venues is enough")
+}
@@ -1,6 +1,7 @@
 package catalog
 
 import (
+	"context"
 	"testing"
 
 	catalogm "psychic-homily-backend/internal/models/catalog"
@@ -22,6 +23,9 @@ func TestApplyGeocoding(t *testing.T) {
 		if v.Latitude == nil || v.Longitude == nil {
 			t.Errorf("expected lat/lng populated, got lat=%v lng=%v", v.Latitude, v.Longitude)
 		}
+		if v.GeocodePrecision == nil || *v.GeocodePrecision != catalogm.GeocodePrecisionCity {
+			t.Errorf("geocode_precision = %v, want city", v.GeocodePrecision)
+		}
 		// metro is a sibling of the geocoding (PSY-1255 step B).
 		if v.Metro == nil || *v.Metro != "38060" {
 			t.Errorf("metro = %v, want 38060 (Phoenix CBSA)", v.Metro)
@@ -43,8 +47,8 @@ func TestApplyGeocoding(t *testing.T) {
 		// All-or-nothing invariant: a miss must leave lat/lng/timezone/metro ALL nil.
 		// UpdateVenue relies on this — it forwards these pointers straight into the
 		// GORM updates map, so a miss must write SQL NULL across all four (PSY-1255).
-		if v.Timezone != nil || v.Latitude != nil || v.Longitude != nil || v.Metro != nil {
-			t.Errorf("expected all geo fields nil on miss, got tz=%v lat=%v lng=%v metro=%v", v.Timezone, v.Latitude, v.Longitude, v.Metro)
+		if v.Timezone != nil || v.Latitude != nil || v.Longitude != nil || v.Metro != nil || v.GeocodePrecision != nil {
+			t.Errorf("expected all geo fields nil on miss, got tz=%v lat=%v lng=%v metro=%v precision=%v", v.Timezone, v.Latitude, v.Longitude, v.Metro, v.GeocodePrecision)
 		}
 	})
 
@@ -57,3 +61,28 @@ func TestApplyGeocoding(t *testing.T) {
 		}
 	})
 }
+
+// stubAddressGeocoder returns a fixed point (or miss/error) and records the query.
+type stubAddressGeocoder struct {
+	point geo.Point
+	ok    bool
+	err   error
+	calls []geo.AddressQuery
+}
+
+func (g *stubAddressGeocoder) GeocodeAddress(_ context.Context, q geo.AddressQuery) (geo.Point, bool, error) {
+	g.calls = append(g.calls, q)
+	return g.point, g.ok, g.err
+}
+
+func TestGeocodeVenueAddressByID_NilGeocoderIsNoop(t *testing.T) {
+	// No DB needed: a nil geocoder returns before loading the venue.
+	if err := GeocodeVenueAddressByID(context.Background(), nil, nil, 1); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+}
+
+func TestQueueAddressGeocode_NoGeocoderIsNoop(t *testing.T) {
+	// Without an address geocoder nothing is scheduled (and the nil DB is never touched).
+	(&VenueService{}).QueueAddressGeocode(1)
+}
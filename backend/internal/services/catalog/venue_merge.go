@@ -0,0 +1,242 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// MergeVenues merges the "mergeFrom" venue into the "canonical" venue, the
+// venue counterpart of ArtistService.MergeArtists. Shows, festivals, series,
+// follows/bookmarks, tags, crates, comments, reports, notification filters
+// and venue managers/claims are re-pointed to the canonical venue; rows the
+// canonical venue already has win over the merged venue's copy. Links the
+// canonical venue lacks are copied over, verification carries forward, the
+// merged venue's slug redirects to the canonical one, and the merged venue
+// is deleted.
+// Runs in a single transaction.
+func (s *VenueService) MergeVenues(canonicalID, mergeFromID uint) (*contracts.MergeVenueResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	if canonicalID == mergeFromID {
+		return nil, apperrors.ErrVenueMergeSelf()
+	}
+
+	var canonical catalogm.Venue
+	if err := s.db.First(&canonical, canonicalID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrVenueNotFound(canonicalID)
+		}
+		return nil, fmt.Errorf("failed to get canonical venue: %w", err)
+	}
+
+	var mergeFrom catalogm.Venue
+	if err := s.db.First(&mergeFrom, mergeFromID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrVenueNotFound(mergeFromID)
+		}
+		return nil, fmt.Errorf("failed to get merge-from venue: %w", err)
+	}
+
+	result := &contracts.MergeVenueResult{
+		CanonicalVenueID: canonicalID,
+		MergedVenueID:    mergeFromID,
+		MergedVenueName:  mergeFrom.Name,
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 1. show_venues: drop rows for shows already at the canonical venue,
+		// then re-point the rest.
+		moved, _, err := movePolymorphicJunction(tx, "show_venues", "venue_id", "show_id", canonicalID, mergeFromID)
+		if err != nil {
+			return fmt.Errorf("show_venues: %w", err)
+		}
+		result.ShowsMoved = moved
+
+		// 2. show_artists denormalized venue_id. A row whose (artist,
+		// event_date) already exists at the canonical venue is a duplicate
+		// show; clearing its venue_id keeps the partial unique index
+		// shows_artist_venue_eventdate_uniq satisfied and leaves the pair for
+		// the show dedup tool.
+		if err := tx.Exec(`
+			UPDATE show_artists SET venue_id = NULL
+			WHERE venue_id = ?
+			  AND EXISTS (
+				SELECT 1 FROM show_artists w
+				WHERE w.venue_id = ?
+				  AND w.artist_id = show_artists.artist_id
+				  AND w.event_date = show_artists.event_date
+			  )
+		`, mergeFromID, canonicalID).Error; err != nil {
+			return fmt.Errorf("show_artists dedup conflicts: %w", err)
+		}
+		if err := tx.Exec(`UPDATE show_artists SET venue_id = ? WHERE venue_id = ?`, canonicalID, mergeFromID).Error; err != nil {
+			return fmt.Errorf("show_artists: %w", err)
+		}
+
+		// 3. Festivals (takeover junction + primary venue) and show series.
+		moved, _, err = movePolymorphicJunction(tx, "festival_venues", "venue_id", "festival_id", canonicalID, mergeFromID)
+		if err != nil {
+			return fmt.Errorf("festival_venues: %w", err)
+		}
+		result.FestivalsMoved = moved
+		r := tx.Exec(`UPDATE festivals SET venue_id = ? WHERE venue_id = ?`, canonicalID, mergeFromID)
+		if r.Error != nil {
+			return fmt.Errorf("festivals: %w", r.Error)
+		}
+		result.FestivalsMoved += r.RowsAffected
+		r = tx.Exec(`UPDATE show_series SET venue_id = ? WHERE venue_id = ?`, canonicalID, mergeFromID)
+		if r.Error != nil {
+			return fmt.Errorf("show_series: %w", r.Error)
+		}
+		result.SeriesMoved = r.RowsAffected
+
+		// 3b. Venue managers carry over (a grant the canonical venue already
+		// has wins), as do claims; a pending claim yields to the claimant's
+		// pending claim on the canonical venue.
+		if _, _, err := movePolymorphicJunction(tx, "venue_managers", "venue_id", "user_id", canonicalID, mergeFromID); err != nil {
+			return fmt.Errorf("venue_managers: %w", err)
+		}
+		if err := tx.Exec(`
+			DELETE FROM venue_claims
+			WHERE venue_id = ? AND status = 'pending'
+			  AND EXISTS (
+				SELECT 1 FROM venue_claims w
+				WHERE w.venue_id = ? AND w.user_id = venue_claims.user_id
+				  AND w.status = 'pending'
+			  )
+		`, mergeFromID, canonicalID).Error; err != nil {
+			return fmt.Errorf("venue_claims conflicts: %w", err)
+		}
+		if err := tx.Exec(`UPDATE venue_claims SET venue_id = ? WHERE venue_id = ?`, canonicalID, mergeFromID).Error; err != nil {
+			return fmt.Errorf("venue_claims: %w", err)
+		}
+
+		// 4. Polymorphic rows with a uniqueness constraint: the canonical
+		// venue's row wins.
+		for _, op := range []struct {
+			table       string
+			correlation []string
+			moved       *int64
+		}{
+			{"user_bookmarks", []string{"user_id", "action"}, &result.BookmarksMoved},
+			{"entity_tags", []string{"tag_id"}, nil},
+			{"tag_votes", []string{"tag_id", "user_id"}, nil},
+			{"collection_items", []string{"collection_id"}, &result.CollectionItemsMoved},
+			{"comment_subscriptions", []string{"user_id"}, nil},
+			{"comment_last_read", []string{"user_id"}, nil},
+			// One calendar source per venue: any canonical config wins.
+			{"source_configs", []string{"entity_type"}, nil},
+		} {
+			moved, _, err := movePolymorphicEntityOfType(tx, op.table, "venue", op.correlation, canonicalID, mergeFromID)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op.table, err)
+			}
+			if op.moved != nil {
+				*op.moved = moved
+			}
+		}
+
+		// 5. Pending edits are unique per submitter only while pending: drop
+		// the merged venue's pending edit when the submitter already has one
+		// on the canonical venue.
+		if err := tx.Exec(`
+			DELETE FROM pending_entity_edits
+			WHERE entity_type = 'venue' AND entity_id = ? AND status = 'pending'
+			  AND EXISTS (
+				SELECT 1 FROM pending_entity_edits w
+				WHERE w.entity_type = 'venue' AND w.entity_id = ?
+				  AND w.submitted_by = pending_entity_edits.submitted_by
+				  AND w.status = 'pending'
+			  )
+		`, mergeFromID, canonicalID).Error; err != nil {
+			return fmt.Errorf("pending_entity_edits conflicts: %w", err)
+		}
+
+		// 6. Plain polymorphic re-points (no uniqueness on the entity).
+		for _, op := range []struct {
+			name string
+			sql  string
+			dst  *int64
+		}{
+			{"entity_reports", `UPDATE entity_reports SET entity_id = ? WHERE entity_type = 'venue' AND entity_id = ?`, &result.ReportsMoved},
+			{"comments", `UPDATE comments SET entity_id = ? WHERE entity_type = 'venue' AND entity_id = ?`, nil},
+			{"pending_entity_edits", `UPDATE pending_entity_edits SET entity_id = ? WHERE entity_type = 'venue' AND entity_id = ?`, nil},
+			{"revisions", `UPDATE revisions SET entity_id = ? WHERE entity_type = 'venue' AND entity_id = ?`, nil},
+			{"notification_log", `UPDATE notification_log SET entity_id = ? WHERE entity_type = 'venue' AND entity_id = ?`, nil},
+			// requests uses requested_entity_id, not entity_id.
+			{"requests", `UPDATE requests SET requested_entity_id = ? WHERE entity_type = 'venue' AND requested_entity_id = ?`, nil},
+		} {
+			res := tx.Exec(op.sql, canonicalID, mergeFromID)
+			if res.Error != nil {
+				return fmt.Errorf("%s: %w", op.name, res.Error)
+			}
+			if op.dst != nil {
+				*op.dst = res.RowsAffected
+			}
+		}
+
+		// 7. notification_filters: swap the ID in venue_ids arrays, then drop
+		// it from filters that already listed the canonical venue.
+		r = tx.Exec(`UPDATE notification_filters
+			SET venue_ids = array_replace(venue_ids, ?, ?),
+			    updated_at = NOW()
+			WHERE venue_ids @> ARRAY[?]::bigint[]
+			AND NOT venue_ids @> ARRAY[?]::bigint[]`,
+			mergeFromID, canonicalID, mergeFromID, canonicalID)
+		if r.Error != nil {
+			return fmt.Errorf("notification_filters: %w", r.Error)
+		}
+		result.FiltersUpdated = r.RowsAffected
+		if err := tx.Exec(`UPDATE notification_filters
+			SET venue_ids = array_remove(venue_ids, ?),
+			    updated_at = NOW()
+			WHERE venue_ids @> ARRAY[?]::bigint[]`,
+			mergeFromID, mergeFromID).Error; err != nil {
+			return fmt.Errorf("notification_filters cleanup: %w", err)
+		}
+
+		// 8. Links the canonical venue lacks, and verification (union: a
+		// merge never un-verifies the surviving venue).
+		updates, copied := fillMissingSocialLinks(canonical.Social, mergeFrom.Social)
+		if mergeFrom.Verified && !canonical.Verified {
+			updates["verified"] = true
+		}
+		if len(updates) > 0 {
+			if err := tx.Model(&catalogm.Venue{}).Where("id = ?", canonicalID).Updates(updates).Error; err != nil {
+				return fmt.Errorf("failed to copy venue links: %w", err)
+			}
+		}
+		result.LinksCopied = copied
+
+		// 9. Keep the merged venue's URL and import aliases working.
+		redirected, err := redirectSlugTx(tx, catalogm.SlugRedirectEntityVenue, mergeFrom.Slug, mergeFromID, canonicalID)
+		if err != nil {
+			return err
+		}
+		result.SlugRedirected = redirected
+		if err := repointEntityAliasesTx(tx, catalogm.EntityAliasTypeVenue, mergeFromID, canonicalID); err != nil {
+			return err
+		}
+
+		// 10. Delete the merged venue. CASCADE clears anything left behind
+		// (nothing, barring rows dropped as conflicts above).
+		if err := tx.Delete(&catalogm.Venue{}, mergeFromID).Error; err != nil {
+			return fmt.Errorf("failed to delete merged venue: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("merge failed: %w", err)
+	}
+
+	return result, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"psychic-homily-backend/internal/backfill"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	"psychic-homily-backend/internal/utils"
 )
@@ -14,6 +15,9 @@ import (
 type VenueSlugBackfillOptions struct {
 	// DryRun computes and reports every change without writing (the CLI default).
 	DryRun bool
+	// Batch sets batching, rate limiting, progress and resume. Its DryRun
+	// is ignored in favour of DryRun above.
+	Batch backfill.Options
 }
 
 // VenueSlugChange records one venue whose slug shows the corruption signature
@@ -35,6 +39,9 @@ type VenueSlugBackfillReport struct {
 	Unchanged int
 	Changes   []VenueSlugChange
 	Errors    []string
+	// Cursor is where the run stopped; pass it as Batch.ResumeFrom to
+	// continue an interrupted run.
+	Cursor backfill.Cursor
 }
 
 // hasLocationTail reports whether slug ends with the venue's canonical location
@@ -75,8 +82,8 @@ func hasLocationTail(slug, tail string) bool {
 //
 // A well-formed slug is left untouched even when it diverges from the venue's
 // CURRENT name: UpdateVenue never regenerates the slug on rename/relocate
-// (venue.go), so a renamed venue's slug is intentionally stable and has no
-// redirect. Rewriting it would 404 every bookmarked/shared link. Detecting the
+// (venue.go), so a renamed venue's slug is intentionally stable. Rewriting it
+// would churn every bookmarked/shared link needlessly. Detecting the
 // corruption signature — not mere divergence from the current name — is what
 // keeps this backfill from clobbering those deliberately-stable slugs.
 func slugLooksCorrupt(slug, city, state string) bool {
@@ -116,30 +123,60 @@ func venueSlugTarget(name, city, state, currentSlug string, slugTaken func(candi
 // signature (empty, or missing the "-{city}-{state}" location tail), rewriting
 // them to the canonical, collision-safe utils.GenerateVenueSlug output.
 //
-// It runs in two phases. Phase 1 (reads only, identical for dry-run and live)
-// computes the full plan, reserving each proposed slug in an in-run "claimed"
-// set so two venues that canonicalize to the same slug preview the SAME "-2"
-// resolution a live run would apply — the dry-run report is exactly what a live
-// run writes. Phase 2 (live only) applies the whole plan inside a single
-// transaction, so a mid-run failure rolls back rather than leaving venues
-// half-rewritten.
+// Venues are visited in id order in batches (see package backfill); each
+// batch is planned and applied in one transaction, so a failure rolls back
+// that batch rather than leaving venues half-rewritten, and the run can be
+// resumed from the last committed batch. Every proposed slug is reserved in
+// an in-run "claimed" set, so two venues that canonicalize to the same slug
+// preview the SAME "-2" resolution a live run would apply — a dry run (whose
+// batches are rolled back) reports exactly what a live run writes.
 //
 // It is idempotent: a rewritten slug carries the location tail, so a second run
-// sees no corruption signature and reports zero changes. There is no
-// slug-redirect mechanism and the corrupted slugs have no legitimate external
-// references, so the old slugs simply 404 after the rewrite; internal links
+// sees no corruption signature and reports zero changes. A non-empty old slug
+// is kept in slug_redirects so any link to it still resolves; internal links
 // regenerate from venue.slug.
 func BackfillVenueSlugs(database *gorm.DB, opts VenueSlugBackfillOptions) (*VenueSlugBackfillReport, error) {
+	report := &VenueSlugBackfillReport{}
+	claimed := make(map[string]bool) // slugs reserved earlier in THIS run
+
+	batch := opts.Batch
+	batch.DryRun = opts.DryRun
+	result, err := backfill.Run(database, batch, backfill.Stage{
+		Name:  "venues",
+		Query: func(db *gorm.DB) *gorm.DB { return db.Model(&catalogm.Venue{}) },
+		Batch: func(tx *gorm.DB, ids []uint) error {
+			changes, err := backfillVenueSlugBatch(tx, ids, claimed, report)
+			if err != nil {
+				// Rolled back — none of this batch's changes were applied.
+				return err
+			}
+			for _, c := range changes {
+				c.Applied = !opts.DryRun
+				report.Changes = append(report.Changes, c)
+			}
+			report.Changed += len(changes)
+			return nil
+		},
+	})
+	report.Cursor = result.Cursor
+	if err != nil {
+		// Report the failure alongside what earlier batches committed, so the
+		// operator can see how far the run got and resume from there.
+		report.Errors = append(report.Errors, err.Error())
+	}
+	return report, nil
+}
+
+// backfillVenueSlugBatch plans and (inside tx) applies the slug changes for
+// one batch of venues.
+func backfillVenueSlugBatch(tx *gorm.DB, ids []uint, claimed map[string]bool, report *VenueSlugBackfillReport) ([]VenueSlugChange, error) {
 	var venues []catalogm.Venue
-	if err := database.Order("id").Find(&venues).Error; err != nil {
+	if err := tx.Where("id IN ?", ids).Order("id").Find(&venues).Error; err != nil {
 		return nil, fmt.Errorf("load venues: %w", err)
 	}
+	report.Scanned += len(venues)
 
-	report := &VenueSlugBackfillReport{Scanned: len(venues)}
-	claimed := make(map[string]bool) // slugs reserved earlier in THIS run
 	var plan []VenueSlugChange
-
-	// Phase 1 — compute the plan (reads only).
 	for i := range venues {
 		v := &venues[i]
 
@@ -154,7 +191,7 @@ func BackfillVenueSlugs(database *gorm.DB, opts VenueSlugBackfillOptions) (*Venu
 				return true
 			}
 			var count int64
-			if err := database.Model(&catalogm.Venue{}).
+			if err := tx.Model(&catalogm.Venue{}).
 				Where("slug = ? AND id <> ?", candidate, v.ID).
 				Count(&count).Error; err != nil {
 				// Fail safe: a probe we couldn't run must not be read as "free",
@@ -165,8 +202,7 @@ func BackfillVenueSlugs(database *gorm.DB, opts VenueSlugBackfillOptions) (*Venu
 			return count > 0
 		})
 		if probeErr != nil {
-			report.Errors = append(report.Errors, fmt.Sprintf("venue %d (%q): probe slug uniqueness: %v", v.ID, v.Name, probeErr))
-			continue
+			return nil, fmt.Errorf("venue %d (%q): probe slug uniqueness: %w", v.ID, v.Name, probeErr)
 		}
 		if !needsUpdate {
 			report.Unchanged++
@@ -184,34 +220,16 @@ func BackfillVenueSlugs(database *gorm.DB, opts VenueSlugBackfillOptions) (*Venu
 		})
 	}
 
-	// Dry-run: report the plan, write nothing.
-	if opts.DryRun {
-		report.Changed = len(plan)
-		report.Changes = plan
-		return report, nil
-	}
-
-	// Phase 2 — apply the whole plan atomically.
-	if err := database.Transaction(func(tx *gorm.DB) error {
-		for _, c := range plan {
-			if err := tx.Model(&catalogm.Venue{}).
-				Where("id = ?", c.VenueID).
-				Update("slug", c.NewSlug).Error; err != nil {
-				return fmt.Errorf("venue %d (%q): update slug: %w", c.VenueID, c.Name, err)
-			}
-		}
-		return nil
-	}); err != nil {
-		// Rolled back — no changes were applied; surface the error and report
-		// zero applied changes so the operator doesn't think a partial run stuck.
-		report.Errors = append(report.Errors, err.Error())
-		return report, nil
-	}
-
 	for _, c := range plan {
-		c.Applied = true
-		report.Changes = append(report.Changes, c)
+		if err := tx.Model(&catalogm.Venue{}).
+			Where("id = ?", c.VenueID).
+			Update("slug", c.NewSlug).Error; err != nil {
+			return nil, fmt.Errorf("venue %d (%q): update slug: %w", c.VenueID, c.Name, err)
+		}
+		oldSlug := c.OldSlug
+		if err := recordSlugChangeTx(tx, catalogm.SlugRedirectEntityVenue, &oldSlug, c.NewSlug, c.VenueID); err != nil {
+			return nil, fmt.Errorf("venue %d (%q): %w", c.VenueID, c.Name, err)
+		}
 	}
-	report.Changed = len(plan)
-	return report, nil
+	return plan, nil
 }
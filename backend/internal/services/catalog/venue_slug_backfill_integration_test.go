@@ -28,6 +28,7 @@ func (suite *VenueSlugBackfillIntegrationTestSuite) TearDownSuite() {
 func (suite *VenueSlugBackfillIntegrationTestSuite) TearDownTest() {
 	// Surface a cleanup failure rather than silently polluting the next test's
 	// exact-count assertions.
+	suite.Require().NoError(suite.db.Exec("DELETE FROM slug_redirects").Error)
 	suite.Require().NoError(suite.db.Exec("DELETE FROM venues").Error)
 }
 
@@ -92,6 +93,13 @@ func (suite *VenueSlugBackfillIntegrationTestSuite) TestBackfill_RewritesCorrupt
 	suite.Equal("the-rogue-bar-phoenix-az", suite.slugOf(renamedID), "renamed venue's stable slug must be untouched")
 	suite.Equal("empty-bottle-chicago-il", suite.slugOf(okID), "canonical slug must be untouched")
 
+	// Only the non-empty corrupted slug is kept as a redirect.
+	var redirects []catalogm.SlugRedirect
+	suite.Require().NoError(suite.db.Find(&redirects).Error)
+	suite.Require().Len(redirects, 1)
+	suite.Equal("alley-ar-hoenix", redirects[0].OldSlug)
+	suite.Equal(badID, redirects[0].EntityID)
+
 	// Idempotent: a second live run changes nothing.
 	report, err = BackfillVenueSlugs(suite.db, VenueSlugBackfillOptions{DryRun: false})
 	suite.Require().NoError(err)
@@ -1,6 +1,7 @@
 package catalog
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -44,6 +45,8 @@ func (suite *VenueServiceIntegrationTestSuite) TearDownTest() {
 	sqlDB, err := suite.db.DB()
 	suite.Require().NoError(err)
 	// Delete in FK-safe order
+	_, _ = sqlDB.Exec("DELETE FROM slug_redirects")
+	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
 	_, _ = sqlDB.Exec("DELETE FROM entity_tags")
 	_, _ = sqlDB.Exec("DELETE FROM tag_aliases")
 	_, _ = sqlDB.Exec("DELETE FROM tag_votes")
@@ -192,6 +195,33 @@ func (suite *VenueServiceIntegrationTestSuite) TestUpdateVenue_SetsCapacity() {
 	suite.Equal(800, *updated.Capacity)
 }
 
+func (suite *VenueServiceIntegrationTestSuite) TestVenueOperatingInfo_RoundTrips() {
+	created, err := suite.venueService.CreateVenue(&contracts.CreateVenueRequest{
+		Name:               "Operating Info Hall",
+		City:               "Phoenix",
+		State:              "AZ",
+		AccessibilityNotes: stringPtr("Step-free entry on the north side."),
+		AllAges:            boolPtr(false),
+		DoorTime:           stringPtr("19:00"),
+	}, true)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(created.AllAges)
+	suite.False(*created.AllAges, "false is stored, not treated as unknown")
+	suite.Equal("19:00", *created.DoorTime)
+	suite.Equal("Step-free entry on the north side.", *created.AccessibilityNotes)
+
+	// Empty strings clear the text fields; all_ages is left alone.
+	updated, err := suite.venueService.UpdateVenue(created.ID, &contracts.UpdateVenueRequest{
+		AccessibilityNotes: stringPtr(""),
+		DoorTime:           stringPtr(""),
+	})
+	suite.Require().NoError(err)
+	suite.Nil(updated.AccessibilityNotes)
+	suite.Nil(updated.DoorTime)
+	suite.Require().NotNil(updated.AllAges)
+	suite.False(*updated.AllAges)
+}
+
 func (suite *VenueServiceIntegrationTestSuite) TestCreateVenue_AdminAutoVerified() {
 	req := &contracts.CreateVenueRequest{
 		Name:  "Admin Venue",
@@ -408,6 +438,88 @@ func (suite *VenueServiceIntegrationTestSuite) TestUpdateVenue_ReGeocodesAndClea
 	suite.Nil(updated.Longitude)
 }
 
+// TestUpdateVenue_TimezoneOverride: an admin-set timezone survives a
+// relocation, and clearing it re-infers the zone from the location.
+func (suite *VenueServiceIntegrationTestSuite) TestUpdateVenue_TimezoneOverride() {
+	svc := &VenueService{db: suite.db, geocoder: geo.Default()}
+	created, err := svc.CreateVenue(&contracts.CreateVenueRequest{
+		Name:  "Override Venue",
+		City:  "Phoenix",
+		State: "AZ",
+	}, true)
+	suite.Require().NoError(err)
+	suite.False(created.TimezoneManual)
+
+	updated, err := svc.UpdateVenue(created.ID, &contracts.UpdateVenueRequest{Timezone: stringPtr("America/Denver")})
+	suite.Require().NoError(err)
+	suite.True(updated.TimezoneManual)
+	suite.Equal("America/Denver", *updated.Timezone)
+
+	updated, err = svc.UpdateVenue(created.ID, &contracts.UpdateVenueRequest{City: stringPtr("Los Angeles"), State: stringPtr("CA")})
+	suite.Require().NoError(err)
+	suite.Equal("America/Denver", *updated.Timezone, "relocation must not overwrite an admin-set timezone")
+
+	updated, err = svc.UpdateVenue(created.ID, &contracts.UpdateVenueRequest{Timezone: stringPtr("")})
+	suite.Require().NoError(err)
+	suite.False(updated.TimezoneManual)
+	suite.Require().NotNil(updated.Timezone)
+	suite.Equal("America/Los_Angeles", *updated.Timezone)
+}
+
+func (suite *VenueServiceIntegrationTestSuite) TestGeocodeVenueAddressByID_RefinesCentroid() {
+	svc := &VenueService{db: suite.db, geocoder: geo.Default()}
+	created, err := svc.CreateVenue(&contracts.CreateVenueRequest{
+		Name:    "Address Geocode Venue",
+		Address: stringPtr("401 W Van Buren St"),
+		City:    "Phoenix",
+		State:   "AZ",
+		Zipcode: stringPtr("85003"),
+	}, true)
+	suite.Require().NoError(err)
+
+	stub := &stubAddressGeocoder{point: geo.Point{Latitude: 33.4495, Longitude: -112.0786}, ok: true}
+	suite.Require().NoError(GeocodeVenueAddressByID(context.Background(), suite.db, stub, created.ID))
+
+	suite.Require().Len(stub.calls, 1)
+	suite.Equal("401 W Van Buren St", stub.calls[0].Street)
+	suite.Equal("85003", stub.calls[0].Zipcode)
+
+	var venue catalogm.Venue
+	suite.Require().NoError(suite.db.First(&venue, created.ID).Error)
+	suite.Require().NotNil(venue.Latitude)
+	suite.InDelta(33.4495, *venue.Latitude, 1e-6)
+	suite.InDelta(-112.0786, *venue.Longitude, 1e-6)
+	suite.Equal(catalogm.GeocodePrecisionAddress, *venue.GeocodePrecision)
+	suite.Equal("America/Phoenix", *venue.Timezone, "timezone stays from the offline lookup")
+}
+
+func (suite *VenueServiceIntegrationTestSuite) TestGeocodeVenueAddressByID_MissKeepsCentroid() {
+	svc := &VenueService{db: suite.db, geocoder: geo.Default()}
+	created, err := svc.CreateVenue(&contracts.CreateVenueRequest{
+		Name:    "Unresolvable Address Venue",
+		Address: stringPtr("Somewhere behind the bar"),
+		City:    "Phoenix",
+		State:   "AZ",
+	}, true)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(GeocodeVenueAddressByID(context.Background(), suite.db, &stubAddressGeocoder{}, created.ID))
+
+	var venue catalogm.Venue
+	suite.Require().NoError(suite.db.First(&venue, created.ID).Error)
+	suite.Equal(catalogm.GeocodePrecisionCity, *venue.GeocodePrecision)
+	suite.InDelta(*created.Latitude, *venue.Latitude, 1e-6)
+}
+
+func (suite *VenueServiceIntegrationTestSuite) TestGeocodeVenueAddressByID_SkipsVenueWithoutAddress() {
+	venue := &catalogm.Venue{Name: "No Address Venue", City: "Phoenix", State: "AZ"}
+	suite.Require().NoError(suite.db.Create(venue).Error)
+
+	stub := &stubAddressGeocoder{ok: true}
+	suite.Require().NoError(GeocodeVenueAddressByID(context.Background(), suite.db, stub, venue.ID))
+	suite.Empty(stub.calls)
+}
+
 func (suite *VenueServiceIntegrationTestSuite) TestUpdateVenue_NotFound() {
 	resp, err := suite.venueService.UpdateVenue(99999, &contracts.UpdateVenueRequest{Name: stringPtr("x")})
 
@@ -729,6 +841,24 @@ func (suite *VenueServiceIntegrationTestSuite) TestGetVenuesWithShowCounts_Filte
 	suite.Equal("PHX Counted", resp[0].Name)
 }
 
+func (suite *VenueServiceIntegrationTestSuite) TestGetVenuesWithShowCounts_FilterByMetro() {
+	tempe := suite.createTestVenue("Tempe Counted", "Tempe", "AZ", true)
+	mesa := suite.createTestVenue("Mesa Counted", "Mesa", "AZ", true)
+	tucson := suite.createTestVenue("TUC Metro Counted", "Tucson", "AZ", true)
+	for _, v := range []*catalogm.Venue{tempe, mesa} {
+		suite.Require().NoError(suite.db.Model(v).Update("metro", "38060").Error)
+	}
+	suite.Require().NoError(suite.db.Model(tucson).Update("metro", "46060").Error)
+
+	resp, total, err := suite.venueService.GetVenuesWithShowCounts(contracts.VenueListFilters{Metro: "38060"}, 10, 0)
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), total)
+	suite.Require().Len(resp, 2)
+	suite.Equal("Mesa Counted", resp[0].Name)
+	suite.Equal("Tempe Counted", resp[1].Name)
+}
+
 func (suite *VenueServiceIntegrationTestSuite) TestGetVenuesWithShowCounts_Pagination() {
 	for i := 0; i < 5; i++ {
 		suite.createTestVenue(fmt.Sprintf("Paginated Venue %d", i), "Phoenix", "AZ", true)
@@ -864,6 +994,55 @@ func (suite *VenueServiceIntegrationTestSuite) TestGetShowsForVenue_NotFound() {
 	suite.Equal(apperrors.CodeVenueNotFound, venueErr.Code)
 }
 
+func (suite *VenueServiceIntegrationTestSuite) TestGetVenueWidget_UpcomingInLineupOrder() {
+	user := suite.createTestUser()
+	venue := suite.createTestVenue("Widget Venue", "Phoenix", "AZ", true)
+
+	past := suite.createApprovedShow(venue.ID, user.ID)
+	suite.Require().NoError(suite.db.Model(past).Update("event_date", time.Now().UTC().AddDate(0, 0, -3)).Error)
+	later := suite.createApprovedShow(venue.ID, user.ID)
+	suite.Require().NoError(suite.db.Model(later).Update("event_date", time.Now().UTC().AddDate(0, 0, 14)).Error)
+	soon := suite.createApprovedShow(venue.ID, user.ID)
+	suite.Require().NoError(suite.db.Model(soon).Updates(map[string]interface{}{
+		"event_date": time.Now().UTC().AddDate(0, 0, 2), "is_cancelled": true,
+	}).Error)
+	deleted := suite.createApprovedShow(venue.ID, user.ID)
+	suite.Require().NoError(suite.db.Model(deleted).Update("deleted_at", time.Now()).Error)
+
+	opener := &catalogm.Artist{Name: "Opener"}
+	headliner := &catalogm.Artist{Name: "Headliner"}
+	suite.Require().NoError(suite.db.Create(opener).Error)
+	suite.Require().NoError(suite.db.Create(headliner).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: soon.ID, ArtistID: headliner.ID, Position: 0, SetType: catalogm.SetTypeHeadliner}).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: soon.ID, ArtistID: opener.ID, Position: 1, SetType: catalogm.SetTypeOpener}).Error)
+
+	widget, err := suite.venueService.GetVenueWidget(venue.ID, 5)
+
+	suite.Require().NoError(err)
+	suite.Equal("Widget Venue", widget.Venue.Name)
+	suite.Equal("America/Phoenix", widget.Venue.Timezone)
+	suite.Require().Len(widget.Shows, 2, "past and deleted shows are left out")
+	suite.Equal(soon.ID, widget.Shows[0].ID)
+	suite.True(widget.Shows[0].IsCancelled)
+	suite.Equal([]string{"Headliner", "Opener"}, widget.Shows[0].Artists)
+	suite.Equal(later.ID, widget.Shows[1].ID)
+	suite.Empty(widget.Shows[1].Artists)
+	suite.Contains(widget.Shows[0].EventDateLocal, "-07:00")
+
+	widget, err = suite.venueService.GetVenueWidget(venue.ID, 1)
+	suite.Require().NoError(err)
+	suite.Len(widget.Shows, 1)
+}
+
+func (suite *VenueServiceIntegrationTestSuite) TestGetVenueWidget_NotFound() {
+	_, err := suite.venueService.GetVenueWidget(99999, 5)
+
+	suite.Require().Error(err)
+	var venueErr *apperrors.VenueError
+	suite.ErrorAs(err, &venueErr)
+	suite.Equal(apperrors.CodeVenueNotFound, venueErr.Code)
+}
+
 // =============================================================================
 // Group 11: GetVenueCities
 // =============================================================================
@@ -1253,3 +1432,90 @@ func (suite *VenueServiceIntegrationTestSuite) TestGetVenueGenreProfile_Success(
 		suite.Greater(g.Count, 0)
 	}
 }
+
+// =============================================================================
+// Group: MergeVenues
+// =============================================================================
+
+func (suite *VenueServiceIntegrationTestSuite) TestMergeVenues_MovesShowsAndDeletesDuplicate() {
+	canonical := suite.createTestVenue("Crescent Ballroom", "Phoenix", "AZ", false)
+	mergeFrom := suite.createTestVenue("Crescent Ballroom PHX", "Phoenix", "AZ", true)
+	user := suite.createTestUser()
+	artist := suite.createArtist("Merge Venue Artist")
+	moved := suite.createApprovedShowWithArtist(mergeFrom.ID, artist.ID, user.ID)
+	suite.Require().NoError(syncShowArtistDedupColumns(suite.db, moved.ID))
+
+	// A show listed at both venues keeps a single show_venues row.
+	both := suite.createApprovedShow(canonical.ID, user.ID)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: both.ID, VenueID: mergeFrom.ID}).Error)
+
+	result, err := suite.venueService.MergeVenues(canonical.ID, mergeFrom.ID)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), result.ShowsMoved)
+	suite.Equal("Crescent Ballroom PHX", result.MergedVenueName)
+
+	var venueIDs []uint
+	suite.db.Model(&catalogm.ShowVenue{}).Where("show_id IN ?", []uint{moved.ID, both.ID}).Pluck("venue_id", &venueIDs)
+	suite.Equal([]uint{canonical.ID, canonical.ID}, venueIDs)
+
+	var denormVenue uint
+	suite.db.Model(&catalogm.ShowArtist{}).Where("show_id = ?", moved.ID).Pluck("venue_id", &denormVenue)
+	suite.Equal(canonical.ID, denormVenue)
+
+	var got catalogm.Venue
+	suite.Require().NoError(suite.db.First(&got, canonical.ID).Error)
+	suite.True(got.Verified, "verification carries forward")
+
+	_, err = suite.venueService.GetVenue(mergeFrom.ID)
+	var venueErr *apperrors.VenueError
+	suite.ErrorAs(err, &venueErr)
+}
+
+func (suite *VenueServiceIntegrationTestSuite) TestMergeVenues_LinksBookmarksAndSlug() {
+	canonical := suite.createTestVenue("Valley Bar", "Phoenix", "AZ", true)
+	mergeFrom := suite.createTestVenue("Valley Bar Phoenix", "Phoenix", "AZ", false)
+	suite.Require().NoError(suite.db.Model(&catalogm.Venue{}).Where("id = ?", mergeFrom.ID).Updates(map[string]interface{}{
+		"slug":      "valley-bar-phoenix",
+		"instagram": "valleybarphx",
+	}).Error)
+	user := suite.createTestUser()
+	other := suite.createTestUser()
+	for _, b := range []struct{ userID, venueID uint }{{user.ID, canonical.ID}, {user.ID, mergeFrom.ID}, {other.ID, mergeFrom.ID}} {
+		suite.Require().NoError(suite.db.Exec(
+			"INSERT INTO user_bookmarks (user_id, entity_type, entity_id, action, created_at) VALUES (?, 'venue', ?, 'follow', NOW())",
+			b.userID, b.venueID,
+		).Error)
+	}
+
+	result, err := suite.venueService.MergeVenues(canonical.ID, mergeFrom.ID)
+	suite.Require().NoError(err)
+	suite.Equal([]string{"instagram"}, result.LinksCopied)
+	suite.Equal(int64(1), result.BookmarksMoved)
+	suite.True(result.SlugRedirected)
+
+	var follows int64
+	suite.db.Table("user_bookmarks").Where("entity_type = 'venue' AND entity_id = ?", canonical.ID).Count(&follows)
+	suite.Equal(int64(2), follows, "one follow per user survives")
+
+	var redirect catalogm.SlugRedirect
+	suite.Require().NoError(suite.db.Where("entity_type = ? AND old_slug = ?", catalogm.SlugRedirectEntityVenue, "valley-bar-phoenix").First(&redirect).Error)
+	suite.Equal(canonical.ID, redirect.EntityID)
+
+	resolved, err := suite.venueService.GetVenueBySlug("valley-bar-phoenix")
+	suite.Require().NoError(err)
+	suite.Equal(canonical.ID, resolved.ID)
+	suite.Equal(resolved.Slug, resolved.CanonicalSlug)
+}
+
+func (suite *VenueServiceIntegrationTestSuite) TestMergeVenues_Errors() {
+	venue := suite.createTestVenue("Lone Venue", "Phoenix", "AZ", false)
+	var venueErr *apperrors.VenueError
+
+	_, err := suite.venueService.MergeVenues(venue.ID, venue.ID)
+	suite.Require().ErrorAs(err, &venueErr)
+	suite.Equal(apperrors.CodeVenueMergeSelf, venueErr.Code)
+
+	_, err = suite.venueService.MergeVenues(venue.ID, 99999)
+	suite.Require().ErrorAs(err, &venueErr)
+	suite.Equal(apperrors.CodeVenueNotFound, venueErr.Code)
+}
@@ -0,0 +1,120 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/utils"
+)
+
+// GetVenueWidget returns the compact upcoming-show list for the embeddable
+// venue widget. "Upcoming" starts at the beginning of today in the venue's
+// own timezone, not the caller's: an embed has no user to ask, and a show
+// tonight should stay listed until the day is over where it happens.
+// Cancelled and postponed shows stay in, flagged, so a venue's embed says
+// so instead of the show silently vanishing.
+func (s *VenueService) GetVenueWidget(venueID uint, count int) (*contracts.VenueWidgetResponse, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var venue catalogm.Venue
+	if err := s.db.First(&venue, venueID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrVenueNotFound(venueID)
+		}
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+
+	loc := utils.EventLocation(venue.Timezone, venue.State)
+	now := time.Now().In(loc)
+	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc).UTC()
+
+	var shows []catalogm.Show
+	if err := s.db.Model(&catalogm.Show{}).
+		Joins("JOIN show_venues ON show_venues.show_id = shows.id").
+		Where("show_venues.venue_id = ? AND shows.status = ? AND shows.deleted_at IS NULL AND shows.event_date >= ?",
+			venueID, catalogm.ShowStatusApproved, startOfToday).
+		Order("shows.event_date ASC, shows.id ASC").
+		Limit(count).
+		Find(&shows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get widget shows: %w", err)
+	}
+
+	// Artist names in lineup order, batch-loaded for the page of shows.
+	artistNames := make(map[uint][]string)
+	if len(shows) > 0 {
+		showIDs := make([]uint, len(shows))
+		for i, show := range shows {
+			showIDs[i] = show.ID
+		}
+		var showArtists []catalogm.ShowArtist
+		if err := s.db.Where("show_id IN ?", showIDs).Order(catalogm.ShowArtistLineupOrder).Find(&showArtists).Error; err != nil {
+			return nil, fmt.Errorf("failed to get widget lineups: %w", err)
+		}
+		artistIDs := make([]uint, len(showArtists))
+		for i, sa := range showArtists {
+			artistIDs[i] = sa.ArtistID
+		}
+		var artists []catalogm.Artist
+		if len(artistIDs) > 0 {
+			if err := s.db.Select("id", "name").Where("id IN ?", artistIDs).Find(&artists).Error; err != nil {
+				return nil, fmt.Errorf("failed to get widget artists: %w", err)
+			}
+		}
+		names := make(map[uint]string, len(artists))
+		for _, artist := range artists {
+			names[artist.ID] = artist.Name
+		}
+		for _, sa := range showArtists {
+			if name, ok := names[sa.ArtistID]; ok {
+				artistNames[sa.ShowID] = append(artistNames[sa.ShowID], name)
+			}
+		}
+	}
+
+	resp := &contracts.VenueWidgetResponse{
+		Venue: contracts.VenueWidgetVenue{
+			ID:       venue.ID,
+			Name:     venue.Name,
+			City:     venue.City,
+			State:    venue.State,
+			Timezone: loc.String(),
+		},
+		Shows: make([]contracts.VenueWidgetShow, len(shows)),
+	}
+	if venue.Slug != nil {
+		resp.Venue.Slug = *venue.Slug
+	}
+	for i, show := range shows {
+		item := contracts.VenueWidgetShow{
+			ID:             show.ID,
+			Title:          show.Title,
+			EventDate:      show.EventDate,
+			EventDateLocal: show.EventDate.In(loc).Format(time.RFC3339),
+			Artists:        artistNames[show.ID],
+			TicketURL:      show.TicketURL,
+			IsSoldOut:      show.IsSoldOut,
+			IsCancelled:    show.IsCancelled,
+			IsPostponed:    show.IsPostponed,
+		}
+		if item.Artists == nil {
+			item.Artists = []string{}
+		}
+		if show.Slug != nil {
+			item.Slug = *show.Slug
+		}
+		if show.DoorsTime != nil {
+			doors := show.DoorsTime.In(loc).Format(time.RFC3339)
+			item.DoorsTimeLocal = &doors
+		}
+		resp.Shows[i] = item
+	}
+	return resp, nil
+}
@@ -9,6 +9,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 // Wikidata client for artist-photo enrichment (PSY-1232).
@@ -39,7 +41,7 @@ type WikidataClient struct {
 // NewWikidataClient builds a production client pointed at the real Wikidata API.
 func NewWikidataClient() *WikidataClient {
 	return &WikidataClient{
-		httpClient:  &http.Client{Timeout: wikidataTimeout},
+		httpClient:  httpclient.New(wikidataTimeout),
 		baseURL:     wikidataBaseURL,
 		rateLimiter: time.NewTicker(wikidataRateLimit),
 	}
@@ -1395,7 +1395,7 @@ func (s *CollectionService) fillResolvedBySlug(entityType string, slugs []string
 	case communitym.CollectionEntityShow:
 		var rows []catalogm.Show
 		if err := s.db.Select("id, title, slug, image_url").
-			Where("slug IN ?", slugs).Find(&rows).Error; err != nil {
+			Where("slug IN ? AND deleted_at IS NULL", slugs).Find(&rows).Error; err != nil {
 			log.Printf("warning: resolve shows by slug failed: %v", err)
 			return
 		}
@@ -2553,7 +2553,7 @@ func (s *CollectionService) batchResolveEntityNames(entityIDsByType map[string][
 
 		case communitym.CollectionEntityShow:
 			var shows []catalogm.Show
-			s.db.Select("id, title, slug, image_url").Where("id IN ?", ids).Find(&shows)
+			s.db.Select("id, title, slug, image_url").Where("id IN ? AND deleted_at IS NULL", ids).Find(&shows)
 			for _, sh := range shows {
 				key := fmt.Sprintf("%s:%d", entityType, sh.ID)
 				names[key] = sh.Title
@@ -3129,7 +3129,7 @@ func (s *CollectionService) batchUpcomingShowCountForArtists(artistIDs []uint) m
 	s.db.Table("show_artists").
 		Select("show_artists.artist_id, COUNT(DISTINCT shows.id) AS show_count").
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
-		Where("show_artists.artist_id IN ? AND shows.status = ? AND shows.event_date > NOW()",
+		Where("show_artists.artist_id IN ? AND shows.status = ? AND shows.deleted_at IS NULL AND shows.event_date > NOW()",
 			artistIDs, catalogm.ShowStatusApproved).
 		Group("show_artists.artist_id").
 		Scan(&rows)
@@ -3523,7 +3523,7 @@ func (s *CollectionService) loadEntityDetailsForGraph(entityType string, ids []u
 	case communitym.CollectionEntityShow:
 		if err := s.db.Table("shows").
 			Select("id, title AS name, slug, city, state").
-			Where("id IN ?", ids).
+			Where("id IN ? AND deleted_at IS NULL", ids).
 			Order("title ASC").Scan(&raws).Error; err != nil {
 			return nil, fmt.Errorf("failed to load show details: %w", err)
 		}
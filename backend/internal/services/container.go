@@ -15,9 +15,12 @@ import (
 	"psychic-homily-backend/internal/services/discography"
 	"psychic-homily-backend/internal/services/engagement"
 	"psychic-homily-backend/internal/services/enrich"
+	"psychic-homily-backend/internal/services/geo"
+	"psychic-homily-backend/internal/services/geoip"
 	exploresvc "psychic-homily-backend/internal/services/explore"
 	"psychic-homily-backend/internal/services/imageenrich"
 	"psychic-homily-backend/internal/services/mbadapter"
+	"psychic-homily-backend/internal/services/media"
 	"psychic-homily-backend/internal/services/notification"
 	"psychic-homily-backend/internal/services/pipeline"
 	"psychic-homily-backend/internal/services/ratelimit"
@@ -34,18 +37,29 @@ type ServiceContainer struct {
 	AdminStats             *adminsvc.AdminStatsService
 	Analytics              *adminsvc.AnalyticsService
 	APIToken               *adminsvc.APITokenService
+	APIKey                 *auth.APIKeyService
+	AdminEvents            *adminsvc.AdminEventBus
+	Idempotency            *adminsvc.IdempotencyService
+	FeatureFlags           *adminsvc.FeatureFlagService
+	AdminAnnotation        *adminsvc.AdminAnnotationService
+	Announcement           *adminsvc.AnnouncementService
 	DataQuality            *adminsvc.DataQualityService
+	VisibilityDebug        *adminsvc.VisibilityDebugService
 	Revision               *adminsvc.RevisionService
 	PendingEdit            *adminsvc.PendingEditService
 	Charts                 *catalog.ChartsService
 	Artist                 *catalog.ArtistService
+	ArtistClaim            *catalog.ArtistClaimService
 	ContributorProfile     *usersvc.ContributorProfileService
 	ArtistReport           *adminsvc.ArtistReportService
+	VenueReport            *adminsvc.VenueReportService
 	AuditLog               *adminsvc.AuditLogService
 	Explore                *exploresvc.ExploreService
 	EntityExistence        *catalog.EntityExistenceService
+	EntityAlias            *catalog.EntityAliasService
 	Bookmark               *engagement.BookmarkService
 	Calendar               *engagement.CalendarService
+	CalendarImport         *engagement.CalendarImportService
 	Collection             *community.CollectionService
 	Request                *community.RequestService
 	EntityRequest          *community.EntityRequestService
@@ -64,7 +78,16 @@ type ServiceContainer struct {
 	Release                *catalog.ReleaseService
 	SavedRelease           *engagement.SavedReleaseService
 	SavedShow              *engagement.SavedShowService
+	ShowRSVP               *engagement.ShowRSVPService
+	Recommendation         *engagement.RecommendationService
 	Show                   *catalog.ShowService
+	ShowDraft              *catalog.ShowDraftService
+	ShowSeries             *catalog.ShowSeriesService
+	ShowUpdate             *catalog.ShowUpdateService
+	Sitemap                *catalog.SitemapService
+	Sync                   *catalog.SyncService
+	NearbyShows            *catalog.NearbyShowsService
+	NotificationPreference *notification.NotificationPreferenceService
 	ShowReport             *adminsvc.ShowReportService
 	EntityReport           *adminsvc.EntityReportService
 	User                   *usersvc.UserService
@@ -72,9 +95,12 @@ type ServiceContainer struct {
 	Radio                  *catalog.RadioService
 	RadioFetch             *catalog.RadioFetchService
 	RelationshipDerivation *catalog.RelationshipDerivationService
+	TicketAvailability     *catalog.TicketAvailabilityService
 	Venue                  *catalog.VenueService
+	VenueClaim             *catalog.VenueClaimService
 	SourceConfig           *sourceregistry.SourceConfigService
 	AIExtractionThrottle   *ratelimit.AIExtractionThrottleService
+	SubmissionThrottle     *ratelimit.SubmissionThrottleService
 	StreamingWorklist      *pipeline.StreamingWorklistService
 	DiscoverMusic          *pipeline.DiscoverMusicService
 	LinkSuggestion         *pipeline.LinkSuggestionService
@@ -83,8 +109,14 @@ type ServiceContainer struct {
 
 	// Config-only services
 	Discord            *notification.DiscordService
+	Slack              *notification.SlackNotifier
+	// AdminNotifier posts admin notifications to Discord and/or Slack;
+	// handlers and jobs take this rather than a single channel.
+	AdminNotifier      *notification.AdminNotifier
 	Email              *notification.EmailService
+	EmailSuppressions  *notification.EmailSuppressionService
 	NotificationFilter *notification.NotificationFilterService
+	Push               *notification.PushService
 
 	// No-param services
 	PasswordValidator *auth.PasswordValidator
@@ -94,11 +126,14 @@ type ServiceContainer struct {
 	JWT                    *auth.JWTService
 	AppleAuth              *auth.AppleAuthService
 	Extraction             *pipeline.ExtractionService
+	Flyer                  *media.FlyerService
 	WebAuthn               *auth.WebAuthnService // nil if init fails (passkeys optional)
 	Cleanup                *adminsvc.CleanupService
 	DataSync               *adminsvc.DataSyncService
 	Discovery              *pipeline.DiscoveryService
+	DiscoverySourceMonitor *pipeline.DiscoverySourceMonitor
 	Reminder               *engagement.ReminderService
+	ShowChangeNotification *engagement.ShowChangeNotificationService
 	Enrichment             *pipeline.EnrichmentService
 	EnrichmentWorker       *pipeline.EnrichmentWorker
 	ImageEnrichSweep       *imageenrich.ImageEnrichmentSweep
@@ -124,8 +159,14 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 	}
 
 	savedShow := engagement.NewSavedShowService(database)
-	email := notification.NewEmailService(cfg)
+	showRSVP := engagement.NewShowRSVPService(database)
+	recommendation := engagement.NewRecommendationService(database)
+	emailSuppressions := notification.NewEmailSuppressionService(database)
+	email := notification.NewEmailService(cfg, emailSuppressions)
 	userService := usersvc.NewUserService(database)
+	// Deletion receipts are signed with the same app secret as the other
+	// server-issued HMACs (unsubscribe tokens, OAuth link intents).
+	userService.SetDeletionReceiptKey(cfg.OAuth.SecretKey)
 
 	// Shared catalog services. extraction backs the ShowHandler AI
 	// show-from-text path; discovery powers the external discovery-app import.
@@ -139,6 +180,8 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 	jwtService := auth.NewJWTService(database, cfg, userService)
 
 	discord := notification.NewDiscordService(cfg)
+	slack := notification.NewSlackNotifier(cfg)
+	adminNotifier := notification.NewAdminNotifier(discord, slack)
 
 	// PSY-1208: ONE shared MusicBrainz client across discovery + enrichment.
 	// MusicBrainz blocks for exceeding ~1 req/s/IP; two independent clients (one
@@ -225,6 +268,19 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 	savedRelease := engagement.NewSavedReleaseService(database, releaseSvc)
 	festivalSvc := catalog.NewFestivalService(database)
 	showSvc := catalog.NewShowService(database)
+
+	// In-process bus for admin queue events (new pending shows, reports and
+	// venue edits), streamed to admins over /admin/events.
+	adminEvents := adminsvc.NewAdminEventBus()
+	showSvc.SetEventPublisher(adminEvents)
+	showReportSvc := adminsvc.NewShowReportService(database)
+	showReportSvc.SetEventPublisher(adminEvents)
+	artistReportSvc := adminsvc.NewArtistReportService(database)
+	artistReportSvc.SetEventPublisher(adminEvents)
+	venueReportSvc := adminsvc.NewVenueReportService(database)
+	venueReportSvc.SetEventPublisher(adminEvents)
+	entityReportSvc := adminsvc.NewEntityReportService(database)
+	entityReportSvc.SetEventPublisher(adminEvents)
 	entityRequestSvc := community.NewEntityRequestService(database)
 	entityRequestFulfiller := community.NewEntityRequestFulfiller(artist, venue, labelSvc, releaseSvc, festivalSvc, showSvc)
 
@@ -233,10 +289,25 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 	// (source=mb_backfill).
 	releaseLinksSweep := enrich.NewReleaseLinksSweep(database, mbClient, releaseSvc)
 
+	// Shared instance: the reminder job checks the saved-show reminder cell
+	// before emailing.
+	notificationPreferenceSvc := notification.NewNotificationPreferenceService(database)
+
+	// Web Push is an alternative channel for show reminders and venue
+	// subscription matches; both check the push preference cell before
+	// sending. Disabled (subscribe refuses, sends no-op) without VAPID keys.
+	pushSvc := notification.NewPushService(database, cfg.Push)
+	notificationFilterSvc := notification.NewNotificationFilterService(database, email, cfg.JWT.KeyRing(), cfg.Email.FrontendURL)
+	notificationFilterSvc.SetPushDelivery(pushSvc, notificationPreferenceSvc)
+	reminderSvc := engagement.NewReminderService(database, email, notificationPreferenceSvc, cfg)
+	reminderSvc.SetPushService(pushSvc)
+	showChangeNotificationSvc := engagement.NewShowChangeNotificationService(database, email, notificationPreferenceSvc, cfg)
+	showChangeNotificationSvc.SetPushService(pushSvc)
+
 	// PSY-289: wire the comment notifier into the comment service so new
 	// comments fan out notification emails fire-and-forget.
 	commentSvc := engagement.NewCommentService(database, utils.NewMarkdownRenderer())
-	commentNotificationSvc := engagement.NewCommentNotificationService(database, email, cfg.JWT.SecretKey, cfg.Email.FrontendURL)
+	commentNotificationSvc := engagement.NewCommentNotificationService(database, email, cfg.JWT.KeyRing(), cfg.Email.FrontendURL)
 	commentSvc.SetNotifier(commentNotificationSvc)
 
 	// PSY-354: collections get tag support via the polymorphic entity_tags
@@ -256,26 +327,70 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 	// ArtistService.UpdateArtist's profile→embed resolver. Inject the artist
 	// service so the approval flow can resolve a newly-set profile root into the
 	// bandcamp_embed_url (fill-when-empty).
-	pendingEditSvc := adminsvc.NewPendingEditService(database, revisionSvc, email, cfg.Email.FrontendURL, engagement.DeriveBackendURL(cfg.Email.FrontendURL), cfg.JWT.SecretKey)
+	pendingEditSvc := adminsvc.NewPendingEditService(database, revisionSvc, email, cfg.Email.FrontendURL, engagement.DeriveBackendURL(cfg.Email.FrontendURL), cfg.JWT.KeyRing())
 	pendingEditSvc.SetBandcampFiller(artist)
+	pendingEditSvc.SetEventPublisher(adminEvents)
+
+	// Optional street-level venue geocoding (GEOCODING_PROVIDER). Config.Load
+	// already validated the provider, so an error here is unexpected; log it
+	// and keep the offline city centroids rather than failing startup.
+	if addressGeocoder, err := geo.NewAddressGeocoder(cfg.Geocoding.Provider, cfg.Geocoding.BaseURL, cfg.Geocoding.APIKey); err != nil {
+		log.Printf("Warning: address geocoding disabled: %v", err)
+	} else if addressGeocoder != nil {
+		venue.SetAddressGeocoder(addressGeocoder)
+		pendingEditSvc.SetVenueAddressGeocoder(venue.QueueAddressGeocode)
+	}
+
+	// Show flyer uploads (MEDIA_*). Config.Load already validated the
+	// storage settings; on an unexpected error uploads answer 503 instead
+	// of failing startup.
+	flyer, err := media.NewFlyerServiceFromConfig(database, cfg.Media)
+	if err != nil {
+		log.Printf("Warning: flyer uploads disabled: %v", err)
+		flyer = media.NewFlyerService(database, nil, cfg.Media.MaxUploadBytes)
+	}
+	showSvc.SetFlyerRemover(flyer.RemoveFlyerObjects)
+
+	// "Shows near me": the GeoIP database is optional. Without one (or if it
+	// fails to load) every visitor is served the default cities.
+	var locator geoip.Locator
+	if path := cfg.Nearby.GeoIPDatabasePath; path != "" {
+		if reader, err := geoip.Open(path); err != nil {
+			log.Printf("Warning: geoip lookups disabled: %v", err)
+		} else {
+			locator = reader
+		}
+	}
+	nearbyShows := catalog.NewNearbyShowsService(showSvc, locator, catalog.ParseCityStateFilters(cfg.Nearby.DefaultCities, config.MaxNearbyDefaultCities))
 
 	return &ServiceContainer{
 		// DB-only leaf services
 		AdminStats:             adminsvc.NewAdminStatsService(database),
 		Analytics:              adminsvc.NewAnalyticsService(database),
 		APIToken:               adminsvc.NewAPITokenService(database),
+		Idempotency:            adminsvc.NewIdempotencyService(database),
+		FeatureFlags:           adminsvc.NewFeatureFlagService(database, os.Getenv(config.EnvEnvironment)),
+		AdminAnnotation:        adminsvc.NewAdminAnnotationService(database),
+		Announcement:           adminsvc.NewAnnouncementService(database),
+		APIKey:                 auth.NewAPIKeyService(database),
+		AdminEvents:            adminEvents,
 		DataQuality:            adminsvc.NewDataQualityService(database),
+		VisibilityDebug:        adminsvc.NewVisibilityDebugService(database),
 		Revision:               revisionSvc,
 		PendingEdit:            pendingEditSvc,
 		Charts:                 catalog.NewChartsService(database),
 		Artist:                 artist,
+		ArtistClaim:            catalog.NewArtistClaimService(database),
 		ContributorProfile:     usersvc.NewContributorProfileService(database),
-		ArtistReport:           adminsvc.NewArtistReportService(database),
+		ArtistReport:           artistReportSvc,
+		VenueReport:            venueReportSvc,
 		AuditLog:               adminsvc.NewAuditLogService(database),
 		Explore:                exploreService,
 		EntityExistence:        catalog.NewEntityExistenceService(database),
+		EntityAlias:            catalog.NewEntityAliasService(database),
 		Bookmark:               engagement.NewBookmarkService(database),
 		Calendar:               engagement.NewCalendarService(database, savedShow),
+		CalendarImport:         engagement.NewCalendarImportService(database),
 		Collection:             collectionSvc,
 		Request:                community.NewRequestService(database),
 		EntityRequest:          entityRequestSvc,
@@ -294,17 +409,29 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 		Release:                releaseSvc,
 		SavedRelease:           savedRelease,
 		SavedShow:              savedShow,
+		ShowRSVP:               showRSVP,
+		Recommendation:         recommendation,
 		Show:                   showSvc,
-		ShowReport:             adminsvc.NewShowReportService(database),
-		EntityReport:           adminsvc.NewEntityReportService(database),
+		ShowDraft:              catalog.NewShowDraftService(database),
+		ShowSeries:             catalog.NewShowSeriesService(database, showSvc),
+		ShowUpdate:             catalog.NewShowUpdateService(database),
+		Sitemap:                catalog.NewSitemapService(database, cfg.Email.FrontendURL, engagement.DeriveBackendURL(cfg.Email.FrontendURL)),
+		Sync:                   catalog.NewSyncService(database),
+		NearbyShows:            nearbyShows,
+		NotificationPreference: notificationPreferenceSvc,
+		ShowReport:             showReportSvc,
+		EntityReport:           entityReportSvc,
 		User:                   userService,
 		Leaderboard:            usersvc.NewLeaderboardService(database),
 		Radio:                  radioSvc,
-		RadioFetch:             catalog.NewRadioFetchService(radioSvc, discord),
+		RadioFetch:             catalog.NewRadioFetchService(radioSvc, adminNotifier),
 		RelationshipDerivation: catalog.NewRelationshipDerivationService(artistRelSvc),
+		TicketAvailability:     catalog.NewTicketAvailabilityService(database),
 		Venue:                  venue,
+		VenueClaim:             catalog.NewVenueClaimService(database),
 		SourceConfig:           sourceConfig,
 		AIExtractionThrottle:   ratelimit.NewAIExtractionThrottleService(database),
+		SubmissionThrottle:     ratelimit.NewSubmissionThrottleService(database, cfg.Submissions, adminNotifier),
 		StreamingWorklist:      pipeline.NewStreamingWorklistService(database),
 		DiscoverMusic:          pipeline.NewDiscoverMusicService(database, mbClient),
 		// PSY-1199: the link-suggestion accept path reuses the artist write path
@@ -315,8 +442,12 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 
 		// Config-only services
 		Discord:            discord,
+		Slack:              slack,
+		AdminNotifier:      adminNotifier,
 		Email:              email,
-		NotificationFilter: notification.NewNotificationFilterService(database, email, cfg.JWT.SecretKey, cfg.Email.FrontendURL),
+		EmailSuppressions:  emailSuppressions,
+		NotificationFilter: notificationFilterSvc,
+		Push:               pushSvc,
 
 		// No-param services
 		PasswordValidator: auth.NewPasswordValidator(),
@@ -326,11 +457,14 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 		JWT:                    jwtService,
 		AppleAuth:              auth.NewAppleAuthService(database, cfg, jwtService),
 		Extraction:             extraction,
+		Flyer:                  flyer,
 		WebAuthn:               webauthnService,
-		Cleanup:                adminsvc.NewCleanupService(database, userService),
+		Cleanup:                adminsvc.NewCleanupService(database, userService, showSvc),
 		DataSync:               adminsvc.NewDataSyncService(database),
 		Discovery:              discovery,
-		Reminder:               engagement.NewReminderService(database, email, cfg),
+		DiscoverySourceMonitor: pipeline.NewDiscoverySourceMonitor(discovery, adminNotifier),
+		Reminder:               reminderSvc,
+		ShowChangeNotification: showChangeNotificationSvc,
 		Enrichment:             enrichmentSvc,
 		EnrichmentWorker:       enrichmentWorker,
 		ImageEnrichSweep:       imageEnrichSweep,
@@ -339,7 +473,7 @@ func NewServiceContainer(database *gorm.DB, cfg *config.Config) *ServiceContaine
 		ArtistDiscographySweep: artistDiscographySweep,
 		ArtistLinksSweep:       artistLinksSweep,
 		ReleaseLinksSweep:      releaseLinksSweep,
-		AutoPromotion:          adminsvc.NewAutoPromotionService(database, email, engagement.DeriveBackendURL(cfg.Email.FrontendURL), cfg.JWT.SecretKey),
+		AutoPromotion:          adminsvc.NewAutoPromotionService(database, email, engagement.DeriveBackendURL(cfg.Email.FrontendURL), cfg.JWT.KeyRing()),
 		CollectionDigest:       engagement.NewCollectionDigestService(database, email, cfg),
 		SceneDigest:            engagement.NewSceneDigestService(database, email, sceneSvc, cfg),
 	}
@@ -1,6 +1,8 @@
 package contracts
 
 import (
+	"context"
+	"io"
 	"time"
 
 	adminm "psychic-homily-backend/internal/models/admin"
@@ -49,6 +51,7 @@ type AdminDashboardStats struct {
 	PendingVenueEdits    int64 `json:"pending_venue_edits"`
 	PendingReports       int64 `json:"pending_reports"`
 	PendingArtistReports int64 `json:"pending_artist_reports"`
+	PendingVenueReports  int64 `json:"pending_venue_reports"`
 	UnverifiedVenues     int64 `json:"unverified_venues"`
 
 	// Content totals
@@ -70,6 +73,129 @@ type AdminDashboardStats struct {
 	TotalUsersTrend   int64 `json:"total_users_trend"`
 }
 
+// ──────────────────────────────────────────────
+// Review Queue Stats types
+// ──────────────────────────────────────────────
+
+// ReviewQueueAging counts pending shows by how long they have been waiting.
+// Buckets are cumulative: a show pending for 8 days is counted in all three.
+type ReviewQueueAging struct {
+	Total     int64 `json:"total"`
+	Over24h   int64 `json:"over_24h"`
+	Over3Days int64 `json:"over_3_days"`
+	Over7Days int64 `json:"over_7_days"`
+}
+
+// ReviewerApprovalCount is the number of shows one admin approved in the range.
+type ReviewerApprovalCount struct {
+	AdminID   uint   `json:"admin_id"`
+	AdminName string `json:"admin_name"`
+	Approvals int64  `json:"approvals"`
+}
+
+// RejectionReasonCount is the number of rejections for one rejection category.
+type RejectionReasonCount struct {
+	Category string `json:"category"`
+	Count    int64  `json:"count"`
+}
+
+// ReviewQueueStats contains show review-queue analytics for a date range.
+// Aging reflects the queue as it stands now and ignores the range.
+type ReviewQueueStats struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	Approvals             int64    `json:"approvals"`
+	Rejections            int64    `json:"rejections"`
+	MedianHoursToApproval *float64 `json:"median_hours_to_approval"`
+
+	Aging            ReviewQueueAging        `json:"aging"`
+	ApprovalsByAdmin []ReviewerApprovalCount `json:"approvals_by_admin"`
+	RejectionReasons []RejectionReasonCount  `json:"rejection_reasons"`
+}
+
+// ──────────────────────────────────────────────
+// Stats Time Series types
+// ──────────────────────────────────────────────
+
+// Time-series metrics.
+const (
+	StatsMetricShowsSubmitted = "shows_submitted"
+	StatsMetricShowsApproved  = "shows_approved"
+	StatsMetricSignups        = "signups"
+	StatsMetricReports        = "reports"
+)
+
+// Time-series bucket intervals. Buckets are UTC; weeks start on Monday.
+const (
+	StatsIntervalDay   = "day"
+	StatsIntervalWeek  = "week"
+	StatsIntervalMonth = "month"
+)
+
+// StatsTimeSeriesPoint is the count for one bucket, keyed by its start.
+type StatsTimeSeriesPoint struct {
+	Bucket time.Time `json:"bucket"`
+	Count  int64     `json:"count"`
+}
+
+// StatsTimeSeries is a metric bucketed by interval from From to To. Every
+// bucket in the range has a point, zero-count ones included; the last
+// bucket is partial.
+type StatsTimeSeries struct {
+	Metric   string                 `json:"metric"`
+	Interval string                 `json:"interval"`
+	From     time.Time              `json:"from"`
+	To       time.Time              `json:"to"`
+	Total    int64                  `json:"total"`
+	Points   []StatsTimeSeriesPoint `json:"points"`
+}
+
+// ──────────────────────────────────────────────
+// Moderation Queue types
+// ──────────────────────────────────────────────
+
+// Moderation queue item types.
+const (
+	ModerationItemShow         = "show"
+	ModerationItemVenueEdit    = "venue_edit"
+	ModerationItemShowReport   = "show_report"
+	ModerationItemArtistReport = "artist_report"
+	ModerationItemVenueReport  = "venue_report"
+)
+
+// ModerationItemTypes lists every item type the moderation queue returns.
+var ModerationItemTypes = []string{
+	ModerationItemShow,
+	ModerationItemVenueEdit,
+	ModerationItemShowReport,
+	ModerationItemArtistReport,
+	ModerationItemVenueReport,
+}
+
+// ModerationQueueItem is one pending item in the moderation queue. ID is the
+// item's own ID (the show, pending edit or report) and is what the type's
+// review endpoints take; EntityType/EntityID name what the item is about.
+type ModerationQueueItem struct {
+	Type        string    `json:"type"`
+	ID          uint      `json:"id"`
+	EntityType  string    `json:"entity_type"`
+	EntityID    uint      `json:"entity_id"`
+	Title       string    `json:"title"`
+	Detail      *string   `json:"detail,omitempty"` // report type or edit summary
+	SubmittedBy *uint     `json:"submitted_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ModerationQueue is a page of pending moderation items, oldest first.
+// Counts covers every type regardless of the type filter; Total is the size
+// of the filtered queue.
+type ModerationQueue struct {
+	Items  []ModerationQueueItem `json:"items"`
+	Total  int64                 `json:"total"`
+	Counts map[string]int64      `json:"counts"`
+}
+
 // ──────────────────────────────────────────────
 // Activity Feed types
 // ──────────────────────────────────────────────
@@ -90,6 +216,29 @@ type ActivityFeedResponse struct {
 	Events []ActivityEvent `json:"events"`
 }
 
+// ──────────────────────────────────────────────
+// Admin Queue Event types
+// ──────────────────────────────────────────────
+
+// Admin queue event types, streamed to admins as they happen.
+const (
+	AdminEventShowSubmitted    = "show_submitted"
+	AdminEventReportCreated    = "report_created"
+	AdminEventVenueEditPending = "venue_edit_pending"
+)
+
+// AdminEvent announces a new item in one of the admin review queues. ID is
+// the queue item (show, report or pending edit); EntityType/EntityID name
+// the thing it is about.
+type AdminEvent struct {
+	Type       string    `json:"type"`
+	ID         uint      `json:"id"`
+	EntityType string    `json:"entity_type"`
+	EntityID   uint      `json:"entity_id"`
+	Summary    string    `json:"summary,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
 // ──────────────────────────────────────────────
 // API Token types
 // ──────────────────────────────────────────────
@@ -125,6 +274,10 @@ type ExportedArtist struct {
 	City             *string `json:"city,omitempty"`
 	State            *string `json:"state,omitempty"`
 	BandcampEmbedURL *string `json:"bandcampEmbedUrl,omitempty"`
+	Bio              *string `json:"bio,omitempty"` // markdown source
+	Hometown         *string `json:"hometown,omitempty"`
+	Pronouns         *string `json:"pronouns,omitempty"`
+	ImageURL         *string `json:"imageUrl,omitempty"`
 	Instagram        *string `json:"instagram,omitempty"`
 	Facebook         *string `json:"facebook,omitempty"`
 	Twitter          *string `json:"twitter,omitempty"`
@@ -132,6 +285,7 @@ type ExportedArtist struct {
 	Spotify          *string `json:"spotify,omitempty"`
 	SoundCloud       *string `json:"soundcloud,omitempty"`
 	Bandcamp         *string `json:"bandcamp,omitempty"`
+	TikTok           *string `json:"tiktok,omitempty"`
 	Website          *string `json:"website,omitempty"`
 }
 
@@ -150,6 +304,7 @@ type ExportedVenue struct {
 	Spotify    *string `json:"spotify,omitempty"`
 	SoundCloud *string `json:"soundcloud,omitempty"`
 	Bandcamp   *string `json:"bandcamp,omitempty"`
+	TikTok     *string `json:"tiktok,omitempty"`
 	Website    *string `json:"website,omitempty"`
 }
 
@@ -166,7 +321,6 @@ type ExportedShow struct {
 	EventDate      string               `json:"eventDate"` // ISO format
 	City           *string              `json:"city,omitempty"`
 	State          *string              `json:"state,omitempty"`
-	Price          *float64             `json:"price,omitempty"`
 	AgeRequirement *string              `json:"ageRequirement,omitempty"`
 	Description    *string              `json:"description,omitempty"`
 	Status         string               `json:"status"`
@@ -174,6 +328,14 @@ type ExportedShow struct {
 	IsCancelled    bool                 `json:"isCancelled"`
 	Venues         []ExportedVenue      `json:"venues"`
 	Artists        []ExportedShowArtist `json:"artists"`
+
+	// Structured price. Price is the pre-range single value, read on import
+	// from older exports that lack priceMin (0 meaning free).
+	Price         *float64 `json:"price,omitempty"`
+	PriceMin      *float64 `json:"priceMin,omitempty"`
+	PriceMax      *float64 `json:"priceMax,omitempty"`
+	PriceCurrency string   `json:"priceCurrency,omitempty"`
+	IsFree        bool     `json:"isFree,omitempty"`
 }
 
 // ExportShowsParams contains filters for show export
@@ -222,6 +384,22 @@ type ExportVenuesResult struct {
 	Total  int64           `json:"total"`
 }
 
+// CSV export entity types.
+const (
+	CSVExportShows  = "shows"
+	CSVExportVenues = "venues"
+	CSVExportUsers  = "users"
+)
+
+// CSVExportParams selects the columns and date range of a CSV export. Nil
+// Columns exports every column. Shows filter on event_date; venues and users
+// on created_at. To is exclusive.
+type CSVExportParams struct {
+	Columns []string
+	From    *time.Time
+	To      *time.Time
+}
+
 // DataImportRequest represents a data import request
 type DataImportRequest struct {
 	Shows   []ExportedShow   `json:"shows,omitempty"`
@@ -257,6 +435,38 @@ type DataImportResult struct {
 	} `json:"venues"`
 }
 
+// Data import validation error codes reported per row.
+const (
+	DataImportRowRequired     = "required"      // a required field is empty
+	DataImportRowInvalidDate  = "invalid_date"  // event date is not RFC 3339
+	DataImportRowInvalidValue = "invalid_value" // e.g. an unknown show status
+	DataImportRowUnknownVenue = "unknown_venue" // venue neither in the database nor the batch
+	DataImportRowConflict     = "conflict"      // would violate a uniqueness constraint
+)
+
+// DataImportRowError is one problem found while validating a batch import.
+// Row is the zero-based index into the request's list for Entity.
+type DataImportRowError struct {
+	Entity  string `json:"entity"` // "show", "artist" or "venue"
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// DataImportValidationReport is the result of a validation-only import pass.
+// Token is empty when the batch has errors; otherwise it commits exactly the
+// validated payload until ExpiresAt.
+type DataImportValidationReport struct {
+	Valid     bool                 `json:"valid"`
+	Token     string               `json:"token,omitempty"`
+	ExpiresAt *time.Time           `json:"expiresAt,omitempty"`
+	Shows     int                  `json:"shows"`
+	Artists   int                  `json:"artists"`
+	Venues    int                  `json:"venues"`
+	Errors    []DataImportRowError `json:"errors"`
+}
+
 // ──────────────────────────────────────────────
 // Data Quality types
 // ──────────────────────────────────────────────
@@ -361,6 +571,23 @@ type DataQualityTrendsResponse struct {
 	InactiveVenues90d      int            `json:"inactive_venues_90d"`
 }
 
+// ──────────────────────────────────────────────
+// Admin Event Bus Interface
+// ──────────────────────────────────────────────
+
+// AdminEventPublisher is the publish side of the admin event bus. Services
+// that create queue items take one; a nil publisher means "don't publish".
+type AdminEventPublisher interface {
+	Publish(event AdminEvent)
+}
+
+// AdminEventBusInterface defines the contract for the in-process admin
+// event bus.
+type AdminEventBusInterface interface {
+	AdminEventPublisher
+	Subscribe() (<-chan AdminEvent, func())
+}
+
 // ──────────────────────────────────────────────
 // Show Report Service Interface
 // ──────────────────────────────────────────────
@@ -390,6 +617,20 @@ type ArtistReportServiceInterface interface {
 	GetReportByID(reportID uint) (*communitym.ArtistReport, error)
 }
 
+// ──────────────────────────────────────────────
+// Venue Report Service Interface
+// ──────────────────────────────────────────────
+
+// VenueReportServiceInterface defines the contract for venue report operations.
+type VenueReportServiceInterface interface {
+	CreateReport(userID, venueID uint, reportType string, details *string) (*VenueReportResponse, error)
+	GetUserReportForVenue(userID, venueID uint) (*VenueReportResponse, error)
+	GetPendingReports(limit, offset int) ([]*VenueReportResponse, int64, error)
+	DismissReport(reportID, adminID uint, notes *string) (*VenueReportResponse, error)
+	ResolveReport(reportID, adminID uint, notes *string) (*VenueReportResponse, error)
+	GetReportByID(reportID uint) (*communitym.VenueReport, error)
+}
+
 // ──────────────────────────────────────────────
 // Audit Log Service Interface
 // ──────────────────────────────────────────────
@@ -420,6 +661,31 @@ type APITokenServiceInterface interface {
 	CleanupExpiredTokens() (int64, error)
 }
 
+// ──────────────────────────────────────────────
+// Idempotency Service Interface
+// ──────────────────────────────────────────────
+
+// IdempotencyRequest identifies a request carrying an Idempotency-Key.
+type IdempotencyRequest struct {
+	Scope       string // caller namespace, e.g. "user:12"
+	Key         string
+	Method      string
+	Path        string
+	RequestHash string // hex SHA-256 of method, path and body
+}
+
+// IdempotencyServiceInterface defines the contract for the Idempotency-Key
+// replay store.
+type IdempotencyServiceInterface interface {
+	// Acquire claims the key. When the key is already held or completed,
+	// acquired is false and record is the stored entry.
+	Acquire(req IdempotencyRequest) (record *adminm.IdempotencyKey, acquired bool, err error)
+	// Complete stores the response for a claimed key.
+	Complete(id uint, statusCode int, contentType string, body []byte) error
+	// Release drops a claimed key so a retry can run the request again.
+	Release(id uint) error
+}
+
 // ──────────────────────────────────────────────
 // Data Sync Service Interface
 // ──────────────────────────────────────────────
@@ -430,6 +696,10 @@ type DataSyncServiceInterface interface {
 	ExportArtists(params ExportArtistsParams) (*ExportArtistsResult, error)
 	ExportVenues(params ExportVenuesParams) (*ExportVenuesResult, error)
 	ImportData(req DataImportRequest) (*DataImportResult, error)
+	ValidateImport(req DataImportRequest, adminID uint) (*DataImportValidationReport, error)
+	CommitImport(token string, adminID uint) (*DataImportResult, error)
+	ValidateCSVExport(entity string, params CSVExportParams) error
+	WriteCSV(ctx context.Context, entity string, params CSVExportParams, w io.Writer) error
 }
 
 // ──────────────────────────────────────────────
@@ -440,6 +710,13 @@ type DataSyncServiceInterface interface {
 type AdminStatsServiceInterface interface {
 	GetDashboardStats() (*AdminDashboardStats, error)
 	GetRecentActivity() (*ActivityFeedResponse, error)
+	GetReviewQueueStats(from, to time.Time) (*ReviewQueueStats, error)
+	// GetStatsTimeSeries returns metric bucketed by interval from the
+	// bucket containing from up to now. Results are cached briefly.
+	GetStatsTimeSeries(metric, interval string, from time.Time) (*StatsTimeSeries, error)
+	// GetModerationQueue returns pending moderation items of every type (or
+	// only itemType, when set), oldest first.
+	GetModerationQueue(itemType string, limit, offset int) (*ModerationQueue, error)
 }
 
 // ──────────────────────────────────────────────
@@ -453,6 +730,29 @@ type RevisionServiceInterface interface {
 	GetRevision(revisionID uint) (*adminm.Revision, error)
 	GetUserRevisions(userID uint, limit, offset int) ([]adminm.Revision, int64, error)
 	Rollback(revisionID uint, adminUserID uint) error
+	GetRevisionSnapshot(revisionID uint) (*RevisionSnapshot, error)
+	RestoreRevision(revisionID uint, adminUserID uint) (*RevisionSnapshot, error)
+}
+
+// RevisionSnapshotField is one field's value as of a revision alongside its
+// latest recorded value.
+type RevisionSnapshotField struct {
+	Field        string      `json:"field"`
+	Value        interface{} `json:"value"`
+	CurrentValue interface{} `json:"current_value"`
+	Changed      bool        `json:"changed"`
+}
+
+// RevisionSnapshot reconstructs an entity's tracked fields as they stood
+// right after a revision was applied, from that revision and every later one.
+// Fields no revision has touched are omitted.
+type RevisionSnapshot struct {
+	RevisionID     uint                    `json:"revision_id"`
+	EntityType     string                  `json:"entity_type"`
+	EntityID       uint                    `json:"entity_id"`
+	CreatedAt      time.Time               `json:"created_at"`
+	LaterRevisions int                     `json:"later_revisions"`
+	Fields         []RevisionSnapshotField `json:"fields"`
 }
 
 // BandcampProfileFillerInterface is the narrow contract the pending-edit approval
@@ -494,3 +794,197 @@ type AnalyticsServiceInterface interface {
 	GetCommunityHealth() (*CommunityHealthResponse, error)
 	GetDataQualityTrends(months int) (*DataQualityTrendsResponse, error)
 }
+
+// ──────────────────────────────────────────────
+// Feature Flag types
+// ──────────────────────────────────────────────
+
+// FeatureFlagResponse represents a feature flag in API responses
+type FeatureFlagResponse struct {
+	ID               uint     `json:"id"`
+	Key              string   `json:"key"`
+	Description      *string  `json:"description,omitempty"`
+	Enabled          bool     `json:"enabled"`
+	EnabledForAdmins bool     `json:"enabled_for_admins"`
+	Environments     []string `json:"environments"`
+	UpdatedBy        *uint    `json:"updated_by,omitempty"`
+	CreatedAt        string   `json:"created_at"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+// CreateFeatureFlagRequest is the input for creating a feature flag
+type CreateFeatureFlagRequest struct {
+	Key              string
+	Description      *string
+	Enabled          bool
+	EnabledForAdmins bool
+	Environments     []string
+}
+
+// UpdateFeatureFlagRequest is a partial update; nil fields are left as
+// they are.
+type UpdateFeatureFlagRequest struct {
+	Description      *string
+	Enabled          *bool
+	EnabledForAdmins *bool
+	Environments     *[]string
+}
+
+// ──────────────────────────────────────────────
+// Feature Flag Service Interface
+// ──────────────────────────────────────────────
+
+// FeatureFlagServiceInterface defines the contract for runtime feature
+// flags.
+type FeatureFlagServiceInterface interface {
+	ListFlags() ([]*FeatureFlagResponse, error)
+	CreateFlag(req *CreateFeatureFlagRequest, userID uint) (*FeatureFlagResponse, error)
+	UpdateFlag(key string, req *UpdateFeatureFlagRequest, userID uint) (*FeatureFlagResponse, error)
+	DeleteFlag(key string) error
+	// IsEnabled reports whether the flag is on in this environment for a
+	// caller who is (or isn't) an admin. Served from a short-lived cache;
+	// unknown keys, and lookups that fail, read as off.
+	IsEnabled(key string, isAdmin bool) bool
+}
+
+// ──────────────────────────────────────────────
+// Announcement types
+// ──────────────────────────────────────────────
+
+// AnnouncementResponse represents an announcement banner in admin responses
+type AnnouncementResponse struct {
+	ID          uint    `json:"id"`
+	Message     string  `json:"message"`
+	Severity    string  `json:"severity"`
+	StartsAt    string  `json:"starts_at"`
+	EndsAt      *string `json:"ends_at,omitempty"`
+	Dismissible bool    `json:"dismissible"`
+	// Status is "scheduled", "active" or "expired" as of the response.
+	Status    string `json:"status"`
+	CreatedBy *uint  `json:"created_by,omitempty"`
+	UpdatedBy *uint  `json:"updated_by,omitempty"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ActiveAnnouncementResponse is a banner as the site shows it
+type ActiveAnnouncementResponse struct {
+	ID          uint    `json:"id"`
+	Message     string  `json:"message"`
+	Severity    string  `json:"severity"`
+	Dismissible bool    `json:"dismissible"`
+	StartsAt    string  `json:"starts_at"`
+	EndsAt      *string `json:"ends_at,omitempty"`
+}
+
+// CreateAnnouncementRequest is the input for creating an announcement. A nil
+// StartsAt starts it now; a nil EndsAt runs it until it is expired.
+type CreateAnnouncementRequest struct {
+	Message     string
+	Severity    string
+	StartsAt    *time.Time
+	EndsAt      *time.Time
+	Dismissible bool
+}
+
+// UpdateAnnouncementRequest is a partial update; nil fields are left as
+// they are.
+type UpdateAnnouncementRequest struct {
+	Message     *string
+	Severity    *string
+	StartsAt    *time.Time
+	EndsAt      *time.Time
+	Dismissible *bool
+}
+
+// ──────────────────────────────────────────────
+// Announcement Service Interface
+// ──────────────────────────────────────────────
+
+// AnnouncementServiceInterface defines the contract for site-wide
+// announcement banners.
+type AnnouncementServiceInterface interface {
+	ListAnnouncements() ([]*AnnouncementResponse, error)
+	CreateAnnouncement(req *CreateAnnouncementRequest, userID uint) (*AnnouncementResponse, error)
+	UpdateAnnouncement(id uint, req *UpdateAnnouncementRequest, userID uint) (*AnnouncementResponse, error)
+	// ExpireAnnouncement takes the banner down now.
+	ExpireAnnouncement(id uint, userID uint) (*AnnouncementResponse, error)
+	// GetActiveAnnouncements returns the banners showing now, minus those
+	// the user dismissed (userID 0: anonymous, nothing dismissed).
+	GetActiveAnnouncements(userID uint) ([]*ActiveAnnouncementResponse, error)
+	DismissAnnouncement(id uint, userID uint) error
+}
+
+// ──────────────────────────────────────────────
+// Admin Annotation types
+// ──────────────────────────────────────────────
+
+// AdminNoteResponse is an internal admin note on an entity
+type AdminNoteResponse struct {
+	ID         uint   `json:"id"`
+	EntityType string `json:"entity_type"`
+	EntityID   uint   `json:"entity_id"`
+	Body       string `json:"body"`
+	CreatedBy  *uint  `json:"created_by,omitempty"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// AdminTagResponse is an internal admin tag on an entity
+type AdminTagResponse struct {
+	ID    uint   `json:"id"`
+	Label string `json:"label"`
+	Color string `json:"color"`
+}
+
+// AdminAnnotationsResponse holds every note and tag on one entity
+type AdminAnnotationsResponse struct {
+	EntityType string               `json:"entity_type"`
+	EntityID   uint                 `json:"entity_id"`
+	Notes      []*AdminNoteResponse `json:"notes"`
+	Tags       []*AdminTagResponse  `json:"tags"`
+}
+
+// AdminAnnotationSummary is what admin list responses carry per entity:
+// its tags and how many notes it has
+type AdminAnnotationSummary struct {
+	Tags      []*AdminTagResponse `json:"tags"`
+	NoteCount int                 `json:"note_count"`
+}
+
+// AdminTagCount is a tag label in use and how many entities carry it
+type AdminTagCount struct {
+	Label string `json:"label"`
+	Color string `json:"color"`
+	Count int64  `json:"count"`
+}
+
+// TaggedEntityResponse is an entity carrying a given tag
+type TaggedEntityResponse struct {
+	EntityType string            `json:"entity_type"`
+	EntityID   uint              `json:"entity_id"`
+	EntityName string            `json:"entity_name"`
+	Tag        *AdminTagResponse `json:"tag"`
+}
+
+// ──────────────────────────────────────────────
+// Admin Annotation Service Interface
+// ──────────────────────────────────────────────
+
+// AdminAnnotationServiceInterface defines the contract for internal admin
+// notes and tags on entities.
+type AdminAnnotationServiceInterface interface {
+	GetAnnotations(entityType string, entityID uint) (*AdminAnnotationsResponse, error)
+	AddNote(entityType string, entityID uint, body string, userID uint) (*AdminNoteResponse, error)
+	UpdateNote(noteID uint, body string) (*AdminNoteResponse, error)
+	DeleteNote(noteID uint) error
+	// AddTag tags the entity, or recolors the tag if the entity already
+	// carries it. An empty color picks the default.
+	AddTag(entityType string, entityID uint, label, color string, userID uint) (*AdminTagResponse, error)
+	RemoveTag(entityType string, entityID uint, label string) error
+	// GetSummaries returns the tags and note count of each entity that has
+	// any, keyed by entity ID.
+	GetSummaries(entityType string, entityIDs []uint) (map[uint]*AdminAnnotationSummary, error)
+	ListTags() ([]*AdminTagCount, error)
+	ListTagged(label, entityType string, limit, offset int) ([]*TaggedEntityResponse, int64, error)
+}
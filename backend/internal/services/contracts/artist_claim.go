@@ -0,0 +1,70 @@
+package contracts
+
+import "time"
+
+// ──────────────────────────────────────────────
+// Artist Claim Service Interface
+// ──────────────────────────────────────────────
+
+// ArtistClaimServiceInterface defines the contract for artist ownership. It
+// mirrors VenueClaimServiceInterface: an approved claim makes the user a
+// manager of the artist, and an artist with managers is verified. Managers
+// may edit the artist's bio and social links.
+type ArtistClaimServiceInterface interface {
+	// SubmitClaim files a pending claim. Fails when the user already has a
+	// pending claim on the artist or already manages it.
+	SubmitClaim(artistID, userID uint, evidence string) (*ArtistClaimResponse, error)
+	// GetUserClaims returns the user's own claims, newest first.
+	GetUserClaims(userID uint) ([]*ArtistClaimResponse, error)
+	// ListClaims returns claims for the admin review queue, oldest first. An
+	// empty status lists every claim.
+	ListClaims(status string, limit, offset int) ([]*ArtistClaimResponse, int64, error)
+	// ApproveClaim marks the claim approved, grants the claimant manager
+	// rights and verifies the artist.
+	ApproveClaim(claimID, reviewerID uint, note string) (*ArtistClaimResponse, error)
+	// DenyClaim marks the claim denied. A reason is required.
+	DenyClaim(claimID, reviewerID uint, reason string) (*ArtistClaimResponse, error)
+
+	// IsArtistManager reports whether the user manages the artist.
+	IsArtistManager(userID, artistID uint) (bool, error)
+	// GetManagedArtists returns the artists the user manages.
+	GetManagedArtists(userID uint) ([]*ArtistManagerResponse, error)
+	// ListArtistManagers returns the managers of an artist.
+	ListArtistManagers(artistID uint) ([]*ArtistManagerResponse, error)
+	// RemoveArtistManager revokes a user's manager rights on an artist. The
+	// artist loses its verified badge when no managers remain.
+	RemoveArtistManager(artistID, userID uint) error
+}
+
+// MaxArtistClaimEvidenceLength caps the evidence note, in characters.
+const MaxArtistClaimEvidenceLength = 2000
+
+// ArtistClaimResponse is an artist claim with its artist and reviewer.
+type ArtistClaimResponse struct {
+	ID           uint       `json:"id"`
+	ArtistID     uint       `json:"artist_id"`
+	ArtistName   string     `json:"artist_name"`
+	ArtistSlug   string     `json:"artist_slug"`
+	UserID       uint       `json:"user_id"`
+	UserName     string     `json:"user_name"`
+	Evidence     string     `json:"evidence"`
+	Status       string     `json:"status"`
+	ReviewedBy   *uint      `json:"reviewed_by,omitempty"`
+	ReviewerName string     `json:"reviewer_name,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNote   *string    `json:"review_note,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// ArtistManagerResponse is one user's manager grant on one artist.
+type ArtistManagerResponse struct {
+	ArtistID   uint      `json:"artist_id"`
+	ArtistName string    `json:"artist_name"`
+	ArtistSlug string    `json:"artist_slug"`
+	UserID     uint      `json:"user_id"`
+	UserName   string    `json:"user_name"`
+	ClaimID    *uint     `json:"claim_id,omitempty"`
+	GrantedBy  *uint     `json:"granted_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
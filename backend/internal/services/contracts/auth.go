@@ -1,6 +1,7 @@
 package contracts
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -101,6 +102,7 @@ type AuthServiceInterface interface {
 	OAuthLogin(w http.ResponseWriter, r *http.Request, provider string) error
 	OAuthCallback(w http.ResponseWriter, r *http.Request, provider string) (*authm.User, string, error)
 	OAuthCallbackWithConsent(w http.ResponseWriter, r *http.Request, provider string, consent *OAuthSignupConsent) (*authm.User, string, error)
+	OAuthLinkCallback(w http.ResponseWriter, r *http.Request, provider string, userID uint) (*authm.User, error)
 	GetUserProfile(userID uint) (*authm.User, error)
 	RefreshUserToken(user *authm.User) (string, error)
 	Logout(w http.ResponseWriter, r *http.Request) error
@@ -125,6 +127,20 @@ type JWTServiceInterface interface {
 	ValidateAccountRecoveryToken(tokenString string) (*AccountRecoveryTokenClaims, error)
 }
 
+// JWTKeyStatus lists the session key ring by key id; secrets never leave
+// the service.
+type JWTKeyStatus struct {
+	CurrentKeyID   string   `json:"current_kid" doc:"Key id new tokens are signed with"`
+	PreviousKeyIDs []string `json:"previous_kids" doc:"Retired key ids that still verify"`
+	Changed        bool     `json:"changed" doc:"Whether a rotation moved the signing key"`
+}
+
+// JWTKeyRotatorInterface exposes the JWT key ring to the admin console.
+type JWTKeyRotatorInterface interface {
+	JWTKeyStatus() *JWTKeyStatus
+	RotateJWTKeys(ctx context.Context) (*JWTKeyStatus, error)
+}
+
 // ──────────────────────────────────────────────
 // Apple Auth Service Interface
 // ──────────────────────────────────────────────
@@ -172,3 +188,44 @@ type PasswordValidatorInterface interface {
 	IsBreached(password string) (bool, error)
 	IsCommonPassword(password string) bool
 }
+
+// ──────────────────────────────────────────────
+// Public API Key types
+// ──────────────────────────────────────────────
+
+// APIKeyResponse represents a public API key and its usage in API responses
+type APIKeyResponse struct {
+	ID                 uint       `json:"id"`
+	Name               string     `json:"name"`
+	KeyPrefix          string     `json:"key_prefix"`
+	Scopes             []string   `json:"scopes"`
+	RateLimitPerMinute int        `json:"rate_limit_per_minute"`
+	RequestCount       int64      `json:"request_count"`
+	LastUsedAt         *time.Time `json:"last_used_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+// APIKeyCreateResponse includes the plaintext key (only returned on creation)
+type APIKeyCreateResponse struct {
+	APIKeyResponse
+	Key string `json:"key"` // Plaintext key - only shown once!
+}
+
+// APIKeyAuthResult is the outcome of presenting a key on a request. Key has
+// its owner preloaded. Allowed is false once the key has used up its
+// per-minute budget; RetryAfterSeconds is then the whole-second wait until
+// the window resets.
+type APIKeyAuthResult struct {
+	Key               *authm.APIKey
+	Allowed           bool
+	RetryAfterSeconds int
+}
+
+// APIKeyServiceInterface defines the contract for public API key operations.
+type APIKeyServiceInterface interface {
+	CreateKey(userID uint, name string, scopes []string) (*APIKeyCreateResponse, error)
+	ListKeys(userID uint) ([]APIKeyResponse, error)
+	GetKey(userID uint, keyID uint) (*APIKeyResponse, error)
+	RevokeKey(userID uint, keyID uint) error
+	AuthenticateKey(plainKey string) (*APIKeyAuthResult, error)
+}
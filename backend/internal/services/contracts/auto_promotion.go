@@ -21,11 +21,12 @@ type AutoPromotionServiceInterface interface {
 
 // Advancement requirement ids (stable; mirrored by frontend/lib/tiers.ts).
 const (
-	AdvancementReqApprovedEdits  = "approved_edits"
-	AdvancementReqAccountAgeDays = "account_age_days"
-	AdvancementReqEmailVerified  = "email_verified"
-	AdvancementReqApprovalRate   = "approval_rate"
-	AdvancementReqCityEdits      = "city_edits"
+	AdvancementReqApprovedEdits   = "approved_edits"
+	AdvancementReqAccountAgeDays  = "account_age_days"
+	AdvancementReqEmailVerified   = "email_verified"
+	AdvancementReqApprovalRate    = "approval_rate"
+	AdvancementReqCityEdits       = "city_edits"
+	AdvancementReqNoUpheldReports = "no_upheld_reports"
 )
 
 // AdvancementProgress is the user-facing, self-scoped view of next-tier progress.
@@ -64,6 +65,7 @@ type UserTierChange struct {
 	OldTier  string `json:"old_tier"`
 	NewTier  string `json:"new_tier"`
 	Reason   string `json:"reason"`
+	Rule     string `json:"rule"`
 }
 
 // UserEvaluationResult contains the detailed evaluation of a single user.
@@ -76,9 +78,11 @@ type UserEvaluationResult struct {
 	AccountAge      time.Duration `json:"account_age"`
 	EmailVerified   bool          `json:"email_verified"`
 	CityEditCount   int           `json:"city_edit_count"`
+	UpheldReports   int           `json:"upheld_reports"`
 	Changed         bool          `json:"changed"`
 	NewTier         string        `json:"new_tier"`
 	Reason          string        `json:"reason"`
+	Rule            string        `json:"rule"`
 	Rolling30dRate  float64       `json:"rolling_30d_rate"`
 	Rolling30dTotal int           `json:"rolling_30d_total"`
 }
@@ -3,6 +3,9 @@
 package contracts
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"time"
 
 	catalogm "psychic-homily-backend/internal/models/catalog"
@@ -25,25 +28,37 @@ type CreateShowVenue struct {
 
 // CreateShowArtist represents an artist in a show creation request.
 // IsHeadliner is used for duplicate prevention (headliners can't perform at same venue on same date).
+// SetType, when one of catalogm.SetTypes, takes precedence over IsHeadliner.
 type CreateShowArtist struct {
-	ID              *uint   `json:"id"`
-	Name            string  `json:"name"`
-	IsHeadliner     *bool   `json:"is_headliner"`
-	InstagramHandle *string `json:"instagram_handle,omitempty"`
+	ID              *uint      `json:"id"`
+	Name            string     `json:"name"`
+	IsHeadliner     *bool      `json:"is_headliner"`
+	SetType         string     `json:"set_type,omitempty"`
+	InstagramHandle *string    `json:"instagram_handle,omitempty"`
+	SetTime         *time.Time `json:"set_time,omitempty"`
+}
+
+// ShowLineupEntry is one slot in a show lineup reorder. Slot order is billing
+// order; a nil SetTime keeps the stored set time.
+type ShowLineupEntry struct {
+	ArtistID uint       `json:"artist_id"`
+	SetType  string     `json:"set_type"`
+	SetTime  *time.Time `json:"set_time,omitempty"`
 }
 
 // CreateShowRequest represents the data needed to create a new show.
 // The service will prevent duplicate headliners at the same venue on the same date/time
 // and reuse existing venues by name and city (venues are unique by name within a city).
 type CreateShowRequest struct {
-	Title          string    `json:"title" validate:"required"`
-	EventDate      time.Time `json:"event_date" validate:"required"`
-	City           string    `json:"city"`
-	State          string    `json:"state"`
-	Price          *float64  `json:"price"`
-	AgeRequirement string    `json:"age_requirement"`
-	Description    string    `json:"description"`
-	TicketURL      string    `json:"ticket_url"`
+	Title          string     `json:"title" validate:"required"`
+	EventDate      time.Time  `json:"event_date" validate:"required"`
+	DoorsTime      *time.Time `json:"doors_time"`
+	City           string     `json:"city"`
+	State          string     `json:"state"`
+	AgeRequirement string     `json:"age_requirement"`
+	Description    string     `json:"description"`
+	TicketURL      string     `json:"ticket_url"`
+	TicketProvider string     `json:"ticket_provider"`
 	// ImageURL is populated by the entity_request fulfiller (PSY-1037, the
 	// payload's flyer). The direct create handler does not expose it yet (set
 	// post-create via the update endpoint), so it leaves it nil here.
@@ -51,10 +66,17 @@ type CreateShowRequest struct {
 	Venues   []CreateShowVenue  `json:"venues" validate:"required,min=1"`
 	Artists  []CreateShowArtist `json:"artists" validate:"required,min=1"`
 
+	// Structured price; see catalogm.Show. An empty PriceCurrency is USD.
+	PriceMin      *float64 `json:"price_min"`
+	PriceMax      *float64 `json:"price_max"`
+	PriceCurrency string   `json:"price_currency"`
+	IsFree        bool     `json:"is_free"`
+
 	// User context for determining show status
 	SubmittedByUserID *uint `json:"-"` // User ID of submitter (set by handler)
 	SubmitterIsAdmin  bool  `json:"-"` // Whether submitter is admin (set by handler)
 	IsPrivate         bool  `json:"-"` // Whether show should be private (user's list only)
+	HoldForReview     bool  `json:"-"` // Whether a public show should wait for moderator approval (set by handler)
 }
 
 // UpdateShowRequest represents the basic show fields that can be updated.
@@ -64,13 +86,35 @@ type CreateShowRequest struct {
 type UpdateShowRequest struct {
 	Title          *string    `json:"title"`
 	EventDate      *time.Time `json:"event_date"`
+	DoorsTime      *time.Time `json:"doors_time"`
 	City           *string    `json:"city"`
 	State          *string    `json:"state"`
-	Price          *float64   `json:"price"`
 	AgeRequirement *string    `json:"age_requirement"`
 	Description    *string    `json:"description"`
 	TicketURL      *string    `json:"ticket_url"`
+	TicketProvider *string    `json:"ticket_provider"`
 	ImageURL       *string    `json:"image_url"`
+
+	// Structured price. PriceMin and PriceMax are written together when
+	// either is set, so a nil PriceMax alongside a PriceMin makes it a single
+	// price. ClearPrice nulls both (a free show with no suggested donation).
+	PriceMin      *float64 `json:"price_min"`
+	PriceMax      *float64 `json:"price_max"`
+	PriceCurrency *string  `json:"price_currency"`
+	IsFree        *bool    `json:"is_free"`
+	ClearPrice    bool     `json:"clear_price"`
+}
+
+// ShowPrice is a show's structured price as returned to clients, embedded in
+// the show response types. Price is the lowest price, 0 for a free show with
+// no suggested donation: the single value shows carried before ranges, kept
+// for older clients.
+type ShowPrice struct {
+	Price         *float64 `json:"price"`
+	PriceMin      *float64 `json:"price_min"`
+	PriceMax      *float64 `json:"price_max"`
+	PriceCurrency string   `json:"price_currency"`
+	IsFree        bool     `json:"is_free"`
 }
 
 // ShowResponse represents the show data returned to clients
@@ -79,13 +123,16 @@ type ShowResponse struct {
 	Slug              string           `json:"slug"`
 	Title             string           `json:"title"`
 	EventDate         time.Time        `json:"event_date"`
+	DoorsTime         *time.Time       `json:"doors_time,omitempty"`
 	City              *string          `json:"city"`
 	State             *string          `json:"state"`
-	Price             *float64         `json:"price"`
 	AgeRequirement    *string          `json:"age_requirement"`
 	Description       *string          `json:"description"`
 	TicketURL         *string          `json:"ticket_url,omitempty"`
-	ImageURL          *string          `json:"image_url"` // Optional show flyer (PSY-521)
+	TicketProvider    *string          `json:"ticket_provider,omitempty"`
+	ImageURL          *string          `json:"image_url"`                     // Optional show flyer (PSY-521)
+	FlyerURL          *string          `json:"flyer_url,omitempty"`           // Uploaded flyer, web-sized
+	FlyerThumbnailURL *string          `json:"flyer_thumbnail_url,omitempty"` // Uploaded flyer, thumbnail
 	Status            string           `json:"status"`
 	SubmittedBy       *uint            `json:"submitted_by,omitempty"`
 	RejectionReason   *string          `json:"rejection_reason,omitempty"`
@@ -95,17 +142,98 @@ type ShowResponse struct {
 	CreatedAt         time.Time        `json:"created_at"`
 	UpdatedAt         time.Time        `json:"updated_at"`
 
+	ShowPrice
+
+	// Genres of the lineup's artists, most widely shared first (at most 5).
+	Genres []GenreChip `json:"genres"`
+
+	// Venue-local renderings of EventDate and DoorsTime in Timezone, the
+	// primary venue's IANA zone (state fallback when it has none), RFC3339 with
+	// the venue's offset. Use these, not the caller's zone, to decide which day
+	// a show is on.
+	Timezone       string  `json:"timezone,omitempty"`
+	EventDateLocal string  `json:"event_date_local,omitempty"`
+	DoorsTimeLocal *string `json:"doors_time_local,omitempty"`
+
+	// When the show's details last changed, from revision history. Unlike
+	// UpdatedAt it ignores internal writes (slug, sync, moderation). Set only
+	// by GetShow and GetShowBySlug; nil if the show was never edited.
+	LastEditedAt *time.Time `json:"last_edited_at,omitempty"`
+
+	// Lineup changes, set times and other notes posted on the show, oldest
+	// first. Set only by GetShow and GetShowBySlug.
+	Updates []ShowUpdateResponse `json:"updates,omitempty"`
+
+	// Number of users who RSVP'd to the show. Filled by the show handlers
+	// from ShowRSVPService.GetBatchGoingCounts on the detail and list reads.
+	GoingCount *int `json:"going_count,omitempty"`
+
 	// Status flags (admin-controlled)
 	IsSoldOut   bool `json:"is_sold_out"`
 	IsCancelled bool `json:"is_cancelled"`
+	IsPostponed bool `json:"is_postponed"`
+
+	// Why the show was cancelled or postponed. A postponed show with a new
+	// date links to the show for that date (RescheduledShowID); that show
+	// links back (RescheduledFromShowID) and carries the date it was first
+	// billed for (OriginalDate).
+	StatusReason          *string    `json:"status_reason,omitempty"`
+	RescheduledShowID     *uint      `json:"rescheduled_show_id,omitempty"`
+	RescheduledFromShowID *uint      `json:"rescheduled_from_show_id,omitempty"`
+	OriginalDate          *time.Time `json:"original_date,omitempty"`
 
 	// Source tracking (for admin view to identify discovered shows)
 	Source      string     `json:"source,omitempty"`       // "user" or "discovery"
 	SourceVenue *string    `json:"source_venue,omitempty"` // Venue slug for scraped shows
 	ScrapedAt   *time.Time `json:"scraped_at,omitempty"`   // When the show was scraped
 
+	// When the ticket availability job last read the show's ticket page
+	TicketLastCheckedAt *time.Time `json:"ticket_last_checked_at,omitempty"`
+
+	// Short link code, served as /s/{code}. Set once the show is approved.
+	ShortCode *string `json:"short_code,omitempty"`
+
 	// Duplicate detection context
 	DuplicateOfShowID *uint `json:"duplicate_of_show_id,omitempty"` // ID of show this may duplicate
+
+	// Non-fatal submission issues, set on create only (e.g. a city/state
+	// that disagrees with the primary venue)
+	Warnings []string `json:"warnings,omitempty"`
+
+	// Existing shows that may be the same event, set on create only
+	PossibleDuplicates []DuplicateCandidate `json:"possible_duplicates,omitempty"`
+
+	// Set only by GetShowBySlug when the requested slug is a historical one,
+	// so the frontend can 301 to the current URL
+	CanonicalSlug string `json:"canonical_slug,omitempty"`
+}
+
+// ShowFlyerResponse holds the public URLs of a show's uploaded flyer
+type ShowFlyerResponse struct {
+	ShowID            uint   `json:"show_id"`
+	FlyerURL          string `json:"flyer_url"`
+	FlyerThumbnailURL string `json:"flyer_thumbnail_url"`
+}
+
+// ShowDraftResponse is a saved, possibly incomplete, show submission.
+// Payload is the submit form's CreateShowRequest body as last saved.
+type ShowDraftResponse struct {
+	ID        uint            `json:"id"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// DuplicateCandidate is an existing show that may be the same event as a
+// newly submitted one. Score runs from 0 to 1; Reasons lists what matched.
+type DuplicateCandidate struct {
+	ShowID    uint      `json:"show_id"`
+	Slug      string    `json:"slug"`
+	Title     string    `json:"title"`
+	EventDate time.Time `json:"event_date"`
+	Status    string    `json:"status"`
+	Score     float64   `json:"score"`
+	Reasons   []string  `json:"reasons"`
 }
 
 // VenueResponse represents venue data in show responses
@@ -130,6 +258,7 @@ type ShowArtistSocials struct {
 	Spotify    *string `json:"spotify"`
 	SoundCloud *string `json:"soundcloud"`
 	Bandcamp   *string `json:"bandcamp"`
+	TikTok     *string `json:"tiktok"`
 	Website    *string `json:"website"`
 }
 
@@ -143,6 +272,7 @@ type ArtistResponse struct {
 	IsHeadliner      *bool             `json:"is_headliner"`
 	SetType          string            `json:"set_type"`
 	Position         int               `json:"position"`
+	SetTime          *time.Time        `json:"set_time,omitempty"`
 	IsNewArtist      *bool             `json:"is_new_artist"`
 	BandcampEmbedURL *string           `json:"bandcamp_embed_url"`
 	Socials          ShowArtistSocials `json:"socials"`
@@ -160,6 +290,41 @@ type BatchShowError struct {
 	Error  string `json:"error"`
 }
 
+// Bulk show actions accepted by POST /admin/shows/bulk.
+const (
+	BulkShowActionApprove = "approve"
+	BulkShowActionReject  = "reject"
+	BulkShowActionCancel  = "cancel"
+	BulkShowActionDelete  = "delete"
+)
+
+// BulkShowActionRequest describes one bulk admin action over a set of shows.
+// Reason is required for reject; Category is optional and only used by reject.
+type BulkShowActionRequest struct {
+	ShowIDs  []uint
+	Action   string
+	Reason   string
+	Category string
+}
+
+// BulkShowItemResult is the per-show outcome of a bulk action. Title is
+// captured before the action runs so deleted shows can still be reported.
+type BulkShowItemResult struct {
+	ShowID  uint   `json:"show_id"`
+	Title   string `json:"title,omitempty"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// BulkShowActionResult contains the per-item report of a bulk action. Each
+// show runs in its own transaction, so one failure never rolls back another.
+type BulkShowActionResult struct {
+	Action    string               `json:"action"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []BulkShowItemResult `json:"results"`
+}
+
 // PendingShowsFilter contains optional filters for pending shows queries.
 type PendingShowsFilter struct {
 	VenueID *uint
@@ -183,6 +348,32 @@ type UpcomingShowsFilter struct {
 	// TagMatchAny switches the tag filter to OR semantics. When false
 	// (default) the shows must have every tag in TagSlugs (AND).
 	TagMatchAny bool
+	// GenreSlugs narrows results to shows whose lineup includes an artist
+	// with any of these genres or their subgenres. Empty means "no genre
+	// filter".
+	GenreSlugs []string
+	// Near narrows results to shows at a venue within a radius of a point.
+	// Nil means "no distance filter".
+	Near *NearFilter
+	// Metro narrows results to shows at a venue in this CBSA metro (the
+	// venues.metro code). Empty means "no metro filter".
+	Metro string
+	// AllAges narrows results to shows at a venue whose all_ages flag equals
+	// the value. Venues with an unknown flag never match. Nil means "no
+	// all-ages filter".
+	AllAges *bool
+	// MaxPrice narrows results to free shows and shows whose lowest price is
+	// at most this amount, in the show's own currency. Shows with no price
+	// never match. Nil means "no price filter".
+	MaxPrice *float64
+}
+
+// NearFilter is a point-and-radius search. Venues without coordinates never
+// match.
+type NearFilter struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
 }
 
 // ShowCityResponse represents a city with the count of upcoming shows.
@@ -194,12 +385,42 @@ type UpcomingShowsFilter struct {
 // Both are nil together when the geocoder can't resolve the city (an obscure
 // place, or a non-US/CA city the GeoNames slice doesn't cover) — callers
 // fall back to exact city-name matching, so a miss degrades gracefully.
+//
+// Metro/MetroName are the US Census CBSA the city rolls up to (the same
+// geocoder source as venues.metro), so the city picker can group Phoenix,
+// Tempe, Mesa and Scottsdale under one region and filter by `metro=` instead
+// of listing every member city. Both are omitted for a non-US or no-CBSA city.
 type ShowCityResponse struct {
 	City      string   `json:"city"`
 	State     string   `json:"state"`
 	ShowCount int      `json:"show_count"`
-	Latitude  *float64 `json:"latitude,omitempty"`  // Geocoded city centroid (PSY-985 source, PSY-981)
-	Longitude *float64 `json:"longitude,omitempty"` // Geocoded city centroid (PSY-985 source, PSY-981)
+	Latitude  *float64 `json:"latitude,omitempty"`   // Geocoded city centroid (PSY-985 source, PSY-981)
+	Longitude *float64 `json:"longitude,omitempty"`  // Geocoded city centroid (PSY-985 source, PSY-981)
+	Metro     string   `json:"metro,omitempty"`      // CBSA code, e.g. "38060"
+	MetroName string   `json:"metro_name,omitempty"` // CBSA title, e.g. "Phoenix-Mesa-Chandler, AZ"
+}
+
+// ShowYearCount is the number of shows in one calendar year (in the request
+// timezone), for archive navigation.
+type ShowYearCount struct {
+	Year  int   `json:"year"`
+	Count int64 `json:"count"`
+}
+
+// ShowMonthCount is the number of shows in one month of an archive year.
+type ShowMonthCount struct {
+	Month int   `json:"month"`
+	Count int64 `json:"count"`
+}
+
+// ShowArchive is one page of past approved shows, most recent first, plus
+// the counts that drive archive navigation. Years always covers the whole
+// archive; Months is set only when a year is selected.
+type ShowArchive struct {
+	Shows  []*ShowResponse
+	Total  int64
+	Years  []*ShowYearCount
+	Months []*ShowMonthCount
 }
 
 // ShowSearchResult is the row shape returned by GET /shows/search.
@@ -233,6 +454,49 @@ type AdminShowFilters struct {
 	FromDate string // RFC3339 format
 	ToDate   string // RFC3339 format
 	City     string
+	Tag      string // internal admin tag label (case-insensitive)
+}
+
+// ShowTicketStatusResponse is a show's sold-out flag, when the ticket
+// availability job last checked it, and the flag's history, newest first.
+type ShowTicketStatusResponse struct {
+	ShowID         uint                             `json:"show_id"`
+	TicketURL      *string                          `json:"ticket_url,omitempty"`
+	TicketProvider *string                          `json:"ticket_provider,omitempty"`
+	IsSoldOut      bool                             `json:"is_sold_out"`
+	LastCheckedAt  *time.Time                       `json:"last_checked_at,omitempty"`
+	Changes        []ShowTicketStatusChangeResponse `json:"changes"`
+}
+
+// ShowTicketStatusChangeResponse is one change to a show's sold-out flag.
+// Source is "manual" or "ticket_check"; Provider is set for ticket_check.
+type ShowTicketStatusChangeResponse struct {
+	IsSoldOut bool      `json:"is_sold_out"`
+	Source    string    `json:"source"`
+	Provider  *string   `json:"provider,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ShowShortLinkTarget is the show a short link redirects to.
+type ShowShortLinkTarget struct {
+	ShowID uint
+	Slug   string
+}
+
+// ShowShortLinkStatsResponse is a show's short link with its click-throughs
+// by referrer, most clicks first.
+type ShowShortLinkStatsResponse struct {
+	ShowID      uint                         `json:"show_id"`
+	ShortCode   *string                      `json:"short_code"`
+	TotalClicks int64                        `json:"total_clicks"`
+	Referrers   []ShowShortLinkReferrerStats `json:"referrers"`
+}
+
+// ShowShortLinkReferrerStats is the click-throughs from one referrer.
+type ShowShortLinkReferrerStats struct {
+	Referrer      string    `json:"referrer"`
+	Clicks        int64     `json:"clicks"`
+	LastClickedAt time.Time `json:"last_clicked_at"`
 }
 
 // ParsedShowImport contains the parsed result of a markdown show import.
@@ -259,24 +523,41 @@ type ArtistMatchResult struct {
 	WillCreate bool   `json:"will_create"`
 }
 
+// ImportEntity is an entity a confirmed show import would create
+type ImportEntity struct {
+	Type string `json:"type"` // "show", "venue" or "artist"
+	Name string `json:"name"`
+}
+
 // ImportPreviewResponse represents the preview response for show import
 type ImportPreviewResponse struct {
 	Show      ExportShowData      `json:"show"`
 	Venues    []VenueMatchResult  `json:"venues"`
 	Artists   []ArtistMatchResult `json:"artists"`
+	Creates   []ImportEntity      `json:"creates"`
 	Warnings  []string            `json:"warnings"`
 	CanImport bool                `json:"can_import"`
 }
 
 // ExportShowData represents show data in export frontmatter
 type ExportShowData struct {
-	Title          string   `yaml:"title" json:"title"`
-	EventDate      string   `yaml:"event_date" json:"event_date"`
-	City           string   `yaml:"city,omitempty" json:"city,omitempty"`
-	State          string   `yaml:"state,omitempty" json:"state,omitempty"`
-	Price          *float64 `yaml:"price,omitempty" json:"price,omitempty"`
-	AgeRequirement string   `yaml:"age_requirement,omitempty" json:"age_requirement,omitempty"`
-	Status         string   `yaml:"status" json:"status"`
+	Title          string `yaml:"title" json:"title"`
+	EventDate      string `yaml:"event_date" json:"event_date"`
+	DoorsTime      string `yaml:"doors_time,omitempty" json:"doors_time,omitempty"`
+	City           string `yaml:"city,omitempty" json:"city,omitempty"`
+	State          string `yaml:"state,omitempty" json:"state,omitempty"`
+	AgeRequirement string `yaml:"age_requirement,omitempty" json:"age_requirement,omitempty"`
+	TicketURL      string `yaml:"ticket_url,omitempty" json:"ticket_url,omitempty"`
+	TicketProvider string `yaml:"ticket_provider,omitempty" json:"ticket_provider,omitempty"`
+	Status         string `yaml:"status" json:"status"`
+
+	// Structured price. Price is the pre-range single value: still read on
+	// import when price_min is absent (0 meaning free), no longer written.
+	Price         *float64 `yaml:"price,omitempty" json:"price,omitempty"`
+	PriceMin      *float64 `yaml:"price_min,omitempty" json:"price_min,omitempty"`
+	PriceMax      *float64 `yaml:"price_max,omitempty" json:"price_max,omitempty"`
+	PriceCurrency string   `yaml:"price_currency,omitempty" json:"price_currency,omitempty"`
+	IsFree        bool     `yaml:"is_free,omitempty" json:"is_free,omitempty"`
 }
 
 // ExportVenueSocial represents venue social links in export
@@ -318,6 +599,7 @@ type ExportArtistData struct {
 	Name     string             `yaml:"name"`
 	Position int                `yaml:"position"`
 	SetType  string             `yaml:"set_type"`
+	SetTime  string             `yaml:"set_time,omitempty"`
 	City     string             `yaml:"city,omitempty"`
 	State    string             `yaml:"state,omitempty"`
 	Social   ExportArtistSocial `yaml:"social,omitempty"`
@@ -338,24 +620,29 @@ type ExportFrontmatter struct {
 
 // CreateVenueRequest represents the data needed to create a new venue
 type CreateVenueRequest struct {
-	Name        string  `json:"name" validate:"required"`
-	Address     *string `json:"address"`
-	City        string  `json:"city" validate:"required"`
-	State       string  `json:"state" validate:"required"`
-	Country     *string `json:"country"`
-	Zipcode     *string `json:"zipcode"`
-	Capacity    *int    `json:"capacity"`
-	Instagram   *string `json:"instagram"`
-	Facebook    *string `json:"facebook"`
-	Twitter     *string `json:"twitter"`
-	YouTube     *string `json:"youtube"`
-	Spotify     *string `json:"spotify"`
-	SoundCloud  *string `json:"soundcloud"`
-	Bandcamp    *string `json:"bandcamp"`
-	Website     *string `json:"website"`
-	Description *string `json:"description"`
-	ImageURL    *string `json:"image_url"`
-	SubmittedBy *uint   `json:"-"` // Set by handler, not from request body
+	Name     string  `json:"name" validate:"required"`
+	Address  *string `json:"address"`
+	City     string  `json:"city" validate:"required"`
+	State    string  `json:"state" validate:"required"`
+	Country  *string `json:"country"`
+	Zipcode  *string `json:"zipcode"`
+	Capacity *int    `json:"capacity"`
+	// Operating info; see catalogm.Venue.
+	AccessibilityNotes *string `json:"accessibility_notes"`
+	AllAges            *bool   `json:"all_ages"`
+	DoorTime           *string `json:"door_time"`
+	Instagram          *string `json:"instagram"`
+	Facebook           *string `json:"facebook"`
+	Twitter            *string `json:"twitter"`
+	YouTube            *string `json:"youtube"`
+	Spotify            *string `json:"spotify"`
+	SoundCloud         *string `json:"soundcloud"`
+	Bandcamp           *string `json:"bandcamp"`
+	TikTok             *string `json:"tiktok"`
+	Website            *string `json:"website"`
+	Description        *string `json:"description"`
+	ImageURL           *string `json:"image_url"`
+	SubmittedBy        *uint   `json:"-"` // Set by handler, not from request body
 }
 
 // UpdateVenueRequest represents the data that can be updated on a venue.
@@ -367,46 +654,65 @@ type CreateVenueRequest struct {
 // in the service (utils.NilIfEmpty). Address/Country/Zipcode and the social
 // fields preserve the prior behavior of writing the value through verbatim.
 type UpdateVenueRequest struct {
-	Name        *string `json:"name"`
-	Address     *string `json:"address"`
-	City        *string `json:"city"`
-	State       *string `json:"state"`
-	Country     *string `json:"country"`
-	Zipcode     *string `json:"zipcode"`
-	Capacity    *int    `json:"capacity"`
-	Description *string `json:"description"`
-	ImageURL    *string `json:"image_url"`
-	Instagram   *string `json:"instagram"`
-	Facebook    *string `json:"facebook"`
-	Twitter     *string `json:"twitter"`
-	YouTube     *string `json:"youtube"`
-	Spotify     *string `json:"spotify"`
-	SoundCloud  *string `json:"soundcloud"`
-	Bandcamp    *string `json:"bandcamp"`
-	Website     *string `json:"website"`
+	Name     *string `json:"name"`
+	Address  *string `json:"address"`
+	City     *string `json:"city"`
+	State    *string `json:"state"`
+	Country  *string `json:"country"`
+	Zipcode  *string `json:"zipcode"`
+	Capacity *int    `json:"capacity"`
+	// AccessibilityNotes and DoorTime normalize an empty string to NULL.
+	AccessibilityNotes *string `json:"accessibility_notes"`
+	AllAges            *bool   `json:"all_ages"`
+	DoorTime           *string `json:"door_time"`
+	Description        *string `json:"description"`
+	ImageURL           *string `json:"image_url"`
+	Instagram          *string `json:"instagram"`
+	Facebook           *string `json:"facebook"`
+	Twitter            *string `json:"twitter"`
+	YouTube            *string `json:"youtube"`
+	Spotify            *string `json:"spotify"`
+	SoundCloud         *string `json:"soundcloud"`
+	Bandcamp           *string `json:"bandcamp"`
+	TikTok             *string `json:"tiktok"`
+	Website            *string `json:"website"`
+
+	// Timezone is an admin override of the geocoded IANA zone; empty clears
+	// the override and re-infers the zone from the venue's location.
+	Timezone *string `json:"timezone"`
 }
 
 // VenueDetailResponse represents the venue data returned to clients
 type VenueDetailResponse struct {
-	ID          uint           `json:"id"`
-	Slug        string         `json:"slug"`
-	Name        string         `json:"name"`
-	Address     *string        `json:"address"`
-	City        string         `json:"city"`
-	State       string         `json:"state"`
-	Country     *string        `json:"country,omitempty"`
-	Latitude    *float64       `json:"latitude,omitempty"`  // Geocoded city centroid (PSY-985)
-	Longitude   *float64       `json:"longitude,omitempty"` // Geocoded city centroid (PSY-985)
-	Timezone    *string        `json:"timezone"`            // IANA zone resolved from location (PSY-985)
-	Zipcode     *string        `json:"zipcode"`
-	Capacity    *int           `json:"capacity"` // Venue capacity (PSY-1179); not redacted for unverified venues
-	Description *string        `json:"description,omitempty"`
-	ImageURL    *string        `json:"image_url"`    // Optional venue photo (PSY-521)
-	Verified    bool           `json:"verified"`     // Admin-verified as legitimate venue
-	SubmittedBy *uint          `json:"submitted_by"` // User ID who originally submitted this venue
-	Social      SocialResponse `json:"social"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
+	ID        uint     `json:"id"`
+	Slug      string   `json:"slug"`
+	Name      string   `json:"name"`
+	Address   *string  `json:"address"`
+	City      string   `json:"city"`
+	State     string   `json:"state"`
+	Country   *string  `json:"country,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`  // Geocoded city centroid (PSY-985)
+	Longitude *float64 `json:"longitude,omitempty"` // Geocoded city centroid (PSY-985)
+	Timezone  *string  `json:"timezone"`            // IANA zone resolved from location (PSY-985)
+	Zipcode   *string  `json:"zipcode"`
+	Capacity  *int     `json:"capacity"` // Venue capacity (PSY-1179); not redacted for unverified venues
+	// Operating info; nil means unknown. DoorTime is local 24h "HH:MM".
+	AccessibilityNotes *string        `json:"accessibility_notes"`
+	AllAges            *bool          `json:"all_ages"`
+	DoorTime           *string        `json:"door_time"`
+	Description        *string        `json:"description,omitempty"`
+	ImageURL           *string        `json:"image_url"`    // Optional venue photo (PSY-521)
+	Verified           bool           `json:"verified"`     // Admin-verified as legitimate venue
+	SubmittedBy        *uint          `json:"submitted_by"` // User ID who originally submitted this venue
+	Social             SocialResponse `json:"social"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	// CanonicalSlug is set only by GetVenueBySlug when the requested slug is
+	// a historical one, so the frontend can 301 to the current URL.
+	CanonicalSlug string `json:"canonical_slug,omitempty"`
+	// TimezoneManual is true when an admin set Timezone by hand rather than
+	// it being inferred from the venue's location.
+	TimezoneManual bool `json:"timezone_manual"`
 }
 
 // VenueWithShowCountResponse includes upcoming show count for a venue.
@@ -421,6 +727,9 @@ type VenueListFilters struct {
 	City     string
 	Cities   []CityStateFilter
 	Verified *bool
+	// Metro narrows results to venues in this CBSA metro (the venues.metro
+	// code). Empty means "no metro filter".
+	Metro string
 	// TagSlugs narrows results to venues tagged with these slugs.
 	// Empty slice means "no tag filter".
 	TagSlugs []string
@@ -436,9 +745,46 @@ type VenueShowResponse struct {
 	EventDate      time.Time        `json:"event_date"`
 	City           *string          `json:"city"`
 	State          *string          `json:"state"`
-	Price          *float64         `json:"price"`
 	AgeRequirement *string          `json:"age_requirement"`
 	Artists        []ArtistResponse `json:"artists"`
+
+	ShowPrice
+}
+
+// VenueWidgetResponse is the compact upcoming-show payload behind the
+// embeddable venue widget: just enough to render a list on a third-party
+// site. URLs are absolute links back to the site, filled in by the handler.
+type VenueWidgetResponse struct {
+	Venue VenueWidgetVenue  `json:"venue"`
+	Shows []VenueWidgetShow `json:"shows"`
+}
+
+// VenueWidgetVenue is the venue a widget belongs to.
+type VenueWidgetVenue struct {
+	ID       uint   `json:"id"`
+	Slug     string `json:"slug"`
+	Name     string `json:"name"`
+	City     string `json:"city"`
+	State    string `json:"state"`
+	Timezone string `json:"timezone" doc:"IANA zone the local times are in"`
+	URL      string `json:"url"`
+}
+
+// VenueWidgetShow is one upcoming show in a venue widget. Artists are names
+// in lineup order.
+type VenueWidgetShow struct {
+	ID             uint      `json:"id"`
+	Slug           string    `json:"slug"`
+	Title          string    `json:"title"`
+	EventDate      time.Time `json:"event_date"`
+	EventDateLocal string    `json:"event_date_local" doc:"Event date as RFC3339 in the venue's timezone"`
+	DoorsTimeLocal *string   `json:"doors_time_local,omitempty" doc:"Doors time as RFC3339 in the venue's timezone"`
+	Artists        []string  `json:"artists"`
+	TicketURL      *string   `json:"ticket_url,omitempty"`
+	IsSoldOut      bool      `json:"is_sold_out"`
+	IsCancelled    bool      `json:"is_cancelled"`
+	IsPostponed    bool      `json:"is_postponed"`
+	URL            string    `json:"url"`
 }
 
 // VenueCityResponse represents a city with venue count for filtering
@@ -479,11 +825,17 @@ type CreateArtistRequest struct {
 	Spotify     *string `json:"spotify"`
 	SoundCloud  *string `json:"soundcloud"`
 	Bandcamp    *string `json:"bandcamp"`
+	TikTok      *string `json:"tiktok"`
 	Website     *string `json:"website"`
 	Description *string `json:"description"`
-	// ImageURL + BandcampEmbedURL are populated by the entity_request fulfiller
-	// (PSY-1038). The direct admin create handler does not expose them yet (set
-	// post-create via the update endpoints), so it leaves them nil here.
+	// Bio is markdown; the service cleans it with utils.SanitizeMarkdownSource.
+	Bio      *string `json:"bio"`
+	Hometown *string `json:"hometown"`
+	Pronouns *string `json:"pronouns"`
+	// ImageURL is the artist photo; a non-nil value is recorded with the
+	// "user" image source. BandcampEmbedURL is populated by the
+	// entity_request fulfiller (PSY-1038); the direct admin create handler
+	// leaves it nil.
 	ImageURL         *string `json:"image_url"`
 	BandcampEmbedURL *string `json:"bandcamp_embed_url"`
 }
@@ -495,13 +847,19 @@ type CreateArtistRequest struct {
 // SQL NULL (utils.NilIfEmpty) for all fields except Name, which maps to a NOT
 // NULL column. Name additionally drives slug regeneration and a uniqueness
 // check in the service. BandcampEmbedURL is the embed-specific column distinct
-// from the Bandcamp social profile URL.
+// from the Bandcamp social profile URL. Bio is cleaned with
+// utils.SanitizeMarkdownSource before it is stored. Setting ImageURL records
+// the photo as user-supplied and clears the previous photo's attribution.
 type UpdateArtistRequest struct {
 	Name             *string `json:"name"`
 	State            *string `json:"state"`
 	City             *string `json:"city"`
 	Country          *string `json:"country"`
 	Description      *string `json:"description"`
+	Bio              *string `json:"bio"`
+	Hometown         *string `json:"hometown"`
+	Pronouns         *string `json:"pronouns"`
+	ImageURL         *string `json:"image_url"`
 	BandcampEmbedURL *string `json:"bandcamp_embed_url"`
 	Instagram        *string `json:"instagram"`
 	Facebook         *string `json:"facebook"`
@@ -510,6 +868,7 @@ type UpdateArtistRequest struct {
 	Spotify          *string `json:"spotify"`
 	SoundCloud       *string `json:"soundcloud"`
 	Bandcamp         *string `json:"bandcamp"`
+	TikTok           *string `json:"tiktok"`
 	Website          *string `json:"website"`
 }
 
@@ -523,18 +882,26 @@ type ArtistDetailResponse struct {
 	Country          *string        `json:"country,omitempty"` // PSY-558: optional country (Australia, UK, etc.)
 	BandcampEmbedURL *string        `json:"bandcamp_embed_url"`
 	Description      *string        `json:"description,omitempty"`
+	Bio              *string        `json:"bio,omitempty"`      // Markdown source, for edit forms
+	BioHTML          string         `json:"bio_html,omitempty"` // Bio rendered to sanitized HTML, for display
+	Hometown         *string        `json:"hometown,omitempty"`
+	Pronouns         *string        `json:"pronouns,omitempty"`
 	ImageURL         *string        `json:"image_url"`        // Optional artist photo (PSY-521)
 	ImageSource      *string        `json:"image_source"`     // Image provider for attribution (PSY-1175)
 	ImageSourceURL   *string        `json:"image_source_url"` // Deep linkback for attribution (PSY-1175)
 	ImageLicense     *string        `json:"image_license"`    // CC license for a Commons photo (PSY-1232)
 	ImageAuthor      *string        `json:"image_author"`     // Photographer credit for a Commons photo (PSY-1232)
 	Social           SocialResponse `json:"social"`
+	Verified         bool           `json:"verified"` // Set while the artist has an approved manager
 	CreatedAt        time.Time      `json:"created_at"`
 	UpdatedAt        time.Time      `json:"updated_at"`
 	// Stats is populated only by detail-page lookups (GetArtist /
 	// GetArtistBySlug — PSY-639). List, search, and mutation responses leave
 	// it nil so the omitempty tag drops it from the wire.
 	Stats *ArtistStatsResponse `json:"stats,omitempty"`
+	// CanonicalSlug is set only by GetArtistBySlug when the requested slug is
+	// a historical one, so the frontend can 301 to the current URL.
+	CanonicalSlug string `json:"canonical_slug,omitempty"`
 }
 
 // ArtistStatsResponse carries the at-a-glance counts surfaced on the artist
@@ -557,6 +924,7 @@ type SocialResponse struct {
 	Spotify    *string `json:"spotify"`
 	SoundCloud *string `json:"soundcloud"`
 	Bandcamp   *string `json:"bandcamp"`
+	TikTok     *string `json:"tiktok"`
 	Website    *string `json:"website"`
 }
 
@@ -593,10 +961,11 @@ type ArtistShowResponse struct {
 	ID             uint                     `json:"id"`
 	Title          string                   `json:"title"`
 	EventDate      time.Time                `json:"event_date"`
-	Price          *float64                 `json:"price"`
 	AgeRequirement *string                  `json:"age_requirement"`
 	Venue          *ArtistShowVenueResponse `json:"venue"`
 	Artists        []ArtistShowArtist       `json:"artists"`
+
+	ShowPrice
 }
 
 // ArtistShowVenueResponse represents venue info in artist show response
@@ -624,6 +993,17 @@ type ArtistAliasResponse struct {
 	CreatedAt string `json:"created_at"`
 }
 
+// EntityAliasResponse represents an import-matching alias in API responses
+type EntityAliasResponse struct {
+	ID         uint   `json:"id"`
+	EntityType string `json:"entity_type"`
+	EntityID   uint   `json:"entity_id"`
+	EntityName string `json:"entity_name"`
+	Alias      string `json:"alias"`
+	CreatedBy  *uint  `json:"created_by,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
 // MergeArtistResult contains the outcome of merging two artists
 type MergeArtistResult struct {
 	CanonicalArtistID    uint   `json:"canonical_artist_id"`
@@ -637,7 +1017,31 @@ type MergeArtistResult struct {
 	BookmarksMoved       int64  `json:"bookmarks_moved"`
 	CollectionItemsMoved int64  `json:"crate_items_moved"`
 	FiltersUpdated       int64  `json:"filters_updated"`
-	AliasCreated         bool   `json:"alias_created"`
+	ReportsMoved         int64  `json:"reports_moved"`
+	RadioPlaysMoved      int64  `json:"radio_plays_moved"`
+	// LinksCopied lists the link columns (e.g. "spotify", "bandcamp") filled
+	// on the canonical artist from the merged one.
+	LinksCopied    []string `json:"links_copied"`
+	AliasCreated   bool     `json:"alias_created"`
+	SlugRedirected bool     `json:"slug_redirected"`
+}
+
+// MergeVenueResult contains the outcome of merging two venues
+type MergeVenueResult struct {
+	CanonicalVenueID     uint   `json:"canonical_venue_id"`
+	MergedVenueID        uint   `json:"merged_venue_id"`
+	MergedVenueName      string `json:"merged_venue_name"`
+	ShowsMoved           int64  `json:"shows_moved"`
+	FestivalsMoved       int64  `json:"festivals_moved"`
+	SeriesMoved          int64  `json:"series_moved"`
+	BookmarksMoved       int64  `json:"bookmarks_moved"`
+	CollectionItemsMoved int64  `json:"crate_items_moved"`
+	ReportsMoved         int64  `json:"reports_moved"`
+	FiltersUpdated       int64  `json:"filters_updated"`
+	// LinksCopied lists the link columns (e.g. "instagram", "website")
+	// filled on the canonical venue from the merged one.
+	LinksCopied    []string `json:"links_copied"`
+	SlugRedirected bool     `json:"slug_redirected"`
 }
 
 // ──────────────────────────────────────────────
@@ -671,6 +1075,9 @@ type SceneListResponse struct {
 	// default orange. Family keys are owned by the catalog service's genre-family
 	// map and mirrored by the frontend's GENRE_FAMILIES.
 	DominantGenre string `json:"dominant_genre,omitempty"`
+	// Metro is the scene's CBSA code, for linking to the `metro=` show and
+	// venue filters; omitted for a no-CBSA fallback scene.
+	Metro string `json:"metro,omitempty"`
 }
 
 // SceneShowSummary is one upcoming show in a scene's "This week" preview row
@@ -957,10 +1364,17 @@ type ShowServiceInterface interface {
 	GetUpcomingShows(timezone string, cursor string, limit int, includeNonApproved bool, filters *UpcomingShowsFilter) ([]*ShowResponse, *string, error)
 	GetShowCities(timezone string) ([]ShowCityResponse, error)
 	DeleteShow(showID uint) error
+	// SetShowLineup reorders a show's existing artists and sets their set
+	// types. The lineup must list every artist on the show exactly once, with
+	// exactly one headliner.
+	SetShowLineup(showID uint, lineup []ShowLineupEntry) (*ShowResponse, error)
 	// SearchShows returns up to 20 shows matching the query in show title or
 	// any bill artist name (case-insensitive), ordered by event_date DESC.
 	// Empty query returns an empty slice. PSY-520.
 	SearchShows(query string) ([]*ShowSearchResult, error)
+	// GetShowArchive pages past approved shows, optionally narrowed to a year
+	// and month (0 = any) in the given timezone.
+	GetShowArchive(year, month int, timezone string, limit, offset int) (*ShowArchive, error)
 }
 
 // ShowAdminServiceInterface defines the contract for admin show management operations
@@ -972,7 +1386,15 @@ type ShowAdminServiceInterface interface {
 	RejectShow(showID uint, reason string) (*ShowResponse, error)
 	BatchApproveShows(showIDs []uint) (*BatchShowResult, error)
 	BatchRejectShows(showIDs []uint, reason string, category string) (*BatchShowResult, error)
+	BulkShowAction(req *BulkShowActionRequest) (*BulkShowActionResult, error)
 	GetAdminShows(limit, offset int, filters AdminShowFilters) ([]*ShowResponse, int64, error)
+	RestoreShow(showID uint) (*ShowResponse, error)
+	GetExpiredDeletedShows() ([]uint, error)
+	PermanentlyDeleteShow(showID uint) error
+	FindDuplicateCandidates(showID uint) ([]DuplicateCandidate, error)
+	SetShowDuplicateOf(showID uint, duplicateOfShowID *uint) (*ShowResponse, error)
+	GetShowTicketStatus(showID uint) (*ShowTicketStatusResponse, error)
+	GetShowShortLinkStats(showID uint) (*ShowShortLinkStatsResponse, error)
 }
 
 // ShowImportServiceInterface defines the contract for show import/export operations.
@@ -991,6 +1413,21 @@ type ShowStateServiceInterface interface {
 	PublishShow(showID uint, userID uint, isAdmin bool) (*ShowResponse, error)
 	SetShowSoldOut(showID uint, isSoldOut bool) (*ShowResponse, error)
 	SetShowCancelled(showID uint, isCancelled bool) (*ShowResponse, error)
+	// CancelShow cancels a show with an optional reason.
+	CancelShow(showID uint, reason string) (*ShowResponse, error)
+	// PostponeShow postpones a show with an optional reason. A non-nil
+	// newEventDate also creates the linked rescheduled show.
+	PostponeShow(showID uint, reason string, newEventDate *time.Time) (*ShowResponse, error)
+}
+
+// ShowShortLinkServiceInterface defines the contract for resolving show
+// short links (/s/{code}) and counting their click-throughs.
+type ShowShortLinkServiceInterface interface {
+	// ResolveShowShortCode returns the approved, undeleted show a short code
+	// points at, or a CodeShowNotFound error.
+	ResolveShowShortCode(code string) (*ShowShortLinkTarget, error)
+	// RecordShowShortLinkClick counts one click-through from referrer.
+	RecordShowShortLinkClick(showID uint, referrer string) error
 }
 
 // ShowFullServiceInterface is the composite interface that embeds all show service
@@ -1001,6 +1438,7 @@ type ShowFullServiceInterface interface {
 	ShowAdminServiceInterface
 	ShowImportServiceInterface
 	ShowStateServiceInterface
+	ShowShortLinkServiceInterface
 }
 
 // ──────────────────────────────────────────────
@@ -1021,6 +1459,13 @@ type VenueServiceInterface interface {
 	GetVenuesWithShowCounts(filters VenueListFilters, limit, offset int) ([]*VenueWithShowCountResponse, int64, error)
 	GetUpcomingShowsForVenue(venueID uint, timezone string, limit int) ([]*VenueShowResponse, int64, error)
 	GetShowsForVenue(venueID uint, timezone string, limit int, timeFilter string) ([]*VenueShowResponse, int64, error)
+	// GetShowsForVenuePage is GetShowsForVenue with an offset and an optional
+	// year (0 = any) in the given timezone.
+	GetShowsForVenuePage(venueID uint, timezone string, limit, offset int, timeFilter string, year int) ([]*VenueShowResponse, int64, error)
+	GetShowYearsForVenue(venueID uint, timezone string, timeFilter string) ([]*ShowYearCount, error)
+	// GetVenueWidget returns the venue's next count approved shows, from the
+	// start of today in the venue's timezone.
+	GetVenueWidget(venueID uint, count int) (*VenueWidgetResponse, error)
 	GetVenueCities() ([]*VenueCityResponse, error)
 	GetVenueModel(venueID uint) (*catalogm.Venue, error)
 	GetUnverifiedVenues(limit, offset int) ([]*UnverifiedVenueResponse, int64, error)
@@ -1030,6 +1475,7 @@ type VenueServiceInterface interface {
 	// requested time window. Window is one of "all", "12m", "year"; Year
 	// is required iff Window=="year". Empty Window defaults to "all".
 	GetVenueBillNetwork(venueID uint, window string, year *int) (*VenueBillNetworkResponse, error)
+	MergeVenues(canonicalID, mergeFromID uint) (*MergeVenueResult, error)
 }
 
 // ──────────────────────────────────────────────
@@ -1052,6 +1498,10 @@ type ArtistServiceInterface interface {
 	DeleteArtist(artistID uint) error
 	SearchArtists(query string) ([]*ArtistDetailResponse, error)
 	GetShowsForArtist(artistID uint, timezone string, limit int, timeFilter string) ([]*ArtistShowResponse, int64, error)
+	// GetShowsForArtistPage is GetShowsForArtist with an offset and an
+	// optional year (0 = any) in the given timezone.
+	GetShowsForArtistPage(artistID uint, timezone string, limit, offset int, timeFilter string, year int) ([]*ArtistShowResponse, int64, error)
+	GetShowYearsForArtist(artistID uint, timezone string, timeFilter string) ([]*ShowYearCount, error)
 	// GetNextShowForArtist: the soonest upcoming show only (no count, no bill) —
 	// the graph-card's next-show glance (PSY-1352).
 	GetNextShowForArtist(artistID uint, timezone string) (*ArtistShowResponse, error)
@@ -1063,6 +1513,54 @@ type ArtistServiceInterface interface {
 	MergeArtists(canonicalID, mergeFromID uint) (*MergeArtistResult, error)
 }
 
+// ──────────────────────────────────────────────
+// Sitemap Service Interface
+// ──────────────────────────────────────────────
+
+// SitemapServiceInterface defines the contract for the public sitemap.
+type SitemapServiceInterface interface {
+	GetSitemap(page int) (doc []byte, found bool, err error)
+}
+
+// ──────────────────────────────────────────────
+// Flyer Service Interface
+// ──────────────────────────────────────────────
+
+// FlyerServiceInterface defines the contract for uploaded show flyers.
+type FlyerServiceInterface interface {
+	UploadShowFlyer(ctx context.Context, showID uint, r io.Reader) (*ShowFlyerResponse, error)
+	DeleteShowFlyer(ctx context.Context, showID uint) error
+}
+
+// ──────────────────────────────────────────────
+// Show Draft Service Interface
+// ──────────────────────────────────────────────
+
+// ShowDraftServiceInterface defines the contract for per-user show
+// submission drafts. Every method is scoped to the owning user; another
+// user's draft is reported as not found.
+type ShowDraftServiceInterface interface {
+	CreateDraft(userID uint, payload json.RawMessage) (*ShowDraftResponse, error)
+	UpdateDraft(userID, draftID uint, payload json.RawMessage) (*ShowDraftResponse, error)
+	GetDraft(userID, draftID uint) (*ShowDraftResponse, error)
+	ListDrafts(userID uint) ([]ShowDraftResponse, error)
+	DeleteDraft(userID, draftID uint) error
+}
+
+// ──────────────────────────────────────────────
+// Entity Alias Service Interface
+// ──────────────────────────────────────────────
+
+// EntityAliasServiceInterface defines the contract for the admin-curated
+// alias dictionary consulted by discovery and seed imports.
+type EntityAliasServiceInterface interface {
+	CreateAlias(entityType string, entityID uint, alias string, createdBy uint) (*EntityAliasResponse, error)
+	// ListAliases returns every alias, or only those of entityType when it
+	// is non-empty.
+	ListAliases(entityType string) ([]*EntityAliasResponse, error)
+	DeleteAlias(aliasID uint) (*EntityAliasResponse, error)
+}
+
 // ──────────────────────────────────────────────
 // Scene Service Interface
 // ──────────────────────────────────────────────
@@ -322,6 +322,29 @@ type CommunityPulse struct {
 	EntitiesInGraph int `json:"entities_in_graph"`
 }
 
+// PublicStats is the homepage hero's live numbers (GET /stats/public):
+// global inventory counts, safe for anonymous callers. Same numbers for
+// every visitor, like CommunityPulse.
+type PublicStats struct {
+	// UpcomingShows is approved non-cancelled shows from start-of-today UTC
+	// on — the same eligibility as the homepage Upcoming shows list.
+	UpcomingShows int `json:"upcoming_shows"`
+	// Venues counts verified venues only; unverified user submissions
+	// aren't listed publicly.
+	Venues  int `json:"venues"`
+	Artists int `json:"artists"`
+	// ShowsThisWeekByCity splits CommunityPulse.ShowsThisWeek by the show's
+	// city, busiest first. Shows without a city are left out.
+	ShowsThisWeekByCity []CityShowCount `json:"shows_this_week_by_city"`
+}
+
+// CityShowCount is one city's show count.
+type CityShowCount struct {
+	City  string `json:"city"`
+	State string `json:"state"`
+	Shows int    `json:"shows"`
+}
+
 // FreshlyAddedItem is one row of the freshly-added footer ticker: the most
 // recently added entities across types, newest first.
 type FreshlyAddedItem struct {
@@ -493,6 +516,49 @@ type FeaturedCollectionRun struct {
 	FeaturedAtEstimated bool       `json:"featured_at_estimated"`
 }
 
+// YearInReview is the end-of-year recap for one UTC calendar year: approved,
+// non-cancelled shows dated inside the year and on/before now (the current
+// year is a year-to-date recap). The personal variant scopes the same
+// aggregates to the shows the user saved. Leader fields are nil when the
+// year has no qualifying shows.
+type YearInReview struct {
+	Year             int                  `json:"year"`
+	TotalShows       int                  `json:"total_shows"`
+	MostActiveVenue  *YearInReviewVenue  `json:"most_active_venue"`
+	MostBookedArtist *YearInReviewArtist `json:"most_booked_artist"`
+	BusiestMonth     *YearInReviewMonth  `json:"busiest_month"`
+	Cities           []YearInReviewCity  `json:"cities"`
+}
+
+// YearInReviewVenue is the venue that hosted the most shows in the year.
+type YearInReviewVenue struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	ShowCount int    `json:"show_count"`
+}
+
+// YearInReviewArtist is the artist billed on the most shows in the year.
+type YearInReviewArtist struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	ShowCount int    `json:"show_count"`
+}
+
+// YearInReviewMonth is the month (1-12) with the most shows in the year.
+type YearInReviewMonth struct {
+	Month     int `json:"month"`
+	ShowCount int `json:"show_count"`
+}
+
+// YearInReviewCity is one city's show count for the year.
+type YearInReviewCity struct {
+	City      string `json:"city"`
+	State     string `json:"state"`
+	ShowCount int    `json:"show_count"`
+}
+
 // ──────────────────────────────────────────────
 // Charts Service Interface
 // ──────────────────────────────────────────────
@@ -523,9 +589,17 @@ type ChartsServiceInterface interface {
 	// GetCommunityPulse returns the homepage global pulse counts
 	// (shows-this-week + entities-in-graph). TTL-cached; viewer-independent.
 	GetCommunityPulse() (*CommunityPulse, error)
+	// GetPublicStats returns the homepage hero counts. TTL-cached;
+	// viewer-independent.
+	GetPublicStats() (*PublicStats, error)
 	GetFreshlyAdded(scene string, limit int) ([]FreshlyAddedItem, error)
 	GetChartScenes(window ChartWindow) ([]ChartScene, error)
 	GetPersonalChartsStats(userID uint) (*PersonalChartsStats, error)
+	// GetYearInReview returns the community recap for year (TTL-cached; a
+	// closed year caches for a day). GetPersonalYearInReview is the same
+	// recap over the user's saved shows and is never cached.
+	GetYearInReview(year int) (*YearInReview, error)
+	GetPersonalYearInReview(userID uint, year int) (*YearInReview, error)
 	GetPopularArtists(limit int) ([]PopularArtist, error)
 	GetActiveVenues(limit int) ([]ActiveVenue, error)
 	GetHotReleases(limit int) ([]HotRelease, error)
@@ -17,6 +17,20 @@ type SavedShowResponse struct {
 	SavedAt time.Time `json:"saved_at"`
 }
 
+// ShowRSVPResponse represents a show the user RSVP'd to
+type ShowRSVPResponse struct {
+	ShowResponse
+	RSVPAt time.Time `json:"rsvp_at"`
+}
+
+// ShowAttendeeResponse is a user listed as going to a show. Only users whose
+// rsvps privacy setting is visible are listed.
+type ShowAttendeeResponse struct {
+	UserID      uint   `json:"user_id"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
 // SavedReleaseResponse represents a release saved by a user. Releases retain
 // the historical `bookmark` storage action internally, but every public API
 // and UI surface calls the relationship Save/Saved.
@@ -84,6 +98,36 @@ type ArtistReportArtistInfo struct {
 	Slug string `json:"slug"`
 }
 
+// ──────────────────────────────────────────────
+// Venue Report types
+// ──────────────────────────────────────────────
+
+// VenueReportResponse represents a venue report response with venue info
+type VenueReportResponse struct {
+	ID         uint      `json:"id"`
+	VenueID    uint      `json:"venue_id"`
+	ReportType string    `json:"report_type"`
+	Details    *string   `json:"details"`
+	Status     string    `json:"status"`
+	AdminNotes *string   `json:"admin_notes,omitempty"`
+	ReviewedBy *uint     `json:"reviewed_by,omitempty"`
+	ReviewedAt *string   `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// Venue info (for admin view)
+	Venue *VenueReportVenueInfo `json:"venue,omitempty"`
+}
+
+// VenueReportVenueInfo contains venue information for report responses
+type VenueReportVenueInfo struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	City  string `json:"city"`
+	State string `json:"state"`
+}
+
 // ──────────────────────────────────────────────
 // Calendar types
 // ──────────────────────────────────────────────
@@ -127,6 +171,61 @@ type FollowingEntityResponse struct {
 	LastEpisodeDate *string `json:"last_episode_date,omitempty"`
 }
 
+// FollowedArtistShow is an upcoming approved show with at least one artist
+// the user follows on the bill.
+type FollowedArtistShow struct {
+	ID          uint      `json:"id"`
+	Slug        string    `json:"slug"`
+	Title       string    `json:"title"`
+	EventDate   time.Time `json:"event_date"`
+	City        *string   `json:"city"`
+	State       *string   `json:"state"`
+	VenueName   *string   `json:"venue_name"`
+	IsCancelled bool      `json:"is_cancelled"`
+	// FollowedArtists are the followed artists on the bill, in bill order.
+	FollowedArtists []FollowedArtistRef `json:"followed_artists"`
+	// InFollowedScene reports a show at a venue in a scene the user follows.
+	// Only the scene digest asks for it; it is false everywhere else.
+	InFollowedScene bool `json:"-"`
+}
+
+// FollowedArtistRef identifies one followed artist on a show's bill.
+type FollowedArtistRef struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// Recommendation reason types, one per scoring signal.
+const (
+	RecommendationReasonFollowedArtist = "followed_artist"
+	RecommendationReasonFollowedVenue  = "followed_venue"
+	RecommendationReasonSavedArtist    = "saved_artist"
+	RecommendationReasonSavedVenue     = "saved_venue"
+	RecommendationReasonFavoriteCity   = "favorite_city"
+)
+
+// RecommendedShow is an upcoming approved show scored for a user. Score is
+// the sum of the weights of its Reasons, strongest reason first.
+type RecommendedShow struct {
+	ID        uint                   `json:"id"`
+	Slug      string                 `json:"slug"`
+	Title     string                 `json:"title"`
+	EventDate time.Time              `json:"event_date"`
+	City      *string                `json:"city"`
+	State     *string                `json:"state"`
+	VenueName *string                `json:"venue_name"`
+	Score     int                    `json:"score"`
+	Reasons   []RecommendationReason `json:"reasons"`
+}
+
+// RecommendationReason explains one signal behind a recommendation, e.g.
+// "Because you saved Big Show at this venue".
+type RecommendationReason struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
 // LibraryFollowingCounts contains the follow totals surfaced by Library tabs.
 // Radio shows are intentionally excluded because they are managed in Radio.
 type LibraryFollowingCounts struct {
@@ -195,6 +294,26 @@ type SavedReleaseServiceInterface interface {
 	GetBatchSaveCounts(releaseIDs []uint) (map[uint]int, error)
 }
 
+// ──────────────────────────────────────────────
+// Show RSVP Service Interface
+// ──────────────────────────────────────────────
+
+// ShowRSVPServiceInterface defines the contract for show RSVPs ("I'm going").
+// RSVPs are separate from saves: a save is a private watchlist entry, an RSVP
+// feeds the show's public going count.
+type ShowRSVPServiceInterface interface {
+	RSVP(userID, showID uint) error
+	CancelRSVP(userID, showID uint) error
+	// GetUserRSVPs lists the shows a user RSVP'd to, most recent RSVP first.
+	GetUserRSVPs(userID uint, limit, offset int) ([]*ShowRSVPResponse, int64, error)
+	IsGoing(userID, showID uint) (bool, error)
+	GetGoingCount(showID uint) (int, error)
+	GetBatchGoingCounts(showIDs []uint) (map[uint]int, error)
+	// GetPublicAttendees lists the users going to a show who allow it, most
+	// recent RSVP first. The total counts only those listed users.
+	GetPublicAttendees(showID uint, limit, offset int) ([]*ShowAttendeeResponse, int64, error)
+}
+
 // ──────────────────────────────────────────────
 // Bookmark Service Interface
 // ──────────────────────────────────────────────
@@ -230,6 +349,17 @@ type FollowServiceInterface interface {
 	GetLibraryFollowingCounts(userID uint) (*LibraryFollowingCounts, error)
 	GetLibraryFollowing(userID uint, entityType string, limit int, cursor *LibraryFollowingCursor) ([]*LibraryFollowingEntityResponse, *LibraryFollowingCursor, error)
 	GetFollowers(entityType string, entityID uint, limit, offset int) ([]*FollowerResponse, int64, error)
+	GetFollowedArtistShows(userID uint, limit, offset int) ([]*FollowedArtistShow, int64, error)
+}
+
+// ──────────────────────────────────────────────
+// Recommendation Service Interface
+// ──────────────────────────────────────────────
+
+// RecommendationServiceInterface defines the contract for personalized show
+// recommendations.
+type RecommendationServiceInterface interface {
+	GetRecommendedShows(userID uint, limit int) ([]*RecommendedShow, error)
 }
 
 // ──────────────────────────────────────────────
@@ -248,3 +378,70 @@ type CalendarServiceInterface interface {
 	// releases involving artists the user follows (PSY-1505).
 	GenerateFollowsActivityFeed(userID uint, frontendURL string) ([]byte, error)
 }
+
+// ──────────────────────────────────────────────
+// Calendar Import Service Interface
+// ──────────────────────────────────────────────
+
+// Match confidence levels for CalendarImportCandidate.Confidence.
+const (
+	// CalendarMatchHigh: a billed artist and the venue both appear in the event.
+	CalendarMatchHigh = "high"
+	// CalendarMatchMedium: a billed artist appears in the event.
+	CalendarMatchMedium = "medium"
+	// CalendarMatchLow: only the venue appears in the event.
+	CalendarMatchLow = "low"
+)
+
+// CalendarImportServiceInterface imports past show attendance from a user's
+// calendar export. Preview is read-only: it matches calendar events against
+// approved past shows and returns candidates for the user to confirm.
+// Confirm records the chosen shows as saved (attendance was folded into the
+// show save action).
+type CalendarImportServiceInterface interface {
+	PreviewCalendarImport(userID uint, ics []byte) (*CalendarImportPreview, error)
+	ConfirmCalendarImport(userID uint, showIDs []uint) (*CalendarImportResult, error)
+}
+
+// CalendarImportPreview lists calendar events that matched at least one show.
+type CalendarImportPreview struct {
+	// EventCount is the number of events in the file.
+	EventCount int `json:"event_count"`
+	// SkippedCount counts events not considered: cancelled, undated, or not
+	// yet happened.
+	SkippedCount int `json:"skipped_count"`
+	// MatchedCount is len(Events).
+	MatchedCount int                   `json:"matched_count"`
+	Events       []CalendarImportEvent `json:"events"`
+}
+
+// CalendarImportEvent is one calendar event with its candidate shows, best
+// match first.
+type CalendarImportEvent struct {
+	UID        string                    `json:"uid,omitempty"`
+	Summary    string                    `json:"summary"`
+	Location   string                    `json:"location,omitempty"`
+	StartsAt   time.Time                 `json:"starts_at"`
+	Candidates []CalendarImportCandidate `json:"candidates"`
+}
+
+// CalendarImportCandidate is a show that may be the calendar event.
+type CalendarImportCandidate struct {
+	ShowID         uint      `json:"show_id"`
+	Slug           string    `json:"slug,omitempty"`
+	Title          string    `json:"title"`
+	EventDate      time.Time `json:"event_date"`
+	VenueName      string    `json:"venue_name,omitempty"`
+	MatchedArtists []string  `json:"matched_artists"`
+	MatchedVenue   bool      `json:"matched_venue"`
+	Confidence     string    `json:"confidence"`
+	AlreadySaved   bool      `json:"already_saved"`
+}
+
+// CalendarImportResult summarizes a confirm request.
+type CalendarImportResult struct {
+	Saved        int `json:"saved"`
+	AlreadySaved int `json:"already_saved"`
+	// Skipped lists IDs that are not approved past shows.
+	Skipped []uint `json:"skipped"`
+}
@@ -0,0 +1,13 @@
+package contracts
+
+// EntityResolution maps one legacy numeric ID or slug to its canonical
+// identity. Found is false when the reference matches nothing publicly
+// visible; ID, Slug and Path are then empty.
+type EntityResolution struct {
+	Query string `json:"query"`
+	Found bool   `json:"found"`
+	ID    uint   `json:"id,omitempty"`
+	Slug  string `json:"slug,omitempty"`
+	// Path is the canonical frontend path, e.g. "/shows/<slug>".
+	Path string `json:"path,omitempty"`
+}
@@ -0,0 +1,52 @@
+package contracts
+
+// ──────────────────────────────────────────────
+// Nearby Shows Service Interface
+// ──────────────────────────────────────────────
+
+// Sources for NearbyShowsResponse.Source.
+const (
+	// NearbySourceIP means the shows come from the area inferred from the
+	// client's IP address.
+	NearbySourceIP = "ip"
+	// NearbySourceDefault means nothing usable was inferred (or the inferred
+	// area has no upcoming shows) and the configured default cities were used.
+	NearbySourceDefault = "default"
+)
+
+// NearbyShowsServiceInterface serves "shows near me" for anonymous visitors:
+// upcoming shows in the metro area inferred from the client IP, falling back
+// to a configured default city list.
+type NearbyShowsServiceInterface interface {
+	GetNearbyShows(clientIP string, timezone string, limit int) (*NearbyShowsResponse, error)
+}
+
+// NearbyLocation is what the client IP resolved to.
+type NearbyLocation struct {
+	City    string `json:"city,omitempty"`
+	State   string `json:"state,omitempty"`
+	Country string `json:"country,omitempty"`
+}
+
+// NearbyCity is one city of the area the shows were drawn from.
+type NearbyCity struct {
+	City  string `json:"city"`
+	State string `json:"state"`
+}
+
+// NearbyShowsResponse is the GET /shows/nearby payload.
+type NearbyShowsResponse struct {
+	// Source is NearbySourceIP or NearbySourceDefault.
+	Source string `json:"source"`
+	// Location is the IP lookup result, present even when Source is
+	// "default" so clients can say "no shows near Boise yet".
+	Location *NearbyLocation `json:"location,omitempty"`
+	// Area is a display label for where the shows are, e.g.
+	// "Phoenix-Mesa-Chandler, AZ" or "Phoenix, AZ".
+	Area string `json:"area"`
+	// MetroCode is the CBSA code when the area is a metro.
+	MetroCode string `json:"metro_code,omitempty"`
+	// Cities lists the cities filtered on when the area is not a metro.
+	Cities []NearbyCity    `json:"cities,omitempty"`
+	Shows  []*ShowResponse `json:"shows"`
+}
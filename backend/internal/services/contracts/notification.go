@@ -35,7 +35,10 @@ type SceneDigestShow struct {
 	DisplayTitle string
 	Date         string // human date, e.g. "Fri, Jul 4"
 	VenueName    string
-	ShowURL      string
+	// Location is "City, ST" for a followed-artist show outside every scene
+	// the user follows, so an out-of-town date reads as one; empty otherwise.
+	Location string
+	ShowURL  string
 }
 
 // SceneDigestArtist is one "new band based here" line (PSY-1342).
@@ -58,6 +61,21 @@ type SceneDigestGroup struct {
 	MoreNewArtists int
 }
 
+// ShowStatusChange describes a saved or RSVP'd show being cancelled or
+// postponed, for the email to its savers. Dates are already formatted in
+// the venue's zone. NewDate and NewShowURL are set only when a postponed
+// show has been rescheduled.
+type ShowStatusChange struct {
+	ShowTitle  string
+	ShowURL    string
+	Date       string
+	VenueName  string
+	Cancelled  bool // false: postponed
+	Reason     string
+	NewDate    string
+	NewShowURL string
+}
+
 // ──────────────────────────────────────────────
 // Email Service Interface
 // ──────────────────────────────────────────────
@@ -68,7 +86,7 @@ type EmailServiceInterface interface {
 	SendVerificationEmail(toEmail, token string) error
 	SendMagicLinkEmail(toEmail, token string) error
 	SendAccountRecoveryEmail(toEmail, token string, daysRemaining int) error
-	SendShowReminderEmail(toEmail, showTitle, showURL, unsubscribeURL string, eventDate time.Time, venues []string) error
+	SendShowReminderEmail(toEmail, showTitle, showURL, unsubscribeURL string, eventDate time.Time, venues []string, daysBefore int) error
 	SendFilterNotificationEmail(toEmail, subject, htmlBody, unsubscribeURL string) error
 	// Each takes an HMAC-signed unsubscribeURL (RFC 8058 one-click).
 	SendTierPromotionEmail(toEmail, username, oldTier, newTier, reason, unsubscribeURL string, newPermissions []string) error
@@ -84,7 +102,40 @@ type EmailServiceInterface interface {
 	SendCollectionDigestEmail(toEmail string, groups []CollectionDigestGroup, unsubscribeURL string) error
 	// PSY-1342: weekly scene digest — single batched email per user grouping
 	// this-week shows + new bands across all the scenes they follow.
-	SendSceneDigestEmail(toEmail string, groups []SceneDigestGroup, unsubscribeURL string) error
+	SendSceneDigestEmail(toEmail string, groups []SceneDigestGroup, artistShows []SceneDigestShow, unsubscribeURL string) error
+	// SendShowStatusChangeEmail tells a user a show they saved or RSVP'd to
+	// was cancelled or postponed.
+	SendShowStatusChangeEmail(toEmail string, change ShowStatusChange, unsubscribeURL string) error
+}
+
+// EmailPreview is an email template rendered with sample data.
+type EmailPreview struct {
+	Template string `json:"template"`
+	Subject  string `json:"subject"`
+	HTML     string `json:"html"`
+	Text     string `json:"text"`
+}
+
+// EmailPreviewServiceInterface renders email templates for the admin
+// preview endpoint.
+type EmailPreviewServiceInterface interface {
+	EmailTemplateNames() []string
+	PreviewEmail(template string) (*EmailPreview, error)
+}
+
+// ──────────────────────────────────────────────
+// Email Suppression Service Interface
+// ──────────────────────────────────────────────
+
+// EmailSuppressionServiceInterface defines the contract for the email
+// suppression list: addresses that hard-bounced or complained, which
+// EmailService skips.
+type EmailSuppressionServiceInterface interface {
+	IsSuppressed(email string) (bool, error)
+	Suppress(email, reason, provider, detail string) error
+	// IngestBounceWebhook parses a provider's bounce/complaint webhook body
+	// and suppresses the addresses it reports. Returns how many were added.
+	IngestBounceWebhook(provider string, body []byte) (int, error)
 }
 
 // ──────────────────────────────────────────────
@@ -98,6 +149,13 @@ type ReminderServiceInterface interface {
 	RunReminderCycleNow()
 }
 
+// ShowChangeNotifierInterface tells the users who saved or RSVP'd to a show
+// that it was cancelled or postponed. actorID, the user who made the change,
+// is not notified.
+type ShowChangeNotifierInterface interface {
+	NotifyShowChange(showID, actorID uint) error
+}
+
 // ──────────────────────────────────────────────
 // Discord Service Interface
 // ──────────────────────────────────────────────
@@ -112,6 +170,11 @@ type DiscordServiceInterface interface {
 	NotifyShowRejected(show *ShowResponse, reason string)
 	NotifyShowReport(report *communitym.ShowReport, reporterEmail string)
 	NotifyArtistReport(report *communitym.ArtistReport, reporterEmail string)
+	NotifyVenueReport(report *communitym.VenueReport, reporterEmail string)
 	NotifyNewVenue(venueID uint, venueName, city, state string, address *string, submitterEmail string)
 	NotifyNewRadioShows(stationName string, newShowNames []string)
+	NotifyBulkShowAction(result *BulkShowActionResult, actorEmail string)
+	NotifyStaleDiscoverySources(sources []DiscoverySourceHealth, staleAfterDays int)
+	NotifySubmissionThrottle(user *authm.User, event, detail string)
+	NotifyMaintenanceMode(enabled bool, source, actorEmail string)
 }
@@ -0,0 +1,52 @@
+package contracts
+
+// ──────────────────────────────────────────────
+// Notification Preference types
+// ──────────────────────────────────────────────
+
+// Notification event types, the rows of the preference matrix.
+const (
+	NotificationEventSavedShowReminder         = "saved_show_reminder"
+	NotificationEventSavedShowChange           = "saved_show_change"
+	NotificationEventFavoriteVenueAnnouncement = "favorite_venue_announcement"
+	NotificationEventFollowedArtistShow        = "followed_artist_show"
+	NotificationEventSubmissionStatus          = "submission_status"
+	NotificationEventDigest                    = "digest"
+)
+
+// Notification channels, the columns of the preference matrix.
+const (
+	NotificationChannelEmail = "email"
+	NotificationChannelPush  = "push"
+)
+
+// NotificationEventTypes lists every event type in display order.
+var NotificationEventTypes = []string{
+	NotificationEventSavedShowReminder,
+	NotificationEventSavedShowChange,
+	NotificationEventFavoriteVenueAnnouncement,
+	NotificationEventFollowedArtistShow,
+	NotificationEventSubmissionStatus,
+	NotificationEventDigest,
+}
+
+// NotificationChannels lists every delivery channel.
+var NotificationChannels = []string{
+	NotificationChannelEmail,
+	NotificationChannelPush,
+}
+
+// NotificationPreferenceMatrix maps event type → channel → enabled. As a
+// response it always holds every cell; as an update it holds only the cells
+// to change.
+type NotificationPreferenceMatrix map[string]map[string]bool
+
+// NotificationPreferenceServiceInterface defines the contract for the
+// per-user notification preference matrix. Senders should ask IsEnabled (or
+// FilterEnabledUsers for batches) before delivering on a channel.
+type NotificationPreferenceServiceInterface interface {
+	GetPreferences(userID uint) (NotificationPreferenceMatrix, error)
+	UpdatePreferences(userID uint, updates NotificationPreferenceMatrix) (NotificationPreferenceMatrix, error)
+	IsEnabled(userID uint, eventType, channel string) (bool, error)
+	FilterEnabledUsers(userIDs []uint, eventType, channel string) ([]uint, error)
+}
@@ -163,6 +163,7 @@ type DiscoveredEvent struct {
 	AgeRestriction *string            `json:"ageRestriction"`            // Age restriction (e.g., "16+", "All Ages")
 	IsSoldOut      *bool              `json:"isSoldOut"`                 // Whether the event is sold out
 	IsCancelled    *bool              `json:"isCancelled"`               // Whether the event is cancelled
+	Genres         []string           `json:"genres,omitempty"`          // Genre names from the listing (e.g., "Post-Punk"), if the source has any
 }
 
 // ImportResult contains statistics about the import operation
@@ -177,6 +178,26 @@ type ImportResult struct {
 	Messages      []string `json:"messages"`       // Detailed messages for each event
 }
 
+// DiscoverySourceHealth reports import health for one scraped venue source
+type DiscoverySourceHealth struct {
+	SourceKey        string     `json:"source_key"`
+	VenueName        string     `json:"venue_name"`
+	Status           string     `json:"status"` // ok, failing or stale
+	LastImportAt     *time.Time `json:"last_import_at"`
+	LastSuccessAt    *time.Time `json:"last_success_at"`
+	DaysSinceSuccess *int       `json:"days_since_success"` // nil when the source never succeeded
+	LastEventCount   int        `json:"last_event_count"`
+	TotalEvents      int64      `json:"total_events"`
+	ErrorStreak      int        `json:"error_streak"`
+	LastError        *string    `json:"last_error"`
+}
+
+// DiscoverySourceHealthList is every tracked source, stalest first
+type DiscoverySourceHealthList struct {
+	Sources        []DiscoverySourceHealth `json:"sources"`
+	StaleAfterDays int                     `json:"stale_after_days"`
+}
+
 // CheckEventInput represents the input for checking whether an event exists
 type CheckEventInput struct {
 	ID        string `json:"id"`
@@ -186,7 +207,10 @@ type CheckEventInput struct {
 
 // ShowCurrentData contains the current stored data for a show, used for diff comparison
 type ShowCurrentData struct {
-	Price          *float64 `json:"price,omitempty"`
+	Price          *float64 `json:"price,omitempty"` // lowest price, 0 when free
+	PriceMin       *float64 `json:"priceMin,omitempty"`
+	PriceMax       *float64 `json:"priceMax,omitempty"`
+	IsFree         bool     `json:"isFree"`
 	AgeRequirement *string  `json:"ageRequirement,omitempty"`
 	Description    *string  `json:"description,omitempty"`
 	EventDate      string   `json:"eventDate,omitempty"`
@@ -345,6 +369,7 @@ type DiscoveryServiceInterface interface {
 	ImportFromJSONWithDB(filepath string, dryRun bool, database *gorm.DB) (*ImportResult, error)
 	CheckEvents(events []CheckEventInput) (*CheckEventsResult, error)
 	ImportEvents(events []DiscoveredEvent, dryRun bool, allowUpdates bool, initialStatus catalogm.ShowStatus) (*ImportResult, error)
+	ListSourceHealth() (*DiscoverySourceHealthList, error)
 }
 
 // ──────────────────────────────────────────────
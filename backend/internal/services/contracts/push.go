@@ -0,0 +1,39 @@
+package contracts
+
+// ──────────────────────────────────────────────
+// Web Push types
+// ──────────────────────────────────────────────
+
+// PushSubscriptionInput is a browser PushSubscription as serialized by
+// PushSubscription.toJSON(): the endpoint plus base64url p256dh/auth keys.
+type PushSubscriptionInput struct {
+	Endpoint  string
+	P256dh    string
+	Auth      string
+	UserAgent string
+}
+
+// PushMessage is the JSON payload delivered to the service worker, which
+// shows it as a notification linking to URL. Tag collapses repeats of the
+// same notification on a device.
+type PushMessage struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	URL   string `json:"url,omitempty"`
+	Tag   string `json:"tag,omitempty"`
+}
+
+// PushServiceInterface defines the contract for Web Push subscriptions and
+// delivery. Senders check the user's push preference first; SendToUser only
+// delivers.
+type PushServiceInterface interface {
+	IsConfigured() bool
+	// PublicKey is the base64url VAPID key browsers subscribe with.
+	PublicKey() string
+	Subscribe(userID uint, input PushSubscriptionInput) error
+	Unsubscribe(userID uint, endpoint string) error
+	// SendToUser delivers msg to every subscription the user has and returns
+	// how many accepted it. Subscriptions the push service reports gone are
+	// removed.
+	SendToUser(userID uint, msg PushMessage) (int, error)
+}
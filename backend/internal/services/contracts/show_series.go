@@ -0,0 +1,125 @@
+package contracts
+
+import "time"
+
+// ──────────────────────────────────────────────
+// Show Series Service Interface
+// ──────────────────────────────────────────────
+
+// ShowSeriesServiceInterface defines the contract for recurring-event
+// templates. A series generates one Show per matching weekday in its date
+// range; occurrences detached for a one-off edit are left alone by later
+// template edits and regeneration.
+type ShowSeriesServiceInterface interface {
+	CreateSeries(req *CreateShowSeriesRequest) (*ShowSeriesResponse, error)
+	GetSeries(seriesID uint) (*ShowSeriesResponse, error)
+	// UpdateSeries edits the template and regenerates future, non-detached
+	// occurrences so they reflect it.
+	UpdateSeries(seriesID uint, req *UpdateShowSeriesRequest) (*ShowSeriesResponse, error)
+	// RegenerateFutureOccurrences replaces every future, non-detached
+	// occurrence with a fresh one built from the current template.
+	RegenerateFutureOccurrences(seriesID uint) (*ShowSeriesResponse, error)
+	// DetachOccurrence marks one occurrence as a one-off so series edits stop
+	// touching it. The show keeps its series_id for provenance.
+	DetachOccurrence(seriesID, showID uint) (*ShowSeriesOccurrence, error)
+	// DeleteSeries removes the template and its future, non-detached
+	// occurrences. Past and detached occurrences survive as ordinary shows.
+	DeleteSeries(seriesID uint) error
+}
+
+// ShowSeriesArtistInput is one billed artist on a series template. Artists
+// must already exist; series are an admin tool, not a submission path.
+type ShowSeriesArtistInput struct {
+	ArtistID    uint `json:"artist_id" minimum:"1" doc:"Existing artist ID"`
+	IsHeadliner bool `json:"is_headliner,omitempty" doc:"Whether the artist headlines"`
+}
+
+// CreateShowSeriesRequest is the template for a new series. StartDate and
+// EndDate are venue-local calendar dates ("YYYY-MM-DD"); StartTime is the
+// venue-local wall-clock start ("HH:MM"). The price fields follow
+// CreateShowRequest; an empty PriceCurrency is USD.
+type CreateShowSeriesRequest struct {
+	Title          string                  `json:"title"`
+	VenueID        uint                    `json:"venue_id"`
+	DayOfWeek      int                     `json:"day_of_week"`
+	StartTime      string                  `json:"start_time"`
+	StartDate      string                  `json:"start_date"`
+	EndDate        string                  `json:"end_date"`
+	PriceMin       *float64                `json:"price_min,omitempty"`
+	PriceMax       *float64                `json:"price_max,omitempty"`
+	PriceCurrency  string                  `json:"price_currency,omitempty"`
+	IsFree         bool                    `json:"is_free,omitempty"`
+	AgeRequirement string                  `json:"age_requirement,omitempty"`
+	Description    string                  `json:"description,omitempty"`
+	TicketURL      string                  `json:"ticket_url,omitempty"`
+	Artists        []ShowSeriesArtistInput `json:"artists"`
+
+	CreatedBy *uint `json:"-"` // Admin user ID (set by handler)
+}
+
+// UpdateShowSeriesRequest carries a partial template edit. Nil fields are
+// left unchanged; a non-nil Artists replaces the whole billing. The price
+// fields are written like UpdateShowRequest's.
+type UpdateShowSeriesRequest struct {
+	Title          *string                  `json:"title,omitempty"`
+	DayOfWeek      *int                     `json:"day_of_week,omitempty"`
+	StartTime      *string                  `json:"start_time,omitempty"`
+	StartDate      *string                  `json:"start_date,omitempty"`
+	EndDate        *string                  `json:"end_date,omitempty"`
+	PriceMin       *float64                 `json:"price_min,omitempty"`
+	PriceMax       *float64                 `json:"price_max,omitempty"`
+	PriceCurrency  *string                  `json:"price_currency,omitempty"`
+	IsFree         *bool                    `json:"is_free,omitempty"`
+	ClearPrice     bool                     `json:"clear_price,omitempty"`
+	AgeRequirement *string                  `json:"age_requirement,omitempty"`
+	Description    *string                  `json:"description,omitempty"`
+	TicketURL      *string                  `json:"ticket_url,omitempty"`
+	Artists        *[]ShowSeriesArtistInput `json:"artists,omitempty"`
+}
+
+// ShowSeriesArtistResponse is one billed artist on a series template.
+type ShowSeriesArtistResponse struct {
+	ArtistID    uint   `json:"artist_id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	IsHeadliner bool   `json:"is_headliner"`
+}
+
+// ShowSeriesOccurrence is one generated show.
+type ShowSeriesOccurrence struct {
+	ShowID    uint      `json:"show_id"`
+	Slug      string    `json:"slug"`
+	EventDate time.Time `json:"event_date"`
+	Detached  bool      `json:"detached"`
+}
+
+// ShowSeriesSkippedDate is an occurrence date that generation did not fill,
+// e.g. because a detached occurrence or a conflicting show already holds it.
+type ShowSeriesSkippedDate struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
+
+// ShowSeriesResponse is a series template plus its occurrences.
+type ShowSeriesResponse struct {
+	ID             uint                       `json:"id"`
+	Title          string                     `json:"title"`
+	VenueID        uint                       `json:"venue_id"`
+	VenueName      string                     `json:"venue_name"`
+	DayOfWeek      int                        `json:"day_of_week"`
+	StartTime      string                     `json:"start_time"`
+	StartDate      string                     `json:"start_date"`
+	EndDate        string                     `json:"end_date"`
+	AgeRequirement *string                    `json:"age_requirement,omitempty"`
+	Description    *string                    `json:"description,omitempty"`
+	TicketURL      *string                    `json:"ticket_url,omitempty"`
+	Artists        []ShowSeriesArtistResponse `json:"artists"`
+	Occurrences    []ShowSeriesOccurrence     `json:"occurrences"`
+	// Skipped lists dates the last generation pass left empty. Only populated
+	// on responses from a write that generated occurrences.
+	Skipped   []ShowSeriesSkippedDate `json:"skipped,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+
+	ShowPrice
+}
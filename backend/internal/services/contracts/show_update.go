@@ -0,0 +1,33 @@
+package contracts
+
+import "time"
+
+// ──────────────────────────────────────────────
+// Show Update Service Interface
+// ──────────────────────────────────────────────
+
+// ShowUpdateServiceInterface defines the contract for a show's updates feed.
+// Who may post is decided by the handler; the service only stores and lists.
+type ShowUpdateServiceInterface interface {
+	// CreateUpdate posts an update on a show.
+	CreateUpdate(showID, userID uint, body string) (*ShowUpdateResponse, error)
+	// ListUpdates returns a show's updates, oldest first.
+	ListUpdates(showID uint) ([]ShowUpdateResponse, error)
+	// GetUpdate returns a single update.
+	GetUpdate(updateID uint) (*ShowUpdateResponse, error)
+	// DeleteUpdate removes an update.
+	DeleteUpdate(updateID uint) error
+}
+
+// MaxShowUpdateBodyLength caps an update's body, in characters.
+const MaxShowUpdateBodyLength = 1000
+
+// ShowUpdateResponse is one entry in a show's updates feed.
+type ShowUpdateResponse struct {
+	ID        uint      `json:"id"`
+	ShowID    uint      `json:"show_id"`
+	UserID    uint      `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,43 @@
+package contracts
+
+import (
+	"time"
+
+	authm "psychic-homily-backend/internal/models/auth"
+)
+
+// ──────────────────────────────────────────────
+// Submission throttle types
+// ──────────────────────────────────────────────
+
+// SubmissionDecision is the throttle verdict for a show submission that is
+// allowed through. Hold routes it to the moderation queue as pending
+// instead of publishing it.
+type SubmissionDecision struct {
+	Hold   bool
+	Reason string
+}
+
+// QuarantinedUser is one entry in the admin quarantine list.
+type QuarantinedUser struct {
+	UserID         uint      `json:"user_id"`
+	Username       *string   `json:"username,omitempty"`
+	Email          *string   `json:"email,omitempty"`
+	UserTier       string    `json:"user_tier"`
+	QuarantinedAt  time.Time `json:"quarantined_at"`
+	Reason         *string   `json:"reason,omitempty"`
+	Submissions24h int64     `json:"submissions_24h"`
+	PendingShows   int64     `json:"pending_shows"`
+}
+
+// SubmissionThrottleServiceInterface defines the contract for show
+// submission caps, burst detection and the submitter quarantine.
+type SubmissionThrottleServiceInterface interface {
+	// CheckSubmission is called before a user's show is created. A user over
+	// their daily cap gets a *errors.SubmissionError; a quarantined user (or
+	// one who just tripped burst detection) gets a decision with Hold set.
+	CheckSubmission(user *authm.User) (*SubmissionDecision, error)
+	QuarantineUser(userID uint, reason string) error
+	ReleaseUser(userID uint) error
+	ListQuarantinedUsers() ([]QuarantinedUser, error)
+}
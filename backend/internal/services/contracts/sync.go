@@ -0,0 +1,102 @@
+package contracts
+
+import "time"
+
+// ──────────────────────────────────────────────
+// Differential Sync Service Interface
+// ──────────────────────────────────────────────
+
+// SyncServiceInterface serves change feeds for offline-capable clients. Each
+// call returns what changed after an opaque cursor: records created or
+// updated since, and IDs deleted (or withdrawn from public view) since.
+// An empty cursor starts a full sync in which every record is "created".
+//
+// market narrows the feed to the given city/state pairs; nil means every
+// market. Records edited out of a market are not reported as deleted, so
+// clients that switch markets should resync from scratch.
+type SyncServiceInterface interface {
+	GetShowChanges(cursor string, limit int, market []CityStateFilter) (*SyncShowDelta, error)
+	GetVenueChanges(cursor string, limit int, market []CityStateFilter) (*SyncVenueDelta, error)
+	GetArtistChanges(cursor string, limit int, market []CityStateFilter) (*SyncArtistDelta, error)
+}
+
+// SyncShow is the compact show record sent to sync clients. Venues and
+// artists are referenced by ID and synced through their own feeds.
+type SyncShow struct {
+	ID             uint      `json:"id"`
+	Slug           string    `json:"slug"`
+	Title          string    `json:"title"`
+	EventDate      time.Time `json:"event_date"`
+	City           *string   `json:"city,omitempty"`
+	State          *string   `json:"state,omitempty"`
+	AgeRequirement *string   `json:"age_requirement,omitempty"`
+	TicketURL      *string   `json:"ticket_url,omitempty"`
+	TicketProvider *string   `json:"ticket_provider,omitempty"`
+	IsSoldOut      bool      `json:"is_sold_out"`
+	IsCancelled    bool      `json:"is_cancelled"`
+	VenueIDs       []uint    `json:"venue_ids"`
+	// ArtistIDs is in billing order, headliner first.
+	ArtistIDs []uint    `json:"artist_ids"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Price is the lowest price (0 when free), as before price ranges.
+	Price         *float64 `json:"price,omitempty"`
+	PriceMin      *float64 `json:"price_min,omitempty"`
+	PriceMax      *float64 `json:"price_max,omitempty"`
+	PriceCurrency string   `json:"price_currency"`
+	IsFree        bool     `json:"is_free"`
+}
+
+// SyncVenue is the compact venue record sent to sync clients.
+type SyncVenue struct {
+	ID        uint      `json:"id"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	Address   *string   `json:"address,omitempty"`
+	City      string    `json:"city"`
+	State     string    `json:"state"`
+	Zipcode   *string   `json:"zipcode,omitempty"`
+	Latitude  *float64  `json:"latitude,omitempty"`
+	Longitude *float64  `json:"longitude,omitempty"`
+	Timezone  *string   `json:"timezone,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SyncArtist is the compact artist record sent to sync clients.
+type SyncArtist struct {
+	ID        uint      `json:"id"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	City      *string   `json:"city,omitempty"`
+	State     *string   `json:"state,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SyncShowDelta is one page of show changes. When HasMore is true the client
+// should immediately call again with NextCursor; otherwise NextCursor is the
+// checkpoint to store for the next sync.
+type SyncShowDelta struct {
+	Created    []SyncShow `json:"created"`
+	Updated    []SyncShow `json:"updated"`
+	Deleted    []uint     `json:"deleted"`
+	NextCursor string     `json:"next_cursor"`
+	HasMore    bool       `json:"has_more"`
+}
+
+// SyncVenueDelta is one page of venue changes; see SyncShowDelta.
+type SyncVenueDelta struct {
+	Created    []SyncVenue `json:"created"`
+	Updated    []SyncVenue `json:"updated"`
+	Deleted    []uint      `json:"deleted"`
+	NextCursor string      `json:"next_cursor"`
+	HasMore    bool        `json:"has_more"`
+}
+
+// SyncArtistDelta is one page of artist changes; see SyncShowDelta.
+type SyncArtistDelta struct {
+	Created    []SyncArtist `json:"created"`
+	Updated    []SyncArtist `json:"updated"`
+	Deleted    []uint       `json:"deleted"`
+	NextCursor string       `json:"next_cursor"`
+	HasMore    bool         `json:"has_more"`
+}
@@ -38,6 +38,13 @@ type TagSummary struct {
 	UsageCount int    `json:"usage_count"`
 }
 
+// GenreChip is a genre on a show, derived from the genres of its lineup.
+type GenreChip struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
 // TagUserRef is a minimal user reference for creator attribution and contributor lists.
 // Username doubles as the public profile slug (users/{username}).
 type TagUserRef struct {
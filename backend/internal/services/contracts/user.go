@@ -1,6 +1,7 @@
 package contracts
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/markbates/goth"
@@ -14,7 +15,8 @@ import (
 
 // AdminUserFilters contains filter criteria for listing users
 type AdminUserFilters struct {
-	Search string // ILIKE match on email or username
+	Search string     // ILIKE match on email or username
+	Role   authm.Role // exact role match; empty = any
 }
 
 // UserSubmissionStats contains show submission counts by status
@@ -25,22 +27,33 @@ type UserSubmissionStats struct {
 	Total    int64 `json:"total"`
 }
 
+// UserSubmissionReputation contains moderator review outcomes for a user's
+// show submissions
+type UserSubmissionReputation struct {
+	Approved     int      `json:"approved"`
+	Rejected     int      `json:"rejected"`
+	ApprovalRate *float64 `json:"approval_rate"` // nil until a submission has been reviewed
+}
+
 // AdminUserResponse is the response type for the admin user list
 type AdminUserResponse struct {
-	ID              uint                `json:"id"`
-	Email           *string             `json:"email"`
-	Username        *string             `json:"username"`
-	DisplayName     *string             `json:"display_name"`
-	FirstName       *string             `json:"first_name"`
-	LastName        *string             `json:"last_name"`
-	AvatarURL       *string             `json:"avatar_url"`
-	IsActive        bool                `json:"is_active"`
-	IsAdmin         bool                `json:"is_admin"`
-	EmailVerified   bool                `json:"email_verified"`
-	AuthMethods     []string            `json:"auth_methods"`
-	SubmissionStats UserSubmissionStats `json:"submission_stats"`
-	CreatedAt       time.Time           `json:"created_at"`
-	DeletedAt       *time.Time          `json:"deleted_at,omitempty"`
+	ID              uint                     `json:"id"`
+	Email           *string                  `json:"email"`
+	Username        *string                  `json:"username"`
+	DisplayName     *string                  `json:"display_name"`
+	FirstName       *string                  `json:"first_name"`
+	LastName        *string                  `json:"last_name"`
+	AvatarURL       *string                  `json:"avatar_url"`
+	IsActive        bool                     `json:"is_active"`
+	IsAdmin         bool                     `json:"is_admin"`
+	Role            authm.Role               `json:"role"`
+	EmailVerified   bool                     `json:"email_verified"`
+	AuthMethods     []string                 `json:"auth_methods"`
+	SubmissionStats UserSubmissionStats      `json:"submission_stats"`
+	Reputation      UserSubmissionReputation `json:"reputation"`
+	TrustTier       authm.SubmissionTrust    `json:"trust_tier"` // Derived from Reputation; see authm.User.SubmissionTrust
+	CreatedAt       time.Time                `json:"created_at"`
+	DeletedAt       *time.Time               `json:"deleted_at,omitempty"`
 }
 
 // DeletionSummary contains counts of data that will be affected by account deletion
@@ -62,8 +75,16 @@ type UserDataExport struct {
 	SavedShows     []SavedShowExport      `json:"saved_shows,omitempty"`
 	SavedReleases  []SavedReleaseExport   `json:"saved_releases,omitempty"`
 	SubmittedShows []SubmittedShowExport  `json:"submitted_shows,omitempty"`
+	FavoriteVenues []FavoriteVenueExport  `json:"favorite_venues,omitempty"`
+	Reports        []ReportExport         `json:"reports,omitempty"`
+	AuditEntries   []AuditEntryExport     `json:"audit_entries,omitempty"`
+	Notifications  []NotificationExport   `json:"notification_history,omitempty"`
 }
 
+// UserDataExportVersion is bumped whenever UserDataExport gains or changes a
+// section, so consumers of old exports can tell what to expect
+const UserDataExportVersion = "1.1"
+
 // UserProfileExport contains user profile data for export
 type UserProfileExport struct {
 	ID            uint      `json:"id"`
@@ -80,11 +101,18 @@ type UserProfileExport struct {
 
 // UserPreferencesExport contains user preferences for export
 type UserPreferencesExport struct {
-	NotificationEmail bool   `json:"notification_email"`
-	NotificationPush  bool   `json:"notification_push"`
-	Theme             string `json:"theme"`
-	Timezone          string `json:"timezone"`
-	Language          string `json:"language"`
+	Theme         string                         `json:"theme"`
+	Timezone      string                         `json:"timezone"`
+	Language      string                         `json:"language"`
+	Notifications []NotificationPreferenceExport `json:"notifications,omitempty"`
+}
+
+// NotificationPreferenceExport is one notification preference the user has
+// set; cells left at their default are not exported
+type NotificationPreferenceExport struct {
+	EventType string `json:"event_type"`
+	Channel   string `json:"channel"`
+	Enabled   bool   `json:"enabled"`
 }
 
 // OAuthAccountExport contains OAuth account data for export (no tokens)
@@ -135,6 +163,87 @@ type SubmittedShowExport struct {
 	Artists     []string  `json:"artists,omitempty"`
 }
 
+// FavoriteVenueExport contains a followed venue for export
+type FavoriteVenueExport struct {
+	VenueID     uint      `json:"venue_id"`
+	Name        string    `json:"name"`
+	City        string    `json:"city"`
+	State       string    `json:"state"`
+	FavoritedAt time.Time `json:"favorited_at"`
+}
+
+// ReportExport contains a report the user filed, from any of the report
+// tables. Admin notes and the reviewer are not exported.
+type ReportExport struct {
+	EntityType string    `json:"entity_type"`
+	EntityID   uint      `json:"entity_id"`
+	ReportType string    `json:"report_type"`
+	Details    *string   `json:"details,omitempty"`
+	Status     string    `json:"status"`
+	ReportedAt time.Time `json:"reported_at"`
+}
+
+// AuditEntryExport contains an audit log entry about the user's account.
+// Actor is "self", "admin" or "system"; admin identities are not exported.
+type AuditEntryExport struct {
+	Action    string          `json:"action"`
+	Actor     string          `json:"actor"`
+	Metadata  json.RawMessage `json:"metadata,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// NotificationExport contains one notification sent to the user
+type NotificationExport struct {
+	NotificationType string    `json:"notification_type"`
+	EntityType       string    `json:"entity_type"`
+	EntityID         uint      `json:"entity_id"`
+	Channel          string    `json:"channel"`
+	SentAt           time.Time `json:"sent_at"`
+}
+
+// ──────────────────────────────────────────────
+// Deletion receipts
+// ──────────────────────────────────────────────
+
+// DeletionReceiptVersion is the version of the DeletionReceipt payload
+const DeletionReceiptVersion = "1"
+
+// DeletionReceipt is the signed record written when an account is
+// permanently deleted. It answers "did you delete my data, and when"
+// without keeping the data: the email is stored only as a SHA-256 hash, the
+// rest is counts, and the free-text deletion reason is left out.
+type DeletionReceipt struct {
+	ReceiptVersion      string           `json:"receipt_version"`
+	UserID              uint             `json:"user_id"`
+	EmailHash           string           `json:"email_hash,omitempty"`
+	AccountCreatedAt    time.Time        `json:"account_created_at"`
+	DeletionRequestedAt *time.Time       `json:"deletion_requested_at,omitempty"`
+	PurgedAt            time.Time        `json:"purged_at"`
+	Erased              map[string]int64 `json:"erased"`
+	Anonymized          map[string]int64 `json:"anonymized"`
+}
+
+// DeletionReceiptRecord is a stored receipt as shown to admins. Payload is
+// the exact signed JSON; Verified reports whether Signature still checks out
+// against the current signing key.
+type DeletionReceiptRecord struct {
+	ID        uint            `json:"id"`
+	UserID    uint            `json:"user_id"`
+	EmailHash string          `json:"email_hash,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	Signature string          `json:"signature"`
+	KeyID     string          `json:"key_id"`
+	Verified  bool            `json:"verified"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// DeletionReceiptServiceInterface exposes the deletion receipt log to the
+// admin console.
+type DeletionReceiptServiceInterface interface {
+	ListDeletionReceipts(limit, offset int, email string) ([]*DeletionReceiptRecord, int64, error)
+	GetDeletionReceipt(id uint) (*DeletionReceiptRecord, error)
+}
+
 // ──────────────────────────────────────────────
 // Contributor Profile types
 // ──────────────────────────────────────────────
@@ -156,6 +265,8 @@ type PrivacySettings struct {
 	Collections     PrivacyLevel `json:"collections"`
 	LastActive      PrivacyLevel `json:"last_active"`
 	ProfileSections PrivacyLevel `json:"profile_sections"`
+	SubmittedShows  PrivacyLevel `json:"submitted_shows"`
+	RSVPs           PrivacyLevel `json:"rsvps"`
 }
 
 // DefaultPrivacySettings returns the default privacy configuration.
@@ -164,7 +275,8 @@ type PrivacySettings struct {
 // content-first profile leads with what a user follows, so the default exposes
 // it. SavedShows stays hidden — a saved show is a private watchlist entry, not
 // an identity surface. Only the per-show save COUNT is public, and that is an
-// aggregate that never names who saved.
+// aggregate that never names who saved. RSVPs are hidden too: listing who is
+// going puts a user at a place and time, so it is opt-in.
 func DefaultPrivacySettings() PrivacySettings {
 	return PrivacySettings{
 		Contributions:   PrivacyVisible,
@@ -173,6 +285,8 @@ func DefaultPrivacySettings() PrivacySettings {
 		Collections:     PrivacyVisible,
 		LastActive:      PrivacyVisible,
 		ProfileSections: PrivacyVisible,
+		SubmittedShows:  PrivacyVisible,
+		RSVPs:           PrivacyHidden,
 	}
 }
 
@@ -235,6 +349,19 @@ type PublicProfileResponse struct {
 	Sections          []*ProfileSectionResponse `json:"sections,omitempty"`
 }
 
+// PublicSubmittedShow is an approved show listed on its submitter's public
+// profile. Only public show fields; nothing about the submission itself.
+type PublicSubmittedShow struct {
+	ID          uint      `json:"id"`
+	Slug        string    `json:"slug"`
+	Title       string    `json:"title"`
+	EventDate   time.Time `json:"event_date"`
+	City        *string   `json:"city"`
+	State       *string   `json:"state"`
+	VenueName   *string   `json:"venue_name"`
+	IsCancelled bool      `json:"is_cancelled"`
+}
+
 // ProfileSectionResponse represents a profile section in API responses.
 type ProfileSectionResponse struct {
 	ID    uint   `json:"id"`
@@ -309,6 +436,9 @@ type ContributionEntry struct {
 // UserServiceInterface defines the contract for user operations.
 type UserServiceInterface interface {
 	ListUsers(limit, offset int, filters AdminUserFilters) ([]*AdminUserResponse, int64, error)
+	// SetUserRole grants role to userID on actorID's behalf and returns the
+	// role it replaced.
+	SetUserRole(actorID, userID uint, role authm.Role) (authm.Role, error)
 	FindOrCreateUser(gothUser goth.User, provider string) (*authm.User, error)
 	FindOrCreateUserWithConsent(gothUser goth.User, provider string, consent *OAuthSignupConsent) (*authm.User, error)
 	AuthenticateUserWithPassword(email, password string) (*authm.User, error)
@@ -340,6 +470,7 @@ type UserServiceInterface interface {
 	PermanentlyDeleteUser(userID uint) error
 	CanUnlinkOAuthAccount(userID uint, provider string) (bool, string, error)
 	UnlinkOAuthAccount(userID uint, provider string) error
+	LinkOAuthAccountToUser(userID uint, gothUser goth.User, provider string) (*authm.User, error)
 	GetFavoriteCities(userID uint) ([]authm.FavoriteCity, error)
 	SetFavoriteCities(userID uint, cities []authm.FavoriteCity) error
 	// PSY-1423: persist /charts window + scene defaults (nil clears).
@@ -377,6 +508,7 @@ type ContributorProfileServiceInterface interface {
 	DeleteSection(userID uint, sectionID uint) error
 	GetActivityHeatmap(userID uint) (*ActivityHeatmapResponse, error)
 	GetPercentileRankings(userID uint) (*PercentileRankings, error)
+	GetSubmittedShows(userID uint, limit, offset int) ([]*PublicSubmittedShow, int64, error)
 }
 
 // ──────────────────────────────────────────────
@@ -0,0 +1,72 @@
+package contracts
+
+import "time"
+
+// ──────────────────────────────────────────────
+// Venue Claim Service Interface
+// ──────────────────────────────────────────────
+
+// VenueClaimServiceInterface defines the contract for venue ownership. Venue
+// staff submit a claim with evidence; an admin approves or denies it, and an
+// approved claim makes the user a manager of the venue. Managers may edit the
+// venue's details and flag its shows sold out or cancelled.
+type VenueClaimServiceInterface interface {
+	// SubmitClaim files a pending claim. Fails when the user already has a
+	// pending claim on the venue or already manages it.
+	SubmitClaim(venueID, userID uint, evidence string) (*VenueClaimResponse, error)
+	// GetUserClaims returns the user's own claims, newest first.
+	GetUserClaims(userID uint) ([]*VenueClaimResponse, error)
+	// ListClaims returns claims for the admin review queue, oldest first. An
+	// empty status lists every claim.
+	ListClaims(status string, limit, offset int) ([]*VenueClaimResponse, int64, error)
+	// ApproveClaim marks the claim approved and grants the claimant manager
+	// rights on the venue.
+	ApproveClaim(claimID, reviewerID uint, note string) (*VenueClaimResponse, error)
+	// DenyClaim marks the claim denied. A reason is required.
+	DenyClaim(claimID, reviewerID uint, reason string) (*VenueClaimResponse, error)
+
+	// IsVenueManager reports whether the user manages the venue.
+	IsVenueManager(userID, venueID uint) (bool, error)
+	// IsShowVenueManager reports whether the user manages any venue the show
+	// is at.
+	IsShowVenueManager(userID, showID uint) (bool, error)
+	// GetManagedVenues returns the venues the user manages.
+	GetManagedVenues(userID uint) ([]*VenueManagerResponse, error)
+	// ListVenueManagers returns the managers of a venue.
+	ListVenueManagers(venueID uint) ([]*VenueManagerResponse, error)
+	// RemoveVenueManager revokes a user's manager rights on a venue.
+	RemoveVenueManager(venueID, userID uint) error
+}
+
+// MaxVenueClaimEvidenceLength caps the evidence note, in characters.
+const MaxVenueClaimEvidenceLength = 2000
+
+// VenueClaimResponse is a venue claim with its venue and reviewer.
+type VenueClaimResponse struct {
+	ID           uint       `json:"id"`
+	VenueID      uint       `json:"venue_id"`
+	VenueName    string     `json:"venue_name"`
+	VenueSlug    string     `json:"venue_slug"`
+	UserID       uint       `json:"user_id"`
+	UserName     string     `json:"user_name"`
+	Evidence     string     `json:"evidence"`
+	Status       string     `json:"status"`
+	ReviewedBy   *uint      `json:"reviewed_by,omitempty"`
+	ReviewerName string     `json:"reviewer_name,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNote   *string    `json:"review_note,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// VenueManagerResponse is one user's manager grant on one venue.
+type VenueManagerResponse struct {
+	VenueID   uint      `json:"venue_id"`
+	VenueName string    `json:"venue_name"`
+	VenueSlug string    `json:"venue_slug"`
+	UserID    uint      `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	ClaimID   *uint     `json:"claim_id,omitempty"`
+	GrantedBy *uint     `json:"granted_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
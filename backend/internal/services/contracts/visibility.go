@@ -0,0 +1,69 @@
+package contracts
+
+// ──────────────────────────────────────────────
+// Visibility Debug Service Interface
+// ──────────────────────────────────────────────
+
+// VisibilityDebugServiceInterface defines the contract for the admin
+// "why can't this user see show X?" debugger. It replays the same rules the
+// public read paths apply and reports each one, so support can answer the
+// question without reproducing the user's session.
+type VisibilityDebugServiceInterface interface {
+	// ExplainShowVisibility evaluates every visibility rule for showID as seen
+	// by userID. A nil userID evaluates the anonymous (logged-out) view.
+	ExplainShowVisibility(userID *uint, showID uint) (*VisibilityExplanation, error)
+}
+
+// Visibility rule ids (stable; the admin UI keys its copy off these).
+const (
+	VisibilityRuleAccount        = "account"
+	VisibilityRuleDeleted        = "deleted"
+	VisibilityRuleStatus         = "status"
+	VisibilityRulePrivacy        = "privacy"
+	VisibilityRuleUpcomingWindow = "upcoming_window"
+	VisibilityRuleMarket         = "market"
+	VisibilityRuleAge            = "age"
+	VisibilityRuleDuplicate      = "duplicate"
+	VisibilityRuleCancelled      = "cancelled"
+)
+
+// Visibility step outcomes.
+//   - pass: the rule does not hide the show.
+//   - hide: the rule hides the show on the step's surface.
+//   - warn: the show stays visible, but the rule explains something the user
+//     may perceive as "missing" (advisory badges, client-side filters).
+const (
+	VisibilityOutcomePass = "pass"
+	VisibilityOutcomeHide = "hide"
+	VisibilityOutcomeWarn = "warn"
+)
+
+// Visibility surfaces. Detail is GET /shows/{id}; list is the upcoming feed
+// (GET /shows/upcoming). A detail-scoped hide also hides the list.
+const (
+	VisibilitySurfaceDetail = "detail"
+	VisibilitySurfaceList   = "list"
+)
+
+// VisibilityStep is one evaluated rule in the visibility pipeline, in the
+// order the public read path applies it.
+type VisibilityStep struct {
+	Rule        string `json:"rule" doc:"Stable rule id"`
+	Outcome     string `json:"outcome" enum:"pass,hide,warn" doc:"Effect of the rule on this viewer"`
+	Surface     string `json:"surface" enum:"detail,list" doc:"Surface the rule applies to"`
+	Explanation string `json:"explanation" doc:"Human-readable reason for the outcome"`
+}
+
+// VisibilityExplanation is the full step-by-step report for one
+// (viewer, show) pair. HiddenBy names the first rule that hides the show on
+// the upcoming list (nil when the show is visible everywhere).
+type VisibilityExplanation struct {
+	ShowID            uint             `json:"show_id"`
+	ShowStatus        string           `json:"show_status"`
+	UserID            *uint            `json:"user_id,omitempty"`
+	ViewerRole        string           `json:"viewer_role" enum:"anonymous,user,submitter,admin" doc:"Effective role after account checks"`
+	VisibleOnDetail   bool             `json:"visible_on_detail"`
+	VisibleInUpcoming bool             `json:"visible_in_upcoming"`
+	HiddenBy          *string          `json:"hidden_by,omitempty"`
+	Steps             []VisibilityStep `json:"steps"`
+}
@@ -28,7 +28,7 @@ func NewBookmarkService(database *gorm.DB) *BookmarkService {
 
 // CreateBookmark creates a bookmark for a user on an entity.
 // Idempotent: if the bookmark already exists, this is a no-op (the existing
-// row, including its created_at and any reminder_sent_at, is left untouched).
+// row, including its created_at, is left untouched).
 func (s *BookmarkService) CreateBookmark(userID uint, entityType engagementm.BookmarkEntityType, entityID uint, action engagementm.BookmarkAction) error {
 	if s.db == nil {
 		return fmt.Errorf("database not initialized")
@@ -46,8 +46,7 @@ func (s *BookmarkService) CreateBookmark(userID uint, entityType engagementm.Boo
 	// FirstOrCreate (SELECT-then-INSERT) lets two simultaneous saves both miss
 	// the row and both INSERT, tripping the unique violation on
 	// user_bookmarks(user_id, entity_type, entity_id, action). DO NOTHING
-	// deliberately preserves the existing row's created_at and reminder_sent_at
-	// so a re-save never resets a reminder's already-sent state.
+	// deliberately preserves the existing row's created_at.
 	err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&bookmark).Error
 	if err != nil {
 		return fmt.Errorf("failed to create bookmark: %w", err)
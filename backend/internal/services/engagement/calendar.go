@@ -305,8 +305,8 @@ func (s *CalendarService) GenerateICSFeed(userID uint, frontendURL string) ([]by
 			descParts = append(descParts, "Artists: "+strings.Join(names, ", "))
 		}
 
-		if show.Price != nil {
-			descParts = append(descParts, fmt.Sprintf("Price: $%.0f", *show.Price))
+		if price := utils.FormatPrice(show.PriceMin, show.PriceMax, show.PriceCurrency, show.IsFree); price != "" {
+			descParts = append(descParts, "Price: "+price)
 		}
 		if show.AgeRequirement != nil && *show.AgeRequirement != "" {
 			descParts = append(descParts, "Ages: "+*show.AgeRequirement)
@@ -0,0 +1,521 @@
+package engagement
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	ics "github.com/arran4/golang-ical"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	// MaxCalendarImportBytes caps the decoded ICS upload. The base64 request
+	// body carrying it must also fit Huma's default 1 MiB body limit.
+	MaxCalendarImportBytes = 512 * 1024
+
+	// MaxCalendarImportConfirm caps the show IDs in one confirm request.
+	MaxCalendarImportConfirm = 500
+
+	// maxCalendarImportEvents bounds the past events matched per upload; the
+	// most recent are kept.
+	maxCalendarImportEvents = 2000
+
+	// calendarImportMaxCandidates is how many shows are offered per event.
+	calendarImportMaxCandidates = 3
+
+	// calendarImportWindow is how far a show's start may be from the event's.
+	// Wide because all-day and floating-time events are read as UTC while the
+	// show is stored in UTC at a venue up to a day's offset away.
+	calendarImportWindow = 18 * time.Hour
+
+	// calendarImportTextCap bounds the event text searched for names, so a
+	// pasted ticket receipt in DESCRIPTION can't blow up matching time.
+	calendarImportTextCap = 4000
+
+	// minCalendarMatchNameLen skips very short artist/venue names, which
+	// would match incidental words.
+	minCalendarMatchNameLen = 3
+
+	// calendarImportQueryChunk bounds date ranges / IDs per query.
+	calendarImportQueryChunk = 500
+)
+
+// CalendarImportService matches a user's calendar export against past shows
+// and records confirmed matches as saved shows.
+type CalendarImportService struct {
+	db       *gorm.DB
+	bookmark *BookmarkService
+	now      func() time.Time
+}
+
+// NewCalendarImportService creates a new calendar import service
+func NewCalendarImportService(database *gorm.DB) *CalendarImportService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &CalendarImportService{
+		db:       database,
+		bookmark: NewBookmarkService(database),
+		now:      time.Now,
+	}
+}
+
+// calendarEvent is a past VEVENT reduced to what matching needs.
+type calendarEvent struct {
+	uid      string
+	summary  string
+	location string
+	start    time.Time
+	// text is the folded summary, location and description.
+	text string
+}
+
+// importShow is a candidate show with its folded venue and artist names.
+type importShow struct {
+	id        uint
+	slug      string
+	title     string
+	eventDate time.Time
+	venueName string
+	venues    []string
+	artists   []importArtist
+}
+
+type importArtist struct {
+	name   string
+	folded string
+}
+
+// PreviewCalendarImport parses an ICS file and returns, for each past event
+// that resembles a show, up to three candidate shows. Nothing is written.
+func (s *CalendarImportService) PreviewCalendarImport(userID uint, data []byte) (*contracts.CalendarImportPreview, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	if len(data) > MaxCalendarImportBytes {
+		return nil, apperrors.ErrCalendarImportTooLarge(MaxCalendarImportBytes)
+	}
+
+	now := s.now().UTC()
+	events, total, skipped, err := parseCalendarEvents(data, now)
+	if err != nil {
+		return nil, apperrors.ErrCalendarImportInvalid(err)
+	}
+	preview := &contracts.CalendarImportPreview{
+		EventCount:   total,
+		SkippedCount: skipped,
+		Events:       []contracts.CalendarImportEvent{},
+	}
+	if len(events) == 0 {
+		return preview, nil
+	}
+
+	shows, err := s.loadCandidateShows(events, now)
+	if err != nil {
+		return nil, err
+	}
+	showIDs := make([]uint, 0, len(shows))
+	for _, sh := range shows {
+		showIDs = append(showIDs, sh.id)
+	}
+	saved, err := s.bookmark.GetBookmarkedEntityIDs(userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave, showIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load saved shows: %w", err)
+	}
+
+	preview.Events = matchCalendarEvents(events, shows, saved)
+	preview.MatchedCount = len(preview.Events)
+	return preview, nil
+}
+
+// ConfirmCalendarImport saves the given shows for the user. Only approved
+// shows that have already happened are accepted; anything else is reported
+// in Skipped. Re-confirming is harmless: existing saves are counted, not
+// duplicated.
+func (s *CalendarImportService) ConfirmCalendarImport(userID uint, showIDs []uint) (*contracts.CalendarImportResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	ids := dedupeIDs(showIDs)
+	if len(ids) > MaxCalendarImportConfirm {
+		return nil, apperrors.ErrCalendarImportTooManyShows(MaxCalendarImportConfirm)
+	}
+	result := &contracts.CalendarImportResult{Skipped: []uint{}}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	var valid []uint
+	err := s.db.Model(&catalogm.Show{}).
+		Where("id IN ? AND status = ? AND deleted_at IS NULL AND event_date < ?", ids, catalogm.ShowStatusApproved, s.now().UTC()).
+		Pluck("id", &valid).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify shows: %w", err)
+	}
+	validSet := make(map[uint]bool, len(valid))
+	for _, id := range valid {
+		validSet[id] = true
+	}
+	for _, id := range ids {
+		if !validSet[id] {
+			result.Skipped = append(result.Skipped, id)
+		}
+	}
+	if len(valid) == 0 {
+		return result, nil
+	}
+
+	createdAt := time.Now().UTC()
+	rows := make([]engagementm.UserBookmark, 0, len(valid))
+	for _, id := range valid {
+		rows = append(rows, engagementm.UserBookmark{
+			UserID:     userID,
+			EntityType: engagementm.BookmarkEntityShow,
+			EntityID:   id,
+			Action:     engagementm.BookmarkActionSave,
+			CreatedAt:  createdAt,
+		})
+	}
+	// ON CONFLICT DO NOTHING, as in CreateBookmark: an existing save keeps
+	// its created_at, and RowsAffected counts only the new ones.
+	res := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows)
+	if res.Error != nil {
+		return nil, fmt.Errorf("failed to save shows: %w", res.Error)
+	}
+	result.Saved = int(res.RowsAffected)
+	result.AlreadySaved = len(valid) - result.Saved
+	return result, nil
+}
+
+// parseCalendarEvents returns the file's past, non-cancelled events (most
+// recent maxCalendarImportEvents), the total event count, and how many
+// events were skipped.
+func parseCalendarEvents(data []byte, now time.Time) ([]calendarEvent, int, int, error) {
+	cal, err := ics.ParseCalendar(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	vevents := cal.Events()
+	events := make([]calendarEvent, 0, len(vevents))
+	skipped := 0
+	for _, ev := range vevents {
+		if strings.EqualFold(icsText(ev, ics.ComponentPropertyStatus), "CANCELLED") {
+			skipped++
+			continue
+		}
+		start, err := ev.GetStartAt()
+		if err != nil || !start.Before(now) {
+			skipped++
+			continue
+		}
+		summary := icsText(ev, ics.ComponentPropertySummary)
+		location := icsText(ev, ics.ComponentPropertyLocation)
+		text := summary + " " + location + " " + icsText(ev, ics.ComponentPropertyDescription)
+		if len(text) > calendarImportTextCap {
+			text = text[:calendarImportTextCap]
+		}
+		events = append(events, calendarEvent{
+			uid:      icsText(ev, ics.ComponentPropertyUniqueId),
+			summary:  summary,
+			location: location,
+			start:    start.UTC(),
+			text:     foldMatchText(text),
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].start.After(events[j].start) })
+	if len(events) > maxCalendarImportEvents {
+		skipped += len(events) - maxCalendarImportEvents
+		events = events[:maxCalendarImportEvents]
+	}
+	return events, len(vevents), skipped, nil
+}
+
+// icsText returns a property's unescaped TEXT value ("" when absent).
+func icsText(ev *ics.VEvent, prop ics.ComponentProperty) string {
+	if p := ev.GetProperty(prop); p != nil {
+		return strings.TrimSpace(ics.FromText(p.Value))
+	}
+	return ""
+}
+
+// loadCandidateShows loads approved past shows within calendarImportWindow of
+// any event, with their venue and artist names.
+func (s *CalendarImportService) loadCandidateShows(events []calendarEvent, now time.Time) ([]*importShow, error) {
+	// Merge the per-event windows into disjoint ranges (events are sorted
+	// newest first) so the show query stays index-friendly.
+	type timeRange struct{ from, to time.Time }
+	var ranges []timeRange
+	for i := len(events) - 1; i >= 0; i-- {
+		from, to := events[i].start.Add(-calendarImportWindow), events[i].start.Add(calendarImportWindow)
+		if n := len(ranges); n > 0 && !from.After(ranges[n-1].to) {
+			ranges[n-1].to = to
+			continue
+		}
+		ranges = append(ranges, timeRange{from, to})
+	}
+
+	type showRow struct {
+		ID        uint
+		Slug      *string
+		Title     string
+		EventDate time.Time
+	}
+	var rows []showRow
+	for start := 0; start < len(ranges); start += calendarImportQueryChunk {
+		end := min(start+calendarImportQueryChunk, len(ranges))
+		conditions := s.db
+		for i, r := range ranges[start:end] {
+			if i == 0 {
+				conditions = conditions.Where("event_date BETWEEN ? AND ?", r.from, r.to)
+			} else {
+				conditions = conditions.Or("event_date BETWEEN ? AND ?", r.from, r.to)
+			}
+		}
+		var chunk []showRow
+		err := s.db.Model(&catalogm.Show{}).
+			Select("id, slug, title, event_date").
+			Where("status = ? AND deleted_at IS NULL AND event_date < ?", catalogm.ShowStatusApproved, now).
+			Where(conditions).
+			Scan(&chunk).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load shows: %w", err)
+		}
+		rows = append(rows, chunk...)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	shows := make([]*importShow, 0, len(rows))
+	byID := make(map[uint]*importShow, len(rows))
+	ids := make([]uint, 0, len(rows))
+	for _, r := range rows {
+		sh := &importShow{id: r.ID, title: r.Title, eventDate: r.EventDate.UTC()}
+		if r.Slug != nil {
+			sh.slug = *r.Slug
+		}
+		shows = append(shows, sh)
+		byID[r.ID] = sh
+		ids = append(ids, r.ID)
+	}
+
+	type nameRow struct {
+		ShowID uint
+		Name   string
+	}
+	for start := 0; start < len(ids); start += calendarImportQueryChunk {
+		chunk := ids[start:min(start+calendarImportQueryChunk, len(ids))]
+
+		var venues []nameRow
+		err := s.db.Table("show_venues sv").
+			Select("sv.show_id, v.name").
+			Joins("JOIN venues v ON v.id = sv.venue_id").
+			Where("sv.show_id IN ?", chunk).
+			Order("sv.show_id, v.id").
+			Scan(&venues).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load show venues: %w", err)
+		}
+		for _, v := range venues {
+			sh := byID[v.ShowID]
+			if sh.venueName == "" {
+				sh.venueName = v.Name
+			}
+			sh.venues = append(sh.venues, foldMatchText(v.Name))
+		}
+
+		var artists []nameRow
+		err = s.db.Table("show_artists sa").
+			Select("sa.show_id, a.name").
+			Joins("JOIN artists a ON a.id = sa.artist_id").
+			Where("sa.show_id IN ?", chunk).
+			Order("sa.show_id, sa.position").
+			Scan(&artists).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load show artists: %w", err)
+		}
+		for _, a := range artists {
+			sh := byID[a.ShowID]
+			sh.artists = append(sh.artists, importArtist{name: a.Name, folded: foldMatchText(a.Name)})
+		}
+	}
+	return shows, nil
+}
+
+// scoredCandidate is a candidate with its sort keys.
+type scoredCandidate struct {
+	candidate contracts.CalendarImportCandidate
+	rank      int
+	distance  time.Duration
+}
+
+// matchCalendarEvents pairs each event with the shows near it in time whose
+// artists or venue appear in the event text. Events with no candidate are
+// dropped. Output keeps the events' order.
+func matchCalendarEvents(events []calendarEvent, shows []*importShow, saved map[uint]bool) []contracts.CalendarImportEvent {
+	sorted := append([]*importShow(nil), shows...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].eventDate.Before(sorted[j].eventDate) })
+
+	out := []contracts.CalendarImportEvent{}
+	for _, ev := range events {
+		from, to := ev.start.Add(-calendarImportWindow), ev.start.Add(calendarImportWindow)
+		first := sort.Search(len(sorted), func(i int) bool { return !sorted[i].eventDate.Before(from) })
+
+		var scored []scoredCandidate
+		for _, sh := range sorted[first:] {
+			if sh.eventDate.After(to) {
+				break
+			}
+			if c, ok := scoreCalendarMatch(ev, sh); ok {
+				c.candidate.AlreadySaved = saved[sh.id]
+				scored = append(scored, c)
+			}
+		}
+		if len(scored) == 0 {
+			continue
+		}
+		sort.Slice(scored, func(i, j int) bool {
+			if scored[i].rank != scored[j].rank {
+				return scored[i].rank > scored[j].rank
+			}
+			if scored[i].distance != scored[j].distance {
+				return scored[i].distance < scored[j].distance
+			}
+			return scored[i].candidate.ShowID < scored[j].candidate.ShowID
+		})
+		if len(scored) > calendarImportMaxCandidates {
+			scored = scored[:calendarImportMaxCandidates]
+		}
+
+		event := contracts.CalendarImportEvent{
+			UID:        ev.uid,
+			Summary:    ev.summary,
+			Location:   ev.location,
+			StartsAt:   ev.start,
+			Candidates: make([]contracts.CalendarImportCandidate, 0, len(scored)),
+		}
+		for _, c := range scored {
+			event.Candidates = append(event.Candidates, c.candidate)
+		}
+		out = append(out, event)
+	}
+	return out
+}
+
+// scoreCalendarMatch reports whether sh is a plausible match for ev: at least
+// one billed artist or the venue must appear in the event text.
+func scoreCalendarMatch(ev calendarEvent, sh *importShow) (scoredCandidate, bool) {
+	matched := []string{}
+	for _, a := range sh.artists {
+		if containsPhrase(ev.text, a.folded) {
+			matched = append(matched, a.name)
+		}
+	}
+	venueMatched := false
+	for _, v := range sh.venues {
+		if containsPhrase(ev.text, v) || containsPhrase(ev.text, strings.TrimPrefix(v, "the ")) {
+			venueMatched = true
+			break
+		}
+	}
+
+	var confidence string
+	var rank int
+	switch {
+	case len(matched) > 0 && venueMatched:
+		confidence, rank = contracts.CalendarMatchHigh, 3
+	case len(matched) > 0:
+		confidence, rank = contracts.CalendarMatchMedium, 2
+	case venueMatched:
+		confidence, rank = contracts.CalendarMatchLow, 1
+	default:
+		return scoredCandidate{}, false
+	}
+
+	distance := sh.eventDate.Sub(ev.start)
+	if distance < 0 {
+		distance = -distance
+	}
+	return scoredCandidate{
+		candidate: contracts.CalendarImportCandidate{
+			ShowID:         sh.id,
+			Slug:           sh.slug,
+			Title:          sh.title,
+			EventDate:      sh.eventDate,
+			VenueName:      sh.venueName,
+			MatchedArtists: matched,
+			MatchedVenue:   venueMatched,
+			Confidence:     confidence,
+		},
+		rank:     rank,
+		distance: distance,
+	}, true
+}
+
+// containsPhrase reports whether the folded phrase occurs in the folded text
+// on word boundaries ("the who" matches "saw the who live", not "thewho").
+func containsPhrase(text, phrase string) bool {
+	if len(phrase) < minCalendarMatchNameLen {
+		return false
+	}
+	return strings.Contains(" "+text+" ", " "+phrase+" ")
+}
+
+// foldMatchText lowercases, strips diacritics, and collapses every run of
+// non-alphanumeric characters to one space, so "Crescent Ballroom — Phoenix"
+// and "crescent ballroom, phoenix" compare equal.
+func foldMatchText(s string) string {
+	// Per-call chain: a transform.Chain keeps per-call state and is not
+	// safe to share across goroutines.
+	normalizer := transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	if folded, _, err := transform.String(normalizer, s); err == nil {
+		s = folded
+	}
+	s = strings.ToLower(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	space := true
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// dedupeIDs drops zero and repeated IDs, preserving order.
+func dedupeIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	out := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
@@ -0,0 +1,299 @@
+package engagement
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// buildICS wraps VEVENT bodies in a minimal VCALENDAR.
+func buildICS(events ...string) []byte {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//test//EN\r\n")
+	for _, ev := range events {
+		b.WriteString("BEGIN:VEVENT\r\n")
+		b.WriteString(strings.ReplaceAll(strings.TrimSpace(ev), "\n", "\r\n"))
+		b.WriteString("\r\nEND:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return []byte(b.String())
+}
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestParseCalendarEvents(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	data := buildICS(
+		`UID:past-1
+DTSTART:20260510T030000Z
+SUMMARY:Bad Bad Hats @ Crescent Ballroom
+LOCATION:Crescent Ballroom\, 308 N 2nd Ave\, Phoenix`,
+		`UID:all-day
+DTSTART;VALUE=DATE:20260301
+SUMMARY:Some festival`,
+		`UID:future
+DTSTART:20260701T030000Z
+SUMMARY:Upcoming show`,
+		`UID:cancelled
+DTSTART:20260401T030000Z
+STATUS:CANCELLED
+SUMMARY:Cancelled show`,
+		`UID:undated
+SUMMARY:No start`,
+	)
+
+	events, total, skipped, err := parseCalendarEvents(data, now)
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, 3, skipped, "future, cancelled and undated events are skipped")
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "past-1", events[0].uid, "most recent first")
+	assert.Equal(t, "Crescent Ballroom, 308 N 2nd Ave, Phoenix", events[0].location, "TEXT escapes are removed")
+	assert.Equal(t, time.Date(2026, 5, 10, 3, 0, 0, 0, time.UTC), events[0].start)
+	assert.Equal(t, "bad bad hats crescent ballroom crescent ballroom 308 n 2nd ave phoenix", events[0].text)
+	assert.Equal(t, "all-day", events[1].uid)
+}
+
+func TestParseCalendarEvents_Invalid(t *testing.T) {
+	_, _, _, err := parseCalendarEvents([]byte("not a calendar"), time.Now())
+	assert.Error(t, err)
+}
+
+func TestFoldMatchText(t *testing.T) {
+	assert.Equal(t, "beyonce the rodeo", foldMatchText("  Beyoncé — The Rodeo!! "))
+	assert.Equal(t, "ac dc", foldMatchText("AC/DC"))
+	assert.Equal(t, "", foldMatchText("—"))
+}
+
+func TestContainsPhrase(t *testing.T) {
+	assert.True(t, containsPhrase("saw the who live", "the who"))
+	assert.False(t, containsPhrase("saw thewho live", "the who"))
+	assert.False(t, containsPhrase("saw the whole show", "the who"), "word boundaries only")
+	assert.False(t, containsPhrase("x marks the spot", "x"), "names below the minimum length never match")
+}
+
+func TestMatchCalendarEvents(t *testing.T) {
+	start := time.Date(2026, 5, 10, 3, 0, 0, 0, time.UTC)
+	event := calendarEvent{
+		uid:     "e1",
+		summary: "Bad Bad Hats",
+		start:   start,
+		text:    foldMatchText("Bad Bad Hats at Crescent Ballroom"),
+	}
+	show := func(id uint, offset time.Duration, venue string, artists ...string) *importShow {
+		sh := &importShow{id: id, title: fmt.Sprintf("show %d", id), eventDate: start.Add(offset), venueName: venue, venues: []string{foldMatchText(venue)}}
+		for _, a := range artists {
+			sh.artists = append(sh.artists, importArtist{name: a, folded: foldMatchText(a)})
+		}
+		return sh
+	}
+	shows := []*importShow{
+		show(1, time.Hour, "Valley Bar", "Bad Bad Hats"),                    // artist only
+		show(2, 2*time.Hour, "Crescent Ballroom", "Bad Bad Hats", "Opener"), // artist + venue
+		show(3, 0, "Crescent Ballroom", "Someone Else"),                     // venue only
+		show(4, 0, "Rebel Lounge", "Nobody"),                                // no text match
+		show(5, 20*time.Hour, "Crescent Ballroom", "Bad Bad Hats"),          // outside the window
+	}
+
+	out := matchCalendarEvents([]calendarEvent{event}, shows, map[uint]bool{2: true})
+	require.Len(t, out, 1)
+	cands := out[0].Candidates
+	require.Len(t, cands, 3)
+
+	assert.Equal(t, uint(2), cands[0].ShowID)
+	assert.Equal(t, contracts.CalendarMatchHigh, cands[0].Confidence)
+	assert.Equal(t, []string{"Bad Bad Hats"}, cands[0].MatchedArtists)
+	assert.True(t, cands[0].MatchedVenue)
+	assert.True(t, cands[0].AlreadySaved)
+
+	assert.Equal(t, uint(1), cands[1].ShowID)
+	assert.Equal(t, contracts.CalendarMatchMedium, cands[1].Confidence)
+	assert.False(t, cands[1].AlreadySaved)
+
+	assert.Equal(t, uint(3), cands[2].ShowID)
+	assert.Equal(t, contracts.CalendarMatchLow, cands[2].Confidence)
+	assert.Empty(t, cands[2].MatchedArtists)
+}
+
+func TestMatchCalendarEvents_DropsEventsWithoutCandidates(t *testing.T) {
+	event := calendarEvent{uid: "dentist", start: time.Now(), text: "dentist appointment"}
+	out := matchCalendarEvents([]calendarEvent{event}, nil, nil)
+	assert.NotNil(t, out)
+	assert.Empty(t, out)
+}
+
+func TestMatchCalendarEvents_VenueWithoutLeadingThe(t *testing.T) {
+	start := time.Now().UTC()
+	event := calendarEvent{start: start, text: foldMatchText("Rebel Lounge, Phoenix")}
+	sh := &importShow{id: 1, eventDate: start, venues: []string{foldMatchText("The Rebel Lounge")}}
+
+	out := matchCalendarEvents([]calendarEvent{event}, []*importShow{sh}, nil)
+	require.Len(t, out, 1)
+	assert.True(t, out[0].Candidates[0].MatchedVenue)
+}
+
+func TestDedupeIDs(t *testing.T) {
+	assert.Equal(t, []uint{3, 1, 2}, dedupeIDs([]uint{3, 1, 0, 3, 2, 1}))
+	assert.Empty(t, dedupeIDs(nil))
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type CalendarImportServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB  *testutil.TestDatabase
+	db      *gorm.DB
+	service *CalendarImportService
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.service = NewCalendarImportService(suite.testDB.DB)
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
+	_, _ = sqlDB.Exec("DELETE FROM show_artists")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM artists")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestCalendarImportServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(CalendarImportServiceIntegrationTestSuite))
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) createTestUser() *authm.User {
+	user := &authm.User{
+		Email:         stringPtr(fmt.Sprintf("user-%d@test.com", time.Now().UnixNano())),
+		FirstName:     stringPtr("Test"),
+		LastName:      stringPtr("User"),
+		IsActive:      true,
+		EmailVerified: true,
+	}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	return user
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) createShow(title string, eventDate time.Time, status catalogm.ShowStatus, venueName, artistName string) *catalogm.Show {
+	venue := &catalogm.Venue{Name: venueName, City: "Phoenix", State: "AZ"}
+	suite.Require().NoError(suite.db.Create(venue).Error)
+	artist := &catalogm.Artist{Name: artistName}
+	suite.Require().NoError(suite.db.Create(artist).Error)
+
+	show := &catalogm.Show{
+		Title:     title,
+		EventDate: eventDate,
+		City:      stringPtr("Phoenix"),
+		State:     stringPtr("AZ"),
+		Status:    status,
+	}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: venue.ID}).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: show.ID, ArtistID: artist.ID}).Error)
+	return show
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) TestPreview_MatchesPastShows() {
+	user := suite.createTestUser()
+	showDate := time.Now().UTC().AddDate(0, -1, 0).Truncate(time.Hour)
+	match := suite.createShow("Calendar Match", showDate, catalogm.ShowStatusApproved, "Calendar Ballroom", "Calendar Hats")
+	suite.createShow("Wrong Night", showDate.AddDate(0, 0, -3), catalogm.ShowStatusApproved, "Calendar Ballroom", "Calendar Hats")
+	suite.createShow("Pending", showDate, catalogm.ShowStatusPending, "Calendar Ballroom", "Calendar Hats")
+
+	data := buildICS(fmt.Sprintf(`UID:ev-1
+DTSTART:%s
+SUMMARY:Calendar Hats!
+LOCATION:Calendar Ballroom`, showDate.Add(-2*time.Hour).Format("20060102T150405Z")),
+		`UID:dentist
+DTSTART:20200101T170000Z
+SUMMARY:Dentist`)
+
+	preview, err := suite.service.PreviewCalendarImport(user.ID, data)
+	suite.Require().NoError(err)
+	suite.Equal(2, preview.EventCount)
+	suite.Equal(1, preview.MatchedCount)
+	suite.Require().Len(preview.Events, 1)
+	suite.Equal("ev-1", preview.Events[0].UID)
+	suite.Require().Len(preview.Events[0].Candidates, 1, "other nights and unapproved shows are not offered")
+	c := preview.Events[0].Candidates[0]
+	suite.Equal(match.ID, c.ShowID)
+	suite.Equal(contracts.CalendarMatchHigh, c.Confidence)
+	suite.Equal("Calendar Ballroom", c.VenueName)
+	suite.False(c.AlreadySaved)
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) TestPreview_Errors() {
+	user := suite.createTestUser()
+
+	_, err := suite.service.PreviewCalendarImport(user.ID, []byte("hello"))
+	var importErr *apperrors.CalendarImportError
+	suite.Require().ErrorAs(err, &importErr)
+	suite.Equal(apperrors.CodeCalendarImportInvalid, importErr.Code)
+
+	_, err = suite.service.PreviewCalendarImport(user.ID, make([]byte, MaxCalendarImportBytes+1))
+	suite.Require().ErrorAs(err, &importErr)
+	suite.Equal(apperrors.CodeCalendarImportTooLarge, importErr.Code)
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) TestConfirm_SavesPastApprovedShows() {
+	user := suite.createTestUser()
+	past := time.Now().UTC().AddDate(0, -1, 0)
+	attended := suite.createShow("Attended", past, catalogm.ShowStatusApproved, "Confirm Venue A", "Confirm Artist A")
+	alreadySaved := suite.createShow("Already Saved", past, catalogm.ShowStatusApproved, "Confirm Venue B", "Confirm Artist B")
+	upcoming := suite.createShow("Upcoming", time.Now().UTC().AddDate(0, 1, 0), catalogm.ShowStatusApproved, "Confirm Venue C", "Confirm Artist C")
+	pending := suite.createShow("Pending", past, catalogm.ShowStatusPending, "Confirm Venue D", "Confirm Artist D")
+	suite.Require().NoError(NewBookmarkService(suite.db).CreateBookmark(user.ID, engagementm.BookmarkEntityShow, alreadySaved.ID, engagementm.BookmarkActionSave))
+
+	result, err := suite.service.ConfirmCalendarImport(user.ID, []uint{attended.ID, alreadySaved.ID, upcoming.ID, pending.ID, attended.ID, 999999})
+	suite.Require().NoError(err)
+	suite.Equal(1, result.Saved)
+	suite.Equal(1, result.AlreadySaved)
+	suite.ElementsMatch([]uint{upcoming.ID, pending.ID, 999999}, result.Skipped)
+
+	var count int64
+	suite.db.Model(&engagementm.UserBookmark{}).
+		Where("user_id = ? AND entity_type = ? AND action = ?", user.ID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave).
+		Count(&count)
+	suite.Equal(int64(2), count)
+}
+
+func (suite *CalendarImportServiceIntegrationTestSuite) TestConfirm_TooManyShows() {
+	user := suite.createTestUser()
+	ids := make([]uint, MaxCalendarImportConfirm+1)
+	for i := range ids {
+		ids[i] = uint(i + 1)
+	}
+
+	_, err := suite.service.ConfirmCalendarImport(user.ID, ids)
+	var importErr *apperrors.CalendarImportError
+	suite.Require().ErrorAs(err, &importErr)
+	suite.Equal(apperrors.CodeCalendarImportTooManyShows, importErr.Code)
+}
@@ -48,7 +48,7 @@ type CollectionDigestService struct {
 	// `List-Unsubscribe` header — the same chi route serves both manual
 	// GET (HTML confirmation page) and RFC 8058 POST one-click unsubscribe.
 	backendURL string
-	jwtSecret  string
+	keys       *config.JWTKeyRing
 }
 
 // NewCollectionDigestService creates a new collection digest service.
@@ -75,7 +75,7 @@ func NewCollectionDigestService(database *gorm.DB, emailService contracts.EmailS
 		logger:       slog.Default(),
 		frontendURL:  cfg.Email.FrontendURL,
 		backendURL:   DeriveBackendURL(cfg.Email.FrontendURL),
-		jwtSecret:    cfg.JWT.SecretKey,
+		keys:         cfg.JWT.KeyRing(),
 	}
 }
 
@@ -250,7 +250,7 @@ func (s *CollectionDigestService) runDigestCycle() {
 		// Unsubscribe URL points at the BACKEND so the same path serves
 		// both the manual-click HTML confirmation page (GET) and the
 		// RFC 8058 / RFC 2369 one-click POST. No SPA round-trip.
-		unsubURL := GenerateCollectionDigestUnsubscribeURL(s.backendURL, ub.userID, s.jwtSecret)
+		unsubURL := GenerateCollectionDigestUnsubscribeURL(s.backendURL, ub.userID, s.keys.Current().Secret)
 
 		if s.emailService != nil && s.emailService.IsConfigured() {
 			if err := s.emailService.SendCollectionDigestEmail(ub.userEmail, groups, unsubURL); err != nil {
@@ -93,7 +93,7 @@ func TestCollectionDigestService_Construction_DefaultInterval(t *testing.T) {
 	require.NotNil(t, svc)
 	assert.Equal(t, DefaultCollectionDigestInterval, svc.interval)
 	assert.Equal(t, "http://localhost:3000", svc.frontendURL)
-	assert.Equal(t, "test", svc.jwtSecret)
+	assert.Equal(t, "test", svc.keys.Current().Secret)
 }
 
 func TestCollectionDigestService_Construction_EnvOverride(t *testing.T) {
@@ -133,7 +133,7 @@ func (m *captureDigestEmailService) SendMagicLinkEmail(_, _ string) error    { r
 func (m *captureDigestEmailService) SendAccountRecoveryEmail(_, _ string, _ int) error {
 	return nil
 }
-func (m *captureDigestEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string) error {
+func (m *captureDigestEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string, _ int) error {
 	return nil
 }
 func (m *captureDigestEmailService) SendFilterNotificationEmail(_, _, _, _ string) error { return nil }
@@ -153,7 +153,10 @@ func (m *captureDigestEmailService) SendMentionNotification(_, _, _, _, _, _, _
 	return nil
 }
 
-func (m *captureDigestEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ string) error {
+func (m *captureDigestEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ []contracts.SceneDigestShow, _ string) error {
+	return nil
+}
+func (m *captureDigestEmailService) SendShowStatusChangeEmail(_ string, _ contracts.ShowStatusChange, _ string) error {
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"psychic-homily-backend/internal/config"
 	engagementm "psychic-homily-backend/internal/models/engagement"
 	notificationm "psychic-homily-backend/internal/models/notification"
 	"psychic-homily-backend/internal/services/contracts"
@@ -51,22 +52,23 @@ const (
 type CommentNotificationService struct {
 	db           *gorm.DB
 	emailService contracts.EmailServiceInterface
-	jwtSecret    string
+	keys         *config.JWTKeyRing
 	frontendURL  string
 }
 
 // NewCommentNotificationService constructs the service. All args required
-// except jwtSecret/frontendURL, which must be non-empty in production (used
-// to mint HMAC unsubscribe URLs).
+// except keys/frontendURL, which must be set in production (used to mint HMAC
+// unsubscribe URLs).
 func NewCommentNotificationService(
 	db *gorm.DB,
 	emailService contracts.EmailServiceInterface,
-	jwtSecret, frontendURL string,
+	keys *config.JWTKeyRing,
+	frontendURL string,
 ) *CommentNotificationService {
 	return &CommentNotificationService{
 		db:           db,
 		emailService: emailService,
-		jwtSecret:    jwtSecret,
+		keys:         keys,
 		frontendURL:  frontendURL,
 	}
 }
@@ -381,7 +383,7 @@ func (s *CommentNotificationService) NotifySubscribers(commentID uint) error {
 		}
 
 		unsubURL := GenerateCommentSubscriptionUnsubscribeURL(
-			s.frontendURL, r.UserID, entityType, comment.EntityID, s.jwtSecret,
+			s.frontendURL, r.UserID, entityType, comment.EntityID, s.keys.Current().Secret,
 		)
 
 		if s.emailService != nil && s.emailService.IsConfigured() {
@@ -527,7 +529,7 @@ func (s *CommentNotificationService) NotifyMentioned(commentID uint) error {
 			continue
 		}
 
-		unsubURL := GenerateMentionUnsubscribeURL(s.frontendURL, r.UserID, s.jwtSecret)
+		unsubURL := GenerateMentionUnsubscribeURL(s.frontendURL, r.UserID, s.keys.Current().Secret)
 
 		if s.emailService != nil && s.emailService.IsConfigured() {
 			if sendErr := s.emailService.SendMentionNotification(
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	"psychic-homily-backend/internal/config"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	engagementm "psychic-homily-backend/internal/models/engagement"
@@ -95,7 +96,7 @@ func TestParseMentions(t *testing.T) {
 }
 
 func TestCommentNotificationService_NilDB(t *testing.T) {
-	svc := NewCommentNotificationService(nil, nil, "secret", "http://localhost:3000")
+	svc := NewCommentNotificationService(nil, nil, config.NewJWTKeyRing("secret", nil), "http://localhost:3000")
 
 	t.Run("NotifySubscribers_NilDB", func(t *testing.T) {
 		err := svc.NotifySubscribers(1)
@@ -236,7 +237,7 @@ func (m *captureEmailService) SendMagicLinkEmail(_, _ string) error    { return
 func (m *captureEmailService) SendAccountRecoveryEmail(_, _ string, _ int) error {
 	return nil
 }
-func (m *captureEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string) error {
+func (m *captureEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string, _ int) error {
 	return nil
 }
 func (m *captureEmailService) SendFilterNotificationEmail(_, _, _, _ string) error { return nil }
@@ -249,7 +250,10 @@ func (m *captureEmailService) SendTierDemotionWarningEmail(_, _, _ string, _, _
 }
 func (m *captureEmailService) SendEditApprovedEmail(_, _, _, _, _, _ string) error { return nil }
 func (m *captureEmailService) SendEditRejectedEmail(_, _, _, _, _, _ string) error { return nil }
-func (m *captureEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ string) error {
+func (m *captureEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ []contracts.SceneDigestShow, _ string) error {
+	return nil
+}
+func (m *captureEmailService) SendShowStatusChangeEmail(_ string, _ contracts.ShowStatusChange, _ string) error {
 	return nil
 }
 
@@ -325,7 +329,7 @@ func (s *CommentNotificationServiceIntegrationSuite) TearDownSuite() {
 
 func (s *CommentNotificationServiceIntegrationSuite) SetupTest() {
 	s.mock = &captureEmailService{configured: true}
-	s.svc = NewCommentNotificationService(s.db, s.mock, "test-secret", "http://localhost:3000")
+	s.svc = NewCommentNotificationService(s.db, s.mock, config.NewJWTKeyRing("test-secret", nil), "http://localhost:3000")
 	s.comment = NewCommentService(s.db, utils.NewMarkdownRenderer())
 }
 
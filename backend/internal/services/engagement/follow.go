@@ -10,6 +10,7 @@ import (
 	"psychic-homily-backend/db"
 	apperrors "psychic-homily-backend/internal/errors"
 	"psychic-homily-backend/internal/logger"
+	catalogm "psychic-homily-backend/internal/models/catalog"
 	engagementm "psychic-homily-backend/internal/models/engagement"
 	"psychic-homily-backend/internal/services/contracts"
 )
@@ -689,3 +690,116 @@ func (s *FollowService) GetFollowers(entityType string, entityID uint, limit, of
 
 	return responses, total, nil
 }
+
+// followedArtistShowsWhere restricts a shows query (aliased s) to approved,
+// live shows with at least one artist the user follows on the bill.
+const followedArtistShowsWhere = `s.status = ? AND s.deleted_at IS NULL AND EXISTS (
+	SELECT 1 FROM show_artists sa
+	JOIN user_bookmarks b ON b.entity_id = sa.artist_id
+	WHERE sa.show_id = s.id AND b.user_id = ? AND b.entity_type = ? AND b.action = ?
+)`
+
+// followedSceneShowExpr is true for a show (aliased s) at a venue in a scene
+// the user follows: the venue's metro for a metro scene, its normalized
+// city/state for a fallback scene — the same branches as the scene-follow
+// notification fan-out.
+const followedSceneShowExpr = `EXISTS (
+	SELECT 1 FROM show_venues sv
+	JOIN venues v ON v.id = sv.venue_id
+	JOIN user_bookmarks sb ON sb.user_id = ? AND sb.entity_type = ? AND sb.action = ?
+	JOIN scenes sc ON sc.id = sb.entity_id AND (
+		(v.metro IS NOT NULL AND sc.metro = v.metro)
+		OR (sc.metro IS NULL
+			AND LOWER(TRIM(sc.city)) = LOWER(TRIM(v.city))
+			AND LOWER(TRIM(sc.state)) = LOWER(TRIM(v.state)))
+	)
+	WHERE sv.show_id = s.id
+)`
+
+// GetFollowedArtistShows lists upcoming approved shows featuring any artist
+// the user follows, soonest first.
+func (s *FollowService) GetFollowedArtistShows(userID uint, limit, offset int) ([]*contracts.FollowedArtistShow, int64, error) {
+	if s.db == nil {
+		return nil, 0, fmt.Errorf("database not initialized")
+	}
+	return s.followedArtistShows(userID, time.Now().UTC(), time.Time{}, limit, offset, false)
+}
+
+// followedArtistShows lists followed-artist shows with event_date in
+// [from, to), soonest first. A zero to leaves the window open-ended. With
+// followedScenesFirst, shows in the user's followed scenes come first and are
+// flagged InFollowedScene, so a capped list keeps the local dates.
+func (s *FollowService) followedArtistShows(userID uint, from, to time.Time, limit, offset int, followedScenesFirst bool) ([]*contracts.FollowedArtistShow, int64, error) {
+	base := func() *gorm.DB {
+		q := s.db.Table("shows s").
+			Where(followedArtistShowsWhere, catalogm.ShowStatusApproved,
+				userID, engagementm.BookmarkEntityArtist, engagementm.BookmarkActionFollow).
+			Where("s.event_date >= ?", from)
+		if !to.IsZero() {
+			q = q.Where("s.event_date < ?", to)
+		}
+		return q
+	}
+
+	var total int64
+	if err := base().Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count followed artist shows: %w", err)
+	}
+	shows := []*contracts.FollowedArtistShow{}
+	if total == 0 {
+		return shows, 0, nil
+	}
+
+	columns := `s.id, COALESCE(s.slug, '') AS slug, s.title, s.event_date, s.city, s.state, s.is_cancelled,
+			(SELECT v.name FROM show_venues sv JOIN venues v ON v.id = sv.venue_id
+				WHERE sv.show_id = s.id ORDER BY sv.venue_id LIMIT 1) AS venue_name`
+	var columnArgs []interface{}
+	order := "s.event_date ASC, s.id ASC"
+	if followedScenesFirst {
+		columns += ", " + followedSceneShowExpr + " AS in_followed_scene"
+		columnArgs = append(columnArgs, userID, engagementm.BookmarkEntityScene, engagementm.BookmarkActionFollow)
+		order = "in_followed_scene DESC, " + order
+	}
+	if err := base().
+		Select(columns, columnArgs...).
+		Order(order).
+		Limit(limit).Offset(offset).
+		Scan(&shows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get followed artist shows: %w", err)
+	}
+	if len(shows) == 0 {
+		return shows, total, nil
+	}
+
+	showIDs := make([]uint, len(shows))
+	byID := make(map[uint]*contracts.FollowedArtistShow, len(shows))
+	for i, sh := range shows {
+		showIDs[i] = sh.ID
+		sh.FollowedArtists = []contracts.FollowedArtistRef{}
+		byID[sh.ID] = sh
+	}
+
+	var artists []struct {
+		ShowID uint   `gorm:"column:show_id"`
+		ID     uint   `gorm:"column:id"`
+		Name   string `gorm:"column:name"`
+		Slug   string `gorm:"column:slug"`
+	}
+	if err := s.db.Table("show_artists sa").
+		Select("sa.show_id, a.id, a.name, COALESCE(a.slug, '') AS slug").
+		Joins("JOIN artists a ON a.id = sa.artist_id").
+		Joins("JOIN user_bookmarks b ON b.entity_id = sa.artist_id AND b.user_id = ? AND b.entity_type = ? AND b.action = ?",
+			userID, engagementm.BookmarkEntityArtist, engagementm.BookmarkActionFollow).
+		Where("sa.show_id IN ?", showIDs).
+		Order("sa.show_id, sa.position, sa.artist_id").
+		Scan(&artists).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get followed artists for shows: %w", err)
+	}
+	for _, a := range artists {
+		if sh, ok := byID[a.ShowID]; ok {
+			sh.FollowedArtists = append(sh.FollowedArtists, contracts.FollowedArtistRef{ID: a.ID, Name: a.Name, Slug: a.Slug})
+		}
+	}
+
+	return shows, total, nil
+}
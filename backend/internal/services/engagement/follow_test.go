@@ -150,6 +150,9 @@ func (suite *FollowServiceIntegrationTestSuite) TearDownTest() {
 	sqlDB, err := suite.db.DB()
 	suite.Require().NoError(err)
 	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
+	_, _ = sqlDB.Exec("DELETE FROM show_artists")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
 	_, _ = sqlDB.Exec("DELETE FROM festivals")
 	_, _ = sqlDB.Exec("DELETE FROM labels")
 	_, _ = sqlDB.Exec("DELETE FROM artists")
@@ -1272,3 +1275,49 @@ func (suite *FollowServiceIntegrationTestSuite) TestGetUserFollowing_AllTypesEnr
 		}
 	}
 }
+
+// =============================================================================
+// GetFollowedArtistShows
+// =============================================================================
+
+func (suite *FollowServiceIntegrationTestSuite) createShowWithArtists(title string, eventDate time.Time, status catalogm.ShowStatus, artistIDs ...uint) uint {
+	show := &catalogm.Show{Title: title, EventDate: eventDate, Status: status}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	for i, id := range artistIDs {
+		suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: show.ID, ArtistID: id, Position: i}).Error)
+	}
+	return show.ID
+}
+
+func (suite *FollowServiceIntegrationTestSuite) TestGetFollowedArtistShows() {
+	user := suite.createTestUser()
+	followed := suite.createTestArtist("Followed Artist")
+	other := suite.createTestArtist("Other Artist")
+	suite.Require().NoError(suite.followService.Follow(user.ID, "artist", followed))
+
+	now := time.Now().UTC()
+	later := suite.createShowWithArtists("Later", now.Add(72*time.Hour), catalogm.ShowStatusApproved, other, followed)
+	sooner := suite.createShowWithArtists("Sooner", now.Add(24*time.Hour), catalogm.ShowStatusApproved, followed)
+	suite.createShowWithArtists("Past", now.Add(-24*time.Hour), catalogm.ShowStatusApproved, followed)
+	suite.createShowWithArtists("Pending", now.Add(24*time.Hour), catalogm.ShowStatusPending, followed)
+	suite.createShowWithArtists("Unfollowed", now.Add(24*time.Hour), catalogm.ShowStatusApproved, other)
+
+	shows, total, err := suite.followService.GetFollowedArtistShows(user.ID, 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), total)
+	suite.Require().Len(shows, 2)
+	suite.Equal(sooner, shows[0].ID, "soonest first")
+	suite.Equal(later, shows[1].ID)
+	suite.Require().Len(shows[1].FollowedArtists, 1, "only followed artists are listed")
+	suite.Equal("Followed Artist", shows[1].FollowedArtists[0].Name)
+}
+
+func (suite *FollowServiceIntegrationTestSuite) TestGetFollowedArtistShows_NoFollows() {
+	user := suite.createTestUser()
+
+	shows, total, err := suite.followService.GetFollowedArtistShows(user.ID, 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(0), total)
+	suite.NotNil(shows)
+	suite.Empty(shows)
+}
@@ -193,7 +193,7 @@ func (s *CalendarService) followedArtistShows(userID uint, cutoff time.Time) ([]
 			"JOIN user_bookmarks ub ON ub.entity_id = sa.artist_id AND ub.user_id = ? AND ub.entity_type = ? AND ub.action = ?",
 			userID, engagementm.BookmarkEntityArtist, engagementm.BookmarkActionFollow,
 		).
-		Where("shows.status = ? AND shows.is_cancelled = ? AND shows.created_at >= ?",
+		Where("shows.status = ? AND shows.deleted_at IS NULL AND shows.is_cancelled = ? AND shows.created_at >= ?",
 			catalogm.ShowStatusApproved, false, cutoff).
 		Preload("Artists").
 		Preload("Venues").
@@ -7,9 +7,13 @@ var (
 	_ contracts.BookmarkServiceInterface            = (*BookmarkService)(nil)
 	_ contracts.SavedShowServiceInterface           = (*SavedShowService)(nil)
 	_ contracts.SavedReleaseServiceInterface        = (*SavedReleaseService)(nil)
+	_ contracts.ShowRSVPServiceInterface            = (*ShowRSVPService)(nil)
 	_ contracts.CalendarServiceInterface            = (*CalendarService)(nil)
+	_ contracts.CalendarImportServiceInterface      = (*CalendarImportService)(nil)
 	_ contracts.ReminderServiceInterface            = (*ReminderService)(nil)
+	_ contracts.ShowChangeNotifierInterface         = (*ShowChangeNotificationService)(nil)
 	_ contracts.FollowServiceInterface              = (*FollowService)(nil)
+	_ contracts.RecommendationServiceInterface      = (*RecommendationService)(nil)
 	_ contracts.CommentServiceInterface             = (*CommentService)(nil)
 	_ contracts.CommentAdminServiceInterface        = (*CommentService)(nil)
 	_ contracts.FieldNoteServiceInterface           = (*CommentService)(nil)
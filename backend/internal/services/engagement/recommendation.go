@@ -0,0 +1,329 @@
+package engagement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Recommendation signal weights. A followed artist on the bill is the
+// strongest signal; a favorite city alone only surfaces a show when nothing
+// better fills the list.
+const (
+	recommendationWeightFollowedArtist = 5
+	recommendationWeightFollowedVenue  = 3
+	recommendationWeightSavedArtist    = 2
+	recommendationWeightSavedVenue     = 2
+	recommendationWeightFavoriteCity   = 1
+)
+
+// recommendationHorizon bounds how far ahead candidates are drawn from.
+const recommendationHorizon = 90 * 24 * time.Hour
+
+// recommendationCandidateSQL selects upcoming, public shows the user has not
+// already saved or RSVP'd to. Args: status, from, to, user ID.
+const recommendationCandidateSQL = `s.status = ? AND s.deleted_at IS NULL AND s.is_cancelled = false
+	AND s.event_date >= ? AND s.event_date < ?
+	AND NOT EXISTS (
+		SELECT 1 FROM user_bookmarks own
+		WHERE own.user_id = ? AND own.entity_type = 'show' AND own.entity_id = s.id
+			AND own.action IN ('save', 'going')
+	)`
+
+// RecommendationService scores upcoming shows for a user from their follows,
+// saved-show history and favorite cities.
+type RecommendationService struct {
+	db *gorm.DB
+}
+
+// NewRecommendationService creates a new recommendation service
+func NewRecommendationService(database *gorm.DB) *RecommendationService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &RecommendationService{db: database}
+}
+
+// recommendationSignal is one matched signal for a candidate show. EntityID
+// is the artist or venue that matched (0 for cities); Via is the saved show
+// that produced a history signal.
+type recommendationSignal struct {
+	ShowID    uint      `gorm:"column:show_id"`
+	EventDate time.Time `gorm:"column:event_date"`
+	EntityID  uint      `gorm:"column:entity_id"`
+	Name      string    `gorm:"column:name"`
+	Via       string    `gorm:"column:via"`
+}
+
+// scoredShow accumulates signals for one candidate show.
+type scoredShow struct {
+	id        uint
+	eventDate time.Time
+	score     int
+	reasons   []contracts.RecommendationReason
+	credited  map[string]bool
+}
+
+// GetRecommendedShows returns up to limit upcoming shows for the user, best
+// score first (soonest first on ties). Shows matching no signal are never
+// returned, so a new user with no history gets an empty list.
+func (s *RecommendationService) GetRecommendedShows(userID uint, limit int) ([]*contracts.RecommendedShow, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	now := time.Now().UTC()
+	candidateArgs := []interface{}{catalogm.ShowStatusApproved, now, now.Add(recommendationHorizon), userID}
+	scored := map[uint]*scoredShow{}
+
+	add := func(sig recommendationSignal, creditKey string, weight int, reasonType, message string) {
+		sh, ok := scored[sig.ShowID]
+		if !ok {
+			sh = &scoredShow{id: sig.ShowID, eventDate: sig.EventDate, credited: map[string]bool{}}
+			scored[sig.ShowID] = sh
+		}
+		// An artist or venue counts once per show: a followed artist the user
+		// also saved before is not credited twice.
+		if creditKey != "" {
+			if sh.credited[creditKey] {
+				return
+			}
+			sh.credited[creditKey] = true
+		}
+		sh.score += weight
+		sh.reasons = append(sh.reasons, contracts.RecommendationReason{Type: reasonType, Message: message})
+	}
+
+	// Signals are applied strongest first, so each show's reasons come out
+	// ordered by weight and the stronger reason wins a credit collision.
+	followedArtists, err := s.followedArtistSignals(userID, candidateArgs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range followedArtists {
+		add(sig, fmt.Sprintf("artist:%d", sig.EntityID), recommendationWeightFollowedArtist,
+			contracts.RecommendationReasonFollowedArtist, fmt.Sprintf("Because you follow %s", sig.Name))
+	}
+
+	followedVenues, err := s.followedVenueSignals(userID, candidateArgs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range followedVenues {
+		add(sig, fmt.Sprintf("venue:%d", sig.EntityID), recommendationWeightFollowedVenue,
+			contracts.RecommendationReasonFollowedVenue, fmt.Sprintf("Because you follow %s", sig.Name))
+	}
+
+	savedArtists, err := s.savedArtistSignals(userID, candidateArgs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range savedArtists {
+		add(sig, fmt.Sprintf("artist:%d", sig.EntityID), recommendationWeightSavedArtist,
+			contracts.RecommendationReasonSavedArtist, fmt.Sprintf("Because you saved %s with %s", sig.Via, sig.Name))
+	}
+
+	savedVenues, err := s.savedVenueSignals(userID, candidateArgs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range savedVenues {
+		add(sig, fmt.Sprintf("venue:%d", sig.EntityID), recommendationWeightSavedVenue,
+			contracts.RecommendationReasonSavedVenue, fmt.Sprintf("Because you saved %s at this venue", sig.Via))
+	}
+
+	citySignals, err := s.favoriteCitySignals(userID, candidateArgs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range citySignals {
+		add(sig, "", recommendationWeightFavoriteCity,
+			contracts.RecommendationReasonFavoriteCity, fmt.Sprintf("In %s, one of your cities", sig.Name))
+	}
+
+	ranked := make([]*scoredShow, 0, len(scored))
+	for _, sh := range scored {
+		ranked = append(ranked, sh)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		if !ranked[i].eventDate.Equal(ranked[j].eventDate) {
+			return ranked[i].eventDate.Before(ranked[j].eventDate)
+		}
+		return ranked[i].id < ranked[j].id
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	return s.hydrate(ranked)
+}
+
+// followedArtistSignals matches candidates with a followed artist on the bill.
+func (s *RecommendationService) followedArtistSignals(userID uint, candidateArgs []interface{}) ([]recommendationSignal, error) {
+	var rows []recommendationSignal
+	err := s.db.Table("show_artists sa").
+		Select("sa.show_id, s.event_date, a.id AS entity_id, a.name").
+		Joins("JOIN shows s ON s.id = sa.show_id").
+		Joins("JOIN artists a ON a.id = sa.artist_id").
+		Joins("JOIN user_bookmarks b ON b.entity_id = sa.artist_id AND b.user_id = ? AND b.entity_type = ? AND b.action = ?",
+			userID, engagementm.BookmarkEntityArtist, engagementm.BookmarkActionFollow).
+		Where(recommendationCandidateSQL, candidateArgs...).
+		Order("sa.show_id, sa.position, sa.artist_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get followed artist signals: %w", err)
+	}
+	return rows, nil
+}
+
+// followedVenueSignals matches candidates at a followed venue.
+func (s *RecommendationService) followedVenueSignals(userID uint, candidateArgs []interface{}) ([]recommendationSignal, error) {
+	var rows []recommendationSignal
+	err := s.db.Table("show_venues sv").
+		Select("sv.show_id, s.event_date, v.id AS entity_id, v.name").
+		Joins("JOIN shows s ON s.id = sv.show_id").
+		Joins("JOIN venues v ON v.id = sv.venue_id").
+		Joins("JOIN user_bookmarks b ON b.entity_id = sv.venue_id AND b.user_id = ? AND b.entity_type = ? AND b.action = ?",
+			userID, engagementm.BookmarkEntityVenue, engagementm.BookmarkActionFollow).
+		Where(recommendationCandidateSQL, candidateArgs...).
+		Order("sv.show_id, sv.venue_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get followed venue signals: %w", err)
+	}
+	return rows, nil
+}
+
+// savedArtistSignals matches candidates sharing an artist with a show the user
+// saved. Via is the most recently saved such show.
+func (s *RecommendationService) savedArtistSignals(userID uint, candidateArgs []interface{}) ([]recommendationSignal, error) {
+	var rows []recommendationSignal
+	err := s.db.Table("show_artists sa").
+		Select("DISTINCT ON (sa.show_id, sa.artist_id) sa.show_id, s.event_date, a.id AS entity_id, a.name, ps.title AS via").
+		Joins("JOIN shows s ON s.id = sa.show_id").
+		Joins("JOIN artists a ON a.id = sa.artist_id").
+		Joins("JOIN show_artists psa ON psa.artist_id = sa.artist_id AND psa.show_id <> sa.show_id").
+		Joins("JOIN shows ps ON ps.id = psa.show_id AND ps.deleted_at IS NULL").
+		Joins("JOIN user_bookmarks b ON b.entity_id = ps.id AND b.user_id = ? AND b.entity_type = ? AND b.action = ?",
+			userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave).
+		Where(recommendationCandidateSQL, candidateArgs...).
+		Order("sa.show_id, sa.artist_id, b.created_at DESC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved artist signals: %w", err)
+	}
+	return rows, nil
+}
+
+// savedVenueSignals matches candidates at a venue where the user saved another
+// show. Via is the most recently saved such show.
+func (s *RecommendationService) savedVenueSignals(userID uint, candidateArgs []interface{}) ([]recommendationSignal, error) {
+	var rows []recommendationSignal
+	err := s.db.Table("show_venues sv").
+		Select("DISTINCT ON (sv.show_id, sv.venue_id) sv.show_id, s.event_date, v.id AS entity_id, v.name, ps.title AS via").
+		Joins("JOIN shows s ON s.id = sv.show_id").
+		Joins("JOIN venues v ON v.id = sv.venue_id").
+		Joins("JOIN show_venues psv ON psv.venue_id = sv.venue_id AND psv.show_id <> sv.show_id").
+		Joins("JOIN shows ps ON ps.id = psv.show_id AND ps.deleted_at IS NULL").
+		Joins("JOIN user_bookmarks b ON b.entity_id = ps.id AND b.user_id = ? AND b.entity_type = ? AND b.action = ?",
+			userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave).
+		Where(recommendationCandidateSQL, candidateArgs...).
+		Order("sv.show_id, sv.venue_id, b.created_at DESC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved venue signals: %w", err)
+	}
+	return rows, nil
+}
+
+// favoriteCitySignals matches candidates in one of the user's favorite cities.
+// Name is the city as the user stored it.
+func (s *RecommendationService) favoriteCitySignals(userID uint, candidateArgs []interface{}) ([]recommendationSignal, error) {
+	var prefs authm.UserPreferences
+	if err := s.db.Where("user_id = ?", userID).First(&prefs).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user preferences: %w", err)
+	}
+	if prefs.FavoriteCities == nil {
+		return nil, nil
+	}
+	var cities []authm.FavoriteCity
+	if err := json.Unmarshal(*prefs.FavoriteCities, &cities); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal favorite cities: %w", err)
+	}
+	if len(cities) == 0 {
+		return nil, nil
+	}
+
+	conds := make([]string, 0, len(cities))
+	args := make([]interface{}, 0, len(cities)*2)
+	for _, c := range cities {
+		conds = append(conds, "(LOWER(s.city) = LOWER(?) AND LOWER(s.state) = LOWER(?))")
+		args = append(args, c.City, c.State)
+	}
+
+	var rows []recommendationSignal
+	err := s.db.Table("shows s").
+		Select("s.id AS show_id, s.event_date, s.city AS name").
+		Where(recommendationCandidateSQL, candidateArgs...).
+		Where("("+strings.Join(conds, " OR ")+")", args...).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite city signals: %w", err)
+	}
+	return rows, nil
+}
+
+// hydrate loads display fields for the ranked shows, preserving rank order.
+func (s *RecommendationService) hydrate(ranked []*scoredShow) ([]*contracts.RecommendedShow, error) {
+	shows := make([]*contracts.RecommendedShow, 0, len(ranked))
+	if len(ranked) == 0 {
+		return shows, nil
+	}
+
+	ids := make([]uint, len(ranked))
+	for i, sh := range ranked {
+		ids[i] = sh.id
+	}
+	var rows []*contracts.RecommendedShow
+	err := s.db.Table("shows s").
+		Select(`s.id, COALESCE(s.slug, '') AS slug, s.title, s.event_date, s.city, s.state,
+			(SELECT v.name FROM show_venues sv JOIN venues v ON v.id = sv.venue_id
+				WHERE sv.show_id = s.id ORDER BY sv.venue_id LIMIT 1) AS venue_name`).
+		Where("s.id IN ?", ids).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommended shows: %w", err)
+	}
+	byID := make(map[uint]*contracts.RecommendedShow, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	for _, sh := range ranked {
+		row, ok := byID[sh.id]
+		if !ok {
+			continue
+		}
+		row.Score = sh.score
+		row.Reasons = sh.reasons
+		shows = append(shows, row)
+	}
+	return shows, nil
+}
@@ -0,0 +1,228 @@
+package engagement
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestRecommendationService_NilDatabase(t *testing.T) {
+	svc := &RecommendationService{}
+
+	if _, err := svc.GetRecommendedShows(1, 10); err == nil {
+		t.Error("expected error for nil database")
+	}
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type RecommendationServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB  *testutil.TestDatabase
+	db      *gorm.DB
+	service *RecommendationService
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+	suite.service = NewRecommendationService(suite.testDB.DB)
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
+	_, _ = sqlDB.Exec("DELETE FROM user_preferences")
+	_, _ = sqlDB.Exec("DELETE FROM show_artists")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM artists")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestRecommendationServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(RecommendationServiceIntegrationTestSuite))
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) createUser() *authm.User {
+	user := &authm.User{
+		Email:    stringPtr(fmt.Sprintf("rec-%d@test.com", time.Now().UnixNano())),
+		IsActive: true,
+	}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	return user
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) createVenue(name string) *catalogm.Venue {
+	venue := &catalogm.Venue{Name: name, City: "Phoenix", State: "AZ"}
+	suite.Require().NoError(suite.db.Create(venue).Error)
+	return venue
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) createArtist(name string) *catalogm.Artist {
+	artist := &catalogm.Artist{Name: name}
+	suite.Require().NoError(suite.db.Create(artist).Error)
+	return artist
+}
+
+// createShow creates an approved show daysOut days from now at venue with
+// the given bill.
+func (suite *RecommendationServiceIntegrationTestSuite) createShow(title string, daysOut int, city string, venue *catalogm.Venue, artists ...*catalogm.Artist) *catalogm.Show {
+	show := &catalogm.Show{
+		Title:     title,
+		EventDate: time.Now().UTC().AddDate(0, 0, daysOut),
+		City:      stringPtr(city),
+		State:     stringPtr("AZ"),
+		Status:    catalogm.ShowStatusApproved,
+	}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	if venue != nil {
+		suite.Require().NoError(suite.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: venue.ID}).Error)
+	}
+	for i, a := range artists {
+		suite.Require().NoError(suite.db.Create(&catalogm.ShowArtist{ShowID: show.ID, ArtistID: a.ID, Position: i}).Error)
+	}
+	return show
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) bookmark(userID uint, entityType engagementm.BookmarkEntityType, entityID uint, action engagementm.BookmarkAction) {
+	suite.Require().NoError(suite.db.Create(&engagementm.UserBookmark{
+		UserID:     userID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		CreatedAt:  time.Now().UTC(),
+	}).Error)
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) setFavoriteCities(userID uint, cities ...authm.FavoriteCity) {
+	raw, err := json.Marshal(cities)
+	suite.Require().NoError(err)
+	msg := json.RawMessage(raw)
+	suite.Require().NoError(suite.db.Create(&authm.UserPreferences{UserID: userID, FavoriteCities: &msg}).Error)
+}
+
+func reasonTypes(show *contracts.RecommendedShow) []string {
+	types := make([]string, len(show.Reasons))
+	for i, r := range show.Reasons {
+		types[i] = r.Type
+	}
+	return types
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) TestNoHistory_ReturnsEmpty() {
+	user := suite.createUser()
+	suite.createShow("Some Show", 3, "Phoenix", suite.createVenue("Venue"))
+
+	shows, err := suite.service.GetRecommendedShows(user.ID, 10)
+	suite.Require().NoError(err)
+	suite.Empty(shows)
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) TestScoresAndExplains() {
+	user := suite.createUser()
+	followedArtist := suite.createArtist("Followed Band")
+	savedArtist := suite.createArtist("Saved Band")
+	followedVenue := suite.createVenue("Followed Hall")
+	savedVenue := suite.createVenue("Saved Room")
+	otherVenue := suite.createVenue("Other Place")
+
+	suite.bookmark(user.ID, engagementm.BookmarkEntityArtist, followedArtist.ID, engagementm.BookmarkActionFollow)
+	suite.bookmark(user.ID, engagementm.BookmarkEntityVenue, followedVenue.ID, engagementm.BookmarkActionFollow)
+	past := suite.createShow("Last Year's Gig", -30, "Tucson", savedVenue, savedArtist)
+	suite.bookmark(user.ID, engagementm.BookmarkEntityShow, past.ID, engagementm.BookmarkActionSave)
+	suite.setFavoriteCities(user.ID, authm.FavoriteCity{City: "Mesa", State: "AZ"})
+
+	best := suite.createShow("Best", 20, "Tucson", followedVenue, followedArtist)
+	savedVenueShow := suite.createShow("At Saved Venue", 10, "Tucson", savedVenue)
+	savedArtistShow := suite.createShow("With Saved Band", 12, "Tucson", otherVenue, savedArtist)
+	cityShow := suite.createShow("In Mesa", 5, "Mesa", otherVenue)
+	suite.createShow("Unrelated", 5, "Tucson", otherVenue)
+
+	shows, err := suite.service.GetRecommendedShows(user.ID, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(shows, 4)
+
+	suite.Equal(best.ID, shows[0].ID)
+	suite.Equal(recommendationWeightFollowedArtist+recommendationWeightFollowedVenue, shows[0].Score)
+	suite.Equal([]string{contracts.RecommendationReasonFollowedArtist, contracts.RecommendationReasonFollowedVenue}, reasonTypes(shows[0]))
+	suite.Equal("Followed Hall", *shows[0].VenueName)
+
+	// Equal scores fall back to the soonest show.
+	suite.Equal(savedVenueShow.ID, shows[1].ID)
+	suite.Equal("Because you saved Last Year's Gig at this venue", shows[1].Reasons[0].Message)
+	suite.Equal(savedArtistShow.ID, shows[2].ID)
+	suite.Equal(contracts.RecommendationReasonSavedArtist, shows[2].Reasons[0].Type)
+
+	suite.Equal(cityShow.ID, shows[3].ID)
+	suite.Equal(recommendationWeightFavoriteCity, shows[3].Score)
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) TestFollowedArtistNotDoubleCountedBySaveHistory() {
+	user := suite.createUser()
+	artist := suite.createArtist("Band")
+	venue := suite.createVenue("Venue")
+	past := suite.createShow("Past", -10, "Phoenix", venue, artist)
+	suite.bookmark(user.ID, engagementm.BookmarkEntityShow, past.ID, engagementm.BookmarkActionSave)
+	suite.bookmark(user.ID, engagementm.BookmarkEntityArtist, artist.ID, engagementm.BookmarkActionFollow)
+
+	upcoming := suite.createShow("Upcoming", 10, "Phoenix", suite.createVenue("Elsewhere"), artist)
+
+	shows, err := suite.service.GetRecommendedShows(user.ID, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(shows, 1)
+	suite.Equal(upcoming.ID, shows[0].ID)
+	suite.Equal(recommendationWeightFollowedArtist, shows[0].Score)
+	suite.Equal([]string{contracts.RecommendationReasonFollowedArtist}, reasonTypes(shows[0]))
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) TestExcludesSavedPastAndHiddenShows() {
+	user := suite.createUser()
+	artist := suite.createArtist("Band")
+	suite.bookmark(user.ID, engagementm.BookmarkEntityArtist, artist.ID, engagementm.BookmarkActionFollow)
+
+	saved := suite.createShow("Already Saved", 3, "Phoenix", nil, artist)
+	suite.bookmark(user.ID, engagementm.BookmarkEntityShow, saved.ID, engagementm.BookmarkActionSave)
+	going := suite.createShow("Already Going", 4, "Phoenix", nil, artist)
+	suite.bookmark(user.ID, engagementm.BookmarkEntityShow, going.ID, engagementm.BookmarkActionGoing)
+	suite.createShow("Past", -3, "Phoenix", nil, artist)
+	pending := suite.createShow("Pending", 5, "Phoenix", nil, artist)
+	suite.Require().NoError(suite.db.Model(pending).Update("status", catalogm.ShowStatusPending).Error)
+	cancelled := suite.createShow("Cancelled", 6, "Phoenix", nil, artist)
+	suite.Require().NoError(suite.db.Model(cancelled).Update("is_cancelled", true).Error)
+
+	shows, err := suite.service.GetRecommendedShows(user.ID, 10)
+	suite.Require().NoError(err)
+	suite.Empty(shows)
+}
+
+func (suite *RecommendationServiceIntegrationTestSuite) TestLimit() {
+	user := suite.createUser()
+	artist := suite.createArtist("Band")
+	suite.bookmark(user.ID, engagementm.BookmarkEntityArtist, artist.ID, engagementm.BookmarkActionFollow)
+	first := suite.createShow("First", 1, "Phoenix", nil, artist)
+	suite.createShow("Second", 2, "Phoenix", nil, artist)
+
+	shows, err := suite.service.GetRecommendedShows(user.ID, 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(shows, 1)
+	suite.Equal(first.ID, shows[0].ID)
+}
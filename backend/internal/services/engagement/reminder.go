@@ -6,13 +6,17 @@ import (
 	"log/slog"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"psychic-homily-backend/db"
 	"psychic-homily-backend/internal/config"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	notificationm "psychic-homily-backend/internal/models/notification"
 	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/services/shared"
 	"psychic-homily-backend/internal/utils"
@@ -21,31 +25,51 @@ import (
 // Default reminder check interval (30 minutes)
 const DefaultReminderInterval = 30 * time.Minute
 
+// reminderSendHour is the user-local hour from which a day's reminders go out.
+const reminderSendHour = 9
+
+// showReminder is one of the reminders lined up for each saved show.
+type showReminder struct {
+	notificationType string // sent_notifications.notification_type
+	daysBefore       int
+}
+
+// showReminders are the reminders sent for a saved show, furthest out first.
+var showReminders = []showReminder{
+	{notificationType: "show_reminder_week_before", daysBefore: 7},
+	{notificationType: "show_reminder_day_before", daysBefore: 1},
+}
+
 // reminderRow holds the result of the reminder query
 type reminderRow struct {
-	UserID    uint
-	ShowID    uint
-	Email     string
-	ShowTitle string
-	ShowSlug  string
-	EventDate time.Time
-	State     *string // show state — fallback for venue-local rendering (PSY-996)
+	UserID       uint
+	ShowID       uint
+	Email        string
+	ShowTitle    string
+	ShowSlug     string
+	EventDate    time.Time
+	State        *string // show state — fallback for venue-local rendering (PSY-996)
+	UserTimezone *string
 }
 
-// ReminderService sends email reminders for saved shows ~24h before the event
+// ReminderService sends reminders for saved shows a week and a day before the
+// event, by email and (when wired) Web Push
 type ReminderService struct {
 	db           *gorm.DB
 	emailService contracts.EmailServiceInterface
+	preferences  contracts.NotificationPreferenceServiceInterface
+	pushService  contracts.PushServiceInterface // optional; nil = email only
 	interval     time.Duration
 	stopCh       chan struct{}
 	wg           sync.WaitGroup
 	logger       *slog.Logger
 	frontendURL  string
-	jwtSecret    string
+	keys         *config.JWTKeyRing
+	now          func() time.Time // injectable for schedule tests
 }
 
 // NewReminderService creates a new reminder service
-func NewReminderService(database *gorm.DB, emailService contracts.EmailServiceInterface, cfg *config.Config) *ReminderService {
+func NewReminderService(database *gorm.DB, emailService contracts.EmailServiceInterface, preferences contracts.NotificationPreferenceServiceInterface, cfg *config.Config) *ReminderService {
 	if database == nil {
 		database = db.GetDB()
 	}
@@ -61,14 +85,22 @@ func NewReminderService(database *gorm.DB, emailService contracts.EmailServiceIn
 	return &ReminderService{
 		db:           database,
 		emailService: emailService,
+		preferences:  preferences,
 		interval:     interval,
 		stopCh:       make(chan struct{}),
 		logger:       slog.Default(),
 		frontendURL:  cfg.Email.FrontendURL,
-		jwtSecret:    cfg.JWT.SecretKey,
+		keys:         cfg.JWT.KeyRing(),
+		now:          time.Now,
 	}
 }
 
+// SetPushService enables push delivery for users who turned on the
+// saved-show reminder push channel.
+func (s *ReminderService) SetPushService(pushService contracts.PushServiceInterface) {
+	s.pushService = pushService
+}
+
 // Start begins the background reminder job
 func (s *ReminderService) Start(ctx context.Context) {
 	s.wg.Add(1)
@@ -93,15 +125,48 @@ func (s *ReminderService) run(ctx context.Context) {
 	})
 }
 
-// runReminderCycle finds shows happening in ~24h and sends reminders
+// reminderLocation is the zone a user's reminders are scheduled in: their
+// own timezone preference, or the show's venue zone when they haven't set
+// one. UTC is the column default, so it counts as unset.
+func reminderLocation(userTimezone *string, venueLoc *time.Location) *time.Location {
+	if userTimezone != nil && *userTimezone != "" && *userTimezone != "UTC" {
+		if loc, err := time.LoadLocation(*userTimezone); err == nil {
+			return loc
+		}
+	}
+	return venueLoc
+}
+
+// dueShowReminder returns the reminder whose send window contains now. A
+// reminder's window runs from reminderSendHour until midnight on the local
+// day daysBefore days ahead of the show's local date, so a show saved late
+// skips the reminders it has already passed instead of sending them late.
+func dueShowReminder(eventDate time.Time, loc *time.Location, now time.Time) (showReminder, bool) {
+	if !now.Before(eventDate) {
+		return showReminder{}, false
+	}
+	local := eventDate.In(loc)
+	for _, r := range showReminders {
+		day := local.Day() - r.daysBefore
+		start := time.Date(local.Year(), local.Month(), day, reminderSendHour, 0, 0, 0, loc)
+		end := time.Date(local.Year(), local.Month(), day+1, 0, 0, 0, 0, loc)
+		if !now.Before(start) && now.Before(end) {
+			return r, true
+		}
+	}
+	return showReminder{}, false
+}
+
+// runReminderCycle finds saved shows with a reminder due and sends it
 func (s *ReminderService) runReminderCycle() {
 	s.logger.Info("starting show reminder cycle")
 
-	now := time.Now()
-	windowStart := now.Add(23 * time.Hour)
-	windowEnd := now.Add(25 * time.Hour)
+	now := s.now()
+	// The week-before window can open up to a day early in a zone far ahead
+	// of the show's, so look a little past seven days out.
+	windowEnd := now.Add(9 * 24 * time.Hour)
 
-	// Query users with saved shows in the reminder window
+	// Query users with saved shows coming up
 	var rows []reminderRow
 	err := s.db.Raw(`
 		SELECT
@@ -111,33 +176,51 @@ func (s *ReminderService) runReminderCycle() {
 			s.title AS show_title,
 			COALESCE(s.slug, CAST(s.id AS TEXT)) AS show_slug,
 			s.event_date,
-			s.state
+			s.state,
+			up.timezone AS user_timezone
 		FROM user_bookmarks ub
 		JOIN shows s ON s.id = ub.entity_id
 		JOIN users u ON u.id = ub.user_id
-		JOIN user_preferences up ON up.user_id = ub.user_id
+		LEFT JOIN user_preferences up ON up.user_id = ub.user_id
 		WHERE ub.entity_type = 'show'
 			AND ub.action = 'save'
-			AND s.event_date BETWEEN ? AND ?
-			AND s.status = 'approved'
+			AND s.event_date > ? AND s.event_date <= ?
+			AND s.status = 'approved' AND s.deleted_at IS NULL
 			AND s.is_cancelled = false
-			AND up.show_reminders = true
+			AND s.is_postponed = false
 			AND u.is_active = true
 			AND u.deleted_at IS NULL
 			AND u.email IS NOT NULL
-			AND ub.reminder_sent_at IS NULL
-	`, windowStart, windowEnd).Scan(&rows).Error
+	`, now, windowEnd).Scan(&rows).Error
 	if err != nil {
 		s.logger.Error("failed to query reminder candidates", "error", err)
 		return
 	}
 
+	channels, err := s.reminderChannels(rows)
+	if err != nil {
+		s.logger.Error("failed to check reminder preferences", "error", err)
+		return
+	}
+
+	kept := rows[:0]
+	for _, row := range rows {
+		if len(channels[row.UserID]) > 0 {
+			kept = append(kept, row)
+		}
+	}
+	rows = kept
+
 	if len(rows) == 0 {
 		s.logger.Info("no show reminders to send")
 		return
 	}
 
-	s.logger.Info("found shows to send reminders for", "count", len(rows))
+	sent, err := s.loadSentReminders(rows)
+	if err != nil {
+		s.logger.Error("failed to load sent reminders", "error", err)
+		return
+	}
 
 	// Collect venue names + the first venue's timezone for each show.
 	type venueInfo struct {
@@ -147,6 +230,7 @@ func (s *ReminderService) runReminderCycle() {
 	}
 	venueCache := make(map[uint]venueInfo)
 
+	dueCount := 0
 	sentCount := 0
 	errorCount := 0
 
@@ -182,51 +266,182 @@ func (s *ReminderService) runReminderCycle() {
 		if locState == "" && row.State != nil {
 			locState = *row.State
 		}
-		localizedEvent := row.EventDate.In(utils.EventLocation(info.timezone, locState))
-
-		showURL := fmt.Sprintf("%s/shows/%s", s.frontendURL, row.ShowSlug)
-		unsubscribeURL := GenerateUnsubscribeURL(s.frontendURL, row.UserID, s.jwtSecret)
-
-		err := s.emailService.SendShowReminderEmail(
-			row.Email,
-			row.ShowTitle,
-			showURL,
-			unsubscribeURL,
-			localizedEvent,
-			info.names,
-		)
-		if err != nil {
-			s.logger.Error("failed to send show reminder email",
-				"user_id", row.UserID,
-				"show_id", row.ShowID,
-				"error", err,
-			)
-			errorCount++
+		venueLoc := utils.EventLocation(info.timezone, locState)
+
+		reminder, ok := dueShowReminder(row.EventDate, reminderLocation(row.UserTimezone, venueLoc), now)
+		if !ok {
 			continue
 		}
+		due := false
+		for _, channel := range channels[row.UserID] {
+			if sent[sentReminderKey{row.UserID, row.ShowID, reminder.notificationType, channel}] {
+				continue
+			}
+			due = true
 
-		// Mark as sent for deduplication
-		if err := s.db.Exec(
-			"UPDATE user_bookmarks SET reminder_sent_at = ? WHERE user_id = ? AND entity_type = 'show' AND entity_id = ? AND action = 'save'",
-			now, row.UserID, row.ShowID,
-		).Error; err != nil {
-			s.logger.Error("failed to mark reminder as sent",
-				"user_id", row.UserID,
-				"show_id", row.ShowID,
-				"error", err,
-			)
-		}
+			delivered, err := s.deliverReminder(channel, row, reminder, row.EventDate.In(venueLoc), info.names)
+			if err != nil {
+				s.logger.Error("failed to send show reminder",
+					"user_id", row.UserID,
+					"show_id", row.ShowID,
+					"reminder", reminder.notificationType,
+					"channel", channel,
+					"error", err,
+				)
+				errorCount++
+				continue
+			}
+			if !delivered {
+				continue
+			}
+
+			// Record the send for deduplication
+			record := notificationm.SentNotification{
+				UserID:           row.UserID,
+				NotificationType: reminder.notificationType,
+				EntityType:       string(engagementm.BookmarkEntityShow),
+				EntityID:         row.ShowID,
+				Channel:          channel,
+				SentAt:           now,
+			}
+			if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&record).Error; err != nil {
+				s.logger.Error("failed to record sent reminder",
+					"user_id", row.UserID,
+					"show_id", row.ShowID,
+					"reminder", reminder.notificationType,
+					"channel", channel,
+					"error", err,
+				)
+			}
 
-		sentCount++
+			sentCount++
+		}
+		if due {
+			dueCount++
+		}
 	}
 
 	s.logger.Info("show reminder cycle completed",
-		"total", len(rows),
+		"candidates", len(rows),
+		"due", dueCount,
 		"sent", sentCount,
 		"errors", errorCount,
 	)
 }
 
+// deliverReminder sends one reminder on channel. delivered is false when
+// there was nothing to deliver to (a push-enabled user with no subscribed
+// browsers), so the reminder isn't recorded as sent.
+func (s *ReminderService) deliverReminder(channel string, row reminderRow, reminder showReminder, eventDate time.Time, venues []string) (delivered bool, err error) {
+	showURL := fmt.Sprintf("%s/shows/%s", s.frontendURL, row.ShowSlug)
+
+	if channel == contracts.NotificationChannelPush {
+		n, err := s.pushService.SendToUser(row.UserID, contracts.PushMessage{
+			Title: row.ShowTitle,
+			Body:  reminderPushBody(eventDate, venues, reminder.daysBefore),
+			URL:   showURL,
+			Tag:   fmt.Sprintf("show-%d", row.ShowID),
+		})
+		return n > 0, err
+	}
+
+	unsubscribeURL := GenerateUnsubscribeURL(s.frontendURL, row.UserID, s.keys.Current().Secret)
+	err = s.emailService.SendShowReminderEmail(
+		row.Email,
+		row.ShowTitle,
+		showURL,
+		unsubscribeURL,
+		eventDate,
+		venues,
+		reminder.daysBefore,
+	)
+	return err == nil, err
+}
+
+// reminderPushBody is the notification text under the show title, e.g.
+// "Tomorrow, Sat Mar 16 at 8:00 PM · The Rebel Lounge".
+func reminderPushBody(eventDate time.Time, venues []string, daysBefore int) string {
+	var lead string
+	switch daysBefore {
+	case 1:
+		lead = "Tomorrow"
+	case 7:
+		lead = "Next week"
+	default:
+		lead = fmt.Sprintf("In %d days", daysBefore)
+	}
+	body := fmt.Sprintf("%s, %s", lead, eventDate.Format("Mon Jan 2 at 3:04 PM"))
+	if len(venues) > 0 {
+		body += " · " + strings.Join(venues, ", ")
+	}
+	return body
+}
+
+// reminderChannels returns the channels each candidate user has enabled
+// saved-show reminders on. Users with none are absent from the map. Push is
+// only considered when a configured push service is wired.
+func (s *ReminderService) reminderChannels(rows []reminderRow) (map[uint][]string, error) {
+	channels := make(map[uint][]string)
+	if len(rows) == 0 {
+		return channels, nil
+	}
+	seen := make(map[uint]bool)
+	userIDs := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		if !seen[row.UserID] {
+			seen[row.UserID] = true
+			userIDs = append(userIDs, row.UserID)
+		}
+	}
+
+	candidates := []string{contracts.NotificationChannelEmail}
+	if s.pushService != nil && s.pushService.IsConfigured() {
+		candidates = append(candidates, contracts.NotificationChannelPush)
+	}
+	for _, channel := range candidates {
+		enabledIDs, err := s.preferences.FilterEnabledUsers(userIDs,
+			contracts.NotificationEventSavedShowReminder, channel)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range enabledIDs {
+			channels[id] = append(channels[id], channel)
+		}
+	}
+	return channels, nil
+}
+
+// sentReminderKey identifies one reminder for one user's saved show on one
+// channel.
+type sentReminderKey struct {
+	userID           uint
+	showID           uint
+	notificationType string
+	channel          string
+}
+
+// loadSentReminders returns the show reminders already sent for the
+// candidate rows' shows.
+func (s *ReminderService) loadSentReminders(rows []reminderRow) (map[sentReminderKey]bool, error) {
+	showIDs := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		showIDs = append(showIDs, row.ShowID)
+	}
+
+	var records []notificationm.SentNotification
+	if err := s.db.Where("entity_type = ? AND entity_id IN ?",
+		string(engagementm.BookmarkEntityShow), showIDs).
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	sent := make(map[sentReminderKey]bool, len(records))
+	for _, r := range records {
+		sent[sentReminderKey{r.UserID, r.EntityID, r.NotificationType, r.Channel}] = true
+	}
+	return sent, nil
+}
+
 // RunReminderCycleNow triggers an immediate reminder cycle (useful for testing)
 func (s *ReminderService) RunReminderCycleNow() {
 	s.runReminderCycle()
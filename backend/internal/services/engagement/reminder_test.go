@@ -75,6 +75,84 @@ func TestHMACRoundTrip(t *testing.T) {
 		"round-trip: signature should not verify for a different user ID")
 }
 
+// =============================================================================
+// UNIT TESTS — Reminder Schedule (No Database Required)
+// =============================================================================
+
+func TestDueShowReminder(t *testing.T) {
+	phoenix, err := time.LoadLocation("America/Phoenix")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Show at 8pm Phoenix on Friday, March 15.
+	event := time.Date(2030, 3, 15, 20, 0, 0, 0, phoenix)
+	at := func(day, hour int) time.Time { return time.Date(2030, 3, day, hour, 0, 0, 0, phoenix) }
+
+	cases := []struct {
+		name     string
+		now      time.Time
+		wantType string
+	}{
+		{"week before, before send hour", at(8, 8), ""},
+		{"week before, at send hour", at(8, 9), "show_reminder_week_before"},
+		{"week before, late evening", at(8, 23), "show_reminder_week_before"},
+		{"between reminders", at(10, 12), ""},
+		{"day before, before send hour", at(14, 8), ""},
+		{"day before", at(14, 9), "show_reminder_day_before"},
+		{"show day", at(15, 10), ""},
+		{"after show", at(16, 10), ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r, ok := dueShowReminder(event, phoenix, c.now)
+			if c.wantType == "" {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, c.wantType, r.notificationType)
+		})
+	}
+}
+
+func TestDueShowReminder_UsesGivenZoneForCalendarDay(t *testing.T) {
+	phoenix, _ := time.LoadLocation("America/Phoenix")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	// 8pm Phoenix Thursday is noon Friday in Tokyo.
+	event := time.Date(2030, 3, 14, 20, 0, 0, 0, phoenix)
+	// 10am Wednesday Phoenix is 2am Thursday Tokyo: the Phoenix user's
+	// day-before window is open, the Tokyo user's hasn't reached 9am yet.
+	now := time.Date(2030, 3, 13, 10, 0, 0, 0, phoenix)
+
+	_, ok := dueShowReminder(event, phoenix, now)
+	assert.True(t, ok)
+	_, ok = dueShowReminder(event, tokyo, now)
+	assert.False(t, ok)
+	_, ok = dueShowReminder(event, tokyo, time.Date(2030, 3, 14, 9, 0, 0, 0, tokyo))
+	assert.True(t, ok)
+}
+
+func TestReminderLocation(t *testing.T) {
+	phoenix, _ := time.LoadLocation("America/Phoenix")
+
+	assert.Equal(t, phoenix, reminderLocation(nil, phoenix))
+	assert.Equal(t, phoenix, reminderLocation(stringPtr(""), phoenix))
+	assert.Equal(t, phoenix, reminderLocation(stringPtr("UTC"), phoenix), "UTC is the column default")
+	assert.Equal(t, phoenix, reminderLocation(stringPtr("Not/AZone"), phoenix))
+	assert.Equal(t, "America/Chicago", reminderLocation(stringPtr("America/Chicago"), phoenix).String())
+}
+
+func TestReminderPushBody(t *testing.T) {
+	phoenix, _ := time.LoadLocation("America/Phoenix")
+	event := time.Date(2030, 3, 16, 20, 0, 0, 0, phoenix)
+
+	assert.Equal(t, "Tomorrow, Sat Mar 16 at 8:00 PM · The Rebel Lounge",
+		reminderPushBody(event, []string{"The Rebel Lounge"}, 1))
+	assert.Equal(t, "Next week, Sat Mar 16 at 8:00 PM · Valley Bar, Crescent Ballroom",
+		reminderPushBody(event, []string{"Valley Bar", "Crescent Ballroom"}, 7))
+	assert.Equal(t, "In 3 days, Sat Mar 16 at 8:00 PM", reminderPushBody(event, nil, 3))
+}
+
 // =============================================================================
 // INTEGRATION TESTS (With Real Database)
 // =============================================================================
@@ -92,6 +170,7 @@ type reminderEmailCall struct {
 	UnsubscribeURL string
 	EventDate      time.Time
 	Venues         []string
+	DaysBefore     int
 }
 
 func (m *mockReminderEmailService) IsConfigured() bool { return true }
@@ -104,7 +183,7 @@ func (m *mockReminderEmailService) SendMagicLinkEmail(_, _ string) error {
 func (m *mockReminderEmailService) SendAccountRecoveryEmail(_ string, _ string, _ int) error {
 	return nil
 }
-func (m *mockReminderEmailService) SendShowReminderEmail(toEmail, showTitle, showURL, unsubscribeURL string, eventDate time.Time, venues []string) error {
+func (m *mockReminderEmailService) SendShowReminderEmail(toEmail, showTitle, showURL, unsubscribeURL string, eventDate time.Time, venues []string, daysBefore int) error {
 	m.calls = append(m.calls, reminderEmailCall{
 		ToEmail:        toEmail,
 		ShowTitle:      showTitle,
@@ -112,6 +191,7 @@ func (m *mockReminderEmailService) SendShowReminderEmail(toEmail, showTitle, sho
 		UnsubscribeURL: unsubscribeURL,
 		EventDate:      eventDate,
 		Venues:         venues,
+		DaysBefore:     daysBefore,
 	})
 	if m.shouldError {
 		return fmt.Errorf("mock email send error")
@@ -136,7 +216,10 @@ func (m *mockReminderEmailService) SendCommentNotification(_, _, _, _, _, _, _ s
 func (m *mockReminderEmailService) SendMentionNotification(_, _, _, _, _, _, _ string) error {
 	return nil
 }
-func (m *mockReminderEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ string) error {
+func (m *mockReminderEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ []contracts.SceneDigestShow, _ string) error {
+	return nil
+}
+func (m *mockReminderEmailService) SendShowStatusChangeEmail(_ string, _ contracts.ShowStatusChange, _ string) error {
 	return nil
 }
 
@@ -144,14 +227,81 @@ func (m *mockReminderEmailService) SendCollectionDigestEmail(_ string, _ []contr
 	return nil
 }
 
+// mockReminderPreferences answers FilterEnabledUsers from in-memory opt-in
+// sets. The real preference service lives in the notification package,
+// which imports this one.
+type mockReminderPreferences struct {
+	optedIn     map[uint]bool
+	pushOptedIn map[uint]bool
+}
+
+func (m *mockReminderPreferences) GetPreferences(_ uint) (contracts.NotificationPreferenceMatrix, error) {
+	return nil, nil
+}
+func (m *mockReminderPreferences) UpdatePreferences(_ uint, _ contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error) {
+	return nil, nil
+}
+func (m *mockReminderPreferences) IsEnabled(userID uint, _, _ string) (bool, error) {
+	return m.optedIn[userID], nil
+}
+func (m *mockReminderPreferences) FilterEnabledUsers(userIDs []uint, eventType, channel string) ([]uint, error) {
+	if eventType != contracts.NotificationEventSavedShowReminder {
+		return nil, fmt.Errorf("unexpected preference cell %s/%s", eventType, channel)
+	}
+	set := m.optedIn
+	if channel == contracts.NotificationChannelPush {
+		set = m.pushOptedIn
+	}
+	var enabled []uint
+	for _, id := range userIDs {
+		if set[id] {
+			enabled = append(enabled, id)
+		}
+	}
+	return enabled, nil
+}
+
+// mockReminderPush records pushes; users in noDevices have no subscribed
+// browsers.
+type mockReminderPush struct {
+	calls     []pushCall
+	noDevices map[uint]bool
+}
+
+type pushCall struct {
+	UserID  uint
+	Message contracts.PushMessage
+}
+
+func (m *mockReminderPush) IsConfigured() bool { return true }
+func (m *mockReminderPush) PublicKey() string  { return "" }
+func (m *mockReminderPush) Subscribe(_ uint, _ contracts.PushSubscriptionInput) error {
+	return nil
+}
+func (m *mockReminderPush) Unsubscribe(_ uint, _ string) error { return nil }
+func (m *mockReminderPush) SendToUser(userID uint, msg contracts.PushMessage) (int, error) {
+	if m.noDevices[userID] {
+		return 0, nil
+	}
+	m.calls = append(m.calls, pushCall{UserID: userID, Message: msg})
+	return 1, nil
+}
+
+// reminderTestNow is the suite's clock: 10am Sunday, March 10 2030 in
+// Phoenix, where the test shows and venues are.
+var reminderTestNow = time.Date(2030, 3, 10, 17, 0, 0, 0, time.UTC)
+
 // ReminderServiceIntegrationTestSuite tests the reminder service with a real database
 type ReminderServiceIntegrationTestSuite struct {
 	suite.Suite
 	testDB          *testutil.TestDatabase
 	db              *gorm.DB
 	emailMock       *mockReminderEmailService
+	prefsMock       *mockReminderPreferences
+	pushMock        *mockReminderPush
 	reminderService *ReminderService
 	cfg             *config.Config
+	now             time.Time
 }
 
 func (s *ReminderServiceIntegrationTestSuite) SetupSuite() {
@@ -170,14 +320,20 @@ func (s *ReminderServiceIntegrationTestSuite) SetupSuite() {
 
 func (s *ReminderServiceIntegrationTestSuite) SetupTest() {
 	s.emailMock = &mockReminderEmailService{}
+	s.prefsMock = &mockReminderPreferences{optedIn: map[uint]bool{}, pushOptedIn: map[uint]bool{}}
+	s.pushMock = &mockReminderPush{noDevices: map[uint]bool{}}
+	s.now = reminderTestNow
 	s.reminderService = &ReminderService{
 		db:           s.db,
 		emailService: s.emailMock,
+		preferences:  s.prefsMock,
+		pushService:  s.pushMock,
 		interval:     1 * time.Second,
 		stopCh:       make(chan struct{}),
 		logger:       testLogger(),
 		frontendURL:  s.cfg.Email.FrontendURL,
-		jwtSecret:    s.cfg.JWT.SecretKey,
+		keys:         s.cfg.JWT.KeyRing(),
+		now:          func() time.Time { return s.now },
 	}
 }
 
@@ -188,6 +344,7 @@ func (s *ReminderServiceIntegrationTestSuite) TearDownSuite() {
 func (s *ReminderServiceIntegrationTestSuite) TearDownTest() {
 	sqlDB, err := s.db.DB()
 	s.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM sent_notifications")
 	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
 	_, _ = sqlDB.Exec("DELETE FROM show_venues")
 	_, _ = sqlDB.Exec("DELETE FROM show_artists")
@@ -221,23 +378,36 @@ func (s *ReminderServiceIntegrationTestSuite) createTestUserWithPrefs(showRemind
 	err := s.db.Create(user).Error
 	s.Require().NoError(err)
 
-	// Create user preferences with show_reminders setting.
-	// GORM bool gotcha: create with ShowReminders true, then update to false if needed.
-	prefs := &authm.UserPreferences{
-		UserID:        user.ID,
-		ShowReminders: true,
-	}
+	prefs := &authm.UserPreferences{UserID: user.ID}
 	err = s.db.Create(prefs).Error
 	s.Require().NoError(err)
 
-	if !showReminders {
-		err = s.db.Model(prefs).Update("show_reminders", false).Error
-		s.Require().NoError(err)
-	}
-
+	s.prefsMock.optedIn[user.ID] = showReminders
 	return user
 }
 
+func (s *ReminderServiceIntegrationTestSuite) setUserTimezone(userID uint, tz string) {
+	err := s.db.Model(&authm.UserPreferences{}).Where("user_id = ?", userID).Update("timezone", tz).Error
+	s.Require().NoError(err)
+}
+
+// phoenixTime returns the given March 2030 day and hour in Phoenix.
+func phoenixTime(day, hour int) time.Time {
+	loc, _ := time.LoadLocation("America/Phoenix")
+	return time.Date(2030, 3, day, hour, 0, 0, 0, loc)
+}
+
+// sentReminderTypes returns the reminder types recorded for a user's show.
+func (s *ReminderServiceIntegrationTestSuite) sentReminderTypes(userID, showID uint) []string {
+	var types []string
+	err := s.db.Table("sent_notifications").
+		Where("user_id = ? AND entity_type = 'show' AND entity_id = ?", userID, showID).
+		Order("notification_type").
+		Pluck("notification_type", &types).Error
+	s.Require().NoError(err)
+	return types
+}
+
 func (s *ReminderServiceIntegrationTestSuite) createShowAt(title string, eventDate time.Time, userID uint) *catalogm.Show {
 	slug := fmt.Sprintf("show-%d", time.Now().UnixNano())
 	show := &catalogm.Show{
@@ -282,9 +452,7 @@ func (s *ReminderServiceIntegrationTestSuite) saveShow(userID, showID uint) {
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_SendsReminderForTomorrowShow() {
 	user := s.createTestUserWithPrefs(true)
-	// Show happening ~24h from now (within the 23-25h window)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Tomorrow Concert", eventDate, user.ID)
+	show := s.createShowAt("Tomorrow Concert", phoenixTime(11, 20), user.ID)
 	venue := s.createVenueForShow(show.ID, "The Rebel Lounge")
 	s.saveShow(user.ID, show.ID)
 
@@ -294,24 +462,42 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_SendsReminder
 	call := s.emailMock.calls[0]
 	s.Equal(*user.Email, call.ToEmail)
 	s.Equal("Tomorrow Concert", call.ShowTitle)
+	s.Equal(1, call.DaysBefore)
 	s.Contains(call.ShowURL, *show.Slug)
 	s.Contains(call.UnsubscribeURL, "/unsubscribe/show-reminders")
 	s.Require().Len(call.Venues, 1)
 	s.Equal(venue.Name, call.Venues[0])
+	s.Equal("America/Phoenix", call.EventDate.Location().String())
 
-	// Verify reminder_sent_at was set (deduplication)
-	var bookmark engagementm.UserBookmark
-	err := s.db.Where("user_id = ? AND entity_type = ? AND entity_id = ? AND action = ?",
-		user.ID, engagementm.BookmarkEntityShow, show.ID, engagementm.BookmarkActionSave).
-		First(&bookmark).Error
-	s.Require().NoError(err)
-	s.NotNil(bookmark.ReminderSentAt, "reminder_sent_at should be set after sending")
+	s.Equal([]string{"show_reminder_day_before"}, s.sentReminderTypes(user.ID, show.ID))
+}
+
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_WeekBeforeThenDayBefore() {
+	user := s.createTestUserWithPrefs(true)
+	show := s.createShowAt("Next Week Concert", phoenixTime(17, 20), user.ID)
+	s.createVenueForShow(show.ID, "Valley Bar")
+	s.saveShow(user.ID, show.ID)
+
+	s.reminderService.RunReminderCycleNow()
+	s.Require().Len(s.emailMock.calls, 1)
+	s.Equal(7, s.emailMock.calls[0].DaysBefore)
+
+	// Nothing more until the day before.
+	s.now = phoenixTime(13, 12)
+	s.reminderService.RunReminderCycleNow()
+	s.Len(s.emailMock.calls, 1)
+
+	s.now = phoenixTime(16, 9)
+	s.reminderService.RunReminderCycleNow()
+	s.Require().Len(s.emailMock.calls, 2)
+	s.Equal(1, s.emailMock.calls[1].DaysBefore)
+
+	s.Equal([]string{"show_reminder_day_before", "show_reminder_week_before"}, s.sentReminderTypes(user.ID, show.ID))
 }
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_MultipleVenues() {
 	user := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Multi-Venue Show", eventDate, user.ID)
+	show := s.createShowAt("Multi-Venue Show", phoenixTime(11, 20), user.ID)
 	s.createVenueForShow(show.ID, "Valley Bar")
 	s.createVenueForShow(show.ID, "Crescent Ballroom")
 	s.saveShow(user.ID, show.ID)
@@ -325,8 +511,7 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_MultipleVenue
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_MultipleUsers() {
 	user1 := s.createTestUserWithPrefs(true)
 	user2 := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Shared Show", eventDate, user1.ID)
+	show := s.createShowAt("Shared Show", phoenixTime(11, 20), user1.ID)
 	s.createVenueForShow(show.ID, "The Van Buren")
 	s.saveShow(user1.ID, show.ID)
 	s.saveShow(user2.ID, show.ID)
@@ -336,6 +521,27 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_MultipleUsers
 	s.Len(s.emailMock.calls, 2, "both users should receive a reminder")
 }
 
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_UserTimezone() {
+	user := s.createTestUserWithPrefs(true)
+	s.setUserTimezone(user.ID, "Asia/Tokyo")
+	// 8pm Monday Phoenix is noon Tuesday in Tokyo.
+	show := s.createShowAt("Tokyo Viewer Show", phoenixTime(11, 20), user.ID)
+	s.createVenueForShow(show.ID, "The Rebel Lounge")
+	s.saveShow(user.ID, show.ID)
+
+	// 10am Sunday Phoenix is 2am Monday in Tokyo — before that user's send hour.
+	s.reminderService.RunReminderCycleNow()
+	s.Empty(s.emailMock.calls)
+
+	// 9am Monday Tokyo.
+	s.now = time.Date(2030, 3, 11, 0, 0, 0, 0, time.UTC)
+	s.reminderService.RunReminderCycleNow()
+	s.Require().Len(s.emailMock.calls, 1)
+	s.Equal(1, s.emailMock.calls[0].DaysBefore)
+	s.Equal("America/Phoenix", s.emailMock.calls[0].EventDate.Location().String(),
+		"event time is still shown in the venue's zone")
+}
+
 // =============================================================================
 // Group 2: runReminderCycle — Filtering / Edge Cases
 // =============================================================================
@@ -346,45 +552,63 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_NoRemindersTo
 	s.Empty(s.emailMock.calls)
 }
 
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_BeforeSendHour() {
+	user := s.createTestUserWithPrefs(true)
+	show := s.createShowAt("Early Check Show", phoenixTime(11, 20), user.ID)
+	s.saveShow(user.ID, show.ID)
+
+	s.now = phoenixTime(10, 7)
+	s.reminderService.RunReminderCycleNow()
+
+	s.Empty(s.emailMock.calls, "reminders wait until the send hour")
+}
+
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_ShowOutsideWindow_TooSoon() {
 	user := s.createTestUserWithPrefs(true)
-	// Show in 20 hours — before the 23h window start
-	eventDate := time.Now().Add(20 * time.Hour)
-	show := s.createShowAt("Too Soon Show", eventDate, user.ID)
+	// Show tonight — the day-before window has passed
+	show := s.createShowAt("Too Soon Show", phoenixTime(10, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	s.reminderService.RunReminderCycleNow()
 
-	s.Empty(s.emailMock.calls, "show before 23h window should not trigger reminder")
+	s.Empty(s.emailMock.calls, "show later today should not trigger reminder")
+}
+
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_ShowOutsideWindow_BetweenReminders() {
+	user := s.createTestUserWithPrefs(true)
+	// Show in three days — past the week-before window, not yet the day before
+	show := s.createShowAt("Midweek Show", phoenixTime(13, 20), user.ID)
+	s.saveShow(user.ID, show.ID)
+
+	s.reminderService.RunReminderCycleNow()
+
+	s.Empty(s.emailMock.calls, "show between reminder windows should not trigger reminder")
 }
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_ShowOutsideWindow_TooFar() {
 	user := s.createTestUserWithPrefs(true)
-	// Show in 48 hours — past the 25h window end
-	eventDate := time.Now().Add(48 * time.Hour)
-	show := s.createShowAt("Far Future Show", eventDate, user.ID)
+	// Show in two weeks
+	show := s.createShowAt("Far Future Show", phoenixTime(24, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	s.reminderService.RunReminderCycleNow()
 
-	s.Empty(s.emailMock.calls, "show past 25h window should not trigger reminder")
+	s.Empty(s.emailMock.calls, "show beyond a week out should not trigger reminder")
 }
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_ShowRemindersDisabled() {
 	user := s.createTestUserWithPrefs(false) // reminders OFF
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("No Reminder Show", eventDate, user.ID)
+	show := s.createShowAt("No Reminder Show", phoenixTime(11, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	s.reminderService.RunReminderCycleNow()
 
-	s.Empty(s.emailMock.calls, "user with show_reminders=false should not receive reminder")
+	s.Empty(s.emailMock.calls, "user without saved-show reminder emails enabled should not receive reminder")
 }
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_CancelledShow() {
 	user := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Cancelled Show", eventDate, user.ID)
+	show := s.createShowAt("Cancelled Show", phoenixTime(11, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	// Mark show as cancelled
@@ -395,10 +619,21 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_CancelledShow
 	s.Empty(s.emailMock.calls, "cancelled shows should not trigger reminders")
 }
 
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_PostponedShow() {
+	user := s.createTestUserWithPrefs(true)
+	show := s.createShowAt("Postponed Show", phoenixTime(11, 20), user.ID)
+	s.saveShow(user.ID, show.ID)
+
+	s.db.Model(show).Update("is_postponed", true)
+
+	s.reminderService.RunReminderCycleNow()
+
+	s.Empty(s.emailMock.calls, "postponed shows should not trigger reminders")
+}
+
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_PendingShow() {
 	user := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Pending Show", eventDate, user.ID)
+	show := s.createShowAt("Pending Show", phoenixTime(11, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	// Change status to pending
@@ -411,8 +646,7 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_PendingShow()
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_InactiveUser() {
 	user := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Inactive User Show", eventDate, user.ID)
+	show := s.createShowAt("Inactive User Show", phoenixTime(11, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	// Deactivate the user
@@ -425,8 +659,7 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_InactiveUser(
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_SoftDeletedUser() {
 	user := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Deleted User Show", eventDate, user.ID)
+	show := s.createShowAt("Deleted User Show", phoenixTime(11, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	// Soft-delete the user
@@ -440,24 +673,39 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_SoftDeletedUs
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_Deduplication() {
 	user := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Dedup Show", eventDate, user.ID)
+	show := s.createShowAt("Dedup Show", phoenixTime(11, 20), user.ID)
 	s.saveShow(user.ID, show.ID)
 
 	// First cycle sends the reminder
 	s.reminderService.RunReminderCycleNow()
 	s.Len(s.emailMock.calls, 1)
 
-	// Second cycle should NOT send again (reminder_sent_at is set)
+	// Second cycle should NOT send again (sent_notifications has the row)
+	s.now = s.now.Add(30 * time.Minute)
+	s.reminderService.RunReminderCycleNow()
+	s.Len(s.emailMock.calls, 1, "reminder should not be sent twice for the same saved show")
+}
+
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_DeduplicationSurvivesResave() {
+	user := s.createTestUserWithPrefs(true)
+	show := s.createShowAt("Resave Show", phoenixTime(11, 20), user.ID)
+	s.saveShow(user.ID, show.ID)
+
 	s.reminderService.RunReminderCycleNow()
-	s.Len(s.emailMock.calls, 1, "reminder should not be sent twice for the same bookmark")
+	s.Require().Len(s.emailMock.calls, 1)
+
+	// Unsave and save again
+	s.db.Where("user_id = ? AND entity_id = ?", user.ID, show.ID).Delete(&engagementm.UserBookmark{})
+	s.saveShow(user.ID, show.ID)
+
+	s.reminderService.RunReminderCycleNow()
+	s.Len(s.emailMock.calls, 1, "re-saving a show should not resend its reminder")
 }
 
 func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_EmailError_ContinuesOthers() {
 	user1 := s.createTestUserWithPrefs(true)
 	user2 := s.createTestUserWithPrefs(true)
-	eventDate := time.Now().Add(24 * time.Hour)
-	show := s.createShowAt("Error Test Show", eventDate, user1.ID)
+	show := s.createShowAt("Error Test Show", phoenixTime(11, 20), user1.ID)
 	s.createVenueForShow(show.ID, "Test Venue")
 	s.saveShow(user1.ID, show.ID)
 	s.saveShow(user2.ID, show.ID)
@@ -470,13 +718,59 @@ func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_EmailError_Co
 	// Both users should have been attempted (both calls recorded before error check)
 	s.Len(s.emailMock.calls, 2)
 
-	// Verify reminder_sent_at was NOT set (since email failed)
-	var bookmark engagementm.UserBookmark
-	err := s.db.Where("user_id = ? AND entity_type = ? AND entity_id = ? AND action = ?",
-		user1.ID, engagementm.BookmarkEntityShow, show.ID, engagementm.BookmarkActionSave).
-		First(&bookmark).Error
-	s.Require().NoError(err)
-	s.Nil(bookmark.ReminderSentAt, "reminder_sent_at should not be set when email fails")
+	// Nothing recorded as sent, so the next cycle retries
+	s.Empty(s.sentReminderTypes(user1.ID, show.ID), "failed sends should not be recorded")
+}
+
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_PushOnly() {
+	user := s.createTestUserWithPrefs(false)
+	s.prefsMock.pushOptedIn[user.ID] = true
+	show := s.createShowAt("Push Show", phoenixTime(11, 20), user.ID)
+	s.createVenueForShow(show.ID, "The Rebel Lounge")
+	s.saveShow(user.ID, show.ID)
+
+	s.reminderService.RunReminderCycleNow()
+
+	s.Empty(s.emailMock.calls)
+	s.Require().Len(s.pushMock.calls, 1)
+	msg := s.pushMock.calls[0].Message
+	s.Equal("Push Show", msg.Title)
+	s.Equal("Tomorrow, Mon Mar 11 at 8:00 PM · The Rebel Lounge", msg.Body)
+	s.Contains(msg.URL, *show.Slug)
+
+	var channels []string
+	s.db.Table("sent_notifications").Where("user_id = ?", user.ID).Pluck("channel", &channels)
+	s.Equal([]string{"push"}, channels)
+
+	// Deduped per channel like email.
+	s.reminderService.RunReminderCycleNow()
+	s.Len(s.pushMock.calls, 1)
+}
+
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_EmailAndPush() {
+	user := s.createTestUserWithPrefs(true)
+	s.prefsMock.pushOptedIn[user.ID] = true
+	show := s.createShowAt("Both Channels", phoenixTime(11, 20), user.ID)
+	s.saveShow(user.ID, show.ID)
+
+	s.reminderService.RunReminderCycleNow()
+
+	s.Len(s.emailMock.calls, 1)
+	s.Len(s.pushMock.calls, 1)
+	s.Equal([]string{"show_reminder_day_before", "show_reminder_day_before"}, s.sentReminderTypes(user.ID, show.ID))
+}
+
+func (s *ReminderServiceIntegrationTestSuite) TestRunReminderCycle_PushWithoutDevicesNotRecorded() {
+	user := s.createTestUserWithPrefs(false)
+	s.prefsMock.pushOptedIn[user.ID] = true
+	s.pushMock.noDevices[user.ID] = true
+	show := s.createShowAt("No Devices", phoenixTime(11, 20), user.ID)
+	s.saveShow(user.ID, show.ID)
+
+	s.reminderService.RunReminderCycleNow()
+
+	s.Empty(s.pushMock.calls)
+	s.Empty(s.sentReminderTypes(user.ID, show.ID), "a browser subscribed later should still get the reminder")
 }
 
 // =============================================================================
@@ -487,11 +781,12 @@ func (s *ReminderServiceIntegrationTestSuite) TestStartStop_NoError() {
 	svc := &ReminderService{
 		db:           s.db,
 		emailService: s.emailMock,
+		preferences:  s.prefsMock,
 		interval:     100 * time.Millisecond,
 		stopCh:       make(chan struct{}),
 		logger:       testLogger(),
 		frontendURL:  s.cfg.Email.FrontendURL,
-		jwtSecret:    s.cfg.JWT.SecretKey,
+		keys:         s.cfg.JWT.KeyRing(),
 	}
 
 	svc.Start(context.Background())
@@ -520,11 +815,12 @@ func (s *ReminderServiceIntegrationTestSuite) TestStartStop_ContextCancellation(
 	svc := &ReminderService{
 		db:           s.db,
 		emailService: s.emailMock,
+		preferences:  s.prefsMock,
 		interval:     100 * time.Millisecond,
 		stopCh:       make(chan struct{}),
 		logger:       testLogger(),
 		frontendURL:  s.cfg.Email.FrontendURL,
-		jwtSecret:    s.cfg.JWT.SecretKey,
+		keys:         s.cfg.JWT.KeyRing(),
 	}
 
 	svc.Start(ctx)
@@ -548,14 +844,16 @@ func (s *ReminderServiceIntegrationTestSuite) TestStartStop_ContextCancellation(
 }
 
 func (s *ReminderServiceIntegrationTestSuite) TestNewReminderService_DefaultInterval() {
-	svc := NewReminderService(s.db, s.emailMock, s.cfg)
+	svc := NewReminderService(s.db, s.emailMock, s.prefsMock, s.cfg)
 	s.Equal(DefaultReminderInterval, svc.interval)
 }
 
 func (s *ReminderServiceIntegrationTestSuite) TestNewReminderService_StoresConfig() {
-	svc := NewReminderService(s.db, s.emailMock, s.cfg)
+	svc := NewReminderService(s.db, s.emailMock, s.prefsMock, s.cfg)
 	s.Equal(s.cfg.Email.FrontendURL, svc.frontendURL)
-	s.Equal(s.cfg.JWT.SecretKey, svc.jwtSecret)
+	s.Equal(s.cfg.JWT.SecretKey, svc.keys.Current().Secret)
+	s.Equal(s.prefsMock, svc.preferences)
 	s.NotNil(svc.stopCh)
 	s.NotNil(svc.logger)
+	s.NotNil(svc.now)
 }
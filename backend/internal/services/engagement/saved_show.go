@@ -12,6 +12,7 @@ import (
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	engagementm "psychic-homily-backend/internal/models/engagement"
 	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
 )
 
 // SavedShowService handles saved show business logic
@@ -42,7 +43,7 @@ func (s *SavedShowService) SaveShow(userID, showID uint) error {
 
 	// Check if show exists
 	var show catalogm.Show
-	if err := s.db.First(&show, showID).Error; err != nil {
+	if err := s.db.Where("deleted_at IS NULL").First(&show, showID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return apperrors.ErrShowNotFound(showID)
 		}
@@ -130,20 +131,9 @@ func (s *SavedShowService) GetUserSavedShows(userID uint, limit, offset int, tim
 	var total int64
 
 	switch timeFilter {
-	case "":
-		// Page over bookmarks (created_at DESC) exactly as before.
-		bookmarks, bookmarkTotal, err := s.bookmark.GetUserBookmarks(userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave, limit, offset)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to get saved shows: %w", err)
-		}
-		total = bookmarkTotal
-		refs = make([]savedShowRef, len(bookmarks))
-		for i, b := range bookmarks {
-			refs[i] = savedShowRef{ShowID: b.EntityID, SavedAt: b.CreatedAt}
-		}
-	case "upcoming", "past":
+	case "", "upcoming", "past":
 		var err error
-		refs, total, err = s.savedShowPageByEventDate(userID, limit, offset, timeFilter)
+		refs, total, err = s.savedShowPage(userID, engagementm.BookmarkActionSave, limit, offset, timeFilter)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -154,17 +144,19 @@ func (s *SavedShowService) GetUserSavedShows(userID uint, limit, offset int, tim
 	return s.hydrateSavedShows(refs, total)
 }
 
-// savedShowPageByEventDate pages over a user's saved shows partitioned and
-// ordered by event date, venue-timezone-aware. Unlike the saved-at path (which
-// pages over bookmarks and then fetches shows), the date partition has to live
-// on the shows side of the join, so this pages over shows joined to bookmarks.
-func (s *SavedShowService) savedShowPageByEventDate(userID uint, limit, offset int, timeFilter string) ([]savedShowRef, int64, error) {
-	var dateCondition, order string
+// savedShowPage pages over the shows a user bookmarked with action (saves,
+// or RSVPs). The list lives on the shows side of the join so soft-deleted
+// shows, whose bookmarks are kept for a restore, drop out of both the page
+// and the total. The upcoming/past partitions are venue-timezone-aware and
+// ordered by event date; the unfiltered list is newest bookmark first.
+func (s *SavedShowService) savedShowPage(userID uint, action engagementm.BookmarkAction, limit, offset int, timeFilter string) ([]savedShowRef, int64, error) {
+	dateCondition := "TRUE"
+	order := "user_bookmarks.created_at DESC, user_bookmarks.id DESC"
 	switch timeFilter {
 	case "past":
 		dateCondition = savedShowVenueLocalDateSQL + " < " + savedShowVenueLocalTodaySQL
 		order = "shows.event_date DESC, shows.id DESC"
-	default: // "upcoming"
+	case "upcoming":
 		dateCondition = savedShowVenueLocalDateSQL + " >= " + savedShowVenueLocalTodaySQL
 		order = "shows.event_date ASC, shows.id ASC"
 	}
@@ -176,7 +168,8 @@ func (s *SavedShowService) savedShowPageByEventDate(userID uint, limit, offset i
 			Joins("JOIN shows ON shows.id = user_bookmarks.entity_id").
 			Joins(savedShowVenueTZJoin).
 			Where("user_bookmarks.user_id = ? AND user_bookmarks.entity_type = ? AND user_bookmarks.action = ?",
-				userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave).
+				userID, engagementm.BookmarkEntityShow, action).
+			Where("shows.deleted_at IS NULL").
 			Where(dateCondition)
 	}
 
@@ -211,10 +204,11 @@ func (s *SavedShowService) hydrateSavedShows(refs []savedShowRef, total int64) (
 		return []*contracts.SavedShowResponse{}, total, nil
 	}
 
-	// Fetch shows with associations (no status filter - user can save any show)
+	// Fetch shows with associations (no status filter - user can save any
+	// show, but soft-deleted shows are excluded)
 	var shows []catalogm.Show
 	err := s.db.Preload("Venues").
-		Where("id IN ?", showIDs).
+		Where("id IN ? AND deleted_at IS NULL", showIDs).
 		Find(&shows).Error
 
 	if err != nil {
@@ -230,7 +224,7 @@ func (s *SavedShowService) hydrateSavedShows(refs []savedShowRef, total int64) (
 	// Batch-load all ShowArtist records for all shows
 	var allShowArtists []catalogm.ShowArtist
 	if len(showIDs) > 0 {
-		s.db.Where("show_id IN ?", showIDs).Order("position ASC").Find(&allShowArtists)
+		s.db.Where("show_id IN ?", showIDs).Order(catalogm.ShowArtistLineupOrder).Find(&allShowArtists)
 	}
 
 	// Collect all unique artist IDs
@@ -264,6 +258,7 @@ func (s *SavedShowService) hydrateSavedShows(refs []savedShowRef, total int64) (
 			Spotify:    artist.Social.Spotify,
 			SoundCloud: artist.Social.SoundCloud,
 			Bandcamp:   artist.Social.Bandcamp,
+			TikTok:     artist.Social.TikTok,
 			Website:    artist.Social.Website,
 		}
 		isHeadliner := sa.SetType == "headliner"
@@ -281,6 +276,7 @@ func (s *SavedShowService) hydrateSavedShows(refs []savedShowRef, total int64) (
 			IsHeadliner:      &isHeadliner,
 			SetType:          sa.SetType,
 			Position:         sa.Position,
+			SetTime:          sa.SetTime,
 			IsNewArtist:      &isNewArtist,
 			BandcampEmbedURL: artist.BandcampEmbedURL,
 			Socials:          socials,
@@ -330,17 +326,19 @@ func (s *SavedShowService) buildShowResponse(show *catalogm.Show, artistsByShow
 	if show.Slug != nil {
 		showSlug = *show.Slug
 	}
-	return &contracts.ShowResponse{
+	response := &contracts.ShowResponse{
 		ID:                show.ID,
 		Slug:              showSlug,
 		Title:             show.Title,
 		EventDate:         show.EventDate,
+		DoorsTime:         show.DoorsTime,
 		City:              show.City,
 		State:             show.State,
-		Price:             show.Price,
+		ShowPrice:         shared.ShowPriceOf(show),
 		AgeRequirement:    show.AgeRequirement,
 		Description:       show.Description,
 		TicketURL:         show.TicketURL,
+		TicketProvider:    show.TicketProvider,
 		Status:            string(show.Status),
 		SubmittedBy:       show.SubmittedBy,
 		RejectionReason:   show.RejectionReason,
@@ -350,11 +348,16 @@ func (s *SavedShowService) buildShowResponse(show *catalogm.Show, artistsByShow
 		UpdatedAt:         show.UpdatedAt,
 		IsSoldOut:         show.IsSoldOut,
 		IsCancelled:       show.IsCancelled,
+		IsPostponed:       show.IsPostponed,
+		StatusReason:      show.StatusReason,
+		RescheduledShowID: show.RescheduledShowID,
 		Source:            string(show.Source),
 		SourceVenue:       show.SourceVenue,
 		ScrapedAt:         show.ScrapedAt,
 		DuplicateOfShowID: show.DuplicateOfShowID,
 	}
+	shared.SetShowLocalTimes(response)
+	return response
 }
 
 // IsShowSaved checks if a show is saved by a user
@@ -440,7 +443,7 @@ func (s *SavedShowService) GetBatchSaveCounts(showIDs []uint) (map[uint]int, err
 		Where("user_bookmarks.entity_type = ? AND user_bookmarks.entity_id IN ? AND user_bookmarks.action = ?",
 			engagementm.BookmarkEntityShow, showIDs, engagementm.BookmarkActionSave,
 		).
-		Where("shows.status = ?", catalogm.ShowStatusApproved).
+		Where("shows.status = ? AND shows.deleted_at IS NULL", catalogm.ShowStatusApproved).
 		Group("user_bookmarks.entity_id").
 		Find(&rows).Error
 	if err != nil {
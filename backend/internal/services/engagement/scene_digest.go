@@ -39,6 +39,8 @@ const (
 	// retried next cycle. A prioritization/rotation upgrade is a follow-up if
 	// global expansion makes it bite in practice.
 	sceneDigestMaxScenes = 20
+	// Followed-artist shows in the "Artists you follow" section.
+	sceneDigestArtistShows = 8
 )
 
 // SceneDigestService is a ticker-based background service that batches, per
@@ -54,17 +56,22 @@ const (
 // a successful send and ONLY on scenes that contributed content, so a band
 // that appears in a scene the user follows but that was empty this cycle is
 // still included next cycle.
+//
+// Each email also carries the user's this-week shows by artists they follow.
+// That section is a forward snapshot too, so when it's included every due
+// follow's cursor advances (otherwise a restart would re-send it).
 type SceneDigestService struct {
 	db           *gorm.DB
 	emailService contracts.EmailServiceInterface
 	sceneService contracts.SceneServiceInterface
+	follows      *FollowService
 	interval     time.Duration
 	stopCh       chan struct{}
 	wg           sync.WaitGroup
 	logger       *slog.Logger
 	frontendURL  string
 	backendURL   string
-	jwtSecret    string
+	keys         *config.JWTKeyRing
 }
 
 // NewSceneDigestService creates a new scene digest service. sceneService
@@ -91,12 +98,13 @@ func NewSceneDigestService(
 		db:           database,
 		emailService: emailService,
 		sceneService: sceneService,
+		follows:      NewFollowService(database),
 		interval:     interval,
 		stopCh:       make(chan struct{}),
 		logger:       slog.Default(),
 		frontendURL:  cfg.Email.FrontendURL,
 		backendURL:   DeriveBackendURL(cfg.Email.FrontendURL),
-		jwtSecret:    cfg.JWT.SecretKey,
+		keys:         cfg.JWT.KeyRing(),
 	}
 }
 
@@ -189,6 +197,7 @@ func (s *SceneDigestService) runDigestCycle() {
 
 		groups := make([]contracts.SceneDigestGroup, 0, len(ub.follows))
 		contributing := make([]uint, 0, len(ub.follows))
+		considered := make([]uint, 0, len(ub.follows))
 		for _, f := range ub.follows {
 			// Safety bound: cap scene sections per email. Excluded scenes are
 			// NOT added to `contributing`, so their cursors don't advance and
@@ -196,6 +205,7 @@ func (s *SceneDigestService) runDigestCycle() {
 			if len(groups) >= sceneDigestMaxScenes {
 				break
 			}
+			considered = append(considered, f.SceneID)
 			group, ok := s.buildSceneGroup(f, now)
 			if !ok {
 				continue
@@ -203,7 +213,11 @@ func (s *SceneDigestService) runDigestCycle() {
 			groups = append(groups, group)
 			contributing = append(contributing, f.SceneID)
 		}
-		if len(groups) == 0 {
+		artistShows := s.buildFollowedArtistShows(userID, now)
+		if len(artistShows) > 0 {
+			contributing = considered
+		}
+		if len(groups) == 0 && len(artistShows) == 0 {
 			continue // nothing new in any followed scene this week
 		}
 
@@ -217,10 +231,10 @@ func (s *SceneDigestService) runDigestCycle() {
 			continue
 		}
 
-		unsubURL := GenerateScopedUnsubscribeURL(s.backendURL, userID, UnsubscribeScopeSceneDigest, s.jwtSecret)
+		unsubURL := GenerateScopedUnsubscribeURL(s.backendURL, userID, UnsubscribeScopeSceneDigest, s.keys.Current().Secret)
 
 		if s.emailService != nil && s.emailService.IsConfigured() {
-			if err := s.emailService.SendSceneDigestEmail(ub.email, groups, unsubURL); err != nil {
+			if err := s.emailService.SendSceneDigestEmail(ub.email, groups, artistShows, unsubURL); err != nil {
 				sentry.WithScope(func(scope *sentry.Scope) {
 					scope.SetTag("service", "scene_digest")
 					sentry.CaptureException(err)
@@ -293,6 +307,43 @@ func (s *SceneDigestService) buildSceneGroup(f sceneFollowRow, now time.Time) (c
 	return group, true
 }
 
+// buildFollowedArtistShows lists this week's shows by artists the user
+// follows. Shows in the user's followed scenes come first so the cap keeps
+// them; the rest carry their city. Errors degrade to an empty section.
+func (s *SceneDigestService) buildFollowedArtistShows(userID uint, now time.Time) []contracts.SceneDigestShow {
+	shows, _, err := s.follows.followedArtistShows(userID, now, now.AddDate(0, 0, sceneDigestWindowDays), sceneDigestArtistShows, 0, true)
+	if err != nil {
+		s.logger.Warn("scene digest: followed artist shows unavailable", "user_id", userID, "error", err)
+		return nil
+	}
+	out := make([]contracts.SceneDigestShow, 0, len(shows))
+	for _, sh := range shows {
+		if sh.IsCancelled {
+			continue
+		}
+		names := make([]string, len(sh.FollowedArtists))
+		for i, a := range sh.FollowedArtists {
+			names[i] = a.Name
+		}
+		venue := ""
+		if sh.VenueName != nil {
+			venue = *sh.VenueName
+		}
+		location := ""
+		if !sh.InFollowedScene {
+			location = showLocation(sh.City, sh.State)
+		}
+		out = append(out, contracts.SceneDigestShow{
+			DisplayTitle: sceneShowDisplayTitle(sh.Title, names),
+			Date:         sh.EventDate.UTC().Format("Mon, Jan 2"),
+			VenueName:    venue,
+			Location:     location,
+			ShowURL:      s.showURL(sh.Slug, sh.ID),
+		})
+	}
+	return out
+}
+
 // queryFollows loads every opted-in scene follow DUE for a digest — its cursor
 // is NULL (never digested) or older than one interval — with its registry row.
 // The interval gate is what makes the cycle idempotent across restarts: a
@@ -373,6 +424,17 @@ func sceneShowDisplayTitle(title string, artistNames []string) string {
 	return strings.Join(names, ", ")
 }
 
+// showLocation formats a show's "City, ST", or whichever half is set.
+func showLocation(city, state *string) string {
+	parts := make([]string, 0, 2)
+	for _, p := range []*string{city, state} {
+		if p != nil && strings.TrimSpace(*p) != "" {
+			parts = append(parts, strings.TrimSpace(*p))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
 // formatDigestDate turns an ISO date-only string (YYYY-MM-DD) into "Mon, Jan 2".
 // Date-only, so no timezone nuance. Falls back to the raw value if unparseable.
 func formatDigestDate(iso string) string {
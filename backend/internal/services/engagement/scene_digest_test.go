@@ -50,6 +50,12 @@ func TestSceneShowDisplayTitle(t *testing.T) {
 	assert.Equal(t, "Untitled Show", sceneShowDisplayTitle("", nil))
 }
 
+func TestShowLocation(t *testing.T) {
+	assert.Equal(t, "Tucson, AZ", showLocation(strPtr(" Tucson "), strPtr("AZ")))
+	assert.Equal(t, "Berlin", showLocation(strPtr("Berlin"), strPtr("")))
+	assert.Equal(t, "", showLocation(nil, nil))
+}
+
 func TestHMAC_SceneDigestScope(t *testing.T) {
 	const secret, userID = "s3cr3t", uint(42)
 	sig := ComputeScopedUnsubscribeSignature(userID, UnsubscribeScopeSceneDigest, secret)
@@ -72,18 +78,22 @@ type captureSceneDigestEmailService struct {
 }
 
 type sceneDigestEmailCall struct {
-	ToEmail string
-	Groups  []contracts.SceneDigestGroup
-	Unsub   string
+	ToEmail     string
+	Groups      []contracts.SceneDigestGroup
+	ArtistShows []contracts.SceneDigestShow
+	Unsub       string
 }
 
 func (m *captureSceneDigestEmailService) IsConfigured() bool { return true }
-func (m *captureSceneDigestEmailService) SendSceneDigestEmail(to string, g []contracts.SceneDigestGroup, unsub string) error {
+func (m *captureSceneDigestEmailService) SendSceneDigestEmail(to string, g []contracts.SceneDigestGroup, artistShows []contracts.SceneDigestShow, unsub string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	cp := make([]contracts.SceneDigestGroup, len(g))
 	copy(cp, g)
-	m.calls = append(m.calls, sceneDigestEmailCall{ToEmail: to, Groups: cp, Unsub: unsub})
+	m.calls = append(m.calls, sceneDigestEmailCall{ToEmail: to, Groups: cp, ArtistShows: artistShows, Unsub: unsub})
+	return nil
+}
+func (m *captureSceneDigestEmailService) SendShowStatusChangeEmail(_ string, _ contracts.ShowStatusChange, _ string) error {
 	return nil
 }
 
@@ -93,7 +103,7 @@ func (m *captureSceneDigestEmailService) SendMagicLinkEmail(_, _ string) error
 func (m *captureSceneDigestEmailService) SendAccountRecoveryEmail(_, _ string, _ int) error {
 	return nil
 }
-func (m *captureSceneDigestEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string) error {
+func (m *captureSceneDigestEmailService) SendShowReminderEmail(_, _, _, _ string, _ time.Time, _ []string, _ int) error {
 	return nil
 }
 func (m *captureSceneDigestEmailService) SendFilterNotificationEmail(_, _, _, _ string) error {
@@ -375,3 +385,80 @@ func (s *SceneDigestSuite) TestNewBandsOverflowRendersMore() {
 	s.Len(g.NewArtists, sceneDigestArtistsPerScene)
 	s.Equal(1, g.MoreNewArtists, "the 9th band is surfaced as +1 more, not silently dropped")
 }
+
+func (s *SceneDigestSuite) TestFollowedArtistShowsSection() {
+	userID, _ := s.createUser()
+	sceneID, _ := s.createScene() // no shows, no new bands of its own
+	s.setSceneDigestPref(userID, true)
+	s.followScene(userID, sceneID, nil, time.Now().Add(-24*time.Hour))
+
+	// A this-week show elsewhere featuring an artist the user follows.
+	elsewhere := catalogm.Venue{Name: "Far Away Hall", City: "Elsewhere", State: "YY"}
+	s.Require().NoError(s.db.Create(&elsewhere).Error)
+	showID := s.createThisWeekShow(elsewhere.ID)
+	s.createArtist("Followed Band", time.Now().Add(-96*time.Hour))
+	var artistID uint
+	s.Require().NoError(s.db.Raw(`SELECT id FROM artists WHERE name = 'Followed Band'`).Scan(&artistID).Error)
+	s.Require().NoError(s.db.Exec(`INSERT INTO show_artists (show_id, artist_id, position) VALUES (?, ?, 0)`, showID, artistID).Error)
+	s.Require().NoError(s.db.Exec(
+		`INSERT INTO user_bookmarks (user_id, entity_type, entity_id, action, created_at) VALUES (?, 'artist', ?, 'follow', now())`,
+		userID, artistID).Error)
+
+	s.svc.RunDigestCycleNow()
+	s.Require().Len(s.mock.calls, 1)
+	s.Empty(s.mock.calls[0].Groups, "the empty scene contributes no section")
+	s.Require().Len(s.mock.calls[0].ArtistShows, 1)
+	s.Equal("Big Show", s.mock.calls[0].ArtistShows[0].DisplayTitle)
+	s.NotNil(s.cursorFor(userID, sceneID), "the artist section advances due follows")
+
+	// Restart: the snapshot section must not be re-sent.
+	s.mock.calls = nil
+	s.svc.RunDigestCycleNow()
+	s.Empty(s.mock.calls)
+}
+
+func (s *SceneDigestSuite) TestFollowedArtistShowsInFollowedScenesFirst() {
+	userID, _ := s.createUser()
+	sceneID, venueID := s.createScene()
+	s.setSceneDigestPref(userID, true)
+	s.followScene(userID, sceneID, nil, time.Now().Add(-24*time.Hour))
+
+	elsewhere := catalogm.Venue{Name: "Far Away Hall", City: "Elsewhere", State: "YY"}
+	s.Require().NoError(s.db.Create(&elsewhere).Error)
+	s.createArtist("Touring Band", time.Now().Add(-96*time.Hour))
+	var artistID uint
+	s.Require().NoError(s.db.Raw(`SELECT id FROM artists WHERE name = 'Touring Band'`).Scan(&artistID).Error)
+	s.Require().NoError(s.db.Exec(
+		`INSERT INTO user_bookmarks (user_id, entity_type, entity_id, action, created_at) VALUES (?, 'artist', ?, 'follow', now())`,
+		userID, artistID).Error)
+
+	// The away date is sooner, but the date in the followed scene leads.
+	for _, sh := range []struct {
+		title, city, state string
+		venueID            uint
+		in                 time.Duration
+	}{
+		{"Away Date", "Elsewhere", "YY", elsewhere.ID, 24 * time.Hour},
+		{"Home Date", "Testville", "ZZ", venueID, 72 * time.Hour},
+	} {
+		show := catalogm.Show{
+			Title:     sh.title,
+			EventDate: time.Now().UTC().Add(sh.in),
+			City:      strPtr(sh.city),
+			State:     strPtr(sh.state),
+			Status:    catalogm.ShowStatusApproved,
+		}
+		s.Require().NoError(s.db.Create(&show).Error)
+		s.Require().NoError(s.db.Exec(`INSERT INTO show_venues (show_id, venue_id) VALUES (?, ?)`, show.ID, sh.venueID).Error)
+		s.Require().NoError(s.db.Exec(`INSERT INTO show_artists (show_id, artist_id, position) VALUES (?, ?, 0)`, show.ID, artistID).Error)
+	}
+
+	s.svc.RunDigestCycleNow()
+	s.Require().Len(s.mock.calls, 1)
+	shows := s.mock.calls[0].ArtistShows
+	s.Require().Len(shows, 2)
+	s.Equal("Home Date", shows[0].DisplayTitle)
+	s.Empty(shows[0].Location, "a show in a followed scene needs no city")
+	s.Equal("Away Date", shows[1].DisplayTitle)
+	s.Equal("Elsewhere, YY", shows[1].Location)
+}
@@ -0,0 +1,313 @@
+package engagement
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	notificationm "psychic-homily-backend/internal/models/notification"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/utils"
+)
+
+// sent_notifications types for show status changes. Each goes out once per
+// user and channel, so re-cancelling with a new reason doesn't re-notify,
+// but a show postponed without a date notifies again once it's rescheduled.
+const (
+	showChangeCancelled   = "show_cancelled"
+	showChangePostponed   = "show_postponed"
+	showChangeRescheduled = "show_rescheduled"
+)
+
+// showChangeRecipient is a user who saved or RSVP'd to a show.
+type showChangeRecipient struct {
+	UserID uint
+	Email  *string
+}
+
+// ShowChangeNotificationService tells the users who saved or RSVP'd to a
+// show that it was cancelled or postponed, by email and (when wired) Web
+// Push, gated on the saved_show_change preference cells.
+type ShowChangeNotificationService struct {
+	db           *gorm.DB
+	emailService contracts.EmailServiceInterface
+	preferences  contracts.NotificationPreferenceServiceInterface
+	pushService  contracts.PushServiceInterface // optional; nil = email only
+	logger       *slog.Logger
+	frontendURL  string
+	backendURL   string
+	keys         *config.JWTKeyRing
+}
+
+// NewShowChangeNotificationService creates a new show change notifier
+func NewShowChangeNotificationService(database *gorm.DB, emailService contracts.EmailServiceInterface, preferences contracts.NotificationPreferenceServiceInterface, cfg *config.Config) *ShowChangeNotificationService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &ShowChangeNotificationService{
+		db:           database,
+		emailService: emailService,
+		preferences:  preferences,
+		logger:       slog.Default(),
+		frontendURL:  cfg.Email.FrontendURL,
+		backendURL:   DeriveBackendURL(cfg.Email.FrontendURL),
+		keys:         cfg.JWT.KeyRing(),
+	}
+}
+
+// SetPushService enables push delivery for users who turned on the
+// saved-show change push channel.
+func (s *ShowChangeNotificationService) SetPushService(pushService contracts.PushServiceInterface) {
+	s.pushService = pushService
+}
+
+// NotifyShowChange notifies the show's savers and RSVPs of its current
+// status: cancelled, or postponed (with the new date once rescheduled). A
+// show that is neither sends nothing. Delivery failures are logged, not
+// returned; the error is for failing to load the show or its audience.
+func (s *ShowChangeNotificationService) NotifyShowChange(showID, actorID uint) error {
+	var show catalogm.Show
+	if err := s.db.Preload("Venues").First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to load show: %w", err)
+	}
+
+	var notificationType string
+	switch {
+	case show.IsCancelled:
+		notificationType = showChangeCancelled
+	case show.IsPostponed && show.RescheduledShowID != nil:
+		notificationType = showChangeRescheduled
+	case show.IsPostponed:
+		notificationType = showChangePostponed
+	default:
+		return nil
+	}
+
+	recipients, err := s.showChangeRecipients(showID, actorID)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	change, err := s.buildShowChange(&show)
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]uint, len(recipients))
+	for i, r := range recipients {
+		userIDs[i] = r.UserID
+	}
+
+	sent := 0
+	if s.emailService != nil && s.emailService.IsConfigured() {
+		enabled, err := s.enabledUsers(userIDs, contracts.NotificationChannelEmail)
+		if err != nil {
+			return err
+		}
+		for _, r := range recipients {
+			if !enabled[r.UserID] || r.Email == nil || *r.Email == "" {
+				continue
+			}
+			if !s.claimSend(r.UserID, showID, notificationType, contracts.NotificationChannelEmail) {
+				continue
+			}
+			unsubscribeURL := GenerateScopedUnsubscribeURL(s.backendURL, r.UserID, UnsubscribeScopeSavedShowChanges, s.keys.Current().Secret)
+			if err := s.emailService.SendShowStatusChangeEmail(*r.Email, change, unsubscribeURL); err != nil {
+				s.logger.Error("failed to send show change email",
+					"user_id", r.UserID,
+					"show_id", showID,
+					"error", err,
+				)
+				continue
+			}
+			sent++
+		}
+	}
+
+	if s.pushService != nil && s.pushService.IsConfigured() {
+		enabled, err := s.enabledUsers(userIDs, contracts.NotificationChannelPush)
+		if err != nil {
+			return err
+		}
+		msg := showChangePushMessage(&show, change)
+		for _, r := range recipients {
+			if !enabled[r.UserID] {
+				continue
+			}
+			if !s.claimSend(r.UserID, showID, notificationType, contracts.NotificationChannelPush) {
+				continue
+			}
+			if _, err := s.pushService.SendToUser(r.UserID, msg); err != nil {
+				s.logger.Error("failed to push show change",
+					"user_id", r.UserID,
+					"show_id", showID,
+					"error", err,
+				)
+				continue
+			}
+			sent++
+		}
+	}
+
+	s.logger.Info("show change notifications sent",
+		"show_id", showID,
+		"type", notificationType,
+		"recipients", len(recipients),
+		"sent", sent,
+	)
+	return nil
+}
+
+// showChangeRecipients returns the active users who saved or RSVP'd to the
+// show, other than actorID.
+func (s *ShowChangeNotificationService) showChangeRecipients(showID, actorID uint) ([]showChangeRecipient, error) {
+	var recipients []showChangeRecipient
+	err := s.db.Raw(`
+		SELECT DISTINCT ub.user_id, u.email
+		FROM user_bookmarks ub
+		JOIN users u ON u.id = ub.user_id
+		WHERE ub.entity_type = ?
+			AND ub.action IN ?
+			AND ub.entity_id = ?
+			AND ub.user_id <> ?
+			AND u.is_active = true
+			AND u.deleted_at IS NULL
+		ORDER BY ub.user_id
+	`, string(engagementm.BookmarkEntityShow),
+		[]string{string(engagementm.BookmarkActionSave), string(engagementm.BookmarkActionGoing)},
+		showID, actorID).Scan(&recipients).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to query show savers: %w", err)
+	}
+	return recipients, nil
+}
+
+// buildShowChange describes the show's change for the email, with dates in
+// the first venue's zone.
+func (s *ShowChangeNotificationService) buildShowChange(show *catalogm.Show) (contracts.ShowStatusChange, error) {
+	var venueTimezone *string
+	state := ""
+	if show.State != nil {
+		state = *show.State
+	}
+	venueNames := make([]string, len(show.Venues))
+	for i, v := range show.Venues {
+		venueNames[i] = v.Name
+		if i == 0 {
+			venueTimezone = v.Timezone
+			state = v.State
+		}
+	}
+	loc := utils.EventLocation(venueTimezone, state)
+
+	change := contracts.ShowStatusChange{
+		ShowTitle: show.Title,
+		ShowURL:   s.showURL(show),
+		Date:      formatShowChangeDate(show.EventDate, loc),
+		VenueName: strings.Join(venueNames, ", "),
+		Cancelled: show.IsCancelled,
+	}
+	if show.StatusReason != nil {
+		change.Reason = *show.StatusReason
+	}
+
+	if !show.IsCancelled && show.RescheduledShowID != nil {
+		var rescheduled catalogm.Show
+		if err := s.db.First(&rescheduled, *show.RescheduledShowID).Error; err != nil {
+			return change, fmt.Errorf("failed to load rescheduled show: %w", err)
+		}
+		change.NewDate = formatShowChangeDate(rescheduled.EventDate, loc)
+		change.NewShowURL = s.showURL(&rescheduled)
+	}
+	return change, nil
+}
+
+func (s *ShowChangeNotificationService) showURL(show *catalogm.Show) string {
+	slug := fmt.Sprintf("%d", show.ID)
+	if show.Slug != nil && *show.Slug != "" {
+		slug = *show.Slug
+	}
+	return fmt.Sprintf("%s/shows/%s", s.frontendURL, slug)
+}
+
+func formatShowChangeDate(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format("Monday, January 2, 2006 at 3:04 PM")
+}
+
+// showChangePushMessage is the push for a change: "Cancelled" or
+// "Postponed", the new date if there is one, then the reason. It links to
+// the rescheduled show when there is one.
+func showChangePushMessage(show *catalogm.Show, change contracts.ShowStatusChange) contracts.PushMessage {
+	body := "Cancelled"
+	url := change.ShowURL
+	if !change.Cancelled {
+		body = "Postponed"
+		if change.NewDate != "" {
+			body += " to " + change.NewDate
+			url = change.NewShowURL
+		}
+	}
+	if change.Reason != "" {
+		body += " · " + change.Reason
+	}
+	return contracts.PushMessage{
+		Title: change.ShowTitle,
+		Body:  body,
+		URL:   url,
+		Tag:   fmt.Sprintf("show-%d", show.ID),
+	}
+}
+
+// enabledUsers returns which of userIDs have the saved_show_change cell
+// enabled on channel.
+func (s *ShowChangeNotificationService) enabledUsers(userIDs []uint, channel string) (map[uint]bool, error) {
+	ids, err := s.preferences.FilterEnabledUsers(userIDs, contracts.NotificationEventSavedShowChange, channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check %s preferences: %w", channel, err)
+	}
+	enabled := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		enabled[id] = true
+	}
+	return enabled, nil
+}
+
+// claimSend records the send before delivering, so concurrent or repeated
+// changes to the same show can't notify a user twice. False when it was
+// already sent or couldn't be recorded.
+func (s *ShowChangeNotificationService) claimSend(userID, showID uint, notificationType, channel string) bool {
+	record := notificationm.SentNotification{
+		UserID:           userID,
+		NotificationType: notificationType,
+		EntityType:       string(engagementm.BookmarkEntityShow),
+		EntityID:         showID,
+		Channel:          channel,
+		SentAt:           time.Now().UTC(),
+	}
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&record)
+	if result.Error != nil {
+		s.logger.Error("failed to record show change notification",
+			"user_id", userID,
+			"show_id", showID,
+			"channel", channel,
+			"error", result.Error,
+		)
+		return false
+	}
+	return result.RowsAffected > 0
+}
@@ -0,0 +1,252 @@
+package engagement
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/internal/config"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestShowChangePushMessage(t *testing.T) {
+	show := &catalogm.Show{ID: 4}
+	cases := []struct {
+		name     string
+		change   contracts.ShowStatusChange
+		wantBody string
+		wantURL  string
+	}{
+		{
+			name:     "cancelled with reason",
+			change:   contracts.ShowStatusChange{ShowURL: "/shows/a", Cancelled: true, Reason: "Venue flooded"},
+			wantBody: "Cancelled · Venue flooded",
+			wantURL:  "/shows/a",
+		},
+		{
+			name:     "postponed without a date",
+			change:   contracts.ShowStatusChange{ShowURL: "/shows/a"},
+			wantBody: "Postponed",
+			wantURL:  "/shows/a",
+		},
+		{
+			name:     "rescheduled links to the new show",
+			change:   contracts.ShowStatusChange{ShowURL: "/shows/a", NewDate: "Saturday, September 12", NewShowURL: "/shows/b"},
+			wantBody: "Postponed to Saturday, September 12",
+			wantURL:  "/shows/b",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := showChangePushMessage(show, tc.change)
+			assert.Equal(t, tc.wantBody, msg.Body)
+			assert.Equal(t, tc.wantURL, msg.URL)
+			assert.Equal(t, "show-4", msg.Tag)
+		})
+	}
+}
+
+// captureShowChangeEmailService records show status change emails.
+type captureShowChangeEmailService struct {
+	mockReminderEmailService
+	sent []showChangeEmailCall
+}
+
+type showChangeEmailCall struct {
+	ToEmail        string
+	Change         contracts.ShowStatusChange
+	UnsubscribeURL string
+}
+
+func (m *captureShowChangeEmailService) SendShowStatusChangeEmail(to string, change contracts.ShowStatusChange, unsubscribeURL string) error {
+	m.sent = append(m.sent, showChangeEmailCall{ToEmail: to, Change: change, UnsubscribeURL: unsubscribeURL})
+	return nil
+}
+
+// showChangePreferences answers the saved_show_change cells: email on
+// unless opted out, push only when opted in.
+type showChangePreferences struct {
+	mockReminderPreferences
+	emailOff map[uint]bool
+	pushOn   map[uint]bool
+}
+
+func (m *showChangePreferences) FilterEnabledUsers(userIDs []uint, eventType, channel string) ([]uint, error) {
+	if eventType != contracts.NotificationEventSavedShowChange {
+		return nil, fmt.Errorf("unexpected preference cell %s/%s", eventType, channel)
+	}
+	var enabled []uint
+	for _, id := range userIDs {
+		if channel == contracts.NotificationChannelPush && m.pushOn[id] ||
+			channel == contracts.NotificationChannelEmail && !m.emailOff[id] {
+			enabled = append(enabled, id)
+		}
+	}
+	return enabled, nil
+}
+
+type ShowChangeNotifyIntegrationTestSuite struct {
+	suite.Suite
+	testDB   *testutil.TestDatabase
+	db       *gorm.DB
+	email    *captureShowChangeEmailService
+	prefs    *showChangePreferences
+	push     *mockReminderPush
+	notifier *ShowChangeNotificationService
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) SetupSuite() {
+	s.testDB = testutil.SetupTestPostgres(s.T())
+	s.db = s.testDB.DB
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) SetupTest() {
+	s.email = &captureShowChangeEmailService{}
+	s.prefs = &showChangePreferences{emailOff: map[uint]bool{}, pushOn: map[uint]bool{}}
+	s.push = &mockReminderPush{noDevices: map[uint]bool{}}
+	s.notifier = NewShowChangeNotificationService(s.db, s.email, s.prefs, &config.Config{
+		Email: config.EmailConfig{FrontendURL: "https://test.psychichomily.com"},
+		JWT:   config.JWTConfig{SecretKey: testSecret},
+	})
+	s.notifier.SetPushService(s.push)
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) TearDownSuite() {
+	s.testDB.Cleanup()
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := s.db.DB()
+	s.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM sent_notifications")
+	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
+	_, _ = sqlDB.Exec("DELETE FROM show_venues")
+	_, _ = sqlDB.Exec("UPDATE shows SET rescheduled_show_id = NULL")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM venues")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestShowChangeNotifyIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShowChangeNotifyIntegrationTestSuite))
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) createUser() *authm.User {
+	user := &authm.User{
+		Email:    stringPtr(fmt.Sprintf("saver-%d@test.com", time.Now().UnixNano())),
+		IsActive: true,
+	}
+	s.Require().NoError(s.db.Create(user).Error)
+	return user
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) createShow(title string, eventDate time.Time) *catalogm.Show {
+	slug := fmt.Sprintf("show-%d", time.Now().UnixNano())
+	show := &catalogm.Show{
+		Title:     title,
+		Slug:      &slug,
+		EventDate: eventDate,
+		State:     stringPtr("AZ"),
+		Status:    catalogm.ShowStatusApproved,
+	}
+	s.Require().NoError(s.db.Create(show).Error)
+	tz := "America/Phoenix"
+	venue := &catalogm.Venue{Name: "Valley Bar", City: "Phoenix", State: "AZ", Timezone: &tz}
+	s.Require().NoError(s.db.Create(venue).Error)
+	s.Require().NoError(s.db.Create(&catalogm.ShowVenue{ShowID: show.ID, VenueID: venue.ID}).Error)
+	return show
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) bookmark(userID, showID uint, action engagementm.BookmarkAction) {
+	s.Require().NoError(s.db.Create(&engagementm.UserBookmark{
+		UserID:     userID,
+		EntityType: engagementm.BookmarkEntityShow,
+		EntityID:   showID,
+		Action:     action,
+	}).Error)
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) TestNotifiesSaversAndRSVPsOnce() {
+	saver, goer, both, actor := s.createUser(), s.createUser(), s.createUser(), s.createUser()
+	show := s.createShow("Cursive", time.Date(2030, 7, 5, 3, 0, 0, 0, time.UTC))
+	s.bookmark(saver.ID, show.ID, engagementm.BookmarkActionSave)
+	s.bookmark(goer.ID, show.ID, engagementm.BookmarkActionGoing)
+	s.bookmark(both.ID, show.ID, engagementm.BookmarkActionSave)
+	s.bookmark(both.ID, show.ID, engagementm.BookmarkActionGoing)
+	s.bookmark(actor.ID, show.ID, engagementm.BookmarkActionSave)
+	s.Require().NoError(s.db.Model(show).Updates(map[string]interface{}{
+		"is_cancelled": true, "status_reason": "Illness",
+	}).Error)
+
+	s.Require().NoError(s.notifier.NotifyShowChange(show.ID, actor.ID))
+
+	s.Require().Len(s.email.sent, 3, "savers and RSVPs once each, not the actor")
+	call := s.email.sent[0]
+	s.True(call.Change.Cancelled)
+	s.Equal("Illness", call.Change.Reason)
+	s.Equal("Valley Bar", call.Change.VenueName)
+	s.Equal("Thursday, July 4, 2030 at 8:00 PM", call.Change.Date, "dates are venue-local")
+	s.Contains(call.Change.ShowURL, *show.Slug)
+	s.Contains(call.UnsubscribeURL, "/unsubscribe/saved-show-changes")
+
+	// A second call (e.g. the reason was edited) doesn't re-notify.
+	s.Require().NoError(s.notifier.NotifyShowChange(show.ID, actor.ID))
+	s.Len(s.email.sent, 3)
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) TestRespectsPreferences() {
+	emailOnly, pushOnly := s.createUser(), s.createUser()
+	s.prefs.pushOn[emailOnly.ID] = false
+	s.prefs.emailOff[pushOnly.ID] = true
+	s.prefs.pushOn[pushOnly.ID] = true
+	show := s.createShow("Pile", time.Date(2030, 7, 5, 3, 0, 0, 0, time.UTC))
+	s.bookmark(emailOnly.ID, show.ID, engagementm.BookmarkActionSave)
+	s.bookmark(pushOnly.ID, show.ID, engagementm.BookmarkActionSave)
+	s.Require().NoError(s.db.Model(show).Update("is_postponed", true).Error)
+
+	s.Require().NoError(s.notifier.NotifyShowChange(show.ID, 0))
+
+	s.Require().Len(s.email.sent, 1)
+	s.Equal(*emailOnly.Email, s.email.sent[0].ToEmail)
+	s.False(s.email.sent[0].Change.Cancelled)
+	s.Require().Len(s.push.calls, 1)
+	s.Equal(pushOnly.ID, s.push.calls[0].UserID)
+	s.Equal("Postponed", s.push.calls[0].Message.Body)
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) TestRescheduleNotifiesAgainWithNewDate() {
+	user := s.createUser()
+	show := s.createShow("Chat Pile", time.Date(2030, 7, 5, 3, 0, 0, 0, time.UTC))
+	s.bookmark(user.ID, show.ID, engagementm.BookmarkActionSave)
+	s.Require().NoError(s.db.Model(show).Update("is_postponed", true).Error)
+	s.Require().NoError(s.notifier.NotifyShowChange(show.ID, 0))
+	s.Require().Len(s.email.sent, 1)
+	s.Empty(s.email.sent[0].Change.NewDate)
+
+	rescheduled := s.createShow("Chat Pile", time.Date(2030, 9, 13, 3, 0, 0, 0, time.UTC))
+	s.Require().NoError(s.db.Model(show).Update("rescheduled_show_id", rescheduled.ID).Error)
+	s.Require().NoError(s.notifier.NotifyShowChange(show.ID, 0))
+
+	s.Require().Len(s.email.sent, 2)
+	s.Equal("Thursday, September 12, 2030 at 8:00 PM", s.email.sent[1].Change.NewDate)
+	s.Contains(s.email.sent[1].Change.NewShowURL, *rescheduled.Slug)
+}
+
+func (s *ShowChangeNotifyIntegrationTestSuite) TestActiveShowSendsNothing() {
+	user := s.createUser()
+	show := s.createShow("Cursive", time.Date(2030, 7, 5, 3, 0, 0, 0, time.UTC))
+	s.bookmark(user.ID, show.ID, engagementm.BookmarkActionSave)
+
+	s.Require().NoError(s.notifier.NotifyShowChange(show.ID, 0))
+
+	s.Empty(s.email.sent)
+	s.Empty(s.push.calls)
+}
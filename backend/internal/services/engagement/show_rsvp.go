@@ -0,0 +1,234 @@
+package engagement
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// ShowRSVPService handles show RSVPs ("I'm going").
+// Backed by the generic user_bookmarks table (action = going) via
+// BookmarkService, so show merges and deletes carry RSVPs along with saves.
+type ShowRSVPService struct {
+	db         *gorm.DB
+	bookmark   *BookmarkService
+	savedShows *SavedShowService
+}
+
+// NewShowRSVPService creates a new show RSVP service
+func NewShowRSVPService(database *gorm.DB) *ShowRSVPService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &ShowRSVPService{
+		db:         database,
+		bookmark:   NewBookmarkService(database),
+		savedShows: NewSavedShowService(database),
+	}
+}
+
+// RSVP marks the user as going to a show. Idempotent.
+func (s *ShowRSVPService) RSVP(userID, showID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	var show catalogm.Show
+	if err := s.db.Where("deleted_at IS NULL").First(&show, showID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.ErrShowNotFound(showID)
+		}
+		return fmt.Errorf("failed to verify show: %w", err)
+	}
+	if show.Status != catalogm.ShowStatusApproved {
+		// Only public shows take RSVPs; the rest look missing, as they do
+		// on GET /shows/{show_id}.
+		return apperrors.ErrShowNotFound(showID)
+	}
+
+	if err := s.bookmark.CreateBookmark(userID, engagementm.BookmarkEntityShow, showID, engagementm.BookmarkActionGoing); err != nil {
+		return fmt.Errorf("failed to rsvp: %w", err)
+	}
+
+	return nil
+}
+
+// CancelRSVP removes the user's RSVP for a show
+func (s *ShowRSVPService) CancelRSVP(userID, showID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	err := s.bookmark.DeleteBookmark(userID, engagementm.BookmarkEntityShow, showID, engagementm.BookmarkActionGoing)
+	if err != nil {
+		if err.Error() == "bookmark not found" {
+			return fmt.Errorf("no rsvp for this show")
+		}
+		return fmt.Errorf("failed to cancel rsvp: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserRSVPs returns the shows a user RSVP'd to, most recent RSVP first
+func (s *ShowRSVPService) GetUserRSVPs(userID uint, limit, offset int) ([]*contracts.ShowRSVPResponse, int64, error) {
+	if s.db == nil {
+		return nil, 0, fmt.Errorf("database not initialized")
+	}
+
+	refs, total, err := s.savedShows.savedShowPage(userID, engagementm.BookmarkActionGoing, limit, offset, "")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get rsvps: %w", err)
+	}
+
+	shows, total, err := s.savedShows.hydrateSavedShows(refs, total)
+	if err != nil {
+		return nil, 0, err
+	}
+	responses := make([]*contracts.ShowRSVPResponse, len(shows))
+	for i, show := range shows {
+		responses[i] = &contracts.ShowRSVPResponse{
+			ShowResponse: show.ShowResponse,
+			RSVPAt:       show.SavedAt,
+		}
+	}
+
+	return responses, total, nil
+}
+
+// IsGoing reports whether the user RSVP'd to a show
+func (s *ShowRSVPService) IsGoing(userID, showID uint) (bool, error) {
+	if s.db == nil {
+		return false, fmt.Errorf("database not initialized")
+	}
+
+	return s.bookmark.IsBookmarked(userID, engagementm.BookmarkEntityShow, showID, engagementm.BookmarkActionGoing)
+}
+
+// GetGoingCount returns the public going count for a show
+func (s *ShowRSVPService) GetGoingCount(showID uint) (int, error) {
+	counts, err := s.GetBatchGoingCounts([]uint{showID})
+	if err != nil {
+		return 0, err
+	}
+	return counts[showID], nil
+}
+
+// GetBatchGoingCounts returns public going counts for multiple shows in a
+// single query. The count includes users whose RSVPs are not listed by name;
+// like the save count it is an aggregate that never identifies anyone. As
+// with saves, only APPROVED shows contribute, and every requested ID is
+// present in the map, zero-filled, so a hidden show is indistinguishable from
+// one nobody is going to.
+func (s *ShowRSVPService) GetBatchGoingCounts(showIDs []uint) (map[uint]int, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	result := make(map[uint]int, len(showIDs))
+	if len(showIDs) == 0 {
+		return result, nil
+	}
+	for _, id := range showIDs {
+		result[id] = 0
+	}
+
+	type countRow struct {
+		EntityID uint
+		Count    int
+	}
+	var rows []countRow
+
+	err := s.db.Model(&engagementm.UserBookmark{}).
+		Select("user_bookmarks.entity_id, COUNT(*) as count").
+		Joins("JOIN shows ON shows.id = user_bookmarks.entity_id").
+		Where("user_bookmarks.entity_type = ? AND user_bookmarks.entity_id IN ? AND user_bookmarks.action = ?",
+			engagementm.BookmarkEntityShow, showIDs, engagementm.BookmarkActionGoing,
+		).
+		Where("shows.status = ? AND shows.deleted_at IS NULL", catalogm.ShowStatusApproved).
+		Group("user_bookmarks.entity_id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch going counts: %w", err)
+	}
+
+	for _, row := range rows {
+		if _, requested := result[row.EntityID]; requested {
+			result[row.EntityID] = row.Count
+		}
+	}
+
+	return result, nil
+}
+
+// publicAttendeeSQL limits attendees to users who opted in to being listed
+// (rsvps privacy = visible, hidden when unset), whose profile is not private
+// and who have a username to link to.
+const publicAttendeeSQL = `users.deleted_at IS NULL
+	AND users.username IS NOT NULL AND users.username <> ''
+	AND users.profile_visibility <> 'private'
+	AND COALESCE(users.privacy_settings->>'rsvps', 'hidden') = 'visible'`
+
+// GetPublicAttendees returns the users going to an approved show who allow
+// their RSVP to be listed, most recent RSVP first
+func (s *ShowRSVPService) GetPublicAttendees(showID uint, limit, offset int) ([]*contracts.ShowAttendeeResponse, int64, error) {
+	if s.db == nil {
+		return nil, 0, fmt.Errorf("database not initialized")
+	}
+
+	// Fresh builder per query: GORM builders accumulate clauses, so Count and
+	// Find must not share one.
+	baseQuery := func() *gorm.DB {
+		return s.db.Table("user_bookmarks").
+			Joins("JOIN users ON users.id = user_bookmarks.user_id").
+			Joins("JOIN shows ON shows.id = user_bookmarks.entity_id").
+			Where("user_bookmarks.entity_type = ? AND user_bookmarks.entity_id = ? AND user_bookmarks.action = ?",
+				engagementm.BookmarkEntityShow, showID, engagementm.BookmarkActionGoing).
+			Where("shows.status = ? AND shows.deleted_at IS NULL", catalogm.ShowStatusApproved).
+			Where(publicAttendeeSQL)
+	}
+
+	var total int64
+	if err := baseQuery().Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count attendees: %w", err)
+	}
+	if total == 0 {
+		return []*contracts.ShowAttendeeResponse{}, 0, nil
+	}
+
+	type attendeeRow struct {
+		UserID      uint
+		Username    string
+		DisplayName *string
+	}
+	var rows []attendeeRow
+	err := baseQuery().
+		Select("user_bookmarks.user_id, users.username, COALESCE(NULLIF(users.display_name, ''), users.first_name) as display_name").
+		Order("user_bookmarks.created_at DESC, user_bookmarks.id DESC").
+		Limit(limit).Offset(offset).
+		Find(&rows).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get attendees: %w", err)
+	}
+
+	responses := make([]*contracts.ShowAttendeeResponse, 0, len(rows))
+	for _, row := range rows {
+		resp := &contracts.ShowAttendeeResponse{
+			UserID:   row.UserID,
+			Username: row.Username,
+		}
+		if row.DisplayName != nil {
+			resp.DisplayName = *row.DisplayName
+		}
+		responses = append(responses, resp)
+	}
+
+	return responses, total, nil
+}
@@ -0,0 +1,218 @@
+package engagement
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func TestShowRSVPService_NilDatabase(t *testing.T) {
+	svc := &ShowRSVPService{}
+
+	if err := svc.RSVP(1, 1); err == nil {
+		t.Error("expected error for nil database")
+	}
+	if err := svc.CancelRSVP(1, 1); err == nil {
+		t.Error("expected error for nil database")
+	}
+	if _, _, err := svc.GetUserRSVPs(1, 10, 0); err == nil {
+		t.Error("expected error for nil database")
+	}
+	if _, err := svc.IsGoing(1, 1); err == nil {
+		t.Error("expected error for nil database")
+	}
+	if _, err := svc.GetBatchGoingCounts([]uint{1}); err == nil {
+		t.Error("expected error for nil database")
+	}
+	if _, _, err := svc.GetPublicAttendees(1, 10, 0); err == nil {
+		t.Error("expected error for nil database")
+	}
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type ShowRSVPServiceIntegrationTestSuite struct {
+	suite.Suite
+	testDB      *testutil.TestDatabase
+	db          *gorm.DB
+	rsvpService *ShowRSVPService
+	savedShows  *SavedShowService
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) SetupSuite() {
+	suite.testDB = testutil.SetupTestPostgres(suite.T())
+	suite.db = suite.testDB.DB
+
+	suite.rsvpService = NewShowRSVPService(suite.testDB.DB)
+	suite.savedShows = NewSavedShowService(suite.testDB.DB)
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TearDownSuite() {
+	suite.testDB.Cleanup()
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM user_bookmarks")
+	_, _ = sqlDB.Exec("DELETE FROM shows")
+	_, _ = sqlDB.Exec("DELETE FROM users")
+}
+
+func TestShowRSVPServiceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShowRSVPServiceIntegrationTestSuite))
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) createUser(username string, listed bool) *authm.User {
+	user := &authm.User{
+		Email:         stringPtr(fmt.Sprintf("%s-%d@test.com", username, time.Now().UnixNano())),
+		Username:      stringPtr(username),
+		FirstName:     stringPtr("Test"),
+		IsActive:      true,
+		EmailVerified: true,
+	}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	if listed {
+		suite.Require().NoError(suite.db.Exec(
+			`UPDATE users SET privacy_settings = privacy_settings || '{"rsvps":"visible"}' WHERE id = ?`, user.ID,
+		).Error)
+	}
+	return user
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) createShow(title string, status catalogm.ShowStatus, userID uint) *catalogm.Show {
+	show := &catalogm.Show{
+		Title:       title,
+		EventDate:   time.Now().UTC().AddDate(0, 0, 7),
+		Status:      status,
+		SubmittedBy: &userID,
+	}
+	suite.Require().NoError(suite.db.Create(show).Error)
+	return show
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TestRSVP_IsSeparateFromSave() {
+	user := suite.createUser("goer", false)
+	show := suite.createShow("Show", catalogm.ShowStatusApproved, user.ID)
+
+	suite.Require().NoError(suite.rsvpService.RSVP(user.ID, show.ID))
+	// Idempotent
+	suite.Require().NoError(suite.rsvpService.RSVP(user.ID, show.ID))
+
+	going, err := suite.rsvpService.IsGoing(user.ID, show.ID)
+	suite.Require().NoError(err)
+	suite.True(going)
+
+	saved, err := suite.savedShows.IsShowSaved(user.ID, show.ID)
+	suite.Require().NoError(err)
+	suite.False(saved, "an RSVP must not also save the show")
+
+	saveCount, err := suite.savedShows.GetSaveCount(show.ID)
+	suite.Require().NoError(err)
+	suite.Equal(0, saveCount)
+
+	goingCount, err := suite.rsvpService.GetGoingCount(show.ID)
+	suite.Require().NoError(err)
+	suite.Equal(1, goingCount)
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TestRSVP_UnknownOrUnapprovedShow() {
+	user := suite.createUser("goer", false)
+	pending := suite.createShow("Pending", catalogm.ShowStatusPending, user.ID)
+
+	for _, showID := range []uint{pending.ID, 999999} {
+		err := suite.rsvpService.RSVP(user.ID, showID)
+		suite.Require().Error(err)
+		var showErr *apperrors.ShowError
+		suite.Require().ErrorAs(err, &showErr)
+		suite.Equal(apperrors.CodeShowNotFound, showErr.Code)
+	}
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TestCancelRSVP() {
+	user := suite.createUser("goer", false)
+	show := suite.createShow("Show", catalogm.ShowStatusApproved, user.ID)
+
+	suite.Require().Error(suite.rsvpService.CancelRSVP(user.ID, show.ID))
+
+	suite.Require().NoError(suite.rsvpService.RSVP(user.ID, show.ID))
+	suite.Require().NoError(suite.rsvpService.CancelRSVP(user.ID, show.ID))
+
+	going, err := suite.rsvpService.IsGoing(user.ID, show.ID)
+	suite.Require().NoError(err)
+	suite.False(going)
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TestGetUserRSVPs() {
+	user := suite.createUser("goer", false)
+	first := suite.createShow("First", catalogm.ShowStatusApproved, user.ID)
+	second := suite.createShow("Second", catalogm.ShowStatusApproved, user.ID)
+	saved := suite.createShow("Saved Only", catalogm.ShowStatusApproved, user.ID)
+
+	suite.Require().NoError(suite.rsvpService.RSVP(user.ID, first.ID))
+	suite.Require().NoError(suite.rsvpService.RSVP(user.ID, second.ID))
+	suite.Require().NoError(suite.savedShows.SaveShow(user.ID, saved.ID))
+
+	shows, total, err := suite.rsvpService.GetUserRSVPs(user.ID, 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), total)
+	suite.Require().Len(shows, 2)
+	suite.Equal(second.ID, shows[0].ID, "most recent RSVP first")
+	suite.Equal(first.ID, shows[1].ID)
+	suite.False(shows[0].RSVPAt.IsZero())
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TestGetBatchGoingCounts_OnlyApprovedShows() {
+	user := suite.createUser("goer", false)
+	other := suite.createUser("other", false)
+	approved := suite.createShow("Approved", catalogm.ShowStatusApproved, user.ID)
+	rejected := suite.createShow("Rejected", catalogm.ShowStatusApproved, user.ID)
+
+	suite.Require().NoError(suite.rsvpService.RSVP(user.ID, approved.ID))
+	suite.Require().NoError(suite.rsvpService.RSVP(other.ID, approved.ID))
+	suite.Require().NoError(suite.rsvpService.RSVP(user.ID, rejected.ID))
+	suite.Require().NoError(suite.db.Model(&catalogm.Show{}).
+		Where("id = ?", rejected.ID).Update("status", catalogm.ShowStatusRejected).Error)
+
+	counts, err := suite.rsvpService.GetBatchGoingCounts([]uint{approved.ID, rejected.ID, 999999})
+	suite.Require().NoError(err)
+	suite.Equal(2, counts[approved.ID])
+	suite.Equal(0, counts[rejected.ID])
+	suite.Equal(0, counts[999999])
+}
+
+func (suite *ShowRSVPServiceIntegrationTestSuite) TestGetPublicAttendees_RespectsPrivacy() {
+	listed := suite.createUser("listed", true)
+	unlisted := suite.createUser("unlisted", false)
+	private := suite.createUser("private", true)
+	suite.Require().NoError(suite.db.Model(&authm.User{}).
+		Where("id = ?", private.ID).Update("profile_visibility", "private").Error)
+	show := suite.createShow("Show", catalogm.ShowStatusApproved, listed.ID)
+
+	for _, u := range []*authm.User{listed, unlisted, private} {
+		suite.Require().NoError(suite.rsvpService.RSVP(u.ID, show.ID))
+	}
+
+	attendees, total, err := suite.rsvpService.GetPublicAttendees(show.ID, 10, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Require().Len(attendees, 1)
+	suite.Equal(listed.ID, attendees[0].UserID)
+	suite.Equal("listed", attendees[0].Username)
+	suite.Equal("Test", attendees[0].DisplayName)
+
+	// Unlisted attendees still count toward the aggregate.
+	count, err := suite.rsvpService.GetGoingCount(show.ID)
+	suite.Require().NoError(err)
+	suite.Equal(3, count)
+}
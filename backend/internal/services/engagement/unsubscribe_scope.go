@@ -18,6 +18,7 @@ const (
 	UnsubscribeScopeMention           = "mention"
 	UnsubscribeScopeCollectionDigest  = "collection-digest"
 	UnsubscribeScopeSceneDigest       = "scene-digest"
+	UnsubscribeScopeSavedShowChanges  = "saved-show-changes"
 )
 
 // ComputeScopedUnsubscribeSignature computes HMAC-SHA256 over
@@ -103,7 +103,7 @@ func (s *ExploreService) GetUpcomingShows(limit, offset int, cities []contracts.
 	var total int64
 	countQuery := s.applyUpcomingCityFilter(
 		s.db.Model(&catalogm.Show{}).
-			Where("event_date >= ? AND status = ?", now, catalogm.ShowStatusApproved),
+			Where("event_date >= ? AND status = ? AND deleted_at IS NULL", now, catalogm.ShowStatusApproved),
 		cities,
 	)
 	if err := countQuery.Count(&total).Error; err != nil {
@@ -112,7 +112,7 @@ func (s *ExploreService) GetUpcomingShows(limit, offset int, cities []contracts.
 
 	var shows []catalogm.Show
 	dataQuery := s.applyUpcomingCityFilter(
-		s.db.Where("event_date >= ? AND status = ?", now, catalogm.ShowStatusApproved),
+		s.db.Where("event_date >= ? AND status = ? AND deleted_at IS NULL", now, catalogm.ShowStatusApproved),
 		cities,
 	)
 	if err := dataQuery.
@@ -348,7 +348,7 @@ func (s *ExploreService) GetShuffleTarget() (*contracts.ExploreShuffleTargetResp
 	subquery := s.db.Table("show_artists AS sa").
 		Select("DISTINCT sa.artist_id").
 		Joins("JOIN shows ON shows.id = sa.show_id").
-		Where("shows.event_date >= ? AND shows.event_date <= ? AND shows.status = ?",
+		Where("shows.event_date >= ? AND shows.event_date <= ? AND shows.status = ? AND shows.deleted_at IS NULL",
 			windowStart, windowEnd, catalogm.ShowStatusApproved)
 
 	var picked row
@@ -0,0 +1,238 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"psychic-homily-backend/internal/httpclient"
+)
+
+// Address-geocoding providers selectable via GEOCODING_PROVIDER.
+const (
+	// ProviderNominatim is OpenStreetMap's Nominatim (public or self-hosted).
+	// No key; the public instance allows at most 1 request/second.
+	ProviderNominatim = "nominatim"
+	// ProviderMapbox is the Mapbox Geocoding v6 API. Requires an access token.
+	ProviderMapbox = "mapbox"
+)
+
+// Providers lists the accepted GEOCODING_PROVIDER values.
+var Providers = []string{ProviderNominatim, ProviderMapbox}
+
+const (
+	defaultNominatimURL = "https://nominatim.openstreetmap.org"
+	defaultMapboxURL    = "https://api.mapbox.com"
+
+	geocodeUserAgent      = "PsychicHomily/1.0 (venue-geocoding; https://psychichomily.com)"
+	addressGeocodeTimeout = 10 * time.Second
+	// nominatimMinInterval honours the public instance's usage policy.
+	nominatimMinInterval = time.Second
+)
+
+// DefaultProviderURL returns the base URL used when GEOCODING_BASE_URL is unset.
+func DefaultProviderURL(provider string) string {
+	switch provider {
+	case ProviderNominatim:
+		return defaultNominatimURL
+	case ProviderMapbox:
+		return defaultMapboxURL
+	}
+	return ""
+}
+
+// Point is a WGS84 coordinate.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// AddressQuery is a structured street address to geocode.
+type AddressQuery struct {
+	Street  string
+	City    string
+	State   string
+	Zipcode string
+	Country string
+}
+
+// AddressGeocoder resolves a street address to a rooftop/street-level point —
+// finer than the offline city-centroid Geocoder, at the cost of a network call.
+type AddressGeocoder interface {
+	// GeocodeAddress returns ok=false with a nil error when the provider has no
+	// match; err is reserved for transport/provider failures.
+	GeocodeAddress(ctx context.Context, q AddressQuery) (Point, bool, error)
+}
+
+// NewAddressGeocoder builds the configured provider client. An empty provider
+// returns (nil, nil): address geocoding is optional and callers keep the
+// offline city centroid. baseURL overrides the provider's default endpoint
+// (e.g. a self-hosted Nominatim).
+func NewAddressGeocoder(provider, baseURL, apiKey string) (AddressGeocoder, error) {
+	if provider == "" {
+		return nil, nil
+	}
+	if baseURL == "" {
+		baseURL = DefaultProviderURL(provider)
+	}
+	baseURL = strings.TrimRight(baseURL, "/")
+	client := httpclient.New(addressGeocodeTimeout)
+
+	switch provider {
+	case ProviderNominatim:
+		return &nominatimGeocoder{baseURL: baseURL, client: client}, nil
+	case ProviderMapbox:
+		if apiKey == "" {
+			return nil, fmt.Errorf("geocoding provider %q requires an API key", provider)
+		}
+		return &mapboxGeocoder{baseURL: baseURL, apiKey: apiKey, client: client}, nil
+	}
+	return nil, fmt.Errorf("unknown geocoding provider %q (want one of: %s)", provider, strings.Join(Providers, ", "))
+}
+
+// getJSON performs a GET and decodes a 200 response into out.
+func getJSON(ctx context.Context, client *http.Client, rawURL string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	// Nominatim's usage policy requires an identifying User-Agent.
+	req.Header.Set("User-Agent", geocodeUserAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("geocoding provider returned status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// validPoint rejects out-of-range coordinates from a misbehaving provider.
+func validPoint(p Point) bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// nominatimGeocoder queries Nominatim's structured search.
+type nominatimGeocoder struct {
+	baseURL string
+	client  *http.Client
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// throttle serializes calls so the process stays under 1 request/second.
+func (g *nominatimGeocoder) throttle(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if wait := nominatimMinInterval - time.Since(g.last); wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	g.last = time.Now()
+	return nil
+}
+
+func (g *nominatimGeocoder) GeocodeAddress(ctx context.Context, q AddressQuery) (Point, bool, error) {
+	params := url.Values{}
+	params.Set("format", "jsonv2")
+	params.Set("limit", "1")
+	setIfNotEmpty(params, "street", q.Street)
+	setIfNotEmpty(params, "city", q.City)
+	setIfNotEmpty(params, "state", q.State)
+	setIfNotEmpty(params, "postalcode", q.Zipcode)
+	setIfNotEmpty(params, "country", q.Country)
+
+	if err := g.throttle(ctx); err != nil {
+		return Point{}, false, err
+	}
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := getJSON(ctx, g.client, g.baseURL+"/search?"+params.Encode(), &results); err != nil {
+		return Point{}, false, fmt.Errorf("nominatim: %w", err)
+	}
+	if len(results) == 0 {
+		return Point{}, false, nil
+	}
+	lat, latErr := strconv.ParseFloat(results[0].Lat, 64)
+	lng, lngErr := strconv.ParseFloat(results[0].Lon, 64)
+	if latErr != nil || lngErr != nil {
+		return Point{}, false, fmt.Errorf("nominatim: malformed coordinates %q,%q", results[0].Lat, results[0].Lon)
+	}
+	p := Point{Latitude: lat, Longitude: lng}
+	if !validPoint(p) {
+		return Point{}, false, fmt.Errorf("nominatim: coordinates out of range %v", p)
+	}
+	return p, true, nil
+}
+
+// mapboxGeocoder queries Mapbox Geocoding v6 structured input.
+type mapboxGeocoder struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func (g *mapboxGeocoder) GeocodeAddress(ctx context.Context, q AddressQuery) (Point, bool, error) {
+	params := url.Values{}
+	params.Set("access_token", g.apiKey)
+	params.Set("limit", "1")
+	params.Set("types", "address,street,place")
+	setIfNotEmpty(params, "address_line1", q.Street)
+	setIfNotEmpty(params, "place", q.City)
+	setIfNotEmpty(params, "region", q.State)
+	setIfNotEmpty(params, "postcode", q.Zipcode)
+	setIfNotEmpty(params, "country", q.Country)
+
+	var result struct {
+		Features []struct {
+			Geometry struct {
+				// Coordinates is GeoJSON order: [longitude, latitude].
+				Coordinates []float64 `json:"coordinates"`
+			} `json:"geometry"`
+		} `json:"features"`
+	}
+	if err := getJSON(ctx, g.client, g.baseURL+"/search/geocode/v6/forward?"+params.Encode(), &result); err != nil {
+		// Never surface the token-bearing URL in logs.
+		return Point{}, false, fmt.Errorf("mapbox: %w", redactURLError(err))
+	}
+	if len(result.Features) == 0 || len(result.Features[0].Geometry.Coordinates) < 2 {
+		return Point{}, false, nil
+	}
+	coords := result.Features[0].Geometry.Coordinates
+	p := Point{Latitude: coords[1], Longitude: coords[0]}
+	if !validPoint(p) {
+		return Point{}, false, fmt.Errorf("mapbox: coordinates out of range %v", p)
+	}
+	return p, true, nil
+}
+
+// redactURLError strips the request URL (which carries the access token) from
+// an http.Client error.
+func redactURLError(err error) error {
+	if ue, ok := err.(*url.Error); ok {
+		return fmt.Errorf("%s request failed: %w", ue.Op, ue.Err)
+	}
+	return err
+}
+
+func setIfNotEmpty(params url.Values, key, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		params.Set(key, value)
+	}
+}
@@ -0,0 +1,104 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewAddressGeocoder(t *testing.T) {
+	g, err := NewAddressGeocoder("", "", "")
+	if err != nil || g != nil {
+		t.Errorf("empty provider = (%v, %v), want (nil, nil)", g, err)
+	}
+	if _, err := NewAddressGeocoder("gooogle", "", ""); err == nil {
+		t.Error("expected error for unknown provider")
+	}
+	if _, err := NewAddressGeocoder(ProviderMapbox, "", ""); err == nil {
+		t.Error("expected error for mapbox without an API key")
+	}
+	if g, err := NewAddressGeocoder(ProviderNominatim, "", ""); err != nil || g == nil {
+		t.Errorf("nominatim = (%v, %v), want a geocoder", g, err)
+	}
+}
+
+func TestNominatimGeocoder(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		if r.URL.Path != "/search" {
+			t.Errorf("path = %q, want /search", r.URL.Path)
+		}
+		if r.Header.Get("User-Agent") == "" {
+			t.Error("missing User-Agent")
+		}
+		if r.URL.Query().Get("street") == "nowhere" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"lat":"33.4495","lon":"-112.0673"}]`))
+	}))
+	defer srv.Close()
+
+	g, err := NewAddressGeocoder(ProviderNominatim, srv.URL+"/", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, ok, err := g.GeocodeAddress(context.Background(), AddressQuery{Street: "401 W Van Buren St", City: "Phoenix", State: "AZ", Zipcode: "85003"})
+	if err != nil || !ok {
+		t.Fatalf("GeocodeAddress = (%v, %v), want a hit", ok, err)
+	}
+	if p.Latitude != 33.4495 || p.Longitude != -112.0673 {
+		t.Errorf("point = %+v", p)
+	}
+	for _, want := range []string{"street=401+W+Van+Buren+St", "city=Phoenix", "state=AZ", "postalcode=85003"} {
+		if !strings.Contains(gotQuery, want) {
+			t.Errorf("query %q missing %q", gotQuery, want)
+		}
+	}
+	if strings.Contains(gotQuery, "country=") {
+		t.Errorf("empty fields should be omitted, got %q", gotQuery)
+	}
+
+	// Skip the 1 req/s throttle between calls in the test.
+	g.(*nominatimGeocoder).last = g.(*nominatimGeocoder).last.Add(-nominatimMinInterval)
+	_, ok, err = g.GeocodeAddress(context.Background(), AddressQuery{Street: "nowhere"})
+	if err != nil || ok {
+		t.Errorf("miss = (%v, %v), want (false, nil)", ok, err)
+	}
+}
+
+func TestMapboxGeocoder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("access_token") != "tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"features":[{"geometry":{"coordinates":[-112.0673,33.4495]}}]}`))
+	}))
+	defer srv.Close()
+
+	g, err := NewAddressGeocoder(ProviderMapbox, srv.URL, "tok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, ok, err := g.GeocodeAddress(context.Background(), AddressQuery{Street: "401 W Van Buren St", City: "Phoenix"})
+	if err != nil || !ok {
+		t.Fatalf("GeocodeAddress = (%v, %v), want a hit", ok, err)
+	}
+	if p.Latitude != 33.4495 || p.Longitude != -112.0673 {
+		t.Errorf("point = %+v (GeoJSON is lng,lat)", p)
+	}
+
+	bad, _ := NewAddressGeocoder(ProviderMapbox, srv.URL, "wrong")
+	_, _, err = bad.GeocodeAddress(context.Background(), AddressQuery{City: "Phoenix"})
+	if err == nil {
+		t.Fatal("expected error for non-200")
+	}
+	if strings.Contains(err.Error(), "wrong") {
+		t.Errorf("error leaks the access token: %v", err)
+	}
+}
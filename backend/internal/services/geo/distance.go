@@ -0,0 +1,54 @@
+package geo
+
+import "math"
+
+// EarthRadiusKm is the mean Earth radius used for great-circle distances.
+const EarthRadiusKm = 6371.0
+
+// kmPerDegreeLat is the length of one degree of latitude.
+const kmPerDegreeLat = 111.32
+
+// HaversineKm returns the great-circle distance between two points in km.
+// Mirrors the SQL used by the shows near-filter so tests can assert against it.
+func HaversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// BoundingBox is a lat/lng rectangle enclosing a search radius. It is a cheap,
+// index-friendly prefilter; the exact distance check still has to run after.
+type BoundingBox struct {
+	MinLat, MaxLat float64
+	MinLng, MaxLng float64
+	// SpansAllLng is true when the radius reaches a pole or the box would cross
+	// the antimeridian; callers should skip the longitude bounds then.
+	SpansAllLng bool
+}
+
+// BoundingBoxAround returns the box enclosing every point within radiusKm of
+// (lat, lng).
+func BoundingBoxAround(lat, lng, radiusKm float64) BoundingBox {
+	dLat := radiusKm / kmPerDegreeLat
+	box := BoundingBox{
+		MinLat: math.Max(-90, lat-dLat),
+		MaxLat: math.Min(90, lat+dLat),
+	}
+	cosLat := math.Cos(toRadians(lat))
+	if box.MinLat <= -90 || box.MaxLat >= 90 || cosLat <= 0 {
+		box.SpansAllLng = true
+		return box
+	}
+	dLng := radiusKm / (kmPerDegreeLat * cosLat)
+	box.MinLng, box.MaxLng = lng-dLng, lng+dLng
+	if box.MinLng < -180 || box.MaxLng > 180 {
+		box.SpansAllLng = true
+	}
+	return box
+}
@@ -0,0 +1,44 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHaversineKm(t *testing.T) {
+	// Phoenix -> Tucson is ~171 km as the crow flies.
+	d := HaversineKm(33.4484, -112.0740, 32.2226, -110.9747)
+	if math.Abs(d-171) > 2 {
+		t.Errorf("Phoenix->Tucson = %.1f km, want ~171", d)
+	}
+	if got := HaversineKm(33.4484, -112.0740, 33.4484, -112.0740); got != 0 {
+		t.Errorf("same point = %v, want 0", got)
+	}
+}
+
+func TestBoundingBoxAround(t *testing.T) {
+	box := BoundingBoxAround(33.4484, -112.0740, 50)
+	if box.SpansAllLng {
+		t.Fatal("mid-latitude box should bound longitude")
+	}
+	// Every point on the circle must fall inside the box.
+	for _, p := range [][2]float64{
+		{33.4484 + 50/kmPerDegreeLat, -112.0740},
+		{33.4484 - 50/kmPerDegreeLat, -112.0740},
+	} {
+		if p[0] < box.MinLat-1e-9 || p[0] > box.MaxLat+1e-9 {
+			t.Errorf("latitude %v outside [%v, %v]", p[0], box.MinLat, box.MaxLat)
+		}
+	}
+	east := HaversineKm(33.4484, -112.0740, 33.4484, box.MaxLng)
+	if math.Abs(east-50) > 1 {
+		t.Errorf("box east edge is %.1f km away, want ~50", east)
+	}
+
+	if !BoundingBoxAround(89.9, 0, 50).SpansAllLng {
+		t.Error("box reaching the pole should span all longitudes")
+	}
+	if !BoundingBoxAround(0, 179.9, 50).SpansAllLng {
+		t.Error("box crossing the antimeridian should span all longitudes")
+	}
+}
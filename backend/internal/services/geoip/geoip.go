@@ -0,0 +1,114 @@
+// Package geoip infers a coarse location (city, state, country) from a client
+// IP address using a local MaxMind-format database such as GeoLite2-City.
+// Lookups are offline and in-memory; the database file is supplied by the
+// deployment (GEOIP_DATABASE_PATH) and is not shipped with the repo.
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"os"
+)
+
+// Location is what the database knows about an address. Fields the database
+// does not carry (e.g. City in a country-level DB) are left empty.
+type Location struct {
+	City string
+	// State is the first-level subdivision ISO code, e.g. "AZ".
+	State string
+	// Country is the ISO 3166-1 alpha-2 code, e.g. "US".
+	Country string
+
+	HasCoordinates bool
+	Latitude       float64
+	Longitude      float64
+}
+
+// Locator resolves a client IP to a Location.
+type Locator interface {
+	// Lookup returns ok=false with a nil error when the address is unknown or
+	// not publicly routable; err is reserved for a corrupt database.
+	Lookup(ip net.IP) (Location, bool, error)
+}
+
+// Reader is a Locator backed by an in-memory MaxMind DB.
+type Reader struct {
+	db *mmdb
+}
+
+// Open loads the database at path into memory.
+func Open(path string) (*Reader, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read geoip database: %w", err)
+	}
+	r, err := FromBytes(buf)
+	if err != nil {
+		return nil, fmt.Errorf("open geoip database %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// FromBytes parses a database already held in memory.
+func FromBytes(buf []byte) (*Reader, error) {
+	db, err := parseMMDB(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{db: db}, nil
+}
+
+// DatabaseType returns the database_type from the file's metadata, e.g.
+// "GeoLite2-City".
+func (r *Reader) DatabaseType() string {
+	return r.db.dbType
+}
+
+// Lookup implements Locator.
+func (r *Reader) Lookup(ip net.IP) (Location, bool, error) {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() {
+		return Location{}, false, nil
+	}
+	raw, ok, err := r.db.lookup(ip)
+	if err != nil || !ok {
+		return Location{}, false, err
+	}
+	record, _ := raw.(map[string]interface{})
+	if record == nil {
+		return Location{}, false, nil
+	}
+
+	var loc Location
+	loc.City = englishName(record["city"])
+	if subs, _ := record["subdivisions"].([]interface{}); len(subs) > 0 {
+		loc.State = stringField(subs[0], "iso_code")
+	}
+	loc.Country = stringField(record["country"], "iso_code")
+	if l, _ := record["location"].(map[string]interface{}); l != nil {
+		lat, latOK := l["latitude"].(float64)
+		lng, lngOK := l["longitude"].(float64)
+		if latOK && lngOK {
+			loc.HasCoordinates = true
+			loc.Latitude, loc.Longitude = lat, lng
+		}
+	}
+	return loc, true, nil
+}
+
+// englishName reads names.en from a GeoLite2 place record.
+func englishName(v interface{}) string {
+	m, _ := v.(map[string]interface{})
+	if m == nil {
+		return ""
+	}
+	return stringField(m["names"], "en")
+}
+
+func stringField(v interface{}, key string) string {
+	m, _ := v.(map[string]interface{})
+	if m == nil {
+		return ""
+	}
+	s, _ := m[key].(string)
+	return s
+}
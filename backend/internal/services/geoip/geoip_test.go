@@ -0,0 +1,281 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- test database writer ---
+
+// encoder writes MaxMind DB data-section values.
+type encoder struct {
+	bytes.Buffer
+}
+
+func (e *encoder) ctrl(typ, size int) {
+	var sizeBytes []byte
+	switch {
+	case size < 29:
+	case size < 285:
+		sizeBytes = []byte{byte(size - 29)}
+		size = 29
+	default:
+		v := size - 285
+		sizeBytes = []byte{byte(v >> 8), byte(v)}
+		size = 30
+	}
+	if typ > 7 {
+		e.WriteByte(byte(size))
+		e.WriteByte(byte(typ - 7))
+	} else {
+		e.WriteByte(byte(typ<<5 | size))
+	}
+	e.Write(sizeBytes)
+}
+
+func (e *encoder) value(v interface{}) {
+	switch v := v.(type) {
+	case string:
+		e.ctrl(typeString, len(v))
+		e.WriteString(v)
+	case float64:
+		e.ctrl(typeDouble, 8)
+		_ = binary.Write(e, binary.BigEndian, math.Float64bits(v))
+	case uint32:
+		e.ctrl(typeUint32, 4)
+		_ = binary.Write(e, binary.BigEndian, v)
+	case uint16:
+		e.ctrl(typeUint16, 2)
+		_ = binary.Write(e, binary.BigEndian, v)
+	case pointerTo:
+		// 1-byte form: 11 bits of offset.
+		e.WriteByte(byte(typePointer<<5 | int(v>>8)&0x7))
+		e.WriteByte(byte(v))
+	case []interface{}:
+		e.ctrl(typeArray, len(v))
+		for _, x := range v {
+			e.value(x)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.ctrl(typeMap, len(keys))
+		for _, k := range keys {
+			e.value(k)
+			e.value(v[k])
+		}
+	default:
+		panic("unsupported test value")
+	}
+}
+
+// pointerTo encodes a data-section pointer to the given offset.
+type pointerTo uint16
+
+// buildDB returns a record-size-24 database mapping one network to record.
+// dataPrefix is written at the start of the data section (for pointer targets);
+// record is written after it.
+func buildDB(t *testing.T, ipVersion int, network string, dataPrefix, record interface{}) []byte {
+	t.Helper()
+	_, ipnet, err := net.ParseCIDR(network)
+	require.NoError(t, err)
+	ones, _ := ipnet.Mask.Size()
+	key := []byte(ipnet.IP)
+	if ipVersion == 6 && len(key) == 4 {
+		// IPv4 networks live under ::/96 in an IPv6 tree.
+		key = append(make([]byte, 12), key...)
+		ones += 96
+	}
+
+	var data encoder
+	if dataPrefix != nil {
+		data.value(dataPrefix)
+	}
+	recordOffset := data.Len()
+	data.value(record)
+
+	// One node per prefix bit: the matching branch descends, the other is
+	// "not found" (== nodeCount). The last node's matching branch is the leaf.
+	nodeCount := ones
+	leaf := nodeCount + dataSectionSeparator + recordOffset
+	var tree bytes.Buffer
+	for i := 0; i < nodeCount; i++ {
+		bit := (key[i/8] >> (7 - uint(i%8))) & 1
+		next := i + 1
+		if i == nodeCount-1 {
+			next = leaf
+		}
+		records := [2]int{nodeCount, nodeCount}
+		records[bit] = next
+		for _, r := range records {
+			tree.Write([]byte{byte(r >> 16), byte(r >> 8), byte(r)})
+		}
+	}
+
+	var meta encoder
+	meta.value(map[string]interface{}{
+		"node_count":                  uint32(nodeCount),
+		"record_size":                 uint16(24),
+		"ip_version":                  uint16(ipVersion),
+		"database_type":               "Test-City",
+		"binary_format_major_version": uint16(2),
+	})
+
+	var out bytes.Buffer
+	out.Write(tree.Bytes())
+	out.Write(make([]byte, dataSectionSeparator))
+	out.Write(data.Bytes())
+	out.Write(metadataMarker)
+	out.Write(meta.Bytes())
+	return out.Bytes()
+}
+
+func phoenixRecord(cityName interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"city":         map[string]interface{}{"names": map[string]interface{}{"en": cityName}},
+		"subdivisions": []interface{}{map[string]interface{}{"iso_code": "AZ"}},
+		"country":      map[string]interface{}{"iso_code": "US"},
+		"location":     map[string]interface{}{"latitude": 33.4484, "longitude": -112.074},
+	}
+}
+
+// --- tests ---
+
+func TestLookup_IPv4Database(t *testing.T) {
+	r, err := FromBytes(buildDB(t, 4, "8.8.4.0/24", nil, phoenixRecord("Phoenix")))
+	require.NoError(t, err)
+	assert.Equal(t, "Test-City", r.DatabaseType())
+
+	loc, ok, err := r.Lookup(net.ParseIP("8.8.4.4"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "Phoenix", loc.City)
+	assert.Equal(t, "AZ", loc.State)
+	assert.Equal(t, "US", loc.Country)
+	assert.True(t, loc.HasCoordinates)
+	assert.InDelta(t, 33.4484, loc.Latitude, 1e-9)
+	assert.InDelta(t, -112.074, loc.Longitude, 1e-9)
+
+	_, ok, err = r.Lookup(net.ParseIP("8.8.5.1"))
+	require.NoError(t, err)
+	assert.False(t, ok, "address outside the network should not match")
+
+	_, ok, err = r.Lookup(net.ParseIP("2001:4860::1"))
+	require.NoError(t, err)
+	assert.False(t, ok, "IPv6 address in an IPv4-only database should not match")
+}
+
+func TestLookup_IPv4InIPv6Database(t *testing.T) {
+	r, err := FromBytes(buildDB(t, 6, "8.8.4.0/24", nil, phoenixRecord("Phoenix")))
+	require.NoError(t, err)
+
+	loc, ok, err := r.Lookup(net.ParseIP("8.8.4.4"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "Phoenix", loc.City)
+}
+
+func TestLookup_IPv6Database(t *testing.T) {
+	r, err := FromBytes(buildDB(t, 6, "2001:4860::/32", nil, phoenixRecord("Phoenix")))
+	require.NoError(t, err)
+
+	loc, ok, err := r.Lookup(net.ParseIP("2001:4860:4860::8888"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "AZ", loc.State)
+}
+
+func TestLookup_FollowsPointers(t *testing.T) {
+	// The city name is stored once at data offset 0 and referenced by pointer,
+	// as real databases do for shared strings.
+	r, err := FromBytes(buildDB(t, 4, "8.8.4.0/24", "Tempe", phoenixRecord(pointerTo(0))))
+	require.NoError(t, err)
+
+	loc, ok, err := r.Lookup(net.ParseIP("8.8.4.4"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "Tempe", loc.City)
+}
+
+func TestLookup_LongString(t *testing.T) {
+	long := string(bytes.Repeat([]byte("x"), 300))
+	r, err := FromBytes(buildDB(t, 4, "8.8.4.0/24", nil, phoenixRecord(long)))
+	require.NoError(t, err)
+
+	loc, ok, err := r.Lookup(net.ParseIP("8.8.4.4"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, long, loc.City)
+}
+
+func TestLookup_CountryOnlyRecord(t *testing.T) {
+	record := map[string]interface{}{
+		"country": map[string]interface{}{"iso_code": "CA"},
+	}
+	r, err := FromBytes(buildDB(t, 4, "8.8.4.0/24", nil, record))
+	require.NoError(t, err)
+
+	loc, ok, err := r.Lookup(net.ParseIP("8.8.4.4"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "CA", loc.Country)
+	assert.Empty(t, loc.City)
+	assert.False(t, loc.HasCoordinates)
+}
+
+func TestLookup_SkipsNonPublicAddresses(t *testing.T) {
+	// A database covering 10.0.0.0/8 must still never be consulted for it.
+	r, err := FromBytes(buildDB(t, 4, "10.0.0.0/8", nil, phoenixRecord("Phoenix")))
+	require.NoError(t, err)
+
+	for _, ip := range []string{"10.1.2.3", "127.0.0.1", "::1", "169.254.1.1", "0.0.0.0"} {
+		_, ok, err := r.Lookup(net.ParseIP(ip))
+		require.NoError(t, err, ip)
+		assert.False(t, ok, ip)
+	}
+	_, ok, err := r.Lookup(nil)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestFromBytes_Invalid(t *testing.T) {
+	_, err := FromBytes([]byte("not a database"))
+	assert.Error(t, err)
+
+	// Valid marker but a record size the reader does not support.
+	var meta encoder
+	meta.value(map[string]interface{}{
+		"node_count":  uint32(0),
+		"record_size": uint16(20),
+		"ip_version":  uint16(4),
+	})
+	buf := append(append(make([]byte, dataSectionSeparator), metadataMarker...), meta.Bytes()...)
+	_, err = FromBytes(buf)
+	assert.ErrorContains(t, err, "record size")
+}
+
+func TestOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.mmdb")
+	require.NoError(t, os.WriteFile(path, buildDB(t, 4, "8.8.4.0/24", nil, phoenixRecord("Phoenix")), 0o600))
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	_, ok, err := r.Lookup(net.ParseIP("8.8.4.4"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = Open(filepath.Join(t.TempDir(), "missing.mmdb"))
+	assert.Error(t, err)
+}
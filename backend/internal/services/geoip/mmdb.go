@@ -0,0 +1,355 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+)
+
+// This file is a minimal reader for the MaxMind DB (.mmdb) format
+// (https://maxmind.github.io/MaxMind-DB/): a binary search tree keyed by IP
+// bits whose leaves point into a data section of self-describing values. It
+// covers what city lookups need — no reflection-based decoding, no
+// memory-mapping — so the backend does not take a dependency for one lookup.
+
+var metadataMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// maxMetadataSize bounds the tail of the file searched for the marker.
+const maxMetadataSize = 128 * 1024
+
+// dataSectionSeparator is the run of zero bytes between tree and data.
+const dataSectionSeparator = 16
+
+// Data section field types.
+const (
+	typeExtended  = 0
+	typePointer   = 1
+	typeString    = 2
+	typeDouble    = 3
+	typeBytes     = 4
+	typeUint16    = 5
+	typeUint32    = 6
+	typeMap       = 7
+	typeInt32     = 8
+	typeUint64    = 9
+	typeUint128   = 10
+	typeArray     = 11
+	typeContainer = 12
+	typeEndMarker = 13
+	typeBoolean   = 14
+	typeFloat     = 15
+)
+
+// maxDecodeDepth guards against pointer cycles in a corrupt file.
+const maxDecodeDepth = 32
+
+// mmdb is a parsed MaxMind DB held in memory.
+type mmdb struct {
+	buf        []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	dbType     string
+	treeSize   uint
+	data       decoder
+	// ipv4Start is the node reached after the 96 leading zero bits of an
+	// IPv4-mapped address in an IPv6 tree.
+	ipv4Start uint
+}
+
+func parseMMDB(buf []byte) (*mmdb, error) {
+	start := len(buf) - maxMetadataSize
+	if start < 0 {
+		start = 0
+	}
+	idx := bytes.LastIndex(buf[start:], metadataMarker)
+	if idx < 0 {
+		return nil, errors.New("not a MaxMind DB: metadata marker not found")
+	}
+	metaStart := start + idx + len(metadataMarker)
+	meta := decoder{buf: buf[metaStart:]}
+	raw, _, err := meta.decode(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid metadata: not a map")
+	}
+
+	db := &mmdb{
+		buf:        buf,
+		nodeCount:  uint(asUint(m["node_count"])),
+		recordSize: uint(asUint(m["record_size"])),
+		ipVersion:  uint(asUint(m["ip_version"])),
+	}
+	db.dbType, _ = m["database_type"].(string)
+	switch db.recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("unsupported record size %d", db.recordSize)
+	}
+	if db.ipVersion != 4 && db.ipVersion != 6 {
+		return nil, fmt.Errorf("unsupported ip version %d", db.ipVersion)
+	}
+	db.treeSize = db.nodeCount * db.recordSize / 4
+	dataStart := db.treeSize + dataSectionSeparator
+	if dataStart > uint(start+idx) {
+		return nil, errors.New("search tree overruns the file")
+	}
+	db.data = decoder{buf: buf[dataStart : start+idx]}
+
+	if db.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < db.nodeCount; i++ {
+			node = db.record(node, 0)
+		}
+		db.ipv4Start = node
+	}
+	return db, nil
+}
+
+// record reads the left (bit 0) or right (bit 1) record of a tree node.
+func (db *mmdb) record(node uint, bit uint) uint {
+	b := db.buf
+	switch db.recordSize {
+	case 24:
+		off := node*6 + bit*3
+		return uint(b[off])<<16 | uint(b[off+1])<<8 | uint(b[off+2])
+	case 28:
+		off := node * 7
+		if bit == 0 {
+			return uint(b[off+3]&0xF0)<<20 | uint(b[off])<<16 | uint(b[off+1])<<8 | uint(b[off+2])
+		}
+		return uint(b[off+3]&0x0F)<<24 | uint(b[off+4])<<16 | uint(b[off+5])<<8 | uint(b[off+6])
+	default: // 32
+		off := node*8 + bit*4
+		return uint(binary.BigEndian.Uint32(b[off : off+4]))
+	}
+}
+
+// lookup walks the tree for ip and decodes the leaf's record. ok is false
+// when the address is not in the database.
+func (db *mmdb) lookup(ip net.IP) (interface{}, bool, error) {
+	node := uint(0)
+	var key []byte
+	if v4 := ip.To4(); v4 != nil {
+		key = v4
+		if db.ipVersion == 6 {
+			node = db.ipv4Start
+		}
+	} else {
+		if db.ipVersion == 4 {
+			return nil, false, nil
+		}
+		key = ip.To16()
+		if key == nil {
+			return nil, false, fmt.Errorf("invalid IP %v", ip)
+		}
+	}
+
+	bits := uint(len(key) * 8)
+	for i := uint(0); i < bits && node < db.nodeCount; i++ {
+		bit := uint(key[i>>3]>>(7-(i&7))) & 1
+		node = db.record(node, bit)
+	}
+	if node == db.nodeCount {
+		return nil, false, nil
+	}
+	if node < db.nodeCount {
+		return nil, false, errors.New("invalid search tree: ran out of address bits")
+	}
+	offset := node - db.nodeCount - dataSectionSeparator
+	v, _, err := db.data.decode(offset, 0)
+	if err != nil {
+		return nil, false, err
+	}
+	return v, true, nil
+}
+
+// decoder decodes values from a data section. Pointers are offsets from the
+// start of buf.
+type decoder struct {
+	buf []byte
+}
+
+func (d decoder) bytesAt(offset, n uint) ([]byte, error) {
+	if offset+n > uint(len(d.buf)) || offset+n < offset {
+		return nil, errors.New("unexpected end of data section")
+	}
+	return d.buf[offset : offset+n], nil
+}
+
+// decode returns the value at offset and the offset just past it.
+func (d decoder) decode(offset uint, depth int) (interface{}, uint, error) {
+	if depth > maxDecodeDepth {
+		return nil, 0, errors.New("data section nests too deeply")
+	}
+	head, err := d.bytesAt(offset, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	ctrl := head[0]
+	offset++
+	typ := uint(ctrl >> 5)
+
+	if typ == typePointer {
+		ptr, next, err := d.pointer(ctrl, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, _, err := d.decode(ptr, depth+1)
+		return v, next, err
+	}
+	if typ == typeExtended {
+		ext, err := d.bytesAt(offset, 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		typ = 7 + uint(ext[0])
+		offset++
+	}
+
+	size, offset, err := d.size(ctrl, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch typ {
+	case typeString:
+		b, err := d.bytesAt(offset, size)
+		return string(b), offset + size, err
+	case typeBytes:
+		b, err := d.bytesAt(offset, size)
+		return append([]byte(nil), b...), offset + size, err
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("invalid double size %d", size)
+		}
+		b, err := d.bytesAt(offset, 8)
+		if err != nil {
+			return nil, 0, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), offset + 8, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("invalid float size %d", size)
+		}
+		b, err := d.bytesAt(offset, 4)
+		if err != nil {
+			return nil, 0, err
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), offset + 4, nil
+	case typeUint16, typeUint32, typeUint64, typeInt32:
+		if size > 8 {
+			return nil, 0, fmt.Errorf("invalid integer size %d", size)
+		}
+		b, err := d.bytesAt(offset, size)
+		if err != nil {
+			return nil, 0, err
+		}
+		var n uint64
+		for _, c := range b {
+			n = n<<8 | uint64(c)
+		}
+		if typ == typeInt32 {
+			return int64(int32(uint32(n))), offset + size, nil
+		}
+		return n, offset + size, nil
+	case typeUint128:
+		b, err := d.bytesAt(offset, size)
+		return append([]byte(nil), b...), offset + size, err
+	case typeBoolean:
+		return size != 0, offset, nil
+	case typeMap:
+		m := make(map[string]interface{}, size)
+		for i := uint(0); i < size; i++ {
+			k, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, 0, errors.New("map key is not a string")
+			}
+			v, next, err := d.decode(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[key] = v
+			offset = next
+		}
+		return m, offset, nil
+	case typeArray:
+		a := make([]interface{}, 0, size)
+		for i := uint(0); i < size; i++ {
+			v, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, v)
+			offset = next
+		}
+		return a, offset, nil
+	}
+	return nil, 0, fmt.Errorf("unsupported data type %d", typ)
+}
+
+// size reads the payload size encoded in the control byte and any
+// following size bytes.
+func (d decoder) size(ctrl byte, offset uint) (uint, uint, error) {
+	size := uint(ctrl & 0x1f)
+	if size < 29 {
+		return size, offset, nil
+	}
+	n := size - 28
+	b, err := d.bytesAt(offset, n)
+	if err != nil {
+		return 0, 0, err
+	}
+	var v uint
+	for _, c := range b {
+		v = v<<8 | uint(c)
+	}
+	switch size {
+	case 29:
+		return 29 + v, offset + n, nil
+	case 30:
+		return 285 + v, offset + n, nil
+	default:
+		return 65821 + v, offset + n, nil
+	}
+}
+
+// pointer decodes a pointer's target and the offset just past it.
+func (d decoder) pointer(ctrl byte, offset uint) (uint, uint, error) {
+	n := uint((ctrl>>3)&0x3) + 1
+	b, err := d.bytesAt(offset, n)
+	if err != nil {
+		return 0, 0, err
+	}
+	prefix := uint(ctrl & 0x7)
+	var v uint
+	for _, c := range b {
+		v = v<<8 | uint(c)
+	}
+	switch n {
+	case 1:
+		return prefix<<8 | v, offset + n, nil
+	case 2:
+		return (prefix<<16 | v) + 2048, offset + n, nil
+	case 3:
+		return (prefix<<24 | v) + 526336, offset + n, nil
+	default:
+		return v, offset + n, nil
+	}
+}
+
+// asUint converts a decoded unsigned integer to uint64 (0 otherwise).
+func asUint(v interface{}) uint64 {
+	n, _ := v.(uint64)
+	return n
+}
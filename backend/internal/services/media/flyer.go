@@ -0,0 +1,209 @@
+package media
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+	apperrors "psychic-homily-backend/internal/errors"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Web sizes every uploaded flyer is stored at (longest edge, in pixels).
+const (
+	FlyerLargeMaxDim     = 1200
+	FlyerThumbnailMaxDim = 400
+)
+
+// Object names under a flyer's storage prefix.
+const (
+	flyerLargeObject     = "large.jpg"
+	flyerThumbnailObject = "thumb.jpg"
+)
+
+// flyerContentTypes are the accepted upload formats, by sniffed type.
+var flyerContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// FlyerService stores uploaded show flyers. Each upload is resized to web
+// sizes, re-encoded as JPEG (which also strips metadata such as EXIF
+// location), and written under a fresh per-upload prefix so CDN caches
+// never serve a replaced flyer.
+type FlyerService struct {
+	db             *gorm.DB
+	store          ObjectStore
+	maxUploadBytes int64
+	logger         *slog.Logger
+}
+
+// NewFlyerService creates a flyer service. A nil store disables uploads:
+// every upload then fails with ErrMediaNotConfigured.
+func NewFlyerService(database *gorm.DB, store ObjectStore, maxUploadBytes int64) *FlyerService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &FlyerService{
+		db:             database,
+		store:          store,
+		maxUploadBytes: maxUploadBytes,
+		logger:         slog.Default(),
+	}
+}
+
+// NewFlyerServiceFromConfig builds the store from MEDIA_* config, leaving
+// uploads disabled when no bucket is configured.
+func NewFlyerServiceFromConfig(database *gorm.DB, cfg config.MediaConfig) (*FlyerService, error) {
+	if !cfg.Enabled() {
+		return NewFlyerService(database, nil, cfg.MaxUploadBytes), nil
+	}
+	store, err := NewS3Store(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewFlyerService(database, store, cfg.MaxUploadBytes), nil
+}
+
+// UploadShowFlyer validates, resizes and stores an image as the show's
+// flyer, replacing (and deleting) any previous upload.
+func (s *FlyerService) UploadShowFlyer(ctx context.Context, showID uint, r io.Reader) (*contracts.ShowFlyerResponse, error) {
+	if s.store == nil {
+		return nil, apperrors.ErrMediaNotConfigured()
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, s.maxUploadBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if int64(len(data)) > s.maxUploadBytes {
+		return nil, apperrors.ErrMediaTooLarge(s.maxUploadBytes)
+	}
+	// Trust the bytes, not the client's declared Content-Type.
+	if contentType := http.DetectContentType(data); !flyerContentTypes[contentType] {
+		return nil, apperrors.ErrMediaUnsupportedType(contentType)
+	}
+	img, _, err := decodeImage(data)
+	if err != nil {
+		return nil, apperrors.ErrMediaInvalidImage(err)
+	}
+
+	var show catalogm.Show
+	if err := s.db.Select("id", "flyer_key").
+		Where("id = ? AND deleted_at IS NULL", showID).
+		First(&show).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrShowNotFound(showID)
+		}
+		return nil, fmt.Errorf("failed to get show: %w", err)
+	}
+
+	flat := flattenRGBA(img)
+	large, err := resizeToJPEG(flat, FlyerLargeMaxDim)
+	if err != nil {
+		return nil, err
+	}
+	thumb, err := resizeToJPEG(flat, FlyerThumbnailMaxDim)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := newFlyerPrefix(showID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(ctx, prefix+flyerLargeObject, "image/jpeg", large); err != nil {
+		return nil, fmt.Errorf("failed to store flyer: %w", err)
+	}
+	if err := s.store.Put(ctx, prefix+flyerThumbnailObject, "image/jpeg", thumb); err != nil {
+		s.RemoveFlyerObjects(ctx, prefix)
+		return nil, fmt.Errorf("failed to store flyer thumbnail: %w", err)
+	}
+
+	resp := &contracts.ShowFlyerResponse{
+		ShowID:            showID,
+		FlyerURL:          s.store.URL(prefix + flyerLargeObject),
+		FlyerThumbnailURL: s.store.URL(prefix + flyerThumbnailObject),
+	}
+	if err := s.db.Model(&catalogm.Show{}).Where("id = ?", showID).Updates(map[string]interface{}{
+		"flyer_key":           prefix,
+		"flyer_url":           resp.FlyerURL,
+		"flyer_thumbnail_url": resp.FlyerThumbnailURL,
+		"updated_at":          time.Now().UTC(),
+	}).Error; err != nil {
+		s.RemoveFlyerObjects(ctx, prefix)
+		return nil, fmt.Errorf("failed to save flyer: %w", err)
+	}
+
+	if show.FlyerKey != nil {
+		s.RemoveFlyerObjects(ctx, *show.FlyerKey)
+	}
+	return resp, nil
+}
+
+// DeleteShowFlyer removes a show's uploaded flyer.
+func (s *FlyerService) DeleteShowFlyer(ctx context.Context, showID uint) error {
+	var show catalogm.Show
+	if err := s.db.Select("id", "flyer_key").
+		Where("id = ? AND deleted_at IS NULL", showID).
+		First(&show).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.ErrShowNotFound(showID)
+		}
+		return fmt.Errorf("failed to get show: %w", err)
+	}
+	if show.FlyerKey == nil {
+		return apperrors.ErrMediaNotFound(showID)
+	}
+
+	if err := s.db.Model(&catalogm.Show{}).Where("id = ?", showID).Updates(map[string]interface{}{
+		"flyer_key":           nil,
+		"flyer_url":           nil,
+		"flyer_thumbnail_url": nil,
+		"updated_at":          time.Now().UTC(),
+	}).Error; err != nil {
+		return fmt.Errorf("failed to clear flyer: %w", err)
+	}
+
+	s.RemoveFlyerObjects(ctx, *show.FlyerKey)
+	return nil
+}
+
+// RemoveFlyerObjects deletes the stored copies under a flyer prefix.
+// Best-effort: failures are logged, since the database no longer points at
+// the objects and an orphaned file is harmless.
+func (s *FlyerService) RemoveFlyerObjects(ctx context.Context, prefix string) {
+	if s.store == nil || prefix == "" {
+		return
+	}
+	for _, name := range []string{flyerLargeObject, flyerThumbnailObject} {
+		if err := s.store.Delete(ctx, prefix+name); err != nil {
+			s.logger.Warn("flyer object delete failed",
+				"key", prefix+name,
+				"error", err,
+			)
+		}
+	}
+}
+
+// newFlyerPrefix returns a fresh storage prefix for one upload of a show's
+// flyer, e.g. "flyers/shows/42/3f9a0c1d2b4e5f60-".
+func newFlyerPrefix(showID uint) (string, error) {
+	token := make([]byte, 8)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate flyer key: %w", err)
+	}
+	return fmt.Sprintf("flyers/shows/%d/%s-", showID, hex.EncodeToString(token)), nil
+}
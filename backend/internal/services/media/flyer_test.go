@@ -0,0 +1,142 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	apperrors "psychic-homily-backend/internal/errors"
+)
+
+type fakeStore struct {
+	puts    map[string][]byte
+	deleted []string
+}
+
+func (f *fakeStore) Put(_ context.Context, key, _ string, body []byte) error {
+	if f.puts == nil {
+		f.puts = map[string][]byte{}
+	}
+	f.puts[key] = body
+	return nil
+}
+
+func (f *fakeStore) Delete(_ context.Context, key string) error {
+	f.deleted = append(f.deleted, key)
+	return nil
+}
+
+func (f *fakeStore) URL(key string) string { return "https://media.example.com/" + key }
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := range img.Pix {
+		img.Pix[i] = 0x80
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func mediaErrorCode(err error) string {
+	if mediaErr, ok := err.(*apperrors.MediaError); ok {
+		return mediaErr.Code
+	}
+	return ""
+}
+
+func TestUploadShowFlyer_RejectsBeforeTouchingStorage(t *testing.T) {
+	cases := []struct {
+		name  string
+		store ObjectStore
+		body  []byte
+		code  string
+	}{
+		{"not configured", nil, testPNG(t, 10, 10), apperrors.CodeMediaNotConfigured},
+		{"too large", &fakeStore{}, bytes.Repeat([]byte{0xff}, 2048), apperrors.CodeMediaTooLarge},
+		{"unsupported type", &fakeStore{}, []byte("%PDF-1.7 not an image"), apperrors.CodeMediaUnsupportedType},
+		// Valid PNG signature, truncated body.
+		{"invalid image", &fakeStore{}, testPNG(t, 10, 10)[:40], apperrors.CodeMediaInvalidImage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewFlyerService(nil, tc.store, 1024)
+			_, err := s.UploadShowFlyer(context.Background(), 1, bytes.NewReader(tc.body))
+			if got := mediaErrorCode(err); got != tc.code {
+				t.Fatalf("expected %s, got %v", tc.code, err)
+			}
+			if fs, ok := tc.store.(*fakeStore); ok && len(fs.puts) > 0 {
+				t.Errorf("expected nothing stored, got %d objects", len(fs.puts))
+			}
+		})
+	}
+}
+
+func TestFitWithin(t *testing.T) {
+	cases := []struct {
+		w, h, max    int
+		wantW, wantH int
+	}{
+		{800, 600, 1200, 800, 600},
+		{2400, 1200, 1200, 1200, 600},
+		{1000, 3000, 400, 133, 400},
+		{5000, 1, 400, 400, 1},
+	}
+	for _, tc := range cases {
+		w, h := fitWithin(tc.w, tc.h, tc.max)
+		if w != tc.wantW || h != tc.wantH {
+			t.Errorf("fitWithin(%d, %d, %d) = %dx%d, want %dx%d", tc.w, tc.h, tc.max, w, h, tc.wantW, tc.wantH)
+		}
+	}
+}
+
+func TestResizeToJPEG(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 900, 300))
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 900; x++ {
+			src.Set(x, y, color.NRGBA{R: 200, G: 20, B: 20, A: 0xff})
+		}
+	}
+
+	out, err := resizeToJPEG(flattenRGBA(src), 400)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(out))
+	if err != nil {
+		t.Fatalf("output is not a JPEG: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 400 || b.Dy() != 133 {
+		t.Errorf("expected 400x133, got %dx%d", b.Dx(), b.Dy())
+	}
+	r, g, _, _ := img.At(200, 66).RGBA()
+	if r>>8 < 180 || g>>8 > 50 {
+		t.Errorf("expected the source color to survive resizing, got r=%d g=%d", r>>8, g>>8)
+	}
+}
+
+func TestDecodeImage_RejectsOversizedDimensions(t *testing.T) {
+	// A PNG header claiming 10000x10000 pixels; decoding must stop at the
+	// header rather than allocate the full image.
+	header := testPNG(t, 1, 1)
+	huge := append([]byte{}, header...)
+	// IHDR width/height live at bytes 16-23; the chunk CRC follows its
+	// type and data at bytes 29-32.
+	copy(huge[16:24], []byte{0, 0, 0x27, 0x10, 0, 0, 0x27, 0x10})
+	binary.BigEndian.PutUint32(huge[29:33], crc32.ChecksumIEEE(huge[12:29]))
+
+	_, _, err := decodeImage(huge)
+	if err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Fatalf("expected dimensions error, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+
+	// Register the accepted upload formats with image.Decode.
+	_ "image/gif"
+	_ "image/png"
+)
+
+// maxSourcePixels rejects images whose header claims more pixels than this
+// before decoding them, so a tiny file can't expand into gigabytes of RAM.
+const maxSourcePixels = 40_000_000
+
+// jpegQuality is used for every resized copy.
+const jpegQuality = 85
+
+// decodeImage decodes a JPEG, PNG or GIF (first frame) and returns it with
+// its format name.
+func decodeImage(data []byte) (image.Image, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxSourcePixels {
+		return nil, "", fmt.Errorf("image dimensions %dx%d out of range", cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	return img, format, nil
+}
+
+// fitWithin scales (w, h) down to fit a maxDim square, keeping the aspect
+// ratio. Images already small enough keep their size; nothing is upscaled.
+func fitWithin(w, h, maxDim int) (int, int) {
+	if w <= maxDim && h <= maxDim {
+		return w, h
+	}
+	if w >= h {
+		return maxDim, max(1, h*maxDim/w)
+	}
+	return max(1, w*maxDim/h), maxDim
+}
+
+// flattenRGBA copies src into an opaque RGBA buffer, with transparent areas
+// flattened onto white, so the box filter can read Pix directly regardless
+// of the source's color model. Flatten once and resize the result to each
+// size, rather than converting the source per size.
+func flattenRGBA(src image.Image) *image.RGBA {
+	b := src.Bounds()
+	flat := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(flat, flat.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, b.Min, draw.Over)
+	return flat
+}
+
+// resizeToJPEG scales a flattened image to fit within maxDim and encodes it
+// as JPEG.
+func resizeToJPEG(flat *image.RGBA, maxDim int) ([]byte, error) {
+	b := flat.Bounds()
+	w, h := fitWithin(b.Dx(), b.Dy(), maxDim)
+
+	out := flat
+	if w != b.Dx() || h != b.Dy() {
+		out = boxResize(flat, w, h)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, out, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode jpeg: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// boxResize downsamples src to w×h by averaging the block of source pixels
+// each destination pixel covers. Downscale only.
+func boxResize(src *image.RGBA, w, h int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, max((y+1)*sh/h, y*sh/h+1)
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, max((x+1)*sw/w, x*sw/w+1)
+			var r, g, bl, n uint32
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					i := sx * 4
+					r += uint32(row[i])
+					g += uint32(row[i+1])
+					bl += uint32(row[i+2])
+					n++
+				}
+			}
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(bl / n)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+	return dst
+}
@@ -0,0 +1,196 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/httpclient"
+)
+
+// ObjectStore is the blob storage media is written to.
+type ObjectStore interface {
+	Put(ctx context.Context, key, contentType string, body []byte) error
+	Delete(ctx context.Context, key string) error
+	// URL returns the public URL an object is served from.
+	URL(key string) string
+}
+
+// S3Store is an ObjectStore backed by any S3-compatible API (AWS S3,
+// Cloudflare R2, MinIO). Requests use path-style addressing and are signed
+// with AWS Signature Version 4, which every such provider accepts.
+type S3Store struct {
+	endpoint      *url.URL
+	bucket        string
+	region        string
+	accessKeyID   string
+	secretKey     string
+	publicBaseURL string
+	client        *http.Client
+	now           func() time.Time
+}
+
+// NewS3Store builds a store from validated media config.
+func NewS3Store(cfg config.MediaConfig) (*S3Store, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media endpoint: %w", err)
+	}
+	publicBaseURL := cfg.PublicBaseURL
+	if publicBaseURL == "" {
+		publicBaseURL = cfg.Endpoint + "/" + cfg.Bucket
+	}
+	return &S3Store{
+		endpoint:      endpoint,
+		bucket:        cfg.Bucket,
+		region:        cfg.Region,
+		accessKeyID:   cfg.AccessKeyID,
+		secretKey:     cfg.SecretAccessKey,
+		publicBaseURL: publicBaseURL,
+		client:        httpclient.New(30 * time.Second),
+		now:           time.Now,
+	}, nil
+}
+
+// Put uploads body as a publicly cacheable object.
+func (s *S3Store) Put(ctx context.Context, key, contentType string, body []byte) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", "public, max-age=31536000, immutable")
+	return s.do(req, body)
+}
+
+// Delete removes an object. Deleting a missing key is not an error.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	return s.do(req, nil)
+}
+
+// URL returns the public URL for key.
+func (s *S3Store) URL(key string) string {
+	return s.publicBaseURL + "/" + key
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimRight(u.Path, "/") + "/" + s.bucket + "/" + key
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build storage request: %w", err)
+	}
+	req.ContentLength = int64(len(body))
+	return req, nil
+}
+
+func (s *S3Store) do(req *http.Request, body []byte) error {
+	s.sign(req, body)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("storage %s %s: %w", req.Method, req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("storage %s %s: status %d: %s", req.Method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// sign adds SigV4 headers (x-amz-date, x-amz-content-sha256, Authorization),
+// signing host plus every header already set on the request. Headers the
+// transport adds later (User-Agent, Accept-Encoding) are left unsigned.
+func (s *S3Store) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signed := []string{"host"}
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		lower := strings.ToLower(name)
+		signed = append(signed, lower)
+		headers[lower] = strings.TrimSpace(req.Header.Get(name))
+	}
+	sort.Strings(signed)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signed {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(signed, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncodePath(req.URL.Path),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+// uriEncodePath percent-encodes everything but unreserved characters and
+// the '/' separators, as SigV4 canonical URIs require.
+func uriEncodePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if c == '/' || c == '-' || c == '_' || c == '.' || c == '~' ||
+			('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,141 @@
+package notification
+
+import (
+	authm "psychic-homily-backend/internal/models/auth"
+	communitym "psychic-homily-backend/internal/models/community"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// adminChannel is a destination for admin notifications.
+type adminChannel interface {
+	contracts.DiscordServiceInterface
+	Flush()
+}
+
+// AdminNotifier fans admin notifications out to every configured channel
+// (Discord, Slack), so handlers and jobs need only one notifier. Channels
+// that are not configured ignore the calls, as they do on their own.
+type AdminNotifier struct {
+	channels []adminChannel
+}
+
+// NewAdminNotifier creates a notifier posting to discord and slack.
+func NewAdminNotifier(discord *DiscordService, slack *SlackNotifier) *AdminNotifier {
+	return &AdminNotifier{channels: []adminChannel{discord, slack}}
+}
+
+// IsConfigured returns true if any channel is configured
+func (n *AdminNotifier) IsConfigured() bool {
+	for _, c := range n.channels {
+		if c.IsConfigured() {
+			return true
+		}
+	}
+	return false
+}
+
+// Flush posts the notifications every channel is still holding for its
+// batch window. Called on shutdown.
+func (n *AdminNotifier) Flush() {
+	for _, c := range n.channels {
+		c.Flush()
+	}
+}
+
+// NotifyNewUser sends a notification when a new user registers
+func (n *AdminNotifier) NotifyNewUser(user *authm.User) {
+	for _, c := range n.channels {
+		c.NotifyNewUser(user)
+	}
+}
+
+// NotifyNewShow sends a notification when a new show is submitted
+func (n *AdminNotifier) NotifyNewShow(show *contracts.ShowResponse, submitterEmail string) {
+	for _, c := range n.channels {
+		c.NotifyNewShow(show, submitterEmail)
+	}
+}
+
+// NotifyShowStatusChange sends a notification when a show's status changes
+func (n *AdminNotifier) NotifyShowStatusChange(showTitle string, showID uint, oldStatus, newStatus, actorEmail string) {
+	for _, c := range n.channels {
+		c.NotifyShowStatusChange(showTitle, showID, oldStatus, newStatus, actorEmail)
+	}
+}
+
+// NotifyShowApproved sends a notification when a show is approved
+func (n *AdminNotifier) NotifyShowApproved(show *contracts.ShowResponse) {
+	for _, c := range n.channels {
+		c.NotifyShowApproved(show)
+	}
+}
+
+// NotifyShowRejected sends a notification when a show is rejected
+func (n *AdminNotifier) NotifyShowRejected(show *contracts.ShowResponse, reason string) {
+	for _, c := range n.channels {
+		c.NotifyShowRejected(show, reason)
+	}
+}
+
+// NotifyShowReport sends a notification when a show is reported
+func (n *AdminNotifier) NotifyShowReport(report *communitym.ShowReport, reporterEmail string) {
+	for _, c := range n.channels {
+		c.NotifyShowReport(report, reporterEmail)
+	}
+}
+
+// NotifyArtistReport sends a notification when an artist is reported
+func (n *AdminNotifier) NotifyArtistReport(report *communitym.ArtistReport, reporterEmail string) {
+	for _, c := range n.channels {
+		c.NotifyArtistReport(report, reporterEmail)
+	}
+}
+
+// NotifyVenueReport sends a notification when a venue is reported
+func (n *AdminNotifier) NotifyVenueReport(report *communitym.VenueReport, reporterEmail string) {
+	for _, c := range n.channels {
+		c.NotifyVenueReport(report, reporterEmail)
+	}
+}
+
+// NotifyNewVenue sends a notification when a new venue needs verification
+func (n *AdminNotifier) NotifyNewVenue(venueID uint, venueName, city, state string, address *string, submitterEmail string) {
+	for _, c := range n.channels {
+		c.NotifyNewVenue(venueID, venueName, city, state, address, submitterEmail)
+	}
+}
+
+// NotifyNewRadioShows sends a notification when new radio shows are discovered
+func (n *AdminNotifier) NotifyNewRadioShows(stationName string, newShowNames []string) {
+	for _, c := range n.channels {
+		c.NotifyNewRadioShows(stationName, newShowNames)
+	}
+}
+
+// NotifyBulkShowAction sends a summary of a bulk show action
+func (n *AdminNotifier) NotifyBulkShowAction(result *contracts.BulkShowActionResult, actorEmail string) {
+	for _, c := range n.channels {
+		c.NotifyBulkShowAction(result, actorEmail)
+	}
+}
+
+// NotifyStaleDiscoverySources sends an alert listing stale discovery sources
+func (n *AdminNotifier) NotifyStaleDiscoverySources(sources []contracts.DiscoverySourceHealth, staleAfterDays int) {
+	for _, c := range n.channels {
+		c.NotifyStaleDiscoverySources(sources, staleAfterDays)
+	}
+}
+
+// NotifySubmissionThrottle sends an alert when a submitter is throttled
+func (n *AdminNotifier) NotifySubmissionThrottle(user *authm.User, event, detail string) {
+	for _, c := range n.channels {
+		c.NotifySubmissionThrottle(user, event, detail)
+	}
+}
+
+// NotifyMaintenanceMode sends an alert when maintenance mode is toggled
+func (n *AdminNotifier) NotifyMaintenanceMode(enabled bool, source, actorEmail string) {
+	for _, c := range n.channels {
+		c.NotifyMaintenanceMode(enabled, source, actorEmail)
+	}
+}
@@ -0,0 +1,186 @@
+package notification
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	notificationm "psychic-homily-backend/internal/models/notification"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/engagement"
+)
+
+// Artist-follow new-show notifications. Runs inside MatchAndNotify after the
+// filter and scene-follow passes and shares their dedup: one notification
+// per (user, show) across all three, so a user already told about the show
+// by a filter or a followed scene isn't told again here.
+//
+// Like scene follows, this reads LIVE artist follows rather than managed
+// notification_filters rows, so following an artist is the whole opt-in.
+
+// artistFollower is one follower of an artist on a show's bill. ArtistName
+// is the first followed artist in bill order, for the email label.
+type artistFollower struct {
+	UserID     uint   `gorm:"column:user_id"`
+	ArtistName string `gorm:"column:artist_name"`
+}
+
+// notifyArtistFollowers fans a newly approved show out to followers of any
+// artist on its bill. The in-app log row is always written; email and push
+// are gated on the followed_artist_show preference cells. A user who muted
+// (mode "off") a scene the show belongs to is skipped, matching the scene
+// pass: "off" silences new-show notifications for that scene entirely.
+// Best-effort: errors are logged, never returned.
+func (s *NotificationFilterService) notifyArtistFollowers(show *catalogm.Show, showArtistIDs pq.Int64Array) {
+	if len(showArtistIDs) == 0 {
+		return
+	}
+	followers, err := s.artistFollowersForShow(show.ID)
+	if err != nil {
+		log.Printf("artist-follow notify: %v", err)
+		return
+	}
+	if len(followers) == 0 {
+		return
+	}
+
+	muted, err := s.sceneMutedUsers(show.ID)
+	if err != nil {
+		log.Printf("artist-follow notify: %v", err)
+		return
+	}
+
+	var submitter uint
+	if show.SubmittedBy != nil {
+		submitter = *show.SubmittedBy
+	}
+
+	now := time.Now().UTC()
+	notified := make([]artistFollower, 0, len(followers))
+	for _, f := range followers {
+		if (submitter != 0 && f.UserID == submitter) || muted[f.UserID] {
+			continue
+		}
+
+		// Cross-system dedup, as in notifySceneFollowers.
+		var existing int64
+		if err := s.db.Model(&notificationm.NotificationLog{}).
+			Where("user_id = ? AND entity_type = ? AND entity_id = ? AND channel = ?",
+				f.UserID, "show", show.ID, "email").
+			Count(&existing).Error; err != nil {
+			log.Printf("artist-follow notify: dedup check for user %d: %v", f.UserID, err)
+			continue
+		}
+		if existing > 0 {
+			continue
+		}
+
+		logEntry := notificationm.NotificationLog{
+			UserID:     f.UserID,
+			FilterID:   nil, // artist follows have no filter row
+			EntityType: "show",
+			EntityID:   show.ID,
+			Channel:    "email",
+			SentAt:     now,
+		}
+		if err := s.db.Create(&logEntry).Error; err != nil {
+			log.Printf("artist-follow notify: log insert for user %d, show %d: %v", f.UserID, show.ID, err)
+			continue
+		}
+		notified = append(notified, f)
+	}
+	if len(notified) == 0 {
+		return
+	}
+
+	userIDs := make([]uint, len(notified))
+	for i, f := range notified {
+		userIDs[i] = f.UserID
+	}
+
+	if s.emailService != nil && s.emailService.IsConfigured() {
+		emailUsers := s.enabledUsers(userIDs, contracts.NotificationChannelEmail)
+		for _, f := range notified {
+			if !emailUsers[f.UserID] {
+				continue
+			}
+			s.sendFollowEmail(f.UserID, show, followEmail{
+				kind:      "artist_follow",
+				label:     f.ArtistName,
+				subject:   fmt.Sprintf("New %s show", f.ArtistName),
+				manageURL: fmt.Sprintf("%s/following?tab=artist", s.frontendURL),
+			})
+		}
+	}
+
+	if s.pushService != nil && s.preferences != nil && s.pushService.IsConfigured() {
+		pushUsers := s.enabledUsers(userIDs, contracts.NotificationChannelPush)
+		for _, f := range notified {
+			if pushUsers[f.UserID] {
+				s.pushShow(f.UserID, contracts.NotificationEventFollowedArtistShow, show)
+			}
+		}
+	}
+}
+
+// enabledUsers returns which of userIDs have the followed_artist_show cell
+// enabled on channel. Without a preference service every user counts as
+// enabled for email (its default) and none for push.
+func (s *NotificationFilterService) enabledUsers(userIDs []uint, channel string) map[uint]bool {
+	enabled := make(map[uint]bool, len(userIDs))
+	if s.preferences == nil {
+		if channel == contracts.NotificationChannelEmail {
+			for _, id := range userIDs {
+				enabled[id] = true
+			}
+		}
+		return enabled
+	}
+	ids, err := s.preferences.FilterEnabledUsers(userIDs, contracts.NotificationEventFollowedArtistShow, channel)
+	if err != nil {
+		log.Printf("artist-follow notify: %s preference check: %v", channel, err)
+		return enabled
+	}
+	for _, id := range ids {
+		enabled[id] = true
+	}
+	return enabled
+}
+
+// artistFollowersForShow returns one row per active user following any
+// artist on the show's bill.
+func (s *NotificationFilterService) artistFollowersForShow(showID uint) ([]artistFollower, error) {
+	var followers []artistFollower
+	err := s.db.Raw(`
+		SELECT DISTINCT ON (b.user_id) b.user_id, a.name AS artist_name
+		FROM show_artists sa
+		JOIN user_bookmarks b ON b.entity_type = 'artist' AND b.action = 'follow' AND b.entity_id = sa.artist_id
+		JOIN artists a ON a.id = sa.artist_id
+		JOIN users u ON u.id = b.user_id
+		WHERE sa.show_id = ? AND u.is_active = TRUE AND u.deleted_at IS NULL
+		ORDER BY b.user_id, sa.position, sa.artist_id
+	`, showID).Scan(&followers).Error
+	if err != nil {
+		return nil, fmt.Errorf("artist followers query: %w", err)
+	}
+	return followers, nil
+}
+
+// sceneMutedUsers returns the users who follow one of the show's scenes with
+// notify mode "off".
+func (s *NotificationFilterService) sceneMutedUsers(showID uint) (map[uint]bool, error) {
+	followers, err := s.sceneFollowersForShow(showID)
+	if err != nil {
+		return nil, err
+	}
+	muted := make(map[uint]bool)
+	for _, f := range followers {
+		if f.Mode != nil && *f.Mode == engagement.SceneNotifyModeOff {
+			muted[f.UserID] = true
+		}
+	}
+	return muted, nil
+}
@@ -0,0 +1,59 @@
+package notification
+
+// Artist-follow fan-out tests — run inside NotificationFilterSuite (real
+// Postgres). Shares the helpers in scene_follow_notify_test.go.
+
+func (s *NotificationFilterSuite) TestArtistFollow_NotifiesFollower() {
+	followerID := s.createTestUser()
+	bystanderID := s.createTestUser()
+
+	artistID := s.createTestArtist("Followed Band")
+	s.followArtist(followerID, artistID)
+	venueID := s.createTestVenue("The Rebel Lounge")
+	showID := s.createTestShow("Followed Band Show", []uint{artistID}, []uint{venueID})
+
+	s.Require().NoError(s.svc.MatchAndNotify(s.loadShow(showID)))
+	s.Equal(int64(1), s.sceneLogCount(followerID, showID))
+	s.Equal(int64(0), s.sceneLogCount(bystanderID, showID))
+
+	// Re-approval doesn't notify twice.
+	s.Require().NoError(s.svc.MatchAndNotify(s.loadShow(showID)))
+	s.Equal(int64(1), s.sceneLogCount(followerID, showID))
+}
+
+func (s *NotificationFilterSuite) TestArtistFollow_OneNotificationForSeveralFollowedArtists() {
+	userID := s.createTestUser()
+	headliner := s.createTestArtist("Headliner")
+	opener := s.createTestArtist("Opener")
+	s.followArtist(userID, headliner)
+	s.followArtist(userID, opener)
+	venueID := s.createTestVenue("The Rebel Lounge")
+	showID := s.createTestShow("Double Bill", []uint{headliner, opener}, []uint{venueID})
+
+	s.Require().NoError(s.svc.MatchAndNotify(s.loadShow(showID)))
+	s.Equal(int64(1), s.sceneLogCount(userID, showID))
+}
+
+func (s *NotificationFilterSuite) TestArtistFollow_DedupsAgainstSceneFollow() {
+	userID := s.createTestUser()
+	s.seedSceneFollow(userID, "")
+	artistID := s.createTestArtist("Scene And Artist Band")
+	s.followArtist(userID, artistID)
+	venueID := s.createTestVenue("The Rebel Lounge")
+	showID := s.createTestShow("Scene And Artist Show", []uint{artistID}, []uint{venueID})
+
+	s.Require().NoError(s.svc.MatchAndNotify(s.loadShow(showID)))
+	s.Equal(int64(1), s.sceneLogCount(userID, showID))
+}
+
+func (s *NotificationFilterSuite) TestArtistFollow_SubmitterIsNotSelfNotified() {
+	userID := s.createTestUser()
+	artistID := s.createTestArtist("My Followed Band")
+	s.followArtist(userID, artistID)
+	venueID := s.createTestVenue("The Rebel Lounge")
+	showID := s.createTestShow("My Submitted Show", []uint{artistID}, []uint{venueID})
+	s.Require().NoError(s.db.Exec(`UPDATE shows SET submitted_by = ? WHERE id = ?`, userID, showID).Error)
+
+	s.Require().NoError(s.svc.MatchAndNotify(s.loadShow(showID)))
+	s.Equal(int64(0), s.sceneLogCount(userID, showID))
+}
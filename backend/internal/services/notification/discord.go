@@ -2,16 +2,19 @@ package notification
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/getsentry/sentry-go"
 
 	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/httpclient"
 	authm "psychic-homily-backend/internal/models/auth"
 	communitym "psychic-homily-backend/internal/models/community"
 	"psychic-homily-backend/internal/services/contracts"
@@ -44,7 +47,7 @@ const (
 	ColorGreen  = 0x00FF00 // New user signups, show approved
 	ColorBlue   = 0x0066FF // New show submissions
 	ColorOrange = 0xFFA500 // Status changes (unpublish/publish/make-private)
-	ColorRed    = 0xFF0000 // Show rejected
+	ColorRed    = 0xFF0000 // Show rejected, submission throttle alerts
 	ColorPurple = 0x9B59B6 // Venue needs verification
 )
 
@@ -75,6 +78,26 @@ type DiscordService struct {
 	enabled     bool
 	frontendURL string
 	httpClient  *http.Client
+	// routes maps event types to the webhook URL they are posted to instead
+	// of webhookURL.
+	routes map[string]string
+	// batchWindow holds notifications this long before posting them; zero
+	// (as in tests) posts each immediately.
+	batchWindow time.Duration
+
+	mu           sync.Mutex
+	pending      map[string][]DiscordEmbed // held embeds by webhook URL
+	pendingOrder []string
+	flushTimer   *time.Timer
+
+	// queuedRetries counts payloads waiting out a 429.
+	queuedRetries atomic.Int32
+
+	// platform and payload let SlackNotifier reuse this service with another
+	// webhook format: platform names the service in logs and Sentry, payload
+	// builds the request body. Zero values mean Discord.
+	platform string
+	payload  func(embeds []DiscordEmbed) any
 }
 
 // NewDiscordService creates a new Discord notification service
@@ -83,9 +106,9 @@ func NewDiscordService(cfg *config.Config) *DiscordService {
 		webhookURL:  cfg.Discord.WebhookURL,
 		enabled:     cfg.Discord.Enabled,
 		frontendURL: cfg.Email.FrontendURL, // Reuse frontend URL from email config
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		httpClient:  httpclient.New(10 * time.Second),
+		routes:      resolveDiscordRoutes(cfg.Discord.WebhookURL, cfg.Discord.Routes),
+		batchWindow: cfg.Discord.BatchWindow,
 	}
 }
 
@@ -118,7 +141,7 @@ func (s *DiscordService) NotifyNewUser(user *authm.User) {
 		},
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventNewUser, embed)
 }
 
 // NotifyNewShow sends a notification when a new show is submitted
@@ -152,7 +175,7 @@ func (s *DiscordService) NotifyNewShow(show *contracts.ShowResponse, submitterEm
 		Fields:      fields,
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventNewShow, embed)
 }
 
 // NotifyShowStatusChange sends a notification when a show's status changes
@@ -180,7 +203,7 @@ func (s *DiscordService) NotifyShowStatusChange(showTitle string, showID uint, o
 		Fields:      fields,
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventShowStatus, embed)
 }
 
 // NotifyShowApproved sends a notification when an admin approves a show
@@ -204,7 +227,7 @@ func (s *DiscordService) NotifyShowApproved(show *contracts.ShowResponse) {
 		},
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventShowApproved, embed)
 }
 
 // NotifyShowRejected sends a notification when an admin rejects a show
@@ -229,7 +252,7 @@ func (s *DiscordService) NotifyShowRejected(show *contracts.ShowResponse, reason
 		},
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventShowRejected, embed)
 }
 
 // NotifyShowReport sends a notification when a user reports a show issue
@@ -283,7 +306,7 @@ func (s *DiscordService) NotifyShowReport(report *communitym.ShowReport, reporte
 		Fields:    fields,
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventShowReport, embed)
 }
 
 // NotifyArtistReport sends a notification when a user reports an artist issue
@@ -332,7 +355,61 @@ func (s *DiscordService) NotifyArtistReport(report *communitym.ArtistReport, rep
 		Fields:    fields,
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventArtistReport, embed)
+}
+
+// NotifyVenueReport sends a notification when a user reports a venue issue
+func (s *DiscordService) NotifyVenueReport(report *communitym.VenueReport, reporterEmail string) {
+	if !s.IsConfigured() || report == nil {
+		return
+	}
+
+	// Format report type for display
+	reportTypeDisplay := string(report.ReportType)
+	switch report.ReportType {
+	case communitym.VenueReportTypeInaccurate:
+		reportTypeDisplay = "Inaccurate Info"
+	case communitym.VenueReportTypeClosed:
+		reportTypeDisplay = "Closed"
+	case communitym.VenueReportTypeRemovalRequest:
+		reportTypeDisplay = "Removal Request"
+	}
+
+	venueName := "Unknown Venue"
+	location := "Unknown"
+	if report.Venue.ID != 0 {
+		venueName = report.Venue.Name
+		location = fmt.Sprintf("%s, %s", report.Venue.City, report.Venue.State)
+	}
+
+	fields := []DiscordEmbedField{
+		{Name: "Report Type", Value: reportTypeDisplay, Inline: true},
+		{Name: "Venue", Value: venueName, Inline: true},
+		{Name: "Location", Value: location, Inline: true},
+		{Name: "Reporter", Value: HashEmail(reporterEmail), Inline: true},
+	}
+
+	// Add details if provided
+	if report.Details != nil && *report.Details != "" {
+		details := *report.Details
+		if len(details) > 200 {
+			details = details[:197] + "..."
+		}
+		fields = append(fields, DiscordEmbedField{Name: "Details", Value: details, Inline: false})
+	}
+
+	// Add action link
+	actions := fmt.Sprintf("[Review Reports](%s/admin?tab=reports)", s.frontendURL)
+	fields = append(fields, DiscordEmbedField{Name: "Actions", Value: actions, Inline: false})
+
+	embed := DiscordEmbed{
+		Title:     fmt.Sprintf("Venue Report: %s", venueName),
+		Color:     ColorOrange,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Fields:    fields,
+	}
+
+	s.dispatch(DiscordEventVenueReport, embed)
 }
 
 // NotifyNewVenue sends a notification when a new unverified venue is created
@@ -368,7 +445,7 @@ func (s *DiscordService) NotifyNewVenue(venueID uint, venueName, city, state str
 		Fields:      fields,
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventNewVenue, embed)
 }
 
 // NotifyNewRadioShows sends a notification when the periodic discover loop
@@ -399,41 +476,213 @@ func (s *DiscordService) NotifyNewRadioShows(stationName string, newShowNames []
 		},
 	}
 
-	shared.GoSafe(context.Background(), "discord_webhook", func() { s.sendWebhook(embed) })
+	s.dispatch(DiscordEventRadioShows, embed)
 }
 
-// sendWebhook sends an embed to the Discord webhook (fire-and-forget)
+// NotifyBulkShowAction sends ONE notification summarizing an admin bulk show
+// action, instead of one message per show. Failed items are listed separately
+// so the channel shows what still needs attention.
+func (s *DiscordService) NotifyBulkShowAction(result *contracts.BulkShowActionResult, actorEmail string) {
+	if !s.IsConfigured() || result == nil || len(result.Results) == 0 {
+		return
+	}
+
+	color := ColorOrange
+	switch result.Action {
+	case contracts.BulkShowActionApprove:
+		color = ColorGreen
+	case contracts.BulkShowActionReject, contracts.BulkShowActionDelete:
+		color = ColorRed
+	}
+
+	// A bulk action can touch hundreds of shows with long titles: cut each
+	// line, then stop the list before the field passes Discord's limit.
+	const maxLineRunes = 100
+	var succeeded, failed []string
+	for _, item := range result.Results {
+		if item.Success {
+			succeeded = append(succeeded, fmt.Sprintf("#%d %s", item.ShowID, truncateRunes(item.Title, maxLineRunes)))
+		} else {
+			failed = append(failed, fmt.Sprintf("#%d %s", item.ShowID, truncateRunes(item.Error, maxLineRunes)))
+		}
+	}
+
+	fields := []DiscordEmbedField{
+		{Name: "Admin", Value: HashEmail(actorEmail), Inline: true},
+	}
+	if len(succeeded) > 0 {
+		fields = append(fields, DiscordEmbedField{Name: fmt.Sprintf("Succeeded (%d)", len(succeeded)), Value: joinWithinLimit(succeeded, discordFieldValueLimit), Inline: false})
+	}
+	if len(failed) > 0 {
+		fields = append(fields, DiscordEmbedField{Name: fmt.Sprintf("Failed (%d)", len(failed)), Value: joinWithinLimit(failed, discordFieldValueLimit), Inline: false})
+	}
+	fields = append(fields, DiscordEmbedField{Name: "Actions", Value: fmt.Sprintf("[View Admin Panel](%s/admin)", s.frontendURL), Inline: false})
+
+	embed := DiscordEmbed{
+		Title:       fmt.Sprintf("Bulk Show Action: %s", result.Action),
+		Description: fmt.Sprintf("%d succeeded, %d failed", result.Succeeded, result.Failed),
+		Color:       color,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Fields:      fields,
+	}
+
+	s.dispatch(DiscordEventBulkShowAction, embed)
+}
+
+// NotifyStaleDiscoverySources sends ONE notification listing discovery
+// sources that have gone without a successful import for staleAfterDays.
+// Fire-and-forget; silently skipped when Discord isn't configured.
+func (s *DiscordService) NotifyStaleDiscoverySources(sources []contracts.DiscoverySourceHealth, staleAfterDays int) {
+	if !s.IsConfigured() || len(sources) == 0 {
+		return
+	}
+
+	// Same cap as NotifyNewRadioShows: keep the field under Discord's limits.
+	const maxShown = 25
+	lines := make([]string, 0, len(sources))
+	for _, src := range sources {
+		last := "never"
+		if src.LastSuccessAt != nil {
+			last = src.LastSuccessAt.UTC().Format("Jan 2, 2006")
+		}
+		line := fmt.Sprintf("%s — last events %s", src.SourceKey, last)
+		if src.ErrorStreak > 0 {
+			line += fmt.Sprintf(", %d failed run(s)", src.ErrorStreak)
+		}
+		lines = append(lines, line)
+	}
+	tail := ""
+	if len(lines) > maxShown {
+		tail = fmt.Sprintf("\n…and %d more", len(lines)-maxShown)
+		lines = lines[:maxShown]
+	}
+
+	embed := DiscordEmbed{
+		Title:       "Stale Discovery Sources",
+		Description: fmt.Sprintf("%d source(s) produced no events in %d days", len(sources), staleAfterDays),
+		Color:       ColorOrange,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Fields: []DiscordEmbedField{
+			{Name: "Sources", Value: strings.Join(lines, "\n") + tail, Inline: false},
+		},
+	}
+
+	s.dispatch(DiscordEventStaleDiscovery, embed)
+}
+
+// NotifySubmissionThrottle sends a notification when a submitter trips a
+// show submission threshold (daily cap or burst quarantine).
+func (s *DiscordService) NotifySubmissionThrottle(user *authm.User, event, detail string) {
+	if !s.IsConfigured() || user == nil {
+		return
+	}
+
+	email := ""
+	if user.Email != nil {
+		email = HashEmail(*user.Email)
+	}
+
+	embed := DiscordEmbed{
+		Title:       fmt.Sprintf("Submission Throttle: %s", event),
+		Description: detail,
+		Color:       ColorRed,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Fields: []DiscordEmbedField{
+			{Name: "User ID", Value: fmt.Sprintf("%d", user.ID), Inline: true},
+			{Name: "Email", Value: email, Inline: true},
+			{Name: "Tier", Value: user.UserTier, Inline: true},
+			{Name: "Actions", Value: fmt.Sprintf("[Review Pending Shows](%s/admin)", s.frontendURL), Inline: false},
+		},
+	}
+
+	s.dispatch(DiscordEventSubmissionThrottle, embed)
+}
+
+// NotifyMaintenanceMode sends a notification when maintenance mode is turned
+// on or off. source says how it was toggled (the admin feature flag API or
+// the MAINTENANCE_MODE env var); actorEmail is empty for the env var.
+func (s *DiscordService) NotifyMaintenanceMode(enabled bool, source, actorEmail string) {
+	if !s.IsConfigured() {
+		return
+	}
+
+	title, description, color := "Maintenance Mode Enabled", "Non-admin routes now answer 503", ColorRed
+	if !enabled {
+		title, description, color = "Maintenance Mode Disabled", "All routes are serving again", ColorGreen
+	}
+	fields := []DiscordEmbedField{
+		{Name: "Source", Value: source, Inline: true},
+	}
+	if actorEmail != "" {
+		fields = append(fields, DiscordEmbedField{Name: "Changed By", Value: HashEmail(actorEmail), Inline: true})
+	}
+
+	embed := DiscordEmbed{
+		Title:       title,
+		Description: description,
+		Color:       color,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Fields:      fields,
+	}
+
+	s.dispatch(DiscordEventMaintenanceMode, embed)
+}
+
+// sendWebhook posts an embed to the default webhook right away, bypassing
+// routing and batching.
 func (s *DiscordService) sendWebhook(embed DiscordEmbed) {
-	payload := DiscordWebhookPayload{
-		Embeds: []DiscordEmbed{embed},
+	s.post(s.webhookURL, []DiscordEmbed{embed}, 1)
+}
+
+// post sends embeds to target. A 429 puts the payload on the retry queue
+// until discordMaxAttempts; other failures are reported to Sentry.
+func (s *DiscordService) post(target string, embeds []DiscordEmbed, attempt int) {
+	platform := s.platform
+	if platform == "" {
+		platform = "discord"
+	}
+	var payload any = DiscordWebhookPayload{
+		Embeds: embeds,
 	}
+	if s.payload != nil {
+		payload = s.payload(embeds)
+	}
+	title := embeds[0].Title
 
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		sentry.CaptureException(fmt.Errorf("discord webhook marshal failed: %w", err))
+		sentry.CaptureException(fmt.Errorf("%s webhook marshal failed: %w", platform, err))
 		return
 	}
 
-	resp, err := s.httpClient.Post(s.webhookURL, "application/json", bytes.NewBuffer(jsonPayload))
+	resp, err := s.httpClient.Post(target, "application/json", bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		// Redact before capture: the webhook URL carries a secret token in its
 		// path, and net/http's *url.Error embeds the full URL in its message.
 		redacted := utils.RedactErrorURL(err)
 		sentry.WithScope(func(scope *sentry.Scope) {
-			scope.SetTag("service", "discord")
-			scope.SetExtra("embed_title", embed.Title)
-			sentry.CaptureException(fmt.Errorf("discord webhook failed: %w", redacted))
+			scope.SetTag("service", platform)
+			scope.SetExtra("embed_title", title)
+			sentry.CaptureException(fmt.Errorf("%s webhook failed: %w", platform, redacted))
 		})
 		return
 	}
 	defer resp.Body.Close() //nolint:errcheck // deferred Close; nothing actionable on failure
 
+	if resp.StatusCode == http.StatusTooManyRequests && attempt < discordMaxAttempts {
+		delay := discordRetryDelay(resp)
+		if s.queueRetry(target, embeds, attempt+1, delay) {
+			log.Printf("%s: rate limited, retrying %q in %s (attempt %d/%d)", platform, title, delay, attempt+1, discordMaxAttempts)
+			return
+		}
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		sentry.WithScope(func(scope *sentry.Scope) {
-			scope.SetTag("service", "discord")
+			scope.SetTag("service", platform)
 			scope.SetExtra("status_code", resp.StatusCode)
-			scope.SetExtra("embed_title", embed.Title)
-			sentry.CaptureMessage(fmt.Sprintf("Discord webhook returned %d", resp.StatusCode))
+			scope.SetExtra("embed_title", title)
+			scope.SetExtra("attempt", attempt)
+			sentry.CaptureMessage(fmt.Sprintf("%s webhook returned %d", platform, resp.StatusCode))
 		})
 	}
 }
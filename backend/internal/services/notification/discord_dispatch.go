@@ -0,0 +1,273 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"psychic-homily-backend/internal/services/shared"
+)
+
+// Discord event types, used as the keys of DISCORD_WEBHOOK_ROUTES.
+const (
+	DiscordEventNewUser            = "new_user"
+	DiscordEventNewShow            = "new_show"
+	DiscordEventShowStatus         = "show_status"
+	DiscordEventShowApproved       = "show_approved"
+	DiscordEventShowRejected       = "show_rejected"
+	DiscordEventShowReport         = "show_report"
+	DiscordEventArtistReport       = "artist_report"
+	DiscordEventVenueReport        = "venue_report"
+	DiscordEventNewVenue           = "new_venue"
+	DiscordEventRadioShows         = "radio_shows"
+	DiscordEventBulkShowAction     = "bulk_show_action"
+	DiscordEventStaleDiscovery     = "stale_discovery"
+	DiscordEventSubmissionThrottle = "submission_throttle"
+	DiscordEventMaintenanceMode    = "maintenance_mode"
+)
+
+// DiscordEvents lists every event type a route can name.
+var DiscordEvents = []string{
+	DiscordEventNewUser,
+	DiscordEventNewShow,
+	DiscordEventShowStatus,
+	DiscordEventShowApproved,
+	DiscordEventShowRejected,
+	DiscordEventShowReport,
+	DiscordEventArtistReport,
+	DiscordEventVenueReport,
+	DiscordEventNewVenue,
+	DiscordEventRadioShows,
+	DiscordEventBulkShowAction,
+	DiscordEventStaleDiscovery,
+	DiscordEventSubmissionThrottle,
+	DiscordEventMaintenanceMode,
+}
+
+const (
+	// discordMaxAttempts bounds how often a payload is posted when Discord
+	// keeps answering 429.
+	discordMaxAttempts = 4
+	// discordMaxQueuedRetries caps payloads waiting out a rate limit; past
+	// it, further rate-limited payloads are dropped (and reported).
+	discordMaxQueuedRetries = 50
+	// discordMaxRetryDelay caps the wait asked for by a 429.
+	discordMaxRetryDelay = time.Minute
+	// discordFieldValueLimit is Discord's limit on an embed field value.
+	discordFieldValueLimit = 1024
+	// discordMaxFields is Discord's limit on fields per embed.
+	discordMaxFields = 25
+)
+
+// resolveDiscordRoutes turns configured routes into webhook URLs: a thread
+// ID becomes the default webhook with ?thread_id=, a URL is used as is.
+// Routes naming an unknown event are logged and ignored.
+func resolveDiscordRoutes(defaultURL string, routes map[string]string) map[string]string {
+	resolved := make(map[string]string, len(routes))
+	for event, target := range routes {
+		if !slices.Contains(DiscordEvents, event) {
+			log.Printf("WARN discord: ignoring route for unknown event %q (known: %s)", event, strings.Join(DiscordEvents, ", "))
+			continue
+		}
+		if u, err := url.Parse(target); err == nil && u.Scheme != "" {
+			resolved[event] = target
+			continue
+		}
+		u, err := url.Parse(defaultURL)
+		if err != nil {
+			continue
+		}
+		q := u.Query()
+		q.Set("thread_id", target)
+		u.RawQuery = q.Encode()
+		resolved[event] = u.String()
+	}
+	return resolved
+}
+
+// webhookFor returns the webhook URL an event is posted to.
+func (s *DiscordService) webhookFor(event string) string {
+	if target, ok := s.routes[event]; ok {
+		return target
+	}
+	return s.webhookURL
+}
+
+// dispatch posts embed for event. With a batch window, it is held until the
+// window closes and posted together with everything else bound for the same
+// webhook; otherwise it is posted right away. Never blocks on the network.
+func (s *DiscordService) dispatch(event string, embed DiscordEmbed) {
+	target := s.webhookFor(event)
+	if s.batchWindow <= 0 {
+		shared.GoSafe(context.Background(), "discord_webhook", func() { s.post(target, []DiscordEmbed{embed}, 1) })
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending == nil {
+		s.pending = make(map[string][]DiscordEmbed)
+	}
+	if _, ok := s.pending[target]; !ok {
+		s.pendingOrder = append(s.pendingOrder, target)
+	}
+	s.pending[target] = append(s.pending[target], embed)
+	if s.flushTimer == nil {
+		s.flushTimer = time.AfterFunc(s.batchWindow, s.Flush)
+	}
+}
+
+// Flush posts every held notification now: one embed as is, several as a
+// single summary embed per webhook. Called when the batch window closes and
+// on shutdown.
+func (s *DiscordService) Flush() {
+	s.mu.Lock()
+	pending, order := s.pending, s.pendingOrder
+	s.pending, s.pendingOrder = nil, nil
+	if s.flushTimer != nil {
+		s.flushTimer.Stop()
+		s.flushTimer = nil
+	}
+	s.mu.Unlock()
+
+	for _, target := range order {
+		embeds := pending[target]
+		if len(embeds) > 1 {
+			embeds = []DiscordEmbed{summarizeDiscordBatch(embeds)}
+		}
+		s.post(target, embeds, 1)
+	}
+}
+
+// summarizeDiscordBatch folds a window's notifications into one embed with
+// a field per notification title, listing each notification's headline.
+func summarizeDiscordBatch(embeds []DiscordEmbed) DiscordEmbed {
+	var titles []string
+	lines := map[string][]string{}
+	color := ColorBlue
+	for _, e := range embeds {
+		if _, ok := lines[e.Title]; !ok {
+			titles = append(titles, e.Title)
+		}
+		lines[e.Title] = append(lines[e.Title], discordHeadline(e))
+		if e.Color == ColorRed {
+			color = ColorRed
+		}
+	}
+
+	description := fmt.Sprintf("%d notifications", len(embeds))
+	if len(titles) > discordMaxFields {
+		description += fmt.Sprintf(" (%d more kinds not shown)", len(titles)-discordMaxFields)
+		titles = titles[:discordMaxFields]
+	}
+	fields := make([]DiscordEmbedField, 0, len(titles))
+	for _, title := range titles {
+		fields = append(fields, DiscordEmbedField{
+			Name:  fmt.Sprintf("%s (%d)", title, len(lines[title])),
+			Value: joinWithinLimit(lines[title], discordFieldValueLimit),
+		})
+	}
+
+	return DiscordEmbed{
+		Title:       "Notification Summary",
+		Description: description,
+		Color:       color,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Fields:      fields,
+	}
+}
+
+// discordHeadline is a one-line gist of an embed for a batch summary: its
+// description, or else its first few inline fields.
+func discordHeadline(e DiscordEmbed) string {
+	if e.Description != "" {
+		return truncateRunes(e.Description, 200)
+	}
+	var parts []string
+	for _, f := range e.Fields {
+		if !f.Inline {
+			continue
+		}
+		parts = append(parts, f.Name+": "+f.Value)
+		if len(parts) == 3 {
+			break
+		}
+	}
+	if len(parts) == 0 {
+		return "(no details)"
+	}
+	return truncateRunes(strings.Join(parts, " · "), 200)
+}
+
+// joinWithinLimit joins lines with newlines, ending with an "…and N more"
+// tail once the rest would push the value past limit characters (Discord
+// counts characters, not bytes).
+func joinWithinLimit(lines []string, limit int) string {
+	out := ""
+	for i, line := range lines {
+		next := line
+		if i > 0 {
+			next = out + "\n" + line
+		}
+		reserve := 0
+		if rest := len(lines) - i - 1; rest > 0 {
+			reserve = utf8.RuneCountInString(fmt.Sprintf("\n…and %d more", rest))
+		}
+		if utf8.RuneCountInString(next)+reserve > limit {
+			return strings.TrimPrefix(out+fmt.Sprintf("\n…and %d more", len(lines)-i), "\n")
+		}
+		out = next
+	}
+	return out
+}
+
+// truncateRunes shortens s to at most n runes, marking the cut with "…".
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
+
+// discordRateLimit is the body of a Discord 429.
+type discordRateLimit struct {
+	RetryAfter float64 `json:"retry_after"`
+}
+
+// discordRetryDelay reads how long a 429 asks us to wait, from the body's
+// retry_after or the Retry-After header (both seconds), capped at
+// discordMaxRetryDelay.
+func discordRetryDelay(resp *http.Response) time.Duration {
+	delay := time.Second
+	var body discordRateLimit
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<10)).Decode(&body); err == nil && body.RetryAfter > 0 {
+		delay = time.Duration(body.RetryAfter * float64(time.Second))
+	} else if secs, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && secs > 0 {
+		delay = time.Duration(secs * float64(time.Second))
+	}
+	return min(delay, discordMaxRetryDelay)
+}
+
+// queueRetry posts the payload again after delay, unless the retry queue
+// is full. Reports whether it was queued.
+func (s *DiscordService) queueRetry(target string, embeds []DiscordEmbed, attempt int, delay time.Duration) bool {
+	if s.queuedRetries.Add(1) > discordMaxQueuedRetries {
+		s.queuedRetries.Add(-1)
+		return false
+	}
+	time.AfterFunc(delay, func() {
+		s.queuedRetries.Add(-1)
+		s.post(target, embeds, attempt)
+	})
+	return true
+}
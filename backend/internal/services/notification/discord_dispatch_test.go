@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDiscordRoutes(t *testing.T) {
+	routes := resolveDiscordRoutes("https://discord.com/api/webhooks/1/abc", map[string]string{
+		DiscordEventNewShow:    "98765",
+		DiscordEventShowReport: "https://discord.com/api/webhooks/2/def",
+		"not_an_event":         "12345",
+	})
+	assert.Equal(t, map[string]string{
+		DiscordEventNewShow:    "https://discord.com/api/webhooks/1/abc?thread_id=98765",
+		DiscordEventShowReport: "https://discord.com/api/webhooks/2/def",
+	}, routes)
+}
+
+func TestDispatch_RoutesEventToItsWebhook(t *testing.T) {
+	svc, defaultPayloads, _ := setupDiscordTest(t)
+	routed := make(chan string, 10)
+	reports := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		routed <- r.URL.Query().Get("thread_id")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(reports.Close)
+	svc.routes = map[string]string{DiscordEventShowReport: reports.URL + "?thread_id=42"}
+
+	svc.dispatch(DiscordEventShowReport, DiscordEmbed{Title: "Show Report"})
+	select {
+	case threadID := <-routed:
+		assert.Equal(t, "42", threadID)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for routed payload")
+	}
+	assertNoPayload(t, defaultPayloads)
+
+	svc.dispatch(DiscordEventNewUser, DiscordEmbed{Title: "New User Registration"})
+	waitForPayload(t, defaultPayloads)
+}
+
+func TestDispatch_BatchesIntoOneSummary(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+	svc.batchWindow = 50 * time.Millisecond
+
+	svc.dispatch(DiscordEventNewShow, DiscordEmbed{Title: "New Show Submission", Color: ColorBlue, Fields: []DiscordEmbedField{
+		{Name: "Show", Value: "Rock Night", Inline: true},
+		{Name: "Actions", Value: "[Review](x)"},
+	}})
+	svc.dispatch(DiscordEventNewShow, DiscordEmbed{Title: "New Show Submission", Description: "Jazz Brunch"})
+	svc.dispatch(DiscordEventSubmissionThrottle, DiscordEmbed{Title: "Submission Throttle: burst", Color: ColorRed, Description: "8 shows in 10 minutes"})
+
+	payload := parseWebhookPayload(t, waitForPayload(t, payloads))
+	require.Len(t, payload.Embeds, 1)
+	summary := payload.Embeds[0]
+	assert.Equal(t, "Notification Summary", summary.Title)
+	assert.Equal(t, "3 notifications", summary.Description)
+	assert.Equal(t, ColorRed, summary.Color)
+	require.Len(t, summary.Fields, 2)
+	assert.Equal(t, "New Show Submission (2)", summary.Fields[0].Name)
+	assert.Equal(t, "Show: Rock Night\nJazz Brunch", summary.Fields[0].Value)
+	assert.Equal(t, "Submission Throttle: burst (1)", summary.Fields[1].Name)
+	assertNoPayload(t, payloads)
+}
+
+func TestDispatch_SingleEventInWindowIsSentAsIs(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+	svc.batchWindow = time.Hour
+
+	svc.dispatch(DiscordEventNewVenue, DiscordEmbed{Title: "New Venue"})
+	assertNoPayload(t, payloads)
+
+	svc.Flush()
+	payload := parseWebhookPayload(t, waitForPayload(t, payloads))
+	require.Len(t, payload.Embeds, 1)
+	assert.Equal(t, "New Venue", payload.Embeds[0].Title)
+}
+
+func TestPost_RetriesAfterRateLimit(t *testing.T) {
+	var calls atomic.Int32
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		if calls.Add(1) == 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "You are being rate limited.", "retry_after": 0.01, "global": false}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		close(done)
+	}))
+	t.Cleanup(server.Close)
+	svc := &DiscordService{webhookURL: server.URL, enabled: true, httpClient: server.Client()}
+
+	svc.sendWebhook(DiscordEmbed{Title: "Rate Limited"})
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for retry")
+	}
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, int32(0), svc.queuedRetries.Load())
+}
+
+func TestPost_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "0.01")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+	svc := &DiscordService{webhookURL: server.URL, enabled: true, httpClient: server.Client()}
+
+	svc.sendWebhook(DiscordEmbed{Title: "Always Limited"})
+	require.Eventually(t, func() bool { return calls.Load() == discordMaxAttempts }, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(discordMaxAttempts), calls.Load())
+}
+
+func TestJoinWithinLimit(t *testing.T) {
+	lines := []string{strings.Repeat("a", 40), strings.Repeat("b", 40), strings.Repeat("c", 40)}
+	assert.Equal(t, strings.Join(lines, "\n"), joinWithinLimit(lines, 200))
+
+	got := joinWithinLimit(lines, 70)
+	assert.Equal(t, strings.Repeat("a", 40)+"\n…and 2 more", got)
+	assert.LessOrEqual(t, len(got), 70)
+}
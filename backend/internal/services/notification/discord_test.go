@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -787,6 +788,82 @@ func TestNotifyArtistReport_UnknownArtist(t *testing.T) {
 	assert.Contains(t, payload.Embeds[0].Title, "Unknown Artist")
 }
 
+// =============================================================================
+// NotifyVenueReport
+// =============================================================================
+
+func TestNotifyVenueReport_Success(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+	details := "Moved down the street"
+	report := &communitym.VenueReport{
+		ReportType: communitym.VenueReportTypeInaccurate,
+		Details:    &details,
+		Venue: catalogm.Venue{
+			Name:  "The Rebel Lounge",
+			City:  "Phoenix",
+			State: "AZ",
+		},
+	}
+	report.Venue.ID = 7
+
+	svc.NotifyVenueReport(report, "reporter@test.com")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	require.Len(t, payload.Embeds, 1)
+	assert.Contains(t, payload.Embeds[0].Title, "The Rebel Lounge")
+	assert.Equal(t, ColorOrange, payload.Embeds[0].Color)
+
+	fields := map[string]string{}
+	for _, f := range payload.Embeds[0].Fields {
+		fields[f.Name] = f.Value
+	}
+	assert.Equal(t, "Inaccurate Info", fields["Report Type"])
+	assert.Equal(t, "Phoenix, AZ", fields["Location"])
+	assert.Equal(t, "Moved down the street", fields["Details"])
+}
+
+func TestNotifyVenueReport_Closed(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+	report := &communitym.VenueReport{
+		ReportType: communitym.VenueReportTypeClosed,
+		Venue:      catalogm.Venue{Name: "Gone Club"},
+	}
+	report.Venue.ID = 1
+
+	svc.NotifyVenueReport(report, "r@t.com")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	var reportType string
+	for _, f := range payload.Embeds[0].Fields {
+		if f.Name == "Report Type" {
+			reportType = f.Value
+		}
+	}
+	assert.Equal(t, "Closed", reportType)
+}
+
+func TestNotifyVenueReport_NilReport(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	svc.NotifyVenueReport(nil, "x@y.com")
+	assertNoPayload(t, payloads)
+}
+
+func TestNotifyVenueReport_UnknownVenue(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+	report := &communitym.VenueReport{
+		ReportType: communitym.VenueReportTypeInaccurate,
+	}
+
+	svc.NotifyVenueReport(report, "r@t.com")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	assert.Contains(t, payload.Embeds[0].Title, "Unknown Venue")
+}
+
 // =============================================================================
 // NotifyNewRadioShows (PSY-671)
 // =============================================================================
@@ -842,6 +919,79 @@ func TestNotifyNewRadioShows_CapsAtTwentyFive(t *testing.T) {
 	assert.Equal(t, "Discovered 30 new show(s)", payload.Embeds[0].Description)
 }
 
+// =============================================================================
+// NotifyBulkShowAction
+// =============================================================================
+
+func TestNotifyBulkShowAction_SingleMessage(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	svc.NotifyBulkShowAction(&contracts.BulkShowActionResult{
+		Action:    contracts.BulkShowActionApprove,
+		Succeeded: 2,
+		Failed:    1,
+		Results: []contracts.BulkShowItemResult{
+			{ShowID: 1, Title: "Show One", Success: true},
+			{ShowID: 2, Title: "Show Two", Success: true},
+			{ShowID: 3, Title: "Show Three", Error: "show cannot be approved"},
+		},
+	}, "admin@test.com")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	require.Len(t, payload.Embeds, 1)
+	e := payload.Embeds[0]
+	assert.Equal(t, "Bulk Show Action: approve", e.Title)
+	assert.Equal(t, "2 succeeded, 1 failed", e.Description)
+	assert.Equal(t, ColorGreen, e.Color)
+	require.Len(t, e.Fields, 4)
+	assert.Equal(t, "Succeeded (2)", e.Fields[1].Name)
+	assert.Contains(t, e.Fields[1].Value, "#1 Show One")
+	assert.Contains(t, e.Fields[1].Value, "#2 Show Two")
+	assert.Equal(t, "Failed (1)", e.Fields[2].Name)
+	assert.Contains(t, e.Fields[2].Value, "#3 show cannot be approved")
+	assertNoPayload(t, payloads)
+}
+
+func TestNotifyBulkShowAction_TruncatesLongField(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	results := make([]contracts.BulkShowItemResult, 200)
+	for i := range results {
+		results[i] = contracts.BulkShowItemResult{ShowID: uint(i + 1), Title: strings.Repeat("é", 300), Success: true}
+	}
+	svc.NotifyBulkShowAction(&contracts.BulkShowActionResult{
+		Action:    contracts.BulkShowActionApprove,
+		Succeeded: len(results),
+		Results:   results,
+	}, "admin@test.com")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	require.Len(t, payload.Embeds, 1)
+	value := payload.Embeds[0].Fields[1].Value
+	assert.LessOrEqual(t, utf8.RuneCountInString(value), discordFieldValueLimit)
+	assert.Regexp(t, `…and \d+ more$`, value)
+	assert.NotContains(t, value, strings.Repeat("é", 300), "each title is cut before joining")
+}
+
+func TestNotifyBulkShowAction_EmptyResult(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	svc.NotifyBulkShowAction(&contracts.BulkShowActionResult{Action: contracts.BulkShowActionDelete}, "admin@test.com")
+
+	assertNoPayload(t, payloads)
+}
+
+func TestNotifyBulkShowAction_NotConfigured(t *testing.T) {
+	svc := &DiscordService{enabled: false}
+
+	svc.NotifyBulkShowAction(&contracts.BulkShowActionResult{
+		Action:  contracts.BulkShowActionCancel,
+		Results: []contracts.BulkShowItemResult{{ShowID: 1, Success: true}},
+	}, "admin@test.com")
+}
+
 // TestNotifyNewShow_RendersEventTimeInVenueTimezone is the regression for PSY-996:
 // an 8 PM Central show (stored as 01:00Z the next day) must render in venue-local
 // time, not the raw UTC instant ("Jul 10, 2026 1:00 AM").
@@ -867,3 +1017,91 @@ func TestNotifyNewShow_RendersEventTimeInVenueTimezone(t *testing.T) {
 	assert.Contains(t, payload.Embeds[0].Description, "Jul 9, 2026 8:00 PM")
 	assert.NotContains(t, payload.Embeds[0].Description, "1:00 AM")
 }
+
+// =============================================================================
+// NotifyStaleDiscoverySources
+// =============================================================================
+
+func TestNotifyStaleDiscoverySources_SingleMessage(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	last := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	svc.NotifyStaleDiscoverySources([]contracts.DiscoverySourceHealth{
+		{SourceKey: "valley-bar", LastSuccessAt: &last},
+		{SourceKey: "crescent-ballroom", ErrorStreak: 4},
+	}, 7)
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	require.Len(t, payload.Embeds, 1)
+	e := payload.Embeds[0]
+	assert.Equal(t, "Stale Discovery Sources", e.Title)
+	assert.Equal(t, "2 source(s) produced no events in 7 days", e.Description)
+	require.Len(t, e.Fields, 1)
+	assert.Contains(t, e.Fields[0].Value, "valley-bar — last events Mar 1, 2026")
+	assert.Contains(t, e.Fields[0].Value, "crescent-ballroom — last events never, 4 failed run(s)")
+}
+
+func TestNotifyStaleDiscoverySources_EmptyList(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	svc.NotifyStaleDiscoverySources(nil, 7)
+
+	assertNoPayload(t, payloads)
+}
+
+func TestNotifySubmissionThrottle_Success(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+	email := "spammer@example.com"
+	user := &authm.User{ID: 7, Email: &email, UserTier: "new_user"}
+
+	svc.NotifySubmissionThrottle(user, "Quarantined", "8 submissions in 10 minutes")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	require.Len(t, payload.Embeds, 1)
+	embed := payload.Embeds[0]
+	assert.Equal(t, "Submission Throttle: Quarantined", embed.Title)
+	assert.Equal(t, "8 submissions in 10 minutes", embed.Description)
+	assert.Equal(t, ColorRed, embed.Color)
+	assert.Equal(t, "7", embed.Fields[0].Value)
+	assert.Equal(t, "sp***@example.com", embed.Fields[1].Value)
+	assert.Equal(t, "new_user", embed.Fields[2].Value)
+}
+
+func TestNotifySubmissionThrottle_NilUser(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	svc.NotifySubmissionThrottle(nil, "Quarantined", "")
+	assertNoPayload(t, payloads)
+}
+
+func TestNotifyMaintenanceMode_Enabled(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	svc.NotifyMaintenanceMode(true, "feature flag", "admin@example.com")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	require.Len(t, payload.Embeds, 1)
+	embed := payload.Embeds[0]
+	assert.Equal(t, "Maintenance Mode Enabled", embed.Title)
+	assert.Equal(t, ColorRed, embed.Color)
+	require.Len(t, embed.Fields, 2)
+	assert.Equal(t, "feature flag", embed.Fields[0].Value)
+	assert.Equal(t, "ad***@example.com", embed.Fields[1].Value)
+}
+
+func TestNotifyMaintenanceMode_DisabledWithoutActor(t *testing.T) {
+	svc, payloads, _ := setupDiscordTest(t)
+
+	svc.NotifyMaintenanceMode(false, "MAINTENANCE_MODE env var", "")
+
+	raw := waitForPayload(t, payloads)
+	payload := parseWebhookPayload(t, raw)
+	require.Len(t, payload.Embeds, 1)
+	embed := payload.Embeds[0]
+	assert.Equal(t, "Maintenance Mode Disabled", embed.Title)
+	assert.Equal(t, ColorGreen, embed.Color)
+	require.Len(t, embed.Fields, 1, "no Changed By field without an actor")
+}
@@ -1,8 +1,10 @@
 package notification
 
 import (
+	"context"
 	"fmt"
 	"html"
+	"log"
 	"strings"
 	"time"
 
@@ -10,34 +12,100 @@ import (
 
 	"psychic-homily-backend/internal/config"
 	"psychic-homily-backend/internal/services/contracts"
+)
 
-	"github.com/resend/resend-go/v2"
+const (
+	// emailSendAttempts is how many times a send is tried before giving up.
+	emailSendAttempts = 3
+	// emailRetryDelay is the wait before the first retry; it doubles after
+	// each attempt.
+	emailRetryDelay = 500 * time.Millisecond
 )
 
-// EmailService handles sending transactional emails via Resend
+// EmailService handles sending transactional emails through the provider
+// selected by EMAIL_PROVIDER
 type EmailService struct {
-	client      *resend.Client
-	fromEmail   string
-	frontendURL string
+	provider     EmailProvider
+	suppressions contracts.EmailSuppressionServiceInterface
+	fromEmail    string
+	frontendURL  string
+	// attempts and retryDelay control provider-level retries; zero values
+	// (as in tests) mean a single attempt.
+	attempts   int
+	retryDelay time.Duration
 }
 
-// NewEmailService creates a new email service instance
-func NewEmailService(cfg *config.Config) *EmailService {
-	var client *resend.Client
-	if cfg.Email.ResendAPIKey != "" {
-		client = resend.NewClient(cfg.Email.ResendAPIKey)
+// NewEmailService creates a new email service instance. suppressions may be
+// nil, in which case no recipient is skipped.
+func NewEmailService(cfg *config.Config, suppressions contracts.EmailSuppressionServiceInterface) *EmailService {
+	provider, err := NewEmailProvider(cfg.Email)
+	if err != nil {
+		log.Printf("WARN email: %v; email sending disabled", err)
 	}
 
 	return &EmailService{
-		client:      client,
-		fromEmail:   cfg.Email.FromEmail,
-		frontendURL: cfg.Email.FrontendURL,
+		provider:     provider,
+		suppressions: suppressions,
+		fromEmail:    cfg.Email.FromEmail,
+		frontendURL:  cfg.Email.FrontendURL,
+		attempts:     emailSendAttempts,
+		retryDelay:   emailRetryDelay,
 	}
 }
 
 // IsConfigured returns true if the email service is properly configured
 func (s *EmailService) IsConfigured() bool {
-	return s.client != nil && s.fromEmail != ""
+	return s.provider != nil && s.fromEmail != ""
+}
+
+// send delivers msg through the provider, dropping suppressed recipients
+// first and retrying failures the provider may recover from. A message
+// whose recipients are all suppressed is skipped without error.
+func (s *EmailService) send(msg *EmailMessage) error {
+	msg.To = s.deliverableRecipients(msg.To, msg.Type)
+	if len(msg.To) == 0 {
+		return nil
+	}
+
+	attempts := s.attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := s.retryDelay
+	var err error
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), emailSendTimeout)
+		err = s.provider.Send(ctx, msg)
+		cancel()
+		if err == nil || isPermanentEmailError(err) || attempt == attempts {
+			return err
+		}
+		log.Printf("WARN email: %s send via %s failed (attempt %d/%d), retrying: %v", msg.Type, s.provider.Name(), attempt, attempts, err)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// deliverableRecipients drops suppressed addresses. A failed lookup is
+// logged and the address kept: a missed suppression costs one bounce, a
+// false one loses a login link.
+func (s *EmailService) deliverableRecipients(to []string, emailType string) []string {
+	if s.suppressions == nil {
+		return to
+	}
+	kept := to[:0:0]
+	for _, addr := range to {
+		suppressed, err := s.suppressions.IsSuppressed(addr)
+		if err != nil {
+			log.Printf("WARN email: suppression check failed, sending anyway: %v", err)
+		}
+		if suppressed {
+			log.Printf("email: skipping %s email to suppressed address %s", emailType, HashEmail(addr))
+			continue
+		}
+		kept = append(kept, addr)
+	}
+	return kept
 }
 
 // SendVerificationEmail sends an email verification link to the user
@@ -48,44 +116,12 @@ func (s *EmailService) SendVerificationEmail(toEmail, token string) error {
 
 	verifyURL := fmt.Sprintf("%s/verify-email?token=%s", s.frontendURL, token)
 
-	html := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-</head>
-<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
-    <div style="text-align: center; margin-bottom: 30px;">
-        <h1 style="color: #1a1a1a; margin: 0;">Psychic Homily</h1>
-    </div>
-    
-    <div style="background: #f9f9f9; border-radius: 8px; padding: 30px; margin-bottom: 20px;">
-        <h2 style="margin-top: 0; color: #1a1a1a;">Verify Your Email Address</h2>
-        <p>Thanks for signing up! Please verify your email address to start submitting shows to the Arizona music calendar.</p>
-        <p style="text-align: center; margin: 30px 0;">
-            <a href="%s" style="display: inline-block; background: #f97316; color: white; text-decoration: none; padding: 12px 30px; border-radius: 6px; font-weight: 600;">Verify Email</a>
-        </p>
-        <p style="font-size: 14px; color: #666;">This link will expire in 24 hours.</p>
-    </div>
-    
-    <div style="text-align: center; font-size: 12px; color: #999;">
-        <p>If you didn't create an account, you can safely ignore this email.</p>
-        <p>If the button doesn't work, copy and paste this link into your browser:</p>
-        <p style="word-break: break-all; color: #666;">%s</p>
-    </div>
-</body>
-</html>
-`, verifyURL, verifyURL)
-
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
-		To:      []string{toEmail},
-		Subject: "Verify your email address - Psychic Homily",
-		Html:    html,
+	msg, err := s.templateMessage(EmailTemplateVerification, toEmail, linkEmailData{URL: verifyURL})
+	if err != nil {
+		return fmt.Errorf("failed to render verification email: %w", err)
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err = s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -106,44 +142,12 @@ func (s *EmailService) SendMagicLinkEmail(toEmail, token string) error {
 
 	magicLinkURL := fmt.Sprintf("%s/auth/magic-link?token=%s", s.frontendURL, token)
 
-	html := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-</head>
-<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
-    <div style="text-align: center; margin-bottom: 30px;">
-        <h1 style="color: #1a1a1a; margin: 0;">Psychic Homily</h1>
-    </div>
-
-    <div style="background: #f9f9f9; border-radius: 8px; padding: 30px; margin-bottom: 20px;">
-        <h2 style="margin-top: 0; color: #1a1a1a;">Sign in to your account</h2>
-        <p>Click the button below to sign in to your Psychic Homily account. This link will expire in 15 minutes.</p>
-        <p style="text-align: center; margin: 30px 0;">
-            <a href="%s" style="display: inline-block; background: #f97316; color: white; text-decoration: none; padding: 12px 30px; border-radius: 6px; font-weight: 600;">Sign In</a>
-        </p>
-        <p style="font-size: 14px; color: #666;">For security, this link expires in 15 minutes and can only be used once.</p>
-    </div>
-
-    <div style="text-align: center; font-size: 12px; color: #999;">
-        <p>If you didn't request this email, you can safely ignore it.</p>
-        <p>If the button doesn't work, copy and paste this link into your browser:</p>
-        <p style="word-break: break-all; color: #666;">%s</p>
-    </div>
-</body>
-</html>
-`, magicLinkURL, magicLinkURL)
-
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
-		To:      []string{toEmail},
-		Subject: "Sign in to Psychic Homily",
-		Html:    html,
+	msg, err := s.templateMessage(EmailTemplateMagicLink, toEmail, linkEmailData{URL: magicLinkURL})
+	if err != nil {
+		return fmt.Errorf("failed to render magic link email: %w", err)
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err = s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -164,44 +168,12 @@ func (s *EmailService) SendAccountRecoveryEmail(toEmail, token string, daysRemai
 
 	recoveryURL := fmt.Sprintf("%s/auth/recover?token=%s", s.frontendURL, token)
 
-	html := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-</head>
-<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
-    <div style="text-align: center; margin-bottom: 30px;">
-        <h1 style="color: #1a1a1a; margin: 0;">Psychic Homily</h1>
-    </div>
-
-    <div style="background: #f9f9f9; border-radius: 8px; padding: 30px; margin-bottom: 20px;">
-        <h2 style="margin-top: 0; color: #1a1a1a;">Recover Your Account</h2>
-        <p>We received a request to recover your deleted Psychic Homily account. You have <strong>%d days remaining</strong> to recover your account before it is permanently deleted.</p>
-        <p style="text-align: center; margin: 30px 0;">
-            <a href="%s" style="display: inline-block; background: #f97316; color: white; text-decoration: none; padding: 12px 30px; border-radius: 6px; font-weight: 600;">Recover Account</a>
-        </p>
-        <p style="font-size: 14px; color: #666;">This link will expire in 1 hour.</p>
-    </div>
-
-    <div style="text-align: center; font-size: 12px; color: #999;">
-        <p>If you didn't request this, you can safely ignore this email. Your account will remain scheduled for deletion.</p>
-        <p>If the button doesn't work, copy and paste this link into your browser:</p>
-        <p style="word-break: break-all; color: #666;">%s</p>
-    </div>
-</body>
-</html>
-`, daysRemaining, recoveryURL, recoveryURL)
-
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
-		To:      []string{toEmail},
-		Subject: "Recover your Psychic Homily account",
-		Html:    html,
+	msg, err := s.templateMessage(EmailTemplateAccountRecovery, toEmail, linkEmailData{URL: recoveryURL, DaysRemaining: daysRemaining})
+	if err != nil {
+		return fmt.Errorf("failed to render account recovery email: %w", err)
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err = s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -214,13 +186,28 @@ func (s *EmailService) SendAccountRecoveryEmail(toEmail, token string, daysRemai
 	return nil
 }
 
-// SendShowReminderEmail sends a reminder email ~24h before a saved show
-func (s *EmailService) SendShowReminderEmail(toEmail, showTitle, showURL, unsubscribeURL string, eventDate time.Time, venues []string) error {
+// showReminderLead phrases how far off a show is for the reminder subject
+// and heading.
+func showReminderLead(daysBefore int) string {
+	switch {
+	case daysBefore <= 1:
+		return "tomorrow"
+	case daysBefore == 7:
+		return "one week away"
+	default:
+		return fmt.Sprintf("in %d days", daysBefore)
+	}
+}
+
+// SendShowReminderEmail sends a reminder email daysBefore days ahead of a
+// saved show.
+func (s *EmailService) SendShowReminderEmail(toEmail, showTitle, showURL, unsubscribeURL string, eventDate time.Time, venues []string, daysBefore int) error {
 	if !s.IsConfigured() {
 		return fmt.Errorf("email service is not configured")
 	}
 
 	formattedDate := eventDate.Format("Monday, January 2, 2006 at 3:04 PM")
+	when := showReminderLead(daysBefore)
 	venueText := ""
 	if len(venues) > 0 {
 		venueText = fmt.Sprintf(`<p style="font-size: 16px; color: #444;">Venue: <strong>%s</strong></p>`, strings.Join(venues, ", "))
@@ -239,7 +226,7 @@ func (s *EmailService) SendShowReminderEmail(toEmail, showTitle, showURL, unsubs
     </div>
 
     <div style="background: #f9f9f9; border-radius: 8px; padding: 30px; margin-bottom: 20px;">
-        <h2 style="margin-top: 0; color: #1a1a1a;">%s is tomorrow!</h2>
+        <h2 style="margin-top: 0; color: #1a1a1a;">%s is %s!</h2>
         <p style="font-size: 16px; color: #444;">%s</p>
         %s
         <p style="text-align: center; margin: 30px 0;">
@@ -252,20 +239,21 @@ func (s *EmailService) SendShowReminderEmail(toEmail, showTitle, showURL, unsubs
     </div>
 </body>
 </html>
-`, showTitle, formattedDate, venueText, showURL, unsubscribeURL)
+`, showTitle, when, formattedDate, venueText, showURL, unsubscribeURL)
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
-		Subject: fmt.Sprintf("Reminder: %s is tomorrow", showTitle),
-		Html:    html,
+		Subject: fmt.Sprintf("Reminder: %s is %s", showTitle, when),
+		HTML:    html,
 		Headers: map[string]string{
 			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeURL),
 			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
 		},
+		Type: "show_reminder",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -285,18 +273,19 @@ func (s *EmailService) SendFilterNotificationEmail(toEmail, subject, htmlBody, u
 		return fmt.Errorf("email service is not configured")
 	}
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: subject,
-		Html:    htmlBody,
+		HTML:    htmlBody,
 		Headers: map[string]string{
 			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeURL),
 			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
 		},
+		Type: "filter_notification",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -412,15 +401,16 @@ func (s *EmailService) SendTierPromotionEmail(toEmail, username, oldTier, newTie
 </html>
 `, greeting, oldDisplayName, displayName, reason, permissionsHTML, nextTierHTML, unsubscribeCardHTML(unsubscribeURL, "tier-change emails"))
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: fmt.Sprintf("You've been promoted to %s!", displayName),
-		Html:    html,
+		HTML:    html,
 		Headers: unsubscribeHeaders(unsubscribeURL),
+		Type:    "tier_promotion",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -482,15 +472,16 @@ func (s *EmailService) SendTierDemotionEmail(toEmail, username, oldTier, newTier
 </html>
 `, greeting, oldDisplayName, newDisplayName, reason, unsubscribeCardHTML(unsubscribeURL, "tier-change emails"))
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: "Your contributor tier has changed",
-		Html:    html,
+		HTML:    html,
 		Headers: unsubscribeHeaders(unsubscribeURL),
+		Type:    "tier_demotion",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -550,15 +541,16 @@ func (s *EmailService) SendTierDemotionWarningEmail(toEmail, username, currentTi
 </html>
 `, greeting, currentRate*100, threshold*100, displayName, unsubscribeCardHTML(unsubscribeURL, "tier-change emails"))
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: "Your contributor status is at risk",
-		Html:    html,
+		HTML:    html,
 		Headers: unsubscribeHeaders(unsubscribeURL),
+		Type:    "tier_demotion_warning",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -617,15 +609,16 @@ func (s *EmailService) SendEditApprovedEmail(toEmail, username, entityType, enti
 </html>
 `, greeting, entityType, entityName, entityURL, entityTypeTitle, unsubscribeCardHTML(unsubscribeURL, "edit-review emails"))
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: fmt.Sprintf("Your edit to %s was approved!", entityName),
-		Html:    html,
+		HTML:    html,
 		Headers: unsubscribeHeaders(unsubscribeURL),
+		Type:    "edit_approved",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -694,18 +687,19 @@ func (s *EmailService) SendCommentNotification(toEmail, commenterName, entityTyp
 </html>
 `, entityName, commenterName, entityType, entityName, commentExcerpt, entityURL, entityTypeTitle, entityName, unsubscribeURL)
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: subject,
-		Html:    html,
+		HTML:    html,
 		Headers: map[string]string{
 			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeURL),
 			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
 		},
+		Type: "comment_notification",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -764,18 +758,19 @@ func (s *EmailService) SendMentionNotification(toEmail, mentionerName, entityTyp
 </html>
 `, mentionerName, entityType, entityName, commentExcerpt, commentURL, unsubscribeURL)
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: subject,
-		Html:    html,
+		HTML:    html,
 		Headers: map[string]string{
 			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeURL),
 			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
 		},
+		Type: "mention_notification",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -822,72 +817,21 @@ func (s *EmailService) SendCollectionDigestEmail(toEmail string, groups []contra
 		return fmt.Errorf("digest groups contain no items")
 	}
 
-	subject := fmt.Sprintf("Your weekly collections digest: %d new %s", totalItems, pluralize("item", totalItems))
-	if len(groups) == 1 {
-		subject = fmt.Sprintf("New this week in %s: %d %s", groups[0].CollectionTitle, totalItems, pluralize("item", totalItems))
-	}
-
-	// Render each group as its own block.
-	var groupsHTML strings.Builder
-	for _, g := range groups {
-		fmt.Fprintf(&groupsHTML, `<div style="margin-bottom: 24px;">
-				<h3 style="margin: 0 0 8px; color: #1a1a1a;"><a href="%s" style="color: #1a1a1a; text-decoration: none;">%s</a></h3>
-				<ul style="margin: 0; padding-left: 20px; color: #444;">`,
-			g.CollectionURL,
-			htmlEscape(g.CollectionTitle))
-		for _, item := range g.Items {
-			fmt.Fprintf(&groupsHTML, `<li style="margin-bottom: 4px;"><a href="%s" style="color: #f97316; text-decoration: none;">%s</a> <span style="color: #888;">(%s, added by %s)</span></li>`,
-				item.EntityURL,
-				htmlEscape(item.EntityName),
-				htmlEscape(item.EntityType),
-				htmlEscape(item.AddedBy))
-		}
-		groupsHTML.WriteString(`</ul></div>`)
-	}
-
-	html := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-</head>
-<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
-    <div style="text-align: center; margin-bottom: 30px;">
-        <h1 style="color: #1a1a1a; margin: 0;">Psychic Homily</h1>
-    </div>
-
-    <div style="background: #f9f9f9; border-radius: 8px; padding: 30px; margin-bottom: 20px;">
-        <h2 style="margin-top: 0; color: #1a1a1a;">New in your collections</h2>
-        <p style="font-size: 15px; color: #444;">Items added to collections you follow over the past week.</p>
-        %s
-    </div>
-
-    <div style="background: #fff7ed; border: 1px solid #fed7aa; border-radius: 8px; padding: 16px 20px; margin-bottom: 20px;">
-        <p style="margin: 0; font-size: 14px; color: #444;">
-            Don&rsquo;t want these weekly digests?
-            <a href="%s" style="color: #c2410c; font-weight: 600;">Unsubscribe in one click</a> &mdash;
-            no login required.
-        </p>
-    </div>
-
-    <div style="text-align: center; font-size: 12px; color: #999;">
-        <p>You&rsquo;re receiving this because you opted in to weekly digests for collections you follow on Psychic Homily.</p>
-        <p>Manage all notifications in your <a href="%s/settings" style="color: #666;">notification settings</a>.</p>
-    </div>
-</body>
-</html>
-`, groupsHTML.String(), unsubscribeURL, s.frontendURL)
-
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
-		To:      []string{toEmail},
-		Subject: subject,
-		Html:    html,
-		Headers: unsubscribeHeaders(unsubscribeURL),
+	msg, err := s.templateMessage(EmailTemplateCollectionDigest, toEmail, collectionDigestEmailData{
+		unsubscribeData: unsubscribeData{
+			UnsubscribeURL:   unsubscribeURL,
+			UnsubscribeLabel: "these weekly digests",
+			SettingsURL:      s.frontendURL + "/settings",
+		},
+		Groups:     groups,
+		TotalItems: totalItems,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render collection digest email: %w", err)
 	}
+	msg.Headers = unsubscribeHeaders(unsubscribeURL)
 
-	_, err := s.client.Emails.Send(params)
+	err = s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
@@ -901,23 +845,25 @@ func (s *EmailService) SendCollectionDigestEmail(toEmail string, groups []contra
 }
 
 // SendSceneDigestEmail sends a single batched email summarizing this-week
-// shows + new bands across every scene the recipient follows (PSY-1342).
-// Caller groups by scene and provides the rendered URLs + display titles.
+// shows + new bands across every scene the recipient follows (PSY-1342),
+// led by this week's shows from artists they follow (artistShows, may be
+// empty). Caller groups by scene and provides the rendered URLs + display
+// titles.
 //
 // Anti-spam hardening mirrors SendCollectionDigestEmail exactly: the recipient
 // must have explicitly enabled `notify_on_scene_digest` (column default FALSE /
 // opt-IN; the digest service filters on it — this is a dumb sender), and the
 // RFC 8058 / RFC 2369 List-Unsubscribe headers + the prominent in-body opt-out
 // card use the same HMAC-signed `unsubscribeURL` (GET page + one-click POST).
-func (s *EmailService) SendSceneDigestEmail(toEmail string, groups []contracts.SceneDigestGroup, unsubscribeURL string) error {
+func (s *EmailService) SendSceneDigestEmail(toEmail string, groups []contracts.SceneDigestGroup, artistShows []contracts.SceneDigestShow, unsubscribeURL string) error {
 	if !s.IsConfigured() {
 		return fmt.Errorf("email service is not configured")
 	}
-	if len(groups) == 0 {
+	if len(groups) == 0 && len(artistShows) == 0 {
 		return fmt.Errorf("no scene digest groups provided")
 	}
 
-	totalShows, totalArtists := 0, 0
+	totalShows, totalArtists := len(artistShows), 0
 	for _, g := range groups {
 		totalShows += len(g.Shows)
 		totalArtists += len(g.NewArtists)
@@ -926,90 +872,62 @@ func (s *EmailService) SendSceneDigestEmail(toEmail string, groups []contracts.S
 		return fmt.Errorf("scene digest groups contain no content")
 	}
 
-	subject := "Your followed scenes this week on Psychic Homily"
-	if len(groups) == 1 {
-		subject = fmt.Sprintf("This week in %s", groups[0].SceneName)
+	msg, err := s.templateMessage(EmailTemplateSceneDigest, toEmail, sceneDigestEmailData{
+		unsubscribeData: unsubscribeData{
+			UnsubscribeURL:   unsubscribeURL,
+			UnsubscribeLabel: "weekly scene digests",
+			SettingsURL:      s.frontendURL + "/settings",
+		},
+		Groups:      groups,
+		ArtistShows: artistShows,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render scene digest email: %w", err)
 	}
+	msg.Headers = unsubscribeHeaders(unsubscribeURL)
 
-	// Render each scene as its own block: shows sub-list, then new-bands sub-list.
-	var groupsHTML strings.Builder
-	for _, g := range groups {
-		fmt.Fprintf(&groupsHTML, `<div style="margin-bottom: 28px;">
-				<h3 style="margin: 0 0 8px; color: #1a1a1a;"><a href="%s" style="color: #1a1a1a; text-decoration: none;">%s</a></h3>`,
-			g.SceneURL, htmlEscape(g.SceneName))
-		if len(g.Shows) > 0 {
-			groupsHTML.WriteString(`<p style="margin: 4px 0; font-size: 13px; font-weight: 600; color: #666; text-transform: uppercase; letter-spacing: 0.04em;">This week</p>`)
-			groupsHTML.WriteString(`<ul style="margin: 0 0 10px; padding-left: 20px; color: #444;">`)
-			for _, sh := range g.Shows {
-				venue := ""
-				if sh.VenueName != "" {
-					venue = " · " + htmlEscape(sh.VenueName)
-				}
-				fmt.Fprintf(&groupsHTML, `<li style="margin-bottom: 4px;"><a href="%s" style="color: #f97316; text-decoration: none;">%s</a> <span style="color: #888;">(%s%s)</span></li>`,
-					sh.ShowURL, htmlEscape(sh.DisplayTitle), htmlEscape(sh.Date), venue)
-			}
-			groupsHTML.WriteString(`</ul>`)
-		}
-		if len(g.NewArtists) > 0 {
-			groupsHTML.WriteString(`<p style="margin: 4px 0; font-size: 13px; font-weight: 600; color: #666; text-transform: uppercase; letter-spacing: 0.04em;">New bands based here</p>`)
-			groupsHTML.WriteString(`<ul style="margin: 0; padding-left: 20px; color: #444;">`)
-			for _, a := range g.NewArtists {
-				fmt.Fprintf(&groupsHTML, `<li style="margin-bottom: 4px;"><a href="%s" style="color: #f97316; text-decoration: none;">%s</a></li>`,
-					a.ArtistURL, htmlEscape(a.Name))
-			}
-			if g.MoreNewArtists > 0 {
-				fmt.Fprintf(&groupsHTML, `<li style="margin-bottom: 4px; list-style: none; color: #888;"><a href="%s" style="color: #888;">+%d more new %s — see the scene</a></li>`,
-					g.SceneURL, g.MoreNewArtists, pluralize("band", g.MoreNewArtists))
-			}
-			groupsHTML.WriteString(`</ul>`)
-		}
-		groupsHTML.WriteString(`</div>`)
+	err = s.send(msg)
+	if err != nil {
+		sentry.WithScope(func(scope *sentry.Scope) {
+			scope.SetTag("service", "email")
+			scope.SetTag("email_type", "scene_digest")
+			sentry.CaptureException(err)
+		})
+		return fmt.Errorf("failed to send scene digest email: %w", err)
 	}
 
-	html := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-</head>
-<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
-    <div style="text-align: center; margin-bottom: 30px;">
-        <h1 style="color: #1a1a1a; margin: 0;">Psychic Homily</h1>
-    </div>
-
-    <div style="background: #f9f9f9; border-radius: 8px; padding: 30px; margin-bottom: 20px;">
-        <h2 style="margin-top: 0; color: #1a1a1a;">Your scenes this week</h2>
-        <p style="font-size: 15px; color: #444;">Shows happening this week and new bands, for the scenes you follow.</p>
-        %s
-    </div>
-
-    %s
+	return nil
+}
 
-    <div style="text-align: center; font-size: 12px; color: #999;">
-        <p>You&rsquo;re receiving this because you opted in to weekly scene digests on Psychic Homily.</p>
-        <p>Manage all notifications in your <a href="%s/settings" style="color: #666;">notification settings</a>.</p>
-    </div>
-</body>
-</html>
-`, groupsHTML.String(), unsubscribeCardHTML(unsubscribeURL, "weekly scene digests"), s.frontendURL)
+// SendShowStatusChangeEmail tells a user that a show they saved or RSVP'd
+// to was cancelled or postponed. The caller checks the saved_show_change
+// preference; unsubscribeURL turns that preference off.
+func (s *EmailService) SendShowStatusChangeEmail(toEmail string, change contracts.ShowStatusChange, unsubscribeURL string) error {
+	if !s.IsConfigured() {
+		return fmt.Errorf("email service is not configured")
+	}
 
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
-		To:      []string{toEmail},
-		Subject: subject,
-		Html:    html,
-		Headers: unsubscribeHeaders(unsubscribeURL),
+	msg, err := s.templateMessage(EmailTemplateShowStatusChange, toEmail, showStatusChangeEmailData{
+		unsubscribeData: unsubscribeData{
+			UnsubscribeURL:   unsubscribeURL,
+			UnsubscribeLabel: "emails about changes to shows you saved",
+			SettingsURL:      s.frontendURL + "/settings",
+		},
+		ShowStatusChange: change,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render show status change email: %w", err)
 	}
+	msg.Headers = unsubscribeHeaders(unsubscribeURL)
 
-	_, err := s.client.Emails.Send(params)
+	err = s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
-			scope.SetTag("email_type", "scene_digest")
+			scope.SetTag("email_type", "show_status_change")
 			sentry.CaptureException(err)
 		})
-		return fmt.Errorf("failed to send scene digest email: %w", err)
+		return fmt.Errorf("failed to send show status change email: %w", err)
 	}
 
 	return nil
@@ -1113,15 +1031,16 @@ func (s *EmailService) SendEditRejectedEmail(toEmail, username, entityType, enti
 </html>
 `, entityName, greeting, entityType, entityName, rejectionReason, unsubscribeCardHTML(unsubscribeURL, "edit-review emails"))
 
-	params := &resend.SendEmailRequest{
+	msg := &EmailMessage{
 		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
 		To:      []string{toEmail},
 		Subject: fmt.Sprintf("Update on your edit to %s", entityName),
-		Html:    html,
+		HTML:    html,
 		Headers: unsubscribeHeaders(unsubscribeURL),
+		Type:    "edit_rejected",
 	}
 
-	_, err := s.client.Emails.Send(params)
+	err := s.send(msg)
 	if err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "email")
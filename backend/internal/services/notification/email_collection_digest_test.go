@@ -2,6 +2,7 @@ package notification
 
 import (
 	"encoding/json"
+	"html"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -49,7 +50,7 @@ func setupDigestEmailTest(t *testing.T) (*EmailService, chan capturedDigestEmail
 	client.BaseURL = serverURL
 
 	service := &EmailService{
-		client:      client,
+		provider:    &resendProvider{client: client},
 		fromEmail:   "noreply@test.com",
 		frontendURL: "http://localhost:3000",
 	}
@@ -97,7 +98,7 @@ func TestSendCollectionDigest_WeeklyCopy_Headers_OptOutBlock(t *testing.T) {
 	// --- The in-body opt-out block must be prominent (its own visible
 	// section above the footer) — we look for the user-facing "in one click"
 	// copy and the unsubscribe URL itself.
-	assert.Contains(t, email.Html, unsubURL,
+	assert.Contains(t, email.Html, html.EscapeString(unsubURL),
 		"the unsubscribe URL must appear as a clickable link in the email body")
 	assert.Contains(t, email.Html, "one click",
 		"the in-body unsubscribe block must communicate the one-click affordance")
@@ -37,7 +37,7 @@ func TestSendEditApprovedEmail_Success(t *testing.T) {
 }
 
 func TestSendEditApprovedEmail_NotConfigured(t *testing.T) {
-	svc := &EmailService{client: nil, fromEmail: ""}
+	svc := &EmailService{provider: nil, fromEmail: ""}
 
 	err := svc.SendEditApprovedEmail("user@test.com", "user", "artist", "Band", "http://example.com", "http://unsub")
 
@@ -120,7 +120,7 @@ func TestSendEditRejectedEmail_Success(t *testing.T) {
 }
 
 func TestSendEditRejectedEmail_NotConfigured(t *testing.T) {
-	svc := &EmailService{client: nil, fromEmail: ""}
+	svc := &EmailService{provider: nil, fromEmail: ""}
 
 	err := svc.SendEditRejectedEmail("user@test.com", "user", "artist", "Band", "reason", "http://unsub")
 
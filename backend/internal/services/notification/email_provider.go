@@ -0,0 +1,204 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v2"
+
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/httpclient"
+)
+
+// Email providers selectable via EMAIL_PROVIDER.
+const (
+	// EmailProviderResend is the Resend API (the default).
+	EmailProviderResend = "resend"
+	// EmailProviderPostmark is the Postmark API.
+	EmailProviderPostmark = "postmark"
+	// EmailProviderSMTP is any SMTP relay, including Amazon SES's SMTP
+	// interface (SMTP_HOST=email-smtp.<region>.amazonaws.com).
+	EmailProviderSMTP = "smtp"
+)
+
+// EmailProviders lists the accepted EMAIL_PROVIDER values.
+var EmailProviders = []string{EmailProviderResend, EmailProviderPostmark, EmailProviderSMTP}
+
+const (
+	defaultPostmarkURL = "https://api.postmarkapp.com"
+	emailSendTimeout   = 15 * time.Second
+)
+
+// EmailMessage is one outgoing email, independent of the provider sending it.
+type EmailMessage struct {
+	From    string // "Name <address>"
+	To      []string
+	Subject string
+	HTML    string
+	// Text is the plain-text alternative; empty sends HTML only.
+	Text    string
+	Headers map[string]string
+	// Type names the email (verification, show_reminder, ...) for provider
+	// tagging and error reports.
+	Type string
+}
+
+// EmailProvider sends email through one provider's API.
+type EmailProvider interface {
+	// Name returns the EMAIL_PROVIDER value the provider was selected by.
+	Name() string
+	// Send delivers msg. Errors the provider will keep returning for the
+	// same message (bad address, rejected sender) are wrapped with
+	// permanentEmailError so they are not retried.
+	Send(ctx context.Context, msg *EmailMessage) error
+}
+
+// permanentEmailError marks a send failure that retrying won't fix.
+type permanentEmailError struct {
+	err error
+}
+
+func (e *permanentEmailError) Error() string { return e.err.Error() }
+func (e *permanentEmailError) Unwrap() error { return e.err }
+
+// isPermanentEmailError reports whether err should not be retried.
+func isPermanentEmailError(err error) bool {
+	var perm *permanentEmailError
+	return errors.As(err, &perm)
+}
+
+// NewEmailProvider builds the configured provider. It returns (nil, nil)
+// when the provider has no credentials: email is optional in development and
+// EmailService reports itself unconfigured.
+func NewEmailProvider(cfg config.EmailConfig) (EmailProvider, error) {
+	if !cfg.Configured() {
+		return nil, nil
+	}
+	switch cfg.ProviderName() {
+	case EmailProviderResend:
+		return &resendProvider{client: resend.NewClient(cfg.ResendAPIKey)}, nil
+	case EmailProviderPostmark:
+		return &postmarkProvider{
+			baseURL:       defaultPostmarkURL,
+			serverToken:   cfg.PostmarkServerToken,
+			messageStream: cfg.PostmarkMessageStream,
+			client:        httpclient.New(emailSendTimeout),
+		}, nil
+	case EmailProviderSMTP:
+		return &smtpProvider{
+			host:     cfg.SMTPHost,
+			port:     cfg.SMTPPort,
+			username: cfg.SMTPUsername,
+			password: cfg.SMTPPassword,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown email provider %q (want one of: %s)", cfg.Provider, strings.Join(EmailProviders, ", "))
+}
+
+// resendProvider sends through the Resend API. The client doesn't expose
+// response status codes, so every failure is treated as retryable.
+type resendProvider struct {
+	client *resend.Client
+}
+
+func (p *resendProvider) Name() string { return EmailProviderResend }
+
+func (p *resendProvider) Send(ctx context.Context, msg *EmailMessage) error {
+	params := &resend.SendEmailRequest{
+		From:    msg.From,
+		To:      msg.To,
+		Subject: msg.Subject,
+		Html:    msg.HTML,
+		Text:    msg.Text,
+		Headers: msg.Headers,
+	}
+	if msg.Type != "" {
+		params.Tags = []resend.Tag{{Name: "email_type", Value: msg.Type}}
+	}
+	_, err := p.client.Emails.SendWithContext(ctx, params)
+	return err
+}
+
+// postmarkProvider sends through Postmark's single-email API.
+type postmarkProvider struct {
+	baseURL       string
+	serverToken   string
+	messageStream string
+	client        *http.Client
+}
+
+type postmarkHeader struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+type postmarkEmailRequest struct {
+	From          string           `json:"From"`
+	To            string           `json:"To"`
+	Subject       string           `json:"Subject"`
+	HtmlBody      string           `json:"HtmlBody"`
+	TextBody      string           `json:"TextBody,omitempty"`
+	Headers       []postmarkHeader `json:"Headers,omitempty"`
+	Tag           string           `json:"Tag,omitempty"`
+	MessageStream string           `json:"MessageStream,omitempty"`
+}
+
+type postmarkEmailResponse struct {
+	ErrorCode int    `json:"ErrorCode"`
+	Message   string `json:"Message"`
+}
+
+func (p *postmarkProvider) Name() string { return EmailProviderPostmark }
+
+func (p *postmarkProvider) Send(ctx context.Context, msg *EmailMessage) error {
+	body := postmarkEmailRequest{
+		From:          msg.From,
+		To:            strings.Join(msg.To, ","),
+		Subject:       msg.Subject,
+		HtmlBody:      msg.HTML,
+		TextBody:      msg.Text,
+		Tag:           msg.Type,
+		MessageStream: p.messageStream,
+	}
+	for name, value := range msg.Headers {
+		body.Headers = append(body.Headers, postmarkHeader{Name: name, Value: value})
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return &permanentEmailError{err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/email", bytes.NewReader(payload))
+	if err != nil {
+		return &permanentEmailError{err: err}
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Postmark-Server-Token", p.serverToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck // deferred Close; nothing actionable on failure
+
+	var result postmarkEmailResponse
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result)
+	if resp.StatusCode == http.StatusOK && result.ErrorCode == 0 {
+		return nil
+	}
+	err = fmt.Errorf("postmark returned status %d (error code %d): %s", resp.StatusCode, result.ErrorCode, result.Message)
+	// 429 and 5xx are transient; any other 4xx (bad token, inactive
+	// recipient, invalid sender) will fail the same way again.
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return err
+	}
+	return &permanentEmailError{err: err}
+}
@@ -0,0 +1,279 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psychic-homily-backend/internal/config"
+)
+
+// =============================================================================
+// NewEmailProvider
+// =============================================================================
+
+func TestNewEmailProvider_SelectsConfiguredProvider(t *testing.T) {
+	cases := []struct {
+		cfg  config.EmailConfig
+		want string
+	}{
+		{config.EmailConfig{ResendAPIKey: "re_123"}, EmailProviderResend},
+		{config.EmailConfig{Provider: "postmark", PostmarkServerToken: "pm-token"}, EmailProviderPostmark},
+		{config.EmailConfig{Provider: "smtp", SMTPHost: "email-smtp.us-west-2.amazonaws.com", SMTPPort: 587}, EmailProviderSMTP},
+	}
+	for _, tc := range cases {
+		t.Run(tc.want, func(t *testing.T) {
+			provider, err := NewEmailProvider(tc.cfg)
+			require.NoError(t, err)
+			require.NotNil(t, provider)
+			assert.Equal(t, tc.want, provider.Name())
+		})
+	}
+}
+
+func TestNewEmailProvider_NoCredentials(t *testing.T) {
+	provider, err := NewEmailProvider(config.EmailConfig{Provider: "postmark", ResendAPIKey: "re_123"})
+	require.NoError(t, err)
+	assert.Nil(t, provider, "postmark without a server token is unconfigured, whatever else is set")
+}
+
+// =============================================================================
+// Postmark
+// =============================================================================
+
+func newTestPostmarkProvider(t *testing.T, handler http.HandlerFunc) *postmarkProvider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &postmarkProvider{
+		baseURL:       server.URL,
+		serverToken:   "pm-token",
+		messageStream: "outbound",
+		client:        server.Client(),
+	}
+}
+
+func TestPostmarkProvider_Send(t *testing.T) {
+	var got postmarkEmailRequest
+	p := newTestPostmarkProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/email", r.URL.Path)
+		assert.Equal(t, "pm-token", r.Header.Get("X-Postmark-Server-Token"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_ = json.NewEncoder(w).Encode(postmarkEmailResponse{ErrorCode: 0, Message: "OK"})
+	})
+
+	err := p.Send(context.Background(), &EmailMessage{
+		From:    "Psychic Homily <noreply@test.com>",
+		To:      []string{"a@test.com", "b@test.com"},
+		Subject: "Hello",
+		HTML:    "<p>Hi</p>",
+		Headers: map[string]string{"List-Unsubscribe": "<https://x>"},
+		Type:    "verification",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a@test.com,b@test.com", got.To)
+	assert.Equal(t, "<p>Hi</p>", got.HtmlBody)
+	assert.Equal(t, "verification", got.Tag)
+	assert.Equal(t, "outbound", got.MessageStream)
+	assert.Equal(t, []postmarkHeader{{Name: "List-Unsubscribe", Value: "<https://x>"}}, got.Headers)
+}
+
+func TestPostmarkProvider_ClassifiesErrors(t *testing.T) {
+	cases := []struct {
+		status    int
+		permanent bool
+	}{
+		{http.StatusUnprocessableEntity, true},
+		{http.StatusUnauthorized, true},
+		{http.StatusTooManyRequests, false},
+		{http.StatusServiceUnavailable, false},
+	}
+	for _, tc := range cases {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			p := newTestPostmarkProvider(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(postmarkEmailResponse{ErrorCode: 406, Message: "Inactive recipient"})
+			})
+			err := p.Send(context.Background(), &EmailMessage{From: "x@test.com", To: []string{"a@test.com"}})
+			require.Error(t, err)
+			assert.Equal(t, tc.permanent, isPermanentEmailError(err))
+		})
+	}
+}
+
+// =============================================================================
+// SMTP
+// =============================================================================
+
+func TestBuildSMTPMessage(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	data, err := buildSMTPMessage(&EmailMessage{
+		From:    "Psychic Homily <noreply@test.com>",
+		To:      []string{"a@test.com"},
+		Subject: "Café show",
+		HTML:    "<p>Hi</p>",
+		Headers: map[string]string{"list-unsubscribe": "<https://x>"},
+	}, now)
+	require.NoError(t, err)
+
+	head, body, ok := strings.Cut(string(data), "\r\n\r\n")
+	head += "\r\n"
+	require.True(t, ok)
+	assert.Contains(t, head, "From: Psychic Homily <noreply@test.com>\r\n")
+	assert.Contains(t, head, "To: a@test.com\r\n")
+	assert.Contains(t, head, "Subject: =?utf-8?q?Caf=C3=A9_show?=\r\n")
+	assert.Contains(t, head, "List-Unsubscribe: <https://x>\r\n")
+	assert.Contains(t, head, "Content-Type: text/html; charset=UTF-8\r\n")
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Hi</p>", string(decoded))
+}
+
+func TestBuildSMTPMessage_TextAlternative(t *testing.T) {
+	data, err := buildSMTPMessage(&EmailMessage{
+		From:    "noreply@test.com",
+		To:      []string{"a@test.com"},
+		Subject: "Hello",
+		HTML:    "<p>Hi</p>",
+		Text:    "Hi",
+	}, time.Now())
+	require.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	var parts []string
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, p))
+		require.NoError(t, err)
+		parts = append(parts, p.Header.Get("Content-Type")+" "+string(body))
+	}
+	assert.Equal(t, []string{"text/plain; charset=UTF-8 Hi", "text/html; charset=UTF-8 <p>Hi</p>"}, parts)
+}
+
+func TestBuildSMTPMessage_RejectsHeaderInjection(t *testing.T) {
+	_, err := buildSMTPMessage(&EmailMessage{
+		From:    "noreply@test.com",
+		To:      []string{"a@test.com"},
+		Headers: map[string]string{"X-Note": "hi\r\nBcc: victim@test.com"},
+	}, time.Now())
+	assert.Error(t, err)
+}
+
+func TestClassifySMTPError(t *testing.T) {
+	assert.True(t, isPermanentEmailError(classifySMTPError(&textproto.Error{Code: 550, Msg: "mailbox unavailable"})))
+	assert.False(t, isPermanentEmailError(classifySMTPError(&textproto.Error{Code: 451, Msg: "try again later"})))
+	assert.False(t, isPermanentEmailError(classifySMTPError(errors.New("connection reset"))))
+}
+
+// =============================================================================
+// EmailService.send: retries and suppression
+// =============================================================================
+
+// fakeEmailProvider fails the first failures sends with err, then succeeds.
+type fakeEmailProvider struct {
+	failures int
+	err      error
+	sent     []*EmailMessage
+	calls    int
+}
+
+func (p *fakeEmailProvider) Name() string { return "fake" }
+
+func (p *fakeEmailProvider) Send(_ context.Context, msg *EmailMessage) error {
+	p.calls++
+	if p.calls <= p.failures {
+		return p.err
+	}
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+type fakeSuppressionList struct {
+	suppressed map[string]bool
+	err        error
+}
+
+func (f *fakeSuppressionList) IsSuppressed(email string) (bool, error) {
+	return f.suppressed[email], f.err
+}
+func (f *fakeSuppressionList) Suppress(string, string, string, string) error { return nil }
+func (f *fakeSuppressionList) IngestBounceWebhook(string, []byte) (int, error) {
+	return 0, nil
+}
+
+func TestEmailServiceSend_RetriesTransientErrors(t *testing.T) {
+	provider := &fakeEmailProvider{failures: 2, err: errors.New("timeout")}
+	svc := &EmailService{provider: provider, fromEmail: "noreply@test.com", attempts: 3}
+
+	require.NoError(t, svc.send(&EmailMessage{To: []string{"a@test.com"}}))
+	assert.Equal(t, 3, provider.calls)
+}
+
+func TestEmailServiceSend_GivesUpAfterAttempts(t *testing.T) {
+	provider := &fakeEmailProvider{failures: 5, err: errors.New("timeout")}
+	svc := &EmailService{provider: provider, fromEmail: "noreply@test.com", attempts: 3}
+
+	assert.Error(t, svc.send(&EmailMessage{To: []string{"a@test.com"}}))
+	assert.Equal(t, 3, provider.calls)
+}
+
+func TestEmailServiceSend_DoesNotRetryPermanentErrors(t *testing.T) {
+	provider := &fakeEmailProvider{failures: 5, err: &permanentEmailError{err: errors.New("invalid recipient")}}
+	svc := &EmailService{provider: provider, fromEmail: "noreply@test.com", attempts: 3}
+
+	assert.Error(t, svc.send(&EmailMessage{To: []string{"a@test.com"}}))
+	assert.Equal(t, 1, provider.calls)
+}
+
+func TestEmailServiceSend_SkipsSuppressedRecipients(t *testing.T) {
+	provider := &fakeEmailProvider{}
+	svc := &EmailService{
+		provider:     provider,
+		fromEmail:    "noreply@test.com",
+		suppressions: &fakeSuppressionList{suppressed: map[string]bool{"bounced@test.com": true}},
+	}
+
+	require.NoError(t, svc.SendVerificationEmail("bounced@test.com", "token"))
+	assert.Zero(t, provider.calls, "a message to only suppressed recipients is not sent")
+
+	require.NoError(t, svc.send(&EmailMessage{To: []string{"bounced@test.com", "ok@test.com"}}))
+	require.Len(t, provider.sent, 1)
+	assert.Equal(t, []string{"ok@test.com"}, provider.sent[0].To)
+}
+
+func TestEmailServiceSend_SendsWhenSuppressionCheckFails(t *testing.T) {
+	provider := &fakeEmailProvider{}
+	svc := &EmailService{
+		provider:     provider,
+		fromEmail:    "noreply@test.com",
+		suppressions: &fakeSuppressionList{err: errors.New("db down")},
+	}
+
+	require.NoError(t, svc.send(&EmailMessage{To: []string{"a@test.com"}}))
+	assert.Equal(t, 1, provider.calls)
+}
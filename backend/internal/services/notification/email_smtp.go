@@ -0,0 +1,184 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// smtpProvider sends through an SMTP relay. Port 465 uses implicit TLS;
+// any other port upgrades with STARTTLS when the server offers it, which
+// Amazon SES and most relays require before AUTH.
+type smtpProvider struct {
+	host     string
+	port     int
+	username string
+	password string
+}
+
+func (p *smtpProvider) Name() string { return EmailProviderSMTP }
+
+func (p *smtpProvider) Send(ctx context.Context, msg *EmailMessage) error {
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return &permanentEmailError{err: fmt.Errorf("invalid from address: %w", err)}
+	}
+	data, err := buildSMTPMessage(msg, time.Now())
+	if err != nil {
+		return &permanentEmailError{err: err}
+	}
+
+	conn, err := p.dial(ctx)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(emailSendTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, p.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close() //nolint:errcheck // deferred Close; Quit already reported any failure
+
+	if p.port != 465 {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: p.host, MinVersion: tls.VersionTLS12}); err != nil {
+				return err
+			}
+		}
+	}
+	if p.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", p.username, p.password, p.host)); err != nil {
+			return classifySMTPError(err)
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return classifySMTPError(err)
+	}
+	for _, to := range msg.To {
+		if err := c.Rcpt(to); err != nil {
+			return classifySMTPError(err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return classifySMTPError(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return classifySMTPError(err)
+	}
+	return c.Quit()
+}
+
+// dial opens the connection, wrapping it in TLS on the implicit-TLS port.
+func (p *smtpProvider) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(p.host, strconv.Itoa(p.port))
+	dialer := &net.Dialer{Timeout: emailSendTimeout}
+	if p.port == 465 {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: p.host, MinVersion: tls.VersionTLS12}}
+		return tlsDialer.DialContext(ctx, "tcp", addr)
+	}
+	return dialer.DialContext(ctx, "tcp", addr)
+}
+
+// classifySMTPError marks 5xx replies permanent; 4xx replies (greylisting,
+// throttling) and transport errors stay retryable.
+func classifySMTPError(err error) error {
+	var tpErr *textproto.Error
+	if errors.As(err, &tpErr) && tpErr.Code >= 500 {
+		return &permanentEmailError{err: err}
+	}
+	return err
+}
+
+// buildSMTPMessage renders msg as an RFC 5322 message: a base64 HTML body,
+// or a multipart/alternative body when msg.Text is set. Header values
+// containing CR or LF are rejected.
+func buildSMTPMessage(msg *EmailMessage, now time.Time) ([]byte, error) {
+	headers := map[string]string{
+		"From":         msg.From,
+		"To":           strings.Join(msg.To, ", "),
+		"Subject":      mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date":         now.Format(time.RFC1123Z),
+		"MIME-Version": "1.0",
+	}
+
+	var body bytes.Buffer
+	if msg.Text == "" {
+		headers["Content-Type"] = "text/html; charset=UTF-8"
+		headers["Content-Transfer-Encoding"] = "base64"
+		writeBase64Lines(&body, msg.HTML)
+	} else {
+		mw := multipart.NewWriter(&body)
+		headers["Content-Type"] = mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": mw.Boundary()})
+		// Least preferred first (RFC 2046 §5.1.4).
+		for _, part := range []struct{ contentType, content string }{
+			{"text/plain; charset=UTF-8", msg.Text},
+			{"text/html; charset=UTF-8", msg.HTML},
+		} {
+			w, err := mw.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {part.contentType},
+				"Content-Transfer-Encoding": {"base64"},
+			})
+			if err != nil {
+				return nil, err
+			}
+			writeBase64Lines(w, part.content)
+		}
+		if err := mw.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, value := range msg.Headers {
+		headers[textproto.CanonicalMIMEHeaderKey(name)] = value
+	}
+	names := make([]string, 0, len(headers))
+	for name, value := range headers {
+		if strings.ContainsAny(name+value, "\r\n") {
+			return nil, fmt.Errorf("header %q contains a line break", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, headers[name])
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+// writeBase64Lines writes content base64-encoded in 76-character lines.
+func writeBase64Lines(w io.Writer, content string) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(content))
+	for len(encoded) > 76 {
+		_, _ = io.WriteString(w, encoded[:76]+"\r\n")
+		encoded = encoded[76:]
+	}
+	_, _ = io.WriteString(w, encoded+"\r\n")
+}
@@ -0,0 +1,257 @@
+package notification
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	notificationm "psychic-homily-backend/internal/models/notification"
+)
+
+var (
+	// ErrUnknownBounceProvider is returned for a webhook from a provider
+	// with no bounce parser.
+	ErrUnknownBounceProvider = errors.New("unknown bounce webhook provider")
+	// ErrInvalidBouncePayload is returned when a webhook body can't be parsed.
+	ErrInvalidBouncePayload = errors.New("invalid bounce webhook payload")
+)
+
+// Bounce webhook providers. SES reports bounces through SNS rather than
+// through its SMTP interface, so it has a parser but no sending driver of
+// its own.
+const BounceProviderSES = "ses"
+
+// BounceWebhookProviders lists the {provider} values accepted by
+// /webhooks/email/{provider}.
+var BounceWebhookProviders = []string{EmailProviderResend, EmailProviderPostmark, BounceProviderSES}
+
+// bounceEvent is one address a provider says should no longer be mailed.
+type bounceEvent struct {
+	Email  string
+	Reason string
+	Detail string
+}
+
+// EmailSuppressionService manages the email suppression list.
+type EmailSuppressionService struct {
+	db *gorm.DB
+}
+
+// NewEmailSuppressionService creates a new email suppression service.
+func NewEmailSuppressionService(database *gorm.DB) *EmailSuppressionService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &EmailSuppressionService{
+		db: database,
+	}
+}
+
+// normalizeSuppressedEmail lowercases and trims an address for storage and
+// lookup.
+func normalizeSuppressedEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// IsSuppressed reports whether email is on the suppression list.
+func (s *EmailSuppressionService) IsSuppressed(email string) (bool, error) {
+	if s.db == nil {
+		return false, fmt.Errorf("database not initialized")
+	}
+	var count int64
+	err := s.db.Model(&notificationm.EmailSuppression{}).
+		Where("email = ?", normalizeSuppressedEmail(email)).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check email suppression: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Suppress adds email to the suppression list. An address already on it
+// keeps its original reason.
+func (s *EmailSuppressionService) Suppress(email, reason, provider, detail string) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	_, err := s.suppress(bounceEvent{Email: email, Reason: reason, Detail: detail}, provider)
+	return err
+}
+
+// suppress inserts one event, reporting whether the address was new.
+func (s *EmailSuppressionService) suppress(ev bounceEvent, provider string) (bool, error) {
+	email := normalizeSuppressedEmail(ev.Email)
+	if email == "" || !strings.Contains(email, "@") {
+		return false, nil
+	}
+	row := &notificationm.EmailSuppression{Email: email, Reason: ev.Reason, Provider: provider}
+	if ev.Detail != "" {
+		detail := ev.Detail
+		row.Detail = &detail
+	}
+	res := s.db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "email"}}, DoNothing: true}).Create(row)
+	if res.Error != nil {
+		return false, fmt.Errorf("failed to suppress email: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// IngestBounceWebhook parses a bounce/complaint webhook from provider and
+// suppresses every address it reports. Soft bounces and other event types
+// are ignored. Returns how many addresses were newly suppressed.
+func (s *EmailSuppressionService) IngestBounceWebhook(provider string, body []byte) (int, error) {
+	if s.db == nil {
+		return 0, fmt.Errorf("database not initialized")
+	}
+	events, err := parseBounceWebhook(provider, body)
+	if err != nil {
+		return 0, err
+	}
+	added := 0
+	for _, ev := range events {
+		isNew, err := s.suppress(ev, provider)
+		if err != nil {
+			return added, err
+		}
+		if isNew {
+			added++
+		}
+	}
+	return added, nil
+}
+
+// parseBounceWebhook extracts the addresses to suppress from a provider's
+// webhook body.
+func parseBounceWebhook(provider string, body []byte) ([]bounceEvent, error) {
+	switch provider {
+	case EmailProviderResend:
+		return parseResendBounce(body)
+	case EmailProviderPostmark:
+		return parsePostmarkBounce(body)
+	case BounceProviderSES:
+		return parseSESBounce(body)
+	}
+	return nil, ErrUnknownBounceProvider
+}
+
+// parseResendBounce handles Resend's email.bounced and email.complained
+// events. Resend only reports a bounce once it is permanent.
+func parseResendBounce(body []byte) ([]bounceEvent, error) {
+	var payload struct {
+		Type string `json:"type"`
+		Data struct {
+			To     []string `json:"to"`
+			Bounce struct {
+				Message string `json:"message"`
+			} `json:"bounce"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBouncePayload, err)
+	}
+	reason := ""
+	switch payload.Type {
+	case "email.bounced":
+		reason = notificationm.EmailSuppressionBounce
+	case "email.complained":
+		reason = notificationm.EmailSuppressionComplaint
+	default:
+		return nil, nil
+	}
+	events := make([]bounceEvent, 0, len(payload.Data.To))
+	for _, to := range payload.Data.To {
+		events = append(events, bounceEvent{Email: to, Reason: reason, Detail: payload.Data.Bounce.Message})
+	}
+	return events, nil
+}
+
+// parsePostmarkBounce handles Postmark's Bounce and SpamComplaint webhooks.
+// Only bounces Postmark has deactivated the recipient for (Inactive) are
+// suppressed; transient bounces are left alone.
+func parsePostmarkBounce(body []byte) ([]bounceEvent, error) {
+	var payload struct {
+		RecordType  string `json:"RecordType"`
+		Type        string `json:"Type"`
+		Email       string `json:"Email"`
+		Inactive    bool   `json:"Inactive"`
+		Description string `json:"Description"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBouncePayload, err)
+	}
+	switch {
+	case payload.RecordType == "SpamComplaint":
+		return []bounceEvent{{Email: payload.Email, Reason: notificationm.EmailSuppressionComplaint, Detail: payload.Description}}, nil
+	case payload.RecordType == "Bounce" && payload.Inactive:
+		return []bounceEvent{{Email: payload.Email, Reason: notificationm.EmailSuppressionBounce, Detail: payload.Type + ": " + payload.Description}}, nil
+	}
+	return nil, nil
+}
+
+// parseSESBounce handles SES bounce and complaint notifications delivered
+// through an SNS HTTPS subscription. Only permanent bounces are suppressed.
+// SNS subscription confirmations are logged with their confirmation URL for
+// an operator to open; nothing is fetched automatically.
+func parseSESBounce(body []byte) ([]bounceEvent, error) {
+	var envelope struct {
+		Type         string `json:"Type"`
+		Message      string `json:"Message"`
+		SubscribeURL string `json:"SubscribeURL"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBouncePayload, err)
+	}
+	if envelope.Type == "SubscriptionConfirmation" {
+		log.Printf("SES bounce webhook: confirm the SNS subscription by opening %s", envelope.SubscribeURL)
+		return nil, nil
+	}
+	if envelope.Type != "Notification" {
+		return nil, nil
+	}
+
+	var notification struct {
+		NotificationType string `json:"notificationType"`
+		Bounce           struct {
+			BounceType        string `json:"bounceType"`
+			BounceSubType     string `json:"bounceSubType"`
+			BouncedRecipients []struct {
+				EmailAddress   string `json:"emailAddress"`
+				DiagnosticCode string `json:"diagnosticCode"`
+			} `json:"bouncedRecipients"`
+		} `json:"bounce"`
+		Complaint struct {
+			ComplainedRecipients []struct {
+				EmailAddress string `json:"emailAddress"`
+			} `json:"complainedRecipients"`
+		} `json:"complaint"`
+	}
+	if err := json.Unmarshal([]byte(envelope.Message), &notification); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBouncePayload, err)
+	}
+
+	var events []bounceEvent
+	switch notification.NotificationType {
+	case "Bounce":
+		if notification.Bounce.BounceType != "Permanent" {
+			return nil, nil
+		}
+		for _, r := range notification.Bounce.BouncedRecipients {
+			detail := notification.Bounce.BounceSubType
+			if r.DiagnosticCode != "" {
+				detail += ": " + r.DiagnosticCode
+			}
+			events = append(events, bounceEvent{Email: r.EmailAddress, Reason: notificationm.EmailSuppressionBounce, Detail: detail})
+		}
+	case "Complaint":
+		for _, r := range notification.Complaint.ComplainedRecipients {
+			events = append(events, bounceEvent{Email: r.EmailAddress, Reason: notificationm.EmailSuppressionComplaint})
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,166 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	notificationm "psychic-homily-backend/internal/models/notification"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// Webhook parsing (no database)
+// =============================================================================
+
+func TestParseBounceWebhook_Resend(t *testing.T) {
+	events, err := parseBounceWebhook("resend", []byte(`{
+		"type": "email.bounced",
+		"data": {"to": ["Gone@Example.com"], "bounce": {"message": "Mailbox does not exist"}}
+	}`))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Gone@Example.com", events[0].Email)
+	assert.Equal(t, notificationm.EmailSuppressionBounce, events[0].Reason)
+
+	events, err = parseBounceWebhook("resend", []byte(`{"type": "email.complained", "data": {"to": ["a@example.com"]}}`))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, notificationm.EmailSuppressionComplaint, events[0].Reason)
+
+	events, err = parseBounceWebhook("resend", []byte(`{"type": "email.delivered", "data": {"to": ["a@example.com"]}}`))
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestParseBounceWebhook_Postmark(t *testing.T) {
+	events, err := parseBounceWebhook("postmark", []byte(`{
+		"RecordType": "Bounce", "Type": "HardBounce", "Email": "gone@example.com",
+		"Inactive": true, "Description": "The server was unable to deliver your message"
+	}`))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, notificationm.EmailSuppressionBounce, events[0].Reason)
+
+	events, err = parseBounceWebhook("postmark", []byte(`{"RecordType": "Bounce", "Type": "SoftBounce", "Email": "full@example.com", "Inactive": false}`))
+	require.NoError(t, err)
+	assert.Empty(t, events, "soft bounces are not suppressed")
+
+	events, err = parseBounceWebhook("postmark", []byte(`{"RecordType": "SpamComplaint", "Email": "angry@example.com"}`))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, notificationm.EmailSuppressionComplaint, events[0].Reason)
+}
+
+func TestParseBounceWebhook_SES(t *testing.T) {
+	permanent := `{"Type": "Notification", "Message": "{\"notificationType\":\"Bounce\",\"bounce\":{\"bounceType\":\"Permanent\",\"bounceSubType\":\"General\",\"bouncedRecipients\":[{\"emailAddress\":\"gone@example.com\",\"diagnosticCode\":\"smtp; 550 5.1.1 user unknown\"}]}}"}`
+	events, err := parseBounceWebhook("ses", []byte(permanent))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "gone@example.com", events[0].Email)
+	assert.Equal(t, "General: smtp; 550 5.1.1 user unknown", events[0].Detail)
+
+	transient := `{"Type": "Notification", "Message": "{\"notificationType\":\"Bounce\",\"bounce\":{\"bounceType\":\"Transient\",\"bouncedRecipients\":[{\"emailAddress\":\"full@example.com\"}]}}"}`
+	events, err = parseBounceWebhook("ses", []byte(transient))
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	complaint := `{"Type": "Notification", "Message": "{\"notificationType\":\"Complaint\",\"complaint\":{\"complainedRecipients\":[{\"emailAddress\":\"angry@example.com\"}]}}"}`
+	events, err = parseBounceWebhook("ses", []byte(complaint))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, notificationm.EmailSuppressionComplaint, events[0].Reason)
+
+	events, err = parseBounceWebhook("ses", []byte(`{"Type": "SubscriptionConfirmation", "SubscribeURL": "https://sns.example/confirm"}`))
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestParseBounceWebhook_Errors(t *testing.T) {
+	_, err := parseBounceWebhook("mailchimp", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrUnknownBounceProvider)
+
+	_, err = parseBounceWebhook("postmark", []byte(`not json`))
+	assert.ErrorIs(t, err, ErrInvalidBouncePayload)
+}
+
+func TestEmailSuppressionService_NilDB(t *testing.T) {
+	svc := &EmailSuppressionService{}
+
+	_, err := svc.IsSuppressed("a@example.com")
+	assert.ErrorContains(t, err, "database not initialized")
+	assert.ErrorContains(t, svc.Suppress("a@example.com", notificationm.EmailSuppressionManual, "", ""), "database not initialized")
+	_, err = svc.IngestBounceWebhook("postmark", []byte(`{}`))
+	assert.ErrorContains(t, err, "database not initialized")
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type EmailSuppressionSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *EmailSuppressionService
+}
+
+func TestEmailSuppressionSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(EmailSuppressionSuite))
+}
+
+func (s *EmailSuppressionSuite) SetupSuite() {
+	s.testDB = testutil.SetupTestPostgres(s.T())
+	s.db = s.testDB.DB
+	s.svc = NewEmailSuppressionService(s.testDB.DB)
+}
+
+func (s *EmailSuppressionSuite) TearDownTest() {
+	s.db.Exec("DELETE FROM email_suppressions")
+}
+
+func (s *EmailSuppressionSuite) TearDownSuite() {
+	s.testDB.Cleanup()
+}
+
+func (s *EmailSuppressionSuite) TestSuppress_NormalizesAndIsIdempotent() {
+	s.Require().NoError(s.svc.Suppress(" Gone@Example.com ", notificationm.EmailSuppressionManual, "", "support ticket"))
+	s.Require().NoError(s.svc.Suppress("gone@example.com", notificationm.EmailSuppressionBounce, "postmark", ""))
+
+	suppressed, err := s.svc.IsSuppressed("GONE@example.com")
+	s.Require().NoError(err)
+	s.True(suppressed)
+
+	var rows []notificationm.EmailSuppression
+	s.Require().NoError(s.db.Find(&rows).Error)
+	s.Require().Len(rows, 1)
+	s.Equal(notificationm.EmailSuppressionManual, rows[0].Reason, "the first reason is kept")
+
+	suppressed, err = s.svc.IsSuppressed("other@example.com")
+	s.Require().NoError(err)
+	s.False(suppressed)
+}
+
+func (s *EmailSuppressionSuite) TestIngestBounceWebhook_CountsNewAddresses() {
+	body := []byte(`{"RecordType": "Bounce", "Type": "HardBounce", "Email": "gone@example.com", "Inactive": true}`)
+
+	added, err := s.svc.IngestBounceWebhook("postmark", body)
+	s.Require().NoError(err)
+	s.Equal(1, added)
+
+	// Providers redeliver webhooks; a repeat adds nothing.
+	added, err = s.svc.IngestBounceWebhook("postmark", body)
+	s.Require().NoError(err)
+	s.Equal(0, added)
+
+	var row notificationm.EmailSuppression
+	s.Require().NoError(s.db.Where("email = ?", "gone@example.com").First(&row).Error)
+	s.Equal("postmark", row.Provider)
+	s.Equal(notificationm.EmailSuppressionBounce, row.Reason)
+}
@@ -0,0 +1,257 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// Email templates live in templates/ as <name>.html and <name>.txt, each
+// defining a "content" block rendered inside layout.html / layout.txt. The
+// .txt file also defines "subject". HTML goes through html/template, so
+// names and titles from the database are escaped; the plain-text part goes
+// out as the message's text alternative.
+
+//go:embed templates/*.html templates/*.txt
+var emailTemplateFS embed.FS
+
+// Templated emails. Names match the EmailMessage.Type they are sent with.
+const (
+	EmailTemplateVerification     = "verification"
+	EmailTemplateMagicLink        = "magic_link"
+	EmailTemplateAccountRecovery  = "account_recovery"
+	EmailTemplateCollectionDigest = "collection_digest"
+	EmailTemplateSceneDigest      = "scene_digest"
+	EmailTemplateShowStatusChange = "show_status_change"
+)
+
+// EmailTemplateNames lists every templated email, in preview order.
+var EmailTemplateNames = []string{
+	EmailTemplateVerification,
+	EmailTemplateMagicLink,
+	EmailTemplateAccountRecovery,
+	EmailTemplateCollectionDigest,
+	EmailTemplateSceneDigest,
+	EmailTemplateShowStatusChange,
+}
+
+// ErrUnknownEmailTemplate is returned when previewing a template that
+// doesn't exist.
+var ErrUnknownEmailTemplate = errors.New("unknown email template")
+
+type emailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// emailTemplates is parsed once at startup; a template that fails to parse
+// panics, and the snapshot tests catch that before it ships.
+var emailTemplates = mustParseEmailTemplates()
+
+func mustParseEmailTemplates() map[string]*emailTemplate {
+	funcs := map[string]any{
+		"pluralize": pluralize,
+		// button bundles the arguments of the layout's "button" block.
+		"button": func(url, label string) map[string]string {
+			return map[string]string{"URL": url, "Label": label}
+		},
+	}
+	templates := make(map[string]*emailTemplate, len(EmailTemplateNames))
+	for _, name := range EmailTemplateNames {
+		templates[name] = &emailTemplate{
+			html: htmltemplate.Must(htmltemplate.New("layout.html").Funcs(funcs).
+				ParseFS(emailTemplateFS, "templates/layout.html", "templates/"+name+".html")),
+			text: texttemplate.Must(texttemplate.New("layout.txt").Funcs(funcs).
+				ParseFS(emailTemplateFS, "templates/layout.txt", "templates/"+name+".txt")),
+		}
+	}
+	return templates
+}
+
+// renderedEmail is a template's output for one set of data.
+type renderedEmail struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// renderEmail executes the named template with data.
+func renderEmail(name string, data any) (*renderedEmail, error) {
+	tmpl, ok := emailTemplates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEmailTemplate, name)
+	}
+	var subject, htmlBody, textBody bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("render %s subject: %w", name, err)
+	}
+	if err := tmpl.html.Execute(&htmlBody, data); err != nil {
+		return nil, fmt.Errorf("render %s html: %w", name, err)
+	}
+	if err := tmpl.text.Execute(&textBody, data); err != nil {
+		return nil, fmt.Errorf("render %s text: %w", name, err)
+	}
+	return &renderedEmail{
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    htmlBody.String(),
+		Text:    textBody.String(),
+	}, nil
+}
+
+// templateMessage renders the named template into a message to toEmail.
+func (s *EmailService) templateMessage(name, toEmail string, data any) (*EmailMessage, error) {
+	rendered, err := renderEmail(name, data)
+	if err != nil {
+		return nil, err
+	}
+	return &EmailMessage{
+		From:    fmt.Sprintf("Psychic Homily <%s>", s.fromEmail),
+		To:      []string{toEmail},
+		Subject: rendered.Subject,
+		HTML:    rendered.HTML,
+		Text:    rendered.Text,
+		Type:    name,
+	}, nil
+}
+
+// linkEmailData is the data for the single-link account emails
+// (verification, magic link, account recovery).
+type linkEmailData struct {
+	URL string
+	// DaysRemaining is only used by account_recovery.
+	DaysRemaining int
+}
+
+// unsubscribeData is embedded by the data of emails carrying the in-body
+// opt-out card. UnsubscribeLabel describes the category in the
+// recipient's words ("weekly scene digests").
+type unsubscribeData struct {
+	UnsubscribeURL   string
+	UnsubscribeLabel string
+	SettingsURL      string
+}
+
+type collectionDigestEmailData struct {
+	unsubscribeData
+	Groups     []contracts.CollectionDigestGroup
+	TotalItems int
+}
+
+type sceneDigestEmailData struct {
+	unsubscribeData
+	Groups      []contracts.SceneDigestGroup
+	ArtistShows []contracts.SceneDigestShow
+}
+
+type showStatusChangeEmailData struct {
+	unsubscribeData
+	contracts.ShowStatusChange
+}
+
+// emailPreviewData builds the sample data each template is previewed (and
+// snapshot-tested) with, using frontendURL for every link.
+func emailPreviewData(name, frontendURL string) (any, bool) {
+	unsub := unsubscribeData{
+		UnsubscribeURL: frontendURL + "/unsubscribe/preview?sig=sample",
+		SettingsURL:    frontendURL + "/settings",
+	}
+	switch name {
+	case EmailTemplateVerification:
+		return linkEmailData{URL: frontendURL + "/verify-email?token=sample-token"}, true
+	case EmailTemplateMagicLink:
+		return linkEmailData{URL: frontendURL + "/auth/magic-link?token=sample-token"}, true
+	case EmailTemplateAccountRecovery:
+		return linkEmailData{URL: frontendURL + "/auth/recover?token=sample-token", DaysRemaining: 14}, true
+	case EmailTemplateCollectionDigest:
+		unsub.UnsubscribeLabel = "these weekly digests"
+		return collectionDigestEmailData{
+			unsubscribeData: unsub,
+			Groups: []contracts.CollectionDigestGroup{
+				{
+					CollectionTitle: "Desert Noise",
+					CollectionURL:   frontendURL + "/collections/desert-noise",
+					Items: []contracts.CollectionDigestEntry{
+						{EntityType: "artist", EntityName: "Sun & Sand", EntityURL: frontendURL + "/artists/sun-and-sand", AddedBy: "ana"},
+						{EntityType: "release", EntityName: "Monsoon Tapes", EntityURL: frontendURL + "/releases/monsoon-tapes", AddedBy: "ana"},
+					},
+				},
+				{
+					CollectionTitle: "Phoenix Basements",
+					CollectionURL:   frontendURL + "/collections/phoenix-basements",
+					Items: []contracts.CollectionDigestEntry{
+						{EntityType: "venue", EntityName: "The Trunk Space", EntityURL: frontendURL + "/venues/the-trunk-space", AddedBy: "jo"},
+					},
+				},
+			},
+			TotalItems: 3,
+		}, true
+	case EmailTemplateSceneDigest:
+		unsub.UnsubscribeLabel = "weekly scene digests"
+		return sceneDigestEmailData{
+			unsubscribeData: unsub,
+			ArtistShows: []contracts.SceneDigestShow{
+				{DisplayTitle: "Sun & Sand, Monsoon Choir", Date: "Fri, Jul 4", VenueName: "Valley Bar", ShowURL: frontendURL + "/shows/sun-and-sand-valley-bar"},
+				{DisplayTitle: "Monsoon Choir", Date: "Sun, Jul 6", VenueName: "Club Congress", Location: "Tucson, AZ", ShowURL: frontendURL + "/shows/monsoon-choir-club-congress"},
+			},
+			Groups: []contracts.SceneDigestGroup{
+				{
+					SceneName: "Phoenix, AZ",
+					SceneURL:  frontendURL + "/scenes/phoenix-az",
+					Shows: []contracts.SceneDigestShow{
+						{DisplayTitle: "Rock Night", Date: "Sat, Jul 5", VenueName: "Crescent Ballroom", ShowURL: frontendURL + "/shows/rock-night"},
+						{DisplayTitle: "Untitled Show", Date: "Sun, Jul 6", ShowURL: frontendURL + "/shows/untitled-show"},
+					},
+					NewArtists: []contracts.SceneDigestArtist{
+						{Name: "Dust Devils", ArtistURL: frontendURL + "/artists/dust-devils"},
+					},
+					MoreNewArtists: 2,
+				},
+			},
+		}, true
+	case EmailTemplateShowStatusChange:
+		unsub.UnsubscribeLabel = "emails about changes to shows you saved"
+		return showStatusChangeEmailData{
+			unsubscribeData: unsub,
+			ShowStatusChange: contracts.ShowStatusChange{
+				ShowTitle:  "Sun & Sand, Monsoon Choir",
+				ShowURL:    frontendURL + "/shows/sun-and-sand-valley-bar",
+				Date:       "Friday, July 4, 2026 at 8:00 PM",
+				VenueName:  "Valley Bar",
+				Reason:     "Illness in the band",
+				NewDate:    "Saturday, September 12, 2026 at 8:00 PM",
+				NewShowURL: frontendURL + "/shows/sun-and-sand-valley-bar-2026-09-12",
+			},
+		}, true
+	}
+	return nil, false
+}
+
+// EmailTemplateNames lists the templates PreviewEmail accepts.
+func (s *EmailService) EmailTemplateNames() []string {
+	return EmailTemplateNames
+}
+
+// PreviewEmail renders the named template with sample data. It works
+// whether or not a provider is configured.
+func (s *EmailService) PreviewEmail(name string) (*contracts.EmailPreview, error) {
+	data, ok := emailPreviewData(name, s.frontendURL)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEmailTemplate, name)
+	}
+	rendered, err := renderEmail(name, data)
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.EmailPreview{
+		Template: name,
+		Subject:  rendered.Subject,
+		HTML:     rendered.HTML,
+		Text:     rendered.Text,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package notification
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+var updateEmailSnapshots = flag.Bool("update", false, "rewrite testdata/email snapshots")
+
+// TestEmailTemplates_Snapshots renders every template with its preview data
+// and compares against testdata/email/<name>.{html,txt}. After an intended
+// copy or layout change, regenerate with:
+//
+//	go test ./internal/services/notification -run TestEmailTemplates_Snapshots -update
+func TestEmailTemplates_Snapshots(t *testing.T) {
+	svc := &EmailService{frontendURL: "https://psychichomily.com"}
+	for _, name := range EmailTemplateNames {
+		t.Run(name, func(t *testing.T) {
+			preview, err := svc.PreviewEmail(name)
+			require.NoError(t, err)
+			assert.NotEmpty(t, preview.Subject)
+
+			// The subject heads the text snapshot so subject changes show up
+			// in review too.
+			text := "Subject: " + preview.Subject + "\n\n" + preview.Text
+			assertEmailSnapshot(t, name+".html", preview.HTML)
+			assertEmailSnapshot(t, name+".txt", text)
+		})
+	}
+}
+
+func assertEmailSnapshot(t *testing.T, file, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", "email", file)
+	if *updateEmailSnapshots {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+		return
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err, "missing snapshot %s; run with -update", path)
+	assert.Equal(t, string(want), got, "snapshot %s is stale; run with -update if the change is intended", path)
+}
+
+func TestEmailTemplates_EscapeHTML(t *testing.T) {
+	rendered, err := renderEmail(EmailTemplateCollectionDigest, collectionDigestEmailData{
+		Groups: []contracts.CollectionDigestGroup{{
+			CollectionTitle: "<script>x</script>",
+			CollectionURL:   "https://x/c",
+			Items:           []contracts.CollectionDigestEntry{{EntityType: "artist", EntityName: "Sun & Sand", EntityURL: "https://x/a"}},
+		}},
+		TotalItems: 1,
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, rendered.HTML, "<script>")
+	assert.Contains(t, rendered.HTML, "&lt;script&gt;x&lt;/script&gt;")
+	assert.Contains(t, rendered.HTML, "Sun &amp; Sand")
+	// The text part and subject are plain text, not HTML.
+	assert.Contains(t, rendered.Text, "Sun & Sand")
+	assert.Equal(t, "New this week in <script>x</script>: 1 item", rendered.Subject)
+
+	rendered, err = renderEmail(EmailTemplateMagicLink, linkEmailData{URL: `javascript:alert("x")`})
+	require.NoError(t, err)
+	assert.NotContains(t, rendered.HTML, `href="javascript:`)
+}
+
+func TestEmailTemplates_CollectionDigestSubject(t *testing.T) {
+	data, _ := emailPreviewData(EmailTemplateCollectionDigest, "https://x")
+	digest := data.(collectionDigestEmailData)
+
+	rendered, err := renderEmail(EmailTemplateCollectionDigest, digest)
+	require.NoError(t, err)
+	assert.Equal(t, "Your weekly collections digest: 3 new items", rendered.Subject)
+
+	digest.Groups = digest.Groups[1:]
+	digest.TotalItems = 1
+	rendered, err = renderEmail(EmailTemplateCollectionDigest, digest)
+	require.NoError(t, err)
+	assert.Equal(t, "New this week in Phoenix Basements: 1 item", rendered.Subject)
+}
+
+func TestPreviewEmail_UnknownTemplate(t *testing.T) {
+	svc := &EmailService{}
+	_, err := svc.PreviewEmail("nope")
+	assert.True(t, errors.Is(err, ErrUnknownEmailTemplate))
+}
+
+func TestPreviewEmail_EveryTemplateHasSampleData(t *testing.T) {
+	for _, name := range EmailTemplateNames {
+		_, ok := emailPreviewData(name, "https://x")
+		assert.True(t, ok, "no preview data for %s", name)
+	}
+}
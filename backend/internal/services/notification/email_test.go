@@ -26,6 +26,7 @@ type capturedEmail struct {
 	To      []string `json:"to"`
 	Subject string   `json:"subject"`
 	Html    string   `json:"html"`
+	Text    string   `json:"text"`
 }
 
 func setupEmailTest(t *testing.T) (*EmailService, chan capturedEmail, *httptest.Server) {
@@ -45,7 +46,7 @@ func setupEmailTest(t *testing.T) (*EmailService, chan capturedEmail, *httptest.
 	client.BaseURL = serverURL
 
 	service := &EmailService{
-		client:      client,
+		provider:    &resendProvider{client: client},
 		fromEmail:   "noreply@test.com",
 		frontendURL: "http://localhost:3000",
 	}
@@ -65,7 +66,7 @@ func setupEmailTestError(t *testing.T) *EmailService {
 	client.BaseURL = serverURL
 
 	return &EmailService{
-		client:      client,
+		provider:    &resendProvider{client: client},
 		fromEmail:   "noreply@test.com",
 		frontendURL: "http://localhost:3000",
 	}
@@ -83,9 +84,9 @@ func TestNewEmailService_Configured(t *testing.T) {
 			FrontendURL:  "http://localhost:3000",
 		},
 	}
-	svc := NewEmailService(cfg)
+	svc := NewEmailService(cfg, nil)
 
-	assert.NotNil(t, svc.client)
+	assert.NotNil(t, svc.provider)
 	assert.Equal(t, "noreply@example.com", svc.fromEmail)
 	assert.Equal(t, "http://localhost:3000", svc.frontendURL)
 }
@@ -98,14 +99,14 @@ func TestNewEmailService_NotConfigured(t *testing.T) {
 			FrontendURL:  "http://localhost:3000",
 		},
 	}
-	svc := NewEmailService(cfg)
+	svc := NewEmailService(cfg, nil)
 
-	assert.Nil(t, svc.client)
+	assert.Nil(t, svc.provider)
 }
 
 func TestEmailIsConfigured_True(t *testing.T) {
 	svc := &EmailService{
-		client:    resend.NewClient("fake-key"),
+		provider:  &resendProvider{client: resend.NewClient("fake-key")},
 		fromEmail: "noreply@test.com",
 	}
 	assert.True(t, svc.IsConfigured())
@@ -113,7 +114,7 @@ func TestEmailIsConfigured_True(t *testing.T) {
 
 func TestEmailIsConfigured_False_NilClient(t *testing.T) {
 	svc := &EmailService{
-		client:    nil,
+		provider:  nil,
 		fromEmail: "noreply@test.com",
 	}
 	assert.False(t, svc.IsConfigured())
@@ -121,7 +122,7 @@ func TestEmailIsConfigured_False_NilClient(t *testing.T) {
 
 func TestEmailIsConfigured_False_EmptyFrom(t *testing.T) {
 	svc := &EmailService{
-		client:    resend.NewClient("fake-key"),
+		provider:  &resendProvider{client: resend.NewClient("fake-key")},
 		fromEmail: "",
 	}
 	assert.False(t, svc.IsConfigured())
@@ -142,10 +143,11 @@ func TestSendVerificationEmail_Success(t *testing.T) {
 	assert.Equal(t, []string{"user@test.com"}, email.To)
 	assert.Contains(t, email.Subject, "Verify your email")
 	assert.Contains(t, email.Html, "http://localhost:3000/verify-email?token=abc-token-123")
+	assert.Contains(t, email.Text, "http://localhost:3000/verify-email?token=abc-token-123")
 }
 
 func TestSendVerificationEmail_NotConfigured(t *testing.T) {
-	svc := &EmailService{client: nil, fromEmail: ""}
+	svc := &EmailService{provider: nil, fromEmail: ""}
 
 	err := svc.SendVerificationEmail("user@test.com", "token")
 
@@ -180,7 +182,7 @@ func TestSendMagicLinkEmail_Success(t *testing.T) {
 }
 
 func TestSendMagicLinkEmail_NotConfigured(t *testing.T) {
-	svc := &EmailService{client: nil, fromEmail: ""}
+	svc := &EmailService{provider: nil, fromEmail: ""}
 
 	err := svc.SendMagicLinkEmail("user@test.com", "token")
 
@@ -217,7 +219,7 @@ func TestSendAccountRecoveryEmail_Success(t *testing.T) {
 }
 
 func TestSendAccountRecoveryEmail_NotConfigured(t *testing.T) {
-	svc := &EmailService{client: nil, fromEmail: ""}
+	svc := &EmailService{provider: nil, fromEmail: ""}
 
 	err := svc.SendAccountRecoveryEmail("user@test.com", "token", 7)
 
@@ -248,6 +250,7 @@ func TestSendShowReminderEmail_Success(t *testing.T) {
 		"http://localhost:3000/unsubscribe?uid=1&sig=abc",
 		time.Date(2026, 7, 15, 20, 0, 0, 0, time.UTC),
 		[]string{"Valley Bar"},
+		1,
 	)
 
 	require.NoError(t, err)
@@ -255,7 +258,7 @@ func TestSendShowReminderEmail_Success(t *testing.T) {
 	assert.Contains(t, email.From, "noreply@test.com")
 	assert.Equal(t, []string{"user@test.com"}, email.To)
 	assert.Contains(t, email.Subject, "Reminder")
-	assert.Contains(t, email.Subject, "Rock Night")
+	assert.Contains(t, email.Subject, "Rock Night is tomorrow")
 	assert.Contains(t, email.Html, "Rock Night")
 	assert.Contains(t, email.Html, "Valley Bar")
 	assert.Contains(t, email.Html, "http://localhost:3000/shows/rock-night")
@@ -263,9 +266,9 @@ func TestSendShowReminderEmail_Success(t *testing.T) {
 }
 
 func TestSendShowReminderEmail_NotConfigured(t *testing.T) {
-	svc := &EmailService{client: nil, fromEmail: ""}
+	svc := &EmailService{provider: nil, fromEmail: ""}
 
-	err := svc.SendShowReminderEmail("user@test.com", "Show", "url", "unsub", time.Now(), nil)
+	err := svc.SendShowReminderEmail("user@test.com", "Show", "url", "unsub", time.Now(), nil, 1)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not configured")
@@ -274,7 +277,7 @@ func TestSendShowReminderEmail_NotConfigured(t *testing.T) {
 func TestSendShowReminderEmail_APIError(t *testing.T) {
 	svc := setupEmailTestError(t)
 
-	err := svc.SendShowReminderEmail("user@test.com", "Show", "url", "unsub", time.Now(), nil)
+	err := svc.SendShowReminderEmail("user@test.com", "Show", "url", "unsub", time.Now(), nil, 1)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to send show reminder email")
@@ -285,7 +288,7 @@ func TestSendShowReminderEmail_MultipleVenues(t *testing.T) {
 
 	err := svc.SendShowReminderEmail(
 		"user@test.com", "Show", "url", "unsub", time.Now(),
-		[]string{"Valley Bar", "Crescent Ballroom"},
+		[]string{"Valley Bar", "Crescent Ballroom"}, 1,
 	)
 
 	require.NoError(t, err)
@@ -298,7 +301,7 @@ func TestSendShowReminderEmail_NoVenues(t *testing.T) {
 
 	err := svc.SendShowReminderEmail(
 		"user@test.com", "Show", "url", "unsub", time.Now(),
-		[]string{},
+		[]string{}, 1,
 	)
 
 	require.NoError(t, err)
@@ -307,6 +310,23 @@ func TestSendShowReminderEmail_NoVenues(t *testing.T) {
 	assert.NotContains(t, email.Html, "Venue:")
 }
 
+func TestSendShowReminderEmail_WeekBefore(t *testing.T) {
+	svc, emails, _ := setupEmailTest(t)
+
+	err := svc.SendShowReminderEmail("user@test.com", "Rock Night", "url", "unsub", time.Now(), nil, 7)
+
+	require.NoError(t, err)
+	email := <-emails
+	assert.Equal(t, "Reminder: Rock Night is one week away", email.Subject)
+	assert.Contains(t, email.Html, "Rock Night is one week away!")
+}
+
+func TestShowReminderLead(t *testing.T) {
+	assert.Equal(t, "tomorrow", showReminderLead(1))
+	assert.Equal(t, "one week away", showReminderLead(7))
+	assert.Equal(t, "in 3 days", showReminderLead(3))
+}
+
 // =============================================================================
 // SendFilterNotificationEmail
 // =============================================================================
@@ -331,7 +351,7 @@ func TestSendFilterNotificationEmail_Success(t *testing.T) {
 }
 
 func TestSendFilterNotificationEmail_NotConfigured(t *testing.T) {
-	svc := &EmailService{client: nil, fromEmail: ""}
+	svc := &EmailService{provider: nil, fromEmail: ""}
 
 	err := svc.SendFilterNotificationEmail("user@test.com", "sub", "body", "unsub")
 
@@ -38,7 +38,7 @@ func setupHeaderCapturingEmailTest(t *testing.T) (*EmailService, chan capturedDi
 	client.BaseURL = serverURL
 
 	return &EmailService{
-		client:      client,
+		provider:    &resendProvider{client: client},
 		fromEmail:   "noreply@test.com",
 		frontendURL: "http://localhost:3000",
 	}, requests
@@ -14,8 +14,10 @@ import (
 	"github.com/getsentry/sentry-go"
 	"github.com/lib/pq"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
 	apperrors "psychic-homily-backend/internal/errors"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	engagementm "psychic-homily-backend/internal/models/engagement"
@@ -30,23 +32,35 @@ import (
 type NotificationFilterService struct {
 	db           *gorm.DB
 	emailService contracts.EmailServiceInterface
-	jwtSecret    string // for HMAC unsubscribe URLs
+	keys         *config.JWTKeyRing // for HMAC unsubscribe URLs
 	frontendURL  string
+
+	// Optional Web Push delivery and preference checks; see SetPushDelivery.
+	pushService contracts.PushServiceInterface
+	preferences contracts.NotificationPreferenceServiceInterface
 }
 
 // NewNotificationFilterService creates a new notification filter service.
-func NewNotificationFilterService(database *gorm.DB, emailService contracts.EmailServiceInterface, jwtSecret, frontendURL string) *NotificationFilterService {
+func NewNotificationFilterService(database *gorm.DB, emailService contracts.EmailServiceInterface, keys *config.JWTKeyRing, frontendURL string) *NotificationFilterService {
 	if database == nil {
 		database = db.GetDB()
 	}
 	return &NotificationFilterService{
 		db:           database,
 		emailService: emailService,
-		jwtSecret:    jwtSecret,
+		keys:         keys,
 		frontendURL:  frontendURL,
 	}
 }
 
+// SetPushDelivery enables push notifications for shows announced at a
+// user's subscribed venues or featuring artists they follow, and the
+// preference checks that gate those pushes and followed-artist emails.
+func (s *NotificationFilterService) SetPushDelivery(pushService contracts.PushServiceInterface, preferences contracts.NotificationPreferenceServiceInterface) {
+	s.pushService = pushService
+	s.preferences = preferences
+}
+
 // maxFiltersPerUser is the maximum number of filters a user can create.
 const maxFiltersPerUser = 50
 
@@ -392,6 +406,8 @@ type filterMatch struct {
 	Source      string `gorm:"column:source"`
 	NotifyEmail bool   `gorm:"column:notify_email"`
 	NotifyInApp bool   `gorm:"column:notify_in_app"`
+
+	VenueIDs pq.Int64Array `gorm:"column:venue_ids;type:bigint[]"`
 }
 
 // MatchAndNotify finds all active filters that match the given show and sends notifications.
@@ -418,10 +434,10 @@ func (s *NotificationFilterService) MatchAndNotify(show *catalogm.Show) error {
 		})
 	}
 
-	// Get show price in cents (nullable)
+	// Get the show's lowest price in cents (nullable; 0 when free)
 	var priceCents *int
-	if show.Price != nil {
-		cents := int(*show.Price * 100)
+	if price := show.LowestPrice(); price != nil {
+		cents := int(*price * 100)
 		priceCents = &cents
 	}
 
@@ -442,10 +458,11 @@ func (s *NotificationFilterService) MatchAndNotify(show *catalogm.Show) error {
 		s.processUserMatches(userID, show, userFilterMatches)
 	}
 
-	// Scene follows fan out AFTER filters — even when no filter matched — so
-	// their cross-system dedup can defer to filter notifications already
-	// logged for this show (PSY-1341).
+	// Scene follows, then artist follows, fan out AFTER filters — even when
+	// no filter matched — so their cross-system dedup can defer to
+	// notifications already logged for this show (PSY-1341).
 	s.notifySceneFollowers(show, showArtistIDs)
+	s.notifyArtistFollowers(show, showArtistIDs)
 
 	return nil
 }
@@ -552,7 +569,7 @@ func (s *NotificationFilterService) findMatchingFilters(
 	// Build the matching query using PostgreSQL array overlap operator (&&).
 	// GORM uses ? for parameter binding.
 	query := `
-		SELECT nf.id as filter_id, nf.user_id, nf.name, nf.source, nf.notify_email, nf.notify_in_app
+		SELECT nf.id as filter_id, nf.user_id, nf.name, nf.source, nf.notify_email, nf.notify_in_app, nf.venue_ids
 		FROM notification_filters nf
 		WHERE nf.is_active = TRUE
 		  AND (nf.artist_ids IS NULL OR nf.artist_ids && ?::bigint[])
@@ -628,6 +645,8 @@ func (s *NotificationFilterService) processUserMatches(userID uint, show *catalo
 			})
 	}
 
+	s.sendVenuePush(userID, show, matches)
+
 	// Cross-filter + cross-system dedup: one email-channel row per (user, show).
 	var existing int64
 	if err := s.db.Model(&notificationm.NotificationLog{}).
@@ -661,6 +680,74 @@ func (s *NotificationFilterService) processUserMatches(userID uint, show *catalo
 	}
 }
 
+// sendVenuePush pushes the show to the user's browsers when one of the
+// matches is a venue subscription and they've enabled favorite-venue pushes.
+// Push sends are deduped in sent_notifications, separately from the email
+// row in notification_log, so the inbox doesn't list the show twice.
+func (s *NotificationFilterService) sendVenuePush(userID uint, show *catalogm.Show, matches []filterMatch) {
+	if s.pushService == nil || s.preferences == nil || !s.pushService.IsConfigured() {
+		return
+	}
+	venueMatch := false
+	for _, m := range matches {
+		if len(m.VenueIDs) > 0 {
+			venueMatch = true
+			break
+		}
+	}
+	if !venueMatch {
+		return
+	}
+
+	enabled, err := s.preferences.IsEnabled(userID,
+		contracts.NotificationEventFavoriteVenueAnnouncement, contracts.NotificationChannelPush)
+	if err != nil {
+		log.Printf("failed to check push preference for user %d: %v", userID, err)
+		return
+	}
+	if !enabled {
+		return
+	}
+	s.pushShow(userID, contracts.NotificationEventFavoriteVenueAnnouncement, show)
+}
+
+// pushShow pushes a new-show notification to the user's browsers once per
+// (user, event type, show). The caller checks the push preference.
+func (s *NotificationFilterService) pushShow(userID uint, eventType string, show *catalogm.Show) {
+	// Claim the send first so concurrent approvals of the same show can't
+	// both push.
+	record := notificationm.SentNotification{
+		UserID:           userID,
+		NotificationType: eventType,
+		EntityType:       "show",
+		EntityID:         show.ID,
+		Channel:          contracts.NotificationChannelPush,
+		SentAt:           time.Now().UTC(),
+	}
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&record)
+	if result.Error != nil {
+		log.Printf("failed to record %s push for user %d, show %d: %v", eventType, userID, show.ID, result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+
+	c := s.showEmailContent(show)
+	body := c.date
+	if c.venueText != "" {
+		body += " · " + c.venueText
+	}
+	if _, err := s.pushService.SendToUser(userID, contracts.PushMessage{
+		Title: show.Title,
+		Body:  body,
+		URL:   c.showURL,
+		Tag:   fmt.Sprintf("show-%d", show.ID),
+	}); err != nil {
+		log.Printf("failed to push show %d to user %d: %v", show.ID, userID, err)
+	}
+}
+
 // pickDeliveryMatch chooses which matched filter owns the single user-visible
 // notification. Prefer managed+email, then any managed, then any email-enabled
 // user filter, then the lowest-id match (query is ORDER BY nf.id).
@@ -708,7 +795,7 @@ func (s *NotificationFilterService) sendFilterEmail(userID uint, filterID uint,
 	c := s.showEmailContent(show)
 
 	// Unsubscribe URL (HMAC-signed)
-	unsubscribeURL := GenerateFilterUnsubscribeURL(s.frontendURL, filterID, s.jwtSecret)
+	unsubscribeURL := GenerateFilterUnsubscribeURL(s.frontendURL, filterID, s.keys.Current().Secret)
 
 	html := buildFilterEmailHTML(filterName, show.Title, c.date, c.venueText, c.artistText, c.priceText, c.showURL, unsubscribeURL)
 
@@ -778,10 +865,7 @@ func (s *NotificationFilterService) showEmailContent(show *catalogm.Show) showEm
 		showURL = fmt.Sprintf("%s/shows/%d", s.frontendURL, show.ID)
 	}
 
-	priceText := ""
-	if show.Price != nil {
-		priceText = fmt.Sprintf("$%.0f", *show.Price)
-	}
+	priceText := utils.FormatPrice(show.PriceMin, show.PriceMax, show.PriceCurrency, show.IsFree)
 
 	return showEmailContentParts{
 		date:       show.EventDate.In(utils.EventLocation(venueTZ, venueState)).Format("Monday, January 2, 2006"),
@@ -798,13 +882,32 @@ func (s *NotificationFilterService) showEmailContent(show *catalogm.Show) showEm
 // follows have no filter row to sign; the weekly-digest ticket owns richer
 // unsubscribe scoping).
 func (s *NotificationFilterService) sendSceneFollowEmail(userID uint, sceneName string, show *catalogm.Show) {
+	s.sendFollowEmail(userID, show, followEmail{
+		kind:      "scene_follow",
+		label:     fmt.Sprintf("%s scene", sceneName),
+		subject:   fmt.Sprintf("New show in %s", sceneName),
+		manageURL: fmt.Sprintf("%s/following?tab=scene", s.frontendURL),
+	})
+}
+
+// followEmail describes a follow-driven new-show email.
+type followEmail struct {
+	kind      string // email_type tag, e.g. "scene_follow"
+	label     string // what the user follows, shown in the email body
+	subject   string
+	manageURL string
+}
+
+// sendFollowEmail sends a follow-driven new-show email, sharing the filter
+// emails' per-user daily cap.
+func (s *NotificationFilterService) sendFollowEmail(userID uint, show *catalogm.Show, e followEmail) {
 	var emailCount int64
 	dayAgo := time.Now().UTC().Add(-24 * time.Hour)
 	s.db.Model(&notificationm.NotificationLog{}).
 		Where("user_id = ? AND channel = ? AND sent_at > ?", userID, "email", dayAgo).
 		Count(&emailCount)
 	if emailCount >= int64(maxFilterEmailsPerDay) {
-		log.Printf("rate limit: skipping scene-follow email for user %d (sent %d today)", userID, emailCount)
+		log.Printf("rate limit: skipping %s email for user %d (sent %d today)", e.kind, userID, emailCount)
 		return
 	}
 
@@ -815,19 +918,17 @@ func (s *NotificationFilterService) sendSceneFollowEmail(userID uint, sceneName
 	}
 
 	c := s.showEmailContent(show)
-	manageURL := fmt.Sprintf("%s/following?tab=scene", s.frontendURL)
 	html := buildFilterEmailHTML(
-		fmt.Sprintf("%s scene", sceneName),
-		show.Title, c.date, c.venueText, c.artistText, c.priceText, c.showURL, manageURL,
+		e.label,
+		show.Title, c.date, c.venueText, c.artistText, c.priceText, c.showURL, e.manageURL,
 	)
-	subject := fmt.Sprintf("New show in %s", sceneName)
-	if err := s.sendEmail(email, subject, html, manageURL); err != nil {
+	if err := s.sendEmail(email, e.subject, html, e.manageURL); err != nil {
 		sentry.WithScope(func(scope *sentry.Scope) {
 			scope.SetTag("service", "notification_filter")
-			scope.SetTag("email_type", "scene_follow")
+			scope.SetTag("email_type", e.kind)
 			sentry.CaptureException(err)
 		})
-		log.Printf("failed to send scene-follow email to %s: %v", email, err)
+		log.Printf("failed to send %s email to %s: %v", e.kind, email, err)
 	}
 }
 
@@ -859,7 +960,19 @@ func (s *NotificationFilterService) GetUserNotifications(userID uint, limit, off
 	// first such rows ever, so the bell UI's filter-name slot would render
 	// bare "show" for them. Re-derive the scene display name from the show's
 	// venues (same join branches as sceneFollowersForShow); a since-merged
-	// scene row just falls back to the generic label.
+	// scene row just falls back to the generic label. Artist-follow rows are
+	// NULL-filter show rows too; when the user follows an artist on the bill
+	// that artist's name is the label instead.
+	followedArtistSubquery := `(
+		SELECT a.name
+		FROM show_artists sa
+		JOIN artists a ON a.id = sa.artist_id
+		JOIN user_bookmarks b ON b.entity_id = sa.artist_id
+			AND b.user_id = nl.user_id AND b.entity_type = 'artist' AND b.action = 'follow'
+		WHERE sa.show_id = nl.entity_id
+		ORDER BY sa.position, sa.artist_id
+		LIMIT 1
+	)`
 	sceneNameSubquery := `(
 		SELECT sc.city || ', ' || sc.state || ' scene'
 		FROM show_venues sv
@@ -874,7 +987,7 @@ func (s *NotificationFilterService) GetUserNotifications(userID uint, limit, off
 		LIMIT 1
 	)`
 	err := s.db.Table("notification_log nl").
-		Select("nl.*, COALESCE(nf.name, CASE WHEN nl.entity_type = 'show' AND nl.filter_id IS NULL THEN "+sceneNameSubquery+" END, '') as filter_name").
+		Select("nl.*, COALESCE(nf.name, CASE WHEN nl.entity_type = 'show' AND nl.filter_id IS NULL THEN COALESCE("+followedArtistSubquery+", "+sceneNameSubquery+") END, '') as filter_name").
 		Joins("LEFT JOIN notification_filters nf ON nf.id = nl.filter_id").
 		Where("nl.user_id = ?", userID).
 		Order("nl.sent_at DESC").
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	"psychic-homily-backend/internal/config"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
 	communitym "psychic-homily-backend/internal/models/community"
@@ -161,7 +162,7 @@ func (s *NotificationFilterSuite) SetupSuite() {
 	s.db = s.testDB.DB
 
 	// Use a mock email service
-	s.svc = NewNotificationFilterService(s.testDB.DB, &mockEmailService{}, "test-secret", "http://localhost:3000")
+	s.svc = NewNotificationFilterService(s.testDB.DB, &mockEmailService{}, config.NewJWTKeyRing("test-secret", nil), "http://localhost:3000")
 }
 
 func (s *NotificationFilterSuite) TearDownTest() {
@@ -224,7 +225,7 @@ func (s *NotificationFilterSuite) createTestShow(title string, artistIDs []uint,
 		EventDate: time.Now().Add(24 * time.Hour),
 		City:      &city,
 		State:     &state,
-		Price:     &price,
+		PriceMin:  &price,
 		Status:    catalogm.ShowStatusApproved,
 	}
 	s.Require().NoError(s.db.Create(&show).Error)
@@ -1018,7 +1019,7 @@ func (m *mockEmailService) SendMagicLinkEmail(_, _ string) error    { return nil
 func (m *mockEmailService) SendAccountRecoveryEmail(_ string, _ string, _ int) error {
 	return nil
 }
-func (m *mockEmailService) SendShowReminderEmail(_ string, _ string, _ string, _ string, _ time.Time, _ []string) error {
+func (m *mockEmailService) SendShowReminderEmail(_ string, _ string, _ string, _ string, _ time.Time, _ []string, _ int) error {
 	return nil
 }
 func (m *mockEmailService) SendFilterNotificationEmail(_, _, _, _ string) error {
@@ -1040,7 +1041,10 @@ func (m *mockEmailService) SendCommentNotification(_, _, _, _, _, _, _ string) e
 func (m *mockEmailService) SendMentionNotification(_, _, _, _, _, _, _ string) error {
 	return nil
 }
-func (m *mockEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ string) error {
+func (m *mockEmailService) SendSceneDigestEmail(_ string, _ []contracts.SceneDigestGroup, _ []contracts.SceneDigestShow, _ string) error {
+	return nil
+}
+func (m *mockEmailService) SendShowStatusChangeEmail(_ string, _ contracts.ShowStatusChange, _ string) error {
 	return nil
 }
 
@@ -4,7 +4,13 @@ import "psychic-homily-backend/internal/services/contracts"
 
 // Compile-time interface satisfaction checks for notification services.
 var (
-	_ contracts.EmailServiceInterface              = (*EmailService)(nil)
-	_ contracts.DiscordServiceInterface            = (*DiscordService)(nil)
-	_ contracts.NotificationFilterServiceInterface = (*NotificationFilterService)(nil)
+	_ contracts.EmailServiceInterface                  = (*EmailService)(nil)
+	_ contracts.EmailPreviewServiceInterface           = (*EmailService)(nil)
+	_ contracts.DiscordServiceInterface                = (*DiscordService)(nil)
+	_ contracts.DiscordServiceInterface                = (*SlackNotifier)(nil)
+	_ contracts.DiscordServiceInterface                = (*AdminNotifier)(nil)
+	_ contracts.EmailSuppressionServiceInterface       = (*EmailSuppressionService)(nil)
+	_ contracts.NotificationFilterServiceInterface     = (*NotificationFilterService)(nil)
+	_ contracts.NotificationPreferenceServiceInterface = (*NotificationPreferenceService)(nil)
+	_ contracts.PushServiceInterface                   = (*PushService)(nil)
 )
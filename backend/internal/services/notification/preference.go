@@ -0,0 +1,234 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	notificationm "psychic-homily-backend/internal/models/notification"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// defaultNotificationPreferences is the value of every cell a user hasn't
+// set. Recurring mail (reminders, digests) is opt-in, matching the existing
+// show_reminders and digest flags; one-off mail about things the user did or
+// follows is opt-out. Push is opt-in everywhere.
+var defaultNotificationPreferences = contracts.NotificationPreferenceMatrix{
+	contracts.NotificationEventSavedShowReminder: {
+		contracts.NotificationChannelEmail: false,
+		contracts.NotificationChannelPush:  false,
+	},
+	contracts.NotificationEventSavedShowChange: {
+		contracts.NotificationChannelEmail: true,
+		contracts.NotificationChannelPush:  false,
+	},
+	contracts.NotificationEventFavoriteVenueAnnouncement: {
+		contracts.NotificationChannelEmail: true,
+		contracts.NotificationChannelPush:  false,
+	},
+	contracts.NotificationEventFollowedArtistShow: {
+		contracts.NotificationChannelEmail: true,
+		contracts.NotificationChannelPush:  false,
+	},
+	contracts.NotificationEventSubmissionStatus: {
+		contracts.NotificationChannelEmail: true,
+		contracts.NotificationChannelPush:  false,
+	},
+	contracts.NotificationEventDigest: {
+		contracts.NotificationChannelEmail: false,
+		contracts.NotificationChannelPush:  false,
+	},
+}
+
+// NotificationPreferenceService manages the per-user notification preference
+// matrix (event type × channel).
+type NotificationPreferenceService struct {
+	db *gorm.DB
+}
+
+// NewNotificationPreferenceService creates a new notification preference service.
+func NewNotificationPreferenceService(database *gorm.DB) *NotificationPreferenceService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &NotificationPreferenceService{
+		db: database,
+	}
+}
+
+// checkNotificationCell rejects an unknown event type or channel.
+func checkNotificationCell(eventType, channel string) error {
+	channels, ok := defaultNotificationPreferences[eventType]
+	if !ok {
+		return apperrors.ErrNotificationPreferenceInvalid(fmt.Sprintf("unknown event type %q", eventType))
+	}
+	if _, ok := channels[channel]; !ok {
+		return apperrors.ErrNotificationPreferenceInvalid(fmt.Sprintf("unknown channel %q", channel))
+	}
+	return nil
+}
+
+// GetPreferences returns the user's full matrix, defaults filled in.
+func (s *NotificationPreferenceService) GetPreferences(userID uint) (contracts.NotificationPreferenceMatrix, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	return s.loadPreferences(s.db, userID)
+}
+
+func (s *NotificationPreferenceService) loadPreferences(tx *gorm.DB, userID uint) (contracts.NotificationPreferenceMatrix, error) {
+	var rows []notificationm.NotificationPreference
+	if err := tx.Where("user_id = ?", userID).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+
+	matrix := make(contracts.NotificationPreferenceMatrix, len(defaultNotificationPreferences))
+	for eventType, channels := range defaultNotificationPreferences {
+		matrix[eventType] = make(map[string]bool, len(channels))
+		for channel, enabled := range channels {
+			matrix[eventType][channel] = enabled
+		}
+	}
+	for _, row := range rows {
+		// Rows for event types or channels since retired are ignored.
+		if _, ok := matrix[row.EventType][row.Channel]; ok {
+			matrix[row.EventType][row.Channel] = row.Enabled
+		}
+	}
+	return matrix, nil
+}
+
+// UpdatePreferences sets the given cells, leaving the rest unchanged, and
+// returns the resulting matrix. The whole update is rejected if any cell
+// names an unknown event type or channel.
+func (s *NotificationPreferenceService) UpdatePreferences(userID uint, updates contracts.NotificationPreferenceMatrix) (contracts.NotificationPreferenceMatrix, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	for eventType, channels := range updates {
+		for channel := range channels {
+			if err := checkNotificationCell(eventType, channel); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var matrix contracts.NotificationPreferenceMatrix
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for eventType, channels := range updates {
+			for channel, enabled := range channels {
+				row := notificationm.NotificationPreference{
+					UserID:    userID,
+					EventType: eventType,
+					Channel:   channel,
+					Enabled:   enabled,
+					UpdatedAt: now,
+				}
+				if err := tx.Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: "user_id"}, {Name: "event_type"}, {Name: "channel"}},
+					DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+				}).Create(&row).Error; err != nil {
+					return fmt.Errorf("failed to save notification preference: %w", err)
+				}
+			}
+		}
+
+		// The reminder job still reads user_preferences.show_reminders.
+		if enabled, ok := updates[contracts.NotificationEventSavedShowReminder][contracts.NotificationChannelEmail]; ok {
+			if err := syncShowRemindersFlag(tx, userID, enabled); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		matrix, err = s.loadPreferences(tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matrix, nil
+}
+
+// syncShowRemindersFlag mirrors the saved_show_reminder/email cell onto the
+// legacy show_reminders column.
+func syncShowRemindersFlag(tx *gorm.DB, userID uint, enabled bool) error {
+	result := tx.Model(&authm.UserPreferences{}).
+		Where("user_id = ?", userID).
+		Update("show_reminders", enabled)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update show reminders: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		prefs := &authm.UserPreferences{UserID: userID, ShowReminders: enabled}
+		if err := tx.Create(prefs).Error; err != nil {
+			return fmt.Errorf("failed to create user preferences: %w", err)
+		}
+	}
+	return nil
+}
+
+// IsEnabled reports whether the user wants eventType delivered over channel.
+func (s *NotificationPreferenceService) IsEnabled(userID uint, eventType, channel string) (bool, error) {
+	if s.db == nil {
+		return false, fmt.Errorf("database not initialized")
+	}
+	if err := checkNotificationCell(eventType, channel); err != nil {
+		return false, err
+	}
+
+	var rows []notificationm.NotificationPreference
+	if err := s.db.Where("user_id = ? AND event_type = ? AND channel = ?", userID, eventType, channel).
+		Limit(1).
+		Find(&rows).Error; err != nil {
+		return false, fmt.Errorf("failed to load notification preference: %w", err)
+	}
+	if len(rows) == 0 {
+		return defaultNotificationPreferences[eventType][channel], nil
+	}
+	return rows[0].Enabled, nil
+}
+
+// FilterEnabledUsers returns the subset of userIDs, in their original order,
+// who want eventType delivered over channel. Batch senders use it instead of
+// calling IsEnabled per recipient.
+func (s *NotificationPreferenceService) FilterEnabledUsers(userIDs []uint, eventType, channel string) ([]uint, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	if err := checkNotificationCell(eventType, channel); err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return []uint{}, nil
+	}
+
+	var rows []notificationm.NotificationPreference
+	if err := s.db.Where("user_id IN ? AND event_type = ? AND channel = ?", userIDs, eventType, channel).
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+	set := make(map[uint]bool, len(rows))
+	for _, row := range rows {
+		set[row.UserID] = row.Enabled
+	}
+
+	fallback := defaultNotificationPreferences[eventType][channel]
+	enabled := make([]uint, 0, len(userIDs))
+	for _, id := range userIDs {
+		want, ok := set[id]
+		if !ok {
+			want = fallback
+		}
+		if want {
+			enabled = append(enabled, id)
+		}
+	}
+	return enabled, nil
+}
@@ -0,0 +1,208 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/testutil"
+)
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestDefaultNotificationPreferences_CoverMatrix(t *testing.T) {
+	assert.Len(t, defaultNotificationPreferences, len(contracts.NotificationEventTypes))
+	for _, eventType := range contracts.NotificationEventTypes {
+		channels, ok := defaultNotificationPreferences[eventType]
+		if !assert.True(t, ok, "missing event type %s", eventType) {
+			continue
+		}
+		assert.Len(t, channels, len(contracts.NotificationChannels))
+		for _, channel := range contracts.NotificationChannels {
+			_, ok := channels[channel]
+			assert.True(t, ok, "missing %s/%s", eventType, channel)
+		}
+	}
+}
+
+func TestCheckNotificationCell(t *testing.T) {
+	assert.NoError(t, checkNotificationCell(contracts.NotificationEventDigest, contracts.NotificationChannelEmail))
+
+	for _, tc := range []struct{ eventType, channel string }{
+		{"bogus", contracts.NotificationChannelEmail},
+		{contracts.NotificationEventDigest, "sms"},
+		{"", ""},
+	} {
+		err := checkNotificationCell(tc.eventType, tc.channel)
+		var prefErr *apperrors.NotificationPreferenceError
+		if assert.True(t, errors.As(err, &prefErr), "%s/%s", tc.eventType, tc.channel) {
+			assert.Equal(t, apperrors.CodeNotificationPreferenceInvalid, prefErr.Code)
+		}
+	}
+}
+
+func TestNotificationPreferenceService_NilDB(t *testing.T) {
+	svc := &NotificationPreferenceService{}
+
+	_, err := svc.GetPreferences(1)
+	assert.Error(t, err)
+	_, err = svc.UpdatePreferences(1, nil)
+	assert.Error(t, err)
+	_, err = svc.IsEnabled(1, contracts.NotificationEventDigest, contracts.NotificationChannelEmail)
+	assert.Error(t, err)
+	_, err = svc.FilterEnabledUsers([]uint{1}, contracts.NotificationEventDigest, contracts.NotificationChannelEmail)
+	assert.Error(t, err)
+}
+
+// =============================================================================
+// INTEGRATION TESTS (Testcontainer PostgreSQL)
+// =============================================================================
+
+type NotificationPreferenceSuite struct {
+	suite.Suite
+	db     *gorm.DB
+	testDB *testutil.TestDatabase
+	svc    *NotificationPreferenceService
+}
+
+func TestNotificationPreferenceSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(NotificationPreferenceSuite))
+}
+
+func (s *NotificationPreferenceSuite) SetupSuite() {
+	s.testDB = testutil.SetupTestPostgres(s.T())
+	s.db = s.testDB.DB
+	s.svc = NewNotificationPreferenceService(s.testDB.DB)
+}
+
+func (s *NotificationPreferenceSuite) TearDownTest() {
+	s.db.Exec("DELETE FROM notification_preferences")
+	s.db.Exec("DELETE FROM user_preferences")
+	s.db.Exec("DELETE FROM users")
+}
+
+func (s *NotificationPreferenceSuite) TearDownSuite() {
+	s.testDB.Cleanup()
+}
+
+func (s *NotificationPreferenceSuite) createTestUser() uint {
+	email := fmt.Sprintf("pref-%d@example.com", time.Now().UnixNano())
+	user := authm.User{Email: &email}
+	s.Require().NoError(s.db.Create(&user).Error)
+	return user.ID
+}
+
+func (s *NotificationPreferenceSuite) TestGetPreferences_Defaults() {
+	userID := s.createTestUser()
+
+	prefs, err := s.svc.GetPreferences(userID)
+	s.Require().NoError(err)
+	s.Equal(defaultNotificationPreferences, prefs)
+}
+
+func (s *NotificationPreferenceSuite) TestUpdatePreferences_PartialUpdate() {
+	userID := s.createTestUser()
+
+	prefs, err := s.svc.UpdatePreferences(userID, contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventSubmissionStatus: {contracts.NotificationChannelEmail: false},
+		contracts.NotificationEventDigest:           {contracts.NotificationChannelPush: true},
+	})
+	s.Require().NoError(err)
+	s.False(prefs[contracts.NotificationEventSubmissionStatus][contracts.NotificationChannelEmail])
+	s.True(prefs[contracts.NotificationEventDigest][contracts.NotificationChannelPush])
+	s.True(prefs[contracts.NotificationEventFavoriteVenueAnnouncement][contracts.NotificationChannelEmail])
+
+	// Updating again flips the stored row rather than adding a second one.
+	_, err = s.svc.UpdatePreferences(userID, contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventSubmissionStatus: {contracts.NotificationChannelEmail: true},
+	})
+	s.Require().NoError(err)
+	var count int64
+	s.db.Table("notification_preferences").Where("user_id = ?", userID).Count(&count)
+	s.Equal(int64(2), count)
+}
+
+func (s *NotificationPreferenceSuite) TestUpdatePreferences_InvalidCellRejectsAll() {
+	userID := s.createTestUser()
+
+	_, err := s.svc.UpdatePreferences(userID, contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventDigest: {contracts.NotificationChannelEmail: true, "sms": true},
+	})
+	var prefErr *apperrors.NotificationPreferenceError
+	s.Require().ErrorAs(err, &prefErr)
+
+	var count int64
+	s.db.Table("notification_preferences").Where("user_id = ?", userID).Count(&count)
+	s.Zero(count)
+}
+
+func (s *NotificationPreferenceSuite) TestUpdatePreferences_SyncsShowReminders() {
+	userID := s.createTestUser()
+
+	_, err := s.svc.UpdatePreferences(userID, contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventSavedShowReminder: {contracts.NotificationChannelEmail: true},
+	})
+	s.Require().NoError(err)
+
+	var prefs authm.UserPreferences
+	s.Require().NoError(s.db.Where("user_id = ?", userID).First(&prefs).Error)
+	s.True(prefs.ShowReminders)
+}
+
+func (s *NotificationPreferenceSuite) TestIsEnabled() {
+	userID := s.createTestUser()
+
+	enabled, err := s.svc.IsEnabled(userID, contracts.NotificationEventSubmissionStatus, contracts.NotificationChannelEmail)
+	s.Require().NoError(err)
+	s.True(enabled)
+
+	_, err = s.svc.UpdatePreferences(userID, contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventSubmissionStatus: {contracts.NotificationChannelEmail: false},
+	})
+	s.Require().NoError(err)
+
+	enabled, err = s.svc.IsEnabled(userID, contracts.NotificationEventSubmissionStatus, contracts.NotificationChannelEmail)
+	s.Require().NoError(err)
+	s.False(enabled)
+
+	_, err = s.svc.IsEnabled(userID, "bogus", contracts.NotificationChannelEmail)
+	s.Error(err)
+}
+
+func (s *NotificationPreferenceSuite) TestFilterEnabledUsers() {
+	optedOut := s.createTestUser()
+	defaulted := s.createTestUser()
+	optedIn := s.createTestUser()
+
+	_, err := s.svc.UpdatePreferences(optedOut, contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventFavoriteVenueAnnouncement: {contracts.NotificationChannelEmail: false},
+	})
+	s.Require().NoError(err)
+	_, err = s.svc.UpdatePreferences(optedIn, contracts.NotificationPreferenceMatrix{
+		contracts.NotificationEventFavoriteVenueAnnouncement: {contracts.NotificationChannelPush: true},
+	})
+	s.Require().NoError(err)
+
+	ids, err := s.svc.FilterEnabledUsers([]uint{optedOut, defaulted, optedIn},
+		contracts.NotificationEventFavoriteVenueAnnouncement, contracts.NotificationChannelEmail)
+	s.Require().NoError(err)
+	s.Equal([]uint{defaulted, optedIn}, ids)
+
+	ids, err = s.svc.FilterEnabledUsers([]uint{optedOut, defaulted, optedIn},
+		contracts.NotificationEventFavoriteVenueAnnouncement, contracts.NotificationChannelPush)
+	s.Require().NoError(err)
+	s.Equal([]uint{optedIn}, ids)
+}
@@ -0,0 +1,287 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+	apperrors "psychic-homily-backend/internal/errors"
+	"psychic-homily-backend/internal/httpclient"
+	notificationm "psychic-homily-backend/internal/models/notification"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// maxPushSubscriptionsPerUser caps stored browsers per user; subscribing
+// past it drops the least recently created one.
+const maxPushSubscriptionsPerUser = 10
+
+// pushTTL is how long a push service holds a message for an offline
+// browser. Reminders and announcements are stale after a day.
+const pushTTL = 24 * time.Hour
+
+// pushServiceHosts are the push services subscription endpoints may point
+// at. Endpoints come from the browser via the client, so anything else is
+// refused rather than letting the server POST to an arbitrary URL. Keep in
+// sync with Config.RequiredEgressHosts.
+var pushServiceHosts = map[string]bool{
+	"fcm.googleapis.com":                true,
+	"updates.push.services.mozilla.com": true,
+	"web.push.apple.com":                true,
+}
+
+// PushService stores Web Push subscriptions and delivers messages to them.
+type PushService struct {
+	db     *gorm.DB
+	signer *vapidSigner // nil when push is not configured
+	client *http.Client
+	now    func() time.Time
+	logger *slog.Logger
+}
+
+// NewPushService creates a new push service. With push disabled in cfg (or
+// an unusable key, which Config.Load has already rejected) it still serves
+// unsubscribe but refuses new subscriptions and sends nothing.
+func NewPushService(database *gorm.DB, cfg config.PushConfig) *PushService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	svc := &PushService{
+		db:     database,
+		client: httpclient.New(15 * time.Second),
+		now:    time.Now,
+		logger: slog.Default(),
+	}
+	if cfg.Enabled() {
+		signer, err := newVAPIDSigner(cfg.VAPIDPrivateKey, cfg.VAPIDSubject)
+		if err != nil {
+			svc.logger.Warn("web push disabled", "error", err)
+		} else {
+			svc.signer = signer
+		}
+	}
+	return svc
+}
+
+// IsConfigured reports whether push messages can be sent.
+func (s *PushService) IsConfigured() bool {
+	return s.signer != nil
+}
+
+// PublicKey returns the VAPID public key, or "" when push is disabled.
+func (s *PushService) PublicKey() string {
+	if s.signer == nil {
+		return ""
+	}
+	return s.signer.publicKey
+}
+
+// checkPushSubscription validates a subscription's endpoint and keys.
+func checkPushSubscription(input contracts.PushSubscriptionInput) error {
+	u, err := url.Parse(input.Endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return apperrors.ErrPushSubscriptionInvalid("endpoint must be an https URL")
+	}
+	if !pushServiceHosts[strings.ToLower(u.Hostname())] {
+		return apperrors.ErrPushSubscriptionInvalid(fmt.Sprintf("unsupported push service %q", u.Hostname()))
+	}
+	p256dh, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(input.P256dh, "="))
+	if err != nil {
+		return apperrors.ErrPushSubscriptionInvalid("p256dh must be base64url encoded")
+	}
+	if _, err := ecdh.P256().NewPublicKey(p256dh); err != nil {
+		return apperrors.ErrPushSubscriptionInvalid("p256dh is not a P-256 public key")
+	}
+	auth, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(input.Auth, "="))
+	if err != nil || len(auth) != 16 {
+		return apperrors.ErrPushSubscriptionInvalid("auth must be a base64url 16-byte secret")
+	}
+	return nil
+}
+
+// Subscribe stores a browser's subscription for the user. Re-subscribing an
+// endpoint refreshes its keys and moves it to this user.
+func (s *PushService) Subscribe(userID uint, input contracts.PushSubscriptionInput) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	if !s.IsConfigured() {
+		return apperrors.ErrPushNotConfigured()
+	}
+	if err := checkPushSubscription(input); err != nil {
+		return err
+	}
+
+	var userAgent *string
+	if ua := strings.TrimSpace(input.UserAgent); ua != "" {
+		if len(ua) > 512 {
+			ua = ua[:512]
+		}
+		userAgent = &ua
+	}
+	sub := notificationm.PushSubscription{
+		UserID:    userID,
+		Endpoint:  input.Endpoint,
+		P256dh:    strings.TrimRight(input.P256dh, "="),
+		Auth:      strings.TrimRight(input.Auth, "="),
+		UserAgent: userAgent,
+		CreatedAt: s.now(),
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "endpoint"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_id", "p256dh", "auth", "user_agent", "created_at"}),
+		}).Create(&sub).Error; err != nil {
+			return fmt.Errorf("failed to save push subscription: %w", err)
+		}
+
+		var stale []uint
+		if err := tx.Model(&notificationm.PushSubscription{}).
+			Where("user_id = ?", userID).
+			Order("created_at DESC, id DESC").
+			Offset(maxPushSubscriptionsPerUser).
+			Pluck("id", &stale).Error; err != nil {
+			return fmt.Errorf("failed to count push subscriptions: %w", err)
+		}
+		if len(stale) > 0 {
+			if err := tx.Delete(&notificationm.PushSubscription{}, stale).Error; err != nil {
+				return fmt.Errorf("failed to prune push subscriptions: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Unsubscribe removes the user's subscription for endpoint.
+func (s *PushService) Unsubscribe(userID uint, endpoint string) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	result := s.db.Where("user_id = ? AND endpoint = ?", userID, endpoint).
+		Delete(&notificationm.PushSubscription{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete push subscription: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrPushSubscriptionNotFound()
+	}
+	return nil
+}
+
+// SendToUser delivers msg to each of the user's subscriptions. Failures on
+// one browser don't stop the others; the error reports the last failure
+// only when nothing was delivered.
+func (s *PushService) SendToUser(userID uint, msg contracts.PushMessage) (int, error) {
+	if s.db == nil {
+		return 0, fmt.Errorf("database not initialized")
+	}
+	if !s.IsConfigured() {
+		return 0, apperrors.ErrPushNotConfigured()
+	}
+
+	var subs []notificationm.PushSubscription
+	if err := s.db.Where("user_id = ?", userID).Find(&subs).Error; err != nil {
+		return 0, fmt.Errorf("failed to load push subscriptions: %w", err)
+	}
+	if len(subs) == 0 {
+		return 0, nil
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode push message: %w", err)
+	}
+
+	delivered := 0
+	var lastErr error
+	for i := range subs {
+		sub := &subs[i]
+		gone, err := s.send(sub, payload)
+		switch {
+		case gone:
+			s.logger.Info("removing expired push subscription", "user_id", userID, "subscription_id", sub.ID)
+			s.db.Delete(&notificationm.PushSubscription{}, sub.ID)
+		case err != nil:
+			s.logger.Error("push delivery failed", "user_id", userID, "subscription_id", sub.ID, "error", err)
+			lastErr = err
+		default:
+			delivered++
+			s.db.Model(sub).Update("last_success_at", s.now())
+		}
+	}
+	if delivered == 0 && lastErr != nil {
+		return 0, lastErr
+	}
+	return delivered, nil
+}
+
+// send encrypts payload for one subscription and posts it. gone reports a
+// 404/410 from the push service: the browser unsubscribed or the
+// subscription expired.
+func (s *PushService) send(sub *notificationm.PushSubscription, payload []byte) (gone bool, err error) {
+	p256dh, err := base64.RawURLEncoding.DecodeString(sub.P256dh)
+	if err != nil {
+		return false, fmt.Errorf("stored p256dh is invalid: %w", err)
+	}
+	auth, err := base64.RawURLEncoding.DecodeString(sub.Auth)
+	if err != nil {
+		return false, fmt.Errorf("stored auth is invalid: %w", err)
+	}
+
+	// A fresh sender key and salt per message (RFC 8291 §3.1).
+	asKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return false, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return false, err
+	}
+	body, err := encryptPushPayload(payload, p256dh, auth, salt, asKey)
+	if err != nil {
+		return false, err
+	}
+
+	authorization, err := s.signer.authorization(sub.Endpoint, s.now())
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequest(http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to build push request: %w", err)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", fmt.Sprintf("%d", int(pushTTL.Seconds())))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("push request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return true, nil
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	default:
+		return false, fmt.Errorf("push service returned %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,143 @@
+package notification
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psychic-homily-backend/internal/config"
+	apperrors "psychic-homily-backend/internal/errors"
+	notificationm "psychic-homily-backend/internal/models/notification"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func validPushInput() contracts.PushSubscriptionInput {
+	return contracts.PushSubscriptionInput{
+		Endpoint: "https://fcm.googleapis.com/fcm/send/abc123",
+		P256dh:   rfc8291UAPublic,
+		Auth:     rfc8291AuthSecret,
+	}
+}
+
+func TestCheckPushSubscription(t *testing.T) {
+	assert.NoError(t, checkPushSubscription(validPushInput()))
+
+	padded := validPushInput()
+	padded.Auth += "=="
+	assert.NoError(t, checkPushSubscription(padded), "padded base64url is accepted")
+
+	for name, mutate := range map[string]func(*contracts.PushSubscriptionInput){
+		"http endpoint": func(in *contracts.PushSubscriptionInput) { in.Endpoint = "http://fcm.googleapis.com/fcm/send/abc" },
+		"unknown host":  func(in *contracts.PushSubscriptionInput) { in.Endpoint = "https://evil.example.com/push" },
+		"lookalike host": func(in *contracts.PushSubscriptionInput) {
+			in.Endpoint = "https://fcm.googleapis.com.evil.example/push"
+		},
+		"internal host":       func(in *contracts.PushSubscriptionInput) { in.Endpoint = "https://169.254.169.254/latest" },
+		"garbage endpoint":    func(in *contracts.PushSubscriptionInput) { in.Endpoint = "::not a url" },
+		"p256dh not base64":   func(in *contracts.PushSubscriptionInput) { in.P256dh = "not base64!" },
+		"p256dh not on curve": func(in *contracts.PushSubscriptionInput) { in.P256dh = "BAAA" },
+		"auth wrong length":   func(in *contracts.PushSubscriptionInput) { in.Auth = "AAAA" },
+	} {
+		in := validPushInput()
+		mutate(&in)
+		err := checkPushSubscription(in)
+		var pushErr *apperrors.PushError
+		if assert.True(t, errors.As(err, &pushErr), name) {
+			assert.Equal(t, apperrors.CodePushSubscriptionInvalid, pushErr.Code, name)
+		}
+	}
+}
+
+func TestNewPushService_Disabled(t *testing.T) {
+	svc := NewPushService(nil, config.PushConfig{})
+	assert.False(t, svc.IsConfigured())
+	assert.Empty(t, svc.PublicKey())
+}
+
+func TestNewPushService_Enabled(t *testing.T) {
+	svc := NewPushService(nil, config.PushConfig{
+		VAPIDPublicKey:  rfc8291ASPublic,
+		VAPIDPrivateKey: rfc8291ASPrivate,
+		VAPIDSubject:    "mailto:noreply@example.com",
+	})
+	assert.True(t, svc.IsConfigured())
+	assert.Equal(t, rfc8291ASPublic, svc.PublicKey())
+}
+
+func TestPushService_NilDB(t *testing.T) {
+	svc := &PushService{}
+
+	assert.Error(t, svc.Subscribe(1, validPushInput()))
+	assert.Error(t, svc.Unsubscribe(1, "https://fcm.googleapis.com/fcm/send/abc123"))
+	_, err := svc.SendToUser(1, contracts.PushMessage{})
+	assert.Error(t, err)
+}
+
+// newTestPushService returns a configured service whose client targets srv.
+func newTestPushService(t *testing.T, srv *httptest.Server) *PushService {
+	t.Helper()
+	signer, err := newVAPIDSigner(rfc8291ASPrivate, "mailto:noreply@example.com")
+	require.NoError(t, err)
+	return &PushService{
+		signer: signer,
+		client: srv.Client(),
+		now:    func() time.Time { return time.Unix(1_900_000_000, 0) },
+	}
+}
+
+func TestPushService_Send(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	svc := newTestPushService(t, srv)
+	sub := &notificationm.PushSubscription{Endpoint: srv.URL + "/push/abc", P256dh: rfc8291UAPublic, Auth: rfc8291AuthSecret}
+
+	gone, err := svc.send(sub, []byte(`{"title":"Show"}`))
+	require.NoError(t, err)
+	assert.False(t, gone)
+
+	assert.Equal(t, "aes128gcm", got.Header.Get("Content-Encoding"))
+	assert.Equal(t, "86400", got.Header.Get("TTL"))
+	assert.True(t, strings.HasPrefix(got.Header.Get("Authorization"), "vapid t="))
+	assert.True(t, strings.HasSuffix(got.Header.Get("Authorization"), ", k="+rfc8291ASPublic))
+	// salt(16) + rs(4) + idlen(1) + key(65) + payload + delimiter + tag(16)
+	assert.Len(t, body, 16+4+1+65+len(`{"title":"Show"}`)+1+16)
+}
+
+func TestPushService_Send_StatusHandling(t *testing.T) {
+	for _, tc := range []struct {
+		status  int
+		gone    bool
+		wantErr bool
+	}{
+		{http.StatusOK, false, false},
+		{http.StatusNotFound, true, false},
+		{http.StatusGone, true, false},
+		{http.StatusTooManyRequests, false, true},
+		{http.StatusInternalServerError, false, true},
+	} {
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(tc.status)
+		}))
+		svc := newTestPushService(t, srv)
+		sub := &notificationm.PushSubscription{Endpoint: srv.URL, P256dh: rfc8291UAPublic, Auth: rfc8291AuthSecret}
+
+		gone, err := svc.send(sub, []byte("{}"))
+		assert.Equal(t, tc.gone, gone, "status %d", tc.status)
+		assert.Equal(t, tc.wantErr, err != nil, "status %d", tc.status)
+		srv.Close()
+	}
+}
@@ -0,0 +1,109 @@
+package notification
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/httpclient"
+)
+
+// SlackNotifier posts the admin notifications DiscordService sends (new
+// users, show submissions, reports, venues, ...) to a Slack incoming
+// webhook instead. It embeds a DiscordService so both build exactly the
+// same messages; only the webhook payload differs. Batching and 429
+// retries work as they do for Discord.
+type SlackNotifier struct {
+	*DiscordService
+}
+
+// NewSlackNotifier creates a Slack notifier from cfg.Slack.
+func NewSlackNotifier(cfg *config.Config) *SlackNotifier {
+	return &SlackNotifier{DiscordService: &DiscordService{
+		webhookURL:  cfg.Slack.WebhookURL,
+		enabled:     cfg.Slack.Enabled,
+		frontendURL: cfg.Email.FrontendURL,
+		httpClient:  httpclient.New(10 * time.Second),
+		batchWindow: cfg.Slack.BatchWindow,
+		platform:    "slack",
+		payload:     slackPayload,
+	}}
+}
+
+// SlackWebhookPayload is the body of a Slack incoming webhook post.
+type SlackWebhookPayload struct {
+	// Text is the notification preview; the attachments carry the detail.
+	Text        string            `json:"text"`
+	Attachments []SlackAttachment `json:"attachments"`
+}
+
+// SlackAttachment is a Slack message attachment, the closest Slack
+// equivalent of a Discord embed (colored bar, title, fields).
+type SlackAttachment struct {
+	Color    string       `json:"color,omitempty"`
+	Title    string       `json:"title,omitempty"`
+	Text     string       `json:"text,omitempty"`
+	Fields   []SlackField `json:"fields,omitempty"`
+	Ts       int64        `json:"ts,omitempty"`
+	MrkdwnIn []string     `json:"mrkdwn_in,omitempty"`
+}
+
+// SlackField is a field of a SlackAttachment.
+type SlackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short,omitempty"`
+}
+
+// slackPayload converts embeds to a Slack webhook payload.
+func slackPayload(embeds []DiscordEmbed) any {
+	titles := make([]string, 0, len(embeds))
+	attachments := make([]SlackAttachment, 0, len(embeds))
+	for _, e := range embeds {
+		titles = append(titles, e.Title)
+		attachments = append(attachments, slackAttachment(e))
+	}
+	return SlackWebhookPayload{
+		Text:        slackEscape(strings.Join(titles, ", ")),
+		Attachments: attachments,
+	}
+}
+
+// slackAttachment converts one embed.
+func slackAttachment(e DiscordEmbed) SlackAttachment {
+	a := SlackAttachment{
+		Title:    slackEscape(e.Title),
+		Text:     slackMarkdown(e.Description),
+		MrkdwnIn: []string{"text", "fields"},
+	}
+	if e.Color != 0 {
+		a.Color = fmt.Sprintf("#%06X", e.Color)
+	}
+	if ts, err := time.Parse(time.RFC3339, e.Timestamp); err == nil {
+		a.Ts = ts.Unix()
+	}
+	for _, f := range e.Fields {
+		a.Fields = append(a.Fields, SlackField{
+			Title: slackEscape(f.Name),
+			Value: slackMarkdown(f.Value),
+			Short: f.Inline,
+		})
+	}
+	return a
+}
+
+// markdownLink matches the [label](url) links used in embeds.
+var markdownLink = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+
+// slackMarkdown escapes s for Slack and rewrites markdown links to Slack's
+// <url|label> form.
+func slackMarkdown(s string) string {
+	return markdownLink.ReplaceAllString(slackEscape(s), "<$2|$1>")
+}
+
+// slackEscape escapes the characters Slack treats as control sequences.
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
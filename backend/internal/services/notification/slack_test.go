@@ -0,0 +1,107 @@
+package notification
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psychic-homily-backend/internal/config"
+	authm "psychic-homily-backend/internal/models/auth"
+)
+
+func setupSlackTest(t *testing.T) (*SlackNotifier, chan []byte) {
+	t.Helper()
+	discord, payloads, server := setupDiscordTest(t)
+	cfg := &config.Config{
+		Slack: config.SlackConfig{WebhookURL: server.URL, Enabled: true},
+		Email: config.EmailConfig{FrontendURL: discord.frontendURL},
+	}
+	slack := NewSlackNotifier(cfg)
+	slack.httpClient = server.Client()
+	return slack, payloads
+}
+
+func parseSlackPayload(t *testing.T, raw []byte) SlackWebhookPayload {
+	t.Helper()
+	var payload SlackWebhookPayload
+	require.NoError(t, json.Unmarshal(raw, &payload), "failed to parse Slack payload JSON")
+	return payload
+}
+
+func TestNewSlackNotifier_NotConfigured(t *testing.T) {
+	assert.False(t, NewSlackNotifier(&config.Config{Slack: config.SlackConfig{Enabled: true}}).IsConfigured())
+	assert.False(t, NewSlackNotifier(&config.Config{Slack: config.SlackConfig{WebhookURL: "https://hooks.slack.com/x"}}).IsConfigured())
+}
+
+func TestSlackNotifier_NotifyNewUser(t *testing.T) {
+	slack, payloads := setupSlackTest(t)
+	email := "john.doe@example.com"
+
+	slack.NotifyNewUser(&authm.User{ID: 7, Email: &email})
+
+	payload := parseSlackPayload(t, waitForPayload(t, payloads))
+	assert.Equal(t, "New User Registration", payload.Text)
+	require.Len(t, payload.Attachments, 1)
+	a := payload.Attachments[0]
+	assert.Equal(t, "#00FF00", a.Color)
+	assert.Equal(t, "New User Registration", a.Title)
+	assert.NotZero(t, a.Ts)
+	var emailField *SlackField
+	for i := range a.Fields {
+		if a.Fields[i].Title == "Email" {
+			emailField = &a.Fields[i]
+		}
+	}
+	require.NotNil(t, emailField)
+	assert.Equal(t, "jo***@example.com", emailField.Value)
+}
+
+func TestSlackNotifier_NotifyNewVenueLinksToAdmin(t *testing.T) {
+	slack, payloads := setupSlackTest(t)
+
+	slack.NotifyNewVenue(3, "Rhythm & Rooms", "Phoenix", "AZ", nil, "a@b.com")
+
+	payload := parseSlackPayload(t, waitForPayload(t, payloads))
+	require.Len(t, payload.Attachments, 1)
+	a := payload.Attachments[0]
+	assert.Contains(t, a.Title+a.Text, "Rhythm &amp; Rooms")
+	var values []string
+	for _, f := range a.Fields {
+		values = append(values, f.Value)
+	}
+	assert.Contains(t, values, "<http://localhost:3000/admin?tab=venues|Review Venues>")
+}
+
+func TestSlackMarkdown(t *testing.T) {
+	assert.Equal(t, "<https://x/admin|Review> &lt;b&gt; &amp; more", slackMarkdown("[Review](https://x/admin) <b> & more"))
+}
+
+func TestAdminNotifier_FansOutToEveryChannel(t *testing.T) {
+	discord, discordPayloads, _ := setupDiscordTest(t)
+	slack, slackPayloads := setupSlackTest(t)
+	notifier := NewAdminNotifier(discord, slack)
+	assert.True(t, notifier.IsConfigured())
+
+	notifier.NotifyMaintenanceMode(true, "admin toggle", "admin@example.com")
+
+	assert.NotEmpty(t, parseWebhookPayload(t, waitForPayload(t, discordPayloads)).Embeds)
+	assert.NotEmpty(t, parseSlackPayload(t, waitForPayload(t, slackPayloads)).Attachments)
+}
+
+func TestAdminNotifier_SkipsUnconfiguredChannel(t *testing.T) {
+	discord, discordPayloads, _ := setupDiscordTest(t)
+	discord.enabled = false
+	slack, slackPayloads := setupSlackTest(t)
+	slack.batchWindow = time.Hour
+	notifier := NewAdminNotifier(discord, slack)
+
+	notifier.NotifyNewVenue(1, "Valley Bar", "Phoenix", "AZ", nil, "a@b.com")
+	assertNoPayload(t, slackPayloads)
+	notifier.Flush()
+
+	waitForPayload(t, slackPayloads)
+	assertNoPayload(t, discordPayloads)
+}
@@ -0,0 +1,152 @@
+package notification
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+)
+
+// Web Push message encryption (RFC 8291, aes128gcm content coding from
+// RFC 8188) and VAPID request signing (RFC 8292), on the standard library.
+
+// pushRecordSize is the aes128gcm record size. A push message is a single
+// record, so it also bounds the payload.
+const pushRecordSize = 4096
+
+// maxPushPayload is the largest plaintext that fits one record: the record
+// size less the 16-byte GCM tag and the 1-byte padding delimiter.
+const maxPushPayload = pushRecordSize - 16 - 1
+
+// vapidTokenTTL is how long a signed VAPID token is valid (RFC 8292 caps it
+// at 24 hours).
+const vapidTokenTTL = 12 * time.Hour
+
+// encryptPushPayload encrypts plaintext for a subscription's p256dh key and
+// auth secret. asKey is a fresh application server key pair and salt 16
+// random bytes; both are parameters so tests can use the RFC vectors.
+func encryptPushPayload(plaintext, uaPublic, authSecret, salt []byte, asKey *ecdh.PrivateKey) ([]byte, error) {
+	if len(plaintext) > maxPushPayload {
+		return nil, fmt.Errorf("push payload is %d bytes, max %d", len(plaintext), maxPushPayload)
+	}
+	if len(salt) != 16 {
+		return nil, fmt.Errorf("salt must be 16 bytes")
+	}
+	uaKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	sharedSecret, err := asKey.ECDH(uaKey)
+	if err != nil {
+		return nil, fmt.Errorf("ecdh failed: %w", err)
+	}
+	asPublic := asKey.PublicKey().Bytes()
+
+	// RFC 8291 §3.4: mix the auth secret and both public keys into the IKM.
+	keyInfo := "WebPush: info\x00" + string(uaPublic) + string(asPublic)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Header: salt | record size | key id length | key id (the sender's
+	// public key), then the single record padded with the 0x02 delimiter.
+	body := make([]byte, 0, 16+4+1+len(asPublic)+len(plaintext)+1+gcm.Overhead())
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, pushRecordSize)
+	body = append(body, byte(len(asPublic)))
+	body = append(body, asPublic...)
+	record := append(append([]byte{}, plaintext...), 0x02)
+	return gcm.Seal(body, nonce, record, nil), nil
+}
+
+// vapidSigner signs VAPID tokens with the deployment's application server
+// key.
+type vapidSigner struct {
+	key       *ecdsa.PrivateKey
+	publicKey string // base64url, as sent in the k= parameter
+	subject   string
+}
+
+// newVAPIDSigner builds a signer from a base64url raw P-256 private key.
+func newVAPIDSigner(privateKey, subject string) (*vapidSigner, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key encoding: %w", err)
+	}
+	ecdhKey, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+	pub := ecdhKey.PublicKey().Bytes()
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:33]),
+			Y:     new(big.Int).SetBytes(pub[33:]),
+		},
+		D: new(big.Int).SetBytes(raw),
+	}
+	return &vapidSigner{
+		key:       key,
+		publicKey: base64.RawURLEncoding.EncodeToString(pub),
+		subject:   subject,
+	}, nil
+}
+
+// authorization returns the Authorization header value for a request to
+// endpoint: a signed ES256 JWT whose audience is the push service origin.
+func (v *vapidSigner) authorization(endpoint string, now time.Time) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint: %w", err)
+	}
+	header, _ := json.Marshal(map[string]string{"typ": "JWT", "alg": "ES256"})
+	claims, _ := json.Marshal(map[string]any{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": now.Add(vapidTokenTTL).Unix(),
+		"sub": v.subject,
+	})
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, v.key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign VAPID token: %w", err)
+	}
+	// JWS ES256 signatures are the fixed-width r || s, not ASN.1.
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	token := signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+	return fmt.Sprintf("vapid t=%s, k=%s", token, v.publicKey), nil
+}
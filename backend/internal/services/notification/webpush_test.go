@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 8291 Appendix A test vectors.
+const (
+	rfc8291Plaintext  = "When I grow up, I want to be a watermelon"
+	rfc8291ASPrivate  = "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"
+	rfc8291ASPublic   = "BP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A8"
+	rfc8291UAPublic   = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	rfc8291AuthSecret = "BTBZMqHH6r4Tts7J_aSIgg"
+	rfc8291Salt       = "DGv6ra1nlYgDCS1FRnbzlw"
+	rfc8291Body       = "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+)
+
+func b64(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestEncryptPushPayload_RFC8291Vector(t *testing.T) {
+	asKey, err := ecdh.P256().NewPrivateKey(b64(t, rfc8291ASPrivate))
+	require.NoError(t, err)
+
+	body, err := encryptPushPayload([]byte(rfc8291Plaintext), b64(t, rfc8291UAPublic), b64(t, rfc8291AuthSecret), b64(t, rfc8291Salt), asKey)
+	require.NoError(t, err)
+	assert.Equal(t, rfc8291Body, base64.RawURLEncoding.EncodeToString(body))
+}
+
+func TestEncryptPushPayload_Rejects(t *testing.T) {
+	asKey, err := ecdh.P256().NewPrivateKey(b64(t, rfc8291ASPrivate))
+	require.NoError(t, err)
+	ua, auth, salt := b64(t, rfc8291UAPublic), b64(t, rfc8291AuthSecret), b64(t, rfc8291Salt)
+
+	_, err = encryptPushPayload(make([]byte, maxPushPayload+1), ua, auth, salt, asKey)
+	assert.Error(t, err, "oversized payload")
+	_, err = encryptPushPayload([]byte("hi"), ua[:10], auth, salt, asKey)
+	assert.Error(t, err, "bad p256dh")
+	_, err = encryptPushPayload([]byte("hi"), ua, auth, salt[:8], asKey)
+	assert.Error(t, err, "short salt")
+}
+
+func TestVAPIDSigner_Authorization(t *testing.T) {
+	signer, err := newVAPIDSigner(rfc8291ASPrivate, "mailto:noreply@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, rfc8291ASPublic, signer.publicKey)
+
+	now := time.Unix(1_900_000_000, 0)
+	header, err := signer.authorization("https://fcm.googleapis.com/fcm/send/abc123", now)
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(header, "vapid t="))
+	parts := strings.SplitN(strings.TrimPrefix(header, "vapid t="), ", k=", 2)
+	require.Len(t, parts, 2)
+	assert.Equal(t, rfc8291ASPublic, parts[1])
+
+	segments := strings.Split(parts[0], ".")
+	require.Len(t, segments, 3)
+	var claims struct {
+		Aud string `json:"aud"`
+		Exp int64  `json:"exp"`
+		Sub string `json:"sub"`
+	}
+	require.NoError(t, json.Unmarshal(b64(t, segments[1]), &claims))
+	assert.Equal(t, "https://fcm.googleapis.com", claims.Aud)
+	assert.Equal(t, now.Add(vapidTokenTTL).Unix(), claims.Exp)
+	assert.Equal(t, "mailto:noreply@example.com", claims.Sub)
+
+	sig := b64(t, segments[2])
+	require.Len(t, sig, 64)
+	digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	assert.True(t, ecdsa.Verify(&signer.key.PublicKey, digest[:], r, s))
+}
+
+func TestNewVAPIDSigner_InvalidKey(t *testing.T) {
+	_, err := newVAPIDSigner("not base64!", "mailto:x@example.com")
+	assert.Error(t, err)
+	_, err = newVAPIDSigner("AAAA", "mailto:x@example.com")
+	assert.Error(t, err)
+}
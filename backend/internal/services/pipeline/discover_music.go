@@ -271,7 +271,7 @@ func (s *DiscoverMusicService) artistShowRegions(artistID uint) ([]showRegion, e
 		Joins("JOIN shows ON shows.id = show_artists.show_id").
 		Joins("JOIN show_venues ON show_venues.show_id = shows.id").
 		Joins("JOIN venues ON venues.id = show_venues.venue_id").
-		Where("show_artists.artist_id = ? AND shows.status = ?", artistID, catalogm.ShowStatusApproved).
+		Where("show_artists.artist_id = ? AND shows.status = ? AND shows.deleted_at IS NULL", artistID, catalogm.ShowStatusApproved).
 		Where("venues.state <> '' AND venues.city <> ''").
 		Distinct().
 		Select("venues.city AS city, venues.state AS state").
@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
-	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +31,7 @@ type DiscoveryService struct {
 	db                *gorm.DB
 	venueService      venueFinderCreator
 	enrichmentService enrichmentQueuer
+	staleAfterDays    int
 }
 
 // venueFinderCreator is the subset of VenueService used by DiscoveryService.
@@ -44,8 +45,9 @@ func NewDiscoveryService(database *gorm.DB, venueSvc venueFinderCreator) *Discov
 		database = db.GetDB()
 	}
 	return &DiscoveryService{
-		db:           database,
-		venueService: venueSvc,
+		db:             database,
+		venueService:   venueSvc,
+		staleAfterDays: discoveryStaleDays(),
 	}
 }
 
@@ -123,19 +125,10 @@ var VenueConfig = map[string]struct {
 	// "mohawk": { Name: "Mohawk", City: "Austin", State: "TX", Address: "912 Red River St" },
 }
 
-// ImportFromJSON imports events from a JSON file
-func (s *DiscoveryService) ImportFromJSON(filepath string, dryRun bool) (*contracts.ImportResult, error) {
-	if s.db == nil {
-		return nil, fmt.Errorf("database not initialized")
-	}
-
-	// Read the JSON file
-	data, err := os.ReadFile(filepath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
-	}
-
-	// Parse JSON - could be a single venue's events or multiple venues
+// ParseDiscoveredEvents decodes a scraper output payload. The payload is
+// either a single array of events or an object mapping venue slugs to event
+// arrays, which is flattened into one slice.
+func ParseDiscoveredEvents(data []byte) ([]contracts.DiscoveredEvent, error) {
 	var events []contracts.DiscoveredEvent
 
 	// Try parsing as array first
@@ -151,14 +144,36 @@ func (s *DiscoveryService) ImportFromJSON(filepath string, dryRun bool) (*contra
 		}
 	}
 
+	return events, nil
+}
+
+// ImportFromJSON imports events from a JSON file
+func (s *DiscoveryService) ImportFromJSON(filepath string, dryRun bool) (*contracts.ImportResult, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	// Read the JSON file
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	events, err := ParseDiscoveredEvents(data)
+	if err != nil {
+		return nil, err
+	}
+
 	result := &contracts.ImportResult{
 		Total:    len(events),
 		Messages: make([]string, 0),
 	}
 
+	tally := sourceTally{}
 	for _, event := range events {
 		msg, status := s.importEvent(&event, dryRun, false, catalogm.ShowStatusApproved)
 		result.Messages = append(result.Messages, msg)
+		tally.add(&event, status, msg)
 
 		switch status {
 		case "imported":
@@ -176,6 +191,10 @@ func (s *DiscoveryService) ImportFromJSON(filepath string, dryRun bool) (*contra
 		}
 	}
 
+	if !dryRun {
+		s.recordSourceImports(tally)
+	}
+
 	return result, nil
 }
 
@@ -202,6 +221,33 @@ func (s *DiscoveryService) checkHeadlinerDuplicate(headlinerName, venueName stri
 	return &existingShow
 }
 
+// resolveVenueAlias returns the canonical venue an admin-curated alias maps
+// the event's scraped venue name (or, failing that, the configured name) to,
+// or nil when neither is aliased.
+func (s *DiscoveryService) resolveVenueAlias(tx *gorm.DB, event *contracts.DiscoveredEvent, configName string) (*catalogm.Venue, error) {
+	for _, name := range []string{event.Venue, configName} {
+		venue, err := catalog.ResolveVenueAliasTx(tx, name)
+		if err != nil || venue != nil {
+			return venue, err
+		}
+	}
+	return nil, nil
+}
+
+// canonicalArtistName returns the name of the artist an alias maps name to,
+// or name unchanged when it is not aliased. Lookup errors fall back to name:
+// this only feeds the best-effort headliner duplicate check.
+func (s *DiscoveryService) canonicalArtistName(name string) string {
+	if name == "" {
+		return name
+	}
+	artist, err := catalog.ResolveArtistAliasTx(s.db, name)
+	if err != nil || artist == nil {
+		return name
+	}
+	return artist.Name
+}
+
 // resolveHeadlinerName determines the headliner artist name for duplicate checking.
 // Prefers BillingArtists data (with explicit set_type/billing_order) over the plain Artists list.
 // Falls back to the first artist in the list, which the import logic treats as headliner.
@@ -259,6 +305,16 @@ func (s *DiscoveryService) importEvent(event *contracts.DiscoveredEvent, dryRun
 		return fmt.Sprintf("ERROR: Unknown venue slug: %s", event.VenueSlug), "error"
 	}
 
+	// An admin-curated alias pins the scraped venue to its canonical row, so
+	// the dedup checks below match on that row's name.
+	aliasVenue, err := s.resolveVenueAlias(s.db, event, venueConfig.Name)
+	if err != nil {
+		return fmt.Sprintf("ERROR: %v", err), "error"
+	}
+	if aliasVenue != nil {
+		venueConfig.Name = aliasVenue.Name
+	}
+
 	// Parse event date using the venue's state for timezone context
 	eventDate, err := parseEventDate(event.Date, event.ShowTime, venueConfig.State)
 	if err != nil {
@@ -272,7 +328,7 @@ func (s *DiscoveryService) importEvent(event *contracts.DiscoveredEvent, dryRun
 	var rejectedShow catalogm.Show
 	err = s.db.Joins("JOIN show_venues ON shows.id = show_venues.show_id").
 		Joins("JOIN venues ON show_venues.venue_id = venues.id").
-		Where("LOWER(venues.name) = LOWER(?) AND shows.event_date = ? AND shows.status = ?",
+		Where("LOWER(venues.name) = LOWER(?) AND shows.event_date = ? AND shows.status = ? AND shows.deleted_at IS NULL",
 			venueConfig.Name, eventDate, catalogm.ShowStatusRejected).
 		First(&rejectedShow).Error
 	if err == nil {
@@ -282,7 +338,7 @@ func (s *DiscoveryService) importEvent(event *contracts.DiscoveredEvent, dryRun
 
 	// Check for duplicate: same headliner + venue + date as an existing show.
 	// Determine the headliner name from billing data (preferred) or artist list.
-	headlinerName := s.resolveHeadlinerName(event)
+	headlinerName := s.canonicalArtistName(s.resolveHeadlinerName(event))
 	if headlinerName != "" {
 		if dupShow := s.checkHeadlinerDuplicate(headlinerName, venueConfig.Name, eventDate); dupShow != nil {
 			return fmt.Sprintf("DUPLICATE: %s at %s on %s (matches existing show #%d: %s)",
@@ -325,9 +381,6 @@ func (s *DiscoveryService) createShowFromEvent(event *contracts.DiscoveredEvent,
 		if event.ShowTime != nil && *event.ShowTime != "" {
 			descParts = append(descParts, fmt.Sprintf("Show: %s", *event.ShowTime))
 		}
-		if event.TicketURL != nil && *event.TicketURL != "" {
-			descParts = append(descParts, fmt.Sprintf("Tickets: %s", *event.TicketURL))
-		}
 		var description *string
 		if len(descParts) > 0 {
 			desc := strings.Join(descParts, " | ")
@@ -360,10 +413,21 @@ func (s *DiscoveryService) createShowFromEvent(event *contracts.DiscoveredEvent,
 			SourceConfidence:  &aiConfidence,
 			LastVerifiedAt:    &now,
 			DuplicateOfShowID: duplicateOfShowID,
-			Price:             parsePriceString(ptrStr(event.Price)),
 			AgeRequirement:    event.AgeRestriction,
 		}
 
+		price, _ := utils.ParsePrice(ptrStr(event.Price))
+		show.PriceMin = price.Min
+		show.PriceMax = price.Max
+		show.PriceCurrency = price.Currency
+		show.IsFree = price.IsFree
+
+		if ticketURL := discoveredTicketURL(event); ticketURL != "" {
+			provider := inferTicketProvider(ticketURL)
+			show.TicketURL = &ticketURL
+			show.TicketProvider = &provider
+		}
+
 		if event.IsSoldOut != nil && *event.IsSoldOut {
 			show.IsSoldOut = true
 		}
@@ -374,20 +438,29 @@ func (s *DiscoveryService) createShowFromEvent(event *contracts.DiscoveredEvent,
 		if err := tx.Create(show).Error; err != nil {
 			return fmt.Errorf("failed to create show: %w", err)
 		}
+		if err := catalog.AssignShowShortCode(tx, show); err != nil {
+			return err
+		}
 
-		// Find or create the venue
-		address := venueConfig.Address
-		venue, _, err := s.venueService.FindOrCreateVenue(
-			venueConfig.Name,
-			venueConfig.City,
-			venueConfig.State,
-			&address,
-			nil,   // zipcode
-			tx,    // use transaction
-			false, // not admin - venue needs verification
-		)
+		// Find or create the venue, preferring an aliased canonical venue
+		venue, err := s.resolveVenueAlias(tx, event, venueConfig.Name)
 		if err != nil {
-			return fmt.Errorf("failed to find/create venue: %w", err)
+			return err
+		}
+		if venue == nil {
+			address := venueConfig.Address
+			venue, _, err = s.venueService.FindOrCreateVenue(
+				venueConfig.Name,
+				venueConfig.City,
+				venueConfig.State,
+				&address,
+				nil,   // zipcode
+				tx,    // use transaction
+				false, // not admin - venue needs verification
+			)
+			if err != nil {
+				return fmt.Errorf("failed to find/create venue: %w", err)
+			}
 		}
 
 		// Create show-venue association
@@ -434,10 +507,17 @@ func (s *DiscoveryService) createShowFromEvent(event *contracts.DiscoveredEvent,
 				continue
 			}
 
-			// Single artist write path (PSY-1254): dedup + unique slug + insert.
-			foundArtist, _, err := catalog.FindOrCreateArtistTx(tx, artistName, nil)
+			// An aliased canonical artist wins; otherwise the single artist
+			// write path (PSY-1254): dedup + unique slug + insert.
+			foundArtist, err := catalog.ResolveArtistAliasTx(tx, artistName)
 			if err != nil {
-				return fmt.Errorf("artist %s: %w", artistName, err)
+				return err
+			}
+			if foundArtist == nil {
+				foundArtist, _, err = catalog.FindOrCreateArtistTx(tx, artistName, nil)
+				if err != nil {
+					return fmt.Errorf("artist %s: %w", artistName, err)
+				}
 			}
 			artist := *foundArtist
 
@@ -477,6 +557,14 @@ func (s *DiscoveryService) createShowFromEvent(event *contracts.DiscoveredEvent,
 			if err := tx.Create(&showArtist).Error; err != nil {
 				return fmt.Errorf("failed to create show-artist association: %w", err)
 			}
+
+			// Listing genres describe the bill, so every billed artist
+			// gets them. Only genres already in the taxonomy are kept.
+			if len(event.Genres) > 0 {
+				if _, err := catalog.InferArtistGenresTx(tx, artist.ID, event.Genres, catalogm.DataSourceDiscovery); err != nil {
+					return err
+				}
+			}
 		}
 
 		// Generate slug for the show
@@ -502,17 +590,20 @@ func (s *DiscoveryService) updateShowFromEvent(existing *catalogm.Show, event *c
 	updates := make(map[string]interface{})
 	var changes []string
 
-	// Compare price
+	// Compare price, as rendered: two decimals is all a listing carries
 	if event.Price != nil {
-		newPrice := parsePriceString(*event.Price)
-		if newPrice != nil {
-			if existing.Price == nil || *existing.Price != *newPrice {
-				updates["price"] = *newPrice
-				oldStr := "nil"
-				if existing.Price != nil {
-					oldStr = fmt.Sprintf("$%.2f", *existing.Price)
+		if newPrice, ok := utils.ParsePrice(*event.Price); ok {
+			oldStr := utils.FormatPrice(existing.PriceMin, existing.PriceMax, existing.PriceCurrency, existing.IsFree)
+			newStr := utils.FormatPrice(newPrice.Min, newPrice.Max, newPrice.Currency, newPrice.IsFree)
+			if oldStr != newStr {
+				updates["price_min"] = newPrice.Min
+				updates["price_max"] = newPrice.Max
+				updates["price_currency"] = newPrice.Currency
+				updates["is_free"] = newPrice.IsFree
+				if oldStr == "" {
+					oldStr = "nil"
 				}
-				changes = append(changes, fmt.Sprintf("price: %s -> $%.2f", oldStr, *newPrice))
+				changes = append(changes, fmt.Sprintf("price: %s -> %s", oldStr, newStr))
 			}
 		}
 	}
@@ -529,6 +620,13 @@ func (s *DiscoveryService) updateShowFromEvent(existing *catalogm.Show, event *c
 		}
 	}
 
+	// Compare ticket link
+	if ticketURL := discoveredTicketURL(event); ticketURL != "" && ptrStr(existing.TicketURL) != ticketURL {
+		updates["ticket_url"] = ticketURL
+		updates["ticket_provider"] = inferTicketProvider(ticketURL)
+		changes = append(changes, fmt.Sprintf("ticketUrl: %s -> %s", ptrStr(existing.TicketURL), ticketURL))
+	}
+
 	// Compare sold out status
 	if event.IsSoldOut != nil && *event.IsSoldOut != existing.IsSoldOut {
 		updates["is_sold_out"] = *event.IsSoldOut
@@ -652,45 +750,54 @@ func ptrStr(s *string) string {
 	return *s
 }
 
-// parsePriceString parses a price string like "$18", "$23.81", "Free" into a float64
-func parsePriceString(s string) *float64 {
-	if s == "" {
-		return nil
+// discoveredTicketURL returns the event's ticket link if it would pass the
+// show API's ticket_url rules (http/https, at most 500 chars), else "".
+// Scraped links that fail are dropped rather than failing the import.
+func discoveredTicketURL(event *contracts.DiscoveredEvent) string {
+	raw := strings.TrimSpace(ptrStr(event.TicketURL))
+	if raw == "" || len(raw) > 500 {
+		return ""
 	}
-	lower := strings.ToLower(strings.TrimSpace(s))
-	if lower == "free" || lower == "no cover" {
-		val := 0.0
-		return &val
+	if err := utils.ValidateHTTPURL(raw, "Ticket URL"); err != nil {
+		return ""
 	}
-	// Strip "$" prefix
-	cleaned := strings.TrimPrefix(lower, "$")
-	val, err := strconv.ParseFloat(cleaned, 64)
+	return raw
+}
+
+// inferTicketProvider maps a ticket link's host to a ticket_provider value.
+// Scraped venue calendars link straight to the vendor, so the host is a
+// reliable signal; anything unrecognised is "other".
+func inferTicketProvider(ticketURL string) string {
+	u, err := url.Parse(ticketURL)
 	if err != nil {
-		return nil
+		return catalogm.TicketProviderOther
+	}
+	host := strings.ToLower(u.Hostname())
+	matches := func(domain string) bool {
+		return host == domain || strings.HasSuffix(host, "."+domain)
+	}
+	switch {
+	case strings.HasPrefix(host, "eventbrite.") || strings.Contains(host, ".eventbrite."):
+		return catalogm.TicketProviderEventbrite
+	case matches("dice.fm"):
+		return catalogm.TicketProviderDice
+	case matches("seetickets.us") || matches("seetickets.com"):
+		return catalogm.TicketProviderSeeTickets
+	default:
+		return catalogm.TicketProviderOther
 	}
-	return &val
 }
 
-// normalizeSetType maps AI-extracted set_type values to the values stored in the DB.
-// The show_artists.set_type column is VARCHAR and stores: headliner, opener, performer, special_guest.
-// AI extraction may return additional values like "support", "dj", "host" which are
-// mapped to the closest DB equivalent.
+// normalizeSetType maps AI-extracted set_type values to the values stored in the DB
+// (catalogm.SetTypes). AI extraction may also return "host", which is stored as
+// performer.
 func normalizeSetType(setType string) string {
-	switch strings.ToLower(strings.TrimSpace(setType)) {
-	case "headliner":
-		return "headliner"
-	case "support":
-		return "opener" // "support" maps to "opener" in the DB
-	case "opener":
-		return "opener"
-	case "special_guest":
-		return "special_guest"
-	case "performer":
-		return "performer"
-	case "dj":
-		return "performer" // DJ sets stored as performer
+	switch st := strings.ToLower(strings.TrimSpace(setType)); st {
+	case catalogm.SetTypeHeadliner, catalogm.SetTypeSupport, catalogm.SetTypeOpener,
+		catalogm.SetTypeDJ, catalogm.SetTypeSpecialGuest, catalogm.SetTypePerformer:
+		return st
 	case "host":
-		return "performer" // Hosts stored as performer
+		return catalogm.SetTypePerformer // Hosts stored as performer
 	default:
 		return "" // Unknown — caller should use fallback logic
 	}
@@ -756,7 +863,7 @@ func (s *DiscoveryService) CheckEvents(events []contracts.CheckEventInput) (*con
 
 	var shows []catalogm.Show
 	err := s.db.Where("(source_venue, source_event_id) IN ?", pairs).
-		Select("id, source_venue, source_event_id, status, price, age_requirement, description, event_date, is_sold_out, is_cancelled").
+		Select("id, source_venue, source_event_id, status, price_min, price_max, price_currency, is_free, age_requirement, description, event_date, is_sold_out, is_cancelled").
 		Find(&shows).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to check events: %w", err)
@@ -810,7 +917,7 @@ func (s *DiscoveryService) CheckEvents(events []contracts.CheckEventInput) (*con
 			Joins("JOIN venues ON show_venues.venue_id = venues.id").
 			Where("LOWER(venues.name) = LOWER(?) AND shows.event_date >= ? AND shows.event_date < ?",
 				venueConfig.Name, startOfDay, endOfDay).
-			Select("shows.id, shows.source_venue, shows.source_event_id, shows.status, shows.price, shows.age_requirement, shows.description, shows.event_date, shows.is_sold_out, shows.is_cancelled").
+			Select("shows.id, shows.source_venue, shows.source_event_id, shows.status, shows.price_min, shows.price_max, shows.price_currency, shows.is_free, shows.age_requirement, shows.description, shows.event_date, shows.is_sold_out, shows.is_cancelled").
 			First(&matchedShow).Error
 		if err != nil {
 			continue // No match found — that's fine
@@ -856,7 +963,10 @@ func buildCheckEventStatus(show catalogm.Show, artistNames []string) contracts.C
 		ShowID: show.ID,
 		Status: string(show.Status),
 		CurrentData: &contracts.ShowCurrentData{
-			Price:          show.Price,
+			Price:          show.LowestPrice(),
+			PriceMin:       show.PriceMin,
+			PriceMax:       show.PriceMax,
+			IsFree:         show.IsFree,
 			AgeRequirement: show.AgeRequirement,
 			Description:    show.Description,
 			EventDate:      show.EventDate.Format(time.RFC3339),
@@ -882,9 +992,11 @@ func (s *DiscoveryService) ImportEvents(events []contracts.DiscoveredEvent, dryR
 	// Track event IDs that were imported so we can queue them for enrichment
 	var importedEventIDs []string
 
+	tally := sourceTally{}
 	for _, event := range events {
 		msg, status := s.importEvent(&event, dryRun, allowUpdates, initialStatus)
 		result.Messages = append(result.Messages, msg)
+		tally.add(&event, status, msg)
 
 		switch status {
 		case "imported":
@@ -904,6 +1016,10 @@ func (s *DiscoveryService) ImportEvents(events []contracts.DiscoveredEvent, dryR
 		}
 	}
 
+	if !dryRun {
+		s.recordSourceImports(tally)
+	}
+
 	// Fire-and-forget: queue newly imported shows for enrichment
 	if !dryRun && s.enrichmentService != nil && len(importedEventIDs) > 0 {
 		shared.GoSafe(context.Background(), "queue_imported_shows_enrichment", func() {
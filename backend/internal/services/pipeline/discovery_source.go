@@ -0,0 +1,195 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	adminm "psychic-homily-backend/internal/models/admin"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+const (
+	// DefaultDiscoveryStaleDays is how long a source may go without a
+	// successful import before it is reported stale.
+	DefaultDiscoveryStaleDays = 7
+	// DiscoverySourceFailingStreak is the number of consecutive all-error
+	// imports after which a source is reported failing.
+	DiscoverySourceFailingStreak = 3
+)
+
+// discoveryStaleDays returns DISCOVERY_STALE_DAYS when it is a positive
+// integer, else DefaultDiscoveryStaleDays.
+func discoveryStaleDays() int {
+	if v := os.Getenv("DISCOVERY_STALE_DAYS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return DefaultDiscoveryStaleDays
+}
+
+// sourceImportStats is one source's share of a single import run.
+type sourceImportStats struct {
+	venueName string
+	events    int
+	errors    int
+	lastError string
+}
+
+// sourceTally groups an import run's per-event outcomes by venue slug.
+type sourceTally map[string]*sourceImportStats
+
+// add records one event's import status against its source.
+func (t sourceTally) add(event *contracts.DiscoveredEvent, status, msg string) {
+	if event.VenueSlug == "" {
+		return
+	}
+	stats, ok := t[event.VenueSlug]
+	if !ok {
+		stats = &sourceImportStats{venueName: event.Venue}
+		t[event.VenueSlug] = stats
+	}
+	stats.events++
+	if status == "error" {
+		stats.errors++
+		stats.lastError = msg
+	}
+}
+
+// recordSourceImports upserts a discovery_sources row for every source in
+// the tally. A source counts as successful when at least one of its events
+// got past the error path (imported, duplicate, updated, ...); an all-error
+// run extends its error streak instead. Failures are logged, never returned:
+// health tracking must not fail an import.
+func (s *DiscoveryService) recordSourceImports(tally sourceTally) {
+	now := time.Now().UTC()
+	for key, stats := range tally {
+		if err := s.recordSourceImport(key, stats, now); err != nil {
+			slog.Default().Error("discovery source health update failed",
+				"source_key", key,
+				"error", err,
+			)
+		}
+	}
+}
+
+func (s *DiscoveryService) recordSourceImport(key string, stats *sourceImportStats, now time.Time) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var source adminm.DiscoverySource
+		err := tx.Where("source_key = ?", key).First(&source).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			source = adminm.DiscoverySource{SourceKey: key}
+		}
+
+		if stats.venueName != "" {
+			source.VenueName = stats.venueName
+		}
+		source.LastImportAt = &now
+		source.LastEventCount = stats.events
+		source.TotalEvents += int64(stats.events)
+
+		if stats.errors < stats.events {
+			source.LastSuccessAt = &now
+			source.ErrorStreak = 0
+		} else {
+			source.ErrorStreak++
+		}
+		if stats.lastError != "" {
+			lastError := stats.lastError
+			source.LastError = &lastError
+		}
+
+		return tx.Save(&source).Error
+	})
+}
+
+// sourceHealth builds the API view of a source, classifying it as stale
+// (nothing successful for staleAfterDays), failing (a run of all-error
+// imports) or ok.
+func sourceHealth(source *adminm.DiscoverySource, staleAfterDays int, now time.Time) contracts.DiscoverySourceHealth {
+	health := contracts.DiscoverySourceHealth{
+		SourceKey:      source.SourceKey,
+		VenueName:      source.VenueName,
+		Status:         adminm.DiscoverySourceStatusOK,
+		LastImportAt:   source.LastImportAt,
+		LastSuccessAt:  source.LastSuccessAt,
+		LastEventCount: source.LastEventCount,
+		TotalEvents:    source.TotalEvents,
+		ErrorStreak:    source.ErrorStreak,
+		LastError:      source.LastError,
+	}
+	if source.LastSuccessAt != nil {
+		days := int(now.Sub(*source.LastSuccessAt).Hours() / 24)
+		health.DaysSinceSuccess = &days
+	}
+
+	switch {
+	case now.Sub(source.StaleSince()) >= time.Duration(staleAfterDays)*24*time.Hour:
+		health.Status = adminm.DiscoverySourceStatusStale
+	case source.ErrorStreak >= DiscoverySourceFailingStreak:
+		health.Status = adminm.DiscoverySourceStatusFailing
+	}
+	return health
+}
+
+// ListSourceHealth returns every tracked discovery source, stalest first.
+func (s *DiscoveryService) ListSourceHealth() (*contracts.DiscoverySourceHealthList, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var sources []adminm.DiscoverySource
+	if err := s.db.Order("COALESCE(last_success_at, created_at) ASC, source_key ASC").Find(&sources).Error; err != nil {
+		return nil, fmt.Errorf("failed to list discovery sources: %w", err)
+	}
+
+	now := time.Now().UTC()
+	result := &contracts.DiscoverySourceHealthList{
+		Sources:        make([]contracts.DiscoverySourceHealth, 0, len(sources)),
+		StaleAfterDays: s.staleAfterDays,
+	}
+	for i := range sources {
+		result.Sources = append(result.Sources, sourceHealth(&sources[i], s.staleAfterDays, now))
+	}
+	return result, nil
+}
+
+// ClaimNewlyStaleSources returns the sources that have gone stale and have
+// not been alerted on since their last success, stamping stale_alerted_at so
+// each stale episode is only reported once. A later successful import starts
+// a new episode.
+func (s *DiscoveryService) ClaimNewlyStaleSources() ([]contracts.DiscoverySourceHealth, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	now := time.Now().UTC()
+	cutoff := now.Add(-time.Duration(s.staleAfterDays) * 24 * time.Hour)
+
+	var claimed []adminm.DiscoverySource
+	err := s.db.Raw(`
+		UPDATE discovery_sources
+		SET stale_alerted_at = ?, updated_at = ?
+		WHERE COALESCE(last_success_at, created_at) < ?
+		  AND (stale_alerted_at IS NULL OR stale_alerted_at < COALESCE(last_success_at, created_at))
+		RETURNING *
+	`, now, now, cutoff).Scan(&claimed).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim stale discovery sources: %w", err)
+	}
+
+	result := make([]contracts.DiscoverySourceHealth, 0, len(claimed))
+	for i := range claimed {
+		result = append(result, sourceHealth(&claimed[i], s.staleAfterDays, now))
+	}
+	return result, nil
+}
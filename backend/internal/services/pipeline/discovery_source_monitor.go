@@ -0,0 +1,85 @@
+package pipeline
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/services/shared"
+)
+
+// DefaultDiscoverySourceCheckInterval is how often stale sources are checked.
+const DefaultDiscoverySourceCheckInterval = 6 * time.Hour
+
+// staleSourceNotifier is the subset of DiscordService used by the monitor.
+type staleSourceNotifier interface {
+	NotifyStaleDiscoverySources(sources []contracts.DiscoverySourceHealth, staleAfterDays int)
+}
+
+// DiscoverySourceMonitor is a background service that posts a Discord alert
+// when a discovery source stops producing events. Each stale episode is
+// alerted once (see DiscoveryService.ClaimNewlyStaleSources), so the check
+// can run often without repeating itself.
+// It follows the same Start/Stop pattern as EnrichmentWorker.
+type DiscoverySourceMonitor struct {
+	discoveryService *DiscoveryService
+	notifier         staleSourceNotifier
+	interval         time.Duration
+	stopCh           chan struct{}
+	wg               sync.WaitGroup
+	logger           *slog.Logger
+}
+
+// NewDiscoverySourceMonitor creates a new stale discovery source monitor.
+func NewDiscoverySourceMonitor(discoveryService *DiscoveryService, notifier staleSourceNotifier) *DiscoverySourceMonitor {
+	return &DiscoverySourceMonitor{
+		discoveryService: discoveryService,
+		notifier:         notifier,
+		interval:         DefaultDiscoverySourceCheckInterval,
+		stopCh:           make(chan struct{}),
+		logger:           slog.Default(),
+	}
+}
+
+// Start begins the background monitor.
+func (m *DiscoverySourceMonitor) Start(ctx context.Context) {
+	m.wg.Add(1)
+	go m.run(ctx)
+	m.logger.Info("discovery source monitor started",
+		"interval", m.interval,
+		"stale_after_days", m.discoveryService.staleAfterDays,
+	)
+}
+
+// Stop gracefully stops the monitor.
+func (m *DiscoverySourceMonitor) Stop() {
+	close(m.stopCh)
+	m.wg.Wait()
+	m.logger.Info("discovery source monitor stopped")
+}
+
+// run is the main loop. Checks once at startup: alerts are deduplicated, so
+// a restart doesn't re-announce sources that were already reported.
+func (m *DiscoverySourceMonitor) run(ctx context.Context) {
+	defer m.wg.Done()
+	shared.RunTickerLoop(ctx, "discovery_source_monitor", m.interval, m.stopCh, true, func(_ context.Context) {
+		m.CheckStaleSources()
+	})
+}
+
+// CheckStaleSources alerts on sources that have newly gone stale.
+func (m *DiscoverySourceMonitor) CheckStaleSources() {
+	stale, err := m.discoveryService.ClaimNewlyStaleSources()
+	if err != nil {
+		m.logger.Error("discovery source stale check failed", "error", err)
+		return
+	}
+	if len(stale) == 0 {
+		return
+	}
+
+	m.logger.Warn("discovery sources went stale", "count", len(stale))
+	m.notifier.NotifyStaleDiscoverySources(stale, m.discoveryService.staleAfterDays)
+}
@@ -0,0 +1,134 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	adminm "psychic-homily-backend/internal/models/admin"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestSourceTally_GroupsByVenueSlug(t *testing.T) {
+	tally := sourceTally{}
+	tally.add(&contracts.DiscoveredEvent{VenueSlug: "valley-bar", Venue: "Valley Bar"}, "imported", "ok")
+	tally.add(&contracts.DiscoveredEvent{VenueSlug: "valley-bar", Venue: "Valley Bar"}, "error", "bad date")
+	tally.add(&contracts.DiscoveredEvent{VenueSlug: "crescent-ballroom"}, "duplicate", "dup")
+	tally.add(&contracts.DiscoveredEvent{VenueSlug: ""}, "imported", "no slug")
+
+	if len(tally) != 2 {
+		t.Fatalf("expected 2 sources, got %d", len(tally))
+	}
+	vb := tally["valley-bar"]
+	if vb.events != 2 || vb.errors != 1 || vb.lastError != "bad date" || vb.venueName != "Valley Bar" {
+		t.Errorf("unexpected valley-bar stats: %+v", vb)
+	}
+}
+
+func TestSourceHealth_Status(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	recent := now.Add(-2 * 24 * time.Hour)
+	old := now.Add(-10 * 24 * time.Hour)
+
+	tests := []struct {
+		name   string
+		source adminm.DiscoverySource
+		want   string
+	}{
+		{"recent success", adminm.DiscoverySource{LastSuccessAt: &recent, CreatedAt: old}, adminm.DiscoverySourceStatusOK},
+		{"old success", adminm.DiscoverySource{LastSuccessAt: &old, CreatedAt: old}, adminm.DiscoverySourceStatusStale},
+		{"never succeeded, new", adminm.DiscoverySource{CreatedAt: recent, ErrorStreak: 1}, adminm.DiscoverySourceStatusOK},
+		{"never succeeded, old", adminm.DiscoverySource{CreatedAt: old, ErrorStreak: 1}, adminm.DiscoverySourceStatusStale},
+		{"error streak", adminm.DiscoverySource{LastSuccessAt: &recent, CreatedAt: old, ErrorStreak: DiscoverySourceFailingStreak}, adminm.DiscoverySourceStatusFailing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sourceHealth(&tt.source, 7, now)
+			if got.Status != tt.want {
+				t.Errorf("status = %q, want %q", got.Status, tt.want)
+			}
+		})
+	}
+
+	got := sourceHealth(&adminm.DiscoverySource{LastSuccessAt: &old}, 7, now)
+	if got.DaysSinceSuccess == nil || *got.DaysSinceSuccess != 10 {
+		t.Errorf("days_since_success = %v, want 10", got.DaysSinceSuccess)
+	}
+}
+
+// =============================================================================
+// Source health integration tests (DiscoveryIntegrationTestSuite)
+// =============================================================================
+
+func (suite *DiscoveryIntegrationTestSuite) TestImportEvents_RecordsSourceHealth() {
+	events := []contracts.DiscoveredEvent{
+		suite.makeEvent("src-001", "Khruangbin", "valley-bar", "2026-06-20", []string{"Khruangbin"}),
+		suite.makeEvent("src-002", "Nobody", "unknown-venue-xyz", "2026-06-21", []string{"Nobody"}),
+	}
+
+	_, err := suite.svc.ImportEvents(events, false, false, catalogm.ShowStatusApproved)
+	suite.Require().NoError(err)
+
+	var good adminm.DiscoverySource
+	suite.Require().NoError(suite.db.Where("source_key = ?", "valley-bar").First(&good).Error)
+	suite.NotNil(good.LastSuccessAt)
+	suite.Equal(1, good.LastEventCount)
+	suite.Equal(int64(1), good.TotalEvents)
+	suite.Equal(0, good.ErrorStreak)
+
+	var bad adminm.DiscoverySource
+	suite.Require().NoError(suite.db.Where("source_key = ?", "unknown-venue-xyz").First(&bad).Error)
+	suite.Nil(bad.LastSuccessAt)
+	suite.Equal(1, bad.ErrorStreak)
+	suite.Require().NotNil(bad.LastError)
+	suite.Contains(*bad.LastError, "Unknown venue slug")
+
+	// A second all-error run extends the streak
+	_, err = suite.svc.ImportEvents(events[1:], false, false, catalogm.ShowStatusApproved)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.db.Where("source_key = ?", "unknown-venue-xyz").First(&bad).Error)
+	suite.Equal(2, bad.ErrorStreak)
+	suite.Equal(int64(2), bad.TotalEvents)
+}
+
+func (suite *DiscoveryIntegrationTestSuite) TestImportEvents_DryRunSkipsSourceHealth() {
+	events := []contracts.DiscoveredEvent{
+		suite.makeEvent("src-003", "Khruangbin", "valley-bar", "2026-06-22", []string{"Khruangbin"}),
+	}
+
+	_, err := suite.svc.ImportEvents(events, true, false, catalogm.ShowStatusApproved)
+	suite.Require().NoError(err)
+
+	var count int64
+	suite.db.Model(&adminm.DiscoverySource{}).Count(&count)
+	suite.Equal(int64(0), count)
+}
+
+func (suite *DiscoveryIntegrationTestSuite) TestClaimNewlyStaleSources_AlertsOncePerEpisode() {
+	old := time.Now().UTC().Add(-30 * 24 * time.Hour)
+	stale := adminm.DiscoverySource{SourceKey: "stale-venue", VenueName: "Stale Venue", LastSuccessAt: &old}
+	fresh := adminm.DiscoverySource{SourceKey: "fresh-venue", VenueName: "Fresh Venue"}
+	suite.Require().NoError(suite.db.Create(&stale).Error)
+	suite.Require().NoError(suite.db.Create(&fresh).Error)
+	now := time.Now().UTC()
+	suite.Require().NoError(suite.db.Model(&fresh).Update("last_success_at", now).Error)
+
+	claimed, err := suite.svc.ClaimNewlyStaleSources()
+	suite.Require().NoError(err)
+	suite.Require().Len(claimed, 1)
+	suite.Equal("stale-venue", claimed[0].SourceKey)
+	suite.Equal(adminm.DiscoverySourceStatusStale, claimed[0].Status)
+
+	// Already alerted — nothing new
+	claimed, err = suite.svc.ClaimNewlyStaleSources()
+	suite.Require().NoError(err)
+	suite.Empty(claimed)
+
+	// The listing still reports it, stalest first
+	list, err := suite.svc.ListSourceHealth()
+	suite.Require().NoError(err)
+	suite.Require().Len(list.Sources, 2)
+	suite.Equal("stale-venue", list.Sources[0].SourceKey)
+	suite.Equal(adminm.DiscoverySourceStatusOK, list.Sources[1].Status)
+}
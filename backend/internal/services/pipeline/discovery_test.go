@@ -261,19 +261,19 @@ func TestNormalizeSetType(t *testing.T) {
 		{"headliner", "headliner"},
 		{"Headliner", "headliner"},
 		{"HEADLINER", "headliner"},
-		{"support", "opener"}, // support maps to opener
-		{"Support", "opener"},
+		{"support", "support"},
+		{"Support", "support"},
 		{"opener", "opener"},
 		{"special_guest", "special_guest"},
 		{"performer", "performer"},
-		{"dj", "performer"}, // dj maps to performer
-		{"DJ", "performer"},
+		{"dj", "dj"},
+		{"DJ", "dj"},
 		{"host", "performer"}, // host maps to performer
 		{"Host", "performer"},
 		{"", ""},                       // empty returns empty
 		{"unknown", ""},                // unknown returns empty
 		{"  headliner  ", "headliner"}, // whitespace trimmed
-		{"  support ", "opener"},
+		{"  support ", "support"},
 	}
 
 	for _, tt := range tests {
@@ -373,6 +373,8 @@ func (suite *DiscoveryIntegrationTestSuite) TearDownSuite() {
 func (suite *DiscoveryIntegrationTestSuite) TearDownTest() {
 	sqlDB, err := suite.db.DB()
 	suite.Require().NoError(err)
+	_, _ = sqlDB.Exec("DELETE FROM discovery_sources")
+	_, _ = sqlDB.Exec("DELETE FROM entity_aliases")
 	_, _ = sqlDB.Exec("DELETE FROM show_artists")
 	_, _ = sqlDB.Exec("DELETE FROM show_venues")
 	_, _ = sqlDB.Exec("DELETE FROM shows")
@@ -427,6 +429,46 @@ func (suite *DiscoveryIntegrationTestSuite) TestImportEvents_Success() {
 	suite.NotNil(show.Slug)
 }
 
+func (suite *DiscoveryIntegrationTestSuite) TestImportEvents_UsesEntityAliases() {
+	venue := &catalogm.Venue{Name: "The Crescent", City: "Phoenix", State: "AZ"}
+	suite.Require().NoError(suite.db.Create(venue).Error)
+	artist := &catalogm.Artist{Name: "Khruangbin"}
+	suite.Require().NoError(suite.db.Create(artist).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.EntityAlias{
+		EntityType: catalogm.EntityAliasTypeVenue, EntityID: venue.ID, Alias: "Crescent Ballroom",
+	}).Error)
+	suite.Require().NoError(suite.db.Create(&catalogm.EntityAlias{
+		EntityType: catalogm.EntityAliasTypeArtist, EntityID: artist.ID, Alias: "Khruangbin (Houston)",
+	}).Error)
+
+	event := suite.makeEvent("alias-001", "Khruangbin", "crescent-ballroom", "2026-06-18", []string{"Khruangbin (Houston)"})
+	event.Venue = "Crescent Ballroom"
+	result, err := suite.svc.ImportEvents([]contracts.DiscoveredEvent{event}, false, false, catalogm.ShowStatusApproved)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, result.Imported, result.Messages)
+
+	var show catalogm.Show
+	suite.Require().NoError(suite.db.Where("source_event_id = ?", "alias-001").First(&show).Error)
+	var showVenue catalogm.ShowVenue
+	suite.Require().NoError(suite.db.Where("show_id = ?", show.ID).First(&showVenue).Error)
+	suite.Equal(venue.ID, showVenue.VenueID)
+	var showArtist catalogm.ShowArtist
+	suite.Require().NoError(suite.db.Where("show_id = ?", show.ID).First(&showArtist).Error)
+	suite.Equal(artist.ID, showArtist.ArtistID)
+
+	var venueCount, artistCount int64
+	suite.db.Model(&catalogm.Venue{}).Count(&venueCount)
+	suite.db.Model(&catalogm.Artist{}).Count(&artistCount)
+	suite.Equal(int64(1), venueCount)
+	suite.Equal(int64(1), artistCount)
+
+	// The headliner duplicate check matches on the canonical names too
+	dup := suite.makeEvent("alias-002", "Khruangbin", "crescent-ballroom", "2026-06-18", []string{"Khruangbin (Houston)"})
+	result, err = suite.svc.ImportEvents([]contracts.DiscoveredEvent{dup}, false, false, catalogm.ShowStatusApproved)
+	suite.Require().NoError(err)
+	suite.Equal(1, result.Duplicates)
+}
+
 func (suite *DiscoveryIntegrationTestSuite) TestImportEvents_SourceDuplicate() {
 	events := []contracts.DiscoveredEvent{
 		suite.makeEvent("evt-002", "Radiohead", "valley-bar", "2026-07-01", []string{"Radiohead"}),
@@ -795,6 +837,37 @@ func (suite *DiscoveryIntegrationTestSuite) TestImportEvents_HeadlinerDuplicate_
 // UNIT TESTS — resolveHeadlinerName
 // =============================================================================
 
+// =============================================================================
+// UNIT TESTS — ticket link mapping
+// =============================================================================
+
+func TestInferTicketProvider(t *testing.T) {
+	tests := map[string]string{
+		"https://www.eventbrite.com/e/some-show-123":     catalogm.TicketProviderEventbrite,
+		"https://eventbrite.co.uk/e/some-show-123":       catalogm.TicketProviderEventbrite,
+		"https://dice.fm/event/abc":                      catalogm.TicketProviderDice,
+		"https://link.dice.fm/abc":                       catalogm.TicketProviderDice,
+		"https://wl.seetickets.us/event/some-show/12345": catalogm.TicketProviderSeeTickets,
+		"https://www.seetickets.com/event/x":             catalogm.TicketProviderSeeTickets,
+		"https://notdice.fm/event/abc":                   catalogm.TicketProviderOther,
+		"https://www.ticketweb.com/event/x":              catalogm.TicketProviderOther,
+	}
+	for ticketURL, want := range tests {
+		assert.Equal(t, want, inferTicketProvider(ticketURL), ticketURL)
+	}
+}
+
+func TestDiscoveredTicketURL(t *testing.T) {
+	valid := " https://dice.fm/event/abc "
+	unsafe := "javascript:alert(1)"
+	long := "https://dice.fm/" + strings.Repeat("a", 500)
+
+	assert.Equal(t, "https://dice.fm/event/abc", discoveredTicketURL(&contracts.DiscoveredEvent{TicketURL: &valid}))
+	assert.Empty(t, discoveredTicketURL(&contracts.DiscoveredEvent{TicketURL: &unsafe}))
+	assert.Empty(t, discoveredTicketURL(&contracts.DiscoveredEvent{TicketURL: &long}))
+	assert.Empty(t, discoveredTicketURL(&contracts.DiscoveredEvent{}))
+}
+
 func TestResolveHeadlinerName_BillingArtists_ExplicitHeadliner(t *testing.T) {
 	svc := &DiscoveryService{}
 	event := &contracts.DiscoveredEvent{
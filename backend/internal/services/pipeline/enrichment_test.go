@@ -326,6 +326,12 @@ func (m *mockArtistServiceForEnrichment) SearchArtists(query string) ([]*contrac
 func (m *mockArtistServiceForEnrichment) GetShowsForArtist(artistID uint, timezone string, limit int, timeFilter string) ([]*contracts.ArtistShowResponse, int64, error) {
 	return nil, 0, nil
 }
+func (m *mockArtistServiceForEnrichment) GetShowsForArtistPage(artistID uint, timezone string, limit, offset int, timeFilter string, year int) ([]*contracts.ArtistShowResponse, int64, error) {
+	return nil, 0, nil
+}
+func (m *mockArtistServiceForEnrichment) GetShowYearsForArtist(artistID uint, timezone string, timeFilter string) ([]*contracts.ShowYearCount, error) {
+	return nil, nil
+}
 func (m *mockArtistServiceForEnrichment) GetNextShowForArtist(artistID uint, timezone string) (*contracts.ArtistShowResponse, error) {
 	return nil, nil
 }
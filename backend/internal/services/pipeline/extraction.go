@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm"
 
 	"psychic-homily-backend/internal/config"
+	"psychic-homily-backend/internal/httpclient"
 	"psychic-homily-backend/internal/services/contracts"
 )
 
@@ -67,7 +68,7 @@ func NewExtractionService(database *gorm.DB, cfg *config.Config, artistSvc artis
 		config:           cfg,
 		artistService:    artistSvc,
 		venueService:     venueSvc,
-		httpClient:       &http.Client{},
+		httpClient:       httpclient.New(0),
 		anthropicBaseURL: "https://api.anthropic.com",
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"psychic-homily-backend/internal/httpclient"
 	"psychic-homily-backend/internal/utils"
 )
 
@@ -133,9 +134,7 @@ type MusicBrainzClient struct {
 // NewMusicBrainzClient creates a new rate-limited MusicBrainz API client.
 func NewMusicBrainzClient() *MusicBrainzClient {
 	return &MusicBrainzClient{
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		client:    httpclient.New(30 * time.Second),
 		baseURL:   mbBaseURL,
 		rateLimit: mbRateLimit,
 		minScore:  mbMinScore,
@@ -8,6 +8,8 @@ import (
 	"net/url"
 	"sync"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 const (
@@ -103,9 +105,7 @@ type SeatGeekClient struct {
 // clientID is the SeatGeek API client_id. If empty, all lookups return nil (skip).
 func NewSeatGeekClient(clientID string) *SeatGeekClient {
 	return &SeatGeekClient{
-		client: &http.Client{
-			Timeout: 15 * time.Second,
-		},
+		client:    httpclient.New(15 * time.Second),
 		clientID:  clientID,
 		rateLimit: sgRateLimit,
 	}
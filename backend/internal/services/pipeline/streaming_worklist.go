@@ -159,6 +159,7 @@ func (s *StreamingWorklistService) ListStreamingWorklist(status string, limit, o
 					JOIN shows s2 ON s2.id = sa2.show_id
 					WHERE sa2.artist_id = a.id
 					  AND s2.event_date >= NOW()
+					  AND s2.deleted_at IS NULL
 				) AS upcoming_show_count
 			FROM artists a
 			JOIN LATERAL (
@@ -172,6 +173,7 @@ func (s *StreamingWorklistService) ListStreamingWorklist(status string, limit, o
 				LEFT JOIN venues v       ON v.id = sv.venue_id
 				WHERE sa.artist_id = a.id
 				  AND s.event_date >= NOW()
+				  AND s.deleted_at IS NULL
 				ORDER BY s.event_date ASC, v.id ASC
 				LIMIT 1
 			) ns ON TRUE
@@ -211,6 +213,7 @@ func (s *StreamingWorklistService) ListStreamingWorklist(status string, limit, o
 			JOIN shows s ON s.id = sa.show_id
 			WHERE sa.artist_id = a.id
 			  AND s.event_date >= NOW()
+			  AND s.deleted_at IS NULL
 		  )
 	`
 	if err := s.db.Raw(countQuery, statusFilter).Scan(&total).Error; err != nil {
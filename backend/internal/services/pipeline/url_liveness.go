@@ -10,6 +10,8 @@ import (
 	"strings"
 	"syscall"
 	"time"
+
+	"psychic-homily-backend/internal/httpclient"
 )
 
 // LivenessChecker reports whether a candidate URL is reachable. It is an
@@ -67,9 +69,11 @@ func NewSSRFSafeLivenessChecker() *SSRFSafeLivenessChecker {
 		ResponseHeaderTimeout: livenessTimeout,
 		DisableKeepAlives:     true,
 	}
+	// The probe dials directly rather than through the shared proxy so the
+	// dial-time IP guard stays meaningful; only the egress allowlist applies.
 	client := &http.Client{
 		Timeout:   livenessTimeout,
-		Transport: transport,
+		Transport: httpclient.WithEgressPolicy(transport),
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			if len(via) >= livenessMaxRedirects {
 				return fmt.Errorf("stopped after %d redirects", livenessMaxRedirects)
@@ -0,0 +1,295 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/db"
+	"psychic-homily-backend/internal/config"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// SubmissionWindow is the rolling window the daily cap applies over.
+const SubmissionWindow = 24 * time.Hour
+
+// trustedSubmitterTiers get SubmissionConfig.TrustedDailyCap. Mirrors the
+// top two rungs of the contributor tier ladder (admin.TierTrustedContributor,
+// admin.TierLocalAmbassador).
+var trustedSubmitterTiers = map[string]bool{
+	"trusted_contributor": true,
+	"local_ambassador":    true,
+}
+
+// Quarantine reason recorded when burst detection trips.
+const burstQuarantineReasonFormat = "Automatic: %d submissions in %d minutes"
+
+// submissionCountsSQL counts the user's shows over the daily window and the
+// burst window in one pass. Soft-deleted shows still count: removing spam
+// must not hand the spammer their quota back.
+const submissionCountsSQL = `
+SELECT
+	COUNT(*) AS day_count,
+	COUNT(*) FILTER (WHERE created_at > ?) AS burst_count,
+	MIN(created_at) AS oldest
+FROM shows
+WHERE submitted_by = ? AND created_at > ?`
+
+type submissionCounts struct {
+	DayCount   int        `gorm:"column:day_count"`
+	BurstCount int        `gorm:"column:burst_count"`
+	Oldest     *time.Time `gorm:"column:oldest"`
+}
+
+const listQuarantinedUsersSQL = `
+SELECT
+	u.id AS user_id,
+	u.username,
+	u.email,
+	u.user_tier,
+	u.quarantined_at,
+	u.quarantine_reason,
+	(SELECT COUNT(*) FROM shows s WHERE s.submitted_by = u.id AND s.created_at > ?) AS submissions_24h,
+	(SELECT COUNT(*) FROM shows s WHERE s.submitted_by = u.id AND s.status = 'pending' AND s.deleted_at IS NULL) AS pending_shows
+FROM users u
+WHERE u.quarantined_at IS NOT NULL AND u.deleted_at IS NULL
+ORDER BY u.quarantined_at DESC`
+
+type quarantinedUserRow struct {
+	UserID         uint      `gorm:"column:user_id"`
+	Username       *string   `gorm:"column:username"`
+	Email          *string   `gorm:"column:email"`
+	UserTier       string    `gorm:"column:user_tier"`
+	QuarantinedAt  time.Time `gorm:"column:quarantined_at"`
+	Reason         *string   `gorm:"column:quarantine_reason"`
+	Submissions24h int64     `gorm:"column:submissions_24h"`
+	PendingShows   int64     `gorm:"column:pending_shows"`
+}
+
+// SubmissionThrottleService enforces per-user show submission caps, detects
+// submission bursts, and manages the quarantine that holds a suspicious
+// user's new submissions for moderator review.
+//
+// Counts come straight from the shows table, so there is no counter to
+// drift. Staff (anyone who can moderate) are exempt.
+type SubmissionThrottleService struct {
+	db      *gorm.DB
+	cfg     config.SubmissionConfig
+	discord contracts.DiscordServiceInterface
+	now     func() time.Time
+	logger  *slog.Logger
+
+	// capAlerted remembers when each user's daily-cap alert last fired so a
+	// user retrying against the cap posts one Discord alert per window, not
+	// one per attempt. Per-process; a restart can repeat one alert.
+	mu         sync.Mutex
+	capAlerted map[uint]time.Time
+}
+
+// NewSubmissionThrottleService creates the submission throttle service.
+// discord may be nil.
+func NewSubmissionThrottleService(database *gorm.DB, cfg config.SubmissionConfig, discord contracts.DiscordServiceInterface) *SubmissionThrottleService {
+	if database == nil {
+		database = db.GetDB()
+	}
+	return &SubmissionThrottleService{
+		db:         database,
+		cfg:        cfg,
+		discord:    discord,
+		now:        time.Now,
+		logger:     slog.Default(),
+		capAlerted: make(map[uint]time.Time),
+	}
+}
+
+// DailyCap returns the rolling 24h submission cap for user.
+func (s *SubmissionThrottleService) DailyCap(user *authm.User) int {
+	if trustedSubmitterTiers[user.UserTier] {
+		return s.cfg.TrustedDailyCap
+	}
+	return s.cfg.DailyCap
+}
+
+// CheckSubmission decides whether user may submit another show and whether
+// it should be held for review. It must be called before the show is
+// created; the counts don't include the submission being checked.
+func (s *SubmissionThrottleService) CheckSubmission(user *authm.User) (*contracts.SubmissionDecision, error) {
+	if user == nil || user.Can(authm.PermissionModerateContent) {
+		return &contracts.SubmissionDecision{}, nil
+	}
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	now := s.now()
+	var counts submissionCounts
+	if err := s.db.Raw(submissionCountsSQL,
+		now.Add(-s.cfg.BurstWindow), user.ID, now.Add(-SubmissionWindow),
+	).Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count submissions: %w", err)
+	}
+
+	dailyCap := s.DailyCap(user)
+	if counts.DayCount >= dailyCap {
+		retryAfter := 1
+		if counts.Oldest != nil {
+			if wait := int(counts.Oldest.Add(SubmissionWindow).Sub(now).Seconds()); wait > retryAfter {
+				retryAfter = wait
+			}
+		}
+		if s.shouldAlertCap(user.ID, now) {
+			s.notify(user, "Daily Cap Reached",
+				fmt.Sprintf("%d submissions in 24 hours (cap %d); further submissions are refused", counts.DayCount, dailyCap))
+		}
+		return nil, apperrors.ErrSubmissionDailyCap(dailyCap, retryAfter)
+	}
+
+	if user.QuarantinedAt != nil {
+		return &contracts.SubmissionDecision{Hold: true, Reason: "quarantined"}, nil
+	}
+
+	// This submission would be the BurstLimit-th inside the burst window.
+	// Submitters with a high-trust record aren't quarantined for volume.
+	if counts.BurstCount+1 >= s.cfg.BurstLimit && user.SubmissionTrust() != authm.SubmissionTrustHigh {
+		reason := fmt.Sprintf(burstQuarantineReasonFormat, counts.BurstCount+1, int(s.cfg.BurstWindow.Minutes()))
+		quarantined, err := s.quarantine(user.ID, reason, now)
+		if err != nil {
+			return nil, err
+		}
+		if quarantined {
+			s.logger.Warn("submission_burst_quarantine",
+				"user_id", user.ID,
+				"burst_count", counts.BurstCount+1,
+			)
+			s.notify(user, "User Quarantined", reason+"; new submissions are held for review")
+		}
+		return &contracts.SubmissionDecision{Hold: true, Reason: "burst"}, nil
+	}
+
+	return &contracts.SubmissionDecision{}, nil
+}
+
+// QuarantineUser holds all of a user's future submissions for review until
+// released. Re-quarantining updates the reason.
+func (s *SubmissionThrottleService) QuarantineUser(userID uint, reason string) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	var user authm.User
+	if err := s.db.Where("id = ? AND deleted_at IS NULL", userID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.ErrSubmissionUserNotFound()
+		}
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+	if user.Can(authm.PermissionModerateContent) {
+		return apperrors.ErrSubmissionStaffQuarantine()
+	}
+
+	updates := map[string]interface{}{"quarantine_reason": nilIfEmpty(reason)}
+	if user.QuarantinedAt == nil {
+		updates["quarantined_at"] = s.now()
+	}
+	if err := s.db.Model(&authm.User{}).Where("id = ?", userID).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to quarantine user: %w", err)
+	}
+	return nil
+}
+
+// ReleaseUser lifts a user's quarantine. Shows already held stay pending.
+func (s *SubmissionThrottleService) ReleaseUser(userID uint) error {
+	if s.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	result := s.db.Model(&authm.User{}).
+		Where("id = ? AND deleted_at IS NULL", userID).
+		Updates(map[string]interface{}{"quarantined_at": nil, "quarantine_reason": nil})
+	if result.Error != nil {
+		return fmt.Errorf("failed to release user: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrSubmissionUserNotFound()
+	}
+	return nil
+}
+
+// ListQuarantinedUsers returns every quarantined user, most recent first,
+// with their recent submission count and how many shows await review.
+func (s *SubmissionThrottleService) ListQuarantinedUsers() ([]contracts.QuarantinedUser, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var rows []quarantinedUserRow
+	err := s.db.Raw(listQuarantinedUsersSQL, s.now().Add(-SubmissionWindow)).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quarantined users: %w", err)
+	}
+
+	users := make([]contracts.QuarantinedUser, len(rows))
+	for i, row := range rows {
+		users[i] = contracts.QuarantinedUser{
+			UserID:         row.UserID,
+			Username:       row.Username,
+			Email:          row.Email,
+			UserTier:       row.UserTier,
+			QuarantinedAt:  row.QuarantinedAt,
+			Reason:         row.Reason,
+			Submissions24h: row.Submissions24h,
+			PendingShows:   row.PendingShows,
+		}
+	}
+	return users, nil
+}
+
+// quarantine sets quarantined_at if it isn't already set and reports whether
+// this call did it, so concurrent bursts alert once.
+func (s *SubmissionThrottleService) quarantine(userID uint, reason string, now time.Time) (bool, error) {
+	result := s.db.Model(&authm.User{}).
+		Where("id = ? AND quarantined_at IS NULL", userID).
+		Updates(map[string]interface{}{"quarantined_at": now, "quarantine_reason": reason})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to quarantine user: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// shouldAlertCap reports whether the daily-cap alert for userID is due, and
+// marks it sent.
+func (s *SubmissionThrottleService) shouldAlertCap(userID uint, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if last, ok := s.capAlerted[userID]; ok && now.Sub(last) < SubmissionWindow {
+		return false
+	}
+	for id, last := range s.capAlerted {
+		if now.Sub(last) >= SubmissionWindow {
+			delete(s.capAlerted, id)
+		}
+	}
+	s.capAlerted[userID] = now
+	return true
+}
+
+func (s *SubmissionThrottleService) notify(user *authm.User, event, detail string) {
+	if s.discord != nil {
+		s.discord.NotifySubmissionThrottle(user, event, detail)
+	}
+}
+
+func nilIfEmpty(v string) interface{} {
+	if v == "" {
+		return nil
+	}
+	return v
+}
+
+var _ contracts.SubmissionThrottleServiceInterface = (*SubmissionThrottleService)(nil)
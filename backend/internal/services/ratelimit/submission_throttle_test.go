@@ -0,0 +1,275 @@
+package ratelimit
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"psychic-homily-backend/internal/config"
+	apperrors "psychic-homily-backend/internal/errors"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/testutil"
+)
+
+func testSubmissionConfig() config.SubmissionConfig {
+	return config.SubmissionConfig{
+		DailyCap:        5,
+		TrustedDailyCap: 10,
+		BurstLimit:      3,
+		BurstWindow:     10 * time.Minute,
+	}
+}
+
+// =============================================================================
+// UNIT TESTS (No Database Required)
+// =============================================================================
+
+func TestSubmissionThrottle_DailyCapByTier(t *testing.T) {
+	svc := &SubmissionThrottleService{cfg: testSubmissionConfig()}
+
+	cases := map[string]int{
+		"new_user":            5,
+		"contributor":         5,
+		"trusted_contributor": 10,
+		"local_ambassador":    10,
+	}
+	for tier, want := range cases {
+		if got := svc.DailyCap(&authm.User{UserTier: tier}); got != want {
+			t.Errorf("DailyCap(%s) = %d, want %d", tier, got, want)
+		}
+	}
+}
+
+func TestSubmissionThrottle_StaffExempt(t *testing.T) {
+	// No database: staff must be waved through before any query.
+	svc := &SubmissionThrottleService{cfg: testSubmissionConfig()}
+
+	for _, user := range []*authm.User{
+		nil,
+		{ID: 1, IsAdmin: true},
+		{ID: 2, Role: authm.RoleModerator},
+	} {
+		decision, err := svc.CheckSubmission(user)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if decision.Hold {
+			t.Errorf("staff submission held: %+v", user)
+		}
+	}
+}
+
+func TestSubmissionThrottle_NilDB(t *testing.T) {
+	svc := &SubmissionThrottleService{cfg: testSubmissionConfig()}
+
+	if _, err := svc.CheckSubmission(&authm.User{ID: 3}); err == nil {
+		t.Error("CheckSubmission: expected error with nil db")
+	}
+	if err := svc.QuarantineUser(3, "spam"); err == nil {
+		t.Error("QuarantineUser: expected error with nil db")
+	}
+	if err := svc.ReleaseUser(3); err == nil {
+		t.Error("ReleaseUser: expected error with nil db")
+	}
+	if _, err := svc.ListQuarantinedUsers(); err == nil {
+		t.Error("ListQuarantinedUsers: expected error with nil db")
+	}
+}
+
+func TestSubmissionThrottle_CapAlertDedupe(t *testing.T) {
+	svc := &SubmissionThrottleService{capAlerted: make(map[uint]time.Time)}
+	now := time.Now()
+
+	if !svc.shouldAlertCap(1, now) {
+		t.Error("first alert should fire")
+	}
+	if svc.shouldAlertCap(1, now.Add(time.Hour)) {
+		t.Error("repeat alert inside the window should be suppressed")
+	}
+	if !svc.shouldAlertCap(2, now.Add(time.Hour)) {
+		t.Error("alerts are per user")
+	}
+	if !svc.shouldAlertCap(1, now.Add(SubmissionWindow)) {
+		t.Error("alert should fire again once the window has passed")
+	}
+}
+
+// =============================================================================
+// INTEGRATION TESTS (With Real Database)
+// =============================================================================
+
+type SubmissionThrottleIntegrationTestSuite struct {
+	suite.Suite
+	testDB *testutil.TestDatabase
+	db     *gorm.DB
+	svc    *SubmissionThrottleService
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) SetupSuite() {
+	s.testDB = testutil.SetupTestPostgres(s.T())
+	s.db = s.testDB.DB
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) SetupTest() {
+	s.svc = NewSubmissionThrottleService(s.db, testSubmissionConfig(), nil)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TearDownSuite() {
+	s.testDB.Cleanup()
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TearDownTest() {
+	s.db.Exec("DELETE FROM shows")
+	s.db.Exec("DELETE FROM users")
+}
+
+func TestSubmissionThrottleIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(SubmissionThrottleIntegrationTestSuite))
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) createUser(tier string) *authm.User {
+	user := &authm.User{
+		Email:         stringPtr(fmt.Sprintf("submitter-%d@test.com", time.Now().UnixNano())),
+		IsActive:      true,
+		EmailVerified: true,
+		UserTier:      tier,
+	}
+	s.Require().NoError(s.db.Create(user).Error)
+	return user
+}
+
+// createShows inserts n shows submitted by user, created at the given time.
+func (s *SubmissionThrottleIntegrationTestSuite) createShows(user *authm.User, n int, createdAt time.Time) {
+	for i := 0; i < n; i++ {
+		show := &catalogm.Show{
+			Title:       fmt.Sprintf("Show %d", i),
+			EventDate:   time.Now().Add(24 * time.Hour),
+			Status:      catalogm.ShowStatusApproved,
+			SubmittedBy: &user.ID,
+		}
+		s.Require().NoError(s.db.Create(show).Error)
+		s.Require().NoError(s.db.Model(show).UpdateColumn("created_at", createdAt).Error)
+	}
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) reload(user *authm.User) *authm.User {
+	var fresh authm.User
+	s.Require().NoError(s.db.First(&fresh, user.ID).Error)
+	return &fresh
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestUnderLimitsAllowed() {
+	user := s.createUser("contributor")
+	s.createShows(user, 1, time.Now().Add(-time.Hour))
+
+	decision, err := s.svc.CheckSubmission(user)
+	s.Require().NoError(err)
+	s.False(decision.Hold)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestDailyCapRefuses() {
+	user := s.createUser("contributor")
+	s.createShows(user, 5, time.Now().Add(-2*time.Hour))
+
+	_, err := s.svc.CheckSubmission(user)
+	var subErr *apperrors.SubmissionError
+	s.Require().True(stderrors.As(err, &subErr))
+	s.Equal(apperrors.CodeSubmissionDailyCap, subErr.Code)
+	// The oldest submission ages out in ~22 hours.
+	s.InDelta(22*3600, subErr.RetryAfterSeconds, 60)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestTrustedTierHigherCap() {
+	user := s.createUser("trusted_contributor")
+	s.createShows(user, 5, time.Now().Add(-2*time.Hour))
+
+	decision, err := s.svc.CheckSubmission(user)
+	s.Require().NoError(err)
+	s.False(decision.Hold)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestOldSubmissionsDontCount() {
+	user := s.createUser("contributor")
+	s.createShows(user, 5, time.Now().Add(-25*time.Hour))
+
+	decision, err := s.svc.CheckSubmission(user)
+	s.Require().NoError(err)
+	s.False(decision.Hold)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestBurstQuarantines() {
+	user := s.createUser("contributor")
+	s.createShows(user, 2, time.Now().Add(-time.Minute))
+
+	decision, err := s.svc.CheckSubmission(user)
+	s.Require().NoError(err)
+	s.True(decision.Hold)
+	s.Equal("burst", decision.Reason)
+
+	fresh := s.reload(user)
+	s.Require().NotNil(fresh.QuarantinedAt)
+	s.Require().NotNil(fresh.QuarantineReason)
+	s.Contains(*fresh.QuarantineReason, "3 submissions in 10 minutes")
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestHighTrustSkipsBurst() {
+	user := s.createUser("contributor")
+	user.SubmissionsApproved = 12
+	s.createShows(user, 2, time.Now().Add(-time.Minute))
+
+	decision, err := s.svc.CheckSubmission(user)
+	s.Require().NoError(err)
+	s.False(decision.Hold)
+	s.Nil(s.reload(user).QuarantinedAt)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestQuarantinedUserHeld() {
+	user := s.createUser("contributor")
+	s.Require().NoError(s.svc.QuarantineUser(user.ID, "looks like spam"))
+
+	decision, err := s.svc.CheckSubmission(s.reload(user))
+	s.Require().NoError(err)
+	s.True(decision.Hold)
+	s.Equal("quarantined", decision.Reason)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestQuarantineRejectsStaffAndMissingUser() {
+	admin := s.createUser("contributor")
+	s.Require().NoError(s.db.Model(admin).Update("is_admin", true).Error)
+
+	var subErr *apperrors.SubmissionError
+	s.Require().True(stderrors.As(s.svc.QuarantineUser(admin.ID, ""), &subErr))
+	s.Equal(apperrors.CodeSubmissionStaffQuarantine, subErr.Code)
+
+	s.Require().True(stderrors.As(s.svc.QuarantineUser(999999, ""), &subErr))
+	s.Equal(apperrors.CodeSubmissionUserNotFound, subErr.Code)
+}
+
+func (s *SubmissionThrottleIntegrationTestSuite) TestListAndRelease() {
+	user := s.createUser("contributor")
+	s.createShows(user, 2, time.Now().Add(-time.Hour))
+	s.Require().NoError(s.db.Model(&catalogm.Show{}).
+		Where("submitted_by = ?", user.ID).Update("status", catalogm.ShowStatusPending).Error)
+	s.Require().NoError(s.svc.QuarantineUser(user.ID, "manual"))
+
+	users, err := s.svc.ListQuarantinedUsers()
+	s.Require().NoError(err)
+	s.Require().Len(users, 1)
+	s.Equal(user.ID, users[0].UserID)
+	s.Equal(int64(2), users[0].Submissions24h)
+	s.Equal(int64(2), users[0].PendingShows)
+	s.Require().NotNil(users[0].Reason)
+	s.Equal("manual", *users[0].Reason)
+
+	s.Require().NoError(s.svc.ReleaseUser(user.ID))
+	s.Nil(s.reload(user).QuarantinedAt)
+
+	users, err = s.svc.ListQuarantinedUsers()
+	s.Require().NoError(err)
+	s.Empty(users)
+}
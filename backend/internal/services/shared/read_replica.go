@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"psychic-homily-backend/db"
+)
+
+// ReadReplicaResolver is the resolver name db.Connect registers the replica
+// under; defined in package db so the connection code needn't import this one.
+const ReadReplicaResolver = db.ReadReplicaResolver
+
+// ReadReplica returns db routed to the read replica, for heavy list, search
+// and export queries that tolerate a little replication lag. When no replica
+// is configured (or db was opened without the resolver, as in tests) it
+// returns db unchanged, so queries fall back to the primary.
+//
+// Don't use it to read back something the same request just wrote: the
+// replica may not have it yet.
+func ReadReplica(db *gorm.DB) *gorm.DB {
+	if db == nil {
+		return db
+	}
+	if _, ok := db.Config.Plugins[(&dbresolver.DBResolver{}).Name()]; !ok {
+		return db
+	}
+	return db.Clauses(dbresolver.Use(ReadReplicaResolver))
+}
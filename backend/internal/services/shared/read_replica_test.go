@@ -0,0 +1,44 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
+)
+
+// openLazyDB opens a GORM handle without dialing; nothing here runs a query.
+func openLazyDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	gdb, err := gorm.Open(postgres.New(postgres.Config{
+		DSN: "host=127.0.0.1 port=1 user=x dbname=x sslmode=disable",
+	}), &gorm.Config{DisableAutomaticPing: true, Logger: logger.Discard})
+	require.NoError(t, err)
+	return gdb
+}
+
+func usesReplicaResolver(gdb *gorm.DB) bool {
+	c, ok := gdb.Statement.Clauses["gorm:db_resolver:using"]
+	return ok && c.Expression == dbresolver.Use(ReadReplicaResolver)
+}
+
+func TestReadReplica_NoResolverFallsBackToPrimary(t *testing.T) {
+	gdb := openLazyDB(t)
+
+	assert.Same(t, gdb, ReadReplica(gdb))
+	assert.Nil(t, ReadReplica(nil))
+}
+
+func TestReadReplica_RoutesToReplicaResolver(t *testing.T) {
+	gdb := openLazyDB(t)
+	require.NoError(t, gdb.Use(dbresolver.Register(dbresolver.Config{
+		Replicas: []gorm.Dialector{postgres.Open("host=127.0.0.1 port=2 user=x dbname=x sslmode=disable")},
+	}, ReadReplicaResolver)))
+
+	assert.True(t, usesReplicaResolver(ReadReplica(gdb)))
+	assert.False(t, usesReplicaResolver(gdb.Session(&gorm.Session{})), "other queries stay on the primary")
+}
@@ -26,10 +26,14 @@ var (
 		{Name: "event_date", Path: "EventDate"},
 		{Name: "city", Path: "City"},
 		{Name: "state", Path: "State"},
-		{Name: "price", Path: "Price"},
+		{Name: "price_min", Path: "PriceMin"},
+		{Name: "price_max", Path: "PriceMax"},
+		{Name: "price_currency", Path: "PriceCurrency"},
+		{Name: "is_free", Path: "IsFree"},
 		{Name: "age_requirement", Path: "AgeRequirement"},
 		{Name: "description", Path: "Description"},
 		{Name: "ticket_url", Path: "TicketURL"},
+		{Name: "ticket_provider", Path: "TicketProvider"},
 		{Name: "image_url", Path: "ImageURL"},
 	}
 
@@ -45,8 +49,13 @@ var (
 		{Name: "spotify", Path: "Social.Spotify"},
 		{Name: "soundcloud", Path: "Social.SoundCloud"},
 		{Name: "bandcamp", Path: "Social.Bandcamp"},
+		{Name: "tiktok", Path: "Social.TikTok"},
 		{Name: "website", Path: "Social.Website"},
 		{Name: "description", Path: "Description"},
+		{Name: "bio", Path: "Bio"},
+		{Name: "hometown", Path: "Hometown"},
+		{Name: "pronouns", Path: "Pronouns"},
+		{Name: "image_url", Path: "ImageURL"},
 	}
 
 	VenueFields = []Field{
@@ -56,6 +65,9 @@ var (
 		{Name: "state", Path: "State"},
 		{Name: "zipcode", Path: "Zipcode"},
 		{Name: "capacity", Path: "Capacity"},
+		{Name: "accessibility_notes", Path: "AccessibilityNotes"},
+		{Name: "all_ages", Path: "AllAges"},
+		{Name: "door_time", Path: "DoorTime"},
 		{Name: "instagram", Path: "Social.Instagram"},
 		{Name: "facebook", Path: "Social.Facebook"},
 		{Name: "twitter", Path: "Social.Twitter"},
@@ -63,6 +75,7 @@ var (
 		{Name: "spotify", Path: "Social.Spotify"},
 		{Name: "soundcloud", Path: "Social.SoundCloud"},
 		{Name: "bandcamp", Path: "Social.Bandcamp"},
+		{Name: "tiktok", Path: "Social.TikTok"},
 		{Name: "website", Path: "Social.Website"},
 		{Name: "description", Path: "Description"},
 		{Name: "image_url", Path: "ImageURL"},
@@ -92,6 +105,7 @@ var (
 		{Name: "spotify", Path: "Social.Spotify"},
 		{Name: "soundcloud", Path: "Social.SoundCloud"},
 		{Name: "bandcamp", Path: "Social.Bandcamp"},
+		{Name: "tiktok", Path: "Social.TikTok"},
 		{Name: "website", Path: "Social.Website"},
 		{Name: "image_url", Path: "ImageURL"},
 	}
@@ -180,11 +194,11 @@ func supportedType(ft reflect.Type) bool {
 		return true
 	}
 	switch ft.Kind() {
-	case reflect.String, reflect.Int:
+	case reflect.String, reflect.Int, reflect.Bool:
 		return true
 	case reflect.Ptr:
 		switch ft.Elem().Kind() {
-		case reflect.String, reflect.Float64, reflect.Int:
+		case reflect.String, reflect.Float64, reflect.Int, reflect.Bool:
 			return true
 		}
 	}
@@ -15,6 +15,7 @@
 //   - *float64          → deref or 0, emit float64
 //   - int               → compare with ==, emit int
 //   - *int              → deref or 0, emit int (both-nil counts as equal)
+//   - *bool             → tri-state: nil emits nil, so nil↔false is a change
 //   - time.Time         → compare with Equal, emit RFC3339 string
 //
 // The per-entity field lists — not the contributor allowlist — are the source
@@ -126,6 +127,11 @@ func diffValue(before, after reflect.Value) (oldVal, newVal interface{}, changed
 		a := int(after.Int())
 		return b, a, b != a
 
+	case reflect.Bool:
+		b := before.Bool()
+		a := after.Bool()
+		return b, a, b != a
+
 	case reflect.Ptr:
 		return diffPtr(before, after, t.Elem())
 
@@ -155,6 +161,13 @@ func diffPtr(before, after reflect.Value, elem reflect.Type) (oldVal, newVal int
 		a := derefInt(after)
 		return b, a, b != a
 
+	case reflect.Bool:
+		// Unlike the other pointers, nil is not the zero value: an unknown
+		// flag and an explicit false are different facts.
+		b := boolOrNil(before)
+		a := boolOrNil(after)
+		return b, a, b != a
+
 	default:
 		panic(fmt.Sprintf("revisiondiff: unsupported pointer element kind %s", elem.Kind()))
 	}
@@ -180,3 +193,10 @@ func derefInt(p reflect.Value) int {
 	}
 	return int(p.Elem().Int())
 }
+
+func boolOrNil(p reflect.Value) interface{} {
+	if p.IsNil() {
+		return nil
+	}
+	return p.Elem().Bool()
+}
@@ -12,6 +12,7 @@ import (
 func strPtr(s string) *string   { return &s }
 func intPtr(i int) *int         { return &i }
 func f64Ptr(f float64) *float64 { return &f }
+func boolPtr(b bool) *bool      { return &b }
 
 // TestCompare_ShowAllFields exercises the show field list across the value
 // kinds it uses (string, *string, *float64, time.Time) and asserts the exact
@@ -26,7 +27,7 @@ func TestCompare_ShowAllFields(t *testing.T) {
 		EventDate:      oldDate,
 		City:           strPtr("Phoenix"),
 		State:          strPtr("AZ"),
-		Price:          f64Ptr(10),
+		ShowPrice:      contracts.ShowPrice{PriceMin: f64Ptr(10), PriceCurrency: "USD"},
 		AgeRequirement: strPtr("21+"),
 		Description:    strPtr("old desc"),
 		TicketURL:      strPtr("https://old.example/tix"),
@@ -37,7 +38,7 @@ func TestCompare_ShowAllFields(t *testing.T) {
 		EventDate:      newDate,
 		City:           strPtr("Mesa"),
 		State:          strPtr("CA"),
-		Price:          f64Ptr(15),
+		ShowPrice:      contracts.ShowPrice{PriceMin: f64Ptr(12), PriceMax: f64Ptr(15), PriceCurrency: "USD", IsFree: true},
 		AgeRequirement: strPtr("18+"),
 		Description:    strPtr("new desc"),
 		TicketURL:      strPtr("https://new.example/tix"),
@@ -50,7 +51,9 @@ func TestCompare_ShowAllFields(t *testing.T) {
 		{Field: "event_date", OldValue: oldDate.Format(time.RFC3339), NewValue: newDate.Format(time.RFC3339)},
 		{Field: "city", OldValue: "Phoenix", NewValue: "Mesa"},
 		{Field: "state", OldValue: "AZ", NewValue: "CA"},
-		{Field: "price", OldValue: float64(10), NewValue: float64(15)},
+		{Field: "price_min", OldValue: float64(10), NewValue: float64(12)},
+		{Field: "price_max", OldValue: float64(0), NewValue: float64(15)},
+		{Field: "is_free", OldValue: false, NewValue: true},
 		{Field: "age_requirement", OldValue: "21+", NewValue: "18+"},
 		{Field: "description", OldValue: "old desc", NewValue: "new desc"},
 		{Field: "ticket_url", OldValue: "https://old.example/tix", NewValue: "https://new.example/tix"},
@@ -174,6 +177,26 @@ func TestCompare_NilPtrToValue(t *testing.T) {
 	}
 }
 
+// TestCompare_BoolPointerIsTriState confirms *bool keeps nil distinct from
+// false: setting an unknown all-ages flag to false is a recorded change.
+func TestCompare_BoolPointerIsTriState(t *testing.T) {
+	old := &contracts.VenueDetailResponse{Name: "V"}
+	updated := &contracts.VenueDetailResponse{Name: "V", AllAges: boolPtr(false), DoorTime: strPtr("19:00")}
+
+	got := Compare(old, updated, VenueFields)
+	want := []adminm.FieldChange{
+		{Field: "all_ages", OldValue: nil, NewValue: false},
+		{Field: "door_time", OldValue: "", NewValue: "19:00"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("venue diff mismatch:\n got=%#v\nwant=%#v", got, want)
+	}
+
+	if got := Compare(updated, updated, VenueFields); len(got) != 0 {
+		t.Fatalf("expected no changes, got %#v", got)
+	}
+}
+
 // TestValidateAll confirms the production field lists all resolve against their
 // structs — this is the guard that a renamed struct field fails loudly.
 func TestValidateAll(t *testing.T) {
@@ -0,0 +1,12 @@
+package shared
+
+// LiveShowBookmarkSQL is a WHERE condition that drops show bookmarks whose
+// show is soft-deleted. A soft delete keeps the show's bookmark rows so a
+// restore brings its saves and RSVPs back; reads that count or list them
+// without joining shows filter here instead. alias is the user_bookmarks
+// table or alias, and the row must already be constrained to
+// entity_type = 'show'.
+func LiveShowBookmarkSQL(alias string) string {
+	return "NOT EXISTS (SELECT 1 FROM shows deleted_show WHERE deleted_show.id = " + alias +
+		".entity_id AND deleted_show.deleted_at IS NOT NULL)"
+}
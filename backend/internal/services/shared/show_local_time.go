@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"time"
+
+	"psychic-homily-backend/internal/services/contracts"
+	"psychic-homily-backend/internal/utils"
+)
+
+// ShowLocation returns the zone a show's times are rendered in: its primary
+// (lowest-ID) venue's timezone, falling back to that venue's state, then to
+// the show's own state when it has no venues. See utils.EventLocation.
+func ShowLocation(resp *contracts.ShowResponse) *time.Location {
+	var timezone *string
+	state := ""
+	if resp.State != nil {
+		state = *resp.State
+	}
+	var primary *contracts.VenueResponse
+	for i := range resp.Venues {
+		if primary == nil || resp.Venues[i].ID < primary.ID {
+			primary = &resp.Venues[i]
+		}
+	}
+	if primary != nil {
+		timezone, state = primary.Timezone, primary.State
+	}
+	return utils.EventLocation(timezone, state)
+}
+
+// SetShowLocalTimes fills the venue-local renderings on a show response
+// (Timezone, EventDateLocal, DoorsTimeLocal) from its UTC times and venues.
+// Every builder of a ShowResponse calls it, so all surfaces agree on which
+// day a show is on.
+func SetShowLocalTimes(resp *contracts.ShowResponse) {
+	if resp == nil {
+		return
+	}
+	loc := ShowLocation(resp)
+	resp.Timezone = loc.String()
+	resp.EventDateLocal = resp.EventDate.In(loc).Format(time.RFC3339)
+	resp.DoorsTimeLocal = nil
+	if resp.DoorsTime != nil {
+		doors := resp.DoorsTime.In(loc).Format(time.RFC3339)
+		resp.DoorsTimeLocal = &doors
+	}
+}
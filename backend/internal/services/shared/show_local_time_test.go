@@ -0,0 +1,68 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+func TestSetShowLocalTimes_UsesPrimaryVenueZone(t *testing.T) {
+	denver, phoenix := "America/Denver", "America/Phoenix"
+	doors := time.Date(2026, 7, 11, 5, 30, 0, 0, time.UTC)
+	resp := &contracts.ShowResponse{
+		// 23:30 the night before in Phoenix: a caller in UTC would put this
+		// show on the wrong day.
+		EventDate: time.Date(2026, 7, 11, 6, 30, 0, 0, time.UTC),
+		DoorsTime: &doors,
+		Venues: []contracts.VenueResponse{
+			{ID: 9, State: "CO", Timezone: &denver},
+			{ID: 3, State: "AZ", Timezone: &phoenix},
+		},
+	}
+
+	SetShowLocalTimes(resp)
+
+	if resp.Timezone != phoenix {
+		t.Errorf("Timezone = %q, want %q (lowest venue ID)", resp.Timezone, phoenix)
+	}
+	if resp.EventDateLocal != "2026-07-10T23:30:00-07:00" {
+		t.Errorf("EventDateLocal = %q", resp.EventDateLocal)
+	}
+	if resp.DoorsTimeLocal == nil || *resp.DoorsTimeLocal != "2026-07-10T22:30:00-07:00" {
+		t.Errorf("DoorsTimeLocal = %v", resp.DoorsTimeLocal)
+	}
+}
+
+func TestSetShowLocalTimes_Fallbacks(t *testing.T) {
+	tests := []struct {
+		name string
+		resp contracts.ShowResponse
+		want string
+	}{
+		{
+			name: "venue without timezone uses its state",
+			resp: contracts.ShowResponse{Venues: []contracts.VenueResponse{{ID: 1, State: "NY"}}},
+			want: "America/New_York",
+		},
+		{
+			name: "no venues uses the show's state",
+			resp: contracts.ShowResponse{State: strPtr("CA")},
+			want: "America/Los_Angeles",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.resp.EventDate = time.Date(2026, 7, 11, 3, 0, 0, 0, time.UTC)
+			SetShowLocalTimes(&tt.resp)
+			if tt.resp.Timezone != tt.want {
+				t.Errorf("Timezone = %q, want %q", tt.resp.Timezone, tt.want)
+			}
+			if tt.resp.DoorsTimeLocal != nil {
+				t.Errorf("DoorsTimeLocal = %v, want nil without doors time", *tt.resp.DoorsTimeLocal)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,17 @@
+package shared
+
+import (
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// ShowPriceOf maps a show's price columns to the response shape.
+func ShowPriceOf(show *catalogm.Show) contracts.ShowPrice {
+	return contracts.ShowPrice{
+		Price:         show.LowestPrice(),
+		PriceMin:      show.PriceMin,
+		PriceMax:      show.PriceMax,
+		PriceCurrency: show.PriceCurrency,
+		IsFree:        show.IsFree,
+	}
+}
@@ -45,6 +45,7 @@ func NewContributorProfileService(database *gorm.DB) *ContributorProfileService
 var binaryOnlyFields = map[string]bool{
 	"last_active":      true,
 	"profile_sections": true,
+	"rsvps":            true,
 }
 
 // ValidatePrivacySettings checks that all fields have valid values.
@@ -56,6 +57,8 @@ func ValidatePrivacySettings(ps contracts.PrivacySettings) error {
 		"collections":      ps.Collections,
 		"last_active":      ps.LastActive,
 		"profile_sections": ps.ProfileSections,
+		"submitted_shows":  ps.SubmittedShows,
+		"rsvps":            ps.RSVPs,
 	}
 	for name, level := range fields {
 		if level != contracts.PrivacyVisible && level != contracts.PrivacyCountOnly && level != contracts.PrivacyHidden {
@@ -69,11 +72,13 @@ func ValidatePrivacySettings(ps contracts.PrivacySettings) error {
 }
 
 // parsePrivacySettings extracts contracts.PrivacySettings from a user's JSONB column.
+// Fields missing from the stored JSON (added after it was written) take their
+// defaults.
 func parsePrivacySettings(raw *json.RawMessage) contracts.PrivacySettings {
 	if raw == nil {
 		return contracts.DefaultPrivacySettings()
 	}
-	var ps contracts.PrivacySettings
+	ps := contracts.DefaultPrivacySettings()
 	if err := json.Unmarshal(*raw, &ps); err != nil {
 		return contracts.DefaultPrivacySettings()
 	}
@@ -262,6 +267,14 @@ func (s *ContributorProfileService) UpdatePrivacySettings(userID uint, settings
 		return nil, fmt.Errorf("database not initialized")
 	}
 
+	// Fields the client didn't send keep their current value, so a client
+	// that predates a privacy field can still save the others.
+	var current authm.User
+	if err := s.db.Select("privacy_settings").First(&current, userID).Error; err != nil {
+		return nil, fmt.Errorf("failed to load privacy settings: %w", err)
+	}
+	settings = mergePrivacySettings(parsePrivacySettings(current.PrivacySettings), settings)
+
 	if err := ValidatePrivacySettings(settings); err != nil {
 		return nil, err
 	}
@@ -279,6 +292,75 @@ func (s *ContributorProfileService) UpdatePrivacySettings(userID uint, settings
 	return &settings, nil
 }
 
+// mergePrivacySettings overlays the non-empty fields of update on current.
+func mergePrivacySettings(current, update contracts.PrivacySettings) contracts.PrivacySettings {
+	for _, f := range []struct {
+		dst *contracts.PrivacyLevel
+		src contracts.PrivacyLevel
+	}{
+		{&current.Contributions, update.Contributions},
+		{&current.SavedShows, update.SavedShows},
+		{&current.Following, update.Following},
+		{&current.Collections, update.Collections},
+		{&current.LastActive, update.LastActive},
+		{&current.ProfileSections, update.ProfileSections},
+		{&current.SubmittedShows, update.SubmittedShows},
+		{&current.RSVPs, update.RSVPs},
+	} {
+		if f.src != "" {
+			*f.dst = f.src
+		}
+	}
+	return current
+}
+
+// =============================================================================
+// Submitted Shows
+// =============================================================================
+
+// submittedShowsSQL lists a user's approved submissions for their public
+// profile, newest event first. venue_name is the show's first-linked venue.
+const submittedShowsSQL = `
+SELECT
+	s.id,
+	COALESCE(s.slug, '') AS slug,
+	s.title,
+	s.event_date,
+	s.city,
+	s.state,
+	(SELECT v.name FROM show_venues sv JOIN venues v ON v.id = sv.venue_id
+		WHERE sv.show_id = s.id ORDER BY sv.venue_id LIMIT 1) AS venue_name,
+	s.is_cancelled
+FROM shows s
+WHERE s.submitted_by = ? AND s.status = 'approved' AND s.deleted_at IS NULL
+ORDER BY s.event_date DESC, s.id DESC
+LIMIT ? OFFSET ?`
+
+// GetSubmittedShows returns the approved shows a user submitted. Pending,
+// rejected and private submissions are never listed, even to the owner:
+// this is the public record.
+func (s *ContributorProfileService) GetSubmittedShows(userID uint, limit, offset int) ([]*contracts.PublicSubmittedShow, int64, error) {
+	if s.db == nil {
+		return nil, 0, fmt.Errorf("database not initialized")
+	}
+
+	var total int64
+	if err := s.db.Model(&catalogm.Show{}).
+		Where("submitted_by = ? AND status = ? AND deleted_at IS NULL", userID, catalogm.ShowStatusApproved).
+		Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count submitted shows: %w", err)
+	}
+
+	shows := []*contracts.PublicSubmittedShow{}
+	if total == 0 {
+		return shows, 0, nil
+	}
+	if err := s.db.Raw(submittedShowsSQL, userID, limit, offset).Scan(&shows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list submitted shows: %w", err)
+	}
+	return shows, total, nil
+}
+
 // =============================================================================
 // Contribution Stats
 // =============================================================================
@@ -292,7 +374,7 @@ func (s *ContributorProfileService) GetContributionStats(userID uint) (*contract
 	stats := &contracts.ContributionStats{}
 
 	// Count submissions from entity tables
-	s.db.Model(&catalogm.Show{}).Where("submitted_by = ?", userID).Count(&stats.ShowsSubmitted)
+	s.db.Model(&catalogm.Show{}).Where("submitted_by = ? AND deleted_at IS NULL", userID).Count(&stats.ShowsSubmitted)
 	s.db.Model(&catalogm.Venue{}).Where("submitted_by = ?", userID).Count(&stats.VenuesSubmitted)
 	s.db.Table("pending_entity_edits").
 		Where("submitted_by = ? AND entity_type = ?", userID, adminm.PendingEditEntityVenue).
@@ -374,19 +456,21 @@ func (s *ContributorProfileService) GetContributionStats(userID uint) (*contract
 	s.db.Model(&communitym.CollectionItem{}).Where("added_by_user_id = ?", userID).Count(&stats.CollectionItemsAdded)
 	s.db.Model(&communitym.CollectionSubscriber{}).Where("user_id = ?", userID).Count(&stats.CollectionSubscriptions)
 
-	// Reports filed (entity_reports + show_reports + artist_reports)
-	var entityReportsFiled, showReportsFiled, artistReportsFiled int64
+	// Reports filed (entity_reports + show_reports + artist_reports + venue_reports)
+	var entityReportsFiled, showReportsFiled, artistReportsFiled, venueReportsFiled int64
 	s.db.Model(&communitym.EntityReport{}).Where("reported_by = ?", userID).Count(&entityReportsFiled)
 	s.db.Model(&communitym.ShowReport{}).Where("reported_by = ?", userID).Count(&showReportsFiled)
 	s.db.Model(&communitym.ArtistReport{}).Where("reported_by = ?", userID).Count(&artistReportsFiled)
-	stats.ReportsFiled = entityReportsFiled + showReportsFiled + artistReportsFiled
+	s.db.Model(&communitym.VenueReport{}).Where("reported_by = ?", userID).Count(&venueReportsFiled)
+	stats.ReportsFiled = entityReportsFiled + showReportsFiled + artistReportsFiled + venueReportsFiled
 
 	// Reports resolved (entity_reports reviewed by this user with resolved/dismissed status)
-	var entityReportsResolved, showReportsResolved, artistReportsResolved int64
+	var entityReportsResolved, showReportsResolved, artistReportsResolved, venueReportsResolved int64
 	s.db.Model(&communitym.EntityReport{}).Where("reviewed_by = ? AND status IN ?", userID, []string{"resolved", "dismissed"}).Count(&entityReportsResolved)
 	s.db.Model(&communitym.ShowReport{}).Where("reviewed_by = ? AND status IN ?", userID, []string{"resolved", "dismissed"}).Count(&showReportsResolved)
 	s.db.Model(&communitym.ArtistReport{}).Where("reviewed_by = ? AND status IN ?", userID, []string{"resolved", "dismissed"}).Count(&artistReportsResolved)
-	stats.ReportsResolved = entityReportsResolved + showReportsResolved + artistReportsResolved
+	s.db.Model(&communitym.VenueReport{}).Where("reviewed_by = ? AND status IN ?", userID, []string{"resolved", "dismissed"}).Count(&venueReportsResolved)
+	stats.ReportsResolved = entityReportsResolved + showReportsResolved + artistReportsResolved + venueReportsResolved
 
 	// Social: followers and following via user_bookmarks with action = 'follow'
 	// (PSY-1496). Followers = other users who follow this user (entity_type=user).
@@ -442,7 +526,7 @@ func (s *ContributorProfileService) GetContributionHistory(userID uint, limit, o
 	}
 
 	auditQuery := `SELECT id, action, entity_type, entity_id, metadata, created_at, 'audit_log' as source FROM audit_logs WHERE actor_id = ?`
-	showQuery := `SELECT id, 'submit_show' as action, 'show' as entity_type, id as entity_id, NULL as metadata, created_at, 'submission' as source FROM shows WHERE submitted_by = ?`
+	showQuery := `SELECT id, 'submit_show' as action, 'show' as entity_type, id as entity_id, NULL as metadata, created_at, 'submission' as source FROM shows WHERE submitted_by = ? AND deleted_at IS NULL`
 	venueQuery := `SELECT id, 'submit_venue' as action, 'venue' as entity_type, id as entity_id, NULL as metadata, created_at, 'submission' as source FROM venues WHERE submitted_by = ?`
 	// Suggested edits pulled from the unified pending_entity_edits table (PSY-503
 	// retired the legacy pending_venue_edits queue). The source entity_type is
@@ -532,7 +616,7 @@ func (s *ContributorProfileService) GetActivityHeatmap(userID uint) (*contracts.
 
 			SELECT DATE(created_at) AS activity_date, COUNT(*) AS cnt
 			FROM shows
-			WHERE submitted_by = ? AND created_at >= NOW() - INTERVAL '365 days'
+			WHERE submitted_by = ? AND deleted_at IS NULL AND created_at >= NOW() - INTERVAL '365 days'
 			GROUP BY DATE(created_at)
 
 			UNION ALL
@@ -804,7 +888,7 @@ func (s *ContributorProfileService) GetPercentileRankings(userID uint) (*contrac
 
 	// shows_submitted
 	var showCount int64
-	s.db.Model(&catalogm.Show{}).Where("submitted_by = ?", userID).Count(&showCount)
+	s.db.Model(&catalogm.Show{}).Where("submitted_by = ? AND deleted_at IS NULL", userID).Count(&showCount)
 	userCounts["shows_submitted"] = showCount
 
 	// venues_submitted
@@ -849,7 +933,7 @@ func (s *ContributorProfileService) GetPercentileRankings(userID uint) (*contrac
 				SELECT COUNT(*) FROM (
 					SELECT u.id, COUNT(s.id) AS cnt
 					FROM users u
-					LEFT JOIN shows s ON s.submitted_by = u.id
+					LEFT JOIN shows s ON s.submitted_by = u.id AND s.deleted_at IS NULL
 					WHERE u.is_active = true
 					GROUP BY u.id
 				) sub WHERE sub.cnt < ?
@@ -50,6 +50,8 @@ func TestValidatePrivacySettings(t *testing.T) {
 			Collections:     contracts.PrivacyVisible,
 			LastActive:      contracts.PrivacyVisible,
 			ProfileSections: contracts.PrivacyVisible,
+			SubmittedShows:  contracts.PrivacyVisible,
+			RSVPs:           contracts.PrivacyVisible,
 		}
 		assert.NoError(t, ValidatePrivacySettings(ps))
 	})
@@ -62,6 +64,8 @@ func TestValidatePrivacySettings(t *testing.T) {
 			Collections:     contracts.PrivacyHidden,
 			LastActive:      contracts.PrivacyHidden,
 			ProfileSections: contracts.PrivacyHidden,
+			SubmittedShows:  contracts.PrivacyHidden,
+			RSVPs:           contracts.PrivacyHidden,
 		}
 		assert.NoError(t, ValidatePrivacySettings(ps))
 	})
@@ -90,11 +94,54 @@ func TestValidatePrivacySettings(t *testing.T) {
 		assert.Contains(t, err.Error(), "only supports 'visible' or 'hidden'")
 	})
 
+	t.Run("Invalid_CountOnly_RSVPs", func(t *testing.T) {
+		ps := contracts.DefaultPrivacySettings()
+		ps.RSVPs = contracts.PrivacyCountOnly
+		err := ValidatePrivacySettings(ps)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supports 'visible' or 'hidden'")
+	})
+
 	t.Run("Valid_CountOnly_Contributions", func(t *testing.T) {
 		ps := contracts.DefaultPrivacySettings()
 		ps.Contributions = contracts.PrivacyCountOnly
 		assert.NoError(t, ValidatePrivacySettings(ps))
 	})
+
+	t.Run("Valid_CountOnly_SubmittedShows", func(t *testing.T) {
+		ps := contracts.DefaultPrivacySettings()
+		ps.SubmittedShows = contracts.PrivacyCountOnly
+		assert.NoError(t, ValidatePrivacySettings(ps))
+	})
+
+	t.Run("Invalid_Missing_SubmittedShows", func(t *testing.T) {
+		ps := contracts.DefaultPrivacySettings()
+		ps.SubmittedShows = ""
+		assert.Error(t, ValidatePrivacySettings(ps))
+	})
+}
+
+func TestParsePrivacySettings_MissingFieldsDefault(t *testing.T) {
+	// Settings written before submitted_shows existed.
+	raw := json.RawMessage(`{"contributions":"hidden","saved_shows":"hidden","following":"count_only","collections":"visible","last_active":"hidden","profile_sections":"visible"}`)
+	ps := parsePrivacySettings(&raw)
+
+	assert.Equal(t, contracts.PrivacyHidden, ps.Contributions)
+	assert.Equal(t, contracts.PrivacyCountOnly, ps.Following)
+	assert.Equal(t, contracts.PrivacyVisible, ps.SubmittedShows)
+}
+
+func TestMergePrivacySettings(t *testing.T) {
+	current := contracts.DefaultPrivacySettings()
+	current.SubmittedShows = contracts.PrivacyHidden
+
+	merged := mergePrivacySettings(current, contracts.PrivacySettings{
+		Contributions: contracts.PrivacyCountOnly,
+	})
+
+	assert.Equal(t, contracts.PrivacyCountOnly, merged.Contributions)
+	assert.Equal(t, contracts.PrivacyHidden, merged.SubmittedShows, "omitted field keeps its stored value")
+	assert.Equal(t, current.SavedShows, merged.SavedShows)
 }
 
 // TestBuildSectionResponse_RendersContentHTML verifies PSY-747: the profile
@@ -1154,6 +1201,49 @@ func (suite *ContributorProfileServiceIntegrationTestSuite) TestUpdatePrivacySet
 	suite.Equal(contracts.PrivacyHidden, profile.PrivacySettings.LastActive)
 }
 
+func (suite *ContributorProfileServiceIntegrationTestSuite) TestUpdatePrivacySettings_OmittedFieldKeepsStoredValue() {
+	user := suite.createTestUser("privacypartial")
+	stored := contracts.DefaultPrivacySettings()
+	stored.SubmittedShows = contracts.PrivacyHidden
+	suite.setPrivacySettings(user.ID, stored)
+
+	// A client that predates submitted_shows sends everything else.
+	update := contracts.DefaultPrivacySettings()
+	update.SubmittedShows = ""
+	update.Contributions = contracts.PrivacyCountOnly
+
+	result, err := suite.profileService.UpdatePrivacySettings(user.ID, update)
+	suite.Require().NoError(err)
+	suite.Equal(contracts.PrivacyCountOnly, result.Contributions)
+	suite.Equal(contracts.PrivacyHidden, result.SubmittedShows)
+}
+
+func (suite *ContributorProfileServiceIntegrationTestSuite) TestGetSubmittedShows_ApprovedOnly() {
+	user := suite.createTestUser("submittedshows")
+	other := suite.createTestUser("submittedother")
+	approved := suite.createShow(user.ID, "Approved Show")
+	pending := suite.createShow(user.ID, "Pending Show")
+	suite.Require().NoError(suite.db.Model(pending).Update("status", "pending").Error)
+	suite.createShow(other.ID, "Someone Else's Show")
+
+	shows, total, err := suite.profileService.GetSubmittedShows(user.ID, 20, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Require().Len(shows, 1)
+	suite.Equal(approved.ID, shows[0].ID)
+	suite.Equal("Approved Show", shows[0].Title)
+}
+
+func (suite *ContributorProfileServiceIntegrationTestSuite) TestGetSubmittedShows_Empty() {
+	user := suite.createTestUser("submittednone")
+
+	shows, total, err := suite.profileService.GetSubmittedShows(user.ID, 20, 0)
+	suite.Require().NoError(err)
+	suite.Equal(int64(0), total)
+	suite.NotNil(shows)
+	suite.Empty(shows)
+}
+
 func (suite *ContributorProfileServiceIntegrationTestSuite) TestUpdatePrivacySettings_InvalidLevel() {
 	user := suite.createTestUser("privacyinvalid")
 
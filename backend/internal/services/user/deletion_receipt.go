@@ -0,0 +1,187 @@
+package user
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	adminm "psychic-homily-backend/internal/models/admin"
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
+	engagementm "psychic-homily-backend/internal/models/engagement"
+	notificationm "psychic-homily-backend/internal/models/notification"
+	"psychic-homily-backend/internal/services/contracts"
+)
+
+// SetDeletionReceiptKey sets the HMAC key used to sign deletion receipts.
+// Without one, receipts are still written but unsigned.
+func (s *UserService) SetDeletionReceiptKey(secret string) {
+	s.deletionReceiptKey = []byte(secret)
+}
+
+// deletionReceiptKeyID identifies a signing key without revealing it: the
+// hex of the first 8 bytes of its SHA-256, the same scheme as JWT kids.
+func deletionReceiptKeyID(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// hashReceiptEmail hashes a normalized email so a compliance request can be
+// matched to its receipt without the receipt holding the address.
+func hashReceiptEmail(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *UserService) signDeletionReceipt(payload []byte) string {
+	if len(s.deletionReceiptKey) == 0 {
+		return ""
+	}
+	mac := hmac.New(sha256.New, s.deletionReceiptKey)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// buildDeletionReceipt counts what is about to be erased or anonymized for
+// user. It must run inside the deletion transaction, before the delete.
+func buildDeletionReceipt(tx *gorm.DB, user *authm.User) (*contracts.DeletionReceipt, error) {
+	receipt := &contracts.DeletionReceipt{
+		ReceiptVersion:      contracts.DeletionReceiptVersion,
+		UserID:              user.ID,
+		AccountCreatedAt:    user.CreatedAt,
+		DeletionRequestedAt: user.DeletedAt,
+		PurgedAt:            time.Now().UTC(),
+		Erased:              map[string]int64{},
+		Anonymized:          map[string]int64{},
+	}
+	if user.Email != nil && *user.Email != "" {
+		receipt.EmailHash = hashReceiptEmail(*user.Email)
+	}
+
+	erased := []struct {
+		key    string
+		model  interface{}
+		column string
+	}{
+		{"oauth_accounts", &authm.OAuthAccount{}, "user_id"},
+		{"passkeys", &authm.WebAuthnCredential{}, "user_id"},
+		{"bookmarks", &engagementm.UserBookmark{}, "user_id"},
+		{"notification_preferences", &notificationm.NotificationPreference{}, "user_id"},
+		{"sent_notifications", &notificationm.SentNotification{}, "user_id"},
+		{"show_reports", &communitym.ShowReport{}, "reported_by"},
+		{"artist_reports", &communitym.ArtistReport{}, "reported_by"},
+		{"venue_reports", &communitym.VenueReport{}, "reported_by"},
+		{"entity_reports", &communitym.EntityReport{}, "reported_by"},
+	}
+	for _, e := range erased {
+		var count int64
+		if err := tx.Model(e.model).Where(e.column+" = ?", user.ID).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", e.key, err)
+		}
+		receipt.Erased[e.key] = count
+	}
+
+	var submitted int64
+	if err := tx.Model(&catalogm.Show{}).Where("submitted_by = ?", user.ID).Count(&submitted).Error; err != nil {
+		return nil, fmt.Errorf("failed to count submitted shows: %w", err)
+	}
+	receipt.Anonymized["submitted_shows"] = submitted
+
+	return receipt, nil
+}
+
+// recordDeletionReceipt signs receipt and stores it in the deletion receipt log
+func (s *UserService) recordDeletionReceipt(tx *gorm.DB, receipt *contracts.DeletionReceipt) error {
+	payload, err := json.Marshal(receipt)
+	if err != nil {
+		return fmt.Errorf("failed to encode deletion receipt: %w", err)
+	}
+
+	row := adminm.DeletionReceipt{
+		UserID:    receipt.UserID,
+		Payload:   string(payload),
+		Signature: s.signDeletionReceipt(payload),
+		KeyID:     deletionReceiptKeyID(s.deletionReceiptKey),
+	}
+	if receipt.EmailHash != "" {
+		row.EmailHash = &receipt.EmailHash
+	}
+	if err := tx.Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to store deletion receipt: %w", err)
+	}
+	return nil
+}
+
+func (s *UserService) toDeletionReceiptRecord(row *adminm.DeletionReceipt) *contracts.DeletionReceiptRecord {
+	record := &contracts.DeletionReceiptRecord{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		Payload:   json.RawMessage(row.Payload),
+		Signature: row.Signature,
+		KeyID:     row.KeyID,
+		CreatedAt: row.CreatedAt,
+	}
+	if row.EmailHash != nil {
+		record.EmailHash = *row.EmailHash
+	}
+	if row.Signature != "" && row.KeyID == deletionReceiptKeyID(s.deletionReceiptKey) {
+		record.Verified = hmac.Equal([]byte(row.Signature), []byte(s.signDeletionReceipt([]byte(row.Payload))))
+	}
+	return record
+}
+
+// ListDeletionReceipts returns the deletion receipt log, newest first. A
+// non-empty email narrows it to receipts for that address.
+func (s *UserService) ListDeletionReceipts(limit, offset int, email string) ([]*contracts.DeletionReceiptRecord, int64, error) {
+	if s.db == nil {
+		return nil, 0, fmt.Errorf("database not initialized")
+	}
+
+	query := s.db.Model(&adminm.DeletionReceipt{})
+	if email != "" {
+		query = query.Where("email_hash = ?", hashReceiptEmail(email))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count deletion receipts: %w", err)
+	}
+
+	var rows []adminm.DeletionReceipt
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list deletion receipts: %w", err)
+	}
+
+	records := make([]*contracts.DeletionReceiptRecord, 0, len(rows))
+	for i := range rows {
+		records = append(records, s.toDeletionReceiptRecord(&rows[i]))
+	}
+	return records, total, nil
+}
+
+// GetDeletionReceipt returns one receipt, or nil if there is none with that id
+func (s *UserService) GetDeletionReceipt(id uint) (*contracts.DeletionReceiptRecord, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var row adminm.DeletionReceipt
+	if err := s.db.First(&row, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get deletion receipt: %w", err)
+	}
+	return s.toDeletionReceiptRecord(&row), nil
+}
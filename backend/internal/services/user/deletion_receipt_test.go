@@ -0,0 +1,66 @@
+package user
+
+import (
+	"testing"
+
+	adminm "psychic-homily-backend/internal/models/admin"
+)
+
+func TestHashReceiptEmail_Normalizes(t *testing.T) {
+	if hashReceiptEmail(" Gone@Example.com ") != hashReceiptEmail("gone@example.com") {
+		t.Error("email hash must ignore case and surrounding whitespace")
+	}
+	if len(hashReceiptEmail("gone@example.com")) != 64 {
+		t.Error("email hash must be a hex SHA-256")
+	}
+}
+
+func TestDeletionReceiptKeyID(t *testing.T) {
+	if deletionReceiptKeyID(nil) != "" {
+		t.Error("no key means no key id")
+	}
+	a, b := deletionReceiptKeyID([]byte("k1")), deletionReceiptKeyID([]byte("k2"))
+	if len(a) != 16 || a == b {
+		t.Errorf("key ids %q and %q must be distinct 16-char ids", a, b)
+	}
+}
+
+func TestToDeletionReceiptRecord_Verification(t *testing.T) {
+	svc := &UserService{}
+	svc.SetDeletionReceiptKey("receipt-key")
+	payload := `{"receipt_version":"1","user_id":9}`
+	row := &adminm.DeletionReceipt{
+		ID:        1,
+		UserID:    9,
+		Payload:   payload,
+		Signature: svc.signDeletionReceipt([]byte(payload)),
+		KeyID:     deletionReceiptKeyID([]byte("receipt-key")),
+	}
+
+	if !svc.toDeletionReceiptRecord(row).Verified {
+		t.Error("a receipt signed with the current key must verify")
+	}
+
+	tampered := *row
+	tampered.Payload = `{"receipt_version":"1","user_id":10}`
+	if svc.toDeletionReceiptRecord(&tampered).Verified {
+		t.Error("a tampered payload must not verify")
+	}
+
+	rotated := &UserService{}
+	rotated.SetDeletionReceiptKey("new-key")
+	if rotated.toDeletionReceiptRecord(row).Verified {
+		t.Error("a receipt signed with another key must not verify")
+	}
+}
+
+func TestToDeletionReceiptRecord_Unsigned(t *testing.T) {
+	svc := &UserService{}
+	row := &adminm.DeletionReceipt{ID: 1, UserID: 9, Payload: `{}`}
+	if svc.signDeletionReceipt([]byte(row.Payload)) != "" {
+		t.Error("no key means no signature")
+	}
+	if svc.toDeletionReceiptRecord(row).Verified {
+		t.Error("an unsigned receipt must not verify")
+	}
+}
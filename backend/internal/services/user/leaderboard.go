@@ -223,7 +223,7 @@ func buildCountSubquery(dimension string, periodFilter string) string {
 		return fmt.Sprintf(`
 			SELECT submitted_by AS user_id, COUNT(*) AS count
 			FROM shows
-			WHERE submitted_by IS NOT NULL %s
+			WHERE submitted_by IS NOT NULL AND deleted_at IS NULL %s
 			GROUP BY submitted_by
 		`, periodFilter)
 	case "venues":
@@ -5,19 +5,24 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/markbates/goth"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"psychic-homily-backend/db"
 	apperrors "psychic-homily-backend/internal/errors"
 	"psychic-homily-backend/internal/logger"
+	adminm "psychic-homily-backend/internal/models/admin"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
 	engagementm "psychic-homily-backend/internal/models/engagement"
+	notificationm "psychic-homily-backend/internal/models/notification"
 	catalogsvc "psychic-homily-backend/internal/services/catalog"
 	"psychic-homily-backend/internal/services/contracts"
 	engagementsvc "psychic-homily-backend/internal/services/engagement"
@@ -44,6 +49,9 @@ func (s *UserService) ListUsers(limit, offset int, filters contracts.AdminUserFi
 		searchPattern := shared.LikePattern(filters.Search)
 		query = query.Where("(email ILIKE ? OR username ILIKE ?)", searchPattern, searchPattern)
 	}
+	if filters.Role != "" {
+		query = query.Where(effectiveRoleSQL+" = ?", filters.Role)
+	}
 
 	// Get total count
 	var total int64
@@ -98,7 +106,7 @@ func (s *UserService) ListUsers(limit, offset int, filters contracts.AdminUserFi
 	var showStats []showStat
 	s.db.Model(&catalogm.Show{}).
 		Select("submitted_by, status, COUNT(*) as count").
-		Where("submitted_by IN ?", userIDs).
+		Where("submitted_by IN ? AND deleted_at IS NULL", userIDs).
 		Group("submitted_by, status").
 		Scan(&showStats)
 
@@ -132,6 +140,14 @@ func (s *UserService) ListUsers(limit, offset int, filters contracts.AdminUserFi
 			authMethods = append(authMethods, "passkey")
 		}
 
+		reputation := contracts.UserSubmissionReputation{
+			Approved: u.SubmissionsApproved,
+			Rejected: u.SubmissionsRejected,
+		}
+		if rate, ok := u.SubmissionApprovalRate(); ok {
+			reputation.ApprovalRate = &rate
+		}
+
 		result[i] = &contracts.AdminUserResponse{
 			ID:              u.ID,
 			Email:           u.Email,
@@ -142,9 +158,12 @@ func (s *UserService) ListUsers(limit, offset int, filters contracts.AdminUserFi
 			AvatarURL:       u.AvatarURL,
 			IsActive:        u.IsActive,
 			IsAdmin:         u.IsAdmin,
+			Role:            u.EffectiveRole(),
 			EmailVerified:   u.EmailVerified,
 			AuthMethods:     authMethods,
 			SubmissionStats: statsMap[u.ID],
+			Reputation:      reputation,
+			TrustTier:       u.SubmissionTrust(),
 			CreatedAt:       u.CreatedAt,
 			DeletedAt:       u.DeletedAt,
 		}
@@ -153,10 +172,82 @@ func (s *UserService) ListUsers(limit, offset int, filters contracts.AdminUserFi
 	return result, total, nil
 }
 
+// effectiveRoleSQL is User.EffectiveRole as a column expression, so role
+// filters match what the console shows: a legacy is_admin account without
+// a staff role is a superadmin.
+const effectiveRoleSQL = "(CASE WHEN role IN ('moderator', 'admin', 'superadmin') THEN role WHEN is_admin THEN 'superadmin' ELSE 'user' END)"
+
+// superadminCountSQL counts accounts with superadmin access, including
+// legacy is_admin accounts without a staff role.
+const superadminCountSQL = effectiveRoleSQL + " = 'superadmin' AND deleted_at IS NULL"
+
+// SetUserRole grants role to userID and returns the role it replaced.
+// is_admin follows the role so owner-or-admin checks stay consistent.
+// Callers can't change their own role, and the last superadmin can't be
+// demoted.
+func (s *UserService) SetUserRole(actorID, userID uint, role authm.Role) (authm.Role, error) {
+	if s.db == nil {
+		return "", fmt.Errorf("database not initialized")
+	}
+	if !role.Valid() {
+		return "", apperrors.ErrRoleInvalid(string(role))
+	}
+	if actorID == userID {
+		return "", apperrors.ErrRoleSelfChange()
+	}
+
+	var previous authm.Role
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Serialize role changes: the last-superadmin check counts other
+		// rows, so locking only the target lets two superadmins demote
+		// each other at once and leave none.
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('psy_user_role_change'))").Error; err != nil {
+			return fmt.Errorf("failed to acquire role change lock: %w", err)
+		}
+
+		var user authm.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", userID).
+			First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.ErrRoleUserNotFound()
+			}
+			return fmt.Errorf("failed to load user: %w", err)
+		}
+		previous = user.EffectiveRole()
+
+		if previous == authm.RoleSuperadmin && role != authm.RoleSuperadmin {
+			var others int64
+			if err := tx.Model(&authm.User{}).
+				Where(superadminCountSQL).
+				Where("id <> ?", userID).
+				Count(&others).Error; err != nil {
+				return fmt.Errorf("failed to count superadmins: %w", err)
+			}
+			if others == 0 {
+				return apperrors.ErrRoleLastSuperadmin()
+			}
+		}
+
+		if err := tx.Model(&user).Updates(map[string]any{
+			"role":     role,
+			"is_admin": role.IsAdminRole(),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return previous, nil
+}
+
 // UserService handles user-related business logic
 type UserService struct {
 	db                  *gorm.DB
 	savedReleaseService contracts.SavedReleaseServiceInterface
+	deletionReceiptKey  []byte
 
 	// incrementFailedAttemptsFn is a test-only seam. When non-nil,
 	// AuthenticateUserWithPassword routes the lockout-counter increment through
@@ -854,7 +945,9 @@ func (s *UserService) GetDeletionSummary(userID uint) (*contracts.DeletionSummar
 	}
 
 	// Count saved shows (bookmarks with entity_type='show' and action='save')
-	if err := s.db.Model(&engagementm.UserBookmark{}).Where("user_id = ? AND entity_type = ? AND action = ?", userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave).Count(&summary.SavedShowsCount).Error; err != nil {
+	if err := s.db.Model(&engagementm.UserBookmark{}).Where("user_id = ? AND entity_type = ? AND action = ?", userID, engagementm.BookmarkEntityShow, engagementm.BookmarkActionSave).
+		Where(shared.LiveShowBookmarkSQL("user_bookmarks")).
+		Count(&summary.SavedShowsCount).Error; err != nil {
 		return nil, fmt.Errorf("failed to count saved shows: %w", err)
 	}
 
@@ -983,7 +1076,7 @@ func (s *UserService) ExportUserData(userID uint) (*contracts.UserDataExport, er
 
 	export := &contracts.UserDataExport{
 		ExportedAt:    time.Now().UTC(),
-		ExportVersion: "1.0",
+		ExportVersion: contracts.UserDataExportVersion,
 		Profile: contracts.UserProfileExport{
 			ID:            user.ID,
 			Email:         user.Email,
@@ -1001,11 +1094,23 @@ func (s *UserService) ExportUserData(userID uint) (*contracts.UserDataExport, er
 	// Export preferences
 	if user.Preferences != nil {
 		export.Preferences = &contracts.UserPreferencesExport{
-			NotificationEmail: user.Preferences.NotificationEmail,
-			NotificationPush:  user.Preferences.NotificationPush,
-			Theme:             user.Preferences.Theme,
-			Timezone:          user.Preferences.Timezone,
-			Language:          user.Preferences.Language,
+			Theme:    user.Preferences.Theme,
+			Timezone: user.Preferences.Timezone,
+			Language: user.Preferences.Language,
+		}
+
+		var notificationPrefs []notificationm.NotificationPreference
+		if err := s.db.Where("user_id = ?", userID).
+			Order("event_type, channel").
+			Find(&notificationPrefs).Error; err != nil {
+			return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+		}
+		for _, pref := range notificationPrefs {
+			export.Preferences.Notifications = append(export.Preferences.Notifications, contracts.NotificationPreferenceExport{
+				EventType: pref.EventType,
+				Channel:   pref.Channel,
+				Enabled:   pref.Enabled,
+			})
 		}
 	}
 
@@ -1105,9 +1210,143 @@ func (s *UserService) ExportUserData(userID uint) (*contracts.UserDataExport, er
 		export.SubmittedShows = append(export.SubmittedShows, submittedExport)
 	}
 
+	if err := s.exportFavoriteVenues(userID, export); err != nil {
+		return nil, err
+	}
+	if err := s.exportReports(userID, export); err != nil {
+		return nil, err
+	}
+	if err := s.exportAuditEntries(userID, export); err != nil {
+		return nil, err
+	}
+
+	var sent []notificationm.SentNotification
+	if err := s.db.Where("user_id = ?", userID).Order("sent_at DESC").Find(&sent).Error; err != nil {
+		return nil, fmt.Errorf("failed to get notification history: %w", err)
+	}
+	for _, n := range sent {
+		export.Notifications = append(export.Notifications, contracts.NotificationExport{
+			NotificationType: n.NotificationType,
+			EntityType:       n.EntityType,
+			EntityID:         n.EntityID,
+			Channel:          n.Channel,
+			SentAt:           n.SentAt,
+		})
+	}
+
 	return export, nil
 }
 
+// exportFavoriteVenues adds the venues the user follows to export
+func (s *UserService) exportFavoriteVenues(userID uint, export *contracts.UserDataExport) error {
+	var follows []engagementm.UserBookmark
+	if err := s.db.Where("user_id = ? AND entity_type = ? AND action = ?",
+		userID, engagementm.BookmarkEntityVenue, engagementm.BookmarkActionFollow).
+		Order("created_at").
+		Find(&follows).Error; err != nil {
+		return fmt.Errorf("failed to get favorite venues: %w", err)
+	}
+
+	for _, follow := range follows {
+		var venue catalogm.Venue
+		if err := s.db.First(&venue, follow.EntityID).Error; err != nil {
+			continue // Skip if venue not found
+		}
+		export.FavoriteVenues = append(export.FavoriteVenues, contracts.FavoriteVenueExport{
+			VenueID:     venue.ID,
+			Name:        venue.Name,
+			City:        venue.City,
+			State:       venue.State,
+			FavoritedAt: follow.CreatedAt,
+		})
+	}
+	return nil
+}
+
+// exportReports adds every report the user filed, across the per-entity
+// report tables and entity_reports, to export
+func (s *UserService) exportReports(userID uint, export *contracts.UserDataExport) error {
+	var showReports []communitym.ShowReport
+	if err := s.db.Where("reported_by = ?", userID).Find(&showReports).Error; err != nil {
+		return fmt.Errorf("failed to get show reports: %w", err)
+	}
+	for _, r := range showReports {
+		export.Reports = append(export.Reports, contracts.ReportExport{
+			EntityType: "show", EntityID: r.ShowID, ReportType: string(r.ReportType),
+			Details: r.Details, Status: string(r.Status), ReportedAt: r.CreatedAt,
+		})
+	}
+
+	var artistReports []communitym.ArtistReport
+	if err := s.db.Where("reported_by = ?", userID).Find(&artistReports).Error; err != nil {
+		return fmt.Errorf("failed to get artist reports: %w", err)
+	}
+	for _, r := range artistReports {
+		export.Reports = append(export.Reports, contracts.ReportExport{
+			EntityType: "artist", EntityID: r.ArtistID, ReportType: string(r.ReportType),
+			Details: r.Details, Status: string(r.Status), ReportedAt: r.CreatedAt,
+		})
+	}
+
+	var venueReports []communitym.VenueReport
+	if err := s.db.Where("reported_by = ?", userID).Find(&venueReports).Error; err != nil {
+		return fmt.Errorf("failed to get venue reports: %w", err)
+	}
+	for _, r := range venueReports {
+		export.Reports = append(export.Reports, contracts.ReportExport{
+			EntityType: "venue", EntityID: r.VenueID, ReportType: string(r.ReportType),
+			Details: r.Details, Status: string(r.Status), ReportedAt: r.CreatedAt,
+		})
+	}
+
+	var entityReports []communitym.EntityReport
+	if err := s.db.Where("reported_by = ?", userID).Find(&entityReports).Error; err != nil {
+		return fmt.Errorf("failed to get entity reports: %w", err)
+	}
+	for _, r := range entityReports {
+		export.Reports = append(export.Reports, contracts.ReportExport{
+			EntityType: r.EntityType, EntityID: r.EntityID, ReportType: r.ReportType,
+			Details: r.Details, Status: string(r.Status), ReportedAt: r.CreatedAt,
+		})
+	}
+
+	sort.SliceStable(export.Reports, func(i, j int) bool {
+		return export.Reports[i].ReportedAt.Before(export.Reports[j].ReportedAt)
+	})
+	return nil
+}
+
+// exportAuditEntries adds the audit log entries whose subject is the user's
+// account to export. Who acted is reduced to self/admin/system.
+func (s *UserService) exportAuditEntries(userID uint, export *contracts.UserDataExport) error {
+	var entries []adminm.AuditLog
+	if err := s.db.Where("entity_type = ? AND entity_id = ?", "user", userID).
+		Order("created_at").
+		Find(&entries).Error; err != nil {
+		return fmt.Errorf("failed to get audit entries: %w", err)
+	}
+
+	for _, entry := range entries {
+		actor := "system"
+		if entry.ActorID != nil {
+			actor = "admin"
+			if *entry.ActorID == userID {
+				actor = "self"
+			}
+		}
+		entryExport := contracts.AuditEntryExport{
+			Action:    entry.Action,
+			Actor:     actor,
+			CreatedAt: entry.CreatedAt,
+		}
+		if entry.Metadata != nil {
+			entryExport.Metadata = *entry.Metadata
+		}
+		export.AuditEntries = append(export.AuditEntries, entryExport)
+	}
+	return nil
+}
+
 // ExportUserDataJSON exports user data as a JSON byte slice
 func (s *UserService) ExportUserDataJSON(userID uint) ([]byte, error) {
 	export, err := s.ExportUserData(userID)
@@ -1251,25 +1490,42 @@ func (s *UserService) SetShowReminders(userID uint, enabled bool) error {
 		return fmt.Errorf("database not initialized")
 	}
 
-	result := s.db.Model(&authm.UserPreferences{}).
-		Where("user_id = ?", userID).
-		Update("show_reminders", enabled)
-
-	if result.Error != nil {
-		return fmt.Errorf("failed to update show reminders: %w", result.Error)
-	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&authm.UserPreferences{}).
+			Where("user_id = ?", userID).
+			Update("show_reminders", enabled)
 
-	if result.RowsAffected == 0 {
-		prefs := &authm.UserPreferences{
-			UserID:        userID,
-			ShowReminders: enabled,
+		if result.Error != nil {
+			return fmt.Errorf("failed to update show reminders: %w", result.Error)
 		}
-		if err := s.db.Create(prefs).Error; err != nil {
-			return fmt.Errorf("failed to create user preferences: %w", err)
+
+		if result.RowsAffected == 0 {
+			prefs := &authm.UserPreferences{
+				UserID:        userID,
+				ShowReminders: enabled,
+			}
+			if err := tx.Create(prefs).Error; err != nil {
+				return fmt.Errorf("failed to create user preferences: %w", err)
+			}
 		}
-	}
 
-	return nil
+		// Keep the notification preference matrix's saved_show_reminder/email
+		// cell in step with the flag.
+		pref := notificationm.NotificationPreference{
+			UserID:    userID,
+			EventType: contracts.NotificationEventSavedShowReminder,
+			Channel:   contracts.NotificationChannelEmail,
+			Enabled:   enabled,
+			UpdatedAt: time.Now(),
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "event_type"}, {Name: "channel"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+		}).Create(&pref).Error; err != nil {
+			return fmt.Errorf("failed to update show reminder preference: %w", err)
+		}
+		return nil
+	})
 }
 
 // SetDefaultReplyPermission sets the user's default reply_permission value
@@ -1594,7 +1850,8 @@ func (s *UserService) GetExpiredDeletedAccounts() ([]authm.User, error) {
 	return users, nil
 }
 
-// PermanentlyDeleteUser hard-deletes a user and all associated data
+// PermanentlyDeleteUser hard-deletes a user and all associated data, and
+// records a signed deletion receipt (see deletion_receipt.go).
 // This should only be called for accounts that have exceeded the grace period
 func (s *UserService) PermanentlyDeleteUser(userID uint) error {
 	if s.db == nil {
@@ -1602,6 +1859,18 @@ func (s *UserService) PermanentlyDeleteUser(userID uint) error {
 	}
 
 	return s.db.Transaction(func(tx *gorm.DB) error {
+		var user authm.User
+		if err := tx.First(&user, userID).Error; err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		// Count what goes before it goes; the receipt is written in the same
+		// transaction so there is never a deletion without one.
+		receipt, err := buildDeletionReceipt(tx, &user)
+		if err != nil {
+			return err
+		}
+
 		// Set shows.submitted_by to NULL for shows submitted by this user
 		// (this is already handled by ON DELETE SET NULL in the FK, but being explicit)
 		if err := tx.Model(&catalogm.Show{}).
@@ -1610,13 +1879,24 @@ func (s *UserService) PermanentlyDeleteUser(userID uint) error {
 			return fmt.Errorf("failed to nullify show submissions: %w", err)
 		}
 
+		// entity_reports has no ON DELETE action on reported_by/reviewed_by,
+		// so clear the user's rows there before the user row goes.
+		if err := tx.Where("reported_by = ?", userID).Delete(&communitym.EntityReport{}).Error; err != nil {
+			return fmt.Errorf("failed to delete entity reports: %w", err)
+		}
+		if err := tx.Model(&communitym.EntityReport{}).
+			Where("reviewed_by = ?", userID).
+			Update("reviewed_by", nil).Error; err != nil {
+			return fmt.Errorf("failed to nullify entity report reviews: %w", err)
+		}
+
 		// Hard delete the user (cascades will handle related data like OAuth accounts,
 		// preferences, passkeys, saved shows, favorite venues)
 		if err := tx.Unscoped().Delete(&authm.User{}, userID).Error; err != nil {
 			return fmt.Errorf("failed to permanently delete user: %w", err)
 		}
 
-		return nil
+		return s.recordDeletionReceipt(tx, receipt)
 	})
 }
 
@@ -1655,6 +1935,42 @@ func (s *UserService) CanUnlinkOAuthAccount(userID uint, provider string) (bool,
 	return true, "", nil
 }
 
+// LinkOAuthAccountToUser attaches a provider account to a logged-in user
+// (the account-linking flow, as opposed to the email match in
+// findOrCreateOAuthUser). It refuses a provider account that belongs to
+// another user, and a second account from a provider the user has already
+// linked. Re-linking the same provider account refreshes its profile fields.
+func (s *UserService) LinkOAuthAccountToUser(userID uint, gothUser goth.User, provider string) (*authm.User, error) {
+	if s.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var user authm.User
+	if err := s.db.First(&user, userID).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var owned authm.OAuthAccount
+	err := s.db.Where("provider = ? AND provider_user_id = ?", provider, gothUser.UserID).First(&owned).Error
+	if err == nil && owned.UserID != userID {
+		return nil, apperrors.ErrOAuthAccountInUse(provider)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	var existing authm.OAuthAccount
+	err = s.db.Where("user_id = ? AND provider = ?", userID, provider).First(&existing).Error
+	if err == nil && existing.ProviderUserID != gothUser.UserID {
+		return nil, apperrors.ErrOAuthProviderLinked(provider)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return s.linkOAuthAccount(&user, gothUser, provider)
+}
+
 // UnlinkOAuthAccount removes an OAuth account from a user
 func (s *UserService) UnlinkOAuthAccount(userID uint, provider string) error {
 	result := s.db.Where("user_id = ? AND provider = ?", userID, provider).Delete(&authm.OAuthAccount{})
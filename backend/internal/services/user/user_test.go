@@ -15,6 +15,7 @@ import (
 	apperrors "psychic-homily-backend/internal/errors"
 	authm "psychic-homily-backend/internal/models/auth"
 	catalogm "psychic-homily-backend/internal/models/catalog"
+	communitym "psychic-homily-backend/internal/models/community"
 	engagementm "psychic-homily-backend/internal/models/engagement"
 	"psychic-homily-backend/internal/services/contracts"
 	"psychic-homily-backend/internal/testutil"
@@ -173,15 +174,13 @@ type UserServiceIntegrationTestSuite struct {
 }
 
 func (suite *UserServiceIntegrationTestSuite) SetupSuite() {
-	suite.testDB = testutil.SetupTestPostgres(suite.T())
-	suite.db = suite.testDB.DB
-
-	// Create UserService
-	suite.userService = NewUserService(suite.testDB.DB)
+	suite.testDB = testutil.SharedTestPostgres(suite.T())
 }
 
-func (suite *UserServiceIntegrationTestSuite) TearDownSuite() {
-	suite.testDB.Cleanup()
+// SetupTest runs each test in its own rolled-back transaction.
+func (suite *UserServiceIntegrationTestSuite) SetupTest() {
+	suite.db = testutil.BeginTestTx(suite.T(), suite.testDB.DB)
+	suite.userService = NewUserService(suite.db)
 }
 
 // ---- Existing tests (GetUserByID, GetUserByEmail, UpdateUser, etc.) --------
@@ -680,12 +679,10 @@ func (suite *UserServiceIntegrationTestSuite) TestGetUserByUsername_WithPreferen
 	suite.Require().NotZero(user.ID)
 
 	preferences := &authm.UserPreferences{
-		UserID:            user.ID,
-		NotificationEmail: true,
-		NotificationPush:  false,
-		Theme:             "dark",
-		Timezone:          "America/New_York",
-		Language:          "en",
+		UserID:   user.ID,
+		Theme:    "dark",
+		Timezone: "America/New_York",
+		Language: "en",
 	}
 
 	err = suite.db.Create(preferences).Error
@@ -702,8 +699,6 @@ func (suite *UserServiceIntegrationTestSuite) TestGetUserByUsername_WithPreferen
 
 	prefs := retrievedUser.Preferences
 	suite.Equal(user.ID, prefs.UserID)
-	suite.True(prefs.NotificationEmail)
-	suite.False(prefs.NotificationPush)
 	suite.Equal("dark", prefs.Theme)
 	suite.Equal("America/New_York", prefs.Timezone)
 	suite.Equal("en", prefs.Language)
@@ -734,9 +729,9 @@ func (suite *UserServiceIntegrationTestSuite) TestGetUserByUsername_WithOAuthAnd
 	suite.Require().NoError(err)
 
 	err = suite.db.Exec(`
-		INSERT INTO user_preferences (user_id, notification_email, notification_push, theme, timezone, language, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
-	`, user.ID, false, true, "light", "UTC", "es").Error
+		INSERT INTO user_preferences (user_id, theme, timezone, language, created_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+	`, user.ID, "light", "UTC", "es").Error
 	suite.Require().NoError(err)
 
 	retrievedUser, err := suite.userService.GetUserByUsername(username)
@@ -755,8 +750,6 @@ func (suite *UserServiceIntegrationTestSuite) TestGetUserByUsername_WithOAuthAnd
 
 	prefs := retrievedUser.Preferences
 	suite.Equal(user.ID, prefs.UserID)
-	suite.False(prefs.NotificationEmail)
-	suite.True(prefs.NotificationPush)
 	suite.Equal("light", prefs.Theme)
 	suite.Equal("UTC", prefs.Timezone)
 	suite.Equal("es", prefs.Language)
@@ -1405,6 +1398,7 @@ func (suite *UserServiceIntegrationTestSuite) TestPermanentlyDeleteUser() {
 		VALUES (?, E'\\x637265642D7065726D64656C6574652D31', E'\\x7075626B65792D7065726D64656C6574652D31', 'Del Key', NOW(), NOW())`,
 		user.ID).Error)
 
+	suite.userService.SetDeletionReceiptKey("test-receipt-key")
 	err := suite.userService.PermanentlyDeleteUser(user.ID)
 	suite.Require().NoError(err)
 
@@ -1431,6 +1425,48 @@ func (suite *UserServiceIntegrationTestSuite) TestPermanentlyDeleteUser() {
 	var submittedBy *uint
 	suite.db.Raw(`SELECT submitted_by FROM shows WHERE id = ?`, showID).Scan(&submittedBy)
 	suite.Nil(submittedBy)
+
+	// Verify a signed receipt was recorded, findable by email
+	receipts, total, err := suite.userService.ListDeletionReceipts(10, 0, "PermDelete@example.com")
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), total)
+	suite.Equal(user.ID, receipts[0].UserID)
+	suite.True(receipts[0].Verified)
+
+	var receipt contracts.DeletionReceipt
+	suite.Require().NoError(json.Unmarshal(receipts[0].Payload, &receipt))
+	suite.Equal(int64(1), receipt.Erased["oauth_accounts"])
+	suite.Equal(int64(1), receipt.Erased["passkeys"])
+	suite.Equal(int64(2), receipt.Erased["bookmarks"])
+	suite.Equal(int64(1), receipt.Anonymized["submitted_shows"])
+	suite.NotContains(string(receipts[0].Payload), "permdelete@example.com")
+
+	got, err := suite.userService.GetDeletionReceipt(receipts[0].ID)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(got)
+	suite.Equal(receipts[0].Signature, got.Signature)
+}
+
+func (suite *UserServiceIntegrationTestSuite) TestPermanentlyDeleteUser_RemovesEntityReports() {
+	user := &authm.User{Email: stringPtr("permdelete-reports@example.com"), IsActive: true}
+	suite.Require().NoError(suite.db.Create(user).Error)
+	suite.Require().NoError(suite.db.Create(&communitym.EntityReport{
+		EntityType: "artist", EntityID: 1, ReportedBy: user.ID, ReportType: "inaccurate",
+		Status: communitym.EntityReportStatusPending,
+	}).Error)
+
+	// A service without a signing key (the suite's may have one set)
+	unsigned := NewUserService(suite.db)
+	suite.Require().NoError(unsigned.PermanentlyDeleteUser(user.ID))
+
+	var count int64
+	suite.db.Model(&communitym.EntityReport{}).Where("reported_by = ?", user.ID).Count(&count)
+	suite.Equal(int64(0), count)
+
+	receipts, _, err := unsigned.ListDeletionReceipts(10, 0, "permdelete-reports@example.com")
+	suite.Require().NoError(err)
+	suite.Require().Len(receipts, 1)
+	suite.False(receipts[0].Verified, "receipts written without a signing key are unsigned")
 }
 
 // =============================================================================
@@ -1528,7 +1564,7 @@ func (suite *UserServiceIntegrationTestSuite) TestExportUserData_Success() {
 	suite.Equal("Export", *export.Profile.FirstName)
 
 	// Verify export version
-	suite.Equal("1.0", export.ExportVersion)
+	suite.Equal(contracts.UserDataExportVersion, export.ExportVersion)
 
 	// Verify preferences
 	suite.NotNil(export.Preferences)
@@ -1557,6 +1593,70 @@ func (suite *UserServiceIntegrationTestSuite) TestExportUserData_Success() {
 	suite.Equal("lp", export.SavedReleases[0].ReleaseType)
 }
 
+func (suite *UserServiceIntegrationTestSuite) TestExportUserData_ReportsVenuesAuditAndNotifications() {
+	user, err := suite.userService.CreateUserWithPassword(
+		"exportmore@example.com", "ExportPass1!", "Export", "More",
+	)
+	suite.Require().NoError(err)
+
+	// Favorite venue (a venue follow)
+	var venueID uint
+	suite.Require().NoError(suite.db.Raw(
+		`INSERT INTO venues (name, city, state, created_at, updated_at) VALUES (?, ?, ?, NOW(), NOW()) RETURNING id`,
+		"Export Venue", "Tucson", "AZ").Scan(&venueID).Error)
+	suite.Require().NoError(suite.db.Create(&engagementm.UserBookmark{
+		UserID: user.ID, EntityType: engagementm.BookmarkEntityVenue,
+		EntityID: venueID, Action: engagementm.BookmarkActionFollow,
+		CreatedAt: time.Now(),
+	}).Error)
+
+	// A show report and an entity report
+	var showID uint
+	suite.Require().NoError(suite.db.Raw(
+		`INSERT INTO shows (title, event_date, created_at, updated_at) VALUES (?, NOW() + interval '1 day', NOW(), NOW()) RETURNING id`,
+		"Reported Show").Scan(&showID).Error)
+	suite.Require().NoError(suite.db.Exec(
+		`INSERT INTO show_reports (show_id, reported_by, report_type, created_at, updated_at) VALUES (?, ?, 'cancelled', NOW(), NOW())`,
+		showID, user.ID).Error)
+	suite.Require().NoError(suite.db.Create(&communitym.EntityReport{
+		EntityType: "venue", EntityID: venueID, ReportedBy: user.ID, ReportType: "inaccurate",
+		Status: communitym.EntityReportStatusPending,
+	}).Error)
+
+	// An audit entry about the account, and one about something else
+	suite.Require().NoError(suite.db.Exec(
+		`INSERT INTO audit_logs (actor_id, action, entity_type, entity_id, metadata, created_at) VALUES (NULL, 'grant_role', 'user', ?, '{"role":"moderator"}', NOW())`,
+		user.ID).Error)
+	suite.Require().NoError(suite.db.Exec(
+		`INSERT INTO audit_logs (actor_id, action, entity_type, entity_id, created_at) VALUES (?, 'approve_show', 'show', ?, NOW())`,
+		user.ID, showID).Error)
+
+	// Notification history
+	suite.Require().NoError(suite.db.Exec(
+		`INSERT INTO sent_notifications (user_id, notification_type, entity_type, entity_id, channel) VALUES (?, 'show_reminder_day_before', 'show', ?, 'email')`,
+		user.ID, showID).Error)
+
+	export, err := suite.userService.ExportUserData(user.ID)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(export.FavoriteVenues, 1)
+	suite.Equal("Export Venue", export.FavoriteVenues[0].Name)
+	suite.Equal("Tucson", export.FavoriteVenues[0].City)
+
+	suite.Require().Len(export.Reports, 2)
+	types := []string{export.Reports[0].EntityType, export.Reports[1].EntityType}
+	suite.ElementsMatch([]string{"show", "venue"}, types)
+
+	suite.Require().Len(export.AuditEntries, 1)
+	suite.Equal("grant_role", export.AuditEntries[0].Action)
+	suite.Equal("system", export.AuditEntries[0].Actor)
+	suite.JSONEq(`{"role":"moderator"}`, string(export.AuditEntries[0].Metadata))
+
+	suite.Require().Len(export.Notifications, 1)
+	suite.Equal("show_reminder_day_before", export.Notifications[0].NotificationType)
+	suite.Equal("email", export.Notifications[0].Channel)
+}
+
 func (suite *UserServiceIntegrationTestSuite) TestExportUserDataJSON() {
 	user, err := suite.userService.CreateUserWithPassword(
 		"exportjson@example.com", "ExportPass1!", "JSON", "Export",
@@ -1574,7 +1674,7 @@ func (suite *UserServiceIntegrationTestSuite) TestExportUserDataJSON() {
 
 	// Verify required fields exist
 	suite.Contains(parsed, "export_version")
-	suite.Equal("1.0", parsed["export_version"])
+	suite.Equal(contracts.UserDataExportVersion, parsed["export_version"])
 	suite.Contains(parsed, "profile")
 	suite.Contains(parsed, "exported_at")
 }
@@ -1676,6 +1776,70 @@ func (suite *UserServiceIntegrationTestSuite) TestUnlinkOAuthAccount_Success() {
 	suite.Equal("github", accounts[0].Provider)
 }
 
+func (suite *UserServiceIntegrationTestSuite) TestLinkOAuthAccountToUser_Success() {
+	user, err := suite.userService.CreateUserWithPassword(
+		"linktest@example.com", "Pass123!", "Link", "Test",
+	)
+	suite.Require().NoError(err)
+
+	// The provider email need not match the account email.
+	linked, err := suite.userService.LinkOAuthAccountToUser(user.ID, goth.User{
+		UserID: "link_google_123",
+		Email:  "someone-else@gmail.com",
+		Name:   "Link Test",
+	}, "google")
+	suite.Require().NoError(err)
+	suite.Equal(user.ID, linked.ID)
+
+	accounts, err := suite.userService.GetOAuthAccounts(user.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 1)
+	suite.Equal("link_google_123", accounts[0].ProviderUserID)
+
+	// Re-linking the same provider account is idempotent.
+	_, err = suite.userService.LinkOAuthAccountToUser(user.ID, goth.User{UserID: "link_google_123"}, "google")
+	suite.Require().NoError(err)
+}
+
+func (suite *UserServiceIntegrationTestSuite) TestLinkOAuthAccountToUser_OwnedByAnotherUser() {
+	owner, err := suite.userService.CreateUserWithPassword(
+		"linkowner@example.com", "Pass123!", "Link", "Owner",
+	)
+	suite.Require().NoError(err)
+	suite.db.Create(&authm.OAuthAccount{
+		UserID:         owner.ID,
+		Provider:       "google",
+		ProviderUserID: "link_owned_google_123",
+	})
+
+	other, err := suite.userService.CreateUserWithPassword(
+		"linkother@example.com", "Pass123!", "Link", "Other",
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.userService.LinkOAuthAccountToUser(other.ID, goth.User{UserID: "link_owned_google_123"}, "google")
+	var authErr *apperrors.AuthError
+	suite.Require().ErrorAs(err, &authErr)
+	suite.Equal(apperrors.CodeOAuthAccountInUse, authErr.Code)
+}
+
+func (suite *UserServiceIntegrationTestSuite) TestLinkOAuthAccountToUser_ProviderAlreadyLinked() {
+	user, err := suite.userService.CreateUserWithPassword(
+		"linktwice@example.com", "Pass123!", "Link", "Twice",
+	)
+	suite.Require().NoError(err)
+	suite.db.Create(&authm.OAuthAccount{
+		UserID:         user.ID,
+		Provider:       "github",
+		ProviderUserID: "link_first_github_123",
+	})
+
+	_, err = suite.userService.LinkOAuthAccountToUser(user.ID, goth.User{UserID: "link_second_github_456"}, "github")
+	var authErr *apperrors.AuthError
+	suite.Require().ErrorAs(err, &authErr)
+	suite.Equal(apperrors.CodeOAuthProviderLinked, authErr.Code)
+}
+
 // =============================================================================
 // NEW INTEGRATION TESTS: GetUserByEmailIncludingDeleted
 // =============================================================================
@@ -1781,6 +1945,57 @@ func (suite *UserServiceIntegrationTestSuite) TestListUsers_WithAuthMethods() {
 	suite.Contains(authMethods, "google")
 }
 
+func (suite *UserServiceIntegrationTestSuite) TestListUsers_RoleFilterUsesEffectiveRole() {
+	// A legacy is_admin account without a staff role shows as superadmin,
+	// so the filter must put it there and not under user.
+	suite.db.Create(&authm.User{
+		Email:    stringPtr("legacy@rolefilter.example.com"),
+		IsActive: true,
+		IsAdmin:  true,
+	})
+	suite.db.Create(&authm.User{
+		Email:    stringPtr("plain@rolefilter.example.com"),
+		IsActive: true,
+	})
+
+	superadmins, _, err := suite.userService.ListUsers(100, 0, contracts.AdminUserFilters{
+		Search: "rolefilter.example.com",
+		Role:   authm.RoleSuperadmin,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(superadmins, 1)
+	suite.Equal("legacy@rolefilter.example.com", *superadmins[0].Email)
+
+	users, _, err := suite.userService.ListUsers(100, 0, contracts.AdminUserFilters{
+		Search: "rolefilter.example.com",
+		Role:   authm.RoleUser,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(users, 1)
+	suite.Equal("plain@rolefilter.example.com", *users[0].Email)
+}
+
+func (suite *UserServiceIntegrationTestSuite) TestSetUserRole_SuperadminsCannotDemoteEachOtherToNone() {
+	// Demote every superadmin already in the database so the two below
+	// are the only ones.
+	suite.Require().NoError(suite.db.Model(&authm.User{}).Where(superadminCountSQL).
+		Updates(map[string]any{"role": authm.RoleUser, "is_admin": false}).Error)
+
+	a := &authm.User{Email: stringPtr("a@superadmins.example.com"), IsActive: true, Role: authm.RoleSuperadmin, IsAdmin: true}
+	b := &authm.User{Email: stringPtr("b@superadmins.example.com"), IsActive: true, IsAdmin: true}
+	suite.Require().NoError(suite.db.Create(a).Error)
+	suite.Require().NoError(suite.db.Create(b).Error)
+
+	previous, err := suite.userService.SetUserRole(a.ID, b.ID, authm.RoleAdmin)
+	suite.Require().NoError(err)
+	suite.Equal(authm.RoleSuperadmin, previous, "legacy is_admin counts as superadmin")
+
+	_, err = suite.userService.SetUserRole(b.ID, a.ID, authm.RoleAdmin)
+	var roleErr *apperrors.RoleError
+	suite.Require().ErrorAs(err, &roleErr)
+	suite.Equal(apperrors.CodeRoleLastSuperadmin, roleErr.Code)
+}
+
 // =============================================================================
 // HELPER FUNCTIONS
 // =============================================================================
@@ -1880,3 +2095,10 @@ func (suite *UserServiceIntegrationTestSuite) TestSetChartDefaults_RejectsInvali
 	suite.Require().Error(err)
 	suite.Contains(err.Error(), "invalid chart window")
 }
+
+func TestUserService_SetUserRole_NilDB(t *testing.T) {
+	svc := &UserService{}
+	_, err := svc.SetUserRole(1, 2, authm.RoleModerator)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "database not initialized")
+}
@@ -0,0 +1,150 @@
+package testutil
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	authm "psychic-homily-backend/internal/models/auth"
+	catalogm "psychic-homily-backend/internal/models/catalog"
+)
+
+// Fixture builders insert a valid row with unique defaults and return it.
+// Options adjust the row before it is created:
+//
+//	admin := testutil.CreateUser(t, db, func(u *authm.User) { u.Role = authm.RoleAdmin })
+//
+// The rows go in directly, not through the services, so a builder never
+// depends on the behaviour under test.
+
+// fixtureSeq makes default names, emails and slugs unique across a test
+// binary, including between suites sharing SharedTestPostgres.
+var fixtureSeq atomic.Uint64
+
+func nextFixtureID() uint64 { return fixtureSeq.Add(1) }
+
+func fixtureString(s string) *string { return &s }
+
+// CreateUser inserts an active, verified user with a unique email.
+func CreateUser(t testing.TB, db *gorm.DB, opts ...func(*authm.User)) *authm.User {
+	t.Helper()
+	n := nextFixtureID()
+	user := &authm.User{
+		Email:         fixtureString(fmt.Sprintf("fixture-user-%d@test.com", n)),
+		FirstName:     fixtureString("Test"),
+		LastName:      fixtureString(fmt.Sprintf("User %d", n)),
+		IsActive:      true,
+		EmailVerified: true,
+	}
+	for _, opt := range opts {
+		opt(user)
+	}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("create fixture user: %v", err)
+	}
+	// is_active has a column default, so gorm omits a false value on insert.
+	if !user.IsActive {
+		if err := db.Model(user).Update("is_active", false).Error; err != nil {
+			t.Fatalf("deactivate fixture user: %v", err)
+		}
+	}
+	return user
+}
+
+// CreateVenue inserts a verified venue in Phoenix, AZ with a unique name and slug.
+func CreateVenue(t testing.TB, db *gorm.DB, opts ...func(*catalogm.Venue)) *catalogm.Venue {
+	t.Helper()
+	n := nextFixtureID()
+	venue := &catalogm.Venue{
+		Name:     fmt.Sprintf("Fixture Venue %d", n),
+		Slug:     fixtureString(fmt.Sprintf("fixture-venue-%d", n)),
+		City:     "Phoenix",
+		State:    "AZ",
+		Verified: true,
+	}
+	for _, opt := range opts {
+		opt(venue)
+	}
+	if err := db.Create(venue).Error; err != nil {
+		t.Fatalf("create fixture venue: %v", err)
+	}
+	return venue
+}
+
+// CreateArtist inserts an artist with a unique name and slug.
+func CreateArtist(t testing.TB, db *gorm.DB, opts ...func(*catalogm.Artist)) *catalogm.Artist {
+	t.Helper()
+	n := nextFixtureID()
+	artist := &catalogm.Artist{
+		Name: fmt.Sprintf("Fixture Artist %d", n),
+		Slug: fixtureString(fmt.Sprintf("fixture-artist-%d", n)),
+	}
+	for _, opt := range opts {
+		opt(artist)
+	}
+	if err := db.Create(artist).Error; err != nil {
+		t.Fatalf("create fixture artist: %v", err)
+	}
+	return artist
+}
+
+// ShowFixture is a show with its venues and lineup, in billing order.
+type ShowFixture struct {
+	Show    catalogm.Show
+	Venues  []*catalogm.Venue
+	Artists []*catalogm.Artist
+}
+
+// CreateShow inserts an approved show a week out, linked to its venues and
+// artists. Options can edit Show and set Venues or Artists to existing rows;
+// left empty, each gets one fresh fixture. The first artist is the headliner.
+func CreateShow(t testing.TB, db *gorm.DB, opts ...func(*ShowFixture)) *ShowFixture {
+	t.Helper()
+	n := nextFixtureID()
+	f := &ShowFixture{
+		Show: catalogm.Show{
+			Title:     fmt.Sprintf("Fixture Show %d", n),
+			Slug:      fixtureString(fmt.Sprintf("fixture-show-%d", n)),
+			EventDate: time.Now().UTC().Add(7 * 24 * time.Hour).Truncate(time.Hour),
+			Status:    catalogm.ShowStatusApproved,
+		},
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if len(f.Venues) == 0 {
+		f.Venues = []*catalogm.Venue{CreateVenue(t, db)}
+	}
+	if len(f.Artists) == 0 {
+		f.Artists = []*catalogm.Artist{CreateArtist(t, db)}
+	}
+	if f.Show.City == nil {
+		f.Show.City = fixtureString(f.Venues[0].City)
+	}
+	if f.Show.State == nil {
+		f.Show.State = fixtureString(f.Venues[0].State)
+	}
+
+	if err := db.Create(&f.Show).Error; err != nil {
+		t.Fatalf("create fixture show: %v", err)
+	}
+	for _, venue := range f.Venues {
+		if err := db.Create(&catalogm.ShowVenue{ShowID: f.Show.ID, VenueID: venue.ID}).Error; err != nil {
+			t.Fatalf("link fixture show venue: %v", err)
+		}
+	}
+	for i, artist := range f.Artists {
+		setType := "opener"
+		if i == 0 {
+			setType = "headliner"
+		}
+		link := &catalogm.ShowArtist{ShowID: f.Show.ID, ArtistID: artist.ID, Position: i, SetType: setType}
+		if err := db.Create(link).Error; err != nil {
+			t.Fatalf("link fixture show artist: %v", err)
+		}
+	}
+	return f
+}
@@ -1,44 +1,51 @@
 package testutil
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"io/fs"
 	"os"
-	"path/filepath"
-	"sort"
-	"strings"
 	"testing"
+
+	"psychic-homily-backend/db/migrations"
+	"psychic-homily-backend/db/migrator"
 )
 
-// RunAllMigrations reads all *.up.sql files from migrationDir, sorts them
-// by filename (which gives numeric order), and executes them against db.
-// It automatically strips CREATE INDEX CONCURRENTLY (not allowed inside
-// transactions) so tests don't need to special-case migration 27.
+// RunAllMigrations applies every migration in migrationDir to db through the
+// same migrator the server's -migrate flag uses, so tests also record
+// schema_migrations. CREATE INDEX CONCURRENTLY is stripped (not allowed
+// inside transactions) so tests don't need to special-case migration 27.
 func RunAllMigrations(t *testing.T, db *sql.DB, migrationDir string) {
 	t.Helper()
+	runMigrations(t, db, os.DirFS(migrationDir))
+}
 
-	pattern := filepath.Join(migrationDir, "*.up.sql")
-	files, err := filepath.Glob(pattern)
-	if err != nil {
-		t.Fatalf("failed to glob migration files: %v", err)
-	}
-	if len(files) == 0 {
-		t.Fatalf("no migration files found in %s", migrationDir)
-	}
-
-	sort.Strings(files)
+// RunEmbeddedMigrations is RunAllMigrations for the migrations compiled into
+// the binary (db/migrations.FS).
+func RunEmbeddedMigrations(t *testing.T, db *sql.DB) {
+	t.Helper()
+	runMigrations(t, db, migrations.FS)
+}
 
-	for _, f := range files {
-		content, err := os.ReadFile(f)
-		if err != nil {
-			t.Fatalf("failed to read migration %s: %v", filepath.Base(f), err)
-		}
+func runMigrations(t *testing.T, db *sql.DB, fsys fs.FS) {
+	t.Helper()
+	if err := migrateFS(db, fsys); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
 
-		// CONCURRENTLY is not allowed inside transactions (used by testcontainers)
-		migrationSQL := strings.ReplaceAll(string(content), "CONCURRENTLY ", "")
+func migrateEmbedded(db *sql.DB) error {
+	return migrateFS(db, migrations.FS)
+}
 
-		_, err = db.Exec(migrationSQL)
-		if err != nil {
-			t.Fatalf("failed to run migration %s: %v", filepath.Base(f), err)
-		}
+func migrateFS(db *sql.DB, fsys fs.FS) error {
+	m, err := migrator.New(db, fsys, migrator.Options{StripConcurrently: true})
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
 	}
+	return nil
 }
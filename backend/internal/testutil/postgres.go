@@ -3,8 +3,7 @@ package testutil
 import (
 	"context"
 	"fmt"
-	"path/filepath"
-	"runtime"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,30 +13,77 @@ import (
 	"gorm.io/gorm"
 )
 
+// postgresImage is the server version every suite tests against.
+const postgresImage = "postgres:18"
+
 // TestDatabase holds the database connection and cleanup function for a test container.
 type TestDatabase struct {
 	DB        *gorm.DB
 	Container testcontainers.Container
 	ctx       context.Context
+	// shared marks the package-wide container from SharedTestPostgres, which
+	// outlives any one suite.
+	shared bool
 }
 
-// Cleanup terminates the test container.
+// Cleanup terminates the test container. It is a no-op for the shared
+// container, which the testcontainers reaper removes when the test binary
+// exits.
 func (td *TestDatabase) Cleanup() {
-	if td.Container != nil {
+	if td.Container != nil && !td.shared {
 		//nolint:errcheck // test teardown best-effort; container is going away
 		td.Container.Terminate(td.ctx)
 	}
 }
 
-// SetupTestPostgres creates a Postgres testcontainer, runs all migrations, and returns
+// SetupTestPostgres creates a Postgres testcontainer, runs all embedded migrations, and returns
 // a GORM DB connection. Call Cleanup() in TearDownSuite to terminate the container.
-func SetupTestPostgres(t *testing.T) *TestDatabase {
+//
+// Suites that isolate each test in a transaction (BeginTestTx) should use
+// SharedTestPostgres instead and skip the container start.
+func SetupTestPostgres(t testing.TB) *TestDatabase {
+	t.Helper()
+	td, err := startPostgres(context.Background())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return td
+}
+
+var (
+	sharedMu  sync.Mutex
+	sharedDB  *TestDatabase
+	sharedErr error
+)
+
+// SharedTestPostgres returns one migrated Postgres container per test binary,
+// started on first use. Every suite in the package gets the same database,
+// so a suite using it must leave no rows behind: wrap each test in
+// BeginTestTx. Cleanup() on the result is safe to call and does nothing.
+func SharedTestPostgres(t testing.TB) *TestDatabase {
 	t.Helper()
-	ctx := context.Background()
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
 
+	if sharedDB == nil && sharedErr == nil {
+		sharedDB, sharedErr = startPostgres(context.Background())
+		if sharedDB != nil {
+			sharedDB.shared = true
+		}
+	}
+	if sharedErr != nil {
+		// Don't retry: a container that failed to start for one suite will
+		// fail for the rest, and each retry costs the startup timeout.
+		t.Fatalf("shared postgres unavailable: %v", sharedErr)
+	}
+	return sharedDB
+}
+
+// startPostgres starts a container and applies the embedded migrations.
+func startPostgres(ctx context.Context) (*TestDatabase, error) {
 	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		ContainerRequest: testcontainers.ContainerRequest{
-			Image:        "postgres:18",
+			Image:        postgresImage,
 			ExposedPorts: []string{"5432/tcp"},
 			Env: map[string]string{
 				"POSTGRES_DB":       "test_db",
@@ -51,17 +97,38 @@ func SetupTestPostgres(t *testing.T) *TestDatabase {
 		Started: true,
 	})
 	if err != nil {
-		t.Fatalf("failed to start postgres container: %v", err)
+		return nil, fmt.Errorf("failed to start postgres container: %w", err)
+	}
+	td := &TestDatabase{Container: container, ctx: ctx}
+
+	db, err := connectContainer(ctx, container)
+	if err != nil {
+		td.Cleanup()
+		return nil, err
 	}
+	td.DB = db
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		td.Cleanup()
+		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
+	}
+	if err := migrateEmbedded(sqlDB); err != nil {
+		td.Cleanup()
+		return nil, err
+	}
+	return td, nil
+}
 
+func connectContainer(ctx context.Context, container testcontainers.Container) (*gorm.DB, error) {
 	host, err := container.Host(ctx)
 	if err != nil {
-		t.Fatalf("failed to get container host: %v", err)
+		return nil, fmt.Errorf("failed to get container host: %w", err)
 	}
 
 	port, err := container.MappedPort(ctx, "5432")
 	if err != nil {
-		t.Fatalf("failed to get container port: %v", err)
+		return nil, fmt.Errorf("failed to get container port: %w", err)
 	}
 
 	dsn := fmt.Sprintf("host=%s port=%s user=test_user password=test_password dbname=test_db sslmode=disable",
@@ -71,22 +138,7 @@ func SetupTestPostgres(t *testing.T) *TestDatabase {
 	// same wrapped sentinels (gorm.ErrDuplicatedKey, etc.).
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
-		t.Fatalf("failed to connect to test database: %v", err)
-	}
-
-	sqlDB, err := db.DB()
-	if err != nil {
-		t.Fatalf("failed to get sql.DB: %v", err)
-	}
-
-	// Find migration directory relative to this file
-	_, filename, _, _ := runtime.Caller(0)
-	migrationDir := filepath.Join(filepath.Dir(filename), "..", "..", "db", "migrations")
-	RunAllMigrations(t, sqlDB, migrationDir)
-
-	return &TestDatabase{
-		DB:        db,
-		Container: container,
-		ctx:       ctx,
+		return nil, fmt.Errorf("failed to connect to test database: %w", err)
 	}
+	return db, nil
 }
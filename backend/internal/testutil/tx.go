@@ -0,0 +1,35 @@
+package testutil
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// BeginTestTx opens a transaction on db and rolls it back when t finishes,
+// so nothing the test writes is visible to the next one. Build the services
+// under test on the returned handle (typically in SetupTest):
+//
+//	func (s *Suite) SetupSuite() { s.testDB = testutil.SharedTestPostgres(s.T()) }
+//	func (s *Suite) SetupTest() {
+//		s.db = testutil.BeginTestTx(s.T(), s.testDB.DB)
+//		s.svc = NewService(s.db)
+//	}
+//
+// Service code that calls db.Transaction gets a savepoint, so its own
+// rollbacks still work. Two things don't carry over from a bare database:
+// now() is fixed at the transaction's start, and a failed statement outside a
+// savepoint aborts the rest of the test. Also avoid tx.DB(): the *sql.DB it
+// returns is outside the transaction.
+func BeginTestTx(t testing.TB, db *gorm.DB) *gorm.DB {
+	t.Helper()
+	tx := db.Begin()
+	if tx.Error != nil {
+		t.Fatalf("failed to begin test transaction: %v", tx.Error)
+	}
+	t.Cleanup(func() {
+		//nolint:errcheck // rollback of a possibly aborted tx; nothing to report
+		tx.Rollback()
+	})
+	return tx
+}
@@ -2,6 +2,9 @@ package utils
 
 import (
 	"bytes"
+	"regexp"
+	"strings"
+	"unicode"
 
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/yuin/goldmark"
@@ -52,3 +55,26 @@ func (r *MarkdownRenderer) Render(body string) string {
 	}
 	return r.sanitize.Sanitize(buf.String())
 }
+
+// rawHTMLPattern matches HTML comments and tags in markdown source. A tag
+// name must be followed by whitespace, "/" or ">", so autolinks such as
+// <https://example.com> and <me@example.com> are left alone.
+var rawHTMLPattern = regexp.MustCompile(`<!--[\s\S]*?(-->|$)|</?[A-Za-z][A-Za-z0-9-]*(\s[^>]*)?/?>`)
+
+// SanitizeMarkdownSource cleans user-submitted markdown before it is stored:
+// line endings are normalized, control characters dropped, raw HTML removed
+// and surrounding whitespace trimmed. Render would drop the HTML anyway;
+// removing it on write keeps the stored source (what edit forms round-trip)
+// the same as what readers see. HTML inside code spans goes too.
+func SanitizeMarkdownSource(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\r", "\n")
+	src = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			return -1
+		}
+		return r
+	}, src)
+	src = rawHTMLPattern.ReplaceAllString(src, "")
+	return strings.TrimSpace(src)
+}
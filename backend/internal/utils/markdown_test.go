@@ -0,0 +1,49 @@
+package utils
+
+import "testing"
+
+func TestSanitizeMarkdownSource(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "plain markdown is unchanged",
+			input: "# Loud Band\n\n**Loud** and _fast_, since 2009.\n\n> a quote\n\n- one\n- two",
+			want:  "# Loud Band\n\n**Loud** and _fast_, since 2009.\n\n> a quote\n\n- one\n- two",
+		},
+		{
+			name:  "raw HTML tags are removed, their text kept",
+			input: `Hi <script>alert("x")</script> <b onclick="x()">there</b><br/>`,
+			want:  `Hi alert("x") there`,
+		},
+		{
+			name:  "comments are removed, including unterminated ones",
+			input: "before <!-- hidden --> after <!-- never closed",
+			want:  "before  after",
+		},
+		{
+			name:  "autolinks and comparison signs survive",
+			input: "Mail <band@example.com> or see <https://example.com>; 1 < 2 > 0 & <3",
+			want:  "Mail <band@example.com> or see <https://example.com>; 1 < 2 > 0 & <3",
+		},
+		{
+			name:  "line endings are normalized and control characters dropped",
+			input: "  line one\r\nline\x00 two\rline\tthree\x1b  ",
+			want:  "line one\nline two\nline\tthree",
+		},
+		{
+			name:  "whitespace-only input becomes empty",
+			input: " \n\t ",
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeMarkdownSource(tt.input); got != tt.want {
+				t.Errorf("SanitizeMarkdownSource(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
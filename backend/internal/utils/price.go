@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultPriceCurrency is the ISO 4217 code assumed when a price names none.
+const DefaultPriceCurrency = "USD"
+
+// PriceRange is a ticket price parsed from free text. A single price has only
+// Min set. IsFree marks free or donation entry; Min/Max, if set, are then the
+// suggested donation.
+type PriceRange struct {
+	Min      *float64
+	Max      *float64
+	Currency string
+	IsFree   bool
+}
+
+// priceCurrencySymbols maps the currency marks seen in listings to ISO 4217.
+var priceCurrencySymbols = map[string]string{
+	"$":   "USD",
+	"us$": "USD",
+	"usd": "USD",
+	"ca$": "CAD",
+	"c$":  "CAD",
+	"cad": "CAD",
+	"€":   "EUR",
+	"eur": "EUR",
+	"£":   "GBP",
+	"gbp": "GBP",
+}
+
+var (
+	priceAmountPattern   = regexp.MustCompile(`\d+(?:\.\d{1,2})?`)
+	priceCurrencyPattern = regexp.MustCompile(`(?i)(us\$|ca\$|c\$|\$|€|£|\b(?:usd|cad|eur|gbp)\b)`)
+	priceFreePattern     = regexp.MustCompile(`(?i)\b(free|no cover|donation|donations|pay what you can|pwyc|sliding scale)\b`)
+)
+
+// ParsePrice parses a listing's price text: "$18", "15", "$12-$15",
+// "$12 adv / $15 dos", "Free", "No cover", "$5 suggested donation",
+// "€10". The lowest and highest amounts become Min and Max (Max only when
+// they differ). Free, donation and sliding-scale wording sets IsFree. Returns
+// false when the text has neither an amount nor free wording.
+func ParsePrice(s string) (PriceRange, bool) {
+	s = strings.TrimSpace(s)
+	out := PriceRange{Currency: DefaultPriceCurrency}
+	if s == "" {
+		return out, false
+	}
+
+	if m := priceCurrencyPattern.FindString(s); m != "" {
+		out.Currency = priceCurrencySymbols[strings.ToLower(m)]
+	}
+	out.IsFree = priceFreePattern.MatchString(s)
+
+	for _, m := range priceAmountPattern.FindAllString(s, -1) {
+		v, err := strconv.ParseFloat(m, 64)
+		if err != nil {
+			continue
+		}
+		if out.Min == nil || v < *out.Min {
+			out.Min = &v
+		}
+		if out.Max == nil || v > *out.Max {
+			out.Max = &v
+		}
+	}
+	if out.Min != nil && *out.Max == *out.Min {
+		out.Max = nil
+	}
+	// "$0" is a free show, not a zero-dollar ticket.
+	if out.Min != nil && *out.Min == 0 && out.Max == nil {
+		out.IsFree = true
+		out.Min = nil
+	}
+
+	return out, out.Min != nil || out.IsFree
+}
+
+// MaxPrice is the highest ticket price the API accepts, in any currency.
+const MaxPrice = 10000
+
+var priceCurrencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ValidatePrice checks a structured price: amounts between 0 and MaxPrice, a
+// max only alongside a min and not below it, and a three-letter uppercase
+// ISO 4217 currency code (empty means DefaultPriceCurrency).
+func ValidatePrice(min, max *float64, currency string) error {
+	if min != nil && (*min < 0 || *min > MaxPrice) {
+		return fmt.Errorf("price_min must be between 0 and %d", MaxPrice)
+	}
+	if max != nil {
+		if *max < 0 || *max > MaxPrice {
+			return fmt.Errorf("price_max must be between 0 and %d", MaxPrice)
+		}
+		if min == nil {
+			return fmt.Errorf("price_max requires price_min")
+		}
+		if *max < *min {
+			return fmt.Errorf("price_max must not be below price_min")
+		}
+	}
+	if currency != "" && !priceCurrencyCodePattern.MatchString(currency) {
+		return fmt.Errorf("price_currency must be a three-letter ISO 4217 code, e.g. USD")
+	}
+	return nil
+}
+
+// LegacyPrice converts the single price shows carried before ranges: 0 was
+// the only way to say free.
+func LegacyPrice(price float64) PriceRange {
+	out := PriceRange{Currency: DefaultPriceCurrency}
+	if price == 0 {
+		out.IsFree = true
+	} else {
+		out.Min = &price
+	}
+	return out
+}
+
+// priceCurrencyPrefixes is how FormatPrice writes the common currencies;
+// anything else is written as its code.
+var priceCurrencyPrefixes = map[string]string{
+	"USD": "$",
+	"CAD": "CA$",
+	"EUR": "€",
+	"GBP": "£",
+}
+
+// FormatPrice renders a structured price for emails and calendar text:
+// "$15", "$12–$15", "Free", "Free ($5 suggested)". Empty when the price is
+// unknown.
+func FormatPrice(min, max *float64, currency string, isFree bool) string {
+	amounts := ""
+	if min != nil {
+		prefix, ok := priceCurrencyPrefixes[currency]
+		if !ok {
+			if currency == "" {
+				prefix = priceCurrencyPrefixes[DefaultPriceCurrency]
+			} else {
+				prefix = currency + " "
+			}
+		}
+		amounts = prefix + formatPriceAmount(*min)
+		if max != nil && *max != *min {
+			amounts += "–" + prefix + formatPriceAmount(*max)
+		}
+	}
+	switch {
+	case isFree && amounts != "":
+		return "Free (" + amounts + " suggested)"
+	case isFree:
+		return "Free"
+	default:
+		return amounts
+	}
+}
+
+func formatPriceAmount(v float64) string {
+	if v == float64(int64(v)) {
+		return strconv.FormatInt(int64(v), 10)
+	}
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
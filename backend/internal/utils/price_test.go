@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestParsePrice(t *testing.T) {
+	num := func(v float64) *float64 { return &v }
+	tests := []struct {
+		input  string
+		want   PriceRange
+		wantOK bool
+	}{
+		{input: "", want: PriceRange{Currency: "USD"}},
+		{input: "TBA", want: PriceRange{Currency: "USD"}},
+		{input: "$18", want: PriceRange{Min: num(18), Currency: "USD"}, wantOK: true},
+		{input: "23.81", want: PriceRange{Min: num(23.81), Currency: "USD"}, wantOK: true},
+		{input: "$12-$15", want: PriceRange{Min: num(12), Max: num(15), Currency: "USD"}, wantOK: true},
+		{input: "$15 dos / $12 adv", want: PriceRange{Min: num(12), Max: num(15), Currency: "USD"}, wantOK: true},
+		{input: "Free", want: PriceRange{Currency: "USD", IsFree: true}, wantOK: true},
+		{input: "No Cover", want: PriceRange{Currency: "USD", IsFree: true}, wantOK: true},
+		{input: "$0", want: PriceRange{Currency: "USD", IsFree: true}, wantOK: true},
+		{input: "$5 suggested donation", want: PriceRange{Min: num(5), Currency: "USD", IsFree: true}, wantOK: true},
+		{input: "€10", want: PriceRange{Min: num(10), Currency: "EUR"}, wantOK: true},
+		{input: "CA$20-25", want: PriceRange{Min: num(20), Max: num(25), Currency: "CAD"}, wantOK: true},
+	}
+	for _, tt := range tests {
+		got, ok := ParsePrice(tt.input)
+		if ok != tt.wantOK {
+			t.Errorf("ParsePrice(%q) ok = %v, want %v", tt.input, ok, tt.wantOK)
+		}
+		if !equalPricePtr(got.Min, tt.want.Min) || !equalPricePtr(got.Max, tt.want.Max) ||
+			got.Currency != tt.want.Currency || got.IsFree != tt.want.IsFree {
+			t.Errorf("ParsePrice(%q) = %s, want %s", tt.input, formatPriceRange(got), formatPriceRange(tt.want))
+		}
+	}
+}
+
+func equalPricePtr(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func formatPriceRange(p PriceRange) string {
+	f := func(v *float64) string {
+		if v == nil {
+			return "nil"
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	}
+	return "{Min:" + f(p.Min) + " Max:" + f(p.Max) + " Currency:" + p.Currency + " IsFree:" + strconv.FormatBool(p.IsFree) + "}"
+}
+
+func TestValidatePrice(t *testing.T) {
+	num := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name     string
+		min, max *float64
+		currency string
+		wantErr  bool
+	}{
+		{name: "empty"},
+		{name: "single price", min: num(15)},
+		{name: "range", min: num(12), max: num(15), currency: "USD"},
+		{name: "equal range", min: num(12), max: num(12)},
+		{name: "negative min", min: num(-1), wantErr: true},
+		{name: "min too high", min: num(MaxPrice + 1), wantErr: true},
+		{name: "max without min", max: num(15), wantErr: true},
+		{name: "max below min", min: num(15), max: num(12), wantErr: true},
+		{name: "lowercase currency", min: num(10), currency: "usd", wantErr: true},
+		{name: "symbol currency", min: num(10), currency: "$", wantErr: true},
+	}
+	for _, tt := range tests {
+		err := ValidatePrice(tt.min, tt.max, tt.currency)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidatePrice err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestLegacyPrice(t *testing.T) {
+	if got := LegacyPrice(0); !got.IsFree || got.Min != nil {
+		t.Errorf("LegacyPrice(0) = %s, want free with no price", formatPriceRange(got))
+	}
+	if got := LegacyPrice(18); got.IsFree || got.Min == nil || *got.Min != 18 || got.Max != nil {
+		t.Errorf("LegacyPrice(18) = %s, want a single $18 price", formatPriceRange(got))
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	num := func(v float64) *float64 { return &v }
+	tests := []struct {
+		min, max *float64
+		currency string
+		isFree   bool
+		want     string
+	}{
+		{want: ""},
+		{min: num(15), currency: "USD", want: "$15"},
+		{min: num(12.5), max: num(15), currency: "USD", want: "$12.50–$15"},
+		{min: num(12), max: num(12), currency: "USD", want: "$12"},
+		{min: num(10), currency: "EUR", want: "€10"},
+		{min: num(10), currency: "JPY", want: "JPY 10"},
+		{min: num(10), want: "$10"},
+		{isFree: true, currency: "USD", want: "Free"},
+		{min: num(5), currency: "USD", isFree: true, want: "Free ($5 suggested)"},
+	}
+	for _, tt := range tests {
+		if got := FormatPrice(tt.min, tt.max, tt.currency, tt.isFree); got != tt.want {
+			t.Errorf("FormatPrice(%v, %v, %q, %v) = %q, want %q", tt.min, tt.max, tt.currency, tt.isFree, got, tt.want)
+		}
+	}
+}
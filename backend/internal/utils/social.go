@@ -0,0 +1,202 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// SocialHostSuffixes anchors each platform social field to its known hosts, so
+// a hostile value (e.g. https://evil.test/artist/x in the spotify field, which
+// renders as a SocialLinks href / embed source) can't be stored (PSY-1113). A
+// field absent here (website) accepts any host. A host matches when it equals a
+// base or is a subdomain of it — covering open.spotify.com, <artist>.bandcamp.com,
+// m.facebook.com, music.youtube.com, www.*, etc.
+//
+// This is a broad HOST floor for the free-form social fields. The dedicated
+// embed endpoints use the stricter, path-aware isValidBandcampURL /
+// isValidSpotifyURL (catalog/artist.go) — change platform-host rules with both
+// in mind.
+//
+// Redirector / short-link hosts (fb.me, t.co, youtube-nocookie.com) are
+// intentionally excluded: they can't be statically verified to land on-platform,
+// which is the point of the anchor. `website` is the escape hatch for any host.
+var SocialHostSuffixes = map[string][]string{
+	"instagram":  {"instagram.com"},
+	"facebook":   {"facebook.com", "fb.com"},
+	"twitter":    {"twitter.com", "x.com"},
+	"youtube":    {"youtube.com", "youtu.be"},
+	"spotify":    {"spotify.com"},
+	"soundcloud": {"soundcloud.com"},
+	"bandcamp":   {"bandcamp.com"},
+	"tiktok":     {"tiktok.com"},
+}
+
+// socialHandleForm is how a platform's bare handle ("@pilemusic") becomes its
+// canonical profile URL. Each pattern is the platform's own handle grammar; none
+// allows ':' or '/', so anything URL-shaped falls through to URL handling.
+type socialHandleForm struct {
+	pattern *regexp.Regexp
+	url     string // fmt pattern taking the handle
+}
+
+// socialHandleForms lists the platforms that accept a bare handle. The URL
+// shapes match what the edit forms and the seed exemplars already store.
+// Spotify has no handle form (profiles are keyed by opaque IDs) and website
+// has no platform to resolve a handle against.
+var socialHandleForms = map[string]socialHandleForm{
+	"instagram":  {instagramHandleRe, "https://instagram.com/%s"},
+	"facebook":   {regexp.MustCompile(`^[A-Za-z0-9.]{5,50}$`), "https://facebook.com/%s"},
+	"twitter":    {regexp.MustCompile(`^[A-Za-z0-9_]{1,15}$`), "https://twitter.com/%s"},
+	"youtube":    {regexp.MustCompile(`^[A-Za-z0-9._-]{3,30}$`), "https://youtube.com/@%s"},
+	"soundcloud": {regexp.MustCompile(`^[A-Za-z0-9_-]{3,25}$`), "https://soundcloud.com/%s"},
+	"bandcamp":   {regexp.MustCompile(`^[A-Za-z0-9-]{1,63}$`), "https://%s.bandcamp.com"},
+	"tiktok":     {regexp.MustCompile(`^[A-Za-z0-9._]{2,24}$`), "https://www.tiktok.com/@%s"},
+}
+
+// socialProfilePlatforms are the platforms whose profile URLs carry nothing in
+// the query string but share tracking (?igshid=, ?si=, ?ref=), so the whole
+// query is dropped. Facebook (profile.php?id=) and YouTube (watch?v=) keep
+// theirs, minus socialTrackingParams.
+var socialProfilePlatforms = map[string]bool{
+	"instagram":  true,
+	"twitter":    true,
+	"spotify":    true,
+	"soundcloud": true,
+	"bandcamp":   true,
+	"tiktok":     true,
+}
+
+// socialTrackingParams are query keys stripped from every social link. utm_*
+// is matched by prefix.
+var socialTrackingParams = map[string]bool{
+	"fbclid": true,
+	"gclid":  true,
+	"igshid": true,
+	"si":     true,
+}
+
+// spamLinkHosts are link shorteners and redirectors. They hide where a link
+// lands, which is how spam gets into free-form website fields, and a
+// destination URL is always available instead. Subdomains match too.
+var spamLinkHosts = []string{
+	"bit.ly",
+	"cutt.ly",
+	"goo.gl",
+	"is.gd",
+	"ow.ly",
+	"rebrand.ly",
+	"shorturl.at",
+	"t.co",
+	"tiny.cc",
+	"tinyurl.com",
+}
+
+// schemelessURLRe matches a URL typed without its scheme ("instagram.com/x",
+// "band.example.org"): a dotted host, an optional port, then a path or nothing.
+var schemelessURLRe = regexp.MustCompile(`^[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)+(:[0-9]+)?(/|$)`)
+
+// NormalizeSocialLink canonicalizes a user- or seed-supplied value for one of
+// the Social link fields (platform is the column name: "instagram", "tiktok",
+// "website", ...) and returns the form to store.
+//
+//   - A bare handle ("pilemusic", "@pilemusic") on a platform that has one
+//     becomes the profile URL ("https://instagram.com/pilemusic").
+//   - A URL typed without a scheme ("instagram.com/pilemusic") gets https://.
+//   - The URL must be http/https with a public domain host: IP literals,
+//     dotless hosts (localhost), embedded credentials and link shorteners
+//     (spamLinkHosts) are rejected. Platform fields must also stay on the
+//     platform's hosts (SocialHostSuffixes).
+//   - The host is lowercased, tracking parameters and a trailing slash are
+//     dropped, and platform links are upgraded to https without a fragment.
+//
+// A handle-shaped value is always read as a handle, so "evil.com" in the
+// instagram field becomes instagram.com/evil.com — it can only ever resolve
+// on-platform (see NormalizeInstagramHandle).
+//
+// Whitespace-only input normalizes to "" with no error, so callers keep their
+// "empty clears the field" semantics. fieldName is interpolated into errors,
+// as in ValidateHTTPURL.
+func NormalizeSocialLink(platform, raw, fieldName string) (string, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return "", nil
+	}
+	if !strings.Contains(s, "://") {
+		if form, ok := socialHandleForms[platform]; ok {
+			handle := strings.TrimPrefix(s, "@")
+			if form.pattern.MatchString(handle) && !isPlatformHost(platform, handle) {
+				s = fmt.Sprintf(form.url, handle)
+			}
+		}
+	}
+	if !strings.Contains(s, "://") && schemelessURLRe.MatchString(s) {
+		s = "https://" + s
+	}
+	if err := ValidateHTTPURL(s, fieldName); err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("%s must be a valid URL (got %q): %w", fieldName, s, err)
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("%s must not include a username or password (got %q)", fieldName, s)
+	}
+	host := strings.ToLower(u.Hostname())
+	if net.ParseIP(host) != nil || !strings.Contains(host, ".") {
+		return "", fmt.Errorf("%s must use a public domain name (got %q)", fieldName, s)
+	}
+	if hostMatches(host, spamLinkHosts) {
+		return "", fmt.Errorf("%s can't be a shortened link (got %q); use the page it leads to", fieldName, s)
+	}
+	if bases, restricted := SocialHostSuffixes[platform]; restricted && !hostMatches(host, bases) {
+		return "", fmt.Errorf("%s must be a link on %s", fieldName, strings.Join(bases, " or "))
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	if _, isPlatform := SocialHostSuffixes[platform]; isPlatform {
+		u.Scheme = "https"
+		u.Fragment, u.RawFragment = "", ""
+	}
+	if socialProfilePlatforms[platform] {
+		u.RawQuery = ""
+	} else if u.RawQuery != "" {
+		q := u.Query()
+		stripped := false
+		for key := range q {
+			if socialTrackingParams[key] || strings.HasPrefix(key, "utm_") {
+				q.Del(key)
+				stripped = true
+			}
+		}
+		// Re-encoding sorts and re-escapes, so only do it when something
+		// was removed.
+		if stripped {
+			u.RawQuery = q.Encode()
+		}
+	}
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = strings.TrimRight(u.RawPath, "/")
+	return u.String(), nil
+}
+
+// isPlatformHost reports whether s is one of platform's hosts, i.e. a URL
+// typed without scheme or path ("instagram.com") rather than a handle.
+func isPlatformHost(platform, s string) bool {
+	return hostMatches(strings.ToLower(s), SocialHostSuffixes[platform])
+}
+
+// hostMatches reports whether host equals one of bases or is a subdomain of
+// one. host must already be lowercase.
+func hostMatches(host string, bases []string) bool {
+	for _, base := range bases {
+		if host == base || strings.HasSuffix(host, "."+base) {
+			return true
+		}
+	}
+	return false
+}
@@ -15,6 +15,7 @@ export interface ScrapedEvent {
   ageRestriction?: string
   isSoldOut?: boolean
   isCancelled?: boolean
+  genres?: string[] // listing genres; the backend keeps those in its genre taxonomy
 }
 
 // Preview event (quick scan without details)
@@ -82,7 +83,10 @@ export interface ImportStatusEntry {
   showId?: number
   status?: string // pending, approved, rejected
   currentData?: {
-    price?: number
+    price?: number // lowest price, 0 when free
+    priceMin?: number
+    priceMax?: number
+    isFree?: boolean
     ageRequirement?: string
     description?: string
     eventDate?: string
@@ -145,7 +149,11 @@ export interface ExportedShow {
   eventDate: string
   city?: string
   state?: string
-  price?: number
+  price?: number // lowest price, 0 when free; read only when priceMin is absent
+  priceMin?: number
+  priceMax?: number
+  priceCurrency?: string
+  isFree?: boolean
   ageRequirement?: string
   description?: string
   status: string
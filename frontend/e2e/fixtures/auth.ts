@@ -1,7 +1,7 @@
 import { test as base } from './error-detection'
 import { type Page, type BrowserContext } from '@playwright/test'
 import * as path from 'path'
-import { USER_COUNT, userAuthFileForWorker } from '../global-setup'
+import { seededUserIndex, userAuthFileForWorker } from '../global-setup'
 import { resetTestFixtures, lookupWorkerUserId } from './test-fixtures-reset'
 
 const AUTH_DIR = path.resolve(__dirname, '../.auth')
@@ -17,11 +17,9 @@ const AUTH_DIR = path.resolve(__dirname, '../.auth')
  *
  * PSY-462: Playwright retries can spawn workers whose `workerIndex`
  * exceeds the seeded pool (retry #2 on a 5-worker run yielded
- * workerIndex=5). Modulo the index over USER_COUNT so retry workers
- * fall back to an already-seeded auth file instead of ENOENT-ing on
- * `user-5.json`. This is race-free in practice: the original worker's
- * test has already finished by the time Playwright spins up a retry
- * worker, so no two live workers share a user at the same instant.
+ * workerIndex=5). Users are picked by `parallelIndex` instead (see
+ * `seededUserIndex`), which stays below the worker count and is reused
+ * by the replacement worker, so no two live workers share a user.
  *
  * PSY-432: `workerCleanup` is a worker-scoped fixture whose teardown
  * calls the admin-only `/admin/test-fixtures/reset` endpoint for this
@@ -53,7 +51,7 @@ export const test = base.extend<
   // cleanup hiccup to mask a real test failure.
   workerCleanup: [
     async ({}, use, workerInfo) => {
-      const seededIndex = workerInfo.workerIndex % USER_COUNT
+      const seededIndex = seededUserIndex(workerInfo.parallelIndex)
       const authFile = userAuthFileForWorker(seededIndex)
 
       let workerUserId: number | null = null
@@ -85,7 +83,7 @@ export const test = base.extend<
     runFixture,
     testInfo,
   ) => {
-    const seededIndex = testInfo.workerIndex % USER_COUNT
+    const seededIndex = seededUserIndex(testInfo.parallelIndex)
     const authFile = userAuthFileForWorker(seededIndex)
     const context: BrowserContext = await browser.newContext({
       storageState: path.join(AUTH_DIR, authFile),
@@ -101,7 +99,7 @@ export const test = base.extend<
   // failing mutating test start from a clean slate instead of compounding
   // state across attempts. Reuses the PSY-432 allowlist scopes verbatim.
   cleanBetweenRetries: async ({}, use, testInfo) => {
-    const seededIndex = testInfo.workerIndex % USER_COUNT
+    const seededIndex = seededUserIndex(testInfo.parallelIndex)
     const authFile = userAuthFileForWorker(seededIndex)
 
     let workerUserId: number | null = null
@@ -10,6 +10,13 @@ function log(msg: string) {
 }
 
 export default async function globalTeardown() {
+  // Sharded local runs share one environment; the test runner tears it
+  // down after the last shard (see E2E_SHARED_ENV in global-setup.ts).
+  if (process.env.E2E_SHARED_ENV) {
+    log('Shared environment; leaving teardown to the test runner.')
+    return
+  }
+
   log('Starting E2E global teardown...')
 
   // 1. Kill the backend process
@@ -4,7 +4,7 @@ import {
   resetTestFixtures,
   lookupWorkerUserId,
 } from '../fixtures/test-fixtures-reset'
-import { USER_COUNT, userAuthFileForWorker } from '../global-setup'
+import { seededUserIndex, userAuthFileForWorker } from '../global-setup'
 
 // Auth-gated buttons (FollowButton, SaveButton) redirect to /auth
 // when useAuthContext returns isAuthenticated=false at click time. The
@@ -50,7 +50,7 @@ test.describe('Follow and save', () => {
   let workerUserId: number | null = null
 
   test.beforeAll(async ({}, testInfo) => {
-    const seededIndex = testInfo.workerIndex % USER_COUNT
+    const seededIndex = seededUserIndex(testInfo.parallelIndex)
     workerUserId = await lookupWorkerUserId(userAuthFileForWorker(seededIndex))
   })
 
@@ -500,8 +500,9 @@ BCRYPT_HASH='$2a$10$h7GdGcX7SxMFQCohXdTQnuVkygj7RPCcPhPrPMgHkWr50w.Fv0XoW'
 
 psql -v ON_ERROR_STOP=1 "$E2E_DB_URL" <<SQL
 -- Regular test users: one per Playwright worker to avoid mutation races (PSY-431).
--- Worker 0 uses e2e-user@test.local (legacy); workers 1-4 use numbered variants.
--- Seeded count (5) >= max local worker count; CI uses 3 workers.
+-- Worker 0 uses e2e-user@test.local (legacy); workers 1-9 use numbered variants.
+-- Seeded count (10) matches USER_COUNT in global-setup.ts: a single run uses
+-- up to 5 (the local worker cap; CI uses 3), sharded local runs split all 10.
 --
 -- PSY-456: user_tier = 'contributor' so new comments publish as 'visible'
 -- immediately (new_user tier -> 'pending_review'). Without this, the
@@ -513,7 +514,12 @@ VALUES
   ('e2e-user-1@test.local', '${BCRYPT_HASH}', 'Test', 'User 1', true, false, true, 'contributor', NOW(), NOW()),
   ('e2e-user-2@test.local', '${BCRYPT_HASH}', 'Test', 'User 2', true, false, true, 'contributor', NOW(), NOW()),
   ('e2e-user-3@test.local', '${BCRYPT_HASH}', 'Test', 'User 3', true, false, true, 'contributor', NOW(), NOW()),
-  ('e2e-user-4@test.local', '${BCRYPT_HASH}', 'Test', 'User 4', true, false, true, 'contributor', NOW(), NOW())
+  ('e2e-user-4@test.local', '${BCRYPT_HASH}', 'Test', 'User 4', true, false, true, 'contributor', NOW(), NOW()),
+  ('e2e-user-5@test.local', '${BCRYPT_HASH}', 'Test', 'User 5', true, false, true, 'contributor', NOW(), NOW()),
+  ('e2e-user-6@test.local', '${BCRYPT_HASH}', 'Test', 'User 6', true, false, true, 'contributor', NOW(), NOW()),
+  ('e2e-user-7@test.local', '${BCRYPT_HASH}', 'Test', 'User 7', true, false, true, 'contributor', NOW(), NOW()),
+  ('e2e-user-8@test.local', '${BCRYPT_HASH}', 'Test', 'User 8', true, false, true, 'contributor', NOW(), NOW()),
+  ('e2e-user-9@test.local', '${BCRYPT_HASH}', 'Test', 'User 9', true, false, true, 'contributor', NOW(), NOW())
 ON CONFLICT (email) DO NOTHING;
 
 -- Admin test user (single; admin tests are rare and low-race-risk)
@@ -548,8 +554,8 @@ VALUES ('e2e-oauth@test.local', '${BCRYPT_HASH}', 'Test', 'OAuth', true, false,
 ON CONFLICT (email) DO NOTHING;
 
 -- Create user_preferences for all seeded test users (regular worker users + admin + unverified + recovery + oauth)
-INSERT INTO user_preferences (user_id, notification_email, notification_push, show_reminders, theme, timezone, language, created_at, updated_at)
-SELECT id, true, false, false, 'system', 'America/Phoenix', 'en', NOW(), NOW()
+INSERT INTO user_preferences (user_id, show_reminders, theme, timezone, language, created_at, updated_at)
+SELECT id, false, 'system', 'America/Phoenix', 'en', NOW(), NOW()
 FROM users
 WHERE email LIKE 'e2e-user%@test.local'
    OR email IN ('e2e-admin@test.local', 'e2e-unverified@test.local', 'e2e-recovery@test.local', 'e2e-oauth@test.local')
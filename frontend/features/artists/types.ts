@@ -163,7 +163,12 @@ export interface ArtistShow {
   slug: string
   title: string
   event_date: string
+  /** Lowest price, 0 when free. */
   price: number | null
+  price_min?: number | null
+  price_max?: number | null
+  price_currency?: string
+  is_free?: boolean
   age_requirement: string | null
   venue: ArtistShowVenue | null
   artists: ArtistShowArtist[]
@@ -432,6 +432,7 @@ describe('useOwnContributorProfile', () => {
         saved_shows: 'count_only',
         following: 'hidden',
         collections: 'visible',
+        submitted_shows: 'visible',
         last_active: 'visible',
         profile_sections: 'visible',
       },
@@ -682,6 +683,7 @@ describe('useUpdatePrivacy', () => {
         saved_shows: 'hidden',
         following: 'hidden',
         collections: 'visible',
+        submitted_shows: 'visible',
         last_active: 'hidden',
         profile_sections: 'visible',
       },
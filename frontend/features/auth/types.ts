@@ -21,6 +21,7 @@ export interface PrivacySettings {
   saved_shows: PrivacyLevel
   following: PrivacyLevel
   collections: PrivacyLevel
+  submitted_shows: PrivacyLevel
   last_active: 'visible' | 'hidden'
   profile_sections: 'visible' | 'hidden'
 }
@@ -152,6 +153,7 @@ export interface UpdatePrivacyInput {
   saved_shows?: PrivacyLevel
   following?: PrivacyLevel
   collections?: PrivacyLevel
+  submitted_shows?: PrivacyLevel
   last_active?: 'visible' | 'hidden'
   profile_sections?: 'visible' | 'hidden'
 }
@@ -304,6 +304,10 @@ export const EDITABLE_FIELDS: Record<EditableEntityType, EditableField[]> = {
     { key: 'zipcode', label: 'Zipcode', type: 'text', group: 'info' },
     { key: 'image_url', label: 'Image URL', type: 'url', placeholder: 'https://...', group: 'info' },
     { key: 'description', label: 'Description', type: 'textarea', group: 'details' },
+    { key: 'capacity', label: 'Capacity', type: 'text', placeholder: '250', group: 'details' },
+    { key: 'all_ages', label: 'All Ages', type: 'text', placeholder: 'true or false', group: 'details' },
+    { key: 'door_time', label: 'Typical Door Time', type: 'text', placeholder: '19:00', group: 'details' },
+    { key: 'accessibility_notes', label: 'Accessibility Notes', type: 'textarea', group: 'details' },
     { key: 'instagram', label: 'Instagram', type: 'url', placeholder: 'https://instagram.com/...', group: 'social' },
     { key: 'facebook', label: 'Facebook', type: 'url', placeholder: 'https://facebook.com/...', group: 'social' },
     { key: 'twitter', label: 'X / Twitter', type: 'url', placeholder: 'https://x.com/...', group: 'social' },
@@ -9,6 +9,7 @@ const basePrivacySettings: PrivacySettings = {
   saved_shows: 'visible',
   following: 'visible',
   collections: 'visible',
+  submitted_shows: 'visible',
   last_active: 'visible',
   profile_sections: 'visible',
 }
@@ -392,6 +393,7 @@ describe('PrivacySettingsPanel', () => {
         saved_shows: 'visible',
         following: 'visible',
         collections: 'visible',
+        submitted_shows: 'visible',
         last_active: 'visible',
         profile_sections: 'visible',
       })
@@ -46,6 +46,11 @@ const privacyFields: {
     label: 'Collections',
     description: 'Your public collections',
   },
+  {
+    key: 'submitted_shows',
+    label: 'Submitted shows',
+    description: 'Approved shows you submitted',
+  },
 ]
 
 const binaryPrivacyFields: {
@@ -201,6 +206,7 @@ export function PrivacySettingsPanel() {
       saved_shows: localPrivacy.saved_shows,
       following: localPrivacy.following,
       collections: localPrivacy.collections,
+      submitted_shows: localPrivacy.submitted_shows,
       last_active: localPrivacy.last_active,
       profile_sections: localPrivacy.profile_sections,
     }
@@ -43,7 +43,7 @@ function advancementFor(
       next_tier: 'contributor',
       requirements: [
         { requirement: 'approved_edits', current: 2, threshold: 5, met: false },
-        { requirement: 'account_age_days', current: 10, threshold: 14, met: false },
+        { requirement: 'account_age_days', current: 10, threshold: 30, met: false },
         { requirement: 'email_verified', met: true },
       ],
     },
@@ -95,7 +95,7 @@ describe('TierAdvancementCard', () => {
     expect(screen.getByText('Contributor')).toBeInTheDocument()
     expect(screen.getByText('Requirements')).toBeInTheDocument()
     expect(screen.getByText(/5 approved edits/i)).toBeInTheDocument()
-    expect(screen.getByText(/14 days/i)).toBeInTheDocument()
+    expect(screen.getByText(/30 days/i)).toBeInTheDocument()
     expect(screen.getByText(/Verified email/i)).toBeInTheDocument()
   })
 
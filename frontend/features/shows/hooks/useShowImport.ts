@@ -12,6 +12,7 @@ import type { ShowResponse } from '../types'
 export interface ExportShowData {
   title: string
   event_date: string
+  doors_time?: string
   city?: string
   state?: string
   price?: number
@@ -27,6 +27,7 @@ export interface ArtistResponse {
   is_headliner?: boolean | null
   set_type: SetType
   position: number
+  set_time?: string // ISO date string; the lineup is ordered by it when present
   is_new_artist?: boolean | null
   bandcamp_embed_url?: string | null
   socials: ShowArtistSocials
@@ -53,24 +54,47 @@ export interface VenueResponse {
  */
 export type ShowStatus = 'pending' | 'approved' | 'rejected' | 'private'
 
+/** A genre on a show, derived from its lineup's artists. */
+export interface ShowGenre {
+  id: number
+  name: string
+  slug: string
+}
+
 export interface ShowResponse {
   id: number
   slug: string
   title: string
   event_date: string // ISO date string
+  doors_time?: string // ISO date string
+  /** IANA zone of the primary venue; the *_local fields are RFC3339 in it. */
+  timezone?: string
+  event_date_local?: string
+  doors_time_local?: string
   city?: string | null
   state?: string | null
+  /** Lowest price, 0 when free: the single pre-range value. */
   price?: number | null
+  price_min?: number | null
+  price_max?: number | null
+  /** ISO 4217 code. */
+  price_currency?: string
+  /** Free or donation entry; price_min/price_max are then the suggested donation. */
+  is_free?: boolean
   age_requirement?: string | null
   description?: string | null
   ticket_url?: string | null
   image_url?: string | null
+  flyer_url?: string
+  flyer_thumbnail_url?: string
   status: ShowStatus
   submitted_by?: number
   rejection_reason?: string | null
   rejection_category?: string | null
   venues: VenueResponse[]
   artists: ArtistResponse[]
+  /** Lineup genres, most widely shared first (at most 5). */
+  genres?: ShowGenre[]
   created_at: string
   updated_at: string
   // Status flags (admin-controlled)
@@ -17,10 +17,17 @@ export interface Venue {
   state: string
   /** IANA timezone resolved from the venue's location (PSY-985). Null until backfilled. */
   timezone?: string | null
+  /** True when an admin set timezone by hand; relocations leave it alone. */
+  timezone_manual?: boolean
   zipcode?: string | null
   description?: string | null
   /** Optional venue photo URL (PSY-521). */
   image_url?: string | null
+  capacity?: number | null
+  /** Operating info. Null means unknown; door_time is local 24h "HH:MM". */
+  accessibility_notes?: string | null
+  all_ages?: boolean | null
+  door_time?: string | null
   verified: boolean
   submitted_by?: number | null
   social?: {
@@ -69,7 +76,12 @@ export interface VenueShow {
   event_date: string
   city: string | null
   state: string | null
+  /** Lowest price, 0 when free. */
   price: number | null
+  price_min?: number | null
+  price_max?: number | null
+  price_currency?: string
+  is_free?: boolean
   age_requirement: string | null
   artists: ArtistResponse[]
 }
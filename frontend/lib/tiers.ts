@@ -47,8 +47,9 @@ export const TIERS: TierInfo[] = [
     advancementFrom: 'new_user',
     advancementRequirements: [
       { id: 'approved_edits', text: '5 approved edits' },
-      { id: 'account_age_days', text: 'Account age at least 14 days' },
+      { id: 'account_age_days', text: 'Account age at least 30 days' },
       { id: 'email_verified', text: 'Verified email address' },
+      { id: 'no_upheld_reports', text: 'No upheld reports on your content' },
     ],
   },
   {
@@ -66,6 +67,7 @@ export const TIERS: TierInfo[] = [
       { id: 'approved_edits', text: '25 approved edits' },
       { id: 'approval_rate', text: 'At least 95% approval rate' },
       { id: 'account_age_days', text: 'Account age at least 60 days' },
+      { id: 'no_upheld_reports', text: 'No upheld reports on your content' },
     ],
   },
   {
@@ -82,6 +84,7 @@ export const TIERS: TierInfo[] = [
       { id: 'approved_edits', text: '50 approved edits' },
       { id: 'city_edits', text: '10 approved edits on venues or artists' },
       { id: 'account_age_days', text: 'Account age at least 180 days' },
+      { id: 'no_upheld_reports', text: 'No upheld reports on your content' },
     ],
   },
 ]
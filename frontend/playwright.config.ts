@@ -5,8 +5,9 @@ export default defineConfig({
   fullyParallel: true,
   forbidOnly: !!process.env.CI,
   retries: process.env.CI ? 2 : 0,
-  // Cap local workers to the seeded user count (see USER_COUNT in
-  // e2e/global-setup.ts). CI stays at 3; headroom lives in the 5 seeded users.
+  // Cap local workers at 5 of the 10 seeded users (see USER_COUNT in
+  // e2e/global-setup.ts); sharded local runs (`bun run test-all
+  // --e2e-shards N`) spread all 10 across shards. CI stays at 3.
   workers: process.env.CI ? 3 : 5,
   // Blob reporter in CI so sharded runs can be merged via `playwright
   // merge-reports` (see `.github/workflows/ci.yml`, PSY-418). HTML locally
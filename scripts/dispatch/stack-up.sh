@@ -182,6 +182,7 @@ nohup env \
   DISABLE_CLEANUP=1 \
   DISABLE_REMINDERS=1 \
   DISABLE_RELATIONSHIP_DERIVATION=1 \
+  DISABLE_DISCOVERY_SOURCE_MONITOR=1 \
   DISABLE_AUTH_RATE_LIMITS=1 \
   SESSION_SECURE=false \
   SESSION_SAME_SITE=lax \
@@ -0,0 +1,673 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ── Suite configuration ──────────────────────────────────────────────
+//
+// .testrunner.yaml at the project root lists the suites to run:
+//
+//	suites:
+//	  - name: lint
+//	    dir: frontend
+//	    command: bun run lint
+//	  - name: backend
+//	    timeout: 10m
+//	  - name: e2e
+//	    depends_on: [frontend]
+//
+// A suite with a command runs it (a string through sh -c, a list as argv)
+// in dir, relative to the project root. The built-in suites (backend,
+// frontend, e2e) keep their own commands, reporters, --packages and
+// sharding; for those only env, depends_on and timeout can be set. Without
+// the file the built-in suites run as before.
+//
+// A suite with depends_on starts once those suites have passed, and fails
+// without running if one of them failed. Dependencies that aren't part of
+// the run (--suites, --failed, watch mode) are ignored. timeout kills the
+// suite's processes once it has run that long.
+//
+// The runner stays dependency-free, so the file is read with a parser for
+// the subset of YAML a suite list needs: block mappings and sequences,
+// [a, b] lists, quoted and plain scalars, and # comments.
+
+const configFile = ".testrunner.yaml"
+
+// builtinSuites are the suites defined in main, in their default order.
+var builtinSuites = []string{"backend", "frontend", "e2e"}
+
+// builtinKeys are the keys a built-in suite accepts.
+var builtinKeys = map[string]bool{"name": true, "env": true, "depends_on": true, "timeout": true}
+
+var suiteKeys = []string{"name", "dir", "command", "env", "depends_on", "timeout"}
+
+// suiteDef is one suite as configured.
+type suiteDef struct {
+	name    string
+	builtin bool
+	dir     string
+	command []string // argv; empty for built-in suites
+	env     []string // KEY=value
+	deps    []string
+	timeout time.Duration
+}
+
+type runnerConfig struct {
+	path   string // "" when the defaults are used
+	suites []*suiteDef
+}
+
+// runnerCfg is the loaded configuration.
+var runnerCfg = defaultConfig()
+
+func defaultConfig() *runnerConfig {
+	c := &runnerConfig{}
+	for _, name := range builtinSuites {
+		c.suites = append(c.suites, &suiteDef{name: name, builtin: true})
+	}
+	return c
+}
+
+// suite returns the configured suite, or nil.
+func (c *runnerConfig) suite(name string) *suiteDef {
+	for _, s := range c.suites {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// names lists the configured suites.
+func (c *runnerConfig) names() []string {
+	names := make([]string, 0, len(c.suites))
+	for _, s := range c.suites {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+// dependsOn returns the suites a top-level suite waits for.
+func (c *runnerConfig) dependsOn(group string) []string {
+	if s := c.suite(group); s != nil {
+		return s.deps
+	}
+	return nil
+}
+
+// suiteTimeout is the timeout of the top-level suite a suite belongs to, or
+// zero for none.
+func suiteTimeout(name string) time.Duration {
+	if s := runnerCfg.suite(suiteGroup(name)); s != nil {
+		return s.timeout
+	}
+	return 0
+}
+
+// suiteEnv is the configured environment of a top-level suite.
+func suiteEnv(name string) []string {
+	if s := runnerCfg.suite(name); s != nil {
+		return s.env
+	}
+	return nil
+}
+
+// loadConfig reads .testrunner.yaml, falling back to the built-in suites
+// when there is none. Every problem found is reported, one per line.
+func loadConfig(projectRoot string) (*runnerConfig, error) {
+	path := filepath.Join(projectRoot, configFile)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return defaultConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	root, err := parseYAML(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s:%v", configFile, err)
+	}
+	c, errs := decodeConfig(root, projectRoot)
+	if len(errs) > 0 {
+		lines := make([]string, len(errs))
+		for i, e := range errs {
+			lines[i] = configFile + ":" + e
+		}
+		return nil, fmt.Errorf("%s", strings.Join(lines, "\n"))
+	}
+	c.path = path
+	return c, nil
+}
+
+// decodeConfig validates the parsed file. Errors are "line: message".
+func decodeConfig(root *yamlNode, projectRoot string) (*runnerConfig, []string) {
+	var errs []string
+	fail := func(n *yamlNode, format string, args ...any) {
+		errs = append(errs, fmt.Sprintf("%d: %s", n.line, fmt.Sprintf(format, args...)))
+	}
+
+	c := &runnerConfig{}
+	if root == nil {
+		return nil, []string{"1: the file is empty; it needs a suites list"}
+	}
+	if root.kind != yamlMap {
+		fail(root, "expected a mapping with a suites key")
+		return nil, errs
+	}
+	var list *yamlNode
+	for i, key := range root.keys {
+		if key != "suites" {
+			fail(root.vals[i], "unknown top-level key %q (want suites)", key)
+			continue
+		}
+		list = root.vals[i]
+	}
+	if list == nil {
+		if len(errs) == 0 {
+			fail(root, "missing suites list")
+		}
+		return nil, errs
+	}
+	if list.kind != yamlList || len(list.items) == 0 {
+		fail(list, "suites must be a non-empty list")
+		return nil, errs
+	}
+
+	seen := map[string]int{}
+	for i, item := range list.items {
+		where := fmt.Sprintf("suites[%d]", i)
+		if item.kind != yamlMap {
+			fail(item, "%s: expected a mapping with name and command", where)
+			continue
+		}
+		d := &suiteDef{dir: "."}
+		nameNode := item.get("name")
+		if nameNode == nil || nameNode.kind != yamlScalar || nameNode.value == "" {
+			fail(item, "%s: missing name", where)
+			continue
+		}
+		d.name = nameNode.value
+		where = fmt.Sprintf("suite %q", d.name)
+		if err := checkSuiteName(d.name); err != "" {
+			fail(nameNode, "%s: %s", where, err)
+		}
+		if line, dup := seen[d.name]; dup {
+			fail(nameNode, "%s: already defined on line %d", where, line)
+		}
+		seen[d.name] = nameNode.line
+		for _, b := range builtinSuites {
+			d.builtin = d.builtin || d.name == b
+		}
+
+		for k, key := range item.keys {
+			v := item.vals[k]
+			if !contains(suiteKeys, key) {
+				fail(v, "%s: unknown key %q (want %s)", where, key, strings.Join(suiteKeys, ", "))
+				continue
+			}
+			if d.builtin && !builtinKeys[key] {
+				fail(v, "%s: %s is a built-in suite, so only env, depends_on and timeout can be set; rename it to define your own", where, d.name)
+				continue
+			}
+			switch key {
+			case "dir":
+				if v.kind != yamlScalar || v.value == "" {
+					fail(v, "%s: dir must be a path relative to the project root", where)
+					continue
+				}
+				d.dir = filepath.Clean(v.value)
+				if filepath.IsAbs(d.dir) || d.dir == ".." || strings.HasPrefix(d.dir, "../") {
+					fail(v, "%s: dir %q must be inside the project", where, v.value)
+					continue
+				}
+				if info, err := os.Stat(filepath.Join(projectRoot, d.dir)); err != nil || !info.IsDir() {
+					fail(v, "%s: dir %q doesn't exist", where, v.value)
+				}
+			case "command":
+				switch {
+				case v.kind == yamlScalar && strings.TrimSpace(v.value) != "":
+					d.command = []string{"sh", "-c", v.value}
+				case v.kind == yamlList && len(v.items) > 0:
+					for _, arg := range v.items {
+						if arg.kind != yamlScalar {
+							fail(arg, "%s: command arguments must be strings", where)
+						}
+						d.command = append(d.command, arg.value)
+					}
+				default:
+					fail(v, "%s: command must be a string or a non-empty list", where)
+				}
+			case "env":
+				if v.kind != yamlMap {
+					fail(v, "%s: env must be a mapping of variable names to values", where)
+					continue
+				}
+				for e, name := range v.keys {
+					val := v.vals[e]
+					if val.kind == yamlList || val.kind == yamlMap {
+						fail(val, "%s: env %s must be a string", where, name)
+						continue
+					}
+					d.env = append(d.env, name+"="+val.value)
+				}
+			case "depends_on":
+				switch v.kind {
+				case yamlScalar:
+					d.deps = []string{v.value}
+				case yamlList:
+					for _, dep := range v.items {
+						if dep.kind != yamlScalar {
+							fail(dep, "%s: depends_on must list suite names", where)
+							continue
+						}
+						d.deps = append(d.deps, dep.value)
+					}
+				default:
+					fail(v, "%s: depends_on must be a suite name or a list of them", where)
+				}
+			case "timeout":
+				t, err := time.ParseDuration(v.value)
+				if v.kind != yamlScalar || err != nil || t <= 0 {
+					fail(v, "%s: timeout must be a duration such as 90s or 10m", where)
+					continue
+				}
+				d.timeout = t
+			}
+		}
+		if !d.builtin && len(d.command) == 0 && item.get("command") == nil {
+			fail(item, "%s: missing command", where)
+		}
+		c.suites = append(c.suites, d)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	for i, d := range c.suites {
+		for _, dep := range d.deps {
+			switch {
+			case dep == d.name:
+				fail(list.items[i], "suite %q depends on itself", d.name)
+			case c.suite(dep) == nil:
+				fail(list.items[i], "suite %q depends on unknown suite %q (defined: %s)", d.name, dep, strings.Join(c.names(), ", "))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		if cycle := c.dependencyCycle(); cycle != nil {
+			fail(list.items[c.index(cycle[0])], "dependency cycle: %s", strings.Join(cycle, " → "))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return c, nil
+}
+
+// checkSuiteName returns why a suite name is invalid, or "". Slashes are
+// reserved for sub-suites (Go packages, e2e shards).
+func checkSuiteName(name string) string {
+	if strings.Contains(name, "/") {
+		return "names can't contain \"/\""
+	}
+	if strings.ContainsAny(name, " ,\t") {
+		return "names can't contain spaces or commas"
+	}
+	return ""
+}
+
+func (c *runnerConfig) index(name string) int {
+	for i, s := range c.suites {
+		if s.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// dependencyCycle returns a cycle in depends_on, first suite repeated at
+// the end, or nil.
+func (c *runnerConfig) dependencyCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var stack []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, dep := range c.suite(name).deps {
+			switch state[dep] {
+			case visiting:
+				for i, s := range stack {
+					if s == dep {
+						return append(append([]string(nil), stack[i:]...), dep)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, s := range c.suites {
+		if state[s.name] == unvisited {
+			if cycle := visit(s.name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// customSuiteConfig is how a configured (non-built-in) suite runs.
+func customSuiteConfig(d *suiteDef) suiteConfig {
+	return suiteConfig{name: d.name, dir: d.dir, command: d.command[0], args: d.command[1:], env: d.env}
+}
+
+// ── YAML subset ──────────────────────────────────────────────────────
+
+type yamlKind int
+
+const (
+	yamlScalar yamlKind = iota
+	yamlList
+	yamlMap
+)
+
+type yamlNode struct {
+	line  int
+	kind  yamlKind
+	value string // scalars
+	items []*yamlNode
+	keys  []string // mappings, in file order
+	vals  []*yamlNode
+}
+
+// get returns a mapping's value for key, or nil.
+func (n *yamlNode) get(key string) *yamlNode {
+	for i, k := range n.keys {
+		if k == key {
+			return n.vals[i]
+		}
+	}
+	return nil
+}
+
+type yamlLine struct {
+	num    int
+	indent int
+	text   string // without indentation and comment
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML parses the supported subset. An empty document is nil. Errors
+// are prefixed with the line number.
+func parseYAML(src string) (*yamlNode, error) {
+	p := &yamlParser{}
+	for i, raw := range strings.Split(src, "\n") {
+		raw = strings.TrimRight(raw, " \r")
+		trimmed := strings.TrimLeft(raw, " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("%d: tabs can't be used for indentation", i+1)
+		}
+		text := strings.TrimSpace(stripComment(trimmed))
+		if text == "" || text == "---" {
+			continue
+		}
+		if text == "..." || strings.HasPrefix(text, "%") {
+			return nil, fmt.Errorf("%d: multiple documents and directives aren't supported", i+1)
+		}
+		p.lines = append(p.lines, yamlLine{num: i + 1, indent: len(raw) - len(trimmed), text: text})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+	n, err := p.block(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("%d: unexpected indentation", p.lines[p.pos].num)
+	}
+	return n, nil
+}
+
+func (p *yamlParser) block(indent int) (*yamlNode, error) {
+	if isSeqItem(p.lines[p.pos].text) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) sequence(indent int) (*yamlNode, error) {
+	n := &yamlNode{line: p.lines[p.pos].num, kind: yamlList}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("%d: unexpected indentation", l.num)
+		}
+		if !isSeqItem(l.text) {
+			break // the parent mapping's next key
+		}
+		content := strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " ")
+		if content == "" {
+			p.pos++
+			if p.pos >= len(p.lines) || p.lines[p.pos].indent <= indent {
+				n.items = append(n.items, &yamlNode{line: l.num, kind: yamlScalar})
+				continue
+			}
+			item, err := p.block(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			n.items = append(n.items, item)
+			continue
+		}
+		if _, _, ok := splitKey(content); ok || isSeqItem(content) {
+			// "- key: value" opens a mapping at the column of key, and
+			// "- - x" a nested list.
+			p.lines[p.pos] = yamlLine{num: l.num, indent: indent + len(l.text) - len(content), text: content}
+			item, err := p.block(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			n.items = append(n.items, item)
+			continue
+		}
+		item, err := parseValue(content, l.num)
+		if err != nil {
+			return nil, err
+		}
+		n.items = append(n.items, item)
+		p.pos++
+	}
+	return n, nil
+}
+
+func (p *yamlParser) mapping(indent int) (*yamlNode, error) {
+	n := &yamlNode{line: p.lines[p.pos].num, kind: yamlMap}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("%d: unexpected indentation", l.num)
+		}
+		if isSeqItem(l.text) {
+			return nil, fmt.Errorf("%d: unexpected list item; did you mean to indent it under a key?", l.num)
+		}
+		key, rest, ok := splitKey(l.text)
+		if !ok {
+			return nil, fmt.Errorf("%d: expected \"key: value\"", l.num)
+		}
+		if n.get(key) != nil {
+			return nil, fmt.Errorf("%d: duplicate key %q", l.num, key)
+		}
+		p.pos++
+
+		var val *yamlNode
+		switch {
+		case rest != "":
+			v, err := parseValue(rest, l.num)
+			if err != nil {
+				return nil, err
+			}
+			val = v
+		case p.pos < len(p.lines) && p.lines[p.pos].indent > indent:
+			v, err := p.block(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			val = v
+		case p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSeqItem(p.lines[p.pos].text):
+			// A list may sit at its key's indentation.
+			v, err := p.sequence(indent)
+			if err != nil {
+				return nil, err
+			}
+			val = v
+		default:
+			val = &yamlNode{line: l.num, kind: yamlScalar}
+		}
+		n.keys = append(n.keys, key)
+		n.vals = append(n.vals, val)
+	}
+	return n, nil
+}
+
+func isSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitKey splits "key: value" (or "key:") outside quotes and brackets.
+func splitKey(text string) (key, rest string, ok bool) {
+	if strings.HasPrefix(text, "\"") || strings.HasPrefix(text, "'") || strings.HasPrefix(text, "[") || strings.HasPrefix(text, "{") {
+		return "", "", false
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] == ':' && (i == len(text)-1 || text[i+1] == ' ') {
+			key = strings.TrimSpace(text[:i])
+			return key, strings.TrimSpace(text[i+1:]), key != ""
+		}
+	}
+	return "", "", false
+}
+
+// stripComment drops a # comment that isn't inside quotes.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' '):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// parseValue parses an inline value: a scalar or a [a, b] list.
+func parseValue(s string, line int) (*yamlNode, error) {
+	switch {
+	case strings.HasPrefix(s, "["):
+		if !strings.HasSuffix(s, "]") {
+			return nil, fmt.Errorf("%d: unterminated list", line)
+		}
+		n := &yamlNode{line: line, kind: yamlList}
+		inner := strings.TrimSpace(s[1 : len(s)-1])
+		if inner == "" {
+			return n, nil
+		}
+		for _, part := range splitFlow(inner) {
+			part = strings.TrimSpace(part)
+			if part == "" || strings.HasPrefix(part, "[") || strings.HasPrefix(part, "{") {
+				return nil, fmt.Errorf("%d: lists in [] may only hold plain values", line)
+			}
+			v, err := parseScalar(part, line)
+			if err != nil {
+				return nil, err
+			}
+			n.items = append(n.items, v)
+		}
+		return n, nil
+	case s == "{}":
+		return &yamlNode{line: line, kind: yamlMap}, nil
+	case strings.HasPrefix(s, "{"):
+		return nil, fmt.Errorf("%d: {} mappings aren't supported; write one key per line", line)
+	case s == "|" || s == ">" || strings.HasPrefix(s, "|-") || strings.HasPrefix(s, ">-"):
+		return nil, fmt.Errorf("%d: block scalars (| and >) aren't supported; use a quoted string", line)
+	case strings.HasPrefix(s, "&") || strings.HasPrefix(s, "*") || strings.HasPrefix(s, "!"):
+		return nil, fmt.Errorf("%d: anchors, aliases and tags aren't supported", line)
+	}
+	return parseScalar(s, line)
+}
+
+// splitFlow splits a flow list's contents on commas outside quotes.
+func splitFlow(s string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ',':
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func parseScalar(s string, line int) (*yamlNode, error) {
+	n := &yamlNode{line: line, kind: yamlScalar, value: s}
+	switch {
+	case strings.HasPrefix(s, "\""):
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("%d: bad double-quoted string %s", line, s)
+		}
+		n.value = v
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return nil, fmt.Errorf("%d: unterminated single-quoted string", line)
+		}
+		n.value = strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	case s == "~" || s == "null":
+		n.value = ""
+	}
+	return n, nil
+}
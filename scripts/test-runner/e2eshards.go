@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// ── E2E shards ───────────────────────────────────────────────────────
+//
+// With --e2e-shards N the Playwright suite runs as N `--shard=i/N`
+// processes, each a tracked sub-suite ("e2e/shard-1-of-3", ...) with its own
+// status, output and history. The shards share one environment: the runner
+// starts the Next dev server, shard 1 sets up the database and backend
+// (E2E_SHARED_ENV=owner in e2e/global-setup.ts) while the others wait for
+// it, and the runner tears it all down once every shard has finished. Each
+// shard gets its own slice of the seeded users, and their blob reports are
+// merged into the usual HTML report.
+
+const (
+	e2eShardPrefix = "e2e/"
+	// e2eUserCount mirrors USER_COUNT in frontend/e2e/global-setup.ts: all
+	// shards' workers together must not outnumber the seeded users.
+	e2eUserCount = 10
+	// e2eMaxWorkers mirrors the local worker cap in playwright.config.ts.
+	e2eMaxWorkers = 5
+	maxE2EShards  = 4
+
+	devServerURL     = "http://localhost:3000"
+	devServerTimeout = 2 * time.Minute
+)
+
+// summaryNotes are printed under the summary, e.g. where the merged e2e
+// report is. Written before the run's done channel closes.
+var summaryNotes []string
+
+// autoE2EShards picks a shard count from the CPU count: every shard drives
+// several browsers next to the shared dev server and backend, so leave
+// about four cores per shard.
+func autoE2EShards() int {
+	n := runtime.NumCPU() / 4
+	if n < 1 {
+		n = 1
+	}
+	if n > maxE2EShards {
+		n = maxE2EShards
+	}
+	return n
+}
+
+// e2eShardWorkers is how many Playwright workers each of n shards gets.
+func e2eShardWorkers(n int) int {
+	w := e2eUserCount / n
+	if w > e2eMaxWorkers {
+		w = e2eMaxWorkers
+	}
+	if w < 1 {
+		w = 1
+	}
+	return w
+}
+
+// e2eShardConfigs builds the n shard sub-suites.
+func e2eShardConfigs(n int) []suiteConfig {
+	workers := e2eShardWorkers(n)
+	configs := make([]suiteConfig, 0, n)
+	for i := 1; i <= n; i++ {
+		report := filepath.Join(tmpDir, fmt.Sprintf("playwright-%d.json", i))
+		role := "attach"
+		if i == 1 {
+			role = "owner"
+		}
+		configs = append(configs, suiteConfig{
+			name:    fmt.Sprintf("%sshard-%d-of-%d", e2eShardPrefix, i, n),
+			dir:     "frontend",
+			command: "bun",
+			args: []string{"run", "test:e2e", fmt.Sprintf("--shard=%d/%d", i, n),
+				"--workers=" + strconv.Itoa(workers), "--reporter=list,json,blob"},
+			env: []string{
+				"E2E_SHARED_ENV=" + role,
+				"E2E_USER_OFFSET=" + strconv.Itoa((i-1)*workers),
+				"PLAYWRIGHT_JSON_OUTPUT_FILE=" + report,
+				"PLAYWRIGHT_BLOB_OUTPUT_FILE=" + filepath.Join(tmpDir, "blob", fmt.Sprintf("report-%d.zip", i)),
+			},
+			failures: func(*suiteState) ([]string, []string) {
+				return playwrightFailures(report)
+			},
+		})
+	}
+	return configs
+}
+
+// isE2EShard reports whether a suite name is an e2e shard.
+func isE2EShard(name string) bool {
+	return strings.HasPrefix(name, e2eShardPrefix)
+}
+
+// e2eEnv tracks the shared environment so an interrupt can tear it down.
+var e2eEnv struct {
+	mu          sync.Mutex
+	projectRoot string
+	devServer   int // process group of the dev server we started, or 0
+	up          bool
+}
+
+// runE2EShards sets up the shared environment, runs the shards in parallel,
+// retries quarantined failures while the environment is still up, merges
+// the reports and tears the environment down.
+func runE2EShards(configs []suiteConfig, states map[string]*suiteState, projectRoot string) {
+	authDir := filepath.Join(projectRoot, "frontend", "e2e", ".auth")
+	_ = os.Remove(filepath.Join(authDir, ".ready"))
+	_ = os.Remove(filepath.Join(authDir, ".failed"))
+	_ = os.RemoveAll(filepath.Join(tmpDir, "blob"))
+
+	if err := startDevServer(projectRoot); err != nil {
+		for _, sc := range configs {
+			s := states[sc.name]
+			s.setStatus(statusRunning)
+			s.addLine(err.Error())
+			s.setStatus(statusFailed)
+		}
+		stopE2EEnv()
+		return
+	}
+
+	var wg sync.WaitGroup
+	results := make([][2][]string, len(configs))
+	for i, sc := range configs {
+		wg.Add(1)
+		go func(i int, cfg suiteConfig) {
+			defer wg.Done()
+			runSuite(cfg, states[cfg.name], projectRoot)
+			results[i][0], results[i][1] = cfg.failures(states[cfg.name])
+		}(i, sc)
+	}
+	wg.Wait()
+
+	// All shards are done, so retries can use any of the seeded users.
+	for i, sc := range configs {
+		settle(states[sc.name], results[i][0], results[i][1], projectRoot, []string{"E2E_SHARED_ENV=attach"})
+	}
+
+	mergeE2EReports(projectRoot)
+	stopE2EEnv()
+}
+
+// startDevServer starts `bun run dev` unless something already answers on
+// the dev server URL (Playwright's reuseExistingServer does the same), and
+// waits for it.
+func startDevServer(projectRoot string) error {
+	e2eEnv.mu.Lock()
+	e2eEnv.projectRoot = projectRoot
+	e2eEnv.up = true
+	e2eEnv.mu.Unlock()
+
+	if devServerUp() {
+		return nil
+	}
+	cmd := exec.Command("bun", "run", "dev")
+	cmd.Dir = filepath.Join(projectRoot, "frontend")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting the dev server: %w", err)
+	}
+	trackPid(cmd.Process.Pid)
+	e2eEnv.mu.Lock()
+	e2eEnv.devServer = cmd.Process.Pid
+	e2eEnv.mu.Unlock()
+	go func() { _ = cmd.Wait() }()
+
+	deadline := time.Now().Add(devServerTimeout)
+	for !devServerUp() {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("dev server not up at %s after %s", devServerURL, devServerTimeout)
+		}
+		time.Sleep(time.Second)
+	}
+	return nil
+}
+
+func devServerUp() bool {
+	client := http.Client{Timeout: 2 * time.Second}
+	resp, err := client.Get(devServerURL)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
+
+// mergeE2EReports merges the shards' blob reports into
+// frontend/playwright-report, as an unsharded local run would have written.
+func mergeE2EReports(projectRoot string) {
+	blobDir := filepath.Join(tmpDir, "blob")
+	if entries, err := os.ReadDir(blobDir); err != nil || len(entries) == 0 {
+		return
+	}
+	cmd := exec.Command("bunx", "playwright", "merge-reports", "--reporter", "html", blobDir)
+	cmd.Dir = filepath.Join(projectRoot, "frontend")
+	cmd.Env = append(os.Environ(), "PLAYWRIGHT_HTML_OPEN=never")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		summaryNotes = append(summaryNotes, fmt.Sprintf("Merging e2e reports failed: %v\n%s", err, strings.TrimSpace(string(out))))
+		return
+	}
+	summaryNotes = append(summaryNotes, "Merged e2e report: cd frontend && bunx playwright show-report")
+}
+
+// stopE2EEnv tears the shared environment down: the dev server if we
+// started it, and what e2e/global-teardown.ts would have stopped (the
+// backend and the database), which the shards skip. Safe to call twice.
+func stopE2EEnv() {
+	e2eEnv.mu.Lock()
+	defer e2eEnv.mu.Unlock()
+	if !e2eEnv.up {
+		return
+	}
+	e2eEnv.up = false
+
+	if e2eEnv.devServer != 0 {
+		_ = syscall.Kill(-e2eEnv.devServer, syscall.SIGTERM)
+		e2eEnv.devServer = 0
+	}
+	pidFile := filepath.Join(e2eEnv.projectRoot, "frontend", "e2e", ".backend-pid")
+	if data, err := os.ReadFile(pidFile); err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil {
+			_ = syscall.Kill(-pid, syscall.SIGTERM)
+		}
+		_ = os.Remove(pidFile)
+	}
+	down := exec.Command("docker", "compose", "-p", "e2e", "-f", "docker-compose.e2e.yml", "down", "-v")
+	down.Dir = filepath.Join(e2eEnv.projectRoot, "backend")
+	_ = down.Run()
+}
@@ -0,0 +1,527 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ── Per-test failures, flakiness and quarantine ──────────────────────
+//
+// Each suite's failed tests are recorded in the history by ID,
+// "<package or spec file>:<test name>", e.g.
+//
+//	backend/internal/services/catalog:TestShowServiceSuite/TestCreate
+//	frontend/lib/utils.test.ts:cn › merges classes
+//	e2e/pages/home.spec.ts:home page › shows upcoming shows
+//
+// Tests listed in .test-quarantine (one ID per line, # comments) are known
+// flakes: when a suite's only failures are quarantined tests, they are
+// re-run up to quarantineRetries times, and the suite passes if they do.
+// Those tests are recorded as passed on retry.
+
+const (
+	quarantineFile    = ".test-quarantine"
+	quarantineRetries = 2
+
+	// flakyWindow is how many recent runs flakiness is scored over, and
+	// flakyThreshold the score from which a test is listed in the summary.
+	flakyWindow    = 10
+	flakyThreshold = 0.3
+	maxFlakyListed = 10
+)
+
+// testNameSep joins describe blocks and test titles in frontend test IDs.
+const testNameSep = " › "
+
+// tmpDir holds this run's reporter output. Removed by removeTmpDir.
+var tmpDir string
+
+func removeTmpDir() {
+	if tmpDir != "" {
+		_ = os.RemoveAll(tmpDir)
+	}
+}
+
+// quarantine is the loaded quarantine list.
+var quarantine quarantineList
+
+type quarantineList map[string]bool
+
+func loadQuarantine(projectRoot string) quarantineList {
+	q := quarantineList{}
+	f, err := os.Open(filepath.Join(projectRoot, quarantineFile))
+	if err != nil {
+		return q
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			q[line] = true
+		}
+	}
+	return q
+}
+
+// has reports whether id is quarantined. Quarantining a Go test covers its
+// subtests.
+func (q quarantineList) has(id string) bool {
+	if q[id] {
+		return true
+	}
+	if !strings.HasPrefix(id, packageSuitePrefix) {
+		return false
+	}
+	colon := strings.Index(id, ":")
+	for i := strings.LastIndex(id, "/"); i > colon; i = strings.LastIndex(id, "/") {
+		id = id[:i]
+		if q[id] {
+			return true
+		}
+	}
+	return false
+}
+
+func (q quarantineList) hasAll(ids []string) bool {
+	for _, id := range ids {
+		if !q.has(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitTestID splits an ID into its group (package or spec file) and name.
+func splitTestID(id string) (group, name string) {
+	group, name, _ = strings.Cut(id, ":")
+	return group, name
+}
+
+// leafTests sorts and dedupes ids and drops Go tests whose subtests are
+// listed too: a failing subtest fails its parent, which isn't news.
+func leafTests(ids []string) []string {
+	seen := map[string]bool{}
+	for _, id := range ids {
+		seen[id] = true
+	}
+	var leaves []string
+	for id := range seen {
+		leaf := true
+		if strings.HasPrefix(id, packageSuitePrefix) {
+			for other := range seen {
+				if strings.HasPrefix(other, id+"/") {
+					leaf = false
+					break
+				}
+			}
+		}
+		if leaf {
+			leaves = append(leaves, id)
+		}
+	}
+	sort.Strings(leaves)
+	return leaves
+}
+
+// ── Extracting failures ──────────────────────────────────────────────
+
+var (
+	goFailRe    = regexp.MustCompile(`^\s*--- FAIL: (\S+) \(`)
+	goPkgFailRe = regexp.MustCompile(`^FAIL\s+(\S+)`)
+)
+
+// goTextFailures extracts failed tests from plain `go test` output, where
+// each failing package's "--- FAIL" lines precede its "FAIL <pkg>" line.
+func goTextFailures(lines []string, module string) []string {
+	var failed, pending []string
+	for _, line := range lines {
+		line = ansiRe.ReplaceAllString(line, "")
+		if m := goFailRe.FindStringSubmatch(line); m != nil {
+			pending = append(pending, m[1])
+			continue
+		}
+		if m := goPkgFailRe.FindStringSubmatch(line); m != nil {
+			suite := packageSuite(module, m[1])
+			for _, name := range pending {
+				failed = append(failed, suite+":"+name)
+			}
+			pending = nil
+		}
+	}
+	return failed
+}
+
+type vitestReport struct {
+	TestResults []struct {
+		Name             string `json:"name"`
+		AssertionResults []struct {
+			AncestorTitles []string `json:"ancestorTitles"`
+			Title          string   `json:"title"`
+			Status         string   `json:"status"`
+		} `json:"assertionResults"`
+	} `json:"testResults"`
+}
+
+// vitestFailures reads a vitest JSON report and removes it, so a later run
+// that crashes before reporting can't be read as this one.
+func vitestFailures(path, projectRoot string) []string {
+	data, err := os.ReadFile(path)
+	_ = os.Remove(path)
+	if err != nil {
+		return nil
+	}
+	var rep vitestReport
+	if json.Unmarshal(data, &rep) != nil {
+		return nil
+	}
+	var failed []string
+	for _, file := range rep.TestResults {
+		group, err := filepath.Rel(projectRoot, file.Name)
+		if err != nil {
+			continue
+		}
+		for _, a := range file.AssertionResults {
+			if a.Status == "failed" {
+				name := strings.Join(append(append([]string(nil), a.AncestorTitles...), a.Title), testNameSep)
+				failed = append(failed, filepath.ToSlash(group)+":"+name)
+			}
+		}
+	}
+	return failed
+}
+
+type playwrightSuite struct {
+	Title  string            `json:"title"`
+	Specs  []playwrightSpec  `json:"specs"`
+	Suites []playwrightSuite `json:"suites"`
+}
+
+type playwrightSpec struct {
+	Title string `json:"title"`
+	File  string `json:"file"`
+	Tests []struct {
+		Status string `json:"status"`
+	} `json:"tests"`
+}
+
+// playwrightFailures reads (and removes) a Playwright JSON report. Tests
+// Playwright's own retries rescued are returned as flaky.
+func playwrightFailures(path string) (failed, flaky []string) {
+	data, err := os.ReadFile(path)
+	_ = os.Remove(path)
+	if err != nil {
+		return nil, nil
+	}
+	var rep struct {
+		Suites []playwrightSuite `json:"suites"`
+	}
+	if json.Unmarshal(data, &rep) != nil {
+		return nil, nil
+	}
+	var walk func(s playwrightSuite, titles []string)
+	walk = func(s playwrightSuite, titles []string) {
+		for _, spec := range s.Specs {
+			id := "e2e/" + spec.File + ":" + strings.Join(append(append([]string(nil), titles...), spec.Title), testNameSep)
+			for _, t := range spec.Tests {
+				switch t.Status {
+				case "unexpected":
+					failed = append(failed, id)
+				case "flaky":
+					flaky = append(flaky, id)
+				}
+			}
+		}
+		for _, child := range s.Suites {
+			walk(child, append(append([]string(nil), titles...), child.Title))
+		}
+	}
+	// Top-level suites are files; their title is the path, not a describe.
+	for _, s := range rep.Suites {
+		walk(s, nil)
+	}
+	return leafTests(failed), leafTests(flaky)
+}
+
+// ── Quarantine retries ───────────────────────────────────────────────
+
+// settle records a finished suite's failed tests. When every failure is
+// quarantined they are re-run, up to quarantineRetries times, and the suite
+// passes if they all do. retryEnv is added to the retries' environment.
+func settle(s *suiteState, failed, flaky []string, projectRoot string, retryEnv []string) {
+	failed = leafTests(failed)
+	s.setTests(failed, flaky)
+	st, elapsed, _, _, _ := s.snapshot()
+	if st != statusFailed || len(failed) == 0 || !quarantine.hasAll(failed) {
+		return
+	}
+
+	s.setStatus(statusRunning)
+	retryStart := time.Now()
+	remaining := failed
+	for attempt := 1; attempt <= quarantineRetries && len(remaining) > 0; attempt++ {
+		s.addLine(fmt.Sprintf("── retrying %d quarantined test(s), attempt %d of %d ──", len(remaining), attempt, quarantineRetries))
+		remaining = retryTests(s, remaining, projectRoot, attempt, retryEnv)
+	}
+
+	stillFailing := map[string]bool{}
+	for _, id := range remaining {
+		stillFailing[id] = true
+	}
+	for _, id := range failed {
+		if !stillFailing[id] {
+			flaky = append(flaky, id)
+		}
+	}
+	s.setTests(remaining, leafTests(flaky))
+	final := statusPassed
+	if len(remaining) > 0 {
+		final = statusFailed
+	}
+	s.finish(final, elapsed+time.Since(retryStart))
+}
+
+// retryTests re-runs ids (all from one suite kind) and returns those that
+// failed again. A run that fails without naming a test (a build error)
+// fails them all.
+func retryTests(s *suiteState, ids []string, projectRoot string, attempt int, env []string) []string {
+	group, _ := splitTestID(ids[0])
+	kind := strings.SplitN(group, "/", 2)[0]
+	report := filepath.Join(tmpDir, fmt.Sprintf("retry-%d.json", attempt))
+
+	var cmd *exec.Cmd
+	switch kind {
+	case "backend":
+		pkgs := map[string]bool{}
+		var names []string
+		for _, id := range ids {
+			group, name := splitTestID(id)
+			pkgs["./"+strings.TrimPrefix(group, packageSuitePrefix)] = true
+			names = append(names, regexp.QuoteMeta(strings.SplitN(name, "/", 2)[0]))
+		}
+		args := []string{"test", "-count=1", "-run", "^(" + strings.Join(names, "|") + ")$"}
+		for p := range pkgs {
+			args = append(args, p)
+		}
+		cmd = exec.Command("go", args...)
+		cmd.Dir = filepath.Join(projectRoot, "backend")
+	case "frontend", "e2e":
+		files := map[string]bool{}
+		var titles []string
+		for _, id := range ids {
+			group, name := splitTestID(id)
+			files[strings.TrimPrefix(group, kind+"/")] = true
+			parts := strings.Split(name, testNameSep)
+			titles = append(titles, regexp.QuoteMeta(parts[len(parts)-1]))
+		}
+		pattern := "(" + strings.Join(titles, "|") + ")$"
+		var args []string
+		if kind == "frontend" {
+			args = []string{"run", "test:run", "--reporter=default", "--reporter=json", "--outputFile.json=" + report, "-t", pattern}
+		} else {
+			args = []string{"run", "test:e2e", "--reporter=list,json", "-g", pattern}
+		}
+		for f := range files {
+			if kind == "e2e" {
+				f = "e2e/" + f
+			}
+			args = append(args, f)
+		}
+		cmd = exec.Command("bun", args...)
+		cmd.Dir = filepath.Join(projectRoot, "frontend")
+	default:
+		return ids
+	}
+	cmd.Env = append(append(os.Environ(), "FORCE_COLOR=0", "NO_COLOR=1", "PLAYWRIGHT_JSON_OUTPUT_FILE="+report), env...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	var lines []string
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return ids
+	}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		s.addLine(fmt.Sprintf("error starting retry: %v", err))
+		return ids
+	}
+	trackPid(cmd.Process.Pid)
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 256*1024), 256*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		s.addLine(scanner.Text())
+	}
+	if cmd.Wait() == nil {
+		return nil
+	}
+
+	var again []string
+	switch kind {
+	case "backend":
+		module, _ := goModulePath(projectRoot)
+		again = goTextFailures(lines, module)
+	case "frontend":
+		again = vitestFailures(report, projectRoot)
+	case "e2e":
+		again, _ = playwrightFailures(report)
+	}
+	if len(again) == 0 {
+		return ids
+	}
+	var remaining []string
+	for _, id := range ids {
+		for _, a := range again {
+			// A retried Go test reruns its parent, which fails along
+			// with the subtest.
+			if a == id || strings.HasPrefix(a, id+"/") || strings.HasPrefix(id, a+"/") {
+				remaining = append(remaining, id)
+				break
+			}
+		}
+	}
+	return remaining
+}
+
+// ── Flakiness ────────────────────────────────────────────────────────
+
+type flakyTest struct {
+	id          string
+	score       float64
+	runs        int // runs in the window that observed the test
+	failures    int
+	retryPasses int
+}
+
+// scoreFlakyTests scores every test that failed or passed on retry in the
+// last flakyWindow runs. A test's score is the share of its observed runs
+// that flipped outcome from the run before, with a pass on retry counting
+// as a flip of its own; a test that broke and stayed broken scores low,
+// one that alternates scores high.
+func scoreFlakyTests(h historyData) []flakyTest {
+	runs := h.Runs
+	if len(runs) > flakyWindow {
+		runs = runs[len(runs)-flakyWindow:]
+	}
+	candidates := map[string]bool{}
+	for _, run := range runs {
+		for _, r := range run.Suites {
+			for _, id := range r.FailedTests {
+				candidates[id] = true
+			}
+			for _, id := range r.FlakyTests {
+				candidates[id] = true
+			}
+		}
+	}
+
+	var scored []flakyTest
+	for id := range candidates {
+		group, _ := splitTestID(id)
+		kind := strings.SplitN(group, "/", 2)[0]
+		ft := flakyTest{id: id}
+		flips := 0
+		var prev *bool
+		for _, run := range runs {
+			// Package sub-suites are named by group; otherwise the whole
+			// suite ran it.
+			r := run.Suites[group]
+			if r == nil {
+				r = run.Suites[kind]
+			}
+			if r == nil {
+				continue
+			}
+			var passed bool
+			switch {
+			case contains(r.FailedTests, id):
+				ft.failures++
+			case contains(r.FlakyTests, id):
+				ft.retryPasses++
+				flips++
+				passed = true
+			case r.Passed || len(r.FailedTests) > 0:
+				passed = true
+			default:
+				continue // failed without test results, e.g. a build error
+			}
+			ft.runs++
+			if prev != nil && *prev != passed {
+				flips++
+			}
+			prev = &passed
+		}
+		if ft.runs < 2 && ft.retryPasses == 0 {
+			continue
+		}
+		ft.score = float64(flips) / float64(ft.runs)
+		if ft.score > 1 {
+			ft.score = 1
+		}
+		scored = append(scored, ft)
+	}
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return scored[i].id < scored[j].id
+	})
+	return scored
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// printFlakySection lists this run's passes on retry and the flakiest tests
+// of recent runs.
+func printFlakySection(suites []*suiteState, h historyData) {
+	var retried []string
+	for _, s := range suites {
+		_, flaky := s.tests()
+		retried = append(retried, flaky...)
+	}
+	var listed []flakyTest
+	for _, ft := range scoreFlakyTests(h) {
+		if ft.score >= flakyThreshold && len(listed) < maxFlakyListed {
+			listed = append(listed, ft)
+		}
+	}
+	if len(retried) == 0 && len(listed) == 0 {
+		return
+	}
+
+	fmt.Printf("  %s%sFlaky tests%s %s(last %d runs)%s\n", bold, yellow, reset, dim, flakyWindow, reset)
+	for _, id := range retried {
+		fmt.Printf("  %s↻%s %s %spassed on retry%s\n", yellow, reset, id, dim, reset)
+	}
+	unquarantined := false
+	for _, ft := range listed {
+		note := ""
+		if quarantine.has(ft.id) {
+			note = "  quarantined"
+		} else {
+			unquarantined = true
+		}
+		fmt.Printf("  %s%.2f%s  %s%d/%d failed%s  %s%s%s%s\n",
+			yellow, ft.score, reset, dim, ft.failures+ft.retryPasses, ft.runs, reset, ft.id, dim, note, reset)
+	}
+	if unquarantined {
+		fmt.Printf("  %sQuarantine a test by adding its ID to %s; it is then retried before failing the run.%s\n", dim, quarantineFile, reset)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ── Go package sub-suites ────────────────────────────────────────────
+//
+// With --packages the backend runs as one tracked sub-suite per Go package
+// instead of a single "backend" suite. All packages still go through one
+// `go test -json` process, so the build cache and go's own package
+// parallelism are shared; the event stream is split per package, and each
+// package gets its own status, output and history entry.
+
+// packageSuitePrefix names package sub-suites in the dashboard and history,
+// e.g. "backend/internal/services/catalog".
+const packageSuitePrefix = "backend/"
+
+// goPackage is one backend package with tests.
+type goPackage struct {
+	importPath string
+	suite      string
+}
+
+// goModulePath returns the backend's module path.
+func goModulePath(projectRoot string) (string, error) {
+	out, err := exec.Command("go", "-C", filepath.Join(projectRoot, "backend"), "list", "-m").Output()
+	if err != nil {
+		return "", fmt.Errorf("go list -m: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// packageSuite names the sub-suite of a backend import path.
+func packageSuite(module, importPath string) string {
+	rel := strings.TrimPrefix(strings.TrimPrefix(importPath, module), "/")
+	if rel == "" {
+		rel = "."
+	}
+	return packageSuitePrefix + rel
+}
+
+// discoverGoPackages lists the backend packages that have test files.
+func discoverGoPackages(projectRoot string) ([]goPackage, error) {
+	dir := filepath.Join(projectRoot, "backend")
+	module, err := goModulePath(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := exec.Command("go", "-C", dir, "list",
+		"-f", "{{if or .TestGoFiles .XTestGoFiles}}{{.ImportPath}}{{end}}", "./...").Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list ./...: %w", err)
+	}
+
+	var pkgs []goPackage
+	for _, line := range strings.Split(string(out), "\n") {
+		importPath := strings.TrimSpace(line)
+		if importPath == "" {
+			continue
+		}
+		pkgs = append(pkgs, goPackage{importPath: importPath, suite: packageSuite(module, importPath)})
+	}
+	return pkgs, nil
+}
+
+// testEvent is the subset of `go test -json` (test2json) events used here.
+type testEvent struct {
+	Action     string
+	Package    string
+	Test       string
+	Output     string
+	Elapsed    float64
+	ImportPath string // build-output / build-fail events
+}
+
+// runGoPackages runs the packages' tests and feeds each package's events to
+// its state. Output that can't be tied to a package (e.g. a `go` error) is
+// attached to every package that never finished, which are marked failed.
+func runGoPackages(pkgs []goPackage, states map[string]*suiteState, projectRoot string) {
+	args := []string{"test", "-count=1", "-json"}
+	for _, p := range pkgs {
+		args = append(args, p.importPath)
+	}
+	cmd := exec.Command("go", args...)
+	cmd.Dir = filepath.Join(projectRoot, "backend")
+	cmd.Env = append(append(os.Environ(), "FORCE_COLOR=0", "NO_COLOR=1"), suiteEnv("backend")...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	var stray []string
+	failRemaining := func() {
+		for _, s := range states {
+			st, _, _, _, _ := s.snapshot()
+			if st == statusPassed || st == statusFailed {
+				continue
+			}
+			if st == statusPending {
+				s.setStatus(statusRunning)
+			}
+			for _, line := range stray {
+				s.addLine(line)
+			}
+			s.setStatus(statusFailed)
+		}
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		stray = append(stray, fmt.Sprintf("error creating pipe: %v", err))
+		failRemaining()
+		return
+	}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		stray = append(stray, fmt.Sprintf("error starting: %v", err))
+		failRemaining()
+		return
+	}
+	trackPid(cmd.Process.Pid)
+	timedOut := startTimeout("backend", cmd.Process.Pid)
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 256*1024), 256*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		var ev testEvent
+		if !strings.HasPrefix(line, "{") || json.Unmarshal([]byte(line), &ev) != nil {
+			stray = append(stray, line)
+			continue
+		}
+
+		pkg := ev.Package
+		if pkg == "" && ev.ImportPath != "" {
+			// "pkg [pkg.test]" for test-variant builds
+			pkg = strings.Fields(ev.ImportPath)[0]
+		}
+		s, ok := states[pkg]
+		if !ok {
+			if ev.Output != "" {
+				stray = append(stray, strings.TrimRight(ev.Output, "\n"))
+			}
+			continue
+		}
+
+		if st, _, _, _, _ := s.snapshot(); st == statusPending {
+			s.setStatus(statusRunning)
+		}
+		if ev.Output != "" {
+			s.addLine(strings.TrimRight(ev.Output, "\n"))
+		}
+		if ev.Test != "" {
+			if ev.Action == "fail" {
+				s.addFailedTest(s.name + ":" + ev.Test)
+			}
+			continue
+		}
+		switch ev.Action {
+		case "pass", "skip":
+			s.finish(statusPassed, time.Duration(ev.Elapsed*float64(time.Second)))
+		case "fail":
+			s.finish(statusFailed, time.Duration(ev.Elapsed*float64(time.Second)))
+		}
+	}
+
+	_ = cmd.Wait()
+	if timedOut() {
+		stray = append(stray, fmt.Sprintf("timed out after %s", suiteTimeout("backend")))
+	}
+	failRemaining()
+}
+
+// previousFailures returns the suites that failed in the most recent run.
+func previousFailures(h historyData) []string {
+	if len(h.Runs) == 0 {
+		return nil
+	}
+	var failed []string
+	for name, r := range h.Runs[len(h.Runs)-1].Suites {
+		if !r.Passed {
+			failed = append(failed, name)
+		}
+	}
+	return failed
+}
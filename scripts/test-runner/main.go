@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"math"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 )
@@ -23,8 +25,10 @@ const maxHistory = 20
 const estimateWindow = 5
 
 type suiteResult struct {
-	Ms     int64 `json:"ms"`
-	Passed bool  `json:"passed"`
+	Ms          int64    `json:"ms"`
+	Passed      bool     `json:"passed"`
+	FailedTests []string `json:"failed_tests,omitempty"`
+	FlakyTests  []string `json:"flaky_tests,omitempty"` // passed on retry
 }
 
 type historyRun struct {
@@ -63,6 +67,31 @@ func saveHistory(projectRoot string, h historyData) {
 	_ = os.WriteFile(historyPath(projectRoot), data, 0644)
 }
 
+// suiteGroup is the top-level suite a suite belongs to: "backend" for a Go
+// package sub-suite, "e2e" for a shard, else the suite itself.
+func suiteGroup(name string) string {
+	return strings.SplitN(name, "/", 2)[0]
+}
+
+// recordRun appends the suites' results as a new history run.
+func recordRun(h *historyData, suites []*suiteState) {
+	run := historyRun{
+		Time:   time.Now().UTC().Format(time.RFC3339),
+		Suites: make(map[string]*suiteResult),
+	}
+	for _, s := range suites {
+		st, elapsed, _, _, _ := s.snapshot()
+		failed, flaky := s.tests()
+		run.Suites[s.name] = &suiteResult{
+			Ms:          elapsed.Milliseconds(),
+			Passed:      st == statusPassed,
+			FailedTests: failed,
+			FlakyTests:  flaky,
+		}
+	}
+	h.Runs = append(h.Runs, run)
+}
+
 func estimateMs(h historyData, suite string) int64 {
 	var times []int64
 	for i := len(h.Runs) - 1; i >= 0 && len(times) < estimateWindow; i-- {
@@ -101,6 +130,9 @@ type suiteState struct {
 	lines      int
 	lastLine   string
 	output     []string
+
+	failedTests []string // test IDs, see flaky.go
+	flakyTests  []string // passed on retry
 }
 
 func (s *suiteState) setStatus(st status) {
@@ -115,6 +147,53 @@ func (s *suiteState) setStatus(st status) {
 	}
 }
 
+// finish records a final status with an externally measured duration (a
+// package's elapsed time from `go test -json`). Zero falls back to the
+// time since the suite started.
+func (s *suiteState) finish(st status, elapsed time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = st
+	if elapsed <= 0 {
+		elapsed = time.Since(s.startTime)
+	}
+	s.elapsed = elapsed
+}
+
+// reset returns the suite to pending for another run (watch mode).
+func (s *suiteState) reset(estimateMs int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = statusPending
+	s.elapsed = 0
+	s.estimateMs = estimateMs
+	s.lines = 0
+	s.lastLine = ""
+	s.output = nil
+	s.failedTests = nil
+	s.flakyTests = nil
+}
+
+func (s *suiteState) addFailedTest(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failedTests = append(s.failedTests, id)
+}
+
+func (s *suiteState) setTests(failed, flaky []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failedTests = failed
+	s.flakyTests = flaky
+}
+
+// tests returns the suite's failed and passed-on-retry test IDs.
+func (s *suiteState) tests() (failed, flaky []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.failedTests...), append([]string(nil), s.flakyTests...)
+}
+
 func (s *suiteState) addLine(line string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -253,7 +332,21 @@ func statusLabel(st status) string {
 
 // ── Rendering ────────────────────────────────────────────────────────
 
-const dashboardLines = 7
+// maxDashboardRows is the most suite rows drawn. Beyond it (a --packages
+// run) the dashboard shows counts plus the running and failed suites only.
+const maxDashboardRows = 12
+
+// dashboardLines is how many lines the last render drew, so the next one
+// can clear exactly that many.
+var dashboardLines = 0
+
+// termMu serializes terminal writes between the render loop and watch
+// mode, which prints each run's results above the dashboard.
+var termMu sync.Mutex
+
+// watchLine is shown under the dashboard header in watch mode. Guarded by
+// termMu.
+var watchLine string
 
 func renderDashboard(suites []*suiteState, startTime time.Time, tick int) string {
 	totalElapsed := time.Since(startTime)
@@ -291,10 +384,35 @@ func renderDashboard(suites []*suiteState, startTime time.Time, tick int) string
 		remaining = fmt.Sprintf("  ·  %s~%s remaining%s", dim, fmtDuration(maxRemaining), reset)
 	}
 	b.WriteString(fmt.Sprintf("  %s%sTest Runner%s  ·  %s elapsed%s\n", bold, white, reset, fmtDuration(totalElapsed), remaining))
+	if watchLine != "" {
+		b.WriteString(fmt.Sprintf("  %s%s%s\n", cyan, watchLine, reset))
+	}
 	b.WriteString("\n")
 
 	// Suite rows
-	for _, s := range suites {
+	rows := suites
+	if len(suites) > maxDashboardRows {
+		var counts [4]int
+		rows = nil
+		for _, s := range suites {
+			st, _, _, _, _ := s.snapshot()
+			counts[st]++
+			if (st == statusRunning || st == statusFailed) && len(rows) < maxDashboardRows-2 {
+				rows = append(rows, s)
+			}
+		}
+		b.WriteString(fmt.Sprintf("  %d suites  ·  %s%d passed%s  ·  %s%d failed%s  ·  %s%d running%s  ·  %s%d pending%s\n",
+			len(suites), green, counts[statusPassed], reset, red, counts[statusFailed], reset,
+			yellow, counts[statusRunning], reset, dim, counts[statusPending], reset))
+		b.WriteString("\n")
+	}
+	nameWidth := 9
+	for _, s := range rows {
+		if len(s.name) > nameWidth {
+			nameWidth = len(s.name)
+		}
+	}
+	for _, s := range rows {
 		st, elapsed, estMs, lines, _ := s.snapshot()
 		bar := progressBar(elapsed, estMs, st, tick)
 
@@ -308,8 +426,8 @@ func renderDashboard(suites []*suiteState, startTime time.Time, tick int) string
 			lineInfo = fmt.Sprintf(" (%d lines)", lines)
 		}
 
-		b.WriteString(fmt.Sprintf("  %-9s %s  %s   %s %s%s%s\n",
-			s.name, bar, timeStr, statusIcon(st), statusLabel(st), dim+lineInfo+reset, ""))
+		b.WriteString(fmt.Sprintf("  %-*s %s  %s   %s %s%s%s\n",
+			nameWidth, s.name, bar, timeStr, statusIcon(st), statusLabel(st), dim+lineInfo+reset, ""))
 	}
 
 	// Latest output line (from the first running suite that has output)
@@ -339,6 +457,17 @@ func clearDashboard() {
 	for i := 0; i < dashboardLines; i++ {
 		fmt.Print("\033[A\033[2K")
 	}
+	dashboardLines = 0
+}
+
+// drawDashboard clears the previous render and prints a new one.
+func drawDashboard(suites []*suiteState, startTime time.Time, tick int) {
+	termMu.Lock()
+	defer termMu.Unlock()
+	clearDashboard()
+	out := renderDashboard(suites, startTime, tick)
+	fmt.Print(out)
+	dashboardLines = strings.Count(out, "\n")
 }
 
 // ── Suite execution ──────────────────────────────────────────────────
@@ -348,6 +477,10 @@ type suiteConfig struct {
 	dir     string
 	command string
 	args    []string
+	env     []string
+	// failures extracts the failed (and, where the tool retries on its
+	// own, flaky) test IDs once the suite has finished.
+	failures func(s *suiteState) (failed, flaky []string)
 }
 
 // Track running processes for cleanup on interrupt
@@ -362,6 +495,14 @@ func trackPid(pid int) {
 	procPids = append(procPids, pid)
 }
 
+// forgetPids drops the tracked processes once they have all exited, so a
+// later interrupt can't signal a reused pid.
+func forgetPids() {
+	procMu.Lock()
+	defer procMu.Unlock()
+	procPids = nil
+}
+
 func killAllProcessGroups() {
 	procMu.Lock()
 	defer procMu.Unlock()
@@ -376,7 +517,7 @@ func runSuite(sc suiteConfig, state *suiteState, projectRoot string) {
 
 	cmd := exec.Command(sc.command, sc.args...)
 	cmd.Dir = filepath.Join(projectRoot, sc.dir)
-	cmd.Env = append(os.Environ(), "FORCE_COLOR=0", "NO_COLOR=1")
+	cmd.Env = append(append(os.Environ(), "FORCE_COLOR=0", "NO_COLOR=1"), sc.env...)
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 
 	stdout, err := cmd.StdoutPipe()
@@ -394,6 +535,7 @@ func runSuite(sc suiteConfig, state *suiteState, projectRoot string) {
 	}
 
 	trackPid(cmd.Process.Pid)
+	timedOut := startTimeout(sc.name, cmd.Process.Pid)
 
 	scanner := bufio.NewScanner(stdout)
 	scanner.Buffer(make([]byte, 256*1024), 256*1024)
@@ -401,13 +543,134 @@ func runSuite(sc suiteConfig, state *suiteState, projectRoot string) {
 		state.addLine(scanner.Text())
 	}
 
-	if err := cmd.Wait(); err != nil {
+	err = cmd.Wait()
+	if timedOut() {
+		state.addLine(fmt.Sprintf("timed out after %s", suiteTimeout(sc.name)))
+		state.setStatus(statusFailed)
+	} else if err != nil {
 		state.setStatus(statusFailed)
 	} else {
 		state.setStatus(statusPassed)
 	}
 }
 
+// startTimeout kills pid's process group once the suite's configured
+// timeout has passed. The returned func stops the timer and reports whether
+// it fired; call it after the process has exited.
+func startTimeout(name string, pid int) func() bool {
+	d := suiteTimeout(name)
+	if d <= 0 {
+		return func() bool { return false }
+	}
+	var fired atomic.Bool
+	timer := time.AfterFunc(d, func() {
+		fired.Store(true)
+		_ = syscall.Kill(-pid, syscall.SIGKILL)
+	})
+	return func() bool {
+		timer.Stop()
+		return fired.Load()
+	}
+}
+
+// startSuites runs the suites in parallel, the Go packages in one shared go
+// test process, and returns a channel that closes once all have finished.
+// A suite with depends_on waits for those of its dependencies in this run,
+// and fails without running if one of them failed.
+func startSuites(configs []suiteConfig, states []*suiteState, pkgs []goPackage, pkgStates map[string]*suiteState, projectRoot string) <-chan struct{} {
+	byName := make(map[string]*suiteState, len(states))
+	byGroup := map[string][]*suiteState{}
+	for _, s := range states {
+		byName[s.name] = s
+		byGroup[suiteGroup(s.name)] = append(byGroup[suiteGroup(s.name)], s)
+	}
+
+	// Each top-level suite runs as one unit: the e2e shards together, and
+	// the Go packages together as "backend".
+	type unit struct {
+		group string
+		run   func()
+	}
+	var units []unit
+	var shards []suiteConfig
+	for _, sc := range configs {
+		if isE2EShard(sc.name) {
+			shards = append(shards, sc)
+			continue
+		}
+		cfg := sc
+		units = append(units, unit{group: cfg.name, run: func() {
+			s := byName[cfg.name]
+			runSuite(cfg, s, projectRoot)
+			var failed, flaky []string
+			if cfg.failures != nil {
+				failed, flaky = cfg.failures(s)
+			}
+			settle(s, failed, flaky, projectRoot, nil)
+		}})
+	}
+	if len(shards) > 0 {
+		units = append(units, unit{group: "e2e", run: func() {
+			runE2EShards(shards, byName, projectRoot)
+		}})
+	}
+	if len(pkgs) > 0 {
+		units = append(units, unit{group: "backend", run: func() {
+			runGoPackages(pkgs, pkgStates, projectRoot)
+			for _, p := range pkgs {
+				s := pkgStates[p.importPath]
+				failed, _ := s.tests()
+				settle(s, failed, nil, projectRoot, nil)
+			}
+		}})
+	}
+
+	finished := make(map[string]chan struct{}, len(units))
+	for _, u := range units {
+		finished[u.group] = make(chan struct{})
+	}
+	var wg sync.WaitGroup
+	for _, u := range units {
+		wg.Add(1)
+		go func(u unit) {
+			defer wg.Done()
+			defer close(finished[u.group])
+			for _, dep := range runnerCfg.dependsOn(u.group) {
+				ch, ok := finished[dep]
+				if !ok {
+					continue // not part of this run
+				}
+				<-ch
+				if groupFailed(byGroup[dep]) {
+					for _, s := range byGroup[u.group] {
+						s.setStatus(statusRunning)
+						s.addLine(fmt.Sprintf("not run: %s failed", dep))
+						s.setStatus(statusFailed)
+					}
+					return
+				}
+			}
+			u.run()
+		}(u)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return done
+}
+
+// groupFailed reports whether any of a top-level suite's states failed.
+func groupFailed(states []*suiteState) bool {
+	for _, s := range states {
+		if st, _, _, _, _ := s.snapshot(); st == statusFailed {
+			return true
+		}
+	}
+	return false
+}
+
 // ── Claude Code fix prompt ──────────────────────────────────────────
 
 const maxPromptBytes = 200 * 1024 // macOS arg limit safety margin
@@ -421,6 +684,15 @@ func isTerminal() bool {
 	return fi.Mode()&os.ModeCharDevice != 0
 }
 
+// stdoutIsTerminal reports whether the dashboard can redraw in place.
+func stdoutIsTerminal() bool {
+	fi, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
 func buildClaudePrompt(suites []*suiteState) string {
 	var b strings.Builder
 	b.WriteString("The following test suite(s) failed during `bun run test-all`. Read the errors below, investigate the root cause in the codebase, and fix the failing tests.\n")
@@ -504,77 +776,246 @@ func main() {
 		}
 	}
 
+	packagesMode := flag.Bool("packages", false, "Run the backend as one tracked sub-suite per Go package")
+	failedOnly := flag.Bool("failed", false, "Re-run only the suites (or Go packages) that failed in the previous run")
+	onlySuites := flag.String("suites", "", "Comma-separated suites to run (backend, frontend, e2e, or as in "+configFile+"); default all")
+	junitPath := flag.String("report", "", "Write a JUnit XML report to this path")
+	jsonPath := flag.String("report-json", "", "Write a JSON report to this path")
+	watch := flag.Bool("watch", false, "Keep running: re-run the suites affected by each file change")
+	e2eShards := flag.Int("e2e-shards", 1, "Split the e2e suite into this many parallel shards (0 = pick from the CPU count)")
+	flag.Parse()
+
+	if *e2eShards == 0 {
+		*e2eShards = autoE2EShards()
+	}
+	if *e2eShards < 1 || *e2eShards > e2eUserCount {
+		fmt.Fprintf(os.Stderr, "  %s--e2e-shards must be between 0 and %d%s\n", red, e2eUserCount, reset)
+		os.Exit(2)
+	}
+
+	if *watch && (*junitPath != "" || *jsonPath != "") {
+		fmt.Fprintf(os.Stderr, "  %s--watch can't be combined with --report or --report-json%s\n", red, reset)
+		os.Exit(2)
+	}
+	if *watch && !stdoutIsTerminal() {
+		fmt.Fprintf(os.Stderr, "  %s--watch needs a terminal%s\n", red, reset)
+		os.Exit(2)
+	}
+
+	cfg, err := loadConfig(projectRoot)
+	if err != nil {
+		for _, line := range strings.Split(err.Error(), "\n") {
+			fmt.Fprintf(os.Stderr, "  %s%s%s\n", red, line, reset)
+		}
+		os.Exit(2)
+	}
+	runnerCfg = cfg
+
 	history := loadHistory(projectRoot)
 
-	suiteConfigs := []suiteConfig{
-		{name: "backend", dir: "backend", command: "go", args: []string{"test", "-count=1", "./..."}},
-		{name: "frontend", dir: "frontend", command: "bun", args: []string{"run", "test:run"}},
-		{name: "e2e", dir: "frontend", command: "bun", args: []string{"run", "test:e2e"}},
+	if tmpDir, err = os.MkdirTemp("", "test-runner-"); err != nil {
+		fmt.Fprintf(os.Stderr, "  %s%v%s\n", red, err, reset)
+		os.Exit(1)
+	}
+	defer removeTmpDir() // os.Exit skips this; those paths call it themselves
+	quarantine = loadQuarantine(projectRoot)
+
+	// vitest and Playwright also write JSON reports, read for per-test
+	// failures; Go's come from the output.
+	vitestJSON := filepath.Join(tmpDir, "vitest.json")
+	playwrightJSON := filepath.Join(tmpDir, "playwright.json")
+	builtins := []suiteConfig{
+		{name: "backend", dir: "backend", command: "go", args: []string{"test", "-count=1", "./..."},
+			failures: func(s *suiteState) ([]string, []string) {
+				module, err := goModulePath(projectRoot)
+				if err != nil {
+					return nil, nil
+				}
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				return goTextFailures(s.output, module), nil
+			}},
+		{name: "frontend", dir: "frontend", command: "bun",
+			args: []string{"run", "test:run", "--reporter=default", "--reporter=json", "--outputFile.json=" + vitestJSON},
+			failures: func(*suiteState) ([]string, []string) {
+				return vitestFailures(vitestJSON, projectRoot), nil
+			}},
+		{name: "e2e", dir: "frontend", command: "bun", args: []string{"run", "test:e2e", "--reporter=list,json"},
+			env: []string{"PLAYWRIGHT_JSON_OUTPUT_FILE=" + playwrightJSON},
+			failures: func(*suiteState) ([]string, []string) {
+				return playwrightFailures(playwrightJSON)
+			}},
+	}
+
+	// The configured suites, in order. Built-in ones add their configured
+	// env to their own.
+	var allConfigs []suiteConfig
+	for _, d := range runnerCfg.suites {
+		if !d.builtin {
+			allConfigs = append(allConfigs, customSuiteConfig(d))
+			continue
+		}
+		for _, sc := range builtins {
+			if sc.name != d.name {
+				continue
+			}
+			if sc.name == "e2e" && *e2eShards > 1 {
+				for _, shard := range e2eShardConfigs(*e2eShards) {
+					shard.env = append(shard.env, d.env...)
+					allConfigs = append(allConfigs, shard)
+				}
+				break
+			}
+			sc.env = append(sc.env, d.env...)
+			allConfigs = append(allConfigs, sc)
+		}
+	}
+
+	// Decide what runs. --failed narrows to the last run's failures: whole
+	// suites by name, backend packages by their sub-suite name.
+
+	wanted := map[string]bool{}
+	for _, sc := range allConfigs {
+		wanted[suiteGroup(sc.name)] = true
+	}
+	if *onlySuites != "" {
+		wanted = map[string]bool{}
+		for _, name := range strings.Split(*onlySuites, ",") {
+			name = strings.TrimSpace(name)
+			known := false
+			for _, sc := range allConfigs {
+				known = known || suiteGroup(sc.name) == name
+			}
+			if !known {
+				fmt.Fprintf(os.Stderr, "  %sUnknown suite %q (want %s)%s\n", red, name, strings.Join(runnerCfg.names(), ", "), reset)
+				os.Exit(2)
+			}
+			wanted[name] = true
+		}
+	}
+	if *failedOnly {
+		narrowed := map[string]bool{}
+		for _, name := range previousFailures(history) {
+			// --suites still applies: a package counts as part of "backend".
+			// A failed e2e shard re-runs all of e2e, since the shards may
+			// be cut differently this time.
+			if isE2EShard(name) {
+				name = "e2e"
+			}
+			if wanted[suiteGroup(name)] {
+				narrowed[name] = true
+			}
+		}
+		wanted = narrowed
+		if len(wanted) == 0 {
+			fmt.Printf("  %sNothing failed in the previous run.%s\n", green, reset)
+			return
+		}
 	}
 
-	suites := make([]*suiteState, len(suiteConfigs))
-	for i, sc := range suiteConfigs {
-		suites[i] = &suiteState{
+	var suiteConfigs []suiteConfig
+	var pkgs []goPackage
+	for _, sc := range allConfigs {
+		if sc.name == "backend" && *packagesMode {
+			continue
+		}
+		if wanted[sc.name] || (isE2EShard(sc.name) && wanted["e2e"]) {
+			suiteConfigs = append(suiteConfigs, sc)
+		}
+	}
+	// Packages run when asked for, or when --failed names any of them
+	// (which means the previous run was a --packages run).
+	for name := range wanted {
+		if strings.HasPrefix(name, packageSuitePrefix) || (name == "backend" && *packagesMode) {
+			all, err := discoverGoPackages(projectRoot)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "  %s%v%s\n", red, err, reset)
+				removeTmpDir()
+				os.Exit(1)
+			}
+			for _, p := range all {
+				if wanted[p.suite] || wanted["backend"] {
+					pkgs = append(pkgs, p)
+				}
+			}
+			break
+		}
+	}
+	if *failedOnly && len(suiteConfigs) == 0 && len(pkgs) == 0 {
+		fmt.Printf("  %sThe previously failed packages no longer exist; nothing to re-run.%s\n", yellow, reset)
+		return
+	}
+
+	suites := make([]*suiteState, 0, len(suiteConfigs)+len(pkgs))
+	for _, sc := range suiteConfigs {
+		suites = append(suites, &suiteState{
 			name:       sc.name,
 			status:     statusPending,
 			estimateMs: estimateMs(history, sc.name),
+		})
+	}
+	pkgStates := make(map[string]*suiteState, len(pkgs))
+	for _, p := range pkgs {
+		st := &suiteState{
+			name:       p.suite,
+			status:     statusPending,
+			estimateMs: estimateMs(history, p.suite),
 		}
+		pkgStates[p.importPath] = st
+		suites = append(suites, st)
+	}
+
+	if *watch {
+		runWatch(suiteConfigs, suites, pkgs, pkgStates, projectRoot, &history)
+		return
 	}
 
 	startTime := time.Now()
 
+	// The live dashboard redraws in place, which only works on a terminal.
+	// Elsewhere (CI logs) only the summary is printed.
+	live := stdoutIsTerminal()
+
 	// Hide cursor
-	fmt.Print("\033[?25l")
+	if live {
+		fmt.Print("\033[?25l")
+	}
 
 	// Signal handler for graceful shutdown
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 
-	// Print initial dashboard (blank lines to establish space)
-	for i := 0; i < dashboardLines; i++ {
-		fmt.Println()
-	}
-
 	// Render loop
 	stopRender := make(chan struct{})
 	renderDone := make(chan struct{})
 	tick := 0
+	if live {
+		drawDashboard(suites, startTime, tick)
+	}
 	go func() {
 		defer close(renderDone)
+		if !live {
+			<-stopRender
+			return
+		}
 		ticker := time.NewTicker(200 * time.Millisecond)
 		defer ticker.Stop()
 		for {
 			select {
 			case <-stopRender:
 				// Final render
-				clearDashboard()
-				fmt.Print(renderDashboard(suites, startTime, tick))
+				drawDashboard(suites, startTime, tick)
 				return
 			case <-ticker.C:
 				tick++
-				clearDashboard()
-				fmt.Print(renderDashboard(suites, startTime, tick))
+				drawDashboard(suites, startTime, tick)
 			}
 		}
 	}()
 
-	// Run all suites in parallel
-	var wg sync.WaitGroup
-	for i, sc := range suiteConfigs {
-		wg.Add(1)
-		go func(idx int, cfg suiteConfig) {
-			defer wg.Done()
-			runSuite(cfg, suites[idx], projectRoot)
-		}(i, sc)
-	}
+	doneCh := startSuites(suiteConfigs, suites, pkgs, pkgStates, projectRoot)
 
 	// Wait for either completion or signal
-	doneCh := make(chan struct{})
-	go func() {
-		wg.Wait()
-		close(doneCh)
-	}()
-
 	interrupted := false
 	select {
 	case <-doneCh:
@@ -597,9 +1038,14 @@ func main() {
 	<-renderDone
 
 	// Show cursor
-	fmt.Print("\033[?25h")
+	if live {
+		fmt.Print("\033[?25h")
+	}
 
 	if interrupted {
+		stopE2EEnv()
+		writeReports(*junitPath, *jsonPath, suites, startTime, true)
+		removeTmpDir()
 		fmt.Println()
 		fmt.Printf("  %sInterrupted%s\n", red, reset)
 		os.Exit(130)
@@ -620,8 +1066,14 @@ func main() {
 	fmt.Printf("  %s══════════════════════════════════════════════%s\n", dim, reset)
 
 	anyFailed := false
+	passedPackages := 0
 	for _, s := range suites {
 		st, elapsed, estMs, _, _ := s.snapshot()
+		// Passing packages are summed into one line below.
+		if strings.HasPrefix(s.name, packageSuitePrefix) && st == statusPassed {
+			passedPackages++
+			continue
+		}
 		icon := statusIcon(st)
 		label := statusLabel(st)
 		timeStr := fmtDuration(elapsed)
@@ -638,14 +1090,24 @@ func main() {
 			anyFailed = true
 		}
 	}
+	if passedPackages > 0 {
+		noun := "packages"
+		if passedPackages == 1 {
+			noun = "package"
+		}
+		fmt.Printf("  %s %d Go %s passed\n", statusIcon(statusPassed), passedPackages, noun)
+	}
 
 	fmt.Printf("  %s──────────────────────────────────────────────%s\n", dim, reset)
 
 	if anyFailed {
-		fmt.Printf("  %s%sSome suites failed!%s\n", bold, red, reset)
+		fmt.Printf("  %s%sSome suites failed!%s  %sRe-run just those with: bun run test-all --failed%s\n", bold, red, reset, dim, reset)
 	} else {
 		fmt.Printf("  %s%sAll suites passed!%s\n", bold, green, reset)
 	}
+	for _, note := range summaryNotes {
+		fmt.Printf("  %s%s%s\n", dim, note, reset)
+	}
 	fmt.Println()
 
 	// Print failed suite output
@@ -662,20 +1124,14 @@ func main() {
 	}
 
 	// ── Save history (before prompt so timing data isn't lost) ───────
-	run := historyRun{
-		Time:   time.Now().UTC().Format(time.RFC3339),
-		Suites: make(map[string]*suiteResult),
-	}
-	for _, s := range suites {
-		st, elapsed, _, _, _ := s.snapshot()
-		run.Suites[s.name] = &suiteResult{
-			Ms:     elapsed.Milliseconds(),
-			Passed: st == statusPassed,
-		}
-	}
-	history.Runs = append(history.Runs, run)
+	recordRun(&history, suites)
 	saveHistory(projectRoot, history)
 
+	printFlakySection(suites, history)
+
+	writeReports(*junitPath, *jsonPath, suites, startTime, false)
+	removeTmpDir()
+
 	if anyFailed {
 		offerClaudeFix(suites)
 		os.Exit(1)
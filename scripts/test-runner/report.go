@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// ── Machine-readable reports ─────────────────────────────────────────
+//
+// --report writes JUnit XML and --report-json a plain JSON summary, so CI
+// can list failing suites (or Go packages) without scraping the log. Only
+// failed suites carry their output, trimmed to the last maxTailLines lines.
+
+// suiteStatusName is a suite's status as written to reports. A suite that
+// never started (the run was interrupted) is "skipped".
+func suiteStatusName(st status) string {
+	switch st {
+	case statusPassed:
+		return "passed"
+	case statusFailed:
+		return "failed"
+	}
+	return "skipped"
+}
+
+// reportOutput is the tail of a suite's output, with ANSI codes stripped.
+func reportOutput(s *suiteState) string {
+	s.mu.Lock()
+	lines := s.output
+	if len(lines) > maxTailLines {
+		lines = lines[len(lines)-maxTailLines:]
+	}
+	lines = append([]string(nil), lines...)
+	s.mu.Unlock()
+	for i, line := range lines {
+		lines[i] = ansiRe.ReplaceAllString(line, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+type jsonReport struct {
+	Time        string            `json:"time"`
+	DurationMs  int64             `json:"duration_ms"`
+	Passed      bool              `json:"passed"`
+	Interrupted bool              `json:"interrupted"`
+	Suites      []jsonSuiteReport `json:"suites"`
+}
+
+type jsonSuiteReport struct {
+	Name        string   `json:"name"`
+	Status      string   `json:"status"`
+	DurationMs  int64    `json:"duration_ms"`
+	FailedTests []string `json:"failed_tests,omitempty"`
+	FlakyTests  []string `json:"flaky_tests,omitempty"` // passed on retry
+	Output      string   `json:"output,omitempty"`
+}
+
+func writeJSONReport(path string, suites []*suiteState, total time.Duration, interrupted bool) error {
+	rep := jsonReport{
+		Time:        time.Now().UTC().Format(time.RFC3339),
+		DurationMs:  total.Milliseconds(),
+		Passed:      !interrupted,
+		Interrupted: interrupted,
+		Suites:      make([]jsonSuiteReport, 0, len(suites)),
+	}
+	for _, s := range suites {
+		st, elapsed, _, _, _ := s.snapshot()
+		failed, flaky := s.tests()
+		sr := jsonSuiteReport{
+			Name:        s.name,
+			Status:      suiteStatusName(st),
+			DurationMs:  elapsed.Milliseconds(),
+			FailedTests: failed,
+			FlakyTests:  flaky,
+		}
+		if st == statusFailed {
+			rep.Passed = false
+			sr.Output = reportOutput(s)
+		}
+		rep.Suites = append(rep.Suites, sr)
+	}
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Output  string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// writeJUnitReport writes one testcase per suite. Go packages are grouped
+// under classname "backend", so report viewers list them together.
+func writeJUnitReport(path string, suites []*suiteState, startTime time.Time, total time.Duration, interrupted bool) error {
+	ts := junitTestSuite{
+		Name:      "test-all",
+		Time:      junitSeconds(total),
+		Timestamp: startTime.UTC().Format(time.RFC3339),
+	}
+	for _, s := range suites {
+		st, elapsed, _, _, _ := s.snapshot()
+		tc := junitTestCase{
+			Name:      s.name,
+			Classname: strings.SplitN(s.name, "/", 2)[0],
+			Time:      junitSeconds(elapsed),
+		}
+		switch st {
+		case statusFailed:
+			ts.Failures++
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%s failed after %s", s.name, fmtDuration(elapsed)),
+				Output:  reportOutput(s),
+			}
+		case statusPending, statusRunning:
+			ts.Skipped++
+			tc.Skipped = &junitSkipped{Message: "not run"}
+			if interrupted {
+				tc.Skipped.Message = "run interrupted"
+			}
+		}
+		ts.Tests++
+		ts.Cases = append(ts.Cases, tc)
+	}
+	doc := junitTestSuites{
+		Name:     ts.Name,
+		Tests:    ts.Tests,
+		Failures: ts.Failures,
+		Skipped:  ts.Skipped,
+		Time:     ts.Time,
+		Suites:   []junitTestSuite{ts},
+	}
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(xml.Header), append(data, '\n')...), 0644)
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// writeReports writes whichever reports were asked for. A failed write is
+// reported but doesn't change the exit code; the run itself decides that.
+func writeReports(junitPath, jsonPath string, suites []*suiteState, startTime time.Time, interrupted bool) {
+	total := time.Since(startTime)
+	if junitPath != "" {
+		if err := writeJUnitReport(junitPath, suites, startTime, total, interrupted); err != nil {
+			fmt.Fprintf(os.Stderr, "  %sFailed to write JUnit report: %v%s\n", red, err, reset)
+		}
+	}
+	if jsonPath != "" {
+		if err := writeJSONReport(jsonPath, suites, total, interrupted); err != nil {
+			fmt.Fprintf(os.Stderr, "  %sFailed to write JSON report: %v%s\n", red, err, reset)
+		}
+	}
+}
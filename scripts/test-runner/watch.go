@@ -0,0 +1,321 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ── Watch mode ───────────────────────────────────────────────────────
+//
+// With --watch the runner runs the selected suites once, then polls
+// backend/ and frontend/ for changes and re-runs only the suites a change
+// affects. Polling mtimes keeps the runner free of dependencies; a walk of
+// both trees (minus node_modules and build output) takes a few ms.
+
+const (
+	watchPollInterval = 250 * time.Millisecond
+	// watchDebounce is how long the tree must be quiet before a run starts,
+	// so a save-all or a branch switch triggers one run, not several.
+	watchDebounce = 400 * time.Millisecond
+	// watchOutputTail is how much of a failed suite's output is printed
+	// after each watch run.
+	watchOutputTail = 40
+)
+
+// watchedRoots are the directories polled, relative to the project root.
+var watchedRoots = []string{"backend", "frontend"}
+
+// skippedDirs are never walked: dependencies, build output and test
+// artifacts, which change on every run. Dot-directories are skipped too.
+var skippedDirs = map[string]bool{
+	"node_modules":      true,
+	"out":               true,
+	"build":             true,
+	"coverage":          true,
+	"test-results":      true,
+	"playwright-report": true,
+}
+
+// frontendSourceExts are the frontend file types whose changes re-run the
+// unit tests.
+var frontendSourceExts = map[string]bool{
+	".ts": true, ".tsx": true, ".js": true, ".jsx": true,
+	".mjs": true, ".mts": true, ".css": true, ".json": true,
+}
+
+// suitesForPath maps a changed file (slash-separated, relative to the
+// project root) to the suites it affects: the built-in suite it belongs to,
+// if any, and every configured suite whose dir contains it.
+func suitesForPath(path string) []string {
+	var suites []string
+	if s := builtinSuiteForPath(path); s != "" {
+		suites = append(suites, s)
+	}
+	for _, d := range runnerCfg.suites {
+		dir := filepath.ToSlash(d.dir)
+		if !d.builtin && (dir == "." || strings.HasPrefix(path, dir+"/")) {
+			suites = append(suites, d.name)
+		}
+	}
+	return suites
+}
+
+func builtinSuiteForPath(path string) string {
+	switch {
+	case strings.HasPrefix(path, "backend/"):
+		ext := filepath.Ext(path)
+		if ext == ".go" || ext == ".sql" || strings.Contains(path, "/testdata/") ||
+			path == "backend/go.mod" || path == "backend/go.sum" {
+			return "backend"
+		}
+	case strings.HasPrefix(path, "frontend/e2e/"), path == "frontend/playwright.config.ts":
+		return "e2e"
+	case strings.HasPrefix(path, "frontend/"):
+		if frontendSourceExts[filepath.Ext(path)] || path == "frontend/bun.lock" {
+			return "frontend"
+		}
+	}
+	return ""
+}
+
+type fileStamp struct {
+	mod  time.Time
+	size int64
+}
+
+// snapshotTree records the mtime and size of every watched file.
+func snapshotTree(projectRoot string) map[string]fileStamp {
+	snap := map[string]fileStamp{}
+	for _, root := range watchedRoots {
+		rootPath := filepath.Join(projectRoot, root)
+		_ = filepath.WalkDir(rootPath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return nil // vanished mid-walk; the next poll sees it
+			}
+			name := d.Name()
+			if d.IsDir() {
+				if path != rootPath && (strings.HasPrefix(name, ".") || skippedDirs[name]) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasPrefix(name, ".") {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+			rel, err := filepath.Rel(projectRoot, path)
+			if err != nil {
+				return nil
+			}
+			snap[filepath.ToSlash(rel)] = fileStamp{mod: info.ModTime(), size: info.Size()}
+			return nil
+		})
+	}
+	return snap
+}
+
+// changedFiles lists files added, modified or removed between snapshots.
+func changedFiles(before, after map[string]fileStamp) []string {
+	var changed []string
+	for path, st := range after {
+		if prev, ok := before[path]; !ok || prev != st {
+			changed = append(changed, path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// runWatch runs the selected suites, then re-runs the affected ones on every
+// change until interrupted. The dashboard stays up throughout; each run's
+// result and failure output scroll above it.
+func runWatch(suiteConfigs []suiteConfig, suites []*suiteState, pkgs []goPackage, pkgStates map[string]*suiteState, projectRoot string, history *historyData) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+
+	fmt.Print("\033[?25l")
+	exit := func(code int) {
+		termMu.Lock()
+		fmt.Print("\033[?25h")
+		termMu.Unlock()
+		fmt.Println()
+		removeTmpDir()
+		os.Exit(code)
+	}
+
+	// A run covers whole suites: "backend" stands for every package and
+	// "e2e" for every shard.
+	selected := map[string]bool{}
+	for _, sc := range suiteConfigs {
+		selected[suiteGroup(sc.name)] = true
+	}
+	if len(pkgs) > 0 {
+		selected["backend"] = true
+	}
+
+	affected := selected
+	trigger := ""
+	for {
+		snap := snapshotTree(projectRoot)
+
+		var runConfigs []suiteConfig
+		var runStates []*suiteState
+		for _, sc := range suiteConfigs {
+			if affected[suiteGroup(sc.name)] {
+				runConfigs = append(runConfigs, sc)
+			}
+		}
+		var runPkgs []goPackage
+		if affected["backend"] {
+			runPkgs = pkgs
+		}
+		for _, s := range suites {
+			if affected[suiteGroup(s.name)] {
+				s.reset(estimateMs(*history, s.name))
+				runStates = append(runStates, s)
+			}
+		}
+
+		startTime := time.Now()
+		termMu.Lock()
+		watchLine = "running"
+		if trigger != "" {
+			watchLine = "changed: " + trigger
+		}
+		termMu.Unlock()
+
+		done := startSuites(runConfigs, runStates, runPkgs, pkgStates, projectRoot)
+		ticker := time.NewTicker(200 * time.Millisecond)
+		tick := 0
+	running:
+		for {
+			select {
+			case <-done:
+				break running
+			case <-sigCh:
+				killAllProcessGroups()
+				stopE2EEnv()
+				exit(130)
+			case <-ticker.C:
+				tick++
+				drawDashboard(suites, startTime, tick)
+			}
+		}
+		ticker.Stop()
+		forgetPids()
+
+		recordRun(history, runStates)
+		saveHistory(projectRoot, *history)
+
+		termMu.Lock()
+		clearDashboard()
+		printWatchResult(runStates)
+		watchLine = fmt.Sprintf("watching %s for changes · Ctrl-C to exit", strings.Join(watchedRoots, "/ and ")+"/")
+		termMu.Unlock()
+		drawDashboard(suites, startTime, tick)
+
+		affected, trigger = waitForChanges(projectRoot, snap, selected, sigCh, exit)
+	}
+}
+
+// waitForChanges polls until files affecting a selected suite change and the
+// tree has been quiet for watchDebounce. It returns the affected suites and
+// a short description of the change. Changes made during the previous run
+// are caught because snap was taken before it started.
+func waitForChanges(projectRoot string, snap map[string]fileStamp, selected map[string]bool, sigCh <-chan os.Signal, exit func(int)) (map[string]bool, string) {
+	affected := map[string]bool{}
+	var files []string
+	var lastChange time.Time
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sigCh:
+			exit(0)
+		case <-ticker.C:
+		}
+
+		cur := snapshotTree(projectRoot)
+		for _, path := range changedFiles(snap, cur) {
+			hit := false
+			for _, suite := range suitesForPath(path) {
+				if selected[suite] {
+					affected[suite] = true
+					hit = true
+				}
+			}
+			if hit {
+				files = append(files, path)
+				lastChange = time.Now()
+			}
+		}
+		snap = cur
+
+		if len(affected) > 0 && time.Since(lastChange) >= watchDebounce {
+			trigger := files[0]
+			if len(files) > 1 {
+				trigger += fmt.Sprintf(" (+%d more)", len(files)-1)
+			}
+			return affected, trigger
+		}
+	}
+}
+
+// printWatchResult prints one line per run plus the tail of each failure.
+// Callers hold termMu.
+func printWatchResult(suites []*suiteState) {
+	var parts []string
+	passedPackages := 0
+	var failed []*suiteState
+	for _, s := range suites {
+		st, elapsed, _, _, _ := s.snapshot()
+		if st == statusFailed {
+			failed = append(failed, s)
+		}
+		if strings.HasPrefix(s.name, packageSuitePrefix) && st == statusPassed {
+			passedPackages++
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %s", statusIcon(st), s.name, fmtDuration(elapsed)))
+	}
+	if passedPackages > 0 {
+		noun := "packages"
+		if passedPackages == 1 {
+			noun = "package"
+		}
+		parts = append(parts, fmt.Sprintf("%s %d Go %s", statusIcon(statusPassed), passedPackages, noun))
+	}
+	fmt.Printf("  %s%s%s  %s\n", dim, time.Now().Format("15:04:05"), reset, strings.Join(parts, "  "))
+	for _, note := range summaryNotes {
+		fmt.Printf("  %s%s%s\n", dim, note, reset)
+	}
+	summaryNotes = nil
+
+	for _, s := range failed {
+		s.mu.Lock()
+		lines := s.output
+		if len(lines) > watchOutputTail {
+			lines = lines[len(lines)-watchOutputTail:]
+		}
+		out := strings.Join(lines, "\n")
+		s.mu.Unlock()
+		fmt.Printf("  %s══ %s output (last %d lines) ══%s\n", red, s.name, watchOutputTail, reset)
+		fmt.Println(out)
+	}
+	fmt.Println()
+}